@@ -0,0 +1,226 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package action contains the icons in the Action category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package action
+
+import "gio.tools/icons"
+
+var (
+	Action3DRotation       = icons.Action3DRotation
+	Accessibility          = icons.ActionAccessibility
+	Accessible             = icons.ActionAccessible
+	AccountBalance         = icons.ActionAccountBalance
+	AccountBalanceWallet   = icons.ActionAccountBalanceWallet
+	AccountBox             = icons.ActionAccountBox
+	AccountCircle          = icons.ActionAccountCircle
+	AddShoppingCart        = icons.ActionAddShoppingCart
+	Alarm                  = icons.ActionAlarm
+	AlarmAdd               = icons.ActionAlarmAdd
+	AlarmOff               = icons.ActionAlarmOff
+	AlarmOn                = icons.ActionAlarmOn
+	AllOut                 = icons.ActionAllOut
+	Android                = icons.ActionAndroid
+	Announcement           = icons.ActionAnnouncement
+	AspectRatio            = icons.ActionAspectRatio
+	Assessment             = icons.ActionAssessment
+	Assignment             = icons.ActionAssignment
+	AssignmentInd          = icons.ActionAssignmentInd
+	AssignmentLate         = icons.ActionAssignmentLate
+	AssignmentReturn       = icons.ActionAssignmentReturn
+	AssignmentReturned     = icons.ActionAssignmentReturned
+	AssignmentTurnedIn     = icons.ActionAssignmentTurnedIn
+	Autorenew              = icons.ActionAutorenew
+	Backup                 = icons.ActionBackup
+	Book                   = icons.ActionBook
+	Bookmark               = icons.ActionBookmark
+	BookmarkBorder         = icons.ActionBookmarkBorder
+	BugReport              = icons.ActionBugReport
+	Build                  = icons.ActionBuild
+	Cached                 = icons.ActionCached
+	CameraEnhance          = icons.ActionCameraEnhance
+	CardGiftcard           = icons.ActionCardGiftcard
+	CardMembership         = icons.ActionCardMembership
+	CardTravel             = icons.ActionCardTravel
+	ChangeHistory          = icons.ActionChangeHistory
+	CheckCircle            = icons.ActionCheckCircle
+	ChromeReaderMode       = icons.ActionChromeReaderMode
+	Class                  = icons.ActionClass
+	Code                   = icons.ActionCode
+	CompareArrows          = icons.ActionCompareArrows
+	Copyright              = icons.ActionCopyright
+	CreditCard             = icons.ActionCreditCard
+	DNS                    = icons.ActionDNS
+	Dashboard              = icons.ActionDashboard
+	DateRange              = icons.ActionDateRange
+	Delete                 = icons.ActionDelete
+	DeleteForever          = icons.ActionDeleteForever
+	Description            = icons.ActionDescription
+	Done                   = icons.ActionDone
+	DoneAll                = icons.ActionDoneAll
+	DonutLarge             = icons.ActionDonutLarge
+	DonutSmall             = icons.ActionDonutSmall
+	Eject                  = icons.ActionEject
+	EuroSymbol             = icons.ActionEuroSymbol
+	Event                  = icons.ActionEvent
+	EventSeat              = icons.ActionEventSeat
+	ExitToApp              = icons.ActionExitToApp
+	Explore                = icons.ActionExplore
+	Extension              = icons.ActionExtension
+	Face                   = icons.ActionFace
+	Favorite               = icons.ActionFavorite
+	FavoriteBorder         = icons.ActionFavoriteBorder
+	Feedback               = icons.ActionFeedback
+	FindInPage             = icons.ActionFindInPage
+	FindReplace            = icons.ActionFindReplace
+	Fingerprint            = icons.ActionFingerprint
+	FlightLand             = icons.ActionFlightLand
+	FlightTakeoff          = icons.ActionFlightTakeoff
+	FlipToBack             = icons.ActionFlipToBack
+	FlipToFront            = icons.ActionFlipToFront
+	GIF                    = icons.ActionGIF
+	GTranslate             = icons.ActionGTranslate
+	Gavel                  = icons.ActionGavel
+	GetApp                 = icons.ActionGetApp
+	Grade                  = icons.ActionGrade
+	GroupWork              = icons.ActionGroupWork
+	HTTP                   = icons.ActionHTTP
+	HTTPS                  = icons.ActionHTTPS
+	Help                   = icons.ActionHelp
+	HelpOutline            = icons.ActionHelpOutline
+	HighlightOff           = icons.ActionHighlightOff
+	History                = icons.ActionHistory
+	Home                   = icons.ActionHome
+	HourglassEmpty         = icons.ActionHourglassEmpty
+	HourglassFull          = icons.ActionHourglassFull
+	ImportantDevices       = icons.ActionImportantDevices
+	Info                   = icons.ActionInfo
+	InfoOutline            = icons.ActionInfoOutline
+	Input                  = icons.ActionInput
+	InvertColors           = icons.ActionInvertColors
+	Label                  = icons.ActionLabel
+	LabelOutline           = icons.ActionLabelOutline
+	Language               = icons.ActionLanguage
+	Launch                 = icons.ActionLaunch
+	LightbulbOutline       = icons.ActionLightbulbOutline
+	LineStyle              = icons.ActionLineStyle
+	LineWeight             = icons.ActionLineWeight
+	List                   = icons.ActionList
+	Lock                   = icons.ActionLock
+	LockOpen               = icons.ActionLockOpen
+	LockOutline            = icons.ActionLockOutline
+	Loyalty                = icons.ActionLoyalty
+	MarkUnreadMailbox      = icons.ActionMarkUnreadMailbox
+	Motorcycle             = icons.ActionMotorcycle
+	NoteAdd                = icons.ActionNoteAdd
+	OfflinePin             = icons.ActionOfflinePin
+	Opacity                = icons.ActionOpacity
+	OpenInBrowser          = icons.ActionOpenInBrowser
+	OpenInNew              = icons.ActionOpenInNew
+	OpenWith               = icons.ActionOpenWith
+	Pageview               = icons.ActionPageview
+	PanTool                = icons.ActionPanTool
+	Payment                = icons.ActionPayment
+	PermCameraMic          = icons.ActionPermCameraMic
+	PermContactCalendar    = icons.ActionPermContactCalendar
+	PermDataSetting        = icons.ActionPermDataSetting
+	PermDeviceInformation  = icons.ActionPermDeviceInformation
+	PermIdentity           = icons.ActionPermIdentity
+	PermMedia              = icons.ActionPermMedia
+	PermPhoneMsg           = icons.ActionPermPhoneMsg
+	PermScanWiFi           = icons.ActionPermScanWiFi
+	Pets                   = icons.ActionPets
+	PictureInPicture       = icons.ActionPictureInPicture
+	PictureInPictureAlt    = icons.ActionPictureInPictureAlt
+	PlayForWork            = icons.ActionPlayForWork
+	Polymer                = icons.ActionPolymer
+	PowerSettingsNew       = icons.ActionPowerSettingsNew
+	PregnantWoman          = icons.ActionPregnantWoman
+	Print                  = icons.ActionPrint
+	QueryBuilder           = icons.ActionQueryBuilder
+	QuestionAnswer         = icons.ActionQuestionAnswer
+	Receipt                = icons.ActionReceipt
+	RecordVoiceOver        = icons.ActionRecordVoiceOver
+	Redeem                 = icons.ActionRedeem
+	RemoveShoppingCart     = icons.ActionRemoveShoppingCart
+	Reorder                = icons.ActionReorder
+	ReportProblem          = icons.ActionReportProblem
+	Restore                = icons.ActionRestore
+	RestorePage            = icons.ActionRestorePage
+	Room                   = icons.ActionRoom
+	RoundedCorner          = icons.ActionRoundedCorner
+	Rowing                 = icons.ActionRowing
+	Schedule               = icons.ActionSchedule
+	Search                 = icons.ActionSearch
+	Settings               = icons.ActionSettings
+	SettingsApplications   = icons.ActionSettingsApplications
+	SettingsBackupRestore  = icons.ActionSettingsBackupRestore
+	SettingsBluetooth      = icons.ActionSettingsBluetooth
+	SettingsBrightness     = icons.ActionSettingsBrightness
+	SettingsCell           = icons.ActionSettingsCell
+	SettingsEthernet       = icons.ActionSettingsEthernet
+	SettingsInputAntenna   = icons.ActionSettingsInputAntenna
+	SettingsInputComponent = icons.ActionSettingsInputComponent
+	SettingsInputComposite = icons.ActionSettingsInputComposite
+	SettingsInputHDMI      = icons.ActionSettingsInputHDMI
+	SettingsInputSVideo    = icons.ActionSettingsInputSVideo
+	SettingsOverscan       = icons.ActionSettingsOverscan
+	SettingsPhone          = icons.ActionSettingsPhone
+	SettingsPower          = icons.ActionSettingsPower
+	SettingsRemote         = icons.ActionSettingsRemote
+	SettingsVoice          = icons.ActionSettingsVoice
+	Shop                   = icons.ActionShop
+	ShopTwo                = icons.ActionShopTwo
+	ShoppingBasket         = icons.ActionShoppingBasket
+	ShoppingCart           = icons.ActionShoppingCart
+	SpeakerNotes           = icons.ActionSpeakerNotes
+	SpeakerNotesOff        = icons.ActionSpeakerNotesOff
+	Spellcheck             = icons.ActionSpellcheck
+	StarRate               = icons.ActionStarRate
+	Stars                  = icons.ActionStars
+	Store                  = icons.ActionStore
+	Subject                = icons.ActionSubject
+	SupervisorAccount      = icons.ActionSupervisorAccount
+	SwapHoriz              = icons.ActionSwapHoriz
+	SwapVert               = icons.ActionSwapVert
+	SwapVerticalCircle     = icons.ActionSwapVerticalCircle
+	SystemUpdateAlt        = icons.ActionSystemUpdateAlt
+	TOC                    = icons.ActionTOC
+	Tab                    = icons.ActionTab
+	TabUnselected          = icons.ActionTabUnselected
+	Theaters               = icons.ActionTheaters
+	ThumbDown              = icons.ActionThumbDown
+	ThumbUp                = icons.ActionThumbUp
+	ThumbsUpDown           = icons.ActionThumbsUpDown
+	Timeline               = icons.ActionTimeline
+	Today                  = icons.ActionToday
+	Toll                   = icons.ActionToll
+	TouchApp               = icons.ActionTouchApp
+	TrackChanges           = icons.ActionTrackChanges
+	Translate              = icons.ActionTranslate
+	TrendingDown           = icons.ActionTrendingDown
+	TrendingFlat           = icons.ActionTrendingFlat
+	TrendingUp             = icons.ActionTrendingUp
+	TurnedIn               = icons.ActionTurnedIn
+	TurnedInNot            = icons.ActionTurnedInNot
+	Update                 = icons.ActionUpdate
+	VerifiedUser           = icons.ActionVerifiedUser
+	ViewAgenda             = icons.ActionViewAgenda
+	ViewArray              = icons.ActionViewArray
+	ViewCarousel           = icons.ActionViewCarousel
+	ViewColumn             = icons.ActionViewColumn
+	ViewDay                = icons.ActionViewDay
+	ViewHeadline           = icons.ActionViewHeadline
+	ViewList               = icons.ActionViewList
+	ViewModule             = icons.ActionViewModule
+	ViewQuilt              = icons.ActionViewQuilt
+	ViewStream             = icons.ActionViewStream
+	ViewWeek               = icons.ActionViewWeek
+	Visibility             = icons.ActionVisibility
+	VisibilityOff          = icons.ActionVisibilityOff
+	WatchLater             = icons.ActionWatchLater
+	Work                   = icons.ActionWork
+	YoutubeSearchedFor     = icons.ActionYoutubeSearchedFor
+	ZoomIn                 = icons.ActionZoomIn
+	ZoomOut                = icons.ActionZoomOut
+)
@@ -0,0 +1,182 @@
+package icons
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Easing maps linear progress through an animation, from 0 to 1, to eased progress.
+type Easing func(t float32) float32
+
+// Common easing curves.
+var (
+	Linear    Easing = func(t float32) float32 { return t }
+	EaseIn    Easing = func(t float32) float32 { return t * t * t }
+	EaseOut   Easing = func(t float32) float32 { t = 1 - t; return 1 - t*t*t }
+	EaseInOut Easing = func(t float32) float32 { return t * t * (3 - 2*t) }
+)
+
+// Effect is a named animation effect.
+type Effect uint8
+
+const (
+	// EffectSpin turns clockwise through one full rotation per cycle, for loading and
+	// syncing indicators.
+	EffectSpin Effect = iota
+	// EffectPulse grows slightly and shrinks back, to draw attention.
+	EffectPulse
+	// EffectBounce jumps up and falls back down.
+	EffectBounce
+	// EffectShake shakes from side to side with a decaying strength, such as for an
+	// error.
+	EffectShake
+)
+
+func (e Effect) defaults() (time.Duration, Easing) {
+	switch e {
+	case EffectSpin:
+		return time.Second, Linear
+	case EffectShake:
+		return 400 * time.Millisecond, Linear
+	}
+	return 600 * time.Millisecond, EaseInOut
+}
+
+// Animation plays an effect on an icon or any other widget. It only invalidates the
+// frame while it is running, so an idle animation costs nothing.
+type Animation struct {
+	Effect Effect
+	// Duration of a single cycle. The zero value uses a default for the effect.
+	Duration time.Duration
+	// Easing is applied to each cycle. If nil, a default for the effect is used.
+	Easing Easing
+	// Repeat is the number of cycles to play. Zero or less plays until Stop is called.
+	Repeat int
+
+	startedAt time.Time
+	running   bool
+}
+
+// Start starts (or restarts) the animation from the beginning.
+func (a *Animation) Start(gtx layout.Context) {
+	a.startedAt = gtx.Now
+	a.running = true
+	gtx.Execute(op.InvalidateCmd{})
+}
+
+// Stop stops the animation, returning the widget to rest.
+func (a *Animation) Stop() {
+	a.running = false
+}
+
+// Running reports whether the animation is still playing at the given time.
+func (a *Animation) Running(now time.Time) bool {
+	if !a.running {
+		return false
+	}
+	if a.Repeat > 0 && now.Sub(a.startedAt) >= a.duration()*time.Duration(a.Repeat) {
+		a.running = false
+	}
+	return a.running
+}
+
+func (a *Animation) duration() time.Duration {
+	if a.Duration > 0 {
+		return a.Duration
+	}
+	d, _ := a.Effect.defaults()
+	return d
+}
+
+// LayoutIcon lays out the icon with the animation applied.
+func (a *Animation) LayoutIcon(gtx layout.Context, ic *Icon, col color.NRGBA) layout.Dimensions {
+	return a.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return ic.Layout(gtx, col)
+	})
+}
+
+// Layout lays out w with the animation applied about its center. The returned dimensions
+// are those of w, unaffected by the animation.
+func (a *Animation) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	if !a.Running(gtx.Now) {
+		return w(gtx)
+	}
+	gtx.Execute(op.InvalidateCmd{})
+
+	m := op.Record(gtx.Ops)
+	dims := w(gtx)
+	call := m.Stop()
+
+	d := a.duration()
+	t := float32(gtx.Now.Sub(a.startedAt)%d) / float32(d)
+	ease := a.Easing
+	if ease == nil {
+		_, ease = a.Effect.defaults()
+	}
+	t = ease(t)
+
+	size := layout.FPt(dims.Size)
+	center := size.Mul(0.5)
+	var af f32.Affine2D
+	switch a.Effect {
+	case EffectSpin:
+		af = af.Rotate(center, 2*math.Pi*t)
+	case EffectPulse:
+		s := 1 + 0.15*float32(math.Sin(math.Pi*float64(t)))
+		af = af.Scale(center, f32.Pt(s, s))
+	case EffectBounce:
+		af = af.Offset(f32.Pt(0, -0.25*size.Y*float32(math.Sin(math.Pi*float64(t)))))
+	case EffectShake:
+		x := 0.1 * size.X * float32(math.Sin(6*math.Pi*float64(t))) * (1 - t)
+		af = af.Offset(f32.Pt(x, 0))
+	}
+	defer op.Affine(af).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+	return dims
+}
+
+// transition tracks the progress between two states, from 0 for false to 1 for true,
+// such as the two icons of a Morph.
+type transition struct {
+	target    bool
+	changedAt time.Time
+	// The progress when the target last changed, so that changing the target partway
+	// through a transition reverses it smoothly.
+	changedFrom float32
+}
+
+// set starts moving towards the target state over the duration d of a full transition.
+// It does nothing if that is already the target.
+func (tr *transition) set(gtx layout.Context, target bool, d time.Duration) {
+	if target == tr.target {
+		return
+	}
+	tr.changedFrom = tr.progress(gtx.Now, d)
+	tr.changedAt = gtx.Now
+	tr.target = target
+	gtx.Execute(op.InvalidateCmd{})
+}
+
+// progress returns how far the transition is from 0 to 1, before easing, given the
+// duration d of a full transition.
+func (tr *transition) progress(now time.Time, d time.Duration) float32 {
+	end := float32(0)
+	if tr.target {
+		end = 1
+	}
+	if tr.changedAt.IsZero() {
+		return end
+	}
+	// A reversal only has the remaining distance to cover.
+	span := float32(math.Abs(float64(end - tr.changedFrom)))
+	t := float32(now.Sub(tr.changedAt)) / (float32(d) * span)
+	if t >= 1 || span == 0 {
+		return end
+	}
+	return tr.changedFrom + (end-tr.changedFrom)*t
+}
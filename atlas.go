@@ -0,0 +1,114 @@
+package icons
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// AtlasRegion is where an icon, rasterized at one size, is in an atlas image.
+type AtlasRegion struct {
+	Icon *Icon
+	Size int // The icon's width in pixels.
+	Rect image.Rectangle
+}
+
+// Atlas is a single image holding many pre-rasterized icons, generated with
+// `go run gio.tools/icons/cmd/gen -atlas`. Drawing from an atlas uploads one texture
+// instead of rasterizing each icon at runtime, which helps on slow devices and in the
+// browser.
+//
+// The atlas image only holds coverage, which is tinted in one colour when the atlas is
+// made. Icons drawn at a size or in a colour that the atlas doesn't have are rasterized
+// as usual instead.
+type Atlas struct {
+	color   color.NRGBA
+	op      paint.ImageOp
+	regions map[atlasKey]image.Rectangle
+}
+
+type atlasKey struct {
+	icon *Icon
+	size int
+}
+
+// NewAtlas returns an atlas of the given regions of mask, whose alpha channel is each
+// icon's coverage, tinted in col.
+func NewAtlas(mask image.Image, regions []AtlasRegion, col color.NRGBA) *Atlas {
+	b := mask.Bounds()
+	img := image.NewRGBA(image.Rectangle{Max: b.Size()})
+	c := linearRGBA(col)
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			_, _, _, a := mask.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			if a == 0 {
+				continue
+			}
+			i := img.PixOffset(x, y)
+			img.Pix[i+0] = uint8(uint32(c.R) * a / 0xffff)
+			img.Pix[i+1] = uint8(uint32(c.G) * a / 0xffff)
+			img.Pix[i+2] = uint8(uint32(c.B) * a / 0xffff)
+			img.Pix[i+3] = uint8(uint32(c.A) * a / 0xffff)
+		}
+	}
+	a := &Atlas{
+		color:   col,
+		op:      paint.NewImageOp(img),
+		regions: make(map[atlasKey]image.Rectangle, len(regions)),
+	}
+	for _, r := range regions {
+		a.regions[atlasKey{r.Icon, r.Size}] = r.Rect.Sub(b.Min)
+	}
+	return a
+}
+
+// DecodeAtlas decodes a PNG atlas image and returns it as an atlas tinted in col.
+func DecodeAtlas(pngData []byte, regions []AtlasRegion, col color.NRGBA) (*Atlas, error) {
+	mask, err := png.Decode(bytes.NewReader(pngData))
+	if err != nil {
+		return nil, fmt.Errorf("icons: decoding atlas: %w", err)
+	}
+	return NewAtlas(mask, regions, col), nil
+}
+
+// Color returns the colour that the atlas's icons are drawn in.
+func (a *Atlas) Color() color.NRGBA {
+	return a.color
+}
+
+// Icon returns the icon drawn from the atlas.
+func (a *Atlas) Icon(ic *Icon) AtlasIcon {
+	return AtlasIcon{Atlas: a, Icon: ic}
+}
+
+// AtlasIcon is an icon drawn from an Atlas when possible.
+type AtlasIcon struct {
+	Atlas *Atlas
+	Icon  *Icon
+}
+
+// Layout displays the icon with its size set to the X minimum constraint, in the same way
+// as `Icon.Layout`. If the atlas doesn't have the icon at that size, or col isn't the
+// atlas's colour, the icon is rasterized instead.
+func (ai AtlasIcon) Layout(gtx layout.Context, col color.NRGBA) layout.Dimensions {
+	size := iconSize(gtx)
+	r, ok := ai.Atlas.regions[atlasKey{ai.Icon, size.X}]
+	if !ok || col != ai.Atlas.color {
+		return ai.Icon.Layout(gtx, col)
+	}
+	if ai.Icon.rtlMirrored(gtx) {
+		defer mirrorOp(r.Size()).Push(gtx.Ops).Pop()
+	}
+	defer clip.Rect{Max: r.Size()}.Push(gtx.Ops).Pop()
+	defer op.Offset(r.Min.Mul(-1)).Push(gtx.Ops).Pop()
+	ai.Atlas.op.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	return layout.Dimensions{Size: r.Size()}
+}
@@ -0,0 +1,90 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package av contains the icons in the AV category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package av
+
+import "gio.tools/icons"
+
+var (
+	AVTimer            = icons.AVAVTimer
+	AddToQueue         = icons.AVAddToQueue
+	Airplay            = icons.AVAirplay
+	Album              = icons.AVAlbum
+	ArtTrack           = icons.AVArtTrack
+	BrandingWatermark  = icons.AVBrandingWatermark
+	CallToAction       = icons.AVCallToAction
+	ClosedCaption      = icons.AVClosedCaption
+	Equalizer          = icons.AVEqualizer
+	Explicit           = icons.AVExplicit
+	FastForward        = icons.AVFastForward
+	FastRewind         = icons.AVFastRewind
+	FeaturedPlayList   = icons.AVFeaturedPlayList
+	FeaturedVideo      = icons.AVFeaturedVideo
+	FiberDVR           = icons.AVFiberDVR
+	FiberManualRecord  = icons.AVFiberManualRecord
+	FiberNew           = icons.AVFiberNew
+	FiberPin           = icons.AVFiberPin
+	FiberSmartRecord   = icons.AVFiberSmartRecord
+	Forward10          = icons.AVForward10
+	Forward30          = icons.AVForward30
+	Forward5           = icons.AVForward5
+	Games              = icons.AVGames
+	HD                 = icons.AVHD
+	Hearing            = icons.AVHearing
+	HighQuality        = icons.AVHighQuality
+	LibraryAdd         = icons.AVLibraryAdd
+	LibraryBooks       = icons.AVLibraryBooks
+	LibraryMusic       = icons.AVLibraryMusic
+	Loop               = icons.AVLoop
+	Mic                = icons.AVMic
+	MicNone            = icons.AVMicNone
+	MicOff             = icons.AVMicOff
+	Movie              = icons.AVMovie
+	MusicVideo         = icons.AVMusicVideo
+	NewReleases        = icons.AVNewReleases
+	NotInterested      = icons.AVNotInterested
+	Note               = icons.AVNote
+	Pause              = icons.AVPause
+	PauseCircleFilled  = icons.AVPauseCircleFilled
+	PauseCircleOutline = icons.AVPauseCircleOutline
+	PlayArrow          = icons.AVPlayArrow
+	PlayCircleFilled   = icons.AVPlayCircleFilled
+	PlayCircleOutline  = icons.AVPlayCircleOutline
+	PlaylistAdd        = icons.AVPlaylistAdd
+	PlaylistAddCheck   = icons.AVPlaylistAddCheck
+	PlaylistPlay       = icons.AVPlaylistPlay
+	Queue              = icons.AVQueue
+	QueueMusic         = icons.AVQueueMusic
+	QueuePlayNext      = icons.AVQueuePlayNext
+	Radio              = icons.AVRadio
+	RecentActors       = icons.AVRecentActors
+	RemoveFromQueue    = icons.AVRemoveFromQueue
+	Repeat             = icons.AVRepeat
+	RepeatOne          = icons.AVRepeatOne
+	Replay             = icons.AVReplay
+	Replay10           = icons.AVReplay10
+	Replay30           = icons.AVReplay30
+	Replay5            = icons.AVReplay5
+	Shuffle            = icons.AVShuffle
+	SkipNext           = icons.AVSkipNext
+	SkipPrevious       = icons.AVSkipPrevious
+	SlowMotionVideo    = icons.AVSlowMotionVideo
+	Snooze             = icons.AVSnooze
+	SortByAlpha        = icons.AVSortByAlpha
+	Stop               = icons.AVStop
+	Subscriptions      = icons.AVSubscriptions
+	Subtitles          = icons.AVSubtitles
+	SurroundSound      = icons.AVSurroundSound
+	VideoCall          = icons.AVVideoCall
+	VideoLabel         = icons.AVVideoLabel
+	VideoLibrary       = icons.AVVideoLibrary
+	Videocam           = icons.AVVideocam
+	VideocamOff        = icons.AVVideocamOff
+	VolumeDown         = icons.AVVolumeDown
+	VolumeMute         = icons.AVVolumeMute
+	VolumeOff          = icons.AVVolumeOff
+	VolumeUp           = icons.AVVolumeUp
+	Web                = icons.AVWeb
+	WebAsset           = icons.AVWebAsset
+)
@@ -0,0 +1,151 @@
+package icons
+
+import (
+	"container/list"
+	"image"
+	"image/color"
+	"sync"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// The budget of a Cache made with a budget of zero or less.
+const defaultCacheBudget = 8 << 20
+
+// Cache holds rasterized icons at any number of sizes and colours, unlike `widget.Icon`,
+// which only keeps the last one it drew. When the images it holds exceed its memory
+// budget, the least recently used ones are evicted.
+//
+// A Cache is safe for concurrent use, and its image ops can be shared between windows.
+type Cache struct {
+	mu     sync.Mutex
+	budget int
+	used   int
+	items  map[cacheKey]*list.Element
+	lru    list.List // Of *cacheItem, most recently used first.
+	stats  CacheStats
+}
+
+type cacheKey struct {
+	icon  *Icon
+	size  int
+	color color.NRGBA
+}
+
+type cacheItem struct {
+	key   cacheKey
+	op    paint.ImageOp
+	bytes int
+}
+
+// CacheStats are a Cache's counters since it was made or last purged.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Images    int // The number of images held.
+	Bytes     int // The memory used by the images held.
+}
+
+// NewCache returns a cache that holds up to budget bytes of images. If budget is zero or
+// less, it is 8 MiB.
+func NewCache(budget int) *Cache {
+	if budget <= 0 {
+		budget = defaultCacheBudget
+	}
+	return &Cache{budget: budget, items: make(map[cacheKey]*list.Element)}
+}
+
+// Layout displays the icon with its size set to the X minimum constraint, in the same way
+// as `Icon.Layout`, using the cached image if there is one.
+func (c *Cache) Layout(gtx layout.Context, ic *Icon, col color.NRGBA) layout.Dimensions {
+	size := iconSize(gtx)
+	if ic.rtlMirrored(gtx) {
+		defer mirrorOp(size).Push(gtx.Ops).Pop()
+	}
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+
+	ico, err := c.ImageOp(ic, size.X, col)
+	if err != nil {
+		return layout.Dimensions{Size: size}
+	}
+	ico.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	return layout.Dimensions{Size: ico.Size()}
+}
+
+// ImageOp returns the icon rasterized size pixels wide in the given colour, in the same
+// way as `widget.Icon`. An image that is larger than the whole budget is returned without
+// being cached. Any error is a `*DecodeError`.
+func (c *Cache) ImageOp(ic *Icon, size int, col color.NRGBA) (paint.ImageOp, error) {
+	key := cacheKey{ic, size, col}
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		c.lru.MoveToFront(e)
+		c.stats.Hits++
+		op := e.Value.(*cacheItem).op
+		c.mu.Unlock()
+		return op, nil
+	}
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	// Rasterize without holding the lock, so that other icons can be looked up in the
+	// meantime.
+	m, err := ic.metadata()
+	if err != nil {
+		return paint.ImageOp{}, err
+	}
+	img := image.NewRGBA(imageRect(m, size))
+	pal := uniformPalette(linearRGBA(col))
+	if err := rasterize(img, ic.Data(), &pal); err != nil {
+		return paint.ImageOp{}, err
+	}
+	item := &cacheItem{key: key, op: paint.NewImageOp(img), bytes: len(img.Pix)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		// Another goroutine got there first.
+		c.lru.MoveToFront(e)
+		return e.Value.(*cacheItem).op, nil
+	}
+	if item.bytes > c.budget {
+		return item.op, nil
+	}
+	for c.used+item.bytes > c.budget {
+		c.evict(c.lru.Back())
+	}
+	c.items[key] = c.lru.PushFront(item)
+	c.used += item.bytes
+	return item.op, nil
+}
+
+func (c *Cache) evict(e *list.Element) {
+	item := c.lru.Remove(e).(*cacheItem)
+	delete(c.items, item.key)
+	c.used -= item.bytes
+	c.stats.Evictions++
+}
+
+// Stats returns the cache's current counters.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Images = len(c.items)
+	s.Bytes = c.used
+	return s
+}
+
+// Purge removes every image from the cache and resets its counters.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+	c.lru.Init()
+	c.used = 0
+	c.stats = CacheStats{}
+}
@@ -0,0 +1,57 @@
+package icons
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	// Each 4px image is 64 bytes, so the cache holds three of them.
+	c := NewCache(3 * 64)
+	black := color.NRGBA{A: 0xff}
+	use := func(ic *Icon, size int) {
+		t.Helper()
+		op, err := c.ImageOp(ic, size, black)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := op.Size(); got != image.Pt(size, size) {
+			t.Fatalf("image size = %v, want %dpx", got, size)
+		}
+	}
+	check := func(want CacheStats) {
+		t.Helper()
+		if got := c.Stats(); got != want {
+			t.Errorf("Stats = %+v, want %+v", got, want)
+		}
+	}
+
+	a, b, d, e := ActionSearch, ActionDelete, ActionHome, ActionInfo
+	use(a, 4)
+	use(b, 4)
+	use(d, 4)
+	use(a, 4)
+	check(CacheStats{Hits: 1, Misses: 3, Images: 3, Bytes: 192})
+
+	// b is now the least recently used.
+	use(e, 4)
+	check(CacheStats{Hits: 1, Misses: 4, Evictions: 1, Images: 3, Bytes: 192})
+	use(a, 4)
+	use(d, 4)
+	use(e, 4)
+	check(CacheStats{Hits: 4, Misses: 4, Evictions: 1, Images: 3, Bytes: 192})
+	use(b, 4)
+	check(CacheStats{Hits: 4, Misses: 5, Evictions: 2, Images: 3, Bytes: 192})
+
+	// An image bigger than the budget is returned without evicting anything.
+	use(a, 8)
+	check(CacheStats{Hits: 4, Misses: 6, Evictions: 2, Images: 3, Bytes: 192})
+	use(a, 8)
+	check(CacheStats{Hits: 4, Misses: 7, Evictions: 2, Images: 3, Bytes: 192})
+
+	c.Purge()
+	check(CacheStats{})
+	use(a, 4)
+	check(CacheStats{Misses: 1, Images: 1, Bytes: 64})
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// The gap left between icons in an atlas, so that filtering never samples a neighbour.
+const atlasPadding = 1
+
+type atlasSprite struct {
+	name string
+	size int
+	rect image.Rectangle
+}
+
+// parseSizes parses a comma separated list of pixel sizes.
+func parseSizes(s string) ([]int, error) {
+	var sizes []int
+	for _, f := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid size %q", f)
+		}
+		sizes = append(sizes, n)
+	}
+	return sizes, nil
+}
+
+// packAtlas places every sprite on shelves, largest first, returning the atlas size.
+func packAtlas(sprites []atlasSprite) image.Point {
+	sort.SliceStable(sprites, func(i, j int) bool { return sprites[i].size > sprites[j].size })
+	area := 0
+	for _, s := range sprites {
+		area += (s.size + atlasPadding) * (s.size + atlasPadding)
+	}
+	width := 64
+	for width*width < area || width < sprites[0].size+atlasPadding {
+		width *= 2
+	}
+	var at image.Point
+	shelfHeight := 0
+	for i := range sprites {
+		sz := sprites[i].size
+		if at.X+sz > width {
+			at = image.Pt(0, at.Y+shelfHeight+atlasPadding)
+			shelfHeight = 0
+		}
+		sprites[i].rect = image.Rectangle{Min: at, Max: at.Add(image.Pt(sz, sz))}
+		at.X += sz + atlasPadding
+		shelfHeight = max(shelfHeight, sz)
+	}
+	return image.Pt(width, at.Y+shelfHeight)
+}
+
+const atlasSrcHeader = `// generated by go run gio.tools/icons/cmd/gen -atlas. DO NOT EDIT
+
+package %s
+
+import (
+	_ "embed"
+	"image"
+	"image/color"
+
+	"gio.tools/icons"
+)
+
+//go:embed %s
+var iconAtlasPNG []byte
+
+// newIconAtlas returns the icon atlas with its icons drawn in col.
+func newIconAtlas(col color.NRGBA) (*icons.Atlas, error) {
+	return icons.DecodeAtlas(iconAtlasPNG, iconAtlasRegions, col)
+}
+
+var iconAtlasRegions = []icons.AtlasRegion{
+`
+
+// genAtlas rasterizes the listed icons at each size into out+".png", with an index of
+// where each one is in out+".go".
+func genAtlas(names []string, listPath, sizeList, out, pkg string) error {
+	set, err := readNameSet(listPath, names)
+	if err != nil {
+		return err
+	}
+	if len(set) == 0 {
+		return fmt.Errorf("%s lists no icons", listPath)
+	}
+	sizes, err := parseSizes(sizeList)
+	if err != nil {
+		return err
+	}
+	data, err := readIconData()
+	if err != nil {
+		return err
+	}
+
+	var sprites []atlasSprite
+	for _, name := range names {
+		if !set[name] {
+			continue
+		}
+		for _, sz := range sizes {
+			sprites = append(sprites, atlasSprite{name: name, size: sz})
+		}
+	}
+	// The atlas only holds coverage. It is tinted when it is loaded, so that one atlas
+	// serves every colour.
+	img := image.NewAlpha(image.Rectangle{Max: packAtlas(sprites)})
+	pal := iconvg.DefaultPalette
+	for i := range pal {
+		pal[i] = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	}
+	for _, s := range sprites {
+		var z iconvg.Rasterizer
+		z.SetDstImage(img, s.rect, draw.Src)
+		if err := iconvg.Decode(&z, data[s.name], &iconvg.DecodeOptions{Palette: &pal}); err != nil {
+			return fmt.Errorf("rasterizing %s: %v", s.name, err)
+		}
+	}
+
+	pngFile, err := os.Create(out + ".png")
+	if err != nil {
+		return fmt.Errorf("creating atlas image: %v", err)
+	}
+	err = png.Encode(pngFile, img)
+	pngFile.Close()
+	if err != nil {
+		return fmt.Errorf("encoding atlas image: %v", err)
+	}
+
+	// Keep the index in name and size order, regardless of where the sprites ended up.
+	sort.SliceStable(sprites, func(i, j int) bool {
+		if sprites[i].name != sprites[j].name {
+			return sprites[i].name < sprites[j].name
+		}
+		return sprites[i].size < sprites[j].size
+	})
+	src := new(bytes.Buffer)
+	fmt.Fprintf(src, atlasSrcHeader, pkg, filepath.Base(out)+".png")
+	for _, s := range sprites {
+		r := s.rect
+		fmt.Fprintf(src, "\t{icons.%s, %d, image.Rect(%d, %d, %d, %d)},\n", s.name, s.size, r.Min.X, r.Min.Y, r.Max.X, r.Max.Y)
+	}
+	src.WriteString("}\n")
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting atlas index: %v", err)
+	}
+	return os.WriteFile(out+".go", formatted, 0o644)
+}
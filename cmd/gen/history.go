@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+// The icon that removed icons are replaced with.
+const placeholderIcon = "ImageBrokenImage"
+
+// nameChange is an upstream icon name that no longer exists.
+type nameChange struct {
+	old string
+	new string // The current name it resolves to, or "" if it was removed.
+}
+
+// readHistory reads the rename and removal history. Each line is "Old -> New" for a
+// rename or "Old -> -" for a removal, ignoring blank lines and '#' comments. Renames are
+// followed through later renames to a current name.
+func readHistory(path string, names []string) ([]nameChange, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	current := make(map[string]bool, len(names))
+	for _, name := range names {
+		current[name] = true
+	}
+	next := make(map[string]string)
+	var order []string
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		old, to, ok := strings.Cut(text, "->")
+		old, to = strings.TrimSpace(old), strings.TrimSpace(to)
+		if !ok || old == "" || to == "" {
+			return nil, fmt.Errorf("%s:%d: want \"Old -> New\" or \"Old -> -\"", path, line)
+		}
+		if current[old] {
+			return nil, fmt.Errorf("%s:%d: %s still exists upstream", path, line, old)
+		}
+		if _, dup := next[old]; dup {
+			return nil, fmt.Errorf("%s:%d: %s is listed twice", path, line, old)
+		}
+		if to == "-" {
+			to = ""
+		}
+		next[old] = to
+		order = append(order, old)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	changes := make([]nameChange, 0, len(order))
+	for _, old := range order {
+		to := next[old]
+		for seen := 0; to != "" && !current[to]; seen++ {
+			further, ok := next[to]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s is renamed to %s, which doesn't exist", path, old, to)
+			}
+			if seen > len(next) {
+				return nil, fmt.Errorf("%s: renames of %s form a loop", path, old)
+			}
+			to = further
+		}
+		changes = append(changes, nameChange{old: old, new: to})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].old < changes[j].old })
+	return changes, nil
+}
+
+// updateSnapshot checks that every name in the snapshot of previously generated names
+// either still exists or is in the history, then adds any new names to the snapshot.
+// Names are never removed from it, so an icon can't silently disappear.
+func updateSnapshot(path string, names []string, changes []nameChange) error {
+	snapshot := make(map[string]bool)
+	if data, err := os.ReadFile(path); err == nil {
+		for _, name := range strings.Fields(string(data)) {
+			snapshot[name] = true
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	current := make(map[string]bool, len(names))
+	for _, name := range names {
+		current[name] = true
+		snapshot[name] = true
+	}
+	for _, c := range changes {
+		current[c.old] = true
+	}
+	var missing []string
+	for name := range snapshot {
+		if !current[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("icons no longer upstream need an entry in the history file: %s", strings.Join(missing, ", "))
+	}
+
+	all := make([]string, 0, len(snapshot))
+	for name := range snapshot {
+		all = append(all, name)
+	}
+	sort.Strings(all)
+	return os.WriteFile(path, []byte(strings.Join(all, "\n")+"\n"), 0o644)
+}
+
+const aliasesSrcHeader = `// generated by go run ./cmd/gen. DO NOT EDIT
+
+package icons
+`
+
+// genAliases writes a deprecated alias for every renamed or removed icon, and a table of
+// their names that Lookup resolves to the current ones.
+func genAliases(changes []nameChange) error {
+	out, err := os.OpenFile("./aliases.go", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening out file: %v", err)
+	}
+	defer out.Close()
+
+	if _, err = fmt.Fprint(out, aliasesSrcHeader); err != nil {
+		return fmt.Errorf("writing source header: %v", err)
+	}
+	if len(changes) > 0 {
+		fmt.Fprint(out, "\n// Icons that were renamed or removed upstream, kept so that code using them still builds.\nvar (\n")
+		for i, c := range changes {
+			if i > 0 {
+				fmt.Fprint(out, "\n")
+			}
+			if c.new == "" {
+				fmt.Fprintf(out, "\t// Deprecated: %s was removed upstream, and this is a placeholder.\n", c.old)
+				fmt.Fprintf(out, "\t%s = %s\n", c.old, placeholderIcon)
+			} else {
+				fmt.Fprintf(out, "\t// Deprecated: %s was renamed to %s.\n", c.old, c.new)
+				fmt.Fprintf(out, "\t%s = %s\n", c.old, c.new)
+			}
+		}
+		fmt.Fprint(out, ")\n")
+	}
+
+	// The table is sorted by old name, like the entries, so that Lookup can binary search
+	// it.
+	fmt.Fprintf(out, "\nvar deprecatedNames = [%d]deprecatedName{", len(changes))
+	if len(changes) > 0 {
+		fmt.Fprint(out, "\n")
+	}
+	for _, c := range changes {
+		to := c.new
+		if to == "" {
+			to = placeholderIcon
+		}
+		fmt.Fprintf(out, "\t{%q, %q},\n", c.old, to)
+	}
+	if _, err = out.WriteString("}\n"); err != nil {
+		return fmt.Errorf("writing last curly bracket: %v", err)
+	}
+	return nil
+}
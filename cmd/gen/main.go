@@ -1,16 +1,146 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"flag"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
 	"log"
 	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"gio.tools/icons/internal/ivgpath"
 	"github.com/fatih/camelcase"
+	"golang.org/x/exp/shiny/iconvg"
 	"golang.org/x/tools/go/packages"
 )
 
+var (
+	genSubPkgs = flag.Bool("subpkgs", true, "Generate a subpackage for each icon category.")
+	svgDir     = flag.String("svg", "", "If set, write every icon as an SVG file into this directory.")
+	svgSize    = flag.Int("svg-size", 24, "The width in pixels of the SVG files written with -svg.")
+	atlasList  = flag.String("atlas", "", "If set, only generate a sprite atlas of the icons listed in this file, one name per line.")
+	atlasSizes = flag.String("atlas-sizes", "24", "The comma separated pixel sizes of each icon in the atlas.")
+	atlasOut   = flag.String("atlas-out", "icon_atlas", "The path, without an extension, to write the atlas's .png image and .go index to.")
+	atlasPkg   = flag.String("atlas-pkg", "main", "The package name of the atlas's .go index.")
+	symbolsDir = flag.String("symbols", "third_party/material-symbols", "The directory of vendored Material Symbols SVG sources, with a subdirectory per style.")
+)
+
+// The Material Design categories, which every upstream icon name is prefixed with.
+var categories = []string{
+	"AV",
+	"Action",
+	"Alert",
+	"Communication",
+	"Content",
+	"Device",
+	"Editor",
+	"File",
+	"Hardware",
+	"Image",
+	"Maps",
+	"Navigation",
+	"Notification",
+	"Places",
+	"Social",
+	"Toggle",
+}
+
+// splitCategory splits an icon name into its category and the rest of the name, e.g.
+// "ActionSearch" into "Action" and "Search".
+func splitCategory(name string) (category, rest string, err error) {
+	for _, c := range categories {
+		rest, ok := strings.CutPrefix(name, c)
+		// The category must be followed by the start of a new word so that, for
+		// example, "AV" doesn't match "Action".
+		if ok && rest != "" && (unicode.IsUpper(rune(rest[0])) || unicode.IsDigit(rune(rest[0]))) {
+			return c, rest, nil
+		}
+	}
+	return "", "", fmt.Errorf("no known category for icon %q", name)
+}
+
+// readNameSet reads a file listing one icon name per line, ignoring blank lines and
+// '#' comments. Every name must be one of the given icon names.
+func readNameSet(path string, names []string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	set := make(map[string]bool)
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		name := strings.TrimSpace(sc.Text())
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+		if !known[name] {
+			return nil, fmt.Errorf("%s:%d: unknown icon %q", path, line, name)
+		}
+		set[name] = true
+	}
+	return set, sc.Err()
+}
+
+// readKeywords returns the search keywords of the icons: the tags of each icon in
+// Google's icon metadata at tagsPath, if that file exists, followed by the additions in
+// the file at path. Each line of that file is an icon name, a colon and a comma separated
+// list of keywords, ignoring blank lines and '#' comments. Every name must be one of the
+// given icon names. An icon's keywords are returned in order, without duplicates.
+func readKeywords(path, tagsPath string, names []string) (map[string][]string, error) {
+	keywords, err := readMaterialTags(tagsPath, names)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", tagsPath, err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		name, list, ok := strings.Cut(text, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: missing colon", path, line)
+		}
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, fmt.Errorf("%s:%d: unknown icon %q", path, line, name)
+		}
+		for _, kw := range strings.Split(list, ",") {
+			kw = strings.ToLower(strings.TrimSpace(kw))
+			if kw != "" && !slices.Contains(keywords[name], kw) {
+				keywords[name] = append(keywords[name], kw)
+			}
+		}
+	}
+	return keywords, sc.Err()
+}
+
 func readAndSortNames() ([]string, error) {
 	names := make([]string, 0, 1000)
 	cfg := packages.Config{
@@ -30,7 +160,240 @@ func readAndSortNames() ([]string, error) {
 	return names, nil
 }
 
-const basePkgSrcHeader = `// generated by go run cmd/gen/main.go. DO NOT EDIT
+// readIconData returns the IconVG data of every upstream icon, keyed by name. The values
+// are read from the source's byte slice literals, so that this command doesn't need to
+// import the package it is generating from.
+func readIconData() (map[string][]byte, error) {
+	cfg := packages.Config{
+		Mode: packages.NeedFiles | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(&cfg, "golang.org/x/exp/shiny/materialdesign/icons")
+	if err != nil {
+		return nil, fmt.Errorf("loading icons package: %w", err)
+	}
+	data := make(map[string][]byte, 1000)
+	for _, f := range pkgs[0].Syntax {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if len(vs.Names) != 1 || len(vs.Values) != 1 {
+					continue
+				}
+				lit, ok := vs.Values[0].(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				b := make([]byte, 0, len(lit.Elts))
+				for _, elt := range lit.Elts {
+					bl, ok := elt.(*ast.BasicLit)
+					if !ok || bl.Kind != token.INT {
+						return nil, fmt.Errorf("%s: unexpected element %T", vs.Names[0].Name, elt)
+					}
+					n, err := strconv.ParseUint(bl.Value, 0, 8)
+					if err != nil {
+						return nil, fmt.Errorf("%s: %v", vs.Names[0].Name, err)
+					}
+					b = append(b, byte(n))
+				}
+				data[vs.Names[0].Name] = b
+			}
+		}
+	}
+	return data, nil
+}
+
+// Words at the end of a name that only describe its style, which aren't read out, and how
+// they are said when an icon must be told apart from another one.
+var styleWords = map[string]string{"Outline": "outline", "Outlined": "outline", "Border": "outline", "Filled": "filled"}
+
+// Word sequences that camelcase splits apart, or that are clearer written out.
+var descriptionReplacer = strings.NewReplacer(
+	"Wi Fi", "Wi-Fi",
+	"I Phone", "iPhone",
+	"L To R", "left to right",
+	"R To L", "right to left",
+	"B And W", "black and white",
+	"CCW", "counterclockwise",
+	"S Video", "S-Video",
+	"Crop 169", "Crop 16:9",
+	"Crop 32", "Crop 3:2",
+	"Crop 54", "Crop 5:4",
+	"Crop 75", "Crop 7:5",
+)
+
+// Words that keep their capital letters in descriptions.
+var properNouns = map[string]bool{
+	"iPhone":     true,
+	"Wi-Fi":      true,
+	"S-Video":    true,
+	"Android":    true,
+	"Bluetooth":  true,
+	"Chromebook": true,
+	"Mac":        true,
+	"Windows":    true,
+}
+
+// describe returns the default accessible description of an icon, which is its name
+// without its category or style in sentence case, e.g. "Find in page" for
+// ActionFindInPage and "Favorite" for ActionFavoriteBorder, and the style that was left
+// out, such as "outline", if any.
+func describe(category, rest string) (desc, style string) {
+	words := camelcase.Split(rest)
+	// Some upstream names repeat their category word, as in ContentContentCopy. An
+	// acronym category, as in AVAVTimer, is part of the name itself.
+	if len(words) > 1 && words[0] == category && strings.ToUpper(category) != category {
+		words = words[1:]
+	}
+	// A number followed by a single capital is one word, such as the "3D" in
+	// Action3DRotation.
+	for i := 0; i+1 < len(words); i++ {
+		if isDigits(words[i]) && len(words[i+1]) == 1 && unicode.IsUpper(rune(words[i+1][0])) {
+			words = slices.Replace(words, i, i+2, words[i]+words[i+1])
+		}
+	}
+	for len(words) > 1 && styleWords[words[len(words)-1]] != "" {
+		style = styleWords[words[len(words)-1]]
+		words = words[:len(words)-1]
+	}
+	words = strings.Fields(descriptionReplacer.Replace(strings.Join(words, " ")))
+	for i, w := range words {
+		// Acronyms and words with digits, such as "HDMI" and "3D", keep their case.
+		if i == 0 || len(w) > 1 && strings.ToUpper(w) == w || strings.ContainsAny(w, "0123456789") {
+			continue
+		}
+		if !properNouns[w] {
+			words[i] = strings.ToLower(w)
+		}
+	}
+	return strings.Join(words, " "), style
+}
+
+// describeAll returns the description of every icon, made from its name and keywords.
+// Different icons whose names describe them the same, such as ToggleStar and
+// ToggleStarBorder, are told apart by the first of each one's keywords that the others
+// don't have, or else by the style left out of its name: "Star, filled" and "Star,
+// outline".
+func describeAll(names []string, keywords map[string][]string, duplicates map[string][]string) (map[string]string, error) {
+	descs := make(map[string]string, len(names))
+	styles := make(map[string]string)
+	byDesc := make(map[string][]string)
+	for _, name := range names {
+		category, rest, err := splitCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		desc, style := describe(category, rest)
+		descs[name], styles[name] = desc, style
+		byDesc[desc] = append(byDesc[desc], name)
+	}
+	// Identical icons are the same icon, which needn't be told apart from itself.
+	canonical := func(name string) string {
+		if group := duplicates[name]; group != nil {
+			return group[0]
+		}
+		return name
+	}
+	for _, name := range names {
+		desc := descs[name]
+		var others []string
+		for _, other := range byDesc[desc] {
+			if canonical(other) != canonical(name) {
+				others = append(others, other)
+			}
+		}
+		if len(others) == 0 {
+			continue
+		}
+		qualifier := styles[name]
+		words := strings.Fields(strings.ToLower(desc))
+	keywords:
+		for _, kw := range keywords[name] {
+			if slices.Contains(words, kw) {
+				continue
+			}
+			for _, other := range others {
+				if slices.Contains(keywords[other], kw) {
+					continue keywords
+				}
+			}
+			qualifier = kw
+			break
+		}
+		if qualifier != "" {
+			descs[name] = desc + ", " + qualifier
+		}
+	}
+	return descs, nil
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// findDuplicates groups the names of icons with identical data, returning each group
+// keyed by every name in it. Groups are in name order, and the first name in a group is
+// its canonical one.
+func findDuplicates(names []string, data map[string][]byte) map[string][]string {
+	byHash := make(map[[sha256.Size]byte][]string)
+	for _, name := range names {
+		h := sha256.Sum256(data[name])
+		byHash[h] = append(byHash[h], name)
+	}
+	groups := make(map[string][]string)
+	for _, group := range byHash {
+		if len(group) < 2 {
+			continue
+		}
+		for _, name := range group {
+			groups[name] = group
+		}
+	}
+	return groups
+}
+
+// checkMirroring checks that identical icons are either all mirrored or none are, since
+// they share an *Icon.
+func checkMirroring(duplicates map[string][]string, mirrored map[string]bool) error {
+	for name, group := range duplicates {
+		if mirrored[name] != mirrored[group[0]] {
+			return fmt.Errorf("%s and %s are identical, so they must both be mirrored or neither", group[0], name)
+		}
+	}
+	return nil
+}
+
+// others returns the names in group other than name.
+func others(group []string, name string) []string {
+	var out []string
+	for _, n := range group {
+		if n != name {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// iconLiteral returns the composite literal declaring an *Icon with the given data
+// pointer. A literal, unlike a constructor call, is initialized statically, so the linker
+// can leave out the data of icons that aren't used. Directional icons are marked to be
+// mirrored in right-to-left layouts, and icons that the Material Icons font has are given
+// their ligature.
+func iconLiteral(src string, mirror bool, lig string) string {
+	fields := "src: " + src
+	if mirror {
+		fields += ", mirror: true"
+	}
+	if lig != "" {
+		fields += fmt.Sprintf(", lig: %q", lig)
+	}
+	return "&Icon{" + fields + "}"
+}
+
+const basePkgSrcHeader = `// generated by go run ./cmd/gen. DO NOT EDIT
 
 package icons
 
@@ -39,7 +402,7 @@ import "golang.org/x/exp/shiny/materialdesign/icons"
 var (
 `
 
-func genBasePkgData(names []string) error {
+func genBasePkgData(names []string, mirrored map[string]bool, ligatures map[string]string, keywords map[string][]string, duplicates map[string][]string) error {
 	out, err := os.OpenFile("./data.go", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
 	if err != nil {
 		return fmt.Errorf("opening out file: %v", err)
@@ -57,59 +420,422 @@ func genBasePkgData(names []string) error {
 		return fmt.Errorf("writing source header: %v", err)
 	}
 	for _, name := range names {
-		fmt.Fprintf(out, "\t%-*s = mi(icons.%s)\n", nameWidth, name, name)
+		// Identical icons share the canonical one's *Icon, so that they are only decoded
+		// once.
+		if group := duplicates[name]; group != nil && group[0] != name {
+			fmt.Fprintf(out, "\t%-*s = %s\n", nameWidth, name, group[0])
+			continue
+		}
+		lig := ligatures[name]
+		// The canonical icon of a group may be missing from the font while another
+		// name for it is there.
+		for _, other := range duplicates[name] {
+			if lig == "" {
+				lig = ligatures[other]
+			}
+		}
+		fmt.Fprintf(out, "\t%-*s = %s\n", nameWidth, name, iconLiteral("&icons."+name, mirrored[name], lig))
 	}
 	if _, err = out.WriteString(")\n"); err != nil {
 		return fmt.Errorf("writing last parenthesis: %v", err)
 	}
 
+	descs, err := describeAll(names, keywords, duplicates)
+	if err != nil {
+		return err
+	}
+	// The registry entries are in the same sorted order as the names so that Lookup
+	// can binary search them.
+	if _, err = fmt.Fprintf(out, "\nvar entries = [%d]Entry{\n", len(names)); err != nil {
+		return fmt.Errorf("writing entries header: %v", err)
+	}
+	for _, name := range names {
+		category, rest, err := splitCategory(name)
+		if err != nil {
+			return err
+		}
+		nameWithSpaces := strings.Join(camelcase.Split(name), " ")
+		shortName := strings.Join(camelcase.Split(rest), " ")
+		kws, aliases := "nil", "nil"
+		if len(keywords[name]) > 0 {
+			kws = fmt.Sprintf("%#v", keywords[name])
+		}
+		if group := duplicates[name]; group != nil {
+			aliases = fmt.Sprintf("%#v", others(group, name))
+		}
+		fmt.Fprintf(out, "\t{%q, %q, Category%s, %q, %q, %s, %s, %s},\n", name, nameWithSpaces, category, shortName, descs[name], name, kws, aliases)
+	}
+	if _, err = out.WriteString("}\n"); err != nil {
+		return fmt.Errorf("writing last curly bracket: %v", err)
+	}
+
 	return nil
 }
 
-const browserSrcHeader = `// generated by 'go run cmd/gen/main.go'; DO NOT EDIT
-
-package main
+const categorySrcHeader = `// generated by go run ./cmd/gen. DO NOT EDIT
 
-import "gio.tools/icons"
+package icons
 
-const numEntries = %d
+// Category is the Material Design category that an icon belongs to.
+type Category uint8
 
-var allEntries = [%d]iconEntry{
+// All of the icon categories.
+const (
 `
 
-func genBrowserData(names []string) error {
-	out, err := os.OpenFile("./cmd/gio-icon-browser/data.go", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+func genCategoryData() error {
+	out, err := os.OpenFile("./category.go", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
 	if err != nil {
 		return fmt.Errorf("opening out file: %v", err)
 	}
 	defer out.Close()
 
-	count := len(names)
-	if _, err = fmt.Fprintf(out, browserSrcHeader, count, count); err != nil {
+	if _, err = fmt.Fprint(out, categorySrcHeader); err != nil {
 		return fmt.Errorf("writing source header: %v", err)
 	}
+	// The zero Category is that of icons from other sets, so that an Entry that doesn't
+	// set one isn't in the first Material category.
+	fmt.Fprint(out, "\t// CategoryNone is the category of icons that aren't in a Material Design category,\n\t// such as those of other icon sets.\n\tCategoryNone Category = iota\n")
+	for _, c := range categories {
+		fmt.Fprintf(out, "\tCategory%s\n", c)
+	}
+	fmt.Fprint(out, ")\n\n// Categories lists every Material Design category in order.\nvar Categories = [...]Category{\n")
+	for _, c := range categories {
+		fmt.Fprintf(out, "\tCategory%s,\n", c)
+	}
+	fmt.Fprint(out, "}\n\nvar categoryNames = [...]string{\n\t\"None\",\n")
+	for _, c := range categories {
+		fmt.Fprintf(out, "\t%q,\n", c)
+	}
+	fmt.Fprint(out, "}\n\n// String returns the category's name, which is also the prefix of each of its icons'\n// variable names, or \"None\" for CategoryNone.\n")
+	if _, err = fmt.Fprint(out, "func (c Category) String() string {\n\tif int(c) < len(categoryNames) {\n\t\treturn categoryNames[c]\n\t}\n\treturn \"Unknown\"\n}\n"); err != nil {
+		return fmt.Errorf("writing String method: %v", err)
+	}
+
+	return nil
+}
+
+// subPkgName returns the name of a category's subpackage, which is the category in lower
+// case, except where that would clash with a standard library package that the same files
+// are likely to import.
+func subPkgName(category string) string {
+	pkg := strings.ToLower(category)
+	switch pkg {
+	case "image", "maps":
+		pkg += "icons"
+	}
+	return pkg
+}
+
+const subPkgSrcHeader = `// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package %s contains the icons in the %s category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package %s
+
+import "gio.tools/icons"
+
+var (
+`
+
+func genSubPkgData(names []string, changes []nameChange) error {
+	byCategory := make(map[string][][2]string, len(categories))
 	for _, name := range names {
-		nameWithSpaces := strings.Join(camelcase.Split(name), " ")
-		fmt.Fprintf(out, "\t{%q, %q, %q, icons.%s},\n", nameWithSpaces, name, strings.ToLower(name), name)
+		category, rest, err := splitCategory(name)
+		if err != nil {
+			return err
+		}
+		// A name such as "Action3DRotation" can't lose its prefix and still be a valid
+		// identifier, so it is kept as is.
+		if unicode.IsDigit(rune(rest[0])) {
+			rest = name
+		}
+		byCategory[category] = append(byCategory[category], [2]string{rest, name})
 	}
-	if _, err = out.WriteString("}\n"); err != nil {
-		return fmt.Errorf("writing last curly bracket: %v", err)
+	deprecated := make(map[string][]nameChange)
+	for _, c := range changes {
+		category, rest, err := splitCategory(c.old)
+		if err != nil {
+			return err
+		}
+		if unicode.IsDigit(rune(rest[0])) {
+			rest = c.old
+		}
+		deprecated[category] = append(deprecated[category], nameChange{old: rest, new: c.new})
 	}
 
+	for _, category := range categories {
+		pkg := subPkgName(category)
+		if err := os.MkdirAll(pkg, 0o755); err != nil {
+			return fmt.Errorf("making %s directory: %v", pkg, err)
+		}
+		out, err := os.OpenFile(filepath.Join(pkg, "data.go"), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return fmt.Errorf("opening out file: %v", err)
+		}
+
+		nameWidth := 0
+		for _, pair := range byCategory[category] {
+			if n := len(pair[0]); n > nameWidth {
+				nameWidth = n
+			}
+		}
+
+		fmt.Fprintf(out, subPkgSrcHeader, pkg, category, pkg)
+		for _, pair := range byCategory[category] {
+			fmt.Fprintf(out, "\t%-*s = icons.%s\n", nameWidth, pair[0], pair[1])
+		}
+		for _, c := range deprecated[category] {
+			if c.new == "" {
+				fmt.Fprintf(out, "\n\t// Deprecated: %s was removed upstream, and this is a placeholder.\n", c.old)
+				fmt.Fprintf(out, "\t%s = icons.%s\n", c.old, placeholderIcon)
+			} else {
+				fmt.Fprintf(out, "\n\t// Deprecated: %s was renamed to icons.%s.\n", c.old, c.new)
+				fmt.Fprintf(out, "\t%s = icons.%s\n", c.old, c.new)
+			}
+		}
+		_, err = out.WriteString(")\n")
+		out.Close()
+		if err != nil {
+			return fmt.Errorf("writing last parenthesis: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func genSVGs(names []string, data map[string][]byte, dir string, size int) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("making svg directory: %v", err)
+	}
+	for _, name := range names {
+		if data[name] == nil {
+			return fmt.Errorf("no data found for %s", name)
+		}
+		d, err := ivgpath.Decode(data[name], &iconvg.DefaultPalette)
+		if err != nil {
+			return fmt.Errorf("decoding %s: %v", name, err)
+		}
+		out, err := os.Create(filepath.Join(dir, name+".svg"))
+		if err != nil {
+			return fmt.Errorf("creating svg file: %v", err)
+		}
+		err = ivgpath.WriteSVG(out, d, size)
+		out.Close()
+		if err != nil {
+			return fmt.Errorf("writing %s svg: %v", name, err)
+		}
+	}
 	return nil
 }
 
+const variantsSrcHeader = `// generated by go run ./cmd/gen. DO NOT EDIT
+
+package icons
+
+import "sort"
+
+// Variant is a Material Symbols style of the icons, with the same names as this package's
+// variables. Icons that the style has no source for are the filled icon instead, as
+// reported by Fallback.
+type Variant struct {
+`
+
+const variantsSrcFooter = `
+// Fallback reports whether the style has no source for the named icon, so that its field
+// is the filled icon. The names of deprecated icons are resolved as by Deprecated.
+func (v *Variant) Fallback(name string) bool {
+	if current, ok := Deprecated(name); ok {
+		name = current
+	}
+	i := sort.SearchStrings(v.fallbacks, name)
+	return i < len(v.fallbacks) && v.fallbacks[i] == name
+}
+`
+
+// genVariants writes a Variant for each style of Material Symbols that has sources, or
+// removes the file if none do. Each Variant also has a deprecated field for every renamed
+// or removed icon, like the package's variables.
+func genVariants(names []string, mirrored map[string]bool, symbols map[string]map[string][]byte, changes []nameChange) error {
+	const path = "./variants.go"
+	if len(symbols) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	// The icons that deprecated fields resolve to are declared as variables of their own,
+	// so that the current and deprecated fields can share them.
+	resolve := func(c nameChange) string {
+		if c.new == "" {
+			return placeholderIcon
+		}
+		return c.new
+	}
+	shared := make(map[string]bool)
+	for _, c := range changes {
+		shared[resolve(c)] = true
+	}
+
+	// The alignment of the fields around the multi-line data is left to gofmt.
+	out := new(bytes.Buffer)
+	fmt.Fprint(out, variantsSrcHeader)
+	for _, name := range names {
+		fmt.Fprintf(out, "\t%s *Icon\n", name)
+	}
+	for _, c := range changes {
+		if c.new == "" {
+			fmt.Fprintf(out, "\n\t// Deprecated: %s was removed upstream, and this is a placeholder.\n", c.old)
+		} else {
+			fmt.Fprintf(out, "\n\t// Deprecated: %s was renamed to %s.\n", c.old, c.new)
+		}
+		fmt.Fprintf(out, "\t%s *Icon\n", c.old)
+	}
+	fmt.Fprint(out, "\n\tfallbacks []string // The sorted names of the icons that have no source.\n}\n")
+
+	var variants strings.Builder
+	for _, style := range symbolStyles {
+		icons, ok := symbols[style[0]]
+		if !ok {
+			continue
+		}
+		// value returns the expression for the style's icon of the given name.
+		value := func(name string) string {
+			data, ok := icons[name]
+			switch {
+			case !ok:
+				return name
+			case shared[name]:
+				return style[0] + name
+			}
+			return variantLiteral(data, mirrored[name])
+		}
+		for _, name := range names {
+			if _, ok := icons[name]; ok && shared[name] {
+				fmt.Fprintf(out, "\nvar %s%s = %s\n", style[0], name, variantLiteral(icons[name], mirrored[name]))
+			}
+		}
+
+		fmt.Fprintf(&variants, "\t%s = Variant{\n", style[1])
+		var fallbacks []string
+		for _, name := range names {
+			if _, ok := icons[name]; !ok {
+				fallbacks = append(fallbacks, name)
+			}
+			fmt.Fprintf(&variants, "\t\t%s: %s,\n", name, value(name))
+		}
+		for _, c := range changes {
+			fmt.Fprintf(&variants, "\t\t%s: %s,\n", c.old, value(resolve(c)))
+		}
+		// The names are sorted, like the entries, so Fallback can binary search them.
+		fmt.Fprint(&variants, "\t\tfallbacks: []string{\n")
+		for _, name := range fallbacks {
+			fmt.Fprintf(&variants, "\t\t\t%q,\n", name)
+		}
+		fmt.Fprint(&variants, "\t\t},\n\t}\n")
+	}
+	fmt.Fprintf(out, "\n// The Material Symbols styles that sources were vendored for.\nvar (\n%s)\n", variants.String())
+	out.WriteString(variantsSrcFooter)
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting variants: %v", err)
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// variantLiteral returns the Icon literal of a style's icon.
+func variantLiteral(data []byte, mirror bool) string {
+	var lit strings.Builder
+	lit.WriteString("&[]byte{")
+	for i, b := range data {
+		if i%16 == 0 {
+			lit.WriteString("\n\t\t\t")
+		} else {
+			lit.WriteString(" ")
+		}
+		fmt.Fprintf(&lit, "0x%02x,", b)
+	}
+	lit.WriteString("\n\t\t}")
+	// The font's glyphs are of the filled icons, so a style has no ligatures.
+	return iconLiteral(lit.String(), mirror, "")
+}
+
 func main() {
+	flag.Parse()
+
 	names, err := readAndSortNames()
 	if err != nil {
 		log.Fatalf("error: reading and sorting icon names: %v", err)
 	}
 
-	if err = genBasePkgData(names); err != nil {
+	if *atlasList != "" {
+		if err = genAtlas(names, *atlasList, *atlasSizes, *atlasOut, *atlasPkg); err != nil {
+			log.Fatalf("error: generating atlas: %v", err)
+		}
+		return
+	}
+
+	mirrored, err := readNameSet("./cmd/gen/mirrored.txt", names)
+	if err != nil {
+		log.Fatalf("error: reading mirrored icon names: %v", err)
+	}
+
+	changes, err := readHistory("./cmd/gen/history.txt", names)
+	if err != nil {
+		log.Fatalf("error: reading icon name history: %v", err)
+	}
+	if err = updateSnapshot("./cmd/gen/names.txt", names, changes); err != nil {
+		log.Fatalf("error: checking icon names: %v", err)
+	}
+
+	keywords, err := readKeywords("./cmd/gen/keywords.txt", "./third_party/material-icons/metadata.json", names)
+	if err != nil {
+		log.Fatalf("error: reading icon keywords: %v", err)
+	}
+
+	data, err := readIconData()
+	if err != nil {
+		log.Fatalf("error: reading icon data: %v", err)
+	}
+	duplicates := findDuplicates(names, data)
+	if err = checkMirroring(duplicates, mirrored); err != nil {
+		log.Fatalf("error: checking mirrored icon names: %v", err)
+	}
+
+	ligatures, err := readLigatures("./iconfont/codepoints", names)
+	if err != nil {
+		log.Fatalf("error: reading icon font ligatures: %v", err)
+	}
+
+	if err = genBasePkgData(names, mirrored, ligatures, keywords, duplicates); err != nil {
 		log.Fatalf("error: generating base pkg data: %v", err)
 	}
 
-	if err = genBrowserData(names); err != nil {
-		log.Fatalf("error: generating browser data: %v", err)
+	if err = genCategoryData(); err != nil {
+		log.Fatalf("error: generating category data: %v", err)
+	}
+
+	if *genSubPkgs {
+		if err = genSubPkgData(names, changes); err != nil {
+			log.Fatalf("error: generating category subpackages: %v", err)
+		}
+	}
+
+	if err = genAliases(changes); err != nil {
+		log.Fatalf("error: generating deprecated aliases: %v", err)
+	}
+
+	symbols, err := readSymbols(names, *symbolsDir)
+	if err != nil {
+		log.Fatalf("error: reading material symbols: %v", err)
+	}
+	if err = genVariants(names, mirrored, symbols, changes); err != nil {
+		log.Fatalf("error: generating style variants: %v", err)
+	}
+
+	if *svgDir != "" {
+		if err = genSVGs(names, data, *svgDir, *svgSize); err != nil {
+			log.Fatalf("error: generating svg files: %v", err)
+		}
 	}
 }
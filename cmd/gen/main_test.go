@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestDescribeAll(t *testing.T) {
+	names := []string{
+		"ActionFindInPage",
+		"ActionStar",
+		"ActionStarBorder",
+		"ContentMail",
+		"ContentMailCopy",
+		"ToggleStar",
+		"ToggleStarBorder",
+	}
+	keywords := map[string][]string{
+		"ActionStar":       {"star", "filled", "rating"},
+		"ActionStarBorder": {"rating"},
+	}
+	// ContentMailCopy is the same icon as ContentMail, so they needn't be told apart.
+	duplicates := map[string][]string{
+		"ContentMail":     {"ContentMail", "ContentMailCopy"},
+		"ContentMailCopy": {"ContentMail", "ContentMailCopy"},
+	}
+	descs, err := describeAll(names, keywords, duplicates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"ActionFindInPage": "Find in page",
+		"ActionStar":       "Star, filled",  // A keyword the others don't have.
+		"ActionStarBorder": "Star, outline", // The style left out of the name.
+		"ContentMail":      "Mail",
+		"ToggleStar":       "Star",
+		"ToggleStarBorder": "Star, outline",
+	} {
+		if got := descs[name]; got != want {
+			t.Errorf("%s: description = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// symbolStyles are the Material Symbols styles, as the directory each one's SVG sources
+// are vendored in and the name of the generated Variant.
+var symbolStyles = [...][2]string{
+	{"outlined", "Outlined"},
+	{"rounded", "Rounded"},
+	{"sharp", "Sharp"},
+	{"twotone", "TwoTone"},
+}
+
+// readSymbols returns the IconVG encoding of every icon that has an SVG source in each
+// style's directory under dir, keyed by style and then icon name. It returns nil if dir
+// doesn't exist.
+func readSymbols(names []string, dir string) (map[string]map[string][]byte, error) {
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	out := make(map[string]map[string][]byte)
+	for _, style := range symbolStyles {
+		styleDir := filepath.Join(dir, style[0])
+		if _, err := os.Stat(styleDir); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		icons := make(map[string][]byte)
+		for _, name := range names {
+			sym, err := upstreamName(name)
+			if err != nil {
+				return nil, err
+			}
+			src, err := os.ReadFile(filepath.Join(styleDir, sym+".svg"))
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			ivg, err := svgToIconVG(src)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s.svg: %v", style[0], sym, err)
+			}
+			icons[name] = ivg
+		}
+		log.Printf("%s: found %d of %d icons", styleDir, len(icons), len(names))
+		out[style[0]] = icons
+	}
+	return out, nil
+}
+
+// svgPath is a <path> element of an SVG icon.
+type svgPath struct {
+	d       string
+	opacity float64
+}
+
+// svgToIconVG converts a single colour SVG icon, made of filled paths, to IconVG. Every
+// path is filled with the first palette colour, so that the icon can be drawn in any
+// colour like the upstream icons. Translucent paths, such as the lighter tone of a
+// two-tone icon, blend that colour with transparency.
+func svgToIconVG(src []byte) ([]byte, error) {
+	var (
+		viewBox [4]float64
+		paths   []svgPath
+	)
+	dec := xml.NewDecoder(bytes.NewReader(src))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attrs := make(map[string]string, len(se.Attr))
+		for _, a := range se.Attr {
+			attrs[a.Name.Local] = a.Value
+		}
+		switch se.Name.Local {
+		case "svg":
+			fields := strings.FieldsFunc(attrs["viewBox"], func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("invalid viewBox %q", attrs["viewBox"])
+			}
+			for i, f := range fields {
+				if viewBox[i], err = strconv.ParseFloat(f, 64); err != nil {
+					return nil, fmt.Errorf("invalid viewBox %q", attrs["viewBox"])
+				}
+			}
+		case "path":
+			if attrs["fill"] == "none" || attrs["d"] == "" {
+				// Material's sources include transparent bounding boxes.
+				continue
+			}
+			p := svgPath{d: attrs["d"], opacity: 1}
+			for _, key := range []string{"opacity", "fill-opacity"} {
+				if v, ok := attrs[key]; ok {
+					o, err := strconv.ParseFloat(v, 64)
+					if err != nil {
+						return nil, fmt.Errorf("invalid %s %q", key, v)
+					}
+					p.opacity *= o
+				}
+			}
+			paths = append(paths, p)
+		}
+	}
+	if viewBox[2] <= 0 || viewBox[3] <= 0 {
+		return nil, errors.New("missing viewBox")
+	}
+
+	// Map the view box onto IconVG's default one, which is the same as the upstream
+	// icons' and keeps coordinates small enough to encode compactly.
+	vb := iconvg.DefaultViewBox
+	t := svgTransform{
+		x0: viewBox[0], y0: viewBox[1],
+		sx: float64(vb.Max[0]-vb.Min[0]) / viewBox[2],
+		sy: float64(vb.Max[1]-vb.Min[1]) / viewBox[3],
+		x1: float64(vb.Min[0]), y1: float64(vb.Min[1]),
+	}
+	var enc iconvg.Encoder
+	enc.Reset(iconvg.Metadata{ViewBox: vb, Palette: iconvg.DefaultPalette})
+	for _, p := range paths {
+		c := iconvg.PaletteIndexColor(0)
+		if p.opacity < 1 {
+			// 0x7f is transparent and 0x80 is the first palette colour.
+			c = iconvg.BlendColor(uint8(p.opacity*0xff+.5), 0x7f, 0x80)
+		}
+		enc.SetCReg(0, false, c)
+		if err := encodeSVGPath(&enc, p.d, t); err != nil {
+			return nil, err
+		}
+	}
+	return enc.Bytes()
+}
+
+// svgTransform maps SVG coordinates to IconVG ones.
+type svgTransform struct {
+	x0, y0, sx, sy, x1, y1 float64
+}
+
+func (t svgTransform) x(x float64) float32 { return float32((x-t.x0)*t.sx + t.x1) }
+func (t svgTransform) y(y float64) float32 { return float32((y-t.y0)*t.sy + t.y1) }
+
+// encodeSVGPath encodes SVG path data as one IconVG path. Relative commands are made
+// absolute, and every sub-path is closed since they are filled.
+func encodeSVGPath(enc *iconvg.Encoder, d string, t svgTransform) error {
+	s := pathScanner{s: d}
+	var (
+		cmd            byte
+		x, y           float64 // The current point.
+		startX, startY float64 // The start of the current sub-path.
+		started        bool    // Whether the IconVG path has been started.
+		open           bool    // Whether the current sub-path is unclosed.
+	)
+	for {
+		s.skipSeparators()
+		if s.done() {
+			break
+		}
+		if c := s.s[s.i]; isPathCommand(c) {
+			cmd = c
+			s.i++
+		} else if cmd == 0 {
+			return fmt.Errorf("path data doesn't start with a command: %q", d)
+		}
+		rel := cmd >= 'a'
+		var ox, oy float64
+		if rel {
+			ox, oy = x, y
+		}
+
+		switch cmd | 0x20 {
+		case 'm':
+			mx, my := s.number()+ox, s.number()+oy
+			switch {
+			case !started:
+				enc.StartPath(0, t.x(mx), t.y(my))
+				started = true
+			default:
+				// An open sub-path is implicitly closed when filled, so closing it
+				// explicitly doesn't change the result.
+				enc.ClosePathAbsMoveTo(t.x(mx), t.y(my))
+			}
+			x, y = mx, my
+			startX, startY = mx, my
+			open = true
+			// Subsequent pairs are implicit line commands.
+			if rel {
+				cmd = 'l'
+			} else {
+				cmd = 'L'
+			}
+		case 'z':
+			if !open {
+				continue
+			}
+			// Material's paths follow a close with a move or end, so a close on its
+			// own is deferred until the next move or the end of the path.
+			x, y = startX, startY
+			open = false
+			if s.skipSeparators(); !s.done() && s.s[s.i]|0x20 != 'm' {
+				// Drawing carries on from the start of the closed sub-path.
+				enc.ClosePathAbsMoveTo(t.x(x), t.y(y))
+				open = true
+			}
+		case 'l':
+			x, y = s.number()+ox, s.number()+oy
+			enc.AbsLineTo(t.x(x), t.y(y))
+		case 'h':
+			x = s.number() + ox
+			enc.AbsHLineTo(t.x(x))
+		case 'v':
+			y = s.number() + oy
+			enc.AbsVLineTo(t.y(y))
+		case 'c':
+			x1, y1 := s.number()+ox, s.number()+oy
+			x2, y2 := s.number()+ox, s.number()+oy
+			x, y = s.number()+ox, s.number()+oy
+			enc.AbsCubeTo(t.x(x1), t.y(y1), t.x(x2), t.y(y2), t.x(x), t.y(y))
+		case 's':
+			x2, y2 := s.number()+ox, s.number()+oy
+			x, y = s.number()+ox, s.number()+oy
+			enc.AbsSmoothCubeTo(t.x(x2), t.y(y2), t.x(x), t.y(y))
+		case 'q':
+			x1, y1 := s.number()+ox, s.number()+oy
+			x, y = s.number()+ox, s.number()+oy
+			enc.AbsQuadTo(t.x(x1), t.y(y1), t.x(x), t.y(y))
+		case 't':
+			x, y = s.number()+ox, s.number()+oy
+			enc.AbsSmoothQuadTo(t.x(x), t.y(y))
+		case 'a':
+			rx, ry, rot := s.number(), s.number(), s.number()
+			large, sweep := s.flag(), s.flag()
+			x, y = s.number()+ox, s.number()+oy
+			// IconVG measures the rotation in turns rather than degrees.
+			enc.AbsArcTo(float32(rx*t.sx), float32(ry*t.sy), float32(rot/360), large, sweep, t.x(x), t.y(y))
+		default:
+			return fmt.Errorf("unsupported path command %q", cmd)
+		}
+		if s.err != nil {
+			return fmt.Errorf("%v in path data %q", s.err, d)
+		}
+	}
+	if started {
+		enc.ClosePathEndPath()
+	}
+	return nil
+}
+
+func isPathCommand(c byte) bool {
+	return strings.IndexByte("MmZzLlHhVvCcSsQqTtAa", c) >= 0
+}
+
+// pathScanner reads the numbers and flags of SVG path data.
+type pathScanner struct {
+	s   string
+	i   int
+	err error
+}
+
+func (s *pathScanner) done() bool { return s.i >= len(s.s) }
+
+func (s *pathScanner) skipSeparators() {
+	for !s.done() && (s.s[s.i] == ',' || unicode.IsSpace(rune(s.s[s.i]))) {
+		s.i++
+	}
+}
+
+// number reads a number, which may run straight into the next one, as in "1-2" or
+// "1.5.5".
+func (s *pathScanner) number() float64 {
+	s.skipSeparators()
+	start := s.i
+	if !s.done() && (s.s[s.i] == '-' || s.s[s.i] == '+') {
+		s.i++
+	}
+	dot, exp := false, false
+	for ; !s.done(); s.i++ {
+		c := s.s[s.i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c == '.' && !dot && !exp:
+			dot = true
+		case (c == 'e' || c == 'E') && !exp:
+			exp = true
+			if s.i+1 < len(s.s) && (s.s[s.i+1] == '-' || s.s[s.i+1] == '+') {
+				s.i++
+			}
+		default:
+			goto end
+		}
+	}
+end:
+	f, err := strconv.ParseFloat(s.s[start:s.i], 64)
+	if err != nil && s.err == nil {
+		s.err = fmt.Errorf("invalid number at offset %d", start)
+	}
+	return f
+}
+
+// flag reads an arc flag, which needn't be separated from what follows it.
+func (s *pathScanner) flag() bool {
+	s.skipSeparators()
+	if s.done() || (s.s[s.i] != '0' && s.s[s.i] != '1') {
+		if s.err == nil {
+			s.err = fmt.Errorf("invalid arc flag at offset %d", s.i)
+		}
+		return false
+	}
+	s.i++
+	return s.s[s.i-1] == '1'
+}
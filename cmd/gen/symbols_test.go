@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gio.tools/icons/internal/ivgpath"
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// The size that icons are rasterized at to compare them.
+const testSize = 48
+
+func rasterize(t *testing.T, data []byte) *image.Alpha {
+	t.Helper()
+	img := image.NewAlpha(image.Rect(0, 0, testSize, testSize))
+	var z iconvg.Rasterizer
+	z.SetDstImage(img, img.Bounds(), draw.Src)
+	if err := iconvg.Decode(&z, data, nil); err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+// coverageDiff returns the mean difference in coverage between two images, from 0 to 1.
+func coverageDiff(a, b *image.Alpha) float64 {
+	var sum int
+	for i := range a.Pix {
+		d := int(a.Pix[i]) - int(b.Pix[i])
+		if d < 0 {
+			d = -d
+		}
+		sum += d
+	}
+	return float64(sum) / float64(len(a.Pix)*0xff)
+}
+
+// TestSVGRoundTrip converts every upstream icon to SVG and back with svgToIconVG, and
+// checks that it is drawn the same.
+func TestSVGRoundTrip(t *testing.T) {
+	data, err := readIconData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, ivg := range data {
+		d, err := ivgpath.Decode(ivg, &iconvg.DefaultPalette)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		var svg bytes.Buffer
+		if err := ivgpath.WriteSVG(&svg, d, testSize); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got, err := svgToIconVG(svg.Bytes())
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if diff := coverageDiff(rasterize(t, ivg), rasterize(t, got)); diff > 0.002 {
+			t.Errorf("%s: coverage differs by %.2f%% after a round trip", name, diff*100)
+		}
+	}
+}
+
+// TestVendoredSymbols checks that every vendored Material Symbols source converts to an
+// icon that draws something.
+func TestVendoredSymbols(t *testing.T) {
+	paths, err := filepath.Glob("../../third_party/material-symbols/*/*.svg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Skip("no vendored sources")
+	}
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ivg, err := svgToIconVG(src)
+		if err != nil {
+			t.Errorf("%s: %v", path, err)
+			continue
+		}
+		img := rasterize(t, ivg)
+		if bytes.Count(img.Pix, []byte{0}) == len(img.Pix) {
+			t.Errorf("%s: draws nothing", path)
+		}
+	}
+}
+
+// TestVariantDeprecated checks that each Variant keeps a deprecated field for renamed and
+// removed icons, which shares the current icon of the style.
+func TestVariantDeprecated(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	names := []string{"ActionFindInPage", "ActionSearch", placeholderIcon}
+	symbols := map[string]map[string][]byte{
+		"outlined": {"ActionSearch": {0x89, 0x49, 0x56, 0x47}},
+	}
+	changes := []nameChange{
+		{old: "ActionFind", new: "ActionSearch"},
+		{old: "ActionGone", new: ""},
+	}
+	if err := genVariants(names, nil, symbols, changes); err != nil {
+		t.Fatal(err)
+	}
+	src, err := os.ReadFile("variants.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"// Deprecated: ActionFind was renamed to ActionSearch.\n\tActionFind *Icon",
+		"// Deprecated: ActionGone was removed upstream, and this is a placeholder.\n\tActionGone *Icon",
+		"var outlinedActionSearch = &Icon{",
+		"ActionSearch:     outlinedActionSearch,",
+		"ActionFind:       outlinedActionSearch,",
+		"ActionGone:       ImageBrokenImage,",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("variants.go doesn't contain %q:\n%s", want, src)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// upstreamAcronyms are the words of upstream names that shiny's generator doesn't simply
+// capitalize, keyed by their spelling in Go names, e.g. "WiFi" for "wifi" and "HDMI" for
+// "hdmi". This is the acronyms table of
+// golang.org/x/exp/shiny/materialdesign/icons/gen.go, inverted.
+var upstreamAcronyms = map[string]string{
+	"3D":            "3d",
+	"AC":            "ac",
+	"ADB":           "adb",
+	"AirplaneMode":  "airplanemode",
+	"ATM":           "atm",
+	"AV":            "av",
+	"CCW":           "ccw",
+	"CW":            "cw",
+	"DIN":           "din",
+	"DNS":           "dns",
+	"DVR":           "dvr",
+	"ETA":           "eta",
+	"EV":            "ev",
+	"GIF":           "gif",
+	"GPS":           "gps",
+	"HD":            "hd",
+	"HDMI":          "hdmi",
+	"HDR":           "hdr",
+	"HTTP":          "http",
+	"HTTPS":         "https",
+	"IPhone":        "iphone",
+	"ISO":           "iso",
+	"JPEG":          "jpeg",
+	"MarkUnread":    "markunread",
+	"MMS":           "mms",
+	"NFC":           "nfc",
+	"OnDemand":      "ondemand",
+	"PDF":           "pdf",
+	"PhoneLink":     "phonelink",
+	"PNG":           "png",
+	"RSS":           "rss",
+	"RV":            "rv",
+	"SD":            "sd",
+	"SIM":           "sim",
+	"SIP":           "sip",
+	"SMS":           "sms",
+	"StreetView":    "streetview",
+	"SVideo":        "svideo",
+	"TextDirection": "textdirection",
+	"TextSMS":       "textsms",
+	"TimeLapse":     "timelapse",
+	"TOC":           "toc",
+	"TV":            "tv",
+	"USB":           "usb",
+	"VPN":           "vpn",
+	"WB":            "wb",
+	"WC":            "wc",
+	"WhatsHot":      "whatshot",
+	"WiFi":          "wifi",
+}
+
+// upstreamNameOverrides are the upstream names that can't be recovered from the Go names,
+// because shiny joins the words of a name without separators.
+var upstreamNameOverrides = map[string]string{
+	"ImageCrop169": "crop_16_9",
+	"ImageCrop32":  "crop_3_2",
+	"ImageCrop54":  "crop_5_4",
+	"ImageCrop75":  "crop_7_5",
+}
+
+// upstreamAcronymsByLength are the keys of upstreamAcronyms, longest first, so that
+// "HDMI" is matched before "HD".
+var upstreamAcronymsByLength = func() []string {
+	keys := make([]string, 0, len(upstreamAcronyms))
+	for k := range upstreamAcronyms {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}()
+
+// A word of a Go name: a run of digits, or a letter followed by lower case letters.
+var upstreamWord = regexp.MustCompile(`^(?:[0-9]+|[A-Za-z][a-z]*)`)
+
+// upstreamName returns the snake case name of an icon in Material Design Icons, such as
+// "find_in_page" for ActionFindInPage and "3d_rotation" for Action3DRotation. It is the
+// name of the icon's SVG sources, its ligature in the Material Icons font and, mostly, its
+// name in Material Symbols.
+func upstreamName(name string) (string, error) {
+	if s, ok := upstreamNameOverrides[name]; ok {
+		return s, nil
+	}
+	_, rest, err := splitCategory(name)
+	if err != nil {
+		return "", err
+	}
+	var words []string
+	for i := 0; i < len(rest); {
+		word := ""
+		for _, acronym := range upstreamAcronymsByLength {
+			end := i + len(acronym)
+			// An acronym must be followed by the start of another word, so that "AV"
+			// doesn't match the start of "Avatar".
+			if strings.HasPrefix(rest[i:], acronym) && (end == len(rest) || !isLower(rest[end])) {
+				word = upstreamAcronyms[acronym]
+				i = end
+				break
+			}
+		}
+		if word == "" {
+			m := upstreamWord.FindString(rest[i:])
+			if m == "" {
+				m = rest[i : i+1]
+			}
+			word = strings.ToLower(m)
+			i += len(m)
+		}
+		words = append(words, word)
+	}
+	return strings.Join(words, "_"), nil
+}
+
+func isLower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+// readLigatures returns the ligature of every icon that the Material Icons font has,
+// keyed by icon name, given the font's codepoints file. Each line of the file is a
+// ligature and its hexadecimal codepoint, such as "search e8b6".
+func readLigatures(path string, names []string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	inFont := make(map[string]bool)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if fields := strings.Fields(sc.Text()); len(fields) > 0 {
+			inFont[fields[0]] = true
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	ligatures := make(map[string]string, len(names))
+	for _, name := range names {
+		lig, err := upstreamName(name)
+		if err != nil {
+			return nil, err
+		}
+		if inFont[lig] {
+			ligatures[name] = lig
+		}
+	}
+	return ligatures, nil
+}
+
+// readMaterialTags returns the tags of every icon in Google's icon metadata at path, as
+// served by https://fonts.google.com/metadata/icons, keyed by icon name. Tags that are
+// words of the icon's name are left out. It returns an empty map if the file doesn't
+// exist.
+func readMaterialTags(path string, names []string) (map[string][]string, error) {
+	tags := make(map[string][]string)
+	src, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return tags, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// The metadata starts with a line that guards it from being run as a script.
+	src = bytes.TrimPrefix(src, []byte(")]}'"))
+	var meta struct {
+		Icons []struct {
+			Name string   `json:"name"`
+			Tags []string `json:"tags"`
+		} `json:"icons"`
+	}
+	if err := json.Unmarshal(src, &meta); err != nil {
+		return nil, err
+	}
+	byUpstream := make(map[string][]string, len(meta.Icons))
+	for _, ic := range meta.Icons {
+		byUpstream[ic.Name] = ic.Tags
+	}
+	for _, name := range names {
+		up, err := upstreamName(name)
+		if err != nil {
+			return nil, err
+		}
+		words := strings.Split(up, "_")
+		for _, tag := range byUpstream[up] {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" || tag == strings.Join(words, " ") || slices.Contains(words, tag) || slices.Contains(tags[name], tag) {
+				continue
+			}
+			tags[name] = append(tags[name], tag)
+		}
+	}
+	return tags, nil
+}
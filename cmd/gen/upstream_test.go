@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestReadKeywords(t *testing.T) {
+	dir := t.TempDir()
+	tagsPath := filepath.Join(dir, "metadata.json")
+	meta := `)]}'
+{"icons": [
+	{"name": "delete", "tags": ["Trash", "delete", "bin", "remove"]},
+	{"name": "find_in_page", "tags": ["find", "in", "page", "search", "find in page"]}
+]}`
+	if err := os.WriteFile(tagsPath, []byte(meta), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "keywords.txt")
+	if err := os.WriteFile(path, []byte("# Additions.\nActionDelete: garbage, bin\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{"ActionDelete", "ActionFindInPage", "ActionHome"}
+
+	keywords, err := readKeywords(path, tagsPath, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Tags come first, without the words of the name, and then the additions.
+	for name, want := range map[string][]string{
+		"ActionDelete":     {"trash", "bin", "remove", "garbage"},
+		"ActionFindInPage": {"search"},
+		"ActionHome":       nil,
+	} {
+		if got := keywords[name]; !slices.Equal(got, want) {
+			t.Errorf("%s: keywords = %q, want %q", name, got, want)
+		}
+	}
+
+	// Without the metadata, only the additions are used.
+	keywords, err = readKeywords(path, filepath.Join(dir, "missing.json"), names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := keywords["ActionDelete"], []string{"garbage", "bin"}; !slices.Equal(got, want) {
+		t.Errorf("without tags: keywords = %q, want %q", got, want)
+	}
+}
@@ -1,971 +0,0 @@
-// generated by go run cmd/gen/main.go. DO NOT EDIT
-
-package main
-
-import "gio.tools/icons"
-
-const numEntries = 961
-
-var allEntries = [961]iconEntry{
-	{"AVAV Timer", "AVAVTimer", "avavtimer", icons.AVAVTimer},
-	{"AV Add To Queue", "AVAddToQueue", "avaddtoqueue", icons.AVAddToQueue},
-	{"AV Airplay", "AVAirplay", "avairplay", icons.AVAirplay},
-	{"AV Album", "AVAlbum", "avalbum", icons.AVAlbum},
-	{"AV Art Track", "AVArtTrack", "avarttrack", icons.AVArtTrack},
-	{"AV Branding Watermark", "AVBrandingWatermark", "avbrandingwatermark", icons.AVBrandingWatermark},
-	{"AV Call To Action", "AVCallToAction", "avcalltoaction", icons.AVCallToAction},
-	{"AV Closed Caption", "AVClosedCaption", "avclosedcaption", icons.AVClosedCaption},
-	{"AV Equalizer", "AVEqualizer", "avequalizer", icons.AVEqualizer},
-	{"AV Explicit", "AVExplicit", "avexplicit", icons.AVExplicit},
-	{"AV Fast Forward", "AVFastForward", "avfastforward", icons.AVFastForward},
-	{"AV Fast Rewind", "AVFastRewind", "avfastrewind", icons.AVFastRewind},
-	{"AV Featured Play List", "AVFeaturedPlayList", "avfeaturedplaylist", icons.AVFeaturedPlayList},
-	{"AV Featured Video", "AVFeaturedVideo", "avfeaturedvideo", icons.AVFeaturedVideo},
-	{"AV Fiber DVR", "AVFiberDVR", "avfiberdvr", icons.AVFiberDVR},
-	{"AV Fiber Manual Record", "AVFiberManualRecord", "avfibermanualrecord", icons.AVFiberManualRecord},
-	{"AV Fiber New", "AVFiberNew", "avfibernew", icons.AVFiberNew},
-	{"AV Fiber Pin", "AVFiberPin", "avfiberpin", icons.AVFiberPin},
-	{"AV Fiber Smart Record", "AVFiberSmartRecord", "avfibersmartrecord", icons.AVFiberSmartRecord},
-	{"AV Forward 10", "AVForward10", "avforward10", icons.AVForward10},
-	{"AV Forward 30", "AVForward30", "avforward30", icons.AVForward30},
-	{"AV Forward 5", "AVForward5", "avforward5", icons.AVForward5},
-	{"AV Games", "AVGames", "avgames", icons.AVGames},
-	{"AVHD", "AVHD", "avhd", icons.AVHD},
-	{"AV Hearing", "AVHearing", "avhearing", icons.AVHearing},
-	{"AV High Quality", "AVHighQuality", "avhighquality", icons.AVHighQuality},
-	{"AV Library Add", "AVLibraryAdd", "avlibraryadd", icons.AVLibraryAdd},
-	{"AV Library Books", "AVLibraryBooks", "avlibrarybooks", icons.AVLibraryBooks},
-	{"AV Library Music", "AVLibraryMusic", "avlibrarymusic", icons.AVLibraryMusic},
-	{"AV Loop", "AVLoop", "avloop", icons.AVLoop},
-	{"AV Mic", "AVMic", "avmic", icons.AVMic},
-	{"AV Mic None", "AVMicNone", "avmicnone", icons.AVMicNone},
-	{"AV Mic Off", "AVMicOff", "avmicoff", icons.AVMicOff},
-	{"AV Movie", "AVMovie", "avmovie", icons.AVMovie},
-	{"AV Music Video", "AVMusicVideo", "avmusicvideo", icons.AVMusicVideo},
-	{"AV New Releases", "AVNewReleases", "avnewreleases", icons.AVNewReleases},
-	{"AV Not Interested", "AVNotInterested", "avnotinterested", icons.AVNotInterested},
-	{"AV Note", "AVNote", "avnote", icons.AVNote},
-	{"AV Pause", "AVPause", "avpause", icons.AVPause},
-	{"AV Pause Circle Filled", "AVPauseCircleFilled", "avpausecirclefilled", icons.AVPauseCircleFilled},
-	{"AV Pause Circle Outline", "AVPauseCircleOutline", "avpausecircleoutline", icons.AVPauseCircleOutline},
-	{"AV Play Arrow", "AVPlayArrow", "avplayarrow", icons.AVPlayArrow},
-	{"AV Play Circle Filled", "AVPlayCircleFilled", "avplaycirclefilled", icons.AVPlayCircleFilled},
-	{"AV Play Circle Outline", "AVPlayCircleOutline", "avplaycircleoutline", icons.AVPlayCircleOutline},
-	{"AV Playlist Add", "AVPlaylistAdd", "avplaylistadd", icons.AVPlaylistAdd},
-	{"AV Playlist Add Check", "AVPlaylistAddCheck", "avplaylistaddcheck", icons.AVPlaylistAddCheck},
-	{"AV Playlist Play", "AVPlaylistPlay", "avplaylistplay", icons.AVPlaylistPlay},
-	{"AV Queue", "AVQueue", "avqueue", icons.AVQueue},
-	{"AV Queue Music", "AVQueueMusic", "avqueuemusic", icons.AVQueueMusic},
-	{"AV Queue Play Next", "AVQueuePlayNext", "avqueueplaynext", icons.AVQueuePlayNext},
-	{"AV Radio", "AVRadio", "avradio", icons.AVRadio},
-	{"AV Recent Actors", "AVRecentActors", "avrecentactors", icons.AVRecentActors},
-	{"AV Remove From Queue", "AVRemoveFromQueue", "avremovefromqueue", icons.AVRemoveFromQueue},
-	{"AV Repeat", "AVRepeat", "avrepeat", icons.AVRepeat},
-	{"AV Repeat One", "AVRepeatOne", "avrepeatone", icons.AVRepeatOne},
-	{"AV Replay", "AVReplay", "avreplay", icons.AVReplay},
-	{"AV Replay 10", "AVReplay10", "avreplay10", icons.AVReplay10},
-	{"AV Replay 30", "AVReplay30", "avreplay30", icons.AVReplay30},
-	{"AV Replay 5", "AVReplay5", "avreplay5", icons.AVReplay5},
-	{"AV Shuffle", "AVShuffle", "avshuffle", icons.AVShuffle},
-	{"AV Skip Next", "AVSkipNext", "avskipnext", icons.AVSkipNext},
-	{"AV Skip Previous", "AVSkipPrevious", "avskipprevious", icons.AVSkipPrevious},
-	{"AV Slow Motion Video", "AVSlowMotionVideo", "avslowmotionvideo", icons.AVSlowMotionVideo},
-	{"AV Snooze", "AVSnooze", "avsnooze", icons.AVSnooze},
-	{"AV Sort By Alpha", "AVSortByAlpha", "avsortbyalpha", icons.AVSortByAlpha},
-	{"AV Stop", "AVStop", "avstop", icons.AVStop},
-	{"AV Subscriptions", "AVSubscriptions", "avsubscriptions", icons.AVSubscriptions},
-	{"AV Subtitles", "AVSubtitles", "avsubtitles", icons.AVSubtitles},
-	{"AV Surround Sound", "AVSurroundSound", "avsurroundsound", icons.AVSurroundSound},
-	{"AV Video Call", "AVVideoCall", "avvideocall", icons.AVVideoCall},
-	{"AV Video Label", "AVVideoLabel", "avvideolabel", icons.AVVideoLabel},
-	{"AV Video Library", "AVVideoLibrary", "avvideolibrary", icons.AVVideoLibrary},
-	{"AV Videocam", "AVVideocam", "avvideocam", icons.AVVideocam},
-	{"AV Videocam Off", "AVVideocamOff", "avvideocamoff", icons.AVVideocamOff},
-	{"AV Volume Down", "AVVolumeDown", "avvolumedown", icons.AVVolumeDown},
-	{"AV Volume Mute", "AVVolumeMute", "avvolumemute", icons.AVVolumeMute},
-	{"AV Volume Off", "AVVolumeOff", "avvolumeoff", icons.AVVolumeOff},
-	{"AV Volume Up", "AVVolumeUp", "avvolumeup", icons.AVVolumeUp},
-	{"AV Web", "AVWeb", "avweb", icons.AVWeb},
-	{"AV Web Asset", "AVWebAsset", "avwebasset", icons.AVWebAsset},
-	{"Action 3 D Rotation", "Action3DRotation", "action3drotation", icons.Action3DRotation},
-	{"Action Accessibility", "ActionAccessibility", "actionaccessibility", icons.ActionAccessibility},
-	{"Action Accessible", "ActionAccessible", "actionaccessible", icons.ActionAccessible},
-	{"Action Account Balance", "ActionAccountBalance", "actionaccountbalance", icons.ActionAccountBalance},
-	{"Action Account Balance Wallet", "ActionAccountBalanceWallet", "actionaccountbalancewallet", icons.ActionAccountBalanceWallet},
-	{"Action Account Box", "ActionAccountBox", "actionaccountbox", icons.ActionAccountBox},
-	{"Action Account Circle", "ActionAccountCircle", "actionaccountcircle", icons.ActionAccountCircle},
-	{"Action Add Shopping Cart", "ActionAddShoppingCart", "actionaddshoppingcart", icons.ActionAddShoppingCart},
-	{"Action Alarm", "ActionAlarm", "actionalarm", icons.ActionAlarm},
-	{"Action Alarm Add", "ActionAlarmAdd", "actionalarmadd", icons.ActionAlarmAdd},
-	{"Action Alarm Off", "ActionAlarmOff", "actionalarmoff", icons.ActionAlarmOff},
-	{"Action Alarm On", "ActionAlarmOn", "actionalarmon", icons.ActionAlarmOn},
-	{"Action All Out", "ActionAllOut", "actionallout", icons.ActionAllOut},
-	{"Action Android", "ActionAndroid", "actionandroid", icons.ActionAndroid},
-	{"Action Announcement", "ActionAnnouncement", "actionannouncement", icons.ActionAnnouncement},
-	{"Action Aspect Ratio", "ActionAspectRatio", "actionaspectratio", icons.ActionAspectRatio},
-	{"Action Assessment", "ActionAssessment", "actionassessment", icons.ActionAssessment},
-	{"Action Assignment", "ActionAssignment", "actionassignment", icons.ActionAssignment},
-	{"Action Assignment Ind", "ActionAssignmentInd", "actionassignmentind", icons.ActionAssignmentInd},
-	{"Action Assignment Late", "ActionAssignmentLate", "actionassignmentlate", icons.ActionAssignmentLate},
-	{"Action Assignment Return", "ActionAssignmentReturn", "actionassignmentreturn", icons.ActionAssignmentReturn},
-	{"Action Assignment Returned", "ActionAssignmentReturned", "actionassignmentreturned", icons.ActionAssignmentReturned},
-	{"Action Assignment Turned In", "ActionAssignmentTurnedIn", "actionassignmentturnedin", icons.ActionAssignmentTurnedIn},
-	{"Action Autorenew", "ActionAutorenew", "actionautorenew", icons.ActionAutorenew},
-	{"Action Backup", "ActionBackup", "actionbackup", icons.ActionBackup},
-	{"Action Book", "ActionBook", "actionbook", icons.ActionBook},
-	{"Action Bookmark", "ActionBookmark", "actionbookmark", icons.ActionBookmark},
-	{"Action Bookmark Border", "ActionBookmarkBorder", "actionbookmarkborder", icons.ActionBookmarkBorder},
-	{"Action Bug Report", "ActionBugReport", "actionbugreport", icons.ActionBugReport},
-	{"Action Build", "ActionBuild", "actionbuild", icons.ActionBuild},
-	{"Action Cached", "ActionCached", "actioncached", icons.ActionCached},
-	{"Action Camera Enhance", "ActionCameraEnhance", "actioncameraenhance", icons.ActionCameraEnhance},
-	{"Action Card Giftcard", "ActionCardGiftcard", "actioncardgiftcard", icons.ActionCardGiftcard},
-	{"Action Card Membership", "ActionCardMembership", "actioncardmembership", icons.ActionCardMembership},
-	{"Action Card Travel", "ActionCardTravel", "actioncardtravel", icons.ActionCardTravel},
-	{"Action Change History", "ActionChangeHistory", "actionchangehistory", icons.ActionChangeHistory},
-	{"Action Check Circle", "ActionCheckCircle", "actioncheckcircle", icons.ActionCheckCircle},
-	{"Action Chrome Reader Mode", "ActionChromeReaderMode", "actionchromereadermode", icons.ActionChromeReaderMode},
-	{"Action Class", "ActionClass", "actionclass", icons.ActionClass},
-	{"Action Code", "ActionCode", "actioncode", icons.ActionCode},
-	{"Action Compare Arrows", "ActionCompareArrows", "actioncomparearrows", icons.ActionCompareArrows},
-	{"Action Copyright", "ActionCopyright", "actioncopyright", icons.ActionCopyright},
-	{"Action Credit Card", "ActionCreditCard", "actioncreditcard", icons.ActionCreditCard},
-	{"Action DNS", "ActionDNS", "actiondns", icons.ActionDNS},
-	{"Action Dashboard", "ActionDashboard", "actiondashboard", icons.ActionDashboard},
-	{"Action Date Range", "ActionDateRange", "actiondaterange", icons.ActionDateRange},
-	{"Action Delete", "ActionDelete", "actiondelete", icons.ActionDelete},
-	{"Action Delete Forever", "ActionDeleteForever", "actiondeleteforever", icons.ActionDeleteForever},
-	{"Action Description", "ActionDescription", "actiondescription", icons.ActionDescription},
-	{"Action Done", "ActionDone", "actiondone", icons.ActionDone},
-	{"Action Done All", "ActionDoneAll", "actiondoneall", icons.ActionDoneAll},
-	{"Action Donut Large", "ActionDonutLarge", "actiondonutlarge", icons.ActionDonutLarge},
-	{"Action Donut Small", "ActionDonutSmall", "actiondonutsmall", icons.ActionDonutSmall},
-	{"Action Eject", "ActionEject", "actioneject", icons.ActionEject},
-	{"Action Euro Symbol", "ActionEuroSymbol", "actioneurosymbol", icons.ActionEuroSymbol},
-	{"Action Event", "ActionEvent", "actionevent", icons.ActionEvent},
-	{"Action Event Seat", "ActionEventSeat", "actioneventseat", icons.ActionEventSeat},
-	{"Action Exit To App", "ActionExitToApp", "actionexittoapp", icons.ActionExitToApp},
-	{"Action Explore", "ActionExplore", "actionexplore", icons.ActionExplore},
-	{"Action Extension", "ActionExtension", "actionextension", icons.ActionExtension},
-	{"Action Face", "ActionFace", "actionface", icons.ActionFace},
-	{"Action Favorite", "ActionFavorite", "actionfavorite", icons.ActionFavorite},
-	{"Action Favorite Border", "ActionFavoriteBorder", "actionfavoriteborder", icons.ActionFavoriteBorder},
-	{"Action Feedback", "ActionFeedback", "actionfeedback", icons.ActionFeedback},
-	{"Action Find In Page", "ActionFindInPage", "actionfindinpage", icons.ActionFindInPage},
-	{"Action Find Replace", "ActionFindReplace", "actionfindreplace", icons.ActionFindReplace},
-	{"Action Fingerprint", "ActionFingerprint", "actionfingerprint", icons.ActionFingerprint},
-	{"Action Flight Land", "ActionFlightLand", "actionflightland", icons.ActionFlightLand},
-	{"Action Flight Takeoff", "ActionFlightTakeoff", "actionflighttakeoff", icons.ActionFlightTakeoff},
-	{"Action Flip To Back", "ActionFlipToBack", "actionfliptoback", icons.ActionFlipToBack},
-	{"Action Flip To Front", "ActionFlipToFront", "actionfliptofront", icons.ActionFlipToFront},
-	{"Action GIF", "ActionGIF", "actiongif", icons.ActionGIF},
-	{"Action G Translate", "ActionGTranslate", "actiongtranslate", icons.ActionGTranslate},
-	{"Action Gavel", "ActionGavel", "actiongavel", icons.ActionGavel},
-	{"Action Get App", "ActionGetApp", "actiongetapp", icons.ActionGetApp},
-	{"Action Grade", "ActionGrade", "actiongrade", icons.ActionGrade},
-	{"Action Group Work", "ActionGroupWork", "actiongroupwork", icons.ActionGroupWork},
-	{"Action HTTP", "ActionHTTP", "actionhttp", icons.ActionHTTP},
-	{"Action HTTPS", "ActionHTTPS", "actionhttps", icons.ActionHTTPS},
-	{"Action Help", "ActionHelp", "actionhelp", icons.ActionHelp},
-	{"Action Help Outline", "ActionHelpOutline", "actionhelpoutline", icons.ActionHelpOutline},
-	{"Action Highlight Off", "ActionHighlightOff", "actionhighlightoff", icons.ActionHighlightOff},
-	{"Action History", "ActionHistory", "actionhistory", icons.ActionHistory},
-	{"Action Home", "ActionHome", "actionhome", icons.ActionHome},
-	{"Action Hourglass Empty", "ActionHourglassEmpty", "actionhourglassempty", icons.ActionHourglassEmpty},
-	{"Action Hourglass Full", "ActionHourglassFull", "actionhourglassfull", icons.ActionHourglassFull},
-	{"Action Important Devices", "ActionImportantDevices", "actionimportantdevices", icons.ActionImportantDevices},
-	{"Action Info", "ActionInfo", "actioninfo", icons.ActionInfo},
-	{"Action Info Outline", "ActionInfoOutline", "actioninfooutline", icons.ActionInfoOutline},
-	{"Action Input", "ActionInput", "actioninput", icons.ActionInput},
-	{"Action Invert Colors", "ActionInvertColors", "actioninvertcolors", icons.ActionInvertColors},
-	{"Action Label", "ActionLabel", "actionlabel", icons.ActionLabel},
-	{"Action Label Outline", "ActionLabelOutline", "actionlabeloutline", icons.ActionLabelOutline},
-	{"Action Language", "ActionLanguage", "actionlanguage", icons.ActionLanguage},
-	{"Action Launch", "ActionLaunch", "actionlaunch", icons.ActionLaunch},
-	{"Action Lightbulb Outline", "ActionLightbulbOutline", "actionlightbulboutline", icons.ActionLightbulbOutline},
-	{"Action Line Style", "ActionLineStyle", "actionlinestyle", icons.ActionLineStyle},
-	{"Action Line Weight", "ActionLineWeight", "actionlineweight", icons.ActionLineWeight},
-	{"Action List", "ActionList", "actionlist", icons.ActionList},
-	{"Action Lock", "ActionLock", "actionlock", icons.ActionLock},
-	{"Action Lock Open", "ActionLockOpen", "actionlockopen", icons.ActionLockOpen},
-	{"Action Lock Outline", "ActionLockOutline", "actionlockoutline", icons.ActionLockOutline},
-	{"Action Loyalty", "ActionLoyalty", "actionloyalty", icons.ActionLoyalty},
-	{"Action Mark Unread Mailbox", "ActionMarkUnreadMailbox", "actionmarkunreadmailbox", icons.ActionMarkUnreadMailbox},
-	{"Action Motorcycle", "ActionMotorcycle", "actionmotorcycle", icons.ActionMotorcycle},
-	{"Action Note Add", "ActionNoteAdd", "actionnoteadd", icons.ActionNoteAdd},
-	{"Action Offline Pin", "ActionOfflinePin", "actionofflinepin", icons.ActionOfflinePin},
-	{"Action Opacity", "ActionOpacity", "actionopacity", icons.ActionOpacity},
-	{"Action Open In Browser", "ActionOpenInBrowser", "actionopeninbrowser", icons.ActionOpenInBrowser},
-	{"Action Open In New", "ActionOpenInNew", "actionopeninnew", icons.ActionOpenInNew},
-	{"Action Open With", "ActionOpenWith", "actionopenwith", icons.ActionOpenWith},
-	{"Action Pageview", "ActionPageview", "actionpageview", icons.ActionPageview},
-	{"Action Pan Tool", "ActionPanTool", "actionpantool", icons.ActionPanTool},
-	{"Action Payment", "ActionPayment", "actionpayment", icons.ActionPayment},
-	{"Action Perm Camera Mic", "ActionPermCameraMic", "actionpermcameramic", icons.ActionPermCameraMic},
-	{"Action Perm Contact Calendar", "ActionPermContactCalendar", "actionpermcontactcalendar", icons.ActionPermContactCalendar},
-	{"Action Perm Data Setting", "ActionPermDataSetting", "actionpermdatasetting", icons.ActionPermDataSetting},
-	{"Action Perm Device Information", "ActionPermDeviceInformation", "actionpermdeviceinformation", icons.ActionPermDeviceInformation},
-	{"Action Perm Identity", "ActionPermIdentity", "actionpermidentity", icons.ActionPermIdentity},
-	{"Action Perm Media", "ActionPermMedia", "actionpermmedia", icons.ActionPermMedia},
-	{"Action Perm Phone Msg", "ActionPermPhoneMsg", "actionpermphonemsg", icons.ActionPermPhoneMsg},
-	{"Action Perm Scan Wi Fi", "ActionPermScanWiFi", "actionpermscanwifi", icons.ActionPermScanWiFi},
-	{"Action Pets", "ActionPets", "actionpets", icons.ActionPets},
-	{"Action Picture In Picture", "ActionPictureInPicture", "actionpictureinpicture", icons.ActionPictureInPicture},
-	{"Action Picture In Picture Alt", "ActionPictureInPictureAlt", "actionpictureinpicturealt", icons.ActionPictureInPictureAlt},
-	{"Action Play For Work", "ActionPlayForWork", "actionplayforwork", icons.ActionPlayForWork},
-	{"Action Polymer", "ActionPolymer", "actionpolymer", icons.ActionPolymer},
-	{"Action Power Settings New", "ActionPowerSettingsNew", "actionpowersettingsnew", icons.ActionPowerSettingsNew},
-	{"Action Pregnant Woman", "ActionPregnantWoman", "actionpregnantwoman", icons.ActionPregnantWoman},
-	{"Action Print", "ActionPrint", "actionprint", icons.ActionPrint},
-	{"Action Query Builder", "ActionQueryBuilder", "actionquerybuilder", icons.ActionQueryBuilder},
-	{"Action Question Answer", "ActionQuestionAnswer", "actionquestionanswer", icons.ActionQuestionAnswer},
-	{"Action Receipt", "ActionReceipt", "actionreceipt", icons.ActionReceipt},
-	{"Action Record Voice Over", "ActionRecordVoiceOver", "actionrecordvoiceover", icons.ActionRecordVoiceOver},
-	{"Action Redeem", "ActionRedeem", "actionredeem", icons.ActionRedeem},
-	{"Action Remove Shopping Cart", "ActionRemoveShoppingCart", "actionremoveshoppingcart", icons.ActionRemoveShoppingCart},
-	{"Action Reorder", "ActionReorder", "actionreorder", icons.ActionReorder},
-	{"Action Report Problem", "ActionReportProblem", "actionreportproblem", icons.ActionReportProblem},
-	{"Action Restore", "ActionRestore", "actionrestore", icons.ActionRestore},
-	{"Action Restore Page", "ActionRestorePage", "actionrestorepage", icons.ActionRestorePage},
-	{"Action Room", "ActionRoom", "actionroom", icons.ActionRoom},
-	{"Action Rounded Corner", "ActionRoundedCorner", "actionroundedcorner", icons.ActionRoundedCorner},
-	{"Action Rowing", "ActionRowing", "actionrowing", icons.ActionRowing},
-	{"Action Schedule", "ActionSchedule", "actionschedule", icons.ActionSchedule},
-	{"Action Search", "ActionSearch", "actionsearch", icons.ActionSearch},
-	{"Action Settings", "ActionSettings", "actionsettings", icons.ActionSettings},
-	{"Action Settings Applications", "ActionSettingsApplications", "actionsettingsapplications", icons.ActionSettingsApplications},
-	{"Action Settings Backup Restore", "ActionSettingsBackupRestore", "actionsettingsbackuprestore", icons.ActionSettingsBackupRestore},
-	{"Action Settings Bluetooth", "ActionSettingsBluetooth", "actionsettingsbluetooth", icons.ActionSettingsBluetooth},
-	{"Action Settings Brightness", "ActionSettingsBrightness", "actionsettingsbrightness", icons.ActionSettingsBrightness},
-	{"Action Settings Cell", "ActionSettingsCell", "actionsettingscell", icons.ActionSettingsCell},
-	{"Action Settings Ethernet", "ActionSettingsEthernet", "actionsettingsethernet", icons.ActionSettingsEthernet},
-	{"Action Settings Input Antenna", "ActionSettingsInputAntenna", "actionsettingsinputantenna", icons.ActionSettingsInputAntenna},
-	{"Action Settings Input Component", "ActionSettingsInputComponent", "actionsettingsinputcomponent", icons.ActionSettingsInputComponent},
-	{"Action Settings Input Composite", "ActionSettingsInputComposite", "actionsettingsinputcomposite", icons.ActionSettingsInputComposite},
-	{"Action Settings Input HDMI", "ActionSettingsInputHDMI", "actionsettingsinputhdmi", icons.ActionSettingsInputHDMI},
-	{"Action Settings Input S Video", "ActionSettingsInputSVideo", "actionsettingsinputsvideo", icons.ActionSettingsInputSVideo},
-	{"Action Settings Overscan", "ActionSettingsOverscan", "actionsettingsoverscan", icons.ActionSettingsOverscan},
-	{"Action Settings Phone", "ActionSettingsPhone", "actionsettingsphone", icons.ActionSettingsPhone},
-	{"Action Settings Power", "ActionSettingsPower", "actionsettingspower", icons.ActionSettingsPower},
-	{"Action Settings Remote", "ActionSettingsRemote", "actionsettingsremote", icons.ActionSettingsRemote},
-	{"Action Settings Voice", "ActionSettingsVoice", "actionsettingsvoice", icons.ActionSettingsVoice},
-	{"Action Shop", "ActionShop", "actionshop", icons.ActionShop},
-	{"Action Shop Two", "ActionShopTwo", "actionshoptwo", icons.ActionShopTwo},
-	{"Action Shopping Basket", "ActionShoppingBasket", "actionshoppingbasket", icons.ActionShoppingBasket},
-	{"Action Shopping Cart", "ActionShoppingCart", "actionshoppingcart", icons.ActionShoppingCart},
-	{"Action Speaker Notes", "ActionSpeakerNotes", "actionspeakernotes", icons.ActionSpeakerNotes},
-	{"Action Speaker Notes Off", "ActionSpeakerNotesOff", "actionspeakernotesoff", icons.ActionSpeakerNotesOff},
-	{"Action Spellcheck", "ActionSpellcheck", "actionspellcheck", icons.ActionSpellcheck},
-	{"Action Star Rate", "ActionStarRate", "actionstarrate", icons.ActionStarRate},
-	{"Action Stars", "ActionStars", "actionstars", icons.ActionStars},
-	{"Action Store", "ActionStore", "actionstore", icons.ActionStore},
-	{"Action Subject", "ActionSubject", "actionsubject", icons.ActionSubject},
-	{"Action Supervisor Account", "ActionSupervisorAccount", "actionsupervisoraccount", icons.ActionSupervisorAccount},
-	{"Action Swap Horiz", "ActionSwapHoriz", "actionswaphoriz", icons.ActionSwapHoriz},
-	{"Action Swap Vert", "ActionSwapVert", "actionswapvert", icons.ActionSwapVert},
-	{"Action Swap Vertical Circle", "ActionSwapVerticalCircle", "actionswapverticalcircle", icons.ActionSwapVerticalCircle},
-	{"Action System Update Alt", "ActionSystemUpdateAlt", "actionsystemupdatealt", icons.ActionSystemUpdateAlt},
-	{"Action TOC", "ActionTOC", "actiontoc", icons.ActionTOC},
-	{"Action Tab", "ActionTab", "actiontab", icons.ActionTab},
-	{"Action Tab Unselected", "ActionTabUnselected", "actiontabunselected", icons.ActionTabUnselected},
-	{"Action Theaters", "ActionTheaters", "actiontheaters", icons.ActionTheaters},
-	{"Action Thumb Down", "ActionThumbDown", "actionthumbdown", icons.ActionThumbDown},
-	{"Action Thumb Up", "ActionThumbUp", "actionthumbup", icons.ActionThumbUp},
-	{"Action Thumbs Up Down", "ActionThumbsUpDown", "actionthumbsupdown", icons.ActionThumbsUpDown},
-	{"Action Timeline", "ActionTimeline", "actiontimeline", icons.ActionTimeline},
-	{"Action Today", "ActionToday", "actiontoday", icons.ActionToday},
-	{"Action Toll", "ActionToll", "actiontoll", icons.ActionToll},
-	{"Action Touch App", "ActionTouchApp", "actiontouchapp", icons.ActionTouchApp},
-	{"Action Track Changes", "ActionTrackChanges", "actiontrackchanges", icons.ActionTrackChanges},
-	{"Action Translate", "ActionTranslate", "actiontranslate", icons.ActionTranslate},
-	{"Action Trending Down", "ActionTrendingDown", "actiontrendingdown", icons.ActionTrendingDown},
-	{"Action Trending Flat", "ActionTrendingFlat", "actiontrendingflat", icons.ActionTrendingFlat},
-	{"Action Trending Up", "ActionTrendingUp", "actiontrendingup", icons.ActionTrendingUp},
-	{"Action Turned In", "ActionTurnedIn", "actionturnedin", icons.ActionTurnedIn},
-	{"Action Turned In Not", "ActionTurnedInNot", "actionturnedinnot", icons.ActionTurnedInNot},
-	{"Action Update", "ActionUpdate", "actionupdate", icons.ActionUpdate},
-	{"Action Verified User", "ActionVerifiedUser", "actionverifieduser", icons.ActionVerifiedUser},
-	{"Action View Agenda", "ActionViewAgenda", "actionviewagenda", icons.ActionViewAgenda},
-	{"Action View Array", "ActionViewArray", "actionviewarray", icons.ActionViewArray},
-	{"Action View Carousel", "ActionViewCarousel", "actionviewcarousel", icons.ActionViewCarousel},
-	{"Action View Column", "ActionViewColumn", "actionviewcolumn", icons.ActionViewColumn},
-	{"Action View Day", "ActionViewDay", "actionviewday", icons.ActionViewDay},
-	{"Action View Headline", "ActionViewHeadline", "actionviewheadline", icons.ActionViewHeadline},
-	{"Action View List", "ActionViewList", "actionviewlist", icons.ActionViewList},
-	{"Action View Module", "ActionViewModule", "actionviewmodule", icons.ActionViewModule},
-	{"Action View Quilt", "ActionViewQuilt", "actionviewquilt", icons.ActionViewQuilt},
-	{"Action View Stream", "ActionViewStream", "actionviewstream", icons.ActionViewStream},
-	{"Action View Week", "ActionViewWeek", "actionviewweek", icons.ActionViewWeek},
-	{"Action Visibility", "ActionVisibility", "actionvisibility", icons.ActionVisibility},
-	{"Action Visibility Off", "ActionVisibilityOff", "actionvisibilityoff", icons.ActionVisibilityOff},
-	{"Action Watch Later", "ActionWatchLater", "actionwatchlater", icons.ActionWatchLater},
-	{"Action Work", "ActionWork", "actionwork", icons.ActionWork},
-	{"Action Youtube Searched For", "ActionYoutubeSearchedFor", "actionyoutubesearchedfor", icons.ActionYoutubeSearchedFor},
-	{"Action Zoom In", "ActionZoomIn", "actionzoomin", icons.ActionZoomIn},
-	{"Action Zoom Out", "ActionZoomOut", "actionzoomout", icons.ActionZoomOut},
-	{"Alert Add Alert", "AlertAddAlert", "alertaddalert", icons.AlertAddAlert},
-	{"Alert Error", "AlertError", "alerterror", icons.AlertError},
-	{"Alert Error Outline", "AlertErrorOutline", "alerterroroutline", icons.AlertErrorOutline},
-	{"Alert Warning", "AlertWarning", "alertwarning", icons.AlertWarning},
-	{"Communication Business", "CommunicationBusiness", "communicationbusiness", icons.CommunicationBusiness},
-	{"Communication Call", "CommunicationCall", "communicationcall", icons.CommunicationCall},
-	{"Communication Call End", "CommunicationCallEnd", "communicationcallend", icons.CommunicationCallEnd},
-	{"Communication Call Made", "CommunicationCallMade", "communicationcallmade", icons.CommunicationCallMade},
-	{"Communication Call Merge", "CommunicationCallMerge", "communicationcallmerge", icons.CommunicationCallMerge},
-	{"Communication Call Missed", "CommunicationCallMissed", "communicationcallmissed", icons.CommunicationCallMissed},
-	{"Communication Call Missed Outgoing", "CommunicationCallMissedOutgoing", "communicationcallmissedoutgoing", icons.CommunicationCallMissedOutgoing},
-	{"Communication Call Received", "CommunicationCallReceived", "communicationcallreceived", icons.CommunicationCallReceived},
-	{"Communication Call Split", "CommunicationCallSplit", "communicationcallsplit", icons.CommunicationCallSplit},
-	{"Communication Chat", "CommunicationChat", "communicationchat", icons.CommunicationChat},
-	{"Communication Chat Bubble", "CommunicationChatBubble", "communicationchatbubble", icons.CommunicationChatBubble},
-	{"Communication Chat Bubble Outline", "CommunicationChatBubbleOutline", "communicationchatbubbleoutline", icons.CommunicationChatBubbleOutline},
-	{"Communication Clear All", "CommunicationClearAll", "communicationclearall", icons.CommunicationClearAll},
-	{"Communication Comment", "CommunicationComment", "communicationcomment", icons.CommunicationComment},
-	{"Communication Contact Mail", "CommunicationContactMail", "communicationcontactmail", icons.CommunicationContactMail},
-	{"Communication Contact Phone", "CommunicationContactPhone", "communicationcontactphone", icons.CommunicationContactPhone},
-	{"Communication Contacts", "CommunicationContacts", "communicationcontacts", icons.CommunicationContacts},
-	{"Communication Dialer SIP", "CommunicationDialerSIP", "communicationdialersip", icons.CommunicationDialerSIP},
-	{"Communication Dialpad", "CommunicationDialpad", "communicationdialpad", icons.CommunicationDialpad},
-	{"Communication Email", "CommunicationEmail", "communicationemail", icons.CommunicationEmail},
-	{"Communication Forum", "CommunicationForum", "communicationforum", icons.CommunicationForum},
-	{"Communication Import Contacts", "CommunicationImportContacts", "communicationimportcontacts", icons.CommunicationImportContacts},
-	{"Communication Import Export", "CommunicationImportExport", "communicationimportexport", icons.CommunicationImportExport},
-	{"Communication Invert Colors Off", "CommunicationInvertColorsOff", "communicationinvertcolorsoff", icons.CommunicationInvertColorsOff},
-	{"Communication Live Help", "CommunicationLiveHelp", "communicationlivehelp", icons.CommunicationLiveHelp},
-	{"Communication Location Off", "CommunicationLocationOff", "communicationlocationoff", icons.CommunicationLocationOff},
-	{"Communication Location On", "CommunicationLocationOn", "communicationlocationon", icons.CommunicationLocationOn},
-	{"Communication Mail Outline", "CommunicationMailOutline", "communicationmailoutline", icons.CommunicationMailOutline},
-	{"Communication Message", "CommunicationMessage", "communicationmessage", icons.CommunicationMessage},
-	{"Communication No SIM", "CommunicationNoSIM", "communicationnosim", icons.CommunicationNoSIM},
-	{"Communication Phone", "CommunicationPhone", "communicationphone", icons.CommunicationPhone},
-	{"Communication Phone Link Erase", "CommunicationPhoneLinkErase", "communicationphonelinkerase", icons.CommunicationPhoneLinkErase},
-	{"Communication Phone Link Lock", "CommunicationPhoneLinkLock", "communicationphonelinklock", icons.CommunicationPhoneLinkLock},
-	{"Communication Phone Link Ring", "CommunicationPhoneLinkRing", "communicationphonelinkring", icons.CommunicationPhoneLinkRing},
-	{"Communication Phone Link Setup", "CommunicationPhoneLinkSetup", "communicationphonelinksetup", icons.CommunicationPhoneLinkSetup},
-	{"Communication Portable Wi Fi Off", "CommunicationPortableWiFiOff", "communicationportablewifioff", icons.CommunicationPortableWiFiOff},
-	{"Communication Present To All", "CommunicationPresentToAll", "communicationpresenttoall", icons.CommunicationPresentToAll},
-	{"Communication RSS Feed", "CommunicationRSSFeed", "communicationrssfeed", icons.CommunicationRSSFeed},
-	{"Communication Ring Volume", "CommunicationRingVolume", "communicationringvolume", icons.CommunicationRingVolume},
-	{"Communication Screen Share", "CommunicationScreenShare", "communicationscreenshare", icons.CommunicationScreenShare},
-	{"Communication Speaker Phone", "CommunicationSpeakerPhone", "communicationspeakerphone", icons.CommunicationSpeakerPhone},
-	{"Communication Stay Current Landscape", "CommunicationStayCurrentLandscape", "communicationstaycurrentlandscape", icons.CommunicationStayCurrentLandscape},
-	{"Communication Stay Current Portrait", "CommunicationStayCurrentPortrait", "communicationstaycurrentportrait", icons.CommunicationStayCurrentPortrait},
-	{"Communication Stay Primary Landscape", "CommunicationStayPrimaryLandscape", "communicationstayprimarylandscape", icons.CommunicationStayPrimaryLandscape},
-	{"Communication Stay Primary Portrait", "CommunicationStayPrimaryPortrait", "communicationstayprimaryportrait", icons.CommunicationStayPrimaryPortrait},
-	{"Communication Stop Screen Share", "CommunicationStopScreenShare", "communicationstopscreenshare", icons.CommunicationStopScreenShare},
-	{"Communication Swap Calls", "CommunicationSwapCalls", "communicationswapcalls", icons.CommunicationSwapCalls},
-	{"Communication Text SMS", "CommunicationTextSMS", "communicationtextsms", icons.CommunicationTextSMS},
-	{"Communication VPN Key", "CommunicationVPNKey", "communicationvpnkey", icons.CommunicationVPNKey},
-	{"Communication Voicemail", "CommunicationVoicemail", "communicationvoicemail", icons.CommunicationVoicemail},
-	{"Content Add", "ContentAdd", "contentadd", icons.ContentAdd},
-	{"Content Add Box", "ContentAddBox", "contentaddbox", icons.ContentAddBox},
-	{"Content Add Circle", "ContentAddCircle", "contentaddcircle", icons.ContentAddCircle},
-	{"Content Add Circle Outline", "ContentAddCircleOutline", "contentaddcircleoutline", icons.ContentAddCircleOutline},
-	{"Content Archive", "ContentArchive", "contentarchive", icons.ContentArchive},
-	{"Content Backspace", "ContentBackspace", "contentbackspace", icons.ContentBackspace},
-	{"Content Block", "ContentBlock", "contentblock", icons.ContentBlock},
-	{"Content Clear", "ContentClear", "contentclear", icons.ContentClear},
-	{"Content Content Copy", "ContentContentCopy", "contentcontentcopy", icons.ContentContentCopy},
-	{"Content Content Cut", "ContentContentCut", "contentcontentcut", icons.ContentContentCut},
-	{"Content Content Paste", "ContentContentPaste", "contentcontentpaste", icons.ContentContentPaste},
-	{"Content Create", "ContentCreate", "contentcreate", icons.ContentCreate},
-	{"Content Delete Sweep", "ContentDeleteSweep", "contentdeletesweep", icons.ContentDeleteSweep},
-	{"Content Drafts", "ContentDrafts", "contentdrafts", icons.ContentDrafts},
-	{"Content Filter List", "ContentFilterList", "contentfilterlist", icons.ContentFilterList},
-	{"Content Flag", "ContentFlag", "contentflag", icons.ContentFlag},
-	{"Content Font Download", "ContentFontDownload", "contentfontdownload", icons.ContentFontDownload},
-	{"Content Forward", "ContentForward", "contentforward", icons.ContentForward},
-	{"Content Gesture", "ContentGesture", "contentgesture", icons.ContentGesture},
-	{"Content Inbox", "ContentInbox", "contentinbox", icons.ContentInbox},
-	{"Content Link", "ContentLink", "contentlink", icons.ContentLink},
-	{"Content Low Priority", "ContentLowPriority", "contentlowpriority", icons.ContentLowPriority},
-	{"Content Mail", "ContentMail", "contentmail", icons.ContentMail},
-	{"Content Mark Unread", "ContentMarkUnread", "contentmarkunread", icons.ContentMarkUnread},
-	{"Content Move To Inbox", "ContentMoveToInbox", "contentmovetoinbox", icons.ContentMoveToInbox},
-	{"Content Next Week", "ContentNextWeek", "contentnextweek", icons.ContentNextWeek},
-	{"Content Redo", "ContentRedo", "contentredo", icons.ContentRedo},
-	{"Content Remove", "ContentRemove", "contentremove", icons.ContentRemove},
-	{"Content Remove Circle", "ContentRemoveCircle", "contentremovecircle", icons.ContentRemoveCircle},
-	{"Content Remove Circle Outline", "ContentRemoveCircleOutline", "contentremovecircleoutline", icons.ContentRemoveCircleOutline},
-	{"Content Reply", "ContentReply", "contentreply", icons.ContentReply},
-	{"Content Reply All", "ContentReplyAll", "contentreplyall", icons.ContentReplyAll},
-	{"Content Report", "ContentReport", "contentreport", icons.ContentReport},
-	{"Content Save", "ContentSave", "contentsave", icons.ContentSave},
-	{"Content Select All", "ContentSelectAll", "contentselectall", icons.ContentSelectAll},
-	{"Content Send", "ContentSend", "contentsend", icons.ContentSend},
-	{"Content Sort", "ContentSort", "contentsort", icons.ContentSort},
-	{"Content Text Format", "ContentTextFormat", "contenttextformat", icons.ContentTextFormat},
-	{"Content Unarchive", "ContentUnarchive", "contentunarchive", icons.ContentUnarchive},
-	{"Content Undo", "ContentUndo", "contentundo", icons.ContentUndo},
-	{"Content Weekend", "ContentWeekend", "contentweekend", icons.ContentWeekend},
-	{"Device Access Alarm", "DeviceAccessAlarm", "deviceaccessalarm", icons.DeviceAccessAlarm},
-	{"Device Access Alarms", "DeviceAccessAlarms", "deviceaccessalarms", icons.DeviceAccessAlarms},
-	{"Device Access Time", "DeviceAccessTime", "deviceaccesstime", icons.DeviceAccessTime},
-	{"Device Add Alarm", "DeviceAddAlarm", "deviceaddalarm", icons.DeviceAddAlarm},
-	{"Device Airplane Mode Active", "DeviceAirplaneModeActive", "deviceairplanemodeactive", icons.DeviceAirplaneModeActive},
-	{"Device Airplane Mode Inactive", "DeviceAirplaneModeInactive", "deviceairplanemodeinactive", icons.DeviceAirplaneModeInactive},
-	{"Device Battery 20", "DeviceBattery20", "devicebattery20", icons.DeviceBattery20},
-	{"Device Battery 30", "DeviceBattery30", "devicebattery30", icons.DeviceBattery30},
-	{"Device Battery 50", "DeviceBattery50", "devicebattery50", icons.DeviceBattery50},
-	{"Device Battery 60", "DeviceBattery60", "devicebattery60", icons.DeviceBattery60},
-	{"Device Battery 80", "DeviceBattery80", "devicebattery80", icons.DeviceBattery80},
-	{"Device Battery 90", "DeviceBattery90", "devicebattery90", icons.DeviceBattery90},
-	{"Device Battery Alert", "DeviceBatteryAlert", "devicebatteryalert", icons.DeviceBatteryAlert},
-	{"Device Battery Charging 20", "DeviceBatteryCharging20", "devicebatterycharging20", icons.DeviceBatteryCharging20},
-	{"Device Battery Charging 30", "DeviceBatteryCharging30", "devicebatterycharging30", icons.DeviceBatteryCharging30},
-	{"Device Battery Charging 50", "DeviceBatteryCharging50", "devicebatterycharging50", icons.DeviceBatteryCharging50},
-	{"Device Battery Charging 60", "DeviceBatteryCharging60", "devicebatterycharging60", icons.DeviceBatteryCharging60},
-	{"Device Battery Charging 80", "DeviceBatteryCharging80", "devicebatterycharging80", icons.DeviceBatteryCharging80},
-	{"Device Battery Charging 90", "DeviceBatteryCharging90", "devicebatterycharging90", icons.DeviceBatteryCharging90},
-	{"Device Battery Charging Full", "DeviceBatteryChargingFull", "devicebatterychargingfull", icons.DeviceBatteryChargingFull},
-	{"Device Battery Full", "DeviceBatteryFull", "devicebatteryfull", icons.DeviceBatteryFull},
-	{"Device Battery Std", "DeviceBatteryStd", "devicebatterystd", icons.DeviceBatteryStd},
-	{"Device Battery Unknown", "DeviceBatteryUnknown", "devicebatteryunknown", icons.DeviceBatteryUnknown},
-	{"Device Bluetooth", "DeviceBluetooth", "devicebluetooth", icons.DeviceBluetooth},
-	{"Device Bluetooth Connected", "DeviceBluetoothConnected", "devicebluetoothconnected", icons.DeviceBluetoothConnected},
-	{"Device Bluetooth Disabled", "DeviceBluetoothDisabled", "devicebluetoothdisabled", icons.DeviceBluetoothDisabled},
-	{"Device Bluetooth Searching", "DeviceBluetoothSearching", "devicebluetoothsearching", icons.DeviceBluetoothSearching},
-	{"Device Brightness Auto", "DeviceBrightnessAuto", "devicebrightnessauto", icons.DeviceBrightnessAuto},
-	{"Device Brightness High", "DeviceBrightnessHigh", "devicebrightnesshigh", icons.DeviceBrightnessHigh},
-	{"Device Brightness Low", "DeviceBrightnessLow", "devicebrightnesslow", icons.DeviceBrightnessLow},
-	{"Device Brightness Medium", "DeviceBrightnessMedium", "devicebrightnessmedium", icons.DeviceBrightnessMedium},
-	{"Device DVR", "DeviceDVR", "devicedvr", icons.DeviceDVR},
-	{"Device Data Usage", "DeviceDataUsage", "devicedatausage", icons.DeviceDataUsage},
-	{"Device Developer Mode", "DeviceDeveloperMode", "devicedevelopermode", icons.DeviceDeveloperMode},
-	{"Device Devices", "DeviceDevices", "devicedevices", icons.DeviceDevices},
-	{"Device GPS Fixed", "DeviceGPSFixed", "devicegpsfixed", icons.DeviceGPSFixed},
-	{"Device GPS Not Fixed", "DeviceGPSNotFixed", "devicegpsnotfixed", icons.DeviceGPSNotFixed},
-	{"Device GPS Off", "DeviceGPSOff", "devicegpsoff", icons.DeviceGPSOff},
-	{"Device Graphic Eq", "DeviceGraphicEq", "devicegraphiceq", icons.DeviceGraphicEq},
-	{"Device Location Disabled", "DeviceLocationDisabled", "devicelocationdisabled", icons.DeviceLocationDisabled},
-	{"Device Location Searching", "DeviceLocationSearching", "devicelocationsearching", icons.DeviceLocationSearching},
-	{"Device NFC", "DeviceNFC", "devicenfc", icons.DeviceNFC},
-	{"Device Network Cell", "DeviceNetworkCell", "devicenetworkcell", icons.DeviceNetworkCell},
-	{"Device Network Wi Fi", "DeviceNetworkWiFi", "devicenetworkwifi", icons.DeviceNetworkWiFi},
-	{"Device SD Storage", "DeviceSDStorage", "devicesdstorage", icons.DeviceSDStorage},
-	{"Device Screen Lock Landscape", "DeviceScreenLockLandscape", "devicescreenlocklandscape", icons.DeviceScreenLockLandscape},
-	{"Device Screen Lock Portrait", "DeviceScreenLockPortrait", "devicescreenlockportrait", icons.DeviceScreenLockPortrait},
-	{"Device Screen Lock Rotation", "DeviceScreenLockRotation", "devicescreenlockrotation", icons.DeviceScreenLockRotation},
-	{"Device Screen Rotation", "DeviceScreenRotation", "devicescreenrotation", icons.DeviceScreenRotation},
-	{"Device Settings System Daydream", "DeviceSettingsSystemDaydream", "devicesettingssystemdaydream", icons.DeviceSettingsSystemDaydream},
-	{"Device Signal Cellular 0 Bar", "DeviceSignalCellular0Bar", "devicesignalcellular0bar", icons.DeviceSignalCellular0Bar},
-	{"Device Signal Cellular 1 Bar", "DeviceSignalCellular1Bar", "devicesignalcellular1bar", icons.DeviceSignalCellular1Bar},
-	{"Device Signal Cellular 2 Bar", "DeviceSignalCellular2Bar", "devicesignalcellular2bar", icons.DeviceSignalCellular2Bar},
-	{"Device Signal Cellular 3 Bar", "DeviceSignalCellular3Bar", "devicesignalcellular3bar", icons.DeviceSignalCellular3Bar},
-	{"Device Signal Cellular 4 Bar", "DeviceSignalCellular4Bar", "devicesignalcellular4bar", icons.DeviceSignalCellular4Bar},
-	{"Device Signal Cellular Connected No Internet 0 Bar", "DeviceSignalCellularConnectedNoInternet0Bar", "devicesignalcellularconnectednointernet0bar", icons.DeviceSignalCellularConnectedNoInternet0Bar},
-	{"Device Signal Cellular Connected No Internet 1 Bar", "DeviceSignalCellularConnectedNoInternet1Bar", "devicesignalcellularconnectednointernet1bar", icons.DeviceSignalCellularConnectedNoInternet1Bar},
-	{"Device Signal Cellular Connected No Internet 2 Bar", "DeviceSignalCellularConnectedNoInternet2Bar", "devicesignalcellularconnectednointernet2bar", icons.DeviceSignalCellularConnectedNoInternet2Bar},
-	{"Device Signal Cellular Connected No Internet 3 Bar", "DeviceSignalCellularConnectedNoInternet3Bar", "devicesignalcellularconnectednointernet3bar", icons.DeviceSignalCellularConnectedNoInternet3Bar},
-	{"Device Signal Cellular Connected No Internet 4 Bar", "DeviceSignalCellularConnectedNoInternet4Bar", "devicesignalcellularconnectednointernet4bar", icons.DeviceSignalCellularConnectedNoInternet4Bar},
-	{"Device Signal Cellular No SIM", "DeviceSignalCellularNoSIM", "devicesignalcellularnosim", icons.DeviceSignalCellularNoSIM},
-	{"Device Signal Cellular Null", "DeviceSignalCellularNull", "devicesignalcellularnull", icons.DeviceSignalCellularNull},
-	{"Device Signal Cellular Off", "DeviceSignalCellularOff", "devicesignalcellularoff", icons.DeviceSignalCellularOff},
-	{"Device Signal Wi Fi 0 Bar", "DeviceSignalWiFi0Bar", "devicesignalwifi0bar", icons.DeviceSignalWiFi0Bar},
-	{"Device Signal Wi Fi 1 Bar", "DeviceSignalWiFi1Bar", "devicesignalwifi1bar", icons.DeviceSignalWiFi1Bar},
-	{"Device Signal Wi Fi 1 Bar Lock", "DeviceSignalWiFi1BarLock", "devicesignalwifi1barlock", icons.DeviceSignalWiFi1BarLock},
-	{"Device Signal Wi Fi 2 Bar", "DeviceSignalWiFi2Bar", "devicesignalwifi2bar", icons.DeviceSignalWiFi2Bar},
-	{"Device Signal Wi Fi 2 Bar Lock", "DeviceSignalWiFi2BarLock", "devicesignalwifi2barlock", icons.DeviceSignalWiFi2BarLock},
-	{"Device Signal Wi Fi 3 Bar", "DeviceSignalWiFi3Bar", "devicesignalwifi3bar", icons.DeviceSignalWiFi3Bar},
-	{"Device Signal Wi Fi 3 Bar Lock", "DeviceSignalWiFi3BarLock", "devicesignalwifi3barlock", icons.DeviceSignalWiFi3BarLock},
-	{"Device Signal Wi Fi 4 Bar", "DeviceSignalWiFi4Bar", "devicesignalwifi4bar", icons.DeviceSignalWiFi4Bar},
-	{"Device Signal Wi Fi 4 Bar Lock", "DeviceSignalWiFi4BarLock", "devicesignalwifi4barlock", icons.DeviceSignalWiFi4BarLock},
-	{"Device Signal Wi Fi Off", "DeviceSignalWiFiOff", "devicesignalwifioff", icons.DeviceSignalWiFiOff},
-	{"Device Storage", "DeviceStorage", "devicestorage", icons.DeviceStorage},
-	{"Device USB", "DeviceUSB", "deviceusb", icons.DeviceUSB},
-	{"Device Wallpaper", "DeviceWallpaper", "devicewallpaper", icons.DeviceWallpaper},
-	{"Device Wi Fi Lock", "DeviceWiFiLock", "devicewifilock", icons.DeviceWiFiLock},
-	{"Device Wi Fi Tethering", "DeviceWiFiTethering", "devicewifitethering", icons.DeviceWiFiTethering},
-	{"Device Widgets", "DeviceWidgets", "devicewidgets", icons.DeviceWidgets},
-	{"Editor Attach File", "EditorAttachFile", "editorattachfile", icons.EditorAttachFile},
-	{"Editor Attach Money", "EditorAttachMoney", "editorattachmoney", icons.EditorAttachMoney},
-	{"Editor Border All", "EditorBorderAll", "editorborderall", icons.EditorBorderAll},
-	{"Editor Border Bottom", "EditorBorderBottom", "editorborderbottom", icons.EditorBorderBottom},
-	{"Editor Border Clear", "EditorBorderClear", "editorborderclear", icons.EditorBorderClear},
-	{"Editor Border Color", "EditorBorderColor", "editorbordercolor", icons.EditorBorderColor},
-	{"Editor Border Horizontal", "EditorBorderHorizontal", "editorborderhorizontal", icons.EditorBorderHorizontal},
-	{"Editor Border Inner", "EditorBorderInner", "editorborderinner", icons.EditorBorderInner},
-	{"Editor Border Left", "EditorBorderLeft", "editorborderleft", icons.EditorBorderLeft},
-	{"Editor Border Outer", "EditorBorderOuter", "editorborderouter", icons.EditorBorderOuter},
-	{"Editor Border Right", "EditorBorderRight", "editorborderright", icons.EditorBorderRight},
-	{"Editor Border Style", "EditorBorderStyle", "editorborderstyle", icons.EditorBorderStyle},
-	{"Editor Border Top", "EditorBorderTop", "editorbordertop", icons.EditorBorderTop},
-	{"Editor Border Vertical", "EditorBorderVertical", "editorbordervertical", icons.EditorBorderVertical},
-	{"Editor Bubble Chart", "EditorBubbleChart", "editorbubblechart", icons.EditorBubbleChart},
-	{"Editor Drag Handle", "EditorDragHandle", "editordraghandle", icons.EditorDragHandle},
-	{"Editor Format Align Center", "EditorFormatAlignCenter", "editorformataligncenter", icons.EditorFormatAlignCenter},
-	{"Editor Format Align Justify", "EditorFormatAlignJustify", "editorformatalignjustify", icons.EditorFormatAlignJustify},
-	{"Editor Format Align Left", "EditorFormatAlignLeft", "editorformatalignleft", icons.EditorFormatAlignLeft},
-	{"Editor Format Align Right", "EditorFormatAlignRight", "editorformatalignright", icons.EditorFormatAlignRight},
-	{"Editor Format Bold", "EditorFormatBold", "editorformatbold", icons.EditorFormatBold},
-	{"Editor Format Clear", "EditorFormatClear", "editorformatclear", icons.EditorFormatClear},
-	{"Editor Format Color Fill", "EditorFormatColorFill", "editorformatcolorfill", icons.EditorFormatColorFill},
-	{"Editor Format Color Reset", "EditorFormatColorReset", "editorformatcolorreset", icons.EditorFormatColorReset},
-	{"Editor Format Color Text", "EditorFormatColorText", "editorformatcolortext", icons.EditorFormatColorText},
-	{"Editor Format Indent Decrease", "EditorFormatIndentDecrease", "editorformatindentdecrease", icons.EditorFormatIndentDecrease},
-	{"Editor Format Indent Increase", "EditorFormatIndentIncrease", "editorformatindentincrease", icons.EditorFormatIndentIncrease},
-	{"Editor Format Italic", "EditorFormatItalic", "editorformatitalic", icons.EditorFormatItalic},
-	{"Editor Format Line Spacing", "EditorFormatLineSpacing", "editorformatlinespacing", icons.EditorFormatLineSpacing},
-	{"Editor Format List Bulleted", "EditorFormatListBulleted", "editorformatlistbulleted", icons.EditorFormatListBulleted},
-	{"Editor Format List Numbered", "EditorFormatListNumbered", "editorformatlistnumbered", icons.EditorFormatListNumbered},
-	{"Editor Format Paint", "EditorFormatPaint", "editorformatpaint", icons.EditorFormatPaint},
-	{"Editor Format Quote", "EditorFormatQuote", "editorformatquote", icons.EditorFormatQuote},
-	{"Editor Format Shapes", "EditorFormatShapes", "editorformatshapes", icons.EditorFormatShapes},
-	{"Editor Format Size", "EditorFormatSize", "editorformatsize", icons.EditorFormatSize},
-	{"Editor Format Strikethrough", "EditorFormatStrikethrough", "editorformatstrikethrough", icons.EditorFormatStrikethrough},
-	{"Editor Format Text Direction L To R", "EditorFormatTextDirectionLToR", "editorformattextdirectionltor", icons.EditorFormatTextDirectionLToR},
-	{"Editor Format Text Direction R To L", "EditorFormatTextDirectionRToL", "editorformattextdirectionrtol", icons.EditorFormatTextDirectionRToL},
-	{"Editor Format Underlined", "EditorFormatUnderlined", "editorformatunderlined", icons.EditorFormatUnderlined},
-	{"Editor Functions", "EditorFunctions", "editorfunctions", icons.EditorFunctions},
-	{"Editor Highlight", "EditorHighlight", "editorhighlight", icons.EditorHighlight},
-	{"Editor Insert Chart", "EditorInsertChart", "editorinsertchart", icons.EditorInsertChart},
-	{"Editor Insert Comment", "EditorInsertComment", "editorinsertcomment", icons.EditorInsertComment},
-	{"Editor Insert Drive File", "EditorInsertDriveFile", "editorinsertdrivefile", icons.EditorInsertDriveFile},
-	{"Editor Insert Emoticon", "EditorInsertEmoticon", "editorinsertemoticon", icons.EditorInsertEmoticon},
-	{"Editor Insert Invitation", "EditorInsertInvitation", "editorinsertinvitation", icons.EditorInsertInvitation},
-	{"Editor Insert Link", "EditorInsertLink", "editorinsertlink", icons.EditorInsertLink},
-	{"Editor Insert Photo", "EditorInsertPhoto", "editorinsertphoto", icons.EditorInsertPhoto},
-	{"Editor Linear Scale", "EditorLinearScale", "editorlinearscale", icons.EditorLinearScale},
-	{"Editor Merge Type", "EditorMergeType", "editormergetype", icons.EditorMergeType},
-	{"Editor Mode Comment", "EditorModeComment", "editormodecomment", icons.EditorModeComment},
-	{"Editor Mode Edit", "EditorModeEdit", "editormodeedit", icons.EditorModeEdit},
-	{"Editor Monetization On", "EditorMonetizationOn", "editormonetizationon", icons.EditorMonetizationOn},
-	{"Editor Money Off", "EditorMoneyOff", "editormoneyoff", icons.EditorMoneyOff},
-	{"Editor Multiline Chart", "EditorMultilineChart", "editormultilinechart", icons.EditorMultilineChart},
-	{"Editor Pie Chart", "EditorPieChart", "editorpiechart", icons.EditorPieChart},
-	{"Editor Pie Chart Outlined", "EditorPieChartOutlined", "editorpiechartoutlined", icons.EditorPieChartOutlined},
-	{"Editor Publish", "EditorPublish", "editorpublish", icons.EditorPublish},
-	{"Editor Short Text", "EditorShortText", "editorshorttext", icons.EditorShortText},
-	{"Editor Show Chart", "EditorShowChart", "editorshowchart", icons.EditorShowChart},
-	{"Editor Space Bar", "EditorSpaceBar", "editorspacebar", icons.EditorSpaceBar},
-	{"Editor Strikethrough S", "EditorStrikethroughS", "editorstrikethroughs", icons.EditorStrikethroughS},
-	{"Editor Text Fields", "EditorTextFields", "editortextfields", icons.EditorTextFields},
-	{"Editor Title", "EditorTitle", "editortitle", icons.EditorTitle},
-	{"Editor Vertical Align Bottom", "EditorVerticalAlignBottom", "editorverticalalignbottom", icons.EditorVerticalAlignBottom},
-	{"Editor Vertical Align Center", "EditorVerticalAlignCenter", "editorverticalaligncenter", icons.EditorVerticalAlignCenter},
-	{"Editor Vertical Align Top", "EditorVerticalAlignTop", "editorverticalaligntop", icons.EditorVerticalAlignTop},
-	{"Editor Wrap Text", "EditorWrapText", "editorwraptext", icons.EditorWrapText},
-	{"File Attachment", "FileAttachment", "fileattachment", icons.FileAttachment},
-	{"File Cloud", "FileCloud", "filecloud", icons.FileCloud},
-	{"File Cloud Circle", "FileCloudCircle", "filecloudcircle", icons.FileCloudCircle},
-	{"File Cloud Done", "FileCloudDone", "fileclouddone", icons.FileCloudDone},
-	{"File Cloud Download", "FileCloudDownload", "fileclouddownload", icons.FileCloudDownload},
-	{"File Cloud Off", "FileCloudOff", "filecloudoff", icons.FileCloudOff},
-	{"File Cloud Queue", "FileCloudQueue", "filecloudqueue", icons.FileCloudQueue},
-	{"File Cloud Upload", "FileCloudUpload", "filecloudupload", icons.FileCloudUpload},
-	{"File Create New Folder", "FileCreateNewFolder", "filecreatenewfolder", icons.FileCreateNewFolder},
-	{"File File Download", "FileFileDownload", "filefiledownload", icons.FileFileDownload},
-	{"File File Upload", "FileFileUpload", "filefileupload", icons.FileFileUpload},
-	{"File Folder", "FileFolder", "filefolder", icons.FileFolder},
-	{"File Folder Open", "FileFolderOpen", "filefolderopen", icons.FileFolderOpen},
-	{"File Folder Shared", "FileFolderShared", "filefoldershared", icons.FileFolderShared},
-	{"Hardware Cast", "HardwareCast", "hardwarecast", icons.HardwareCast},
-	{"Hardware Cast Connected", "HardwareCastConnected", "hardwarecastconnected", icons.HardwareCastConnected},
-	{"Hardware Computer", "HardwareComputer", "hardwarecomputer", icons.HardwareComputer},
-	{"Hardware Desktop Mac", "HardwareDesktopMac", "hardwaredesktopmac", icons.HardwareDesktopMac},
-	{"Hardware Desktop Windows", "HardwareDesktopWindows", "hardwaredesktopwindows", icons.HardwareDesktopWindows},
-	{"Hardware Developer Board", "HardwareDeveloperBoard", "hardwaredeveloperboard", icons.HardwareDeveloperBoard},
-	{"Hardware Device Hub", "HardwareDeviceHub", "hardwaredevicehub", icons.HardwareDeviceHub},
-	{"Hardware Devices Other", "HardwareDevicesOther", "hardwaredevicesother", icons.HardwareDevicesOther},
-	{"Hardware Dock", "HardwareDock", "hardwaredock", icons.HardwareDock},
-	{"Hardware Gamepad", "HardwareGamepad", "hardwaregamepad", icons.HardwareGamepad},
-	{"Hardware Headset", "HardwareHeadset", "hardwareheadset", icons.HardwareHeadset},
-	{"Hardware Headset Mic", "HardwareHeadsetMic", "hardwareheadsetmic", icons.HardwareHeadsetMic},
-	{"Hardware Keyboard", "HardwareKeyboard", "hardwarekeyboard", icons.HardwareKeyboard},
-	{"Hardware Keyboard Arrow Down", "HardwareKeyboardArrowDown", "hardwarekeyboardarrowdown", icons.HardwareKeyboardArrowDown},
-	{"Hardware Keyboard Arrow Left", "HardwareKeyboardArrowLeft", "hardwarekeyboardarrowleft", icons.HardwareKeyboardArrowLeft},
-	{"Hardware Keyboard Arrow Right", "HardwareKeyboardArrowRight", "hardwarekeyboardarrowright", icons.HardwareKeyboardArrowRight},
-	{"Hardware Keyboard Arrow Up", "HardwareKeyboardArrowUp", "hardwarekeyboardarrowup", icons.HardwareKeyboardArrowUp},
-	{"Hardware Keyboard Backspace", "HardwareKeyboardBackspace", "hardwarekeyboardbackspace", icons.HardwareKeyboardBackspace},
-	{"Hardware Keyboard Capslock", "HardwareKeyboardCapslock", "hardwarekeyboardcapslock", icons.HardwareKeyboardCapslock},
-	{"Hardware Keyboard Hide", "HardwareKeyboardHide", "hardwarekeyboardhide", icons.HardwareKeyboardHide},
-	{"Hardware Keyboard Return", "HardwareKeyboardReturn", "hardwarekeyboardreturn", icons.HardwareKeyboardReturn},
-	{"Hardware Keyboard Tab", "HardwareKeyboardTab", "hardwarekeyboardtab", icons.HardwareKeyboardTab},
-	{"Hardware Keyboard Voice", "HardwareKeyboardVoice", "hardwarekeyboardvoice", icons.HardwareKeyboardVoice},
-	{"Hardware Laptop", "HardwareLaptop", "hardwarelaptop", icons.HardwareLaptop},
-	{"Hardware Laptop Chromebook", "HardwareLaptopChromebook", "hardwarelaptopchromebook", icons.HardwareLaptopChromebook},
-	{"Hardware Laptop Mac", "HardwareLaptopMac", "hardwarelaptopmac", icons.HardwareLaptopMac},
-	{"Hardware Laptop Windows", "HardwareLaptopWindows", "hardwarelaptopwindows", icons.HardwareLaptopWindows},
-	{"Hardware Memory", "HardwareMemory", "hardwarememory", icons.HardwareMemory},
-	{"Hardware Mouse", "HardwareMouse", "hardwaremouse", icons.HardwareMouse},
-	{"Hardware Phone Android", "HardwarePhoneAndroid", "hardwarephoneandroid", icons.HardwarePhoneAndroid},
-	{"Hardware Phone I Phone", "HardwarePhoneIPhone", "hardwarephoneiphone", icons.HardwarePhoneIPhone},
-	{"Hardware Phone Link", "HardwarePhoneLink", "hardwarephonelink", icons.HardwarePhoneLink},
-	{"Hardware Phone Link Off", "HardwarePhoneLinkOff", "hardwarephonelinkoff", icons.HardwarePhoneLinkOff},
-	{"Hardware Power Input", "HardwarePowerInput", "hardwarepowerinput", icons.HardwarePowerInput},
-	{"Hardware Router", "HardwareRouter", "hardwarerouter", icons.HardwareRouter},
-	{"Hardware SIM Card", "HardwareSIMCard", "hardwaresimcard", icons.HardwareSIMCard},
-	{"Hardware Scanner", "HardwareScanner", "hardwarescanner", icons.HardwareScanner},
-	{"Hardware Security", "HardwareSecurity", "hardwaresecurity", icons.HardwareSecurity},
-	{"Hardware Smartphone", "HardwareSmartphone", "hardwaresmartphone", icons.HardwareSmartphone},
-	{"Hardware Speaker", "HardwareSpeaker", "hardwarespeaker", icons.HardwareSpeaker},
-	{"Hardware Speaker Group", "HardwareSpeakerGroup", "hardwarespeakergroup", icons.HardwareSpeakerGroup},
-	{"Hardware TV", "HardwareTV", "hardwaretv", icons.HardwareTV},
-	{"Hardware Tablet", "HardwareTablet", "hardwaretablet", icons.HardwareTablet},
-	{"Hardware Tablet Android", "HardwareTabletAndroid", "hardwaretabletandroid", icons.HardwareTabletAndroid},
-	{"Hardware Tablet Mac", "HardwareTabletMac", "hardwaretabletmac", icons.HardwareTabletMac},
-	{"Hardware Toys", "HardwareToys", "hardwaretoys", icons.HardwareToys},
-	{"Hardware Videogame Asset", "HardwareVideogameAsset", "hardwarevideogameasset", icons.HardwareVideogameAsset},
-	{"Hardware Watch", "HardwareWatch", "hardwarewatch", icons.HardwareWatch},
-	{"Image Add A Photo", "ImageAddAPhoto", "imageaddaphoto", icons.ImageAddAPhoto},
-	{"Image Add To Photos", "ImageAddToPhotos", "imageaddtophotos", icons.ImageAddToPhotos},
-	{"Image Adjust", "ImageAdjust", "imageadjust", icons.ImageAdjust},
-	{"Image Assistant", "ImageAssistant", "imageassistant", icons.ImageAssistant},
-	{"Image Assistant Photo", "ImageAssistantPhoto", "imageassistantphoto", icons.ImageAssistantPhoto},
-	{"Image Audiotrack", "ImageAudiotrack", "imageaudiotrack", icons.ImageAudiotrack},
-	{"Image Blur Circular", "ImageBlurCircular", "imageblurcircular", icons.ImageBlurCircular},
-	{"Image Blur Linear", "ImageBlurLinear", "imageblurlinear", icons.ImageBlurLinear},
-	{"Image Blur Off", "ImageBlurOff", "imagebluroff", icons.ImageBlurOff},
-	{"Image Blur On", "ImageBlurOn", "imagebluron", icons.ImageBlurOn},
-	{"Image Brightness 1", "ImageBrightness1", "imagebrightness1", icons.ImageBrightness1},
-	{"Image Brightness 2", "ImageBrightness2", "imagebrightness2", icons.ImageBrightness2},
-	{"Image Brightness 3", "ImageBrightness3", "imagebrightness3", icons.ImageBrightness3},
-	{"Image Brightness 4", "ImageBrightness4", "imagebrightness4", icons.ImageBrightness4},
-	{"Image Brightness 5", "ImageBrightness5", "imagebrightness5", icons.ImageBrightness5},
-	{"Image Brightness 6", "ImageBrightness6", "imagebrightness6", icons.ImageBrightness6},
-	{"Image Brightness 7", "ImageBrightness7", "imagebrightness7", icons.ImageBrightness7},
-	{"Image Broken Image", "ImageBrokenImage", "imagebrokenimage", icons.ImageBrokenImage},
-	{"Image Brush", "ImageBrush", "imagebrush", icons.ImageBrush},
-	{"Image Burst Mode", "ImageBurstMode", "imageburstmode", icons.ImageBurstMode},
-	{"Image Camera", "ImageCamera", "imagecamera", icons.ImageCamera},
-	{"Image Camera Alt", "ImageCameraAlt", "imagecameraalt", icons.ImageCameraAlt},
-	{"Image Camera Front", "ImageCameraFront", "imagecamerafront", icons.ImageCameraFront},
-	{"Image Camera Rear", "ImageCameraRear", "imagecamerarear", icons.ImageCameraRear},
-	{"Image Camera Roll", "ImageCameraRoll", "imagecameraroll", icons.ImageCameraRoll},
-	{"Image Center Focus Strong", "ImageCenterFocusStrong", "imagecenterfocusstrong", icons.ImageCenterFocusStrong},
-	{"Image Center Focus Weak", "ImageCenterFocusWeak", "imagecenterfocusweak", icons.ImageCenterFocusWeak},
-	{"Image Collections", "ImageCollections", "imagecollections", icons.ImageCollections},
-	{"Image Collections Bookmark", "ImageCollectionsBookmark", "imagecollectionsbookmark", icons.ImageCollectionsBookmark},
-	{"Image Color Lens", "ImageColorLens", "imagecolorlens", icons.ImageColorLens},
-	{"Image Colorize", "ImageColorize", "imagecolorize", icons.ImageColorize},
-	{"Image Compare", "ImageCompare", "imagecompare", icons.ImageCompare},
-	{"Image Control Point", "ImageControlPoint", "imagecontrolpoint", icons.ImageControlPoint},
-	{"Image Control Point Duplicate", "ImageControlPointDuplicate", "imagecontrolpointduplicate", icons.ImageControlPointDuplicate},
-	{"Image Crop", "ImageCrop", "imagecrop", icons.ImageCrop},
-	{"Image Crop 169", "ImageCrop169", "imagecrop169", icons.ImageCrop169},
-	{"Image Crop 32", "ImageCrop32", "imagecrop32", icons.ImageCrop32},
-	{"Image Crop 54", "ImageCrop54", "imagecrop54", icons.ImageCrop54},
-	{"Image Crop 75", "ImageCrop75", "imagecrop75", icons.ImageCrop75},
-	{"Image Crop DIN", "ImageCropDIN", "imagecropdin", icons.ImageCropDIN},
-	{"Image Crop Free", "ImageCropFree", "imagecropfree", icons.ImageCropFree},
-	{"Image Crop Landscape", "ImageCropLandscape", "imagecroplandscape", icons.ImageCropLandscape},
-	{"Image Crop Original", "ImageCropOriginal", "imagecroporiginal", icons.ImageCropOriginal},
-	{"Image Crop Portrait", "ImageCropPortrait", "imagecropportrait", icons.ImageCropPortrait},
-	{"Image Crop Rotate", "ImageCropRotate", "imagecroprotate", icons.ImageCropRotate},
-	{"Image Crop Square", "ImageCropSquare", "imagecropsquare", icons.ImageCropSquare},
-	{"Image Dehaze", "ImageDehaze", "imagedehaze", icons.ImageDehaze},
-	{"Image Details", "ImageDetails", "imagedetails", icons.ImageDetails},
-	{"Image Edit", "ImageEdit", "imageedit", icons.ImageEdit},
-	{"Image Exposure", "ImageExposure", "imageexposure", icons.ImageExposure},
-	{"Image Exposure Neg 1", "ImageExposureNeg1", "imageexposureneg1", icons.ImageExposureNeg1},
-	{"Image Exposure Neg 2", "ImageExposureNeg2", "imageexposureneg2", icons.ImageExposureNeg2},
-	{"Image Exposure Plus 1", "ImageExposurePlus1", "imageexposureplus1", icons.ImageExposurePlus1},
-	{"Image Exposure Plus 2", "ImageExposurePlus2", "imageexposureplus2", icons.ImageExposurePlus2},
-	{"Image Exposure Zero", "ImageExposureZero", "imageexposurezero", icons.ImageExposureZero},
-	{"Image Filter", "ImageFilter", "imagefilter", icons.ImageFilter},
-	{"Image Filter 1", "ImageFilter1", "imagefilter1", icons.ImageFilter1},
-	{"Image Filter 2", "ImageFilter2", "imagefilter2", icons.ImageFilter2},
-	{"Image Filter 3", "ImageFilter3", "imagefilter3", icons.ImageFilter3},
-	{"Image Filter 4", "ImageFilter4", "imagefilter4", icons.ImageFilter4},
-	{"Image Filter 5", "ImageFilter5", "imagefilter5", icons.ImageFilter5},
-	{"Image Filter 6", "ImageFilter6", "imagefilter6", icons.ImageFilter6},
-	{"Image Filter 7", "ImageFilter7", "imagefilter7", icons.ImageFilter7},
-	{"Image Filter 8", "ImageFilter8", "imagefilter8", icons.ImageFilter8},
-	{"Image Filter 9", "ImageFilter9", "imagefilter9", icons.ImageFilter9},
-	{"Image Filter 9 Plus", "ImageFilter9Plus", "imagefilter9plus", icons.ImageFilter9Plus},
-	{"Image Filter B And W", "ImageFilterBAndW", "imagefilterbandw", icons.ImageFilterBAndW},
-	{"Image Filter Center Focus", "ImageFilterCenterFocus", "imagefiltercenterfocus", icons.ImageFilterCenterFocus},
-	{"Image Filter Drama", "ImageFilterDrama", "imagefilterdrama", icons.ImageFilterDrama},
-	{"Image Filter Frames", "ImageFilterFrames", "imagefilterframes", icons.ImageFilterFrames},
-	{"Image Filter HDR", "ImageFilterHDR", "imagefilterhdr", icons.ImageFilterHDR},
-	{"Image Filter None", "ImageFilterNone", "imagefilternone", icons.ImageFilterNone},
-	{"Image Filter Tilt Shift", "ImageFilterTiltShift", "imagefiltertiltshift", icons.ImageFilterTiltShift},
-	{"Image Filter Vintage", "ImageFilterVintage", "imagefiltervintage", icons.ImageFilterVintage},
-	{"Image Flare", "ImageFlare", "imageflare", icons.ImageFlare},
-	{"Image Flash Auto", "ImageFlashAuto", "imageflashauto", icons.ImageFlashAuto},
-	{"Image Flash Off", "ImageFlashOff", "imageflashoff", icons.ImageFlashOff},
-	{"Image Flash On", "ImageFlashOn", "imageflashon", icons.ImageFlashOn},
-	{"Image Flip", "ImageFlip", "imageflip", icons.ImageFlip},
-	{"Image Gradient", "ImageGradient", "imagegradient", icons.ImageGradient},
-	{"Image Grain", "ImageGrain", "imagegrain", icons.ImageGrain},
-	{"Image Grid Off", "ImageGridOff", "imagegridoff", icons.ImageGridOff},
-	{"Image Grid On", "ImageGridOn", "imagegridon", icons.ImageGridOn},
-	{"Image HDR Off", "ImageHDROff", "imagehdroff", icons.ImageHDROff},
-	{"Image HDR On", "ImageHDROn", "imagehdron", icons.ImageHDROn},
-	{"Image HDR Strong", "ImageHDRStrong", "imagehdrstrong", icons.ImageHDRStrong},
-	{"Image HDR Weak", "ImageHDRWeak", "imagehdrweak", icons.ImageHDRWeak},
-	{"Image Healing", "ImageHealing", "imagehealing", icons.ImageHealing},
-	{"Image ISO", "ImageISO", "imageiso", icons.ImageISO},
-	{"Image Image", "ImageImage", "imageimage", icons.ImageImage},
-	{"Image Image Aspect Ratio", "ImageImageAspectRatio", "imageimageaspectratio", icons.ImageImageAspectRatio},
-	{"Image Landscape", "ImageLandscape", "imagelandscape", icons.ImageLandscape},
-	{"Image Leak Add", "ImageLeakAdd", "imageleakadd", icons.ImageLeakAdd},
-	{"Image Leak Remove", "ImageLeakRemove", "imageleakremove", icons.ImageLeakRemove},
-	{"Image Lens", "ImageLens", "imagelens", icons.ImageLens},
-	{"Image Linked Camera", "ImageLinkedCamera", "imagelinkedcamera", icons.ImageLinkedCamera},
-	{"Image Looks", "ImageLooks", "imagelooks", icons.ImageLooks},
-	{"Image Looks 3", "ImageLooks3", "imagelooks3", icons.ImageLooks3},
-	{"Image Looks 4", "ImageLooks4", "imagelooks4", icons.ImageLooks4},
-	{"Image Looks 5", "ImageLooks5", "imagelooks5", icons.ImageLooks5},
-	{"Image Looks 6", "ImageLooks6", "imagelooks6", icons.ImageLooks6},
-	{"Image Looks One", "ImageLooksOne", "imagelooksone", icons.ImageLooksOne},
-	{"Image Looks Two", "ImageLooksTwo", "imagelookstwo", icons.ImageLooksTwo},
-	{"Image Loupe", "ImageLoupe", "imageloupe", icons.ImageLoupe},
-	{"Image Monochrome Photos", "ImageMonochromePhotos", "imagemonochromephotos", icons.ImageMonochromePhotos},
-	{"Image Movie Creation", "ImageMovieCreation", "imagemoviecreation", icons.ImageMovieCreation},
-	{"Image Movie Filter", "ImageMovieFilter", "imagemoviefilter", icons.ImageMovieFilter},
-	{"Image Music Note", "ImageMusicNote", "imagemusicnote", icons.ImageMusicNote},
-	{"Image Nature", "ImageNature", "imagenature", icons.ImageNature},
-	{"Image Nature People", "ImageNaturePeople", "imagenaturepeople", icons.ImageNaturePeople},
-	{"Image Navigate Before", "ImageNavigateBefore", "imagenavigatebefore", icons.ImageNavigateBefore},
-	{"Image Navigate Next", "ImageNavigateNext", "imagenavigatenext", icons.ImageNavigateNext},
-	{"Image Palette", "ImagePalette", "imagepalette", icons.ImagePalette},
-	{"Image Panorama", "ImagePanorama", "imagepanorama", icons.ImagePanorama},
-	{"Image Panorama Fish Eye", "ImagePanoramaFishEye", "imagepanoramafisheye", icons.ImagePanoramaFishEye},
-	{"Image Panorama Horizontal", "ImagePanoramaHorizontal", "imagepanoramahorizontal", icons.ImagePanoramaHorizontal},
-	{"Image Panorama Vertical", "ImagePanoramaVertical", "imagepanoramavertical", icons.ImagePanoramaVertical},
-	{"Image Panorama Wide Angle", "ImagePanoramaWideAngle", "imagepanoramawideangle", icons.ImagePanoramaWideAngle},
-	{"Image Photo", "ImagePhoto", "imagephoto", icons.ImagePhoto},
-	{"Image Photo Album", "ImagePhotoAlbum", "imagephotoalbum", icons.ImagePhotoAlbum},
-	{"Image Photo Camera", "ImagePhotoCamera", "imagephotocamera", icons.ImagePhotoCamera},
-	{"Image Photo Filter", "ImagePhotoFilter", "imagephotofilter", icons.ImagePhotoFilter},
-	{"Image Photo Library", "ImagePhotoLibrary", "imagephotolibrary", icons.ImagePhotoLibrary},
-	{"Image Photo Size Select Actual", "ImagePhotoSizeSelectActual", "imagephotosizeselectactual", icons.ImagePhotoSizeSelectActual},
-	{"Image Photo Size Select Large", "ImagePhotoSizeSelectLarge", "imagephotosizeselectlarge", icons.ImagePhotoSizeSelectLarge},
-	{"Image Photo Size Select Small", "ImagePhotoSizeSelectSmall", "imagephotosizeselectsmall", icons.ImagePhotoSizeSelectSmall},
-	{"Image Picture As PDF", "ImagePictureAsPDF", "imagepictureaspdf", icons.ImagePictureAsPDF},
-	{"Image Portrait", "ImagePortrait", "imageportrait", icons.ImagePortrait},
-	{"Image Remove Red Eye", "ImageRemoveRedEye", "imageremoveredeye", icons.ImageRemoveRedEye},
-	{"Image Rotate 90 Degrees CCW", "ImageRotate90DegreesCCW", "imagerotate90degreesccw", icons.ImageRotate90DegreesCCW},
-	{"Image Rotate Left", "ImageRotateLeft", "imagerotateleft", icons.ImageRotateLeft},
-	{"Image Rotate Right", "ImageRotateRight", "imagerotateright", icons.ImageRotateRight},
-	{"Image Slideshow", "ImageSlideshow", "imageslideshow", icons.ImageSlideshow},
-	{"Image Straighten", "ImageStraighten", "imagestraighten", icons.ImageStraighten},
-	{"Image Style", "ImageStyle", "imagestyle", icons.ImageStyle},
-	{"Image Switch Camera", "ImageSwitchCamera", "imageswitchcamera", icons.ImageSwitchCamera},
-	{"Image Switch Video", "ImageSwitchVideo", "imageswitchvideo", icons.ImageSwitchVideo},
-	{"Image Tag Faces", "ImageTagFaces", "imagetagfaces", icons.ImageTagFaces},
-	{"Image Texture", "ImageTexture", "imagetexture", icons.ImageTexture},
-	{"Image Time Lapse", "ImageTimeLapse", "imagetimelapse", icons.ImageTimeLapse},
-	{"Image Timer", "ImageTimer", "imagetimer", icons.ImageTimer},
-	{"Image Timer 10", "ImageTimer10", "imagetimer10", icons.ImageTimer10},
-	{"Image Timer 3", "ImageTimer3", "imagetimer3", icons.ImageTimer3},
-	{"Image Timer Off", "ImageTimerOff", "imagetimeroff", icons.ImageTimerOff},
-	{"Image Tonality", "ImageTonality", "imagetonality", icons.ImageTonality},
-	{"Image Transform", "ImageTransform", "imagetransform", icons.ImageTransform},
-	{"Image Tune", "ImageTune", "imagetune", icons.ImageTune},
-	{"Image View Comfy", "ImageViewComfy", "imageviewcomfy", icons.ImageViewComfy},
-	{"Image View Compact", "ImageViewCompact", "imageviewcompact", icons.ImageViewCompact},
-	{"Image Vignette", "ImageVignette", "imagevignette", icons.ImageVignette},
-	{"Image WB Auto", "ImageWBAuto", "imagewbauto", icons.ImageWBAuto},
-	{"Image WB Cloudy", "ImageWBCloudy", "imagewbcloudy", icons.ImageWBCloudy},
-	{"Image WB Incandescent", "ImageWBIncandescent", "imagewbincandescent", icons.ImageWBIncandescent},
-	{"Image WB Iridescent", "ImageWBIridescent", "imagewbiridescent", icons.ImageWBIridescent},
-	{"Image WB Sunny", "ImageWBSunny", "imagewbsunny", icons.ImageWBSunny},
-	{"Maps Add Location", "MapsAddLocation", "mapsaddlocation", icons.MapsAddLocation},
-	{"Maps Beenhere", "MapsBeenhere", "mapsbeenhere", icons.MapsBeenhere},
-	{"Maps Directions", "MapsDirections", "mapsdirections", icons.MapsDirections},
-	{"Maps Directions Bike", "MapsDirectionsBike", "mapsdirectionsbike", icons.MapsDirectionsBike},
-	{"Maps Directions Boat", "MapsDirectionsBoat", "mapsdirectionsboat", icons.MapsDirectionsBoat},
-	{"Maps Directions Bus", "MapsDirectionsBus", "mapsdirectionsbus", icons.MapsDirectionsBus},
-	{"Maps Directions Car", "MapsDirectionsCar", "mapsdirectionscar", icons.MapsDirectionsCar},
-	{"Maps Directions Railway", "MapsDirectionsRailway", "mapsdirectionsrailway", icons.MapsDirectionsRailway},
-	{"Maps Directions Run", "MapsDirectionsRun", "mapsdirectionsrun", icons.MapsDirectionsRun},
-	{"Maps Directions Subway", "MapsDirectionsSubway", "mapsdirectionssubway", icons.MapsDirectionsSubway},
-	{"Maps Directions Transit", "MapsDirectionsTransit", "mapsdirectionstransit", icons.MapsDirectionsTransit},
-	{"Maps Directions Walk", "MapsDirectionsWalk", "mapsdirectionswalk", icons.MapsDirectionsWalk},
-	{"Maps EV Station", "MapsEVStation", "mapsevstation", icons.MapsEVStation},
-	{"Maps Edit Location", "MapsEditLocation", "mapseditlocation", icons.MapsEditLocation},
-	{"Maps Flight", "MapsFlight", "mapsflight", icons.MapsFlight},
-	{"Maps Hotel", "MapsHotel", "mapshotel", icons.MapsHotel},
-	{"Maps Layers", "MapsLayers", "mapslayers", icons.MapsLayers},
-	{"Maps Layers Clear", "MapsLayersClear", "mapslayersclear", icons.MapsLayersClear},
-	{"Maps Local ATM", "MapsLocalATM", "mapslocalatm", icons.MapsLocalATM},
-	{"Maps Local Activity", "MapsLocalActivity", "mapslocalactivity", icons.MapsLocalActivity},
-	{"Maps Local Airport", "MapsLocalAirport", "mapslocalairport", icons.MapsLocalAirport},
-	{"Maps Local Bar", "MapsLocalBar", "mapslocalbar", icons.MapsLocalBar},
-	{"Maps Local Cafe", "MapsLocalCafe", "mapslocalcafe", icons.MapsLocalCafe},
-	{"Maps Local Car Wash", "MapsLocalCarWash", "mapslocalcarwash", icons.MapsLocalCarWash},
-	{"Maps Local Convenience Store", "MapsLocalConvenienceStore", "mapslocalconveniencestore", icons.MapsLocalConvenienceStore},
-	{"Maps Local Dining", "MapsLocalDining", "mapslocaldining", icons.MapsLocalDining},
-	{"Maps Local Drink", "MapsLocalDrink", "mapslocaldrink", icons.MapsLocalDrink},
-	{"Maps Local Florist", "MapsLocalFlorist", "mapslocalflorist", icons.MapsLocalFlorist},
-	{"Maps Local Gas Station", "MapsLocalGasStation", "mapslocalgasstation", icons.MapsLocalGasStation},
-	{"Maps Local Grocery Store", "MapsLocalGroceryStore", "mapslocalgrocerystore", icons.MapsLocalGroceryStore},
-	{"Maps Local Hospital", "MapsLocalHospital", "mapslocalhospital", icons.MapsLocalHospital},
-	{"Maps Local Hotel", "MapsLocalHotel", "mapslocalhotel", icons.MapsLocalHotel},
-	{"Maps Local Laundry Service", "MapsLocalLaundryService", "mapslocallaundryservice", icons.MapsLocalLaundryService},
-	{"Maps Local Library", "MapsLocalLibrary", "mapslocallibrary", icons.MapsLocalLibrary},
-	{"Maps Local Mall", "MapsLocalMall", "mapslocalmall", icons.MapsLocalMall},
-	{"Maps Local Movies", "MapsLocalMovies", "mapslocalmovies", icons.MapsLocalMovies},
-	{"Maps Local Offer", "MapsLocalOffer", "mapslocaloffer", icons.MapsLocalOffer},
-	{"Maps Local Parking", "MapsLocalParking", "mapslocalparking", icons.MapsLocalParking},
-	{"Maps Local Pharmacy", "MapsLocalPharmacy", "mapslocalpharmacy", icons.MapsLocalPharmacy},
-	{"Maps Local Phone", "MapsLocalPhone", "mapslocalphone", icons.MapsLocalPhone},
-	{"Maps Local Pizza", "MapsLocalPizza", "mapslocalpizza", icons.MapsLocalPizza},
-	{"Maps Local Play", "MapsLocalPlay", "mapslocalplay", icons.MapsLocalPlay},
-	{"Maps Local Post Office", "MapsLocalPostOffice", "mapslocalpostoffice", icons.MapsLocalPostOffice},
-	{"Maps Local Printshop", "MapsLocalPrintshop", "mapslocalprintshop", icons.MapsLocalPrintshop},
-	{"Maps Local See", "MapsLocalSee", "mapslocalsee", icons.MapsLocalSee},
-	{"Maps Local Shipping", "MapsLocalShipping", "mapslocalshipping", icons.MapsLocalShipping},
-	{"Maps Local Taxi", "MapsLocalTaxi", "mapslocaltaxi", icons.MapsLocalTaxi},
-	{"Maps Map", "MapsMap", "mapsmap", icons.MapsMap},
-	{"Maps My Location", "MapsMyLocation", "mapsmylocation", icons.MapsMyLocation},
-	{"Maps Navigation", "MapsNavigation", "mapsnavigation", icons.MapsNavigation},
-	{"Maps Near Me", "MapsNearMe", "mapsnearme", icons.MapsNearMe},
-	{"Maps Person Pin", "MapsPersonPin", "mapspersonpin", icons.MapsPersonPin},
-	{"Maps Person Pin Circle", "MapsPersonPinCircle", "mapspersonpincircle", icons.MapsPersonPinCircle},
-	{"Maps Pin Drop", "MapsPinDrop", "mapspindrop", icons.MapsPinDrop},
-	{"Maps Place", "MapsPlace", "mapsplace", icons.MapsPlace},
-	{"Maps Rate Review", "MapsRateReview", "mapsratereview", icons.MapsRateReview},
-	{"Maps Restaurant", "MapsRestaurant", "mapsrestaurant", icons.MapsRestaurant},
-	{"Maps Restaurant Menu", "MapsRestaurantMenu", "mapsrestaurantmenu", icons.MapsRestaurantMenu},
-	{"Maps Satellite", "MapsSatellite", "mapssatellite", icons.MapsSatellite},
-	{"Maps Store Mall Directory", "MapsStoreMallDirectory", "mapsstoremalldirectory", icons.MapsStoreMallDirectory},
-	{"Maps Street View", "MapsStreetView", "mapsstreetview", icons.MapsStreetView},
-	{"Maps Subway", "MapsSubway", "mapssubway", icons.MapsSubway},
-	{"Maps Terrain", "MapsTerrain", "mapsterrain", icons.MapsTerrain},
-	{"Maps Traffic", "MapsTraffic", "mapstraffic", icons.MapsTraffic},
-	{"Maps Train", "MapsTrain", "mapstrain", icons.MapsTrain},
-	{"Maps Tram", "MapsTram", "mapstram", icons.MapsTram},
-	{"Maps Transfer Within A Station", "MapsTransferWithinAStation", "mapstransferwithinastation", icons.MapsTransferWithinAStation},
-	{"Maps Zoom Out Map", "MapsZoomOutMap", "mapszoomoutmap", icons.MapsZoomOutMap},
-	{"Navigation Apps", "NavigationApps", "navigationapps", icons.NavigationApps},
-	{"Navigation Arrow Back", "NavigationArrowBack", "navigationarrowback", icons.NavigationArrowBack},
-	{"Navigation Arrow Downward", "NavigationArrowDownward", "navigationarrowdownward", icons.NavigationArrowDownward},
-	{"Navigation Arrow Drop Down", "NavigationArrowDropDown", "navigationarrowdropdown", icons.NavigationArrowDropDown},
-	{"Navigation Arrow Drop Down Circle", "NavigationArrowDropDownCircle", "navigationarrowdropdowncircle", icons.NavigationArrowDropDownCircle},
-	{"Navigation Arrow Drop Up", "NavigationArrowDropUp", "navigationarrowdropup", icons.NavigationArrowDropUp},
-	{"Navigation Arrow Forward", "NavigationArrowForward", "navigationarrowforward", icons.NavigationArrowForward},
-	{"Navigation Arrow Upward", "NavigationArrowUpward", "navigationarrowupward", icons.NavigationArrowUpward},
-	{"Navigation Cancel", "NavigationCancel", "navigationcancel", icons.NavigationCancel},
-	{"Navigation Check", "NavigationCheck", "navigationcheck", icons.NavigationCheck},
-	{"Navigation Chevron Left", "NavigationChevronLeft", "navigationchevronleft", icons.NavigationChevronLeft},
-	{"Navigation Chevron Right", "NavigationChevronRight", "navigationchevronright", icons.NavigationChevronRight},
-	{"Navigation Close", "NavigationClose", "navigationclose", icons.NavigationClose},
-	{"Navigation Expand Less", "NavigationExpandLess", "navigationexpandless", icons.NavigationExpandLess},
-	{"Navigation Expand More", "NavigationExpandMore", "navigationexpandmore", icons.NavigationExpandMore},
-	{"Navigation First Page", "NavigationFirstPage", "navigationfirstpage", icons.NavigationFirstPage},
-	{"Navigation Fullscreen", "NavigationFullscreen", "navigationfullscreen", icons.NavigationFullscreen},
-	{"Navigation Fullscreen Exit", "NavigationFullscreenExit", "navigationfullscreenexit", icons.NavigationFullscreenExit},
-	{"Navigation Last Page", "NavigationLastPage", "navigationlastpage", icons.NavigationLastPage},
-	{"Navigation Menu", "NavigationMenu", "navigationmenu", icons.NavigationMenu},
-	{"Navigation More Horiz", "NavigationMoreHoriz", "navigationmorehoriz", icons.NavigationMoreHoriz},
-	{"Navigation More Vert", "NavigationMoreVert", "navigationmorevert", icons.NavigationMoreVert},
-	{"Navigation Refresh", "NavigationRefresh", "navigationrefresh", icons.NavigationRefresh},
-	{"Navigation Subdirectory Arrow Left", "NavigationSubdirectoryArrowLeft", "navigationsubdirectoryarrowleft", icons.NavigationSubdirectoryArrowLeft},
-	{"Navigation Subdirectory Arrow Right", "NavigationSubdirectoryArrowRight", "navigationsubdirectoryarrowright", icons.NavigationSubdirectoryArrowRight},
-	{"Navigation Unfold Less", "NavigationUnfoldLess", "navigationunfoldless", icons.NavigationUnfoldLess},
-	{"Navigation Unfold More", "NavigationUnfoldMore", "navigationunfoldmore", icons.NavigationUnfoldMore},
-	{"Notification ADB", "NotificationADB", "notificationadb", icons.NotificationADB},
-	{"Notification Airline Seat Flat", "NotificationAirlineSeatFlat", "notificationairlineseatflat", icons.NotificationAirlineSeatFlat},
-	{"Notification Airline Seat Flat Angled", "NotificationAirlineSeatFlatAngled", "notificationairlineseatflatangled", icons.NotificationAirlineSeatFlatAngled},
-	{"Notification Airline Seat Individual Suite", "NotificationAirlineSeatIndividualSuite", "notificationairlineseatindividualsuite", icons.NotificationAirlineSeatIndividualSuite},
-	{"Notification Airline Seat Legroom Extra", "NotificationAirlineSeatLegroomExtra", "notificationairlineseatlegroomextra", icons.NotificationAirlineSeatLegroomExtra},
-	{"Notification Airline Seat Legroom Normal", "NotificationAirlineSeatLegroomNormal", "notificationairlineseatlegroomnormal", icons.NotificationAirlineSeatLegroomNormal},
-	{"Notification Airline Seat Legroom Reduced", "NotificationAirlineSeatLegroomReduced", "notificationairlineseatlegroomreduced", icons.NotificationAirlineSeatLegroomReduced},
-	{"Notification Airline Seat Recline Extra", "NotificationAirlineSeatReclineExtra", "notificationairlineseatreclineextra", icons.NotificationAirlineSeatReclineExtra},
-	{"Notification Airline Seat Recline Normal", "NotificationAirlineSeatReclineNormal", "notificationairlineseatreclinenormal", icons.NotificationAirlineSeatReclineNormal},
-	{"Notification Bluetooth Audio", "NotificationBluetoothAudio", "notificationbluetoothaudio", icons.NotificationBluetoothAudio},
-	{"Notification Confirmation Number", "NotificationConfirmationNumber", "notificationconfirmationnumber", icons.NotificationConfirmationNumber},
-	{"Notification Disc Full", "NotificationDiscFull", "notificationdiscfull", icons.NotificationDiscFull},
-	{"Notification Do Not Disturb", "NotificationDoNotDisturb", "notificationdonotdisturb", icons.NotificationDoNotDisturb},
-	{"Notification Do Not Disturb Alt", "NotificationDoNotDisturbAlt", "notificationdonotdisturbalt", icons.NotificationDoNotDisturbAlt},
-	{"Notification Do Not Disturb Off", "NotificationDoNotDisturbOff", "notificationdonotdisturboff", icons.NotificationDoNotDisturbOff},
-	{"Notification Do Not Disturb On", "NotificationDoNotDisturbOn", "notificationdonotdisturbon", icons.NotificationDoNotDisturbOn},
-	{"Notification Drive ETA", "NotificationDriveETA", "notificationdriveeta", icons.NotificationDriveETA},
-	{"Notification Enhanced Encryption", "NotificationEnhancedEncryption", "notificationenhancedencryption", icons.NotificationEnhancedEncryption},
-	{"Notification Event Available", "NotificationEventAvailable", "notificationeventavailable", icons.NotificationEventAvailable},
-	{"Notification Event Busy", "NotificationEventBusy", "notificationeventbusy", icons.NotificationEventBusy},
-	{"Notification Event Note", "NotificationEventNote", "notificationeventnote", icons.NotificationEventNote},
-	{"Notification Folder Special", "NotificationFolderSpecial", "notificationfolderspecial", icons.NotificationFolderSpecial},
-	{"Notification Live TV", "NotificationLiveTV", "notificationlivetv", icons.NotificationLiveTV},
-	{"Notification MMS", "NotificationMMS", "notificationmms", icons.NotificationMMS},
-	{"Notification More", "NotificationMore", "notificationmore", icons.NotificationMore},
-	{"Notification Network Check", "NotificationNetworkCheck", "notificationnetworkcheck", icons.NotificationNetworkCheck},
-	{"Notification Network Locked", "NotificationNetworkLocked", "notificationnetworklocked", icons.NotificationNetworkLocked},
-	{"Notification No Encryption", "NotificationNoEncryption", "notificationnoencryption", icons.NotificationNoEncryption},
-	{"Notification On Demand Video", "NotificationOnDemandVideo", "notificationondemandvideo", icons.NotificationOnDemandVideo},
-	{"Notification Personal Video", "NotificationPersonalVideo", "notificationpersonalvideo", icons.NotificationPersonalVideo},
-	{"Notification Phone Bluetooth Speaker", "NotificationPhoneBluetoothSpeaker", "notificationphonebluetoothspeaker", icons.NotificationPhoneBluetoothSpeaker},
-	{"Notification Phone Forwarded", "NotificationPhoneForwarded", "notificationphoneforwarded", icons.NotificationPhoneForwarded},
-	{"Notification Phone In Talk", "NotificationPhoneInTalk", "notificationphoneintalk", icons.NotificationPhoneInTalk},
-	{"Notification Phone Locked", "NotificationPhoneLocked", "notificationphonelocked", icons.NotificationPhoneLocked},
-	{"Notification Phone Missed", "NotificationPhoneMissed", "notificationphonemissed", icons.NotificationPhoneMissed},
-	{"Notification Phone Paused", "NotificationPhonePaused", "notificationphonepaused", icons.NotificationPhonePaused},
-	{"Notification Power", "NotificationPower", "notificationpower", icons.NotificationPower},
-	{"Notification Priority High", "NotificationPriorityHigh", "notificationpriorityhigh", icons.NotificationPriorityHigh},
-	{"Notification RV Hookup", "NotificationRVHookup", "notificationrvhookup", icons.NotificationRVHookup},
-	{"Notification SD Card", "NotificationSDCard", "notificationsdcard", icons.NotificationSDCard},
-	{"Notification SIM Card Alert", "NotificationSIMCardAlert", "notificationsimcardalert", icons.NotificationSIMCardAlert},
-	{"Notification SMS", "NotificationSMS", "notificationsms", icons.NotificationSMS},
-	{"Notification SMS Failed", "NotificationSMSFailed", "notificationsmsfailed", icons.NotificationSMSFailed},
-	{"Notification Sync", "NotificationSync", "notificationsync", icons.NotificationSync},
-	{"Notification Sync Disabled", "NotificationSyncDisabled", "notificationsyncdisabled", icons.NotificationSyncDisabled},
-	{"Notification Sync Problem", "NotificationSyncProblem", "notificationsyncproblem", icons.NotificationSyncProblem},
-	{"Notification System Update", "NotificationSystemUpdate", "notificationsystemupdate", icons.NotificationSystemUpdate},
-	{"Notification Tap And Play", "NotificationTapAndPlay", "notificationtapandplay", icons.NotificationTapAndPlay},
-	{"Notification Time To Leave", "NotificationTimeToLeave", "notificationtimetoleave", icons.NotificationTimeToLeave},
-	{"Notification VPN Lock", "NotificationVPNLock", "notificationvpnlock", icons.NotificationVPNLock},
-	{"Notification Vibration", "NotificationVibration", "notificationvibration", icons.NotificationVibration},
-	{"Notification Voice Chat", "NotificationVoiceChat", "notificationvoicechat", icons.NotificationVoiceChat},
-	{"Notification WC", "NotificationWC", "notificationwc", icons.NotificationWC},
-	{"Notification Wi Fi", "NotificationWiFi", "notificationwifi", icons.NotificationWiFi},
-	{"Places AC Unit", "PlacesACUnit", "placesacunit", icons.PlacesACUnit},
-	{"Places Airport Shuttle", "PlacesAirportShuttle", "placesairportshuttle", icons.PlacesAirportShuttle},
-	{"Places All Inclusive", "PlacesAllInclusive", "placesallinclusive", icons.PlacesAllInclusive},
-	{"Places Beach Access", "PlacesBeachAccess", "placesbeachaccess", icons.PlacesBeachAccess},
-	{"Places Business Center", "PlacesBusinessCenter", "placesbusinesscenter", icons.PlacesBusinessCenter},
-	{"Places Casino", "PlacesCasino", "placescasino", icons.PlacesCasino},
-	{"Places Child Care", "PlacesChildCare", "placeschildcare", icons.PlacesChildCare},
-	{"Places Child Friendly", "PlacesChildFriendly", "placeschildfriendly", icons.PlacesChildFriendly},
-	{"Places Fitness Center", "PlacesFitnessCenter", "placesfitnesscenter", icons.PlacesFitnessCenter},
-	{"Places Free Breakfast", "PlacesFreeBreakfast", "placesfreebreakfast", icons.PlacesFreeBreakfast},
-	{"Places Golf Course", "PlacesGolfCourse", "placesgolfcourse", icons.PlacesGolfCourse},
-	{"Places Hot Tub", "PlacesHotTub", "placeshottub", icons.PlacesHotTub},
-	{"Places Kitchen", "PlacesKitchen", "placeskitchen", icons.PlacesKitchen},
-	{"Places Pool", "PlacesPool", "placespool", icons.PlacesPool},
-	{"Places RV Hookup", "PlacesRVHookup", "placesrvhookup", icons.PlacesRVHookup},
-	{"Places Room Service", "PlacesRoomService", "placesroomservice", icons.PlacesRoomService},
-	{"Places Smoke Free", "PlacesSmokeFree", "placessmokefree", icons.PlacesSmokeFree},
-	{"Places Smoking Rooms", "PlacesSmokingRooms", "placessmokingrooms", icons.PlacesSmokingRooms},
-	{"Places Spa", "PlacesSpa", "placesspa", icons.PlacesSpa},
-	{"Social Cake", "SocialCake", "socialcake", icons.SocialCake},
-	{"Social Domain", "SocialDomain", "socialdomain", icons.SocialDomain},
-	{"Social Group", "SocialGroup", "socialgroup", icons.SocialGroup},
-	{"Social Group Add", "SocialGroupAdd", "socialgroupadd", icons.SocialGroupAdd},
-	{"Social Location City", "SocialLocationCity", "sociallocationcity", icons.SocialLocationCity},
-	{"Social Mood", "SocialMood", "socialmood", icons.SocialMood},
-	{"Social Mood Bad", "SocialMoodBad", "socialmoodbad", icons.SocialMoodBad},
-	{"Social Notifications", "SocialNotifications", "socialnotifications", icons.SocialNotifications},
-	{"Social Notifications Active", "SocialNotificationsActive", "socialnotificationsactive", icons.SocialNotificationsActive},
-	{"Social Notifications None", "SocialNotificationsNone", "socialnotificationsnone", icons.SocialNotificationsNone},
-	{"Social Notifications Off", "SocialNotificationsOff", "socialnotificationsoff", icons.SocialNotificationsOff},
-	{"Social Notifications Paused", "SocialNotificationsPaused", "socialnotificationspaused", icons.SocialNotificationsPaused},
-	{"Social Pages", "SocialPages", "socialpages", icons.SocialPages},
-	{"Social Party Mode", "SocialPartyMode", "socialpartymode", icons.SocialPartyMode},
-	{"Social People", "SocialPeople", "socialpeople", icons.SocialPeople},
-	{"Social People Outline", "SocialPeopleOutline", "socialpeopleoutline", icons.SocialPeopleOutline},
-	{"Social Person", "SocialPerson", "socialperson", icons.SocialPerson},
-	{"Social Person Add", "SocialPersonAdd", "socialpersonadd", icons.SocialPersonAdd},
-	{"Social Person Outline", "SocialPersonOutline", "socialpersonoutline", icons.SocialPersonOutline},
-	{"Social Plus One", "SocialPlusOne", "socialplusone", icons.SocialPlusOne},
-	{"Social Poll", "SocialPoll", "socialpoll", icons.SocialPoll},
-	{"Social Public", "SocialPublic", "socialpublic", icons.SocialPublic},
-	{"Social School", "SocialSchool", "socialschool", icons.SocialSchool},
-	{"Social Sentiment Dissatisfied", "SocialSentimentDissatisfied", "socialsentimentdissatisfied", icons.SocialSentimentDissatisfied},
-	{"Social Sentiment Neutral", "SocialSentimentNeutral", "socialsentimentneutral", icons.SocialSentimentNeutral},
-	{"Social Sentiment Satisfied", "SocialSentimentSatisfied", "socialsentimentsatisfied", icons.SocialSentimentSatisfied},
-	{"Social Sentiment Very Dissatisfied", "SocialSentimentVeryDissatisfied", "socialsentimentverydissatisfied", icons.SocialSentimentVeryDissatisfied},
-	{"Social Sentiment Very Satisfied", "SocialSentimentVerySatisfied", "socialsentimentverysatisfied", icons.SocialSentimentVerySatisfied},
-	{"Social Share", "SocialShare", "socialshare", icons.SocialShare},
-	{"Social Whats Hot", "SocialWhatsHot", "socialwhatshot", icons.SocialWhatsHot},
-	{"Toggle Check Box", "ToggleCheckBox", "togglecheckbox", icons.ToggleCheckBox},
-	{"Toggle Check Box Outline Blank", "ToggleCheckBoxOutlineBlank", "togglecheckboxoutlineblank", icons.ToggleCheckBoxOutlineBlank},
-	{"Toggle Indeterminate Check Box", "ToggleIndeterminateCheckBox", "toggleindeterminatecheckbox", icons.ToggleIndeterminateCheckBox},
-	{"Toggle Radio Button Checked", "ToggleRadioButtonChecked", "toggleradiobuttonchecked", icons.ToggleRadioButtonChecked},
-	{"Toggle Radio Button Unchecked", "ToggleRadioButtonUnchecked", "toggleradiobuttonunchecked", icons.ToggleRadioButtonUnchecked},
-	{"Toggle Star", "ToggleStar", "togglestar", icons.ToggleStar},
-	{"Toggle Star Border", "ToggleStarBorder", "togglestarborder", icons.ToggleStarBorder},
-	{"Toggle Star Half", "ToggleStarHalf", "togglestarhalf", icons.ToggleStarHalf},
-}
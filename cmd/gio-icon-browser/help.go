@@ -102,7 +102,7 @@ func (h *helpInfo) layout(gtx C, th *material.Theme) D {
 		{
 			lbl := material.H5(th, "Keyboard Shortcuts")
 			lbl.Font.Weight = font.Bold
-			btn := material.IconButton(th, &h.closeBtn, icons.ActionExitToApp, "")
+			btn := material.IconButton(th, &h.closeBtn, icons.ActionExitToApp.Widget(), "Close keyboard shortcuts")
 			btn.Inset = layout.UniformInset(4)
 			dims := layout.Flex{Alignment: layout.Middle}.Layout(gtx,
 				layout.Flexed(1, lbl.Layout),
@@ -1,5 +1,3 @@
-//go:generate go run cmd/gen/main.go
-
 package main
 
 import (
@@ -15,6 +13,7 @@ import (
 
 	"gio.tools/fonts/vegur"
 	"gio.tools/icons"
+	"gio.tools/icons/search"
 	"gioui.org/app"
 	"gioui.org/f32"
 	"gioui.org/font"
@@ -39,9 +38,12 @@ var (
 	printSearchTimes = flag.Bool("print-search-times", false, "Print out how long each search takes.")
 )
 
+// The icons of every registered set, in the order of icons.Sets.
 var (
-	allIndices  [numEntries]int
-	entryClicks [numEntries]clickState
+	allEntries  []iconEntry
+	allIndices  []int
+	entryClicks []clickState
+	searchIndex *search.Index
 )
 
 type clickState struct {
@@ -50,16 +52,42 @@ type clickState struct {
 }
 
 func init() {
-	for i := 0; i < numEntries; i++ {
+	var entries []icons.Entry
+	for _, set := range icons.Sets() {
+		pkg := set.Info().Package
+		for _, e := range set.Entries() {
+			if e.Icon == nil {
+				// There's nothing to show.
+				continue
+			}
+			ref := e.Name
+			if pkg != "" {
+				ref = pkg + "." + e.Name
+			}
+			var aka []string
+			for _, alias := range e.Aliases {
+				if other, ok := set.Lookup(alias); ok {
+					aka = append(aka, other.HumanName)
+				}
+			}
+			allEntries = append(allEntries, iconEntry{e.HumanName, ref, strings.Join(aka, ", "), e.Description, e.Icon})
+			entries = append(entries, e)
+		}
+	}
+	searchIndex = search.New(entries)
+	allIndices = make([]int, len(allEntries))
+	for i := range allIndices {
 		allIndices[i] = i
 	}
+	entryClicks = make([]clickState, len(allEntries))
 }
 
 type iconEntry struct {
-	name    string // The human readable name.
-	varName string // The actual variable name in the icons package.
-	key     string // The variable name, but all lowercase for search matching.
-	icon    *widget.Icon
+	name string // The human readable name.
+	ref  string // How to refer to the icon in Go code, e.g. "icons.ActionSearch".
+	aka  string // The human readable names of identical icons, if any.
+	desc string // The accessible description.
+	icon *icons.Icon
 }
 
 type iconBrowser struct {
@@ -74,6 +102,7 @@ type iconBrowser struct {
 	copyNotif       copyNotif
 	helpInfo        helpInfo
 	openHelpBtn     widget.Clickable
+	entryStyle      icons.StateStyle
 
 	textSize   unit.Sp
 	iconSize   image.Point
@@ -198,7 +227,7 @@ func (ib *iconBrowser) layout(gtx C) {
 		ib.helpInfo.state = helpInfoOpening
 	}
 	if ib.matchedIndices == nil {
-		ib.matchedIndices = allIndices[:]
+		ib.matchedIndices = allIndices
 	}
 	ib.ensure(gtx)
 	paint.Fill(gtx.Ops, ib.th.Bg)
@@ -262,7 +291,7 @@ func (ib *iconBrowser) layHeader(gtx C) D {
 	return layout.UniformInset(16).Layout(gtx, func(gtx C) D {
 		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return icons.ActionSearch.Layout(gtx, ib.th.Fg)
+				return icons.ActionSearch.LayoutDescribed(gtx, ib.th.Fg, "Search")
 			}),
 			layout.Rigid(layout.Spacer{Width: 16}.Layout),
 			layout.Flexed(1, searchEd.Layout),
@@ -270,7 +299,7 @@ func (ib *iconBrowser) layHeader(gtx C) D {
 			layout.Rigid(material.Caption(ib.th, " icons").Layout),
 			layout.Rigid(layout.Spacer{Width: 16}.Layout),
 			layout.Rigid(func(gtx C) D {
-				btn := material.IconButton(ib.th, &ib.openHelpBtn, icons.ActionHelpOutline, "")
+				btn := material.IconButton(ib.th, &ib.openHelpBtn, icons.ActionHelpOutline.Widget(), "Show keyboard shortcuts")
 				btn.Size = 28
 				btn.Inset = layout.UniformInset(2)
 				return btn.Layout(gtx)
@@ -320,10 +349,9 @@ func (ib *iconBrowser) layEntry(gtx C, index int) D {
 	}
 	if pressed {
 		click.lastPressAt = gtx.Now
-		varPath := "icons." + en.varName
-		gtx.Execute(clipboard.WriteCmd{Type: "application/text", Data: io.NopCloser(strings.NewReader(varPath))})
+		gtx.Execute(clipboard.WriteCmd{Type: "application/text", Data: io.NopCloser(strings.NewReader(en.ref))})
 		ib.copyNotif = copyNotif{
-			msg: varPath,
+			msg: en.ref,
 			at:  time.Now(),
 		}
 		gtx.Execute(op.InvalidateCmd{})
@@ -333,6 +361,16 @@ func (ib *iconBrowser) layEntry(gtx C, index int) D {
 		}()
 	}
 
+	// We animate click presses by scaling the entry down and back up over a certain time
+	// frame.
+	const animTimeFrame = 200
+	sinceLastPress := gtx.Now.Sub(click.lastPressAt).Milliseconds()
+	isAnimating := sinceLastPress < animTimeFrame
+	state := icons.State{
+		Hovered: click.Hovered(),
+		Pressed: click.Pressed() || isAnimating,
+	}
+
 	const inset = 10   // The outer inset that serves as space between entries.
 	const spacing = 15 // The space before and after each inner element of an entry.
 
@@ -355,7 +393,7 @@ func (ib *iconBrowser) layEntry(gtx C, index int) D {
 		offOp := op.Offset(image.Pt(x, spacing)).Push(gtx.Ops)
 		gtx1 := gtx
 		gtx1.Constraints.Max = ib.iconSize
-		iconDims := en.icon.Layout(gtx1, color.NRGBA{210, 210, 210, 255})
+		iconDims := en.icon.LayoutDescribed(gtx1, ib.entryStyle.IconColor(state), en.desc)
 		innerDims.Size.Y += iconDims.Size.Y + spacing
 		offOp.Pop()
 	}
@@ -369,13 +407,18 @@ func (ib *iconBrowser) layEntry(gtx C, index int) D {
 		innerDims.Size.Y += nameDims.Size.Y + spacing
 		offOp.Pop()
 	}
+	if en.aka != "" {
+		// List the other names of the same icon under its own.
+		offOp := op.Offset(image.Pt(0, innerDims.Size.Y-spacing/2)).Push(gtx.Ops)
+		aka := material.Caption(ib.th, "Also known as "+en.aka)
+		aka.Alignment = text.Middle
+		aka.Color.A = 0x99
+		akaDims := aka.Layout(gtx)
+		innerDims.Size.Y += akaDims.Size.Y + spacing/2
+		offOp.Pop()
+	}
 	drawEntry := m.Stop()
 
-	// We animate click presses by scaling the entry down and back up over a certain time
-	// frame.
-	const animTimeFrame = 200
-	sinceLastPress := gtx.Now.Sub(click.lastPressAt).Milliseconds()
-	isAnimating := sinceLastPress < animTimeFrame
 	if isAnimating {
 		const halfMillis = animTimeFrame / 2
 		// The scaling factor is some percentage between 70% - 100%, based on where we are
@@ -396,16 +439,9 @@ func (ib *iconBrowser) layEntry(gtx C, index int) D {
 		gtx.Execute(op.InvalidateCmd{})
 	}
 
-	rrOp := clip.UniformRRect(image.Rectangle{Max: innerDims.Size}, 6).Push(gtx.Ops)
-	if click.Hovered() || isAnimating {
-		paint.LinearGradientOp{
-			Stop1:  layout.FPt(image.Point{}),
-			Stop2:  layout.FPt(innerDims.Size),
-			Color1: color.NRGBA{32, 32, 32, 255},
-			Color2: color.NRGBA{65, 65, 65, 255},
-		}.Add(gtx.Ops)
-		paint.PaintOp{}.Add(gtx.Ops)
-	}
+	rr := clip.UniformRRect(image.Rectangle{Max: innerDims.Size}, 6)
+	rrOp := rr.Push(gtx.Ops)
+	ib.entryStyle.LayoutLayer(gtx, state, rr.Op(gtx.Ops))
 	click.Add(gtx.Ops)
 	drawEntry.Add(gtx.Ops)
 	rrOp.Pop()
@@ -431,16 +467,14 @@ func (ib *iconBrowser) runSearch() {
 				log.Println(time.Since(start))
 			}
 		}()
-		input := strings.ToLower(ib.searchInput.Text())
-		if input == "" {
+		input := ib.searchInput.Text()
+		if strings.TrimSpace(input) == "" {
 			return
 		}
-		resp.indices = make([]int, 0, len(allEntries)/3)
-		for i := range allEntries {
-			e := &allEntries[i]
-			if strings.Contains(e.key, input) || strings.Contains(strings.ToLower(e.name), input) {
-				resp.indices = append(resp.indices, i)
-			}
+		results := searchIndex.Search(input, 0)
+		resp.indices = make([]int, len(results))
+		for i, r := range results {
+			resp.indices[i] = r.Index
 		}
 	}()
 }
@@ -464,6 +498,7 @@ func run() error {
 	ib := iconBrowser{
 		win:             &win,
 		th:              th,
+		entryStyle:      icons.NewStateStyle(th),
 		searchResponses: make(chan searchResponse),
 		searchInput:     widget.Editor{SingleLine: true, Submit: true},
 		resultList:      widget.List{List: layout.List{Axis: layout.Vertical}},
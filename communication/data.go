@@ -0,0 +1,60 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package communication contains the icons in the Communication category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package communication
+
+import "gio.tools/icons"
+
+var (
+	Business             = icons.CommunicationBusiness
+	Call                 = icons.CommunicationCall
+	CallEnd              = icons.CommunicationCallEnd
+	CallMade             = icons.CommunicationCallMade
+	CallMerge            = icons.CommunicationCallMerge
+	CallMissed           = icons.CommunicationCallMissed
+	CallMissedOutgoing   = icons.CommunicationCallMissedOutgoing
+	CallReceived         = icons.CommunicationCallReceived
+	CallSplit            = icons.CommunicationCallSplit
+	Chat                 = icons.CommunicationChat
+	ChatBubble           = icons.CommunicationChatBubble
+	ChatBubbleOutline    = icons.CommunicationChatBubbleOutline
+	ClearAll             = icons.CommunicationClearAll
+	Comment              = icons.CommunicationComment
+	ContactMail          = icons.CommunicationContactMail
+	ContactPhone         = icons.CommunicationContactPhone
+	Contacts             = icons.CommunicationContacts
+	DialerSIP            = icons.CommunicationDialerSIP
+	Dialpad              = icons.CommunicationDialpad
+	Email                = icons.CommunicationEmail
+	Forum                = icons.CommunicationForum
+	ImportContacts       = icons.CommunicationImportContacts
+	ImportExport         = icons.CommunicationImportExport
+	InvertColorsOff      = icons.CommunicationInvertColorsOff
+	LiveHelp             = icons.CommunicationLiveHelp
+	LocationOff          = icons.CommunicationLocationOff
+	LocationOn           = icons.CommunicationLocationOn
+	MailOutline          = icons.CommunicationMailOutline
+	Message              = icons.CommunicationMessage
+	NoSIM                = icons.CommunicationNoSIM
+	Phone                = icons.CommunicationPhone
+	PhoneLinkErase       = icons.CommunicationPhoneLinkErase
+	PhoneLinkLock        = icons.CommunicationPhoneLinkLock
+	PhoneLinkRing        = icons.CommunicationPhoneLinkRing
+	PhoneLinkSetup       = icons.CommunicationPhoneLinkSetup
+	PortableWiFiOff      = icons.CommunicationPortableWiFiOff
+	PresentToAll         = icons.CommunicationPresentToAll
+	RSSFeed              = icons.CommunicationRSSFeed
+	RingVolume           = icons.CommunicationRingVolume
+	ScreenShare          = icons.CommunicationScreenShare
+	SpeakerPhone         = icons.CommunicationSpeakerPhone
+	StayCurrentLandscape = icons.CommunicationStayCurrentLandscape
+	StayCurrentPortrait  = icons.CommunicationStayCurrentPortrait
+	StayPrimaryLandscape = icons.CommunicationStayPrimaryLandscape
+	StayPrimaryPortrait  = icons.CommunicationStayPrimaryPortrait
+	StopScreenShare      = icons.CommunicationStopScreenShare
+	SwapCalls            = icons.CommunicationSwapCalls
+	TextSMS              = icons.CommunicationTextSMS
+	VPNKey               = icons.CommunicationVPNKey
+	Voicemail            = icons.CommunicationVoicemail
+)
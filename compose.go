@@ -0,0 +1,340 @@
+package icons
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"slices"
+	"strconv"
+
+	"gioui.org/f32"
+	"gioui.org/font"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// Layer is one icon in a Composite. Every measurement is a fraction of the composite's
+// size, so a composition looks the same at any size.
+type Layer struct {
+	Icon  *Icon
+	Color color.NRGBA
+	// Offset moves the layer's center away from the composite's center. For example,
+	// {0.25, 0.25} centers the layer in the bottom right quadrant.
+	Offset f32.Point
+	// Scale is the layer's size. The zero value is treated as 1.
+	Scale float32
+	// Cutout is the width of the gap that is knocked out of the layers beneath, around
+	// this layer's shape. The zero value knocks nothing out.
+	Cutout float32
+}
+
+// Badge is a dot or a number drawn in a corner of a Composite, such as an unread count on
+// a notification bell.
+type Badge struct {
+	// Count is the number shown in the badge. If it is zero or less, the badge is a small
+	// dot instead.
+	Count int
+	// Max is the highest count shown before it is displayed as "Max+". The zero value is
+	// treated as 99.
+	Max int
+	// Corner is the corner the badge is drawn in, following `layout.Direction`. Material
+	// Design places badges at `layout.NE`. In a right-to-left locale, east and west are
+	// swapped, so that a `layout.NE` badge is at the top left.
+	Corner layout.Direction
+	// Color is the badge's background. The zero value is Material's error red.
+	Color color.NRGBA
+	// TextColor is the colour of the count. The zero value is white.
+	TextColor color.NRGBA
+	// Cutout is the width of the gap knocked out around the badge, as a fraction of the
+	// composite's size.
+	Cutout float32
+	// Shaper is used to draw the count and must be set unless Count is zero or less.
+	Shaper *text.Shaper
+}
+
+// Composite draws several icons stacked on top of each other, in order, with an optional
+// badge on top. Layers are composited in software so that cutouts can erase the layers
+// beneath them, and the result is cached until the layers or size change. In a
+// right-to-left locale, directional layers are mirrored like `Icon.Layout` mirrors them;
+// their offsets are kept.
+type Composite struct {
+	Layers []Layer
+	Badge  *Badge
+
+	// Cached values.
+	op        paint.ImageOp
+	imgSize   int
+	imgLayers []Layer
+	imgCutout image.Rectangle
+	imgRTL    bool
+}
+
+// Layout displays the composite with its size set to the X minimum constraint, in the
+// same way as `Icon.Layout`.
+func (c *Composite) Layout(gtx layout.Context) layout.Dimensions {
+	size := iconSize(gtx)
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	rtl := gtx.Locale.Direction.Progression() == system.TowardOrigin
+
+	var (
+		badgeRect image.Rectangle
+		drawBadge op.CallOp
+	)
+	if c.Badge != nil {
+		badgeRect, drawBadge = c.Badge.record(gtx, size.X, rtl)
+	}
+	var cutout image.Rectangle
+	if c.Badge != nil && c.Badge.Cutout > 0 {
+		gap := int(c.Badge.Cutout*float32(size.X) + .5)
+		cutout = badgeRect.Inset(-gap)
+	}
+
+	ico := c.image(size.X, cutout, rtl)
+	ico.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	if c.Badge != nil {
+		drawBadge.Add(gtx.Ops)
+	}
+	return layout.Dimensions{Size: size}
+}
+
+func (c *Composite) image(sz int, cutout image.Rectangle, rtl bool) paint.ImageOp {
+	if sz == c.imgSize && cutout == c.imgCutout && rtl == c.imgRTL && layersEqual(c.Layers, c.imgLayers) {
+		return c.op
+	}
+	c.op = paint.NewImageOp(c.draw(sz, cutout, rtl))
+	c.imgSize = sz
+	c.imgCutout = cutout
+	c.imgRTL = rtl
+	c.imgLayers = append(c.imgLayers[:0], c.Layers...)
+	return c.op
+}
+
+// draw composites the layers into a new image that is sz pixels square.
+func (c *Composite) draw(sz int, cutout image.Rectangle, rtl bool) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, sz, sz))
+	for _, l := range c.Layers {
+		drawLayer(img, l, rtl && l.Icon.AutoMirror())
+	}
+	if !cutout.Empty() {
+		knockOutPill(img, cutout)
+	}
+	return img
+}
+
+func layersEqual(a, b []Layer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// drawLayer composites the layer onto dst, knocking out its cutout first. The layer's
+// icon is flipped horizontally if mirror is true.
+func drawLayer(dst *image.RGBA, l Layer, mirror bool) {
+	full := float32(dst.Bounds().Dx())
+	scale := l.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	sz := int(full*scale + .5)
+	if sz <= 0 {
+		return
+	}
+	mask, err := l.Icon.Alpha(sz)
+	if err != nil {
+		return
+	}
+	if mirror {
+		flipAlpha(mask)
+	}
+	center := image.Pt(int(full*(0.5+l.Offset.X)+.5), int(full*(0.5+l.Offset.Y)+.5))
+	r := mask.Bounds().Add(center.Sub(image.Pt(mask.Rect.Dx()/2, mask.Rect.Dy()/2)))
+
+	if l.Cutout > 0 {
+		gap := int(l.Cutout*full + .5)
+		knockOut(dst, dilate(mask, gap), r.Min.Sub(image.Pt(gap, gap)))
+	}
+	col := image.NewUniform(linearRGBA(l.Color))
+	draw.DrawMask(dst, r, col, image.Point{}, mask, image.Point{}, draw.Over)
+}
+
+// flipAlpha mirrors the mask horizontally in place.
+func flipAlpha(mask *image.Alpha) {
+	w := mask.Rect.Dx()
+	for y := 0; y < mask.Rect.Dy(); y++ {
+		row := mask.Pix[y*mask.Stride : y*mask.Stride+w]
+		slices.Reverse(row)
+	}
+}
+
+// dilate returns the mask grown by radius pixels in every direction. The returned mask is
+// larger than the original by radius on each side.
+func dilate(mask *image.Alpha, radius int) *image.Alpha {
+	b := mask.Bounds()
+	out := image.NewAlpha(image.Rect(0, 0, b.Dx()+radius*2, b.Dy()+radius*2))
+	r2 := radius * radius
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			a := mask.Pix[y*mask.Stride+x]
+			if a == 0 {
+				continue
+			}
+			for dy := -radius; dy <= radius; dy++ {
+				for dx := -radius; dx <= radius; dx++ {
+					if dx*dx+dy*dy > r2 {
+						continue
+					}
+					i := (y+radius+dy)*out.Stride + x + radius + dx
+					if out.Pix[i] < a {
+						out.Pix[i] = a
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// knockOut erases dst wherever the mask, placed at the given point, is opaque.
+func knockOut(dst *image.RGBA, mask *image.Alpha, at image.Point) {
+	r := mask.Bounds().Add(at).Intersect(dst.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			a := mask.Pix[(y-at.Y)*mask.Stride+x-at.X]
+			if a == 0 {
+				continue
+			}
+			keep := 0xff - uint32(a)
+			i := dst.PixOffset(x, y)
+			for j := 0; j < 4; j++ {
+				dst.Pix[i+j] = uint8(uint32(dst.Pix[i+j]) * keep / 0xff)
+			}
+		}
+	}
+}
+
+// knockOutPill erases a rectangle with fully rounded ends from dst.
+func knockOutPill(dst *image.RGBA, r image.Rectangle) {
+	mask := image.NewAlpha(image.Rect(0, 0, r.Dx(), r.Dy()))
+	rad := float64(min(r.Dx(), r.Dy())) / 2
+	for y := 0; y < r.Dy(); y++ {
+		for x := 0; x < r.Dx(); x++ {
+			// Distance from the pill's central segment, sampled at the pixel center.
+			px, py := float64(x)+.5, float64(y)+.5
+			cx := math.Max(rad, math.Min(px, float64(r.Dx())-rad))
+			cy := math.Max(rad, math.Min(py, float64(r.Dy())-rad))
+			d := math.Hypot(px-cx, py-cy)
+			cov := math.Max(0, math.Min(1, rad-d+.5))
+			mask.Pix[y*mask.Stride+x] = uint8(cov*0xff + .5)
+		}
+	}
+	knockOut(dst, mask, r.Min)
+}
+
+// record lays out the badge into a macro for a composite that is size pixels wide,
+// returning where it was placed. If rtl is true, the badge's corner is mirrored.
+func (b *Badge) record(gtx layout.Context, size int, rtl bool) (image.Rectangle, op.CallOp) {
+	bg := b.Color
+	if bg == (color.NRGBA{}) {
+		bg = color.NRGBA{R: 0xb3, G: 0x26, B: 0x1e, A: 0xff}
+	}
+	fg := b.TextColor
+	if fg == (color.NRGBA{}) {
+		fg = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+
+	m := op.Record(gtx.Ops)
+	var pill image.Point
+	if b.Count <= 0 || b.Shaper == nil {
+		d := max(1, int(float32(size)*0.25+.5))
+		pill = image.Pt(d, d)
+		paint.FillShape(gtx.Ops, bg, clip.Ellipse{Max: pill}.Op(gtx.Ops))
+	} else {
+		h := max(1, int(float32(size)*0.6+.5))
+		txt := badgeText(b.Count, b.Max)
+
+		cm := op.Record(gtx.Ops)
+		paint.ColorOp{Color: fg}.Add(gtx.Ops)
+		textColor := cm.Stop()
+		lm := op.Record(gtx.Ops)
+		gtx1 := gtx
+		gtx1.Constraints = layout.Constraints{Max: image.Pt(size*4, h)}
+		textSize := unit.Sp(float32(h) * 0.75 / gtx.Metric.PxPerSp)
+		lbl := widget.Label{MaxLines: 1}
+		dims := lbl.Layout(gtx1, b.Shaper, font.Font{Weight: font.Bold}, textSize, txt, textColor)
+		drawText := lm.Stop()
+
+		pill = image.Pt(max(h, dims.Size.X+h/2), h)
+		paint.FillShape(gtx.Ops, bg, clip.UniformRRect(image.Rectangle{Max: pill}, h/2).Op(gtx.Ops))
+		textOff := op.Offset(image.Pt((pill.X-dims.Size.X)/2, (pill.Y-dims.Size.Y)/2)).Push(gtx.Ops)
+		drawText.Add(gtx.Ops)
+		textOff.Pop()
+	}
+	call := m.Stop()
+
+	// Place the badge in its corner, then wrap the macro in that offset.
+	corner := b.Corner
+	if rtl {
+		corner = mirrorDirection(corner)
+	}
+	var at image.Point
+	switch corner {
+	case layout.N, layout.S, layout.Center:
+		at.X = (size - pill.X) / 2
+	case layout.NE, layout.E, layout.SE:
+		at.X = size - pill.X
+	}
+	switch corner {
+	case layout.W, layout.E, layout.Center:
+		at.Y = (size - pill.Y) / 2
+	case layout.SW, layout.S, layout.SE:
+		at.Y = size - pill.Y
+	}
+	m = op.Record(gtx.Ops)
+	off := op.Offset(at).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	off.Pop()
+	return image.Rectangle{Min: at, Max: at.Add(pill)}, m.Stop()
+}
+
+// mirrorDirection swaps east and west in d.
+func mirrorDirection(d layout.Direction) layout.Direction {
+	switch d {
+	case layout.NE:
+		return layout.NW
+	case layout.E:
+		return layout.W
+	case layout.SE:
+		return layout.SW
+	case layout.NW:
+		return layout.NE
+	case layout.W:
+		return layout.E
+	case layout.SW:
+		return layout.SE
+	}
+	return d
+}
+
+func badgeText(count, maxCount int) string {
+	if maxCount <= 0 {
+		maxCount = 99
+	}
+	if count > maxCount {
+		return strconv.Itoa(maxCount) + "+"
+	}
+	return strconv.Itoa(count)
+}
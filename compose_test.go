@@ -0,0 +1,51 @@
+package icons
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"gioui.org/layout"
+)
+
+// TestCompositeRTL checks that only directional layers are mirrored in a right-to-left
+// locale.
+func TestCompositeRTL(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		ic     *Icon
+		mirror bool
+	}{
+		{"NavigationArrowBack", NavigationArrowBack, true},
+		{"ActionSearch", ActionSearch, false},
+	} {
+		comp := Composite{Layers: []Layer{{Icon: c.ic, Color: color.NRGBA{A: 0xff}}}}
+		ltr := comp.draw(24, image.Rectangle{}, false)
+		rtl := comp.draw(24, image.Rectangle{}, true)
+		want := ltr
+		if c.mirror {
+			want = flipRGBA(ltr)
+		}
+		if !equalRGBA(rtl, want) {
+			t.Errorf("%s: mirrored is %v, want %v", c.name, !c.mirror, c.mirror)
+		}
+	}
+	if got := mirrorDirection(layout.NE); got != layout.NW {
+		t.Errorf("mirrorDirection(NE) = %v, want NW", got)
+	}
+}
+
+func flipRGBA(img *image.RGBA) *image.RGBA {
+	b := img.Bounds()
+	out := image.NewRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			out.Set(b.Max.X-1-x+b.Min.X, y, img.At(x, y))
+		}
+	}
+	return out
+}
+
+func equalRGBA(a, b *image.RGBA) bool {
+	return a.Bounds() == b.Bounds() && string(a.Pix) == string(b.Pix)
+}
@@ -0,0 +1,51 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package content contains the icons in the Content category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package content
+
+import "gio.tools/icons"
+
+var (
+	Add                 = icons.ContentAdd
+	AddBox              = icons.ContentAddBox
+	AddCircle           = icons.ContentAddCircle
+	AddCircleOutline    = icons.ContentAddCircleOutline
+	Archive             = icons.ContentArchive
+	Backspace           = icons.ContentBackspace
+	Block               = icons.ContentBlock
+	Clear               = icons.ContentClear
+	ContentCopy         = icons.ContentContentCopy
+	ContentCut          = icons.ContentContentCut
+	ContentPaste        = icons.ContentContentPaste
+	Create              = icons.ContentCreate
+	DeleteSweep         = icons.ContentDeleteSweep
+	Drafts              = icons.ContentDrafts
+	FilterList          = icons.ContentFilterList
+	Flag                = icons.ContentFlag
+	FontDownload        = icons.ContentFontDownload
+	Forward             = icons.ContentForward
+	Gesture             = icons.ContentGesture
+	Inbox               = icons.ContentInbox
+	Link                = icons.ContentLink
+	LowPriority         = icons.ContentLowPriority
+	Mail                = icons.ContentMail
+	MarkUnread          = icons.ContentMarkUnread
+	MoveToInbox         = icons.ContentMoveToInbox
+	NextWeek            = icons.ContentNextWeek
+	Redo                = icons.ContentRedo
+	Remove              = icons.ContentRemove
+	RemoveCircle        = icons.ContentRemoveCircle
+	RemoveCircleOutline = icons.ContentRemoveCircleOutline
+	Reply               = icons.ContentReply
+	ReplyAll            = icons.ContentReplyAll
+	Report              = icons.ContentReport
+	Save                = icons.ContentSave
+	SelectAll           = icons.ContentSelectAll
+	Send                = icons.ContentSend
+	Sort                = icons.ContentSort
+	TextFormat          = icons.ContentTextFormat
+	Unarchive           = icons.ContentUnarchive
+	Undo                = icons.ContentUndo
+	Weekend             = icons.ContentWeekend
+)
@@ -1,969 +1,1933 @@
-// generated by go run cmd/gen/main.go. DO NOT EDIT
+// generated by go run ./cmd/gen. DO NOT EDIT
 
 package icons
 
 import "golang.org/x/exp/shiny/materialdesign/icons"
 
 var (
-	AVAVTimer                                   = mi(icons.AVAVTimer)
-	AVAddToQueue                                = mi(icons.AVAddToQueue)
-	AVAirplay                                   = mi(icons.AVAirplay)
-	AVAlbum                                     = mi(icons.AVAlbum)
-	AVArtTrack                                  = mi(icons.AVArtTrack)
-	AVBrandingWatermark                         = mi(icons.AVBrandingWatermark)
-	AVCallToAction                              = mi(icons.AVCallToAction)
-	AVClosedCaption                             = mi(icons.AVClosedCaption)
-	AVEqualizer                                 = mi(icons.AVEqualizer)
-	AVExplicit                                  = mi(icons.AVExplicit)
-	AVFastForward                               = mi(icons.AVFastForward)
-	AVFastRewind                                = mi(icons.AVFastRewind)
-	AVFeaturedPlayList                          = mi(icons.AVFeaturedPlayList)
-	AVFeaturedVideo                             = mi(icons.AVFeaturedVideo)
-	AVFiberDVR                                  = mi(icons.AVFiberDVR)
-	AVFiberManualRecord                         = mi(icons.AVFiberManualRecord)
-	AVFiberNew                                  = mi(icons.AVFiberNew)
-	AVFiberPin                                  = mi(icons.AVFiberPin)
-	AVFiberSmartRecord                          = mi(icons.AVFiberSmartRecord)
-	AVForward10                                 = mi(icons.AVForward10)
-	AVForward30                                 = mi(icons.AVForward30)
-	AVForward5                                  = mi(icons.AVForward5)
-	AVGames                                     = mi(icons.AVGames)
-	AVHD                                        = mi(icons.AVHD)
-	AVHearing                                   = mi(icons.AVHearing)
-	AVHighQuality                               = mi(icons.AVHighQuality)
-	AVLibraryAdd                                = mi(icons.AVLibraryAdd)
-	AVLibraryBooks                              = mi(icons.AVLibraryBooks)
-	AVLibraryMusic                              = mi(icons.AVLibraryMusic)
-	AVLoop                                      = mi(icons.AVLoop)
-	AVMic                                       = mi(icons.AVMic)
-	AVMicNone                                   = mi(icons.AVMicNone)
-	AVMicOff                                    = mi(icons.AVMicOff)
-	AVMovie                                     = mi(icons.AVMovie)
-	AVMusicVideo                                = mi(icons.AVMusicVideo)
-	AVNewReleases                               = mi(icons.AVNewReleases)
-	AVNotInterested                             = mi(icons.AVNotInterested)
-	AVNote                                      = mi(icons.AVNote)
-	AVPause                                     = mi(icons.AVPause)
-	AVPauseCircleFilled                         = mi(icons.AVPauseCircleFilled)
-	AVPauseCircleOutline                        = mi(icons.AVPauseCircleOutline)
-	AVPlayArrow                                 = mi(icons.AVPlayArrow)
-	AVPlayCircleFilled                          = mi(icons.AVPlayCircleFilled)
-	AVPlayCircleOutline                         = mi(icons.AVPlayCircleOutline)
-	AVPlaylistAdd                               = mi(icons.AVPlaylistAdd)
-	AVPlaylistAddCheck                          = mi(icons.AVPlaylistAddCheck)
-	AVPlaylistPlay                              = mi(icons.AVPlaylistPlay)
-	AVQueue                                     = mi(icons.AVQueue)
-	AVQueueMusic                                = mi(icons.AVQueueMusic)
-	AVQueuePlayNext                             = mi(icons.AVQueuePlayNext)
-	AVRadio                                     = mi(icons.AVRadio)
-	AVRecentActors                              = mi(icons.AVRecentActors)
-	AVRemoveFromQueue                           = mi(icons.AVRemoveFromQueue)
-	AVRepeat                                    = mi(icons.AVRepeat)
-	AVRepeatOne                                 = mi(icons.AVRepeatOne)
-	AVReplay                                    = mi(icons.AVReplay)
-	AVReplay10                                  = mi(icons.AVReplay10)
-	AVReplay30                                  = mi(icons.AVReplay30)
-	AVReplay5                                   = mi(icons.AVReplay5)
-	AVShuffle                                   = mi(icons.AVShuffle)
-	AVSkipNext                                  = mi(icons.AVSkipNext)
-	AVSkipPrevious                              = mi(icons.AVSkipPrevious)
-	AVSlowMotionVideo                           = mi(icons.AVSlowMotionVideo)
-	AVSnooze                                    = mi(icons.AVSnooze)
-	AVSortByAlpha                               = mi(icons.AVSortByAlpha)
-	AVStop                                      = mi(icons.AVStop)
-	AVSubscriptions                             = mi(icons.AVSubscriptions)
-	AVSubtitles                                 = mi(icons.AVSubtitles)
-	AVSurroundSound                             = mi(icons.AVSurroundSound)
-	AVVideoCall                                 = mi(icons.AVVideoCall)
-	AVVideoLabel                                = mi(icons.AVVideoLabel)
-	AVVideoLibrary                              = mi(icons.AVVideoLibrary)
-	AVVideocam                                  = mi(icons.AVVideocam)
-	AVVideocamOff                               = mi(icons.AVVideocamOff)
-	AVVolumeDown                                = mi(icons.AVVolumeDown)
-	AVVolumeMute                                = mi(icons.AVVolumeMute)
-	AVVolumeOff                                 = mi(icons.AVVolumeOff)
-	AVVolumeUp                                  = mi(icons.AVVolumeUp)
-	AVWeb                                       = mi(icons.AVWeb)
-	AVWebAsset                                  = mi(icons.AVWebAsset)
-	Action3DRotation                            = mi(icons.Action3DRotation)
-	ActionAccessibility                         = mi(icons.ActionAccessibility)
-	ActionAccessible                            = mi(icons.ActionAccessible)
-	ActionAccountBalance                        = mi(icons.ActionAccountBalance)
-	ActionAccountBalanceWallet                  = mi(icons.ActionAccountBalanceWallet)
-	ActionAccountBox                            = mi(icons.ActionAccountBox)
-	ActionAccountCircle                         = mi(icons.ActionAccountCircle)
-	ActionAddShoppingCart                       = mi(icons.ActionAddShoppingCart)
-	ActionAlarm                                 = mi(icons.ActionAlarm)
-	ActionAlarmAdd                              = mi(icons.ActionAlarmAdd)
-	ActionAlarmOff                              = mi(icons.ActionAlarmOff)
-	ActionAlarmOn                               = mi(icons.ActionAlarmOn)
-	ActionAllOut                                = mi(icons.ActionAllOut)
-	ActionAndroid                               = mi(icons.ActionAndroid)
-	ActionAnnouncement                          = mi(icons.ActionAnnouncement)
-	ActionAspectRatio                           = mi(icons.ActionAspectRatio)
-	ActionAssessment                            = mi(icons.ActionAssessment)
-	ActionAssignment                            = mi(icons.ActionAssignment)
-	ActionAssignmentInd                         = mi(icons.ActionAssignmentInd)
-	ActionAssignmentLate                        = mi(icons.ActionAssignmentLate)
-	ActionAssignmentReturn                      = mi(icons.ActionAssignmentReturn)
-	ActionAssignmentReturned                    = mi(icons.ActionAssignmentReturned)
-	ActionAssignmentTurnedIn                    = mi(icons.ActionAssignmentTurnedIn)
-	ActionAutorenew                             = mi(icons.ActionAutorenew)
-	ActionBackup                                = mi(icons.ActionBackup)
-	ActionBook                                  = mi(icons.ActionBook)
-	ActionBookmark                              = mi(icons.ActionBookmark)
-	ActionBookmarkBorder                        = mi(icons.ActionBookmarkBorder)
-	ActionBugReport                             = mi(icons.ActionBugReport)
-	ActionBuild                                 = mi(icons.ActionBuild)
-	ActionCached                                = mi(icons.ActionCached)
-	ActionCameraEnhance                         = mi(icons.ActionCameraEnhance)
-	ActionCardGiftcard                          = mi(icons.ActionCardGiftcard)
-	ActionCardMembership                        = mi(icons.ActionCardMembership)
-	ActionCardTravel                            = mi(icons.ActionCardTravel)
-	ActionChangeHistory                         = mi(icons.ActionChangeHistory)
-	ActionCheckCircle                           = mi(icons.ActionCheckCircle)
-	ActionChromeReaderMode                      = mi(icons.ActionChromeReaderMode)
-	ActionClass                                 = mi(icons.ActionClass)
-	ActionCode                                  = mi(icons.ActionCode)
-	ActionCompareArrows                         = mi(icons.ActionCompareArrows)
-	ActionCopyright                             = mi(icons.ActionCopyright)
-	ActionCreditCard                            = mi(icons.ActionCreditCard)
-	ActionDNS                                   = mi(icons.ActionDNS)
-	ActionDashboard                             = mi(icons.ActionDashboard)
-	ActionDateRange                             = mi(icons.ActionDateRange)
-	ActionDelete                                = mi(icons.ActionDelete)
-	ActionDeleteForever                         = mi(icons.ActionDeleteForever)
-	ActionDescription                           = mi(icons.ActionDescription)
-	ActionDone                                  = mi(icons.ActionDone)
-	ActionDoneAll                               = mi(icons.ActionDoneAll)
-	ActionDonutLarge                            = mi(icons.ActionDonutLarge)
-	ActionDonutSmall                            = mi(icons.ActionDonutSmall)
-	ActionEject                                 = mi(icons.ActionEject)
-	ActionEuroSymbol                            = mi(icons.ActionEuroSymbol)
-	ActionEvent                                 = mi(icons.ActionEvent)
-	ActionEventSeat                             = mi(icons.ActionEventSeat)
-	ActionExitToApp                             = mi(icons.ActionExitToApp)
-	ActionExplore                               = mi(icons.ActionExplore)
-	ActionExtension                             = mi(icons.ActionExtension)
-	ActionFace                                  = mi(icons.ActionFace)
-	ActionFavorite                              = mi(icons.ActionFavorite)
-	ActionFavoriteBorder                        = mi(icons.ActionFavoriteBorder)
-	ActionFeedback                              = mi(icons.ActionFeedback)
-	ActionFindInPage                            = mi(icons.ActionFindInPage)
-	ActionFindReplace                           = mi(icons.ActionFindReplace)
-	ActionFingerprint                           = mi(icons.ActionFingerprint)
-	ActionFlightLand                            = mi(icons.ActionFlightLand)
-	ActionFlightTakeoff                         = mi(icons.ActionFlightTakeoff)
-	ActionFlipToBack                            = mi(icons.ActionFlipToBack)
-	ActionFlipToFront                           = mi(icons.ActionFlipToFront)
-	ActionGIF                                   = mi(icons.ActionGIF)
-	ActionGTranslate                            = mi(icons.ActionGTranslate)
-	ActionGavel                                 = mi(icons.ActionGavel)
-	ActionGetApp                                = mi(icons.ActionGetApp)
-	ActionGrade                                 = mi(icons.ActionGrade)
-	ActionGroupWork                             = mi(icons.ActionGroupWork)
-	ActionHTTP                                  = mi(icons.ActionHTTP)
-	ActionHTTPS                                 = mi(icons.ActionHTTPS)
-	ActionHelp                                  = mi(icons.ActionHelp)
-	ActionHelpOutline                           = mi(icons.ActionHelpOutline)
-	ActionHighlightOff                          = mi(icons.ActionHighlightOff)
-	ActionHistory                               = mi(icons.ActionHistory)
-	ActionHome                                  = mi(icons.ActionHome)
-	ActionHourglassEmpty                        = mi(icons.ActionHourglassEmpty)
-	ActionHourglassFull                         = mi(icons.ActionHourglassFull)
-	ActionImportantDevices                      = mi(icons.ActionImportantDevices)
-	ActionInfo                                  = mi(icons.ActionInfo)
-	ActionInfoOutline                           = mi(icons.ActionInfoOutline)
-	ActionInput                                 = mi(icons.ActionInput)
-	ActionInvertColors                          = mi(icons.ActionInvertColors)
-	ActionLabel                                 = mi(icons.ActionLabel)
-	ActionLabelOutline                          = mi(icons.ActionLabelOutline)
-	ActionLanguage                              = mi(icons.ActionLanguage)
-	ActionLaunch                                = mi(icons.ActionLaunch)
-	ActionLightbulbOutline                      = mi(icons.ActionLightbulbOutline)
-	ActionLineStyle                             = mi(icons.ActionLineStyle)
-	ActionLineWeight                            = mi(icons.ActionLineWeight)
-	ActionList                                  = mi(icons.ActionList)
-	ActionLock                                  = mi(icons.ActionLock)
-	ActionLockOpen                              = mi(icons.ActionLockOpen)
-	ActionLockOutline                           = mi(icons.ActionLockOutline)
-	ActionLoyalty                               = mi(icons.ActionLoyalty)
-	ActionMarkUnreadMailbox                     = mi(icons.ActionMarkUnreadMailbox)
-	ActionMotorcycle                            = mi(icons.ActionMotorcycle)
-	ActionNoteAdd                               = mi(icons.ActionNoteAdd)
-	ActionOfflinePin                            = mi(icons.ActionOfflinePin)
-	ActionOpacity                               = mi(icons.ActionOpacity)
-	ActionOpenInBrowser                         = mi(icons.ActionOpenInBrowser)
-	ActionOpenInNew                             = mi(icons.ActionOpenInNew)
-	ActionOpenWith                              = mi(icons.ActionOpenWith)
-	ActionPageview                              = mi(icons.ActionPageview)
-	ActionPanTool                               = mi(icons.ActionPanTool)
-	ActionPayment                               = mi(icons.ActionPayment)
-	ActionPermCameraMic                         = mi(icons.ActionPermCameraMic)
-	ActionPermContactCalendar                   = mi(icons.ActionPermContactCalendar)
-	ActionPermDataSetting                       = mi(icons.ActionPermDataSetting)
-	ActionPermDeviceInformation                 = mi(icons.ActionPermDeviceInformation)
-	ActionPermIdentity                          = mi(icons.ActionPermIdentity)
-	ActionPermMedia                             = mi(icons.ActionPermMedia)
-	ActionPermPhoneMsg                          = mi(icons.ActionPermPhoneMsg)
-	ActionPermScanWiFi                          = mi(icons.ActionPermScanWiFi)
-	ActionPets                                  = mi(icons.ActionPets)
-	ActionPictureInPicture                      = mi(icons.ActionPictureInPicture)
-	ActionPictureInPictureAlt                   = mi(icons.ActionPictureInPictureAlt)
-	ActionPlayForWork                           = mi(icons.ActionPlayForWork)
-	ActionPolymer                               = mi(icons.ActionPolymer)
-	ActionPowerSettingsNew                      = mi(icons.ActionPowerSettingsNew)
-	ActionPregnantWoman                         = mi(icons.ActionPregnantWoman)
-	ActionPrint                                 = mi(icons.ActionPrint)
-	ActionQueryBuilder                          = mi(icons.ActionQueryBuilder)
-	ActionQuestionAnswer                        = mi(icons.ActionQuestionAnswer)
-	ActionReceipt                               = mi(icons.ActionReceipt)
-	ActionRecordVoiceOver                       = mi(icons.ActionRecordVoiceOver)
-	ActionRedeem                                = mi(icons.ActionRedeem)
-	ActionRemoveShoppingCart                    = mi(icons.ActionRemoveShoppingCart)
-	ActionReorder                               = mi(icons.ActionReorder)
-	ActionReportProblem                         = mi(icons.ActionReportProblem)
-	ActionRestore                               = mi(icons.ActionRestore)
-	ActionRestorePage                           = mi(icons.ActionRestorePage)
-	ActionRoom                                  = mi(icons.ActionRoom)
-	ActionRoundedCorner                         = mi(icons.ActionRoundedCorner)
-	ActionRowing                                = mi(icons.ActionRowing)
-	ActionSchedule                              = mi(icons.ActionSchedule)
-	ActionSearch                                = mi(icons.ActionSearch)
-	ActionSettings                              = mi(icons.ActionSettings)
-	ActionSettingsApplications                  = mi(icons.ActionSettingsApplications)
-	ActionSettingsBackupRestore                 = mi(icons.ActionSettingsBackupRestore)
-	ActionSettingsBluetooth                     = mi(icons.ActionSettingsBluetooth)
-	ActionSettingsBrightness                    = mi(icons.ActionSettingsBrightness)
-	ActionSettingsCell                          = mi(icons.ActionSettingsCell)
-	ActionSettingsEthernet                      = mi(icons.ActionSettingsEthernet)
-	ActionSettingsInputAntenna                  = mi(icons.ActionSettingsInputAntenna)
-	ActionSettingsInputComponent                = mi(icons.ActionSettingsInputComponent)
-	ActionSettingsInputComposite                = mi(icons.ActionSettingsInputComposite)
-	ActionSettingsInputHDMI                     = mi(icons.ActionSettingsInputHDMI)
-	ActionSettingsInputSVideo                   = mi(icons.ActionSettingsInputSVideo)
-	ActionSettingsOverscan                      = mi(icons.ActionSettingsOverscan)
-	ActionSettingsPhone                         = mi(icons.ActionSettingsPhone)
-	ActionSettingsPower                         = mi(icons.ActionSettingsPower)
-	ActionSettingsRemote                        = mi(icons.ActionSettingsRemote)
-	ActionSettingsVoice                         = mi(icons.ActionSettingsVoice)
-	ActionShop                                  = mi(icons.ActionShop)
-	ActionShopTwo                               = mi(icons.ActionShopTwo)
-	ActionShoppingBasket                        = mi(icons.ActionShoppingBasket)
-	ActionShoppingCart                          = mi(icons.ActionShoppingCart)
-	ActionSpeakerNotes                          = mi(icons.ActionSpeakerNotes)
-	ActionSpeakerNotesOff                       = mi(icons.ActionSpeakerNotesOff)
-	ActionSpellcheck                            = mi(icons.ActionSpellcheck)
-	ActionStarRate                              = mi(icons.ActionStarRate)
-	ActionStars                                 = mi(icons.ActionStars)
-	ActionStore                                 = mi(icons.ActionStore)
-	ActionSubject                               = mi(icons.ActionSubject)
-	ActionSupervisorAccount                     = mi(icons.ActionSupervisorAccount)
-	ActionSwapHoriz                             = mi(icons.ActionSwapHoriz)
-	ActionSwapVert                              = mi(icons.ActionSwapVert)
-	ActionSwapVerticalCircle                    = mi(icons.ActionSwapVerticalCircle)
-	ActionSystemUpdateAlt                       = mi(icons.ActionSystemUpdateAlt)
-	ActionTOC                                   = mi(icons.ActionTOC)
-	ActionTab                                   = mi(icons.ActionTab)
-	ActionTabUnselected                         = mi(icons.ActionTabUnselected)
-	ActionTheaters                              = mi(icons.ActionTheaters)
-	ActionThumbDown                             = mi(icons.ActionThumbDown)
-	ActionThumbUp                               = mi(icons.ActionThumbUp)
-	ActionThumbsUpDown                          = mi(icons.ActionThumbsUpDown)
-	ActionTimeline                              = mi(icons.ActionTimeline)
-	ActionToday                                 = mi(icons.ActionToday)
-	ActionToll                                  = mi(icons.ActionToll)
-	ActionTouchApp                              = mi(icons.ActionTouchApp)
-	ActionTrackChanges                          = mi(icons.ActionTrackChanges)
-	ActionTranslate                             = mi(icons.ActionTranslate)
-	ActionTrendingDown                          = mi(icons.ActionTrendingDown)
-	ActionTrendingFlat                          = mi(icons.ActionTrendingFlat)
-	ActionTrendingUp                            = mi(icons.ActionTrendingUp)
-	ActionTurnedIn                              = mi(icons.ActionTurnedIn)
-	ActionTurnedInNot                           = mi(icons.ActionTurnedInNot)
-	ActionUpdate                                = mi(icons.ActionUpdate)
-	ActionVerifiedUser                          = mi(icons.ActionVerifiedUser)
-	ActionViewAgenda                            = mi(icons.ActionViewAgenda)
-	ActionViewArray                             = mi(icons.ActionViewArray)
-	ActionViewCarousel                          = mi(icons.ActionViewCarousel)
-	ActionViewColumn                            = mi(icons.ActionViewColumn)
-	ActionViewDay                               = mi(icons.ActionViewDay)
-	ActionViewHeadline                          = mi(icons.ActionViewHeadline)
-	ActionViewList                              = mi(icons.ActionViewList)
-	ActionViewModule                            = mi(icons.ActionViewModule)
-	ActionViewQuilt                             = mi(icons.ActionViewQuilt)
-	ActionViewStream                            = mi(icons.ActionViewStream)
-	ActionViewWeek                              = mi(icons.ActionViewWeek)
-	ActionVisibility                            = mi(icons.ActionVisibility)
-	ActionVisibilityOff                         = mi(icons.ActionVisibilityOff)
-	ActionWatchLater                            = mi(icons.ActionWatchLater)
-	ActionWork                                  = mi(icons.ActionWork)
-	ActionYoutubeSearchedFor                    = mi(icons.ActionYoutubeSearchedFor)
-	ActionZoomIn                                = mi(icons.ActionZoomIn)
-	ActionZoomOut                               = mi(icons.ActionZoomOut)
-	AlertAddAlert                               = mi(icons.AlertAddAlert)
-	AlertError                                  = mi(icons.AlertError)
-	AlertErrorOutline                           = mi(icons.AlertErrorOutline)
-	AlertWarning                                = mi(icons.AlertWarning)
-	CommunicationBusiness                       = mi(icons.CommunicationBusiness)
-	CommunicationCall                           = mi(icons.CommunicationCall)
-	CommunicationCallEnd                        = mi(icons.CommunicationCallEnd)
-	CommunicationCallMade                       = mi(icons.CommunicationCallMade)
-	CommunicationCallMerge                      = mi(icons.CommunicationCallMerge)
-	CommunicationCallMissed                     = mi(icons.CommunicationCallMissed)
-	CommunicationCallMissedOutgoing             = mi(icons.CommunicationCallMissedOutgoing)
-	CommunicationCallReceived                   = mi(icons.CommunicationCallReceived)
-	CommunicationCallSplit                      = mi(icons.CommunicationCallSplit)
-	CommunicationChat                           = mi(icons.CommunicationChat)
-	CommunicationChatBubble                     = mi(icons.CommunicationChatBubble)
-	CommunicationChatBubbleOutline              = mi(icons.CommunicationChatBubbleOutline)
-	CommunicationClearAll                       = mi(icons.CommunicationClearAll)
-	CommunicationComment                        = mi(icons.CommunicationComment)
-	CommunicationContactMail                    = mi(icons.CommunicationContactMail)
-	CommunicationContactPhone                   = mi(icons.CommunicationContactPhone)
-	CommunicationContacts                       = mi(icons.CommunicationContacts)
-	CommunicationDialerSIP                      = mi(icons.CommunicationDialerSIP)
-	CommunicationDialpad                        = mi(icons.CommunicationDialpad)
-	CommunicationEmail                          = mi(icons.CommunicationEmail)
-	CommunicationForum                          = mi(icons.CommunicationForum)
-	CommunicationImportContacts                 = mi(icons.CommunicationImportContacts)
-	CommunicationImportExport                   = mi(icons.CommunicationImportExport)
-	CommunicationInvertColorsOff                = mi(icons.CommunicationInvertColorsOff)
-	CommunicationLiveHelp                       = mi(icons.CommunicationLiveHelp)
-	CommunicationLocationOff                    = mi(icons.CommunicationLocationOff)
-	CommunicationLocationOn                     = mi(icons.CommunicationLocationOn)
-	CommunicationMailOutline                    = mi(icons.CommunicationMailOutline)
-	CommunicationMessage                        = mi(icons.CommunicationMessage)
-	CommunicationNoSIM                          = mi(icons.CommunicationNoSIM)
-	CommunicationPhone                          = mi(icons.CommunicationPhone)
-	CommunicationPhoneLinkErase                 = mi(icons.CommunicationPhoneLinkErase)
-	CommunicationPhoneLinkLock                  = mi(icons.CommunicationPhoneLinkLock)
-	CommunicationPhoneLinkRing                  = mi(icons.CommunicationPhoneLinkRing)
-	CommunicationPhoneLinkSetup                 = mi(icons.CommunicationPhoneLinkSetup)
-	CommunicationPortableWiFiOff                = mi(icons.CommunicationPortableWiFiOff)
-	CommunicationPresentToAll                   = mi(icons.CommunicationPresentToAll)
-	CommunicationRSSFeed                        = mi(icons.CommunicationRSSFeed)
-	CommunicationRingVolume                     = mi(icons.CommunicationRingVolume)
-	CommunicationScreenShare                    = mi(icons.CommunicationScreenShare)
-	CommunicationSpeakerPhone                   = mi(icons.CommunicationSpeakerPhone)
-	CommunicationStayCurrentLandscape           = mi(icons.CommunicationStayCurrentLandscape)
-	CommunicationStayCurrentPortrait            = mi(icons.CommunicationStayCurrentPortrait)
-	CommunicationStayPrimaryLandscape           = mi(icons.CommunicationStayPrimaryLandscape)
-	CommunicationStayPrimaryPortrait            = mi(icons.CommunicationStayPrimaryPortrait)
-	CommunicationStopScreenShare                = mi(icons.CommunicationStopScreenShare)
-	CommunicationSwapCalls                      = mi(icons.CommunicationSwapCalls)
-	CommunicationTextSMS                        = mi(icons.CommunicationTextSMS)
-	CommunicationVPNKey                         = mi(icons.CommunicationVPNKey)
-	CommunicationVoicemail                      = mi(icons.CommunicationVoicemail)
-	ContentAdd                                  = mi(icons.ContentAdd)
-	ContentAddBox                               = mi(icons.ContentAddBox)
-	ContentAddCircle                            = mi(icons.ContentAddCircle)
-	ContentAddCircleOutline                     = mi(icons.ContentAddCircleOutline)
-	ContentArchive                              = mi(icons.ContentArchive)
-	ContentBackspace                            = mi(icons.ContentBackspace)
-	ContentBlock                                = mi(icons.ContentBlock)
-	ContentClear                                = mi(icons.ContentClear)
-	ContentContentCopy                          = mi(icons.ContentContentCopy)
-	ContentContentCut                           = mi(icons.ContentContentCut)
-	ContentContentPaste                         = mi(icons.ContentContentPaste)
-	ContentCreate                               = mi(icons.ContentCreate)
-	ContentDeleteSweep                          = mi(icons.ContentDeleteSweep)
-	ContentDrafts                               = mi(icons.ContentDrafts)
-	ContentFilterList                           = mi(icons.ContentFilterList)
-	ContentFlag                                 = mi(icons.ContentFlag)
-	ContentFontDownload                         = mi(icons.ContentFontDownload)
-	ContentForward                              = mi(icons.ContentForward)
-	ContentGesture                              = mi(icons.ContentGesture)
-	ContentInbox                                = mi(icons.ContentInbox)
-	ContentLink                                 = mi(icons.ContentLink)
-	ContentLowPriority                          = mi(icons.ContentLowPriority)
-	ContentMail                                 = mi(icons.ContentMail)
-	ContentMarkUnread                           = mi(icons.ContentMarkUnread)
-	ContentMoveToInbox                          = mi(icons.ContentMoveToInbox)
-	ContentNextWeek                             = mi(icons.ContentNextWeek)
-	ContentRedo                                 = mi(icons.ContentRedo)
-	ContentRemove                               = mi(icons.ContentRemove)
-	ContentRemoveCircle                         = mi(icons.ContentRemoveCircle)
-	ContentRemoveCircleOutline                  = mi(icons.ContentRemoveCircleOutline)
-	ContentReply                                = mi(icons.ContentReply)
-	ContentReplyAll                             = mi(icons.ContentReplyAll)
-	ContentReport                               = mi(icons.ContentReport)
-	ContentSave                                 = mi(icons.ContentSave)
-	ContentSelectAll                            = mi(icons.ContentSelectAll)
-	ContentSend                                 = mi(icons.ContentSend)
-	ContentSort                                 = mi(icons.ContentSort)
-	ContentTextFormat                           = mi(icons.ContentTextFormat)
-	ContentUnarchive                            = mi(icons.ContentUnarchive)
-	ContentUndo                                 = mi(icons.ContentUndo)
-	ContentWeekend                              = mi(icons.ContentWeekend)
-	DeviceAccessAlarm                           = mi(icons.DeviceAccessAlarm)
-	DeviceAccessAlarms                          = mi(icons.DeviceAccessAlarms)
-	DeviceAccessTime                            = mi(icons.DeviceAccessTime)
-	DeviceAddAlarm                              = mi(icons.DeviceAddAlarm)
-	DeviceAirplaneModeActive                    = mi(icons.DeviceAirplaneModeActive)
-	DeviceAirplaneModeInactive                  = mi(icons.DeviceAirplaneModeInactive)
-	DeviceBattery20                             = mi(icons.DeviceBattery20)
-	DeviceBattery30                             = mi(icons.DeviceBattery30)
-	DeviceBattery50                             = mi(icons.DeviceBattery50)
-	DeviceBattery60                             = mi(icons.DeviceBattery60)
-	DeviceBattery80                             = mi(icons.DeviceBattery80)
-	DeviceBattery90                             = mi(icons.DeviceBattery90)
-	DeviceBatteryAlert                          = mi(icons.DeviceBatteryAlert)
-	DeviceBatteryCharging20                     = mi(icons.DeviceBatteryCharging20)
-	DeviceBatteryCharging30                     = mi(icons.DeviceBatteryCharging30)
-	DeviceBatteryCharging50                     = mi(icons.DeviceBatteryCharging50)
-	DeviceBatteryCharging60                     = mi(icons.DeviceBatteryCharging60)
-	DeviceBatteryCharging80                     = mi(icons.DeviceBatteryCharging80)
-	DeviceBatteryCharging90                     = mi(icons.DeviceBatteryCharging90)
-	DeviceBatteryChargingFull                   = mi(icons.DeviceBatteryChargingFull)
-	DeviceBatteryFull                           = mi(icons.DeviceBatteryFull)
-	DeviceBatteryStd                            = mi(icons.DeviceBatteryStd)
-	DeviceBatteryUnknown                        = mi(icons.DeviceBatteryUnknown)
-	DeviceBluetooth                             = mi(icons.DeviceBluetooth)
-	DeviceBluetoothConnected                    = mi(icons.DeviceBluetoothConnected)
-	DeviceBluetoothDisabled                     = mi(icons.DeviceBluetoothDisabled)
-	DeviceBluetoothSearching                    = mi(icons.DeviceBluetoothSearching)
-	DeviceBrightnessAuto                        = mi(icons.DeviceBrightnessAuto)
-	DeviceBrightnessHigh                        = mi(icons.DeviceBrightnessHigh)
-	DeviceBrightnessLow                         = mi(icons.DeviceBrightnessLow)
-	DeviceBrightnessMedium                      = mi(icons.DeviceBrightnessMedium)
-	DeviceDVR                                   = mi(icons.DeviceDVR)
-	DeviceDataUsage                             = mi(icons.DeviceDataUsage)
-	DeviceDeveloperMode                         = mi(icons.DeviceDeveloperMode)
-	DeviceDevices                               = mi(icons.DeviceDevices)
-	DeviceGPSFixed                              = mi(icons.DeviceGPSFixed)
-	DeviceGPSNotFixed                           = mi(icons.DeviceGPSNotFixed)
-	DeviceGPSOff                                = mi(icons.DeviceGPSOff)
-	DeviceGraphicEq                             = mi(icons.DeviceGraphicEq)
-	DeviceLocationDisabled                      = mi(icons.DeviceLocationDisabled)
-	DeviceLocationSearching                     = mi(icons.DeviceLocationSearching)
-	DeviceNFC                                   = mi(icons.DeviceNFC)
-	DeviceNetworkCell                           = mi(icons.DeviceNetworkCell)
-	DeviceNetworkWiFi                           = mi(icons.DeviceNetworkWiFi)
-	DeviceSDStorage                             = mi(icons.DeviceSDStorage)
-	DeviceScreenLockLandscape                   = mi(icons.DeviceScreenLockLandscape)
-	DeviceScreenLockPortrait                    = mi(icons.DeviceScreenLockPortrait)
-	DeviceScreenLockRotation                    = mi(icons.DeviceScreenLockRotation)
-	DeviceScreenRotation                        = mi(icons.DeviceScreenRotation)
-	DeviceSettingsSystemDaydream                = mi(icons.DeviceSettingsSystemDaydream)
-	DeviceSignalCellular0Bar                    = mi(icons.DeviceSignalCellular0Bar)
-	DeviceSignalCellular1Bar                    = mi(icons.DeviceSignalCellular1Bar)
-	DeviceSignalCellular2Bar                    = mi(icons.DeviceSignalCellular2Bar)
-	DeviceSignalCellular3Bar                    = mi(icons.DeviceSignalCellular3Bar)
-	DeviceSignalCellular4Bar                    = mi(icons.DeviceSignalCellular4Bar)
-	DeviceSignalCellularConnectedNoInternet0Bar = mi(icons.DeviceSignalCellularConnectedNoInternet0Bar)
-	DeviceSignalCellularConnectedNoInternet1Bar = mi(icons.DeviceSignalCellularConnectedNoInternet1Bar)
-	DeviceSignalCellularConnectedNoInternet2Bar = mi(icons.DeviceSignalCellularConnectedNoInternet2Bar)
-	DeviceSignalCellularConnectedNoInternet3Bar = mi(icons.DeviceSignalCellularConnectedNoInternet3Bar)
-	DeviceSignalCellularConnectedNoInternet4Bar = mi(icons.DeviceSignalCellularConnectedNoInternet4Bar)
-	DeviceSignalCellularNoSIM                   = mi(icons.DeviceSignalCellularNoSIM)
-	DeviceSignalCellularNull                    = mi(icons.DeviceSignalCellularNull)
-	DeviceSignalCellularOff                     = mi(icons.DeviceSignalCellularOff)
-	DeviceSignalWiFi0Bar                        = mi(icons.DeviceSignalWiFi0Bar)
-	DeviceSignalWiFi1Bar                        = mi(icons.DeviceSignalWiFi1Bar)
-	DeviceSignalWiFi1BarLock                    = mi(icons.DeviceSignalWiFi1BarLock)
-	DeviceSignalWiFi2Bar                        = mi(icons.DeviceSignalWiFi2Bar)
-	DeviceSignalWiFi2BarLock                    = mi(icons.DeviceSignalWiFi2BarLock)
-	DeviceSignalWiFi3Bar                        = mi(icons.DeviceSignalWiFi3Bar)
-	DeviceSignalWiFi3BarLock                    = mi(icons.DeviceSignalWiFi3BarLock)
-	DeviceSignalWiFi4Bar                        = mi(icons.DeviceSignalWiFi4Bar)
-	DeviceSignalWiFi4BarLock                    = mi(icons.DeviceSignalWiFi4BarLock)
-	DeviceSignalWiFiOff                         = mi(icons.DeviceSignalWiFiOff)
-	DeviceStorage                               = mi(icons.DeviceStorage)
-	DeviceUSB                                   = mi(icons.DeviceUSB)
-	DeviceWallpaper                             = mi(icons.DeviceWallpaper)
-	DeviceWiFiLock                              = mi(icons.DeviceWiFiLock)
-	DeviceWiFiTethering                         = mi(icons.DeviceWiFiTethering)
-	DeviceWidgets                               = mi(icons.DeviceWidgets)
-	EditorAttachFile                            = mi(icons.EditorAttachFile)
-	EditorAttachMoney                           = mi(icons.EditorAttachMoney)
-	EditorBorderAll                             = mi(icons.EditorBorderAll)
-	EditorBorderBottom                          = mi(icons.EditorBorderBottom)
-	EditorBorderClear                           = mi(icons.EditorBorderClear)
-	EditorBorderColor                           = mi(icons.EditorBorderColor)
-	EditorBorderHorizontal                      = mi(icons.EditorBorderHorizontal)
-	EditorBorderInner                           = mi(icons.EditorBorderInner)
-	EditorBorderLeft                            = mi(icons.EditorBorderLeft)
-	EditorBorderOuter                           = mi(icons.EditorBorderOuter)
-	EditorBorderRight                           = mi(icons.EditorBorderRight)
-	EditorBorderStyle                           = mi(icons.EditorBorderStyle)
-	EditorBorderTop                             = mi(icons.EditorBorderTop)
-	EditorBorderVertical                        = mi(icons.EditorBorderVertical)
-	EditorBubbleChart                           = mi(icons.EditorBubbleChart)
-	EditorDragHandle                            = mi(icons.EditorDragHandle)
-	EditorFormatAlignCenter                     = mi(icons.EditorFormatAlignCenter)
-	EditorFormatAlignJustify                    = mi(icons.EditorFormatAlignJustify)
-	EditorFormatAlignLeft                       = mi(icons.EditorFormatAlignLeft)
-	EditorFormatAlignRight                      = mi(icons.EditorFormatAlignRight)
-	EditorFormatBold                            = mi(icons.EditorFormatBold)
-	EditorFormatClear                           = mi(icons.EditorFormatClear)
-	EditorFormatColorFill                       = mi(icons.EditorFormatColorFill)
-	EditorFormatColorReset                      = mi(icons.EditorFormatColorReset)
-	EditorFormatColorText                       = mi(icons.EditorFormatColorText)
-	EditorFormatIndentDecrease                  = mi(icons.EditorFormatIndentDecrease)
-	EditorFormatIndentIncrease                  = mi(icons.EditorFormatIndentIncrease)
-	EditorFormatItalic                          = mi(icons.EditorFormatItalic)
-	EditorFormatLineSpacing                     = mi(icons.EditorFormatLineSpacing)
-	EditorFormatListBulleted                    = mi(icons.EditorFormatListBulleted)
-	EditorFormatListNumbered                    = mi(icons.EditorFormatListNumbered)
-	EditorFormatPaint                           = mi(icons.EditorFormatPaint)
-	EditorFormatQuote                           = mi(icons.EditorFormatQuote)
-	EditorFormatShapes                          = mi(icons.EditorFormatShapes)
-	EditorFormatSize                            = mi(icons.EditorFormatSize)
-	EditorFormatStrikethrough                   = mi(icons.EditorFormatStrikethrough)
-	EditorFormatTextDirectionLToR               = mi(icons.EditorFormatTextDirectionLToR)
-	EditorFormatTextDirectionRToL               = mi(icons.EditorFormatTextDirectionRToL)
-	EditorFormatUnderlined                      = mi(icons.EditorFormatUnderlined)
-	EditorFunctions                             = mi(icons.EditorFunctions)
-	EditorHighlight                             = mi(icons.EditorHighlight)
-	EditorInsertChart                           = mi(icons.EditorInsertChart)
-	EditorInsertComment                         = mi(icons.EditorInsertComment)
-	EditorInsertDriveFile                       = mi(icons.EditorInsertDriveFile)
-	EditorInsertEmoticon                        = mi(icons.EditorInsertEmoticon)
-	EditorInsertInvitation                      = mi(icons.EditorInsertInvitation)
-	EditorInsertLink                            = mi(icons.EditorInsertLink)
-	EditorInsertPhoto                           = mi(icons.EditorInsertPhoto)
-	EditorLinearScale                           = mi(icons.EditorLinearScale)
-	EditorMergeType                             = mi(icons.EditorMergeType)
-	EditorModeComment                           = mi(icons.EditorModeComment)
-	EditorModeEdit                              = mi(icons.EditorModeEdit)
-	EditorMonetizationOn                        = mi(icons.EditorMonetizationOn)
-	EditorMoneyOff                              = mi(icons.EditorMoneyOff)
-	EditorMultilineChart                        = mi(icons.EditorMultilineChart)
-	EditorPieChart                              = mi(icons.EditorPieChart)
-	EditorPieChartOutlined                      = mi(icons.EditorPieChartOutlined)
-	EditorPublish                               = mi(icons.EditorPublish)
-	EditorShortText                             = mi(icons.EditorShortText)
-	EditorShowChart                             = mi(icons.EditorShowChart)
-	EditorSpaceBar                              = mi(icons.EditorSpaceBar)
-	EditorStrikethroughS                        = mi(icons.EditorStrikethroughS)
-	EditorTextFields                            = mi(icons.EditorTextFields)
-	EditorTitle                                 = mi(icons.EditorTitle)
-	EditorVerticalAlignBottom                   = mi(icons.EditorVerticalAlignBottom)
-	EditorVerticalAlignCenter                   = mi(icons.EditorVerticalAlignCenter)
-	EditorVerticalAlignTop                      = mi(icons.EditorVerticalAlignTop)
-	EditorWrapText                              = mi(icons.EditorWrapText)
-	FileAttachment                              = mi(icons.FileAttachment)
-	FileCloud                                   = mi(icons.FileCloud)
-	FileCloudCircle                             = mi(icons.FileCloudCircle)
-	FileCloudDone                               = mi(icons.FileCloudDone)
-	FileCloudDownload                           = mi(icons.FileCloudDownload)
-	FileCloudOff                                = mi(icons.FileCloudOff)
-	FileCloudQueue                              = mi(icons.FileCloudQueue)
-	FileCloudUpload                             = mi(icons.FileCloudUpload)
-	FileCreateNewFolder                         = mi(icons.FileCreateNewFolder)
-	FileFileDownload                            = mi(icons.FileFileDownload)
-	FileFileUpload                              = mi(icons.FileFileUpload)
-	FileFolder                                  = mi(icons.FileFolder)
-	FileFolderOpen                              = mi(icons.FileFolderOpen)
-	FileFolderShared                            = mi(icons.FileFolderShared)
-	HardwareCast                                = mi(icons.HardwareCast)
-	HardwareCastConnected                       = mi(icons.HardwareCastConnected)
-	HardwareComputer                            = mi(icons.HardwareComputer)
-	HardwareDesktopMac                          = mi(icons.HardwareDesktopMac)
-	HardwareDesktopWindows                      = mi(icons.HardwareDesktopWindows)
-	HardwareDeveloperBoard                      = mi(icons.HardwareDeveloperBoard)
-	HardwareDeviceHub                           = mi(icons.HardwareDeviceHub)
-	HardwareDevicesOther                        = mi(icons.HardwareDevicesOther)
-	HardwareDock                                = mi(icons.HardwareDock)
-	HardwareGamepad                             = mi(icons.HardwareGamepad)
-	HardwareHeadset                             = mi(icons.HardwareHeadset)
-	HardwareHeadsetMic                          = mi(icons.HardwareHeadsetMic)
-	HardwareKeyboard                            = mi(icons.HardwareKeyboard)
-	HardwareKeyboardArrowDown                   = mi(icons.HardwareKeyboardArrowDown)
-	HardwareKeyboardArrowLeft                   = mi(icons.HardwareKeyboardArrowLeft)
-	HardwareKeyboardArrowRight                  = mi(icons.HardwareKeyboardArrowRight)
-	HardwareKeyboardArrowUp                     = mi(icons.HardwareKeyboardArrowUp)
-	HardwareKeyboardBackspace                   = mi(icons.HardwareKeyboardBackspace)
-	HardwareKeyboardCapslock                    = mi(icons.HardwareKeyboardCapslock)
-	HardwareKeyboardHide                        = mi(icons.HardwareKeyboardHide)
-	HardwareKeyboardReturn                      = mi(icons.HardwareKeyboardReturn)
-	HardwareKeyboardTab                         = mi(icons.HardwareKeyboardTab)
-	HardwareKeyboardVoice                       = mi(icons.HardwareKeyboardVoice)
-	HardwareLaptop                              = mi(icons.HardwareLaptop)
-	HardwareLaptopChromebook                    = mi(icons.HardwareLaptopChromebook)
-	HardwareLaptopMac                           = mi(icons.HardwareLaptopMac)
-	HardwareLaptopWindows                       = mi(icons.HardwareLaptopWindows)
-	HardwareMemory                              = mi(icons.HardwareMemory)
-	HardwareMouse                               = mi(icons.HardwareMouse)
-	HardwarePhoneAndroid                        = mi(icons.HardwarePhoneAndroid)
-	HardwarePhoneIPhone                         = mi(icons.HardwarePhoneIPhone)
-	HardwarePhoneLink                           = mi(icons.HardwarePhoneLink)
-	HardwarePhoneLinkOff                        = mi(icons.HardwarePhoneLinkOff)
-	HardwarePowerInput                          = mi(icons.HardwarePowerInput)
-	HardwareRouter                              = mi(icons.HardwareRouter)
-	HardwareSIMCard                             = mi(icons.HardwareSIMCard)
-	HardwareScanner                             = mi(icons.HardwareScanner)
-	HardwareSecurity                            = mi(icons.HardwareSecurity)
-	HardwareSmartphone                          = mi(icons.HardwareSmartphone)
-	HardwareSpeaker                             = mi(icons.HardwareSpeaker)
-	HardwareSpeakerGroup                        = mi(icons.HardwareSpeakerGroup)
-	HardwareTV                                  = mi(icons.HardwareTV)
-	HardwareTablet                              = mi(icons.HardwareTablet)
-	HardwareTabletAndroid                       = mi(icons.HardwareTabletAndroid)
-	HardwareTabletMac                           = mi(icons.HardwareTabletMac)
-	HardwareToys                                = mi(icons.HardwareToys)
-	HardwareVideogameAsset                      = mi(icons.HardwareVideogameAsset)
-	HardwareWatch                               = mi(icons.HardwareWatch)
-	ImageAddAPhoto                              = mi(icons.ImageAddAPhoto)
-	ImageAddToPhotos                            = mi(icons.ImageAddToPhotos)
-	ImageAdjust                                 = mi(icons.ImageAdjust)
-	ImageAssistant                              = mi(icons.ImageAssistant)
-	ImageAssistantPhoto                         = mi(icons.ImageAssistantPhoto)
-	ImageAudiotrack                             = mi(icons.ImageAudiotrack)
-	ImageBlurCircular                           = mi(icons.ImageBlurCircular)
-	ImageBlurLinear                             = mi(icons.ImageBlurLinear)
-	ImageBlurOff                                = mi(icons.ImageBlurOff)
-	ImageBlurOn                                 = mi(icons.ImageBlurOn)
-	ImageBrightness1                            = mi(icons.ImageBrightness1)
-	ImageBrightness2                            = mi(icons.ImageBrightness2)
-	ImageBrightness3                            = mi(icons.ImageBrightness3)
-	ImageBrightness4                            = mi(icons.ImageBrightness4)
-	ImageBrightness5                            = mi(icons.ImageBrightness5)
-	ImageBrightness6                            = mi(icons.ImageBrightness6)
-	ImageBrightness7                            = mi(icons.ImageBrightness7)
-	ImageBrokenImage                            = mi(icons.ImageBrokenImage)
-	ImageBrush                                  = mi(icons.ImageBrush)
-	ImageBurstMode                              = mi(icons.ImageBurstMode)
-	ImageCamera                                 = mi(icons.ImageCamera)
-	ImageCameraAlt                              = mi(icons.ImageCameraAlt)
-	ImageCameraFront                            = mi(icons.ImageCameraFront)
-	ImageCameraRear                             = mi(icons.ImageCameraRear)
-	ImageCameraRoll                             = mi(icons.ImageCameraRoll)
-	ImageCenterFocusStrong                      = mi(icons.ImageCenterFocusStrong)
-	ImageCenterFocusWeak                        = mi(icons.ImageCenterFocusWeak)
-	ImageCollections                            = mi(icons.ImageCollections)
-	ImageCollectionsBookmark                    = mi(icons.ImageCollectionsBookmark)
-	ImageColorLens                              = mi(icons.ImageColorLens)
-	ImageColorize                               = mi(icons.ImageColorize)
-	ImageCompare                                = mi(icons.ImageCompare)
-	ImageControlPoint                           = mi(icons.ImageControlPoint)
-	ImageControlPointDuplicate                  = mi(icons.ImageControlPointDuplicate)
-	ImageCrop                                   = mi(icons.ImageCrop)
-	ImageCrop169                                = mi(icons.ImageCrop169)
-	ImageCrop32                                 = mi(icons.ImageCrop32)
-	ImageCrop54                                 = mi(icons.ImageCrop54)
-	ImageCrop75                                 = mi(icons.ImageCrop75)
-	ImageCropDIN                                = mi(icons.ImageCropDIN)
-	ImageCropFree                               = mi(icons.ImageCropFree)
-	ImageCropLandscape                          = mi(icons.ImageCropLandscape)
-	ImageCropOriginal                           = mi(icons.ImageCropOriginal)
-	ImageCropPortrait                           = mi(icons.ImageCropPortrait)
-	ImageCropRotate                             = mi(icons.ImageCropRotate)
-	ImageCropSquare                             = mi(icons.ImageCropSquare)
-	ImageDehaze                                 = mi(icons.ImageDehaze)
-	ImageDetails                                = mi(icons.ImageDetails)
-	ImageEdit                                   = mi(icons.ImageEdit)
-	ImageExposure                               = mi(icons.ImageExposure)
-	ImageExposureNeg1                           = mi(icons.ImageExposureNeg1)
-	ImageExposureNeg2                           = mi(icons.ImageExposureNeg2)
-	ImageExposurePlus1                          = mi(icons.ImageExposurePlus1)
-	ImageExposurePlus2                          = mi(icons.ImageExposurePlus2)
-	ImageExposureZero                           = mi(icons.ImageExposureZero)
-	ImageFilter                                 = mi(icons.ImageFilter)
-	ImageFilter1                                = mi(icons.ImageFilter1)
-	ImageFilter2                                = mi(icons.ImageFilter2)
-	ImageFilter3                                = mi(icons.ImageFilter3)
-	ImageFilter4                                = mi(icons.ImageFilter4)
-	ImageFilter5                                = mi(icons.ImageFilter5)
-	ImageFilter6                                = mi(icons.ImageFilter6)
-	ImageFilter7                                = mi(icons.ImageFilter7)
-	ImageFilter8                                = mi(icons.ImageFilter8)
-	ImageFilter9                                = mi(icons.ImageFilter9)
-	ImageFilter9Plus                            = mi(icons.ImageFilter9Plus)
-	ImageFilterBAndW                            = mi(icons.ImageFilterBAndW)
-	ImageFilterCenterFocus                      = mi(icons.ImageFilterCenterFocus)
-	ImageFilterDrama                            = mi(icons.ImageFilterDrama)
-	ImageFilterFrames                           = mi(icons.ImageFilterFrames)
-	ImageFilterHDR                              = mi(icons.ImageFilterHDR)
-	ImageFilterNone                             = mi(icons.ImageFilterNone)
-	ImageFilterTiltShift                        = mi(icons.ImageFilterTiltShift)
-	ImageFilterVintage                          = mi(icons.ImageFilterVintage)
-	ImageFlare                                  = mi(icons.ImageFlare)
-	ImageFlashAuto                              = mi(icons.ImageFlashAuto)
-	ImageFlashOff                               = mi(icons.ImageFlashOff)
-	ImageFlashOn                                = mi(icons.ImageFlashOn)
-	ImageFlip                                   = mi(icons.ImageFlip)
-	ImageGradient                               = mi(icons.ImageGradient)
-	ImageGrain                                  = mi(icons.ImageGrain)
-	ImageGridOff                                = mi(icons.ImageGridOff)
-	ImageGridOn                                 = mi(icons.ImageGridOn)
-	ImageHDROff                                 = mi(icons.ImageHDROff)
-	ImageHDROn                                  = mi(icons.ImageHDROn)
-	ImageHDRStrong                              = mi(icons.ImageHDRStrong)
-	ImageHDRWeak                                = mi(icons.ImageHDRWeak)
-	ImageHealing                                = mi(icons.ImageHealing)
-	ImageISO                                    = mi(icons.ImageISO)
-	ImageImage                                  = mi(icons.ImageImage)
-	ImageImageAspectRatio                       = mi(icons.ImageImageAspectRatio)
-	ImageLandscape                              = mi(icons.ImageLandscape)
-	ImageLeakAdd                                = mi(icons.ImageLeakAdd)
-	ImageLeakRemove                             = mi(icons.ImageLeakRemove)
-	ImageLens                                   = mi(icons.ImageLens)
-	ImageLinkedCamera                           = mi(icons.ImageLinkedCamera)
-	ImageLooks                                  = mi(icons.ImageLooks)
-	ImageLooks3                                 = mi(icons.ImageLooks3)
-	ImageLooks4                                 = mi(icons.ImageLooks4)
-	ImageLooks5                                 = mi(icons.ImageLooks5)
-	ImageLooks6                                 = mi(icons.ImageLooks6)
-	ImageLooksOne                               = mi(icons.ImageLooksOne)
-	ImageLooksTwo                               = mi(icons.ImageLooksTwo)
-	ImageLoupe                                  = mi(icons.ImageLoupe)
-	ImageMonochromePhotos                       = mi(icons.ImageMonochromePhotos)
-	ImageMovieCreation                          = mi(icons.ImageMovieCreation)
-	ImageMovieFilter                            = mi(icons.ImageMovieFilter)
-	ImageMusicNote                              = mi(icons.ImageMusicNote)
-	ImageNature                                 = mi(icons.ImageNature)
-	ImageNaturePeople                           = mi(icons.ImageNaturePeople)
-	ImageNavigateBefore                         = mi(icons.ImageNavigateBefore)
-	ImageNavigateNext                           = mi(icons.ImageNavigateNext)
-	ImagePalette                                = mi(icons.ImagePalette)
-	ImagePanorama                               = mi(icons.ImagePanorama)
-	ImagePanoramaFishEye                        = mi(icons.ImagePanoramaFishEye)
-	ImagePanoramaHorizontal                     = mi(icons.ImagePanoramaHorizontal)
-	ImagePanoramaVertical                       = mi(icons.ImagePanoramaVertical)
-	ImagePanoramaWideAngle                      = mi(icons.ImagePanoramaWideAngle)
-	ImagePhoto                                  = mi(icons.ImagePhoto)
-	ImagePhotoAlbum                             = mi(icons.ImagePhotoAlbum)
-	ImagePhotoCamera                            = mi(icons.ImagePhotoCamera)
-	ImagePhotoFilter                            = mi(icons.ImagePhotoFilter)
-	ImagePhotoLibrary                           = mi(icons.ImagePhotoLibrary)
-	ImagePhotoSizeSelectActual                  = mi(icons.ImagePhotoSizeSelectActual)
-	ImagePhotoSizeSelectLarge                   = mi(icons.ImagePhotoSizeSelectLarge)
-	ImagePhotoSizeSelectSmall                   = mi(icons.ImagePhotoSizeSelectSmall)
-	ImagePictureAsPDF                           = mi(icons.ImagePictureAsPDF)
-	ImagePortrait                               = mi(icons.ImagePortrait)
-	ImageRemoveRedEye                           = mi(icons.ImageRemoveRedEye)
-	ImageRotate90DegreesCCW                     = mi(icons.ImageRotate90DegreesCCW)
-	ImageRotateLeft                             = mi(icons.ImageRotateLeft)
-	ImageRotateRight                            = mi(icons.ImageRotateRight)
-	ImageSlideshow                              = mi(icons.ImageSlideshow)
-	ImageStraighten                             = mi(icons.ImageStraighten)
-	ImageStyle                                  = mi(icons.ImageStyle)
-	ImageSwitchCamera                           = mi(icons.ImageSwitchCamera)
-	ImageSwitchVideo                            = mi(icons.ImageSwitchVideo)
-	ImageTagFaces                               = mi(icons.ImageTagFaces)
-	ImageTexture                                = mi(icons.ImageTexture)
-	ImageTimeLapse                              = mi(icons.ImageTimeLapse)
-	ImageTimer                                  = mi(icons.ImageTimer)
-	ImageTimer10                                = mi(icons.ImageTimer10)
-	ImageTimer3                                 = mi(icons.ImageTimer3)
-	ImageTimerOff                               = mi(icons.ImageTimerOff)
-	ImageTonality                               = mi(icons.ImageTonality)
-	ImageTransform                              = mi(icons.ImageTransform)
-	ImageTune                                   = mi(icons.ImageTune)
-	ImageViewComfy                              = mi(icons.ImageViewComfy)
-	ImageViewCompact                            = mi(icons.ImageViewCompact)
-	ImageVignette                               = mi(icons.ImageVignette)
-	ImageWBAuto                                 = mi(icons.ImageWBAuto)
-	ImageWBCloudy                               = mi(icons.ImageWBCloudy)
-	ImageWBIncandescent                         = mi(icons.ImageWBIncandescent)
-	ImageWBIridescent                           = mi(icons.ImageWBIridescent)
-	ImageWBSunny                                = mi(icons.ImageWBSunny)
-	MapsAddLocation                             = mi(icons.MapsAddLocation)
-	MapsBeenhere                                = mi(icons.MapsBeenhere)
-	MapsDirections                              = mi(icons.MapsDirections)
-	MapsDirectionsBike                          = mi(icons.MapsDirectionsBike)
-	MapsDirectionsBoat                          = mi(icons.MapsDirectionsBoat)
-	MapsDirectionsBus                           = mi(icons.MapsDirectionsBus)
-	MapsDirectionsCar                           = mi(icons.MapsDirectionsCar)
-	MapsDirectionsRailway                       = mi(icons.MapsDirectionsRailway)
-	MapsDirectionsRun                           = mi(icons.MapsDirectionsRun)
-	MapsDirectionsSubway                        = mi(icons.MapsDirectionsSubway)
-	MapsDirectionsTransit                       = mi(icons.MapsDirectionsTransit)
-	MapsDirectionsWalk                          = mi(icons.MapsDirectionsWalk)
-	MapsEVStation                               = mi(icons.MapsEVStation)
-	MapsEditLocation                            = mi(icons.MapsEditLocation)
-	MapsFlight                                  = mi(icons.MapsFlight)
-	MapsHotel                                   = mi(icons.MapsHotel)
-	MapsLayers                                  = mi(icons.MapsLayers)
-	MapsLayersClear                             = mi(icons.MapsLayersClear)
-	MapsLocalATM                                = mi(icons.MapsLocalATM)
-	MapsLocalActivity                           = mi(icons.MapsLocalActivity)
-	MapsLocalAirport                            = mi(icons.MapsLocalAirport)
-	MapsLocalBar                                = mi(icons.MapsLocalBar)
-	MapsLocalCafe                               = mi(icons.MapsLocalCafe)
-	MapsLocalCarWash                            = mi(icons.MapsLocalCarWash)
-	MapsLocalConvenienceStore                   = mi(icons.MapsLocalConvenienceStore)
-	MapsLocalDining                             = mi(icons.MapsLocalDining)
-	MapsLocalDrink                              = mi(icons.MapsLocalDrink)
-	MapsLocalFlorist                            = mi(icons.MapsLocalFlorist)
-	MapsLocalGasStation                         = mi(icons.MapsLocalGasStation)
-	MapsLocalGroceryStore                       = mi(icons.MapsLocalGroceryStore)
-	MapsLocalHospital                           = mi(icons.MapsLocalHospital)
-	MapsLocalHotel                              = mi(icons.MapsLocalHotel)
-	MapsLocalLaundryService                     = mi(icons.MapsLocalLaundryService)
-	MapsLocalLibrary                            = mi(icons.MapsLocalLibrary)
-	MapsLocalMall                               = mi(icons.MapsLocalMall)
-	MapsLocalMovies                             = mi(icons.MapsLocalMovies)
-	MapsLocalOffer                              = mi(icons.MapsLocalOffer)
-	MapsLocalParking                            = mi(icons.MapsLocalParking)
-	MapsLocalPharmacy                           = mi(icons.MapsLocalPharmacy)
-	MapsLocalPhone                              = mi(icons.MapsLocalPhone)
-	MapsLocalPizza                              = mi(icons.MapsLocalPizza)
-	MapsLocalPlay                               = mi(icons.MapsLocalPlay)
-	MapsLocalPostOffice                         = mi(icons.MapsLocalPostOffice)
-	MapsLocalPrintshop                          = mi(icons.MapsLocalPrintshop)
-	MapsLocalSee                                = mi(icons.MapsLocalSee)
-	MapsLocalShipping                           = mi(icons.MapsLocalShipping)
-	MapsLocalTaxi                               = mi(icons.MapsLocalTaxi)
-	MapsMap                                     = mi(icons.MapsMap)
-	MapsMyLocation                              = mi(icons.MapsMyLocation)
-	MapsNavigation                              = mi(icons.MapsNavigation)
-	MapsNearMe                                  = mi(icons.MapsNearMe)
-	MapsPersonPin                               = mi(icons.MapsPersonPin)
-	MapsPersonPinCircle                         = mi(icons.MapsPersonPinCircle)
-	MapsPinDrop                                 = mi(icons.MapsPinDrop)
-	MapsPlace                                   = mi(icons.MapsPlace)
-	MapsRateReview                              = mi(icons.MapsRateReview)
-	MapsRestaurant                              = mi(icons.MapsRestaurant)
-	MapsRestaurantMenu                          = mi(icons.MapsRestaurantMenu)
-	MapsSatellite                               = mi(icons.MapsSatellite)
-	MapsStoreMallDirectory                      = mi(icons.MapsStoreMallDirectory)
-	MapsStreetView                              = mi(icons.MapsStreetView)
-	MapsSubway                                  = mi(icons.MapsSubway)
-	MapsTerrain                                 = mi(icons.MapsTerrain)
-	MapsTraffic                                 = mi(icons.MapsTraffic)
-	MapsTrain                                   = mi(icons.MapsTrain)
-	MapsTram                                    = mi(icons.MapsTram)
-	MapsTransferWithinAStation                  = mi(icons.MapsTransferWithinAStation)
-	MapsZoomOutMap                              = mi(icons.MapsZoomOutMap)
-	NavigationApps                              = mi(icons.NavigationApps)
-	NavigationArrowBack                         = mi(icons.NavigationArrowBack)
-	NavigationArrowDownward                     = mi(icons.NavigationArrowDownward)
-	NavigationArrowDropDown                     = mi(icons.NavigationArrowDropDown)
-	NavigationArrowDropDownCircle               = mi(icons.NavigationArrowDropDownCircle)
-	NavigationArrowDropUp                       = mi(icons.NavigationArrowDropUp)
-	NavigationArrowForward                      = mi(icons.NavigationArrowForward)
-	NavigationArrowUpward                       = mi(icons.NavigationArrowUpward)
-	NavigationCancel                            = mi(icons.NavigationCancel)
-	NavigationCheck                             = mi(icons.NavigationCheck)
-	NavigationChevronLeft                       = mi(icons.NavigationChevronLeft)
-	NavigationChevronRight                      = mi(icons.NavigationChevronRight)
-	NavigationClose                             = mi(icons.NavigationClose)
-	NavigationExpandLess                        = mi(icons.NavigationExpandLess)
-	NavigationExpandMore                        = mi(icons.NavigationExpandMore)
-	NavigationFirstPage                         = mi(icons.NavigationFirstPage)
-	NavigationFullscreen                        = mi(icons.NavigationFullscreen)
-	NavigationFullscreenExit                    = mi(icons.NavigationFullscreenExit)
-	NavigationLastPage                          = mi(icons.NavigationLastPage)
-	NavigationMenu                              = mi(icons.NavigationMenu)
-	NavigationMoreHoriz                         = mi(icons.NavigationMoreHoriz)
-	NavigationMoreVert                          = mi(icons.NavigationMoreVert)
-	NavigationRefresh                           = mi(icons.NavigationRefresh)
-	NavigationSubdirectoryArrowLeft             = mi(icons.NavigationSubdirectoryArrowLeft)
-	NavigationSubdirectoryArrowRight            = mi(icons.NavigationSubdirectoryArrowRight)
-	NavigationUnfoldLess                        = mi(icons.NavigationUnfoldLess)
-	NavigationUnfoldMore                        = mi(icons.NavigationUnfoldMore)
-	NotificationADB                             = mi(icons.NotificationADB)
-	NotificationAirlineSeatFlat                 = mi(icons.NotificationAirlineSeatFlat)
-	NotificationAirlineSeatFlatAngled           = mi(icons.NotificationAirlineSeatFlatAngled)
-	NotificationAirlineSeatIndividualSuite      = mi(icons.NotificationAirlineSeatIndividualSuite)
-	NotificationAirlineSeatLegroomExtra         = mi(icons.NotificationAirlineSeatLegroomExtra)
-	NotificationAirlineSeatLegroomNormal        = mi(icons.NotificationAirlineSeatLegroomNormal)
-	NotificationAirlineSeatLegroomReduced       = mi(icons.NotificationAirlineSeatLegroomReduced)
-	NotificationAirlineSeatReclineExtra         = mi(icons.NotificationAirlineSeatReclineExtra)
-	NotificationAirlineSeatReclineNormal        = mi(icons.NotificationAirlineSeatReclineNormal)
-	NotificationBluetoothAudio                  = mi(icons.NotificationBluetoothAudio)
-	NotificationConfirmationNumber              = mi(icons.NotificationConfirmationNumber)
-	NotificationDiscFull                        = mi(icons.NotificationDiscFull)
-	NotificationDoNotDisturb                    = mi(icons.NotificationDoNotDisturb)
-	NotificationDoNotDisturbAlt                 = mi(icons.NotificationDoNotDisturbAlt)
-	NotificationDoNotDisturbOff                 = mi(icons.NotificationDoNotDisturbOff)
-	NotificationDoNotDisturbOn                  = mi(icons.NotificationDoNotDisturbOn)
-	NotificationDriveETA                        = mi(icons.NotificationDriveETA)
-	NotificationEnhancedEncryption              = mi(icons.NotificationEnhancedEncryption)
-	NotificationEventAvailable                  = mi(icons.NotificationEventAvailable)
-	NotificationEventBusy                       = mi(icons.NotificationEventBusy)
-	NotificationEventNote                       = mi(icons.NotificationEventNote)
-	NotificationFolderSpecial                   = mi(icons.NotificationFolderSpecial)
-	NotificationLiveTV                          = mi(icons.NotificationLiveTV)
-	NotificationMMS                             = mi(icons.NotificationMMS)
-	NotificationMore                            = mi(icons.NotificationMore)
-	NotificationNetworkCheck                    = mi(icons.NotificationNetworkCheck)
-	NotificationNetworkLocked                   = mi(icons.NotificationNetworkLocked)
-	NotificationNoEncryption                    = mi(icons.NotificationNoEncryption)
-	NotificationOnDemandVideo                   = mi(icons.NotificationOnDemandVideo)
-	NotificationPersonalVideo                   = mi(icons.NotificationPersonalVideo)
-	NotificationPhoneBluetoothSpeaker           = mi(icons.NotificationPhoneBluetoothSpeaker)
-	NotificationPhoneForwarded                  = mi(icons.NotificationPhoneForwarded)
-	NotificationPhoneInTalk                     = mi(icons.NotificationPhoneInTalk)
-	NotificationPhoneLocked                     = mi(icons.NotificationPhoneLocked)
-	NotificationPhoneMissed                     = mi(icons.NotificationPhoneMissed)
-	NotificationPhonePaused                     = mi(icons.NotificationPhonePaused)
-	NotificationPower                           = mi(icons.NotificationPower)
-	NotificationPriorityHigh                    = mi(icons.NotificationPriorityHigh)
-	NotificationRVHookup                        = mi(icons.NotificationRVHookup)
-	NotificationSDCard                          = mi(icons.NotificationSDCard)
-	NotificationSIMCardAlert                    = mi(icons.NotificationSIMCardAlert)
-	NotificationSMS                             = mi(icons.NotificationSMS)
-	NotificationSMSFailed                       = mi(icons.NotificationSMSFailed)
-	NotificationSync                            = mi(icons.NotificationSync)
-	NotificationSyncDisabled                    = mi(icons.NotificationSyncDisabled)
-	NotificationSyncProblem                     = mi(icons.NotificationSyncProblem)
-	NotificationSystemUpdate                    = mi(icons.NotificationSystemUpdate)
-	NotificationTapAndPlay                      = mi(icons.NotificationTapAndPlay)
-	NotificationTimeToLeave                     = mi(icons.NotificationTimeToLeave)
-	NotificationVPNLock                         = mi(icons.NotificationVPNLock)
-	NotificationVibration                       = mi(icons.NotificationVibration)
-	NotificationVoiceChat                       = mi(icons.NotificationVoiceChat)
-	NotificationWC                              = mi(icons.NotificationWC)
-	NotificationWiFi                            = mi(icons.NotificationWiFi)
-	PlacesACUnit                                = mi(icons.PlacesACUnit)
-	PlacesAirportShuttle                        = mi(icons.PlacesAirportShuttle)
-	PlacesAllInclusive                          = mi(icons.PlacesAllInclusive)
-	PlacesBeachAccess                           = mi(icons.PlacesBeachAccess)
-	PlacesBusinessCenter                        = mi(icons.PlacesBusinessCenter)
-	PlacesCasino                                = mi(icons.PlacesCasino)
-	PlacesChildCare                             = mi(icons.PlacesChildCare)
-	PlacesChildFriendly                         = mi(icons.PlacesChildFriendly)
-	PlacesFitnessCenter                         = mi(icons.PlacesFitnessCenter)
-	PlacesFreeBreakfast                         = mi(icons.PlacesFreeBreakfast)
-	PlacesGolfCourse                            = mi(icons.PlacesGolfCourse)
-	PlacesHotTub                                = mi(icons.PlacesHotTub)
-	PlacesKitchen                               = mi(icons.PlacesKitchen)
-	PlacesPool                                  = mi(icons.PlacesPool)
-	PlacesRVHookup                              = mi(icons.PlacesRVHookup)
-	PlacesRoomService                           = mi(icons.PlacesRoomService)
-	PlacesSmokeFree                             = mi(icons.PlacesSmokeFree)
-	PlacesSmokingRooms                          = mi(icons.PlacesSmokingRooms)
-	PlacesSpa                                   = mi(icons.PlacesSpa)
-	SocialCake                                  = mi(icons.SocialCake)
-	SocialDomain                                = mi(icons.SocialDomain)
-	SocialGroup                                 = mi(icons.SocialGroup)
-	SocialGroupAdd                              = mi(icons.SocialGroupAdd)
-	SocialLocationCity                          = mi(icons.SocialLocationCity)
-	SocialMood                                  = mi(icons.SocialMood)
-	SocialMoodBad                               = mi(icons.SocialMoodBad)
-	SocialNotifications                         = mi(icons.SocialNotifications)
-	SocialNotificationsActive                   = mi(icons.SocialNotificationsActive)
-	SocialNotificationsNone                     = mi(icons.SocialNotificationsNone)
-	SocialNotificationsOff                      = mi(icons.SocialNotificationsOff)
-	SocialNotificationsPaused                   = mi(icons.SocialNotificationsPaused)
-	SocialPages                                 = mi(icons.SocialPages)
-	SocialPartyMode                             = mi(icons.SocialPartyMode)
-	SocialPeople                                = mi(icons.SocialPeople)
-	SocialPeopleOutline                         = mi(icons.SocialPeopleOutline)
-	SocialPerson                                = mi(icons.SocialPerson)
-	SocialPersonAdd                             = mi(icons.SocialPersonAdd)
-	SocialPersonOutline                         = mi(icons.SocialPersonOutline)
-	SocialPlusOne                               = mi(icons.SocialPlusOne)
-	SocialPoll                                  = mi(icons.SocialPoll)
-	SocialPublic                                = mi(icons.SocialPublic)
-	SocialSchool                                = mi(icons.SocialSchool)
-	SocialSentimentDissatisfied                 = mi(icons.SocialSentimentDissatisfied)
-	SocialSentimentNeutral                      = mi(icons.SocialSentimentNeutral)
-	SocialSentimentSatisfied                    = mi(icons.SocialSentimentSatisfied)
-	SocialSentimentVeryDissatisfied             = mi(icons.SocialSentimentVeryDissatisfied)
-	SocialSentimentVerySatisfied                = mi(icons.SocialSentimentVerySatisfied)
-	SocialShare                                 = mi(icons.SocialShare)
-	SocialWhatsHot                              = mi(icons.SocialWhatsHot)
-	ToggleCheckBox                              = mi(icons.ToggleCheckBox)
-	ToggleCheckBoxOutlineBlank                  = mi(icons.ToggleCheckBoxOutlineBlank)
-	ToggleIndeterminateCheckBox                 = mi(icons.ToggleIndeterminateCheckBox)
-	ToggleRadioButtonChecked                    = mi(icons.ToggleRadioButtonChecked)
-	ToggleRadioButtonUnchecked                  = mi(icons.ToggleRadioButtonUnchecked)
-	ToggleStar                                  = mi(icons.ToggleStar)
-	ToggleStarBorder                            = mi(icons.ToggleStarBorder)
-	ToggleStarHalf                              = mi(icons.ToggleStarHalf)
+	AVAVTimer                                   = &Icon{src: &icons.AVAVTimer, lig: "av_timer"}
+	AVAddToQueue                                = &Icon{src: &icons.AVAddToQueue, lig: "add_to_queue"}
+	AVAirplay                                   = &Icon{src: &icons.AVAirplay, lig: "airplay"}
+	AVAlbum                                     = &Icon{src: &icons.AVAlbum, lig: "album"}
+	AVArtTrack                                  = &Icon{src: &icons.AVArtTrack, lig: "art_track"}
+	AVBrandingWatermark                         = &Icon{src: &icons.AVBrandingWatermark, lig: "branding_watermark"}
+	AVCallToAction                              = &Icon{src: &icons.AVCallToAction, lig: "call_to_action"}
+	AVClosedCaption                             = &Icon{src: &icons.AVClosedCaption, lig: "closed_caption"}
+	AVEqualizer                                 = &Icon{src: &icons.AVEqualizer, lig: "equalizer"}
+	AVExplicit                                  = &Icon{src: &icons.AVExplicit, lig: "explicit"}
+	AVFastForward                               = &Icon{src: &icons.AVFastForward, lig: "fast_forward"}
+	AVFastRewind                                = &Icon{src: &icons.AVFastRewind, lig: "fast_rewind"}
+	AVFeaturedPlayList                          = &Icon{src: &icons.AVFeaturedPlayList, mirror: true, lig: "featured_play_list"}
+	AVFeaturedVideo                             = &Icon{src: &icons.AVFeaturedVideo, lig: "featured_video"}
+	AVFiberDVR                                  = &Icon{src: &icons.AVFiberDVR, lig: "fiber_dvr"}
+	AVFiberManualRecord                         = &Icon{src: &icons.AVFiberManualRecord, lig: "fiber_manual_record"}
+	AVFiberNew                                  = &Icon{src: &icons.AVFiberNew, lig: "fiber_new"}
+	AVFiberPin                                  = &Icon{src: &icons.AVFiberPin, lig: "fiber_pin"}
+	AVFiberSmartRecord                          = &Icon{src: &icons.AVFiberSmartRecord, lig: "fiber_smart_record"}
+	AVForward10                                 = &Icon{src: &icons.AVForward10, lig: "forward_10"}
+	AVForward30                                 = &Icon{src: &icons.AVForward30, lig: "forward_30"}
+	AVForward5                                  = &Icon{src: &icons.AVForward5, lig: "forward_5"}
+	AVGames                                     = &Icon{src: &icons.AVGames, lig: "games"}
+	AVHD                                        = &Icon{src: &icons.AVHD, lig: "hd"}
+	AVHearing                                   = &Icon{src: &icons.AVHearing, lig: "hearing"}
+	AVHighQuality                               = &Icon{src: &icons.AVHighQuality, lig: "high_quality"}
+	AVLibraryAdd                                = &Icon{src: &icons.AVLibraryAdd, lig: "library_add"}
+	AVLibraryBooks                              = &Icon{src: &icons.AVLibraryBooks, lig: "library_books"}
+	AVLibraryMusic                              = &Icon{src: &icons.AVLibraryMusic, lig: "library_music"}
+	AVLoop                                      = &Icon{src: &icons.AVLoop, lig: "loop"}
+	AVMic                                       = &Icon{src: &icons.AVMic, lig: "mic"}
+	AVMicNone                                   = &Icon{src: &icons.AVMicNone, lig: "mic_none"}
+	AVMicOff                                    = &Icon{src: &icons.AVMicOff, lig: "mic_off"}
+	AVMovie                                     = &Icon{src: &icons.AVMovie, lig: "movie"}
+	AVMusicVideo                                = &Icon{src: &icons.AVMusicVideo, lig: "music_video"}
+	AVNewReleases                               = &Icon{src: &icons.AVNewReleases, lig: "new_releases"}
+	AVNotInterested                             = &Icon{src: &icons.AVNotInterested, lig: "not_interested"}
+	AVNote                                      = &Icon{src: &icons.AVNote, lig: "note"}
+	AVPause                                     = &Icon{src: &icons.AVPause, lig: "pause"}
+	AVPauseCircleFilled                         = &Icon{src: &icons.AVPauseCircleFilled, lig: "pause_circle_filled"}
+	AVPauseCircleOutline                        = &Icon{src: &icons.AVPauseCircleOutline, lig: "pause_circle_outline"}
+	AVPlayArrow                                 = &Icon{src: &icons.AVPlayArrow, lig: "play_arrow"}
+	AVPlayCircleFilled                          = &Icon{src: &icons.AVPlayCircleFilled, lig: "play_circle_filled"}
+	AVPlayCircleOutline                         = &Icon{src: &icons.AVPlayCircleOutline, lig: "play_circle_outline"}
+	AVPlaylistAdd                               = &Icon{src: &icons.AVPlaylistAdd, mirror: true, lig: "playlist_add"}
+	AVPlaylistAddCheck                          = &Icon{src: &icons.AVPlaylistAddCheck, mirror: true, lig: "playlist_add_check"}
+	AVPlaylistPlay                              = &Icon{src: &icons.AVPlaylistPlay, lig: "playlist_play"}
+	AVQueue                                     = AVLibraryAdd
+	AVQueueMusic                                = &Icon{src: &icons.AVQueueMusic, mirror: true, lig: "queue_music"}
+	AVQueuePlayNext                             = &Icon{src: &icons.AVQueuePlayNext, lig: "queue_play_next"}
+	AVRadio                                     = &Icon{src: &icons.AVRadio, lig: "radio"}
+	AVRecentActors                              = &Icon{src: &icons.AVRecentActors, lig: "recent_actors"}
+	AVRemoveFromQueue                           = &Icon{src: &icons.AVRemoveFromQueue, lig: "remove_from_queue"}
+	AVRepeat                                    = &Icon{src: &icons.AVRepeat, lig: "repeat"}
+	AVRepeatOne                                 = &Icon{src: &icons.AVRepeatOne, lig: "repeat_one"}
+	AVReplay                                    = &Icon{src: &icons.AVReplay, lig: "replay"}
+	AVReplay10                                  = &Icon{src: &icons.AVReplay10, lig: "replay_10"}
+	AVReplay30                                  = &Icon{src: &icons.AVReplay30, lig: "replay_30"}
+	AVReplay5                                   = &Icon{src: &icons.AVReplay5, lig: "replay_5"}
+	AVShuffle                                   = &Icon{src: &icons.AVShuffle, lig: "shuffle"}
+	AVSkipNext                                  = &Icon{src: &icons.AVSkipNext, lig: "skip_next"}
+	AVSkipPrevious                              = &Icon{src: &icons.AVSkipPrevious, lig: "skip_previous"}
+	AVSlowMotionVideo                           = &Icon{src: &icons.AVSlowMotionVideo, lig: "slow_motion_video"}
+	AVSnooze                                    = &Icon{src: &icons.AVSnooze, lig: "snooze"}
+	AVSortByAlpha                               = &Icon{src: &icons.AVSortByAlpha, lig: "sort_by_alpha"}
+	AVStop                                      = &Icon{src: &icons.AVStop, lig: "stop"}
+	AVSubscriptions                             = &Icon{src: &icons.AVSubscriptions, lig: "subscriptions"}
+	AVSubtitles                                 = &Icon{src: &icons.AVSubtitles, lig: "subtitles"}
+	AVSurroundSound                             = &Icon{src: &icons.AVSurroundSound, lig: "surround_sound"}
+	AVVideoCall                                 = &Icon{src: &icons.AVVideoCall, lig: "video_call"}
+	AVVideoLabel                                = &Icon{src: &icons.AVVideoLabel, lig: "video_label"}
+	AVVideoLibrary                              = &Icon{src: &icons.AVVideoLibrary, lig: "video_library"}
+	AVVideocam                                  = &Icon{src: &icons.AVVideocam, lig: "videocam"}
+	AVVideocamOff                               = &Icon{src: &icons.AVVideocamOff, lig: "videocam_off"}
+	AVVolumeDown                                = &Icon{src: &icons.AVVolumeDown, lig: "volume_down"}
+	AVVolumeMute                                = &Icon{src: &icons.AVVolumeMute, lig: "volume_mute"}
+	AVVolumeOff                                 = &Icon{src: &icons.AVVolumeOff, lig: "volume_off"}
+	AVVolumeUp                                  = &Icon{src: &icons.AVVolumeUp, lig: "volume_up"}
+	AVWeb                                       = &Icon{src: &icons.AVWeb, lig: "web"}
+	AVWebAsset                                  = &Icon{src: &icons.AVWebAsset, lig: "web_asset"}
+	Action3DRotation                            = &Icon{src: &icons.Action3DRotation, lig: "3d_rotation"}
+	ActionAccessibility                         = &Icon{src: &icons.ActionAccessibility, lig: "accessibility"}
+	ActionAccessible                            = &Icon{src: &icons.ActionAccessible, lig: "accessible"}
+	ActionAccountBalance                        = &Icon{src: &icons.ActionAccountBalance, lig: "account_balance"}
+	ActionAccountBalanceWallet                  = &Icon{src: &icons.ActionAccountBalanceWallet, lig: "account_balance_wallet"}
+	ActionAccountBox                            = &Icon{src: &icons.ActionAccountBox, lig: "account_box"}
+	ActionAccountCircle                         = &Icon{src: &icons.ActionAccountCircle, lig: "account_circle"}
+	ActionAddShoppingCart                       = &Icon{src: &icons.ActionAddShoppingCart, lig: "add_shopping_cart"}
+	ActionAlarm                                 = &Icon{src: &icons.ActionAlarm, lig: "alarm"}
+	ActionAlarmAdd                              = &Icon{src: &icons.ActionAlarmAdd, lig: "alarm_add"}
+	ActionAlarmOff                              = &Icon{src: &icons.ActionAlarmOff, lig: "alarm_off"}
+	ActionAlarmOn                               = &Icon{src: &icons.ActionAlarmOn, lig: "alarm_on"}
+	ActionAllOut                                = &Icon{src: &icons.ActionAllOut, lig: "all_out"}
+	ActionAndroid                               = &Icon{src: &icons.ActionAndroid, lig: "android"}
+	ActionAnnouncement                          = &Icon{src: &icons.ActionAnnouncement, lig: "announcement"}
+	ActionAspectRatio                           = &Icon{src: &icons.ActionAspectRatio, lig: "aspect_ratio"}
+	ActionAssessment                            = &Icon{src: &icons.ActionAssessment, lig: "assessment"}
+	ActionAssignment                            = &Icon{src: &icons.ActionAssignment, lig: "assignment"}
+	ActionAssignmentInd                         = &Icon{src: &icons.ActionAssignmentInd, lig: "assignment_ind"}
+	ActionAssignmentLate                        = &Icon{src: &icons.ActionAssignmentLate, lig: "assignment_late"}
+	ActionAssignmentReturn                      = &Icon{src: &icons.ActionAssignmentReturn, lig: "assignment_return"}
+	ActionAssignmentReturned                    = &Icon{src: &icons.ActionAssignmentReturned, lig: "assignment_returned"}
+	ActionAssignmentTurnedIn                    = &Icon{src: &icons.ActionAssignmentTurnedIn, lig: "assignment_turned_in"}
+	ActionAutorenew                             = &Icon{src: &icons.ActionAutorenew, lig: "autorenew"}
+	ActionBackup                                = &Icon{src: &icons.ActionBackup, lig: "backup"}
+	ActionBook                                  = &Icon{src: &icons.ActionBook, lig: "book"}
+	ActionBookmark                              = &Icon{src: &icons.ActionBookmark, lig: "bookmark"}
+	ActionBookmarkBorder                        = &Icon{src: &icons.ActionBookmarkBorder, lig: "bookmark_border"}
+	ActionBugReport                             = &Icon{src: &icons.ActionBugReport, lig: "bug_report"}
+	ActionBuild                                 = &Icon{src: &icons.ActionBuild, lig: "build"}
+	ActionCached                                = &Icon{src: &icons.ActionCached, lig: "cached"}
+	ActionCameraEnhance                         = &Icon{src: &icons.ActionCameraEnhance, lig: "camera_enhance"}
+	ActionCardGiftcard                          = &Icon{src: &icons.ActionCardGiftcard, lig: "card_giftcard"}
+	ActionCardMembership                        = &Icon{src: &icons.ActionCardMembership, lig: "card_membership"}
+	ActionCardTravel                            = &Icon{src: &icons.ActionCardTravel, lig: "card_travel"}
+	ActionChangeHistory                         = &Icon{src: &icons.ActionChangeHistory, lig: "change_history"}
+	ActionCheckCircle                           = &Icon{src: &icons.ActionCheckCircle, lig: "check_circle"}
+	ActionChromeReaderMode                      = &Icon{src: &icons.ActionChromeReaderMode, lig: "chrome_reader_mode"}
+	ActionClass                                 = ActionBook
+	ActionCode                                  = &Icon{src: &icons.ActionCode, lig: "code"}
+	ActionCompareArrows                         = &Icon{src: &icons.ActionCompareArrows, lig: "compare_arrows"}
+	ActionCopyright                             = &Icon{src: &icons.ActionCopyright, lig: "copyright"}
+	ActionCreditCard                            = &Icon{src: &icons.ActionCreditCard, lig: "credit_card"}
+	ActionDNS                                   = &Icon{src: &icons.ActionDNS, lig: "dns"}
+	ActionDashboard                             = &Icon{src: &icons.ActionDashboard, lig: "dashboard"}
+	ActionDateRange                             = &Icon{src: &icons.ActionDateRange, lig: "date_range"}
+	ActionDelete                                = &Icon{src: &icons.ActionDelete, lig: "delete"}
+	ActionDeleteForever                         = &Icon{src: &icons.ActionDeleteForever, lig: "delete_forever"}
+	ActionDescription                           = &Icon{src: &icons.ActionDescription, lig: "description"}
+	ActionDone                                  = &Icon{src: &icons.ActionDone, lig: "done"}
+	ActionDoneAll                               = &Icon{src: &icons.ActionDoneAll, lig: "done_all"}
+	ActionDonutLarge                            = &Icon{src: &icons.ActionDonutLarge, lig: "donut_large"}
+	ActionDonutSmall                            = &Icon{src: &icons.ActionDonutSmall, lig: "donut_small"}
+	ActionEject                                 = &Icon{src: &icons.ActionEject, lig: "eject"}
+	ActionEuroSymbol                            = &Icon{src: &icons.ActionEuroSymbol, lig: "euro_symbol"}
+	ActionEvent                                 = &Icon{src: &icons.ActionEvent, lig: "event"}
+	ActionEventSeat                             = &Icon{src: &icons.ActionEventSeat, lig: "event_seat"}
+	ActionExitToApp                             = &Icon{src: &icons.ActionExitToApp, mirror: true, lig: "exit_to_app"}
+	ActionExplore                               = &Icon{src: &icons.ActionExplore, lig: "explore"}
+	ActionExtension                             = &Icon{src: &icons.ActionExtension, lig: "extension"}
+	ActionFace                                  = &Icon{src: &icons.ActionFace, lig: "face"}
+	ActionFavorite                              = &Icon{src: &icons.ActionFavorite, lig: "favorite"}
+	ActionFavoriteBorder                        = &Icon{src: &icons.ActionFavoriteBorder, lig: "favorite_border"}
+	ActionFeedback                              = &Icon{src: &icons.ActionFeedback, lig: "feedback"}
+	ActionFindInPage                            = &Icon{src: &icons.ActionFindInPage, lig: "find_in_page"}
+	ActionFindReplace                           = &Icon{src: &icons.ActionFindReplace, lig: "find_replace"}
+	ActionFingerprint                           = &Icon{src: &icons.ActionFingerprint, lig: "fingerprint"}
+	ActionFlightLand                            = &Icon{src: &icons.ActionFlightLand, lig: "flight_land"}
+	ActionFlightTakeoff                         = &Icon{src: &icons.ActionFlightTakeoff, lig: "flight_takeoff"}
+	ActionFlipToBack                            = &Icon{src: &icons.ActionFlipToBack, lig: "flip_to_back"}
+	ActionFlipToFront                           = &Icon{src: &icons.ActionFlipToFront, lig: "flip_to_front"}
+	ActionGIF                                   = &Icon{src: &icons.ActionGIF, lig: "gif"}
+	ActionGTranslate                            = &Icon{src: &icons.ActionGTranslate, lig: "g_translate"}
+	ActionGavel                                 = &Icon{src: &icons.ActionGavel, lig: "gavel"}
+	ActionGetApp                                = &Icon{src: &icons.ActionGetApp, lig: "get_app"}
+	ActionGrade                                 = &Icon{src: &icons.ActionGrade, lig: "grade"}
+	ActionGroupWork                             = &Icon{src: &icons.ActionGroupWork, lig: "group_work"}
+	ActionHTTP                                  = &Icon{src: &icons.ActionHTTP, lig: "http"}
+	ActionHTTPS                                 = &Icon{src: &icons.ActionHTTPS, lig: "https"}
+	ActionHelp                                  = &Icon{src: &icons.ActionHelp, lig: "help"}
+	ActionHelpOutline                           = &Icon{src: &icons.ActionHelpOutline, lig: "help_outline"}
+	ActionHighlightOff                          = &Icon{src: &icons.ActionHighlightOff, lig: "highlight_off"}
+	ActionHistory                               = &Icon{src: &icons.ActionHistory, lig: "history"}
+	ActionHome                                  = &Icon{src: &icons.ActionHome, lig: "home"}
+	ActionHourglassEmpty                        = &Icon{src: &icons.ActionHourglassEmpty, lig: "hourglass_empty"}
+	ActionHourglassFull                         = &Icon{src: &icons.ActionHourglassFull, lig: "hourglass_full"}
+	ActionImportantDevices                      = &Icon{src: &icons.ActionImportantDevices, lig: "important_devices"}
+	ActionInfo                                  = &Icon{src: &icons.ActionInfo, lig: "info"}
+	ActionInfoOutline                           = &Icon{src: &icons.ActionInfoOutline, lig: "info_outline"}
+	ActionInput                                 = &Icon{src: &icons.ActionInput, mirror: true, lig: "input"}
+	ActionInvertColors                          = &Icon{src: &icons.ActionInvertColors, lig: "invert_colors"}
+	ActionLabel                                 = &Icon{src: &icons.ActionLabel, mirror: true, lig: "label"}
+	ActionLabelOutline                          = &Icon{src: &icons.ActionLabelOutline, mirror: true, lig: "label_outline"}
+	ActionLanguage                              = &Icon{src: &icons.ActionLanguage, lig: "language"}
+	ActionLaunch                                = &Icon{src: &icons.ActionLaunch, mirror: true, lig: "launch"}
+	ActionLightbulbOutline                      = &Icon{src: &icons.ActionLightbulbOutline, lig: "lightbulb_outline"}
+	ActionLineStyle                             = &Icon{src: &icons.ActionLineStyle, lig: "line_style"}
+	ActionLineWeight                            = &Icon{src: &icons.ActionLineWeight, lig: "line_weight"}
+	ActionList                                  = &Icon{src: &icons.ActionList, mirror: true, lig: "list"}
+	ActionLock                                  = ActionHTTPS
+	ActionLockOpen                              = &Icon{src: &icons.ActionLockOpen, lig: "lock_open"}
+	ActionLockOutline                           = &Icon{src: &icons.ActionLockOutline, lig: "lock_outline"}
+	ActionLoyalty                               = &Icon{src: &icons.ActionLoyalty, lig: "loyalty"}
+	ActionMarkUnreadMailbox                     = &Icon{src: &icons.ActionMarkUnreadMailbox, lig: "markunread_mailbox"}
+	ActionMotorcycle                            = &Icon{src: &icons.ActionMotorcycle, lig: "motorcycle"}
+	ActionNoteAdd                               = &Icon{src: &icons.ActionNoteAdd, lig: "note_add"}
+	ActionOfflinePin                            = &Icon{src: &icons.ActionOfflinePin, lig: "offline_pin"}
+	ActionOpacity                               = &Icon{src: &icons.ActionOpacity, lig: "opacity"}
+	ActionOpenInBrowser                         = &Icon{src: &icons.ActionOpenInBrowser, lig: "open_in_browser"}
+	ActionOpenInNew                             = ActionLaunch
+	ActionOpenWith                              = &Icon{src: &icons.ActionOpenWith, lig: "open_with"}
+	ActionPageview                              = &Icon{src: &icons.ActionPageview, lig: "pageview"}
+	ActionPanTool                               = &Icon{src: &icons.ActionPanTool, lig: "pan_tool"}
+	ActionPayment                               = ActionCreditCard
+	ActionPermCameraMic                         = &Icon{src: &icons.ActionPermCameraMic, lig: "perm_camera_mic"}
+	ActionPermContactCalendar                   = &Icon{src: &icons.ActionPermContactCalendar, lig: "perm_contact_calendar"}
+	ActionPermDataSetting                       = &Icon{src: &icons.ActionPermDataSetting, lig: "perm_data_setting"}
+	ActionPermDeviceInformation                 = &Icon{src: &icons.ActionPermDeviceInformation, lig: "perm_device_information"}
+	ActionPermIdentity                          = &Icon{src: &icons.ActionPermIdentity, lig: "perm_identity"}
+	ActionPermMedia                             = &Icon{src: &icons.ActionPermMedia, lig: "perm_media"}
+	ActionPermPhoneMsg                          = &Icon{src: &icons.ActionPermPhoneMsg, lig: "perm_phone_msg"}
+	ActionPermScanWiFi                          = &Icon{src: &icons.ActionPermScanWiFi, lig: "perm_scan_wifi"}
+	ActionPets                                  = &Icon{src: &icons.ActionPets, lig: "pets"}
+	ActionPictureInPicture                      = &Icon{src: &icons.ActionPictureInPicture, lig: "picture_in_picture"}
+	ActionPictureInPictureAlt                   = &Icon{src: &icons.ActionPictureInPictureAlt, lig: "picture_in_picture_alt"}
+	ActionPlayForWork                           = &Icon{src: &icons.ActionPlayForWork, lig: "play_for_work"}
+	ActionPolymer                               = &Icon{src: &icons.ActionPolymer, lig: "polymer"}
+	ActionPowerSettingsNew                      = &Icon{src: &icons.ActionPowerSettingsNew, lig: "power_settings_new"}
+	ActionPregnantWoman                         = &Icon{src: &icons.ActionPregnantWoman, lig: "pregnant_woman"}
+	ActionPrint                                 = &Icon{src: &icons.ActionPrint, lig: "print"}
+	ActionQueryBuilder                          = &Icon{src: &icons.ActionQueryBuilder, lig: "query_builder"}
+	ActionQuestionAnswer                        = &Icon{src: &icons.ActionQuestionAnswer, mirror: true, lig: "question_answer"}
+	ActionReceipt                               = &Icon{src: &icons.ActionReceipt, lig: "receipt"}
+	ActionRecordVoiceOver                       = &Icon{src: &icons.ActionRecordVoiceOver, lig: "record_voice_over"}
+	ActionRedeem                                = ActionCardGiftcard
+	ActionRemoveShoppingCart                    = &Icon{src: &icons.ActionRemoveShoppingCart, lig: "remove_shopping_cart"}
+	ActionReorder                               = &Icon{src: &icons.ActionReorder, lig: "reorder"}
+	ActionReportProblem                         = &Icon{src: &icons.ActionReportProblem, lig: "report_problem"}
+	ActionRestore                               = ActionHistory
+	ActionRestorePage                           = &Icon{src: &icons.ActionRestorePage, lig: "restore_page"}
+	ActionRoom                                  = &Icon{src: &icons.ActionRoom, lig: "room"}
+	ActionRoundedCorner                         = &Icon{src: &icons.ActionRoundedCorner, lig: "rounded_corner"}
+	ActionRowing                                = &Icon{src: &icons.ActionRowing, lig: "rowing"}
+	ActionSchedule                              = ActionQueryBuilder
+	ActionSearch                                = &Icon{src: &icons.ActionSearch, lig: "search"}
+	ActionSettings                              = &Icon{src: &icons.ActionSettings, lig: "settings"}
+	ActionSettingsApplications                  = &Icon{src: &icons.ActionSettingsApplications, lig: "settings_applications"}
+	ActionSettingsBackupRestore                 = &Icon{src: &icons.ActionSettingsBackupRestore, lig: "settings_backup_restore"}
+	ActionSettingsBluetooth                     = &Icon{src: &icons.ActionSettingsBluetooth, lig: "settings_bluetooth"}
+	ActionSettingsBrightness                    = &Icon{src: &icons.ActionSettingsBrightness, lig: "settings_brightness"}
+	ActionSettingsCell                          = &Icon{src: &icons.ActionSettingsCell, lig: "settings_cell"}
+	ActionSettingsEthernet                      = &Icon{src: &icons.ActionSettingsEthernet, lig: "settings_ethernet"}
+	ActionSettingsInputAntenna                  = &Icon{src: &icons.ActionSettingsInputAntenna, lig: "settings_input_antenna"}
+	ActionSettingsInputComponent                = &Icon{src: &icons.ActionSettingsInputComponent, lig: "settings_input_component"}
+	ActionSettingsInputComposite                = ActionSettingsInputComponent
+	ActionSettingsInputHDMI                     = &Icon{src: &icons.ActionSettingsInputHDMI, lig: "settings_input_hdmi"}
+	ActionSettingsInputSVideo                   = &Icon{src: &icons.ActionSettingsInputSVideo, lig: "settings_input_svideo"}
+	ActionSettingsOverscan                      = &Icon{src: &icons.ActionSettingsOverscan, lig: "settings_overscan"}
+	ActionSettingsPhone                         = &Icon{src: &icons.ActionSettingsPhone, lig: "settings_phone"}
+	ActionSettingsPower                         = &Icon{src: &icons.ActionSettingsPower, lig: "settings_power"}
+	ActionSettingsRemote                        = &Icon{src: &icons.ActionSettingsRemote, lig: "settings_remote"}
+	ActionSettingsVoice                         = &Icon{src: &icons.ActionSettingsVoice, lig: "settings_voice"}
+	ActionShop                                  = &Icon{src: &icons.ActionShop, lig: "shop"}
+	ActionShopTwo                               = &Icon{src: &icons.ActionShopTwo, lig: "shop_two"}
+	ActionShoppingBasket                        = &Icon{src: &icons.ActionShoppingBasket, lig: "shopping_basket"}
+	ActionShoppingCart                          = &Icon{src: &icons.ActionShoppingCart, lig: "shopping_cart"}
+	ActionSpeakerNotes                          = &Icon{src: &icons.ActionSpeakerNotes, lig: "speaker_notes"}
+	ActionSpeakerNotesOff                       = &Icon{src: &icons.ActionSpeakerNotesOff, lig: "speaker_notes_off"}
+	ActionSpellcheck                            = &Icon{src: &icons.ActionSpellcheck, lig: "spellcheck"}
+	ActionStarRate                              = &Icon{src: &icons.ActionStarRate}
+	ActionStars                                 = &Icon{src: &icons.ActionStars, lig: "stars"}
+	ActionStore                                 = &Icon{src: &icons.ActionStore, lig: "store"}
+	ActionSubject                               = &Icon{src: &icons.ActionSubject, mirror: true, lig: "subject"}
+	ActionSupervisorAccount                     = &Icon{src: &icons.ActionSupervisorAccount, lig: "supervisor_account"}
+	ActionSwapHoriz                             = &Icon{src: &icons.ActionSwapHoriz, lig: "swap_horiz"}
+	ActionSwapVert                              = &Icon{src: &icons.ActionSwapVert, lig: "swap_vert"}
+	ActionSwapVerticalCircle                    = &Icon{src: &icons.ActionSwapVerticalCircle, lig: "swap_vertical_circle"}
+	ActionSystemUpdateAlt                       = &Icon{src: &icons.ActionSystemUpdateAlt, lig: "system_update_alt"}
+	ActionTOC                                   = &Icon{src: &icons.ActionTOC, mirror: true, lig: "toc"}
+	ActionTab                                   = &Icon{src: &icons.ActionTab, lig: "tab"}
+	ActionTabUnselected                         = &Icon{src: &icons.ActionTabUnselected, lig: "tab_unselected"}
+	ActionTheaters                              = &Icon{src: &icons.ActionTheaters, lig: "theaters"}
+	ActionThumbDown                             = &Icon{src: &icons.ActionThumbDown, lig: "thumb_down"}
+	ActionThumbUp                               = &Icon{src: &icons.ActionThumbUp, lig: "thumb_up"}
+	ActionThumbsUpDown                          = &Icon{src: &icons.ActionThumbsUpDown, lig: "thumbs_up_down"}
+	ActionTimeline                              = &Icon{src: &icons.ActionTimeline, lig: "timeline"}
+	ActionToday                                 = &Icon{src: &icons.ActionToday, lig: "today"}
+	ActionToll                                  = &Icon{src: &icons.ActionToll, lig: "toll"}
+	ActionTouchApp                              = &Icon{src: &icons.ActionTouchApp, lig: "touch_app"}
+	ActionTrackChanges                          = &Icon{src: &icons.ActionTrackChanges, lig: "track_changes"}
+	ActionTranslate                             = &Icon{src: &icons.ActionTranslate, lig: "translate"}
+	ActionTrendingDown                          = &Icon{src: &icons.ActionTrendingDown, mirror: true, lig: "trending_down"}
+	ActionTrendingFlat                          = &Icon{src: &icons.ActionTrendingFlat, mirror: true, lig: "trending_flat"}
+	ActionTrendingUp                            = &Icon{src: &icons.ActionTrendingUp, mirror: true, lig: "trending_up"}
+	ActionTurnedIn                              = ActionBookmark
+	ActionTurnedInNot                           = ActionBookmarkBorder
+	ActionUpdate                                = &Icon{src: &icons.ActionUpdate, lig: "update"}
+	ActionVerifiedUser                          = &Icon{src: &icons.ActionVerifiedUser, lig: "verified_user"}
+	ActionViewAgenda                            = &Icon{src: &icons.ActionViewAgenda, lig: "view_agenda"}
+	ActionViewArray                             = &Icon{src: &icons.ActionViewArray, lig: "view_array"}
+	ActionViewCarousel                          = &Icon{src: &icons.ActionViewCarousel, lig: "view_carousel"}
+	ActionViewColumn                            = &Icon{src: &icons.ActionViewColumn, lig: "view_column"}
+	ActionViewDay                               = &Icon{src: &icons.ActionViewDay, lig: "view_day"}
+	ActionViewHeadline                          = &Icon{src: &icons.ActionViewHeadline, lig: "view_headline"}
+	ActionViewList                              = &Icon{src: &icons.ActionViewList, mirror: true, lig: "view_list"}
+	ActionViewModule                            = &Icon{src: &icons.ActionViewModule, lig: "view_module"}
+	ActionViewQuilt                             = &Icon{src: &icons.ActionViewQuilt, lig: "view_quilt"}
+	ActionViewStream                            = &Icon{src: &icons.ActionViewStream, lig: "view_stream"}
+	ActionViewWeek                              = &Icon{src: &icons.ActionViewWeek, lig: "view_week"}
+	ActionVisibility                            = &Icon{src: &icons.ActionVisibility, lig: "visibility"}
+	ActionVisibilityOff                         = &Icon{src: &icons.ActionVisibilityOff, lig: "visibility_off"}
+	ActionWatchLater                            = &Icon{src: &icons.ActionWatchLater, lig: "watch_later"}
+	ActionWork                                  = &Icon{src: &icons.ActionWork, lig: "work"}
+	ActionYoutubeSearchedFor                    = &Icon{src: &icons.ActionYoutubeSearchedFor, lig: "youtube_searched_for"}
+	ActionZoomIn                                = &Icon{src: &icons.ActionZoomIn, lig: "zoom_in"}
+	ActionZoomOut                               = &Icon{src: &icons.ActionZoomOut, lig: "zoom_out"}
+	AlertAddAlert                               = &Icon{src: &icons.AlertAddAlert, lig: "add_alert"}
+	AlertError                                  = &Icon{src: &icons.AlertError, lig: "error"}
+	AlertErrorOutline                           = &Icon{src: &icons.AlertErrorOutline, lig: "error_outline"}
+	AlertWarning                                = ActionReportProblem
+	CommunicationBusiness                       = &Icon{src: &icons.CommunicationBusiness, lig: "business"}
+	CommunicationCall                           = &Icon{src: &icons.CommunicationCall, lig: "call"}
+	CommunicationCallEnd                        = &Icon{src: &icons.CommunicationCallEnd, lig: "call_end"}
+	CommunicationCallMade                       = &Icon{src: &icons.CommunicationCallMade, mirror: true, lig: "call_made"}
+	CommunicationCallMerge                      = &Icon{src: &icons.CommunicationCallMerge, mirror: true, lig: "call_merge"}
+	CommunicationCallMissed                     = &Icon{src: &icons.CommunicationCallMissed, mirror: true, lig: "call_missed"}
+	CommunicationCallMissedOutgoing             = &Icon{src: &icons.CommunicationCallMissedOutgoing, mirror: true, lig: "call_missed_outgoing"}
+	CommunicationCallReceived                   = &Icon{src: &icons.CommunicationCallReceived, mirror: true, lig: "call_received"}
+	CommunicationCallSplit                      = &Icon{src: &icons.CommunicationCallSplit, mirror: true, lig: "call_split"}
+	CommunicationChat                           = &Icon{src: &icons.CommunicationChat, mirror: true, lig: "chat"}
+	CommunicationChatBubble                     = &Icon{src: &icons.CommunicationChatBubble, lig: "chat_bubble"}
+	CommunicationChatBubbleOutline              = &Icon{src: &icons.CommunicationChatBubbleOutline, lig: "chat_bubble_outline"}
+	CommunicationClearAll                       = &Icon{src: &icons.CommunicationClearAll, lig: "clear_all"}
+	CommunicationComment                        = &Icon{src: &icons.CommunicationComment, mirror: true, lig: "comment"}
+	CommunicationContactMail                    = &Icon{src: &icons.CommunicationContactMail, lig: "contact_mail"}
+	CommunicationContactPhone                   = &Icon{src: &icons.CommunicationContactPhone, lig: "contact_phone"}
+	CommunicationContacts                       = &Icon{src: &icons.CommunicationContacts, lig: "contacts"}
+	CommunicationDialerSIP                      = &Icon{src: &icons.CommunicationDialerSIP, lig: "dialer_sip"}
+	CommunicationDialpad                        = &Icon{src: &icons.CommunicationDialpad, lig: "dialpad"}
+	CommunicationEmail                          = &Icon{src: &icons.CommunicationEmail, lig: "email"}
+	CommunicationForum                          = ActionQuestionAnswer
+	CommunicationImportContacts                 = &Icon{src: &icons.CommunicationImportContacts, lig: "import_contacts"}
+	CommunicationImportExport                   = &Icon{src: &icons.CommunicationImportExport, lig: "import_export"}
+	CommunicationInvertColorsOff                = &Icon{src: &icons.CommunicationInvertColorsOff, lig: "invert_colors_off"}
+	CommunicationLiveHelp                       = &Icon{src: &icons.CommunicationLiveHelp, lig: "live_help"}
+	CommunicationLocationOff                    = &Icon{src: &icons.CommunicationLocationOff, lig: "location_off"}
+	CommunicationLocationOn                     = ActionRoom
+	CommunicationMailOutline                    = &Icon{src: &icons.CommunicationMailOutline, lig: "mail_outline"}
+	CommunicationMessage                        = &Icon{src: &icons.CommunicationMessage, mirror: true, lig: "message"}
+	CommunicationNoSIM                          = &Icon{src: &icons.CommunicationNoSIM, lig: "no_sim"}
+	CommunicationPhone                          = CommunicationCall
+	CommunicationPhoneLinkErase                 = &Icon{src: &icons.CommunicationPhoneLinkErase, lig: "phonelink_erase"}
+	CommunicationPhoneLinkLock                  = &Icon{src: &icons.CommunicationPhoneLinkLock, lig: "phonelink_lock"}
+	CommunicationPhoneLinkRing                  = &Icon{src: &icons.CommunicationPhoneLinkRing, lig: "phonelink_ring"}
+	CommunicationPhoneLinkSetup                 = &Icon{src: &icons.CommunicationPhoneLinkSetup, lig: "phonelink_setup"}
+	CommunicationPortableWiFiOff                = &Icon{src: &icons.CommunicationPortableWiFiOff, lig: "portable_wifi_off"}
+	CommunicationPresentToAll                   = &Icon{src: &icons.CommunicationPresentToAll, lig: "present_to_all"}
+	CommunicationRSSFeed                        = &Icon{src: &icons.CommunicationRSSFeed, lig: "rss_feed"}
+	CommunicationRingVolume                     = &Icon{src: &icons.CommunicationRingVolume, lig: "ring_volume"}
+	CommunicationScreenShare                    = &Icon{src: &icons.CommunicationScreenShare, lig: "screen_share"}
+	CommunicationSpeakerPhone                   = &Icon{src: &icons.CommunicationSpeakerPhone, lig: "speaker_phone"}
+	CommunicationStayCurrentLandscape           = &Icon{src: &icons.CommunicationStayCurrentLandscape, lig: "stay_current_landscape"}
+	CommunicationStayCurrentPortrait            = &Icon{src: &icons.CommunicationStayCurrentPortrait, lig: "stay_current_portrait"}
+	CommunicationStayPrimaryLandscape           = CommunicationStayCurrentLandscape
+	CommunicationStayPrimaryPortrait            = CommunicationStayCurrentPortrait
+	CommunicationStopScreenShare                = &Icon{src: &icons.CommunicationStopScreenShare, lig: "stop_screen_share"}
+	CommunicationSwapCalls                      = &Icon{src: &icons.CommunicationSwapCalls, lig: "swap_calls"}
+	CommunicationTextSMS                        = &Icon{src: &icons.CommunicationTextSMS, lig: "textsms"}
+	CommunicationVPNKey                         = &Icon{src: &icons.CommunicationVPNKey, lig: "vpn_key"}
+	CommunicationVoicemail                      = &Icon{src: &icons.CommunicationVoicemail, lig: "voicemail"}
+	ContentAdd                                  = &Icon{src: &icons.ContentAdd, lig: "add"}
+	ContentAddBox                               = &Icon{src: &icons.ContentAddBox, lig: "add_box"}
+	ContentAddCircle                            = &Icon{src: &icons.ContentAddCircle, lig: "add_circle"}
+	ContentAddCircleOutline                     = &Icon{src: &icons.ContentAddCircleOutline, lig: "add_circle_outline"}
+	ContentArchive                              = &Icon{src: &icons.ContentArchive, lig: "archive"}
+	ContentBackspace                            = &Icon{src: &icons.ContentBackspace, mirror: true, lig: "backspace"}
+	ContentBlock                                = &Icon{src: &icons.ContentBlock, lig: "block"}
+	ContentClear                                = &Icon{src: &icons.ContentClear, lig: "clear"}
+	ContentContentCopy                          = &Icon{src: &icons.ContentContentCopy, lig: "content_copy"}
+	ContentContentCut                           = &Icon{src: &icons.ContentContentCut, lig: "content_cut"}
+	ContentContentPaste                         = &Icon{src: &icons.ContentContentPaste, lig: "content_paste"}
+	ContentCreate                               = &Icon{src: &icons.ContentCreate, lig: "create"}
+	ContentDeleteSweep                          = &Icon{src: &icons.ContentDeleteSweep, lig: "delete_sweep"}
+	ContentDrafts                               = &Icon{src: &icons.ContentDrafts, lig: "drafts"}
+	ContentFilterList                           = &Icon{src: &icons.ContentFilterList, mirror: true, lig: "filter_list"}
+	ContentFlag                                 = &Icon{src: &icons.ContentFlag, lig: "flag"}
+	ContentFontDownload                         = &Icon{src: &icons.ContentFontDownload, lig: "font_download"}
+	ContentForward                              = &Icon{src: &icons.ContentForward, mirror: true, lig: "forward"}
+	ContentGesture                              = &Icon{src: &icons.ContentGesture, lig: "gesture"}
+	ContentInbox                                = &Icon{src: &icons.ContentInbox, lig: "inbox"}
+	ContentLink                                 = &Icon{src: &icons.ContentLink, lig: "link"}
+	ContentLowPriority                          = &Icon{src: &icons.ContentLowPriority, lig: "low_priority"}
+	ContentMail                                 = CommunicationEmail
+	ContentMarkUnread                           = CommunicationEmail
+	ContentMoveToInbox                          = &Icon{src: &icons.ContentMoveToInbox, lig: "move_to_inbox"}
+	ContentNextWeek                             = &Icon{src: &icons.ContentNextWeek, lig: "next_week"}
+	ContentRedo                                 = &Icon{src: &icons.ContentRedo, mirror: true, lig: "redo"}
+	ContentRemove                               = &Icon{src: &icons.ContentRemove, lig: "remove"}
+	ContentRemoveCircle                         = &Icon{src: &icons.ContentRemoveCircle, lig: "remove_circle"}
+	ContentRemoveCircleOutline                  = &Icon{src: &icons.ContentRemoveCircleOutline, lig: "remove_circle_outline"}
+	ContentReply                                = &Icon{src: &icons.ContentReply, mirror: true, lig: "reply"}
+	ContentReplyAll                             = &Icon{src: &icons.ContentReplyAll, mirror: true, lig: "reply_all"}
+	ContentReport                               = &Icon{src: &icons.ContentReport, lig: "report"}
+	ContentSave                                 = &Icon{src: &icons.ContentSave, lig: "save"}
+	ContentSelectAll                            = &Icon{src: &icons.ContentSelectAll, lig: "select_all"}
+	ContentSend                                 = &Icon{src: &icons.ContentSend, mirror: true, lig: "send"}
+	ContentSort                                 = &Icon{src: &icons.ContentSort, mirror: true, lig: "sort"}
+	ContentTextFormat                           = &Icon{src: &icons.ContentTextFormat, lig: "text_format"}
+	ContentUnarchive                            = &Icon{src: &icons.ContentUnarchive, lig: "unarchive"}
+	ContentUndo                                 = &Icon{src: &icons.ContentUndo, mirror: true, lig: "undo"}
+	ContentWeekend                              = &Icon{src: &icons.ContentWeekend, lig: "weekend"}
+	DeviceAccessAlarm                           = ActionAlarm
+	DeviceAccessAlarms                          = &Icon{src: &icons.DeviceAccessAlarms, lig: "access_alarms"}
+	DeviceAccessTime                            = ActionQueryBuilder
+	DeviceAddAlarm                              = ActionAlarmAdd
+	DeviceAirplaneModeActive                    = &Icon{src: &icons.DeviceAirplaneModeActive, lig: "airplanemode_active"}
+	DeviceAirplaneModeInactive                  = &Icon{src: &icons.DeviceAirplaneModeInactive, lig: "airplanemode_inactive"}
+	DeviceBattery20                             = &Icon{src: &icons.DeviceBattery20}
+	DeviceBattery30                             = &Icon{src: &icons.DeviceBattery30}
+	DeviceBattery50                             = &Icon{src: &icons.DeviceBattery50}
+	DeviceBattery60                             = &Icon{src: &icons.DeviceBattery60}
+	DeviceBattery80                             = &Icon{src: &icons.DeviceBattery80}
+	DeviceBattery90                             = &Icon{src: &icons.DeviceBattery90}
+	DeviceBatteryAlert                          = &Icon{src: &icons.DeviceBatteryAlert, lig: "battery_alert"}
+	DeviceBatteryCharging20                     = &Icon{src: &icons.DeviceBatteryCharging20}
+	DeviceBatteryCharging30                     = &Icon{src: &icons.DeviceBatteryCharging30}
+	DeviceBatteryCharging50                     = &Icon{src: &icons.DeviceBatteryCharging50}
+	DeviceBatteryCharging60                     = &Icon{src: &icons.DeviceBatteryCharging60}
+	DeviceBatteryCharging80                     = &Icon{src: &icons.DeviceBatteryCharging80}
+	DeviceBatteryCharging90                     = &Icon{src: &icons.DeviceBatteryCharging90}
+	DeviceBatteryChargingFull                   = &Icon{src: &icons.DeviceBatteryChargingFull, lig: "battery_charging_full"}
+	DeviceBatteryFull                           = &Icon{src: &icons.DeviceBatteryFull, lig: "battery_full"}
+	DeviceBatteryStd                            = DeviceBatteryFull
+	DeviceBatteryUnknown                        = &Icon{src: &icons.DeviceBatteryUnknown, lig: "battery_unknown"}
+	DeviceBluetooth                             = &Icon{src: &icons.DeviceBluetooth, lig: "bluetooth"}
+	DeviceBluetoothConnected                    = &Icon{src: &icons.DeviceBluetoothConnected, lig: "bluetooth_connected"}
+	DeviceBluetoothDisabled                     = &Icon{src: &icons.DeviceBluetoothDisabled, lig: "bluetooth_disabled"}
+	DeviceBluetoothSearching                    = &Icon{src: &icons.DeviceBluetoothSearching, lig: "bluetooth_searching"}
+	DeviceBrightnessAuto                        = &Icon{src: &icons.DeviceBrightnessAuto, lig: "brightness_auto"}
+	DeviceBrightnessHigh                        = &Icon{src: &icons.DeviceBrightnessHigh, lig: "brightness_high"}
+	DeviceBrightnessLow                         = &Icon{src: &icons.DeviceBrightnessLow, lig: "brightness_low"}
+	DeviceBrightnessMedium                      = &Icon{src: &icons.DeviceBrightnessMedium, lig: "brightness_medium"}
+	DeviceDVR                                   = &Icon{src: &icons.DeviceDVR, lig: "dvr"}
+	DeviceDataUsage                             = &Icon{src: &icons.DeviceDataUsage, lig: "data_usage"}
+	DeviceDeveloperMode                         = &Icon{src: &icons.DeviceDeveloperMode, lig: "developer_mode"}
+	DeviceDevices                               = &Icon{src: &icons.DeviceDevices, lig: "devices"}
+	DeviceGPSFixed                              = &Icon{src: &icons.DeviceGPSFixed, lig: "gps_fixed"}
+	DeviceGPSNotFixed                           = &Icon{src: &icons.DeviceGPSNotFixed, lig: "gps_not_fixed"}
+	DeviceGPSOff                                = &Icon{src: &icons.DeviceGPSOff, lig: "gps_off"}
+	DeviceGraphicEq                             = &Icon{src: &icons.DeviceGraphicEq, lig: "graphic_eq"}
+	DeviceLocationDisabled                      = &Icon{src: &icons.DeviceLocationDisabled, lig: "location_disabled"}
+	DeviceLocationSearching                     = &Icon{src: &icons.DeviceLocationSearching, lig: "location_searching"}
+	DeviceNFC                                   = &Icon{src: &icons.DeviceNFC, lig: "nfc"}
+	DeviceNetworkCell                           = &Icon{src: &icons.DeviceNetworkCell, lig: "network_cell"}
+	DeviceNetworkWiFi                           = &Icon{src: &icons.DeviceNetworkWiFi, lig: "network_wifi"}
+	DeviceSDStorage                             = &Icon{src: &icons.DeviceSDStorage, lig: "sd_storage"}
+	DeviceScreenLockLandscape                   = &Icon{src: &icons.DeviceScreenLockLandscape, lig: "screen_lock_landscape"}
+	DeviceScreenLockPortrait                    = &Icon{src: &icons.DeviceScreenLockPortrait, lig: "screen_lock_portrait"}
+	DeviceScreenLockRotation                    = &Icon{src: &icons.DeviceScreenLockRotation, lig: "screen_lock_rotation"}
+	DeviceScreenRotation                        = &Icon{src: &icons.DeviceScreenRotation, lig: "screen_rotation"}
+	DeviceSettingsSystemDaydream                = &Icon{src: &icons.DeviceSettingsSystemDaydream, lig: "settings_system_daydream"}
+	DeviceSignalCellular0Bar                    = &Icon{src: &icons.DeviceSignalCellular0Bar}
+	DeviceSignalCellular1Bar                    = &Icon{src: &icons.DeviceSignalCellular1Bar}
+	DeviceSignalCellular2Bar                    = &Icon{src: &icons.DeviceSignalCellular2Bar}
+	DeviceSignalCellular3Bar                    = DeviceNetworkCell
+	DeviceSignalCellular4Bar                    = &Icon{src: &icons.DeviceSignalCellular4Bar, lig: "signal_cellular_4_bar"}
+	DeviceSignalCellularConnectedNoInternet0Bar = &Icon{src: &icons.DeviceSignalCellularConnectedNoInternet0Bar}
+	DeviceSignalCellularConnectedNoInternet1Bar = &Icon{src: &icons.DeviceSignalCellularConnectedNoInternet1Bar}
+	DeviceSignalCellularConnectedNoInternet2Bar = &Icon{src: &icons.DeviceSignalCellularConnectedNoInternet2Bar}
+	DeviceSignalCellularConnectedNoInternet3Bar = &Icon{src: &icons.DeviceSignalCellularConnectedNoInternet3Bar}
+	DeviceSignalCellularConnectedNoInternet4Bar = &Icon{src: &icons.DeviceSignalCellularConnectedNoInternet4Bar, lig: "signal_cellular_connected_no_internet_4_bar"}
+	DeviceSignalCellularNoSIM                   = CommunicationNoSIM
+	DeviceSignalCellularNull                    = &Icon{src: &icons.DeviceSignalCellularNull, lig: "signal_cellular_null"}
+	DeviceSignalCellularOff                     = &Icon{src: &icons.DeviceSignalCellularOff, lig: "signal_cellular_off"}
+	DeviceSignalWiFi0Bar                        = &Icon{src: &icons.DeviceSignalWiFi0Bar}
+	DeviceSignalWiFi1Bar                        = &Icon{src: &icons.DeviceSignalWiFi1Bar}
+	DeviceSignalWiFi1BarLock                    = &Icon{src: &icons.DeviceSignalWiFi1BarLock}
+	DeviceSignalWiFi2Bar                        = &Icon{src: &icons.DeviceSignalWiFi2Bar}
+	DeviceSignalWiFi2BarLock                    = &Icon{src: &icons.DeviceSignalWiFi2BarLock}
+	DeviceSignalWiFi3Bar                        = DeviceNetworkWiFi
+	DeviceSignalWiFi3BarLock                    = &Icon{src: &icons.DeviceSignalWiFi3BarLock}
+	DeviceSignalWiFi4Bar                        = &Icon{src: &icons.DeviceSignalWiFi4Bar, lig: "signal_wifi_4_bar"}
+	DeviceSignalWiFi4BarLock                    = &Icon{src: &icons.DeviceSignalWiFi4BarLock, lig: "signal_wifi_4_bar_lock"}
+	DeviceSignalWiFiOff                         = &Icon{src: &icons.DeviceSignalWiFiOff, lig: "signal_wifi_off"}
+	DeviceStorage                               = &Icon{src: &icons.DeviceStorage, lig: "storage"}
+	DeviceUSB                                   = &Icon{src: &icons.DeviceUSB, lig: "usb"}
+	DeviceWallpaper                             = &Icon{src: &icons.DeviceWallpaper, lig: "wallpaper"}
+	DeviceWiFiLock                              = &Icon{src: &icons.DeviceWiFiLock, lig: "wifi_lock"}
+	DeviceWiFiTethering                         = &Icon{src: &icons.DeviceWiFiTethering, lig: "wifi_tethering"}
+	DeviceWidgets                               = &Icon{src: &icons.DeviceWidgets, lig: "widgets"}
+	EditorAttachFile                            = &Icon{src: &icons.EditorAttachFile, lig: "attach_file"}
+	EditorAttachMoney                           = &Icon{src: &icons.EditorAttachMoney, lig: "attach_money"}
+	EditorBorderAll                             = &Icon{src: &icons.EditorBorderAll, lig: "border_all"}
+	EditorBorderBottom                          = &Icon{src: &icons.EditorBorderBottom, lig: "border_bottom"}
+	EditorBorderClear                           = &Icon{src: &icons.EditorBorderClear, lig: "border_clear"}
+	EditorBorderColor                           = &Icon{src: &icons.EditorBorderColor, lig: "border_color"}
+	EditorBorderHorizontal                      = &Icon{src: &icons.EditorBorderHorizontal, lig: "border_horizontal"}
+	EditorBorderInner                           = &Icon{src: &icons.EditorBorderInner, lig: "border_inner"}
+	EditorBorderLeft                            = &Icon{src: &icons.EditorBorderLeft, lig: "border_left"}
+	EditorBorderOuter                           = &Icon{src: &icons.EditorBorderOuter, lig: "border_outer"}
+	EditorBorderRight                           = &Icon{src: &icons.EditorBorderRight, lig: "border_right"}
+	EditorBorderStyle                           = &Icon{src: &icons.EditorBorderStyle, lig: "border_style"}
+	EditorBorderTop                             = &Icon{src: &icons.EditorBorderTop, lig: "border_top"}
+	EditorBorderVertical                        = &Icon{src: &icons.EditorBorderVertical, lig: "border_vertical"}
+	EditorBubbleChart                           = &Icon{src: &icons.EditorBubbleChart, lig: "bubble_chart"}
+	EditorDragHandle                            = &Icon{src: &icons.EditorDragHandle, lig: "drag_handle"}
+	EditorFormatAlignCenter                     = &Icon{src: &icons.EditorFormatAlignCenter, lig: "format_align_center"}
+	EditorFormatAlignJustify                    = &Icon{src: &icons.EditorFormatAlignJustify, lig: "format_align_justify"}
+	EditorFormatAlignLeft                       = &Icon{src: &icons.EditorFormatAlignLeft, lig: "format_align_left"}
+	EditorFormatAlignRight                      = &Icon{src: &icons.EditorFormatAlignRight, lig: "format_align_right"}
+	EditorFormatBold                            = &Icon{src: &icons.EditorFormatBold, lig: "format_bold"}
+	EditorFormatClear                           = &Icon{src: &icons.EditorFormatClear, lig: "format_clear"}
+	EditorFormatColorFill                       = &Icon{src: &icons.EditorFormatColorFill, lig: "format_color_fill"}
+	EditorFormatColorReset                      = &Icon{src: &icons.EditorFormatColorReset, lig: "format_color_reset"}
+	EditorFormatColorText                       = &Icon{src: &icons.EditorFormatColorText, lig: "format_color_text"}
+	EditorFormatIndentDecrease                  = &Icon{src: &icons.EditorFormatIndentDecrease, mirror: true, lig: "format_indent_decrease"}
+	EditorFormatIndentIncrease                  = &Icon{src: &icons.EditorFormatIndentIncrease, mirror: true, lig: "format_indent_increase"}
+	EditorFormatItalic                          = &Icon{src: &icons.EditorFormatItalic, lig: "format_italic"}
+	EditorFormatLineSpacing                     = &Icon{src: &icons.EditorFormatLineSpacing, lig: "format_line_spacing"}
+	EditorFormatListBulleted                    = &Icon{src: &icons.EditorFormatListBulleted, mirror: true, lig: "format_list_bulleted"}
+	EditorFormatListNumbered                    = &Icon{src: &icons.EditorFormatListNumbered, mirror: true, lig: "format_list_numbered"}
+	EditorFormatPaint                           = &Icon{src: &icons.EditorFormatPaint, lig: "format_paint"}
+	EditorFormatQuote                           = &Icon{src: &icons.EditorFormatQuote, lig: "format_quote"}
+	EditorFormatShapes                          = &Icon{src: &icons.EditorFormatShapes, lig: "format_shapes"}
+	EditorFormatSize                            = &Icon{src: &icons.EditorFormatSize, lig: "format_size"}
+	EditorFormatStrikethrough                   = &Icon{src: &icons.EditorFormatStrikethrough, lig: "format_strikethrough"}
+	EditorFormatTextDirectionLToR               = &Icon{src: &icons.EditorFormatTextDirectionLToR, lig: "format_textdirection_l_to_r"}
+	EditorFormatTextDirectionRToL               = &Icon{src: &icons.EditorFormatTextDirectionRToL, lig: "format_textdirection_r_to_l"}
+	EditorFormatUnderlined                      = &Icon{src: &icons.EditorFormatUnderlined, lig: "format_underlined"}
+	EditorFunctions                             = &Icon{src: &icons.EditorFunctions, lig: "functions"}
+	EditorHighlight                             = &Icon{src: &icons.EditorHighlight, lig: "highlight"}
+	EditorInsertChart                           = ActionAssessment
+	EditorInsertComment                         = &Icon{src: &icons.EditorInsertComment, mirror: true, lig: "insert_comment"}
+	EditorInsertDriveFile                       = &Icon{src: &icons.EditorInsertDriveFile, lig: "insert_drive_file"}
+	EditorInsertEmoticon                        = &Icon{src: &icons.EditorInsertEmoticon, lig: "insert_emoticon"}
+	EditorInsertInvitation                      = ActionEvent
+	EditorInsertLink                            = ContentLink
+	EditorInsertPhoto                           = &Icon{src: &icons.EditorInsertPhoto, lig: "insert_photo"}
+	EditorLinearScale                           = &Icon{src: &icons.EditorLinearScale, lig: "linear_scale"}
+	EditorMergeType                             = CommunicationCallMerge
+	EditorModeComment                           = &Icon{src: &icons.EditorModeComment, mirror: true, lig: "mode_comment"}
+	EditorModeEdit                              = ContentCreate
+	EditorMonetizationOn                        = &Icon{src: &icons.EditorMonetizationOn, lig: "monetization_on"}
+	EditorMoneyOff                              = &Icon{src: &icons.EditorMoneyOff, lig: "money_off"}
+	EditorMultilineChart                        = &Icon{src: &icons.EditorMultilineChart, lig: "multiline_chart"}
+	EditorPieChart                              = &Icon{src: &icons.EditorPieChart, lig: "pie_chart"}
+	EditorPieChartOutlined                      = &Icon{src: &icons.EditorPieChartOutlined, lig: "pie_chart_outlined"}
+	EditorPublish                               = &Icon{src: &icons.EditorPublish, lig: "publish"}
+	EditorShortText                             = &Icon{src: &icons.EditorShortText, mirror: true, lig: "short_text"}
+	EditorShowChart                             = &Icon{src: &icons.EditorShowChart, lig: "show_chart"}
+	EditorSpaceBar                              = &Icon{src: &icons.EditorSpaceBar, lig: "space_bar"}
+	EditorStrikethroughS                        = &Icon{src: &icons.EditorStrikethroughS, lig: "strikethrough_s"}
+	EditorTextFields                            = &Icon{src: &icons.EditorTextFields, lig: "text_fields"}
+	EditorTitle                                 = &Icon{src: &icons.EditorTitle, lig: "title"}
+	EditorVerticalAlignBottom                   = &Icon{src: &icons.EditorVerticalAlignBottom, lig: "vertical_align_bottom"}
+	EditorVerticalAlignCenter                   = &Icon{src: &icons.EditorVerticalAlignCenter, lig: "vertical_align_center"}
+	EditorVerticalAlignTop                      = &Icon{src: &icons.EditorVerticalAlignTop, lig: "vertical_align_top"}
+	EditorWrapText                              = &Icon{src: &icons.EditorWrapText, mirror: true, lig: "wrap_text"}
+	FileAttachment                              = &Icon{src: &icons.FileAttachment, lig: "attachment"}
+	FileCloud                                   = &Icon{src: &icons.FileCloud, lig: "cloud"}
+	FileCloudCircle                             = &Icon{src: &icons.FileCloudCircle, lig: "cloud_circle"}
+	FileCloudDone                               = &Icon{src: &icons.FileCloudDone, lig: "cloud_done"}
+	FileCloudDownload                           = &Icon{src: &icons.FileCloudDownload, lig: "cloud_download"}
+	FileCloudOff                                = &Icon{src: &icons.FileCloudOff, lig: "cloud_off"}
+	FileCloudQueue                              = &Icon{src: &icons.FileCloudQueue, lig: "cloud_queue"}
+	FileCloudUpload                             = ActionBackup
+	FileCreateNewFolder                         = &Icon{src: &icons.FileCreateNewFolder, lig: "create_new_folder"}
+	FileFileDownload                            = ActionGetApp
+	FileFileUpload                              = &Icon{src: &icons.FileFileUpload, lig: "file_upload"}
+	FileFolder                                  = &Icon{src: &icons.FileFolder, lig: "folder"}
+	FileFolderOpen                              = &Icon{src: &icons.FileFolderOpen, lig: "folder_open"}
+	FileFolderShared                            = &Icon{src: &icons.FileFolderShared, lig: "folder_shared"}
+	HardwareCast                                = &Icon{src: &icons.HardwareCast, lig: "cast"}
+	HardwareCastConnected                       = &Icon{src: &icons.HardwareCastConnected, lig: "cast_connected"}
+	HardwareComputer                            = &Icon{src: &icons.HardwareComputer, lig: "computer"}
+	HardwareDesktopMac                          = &Icon{src: &icons.HardwareDesktopMac, lig: "desktop_mac"}
+	HardwareDesktopWindows                      = &Icon{src: &icons.HardwareDesktopWindows, lig: "desktop_windows"}
+	HardwareDeveloperBoard                      = &Icon{src: &icons.HardwareDeveloperBoard, lig: "developer_board"}
+	HardwareDeviceHub                           = &Icon{src: &icons.HardwareDeviceHub, lig: "device_hub"}
+	HardwareDevicesOther                        = &Icon{src: &icons.HardwareDevicesOther, lig: "devices_other"}
+	HardwareDock                                = &Icon{src: &icons.HardwareDock, lig: "dock"}
+	HardwareGamepad                             = AVGames
+	HardwareHeadset                             = &Icon{src: &icons.HardwareHeadset, lig: "headset"}
+	HardwareHeadsetMic                          = &Icon{src: &icons.HardwareHeadsetMic, lig: "headset_mic"}
+	HardwareKeyboard                            = &Icon{src: &icons.HardwareKeyboard, lig: "keyboard"}
+	HardwareKeyboardArrowDown                   = &Icon{src: &icons.HardwareKeyboardArrowDown, lig: "keyboard_arrow_down"}
+	HardwareKeyboardArrowLeft                   = &Icon{src: &icons.HardwareKeyboardArrowLeft, mirror: true, lig: "keyboard_arrow_left"}
+	HardwareKeyboardArrowRight                  = &Icon{src: &icons.HardwareKeyboardArrowRight, mirror: true, lig: "keyboard_arrow_right"}
+	HardwareKeyboardArrowUp                     = &Icon{src: &icons.HardwareKeyboardArrowUp, lig: "keyboard_arrow_up"}
+	HardwareKeyboardBackspace                   = &Icon{src: &icons.HardwareKeyboardBackspace, mirror: true, lig: "keyboard_backspace"}
+	HardwareKeyboardCapslock                    = &Icon{src: &icons.HardwareKeyboardCapslock, lig: "keyboard_capslock"}
+	HardwareKeyboardHide                        = &Icon{src: &icons.HardwareKeyboardHide, lig: "keyboard_hide"}
+	HardwareKeyboardReturn                      = &Icon{src: &icons.HardwareKeyboardReturn, mirror: true, lig: "keyboard_return"}
+	HardwareKeyboardTab                         = &Icon{src: &icons.HardwareKeyboardTab, mirror: true, lig: "keyboard_tab"}
+	HardwareKeyboardVoice                       = &Icon{src: &icons.HardwareKeyboardVoice, lig: "keyboard_voice"}
+	HardwareLaptop                              = &Icon{src: &icons.HardwareLaptop, lig: "laptop"}
+	HardwareLaptopChromebook                    = &Icon{src: &icons.HardwareLaptopChromebook, lig: "laptop_chromebook"}
+	HardwareLaptopMac                           = &Icon{src: &icons.HardwareLaptopMac, lig: "laptop_mac"}
+	HardwareLaptopWindows                       = &Icon{src: &icons.HardwareLaptopWindows, lig: "laptop_windows"}
+	HardwareMemory                              = &Icon{src: &icons.HardwareMemory, lig: "memory"}
+	HardwareMouse                               = &Icon{src: &icons.HardwareMouse, lig: "mouse"}
+	HardwarePhoneAndroid                        = &Icon{src: &icons.HardwarePhoneAndroid, lig: "phone_android"}
+	HardwarePhoneIPhone                         = &Icon{src: &icons.HardwarePhoneIPhone, lig: "phone_iphone"}
+	HardwarePhoneLink                           = DeviceDevices
+	HardwarePhoneLinkOff                        = &Icon{src: &icons.HardwarePhoneLinkOff, lig: "phonelink_off"}
+	HardwarePowerInput                          = &Icon{src: &icons.HardwarePowerInput, lig: "power_input"}
+	HardwareRouter                              = &Icon{src: &icons.HardwareRouter, lig: "router"}
+	HardwareSIMCard                             = &Icon{src: &icons.HardwareSIMCard, lig: "sim_card"}
+	HardwareScanner                             = &Icon{src: &icons.HardwareScanner, lig: "scanner"}
+	HardwareSecurity                            = &Icon{src: &icons.HardwareSecurity, lig: "security"}
+	HardwareSmartphone                          = &Icon{src: &icons.HardwareSmartphone, lig: "smartphone"}
+	HardwareSpeaker                             = &Icon{src: &icons.HardwareSpeaker, lig: "speaker"}
+	HardwareSpeakerGroup                        = &Icon{src: &icons.HardwareSpeakerGroup, lig: "speaker_group"}
+	HardwareTV                                  = &Icon{src: &icons.HardwareTV, lig: "tv"}
+	HardwareTablet                              = &Icon{src: &icons.HardwareTablet, lig: "tablet"}
+	HardwareTabletAndroid                       = &Icon{src: &icons.HardwareTabletAndroid, lig: "tablet_android"}
+	HardwareTabletMac                           = &Icon{src: &icons.HardwareTabletMac, lig: "tablet_mac"}
+	HardwareToys                                = &Icon{src: &icons.HardwareToys, lig: "toys"}
+	HardwareVideogameAsset                      = &Icon{src: &icons.HardwareVideogameAsset, lig: "videogame_asset"}
+	HardwareWatch                               = &Icon{src: &icons.HardwareWatch, lig: "watch"}
+	ImageAddAPhoto                              = &Icon{src: &icons.ImageAddAPhoto, lig: "add_a_photo"}
+	ImageAddToPhotos                            = AVLibraryAdd
+	ImageAdjust                                 = &Icon{src: &icons.ImageAdjust, lig: "adjust"}
+	ImageAssistant                              = &Icon{src: &icons.ImageAssistant, lig: "assistant"}
+	ImageAssistantPhoto                         = ContentFlag
+	ImageAudiotrack                             = &Icon{src: &icons.ImageAudiotrack, lig: "audiotrack"}
+	ImageBlurCircular                           = &Icon{src: &icons.ImageBlurCircular, lig: "blur_circular"}
+	ImageBlurLinear                             = &Icon{src: &icons.ImageBlurLinear, lig: "blur_linear"}
+	ImageBlurOff                                = &Icon{src: &icons.ImageBlurOff, lig: "blur_off"}
+	ImageBlurOn                                 = &Icon{src: &icons.ImageBlurOn, lig: "blur_on"}
+	ImageBrightness1                            = &Icon{src: &icons.ImageBrightness1, lig: "brightness_1"}
+	ImageBrightness2                            = &Icon{src: &icons.ImageBrightness2, lig: "brightness_2"}
+	ImageBrightness3                            = &Icon{src: &icons.ImageBrightness3, lig: "brightness_3"}
+	ImageBrightness4                            = &Icon{src: &icons.ImageBrightness4, lig: "brightness_4"}
+	ImageBrightness5                            = DeviceBrightnessLow
+	ImageBrightness6                            = DeviceBrightnessMedium
+	ImageBrightness7                            = DeviceBrightnessHigh
+	ImageBrokenImage                            = &Icon{src: &icons.ImageBrokenImage, lig: "broken_image"}
+	ImageBrush                                  = &Icon{src: &icons.ImageBrush, lig: "brush"}
+	ImageBurstMode                              = &Icon{src: &icons.ImageBurstMode, lig: "burst_mode"}
+	ImageCamera                                 = &Icon{src: &icons.ImageCamera, lig: "camera"}
+	ImageCameraAlt                              = &Icon{src: &icons.ImageCameraAlt, lig: "camera_alt"}
+	ImageCameraFront                            = &Icon{src: &icons.ImageCameraFront, lig: "camera_front"}
+	ImageCameraRear                             = &Icon{src: &icons.ImageCameraRear, lig: "camera_rear"}
+	ImageCameraRoll                             = &Icon{src: &icons.ImageCameraRoll, lig: "camera_roll"}
+	ImageCenterFocusStrong                      = &Icon{src: &icons.ImageCenterFocusStrong, lig: "center_focus_strong"}
+	ImageCenterFocusWeak                        = &Icon{src: &icons.ImageCenterFocusWeak, lig: "center_focus_weak"}
+	ImageCollections                            = &Icon{src: &icons.ImageCollections, lig: "collections"}
+	ImageCollectionsBookmark                    = &Icon{src: &icons.ImageCollectionsBookmark, lig: "collections_bookmark"}
+	ImageColorLens                              = &Icon{src: &icons.ImageColorLens, lig: "color_lens"}
+	ImageColorize                               = &Icon{src: &icons.ImageColorize, lig: "colorize"}
+	ImageCompare                                = &Icon{src: &icons.ImageCompare, lig: "compare"}
+	ImageControlPoint                           = &Icon{src: &icons.ImageControlPoint, lig: "control_point"}
+	ImageControlPointDuplicate                  = &Icon{src: &icons.ImageControlPointDuplicate, lig: "control_point_duplicate"}
+	ImageCrop                                   = &Icon{src: &icons.ImageCrop, lig: "crop"}
+	ImageCrop169                                = &Icon{src: &icons.ImageCrop169, lig: "crop_16_9"}
+	ImageCrop32                                 = &Icon{src: &icons.ImageCrop32, lig: "crop_3_2"}
+	ImageCrop54                                 = &Icon{src: &icons.ImageCrop54, lig: "crop_5_4"}
+	ImageCrop75                                 = &Icon{src: &icons.ImageCrop75, lig: "crop_7_5"}
+	ImageCropDIN                                = &Icon{src: &icons.ImageCropDIN, lig: "crop_din"}
+	ImageCropFree                               = &Icon{src: &icons.ImageCropFree, lig: "crop_free"}
+	ImageCropLandscape                          = ImageCrop54
+	ImageCropOriginal                           = &Icon{src: &icons.ImageCropOriginal, lig: "crop_original"}
+	ImageCropPortrait                           = &Icon{src: &icons.ImageCropPortrait, lig: "crop_portrait"}
+	ImageCropRotate                             = &Icon{src: &icons.ImageCropRotate, lig: "crop_rotate"}
+	ImageCropSquare                             = &Icon{src: &icons.ImageCropSquare, lig: "crop_square"}
+	ImageDehaze                                 = &Icon{src: &icons.ImageDehaze, lig: "dehaze"}
+	ImageDetails                                = &Icon{src: &icons.ImageDetails, lig: "details"}
+	ImageEdit                                   = ContentCreate
+	ImageExposure                               = &Icon{src: &icons.ImageExposure, lig: "exposure"}
+	ImageExposureNeg1                           = &Icon{src: &icons.ImageExposureNeg1, lig: "exposure_neg_1"}
+	ImageExposureNeg2                           = &Icon{src: &icons.ImageExposureNeg2, lig: "exposure_neg_2"}
+	ImageExposurePlus1                          = &Icon{src: &icons.ImageExposurePlus1, lig: "exposure_plus_1"}
+	ImageExposurePlus2                          = &Icon{src: &icons.ImageExposurePlus2, lig: "exposure_plus_2"}
+	ImageExposureZero                           = &Icon{src: &icons.ImageExposureZero, lig: "exposure_zero"}
+	ImageFilter                                 = &Icon{src: &icons.ImageFilter, lig: "filter"}
+	ImageFilter1                                = &Icon{src: &icons.ImageFilter1, lig: "filter_1"}
+	ImageFilter2                                = &Icon{src: &icons.ImageFilter2, lig: "filter_2"}
+	ImageFilter3                                = &Icon{src: &icons.ImageFilter3, lig: "filter_3"}
+	ImageFilter4                                = &Icon{src: &icons.ImageFilter4, lig: "filter_4"}
+	ImageFilter5                                = &Icon{src: &icons.ImageFilter5, lig: "filter_5"}
+	ImageFilter6                                = &Icon{src: &icons.ImageFilter6, lig: "filter_6"}
+	ImageFilter7                                = &Icon{src: &icons.ImageFilter7, lig: "filter_7"}
+	ImageFilter8                                = &Icon{src: &icons.ImageFilter8, lig: "filter_8"}
+	ImageFilter9                                = &Icon{src: &icons.ImageFilter9, lig: "filter_9"}
+	ImageFilter9Plus                            = &Icon{src: &icons.ImageFilter9Plus, lig: "filter_9_plus"}
+	ImageFilterBAndW                            = &Icon{src: &icons.ImageFilterBAndW, lig: "filter_b_and_w"}
+	ImageFilterCenterFocus                      = &Icon{src: &icons.ImageFilterCenterFocus, lig: "filter_center_focus"}
+	ImageFilterDrama                            = &Icon{src: &icons.ImageFilterDrama, lig: "filter_drama"}
+	ImageFilterFrames                           = &Icon{src: &icons.ImageFilterFrames, lig: "filter_frames"}
+	ImageFilterHDR                              = &Icon{src: &icons.ImageFilterHDR, lig: "filter_hdr"}
+	ImageFilterNone                             = &Icon{src: &icons.ImageFilterNone, lig: "filter_none"}
+	ImageFilterTiltShift                        = &Icon{src: &icons.ImageFilterTiltShift, lig: "filter_tilt_shift"}
+	ImageFilterVintage                          = &Icon{src: &icons.ImageFilterVintage, lig: "filter_vintage"}
+	ImageFlare                                  = &Icon{src: &icons.ImageFlare, lig: "flare"}
+	ImageFlashAuto                              = &Icon{src: &icons.ImageFlashAuto, lig: "flash_auto"}
+	ImageFlashOff                               = &Icon{src: &icons.ImageFlashOff, lig: "flash_off"}
+	ImageFlashOn                                = &Icon{src: &icons.ImageFlashOn, lig: "flash_on"}
+	ImageFlip                                   = &Icon{src: &icons.ImageFlip, lig: "flip"}
+	ImageGradient                               = &Icon{src: &icons.ImageGradient, lig: "gradient"}
+	ImageGrain                                  = &Icon{src: &icons.ImageGrain, lig: "grain"}
+	ImageGridOff                                = &Icon{src: &icons.ImageGridOff, lig: "grid_off"}
+	ImageGridOn                                 = &Icon{src: &icons.ImageGridOn, lig: "grid_on"}
+	ImageHDROff                                 = &Icon{src: &icons.ImageHDROff, lig: "hdr_off"}
+	ImageHDROn                                  = &Icon{src: &icons.ImageHDROn, lig: "hdr_on"}
+	ImageHDRStrong                              = &Icon{src: &icons.ImageHDRStrong, lig: "hdr_strong"}
+	ImageHDRWeak                                = &Icon{src: &icons.ImageHDRWeak, lig: "hdr_weak"}
+	ImageHealing                                = &Icon{src: &icons.ImageHealing, lig: "healing"}
+	ImageISO                                    = &Icon{src: &icons.ImageISO, lig: "iso"}
+	ImageImage                                  = EditorInsertPhoto
+	ImageImageAspectRatio                       = &Icon{src: &icons.ImageImageAspectRatio, lig: "image_aspect_ratio"}
+	ImageLandscape                              = ImageFilterHDR
+	ImageLeakAdd                                = &Icon{src: &icons.ImageLeakAdd, lig: "leak_add"}
+	ImageLeakRemove                             = &Icon{src: &icons.ImageLeakRemove, lig: "leak_remove"}
+	ImageLens                                   = &Icon{src: &icons.ImageLens, lig: "lens"}
+	ImageLinkedCamera                           = &Icon{src: &icons.ImageLinkedCamera, lig: "linked_camera"}
+	ImageLooks                                  = &Icon{src: &icons.ImageLooks, lig: "looks"}
+	ImageLooks3                                 = &Icon{src: &icons.ImageLooks3, lig: "looks_3"}
+	ImageLooks4                                 = &Icon{src: &icons.ImageLooks4, lig: "looks_4"}
+	ImageLooks5                                 = &Icon{src: &icons.ImageLooks5, lig: "looks_5"}
+	ImageLooks6                                 = &Icon{src: &icons.ImageLooks6, lig: "looks_6"}
+	ImageLooksOne                               = &Icon{src: &icons.ImageLooksOne, lig: "looks_one"}
+	ImageLooksTwo                               = &Icon{src: &icons.ImageLooksTwo, lig: "looks_two"}
+	ImageLoupe                                  = &Icon{src: &icons.ImageLoupe, lig: "loupe"}
+	ImageMonochromePhotos                       = &Icon{src: &icons.ImageMonochromePhotos, lig: "monochrome_photos"}
+	ImageMovieCreation                          = AVMovie
+	ImageMovieFilter                            = &Icon{src: &icons.ImageMovieFilter, lig: "movie_filter"}
+	ImageMusicNote                              = &Icon{src: &icons.ImageMusicNote, lig: "music_note"}
+	ImageNature                                 = &Icon{src: &icons.ImageNature, lig: "nature"}
+	ImageNaturePeople                           = &Icon{src: &icons.ImageNaturePeople, lig: "nature_people"}
+	ImageNavigateBefore                         = &Icon{src: &icons.ImageNavigateBefore, mirror: true, lig: "navigate_before"}
+	ImageNavigateNext                           = &Icon{src: &icons.ImageNavigateNext, mirror: true, lig: "navigate_next"}
+	ImagePalette                                = ImageColorLens
+	ImagePanorama                               = &Icon{src: &icons.ImagePanorama, lig: "panorama"}
+	ImagePanoramaFishEye                        = &Icon{src: &icons.ImagePanoramaFishEye, lig: "panorama_fish_eye"}
+	ImagePanoramaHorizontal                     = &Icon{src: &icons.ImagePanoramaHorizontal, lig: "panorama_horizontal"}
+	ImagePanoramaVertical                       = &Icon{src: &icons.ImagePanoramaVertical, lig: "panorama_vertical"}
+	ImagePanoramaWideAngle                      = &Icon{src: &icons.ImagePanoramaWideAngle, lig: "panorama_wide_angle"}
+	ImagePhoto                                  = EditorInsertPhoto
+	ImagePhotoAlbum                             = &Icon{src: &icons.ImagePhotoAlbum, lig: "photo_album"}
+	ImagePhotoCamera                            = ImageCameraAlt
+	ImagePhotoFilter                            = &Icon{src: &icons.ImagePhotoFilter, lig: "photo_filter"}
+	ImagePhotoLibrary                           = ImageCollections
+	ImagePhotoSizeSelectActual                  = &Icon{src: &icons.ImagePhotoSizeSelectActual, lig: "photo_size_select_actual"}
+	ImagePhotoSizeSelectLarge                   = &Icon{src: &icons.ImagePhotoSizeSelectLarge, lig: "photo_size_select_large"}
+	ImagePhotoSizeSelectSmall                   = &Icon{src: &icons.ImagePhotoSizeSelectSmall, lig: "photo_size_select_small"}
+	ImagePictureAsPDF                           = &Icon{src: &icons.ImagePictureAsPDF, lig: "picture_as_pdf"}
+	ImagePortrait                               = &Icon{src: &icons.ImagePortrait, lig: "portrait"}
+	ImageRemoveRedEye                           = &Icon{src: &icons.ImageRemoveRedEye, lig: "remove_red_eye"}
+	ImageRotate90DegreesCCW                     = &Icon{src: &icons.ImageRotate90DegreesCCW, lig: "rotate_90_degrees_ccw"}
+	ImageRotateLeft                             = &Icon{src: &icons.ImageRotateLeft, lig: "rotate_left"}
+	ImageRotateRight                            = &Icon{src: &icons.ImageRotateRight, lig: "rotate_right"}
+	ImageSlideshow                              = &Icon{src: &icons.ImageSlideshow, lig: "slideshow"}
+	ImageStraighten                             = &Icon{src: &icons.ImageStraighten, lig: "straighten"}
+	ImageStyle                                  = &Icon{src: &icons.ImageStyle, lig: "style"}
+	ImageSwitchCamera                           = &Icon{src: &icons.ImageSwitchCamera, lig: "switch_camera"}
+	ImageSwitchVideo                            = &Icon{src: &icons.ImageSwitchVideo, lig: "switch_video"}
+	ImageTagFaces                               = EditorInsertEmoticon
+	ImageTexture                                = &Icon{src: &icons.ImageTexture, lig: "texture"}
+	ImageTimeLapse                              = &Icon{src: &icons.ImageTimeLapse, lig: "timelapse"}
+	ImageTimer                                  = &Icon{src: &icons.ImageTimer, lig: "timer"}
+	ImageTimer10                                = &Icon{src: &icons.ImageTimer10, lig: "timer_10"}
+	ImageTimer3                                 = &Icon{src: &icons.ImageTimer3, lig: "timer_3"}
+	ImageTimerOff                               = &Icon{src: &icons.ImageTimerOff, lig: "timer_off"}
+	ImageTonality                               = &Icon{src: &icons.ImageTonality, lig: "tonality"}
+	ImageTransform                              = &Icon{src: &icons.ImageTransform, lig: "transform"}
+	ImageTune                                   = &Icon{src: &icons.ImageTune, lig: "tune"}
+	ImageViewComfy                              = &Icon{src: &icons.ImageViewComfy, lig: "view_comfy"}
+	ImageViewCompact                            = &Icon{src: &icons.ImageViewCompact, lig: "view_compact"}
+	ImageVignette                               = &Icon{src: &icons.ImageVignette, lig: "vignette"}
+	ImageWBAuto                                 = &Icon{src: &icons.ImageWBAuto, lig: "wb_auto"}
+	ImageWBCloudy                               = FileCloud
+	ImageWBIncandescent                         = &Icon{src: &icons.ImageWBIncandescent, lig: "wb_incandescent"}
+	ImageWBIridescent                           = &Icon{src: &icons.ImageWBIridescent, lig: "wb_iridescent"}
+	ImageWBSunny                                = &Icon{src: &icons.ImageWBSunny, lig: "wb_sunny"}
+	MapsAddLocation                             = &Icon{src: &icons.MapsAddLocation, lig: "add_location"}
+	MapsBeenhere                                = &Icon{src: &icons.MapsBeenhere, lig: "beenhere"}
+	MapsDirections                              = &Icon{src: &icons.MapsDirections, lig: "directions"}
+	MapsDirectionsBike                          = &Icon{src: &icons.MapsDirectionsBike, mirror: true, lig: "directions_bike"}
+	MapsDirectionsBoat                          = &Icon{src: &icons.MapsDirectionsBoat, lig: "directions_boat"}
+	MapsDirectionsBus                           = &Icon{src: &icons.MapsDirectionsBus, lig: "directions_bus"}
+	MapsDirectionsCar                           = &Icon{src: &icons.MapsDirectionsCar, lig: "directions_car"}
+	MapsDirectionsRailway                       = &Icon{src: &icons.MapsDirectionsRailway, lig: "directions_railway"}
+	MapsDirectionsRun                           = &Icon{src: &icons.MapsDirectionsRun, mirror: true, lig: "directions_run"}
+	MapsDirectionsSubway                        = &Icon{src: &icons.MapsDirectionsSubway, lig: "directions_subway"}
+	MapsDirectionsTransit                       = MapsDirectionsSubway
+	MapsDirectionsWalk                          = &Icon{src: &icons.MapsDirectionsWalk, mirror: true, lig: "directions_walk"}
+	MapsEVStation                               = &Icon{src: &icons.MapsEVStation, lig: "ev_station"}
+	MapsEditLocation                            = &Icon{src: &icons.MapsEditLocation, lig: "edit_location"}
+	MapsFlight                                  = DeviceAirplaneModeActive
+	MapsHotel                                   = &Icon{src: &icons.MapsHotel, lig: "hotel"}
+	MapsLayers                                  = &Icon{src: &icons.MapsLayers, lig: "layers"}
+	MapsLayersClear                             = &Icon{src: &icons.MapsLayersClear, lig: "layers_clear"}
+	MapsLocalATM                                = &Icon{src: &icons.MapsLocalATM, lig: "local_atm"}
+	MapsLocalActivity                           = &Icon{src: &icons.MapsLocalActivity, lig: "local_activity"}
+	MapsLocalAirport                            = DeviceAirplaneModeActive
+	MapsLocalBar                                = &Icon{src: &icons.MapsLocalBar, lig: "local_bar"}
+	MapsLocalCafe                               = &Icon{src: &icons.MapsLocalCafe, lig: "local_cafe"}
+	MapsLocalCarWash                            = &Icon{src: &icons.MapsLocalCarWash, lig: "local_car_wash"}
+	MapsLocalConvenienceStore                   = &Icon{src: &icons.MapsLocalConvenienceStore, lig: "local_convenience_store"}
+	MapsLocalDining                             = &Icon{src: &icons.MapsLocalDining, lig: "local_dining"}
+	MapsLocalDrink                              = &Icon{src: &icons.MapsLocalDrink, lig: "local_drink"}
+	MapsLocalFlorist                            = &Icon{src: &icons.MapsLocalFlorist, lig: "local_florist"}
+	MapsLocalGasStation                         = &Icon{src: &icons.MapsLocalGasStation, lig: "local_gas_station"}
+	MapsLocalGroceryStore                       = ActionShoppingCart
+	MapsLocalHospital                           = &Icon{src: &icons.MapsLocalHospital, lig: "local_hospital"}
+	MapsLocalHotel                              = MapsHotel
+	MapsLocalLaundryService                     = &Icon{src: &icons.MapsLocalLaundryService, lig: "local_laundry_service"}
+	MapsLocalLibrary                            = &Icon{src: &icons.MapsLocalLibrary, lig: "local_library"}
+	MapsLocalMall                               = &Icon{src: &icons.MapsLocalMall, lig: "local_mall"}
+	MapsLocalMovies                             = ActionTheaters
+	MapsLocalOffer                              = &Icon{src: &icons.MapsLocalOffer, lig: "local_offer"}
+	MapsLocalParking                            = &Icon{src: &icons.MapsLocalParking, lig: "local_parking"}
+	MapsLocalPharmacy                           = &Icon{src: &icons.MapsLocalPharmacy, lig: "local_pharmacy"}
+	MapsLocalPhone                              = CommunicationCall
+	MapsLocalPizza                              = &Icon{src: &icons.MapsLocalPizza, lig: "local_pizza"}
+	MapsLocalPlay                               = MapsLocalActivity
+	MapsLocalPostOffice                         = CommunicationEmail
+	MapsLocalPrintshop                          = ActionPrint
+	MapsLocalSee                                = ImageCameraAlt
+	MapsLocalShipping                           = &Icon{src: &icons.MapsLocalShipping, lig: "local_shipping"}
+	MapsLocalTaxi                               = &Icon{src: &icons.MapsLocalTaxi, lig: "local_taxi"}
+	MapsMap                                     = &Icon{src: &icons.MapsMap, lig: "map"}
+	MapsMyLocation                              = DeviceGPSFixed
+	MapsNavigation                              = &Icon{src: &icons.MapsNavigation, lig: "navigation"}
+	MapsNearMe                                  = &Icon{src: &icons.MapsNearMe, lig: "near_me"}
+	MapsPersonPin                               = &Icon{src: &icons.MapsPersonPin, lig: "person_pin"}
+	MapsPersonPinCircle                         = &Icon{src: &icons.MapsPersonPinCircle, lig: "person_pin_circle"}
+	MapsPinDrop                                 = &Icon{src: &icons.MapsPinDrop, lig: "pin_drop"}
+	MapsPlace                                   = ActionRoom
+	MapsRateReview                              = &Icon{src: &icons.MapsRateReview, lig: "rate_review"}
+	MapsRestaurant                              = &Icon{src: &icons.MapsRestaurant, lig: "restaurant"}
+	MapsRestaurantMenu                          = MapsLocalDining
+	MapsSatellite                               = &Icon{src: &icons.MapsSatellite, lig: "satellite"}
+	MapsStoreMallDirectory                      = ActionStore
+	MapsStreetView                              = &Icon{src: &icons.MapsStreetView, lig: "streetview"}
+	MapsSubway                                  = &Icon{src: &icons.MapsSubway, lig: "subway"}
+	MapsTerrain                                 = ImageFilterHDR
+	MapsTraffic                                 = &Icon{src: &icons.MapsTraffic, lig: "traffic"}
+	MapsTrain                                   = &Icon{src: &icons.MapsTrain, lig: "train"}
+	MapsTram                                    = &Icon{src: &icons.MapsTram, lig: "tram"}
+	MapsTransferWithinAStation                  = &Icon{src: &icons.MapsTransferWithinAStation, lig: "transfer_within_a_station"}
+	MapsZoomOutMap                              = &Icon{src: &icons.MapsZoomOutMap, lig: "zoom_out_map"}
+	NavigationApps                              = &Icon{src: &icons.NavigationApps, lig: "apps"}
+	NavigationArrowBack                         = &Icon{src: &icons.NavigationArrowBack, mirror: true, lig: "arrow_back"}
+	NavigationArrowDownward                     = &Icon{src: &icons.NavigationArrowDownward, lig: "arrow_downward"}
+	NavigationArrowDropDown                     = &Icon{src: &icons.NavigationArrowDropDown, lig: "arrow_drop_down"}
+	NavigationArrowDropDownCircle               = &Icon{src: &icons.NavigationArrowDropDownCircle, lig: "arrow_drop_down_circle"}
+	NavigationArrowDropUp                       = &Icon{src: &icons.NavigationArrowDropUp, lig: "arrow_drop_up"}
+	NavigationArrowForward                      = &Icon{src: &icons.NavigationArrowForward, mirror: true, lig: "arrow_forward"}
+	NavigationArrowUpward                       = &Icon{src: &icons.NavigationArrowUpward, lig: "arrow_upward"}
+	NavigationCancel                            = &Icon{src: &icons.NavigationCancel, lig: "cancel"}
+	NavigationCheck                             = ActionDone
+	NavigationChevronLeft                       = ImageNavigateBefore
+	NavigationChevronRight                      = ImageNavigateNext
+	NavigationClose                             = ContentClear
+	NavigationExpandLess                        = &Icon{src: &icons.NavigationExpandLess, lig: "expand_less"}
+	NavigationExpandMore                        = &Icon{src: &icons.NavigationExpandMore, lig: "expand_more"}
+	NavigationFirstPage                         = &Icon{src: &icons.NavigationFirstPage, mirror: true, lig: "first_page"}
+	NavigationFullscreen                        = &Icon{src: &icons.NavigationFullscreen, lig: "fullscreen"}
+	NavigationFullscreenExit                    = &Icon{src: &icons.NavigationFullscreenExit, lig: "fullscreen_exit"}
+	NavigationLastPage                          = &Icon{src: &icons.NavigationLastPage, mirror: true, lig: "last_page"}
+	NavigationMenu                              = &Icon{src: &icons.NavigationMenu, lig: "menu"}
+	NavigationMoreHoriz                         = &Icon{src: &icons.NavigationMoreHoriz, lig: "more_horiz"}
+	NavigationMoreVert                          = &Icon{src: &icons.NavigationMoreVert, lig: "more_vert"}
+	NavigationRefresh                           = &Icon{src: &icons.NavigationRefresh, lig: "refresh"}
+	NavigationSubdirectoryArrowLeft             = &Icon{src: &icons.NavigationSubdirectoryArrowLeft, mirror: true, lig: "subdirectory_arrow_left"}
+	NavigationSubdirectoryArrowRight            = &Icon{src: &icons.NavigationSubdirectoryArrowRight, mirror: true, lig: "subdirectory_arrow_right"}
+	NavigationUnfoldLess                        = &Icon{src: &icons.NavigationUnfoldLess, lig: "unfold_less"}
+	NavigationUnfoldMore                        = &Icon{src: &icons.NavigationUnfoldMore, lig: "unfold_more"}
+	NotificationADB                             = &Icon{src: &icons.NotificationADB, lig: "adb"}
+	NotificationAirlineSeatFlat                 = &Icon{src: &icons.NotificationAirlineSeatFlat, lig: "airline_seat_flat"}
+	NotificationAirlineSeatFlatAngled           = &Icon{src: &icons.NotificationAirlineSeatFlatAngled, lig: "airline_seat_flat_angled"}
+	NotificationAirlineSeatIndividualSuite      = &Icon{src: &icons.NotificationAirlineSeatIndividualSuite, lig: "airline_seat_individual_suite"}
+	NotificationAirlineSeatLegroomExtra         = &Icon{src: &icons.NotificationAirlineSeatLegroomExtra, lig: "airline_seat_legroom_extra"}
+	NotificationAirlineSeatLegroomNormal        = &Icon{src: &icons.NotificationAirlineSeatLegroomNormal, lig: "airline_seat_legroom_normal"}
+	NotificationAirlineSeatLegroomReduced       = &Icon{src: &icons.NotificationAirlineSeatLegroomReduced, lig: "airline_seat_legroom_reduced"}
+	NotificationAirlineSeatReclineExtra         = &Icon{src: &icons.NotificationAirlineSeatReclineExtra, lig: "airline_seat_recline_extra"}
+	NotificationAirlineSeatReclineNormal        = &Icon{src: &icons.NotificationAirlineSeatReclineNormal, lig: "airline_seat_recline_normal"}
+	NotificationBluetoothAudio                  = DeviceBluetoothSearching
+	NotificationConfirmationNumber              = &Icon{src: &icons.NotificationConfirmationNumber, lig: "confirmation_number"}
+	NotificationDiscFull                        = &Icon{src: &icons.NotificationDiscFull, lig: "disc_full"}
+	NotificationDoNotDisturb                    = AVNotInterested
+	NotificationDoNotDisturbAlt                 = &Icon{src: &icons.NotificationDoNotDisturbAlt, lig: "do_not_disturb_alt"}
+	NotificationDoNotDisturbOff                 = &Icon{src: &icons.NotificationDoNotDisturbOff, lig: "do_not_disturb_off"}
+	NotificationDoNotDisturbOn                  = ContentRemoveCircle
+	NotificationDriveETA                        = &Icon{src: &icons.NotificationDriveETA, lig: "drive_eta"}
+	NotificationEnhancedEncryption              = &Icon{src: &icons.NotificationEnhancedEncryption, lig: "enhanced_encryption"}
+	NotificationEventAvailable                  = &Icon{src: &icons.NotificationEventAvailable, lig: "event_available"}
+	NotificationEventBusy                       = &Icon{src: &icons.NotificationEventBusy, lig: "event_busy"}
+	NotificationEventNote                       = &Icon{src: &icons.NotificationEventNote, lig: "event_note"}
+	NotificationFolderSpecial                   = &Icon{src: &icons.NotificationFolderSpecial, lig: "folder_special"}
+	NotificationLiveTV                          = &Icon{src: &icons.NotificationLiveTV, lig: "live_tv"}
+	NotificationMMS                             = &Icon{src: &icons.NotificationMMS, lig: "mms"}
+	NotificationMore                            = &Icon{src: &icons.NotificationMore, lig: "more"}
+	NotificationNetworkCheck                    = &Icon{src: &icons.NotificationNetworkCheck, lig: "network_check"}
+	NotificationNetworkLocked                   = &Icon{src: &icons.NotificationNetworkLocked, lig: "network_locked"}
+	NotificationNoEncryption                    = &Icon{src: &icons.NotificationNoEncryption, lig: "no_encryption"}
+	NotificationOnDemandVideo                   = &Icon{src: &icons.NotificationOnDemandVideo, lig: "ondemand_video"}
+	NotificationPersonalVideo                   = HardwareTV
+	NotificationPhoneBluetoothSpeaker           = &Icon{src: &icons.NotificationPhoneBluetoothSpeaker, lig: "phone_bluetooth_speaker"}
+	NotificationPhoneForwarded                  = &Icon{src: &icons.NotificationPhoneForwarded, mirror: true, lig: "phone_forwarded"}
+	NotificationPhoneInTalk                     = &Icon{src: &icons.NotificationPhoneInTalk, lig: "phone_in_talk"}
+	NotificationPhoneLocked                     = &Icon{src: &icons.NotificationPhoneLocked, lig: "phone_locked"}
+	NotificationPhoneMissed                     = &Icon{src: &icons.NotificationPhoneMissed, lig: "phone_missed"}
+	NotificationPhonePaused                     = &Icon{src: &icons.NotificationPhonePaused, lig: "phone_paused"}
+	NotificationPower                           = &Icon{src: &icons.NotificationPower, lig: "power"}
+	NotificationPriorityHigh                    = &Icon{src: &icons.NotificationPriorityHigh, lig: "priority_high"}
+	NotificationRVHookup                        = &Icon{src: &icons.NotificationRVHookup, lig: "rv_hookup"}
+	NotificationSDCard                          = DeviceSDStorage
+	NotificationSIMCardAlert                    = &Icon{src: &icons.NotificationSIMCardAlert, lig: "sim_card_alert"}
+	NotificationSMS                             = CommunicationTextSMS
+	NotificationSMSFailed                       = ActionFeedback
+	NotificationSync                            = AVLoop
+	NotificationSyncDisabled                    = &Icon{src: &icons.NotificationSyncDisabled, lig: "sync_disabled"}
+	NotificationSyncProblem                     = &Icon{src: &icons.NotificationSyncProblem, lig: "sync_problem"}
+	NotificationSystemUpdate                    = &Icon{src: &icons.NotificationSystemUpdate, lig: "system_update"}
+	NotificationTapAndPlay                      = &Icon{src: &icons.NotificationTapAndPlay, lig: "tap_and_play"}
+	NotificationTimeToLeave                     = NotificationDriveETA
+	NotificationVPNLock                         = &Icon{src: &icons.NotificationVPNLock, lig: "vpn_lock"}
+	NotificationVibration                       = &Icon{src: &icons.NotificationVibration, lig: "vibration"}
+	NotificationVoiceChat                       = &Icon{src: &icons.NotificationVoiceChat, lig: "voice_chat"}
+	NotificationWC                              = &Icon{src: &icons.NotificationWC, lig: "wc"}
+	NotificationWiFi                            = &Icon{src: &icons.NotificationWiFi, lig: "wifi"}
+	PlacesACUnit                                = &Icon{src: &icons.PlacesACUnit, lig: "ac_unit"}
+	PlacesAirportShuttle                        = &Icon{src: &icons.PlacesAirportShuttle, lig: "airport_shuttle"}
+	PlacesAllInclusive                          = &Icon{src: &icons.PlacesAllInclusive, lig: "all_inclusive"}
+	PlacesBeachAccess                           = &Icon{src: &icons.PlacesBeachAccess, lig: "beach_access"}
+	PlacesBusinessCenter                        = &Icon{src: &icons.PlacesBusinessCenter, lig: "business_center"}
+	PlacesCasino                                = &Icon{src: &icons.PlacesCasino, lig: "casino"}
+	PlacesChildCare                             = &Icon{src: &icons.PlacesChildCare, lig: "child_care"}
+	PlacesChildFriendly                         = &Icon{src: &icons.PlacesChildFriendly, lig: "child_friendly"}
+	PlacesFitnessCenter                         = &Icon{src: &icons.PlacesFitnessCenter, lig: "fitness_center"}
+	PlacesFreeBreakfast                         = &Icon{src: &icons.PlacesFreeBreakfast, lig: "free_breakfast"}
+	PlacesGolfCourse                            = &Icon{src: &icons.PlacesGolfCourse, lig: "golf_course"}
+	PlacesHotTub                                = &Icon{src: &icons.PlacesHotTub, lig: "hot_tub"}
+	PlacesKitchen                               = &Icon{src: &icons.PlacesKitchen, lig: "kitchen"}
+	PlacesPool                                  = &Icon{src: &icons.PlacesPool, lig: "pool"}
+	PlacesRVHookup                              = NotificationRVHookup
+	PlacesRoomService                           = &Icon{src: &icons.PlacesRoomService, lig: "room_service"}
+	PlacesSmokeFree                             = &Icon{src: &icons.PlacesSmokeFree, lig: "smoke_free"}
+	PlacesSmokingRooms                          = &Icon{src: &icons.PlacesSmokingRooms, lig: "smoking_rooms"}
+	PlacesSpa                                   = &Icon{src: &icons.PlacesSpa, lig: "spa"}
+	SocialCake                                  = &Icon{src: &icons.SocialCake, lig: "cake"}
+	SocialDomain                                = CommunicationBusiness
+	SocialGroup                                 = &Icon{src: &icons.SocialGroup, lig: "group"}
+	SocialGroupAdd                              = &Icon{src: &icons.SocialGroupAdd, lig: "group_add"}
+	SocialLocationCity                          = &Icon{src: &icons.SocialLocationCity, lig: "location_city"}
+	SocialMood                                  = EditorInsertEmoticon
+	SocialMoodBad                               = &Icon{src: &icons.SocialMoodBad, lig: "mood_bad"}
+	SocialNotifications                         = &Icon{src: &icons.SocialNotifications, lig: "notifications"}
+	SocialNotificationsActive                   = &Icon{src: &icons.SocialNotificationsActive, lig: "notifications_active"}
+	SocialNotificationsNone                     = &Icon{src: &icons.SocialNotificationsNone, lig: "notifications_none"}
+	SocialNotificationsOff                      = &Icon{src: &icons.SocialNotificationsOff, lig: "notifications_off"}
+	SocialNotificationsPaused                   = &Icon{src: &icons.SocialNotificationsPaused, lig: "notifications_paused"}
+	SocialPages                                 = &Icon{src: &icons.SocialPages, lig: "pages"}
+	SocialPartyMode                             = &Icon{src: &icons.SocialPartyMode, lig: "party_mode"}
+	SocialPeople                                = SocialGroup
+	SocialPeopleOutline                         = &Icon{src: &icons.SocialPeopleOutline, lig: "people_outline"}
+	SocialPerson                                = &Icon{src: &icons.SocialPerson, lig: "person"}
+	SocialPersonAdd                             = &Icon{src: &icons.SocialPersonAdd, lig: "person_add"}
+	SocialPersonOutline                         = ActionPermIdentity
+	SocialPlusOne                               = &Icon{src: &icons.SocialPlusOne, lig: "plus_one"}
+	SocialPoll                                  = ActionAssessment
+	SocialPublic                                = &Icon{src: &icons.SocialPublic, lig: "public"}
+	SocialSchool                                = &Icon{src: &icons.SocialSchool, lig: "school"}
+	SocialSentimentDissatisfied                 = &Icon{src: &icons.SocialSentimentDissatisfied, lig: "sentiment_dissatisfied"}
+	SocialSentimentNeutral                      = &Icon{src: &icons.SocialSentimentNeutral, lig: "sentiment_neutral"}
+	SocialSentimentSatisfied                    = &Icon{src: &icons.SocialSentimentSatisfied, lig: "sentiment_satisfied"}
+	SocialSentimentVeryDissatisfied             = &Icon{src: &icons.SocialSentimentVeryDissatisfied, lig: "sentiment_very_dissatisfied"}
+	SocialSentimentVerySatisfied                = &Icon{src: &icons.SocialSentimentVerySatisfied, lig: "sentiment_very_satisfied"}
+	SocialShare                                 = &Icon{src: &icons.SocialShare, lig: "share"}
+	SocialWhatsHot                              = &Icon{src: &icons.SocialWhatsHot, lig: "whatshot"}
+	ToggleCheckBox                              = &Icon{src: &icons.ToggleCheckBox, lig: "check_box"}
+	ToggleCheckBoxOutlineBlank                  = &Icon{src: &icons.ToggleCheckBoxOutlineBlank, lig: "check_box_outline_blank"}
+	ToggleIndeterminateCheckBox                 = &Icon{src: &icons.ToggleIndeterminateCheckBox, lig: "indeterminate_check_box"}
+	ToggleRadioButtonChecked                    = &Icon{src: &icons.ToggleRadioButtonChecked, lig: "radio_button_checked"}
+	ToggleRadioButtonUnchecked                  = &Icon{src: &icons.ToggleRadioButtonUnchecked, lig: "radio_button_unchecked"}
+	ToggleStar                                  = &Icon{src: &icons.ToggleStar, lig: "star"}
+	ToggleStarBorder                            = &Icon{src: &icons.ToggleStarBorder, lig: "star_border"}
+	ToggleStarHalf                              = &Icon{src: &icons.ToggleStarHalf, lig: "star_half"}
 )
+
+var entries = [961]Entry{
+	{"AVAVTimer", "AVAV Timer", CategoryAV, "AV Timer", "AV timer", AVAVTimer, nil, nil},
+	{"AVAddToQueue", "AV Add To Queue", CategoryAV, "Add To Queue", "Add to queue", AVAddToQueue, nil, nil},
+	{"AVAirplay", "AV Airplay", CategoryAV, "Airplay", "Airplay", AVAirplay, nil, nil},
+	{"AVAlbum", "AV Album", CategoryAV, "Album", "Album", AVAlbum, []string{"record", "disc", "music", "vinyl"}, nil},
+	{"AVArtTrack", "AV Art Track", CategoryAV, "Art Track", "Art track", AVArtTrack, nil, nil},
+	{"AVBrandingWatermark", "AV Branding Watermark", CategoryAV, "Branding Watermark", "Branding watermark", AVBrandingWatermark, nil, nil},
+	{"AVCallToAction", "AV Call To Action", CategoryAV, "Call To Action", "Call to action", AVCallToAction, nil, nil},
+	{"AVClosedCaption", "AV Closed Caption", CategoryAV, "Closed Caption", "Closed caption", AVClosedCaption, []string{"captions", "cc", "subtitles"}, nil},
+	{"AVEqualizer", "AV Equalizer", CategoryAV, "Equalizer", "Equalizer", AVEqualizer, nil, nil},
+	{"AVExplicit", "AV Explicit", CategoryAV, "Explicit", "Explicit", AVExplicit, nil, nil},
+	{"AVFastForward", "AV Fast Forward", CategoryAV, "Fast Forward", "Fast forward", AVFastForward, []string{"forward", "skip", "speed"}, nil},
+	{"AVFastRewind", "AV Fast Rewind", CategoryAV, "Fast Rewind", "Fast rewind", AVFastRewind, []string{"rewind", "back", "skip"}, nil},
+	{"AVFeaturedPlayList", "AV Featured Play List", CategoryAV, "Featured Play List", "Featured play list", AVFeaturedPlayList, nil, nil},
+	{"AVFeaturedVideo", "AV Featured Video", CategoryAV, "Featured Video", "Featured video", AVFeaturedVideo, nil, nil},
+	{"AVFiberDVR", "AV Fiber DVR", CategoryAV, "Fiber DVR", "Fiber DVR", AVFiberDVR, nil, nil},
+	{"AVFiberManualRecord", "AV Fiber Manual Record", CategoryAV, "Fiber Manual Record", "Fiber manual record", AVFiberManualRecord, nil, nil},
+	{"AVFiberNew", "AV Fiber New", CategoryAV, "Fiber New", "Fiber new", AVFiberNew, nil, nil},
+	{"AVFiberPin", "AV Fiber Pin", CategoryAV, "Fiber Pin", "Fiber pin", AVFiberPin, nil, nil},
+	{"AVFiberSmartRecord", "AV Fiber Smart Record", CategoryAV, "Fiber Smart Record", "Fiber smart record", AVFiberSmartRecord, nil, nil},
+	{"AVForward10", "AV Forward 10", CategoryAV, "Forward 10", "Forward 10", AVForward10, nil, nil},
+	{"AVForward30", "AV Forward 30", CategoryAV, "Forward 30", "Forward 30", AVForward30, nil, nil},
+	{"AVForward5", "AV Forward 5", CategoryAV, "Forward 5", "Forward 5", AVForward5, nil, nil},
+	{"AVGames", "AV Games", CategoryAV, "Games", "Games", AVGames, nil, []string{"HardwareGamepad"}},
+	{"AVHD", "AVHD", CategoryAV, "HD", "HD", AVHD, nil, nil},
+	{"AVHearing", "AV Hearing", CategoryAV, "Hearing", "Hearing", AVHearing, nil, nil},
+	{"AVHighQuality", "AV High Quality", CategoryAV, "High Quality", "High quality", AVHighQuality, nil, nil},
+	{"AVLibraryAdd", "AV Library Add", CategoryAV, "Library Add", "Library add", AVLibraryAdd, nil, []string{"AVQueue", "ImageAddToPhotos"}},
+	{"AVLibraryBooks", "AV Library Books", CategoryAV, "Library Books", "Library books", AVLibraryBooks, nil, nil},
+	{"AVLibraryMusic", "AV Library Music", CategoryAV, "Library Music", "Library music", AVLibraryMusic, []string{"music", "songs", "playlist"}, nil},
+	{"AVLoop", "AV Loop", CategoryAV, "Loop", "Loop", AVLoop, []string{"repeat", "cycle"}, []string{"NotificationSync"}},
+	{"AVMic", "AV Mic", CategoryAV, "Mic", "Mic", AVMic, []string{"microphone", "record", "voice", "audio"}, nil},
+	{"AVMicNone", "AV Mic None", CategoryAV, "Mic None", "Mic none", AVMicNone, nil, nil},
+	{"AVMicOff", "AV Mic Off", CategoryAV, "Mic Off", "Mic off", AVMicOff, []string{"microphone", "mute", "silent"}, nil},
+	{"AVMovie", "AV Movie", CategoryAV, "Movie", "Movie", AVMovie, []string{"film", "video", "cinema", "clapperboard"}, []string{"ImageMovieCreation"}},
+	{"AVMusicVideo", "AV Music Video", CategoryAV, "Music Video", "Music video", AVMusicVideo, nil, nil},
+	{"AVNewReleases", "AV New Releases", CategoryAV, "New Releases", "New releases", AVNewReleases, nil, nil},
+	{"AVNotInterested", "AV Not Interested", CategoryAV, "Not Interested", "Not interested", AVNotInterested, nil, []string{"NotificationDoNotDisturb"}},
+	{"AVNote", "AV Note", CategoryAV, "Note", "Note", AVNote, nil, nil},
+	{"AVPause", "AV Pause", CategoryAV, "Pause", "Pause", AVPause, []string{"stop", "hold", "break"}, nil},
+	{"AVPauseCircleFilled", "AV Pause Circle Filled", CategoryAV, "Pause Circle Filled", "Pause circle, filled", AVPauseCircleFilled, nil, nil},
+	{"AVPauseCircleOutline", "AV Pause Circle Outline", CategoryAV, "Pause Circle Outline", "Pause circle, outline", AVPauseCircleOutline, nil, nil},
+	{"AVPlayArrow", "AV Play Arrow", CategoryAV, "Play Arrow", "Play arrow", AVPlayArrow, []string{"play", "start", "run", "triangle"}, nil},
+	{"AVPlayCircleFilled", "AV Play Circle Filled", CategoryAV, "Play Circle Filled", "Play circle, filled", AVPlayCircleFilled, []string{"play", "start", "video"}, nil},
+	{"AVPlayCircleOutline", "AV Play Circle Outline", CategoryAV, "Play Circle Outline", "Play circle, outline", AVPlayCircleOutline, []string{"play", "start", "video"}, nil},
+	{"AVPlaylistAdd", "AV Playlist Add", CategoryAV, "Playlist Add", "Playlist add", AVPlaylistAdd, nil, nil},
+	{"AVPlaylistAddCheck", "AV Playlist Add Check", CategoryAV, "Playlist Add Check", "Playlist add check", AVPlaylistAddCheck, nil, nil},
+	{"AVPlaylistPlay", "AV Playlist Play", CategoryAV, "Playlist Play", "Playlist play", AVPlaylistPlay, nil, nil},
+	{"AVQueue", "AV Queue", CategoryAV, "Queue", "Queue", AVQueue, nil, []string{"AVLibraryAdd", "ImageAddToPhotos"}},
+	{"AVQueueMusic", "AV Queue Music", CategoryAV, "Queue Music", "Queue music", AVQueueMusic, []string{"playlist", "music", "songs"}, nil},
+	{"AVQueuePlayNext", "AV Queue Play Next", CategoryAV, "Queue Play Next", "Queue play next", AVQueuePlayNext, nil, nil},
+	{"AVRadio", "AV Radio", CategoryAV, "Radio", "Radio", AVRadio, nil, nil},
+	{"AVRecentActors", "AV Recent Actors", CategoryAV, "Recent Actors", "Recent actors", AVRecentActors, nil, nil},
+	{"AVRemoveFromQueue", "AV Remove From Queue", CategoryAV, "Remove From Queue", "Remove from queue", AVRemoveFromQueue, nil, nil},
+	{"AVRepeat", "AV Repeat", CategoryAV, "Repeat", "Repeat", AVRepeat, []string{"loop", "cycle"}, nil},
+	{"AVRepeatOne", "AV Repeat One", CategoryAV, "Repeat One", "Repeat one", AVRepeatOne, nil, nil},
+	{"AVReplay", "AV Replay", CategoryAV, "Replay", "Replay", AVReplay, nil, nil},
+	{"AVReplay10", "AV Replay 10", CategoryAV, "Replay 10", "Replay 10", AVReplay10, nil, nil},
+	{"AVReplay30", "AV Replay 30", CategoryAV, "Replay 30", "Replay 30", AVReplay30, nil, nil},
+	{"AVReplay5", "AV Replay 5", CategoryAV, "Replay 5", "Replay 5", AVReplay5, nil, nil},
+	{"AVShuffle", "AV Shuffle", CategoryAV, "Shuffle", "Shuffle", AVShuffle, []string{"random", "mix"}, nil},
+	{"AVSkipNext", "AV Skip Next", CategoryAV, "Skip Next", "Skip next", AVSkipNext, []string{"next", "forward", "track"}, nil},
+	{"AVSkipPrevious", "AV Skip Previous", CategoryAV, "Skip Previous", "Skip previous", AVSkipPrevious, []string{"previous", "back", "track"}, nil},
+	{"AVSlowMotionVideo", "AV Slow Motion Video", CategoryAV, "Slow Motion Video", "Slow motion video", AVSlowMotionVideo, nil, nil},
+	{"AVSnooze", "AV Snooze", CategoryAV, "Snooze", "Snooze", AVSnooze, nil, nil},
+	{"AVSortByAlpha", "AV Sort By Alpha", CategoryAV, "Sort By Alpha", "Sort by alpha", AVSortByAlpha, nil, nil},
+	{"AVStop", "AV Stop", CategoryAV, "Stop", "Stop", AVStop, []string{"halt", "square", "end"}, nil},
+	{"AVSubscriptions", "AV Subscriptions", CategoryAV, "Subscriptions", "Subscriptions", AVSubscriptions, nil, nil},
+	{"AVSubtitles", "AV Subtitles", CategoryAV, "Subtitles", "Subtitles", AVSubtitles, []string{"captions", "cc", "text"}, nil},
+	{"AVSurroundSound", "AV Surround Sound", CategoryAV, "Surround Sound", "Surround sound", AVSurroundSound, nil, nil},
+	{"AVVideoCall", "AV Video Call", CategoryAV, "Video Call", "Video call", AVVideoCall, nil, nil},
+	{"AVVideoLabel", "AV Video Label", CategoryAV, "Video Label", "Video label", AVVideoLabel, nil, nil},
+	{"AVVideoLibrary", "AV Video Library", CategoryAV, "Video Library", "Video library", AVVideoLibrary, nil, nil},
+	{"AVVideocam", "AV Videocam", CategoryAV, "Videocam", "Videocam", AVVideocam, []string{"camera", "video", "record", "film"}, nil},
+	{"AVVideocamOff", "AV Videocam Off", CategoryAV, "Videocam Off", "Videocam off", AVVideocamOff, nil, nil},
+	{"AVVolumeDown", "AV Volume Down", CategoryAV, "Volume Down", "Volume down", AVVolumeDown, []string{"sound", "speaker", "quiet", "audio"}, nil},
+	{"AVVolumeMute", "AV Volume Mute", CategoryAV, "Volume Mute", "Volume mute", AVVolumeMute, []string{"mute", "silent", "speaker", "sound"}, nil},
+	{"AVVolumeOff", "AV Volume Off", CategoryAV, "Volume Off", "Volume off", AVVolumeOff, []string{"mute", "silent", "speaker", "sound"}, nil},
+	{"AVVolumeUp", "AV Volume Up", CategoryAV, "Volume Up", "Volume up", AVVolumeUp, []string{"sound", "speaker", "loud", "audio"}, nil},
+	{"AVWeb", "AV Web", CategoryAV, "Web", "Web", AVWeb, nil, nil},
+	{"AVWebAsset", "AV Web Asset", CategoryAV, "Web Asset", "Web asset", AVWebAsset, nil, nil},
+	{"Action3DRotation", "Action 3 D Rotation", CategoryAction, "3 D Rotation", "3D rotation", Action3DRotation, nil, nil},
+	{"ActionAccessibility", "Action Accessibility", CategoryAction, "Accessibility", "Accessibility", ActionAccessibility, nil, nil},
+	{"ActionAccessible", "Action Accessible", CategoryAction, "Accessible", "Accessible", ActionAccessible, nil, nil},
+	{"ActionAccountBalance", "Action Account Balance", CategoryAction, "Account Balance", "Account balance", ActionAccountBalance, []string{"bank", "building", "finance", "museum"}, nil},
+	{"ActionAccountBalanceWallet", "Action Account Balance Wallet", CategoryAction, "Account Balance Wallet", "Account balance wallet", ActionAccountBalanceWallet, []string{"wallet", "money", "finance", "payment"}, nil},
+	{"ActionAccountBox", "Action Account Box", CategoryAction, "Account Box", "Account box", ActionAccountBox, []string{"user", "profile", "avatar", "person"}, nil},
+	{"ActionAccountCircle", "Action Account Circle", CategoryAction, "Account Circle", "Account circle", ActionAccountCircle, []string{"user", "profile", "avatar", "person"}, nil},
+	{"ActionAddShoppingCart", "Action Add Shopping Cart", CategoryAction, "Add Shopping Cart", "Add shopping cart", ActionAddShoppingCart, []string{"basket", "trolley", "buy"}, nil},
+	{"ActionAlarm", "Action Alarm", CategoryAction, "Alarm", "Alarm", ActionAlarm, []string{"clock", "alert", "timer", "wake"}, []string{"DeviceAccessAlarm"}},
+	{"ActionAlarmAdd", "Action Alarm Add", CategoryAction, "Alarm Add", "Alarm add", ActionAlarmAdd, nil, []string{"DeviceAddAlarm"}},
+	{"ActionAlarmOff", "Action Alarm Off", CategoryAction, "Alarm Off", "Alarm off", ActionAlarmOff, nil, nil},
+	{"ActionAlarmOn", "Action Alarm On", CategoryAction, "Alarm On", "Alarm on", ActionAlarmOn, nil, nil},
+	{"ActionAllOut", "Action All Out", CategoryAction, "All Out", "All out", ActionAllOut, nil, nil},
+	{"ActionAndroid", "Action Android", CategoryAction, "Android", "Android", ActionAndroid, nil, nil},
+	{"ActionAnnouncement", "Action Announcement", CategoryAction, "Announcement", "Announcement", ActionAnnouncement, []string{"news", "message", "alert", "notice"}, nil},
+	{"ActionAspectRatio", "Action Aspect Ratio", CategoryAction, "Aspect Ratio", "Aspect ratio, screen", ActionAspectRatio, []string{"screen", "proportions"}, nil},
+	{"ActionAssessment", "Action Assessment", CategoryAction, "Assessment", "Assessment", ActionAssessment, []string{"chart", "graph", "bar chart", "analytics", "statistics"}, []string{"EditorInsertChart", "SocialPoll"}},
+	{"ActionAssignment", "Action Assignment", CategoryAction, "Assignment", "Assignment", ActionAssignment, []string{"clipboard", "task", "document"}, nil},
+	{"ActionAssignmentInd", "Action Assignment Ind", CategoryAction, "Assignment Ind", "Assignment ind", ActionAssignmentInd, nil, nil},
+	{"ActionAssignmentLate", "Action Assignment Late", CategoryAction, "Assignment Late", "Assignment late", ActionAssignmentLate, nil, nil},
+	{"ActionAssignmentReturn", "Action Assignment Return", CategoryAction, "Assignment Return", "Assignment return", ActionAssignmentReturn, nil, nil},
+	{"ActionAssignmentReturned", "Action Assignment Returned", CategoryAction, "Assignment Returned", "Assignment returned", ActionAssignmentReturned, nil, nil},
+	{"ActionAssignmentTurnedIn", "Action Assignment Turned In", CategoryAction, "Assignment Turned In", "Assignment turned in", ActionAssignmentTurnedIn, nil, nil},
+	{"ActionAutorenew", "Action Autorenew", CategoryAction, "Autorenew", "Autorenew", ActionAutorenew, []string{"refresh", "reload", "sync", "cycle"}, nil},
+	{"ActionBackup", "Action Backup", CategoryAction, "Backup", "Backup", ActionBackup, []string{"cloud", "upload", "save"}, []string{"FileCloudUpload"}},
+	{"ActionBook", "Action Book", CategoryAction, "Book", "Book", ActionBook, nil, []string{"ActionClass"}},
+	{"ActionBookmark", "Action Bookmark", CategoryAction, "Bookmark", "Bookmark, filled", ActionBookmark, []string{"filled", "save", "favorite", "ribbon", "tag"}, []string{"ActionTurnedIn"}},
+	{"ActionBookmarkBorder", "Action Bookmark Border", CategoryAction, "Bookmark Border", "Bookmark, outline", ActionBookmarkBorder, []string{"save", "favorite", "ribbon", "tag"}, []string{"ActionTurnedInNot"}},
+	{"ActionBugReport", "Action Bug Report", CategoryAction, "Bug Report", "Bug report", ActionBugReport, []string{"bug", "issue", "debug", "insect"}, nil},
+	{"ActionBuild", "Action Build", CategoryAction, "Build", "Build", ActionBuild, []string{"wrench", "spanner", "tool", "repair", "fix"}, nil},
+	{"ActionCached", "Action Cached", CategoryAction, "Cached", "Cached", ActionCached, []string{"refresh", "reload", "sync"}, nil},
+	{"ActionCameraEnhance", "Action Camera Enhance", CategoryAction, "Camera Enhance", "Camera enhance", ActionCameraEnhance, nil, nil},
+	{"ActionCardGiftcard", "Action Card Giftcard", CategoryAction, "Card Giftcard", "Card giftcard", ActionCardGiftcard, nil, []string{"ActionRedeem"}},
+	{"ActionCardMembership", "Action Card Membership", CategoryAction, "Card Membership", "Card membership", ActionCardMembership, nil, nil},
+	{"ActionCardTravel", "Action Card Travel", CategoryAction, "Card Travel", "Card travel", ActionCardTravel, nil, nil},
+	{"ActionChangeHistory", "Action Change History", CategoryAction, "Change History", "Change history", ActionChangeHistory, nil, nil},
+	{"ActionCheckCircle", "Action Check Circle", CategoryAction, "Check Circle", "Check circle", ActionCheckCircle, []string{"tick", "ok", "success", "complete", "verified"}, nil},
+	{"ActionChromeReaderMode", "Action Chrome Reader Mode", CategoryAction, "Chrome Reader Mode", "Chrome reader mode", ActionChromeReaderMode, nil, nil},
+	{"ActionClass", "Action Class", CategoryAction, "Class", "Class", ActionClass, nil, []string{"ActionBook"}},
+	{"ActionCode", "Action Code", CategoryAction, "Code", "Code", ActionCode, []string{"brackets", "source", "developer", "programming", "html"}, nil},
+	{"ActionCompareArrows", "Action Compare Arrows", CategoryAction, "Compare Arrows", "Compare arrows", ActionCompareArrows, nil, nil},
+	{"ActionCopyright", "Action Copyright", CategoryAction, "Copyright", "Copyright", ActionCopyright, nil, nil},
+	{"ActionCreditCard", "Action Credit Card", CategoryAction, "Credit Card", "Credit card", ActionCreditCard, []string{"payment", "money", "bank card", "debit"}, []string{"ActionPayment"}},
+	{"ActionDNS", "Action DNS", CategoryAction, "DNS", "DNS", ActionDNS, []string{"server", "database", "network"}, nil},
+	{"ActionDashboard", "Action Dashboard", CategoryAction, "Dashboard", "Dashboard", ActionDashboard, []string{"layout", "tiles", "overview", "grid"}, nil},
+	{"ActionDateRange", "Action Date Range", CategoryAction, "Date Range", "Date range", ActionDateRange, []string{"calendar", "date", "period"}, nil},
+	{"ActionDelete", "Action Delete", CategoryAction, "Delete", "Delete", ActionDelete, []string{"trash", "bin", "garbage", "remove", "discard", "rubbish"}, nil},
+	{"ActionDeleteForever", "Action Delete Forever", CategoryAction, "Delete Forever", "Delete forever", ActionDeleteForever, []string{"trash", "bin", "garbage", "remove", "permanent", "destroy"}, nil},
+	{"ActionDescription", "Action Description", CategoryAction, "Description", "Description", ActionDescription, []string{"document", "file", "paper", "text"}, nil},
+	{"ActionDone", "Action Done", CategoryAction, "Done", "Done", ActionDone, []string{"tick", "check", "ok", "confirm", "complete"}, []string{"NavigationCheck"}},
+	{"ActionDoneAll", "Action Done All", CategoryAction, "Done All", "Done all", ActionDoneAll, []string{"tick", "check", "complete", "read"}, nil},
+	{"ActionDonutLarge", "Action Donut Large", CategoryAction, "Donut Large", "Donut large", ActionDonutLarge, nil, nil},
+	{"ActionDonutSmall", "Action Donut Small", CategoryAction, "Donut Small", "Donut small", ActionDonutSmall, nil, nil},
+	{"ActionEject", "Action Eject", CategoryAction, "Eject", "Eject", ActionEject, nil, nil},
+	{"ActionEuroSymbol", "Action Euro Symbol", CategoryAction, "Euro Symbol", "Euro symbol", ActionEuroSymbol, nil, nil},
+	{"ActionEvent", "Action Event", CategoryAction, "Event", "Event", ActionEvent, []string{"calendar", "date", "appointment"}, []string{"EditorInsertInvitation"}},
+	{"ActionEventSeat", "Action Event Seat", CategoryAction, "Event Seat", "Event seat", ActionEventSeat, nil, nil},
+	{"ActionExitToApp", "Action Exit To App", CategoryAction, "Exit To App", "Exit to app", ActionExitToApp, []string{"logout", "sign out", "leave", "quit"}, nil},
+	{"ActionExplore", "Action Explore", CategoryAction, "Explore", "Explore", ActionExplore, []string{"compass", "discover", "navigation"}, nil},
+	{"ActionExtension", "Action Extension", CategoryAction, "Extension", "Extension", ActionExtension, []string{"plugin", "puzzle", "addon", "add-on"}, nil},
+	{"ActionFace", "Action Face", CategoryAction, "Face", "Face", ActionFace, []string{"person", "emoji", "smile", "user"}, nil},
+	{"ActionFavorite", "Action Favorite", CategoryAction, "Favorite", "Favorite, filled", ActionFavorite, []string{"filled", "heart", "love", "like"}, nil},
+	{"ActionFavoriteBorder", "Action Favorite Border", CategoryAction, "Favorite Border", "Favorite, outline", ActionFavoriteBorder, []string{"heart", "love", "like"}, nil},
+	{"ActionFeedback", "Action Feedback", CategoryAction, "Feedback", "Feedback", ActionFeedback, []string{"comment", "report", "message"}, []string{"NotificationSMSFailed"}},
+	{"ActionFindInPage", "Action Find In Page", CategoryAction, "Find In Page", "Find in page", ActionFindInPage, []string{"search", "lookup"}, nil},
+	{"ActionFindReplace", "Action Find Replace", CategoryAction, "Find Replace", "Find replace", ActionFindReplace, []string{"search", "substitute"}, nil},
+	{"ActionFingerprint", "Action Fingerprint", CategoryAction, "Fingerprint", "Fingerprint", ActionFingerprint, []string{"biometric", "identity", "touch id", "unlock"}, nil},
+	{"ActionFlightLand", "Action Flight Land", CategoryAction, "Flight Land", "Flight land", ActionFlightLand, nil, nil},
+	{"ActionFlightTakeoff", "Action Flight Takeoff", CategoryAction, "Flight Takeoff", "Flight takeoff", ActionFlightTakeoff, nil, nil},
+	{"ActionFlipToBack", "Action Flip To Back", CategoryAction, "Flip To Back", "Flip to back", ActionFlipToBack, nil, nil},
+	{"ActionFlipToFront", "Action Flip To Front", CategoryAction, "Flip To Front", "Flip to front", ActionFlipToFront, nil, nil},
+	{"ActionGIF", "Action GIF", CategoryAction, "GIF", "GIF", ActionGIF, nil, nil},
+	{"ActionGTranslate", "Action G Translate", CategoryAction, "G Translate", "G translate", ActionGTranslate, nil, nil},
+	{"ActionGavel", "Action Gavel", CategoryAction, "Gavel", "Gavel", ActionGavel, nil, nil},
+	{"ActionGetApp", "Action Get App", CategoryAction, "Get App", "Get app", ActionGetApp, []string{"download", "install", "save"}, []string{"FileFileDownload"}},
+	{"ActionGrade", "Action Grade", CategoryAction, "Grade", "Grade", ActionGrade, []string{"star", "rating", "favorite", "rate"}, nil},
+	{"ActionGroupWork", "Action Group Work", CategoryAction, "Group Work", "Group work", ActionGroupWork, nil, nil},
+	{"ActionHTTP", "Action HTTP", CategoryAction, "HTTP", "HTTP", ActionHTTP, nil, nil},
+	{"ActionHTTPS", "Action HTTPS", CategoryAction, "HTTPS", "HTTPS", ActionHTTPS, nil, []string{"ActionLock"}},
+	{"ActionHelp", "Action Help", CategoryAction, "Help", "Help, filled", ActionHelp, []string{"filled", "question", "support", "faq", "information"}, nil},
+	{"ActionHelpOutline", "Action Help Outline", CategoryAction, "Help Outline", "Help, outline", ActionHelpOutline, []string{"question", "support", "faq", "information"}, nil},
+	{"ActionHighlightOff", "Action Highlight Off", CategoryAction, "Highlight Off", "Highlight off", ActionHighlightOff, nil, nil},
+	{"ActionHistory", "Action History", CategoryAction, "History", "History", ActionHistory, []string{"recent", "clock", "past", "undo"}, []string{"ActionRestore"}},
+	{"ActionHome", "Action Home", CategoryAction, "Home", "Home", ActionHome, []string{"house", "start", "main"}, nil},
+	{"ActionHourglassEmpty", "Action Hourglass Empty", CategoryAction, "Hourglass Empty", "Hourglass empty", ActionHourglassEmpty, nil, nil},
+	{"ActionHourglassFull", "Action Hourglass Full", CategoryAction, "Hourglass Full", "Hourglass full", ActionHourglassFull, nil, nil},
+	{"ActionImportantDevices", "Action Important Devices", CategoryAction, "Important Devices", "Important devices", ActionImportantDevices, nil, nil},
+	{"ActionInfo", "Action Info", CategoryAction, "Info", "Info, filled", ActionInfo, []string{"filled", "about", "details", "information", "help"}, nil},
+	{"ActionInfoOutline", "Action Info Outline", CategoryAction, "Info Outline", "Info, outline", ActionInfoOutline, []string{"about", "details", "information", "help"}, nil},
+	{"ActionInput", "Action Input", CategoryAction, "Input", "Input", ActionInput, nil, nil},
+	{"ActionInvertColors", "Action Invert Colors", CategoryAction, "Invert Colors", "Invert colors", ActionInvertColors, nil, nil},
+	{"ActionLabel", "Action Label", CategoryAction, "Label", "Label, filled", ActionLabel, []string{"filled", "tag"}, nil},
+	{"ActionLabelOutline", "Action Label Outline", CategoryAction, "Label Outline", "Label, outline", ActionLabelOutline, []string{"tag"}, nil},
+	{"ActionLanguage", "Action Language", CategoryAction, "Language", "Language", ActionLanguage, []string{"globe", "world", "internet", "web", "locale", "translate"}, nil},
+	{"ActionLaunch", "Action Launch", CategoryAction, "Launch", "Launch", ActionLaunch, []string{"external", "open", "new window", "link"}, []string{"ActionOpenInNew"}},
+	{"ActionLightbulbOutline", "Action Lightbulb Outline", CategoryAction, "Lightbulb Outline", "Lightbulb", ActionLightbulbOutline, []string{"idea", "light", "tip", "bulb"}, nil},
+	{"ActionLineStyle", "Action Line Style", CategoryAction, "Line Style", "Line style", ActionLineStyle, nil, nil},
+	{"ActionLineWeight", "Action Line Weight", CategoryAction, "Line Weight", "Line weight", ActionLineWeight, nil, nil},
+	{"ActionList", "Action List", CategoryAction, "List", "List", ActionList, []string{"lines", "items", "bullets"}, nil},
+	{"ActionLock", "Action Lock", CategoryAction, "Lock", "Lock, filled", ActionLock, []string{"filled", "secure", "password", "private", "padlock", "locked"}, []string{"ActionHTTPS"}},
+	{"ActionLockOpen", "Action Lock Open", CategoryAction, "Lock Open", "Lock open", ActionLockOpen, []string{"unlock", "unlocked", "insecure", "padlock"}, nil},
+	{"ActionLockOutline", "Action Lock Outline", CategoryAction, "Lock Outline", "Lock, outline", ActionLockOutline, []string{"secure", "password", "private", "padlock", "locked"}, nil},
+	{"ActionLoyalty", "Action Loyalty", CategoryAction, "Loyalty", "Loyalty", ActionLoyalty, nil, nil},
+	{"ActionMarkUnreadMailbox", "Action Mark Unread Mailbox", CategoryAction, "Mark Unread Mailbox", "Mark unread mailbox", ActionMarkUnreadMailbox, nil, nil},
+	{"ActionMotorcycle", "Action Motorcycle", CategoryAction, "Motorcycle", "Motorcycle", ActionMotorcycle, nil, nil},
+	{"ActionNoteAdd", "Action Note Add", CategoryAction, "Note Add", "Note add", ActionNoteAdd, nil, nil},
+	{"ActionOfflinePin", "Action Offline Pin", CategoryAction, "Offline Pin", "Offline pin", ActionOfflinePin, nil, nil},
+	{"ActionOpacity", "Action Opacity", CategoryAction, "Opacity", "Opacity", ActionOpacity, nil, nil},
+	{"ActionOpenInBrowser", "Action Open In Browser", CategoryAction, "Open In Browser", "Open in browser", ActionOpenInBrowser, nil, nil},
+	{"ActionOpenInNew", "Action Open In New", CategoryAction, "Open In New", "Open in new", ActionOpenInNew, []string{"external", "launch", "new window", "link"}, []string{"ActionLaunch"}},
+	{"ActionOpenWith", "Action Open With", CategoryAction, "Open With", "Open with", ActionOpenWith, nil, nil},
+	{"ActionPageview", "Action Pageview", CategoryAction, "Pageview", "Pageview", ActionPageview, nil, nil},
+	{"ActionPanTool", "Action Pan Tool", CategoryAction, "Pan Tool", "Pan tool", ActionPanTool, []string{"hand", "drag", "grab", "move"}, nil},
+	{"ActionPayment", "Action Payment", CategoryAction, "Payment", "Payment", ActionPayment, []string{"credit card", "money", "pay", "bank card"}, []string{"ActionCreditCard"}},
+	{"ActionPermCameraMic", "Action Perm Camera Mic", CategoryAction, "Perm Camera Mic", "Perm camera mic", ActionPermCameraMic, nil, nil},
+	{"ActionPermContactCalendar", "Action Perm Contact Calendar", CategoryAction, "Perm Contact Calendar", "Perm contact calendar", ActionPermContactCalendar, nil, nil},
+	{"ActionPermDataSetting", "Action Perm Data Setting", CategoryAction, "Perm Data Setting", "Perm data setting", ActionPermDataSetting, nil, nil},
+	{"ActionPermDeviceInformation", "Action Perm Device Information", CategoryAction, "Perm Device Information", "Perm device information", ActionPermDeviceInformation, nil, nil},
+	{"ActionPermIdentity", "Action Perm Identity", CategoryAction, "Perm Identity", "Perm identity", ActionPermIdentity, nil, []string{"SocialPersonOutline"}},
+	{"ActionPermMedia", "Action Perm Media", CategoryAction, "Perm Media", "Perm media", ActionPermMedia, nil, nil},
+	{"ActionPermPhoneMsg", "Action Perm Phone Msg", CategoryAction, "Perm Phone Msg", "Perm phone msg", ActionPermPhoneMsg, nil, nil},
+	{"ActionPermScanWiFi", "Action Perm Scan Wi Fi", CategoryAction, "Perm Scan Wi Fi", "Perm scan Wi-Fi", ActionPermScanWiFi, nil, nil},
+	{"ActionPets", "Action Pets", CategoryAction, "Pets", "Pets", ActionPets, []string{"animal", "paw", "dog", "cat"}, nil},
+	{"ActionPictureInPicture", "Action Picture In Picture", CategoryAction, "Picture In Picture", "Picture in picture", ActionPictureInPicture, nil, nil},
+	{"ActionPictureInPictureAlt", "Action Picture In Picture Alt", CategoryAction, "Picture In Picture Alt", "Picture in picture alt", ActionPictureInPictureAlt, nil, nil},
+	{"ActionPlayForWork", "Action Play For Work", CategoryAction, "Play For Work", "Play for work", ActionPlayForWork, nil, nil},
+	{"ActionPolymer", "Action Polymer", CategoryAction, "Polymer", "Polymer", ActionPolymer, nil, nil},
+	{"ActionPowerSettingsNew", "Action Power Settings New", CategoryAction, "Power Settings New", "Power settings new", ActionPowerSettingsNew, []string{"power", "shutdown", "turn off", "on off"}, nil},
+	{"ActionPregnantWoman", "Action Pregnant Woman", CategoryAction, "Pregnant Woman", "Pregnant woman", ActionPregnantWoman, nil, nil},
+	{"ActionPrint", "Action Print", CategoryAction, "Print", "Print", ActionPrint, []string{"printer", "paper"}, []string{"MapsLocalPrintshop"}},
+	{"ActionQueryBuilder", "Action Query Builder", CategoryAction, "Query Builder", "Query builder", ActionQueryBuilder, nil, []string{"ActionSchedule", "DeviceAccessTime"}},
+	{"ActionQuestionAnswer", "Action Question Answer", CategoryAction, "Question Answer", "Question answer", ActionQuestionAnswer, []string{"chat", "conversation", "faq", "discussion"}, []string{"CommunicationForum"}},
+	{"ActionReceipt", "Action Receipt", CategoryAction, "Receipt", "Receipt", ActionReceipt, []string{"bill", "invoice", "purchase"}, nil},
+	{"ActionRecordVoiceOver", "Action Record Voice Over", CategoryAction, "Record Voice Over", "Record voice over", ActionRecordVoiceOver, []string{"speak", "talk", "voice", "speaker"}, nil},
+	{"ActionRedeem", "Action Redeem", CategoryAction, "Redeem", "Redeem", ActionRedeem, nil, []string{"ActionCardGiftcard"}},
+	{"ActionRemoveShoppingCart", "Action Remove Shopping Cart", CategoryAction, "Remove Shopping Cart", "Remove shopping cart", ActionRemoveShoppingCart, nil, nil},
+	{"ActionReorder", "Action Reorder", CategoryAction, "Reorder", "Reorder", ActionReorder, nil, nil},
+	{"ActionReportProblem", "Action Report Problem", CategoryAction, "Report Problem", "Report problem", ActionReportProblem, []string{"warning", "caution", "triangle", "exclamation"}, []string{"AlertWarning"}},
+	{"ActionRestore", "Action Restore", CategoryAction, "Restore", "Restore", ActionRestore, []string{"history", "recent", "undo", "revert"}, []string{"ActionHistory"}},
+	{"ActionRestorePage", "Action Restore Page", CategoryAction, "Restore Page", "Restore page", ActionRestorePage, nil, nil},
+	{"ActionRoom", "Action Room", CategoryAction, "Room", "Room", ActionRoom, []string{"location", "pin", "place", "marker", "map"}, []string{"CommunicationLocationOn", "MapsPlace"}},
+	{"ActionRoundedCorner", "Action Rounded Corner", CategoryAction, "Rounded Corner", "Rounded corner", ActionRoundedCorner, nil, nil},
+	{"ActionRowing", "Action Rowing", CategoryAction, "Rowing", "Rowing", ActionRowing, nil, nil},
+	{"ActionSchedule", "Action Schedule", CategoryAction, "Schedule", "Schedule", ActionSchedule, []string{"clock", "time", "watch", "history"}, []string{"ActionQueryBuilder", "DeviceAccessTime"}},
+	{"ActionSearch", "Action Search", CategoryAction, "Search", "Search", ActionSearch, []string{"find", "magnifying glass", "lookup", "query"}, nil},
+	{"ActionSettings", "Action Settings", CategoryAction, "Settings", "Settings", ActionSettings, []string{"gear", "cog", "preferences", "options", "configuration"}, nil},
+	{"ActionSettingsApplications", "Action Settings Applications", CategoryAction, "Settings Applications", "Settings applications", ActionSettingsApplications, []string{"gear", "cog", "preferences", "options", "configuration"}, nil},
+	{"ActionSettingsBackupRestore", "Action Settings Backup Restore", CategoryAction, "Settings Backup Restore", "Settings backup restore", ActionSettingsBackupRestore, nil, nil},
+	{"ActionSettingsBluetooth", "Action Settings Bluetooth", CategoryAction, "Settings Bluetooth", "Settings Bluetooth", ActionSettingsBluetooth, nil, nil},
+	{"ActionSettingsBrightness", "Action Settings Brightness", CategoryAction, "Settings Brightness", "Settings brightness", ActionSettingsBrightness, nil, nil},
+	{"ActionSettingsCell", "Action Settings Cell", CategoryAction, "Settings Cell", "Settings cell", ActionSettingsCell, nil, nil},
+	{"ActionSettingsEthernet", "Action Settings Ethernet", CategoryAction, "Settings Ethernet", "Settings ethernet", ActionSettingsEthernet, nil, nil},
+	{"ActionSettingsInputAntenna", "Action Settings Input Antenna", CategoryAction, "Settings Input Antenna", "Settings input antenna", ActionSettingsInputAntenna, nil, nil},
+	{"ActionSettingsInputComponent", "Action Settings Input Component", CategoryAction, "Settings Input Component", "Settings input component", ActionSettingsInputComponent, nil, []string{"ActionSettingsInputComposite"}},
+	{"ActionSettingsInputComposite", "Action Settings Input Composite", CategoryAction, "Settings Input Composite", "Settings input composite", ActionSettingsInputComposite, nil, []string{"ActionSettingsInputComponent"}},
+	{"ActionSettingsInputHDMI", "Action Settings Input HDMI", CategoryAction, "Settings Input HDMI", "Settings input HDMI", ActionSettingsInputHDMI, nil, nil},
+	{"ActionSettingsInputSVideo", "Action Settings Input S Video", CategoryAction, "Settings Input S Video", "Settings input S-Video", ActionSettingsInputSVideo, nil, nil},
+	{"ActionSettingsOverscan", "Action Settings Overscan", CategoryAction, "Settings Overscan", "Settings overscan", ActionSettingsOverscan, nil, nil},
+	{"ActionSettingsPhone", "Action Settings Phone", CategoryAction, "Settings Phone", "Settings phone", ActionSettingsPhone, nil, nil},
+	{"ActionSettingsPower", "Action Settings Power", CategoryAction, "Settings Power", "Settings power", ActionSettingsPower, nil, nil},
+	{"ActionSettingsRemote", "Action Settings Remote", CategoryAction, "Settings Remote", "Settings remote", ActionSettingsRemote, nil, nil},
+	{"ActionSettingsVoice", "Action Settings Voice", CategoryAction, "Settings Voice", "Settings voice", ActionSettingsVoice, nil, nil},
+	{"ActionShop", "Action Shop", CategoryAction, "Shop", "Shop", ActionShop, nil, nil},
+	{"ActionShopTwo", "Action Shop Two", CategoryAction, "Shop Two", "Shop two", ActionShopTwo, nil, nil},
+	{"ActionShoppingBasket", "Action Shopping Basket", CategoryAction, "Shopping Basket", "Shopping basket", ActionShoppingBasket, []string{"cart", "buy", "store"}, nil},
+	{"ActionShoppingCart", "Action Shopping Cart", CategoryAction, "Shopping Cart", "Shopping cart", ActionShoppingCart, []string{"basket", "trolley", "buy", "checkout", "store"}, []string{"MapsLocalGroceryStore"}},
+	{"ActionSpeakerNotes", "Action Speaker Notes", CategoryAction, "Speaker Notes", "Speaker notes", ActionSpeakerNotes, nil, nil},
+	{"ActionSpeakerNotesOff", "Action Speaker Notes Off", CategoryAction, "Speaker Notes Off", "Speaker notes off", ActionSpeakerNotesOff, nil, nil},
+	{"ActionSpellcheck", "Action Spellcheck", CategoryAction, "Spellcheck", "Spellcheck", ActionSpellcheck, nil, nil},
+	{"ActionStarRate", "Action Star Rate", CategoryAction, "Star Rate", "Star rate", ActionStarRate, []string{"star", "rating", "favorite"}, nil},
+	{"ActionStars", "Action Stars", CategoryAction, "Stars", "Stars", ActionStars, nil, nil},
+	{"ActionStore", "Action Store", CategoryAction, "Store", "Store", ActionStore, nil, []string{"MapsStoreMallDirectory"}},
+	{"ActionSubject", "Action Subject", CategoryAction, "Subject", "Subject", ActionSubject, nil, nil},
+	{"ActionSupervisorAccount", "Action Supervisor Account", CategoryAction, "Supervisor Account", "Supervisor account", ActionSupervisorAccount, []string{"users", "people", "admin", "group"}, nil},
+	{"ActionSwapHoriz", "Action Swap Horiz", CategoryAction, "Swap Horiz", "Swap horiz", ActionSwapHoriz, []string{"exchange", "switch", "arrows"}, nil},
+	{"ActionSwapVert", "Action Swap Vert", CategoryAction, "Swap Vert", "Swap vert", ActionSwapVert, []string{"exchange", "switch", "arrows", "sort"}, nil},
+	{"ActionSwapVerticalCircle", "Action Swap Vertical Circle", CategoryAction, "Swap Vertical Circle", "Swap vertical circle", ActionSwapVerticalCircle, nil, nil},
+	{"ActionSystemUpdateAlt", "Action System Update Alt", CategoryAction, "System Update Alt", "System update alt", ActionSystemUpdateAlt, nil, nil},
+	{"ActionTOC", "Action TOC", CategoryAction, "TOC", "TOC", ActionTOC, nil, nil},
+	{"ActionTab", "Action Tab", CategoryAction, "Tab", "Tab", ActionTab, nil, nil},
+	{"ActionTabUnselected", "Action Tab Unselected", CategoryAction, "Tab Unselected", "Tab unselected", ActionTabUnselected, nil, nil},
+	{"ActionTheaters", "Action Theaters", CategoryAction, "Theaters", "Theaters", ActionTheaters, nil, []string{"MapsLocalMovies"}},
+	{"ActionThumbDown", "Action Thumb Down", CategoryAction, "Thumb Down", "Thumb down", ActionThumbDown, []string{"dislike", "disapprove", "bad", "downvote"}, nil},
+	{"ActionThumbUp", "Action Thumb Up", CategoryAction, "Thumb Up", "Thumb up", ActionThumbUp, []string{"like", "approve", "good", "upvote"}, nil},
+	{"ActionThumbsUpDown", "Action Thumbs Up Down", CategoryAction, "Thumbs Up Down", "Thumbs up down", ActionThumbsUpDown, nil, nil},
+	{"ActionTimeline", "Action Timeline", CategoryAction, "Timeline", "Timeline", ActionTimeline, []string{"chart", "graph", "line", "analytics"}, nil},
+	{"ActionToday", "Action Today", CategoryAction, "Today", "Today", ActionToday, []string{"calendar", "date", "day", "event"}, nil},
+	{"ActionToll", "Action Toll", CategoryAction, "Toll", "Toll", ActionToll, nil, nil},
+	{"ActionTouchApp", "Action Touch App", CategoryAction, "Touch App", "Touch app", ActionTouchApp, []string{"tap", "hand", "finger", "gesture"}, nil},
+	{"ActionTrackChanges", "Action Track Changes", CategoryAction, "Track Changes", "Track changes", ActionTrackChanges, nil, nil},
+	{"ActionTranslate", "Action Translate", CategoryAction, "Translate", "Translate", ActionTranslate, []string{"language", "locale", "international"}, nil},
+	{"ActionTrendingDown", "Action Trending Down", CategoryAction, "Trending Down", "Trending down", ActionTrendingDown, []string{"chart", "graph", "decline", "decrease", "analytics"}, nil},
+	{"ActionTrendingFlat", "Action Trending Flat", CategoryAction, "Trending Flat", "Trending flat", ActionTrendingFlat, nil, nil},
+	{"ActionTrendingUp", "Action Trending Up", CategoryAction, "Trending Up", "Trending up", ActionTrendingUp, []string{"chart", "graph", "growth", "increase", "analytics"}, nil},
+	{"ActionTurnedIn", "Action Turned In", CategoryAction, "Turned In", "Turned in", ActionTurnedIn, nil, []string{"ActionBookmark"}},
+	{"ActionTurnedInNot", "Action Turned In Not", CategoryAction, "Turned In Not", "Turned in not", ActionTurnedInNot, nil, []string{"ActionBookmarkBorder"}},
+	{"ActionUpdate", "Action Update", CategoryAction, "Update", "Update", ActionUpdate, []string{"refresh", "clock", "history"}, nil},
+	{"ActionVerifiedUser", "Action Verified User", CategoryAction, "Verified User", "Verified user", ActionVerifiedUser, []string{"shield", "security", "trusted", "protection"}, nil},
+	{"ActionViewAgenda", "Action View Agenda", CategoryAction, "View Agenda", "View agenda", ActionViewAgenda, nil, nil},
+	{"ActionViewArray", "Action View Array", CategoryAction, "View Array", "View array", ActionViewArray, nil, nil},
+	{"ActionViewCarousel", "Action View Carousel", CategoryAction, "View Carousel", "View carousel", ActionViewCarousel, nil, nil},
+	{"ActionViewColumn", "Action View Column", CategoryAction, "View Column", "View column", ActionViewColumn, nil, nil},
+	{"ActionViewDay", "Action View Day", CategoryAction, "View Day", "View day", ActionViewDay, nil, nil},
+	{"ActionViewHeadline", "Action View Headline", CategoryAction, "View Headline", "View headline", ActionViewHeadline, nil, nil},
+	{"ActionViewList", "Action View List", CategoryAction, "View List", "View list", ActionViewList, []string{"list", "rows", "layout"}, nil},
+	{"ActionViewModule", "Action View Module", CategoryAction, "View Module", "View module", ActionViewModule, []string{"grid", "tiles", "layout"}, nil},
+	{"ActionViewQuilt", "Action View Quilt", CategoryAction, "View Quilt", "View quilt", ActionViewQuilt, nil, nil},
+	{"ActionViewStream", "Action View Stream", CategoryAction, "View Stream", "View stream", ActionViewStream, nil, nil},
+	{"ActionViewWeek", "Action View Week", CategoryAction, "View Week", "View week", ActionViewWeek, nil, nil},
+	{"ActionVisibility", "Action Visibility", CategoryAction, "Visibility", "Visibility", ActionVisibility, []string{"eye", "show", "view", "reveal", "see"}, nil},
+	{"ActionVisibilityOff", "Action Visibility Off", CategoryAction, "Visibility Off", "Visibility off", ActionVisibilityOff, []string{"eye", "hide", "hidden", "invisible"}, nil},
+	{"ActionWatchLater", "Action Watch Later", CategoryAction, "Watch Later", "Watch later", ActionWatchLater, []string{"clock", "time", "schedule", "later"}, nil},
+	{"ActionWork", "Action Work", CategoryAction, "Work", "Work", ActionWork, []string{"briefcase", "job", "business", "office"}, nil},
+	{"ActionYoutubeSearchedFor", "Action Youtube Searched For", CategoryAction, "Youtube Searched For", "Youtube searched for", ActionYoutubeSearchedFor, nil, nil},
+	{"ActionZoomIn", "Action Zoom In", CategoryAction, "Zoom In", "Zoom in", ActionZoomIn, []string{"magnify", "enlarge", "bigger", "plus"}, nil},
+	{"ActionZoomOut", "Action Zoom Out", CategoryAction, "Zoom Out", "Zoom out", ActionZoomOut, []string{"shrink", "smaller", "minus"}, nil},
+	{"AlertAddAlert", "Alert Add Alert", CategoryAlert, "Add Alert", "Add alert", AlertAddAlert, []string{"bell", "notification", "reminder"}, nil},
+	{"AlertError", "Alert Error", CategoryAlert, "Error", "Error, filled", AlertError, []string{"filled", "exclamation", "problem", "failure", "danger"}, nil},
+	{"AlertErrorOutline", "Alert Error Outline", CategoryAlert, "Error Outline", "Error, outline", AlertErrorOutline, []string{"exclamation", "problem", "failure", "danger"}, nil},
+	{"AlertWarning", "Alert Warning", CategoryAlert, "Warning", "Warning", AlertWarning, []string{"caution", "exclamation", "danger", "triangle", "alert"}, []string{"ActionReportProblem"}},
+	{"CommunicationBusiness", "Communication Business", CategoryCommunication, "Business", "Business", CommunicationBusiness, nil, []string{"SocialDomain"}},
+	{"CommunicationCall", "Communication Call", CategoryCommunication, "Call", "Call", CommunicationCall, []string{"phone", "telephone", "dial", "ring"}, []string{"CommunicationPhone", "MapsLocalPhone"}},
+	{"CommunicationCallEnd", "Communication Call End", CategoryCommunication, "Call End", "Call end", CommunicationCallEnd, []string{"hang up", "phone", "end call"}, nil},
+	{"CommunicationCallMade", "Communication Call Made", CategoryCommunication, "Call Made", "Call made", CommunicationCallMade, nil, nil},
+	{"CommunicationCallMerge", "Communication Call Merge", CategoryCommunication, "Call Merge", "Call merge", CommunicationCallMerge, nil, []string{"EditorMergeType"}},
+	{"CommunicationCallMissed", "Communication Call Missed", CategoryCommunication, "Call Missed", "Call missed", CommunicationCallMissed, nil, nil},
+	{"CommunicationCallMissedOutgoing", "Communication Call Missed Outgoing", CategoryCommunication, "Call Missed Outgoing", "Call missed outgoing", CommunicationCallMissedOutgoing, nil, nil},
+	{"CommunicationCallReceived", "Communication Call Received", CategoryCommunication, "Call Received", "Call received", CommunicationCallReceived, nil, nil},
+	{"CommunicationCallSplit", "Communication Call Split", CategoryCommunication, "Call Split", "Call split", CommunicationCallSplit, nil, nil},
+	{"CommunicationChat", "Communication Chat", CategoryCommunication, "Chat", "Chat", CommunicationChat, []string{"message", "conversation", "speech bubble", "talk"}, nil},
+	{"CommunicationChatBubble", "Communication Chat Bubble", CategoryCommunication, "Chat Bubble", "Chat bubble, filled", CommunicationChatBubble, []string{"filled", "message", "conversation", "speech bubble", "talk"}, nil},
+	{"CommunicationChatBubbleOutline", "Communication Chat Bubble Outline", CategoryCommunication, "Chat Bubble Outline", "Chat bubble, outline", CommunicationChatBubbleOutline, []string{"message", "conversation", "speech bubble", "talk"}, nil},
+	{"CommunicationClearAll", "Communication Clear All", CategoryCommunication, "Clear All", "Clear all", CommunicationClearAll, nil, nil},
+	{"CommunicationComment", "Communication Comment", CategoryCommunication, "Comment", "Comment", CommunicationComment, []string{"message", "speech bubble", "feedback"}, nil},
+	{"CommunicationContactMail", "Communication Contact Mail", CategoryCommunication, "Contact Mail", "Contact mail", CommunicationContactMail, nil, nil},
+	{"CommunicationContactPhone", "Communication Contact Phone", CategoryCommunication, "Contact Phone", "Contact phone", CommunicationContactPhone, nil, nil},
+	{"CommunicationContacts", "Communication Contacts", CategoryCommunication, "Contacts", "Contacts", CommunicationContacts, []string{"address book", "people", "phonebook"}, nil},
+	{"CommunicationDialerSIP", "Communication Dialer SIP", CategoryCommunication, "Dialer SIP", "Dialer SIP", CommunicationDialerSIP, nil, nil},
+	{"CommunicationDialpad", "Communication Dialpad", CategoryCommunication, "Dialpad", "Dialpad", CommunicationDialpad, nil, nil},
+	{"CommunicationEmail", "Communication Email", CategoryCommunication, "Email", "Email", CommunicationEmail, []string{"mail", "envelope", "message", "letter"}, []string{"ContentMail", "ContentMarkUnread", "MapsLocalPostOffice"}},
+	{"CommunicationForum", "Communication Forum", CategoryCommunication, "Forum", "Forum", CommunicationForum, []string{"chat", "discussion", "conversation", "community"}, []string{"ActionQuestionAnswer"}},
+	{"CommunicationImportContacts", "Communication Import Contacts", CategoryCommunication, "Import Contacts", "Import contacts", CommunicationImportContacts, nil, nil},
+	{"CommunicationImportExport", "Communication Import Export", CategoryCommunication, "Import Export", "Import export", CommunicationImportExport, nil, nil},
+	{"CommunicationInvertColorsOff", "Communication Invert Colors Off", CategoryCommunication, "Invert Colors Off", "Invert colors off", CommunicationInvertColorsOff, nil, nil},
+	{"CommunicationLiveHelp", "Communication Live Help", CategoryCommunication, "Live Help", "Live help", CommunicationLiveHelp, nil, nil},
+	{"CommunicationLocationOff", "Communication Location Off", CategoryCommunication, "Location Off", "Location off", CommunicationLocationOff, nil, nil},
+	{"CommunicationLocationOn", "Communication Location On", CategoryCommunication, "Location On", "Location on", CommunicationLocationOn, nil, []string{"ActionRoom", "MapsPlace"}},
+	{"CommunicationMailOutline", "Communication Mail Outline", CategoryCommunication, "Mail Outline", "Mail, outline", CommunicationMailOutline, []string{"email", "envelope", "message", "letter"}, nil},
+	{"CommunicationMessage", "Communication Message", CategoryCommunication, "Message", "Message", CommunicationMessage, []string{"chat", "speech bubble", "text"}, nil},
+	{"CommunicationNoSIM", "Communication No SIM", CategoryCommunication, "No SIM", "No SIM", CommunicationNoSIM, nil, []string{"DeviceSignalCellularNoSIM"}},
+	{"CommunicationPhone", "Communication Phone", CategoryCommunication, "Phone", "Phone", CommunicationPhone, []string{"call", "telephone", "dial", "ring"}, []string{"CommunicationCall", "MapsLocalPhone"}},
+	{"CommunicationPhoneLinkErase", "Communication Phone Link Erase", CategoryCommunication, "Phone Link Erase", "Phone link erase", CommunicationPhoneLinkErase, nil, nil},
+	{"CommunicationPhoneLinkLock", "Communication Phone Link Lock", CategoryCommunication, "Phone Link Lock", "Phone link lock", CommunicationPhoneLinkLock, nil, nil},
+	{"CommunicationPhoneLinkRing", "Communication Phone Link Ring", CategoryCommunication, "Phone Link Ring", "Phone link ring", CommunicationPhoneLinkRing, nil, nil},
+	{"CommunicationPhoneLinkSetup", "Communication Phone Link Setup", CategoryCommunication, "Phone Link Setup", "Phone link setup", CommunicationPhoneLinkSetup, nil, nil},
+	{"CommunicationPortableWiFiOff", "Communication Portable Wi Fi Off", CategoryCommunication, "Portable Wi Fi Off", "Portable Wi-Fi off", CommunicationPortableWiFiOff, nil, nil},
+	{"CommunicationPresentToAll", "Communication Present To All", CategoryCommunication, "Present To All", "Present to all", CommunicationPresentToAll, nil, nil},
+	{"CommunicationRSSFeed", "Communication RSS Feed", CategoryCommunication, "RSS Feed", "RSS feed", CommunicationRSSFeed, []string{"feed", "subscribe", "news", "syndication"}, nil},
+	{"CommunicationRingVolume", "Communication Ring Volume", CategoryCommunication, "Ring Volume", "Ring volume", CommunicationRingVolume, nil, nil},
+	{"CommunicationScreenShare", "Communication Screen Share", CategoryCommunication, "Screen Share", "Screen share", CommunicationScreenShare, []string{"present", "cast", "share screen"}, nil},
+	{"CommunicationSpeakerPhone", "Communication Speaker Phone", CategoryCommunication, "Speaker Phone", "Speaker phone", CommunicationSpeakerPhone, nil, nil},
+	{"CommunicationStayCurrentLandscape", "Communication Stay Current Landscape", CategoryCommunication, "Stay Current Landscape", "Stay current landscape", CommunicationStayCurrentLandscape, nil, []string{"CommunicationStayPrimaryLandscape"}},
+	{"CommunicationStayCurrentPortrait", "Communication Stay Current Portrait", CategoryCommunication, "Stay Current Portrait", "Stay current portrait", CommunicationStayCurrentPortrait, nil, []string{"CommunicationStayPrimaryPortrait"}},
+	{"CommunicationStayPrimaryLandscape", "Communication Stay Primary Landscape", CategoryCommunication, "Stay Primary Landscape", "Stay primary landscape", CommunicationStayPrimaryLandscape, nil, []string{"CommunicationStayCurrentLandscape"}},
+	{"CommunicationStayPrimaryPortrait", "Communication Stay Primary Portrait", CategoryCommunication, "Stay Primary Portrait", "Stay primary portrait", CommunicationStayPrimaryPortrait, nil, []string{"CommunicationStayCurrentPortrait"}},
+	{"CommunicationStopScreenShare", "Communication Stop Screen Share", CategoryCommunication, "Stop Screen Share", "Stop screen share", CommunicationStopScreenShare, nil, nil},
+	{"CommunicationSwapCalls", "Communication Swap Calls", CategoryCommunication, "Swap Calls", "Swap calls", CommunicationSwapCalls, nil, nil},
+	{"CommunicationTextSMS", "Communication Text SMS", CategoryCommunication, "Text SMS", "Text SMS", CommunicationTextSMS, []string{"message", "text", "chat"}, []string{"NotificationSMS"}},
+	{"CommunicationVPNKey", "Communication VPN Key", CategoryCommunication, "VPN Key", "VPN key", CommunicationVPNKey, []string{"key", "password", "security", "vpn"}, nil},
+	{"CommunicationVoicemail", "Communication Voicemail", CategoryCommunication, "Voicemail", "Voicemail", CommunicationVoicemail, nil, nil},
+	{"ContentAdd", "Content Add", CategoryContent, "Add", "Add", ContentAdd, []string{"plus", "new", "create", "add"}, nil},
+	{"ContentAddBox", "Content Add Box", CategoryContent, "Add Box", "Add box", ContentAddBox, []string{"plus", "new", "create"}, nil},
+	{"ContentAddCircle", "Content Add Circle", CategoryContent, "Add Circle", "Add circle, filled", ContentAddCircle, []string{"filled", "plus", "new", "create"}, nil},
+	{"ContentAddCircleOutline", "Content Add Circle Outline", CategoryContent, "Add Circle Outline", "Add circle, outline", ContentAddCircleOutline, []string{"plus", "new", "create"}, nil},
+	{"ContentArchive", "Content Archive", CategoryContent, "Archive", "Archive", ContentArchive, []string{"box", "store", "file away"}, nil},
+	{"ContentBackspace", "Content Backspace", CategoryContent, "Backspace", "Backspace", ContentBackspace, []string{"delete", "erase", "remove"}, nil},
+	{"ContentBlock", "Content Block", CategoryContent, "Block", "Block", ContentBlock, []string{"forbidden", "ban", "stop", "prohibited", "not allowed"}, nil},
+	{"ContentClear", "Content Clear", CategoryContent, "Clear", "Clear", ContentClear, []string{"x", "cross", "close", "remove", "erase"}, []string{"NavigationClose"}},
+	{"ContentContentCopy", "Content Content Copy", CategoryContent, "Content Copy", "Copy", ContentContentCopy, []string{"copy", "duplicate", "clipboard"}, nil},
+	{"ContentContentCut", "Content Content Cut", CategoryContent, "Content Cut", "Cut", ContentContentCut, []string{"cut", "scissors", "clipboard"}, nil},
+	{"ContentContentPaste", "Content Content Paste", CategoryContent, "Content Paste", "Paste", ContentContentPaste, []string{"paste", "clipboard"}, nil},
+	{"ContentCreate", "Content Create", CategoryContent, "Create", "Create", ContentCreate, []string{"pencil", "edit", "write", "compose"}, []string{"EditorModeEdit", "ImageEdit"}},
+	{"ContentDeleteSweep", "Content Delete Sweep", CategoryContent, "Delete Sweep", "Delete sweep", ContentDeleteSweep, []string{"trash", "clear", "clean", "remove"}, nil},
+	{"ContentDrafts", "Content Drafts", CategoryContent, "Drafts", "Drafts", ContentDrafts, nil, nil},
+	{"ContentFilterList", "Content Filter List", CategoryContent, "Filter List", "Filter list", ContentFilterList, []string{"filter", "sort", "funnel"}, nil},
+	{"ContentFlag", "Content Flag", CategoryContent, "Flag", "Flag", ContentFlag, []string{"report", "mark", "banner"}, []string{"ImageAssistantPhoto"}},
+	{"ContentFontDownload", "Content Font Download", CategoryContent, "Font Download", "Font download", ContentFontDownload, nil, nil},
+	{"ContentForward", "Content Forward", CategoryContent, "Forward", "Forward", ContentForward, []string{"share", "send", "next"}, nil},
+	{"ContentGesture", "Content Gesture", CategoryContent, "Gesture", "Gesture", ContentGesture, nil, nil},
+	{"ContentInbox", "Content Inbox", CategoryContent, "Inbox", "Inbox", ContentInbox, []string{"tray", "mail", "messages"}, nil},
+	{"ContentLink", "Content Link", CategoryContent, "Link", "Link", ContentLink, []string{"chain", "url", "hyperlink"}, []string{"EditorInsertLink"}},
+	{"ContentLowPriority", "Content Low Priority", CategoryContent, "Low Priority", "Low priority", ContentLowPriority, nil, nil},
+	{"ContentMail", "Content Mail", CategoryContent, "Mail", "Mail, filled", ContentMail, []string{"filled", "email", "envelope", "message", "letter"}, []string{"CommunicationEmail", "ContentMarkUnread", "MapsLocalPostOffice"}},
+	{"ContentMarkUnread", "Content Mark Unread", CategoryContent, "Mark Unread", "Mark unread", ContentMarkUnread, nil, []string{"CommunicationEmail", "ContentMail", "MapsLocalPostOffice"}},
+	{"ContentMoveToInbox", "Content Move To Inbox", CategoryContent, "Move To Inbox", "Move to inbox", ContentMoveToInbox, nil, nil},
+	{"ContentNextWeek", "Content Next Week", CategoryContent, "Next Week", "Next week", ContentNextWeek, nil, nil},
+	{"ContentRedo", "Content Redo", CategoryContent, "Redo", "Redo", ContentRedo, []string{"forward", "repeat", "history"}, nil},
+	{"ContentRemove", "Content Remove", CategoryContent, "Remove", "Remove", ContentRemove, []string{"minus", "subtract", "delete"}, nil},
+	{"ContentRemoveCircle", "Content Remove Circle", CategoryContent, "Remove Circle", "Remove circle, filled", ContentRemoveCircle, []string{"filled", "minus", "subtract", "delete"}, []string{"NotificationDoNotDisturbOn"}},
+	{"ContentRemoveCircleOutline", "Content Remove Circle Outline", CategoryContent, "Remove Circle Outline", "Remove circle, outline", ContentRemoveCircleOutline, []string{"minus", "subtract", "delete"}, nil},
+	{"ContentReply", "Content Reply", CategoryContent, "Reply", "Reply", ContentReply, []string{"respond", "answer", "back"}, nil},
+	{"ContentReplyAll", "Content Reply All", CategoryContent, "Reply All", "Reply all", ContentReplyAll, nil, nil},
+	{"ContentReport", "Content Report", CategoryContent, "Report", "Report", ContentReport, nil, nil},
+	{"ContentSave", "Content Save", CategoryContent, "Save", "Save", ContentSave, []string{"floppy", "disk", "store", "keep"}, nil},
+	{"ContentSelectAll", "Content Select All", CategoryContent, "Select All", "Select all", ContentSelectAll, nil, nil},
+	{"ContentSend", "Content Send", CategoryContent, "Send", "Send", ContentSend, []string{"paper plane", "submit", "share", "message"}, nil},
+	{"ContentSort", "Content Sort", CategoryContent, "Sort", "Sort", ContentSort, []string{"order", "arrange", "filter"}, nil},
+	{"ContentTextFormat", "Content Text Format", CategoryContent, "Text Format", "Text format", ContentTextFormat, nil, nil},
+	{"ContentUnarchive", "Content Unarchive", CategoryContent, "Unarchive", "Unarchive", ContentUnarchive, []string{"box", "restore"}, nil},
+	{"ContentUndo", "Content Undo", CategoryContent, "Undo", "Undo", ContentUndo, []string{"back", "revert", "history"}, nil},
+	{"ContentWeekend", "Content Weekend", CategoryContent, "Weekend", "Weekend", ContentWeekend, nil, nil},
+	{"DeviceAccessAlarm", "Device Access Alarm", CategoryDevice, "Access Alarm", "Access alarm", DeviceAccessAlarm, nil, []string{"ActionAlarm"}},
+	{"DeviceAccessAlarms", "Device Access Alarms", CategoryDevice, "Access Alarms", "Access alarms", DeviceAccessAlarms, nil, nil},
+	{"DeviceAccessTime", "Device Access Time", CategoryDevice, "Access Time", "Access time", DeviceAccessTime, nil, []string{"ActionQueryBuilder", "ActionSchedule"}},
+	{"DeviceAddAlarm", "Device Add Alarm", CategoryDevice, "Add Alarm", "Add alarm", DeviceAddAlarm, nil, []string{"ActionAlarmAdd"}},
+	{"DeviceAirplaneModeActive", "Device Airplane Mode Active", CategoryDevice, "Airplane Mode Active", "Airplane mode active", DeviceAirplaneModeActive, nil, []string{"MapsFlight", "MapsLocalAirport"}},
+	{"DeviceAirplaneModeInactive", "Device Airplane Mode Inactive", CategoryDevice, "Airplane Mode Inactive", "Airplane mode inactive", DeviceAirplaneModeInactive, nil, nil},
+	{"DeviceBattery20", "Device Battery 20", CategoryDevice, "Battery 20", "Battery 20", DeviceBattery20, nil, nil},
+	{"DeviceBattery30", "Device Battery 30", CategoryDevice, "Battery 30", "Battery 30", DeviceBattery30, nil, nil},
+	{"DeviceBattery50", "Device Battery 50", CategoryDevice, "Battery 50", "Battery 50", DeviceBattery50, nil, nil},
+	{"DeviceBattery60", "Device Battery 60", CategoryDevice, "Battery 60", "Battery 60", DeviceBattery60, nil, nil},
+	{"DeviceBattery80", "Device Battery 80", CategoryDevice, "Battery 80", "Battery 80", DeviceBattery80, nil, nil},
+	{"DeviceBattery90", "Device Battery 90", CategoryDevice, "Battery 90", "Battery 90", DeviceBattery90, nil, nil},
+	{"DeviceBatteryAlert", "Device Battery Alert", CategoryDevice, "Battery Alert", "Battery alert", DeviceBatteryAlert, []string{"power", "low battery", "warning"}, nil},
+	{"DeviceBatteryCharging20", "Device Battery Charging 20", CategoryDevice, "Battery Charging 20", "Battery charging 20", DeviceBatteryCharging20, nil, nil},
+	{"DeviceBatteryCharging30", "Device Battery Charging 30", CategoryDevice, "Battery Charging 30", "Battery charging 30", DeviceBatteryCharging30, nil, nil},
+	{"DeviceBatteryCharging50", "Device Battery Charging 50", CategoryDevice, "Battery Charging 50", "Battery charging 50", DeviceBatteryCharging50, nil, nil},
+	{"DeviceBatteryCharging60", "Device Battery Charging 60", CategoryDevice, "Battery Charging 60", "Battery charging 60", DeviceBatteryCharging60, nil, nil},
+	{"DeviceBatteryCharging80", "Device Battery Charging 80", CategoryDevice, "Battery Charging 80", "Battery charging 80", DeviceBatteryCharging80, nil, nil},
+	{"DeviceBatteryCharging90", "Device Battery Charging 90", CategoryDevice, "Battery Charging 90", "Battery charging 90", DeviceBatteryCharging90, nil, nil},
+	{"DeviceBatteryChargingFull", "Device Battery Charging Full", CategoryDevice, "Battery Charging Full", "Battery charging full", DeviceBatteryChargingFull, []string{"power", "charging", "energy"}, nil},
+	{"DeviceBatteryFull", "Device Battery Full", CategoryDevice, "Battery Full", "Battery full", DeviceBatteryFull, []string{"power", "charge", "energy"}, []string{"DeviceBatteryStd"}},
+	{"DeviceBatteryStd", "Device Battery Std", CategoryDevice, "Battery Std", "Battery std", DeviceBatteryStd, nil, []string{"DeviceBatteryFull"}},
+	{"DeviceBatteryUnknown", "Device Battery Unknown", CategoryDevice, "Battery Unknown", "Battery unknown", DeviceBatteryUnknown, nil, nil},
+	{"DeviceBluetooth", "Device Bluetooth", CategoryDevice, "Bluetooth", "Bluetooth", DeviceBluetooth, []string{"wireless", "connection", "pairing"}, nil},
+	{"DeviceBluetoothConnected", "Device Bluetooth Connected", CategoryDevice, "Bluetooth Connected", "Bluetooth connected", DeviceBluetoothConnected, nil, nil},
+	{"DeviceBluetoothDisabled", "Device Bluetooth Disabled", CategoryDevice, "Bluetooth Disabled", "Bluetooth disabled", DeviceBluetoothDisabled, nil, nil},
+	{"DeviceBluetoothSearching", "Device Bluetooth Searching", CategoryDevice, "Bluetooth Searching", "Bluetooth searching", DeviceBluetoothSearching, nil, []string{"NotificationBluetoothAudio"}},
+	{"DeviceBrightnessAuto", "Device Brightness Auto", CategoryDevice, "Brightness Auto", "Brightness auto", DeviceBrightnessAuto, nil, nil},
+	{"DeviceBrightnessHigh", "Device Brightness High", CategoryDevice, "Brightness High", "Brightness high", DeviceBrightnessHigh, nil, []string{"ImageBrightness7"}},
+	{"DeviceBrightnessLow", "Device Brightness Low", CategoryDevice, "Brightness Low", "Brightness low", DeviceBrightnessLow, nil, []string{"ImageBrightness5"}},
+	{"DeviceBrightnessMedium", "Device Brightness Medium", CategoryDevice, "Brightness Medium", "Brightness medium", DeviceBrightnessMedium, nil, []string{"ImageBrightness6"}},
+	{"DeviceDVR", "Device DVR", CategoryDevice, "DVR", "DVR", DeviceDVR, nil, nil},
+	{"DeviceDataUsage", "Device Data Usage", CategoryDevice, "Data Usage", "Data usage", DeviceDataUsage, nil, nil},
+	{"DeviceDeveloperMode", "Device Developer Mode", CategoryDevice, "Developer Mode", "Developer mode", DeviceDeveloperMode, nil, nil},
+	{"DeviceDevices", "Device Devices", CategoryDevice, "Devices", "Devices", DeviceDevices, []string{"phone", "tablet", "computer", "responsive"}, []string{"HardwarePhoneLink"}},
+	{"DeviceGPSFixed", "Device GPS Fixed", CategoryDevice, "GPS Fixed", "GPS fixed", DeviceGPSFixed, []string{"location", "crosshair", "target", "position"}, []string{"MapsMyLocation"}},
+	{"DeviceGPSNotFixed", "Device GPS Not Fixed", CategoryDevice, "GPS Not Fixed", "GPS not fixed", DeviceGPSNotFixed, nil, nil},
+	{"DeviceGPSOff", "Device GPS Off", CategoryDevice, "GPS Off", "GPS off", DeviceGPSOff, nil, nil},
+	{"DeviceGraphicEq", "Device Graphic Eq", CategoryDevice, "Graphic Eq", "Graphic eq", DeviceGraphicEq, nil, nil},
+	{"DeviceLocationDisabled", "Device Location Disabled", CategoryDevice, "Location Disabled", "Location disabled", DeviceLocationDisabled, nil, nil},
+	{"DeviceLocationSearching", "Device Location Searching", CategoryDevice, "Location Searching", "Location searching", DeviceLocationSearching, nil, nil},
+	{"DeviceNFC", "Device NFC", CategoryDevice, "NFC", "NFC", DeviceNFC, nil, nil},
+	{"DeviceNetworkCell", "Device Network Cell", CategoryDevice, "Network Cell", "Network cell", DeviceNetworkCell, nil, []string{"DeviceSignalCellular3Bar"}},
+	{"DeviceNetworkWiFi", "Device Network Wi Fi", CategoryDevice, "Network Wi Fi", "Network Wi-Fi", DeviceNetworkWiFi, []string{"wifi", "wireless", "internet", "signal"}, []string{"DeviceSignalWiFi3Bar"}},
+	{"DeviceSDStorage", "Device SD Storage", CategoryDevice, "SD Storage", "SD storage", DeviceSDStorage, nil, []string{"NotificationSDCard"}},
+	{"DeviceScreenLockLandscape", "Device Screen Lock Landscape", CategoryDevice, "Screen Lock Landscape", "Screen lock landscape", DeviceScreenLockLandscape, nil, nil},
+	{"DeviceScreenLockPortrait", "Device Screen Lock Portrait", CategoryDevice, "Screen Lock Portrait", "Screen lock portrait", DeviceScreenLockPortrait, nil, nil},
+	{"DeviceScreenLockRotation", "Device Screen Lock Rotation", CategoryDevice, "Screen Lock Rotation", "Screen lock rotation", DeviceScreenLockRotation, nil, nil},
+	{"DeviceScreenRotation", "Device Screen Rotation", CategoryDevice, "Screen Rotation", "Screen rotation", DeviceScreenRotation, nil, nil},
+	{"DeviceSettingsSystemDaydream", "Device Settings System Daydream", CategoryDevice, "Settings System Daydream", "Settings system daydream", DeviceSettingsSystemDaydream, nil, nil},
+	{"DeviceSignalCellular0Bar", "Device Signal Cellular 0 Bar", CategoryDevice, "Signal Cellular 0 Bar", "Signal cellular 0 bar", DeviceSignalCellular0Bar, nil, nil},
+	{"DeviceSignalCellular1Bar", "Device Signal Cellular 1 Bar", CategoryDevice, "Signal Cellular 1 Bar", "Signal cellular 1 bar", DeviceSignalCellular1Bar, nil, nil},
+	{"DeviceSignalCellular2Bar", "Device Signal Cellular 2 Bar", CategoryDevice, "Signal Cellular 2 Bar", "Signal cellular 2 bar", DeviceSignalCellular2Bar, nil, nil},
+	{"DeviceSignalCellular3Bar", "Device Signal Cellular 3 Bar", CategoryDevice, "Signal Cellular 3 Bar", "Signal cellular 3 bar", DeviceSignalCellular3Bar, nil, []string{"DeviceNetworkCell"}},
+	{"DeviceSignalCellular4Bar", "Device Signal Cellular 4 Bar", CategoryDevice, "Signal Cellular 4 Bar", "Signal cellular 4 bar", DeviceSignalCellular4Bar, nil, nil},
+	{"DeviceSignalCellularConnectedNoInternet0Bar", "Device Signal Cellular Connected No Internet 0 Bar", CategoryDevice, "Signal Cellular Connected No Internet 0 Bar", "Signal cellular connected no internet 0 bar", DeviceSignalCellularConnectedNoInternet0Bar, nil, nil},
+	{"DeviceSignalCellularConnectedNoInternet1Bar", "Device Signal Cellular Connected No Internet 1 Bar", CategoryDevice, "Signal Cellular Connected No Internet 1 Bar", "Signal cellular connected no internet 1 bar", DeviceSignalCellularConnectedNoInternet1Bar, nil, nil},
+	{"DeviceSignalCellularConnectedNoInternet2Bar", "Device Signal Cellular Connected No Internet 2 Bar", CategoryDevice, "Signal Cellular Connected No Internet 2 Bar", "Signal cellular connected no internet 2 bar", DeviceSignalCellularConnectedNoInternet2Bar, nil, nil},
+	{"DeviceSignalCellularConnectedNoInternet3Bar", "Device Signal Cellular Connected No Internet 3 Bar", CategoryDevice, "Signal Cellular Connected No Internet 3 Bar", "Signal cellular connected no internet 3 bar", DeviceSignalCellularConnectedNoInternet3Bar, nil, nil},
+	{"DeviceSignalCellularConnectedNoInternet4Bar", "Device Signal Cellular Connected No Internet 4 Bar", CategoryDevice, "Signal Cellular Connected No Internet 4 Bar", "Signal cellular connected no internet 4 bar", DeviceSignalCellularConnectedNoInternet4Bar, nil, nil},
+	{"DeviceSignalCellularNoSIM", "Device Signal Cellular No SIM", CategoryDevice, "Signal Cellular No SIM", "Signal cellular no SIM", DeviceSignalCellularNoSIM, nil, []string{"CommunicationNoSIM"}},
+	{"DeviceSignalCellularNull", "Device Signal Cellular Null", CategoryDevice, "Signal Cellular Null", "Signal cellular null", DeviceSignalCellularNull, nil, nil},
+	{"DeviceSignalCellularOff", "Device Signal Cellular Off", CategoryDevice, "Signal Cellular Off", "Signal cellular off", DeviceSignalCellularOff, nil, nil},
+	{"DeviceSignalWiFi0Bar", "Device Signal Wi Fi 0 Bar", CategoryDevice, "Signal Wi Fi 0 Bar", "Signal Wi-Fi 0 bar", DeviceSignalWiFi0Bar, nil, nil},
+	{"DeviceSignalWiFi1Bar", "Device Signal Wi Fi 1 Bar", CategoryDevice, "Signal Wi Fi 1 Bar", "Signal Wi-Fi 1 bar", DeviceSignalWiFi1Bar, nil, nil},
+	{"DeviceSignalWiFi1BarLock", "Device Signal Wi Fi 1 Bar Lock", CategoryDevice, "Signal Wi Fi 1 Bar Lock", "Signal Wi-Fi 1 bar lock", DeviceSignalWiFi1BarLock, nil, nil},
+	{"DeviceSignalWiFi2Bar", "Device Signal Wi Fi 2 Bar", CategoryDevice, "Signal Wi Fi 2 Bar", "Signal Wi-Fi 2 bar", DeviceSignalWiFi2Bar, nil, nil},
+	{"DeviceSignalWiFi2BarLock", "Device Signal Wi Fi 2 Bar Lock", CategoryDevice, "Signal Wi Fi 2 Bar Lock", "Signal Wi-Fi 2 bar lock", DeviceSignalWiFi2BarLock, nil, nil},
+	{"DeviceSignalWiFi3Bar", "Device Signal Wi Fi 3 Bar", CategoryDevice, "Signal Wi Fi 3 Bar", "Signal Wi-Fi 3 bar", DeviceSignalWiFi3Bar, nil, []string{"DeviceNetworkWiFi"}},
+	{"DeviceSignalWiFi3BarLock", "Device Signal Wi Fi 3 Bar Lock", CategoryDevice, "Signal Wi Fi 3 Bar Lock", "Signal Wi-Fi 3 bar lock", DeviceSignalWiFi3BarLock, nil, nil},
+	{"DeviceSignalWiFi4Bar", "Device Signal Wi Fi 4 Bar", CategoryDevice, "Signal Wi Fi 4 Bar", "Signal Wi-Fi 4 bar", DeviceSignalWiFi4Bar, []string{"wifi", "wireless", "internet", "signal"}, nil},
+	{"DeviceSignalWiFi4BarLock", "Device Signal Wi Fi 4 Bar Lock", CategoryDevice, "Signal Wi Fi 4 Bar Lock", "Signal Wi-Fi 4 bar lock", DeviceSignalWiFi4BarLock, nil, nil},
+	{"DeviceSignalWiFiOff", "Device Signal Wi Fi Off", CategoryDevice, "Signal Wi Fi Off", "Signal Wi-Fi off", DeviceSignalWiFiOff, nil, nil},
+	{"DeviceStorage", "Device Storage", CategoryDevice, "Storage", "Storage", DeviceStorage, []string{"database", "server", "disk", "drive"}, nil},
+	{"DeviceUSB", "Device USB", CategoryDevice, "USB", "USB", DeviceUSB, []string{"connector", "cable", "port"}, nil},
+	{"DeviceWallpaper", "Device Wallpaper", CategoryDevice, "Wallpaper", "Wallpaper", DeviceWallpaper, nil, nil},
+	{"DeviceWiFiLock", "Device Wi Fi Lock", CategoryDevice, "Wi Fi Lock", "Wi-Fi lock", DeviceWiFiLock, nil, nil},
+	{"DeviceWiFiTethering", "Device Wi Fi Tethering", CategoryDevice, "Wi Fi Tethering", "Wi-Fi tethering", DeviceWiFiTethering, nil, nil},
+	{"DeviceWidgets", "Device Widgets", CategoryDevice, "Widgets", "Widgets", DeviceWidgets, nil, nil},
+	{"EditorAttachFile", "Editor Attach File", CategoryEditor, "Attach File", "Attach file", EditorAttachFile, []string{"paperclip", "attachment"}, nil},
+	{"EditorAttachMoney", "Editor Attach Money", CategoryEditor, "Attach Money", "Attach money", EditorAttachMoney, []string{"dollar", "money", "currency", "price"}, nil},
+	{"EditorBorderAll", "Editor Border All", CategoryEditor, "Border All", "Border all", EditorBorderAll, nil, nil},
+	{"EditorBorderBottom", "Editor Border Bottom", CategoryEditor, "Border Bottom", "Border bottom", EditorBorderBottom, nil, nil},
+	{"EditorBorderClear", "Editor Border Clear", CategoryEditor, "Border Clear", "Border clear", EditorBorderClear, nil, nil},
+	{"EditorBorderColor", "Editor Border Color", CategoryEditor, "Border Color", "Border color", EditorBorderColor, nil, nil},
+	{"EditorBorderHorizontal", "Editor Border Horizontal", CategoryEditor, "Border Horizontal", "Border horizontal", EditorBorderHorizontal, nil, nil},
+	{"EditorBorderInner", "Editor Border Inner", CategoryEditor, "Border Inner", "Border inner", EditorBorderInner, nil, nil},
+	{"EditorBorderLeft", "Editor Border Left", CategoryEditor, "Border Left", "Border left", EditorBorderLeft, nil, nil},
+	{"EditorBorderOuter", "Editor Border Outer", CategoryEditor, "Border Outer", "Border outer", EditorBorderOuter, nil, nil},
+	{"EditorBorderRight", "Editor Border Right", CategoryEditor, "Border Right", "Border right", EditorBorderRight, nil, nil},
+	{"EditorBorderStyle", "Editor Border Style", CategoryEditor, "Border Style", "Border style", EditorBorderStyle, nil, nil},
+	{"EditorBorderTop", "Editor Border Top", CategoryEditor, "Border Top", "Border top", EditorBorderTop, nil, nil},
+	{"EditorBorderVertical", "Editor Border Vertical", CategoryEditor, "Border Vertical", "Border vertical", EditorBorderVertical, nil, nil},
+	{"EditorBubbleChart", "Editor Bubble Chart", CategoryEditor, "Bubble Chart", "Bubble chart", EditorBubbleChart, nil, nil},
+	{"EditorDragHandle", "Editor Drag Handle", CategoryEditor, "Drag Handle", "Drag handle", EditorDragHandle, []string{"drag", "reorder", "grip", "move"}, nil},
+	{"EditorFormatAlignCenter", "Editor Format Align Center", CategoryEditor, "Format Align Center", "Format align center", EditorFormatAlignCenter, nil, nil},
+	{"EditorFormatAlignJustify", "Editor Format Align Justify", CategoryEditor, "Format Align Justify", "Format align justify", EditorFormatAlignJustify, nil, nil},
+	{"EditorFormatAlignLeft", "Editor Format Align Left", CategoryEditor, "Format Align Left", "Format align left", EditorFormatAlignLeft, nil, nil},
+	{"EditorFormatAlignRight", "Editor Format Align Right", CategoryEditor, "Format Align Right", "Format align right", EditorFormatAlignRight, nil, nil},
+	{"EditorFormatBold", "Editor Format Bold", CategoryEditor, "Format Bold", "Format bold", EditorFormatBold, []string{"bold", "text", "font", "strong"}, nil},
+	{"EditorFormatClear", "Editor Format Clear", CategoryEditor, "Format Clear", "Format clear", EditorFormatClear, nil, nil},
+	{"EditorFormatColorFill", "Editor Format Color Fill", CategoryEditor, "Format Color Fill", "Format color fill", EditorFormatColorFill, nil, nil},
+	{"EditorFormatColorReset", "Editor Format Color Reset", CategoryEditor, "Format Color Reset", "Format color reset", EditorFormatColorReset, nil, nil},
+	{"EditorFormatColorText", "Editor Format Color Text", CategoryEditor, "Format Color Text", "Format color text", EditorFormatColorText, nil, nil},
+	{"EditorFormatIndentDecrease", "Editor Format Indent Decrease", CategoryEditor, "Format Indent Decrease", "Format indent decrease", EditorFormatIndentDecrease, nil, nil},
+	{"EditorFormatIndentIncrease", "Editor Format Indent Increase", CategoryEditor, "Format Indent Increase", "Format indent increase", EditorFormatIndentIncrease, nil, nil},
+	{"EditorFormatItalic", "Editor Format Italic", CategoryEditor, "Format Italic", "Format italic", EditorFormatItalic, []string{"italic", "text", "font", "emphasis"}, nil},
+	{"EditorFormatLineSpacing", "Editor Format Line Spacing", CategoryEditor, "Format Line Spacing", "Format line spacing", EditorFormatLineSpacing, nil, nil},
+	{"EditorFormatListBulleted", "Editor Format List Bulleted", CategoryEditor, "Format List Bulleted", "Format list bulleted", EditorFormatListBulleted, []string{"list", "bullets", "unordered"}, nil},
+	{"EditorFormatListNumbered", "Editor Format List Numbered", CategoryEditor, "Format List Numbered", "Format list numbered", EditorFormatListNumbered, []string{"list", "numbers", "ordered"}, nil},
+	{"EditorFormatPaint", "Editor Format Paint", CategoryEditor, "Format Paint", "Format paint", EditorFormatPaint, nil, nil},
+	{"EditorFormatQuote", "Editor Format Quote", CategoryEditor, "Format Quote", "Format quote", EditorFormatQuote, []string{"quote", "quotation", "blockquote"}, nil},
+	{"EditorFormatShapes", "Editor Format Shapes", CategoryEditor, "Format Shapes", "Format shapes", EditorFormatShapes, nil, nil},
+	{"EditorFormatSize", "Editor Format Size", CategoryEditor, "Format Size", "Format size", EditorFormatSize, nil, nil},
+	{"EditorFormatStrikethrough", "Editor Format Strikethrough", CategoryEditor, "Format Strikethrough", "Format strikethrough", EditorFormatStrikethrough, nil, nil},
+	{"EditorFormatTextDirectionLToR", "Editor Format Text Direction L To R", CategoryEditor, "Format Text Direction L To R", "Format text direction left to right", EditorFormatTextDirectionLToR, nil, nil},
+	{"EditorFormatTextDirectionRToL", "Editor Format Text Direction R To L", CategoryEditor, "Format Text Direction R To L", "Format text direction right to left", EditorFormatTextDirectionRToL, nil, nil},
+	{"EditorFormatUnderlined", "Editor Format Underlined", CategoryEditor, "Format Underlined", "Format underlined", EditorFormatUnderlined, []string{"underline", "text", "font"}, nil},
+	{"EditorFunctions", "Editor Functions", CategoryEditor, "Functions", "Functions", EditorFunctions, []string{"sigma", "sum", "math", "formula"}, nil},
+	{"EditorHighlight", "Editor Highlight", CategoryEditor, "Highlight", "Highlight", EditorHighlight, nil, nil},
+	{"EditorInsertChart", "Editor Insert Chart", CategoryEditor, "Insert Chart", "Insert chart", EditorInsertChart, []string{"chart", "graph", "bar chart", "analytics"}, []string{"ActionAssessment", "SocialPoll"}},
+	{"EditorInsertComment", "Editor Insert Comment", CategoryEditor, "Insert Comment", "Insert comment", EditorInsertComment, nil, nil},
+	{"EditorInsertDriveFile", "Editor Insert Drive File", CategoryEditor, "Insert Drive File", "Insert drive file", EditorInsertDriveFile, []string{"document", "file", "page"}, nil},
+	{"EditorInsertEmoticon", "Editor Insert Emoticon", CategoryEditor, "Insert Emoticon", "Insert emoticon", EditorInsertEmoticon, []string{"emoji", "smile", "face", "smiley"}, []string{"ImageTagFaces", "SocialMood"}},
+	{"EditorInsertInvitation", "Editor Insert Invitation", CategoryEditor, "Insert Invitation", "Insert invitation", EditorInsertInvitation, nil, []string{"ActionEvent"}},
+	{"EditorInsertLink", "Editor Insert Link", CategoryEditor, "Insert Link", "Insert link", EditorInsertLink, []string{"chain", "url", "hyperlink"}, []string{"ContentLink"}},
+	{"EditorInsertPhoto", "Editor Insert Photo", CategoryEditor, "Insert Photo", "Insert photo", EditorInsertPhoto, []string{"image", "picture", "photo"}, []string{"ImageImage", "ImagePhoto"}},
+	{"EditorLinearScale", "Editor Linear Scale", CategoryEditor, "Linear Scale", "Linear scale", EditorLinearScale, nil, nil},
+	{"EditorMergeType", "Editor Merge Type", CategoryEditor, "Merge Type", "Merge type", EditorMergeType, nil, []string{"CommunicationCallMerge"}},
+	{"EditorModeComment", "Editor Mode Comment", CategoryEditor, "Mode Comment", "Mode comment", EditorModeComment, nil, nil},
+	{"EditorModeEdit", "Editor Mode Edit", CategoryEditor, "Mode Edit", "Mode edit", EditorModeEdit, []string{"pencil", "edit", "write", "compose"}, []string{"ContentCreate", "ImageEdit"}},
+	{"EditorMonetizationOn", "Editor Monetization On", CategoryEditor, "Monetization On", "Monetization on", EditorMonetizationOn, []string{"dollar", "money", "coin", "currency"}, nil},
+	{"EditorMoneyOff", "Editor Money Off", CategoryEditor, "Money Off", "Money off", EditorMoneyOff, nil, nil},
+	{"EditorMultilineChart", "Editor Multiline Chart", CategoryEditor, "Multiline Chart", "Multiline chart", EditorMultilineChart, nil, nil},
+	{"EditorPieChart", "Editor Pie Chart", CategoryEditor, "Pie Chart", "Pie chart, filled", EditorPieChart, []string{"filled", "chart", "graph", "analytics"}, nil},
+	{"EditorPieChartOutlined", "Editor Pie Chart Outlined", CategoryEditor, "Pie Chart Outlined", "Pie chart, outline", EditorPieChartOutlined, nil, nil},
+	{"EditorPublish", "Editor Publish", CategoryEditor, "Publish", "Publish", EditorPublish, []string{"upload", "publish", "arrow up"}, nil},
+	{"EditorShortText", "Editor Short Text", CategoryEditor, "Short Text", "Short text", EditorShortText, nil, nil},
+	{"EditorShowChart", "Editor Show Chart", CategoryEditor, "Show Chart", "Show chart", EditorShowChart, []string{"chart", "graph", "line", "analytics"}, nil},
+	{"EditorSpaceBar", "Editor Space Bar", CategoryEditor, "Space Bar", "Space bar", EditorSpaceBar, nil, nil},
+	{"EditorStrikethroughS", "Editor Strikethrough S", CategoryEditor, "Strikethrough S", "Strikethrough s", EditorStrikethroughS, nil, nil},
+	{"EditorTextFields", "Editor Text Fields", CategoryEditor, "Text Fields", "Text fields", EditorTextFields, nil, nil},
+	{"EditorTitle", "Editor Title", CategoryEditor, "Title", "Title", EditorTitle, nil, nil},
+	{"EditorVerticalAlignBottom", "Editor Vertical Align Bottom", CategoryEditor, "Vertical Align Bottom", "Vertical align bottom", EditorVerticalAlignBottom, nil, nil},
+	{"EditorVerticalAlignCenter", "Editor Vertical Align Center", CategoryEditor, "Vertical Align Center", "Vertical align center", EditorVerticalAlignCenter, nil, nil},
+	{"EditorVerticalAlignTop", "Editor Vertical Align Top", CategoryEditor, "Vertical Align Top", "Vertical align top", EditorVerticalAlignTop, nil, nil},
+	{"EditorWrapText", "Editor Wrap Text", CategoryEditor, "Wrap Text", "Wrap text", EditorWrapText, nil, nil},
+	{"FileAttachment", "File Attachment", CategoryFile, "Attachment", "Attachment", FileAttachment, []string{"paperclip", "attach", "file"}, nil},
+	{"FileCloud", "File Cloud", CategoryFile, "Cloud", "Cloud", FileCloud, []string{"weather", "storage", "online"}, []string{"ImageWBCloudy"}},
+	{"FileCloudCircle", "File Cloud Circle", CategoryFile, "Cloud Circle", "Cloud circle", FileCloudCircle, nil, nil},
+	{"FileCloudDone", "File Cloud Done", CategoryFile, "Cloud Done", "Cloud done", FileCloudDone, nil, nil},
+	{"FileCloudDownload", "File Cloud Download", CategoryFile, "Cloud Download", "Cloud download", FileCloudDownload, []string{"download", "storage"}, nil},
+	{"FileCloudOff", "File Cloud Off", CategoryFile, "Cloud Off", "Cloud off", FileCloudOff, nil, nil},
+	{"FileCloudQueue", "File Cloud Queue", CategoryFile, "Cloud Queue", "Cloud queue", FileCloudQueue, nil, nil},
+	{"FileCloudUpload", "File Cloud Upload", CategoryFile, "Cloud Upload", "Cloud upload", FileCloudUpload, []string{"upload", "backup", "storage"}, []string{"ActionBackup"}},
+	{"FileCreateNewFolder", "File Create New Folder", CategoryFile, "Create New Folder", "Create new folder", FileCreateNewFolder, []string{"directory", "new folder", "add"}, nil},
+	{"FileFileDownload", "File File Download", CategoryFile, "File Download", "Download", FileFileDownload, []string{"download", "save", "arrow down"}, []string{"ActionGetApp"}},
+	{"FileFileUpload", "File File Upload", CategoryFile, "File Upload", "Upload", FileFileUpload, []string{"upload", "arrow up"}, nil},
+	{"FileFolder", "File Folder", CategoryFile, "Folder", "Folder", FileFolder, []string{"directory", "files"}, nil},
+	{"FileFolderOpen", "File Folder Open", CategoryFile, "Folder Open", "Folder open", FileFolderOpen, []string{"directory", "files", "open"}, nil},
+	{"FileFolderShared", "File Folder Shared", CategoryFile, "Folder Shared", "Folder shared", FileFolderShared, nil, nil},
+	{"HardwareCast", "Hardware Cast", CategoryHardware, "Cast", "Cast", HardwareCast, []string{"chromecast", "stream", "screen", "tv"}, nil},
+	{"HardwareCastConnected", "Hardware Cast Connected", CategoryHardware, "Cast Connected", "Cast connected", HardwareCastConnected, nil, nil},
+	{"HardwareComputer", "Hardware Computer", CategoryHardware, "Computer", "Computer", HardwareComputer, []string{"pc", "desktop", "monitor", "workstation"}, nil},
+	{"HardwareDesktopMac", "Hardware Desktop Mac", CategoryHardware, "Desktop Mac", "Desktop Mac", HardwareDesktopMac, nil, nil},
+	{"HardwareDesktopWindows", "Hardware Desktop Windows", CategoryHardware, "Desktop Windows", "Desktop Windows", HardwareDesktopWindows, []string{"pc", "desktop", "monitor", "screen"}, nil},
+	{"HardwareDeveloperBoard", "Hardware Developer Board", CategoryHardware, "Developer Board", "Developer board", HardwareDeveloperBoard, []string{"chip", "circuit", "hardware", "electronics"}, nil},
+	{"HardwareDeviceHub", "Hardware Device Hub", CategoryHardware, "Device Hub", "Device hub", HardwareDeviceHub, nil, nil},
+	{"HardwareDevicesOther", "Hardware Devices Other", CategoryHardware, "Devices Other", "Devices other", HardwareDevicesOther, nil, nil},
+	{"HardwareDock", "Hardware Dock", CategoryHardware, "Dock", "Dock", HardwareDock, nil, nil},
+	{"HardwareGamepad", "Hardware Gamepad", CategoryHardware, "Gamepad", "Gamepad", HardwareGamepad, []string{"game", "controller", "joystick", "play"}, []string{"AVGames"}},
+	{"HardwareHeadset", "Hardware Headset", CategoryHardware, "Headset", "Headset", HardwareHeadset, []string{"headphones", "audio", "music", "support"}, nil},
+	{"HardwareHeadsetMic", "Hardware Headset Mic", CategoryHardware, "Headset Mic", "Headset mic", HardwareHeadsetMic, nil, nil},
+	{"HardwareKeyboard", "Hardware Keyboard", CategoryHardware, "Keyboard", "Keyboard", HardwareKeyboard, []string{"typing", "keys", "input"}, nil},
+	{"HardwareKeyboardArrowDown", "Hardware Keyboard Arrow Down", CategoryHardware, "Keyboard Arrow Down", "Keyboard arrow down", HardwareKeyboardArrowDown, []string{"chevron", "down", "expand"}, nil},
+	{"HardwareKeyboardArrowLeft", "Hardware Keyboard Arrow Left", CategoryHardware, "Keyboard Arrow Left", "Keyboard arrow left", HardwareKeyboardArrowLeft, []string{"chevron", "back", "previous"}, nil},
+	{"HardwareKeyboardArrowRight", "Hardware Keyboard Arrow Right", CategoryHardware, "Keyboard Arrow Right", "Keyboard arrow right", HardwareKeyboardArrowRight, []string{"chevron", "forward", "next"}, nil},
+	{"HardwareKeyboardArrowUp", "Hardware Keyboard Arrow Up", CategoryHardware, "Keyboard Arrow Up", "Keyboard arrow up", HardwareKeyboardArrowUp, []string{"chevron", "up", "collapse"}, nil},
+	{"HardwareKeyboardBackspace", "Hardware Keyboard Backspace", CategoryHardware, "Keyboard Backspace", "Keyboard backspace", HardwareKeyboardBackspace, nil, nil},
+	{"HardwareKeyboardCapslock", "Hardware Keyboard Capslock", CategoryHardware, "Keyboard Capslock", "Keyboard capslock", HardwareKeyboardCapslock, nil, nil},
+	{"HardwareKeyboardHide", "Hardware Keyboard Hide", CategoryHardware, "Keyboard Hide", "Keyboard hide", HardwareKeyboardHide, nil, nil},
+	{"HardwareKeyboardReturn", "Hardware Keyboard Return", CategoryHardware, "Keyboard Return", "Keyboard return", HardwareKeyboardReturn, nil, nil},
+	{"HardwareKeyboardTab", "Hardware Keyboard Tab", CategoryHardware, "Keyboard Tab", "Keyboard tab", HardwareKeyboardTab, nil, nil},
+	{"HardwareKeyboardVoice", "Hardware Keyboard Voice", CategoryHardware, "Keyboard Voice", "Keyboard voice", HardwareKeyboardVoice, nil, nil},
+	{"HardwareLaptop", "Hardware Laptop", CategoryHardware, "Laptop", "Laptop", HardwareLaptop, []string{"notebook", "computer", "pc"}, nil},
+	{"HardwareLaptopChromebook", "Hardware Laptop Chromebook", CategoryHardware, "Laptop Chromebook", "Laptop Chromebook", HardwareLaptopChromebook, nil, nil},
+	{"HardwareLaptopMac", "Hardware Laptop Mac", CategoryHardware, "Laptop Mac", "Laptop Mac", HardwareLaptopMac, nil, nil},
+	{"HardwareLaptopWindows", "Hardware Laptop Windows", CategoryHardware, "Laptop Windows", "Laptop Windows", HardwareLaptopWindows, nil, nil},
+	{"HardwareMemory", "Hardware Memory", CategoryHardware, "Memory", "Memory", HardwareMemory, []string{"chip", "cpu", "processor", "ram"}, nil},
+	{"HardwareMouse", "Hardware Mouse", CategoryHardware, "Mouse", "Mouse", HardwareMouse, []string{"pointer", "click", "input"}, nil},
+	{"HardwarePhoneAndroid", "Hardware Phone Android", CategoryHardware, "Phone Android", "Phone Android", HardwarePhoneAndroid, []string{"phone", "mobile", "cellphone"}, nil},
+	{"HardwarePhoneIPhone", "Hardware Phone I Phone", CategoryHardware, "Phone I Phone", "Phone iPhone", HardwarePhoneIPhone, []string{"phone", "mobile", "cellphone"}, nil},
+	{"HardwarePhoneLink", "Hardware Phone Link", CategoryHardware, "Phone Link", "Phone link", HardwarePhoneLink, nil, []string{"DeviceDevices"}},
+	{"HardwarePhoneLinkOff", "Hardware Phone Link Off", CategoryHardware, "Phone Link Off", "Phone link off", HardwarePhoneLinkOff, nil, nil},
+	{"HardwarePowerInput", "Hardware Power Input", CategoryHardware, "Power Input", "Power input", HardwarePowerInput, nil, nil},
+	{"HardwareRouter", "Hardware Router", CategoryHardware, "Router", "Router", HardwareRouter, []string{"network", "internet", "wireless"}, nil},
+	{"HardwareSIMCard", "Hardware SIM Card", CategoryHardware, "SIM Card", "SIM card", HardwareSIMCard, nil, nil},
+	{"HardwareScanner", "Hardware Scanner", CategoryHardware, "Scanner", "Scanner", HardwareScanner, nil, nil},
+	{"HardwareSecurity", "Hardware Security", CategoryHardware, "Security", "Security", HardwareSecurity, []string{"shield", "protection", "safe"}, nil},
+	{"HardwareSmartphone", "Hardware Smartphone", CategoryHardware, "Smartphone", "Smartphone", HardwareSmartphone, []string{"phone", "mobile", "cellphone"}, nil},
+	{"HardwareSpeaker", "Hardware Speaker", CategoryHardware, "Speaker", "Speaker", HardwareSpeaker, []string{"audio", "sound", "music"}, nil},
+	{"HardwareSpeakerGroup", "Hardware Speaker Group", CategoryHardware, "Speaker Group", "Speaker group", HardwareSpeakerGroup, nil, nil},
+	{"HardwareTV", "Hardware TV", CategoryHardware, "TV", "TV", HardwareTV, []string{"television", "screen", "monitor", "display"}, []string{"NotificationPersonalVideo"}},
+	{"HardwareTablet", "Hardware Tablet", CategoryHardware, "Tablet", "Tablet", HardwareTablet, []string{"ipad", "device", "screen"}, nil},
+	{"HardwareTabletAndroid", "Hardware Tablet Android", CategoryHardware, "Tablet Android", "Tablet Android", HardwareTabletAndroid, nil, nil},
+	{"HardwareTabletMac", "Hardware Tablet Mac", CategoryHardware, "Tablet Mac", "Tablet Mac", HardwareTabletMac, nil, nil},
+	{"HardwareToys", "Hardware Toys", CategoryHardware, "Toys", "Toys", HardwareToys, nil, nil},
+	{"HardwareVideogameAsset", "Hardware Videogame Asset", CategoryHardware, "Videogame Asset", "Videogame asset", HardwareVideogameAsset, []string{"game", "controller", "gamepad", "play"}, nil},
+	{"HardwareWatch", "Hardware Watch", CategoryHardware, "Watch", "Watch", HardwareWatch, []string{"clock", "time", "smartwatch", "wearable"}, nil},
+	{"ImageAddAPhoto", "Image Add A Photo", CategoryImage, "Add A Photo", "Add a photo", ImageAddAPhoto, []string{"camera", "picture", "upload"}, nil},
+	{"ImageAddToPhotos", "Image Add To Photos", CategoryImage, "Add To Photos", "Add to photos", ImageAddToPhotos, nil, []string{"AVLibraryAdd", "AVQueue"}},
+	{"ImageAdjust", "Image Adjust", CategoryImage, "Adjust", "Adjust", ImageAdjust, nil, nil},
+	{"ImageAssistant", "Image Assistant", CategoryImage, "Assistant", "Assistant", ImageAssistant, nil, nil},
+	{"ImageAssistantPhoto", "Image Assistant Photo", CategoryImage, "Assistant Photo", "Assistant photo", ImageAssistantPhoto, nil, []string{"ContentFlag"}},
+	{"ImageAudiotrack", "Image Audiotrack", CategoryImage, "Audiotrack", "Audiotrack", ImageAudiotrack, []string{"music", "song", "audio", "note"}, nil},
+	{"ImageBlurCircular", "Image Blur Circular", CategoryImage, "Blur Circular", "Blur circular", ImageBlurCircular, nil, nil},
+	{"ImageBlurLinear", "Image Blur Linear", CategoryImage, "Blur Linear", "Blur linear", ImageBlurLinear, nil, nil},
+	{"ImageBlurOff", "Image Blur Off", CategoryImage, "Blur Off", "Blur off", ImageBlurOff, nil, nil},
+	{"ImageBlurOn", "Image Blur On", CategoryImage, "Blur On", "Blur on", ImageBlurOn, nil, nil},
+	{"ImageBrightness1", "Image Brightness 1", CategoryImage, "Brightness 1", "Brightness 1", ImageBrightness1, nil, nil},
+	{"ImageBrightness2", "Image Brightness 2", CategoryImage, "Brightness 2", "Brightness 2", ImageBrightness2, []string{"moon", "night", "dark mode"}, nil},
+	{"ImageBrightness3", "Image Brightness 3", CategoryImage, "Brightness 3", "Brightness 3", ImageBrightness3, []string{"moon", "night", "dark mode"}, nil},
+	{"ImageBrightness4", "Image Brightness 4", CategoryImage, "Brightness 4", "Brightness 4", ImageBrightness4, nil, nil},
+	{"ImageBrightness5", "Image Brightness 5", CategoryImage, "Brightness 5", "Brightness 5", ImageBrightness5, nil, []string{"DeviceBrightnessLow"}},
+	{"ImageBrightness6", "Image Brightness 6", CategoryImage, "Brightness 6", "Brightness 6", ImageBrightness6, nil, []string{"DeviceBrightnessMedium"}},
+	{"ImageBrightness7", "Image Brightness 7", CategoryImage, "Brightness 7", "Brightness 7", ImageBrightness7, nil, []string{"DeviceBrightnessHigh"}},
+	{"ImageBrokenImage", "Image Broken Image", CategoryImage, "Broken Image", "Broken image", ImageBrokenImage, nil, nil},
+	{"ImageBrush", "Image Brush", CategoryImage, "Brush", "Brush", ImageBrush, []string{"paint", "art", "draw"}, nil},
+	{"ImageBurstMode", "Image Burst Mode", CategoryImage, "Burst Mode", "Burst mode", ImageBurstMode, nil, nil},
+	{"ImageCamera", "Image Camera", CategoryImage, "Camera", "Camera", ImageCamera, nil, nil},
+	{"ImageCameraAlt", "Image Camera Alt", CategoryImage, "Camera Alt", "Camera alt", ImageCameraAlt, []string{"camera", "picture", "snapshot"}, []string{"ImagePhotoCamera", "MapsLocalSee"}},
+	{"ImageCameraFront", "Image Camera Front", CategoryImage, "Camera Front", "Camera front", ImageCameraFront, nil, nil},
+	{"ImageCameraRear", "Image Camera Rear", CategoryImage, "Camera Rear", "Camera rear", ImageCameraRear, nil, nil},
+	{"ImageCameraRoll", "Image Camera Roll", CategoryImage, "Camera Roll", "Camera roll", ImageCameraRoll, nil, nil},
+	{"ImageCenterFocusStrong", "Image Center Focus Strong", CategoryImage, "Center Focus Strong", "Center focus strong", ImageCenterFocusStrong, nil, nil},
+	{"ImageCenterFocusWeak", "Image Center Focus Weak", CategoryImage, "Center Focus Weak", "Center focus weak", ImageCenterFocusWeak, nil, nil},
+	{"ImageCollections", "Image Collections", CategoryImage, "Collections", "Collections", ImageCollections, []string{"gallery", "pictures", "album"}, []string{"ImagePhotoLibrary"}},
+	{"ImageCollectionsBookmark", "Image Collections Bookmark", CategoryImage, "Collections Bookmark", "Collections bookmark", ImageCollectionsBookmark, nil, nil},
+	{"ImageColorLens", "Image Color Lens", CategoryImage, "Color Lens", "Color lens", ImageColorLens, []string{"colors", "colours", "paint", "palette"}, []string{"ImagePalette"}},
+	{"ImageColorize", "Image Colorize", CategoryImage, "Colorize", "Colorize", ImageColorize, nil, nil},
+	{"ImageCompare", "Image Compare", CategoryImage, "Compare", "Compare", ImageCompare, nil, nil},
+	{"ImageControlPoint", "Image Control Point", CategoryImage, "Control Point", "Control point", ImageControlPoint, nil, nil},
+	{"ImageControlPointDuplicate", "Image Control Point Duplicate", CategoryImage, "Control Point Duplicate", "Control point duplicate", ImageControlPointDuplicate, nil, nil},
+	{"ImageCrop", "Image Crop", CategoryImage, "Crop", "Crop", ImageCrop, []string{"trim", "cut", "resize"}, nil},
+	{"ImageCrop169", "Image Crop 169", CategoryImage, "Crop 169", "Crop 16:9", ImageCrop169, nil, nil},
+	{"ImageCrop32", "Image Crop 32", CategoryImage, "Crop 32", "Crop 3:2", ImageCrop32, nil, nil},
+	{"ImageCrop54", "Image Crop 54", CategoryImage, "Crop 54", "Crop 5:4", ImageCrop54, nil, []string{"ImageCropLandscape"}},
+	{"ImageCrop75", "Image Crop 75", CategoryImage, "Crop 75", "Crop 7:5", ImageCrop75, nil, nil},
+	{"ImageCropDIN", "Image Crop DIN", CategoryImage, "Crop DIN", "Crop DIN", ImageCropDIN, nil, nil},
+	{"ImageCropFree", "Image Crop Free", CategoryImage, "Crop Free", "Crop free", ImageCropFree, nil, nil},
+	{"ImageCropLandscape", "Image Crop Landscape", CategoryImage, "Crop Landscape", "Crop landscape", ImageCropLandscape, nil, []string{"ImageCrop54"}},
+	{"ImageCropOriginal", "Image Crop Original", CategoryImage, "Crop Original", "Crop original", ImageCropOriginal, nil, nil},
+	{"ImageCropPortrait", "Image Crop Portrait", CategoryImage, "Crop Portrait", "Crop portrait", ImageCropPortrait, nil, nil},
+	{"ImageCropRotate", "Image Crop Rotate", CategoryImage, "Crop Rotate", "Crop rotate", ImageCropRotate, nil, nil},
+	{"ImageCropSquare", "Image Crop Square", CategoryImage, "Crop Square", "Crop square", ImageCropSquare, nil, nil},
+	{"ImageDehaze", "Image Dehaze", CategoryImage, "Dehaze", "Dehaze", ImageDehaze, nil, nil},
+	{"ImageDetails", "Image Details", CategoryImage, "Details", "Details", ImageDetails, nil, nil},
+	{"ImageEdit", "Image Edit", CategoryImage, "Edit", "Edit", ImageEdit, []string{"pencil", "write", "compose"}, []string{"ContentCreate", "EditorModeEdit"}},
+	{"ImageExposure", "Image Exposure", CategoryImage, "Exposure", "Exposure", ImageExposure, nil, nil},
+	{"ImageExposureNeg1", "Image Exposure Neg 1", CategoryImage, "Exposure Neg 1", "Exposure neg 1", ImageExposureNeg1, nil, nil},
+	{"ImageExposureNeg2", "Image Exposure Neg 2", CategoryImage, "Exposure Neg 2", "Exposure neg 2", ImageExposureNeg2, nil, nil},
+	{"ImageExposurePlus1", "Image Exposure Plus 1", CategoryImage, "Exposure Plus 1", "Exposure plus 1", ImageExposurePlus1, nil, nil},
+	{"ImageExposurePlus2", "Image Exposure Plus 2", CategoryImage, "Exposure Plus 2", "Exposure plus 2", ImageExposurePlus2, nil, nil},
+	{"ImageExposureZero", "Image Exposure Zero", CategoryImage, "Exposure Zero", "Exposure zero", ImageExposureZero, nil, nil},
+	{"ImageFilter", "Image Filter", CategoryImage, "Filter", "Filter", ImageFilter, nil, nil},
+	{"ImageFilter1", "Image Filter 1", CategoryImage, "Filter 1", "Filter 1", ImageFilter1, nil, nil},
+	{"ImageFilter2", "Image Filter 2", CategoryImage, "Filter 2", "Filter 2", ImageFilter2, nil, nil},
+	{"ImageFilter3", "Image Filter 3", CategoryImage, "Filter 3", "Filter 3", ImageFilter3, nil, nil},
+	{"ImageFilter4", "Image Filter 4", CategoryImage, "Filter 4", "Filter 4", ImageFilter4, nil, nil},
+	{"ImageFilter5", "Image Filter 5", CategoryImage, "Filter 5", "Filter 5", ImageFilter5, nil, nil},
+	{"ImageFilter6", "Image Filter 6", CategoryImage, "Filter 6", "Filter 6", ImageFilter6, nil, nil},
+	{"ImageFilter7", "Image Filter 7", CategoryImage, "Filter 7", "Filter 7", ImageFilter7, nil, nil},
+	{"ImageFilter8", "Image Filter 8", CategoryImage, "Filter 8", "Filter 8", ImageFilter8, nil, nil},
+	{"ImageFilter9", "Image Filter 9", CategoryImage, "Filter 9", "Filter 9", ImageFilter9, nil, nil},
+	{"ImageFilter9Plus", "Image Filter 9 Plus", CategoryImage, "Filter 9 Plus", "Filter 9 plus", ImageFilter9Plus, nil, nil},
+	{"ImageFilterBAndW", "Image Filter B And W", CategoryImage, "Filter B And W", "Filter black and white", ImageFilterBAndW, nil, nil},
+	{"ImageFilterCenterFocus", "Image Filter Center Focus", CategoryImage, "Filter Center Focus", "Filter center focus", ImageFilterCenterFocus, nil, nil},
+	{"ImageFilterDrama", "Image Filter Drama", CategoryImage, "Filter Drama", "Filter drama", ImageFilterDrama, nil, nil},
+	{"ImageFilterFrames", "Image Filter Frames", CategoryImage, "Filter Frames", "Filter frames", ImageFilterFrames, nil, nil},
+	{"ImageFilterHDR", "Image Filter HDR", CategoryImage, "Filter HDR", "Filter HDR", ImageFilterHDR, nil, []string{"ImageLandscape", "MapsTerrain"}},
+	{"ImageFilterNone", "Image Filter None", CategoryImage, "Filter None", "Filter none", ImageFilterNone, nil, nil},
+	{"ImageFilterTiltShift", "Image Filter Tilt Shift", CategoryImage, "Filter Tilt Shift", "Filter tilt shift", ImageFilterTiltShift, nil, nil},
+	{"ImageFilterVintage", "Image Filter Vintage", CategoryImage, "Filter Vintage", "Filter vintage", ImageFilterVintage, nil, nil},
+	{"ImageFlare", "Image Flare", CategoryImage, "Flare", "Flare", ImageFlare, nil, nil},
+	{"ImageFlashAuto", "Image Flash Auto", CategoryImage, "Flash Auto", "Flash auto", ImageFlashAuto, nil, nil},
+	{"ImageFlashOff", "Image Flash Off", CategoryImage, "Flash Off", "Flash off", ImageFlashOff, nil, nil},
+	{"ImageFlashOn", "Image Flash On", CategoryImage, "Flash On", "Flash on", ImageFlashOn, []string{"lightning", "flash", "bolt"}, nil},
+	{"ImageFlip", "Image Flip", CategoryImage, "Flip", "Flip", ImageFlip, nil, nil},
+	{"ImageGradient", "Image Gradient", CategoryImage, "Gradient", "Gradient", ImageGradient, nil, nil},
+	{"ImageGrain", "Image Grain", CategoryImage, "Grain", "Grain", ImageGrain, nil, nil},
+	{"ImageGridOff", "Image Grid Off", CategoryImage, "Grid Off", "Grid off", ImageGridOff, nil, nil},
+	{"ImageGridOn", "Image Grid On", CategoryImage, "Grid On", "Grid on", ImageGridOn, nil, nil},
+	{"ImageHDROff", "Image HDR Off", CategoryImage, "HDR Off", "HDR off", ImageHDROff, nil, nil},
+	{"ImageHDROn", "Image HDR On", CategoryImage, "HDR On", "HDR on", ImageHDROn, nil, nil},
+	{"ImageHDRStrong", "Image HDR Strong", CategoryImage, "HDR Strong", "HDR strong", ImageHDRStrong, nil, nil},
+	{"ImageHDRWeak", "Image HDR Weak", CategoryImage, "HDR Weak", "HDR weak", ImageHDRWeak, nil, nil},
+	{"ImageHealing", "Image Healing", CategoryImage, "Healing", "Healing", ImageHealing, nil, nil},
+	{"ImageISO", "Image ISO", CategoryImage, "ISO", "ISO", ImageISO, nil, nil},
+	{"ImageImage", "Image Image", CategoryImage, "Image", "Image", ImageImage, []string{"picture", "photo", "gallery"}, []string{"EditorInsertPhoto", "ImagePhoto"}},
+	{"ImageImageAspectRatio", "Image Image Aspect Ratio", CategoryImage, "Image Aspect Ratio", "Aspect ratio, picture", ImageImageAspectRatio, []string{"picture", "proportions"}, nil},
+	{"ImageLandscape", "Image Landscape", CategoryImage, "Landscape", "Landscape", ImageLandscape, []string{"mountains", "nature", "picture"}, []string{"ImageFilterHDR", "MapsTerrain"}},
+	{"ImageLeakAdd", "Image Leak Add", CategoryImage, "Leak Add", "Leak add", ImageLeakAdd, nil, nil},
+	{"ImageLeakRemove", "Image Leak Remove", CategoryImage, "Leak Remove", "Leak remove", ImageLeakRemove, nil, nil},
+	{"ImageLens", "Image Lens", CategoryImage, "Lens", "Lens", ImageLens, nil, nil},
+	{"ImageLinkedCamera", "Image Linked Camera", CategoryImage, "Linked Camera", "Linked camera", ImageLinkedCamera, nil, nil},
+	{"ImageLooks", "Image Looks", CategoryImage, "Looks", "Looks", ImageLooks, nil, nil},
+	{"ImageLooks3", "Image Looks 3", CategoryImage, "Looks 3", "Looks 3", ImageLooks3, nil, nil},
+	{"ImageLooks4", "Image Looks 4", CategoryImage, "Looks 4", "Looks 4", ImageLooks4, nil, nil},
+	{"ImageLooks5", "Image Looks 5", CategoryImage, "Looks 5", "Looks 5", ImageLooks5, nil, nil},
+	{"ImageLooks6", "Image Looks 6", CategoryImage, "Looks 6", "Looks 6", ImageLooks6, nil, nil},
+	{"ImageLooksOne", "Image Looks One", CategoryImage, "Looks One", "Looks one", ImageLooksOne, nil, nil},
+	{"ImageLooksTwo", "Image Looks Two", CategoryImage, "Looks Two", "Looks two", ImageLooksTwo, nil, nil},
+	{"ImageLoupe", "Image Loupe", CategoryImage, "Loupe", "Loupe", ImageLoupe, nil, nil},
+	{"ImageMonochromePhotos", "Image Monochrome Photos", CategoryImage, "Monochrome Photos", "Monochrome photos", ImageMonochromePhotos, nil, nil},
+	{"ImageMovieCreation", "Image Movie Creation", CategoryImage, "Movie Creation", "Movie creation", ImageMovieCreation, nil, []string{"AVMovie"}},
+	{"ImageMovieFilter", "Image Movie Filter", CategoryImage, "Movie Filter", "Movie filter", ImageMovieFilter, nil, nil},
+	{"ImageMusicNote", "Image Music Note", CategoryImage, "Music Note", "Music note", ImageMusicNote, []string{"music", "song", "audio", "note"}, nil},
+	{"ImageNature", "Image Nature", CategoryImage, "Nature", "Nature", ImageNature, []string{"tree", "plant", "outdoors"}, nil},
+	{"ImageNaturePeople", "Image Nature People", CategoryImage, "Nature People", "Nature people", ImageNaturePeople, nil, nil},
+	{"ImageNavigateBefore", "Image Navigate Before", CategoryImage, "Navigate Before", "Navigate before", ImageNavigateBefore, nil, []string{"NavigationChevronLeft"}},
+	{"ImageNavigateNext", "Image Navigate Next", CategoryImage, "Navigate Next", "Navigate next", ImageNavigateNext, nil, []string{"NavigationChevronRight"}},
+	{"ImagePalette", "Image Palette", CategoryImage, "Palette", "Palette", ImagePalette, []string{"colors", "colours", "paint", "art"}, []string{"ImageColorLens"}},
+	{"ImagePanorama", "Image Panorama", CategoryImage, "Panorama", "Panorama", ImagePanorama, nil, nil},
+	{"ImagePanoramaFishEye", "Image Panorama Fish Eye", CategoryImage, "Panorama Fish Eye", "Panorama fish eye", ImagePanoramaFishEye, nil, nil},
+	{"ImagePanoramaHorizontal", "Image Panorama Horizontal", CategoryImage, "Panorama Horizontal", "Panorama horizontal", ImagePanoramaHorizontal, nil, nil},
+	{"ImagePanoramaVertical", "Image Panorama Vertical", CategoryImage, "Panorama Vertical", "Panorama vertical", ImagePanoramaVertical, nil, nil},
+	{"ImagePanoramaWideAngle", "Image Panorama Wide Angle", CategoryImage, "Panorama Wide Angle", "Panorama wide angle", ImagePanoramaWideAngle, nil, nil},
+	{"ImagePhoto", "Image Photo", CategoryImage, "Photo", "Photo", ImagePhoto, []string{"picture", "image", "gallery"}, []string{"EditorInsertPhoto", "ImageImage"}},
+	{"ImagePhotoAlbum", "Image Photo Album", CategoryImage, "Photo Album", "Photo album", ImagePhotoAlbum, nil, nil},
+	{"ImagePhotoCamera", "Image Photo Camera", CategoryImage, "Photo Camera", "Photo camera", ImagePhotoCamera, []string{"camera", "picture", "snapshot"}, []string{"ImageCameraAlt", "MapsLocalSee"}},
+	{"ImagePhotoFilter", "Image Photo Filter", CategoryImage, "Photo Filter", "Photo filter", ImagePhotoFilter, nil, nil},
+	{"ImagePhotoLibrary", "Image Photo Library", CategoryImage, "Photo Library", "Photo library", ImagePhotoLibrary, []string{"gallery", "pictures", "album"}, []string{"ImageCollections"}},
+	{"ImagePhotoSizeSelectActual", "Image Photo Size Select Actual", CategoryImage, "Photo Size Select Actual", "Photo size select actual", ImagePhotoSizeSelectActual, nil, nil},
+	{"ImagePhotoSizeSelectLarge", "Image Photo Size Select Large", CategoryImage, "Photo Size Select Large", "Photo size select large", ImagePhotoSizeSelectLarge, nil, nil},
+	{"ImagePhotoSizeSelectSmall", "Image Photo Size Select Small", CategoryImage, "Photo Size Select Small", "Photo size select small", ImagePhotoSizeSelectSmall, nil, nil},
+	{"ImagePictureAsPDF", "Image Picture As PDF", CategoryImage, "Picture As PDF", "Picture as PDF", ImagePictureAsPDF, []string{"pdf", "document", "file"}, nil},
+	{"ImagePortrait", "Image Portrait", CategoryImage, "Portrait", "Portrait", ImagePortrait, nil, nil},
+	{"ImageRemoveRedEye", "Image Remove Red Eye", CategoryImage, "Remove Red Eye", "Remove red eye", ImageRemoveRedEye, []string{"eye", "view", "visibility", "see"}, nil},
+	{"ImageRotate90DegreesCCW", "Image Rotate 90 Degrees CCW", CategoryImage, "Rotate 90 Degrees CCW", "Rotate 90 degrees counterclockwise", ImageRotate90DegreesCCW, nil, nil},
+	{"ImageRotateLeft", "Image Rotate Left", CategoryImage, "Rotate Left", "Rotate left", ImageRotateLeft, []string{"rotate", "turn", "counterclockwise"}, nil},
+	{"ImageRotateRight", "Image Rotate Right", CategoryImage, "Rotate Right", "Rotate right", ImageRotateRight, []string{"rotate", "turn", "clockwise"}, nil},
+	{"ImageSlideshow", "Image Slideshow", CategoryImage, "Slideshow", "Slideshow", ImageSlideshow, nil, nil},
+	{"ImageStraighten", "Image Straighten", CategoryImage, "Straighten", "Straighten", ImageStraighten, nil, nil},
+	{"ImageStyle", "Image Style", CategoryImage, "Style", "Style", ImageStyle, nil, nil},
+	{"ImageSwitchCamera", "Image Switch Camera", CategoryImage, "Switch Camera", "Switch camera", ImageSwitchCamera, nil, nil},
+	{"ImageSwitchVideo", "Image Switch Video", CategoryImage, "Switch Video", "Switch video", ImageSwitchVideo, nil, nil},
+	{"ImageTagFaces", "Image Tag Faces", CategoryImage, "Tag Faces", "Tag faces", ImageTagFaces, nil, []string{"EditorInsertEmoticon", "SocialMood"}},
+	{"ImageTexture", "Image Texture", CategoryImage, "Texture", "Texture", ImageTexture, nil, nil},
+	{"ImageTimeLapse", "Image Time Lapse", CategoryImage, "Time Lapse", "Time lapse", ImageTimeLapse, nil, nil},
+	{"ImageTimer", "Image Timer", CategoryImage, "Timer", "Timer", ImageTimer, []string{"stopwatch", "countdown", "clock"}, nil},
+	{"ImageTimer10", "Image Timer 10", CategoryImage, "Timer 10", "Timer 10", ImageTimer10, nil, nil},
+	{"ImageTimer3", "Image Timer 3", CategoryImage, "Timer 3", "Timer 3", ImageTimer3, nil, nil},
+	{"ImageTimerOff", "Image Timer Off", CategoryImage, "Timer Off", "Timer off", ImageTimerOff, nil, nil},
+	{"ImageTonality", "Image Tonality", CategoryImage, "Tonality", "Tonality", ImageTonality, nil, nil},
+	{"ImageTransform", "Image Transform", CategoryImage, "Transform", "Transform", ImageTransform, nil, nil},
+	{"ImageTune", "Image Tune", CategoryImage, "Tune", "Tune", ImageTune, []string{"adjust", "sliders", "settings", "controls"}, nil},
+	{"ImageViewComfy", "Image View Comfy", CategoryImage, "View Comfy", "View comfy", ImageViewComfy, nil, nil},
+	{"ImageViewCompact", "Image View Compact", CategoryImage, "View Compact", "View compact", ImageViewCompact, nil, nil},
+	{"ImageVignette", "Image Vignette", CategoryImage, "Vignette", "Vignette", ImageVignette, nil, nil},
+	{"ImageWBAuto", "Image WB Auto", CategoryImage, "WB Auto", "WB auto", ImageWBAuto, nil, nil},
+	{"ImageWBCloudy", "Image WB Cloudy", CategoryImage, "WB Cloudy", "WB cloudy", ImageWBCloudy, nil, []string{"FileCloud"}},
+	{"ImageWBIncandescent", "Image WB Incandescent", CategoryImage, "WB Incandescent", "WB incandescent", ImageWBIncandescent, nil, nil},
+	{"ImageWBIridescent", "Image WB Iridescent", CategoryImage, "WB Iridescent", "WB iridescent", ImageWBIridescent, nil, nil},
+	{"ImageWBSunny", "Image WB Sunny", CategoryImage, "WB Sunny", "WB sunny", ImageWBSunny, []string{"sun", "weather", "bright", "day"}, nil},
+	{"MapsAddLocation", "Maps Add Location", CategoryMaps, "Add Location", "Add location", MapsAddLocation, nil, nil},
+	{"MapsBeenhere", "Maps Beenhere", CategoryMaps, "Beenhere", "Beenhere", MapsBeenhere, nil, nil},
+	{"MapsDirections", "Maps Directions", CategoryMaps, "Directions", "Directions", MapsDirections, []string{"route", "navigation", "travel"}, nil},
+	{"MapsDirectionsBike", "Maps Directions Bike", CategoryMaps, "Directions Bike", "Directions bike", MapsDirectionsBike, []string{"bicycle", "cycle", "ride"}, nil},
+	{"MapsDirectionsBoat", "Maps Directions Boat", CategoryMaps, "Directions Boat", "Directions boat", MapsDirectionsBoat, nil, nil},
+	{"MapsDirectionsBus", "Maps Directions Bus", CategoryMaps, "Directions Bus", "Directions bus", MapsDirectionsBus, []string{"bus", "transit", "public transport"}, nil},
+	{"MapsDirectionsCar", "Maps Directions Car", CategoryMaps, "Directions Car", "Directions car", MapsDirectionsCar, []string{"car", "automobile", "drive", "vehicle"}, nil},
+	{"MapsDirectionsRailway", "Maps Directions Railway", CategoryMaps, "Directions Railway", "Directions railway", MapsDirectionsRailway, nil, nil},
+	{"MapsDirectionsRun", "Maps Directions Run", CategoryMaps, "Directions Run", "Directions run", MapsDirectionsRun, []string{"run", "jog", "exercise"}, nil},
+	{"MapsDirectionsSubway", "Maps Directions Subway", CategoryMaps, "Directions Subway", "Directions subway", MapsDirectionsSubway, nil, []string{"MapsDirectionsTransit"}},
+	{"MapsDirectionsTransit", "Maps Directions Transit", CategoryMaps, "Directions Transit", "Directions transit", MapsDirectionsTransit, nil, []string{"MapsDirectionsSubway"}},
+	{"MapsDirectionsWalk", "Maps Directions Walk", CategoryMaps, "Directions Walk", "Directions walk", MapsDirectionsWalk, []string{"walk", "pedestrian", "on foot"}, nil},
+	{"MapsEVStation", "Maps EV Station", CategoryMaps, "EV Station", "EV station", MapsEVStation, []string{"electric", "charging", "car"}, nil},
+	{"MapsEditLocation", "Maps Edit Location", CategoryMaps, "Edit Location", "Edit location", MapsEditLocation, nil, nil},
+	{"MapsFlight", "Maps Flight", CategoryMaps, "Flight", "Flight", MapsFlight, []string{"airplane", "plane", "travel", "trip"}, []string{"DeviceAirplaneModeActive", "MapsLocalAirport"}},
+	{"MapsHotel", "Maps Hotel", CategoryMaps, "Hotel", "Hotel", MapsHotel, []string{"bed", "sleep", "accommodation"}, []string{"MapsLocalHotel"}},
+	{"MapsLayers", "Maps Layers", CategoryMaps, "Layers", "Layers", MapsLayers, []string{"stack", "levels", "overlay"}, nil},
+	{"MapsLayersClear", "Maps Layers Clear", CategoryMaps, "Layers Clear", "Layers clear", MapsLayersClear, nil, nil},
+	{"MapsLocalATM", "Maps Local ATM", CategoryMaps, "Local ATM", "Local ATM", MapsLocalATM, nil, nil},
+	{"MapsLocalActivity", "Maps Local Activity", CategoryMaps, "Local Activity", "Local activity", MapsLocalActivity, nil, []string{"MapsLocalPlay"}},
+	{"MapsLocalAirport", "Maps Local Airport", CategoryMaps, "Local Airport", "Local airport", MapsLocalAirport, []string{"airplane", "plane", "travel", "airport"}, []string{"DeviceAirplaneModeActive", "MapsFlight"}},
+	{"MapsLocalBar", "Maps Local Bar", CategoryMaps, "Local Bar", "Local bar", MapsLocalBar, []string{"drink", "cocktail", "alcohol"}, nil},
+	{"MapsLocalCafe", "Maps Local Cafe", CategoryMaps, "Local Cafe", "Local cafe", MapsLocalCafe, []string{"coffee", "cup", "tea", "drink"}, nil},
+	{"MapsLocalCarWash", "Maps Local Car Wash", CategoryMaps, "Local Car Wash", "Local car wash", MapsLocalCarWash, nil, nil},
+	{"MapsLocalConvenienceStore", "Maps Local Convenience Store", CategoryMaps, "Local Convenience Store", "Local convenience store", MapsLocalConvenienceStore, nil, nil},
+	{"MapsLocalDining", "Maps Local Dining", CategoryMaps, "Local Dining", "Local dining", MapsLocalDining, []string{"food", "restaurant", "eat", "cutlery"}, []string{"MapsRestaurantMenu"}},
+	{"MapsLocalDrink", "Maps Local Drink", CategoryMaps, "Local Drink", "Local drink", MapsLocalDrink, nil, nil},
+	{"MapsLocalFlorist", "Maps Local Florist", CategoryMaps, "Local Florist", "Local florist", MapsLocalFlorist, nil, nil},
+	{"MapsLocalGasStation", "Maps Local Gas Station", CategoryMaps, "Local Gas Station", "Local gas station", MapsLocalGasStation, []string{"fuel", "petrol", "gas"}, nil},
+	{"MapsLocalGroceryStore", "Maps Local Grocery Store", CategoryMaps, "Local Grocery Store", "Local grocery store", MapsLocalGroceryStore, []string{"shopping", "cart", "market"}, []string{"ActionShoppingCart"}},
+	{"MapsLocalHospital", "Maps Local Hospital", CategoryMaps, "Local Hospital", "Local hospital", MapsLocalHospital, []string{"health", "medical", "doctor", "emergency"}, nil},
+	{"MapsLocalHotel", "Maps Local Hotel", CategoryMaps, "Local Hotel", "Local hotel", MapsLocalHotel, []string{"bed", "sleep", "accommodation"}, []string{"MapsHotel"}},
+	{"MapsLocalLaundryService", "Maps Local Laundry Service", CategoryMaps, "Local Laundry Service", "Local laundry service", MapsLocalLaundryService, nil, nil},
+	{"MapsLocalLibrary", "Maps Local Library", CategoryMaps, "Local Library", "Local library", MapsLocalLibrary, nil, nil},
+	{"MapsLocalMall", "Maps Local Mall", CategoryMaps, "Local Mall", "Local mall", MapsLocalMall, nil, nil},
+	{"MapsLocalMovies", "Maps Local Movies", CategoryMaps, "Local Movies", "Local movies", MapsLocalMovies, nil, []string{"ActionTheaters"}},
+	{"MapsLocalOffer", "Maps Local Offer", CategoryMaps, "Local Offer", "Local offer", MapsLocalOffer, nil, nil},
+	{"MapsLocalParking", "Maps Local Parking", CategoryMaps, "Local Parking", "Local parking", MapsLocalParking, nil, nil},
+	{"MapsLocalPharmacy", "Maps Local Pharmacy", CategoryMaps, "Local Pharmacy", "Local pharmacy", MapsLocalPharmacy, []string{"medicine", "drugs", "health"}, nil},
+	{"MapsLocalPhone", "Maps Local Phone", CategoryMaps, "Local Phone", "Local phone", MapsLocalPhone, nil, []string{"CommunicationCall", "CommunicationPhone"}},
+	{"MapsLocalPizza", "Maps Local Pizza", CategoryMaps, "Local Pizza", "Local pizza", MapsLocalPizza, []string{"food", "pizza", "slice"}, nil},
+	{"MapsLocalPlay", "Maps Local Play", CategoryMaps, "Local Play", "Local play", MapsLocalPlay, nil, []string{"MapsLocalActivity"}},
+	{"MapsLocalPostOffice", "Maps Local Post Office", CategoryMaps, "Local Post Office", "Local post office", MapsLocalPostOffice, nil, []string{"CommunicationEmail", "ContentMail", "ContentMarkUnread"}},
+	{"MapsLocalPrintshop", "Maps Local Printshop", CategoryMaps, "Local Printshop", "Local printshop", MapsLocalPrintshop, nil, []string{"ActionPrint"}},
+	{"MapsLocalSee", "Maps Local See", CategoryMaps, "Local See", "Local see", MapsLocalSee, nil, []string{"ImageCameraAlt", "ImagePhotoCamera"}},
+	{"MapsLocalShipping", "Maps Local Shipping", CategoryMaps, "Local Shipping", "Local shipping", MapsLocalShipping, []string{"delivery", "truck", "shipping"}, nil},
+	{"MapsLocalTaxi", "Maps Local Taxi", CategoryMaps, "Local Taxi", "Local taxi", MapsLocalTaxi, nil, nil},
+	{"MapsMap", "Maps Map", CategoryMaps, "Map", "Map", MapsMap, []string{"location", "navigation", "directions"}, nil},
+	{"MapsMyLocation", "Maps My Location", CategoryMaps, "My Location", "My location", MapsMyLocation, []string{"gps", "locate", "crosshair", "position"}, []string{"DeviceGPSFixed"}},
+	{"MapsNavigation", "Maps Navigation", CategoryMaps, "Navigation", "Navigation", MapsNavigation, []string{"direction", "arrow", "compass"}, nil},
+	{"MapsNearMe", "Maps Near Me", CategoryMaps, "Near Me", "Near me", MapsNearMe, nil, nil},
+	{"MapsPersonPin", "Maps Person Pin", CategoryMaps, "Person Pin", "Person pin", MapsPersonPin, nil, nil},
+	{"MapsPersonPinCircle", "Maps Person Pin Circle", CategoryMaps, "Person Pin Circle", "Person pin circle", MapsPersonPinCircle, nil, nil},
+	{"MapsPinDrop", "Maps Pin Drop", CategoryMaps, "Pin Drop", "Pin drop", MapsPinDrop, nil, nil},
+	{"MapsPlace", "Maps Place", CategoryMaps, "Place", "Place", MapsPlace, []string{"location", "pin", "marker", "map"}, []string{"ActionRoom", "CommunicationLocationOn"}},
+	{"MapsRateReview", "Maps Rate Review", CategoryMaps, "Rate Review", "Rate review", MapsRateReview, nil, nil},
+	{"MapsRestaurant", "Maps Restaurant", CategoryMaps, "Restaurant", "Restaurant", MapsRestaurant, []string{"food", "dining", "eat", "cutlery"}, nil},
+	{"MapsRestaurantMenu", "Maps Restaurant Menu", CategoryMaps, "Restaurant Menu", "Restaurant menu", MapsRestaurantMenu, []string{"food", "dining", "eat", "cutlery"}, []string{"MapsLocalDining"}},
+	{"MapsSatellite", "Maps Satellite", CategoryMaps, "Satellite", "Satellite", MapsSatellite, nil, nil},
+	{"MapsStoreMallDirectory", "Maps Store Mall Directory", CategoryMaps, "Store Mall Directory", "Store mall directory", MapsStoreMallDirectory, nil, []string{"ActionStore"}},
+	{"MapsStreetView", "Maps Street View", CategoryMaps, "Street View", "Street view", MapsStreetView, nil, nil},
+	{"MapsSubway", "Maps Subway", CategoryMaps, "Subway", "Subway", MapsSubway, []string{"metro", "underground", "tube"}, nil},
+	{"MapsTerrain", "Maps Terrain", CategoryMaps, "Terrain", "Terrain", MapsTerrain, nil, []string{"ImageFilterHDR", "ImageLandscape"}},
+	{"MapsTraffic", "Maps Traffic", CategoryMaps, "Traffic", "Traffic", MapsTraffic, nil, nil},
+	{"MapsTrain", "Maps Train", CategoryMaps, "Train", "Train", MapsTrain, []string{"rail", "railway", "transit"}, nil},
+	{"MapsTram", "Maps Tram", CategoryMaps, "Tram", "Tram", MapsTram, nil, nil},
+	{"MapsTransferWithinAStation", "Maps Transfer Within A Station", CategoryMaps, "Transfer Within A Station", "Transfer within a station", MapsTransferWithinAStation, nil, nil},
+	{"MapsZoomOutMap", "Maps Zoom Out Map", CategoryMaps, "Zoom Out Map", "Zoom out map", MapsZoomOutMap, nil, nil},
+	{"NavigationApps", "Navigation Apps", CategoryNavigation, "Apps", "Apps", NavigationApps, []string{"grid", "launcher", "dots", "applications"}, nil},
+	{"NavigationArrowBack", "Navigation Arrow Back", CategoryNavigation, "Arrow Back", "Arrow back", NavigationArrowBack, []string{"back", "previous", "left", "return"}, nil},
+	{"NavigationArrowDownward", "Navigation Arrow Downward", CategoryNavigation, "Arrow Downward", "Arrow downward", NavigationArrowDownward, []string{"down", "bottom", "descend"}, nil},
+	{"NavigationArrowDropDown", "Navigation Arrow Drop Down", CategoryNavigation, "Arrow Drop Down", "Arrow drop down", NavigationArrowDropDown, []string{"caret", "dropdown", "down", "select"}, nil},
+	{"NavigationArrowDropDownCircle", "Navigation Arrow Drop Down Circle", CategoryNavigation, "Arrow Drop Down Circle", "Arrow drop down circle", NavigationArrowDropDownCircle, nil, nil},
+	{"NavigationArrowDropUp", "Navigation Arrow Drop Up", CategoryNavigation, "Arrow Drop Up", "Arrow drop up", NavigationArrowDropUp, []string{"caret", "up"}, nil},
+	{"NavigationArrowForward", "Navigation Arrow Forward", CategoryNavigation, "Arrow Forward", "Arrow forward", NavigationArrowForward, []string{"forward", "next", "right"}, nil},
+	{"NavigationArrowUpward", "Navigation Arrow Upward", CategoryNavigation, "Arrow Upward", "Arrow upward", NavigationArrowUpward, []string{"up", "top", "ascend"}, nil},
+	{"NavigationCancel", "Navigation Cancel", CategoryNavigation, "Cancel", "Cancel", NavigationCancel, []string{"x", "cross", "close", "dismiss", "stop"}, nil},
+	{"NavigationCheck", "Navigation Check", CategoryNavigation, "Check", "Check", NavigationCheck, []string{"tick", "ok", "confirm", "done"}, []string{"ActionDone"}},
+	{"NavigationChevronLeft", "Navigation Chevron Left", CategoryNavigation, "Chevron Left", "Chevron left", NavigationChevronLeft, []string{"back", "previous", "left", "caret"}, []string{"ImageNavigateBefore"}},
+	{"NavigationChevronRight", "Navigation Chevron Right", CategoryNavigation, "Chevron Right", "Chevron right", NavigationChevronRight, []string{"forward", "next", "right", "caret"}, []string{"ImageNavigateNext"}},
+	{"NavigationClose", "Navigation Close", CategoryNavigation, "Close", "Close", NavigationClose, []string{"x", "cross", "cancel", "dismiss", "exit"}, []string{"ContentClear"}},
+	{"NavigationExpandLess", "Navigation Expand Less", CategoryNavigation, "Expand Less", "Expand less", NavigationExpandLess, []string{"chevron", "up", "close", "show less"}, nil},
+	{"NavigationExpandMore", "Navigation Expand More", CategoryNavigation, "Expand More", "Expand more", NavigationExpandMore, []string{"chevron", "down", "open", "show more"}, nil},
+	{"NavigationFirstPage", "Navigation First Page", CategoryNavigation, "First Page", "First page", NavigationFirstPage, []string{"start", "beginning", "first"}, nil},
+	{"NavigationFullscreen", "Navigation Fullscreen", CategoryNavigation, "Fullscreen", "Fullscreen", NavigationFullscreen, []string{"maximize", "expand", "enlarge"}, nil},
+	{"NavigationFullscreenExit", "Navigation Fullscreen Exit", CategoryNavigation, "Fullscreen Exit", "Fullscreen exit", NavigationFullscreenExit, []string{"minimize", "shrink", "exit fullscreen"}, nil},
+	{"NavigationLastPage", "Navigation Last Page", CategoryNavigation, "Last Page", "Last page", NavigationLastPage, []string{"end", "last"}, nil},
+	{"NavigationMenu", "Navigation Menu", CategoryNavigation, "Menu", "Menu", NavigationMenu, []string{"hamburger", "lines", "navigation drawer"}, nil},
+	{"NavigationMoreHoriz", "Navigation More Horiz", CategoryNavigation, "More Horiz", "More horiz", NavigationMoreHoriz, []string{"meatballs", "ellipsis", "dots", "overflow", "options"}, nil},
+	{"NavigationMoreVert", "Navigation More Vert", CategoryNavigation, "More Vert", "More vert", NavigationMoreVert, []string{"kebab", "dots", "overflow", "options"}, nil},
+	{"NavigationRefresh", "Navigation Refresh", CategoryNavigation, "Refresh", "Refresh", NavigationRefresh, []string{"reload", "retry", "sync", "update"}, nil},
+	{"NavigationSubdirectoryArrowLeft", "Navigation Subdirectory Arrow Left", CategoryNavigation, "Subdirectory Arrow Left", "Subdirectory arrow left", NavigationSubdirectoryArrowLeft, nil, nil},
+	{"NavigationSubdirectoryArrowRight", "Navigation Subdirectory Arrow Right", CategoryNavigation, "Subdirectory Arrow Right", "Subdirectory arrow right", NavigationSubdirectoryArrowRight, nil, nil},
+	{"NavigationUnfoldLess", "Navigation Unfold Less", CategoryNavigation, "Unfold Less", "Unfold less", NavigationUnfoldLess, nil, nil},
+	{"NavigationUnfoldMore", "Navigation Unfold More", CategoryNavigation, "Unfold More", "Unfold more", NavigationUnfoldMore, nil, nil},
+	{"NotificationADB", "Notification ADB", CategoryNotification, "ADB", "ADB", NotificationADB, nil, nil},
+	{"NotificationAirlineSeatFlat", "Notification Airline Seat Flat", CategoryNotification, "Airline Seat Flat", "Airline seat flat", NotificationAirlineSeatFlat, nil, nil},
+	{"NotificationAirlineSeatFlatAngled", "Notification Airline Seat Flat Angled", CategoryNotification, "Airline Seat Flat Angled", "Airline seat flat angled", NotificationAirlineSeatFlatAngled, nil, nil},
+	{"NotificationAirlineSeatIndividualSuite", "Notification Airline Seat Individual Suite", CategoryNotification, "Airline Seat Individual Suite", "Airline seat individual suite", NotificationAirlineSeatIndividualSuite, nil, nil},
+	{"NotificationAirlineSeatLegroomExtra", "Notification Airline Seat Legroom Extra", CategoryNotification, "Airline Seat Legroom Extra", "Airline seat legroom extra", NotificationAirlineSeatLegroomExtra, nil, nil},
+	{"NotificationAirlineSeatLegroomNormal", "Notification Airline Seat Legroom Normal", CategoryNotification, "Airline Seat Legroom Normal", "Airline seat legroom normal", NotificationAirlineSeatLegroomNormal, nil, nil},
+	{"NotificationAirlineSeatLegroomReduced", "Notification Airline Seat Legroom Reduced", CategoryNotification, "Airline Seat Legroom Reduced", "Airline seat legroom reduced", NotificationAirlineSeatLegroomReduced, nil, nil},
+	{"NotificationAirlineSeatReclineExtra", "Notification Airline Seat Recline Extra", CategoryNotification, "Airline Seat Recline Extra", "Airline seat recline extra", NotificationAirlineSeatReclineExtra, nil, nil},
+	{"NotificationAirlineSeatReclineNormal", "Notification Airline Seat Recline Normal", CategoryNotification, "Airline Seat Recline Normal", "Airline seat recline normal", NotificationAirlineSeatReclineNormal, nil, nil},
+	{"NotificationBluetoothAudio", "Notification Bluetooth Audio", CategoryNotification, "Bluetooth Audio", "Bluetooth audio", NotificationBluetoothAudio, nil, []string{"DeviceBluetoothSearching"}},
+	{"NotificationConfirmationNumber", "Notification Confirmation Number", CategoryNotification, "Confirmation Number", "Confirmation number", NotificationConfirmationNumber, nil, nil},
+	{"NotificationDiscFull", "Notification Disc Full", CategoryNotification, "Disc Full", "Disc full", NotificationDiscFull, nil, nil},
+	{"NotificationDoNotDisturb", "Notification Do Not Disturb", CategoryNotification, "Do Not Disturb", "Do not disturb", NotificationDoNotDisturb, []string{"block", "forbidden", "silent", "mute"}, []string{"AVNotInterested"}},
+	{"NotificationDoNotDisturbAlt", "Notification Do Not Disturb Alt", CategoryNotification, "Do Not Disturb Alt", "Do not disturb alt", NotificationDoNotDisturbAlt, nil, nil},
+	{"NotificationDoNotDisturbOff", "Notification Do Not Disturb Off", CategoryNotification, "Do Not Disturb Off", "Do not disturb off", NotificationDoNotDisturbOff, nil, nil},
+	{"NotificationDoNotDisturbOn", "Notification Do Not Disturb On", CategoryNotification, "Do Not Disturb On", "Do not disturb on", NotificationDoNotDisturbOn, nil, []string{"ContentRemoveCircle"}},
+	{"NotificationDriveETA", "Notification Drive ETA", CategoryNotification, "Drive ETA", "Drive ETA", NotificationDriveETA, nil, []string{"NotificationTimeToLeave"}},
+	{"NotificationEnhancedEncryption", "Notification Enhanced Encryption", CategoryNotification, "Enhanced Encryption", "Enhanced encryption", NotificationEnhancedEncryption, nil, nil},
+	{"NotificationEventAvailable", "Notification Event Available", CategoryNotification, "Event Available", "Event available", NotificationEventAvailable, []string{"calendar", "date", "confirmed"}, nil},
+	{"NotificationEventBusy", "Notification Event Busy", CategoryNotification, "Event Busy", "Event busy", NotificationEventBusy, nil, nil},
+	{"NotificationEventNote", "Notification Event Note", CategoryNotification, "Event Note", "Event note", NotificationEventNote, nil, nil},
+	{"NotificationFolderSpecial", "Notification Folder Special", CategoryNotification, "Folder Special", "Folder special", NotificationFolderSpecial, nil, nil},
+	{"NotificationLiveTV", "Notification Live TV", CategoryNotification, "Live TV", "Live TV", NotificationLiveTV, nil, nil},
+	{"NotificationMMS", "Notification MMS", CategoryNotification, "MMS", "MMS", NotificationMMS, nil, nil},
+	{"NotificationMore", "Notification More", CategoryNotification, "More", "More", NotificationMore, nil, nil},
+	{"NotificationNetworkCheck", "Notification Network Check", CategoryNotification, "Network Check", "Network check", NotificationNetworkCheck, nil, nil},
+	{"NotificationNetworkLocked", "Notification Network Locked", CategoryNotification, "Network Locked", "Network locked", NotificationNetworkLocked, nil, nil},
+	{"NotificationNoEncryption", "Notification No Encryption", CategoryNotification, "No Encryption", "No encryption", NotificationNoEncryption, nil, nil},
+	{"NotificationOnDemandVideo", "Notification On Demand Video", CategoryNotification, "On Demand Video", "On demand video", NotificationOnDemandVideo, nil, nil},
+	{"NotificationPersonalVideo", "Notification Personal Video", CategoryNotification, "Personal Video", "Personal video", NotificationPersonalVideo, nil, []string{"HardwareTV"}},
+	{"NotificationPhoneBluetoothSpeaker", "Notification Phone Bluetooth Speaker", CategoryNotification, "Phone Bluetooth Speaker", "Phone Bluetooth speaker", NotificationPhoneBluetoothSpeaker, nil, nil},
+	{"NotificationPhoneForwarded", "Notification Phone Forwarded", CategoryNotification, "Phone Forwarded", "Phone forwarded", NotificationPhoneForwarded, nil, nil},
+	{"NotificationPhoneInTalk", "Notification Phone In Talk", CategoryNotification, "Phone In Talk", "Phone in talk", NotificationPhoneInTalk, nil, nil},
+	{"NotificationPhoneLocked", "Notification Phone Locked", CategoryNotification, "Phone Locked", "Phone locked", NotificationPhoneLocked, nil, nil},
+	{"NotificationPhoneMissed", "Notification Phone Missed", CategoryNotification, "Phone Missed", "Phone missed", NotificationPhoneMissed, nil, nil},
+	{"NotificationPhonePaused", "Notification Phone Paused", CategoryNotification, "Phone Paused", "Phone paused", NotificationPhonePaused, nil, nil},
+	{"NotificationPower", "Notification Power", CategoryNotification, "Power", "Power", NotificationPower, nil, nil},
+	{"NotificationPriorityHigh", "Notification Priority High", CategoryNotification, "Priority High", "Priority high", NotificationPriorityHigh, []string{"exclamation", "important", "urgent"}, nil},
+	{"NotificationRVHookup", "Notification RV Hookup", CategoryNotification, "RV Hookup", "RV hookup", NotificationRVHookup, nil, []string{"PlacesRVHookup"}},
+	{"NotificationSDCard", "Notification SD Card", CategoryNotification, "SD Card", "SD card", NotificationSDCard, nil, []string{"DeviceSDStorage"}},
+	{"NotificationSIMCardAlert", "Notification SIM Card Alert", CategoryNotification, "SIM Card Alert", "SIM card alert", NotificationSIMCardAlert, nil, nil},
+	{"NotificationSMS", "Notification SMS", CategoryNotification, "SMS", "SMS", NotificationSMS, nil, []string{"CommunicationTextSMS"}},
+	{"NotificationSMSFailed", "Notification SMS Failed", CategoryNotification, "SMS Failed", "SMS failed", NotificationSMSFailed, nil, []string{"ActionFeedback"}},
+	{"NotificationSync", "Notification Sync", CategoryNotification, "Sync", "Sync", NotificationSync, []string{"refresh", "update", "reload"}, []string{"AVLoop"}},
+	{"NotificationSyncDisabled", "Notification Sync Disabled", CategoryNotification, "Sync Disabled", "Sync disabled", NotificationSyncDisabled, nil, nil},
+	{"NotificationSyncProblem", "Notification Sync Problem", CategoryNotification, "Sync Problem", "Sync problem", NotificationSyncProblem, nil, nil},
+	{"NotificationSystemUpdate", "Notification System Update", CategoryNotification, "System Update", "System update", NotificationSystemUpdate, nil, nil},
+	{"NotificationTapAndPlay", "Notification Tap And Play", CategoryNotification, "Tap And Play", "Tap and play", NotificationTapAndPlay, nil, nil},
+	{"NotificationTimeToLeave", "Notification Time To Leave", CategoryNotification, "Time To Leave", "Time to leave", NotificationTimeToLeave, nil, []string{"NotificationDriveETA"}},
+	{"NotificationVPNLock", "Notification VPN Lock", CategoryNotification, "VPN Lock", "VPN lock", NotificationVPNLock, nil, nil},
+	{"NotificationVibration", "Notification Vibration", CategoryNotification, "Vibration", "Vibration", NotificationVibration, []string{"phone", "buzz", "shake"}, nil},
+	{"NotificationVoiceChat", "Notification Voice Chat", CategoryNotification, "Voice Chat", "Voice chat", NotificationVoiceChat, nil, nil},
+	{"NotificationWC", "Notification WC", CategoryNotification, "WC", "WC", NotificationWC, nil, nil},
+	{"NotificationWiFi", "Notification Wi Fi", CategoryNotification, "Wi Fi", "Wi-Fi", NotificationWiFi, []string{"wifi", "wireless", "internet", "signal"}, nil},
+	{"PlacesACUnit", "Places AC Unit", CategoryPlaces, "AC Unit", "AC unit", PlacesACUnit, nil, nil},
+	{"PlacesAirportShuttle", "Places Airport Shuttle", CategoryPlaces, "Airport Shuttle", "Airport shuttle", PlacesAirportShuttle, nil, nil},
+	{"PlacesAllInclusive", "Places All Inclusive", CategoryPlaces, "All Inclusive", "All inclusive", PlacesAllInclusive, []string{"infinity", "unlimited", "forever"}, nil},
+	{"PlacesBeachAccess", "Places Beach Access", CategoryPlaces, "Beach Access", "Beach access", PlacesBeachAccess, []string{"umbrella", "beach", "vacation"}, nil},
+	{"PlacesBusinessCenter", "Places Business Center", CategoryPlaces, "Business Center", "Business center", PlacesBusinessCenter, []string{"briefcase", "work", "job", "office"}, nil},
+	{"PlacesCasino", "Places Casino", CategoryPlaces, "Casino", "Casino", PlacesCasino, nil, nil},
+	{"PlacesChildCare", "Places Child Care", CategoryPlaces, "Child Care", "Child care", PlacesChildCare, []string{"baby", "kids", "children"}, nil},
+	{"PlacesChildFriendly", "Places Child Friendly", CategoryPlaces, "Child Friendly", "Child friendly", PlacesChildFriendly, nil, nil},
+	{"PlacesFitnessCenter", "Places Fitness Center", CategoryPlaces, "Fitness Center", "Fitness center", PlacesFitnessCenter, []string{"gym", "exercise", "dumbbell", "workout"}, nil},
+	{"PlacesFreeBreakfast", "Places Free Breakfast", CategoryPlaces, "Free Breakfast", "Free breakfast", PlacesFreeBreakfast, []string{"coffee", "cup", "tea", "breakfast"}, nil},
+	{"PlacesGolfCourse", "Places Golf Course", CategoryPlaces, "Golf Course", "Golf course", PlacesGolfCourse, nil, nil},
+	{"PlacesHotTub", "Places Hot Tub", CategoryPlaces, "Hot Tub", "Hot tub", PlacesHotTub, nil, nil},
+	{"PlacesKitchen", "Places Kitchen", CategoryPlaces, "Kitchen", "Kitchen", PlacesKitchen, []string{"fridge", "refrigerator", "appliance"}, nil},
+	{"PlacesPool", "Places Pool", CategoryPlaces, "Pool", "Pool", PlacesPool, []string{"swimming", "swim", "water"}, nil},
+	{"PlacesRVHookup", "Places RV Hookup", CategoryPlaces, "RV Hookup", "RV hookup", PlacesRVHookup, nil, []string{"NotificationRVHookup"}},
+	{"PlacesRoomService", "Places Room Service", CategoryPlaces, "Room Service", "Room service", PlacesRoomService, nil, nil},
+	{"PlacesSmokeFree", "Places Smoke Free", CategoryPlaces, "Smoke Free", "Smoke free", PlacesSmokeFree, nil, nil},
+	{"PlacesSmokingRooms", "Places Smoking Rooms", CategoryPlaces, "Smoking Rooms", "Smoking rooms", PlacesSmokingRooms, nil, nil},
+	{"PlacesSpa", "Places Spa", CategoryPlaces, "Spa", "Spa", PlacesSpa, nil, nil},
+	{"SocialCake", "Social Cake", CategorySocial, "Cake", "Cake", SocialCake, []string{"birthday", "celebration", "party"}, nil},
+	{"SocialDomain", "Social Domain", CategorySocial, "Domain", "Domain", SocialDomain, nil, []string{"CommunicationBusiness"}},
+	{"SocialGroup", "Social Group", CategorySocial, "Group", "Group", SocialGroup, []string{"users", "people", "team", "friends"}, []string{"SocialPeople"}},
+	{"SocialGroupAdd", "Social Group Add", CategorySocial, "Group Add", "Group add", SocialGroupAdd, []string{"users", "people", "team", "invite"}, nil},
+	{"SocialLocationCity", "Social Location City", CategorySocial, "Location City", "Location city", SocialLocationCity, nil, nil},
+	{"SocialMood", "Social Mood", CategorySocial, "Mood", "Mood", SocialMood, []string{"smile", "happy", "emoji", "face"}, []string{"EditorInsertEmoticon", "ImageTagFaces"}},
+	{"SocialMoodBad", "Social Mood Bad", CategorySocial, "Mood Bad", "Mood bad", SocialMoodBad, []string{"sad", "unhappy", "emoji", "face"}, nil},
+	{"SocialNotifications", "Social Notifications", CategorySocial, "Notifications", "Notifications", SocialNotifications, []string{"bell", "alert", "alarm", "reminder"}, nil},
+	{"SocialNotificationsActive", "Social Notifications Active", CategorySocial, "Notifications Active", "Notifications active", SocialNotificationsActive, []string{"bell", "alert", "ringing", "alarm"}, nil},
+	{"SocialNotificationsNone", "Social Notifications None", CategorySocial, "Notifications None", "Notifications none", SocialNotificationsNone, []string{"bell", "alert"}, nil},
+	{"SocialNotificationsOff", "Social Notifications Off", CategorySocial, "Notifications Off", "Notifications off", SocialNotificationsOff, []string{"bell", "mute", "silent"}, nil},
+	{"SocialNotificationsPaused", "Social Notifications Paused", CategorySocial, "Notifications Paused", "Notifications paused", SocialNotificationsPaused, nil, nil},
+	{"SocialPages", "Social Pages", CategorySocial, "Pages", "Pages", SocialPages, nil, nil},
+	{"SocialPartyMode", "Social Party Mode", CategorySocial, "Party Mode", "Party mode", SocialPartyMode, nil, nil},
+	{"SocialPeople", "Social People", CategorySocial, "People", "People, filled", SocialPeople, []string{"filled", "users", "group", "team", "friends"}, []string{"SocialGroup"}},
+	{"SocialPeopleOutline", "Social People Outline", CategorySocial, "People Outline", "People, outline", SocialPeopleOutline, nil, nil},
+	{"SocialPerson", "Social Person", CategorySocial, "Person", "Person, filled", SocialPerson, []string{"filled", "user", "account", "profile", "avatar"}, nil},
+	{"SocialPersonAdd", "Social Person Add", CategorySocial, "Person Add", "Person add", SocialPersonAdd, []string{"user", "account", "invite", "add friend"}, nil},
+	{"SocialPersonOutline", "Social Person Outline", CategorySocial, "Person Outline", "Person, outline", SocialPersonOutline, []string{"user", "account", "profile", "avatar"}, []string{"ActionPermIdentity"}},
+	{"SocialPlusOne", "Social Plus One", CategorySocial, "Plus One", "Plus one", SocialPlusOne, nil, nil},
+	{"SocialPoll", "Social Poll", CategorySocial, "Poll", "Poll", SocialPoll, []string{"chart", "vote", "survey", "bar chart"}, []string{"ActionAssessment", "EditorInsertChart"}},
+	{"SocialPublic", "Social Public", CategorySocial, "Public", "Public", SocialPublic, []string{"globe", "world", "earth", "internet"}, nil},
+	{"SocialSchool", "Social School", CategorySocial, "School", "School", SocialSchool, []string{"education", "graduation", "learn", "university"}, nil},
+	{"SocialSentimentDissatisfied", "Social Sentiment Dissatisfied", CategorySocial, "Sentiment Dissatisfied", "Sentiment dissatisfied", SocialSentimentDissatisfied, []string{"sad", "unhappy", "emoji", "face"}, nil},
+	{"SocialSentimentNeutral", "Social Sentiment Neutral", CategorySocial, "Sentiment Neutral", "Sentiment neutral", SocialSentimentNeutral, nil, nil},
+	{"SocialSentimentSatisfied", "Social Sentiment Satisfied", CategorySocial, "Sentiment Satisfied", "Sentiment satisfied", SocialSentimentSatisfied, []string{"smile", "happy", "emoji", "face"}, nil},
+	{"SocialSentimentVeryDissatisfied", "Social Sentiment Very Dissatisfied", CategorySocial, "Sentiment Very Dissatisfied", "Sentiment very dissatisfied", SocialSentimentVeryDissatisfied, nil, nil},
+	{"SocialSentimentVerySatisfied", "Social Sentiment Very Satisfied", CategorySocial, "Sentiment Very Satisfied", "Sentiment very satisfied", SocialSentimentVerySatisfied, nil, nil},
+	{"SocialShare", "Social Share", CategorySocial, "Share", "Share", SocialShare, []string{"send", "network", "connect"}, nil},
+	{"SocialWhatsHot", "Social Whats Hot", CategorySocial, "Whats Hot", "Whats hot", SocialWhatsHot, []string{"fire", "flame", "trending", "popular", "hot"}, nil},
+	{"ToggleCheckBox", "Toggle Check Box", CategoryToggle, "Check Box", "Check box", ToggleCheckBox, []string{"checkbox", "tick", "checked", "selected"}, nil},
+	{"ToggleCheckBoxOutlineBlank", "Toggle Check Box Outline Blank", CategoryToggle, "Check Box Outline Blank", "Check box outline blank", ToggleCheckBoxOutlineBlank, []string{"checkbox", "unchecked", "empty"}, nil},
+	{"ToggleIndeterminateCheckBox", "Toggle Indeterminate Check Box", CategoryToggle, "Indeterminate Check Box", "Indeterminate check box", ToggleIndeterminateCheckBox, nil, nil},
+	{"ToggleRadioButtonChecked", "Toggle Radio Button Checked", CategoryToggle, "Radio Button Checked", "Radio button checked", ToggleRadioButtonChecked, []string{"radio", "selected", "option"}, nil},
+	{"ToggleRadioButtonUnchecked", "Toggle Radio Button Unchecked", CategoryToggle, "Radio Button Unchecked", "Radio button unchecked", ToggleRadioButtonUnchecked, []string{"radio", "unselected", "option"}, nil},
+	{"ToggleStar", "Toggle Star", CategoryToggle, "Star", "Star, filled", ToggleStar, []string{"filled", "favorite", "rating", "bookmark", "rate"}, nil},
+	{"ToggleStarBorder", "Toggle Star Border", CategoryToggle, "Star Border", "Star, outline", ToggleStarBorder, []string{"favorite", "rating", "bookmark", "rate"}, nil},
+	{"ToggleStarHalf", "Toggle Star Half", CategoryToggle, "Star Half", "Star half", ToggleStarHalf, nil, nil},
+}
@@ -0,0 +1,89 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package device contains the icons in the Device category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package device
+
+import "gio.tools/icons"
+
+var (
+	AccessAlarm                           = icons.DeviceAccessAlarm
+	AccessAlarms                          = icons.DeviceAccessAlarms
+	AccessTime                            = icons.DeviceAccessTime
+	AddAlarm                              = icons.DeviceAddAlarm
+	AirplaneModeActive                    = icons.DeviceAirplaneModeActive
+	AirplaneModeInactive                  = icons.DeviceAirplaneModeInactive
+	Battery20                             = icons.DeviceBattery20
+	Battery30                             = icons.DeviceBattery30
+	Battery50                             = icons.DeviceBattery50
+	Battery60                             = icons.DeviceBattery60
+	Battery80                             = icons.DeviceBattery80
+	Battery90                             = icons.DeviceBattery90
+	BatteryAlert                          = icons.DeviceBatteryAlert
+	BatteryCharging20                     = icons.DeviceBatteryCharging20
+	BatteryCharging30                     = icons.DeviceBatteryCharging30
+	BatteryCharging50                     = icons.DeviceBatteryCharging50
+	BatteryCharging60                     = icons.DeviceBatteryCharging60
+	BatteryCharging80                     = icons.DeviceBatteryCharging80
+	BatteryCharging90                     = icons.DeviceBatteryCharging90
+	BatteryChargingFull                   = icons.DeviceBatteryChargingFull
+	BatteryFull                           = icons.DeviceBatteryFull
+	BatteryStd                            = icons.DeviceBatteryStd
+	BatteryUnknown                        = icons.DeviceBatteryUnknown
+	Bluetooth                             = icons.DeviceBluetooth
+	BluetoothConnected                    = icons.DeviceBluetoothConnected
+	BluetoothDisabled                     = icons.DeviceBluetoothDisabled
+	BluetoothSearching                    = icons.DeviceBluetoothSearching
+	BrightnessAuto                        = icons.DeviceBrightnessAuto
+	BrightnessHigh                        = icons.DeviceBrightnessHigh
+	BrightnessLow                         = icons.DeviceBrightnessLow
+	BrightnessMedium                      = icons.DeviceBrightnessMedium
+	DVR                                   = icons.DeviceDVR
+	DataUsage                             = icons.DeviceDataUsage
+	DeveloperMode                         = icons.DeviceDeveloperMode
+	Devices                               = icons.DeviceDevices
+	GPSFixed                              = icons.DeviceGPSFixed
+	GPSNotFixed                           = icons.DeviceGPSNotFixed
+	GPSOff                                = icons.DeviceGPSOff
+	GraphicEq                             = icons.DeviceGraphicEq
+	LocationDisabled                      = icons.DeviceLocationDisabled
+	LocationSearching                     = icons.DeviceLocationSearching
+	NFC                                   = icons.DeviceNFC
+	NetworkCell                           = icons.DeviceNetworkCell
+	NetworkWiFi                           = icons.DeviceNetworkWiFi
+	SDStorage                             = icons.DeviceSDStorage
+	ScreenLockLandscape                   = icons.DeviceScreenLockLandscape
+	ScreenLockPortrait                    = icons.DeviceScreenLockPortrait
+	ScreenLockRotation                    = icons.DeviceScreenLockRotation
+	ScreenRotation                        = icons.DeviceScreenRotation
+	SettingsSystemDaydream                = icons.DeviceSettingsSystemDaydream
+	SignalCellular0Bar                    = icons.DeviceSignalCellular0Bar
+	SignalCellular1Bar                    = icons.DeviceSignalCellular1Bar
+	SignalCellular2Bar                    = icons.DeviceSignalCellular2Bar
+	SignalCellular3Bar                    = icons.DeviceSignalCellular3Bar
+	SignalCellular4Bar                    = icons.DeviceSignalCellular4Bar
+	SignalCellularConnectedNoInternet0Bar = icons.DeviceSignalCellularConnectedNoInternet0Bar
+	SignalCellularConnectedNoInternet1Bar = icons.DeviceSignalCellularConnectedNoInternet1Bar
+	SignalCellularConnectedNoInternet2Bar = icons.DeviceSignalCellularConnectedNoInternet2Bar
+	SignalCellularConnectedNoInternet3Bar = icons.DeviceSignalCellularConnectedNoInternet3Bar
+	SignalCellularConnectedNoInternet4Bar = icons.DeviceSignalCellularConnectedNoInternet4Bar
+	SignalCellularNoSIM                   = icons.DeviceSignalCellularNoSIM
+	SignalCellularNull                    = icons.DeviceSignalCellularNull
+	SignalCellularOff                     = icons.DeviceSignalCellularOff
+	SignalWiFi0Bar                        = icons.DeviceSignalWiFi0Bar
+	SignalWiFi1Bar                        = icons.DeviceSignalWiFi1Bar
+	SignalWiFi1BarLock                    = icons.DeviceSignalWiFi1BarLock
+	SignalWiFi2Bar                        = icons.DeviceSignalWiFi2Bar
+	SignalWiFi2BarLock                    = icons.DeviceSignalWiFi2BarLock
+	SignalWiFi3Bar                        = icons.DeviceSignalWiFi3Bar
+	SignalWiFi3BarLock                    = icons.DeviceSignalWiFi3BarLock
+	SignalWiFi4Bar                        = icons.DeviceSignalWiFi4Bar
+	SignalWiFi4BarLock                    = icons.DeviceSignalWiFi4BarLock
+	SignalWiFiOff                         = icons.DeviceSignalWiFiOff
+	Storage                               = icons.DeviceStorage
+	USB                                   = icons.DeviceUSB
+	Wallpaper                             = icons.DeviceWallpaper
+	WiFiLock                              = icons.DeviceWiFiLock
+	WiFiTethering                         = icons.DeviceWiFiTethering
+	Widgets                               = icons.DeviceWidgets
+)
@@ -0,0 +1,78 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package editor contains the icons in the Editor category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package editor
+
+import "gio.tools/icons"
+
+var (
+	AttachFile              = icons.EditorAttachFile
+	AttachMoney             = icons.EditorAttachMoney
+	BorderAll               = icons.EditorBorderAll
+	BorderBottom            = icons.EditorBorderBottom
+	BorderClear             = icons.EditorBorderClear
+	BorderColor             = icons.EditorBorderColor
+	BorderHorizontal        = icons.EditorBorderHorizontal
+	BorderInner             = icons.EditorBorderInner
+	BorderLeft              = icons.EditorBorderLeft
+	BorderOuter             = icons.EditorBorderOuter
+	BorderRight             = icons.EditorBorderRight
+	BorderStyle             = icons.EditorBorderStyle
+	BorderTop               = icons.EditorBorderTop
+	BorderVertical          = icons.EditorBorderVertical
+	BubbleChart             = icons.EditorBubbleChart
+	DragHandle              = icons.EditorDragHandle
+	FormatAlignCenter       = icons.EditorFormatAlignCenter
+	FormatAlignJustify      = icons.EditorFormatAlignJustify
+	FormatAlignLeft         = icons.EditorFormatAlignLeft
+	FormatAlignRight        = icons.EditorFormatAlignRight
+	FormatBold              = icons.EditorFormatBold
+	FormatClear             = icons.EditorFormatClear
+	FormatColorFill         = icons.EditorFormatColorFill
+	FormatColorReset        = icons.EditorFormatColorReset
+	FormatColorText         = icons.EditorFormatColorText
+	FormatIndentDecrease    = icons.EditorFormatIndentDecrease
+	FormatIndentIncrease    = icons.EditorFormatIndentIncrease
+	FormatItalic            = icons.EditorFormatItalic
+	FormatLineSpacing       = icons.EditorFormatLineSpacing
+	FormatListBulleted      = icons.EditorFormatListBulleted
+	FormatListNumbered      = icons.EditorFormatListNumbered
+	FormatPaint             = icons.EditorFormatPaint
+	FormatQuote             = icons.EditorFormatQuote
+	FormatShapes            = icons.EditorFormatShapes
+	FormatSize              = icons.EditorFormatSize
+	FormatStrikethrough     = icons.EditorFormatStrikethrough
+	FormatTextDirectionLToR = icons.EditorFormatTextDirectionLToR
+	FormatTextDirectionRToL = icons.EditorFormatTextDirectionRToL
+	FormatUnderlined        = icons.EditorFormatUnderlined
+	Functions               = icons.EditorFunctions
+	Highlight               = icons.EditorHighlight
+	InsertChart             = icons.EditorInsertChart
+	InsertComment           = icons.EditorInsertComment
+	InsertDriveFile         = icons.EditorInsertDriveFile
+	InsertEmoticon          = icons.EditorInsertEmoticon
+	InsertInvitation        = icons.EditorInsertInvitation
+	InsertLink              = icons.EditorInsertLink
+	InsertPhoto             = icons.EditorInsertPhoto
+	LinearScale             = icons.EditorLinearScale
+	MergeType               = icons.EditorMergeType
+	ModeComment             = icons.EditorModeComment
+	ModeEdit                = icons.EditorModeEdit
+	MonetizationOn          = icons.EditorMonetizationOn
+	MoneyOff                = icons.EditorMoneyOff
+	MultilineChart          = icons.EditorMultilineChart
+	PieChart                = icons.EditorPieChart
+	PieChartOutlined        = icons.EditorPieChartOutlined
+	Publish                 = icons.EditorPublish
+	ShortText               = icons.EditorShortText
+	ShowChart               = icons.EditorShowChart
+	SpaceBar                = icons.EditorSpaceBar
+	StrikethroughS          = icons.EditorStrikethroughS
+	TextFields              = icons.EditorTextFields
+	Title                   = icons.EditorTitle
+	VerticalAlignBottom     = icons.EditorVerticalAlignBottom
+	VerticalAlignCenter     = icons.EditorVerticalAlignCenter
+	VerticalAlignTop        = icons.EditorVerticalAlignTop
+	WrapText                = icons.EditorWrapText
+)
@@ -0,0 +1,24 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package file contains the icons in the File category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package file
+
+import "gio.tools/icons"
+
+var (
+	Attachment      = icons.FileAttachment
+	Cloud           = icons.FileCloud
+	CloudCircle     = icons.FileCloudCircle
+	CloudDone       = icons.FileCloudDone
+	CloudDownload   = icons.FileCloudDownload
+	CloudOff        = icons.FileCloudOff
+	CloudQueue      = icons.FileCloudQueue
+	CloudUpload     = icons.FileCloudUpload
+	CreateNewFolder = icons.FileCreateNewFolder
+	FileDownload    = icons.FileFileDownload
+	FileUpload      = icons.FileFileUpload
+	Folder          = icons.FileFolder
+	FolderOpen      = icons.FileFolderOpen
+	FolderShared    = icons.FileFolderShared
+)
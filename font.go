@@ -0,0 +1,154 @@
+package icons
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strconv"
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"golang.org/x/image/math/fixed"
+)
+
+// Renderer draws icons. The icons' own Layout rasterizes their IconVG data, while a
+// Cache keeps the rasterized images and a FontRenderer draws glyphs from an icon font,
+// so an app can pick whichever is fastest on the device at runtime.
+type Renderer interface {
+	// Layout displays the icon with its size set to the X minimum constraint, in the
+	// same way as `Icon.Layout`.
+	Layout(gtx layout.Context, ic *Icon, col color.NRGBA) layout.Dimensions
+}
+
+// Vector is the Renderer that rasterizes icons with `Icon.Layout`.
+var Vector Renderer = vectorRenderer{}
+
+type vectorRenderer struct{}
+
+func (vectorRenderer) Layout(gtx layout.Context, ic *Icon, col color.NRGBA) layout.Dimensions {
+	return ic.Layout(gtx, col)
+}
+
+// FontRenderer draws icons as glyphs of the Material Icons font, which Gio's text shaper
+// caches far more cheaply than IconVG is rasterized. Package iconfont embeds the font, and
+// its NewRenderer returns a FontRenderer that uses it.
+//
+// Icons are looked up by their ligature, the snake case upstream name such as
+// "find_in_page", or by codepoint if Codepoints is set. Icons that the font doesn't have
+// are rasterized from IconVG instead.
+//
+// Like `text.Shaper`, a FontRenderer isn't safe for concurrent use.
+type FontRenderer struct {
+	Shaper *text.Shaper
+	Font   font.Font
+	// Codepoints, if set, maps ligatures to the codepoints of their glyphs, as read by
+	// ParseCodepoints. Shaping a single codepoint is cheaper than a ligature.
+	Codepoints map[string]rune
+}
+
+// Layout implements Renderer.
+func (r *FontRenderer) Layout(gtx layout.Context, ic *Icon, col color.NRGBA) layout.Dimensions {
+	size := iconSize(gtx)
+	g, ok := r.glyph(gtx, ic, size.Y)
+	if !ok {
+		return ic.Layout(gtx, col)
+	}
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	if ic.rtlMirrored(gtx) {
+		defer mirrorOp(size).Push(gtx.Ops).Pop()
+	}
+	// The Material Icons glyphs fill the em square, so the glyph is centered horizontally
+	// and its descent sits at the bottom. The shaped path starts at the glyph's origin on
+	// the baseline.
+	off := image.Pt((size.X-g.Advance.Ceil())/2, size.Y-g.Descent.Ceil())
+	defer op.Offset(off).Push(gtx.Ops).Pop()
+	glyphs := []text.Glyph{g}
+	outline := clip.Outline{Path: r.Shaper.Shape(glyphs)}.Op().Push(gtx.Ops)
+	paint.ColorOp{Color: col}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	outline.Pop()
+	if call := r.Shaper.Bitmaps(glyphs); call != (op.CallOp{}) {
+		call.Add(gtx.Ops)
+	}
+	return layout.Dimensions{Size: size}
+}
+
+// Has reports whether the font has a glyph for the icon.
+func (r *FontRenderer) Has(gtx layout.Context, ic *Icon) bool {
+	_, ok := r.glyph(gtx, ic, iconSize(gtx).Y)
+	return ok
+}
+
+// glyph shapes the icon's ligature or codepoint at the given size, reporting whether the
+// font has a single glyph for it.
+func (r *FontRenderer) glyph(gtx layout.Context, ic *Icon, size int) (text.Glyph, bool) {
+	lig, ok := Ligature(ic)
+	if !ok {
+		return text.Glyph{}, false
+	}
+	s := lig
+	if c, ok := r.Codepoints[lig]; ok {
+		s = string(c)
+	}
+	r.Shaper.LayoutString(text.Parameters{
+		Font:     r.Font,
+		PxPerEm:  fixed.I(size),
+		MaxLines: 1,
+		MaxWidth: 1 << 24,
+		Locale:   gtx.Locale,
+	}, s)
+	var (
+		g text.Glyph
+		n int
+	)
+	for {
+		next, ok := r.Shaper.NextGlyph()
+		if !ok {
+			break
+		}
+		g = next
+		n++
+	}
+	// A missing ligature is shaped as one glyph per letter, and a missing codepoint as
+	// the font's .notdef glyph.
+	return g, n == 1 && g.ID != 0
+}
+
+// Ligature returns the name of the icon in the Material Icons font, such as
+// "find_in_page" for ActionFindInPage, reporting whether the font has the icon. Only the
+// filled icons of this package are in the font.
+func Ligature(ic *Icon) (string, bool) {
+	return ic.lig, ic.lig != ""
+}
+
+// ParseCodepoints reads the codepoints file that comes with the Material Icons font, in
+// which each line is a ligature and its hexadecimal codepoint, such as "search e8b6".
+func ParseCodepoints(r io.Reader) (map[string]rune, error) {
+	cps := make(map[string]rune)
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("icons: codepoints line %d: want a name and a codepoint", line)
+		}
+		c, err := strconv.ParseUint(fields[1], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("icons: codepoints line %d: %w", line, err)
+		}
+		cps[fields[0]] = rune(c)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("icons: reading codepoints: %w", err)
+	}
+	return cps, nil
+}
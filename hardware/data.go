@@ -0,0 +1,58 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package hardware contains the icons in the Hardware category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package hardware
+
+import "gio.tools/icons"
+
+var (
+	Cast               = icons.HardwareCast
+	CastConnected      = icons.HardwareCastConnected
+	Computer           = icons.HardwareComputer
+	DesktopMac         = icons.HardwareDesktopMac
+	DesktopWindows     = icons.HardwareDesktopWindows
+	DeveloperBoard     = icons.HardwareDeveloperBoard
+	DeviceHub          = icons.HardwareDeviceHub
+	DevicesOther       = icons.HardwareDevicesOther
+	Dock               = icons.HardwareDock
+	Gamepad            = icons.HardwareGamepad
+	Headset            = icons.HardwareHeadset
+	HeadsetMic         = icons.HardwareHeadsetMic
+	Keyboard           = icons.HardwareKeyboard
+	KeyboardArrowDown  = icons.HardwareKeyboardArrowDown
+	KeyboardArrowLeft  = icons.HardwareKeyboardArrowLeft
+	KeyboardArrowRight = icons.HardwareKeyboardArrowRight
+	KeyboardArrowUp    = icons.HardwareKeyboardArrowUp
+	KeyboardBackspace  = icons.HardwareKeyboardBackspace
+	KeyboardCapslock   = icons.HardwareKeyboardCapslock
+	KeyboardHide       = icons.HardwareKeyboardHide
+	KeyboardReturn     = icons.HardwareKeyboardReturn
+	KeyboardTab        = icons.HardwareKeyboardTab
+	KeyboardVoice      = icons.HardwareKeyboardVoice
+	Laptop             = icons.HardwareLaptop
+	LaptopChromebook   = icons.HardwareLaptopChromebook
+	LaptopMac          = icons.HardwareLaptopMac
+	LaptopWindows      = icons.HardwareLaptopWindows
+	Memory             = icons.HardwareMemory
+	Mouse              = icons.HardwareMouse
+	PhoneAndroid       = icons.HardwarePhoneAndroid
+	PhoneIPhone        = icons.HardwarePhoneIPhone
+	PhoneLink          = icons.HardwarePhoneLink
+	PhoneLinkOff       = icons.HardwarePhoneLinkOff
+	PowerInput         = icons.HardwarePowerInput
+	Router             = icons.HardwareRouter
+	SIMCard            = icons.HardwareSIMCard
+	Scanner            = icons.HardwareScanner
+	Security           = icons.HardwareSecurity
+	Smartphone         = icons.HardwareSmartphone
+	Speaker            = icons.HardwareSpeaker
+	SpeakerGroup       = icons.HardwareSpeakerGroup
+	TV                 = icons.HardwareTV
+	Tablet             = icons.HardwareTablet
+	TabletAndroid      = icons.HardwareTabletAndroid
+	TabletMac          = icons.HardwareTabletMac
+	Toys               = icons.HardwareToys
+	VideogameAsset     = icons.HardwareVideogameAsset
+	Watch              = icons.HardwareWatch
+)
@@ -0,0 +1,69 @@
+// Package iconfont embeds the Material Icons font, version 3.0.1, and its codepoints,
+// for drawing icons with `icons.FontRenderer`:
+//
+//	r := iconfont.NewRenderer()
+//	...
+//	r.Layout(gtx, icons.ActionSearch, color)
+//
+// The font is by Google and licensed under the Apache License 2.0.
+package iconfont
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"sync"
+
+	"gio.tools/icons"
+	"gioui.org/font"
+	"gioui.org/font/opentype"
+	"gioui.org/text"
+)
+
+var (
+	//go:embed MaterialIcons-Regular.ttf
+	ttf []byte
+	//go:embed codepoints
+	codepointsSrc []byte
+)
+
+var (
+	once       sync.Once
+	collection []font.FontFace
+	codepoints map[string]rune
+)
+
+func load() {
+	faces, err := opentype.ParseCollection(ttf)
+	if err != nil {
+		panic(fmt.Errorf("iconfont: parsing font: %w", err))
+	}
+	cps, err := icons.ParseCodepoints(bytes.NewReader(codepointsSrc))
+	if err != nil {
+		panic(fmt.Errorf("iconfont: %w", err))
+	}
+	collection, codepoints = faces, cps
+}
+
+// Collection returns the font, for making a `text.Shaper`.
+func Collection() []font.FontFace {
+	once.Do(load)
+	return collection
+}
+
+// Codepoints returns the codepoint of each of the font's ligatures, such as 0xe8b6 for
+// "search". The map is shared and must not be modified.
+func Codepoints() map[string]rune {
+	once.Do(load)
+	return codepoints
+}
+
+// NewRenderer returns a FontRenderer with a shaper of its own that only has this font.
+func NewRenderer() *icons.FontRenderer {
+	faces := Collection()
+	return &icons.FontRenderer{
+		Shaper:     text.NewShaper(text.NoSystemFonts(), text.WithCollection(faces)),
+		Font:       faces[0].Font,
+		Codepoints: Codepoints(),
+	}
+}
@@ -0,0 +1,137 @@
+package iconfont_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"gio.tools/icons"
+	"gio.tools/icons/iconfont"
+	"gio.tools/icons/internal/ivgpath"
+	"gioui.org/gpu/headless"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// The size that icons are drawn at to compare them.
+const testSize = 48
+
+// The largest mean difference in coverage between a glyph and its vector icon. Most
+// differ by under 2%, from antialiasing and rounding.
+const tolerance = 0.05
+
+// Icons whose glyphs in the font were drawn differently from their vector icons.
+var redrawn = map[string]bool{
+	"ActionHourglassEmpty":      true,
+	"AlertAddAlert":             true,
+	"CommunicationSpeakerPhone": true,
+	"FileAttachment":            true,
+	"HardwareScanner":           true,
+	"MapsDirectionsRun":         true,
+	"MapsDirectionsWalk":        true,
+}
+
+// TestFontRenderer draws every icon that the font has both as a glyph and from its IconVG
+// data, and checks that they cover the same pixels.
+func TestFontRenderer(t *testing.T) {
+	w, err := headless.NewWindow(testSize, testSize)
+	if err != nil {
+		t.Skipf("no headless GPU: %v", err)
+	}
+	defer w.Release()
+
+	r := iconfont.NewRenderer()
+	seen := make(map[*icons.Icon]bool)
+	n := 0
+	for _, e := range icons.Material.Entries() {
+		lig, ok := icons.Ligature(e.Icon)
+		if !ok || seen[e.Icon] {
+			continue
+		}
+		seen[e.Icon] = true
+		n++
+		if _, ok := iconfont.Codepoints()[lig]; !ok {
+			t.Errorf("%s: ligature %q isn't in the codepoints", e.Name, lig)
+			continue
+		}
+		var has bool
+		glyph := draw(t, w, func(gtx layout.Context) {
+			if has = r.Has(gtx, e.Icon); has {
+				r.Layout(gtx, e.Icon, color.NRGBA{A: 0xff})
+			}
+		})
+		if !has {
+			t.Errorf("%s: the font has no glyph for %q", e.Name, lig)
+			continue
+		}
+		vector := draw(t, w, func(gtx layout.Context) {
+			icons.Vector.Layout(gtx, e.Icon, color.NRGBA{A: 0xff})
+		})
+		if redrawn[e.Name] || translucent(t, e.Icon) {
+			continue
+		}
+		if diff := coverageDiff(glyph, vector); diff > tolerance {
+			t.Errorf("%s: the glyph's coverage differs from the vector icon's by %.1f%%", e.Name, diff*100)
+		}
+	}
+	if n == 0 {
+		t.Fatal("no icons have ligatures")
+	}
+}
+
+// translucent reports whether part of the icon is translucent, such as the empty bars of
+// DeviceNetworkCell, which the font's glyphs draw opaque or leave out.
+func translucent(t *testing.T, ic *icons.Icon) bool {
+	t.Helper()
+	d, err := ivgpath.Decode(ic.Data(), &iconvg.DefaultPalette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range d.Shapes {
+		if s.Color.A < 0xff {
+			return true
+		}
+	}
+	return false
+}
+
+// draw returns the coverage of what lay draws, in black on white, at the test size.
+func draw(t *testing.T, w *headless.Window, lay func(gtx layout.Context)) []uint8 {
+	t.Helper()
+	ops := new(op.Ops)
+	gtx := layout.Context{
+		Ops:         ops,
+		Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		Constraints: layout.Exact(image.Pt(testSize, testSize)),
+	}
+	paint.Fill(ops, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff})
+	lay(gtx)
+	if err := w.Frame(ops); err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, testSize, testSize))
+	if err := w.Screenshot(img); err != nil {
+		t.Fatal(err)
+	}
+	cov := make([]uint8, testSize*testSize)
+	for i := range cov {
+		cov[i] = 0xff - img.Pix[i*4]
+	}
+	return cov
+}
+
+// coverageDiff returns the mean difference in coverage between two images, from 0 to 1.
+func coverageDiff(a, b []uint8) float64 {
+	var sum int
+	for i := range a {
+		d := int(a[i]) - int(b[i])
+		if d < 0 {
+			d = -d
+		}
+		sum += d
+	}
+	return float64(sum) / float64(len(a)*0xff)
+}
@@ -1,16 +1,215 @@
+//go:generate go run ./cmd/gen
+
 package icons
 
-import "gioui.org/widget"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"sync"
+
+	"gioui.org/f32"
+	"gioui.org/io/semantic"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// The size used when an icon is laid out with a zero X minimum constraint, which is the
+// same as `widget.Icon`.
+const defaultIconSize = unit.Dp(24)
+
+// Icon is an IconVG icon that is only parsed into a `*widget.Icon` the first time it's
+// used, so that importing this package doesn't decode every icon up front.
+//
+// The generated icons are declared as composite literals pointing at the upstream data,
+// rather than with a constructor, so that they are initialized statically and the linker
+// leaves out every icon that a program doesn't use.
+type Icon struct {
+	src    *[]byte
+	mirror bool
+	lig    string // The icon's ligature in the Material Icons font, if it has one.
+	once   sync.Once
+	ic     *widget.Icon
+}
+
+// AutoMirror reports whether the icon is directional (such as an arrow or a list of text)
+// and is therefore mirrored horizontally when laid out with a right-to-left
+// `gtx.Locale.Direction`.
+func (ic *Icon) AutoMirror() bool {
+	return ic.mirror
+}
 
-// The function `MustIcon` is primarily used to parse each icon's source. Shortening the
-// name to `mi` reduces the generated file size by about 6kb.
-var mi = MustIcon
+// Data returns the icon's IconVG source.
+func (ic *Icon) Data() []byte {
+	return *ic.src
+}
+
+// Widget returns the decoded `*widget.Icon`, decoding it on the first call. It panics if
+// the icon's data is malformed.
+func (ic *Icon) Widget() *widget.Icon {
+	ic.once.Do(func() {
+		ic.ic = MustIcon(ic.Data())
+	})
+	return ic.ic
+}
+
+// Layout displays the icon with its size set to the X minimum constraint. See
+// `widget.Icon.Layout`. Directional icons are mirrored if the context's locale is
+// right-to-left.
+func (ic *Icon) Layout(gtx layout.Context, color color.NRGBA) layout.Dimensions {
+	if ic.rtlMirrored(gtx) {
+		defer mirrorOp(iconSize(gtx)).Push(gtx.Ops).Pop()
+	}
+	return ic.Widget().Layout(gtx, color)
+}
+
+// LayoutDescribed is like Layout, but also describes the icon to assistive technology,
+// such as screen readers. The description of an icon-only control should say what the
+// control does; otherwise, the icon's Entry.Description is a good default.
+func (ic *Icon) LayoutDescribed(gtx layout.Context, color color.NRGBA, desc string) layout.Dimensions {
+	dims := ic.Layout(gtx, color)
+	defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
+	semantic.DescriptionOp(desc).Add(gtx.Ops)
+	return dims
+}
+
+func (ic *Icon) rtlMirrored(gtx layout.Context) bool {
+	return ic.mirror && gtx.Locale.Direction.Progression() == system.TowardOrigin
+}
+
+// iconSize returns the size an icon is drawn at for the given constraints, following
+// `widget.Icon.Layout`.
+func iconSize(gtx layout.Context) image.Point {
+	sz := gtx.Constraints.Min.X
+	if sz == 0 {
+		sz = gtx.Dp(defaultIconSize)
+	}
+	return gtx.Constraints.Constrain(image.Pt(sz, sz))
+}
+
+// mirrorOp flips an area of the given size horizontally about its center.
+func mirrorOp(size image.Point) op.TransformOp {
+	origin := f32.Pt(float32(size.X)/2, float32(size.Y)/2)
+	return op.Affine(f32.Affine2D{}.Scale(origin, f32.Pt(-1, 1)))
+}
+
+// NewIcon returns an Icon for the given IconVG data, such as for the entries of another
+// IconSet. The data is fully decoded, as by New, but only parsed into a `*widget.Icon`
+// when the icon is first used, and it must not be modified afterwards. If autoMirror is
+// true, the icon is mirrored in right-to-left layouts; see AutoMirror. Any error is a
+// `*DecodeError`.
+func NewIcon(data []byte, autoMirror bool) (*Icon, error) {
+	if err := decodeAll(data); err != nil {
+		return nil, err
+	}
+	return &Icon{src: &data, mirror: autoMirror}, nil
+}
+
+// New returns a new `*widget.Icon` for the given IconVG data. Unlike `widget.NewIcon`,
+// which only checks the metadata, the whole stream is decoded so that malformed drawing
+// opcodes are reported here rather than silently rendering nothing. Any error is a
+// `*DecodeError`.
+func New(data []byte) (*widget.Icon, error) {
+	if err := decodeAll(data); err != nil {
+		return nil, err
+	}
+	return widget.NewIcon(data)
+}
 
 // MustIcon returns a new `*widget.Icon` for the given byte slice or panics on error.
 func MustIcon(data []byte) *widget.Icon {
-	ic, err := widget.NewIcon(data)
+	ic, err := New(data)
 	if err != nil {
 		panic(err)
 	}
 	return ic
 }
+
+// Validate decodes every icon of every registered IconSet and returns an error joining a
+// `*DecodeError` for each one that is malformed, or nil if they are all valid.
+func Validate() error {
+	var errs []error
+	for _, set := range Sets() {
+		for _, e := range set.Entries() {
+			if e.Icon == nil {
+				continue
+			}
+			if err := decodeAll(e.Icon.Data()); err != nil {
+				err.Set, err.Name = set.Name(), e.Name
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DecodePart identifies the part of an IconVG stream that was malformed.
+type DecodePart uint8
+
+const (
+	// PartMagic means the data doesn't start with the IconVG magic identifier.
+	PartMagic DecodePart = iota
+	// PartMetadata means the metadata chunks (view box, palette) are malformed.
+	PartMetadata
+	// PartDrawing means the styling or drawing opcodes are malformed.
+	PartDrawing
+)
+
+func (p DecodePart) String() string {
+	switch p {
+	case PartMagic:
+		return "magic identifier"
+	case PartMetadata:
+		return "metadata"
+	case PartDrawing:
+		return "drawing opcodes"
+	}
+	return "unknown part"
+}
+
+// DecodeError is returned for IconVG data that can't be decoded.
+type DecodeError struct {
+	Set  string // The name of the icon's IconSet, if known.
+	Name string // The icon's variable name, if known.
+	Part DecodePart
+	Err  error // The underlying error from the IconVG decoder.
+}
+
+func (e *DecodeError) Error() string {
+	if e.Name != "" {
+		name := e.Name
+		if e.Set != "" {
+			name = e.Set + "." + name
+		}
+		return fmt.Sprintf("icons: %s: malformed IconVG %s: %v", name, e.Part, e.Err)
+	}
+	return fmt.Sprintf("icons: malformed IconVG %s: %v", e.Part, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+var iconvgMagic = []byte("\x89IVG")
+
+// decodeAll fully decodes the given data without drawing anything.
+func decodeAll(data []byte) *DecodeError {
+	if !bytes.HasPrefix(data, iconvgMagic) {
+		return &DecodeError{Part: PartMagic, Err: errors.New("missing \"\\x89IVG\" prefix")}
+	}
+	if _, err := iconvg.DecodeMetadata(data); err != nil {
+		return &DecodeError{Part: PartMetadata, Err: err}
+	}
+	// The zero Rasterizer has no destination image, so this only checks the opcodes.
+	if err := iconvg.Decode(&iconvg.Rasterizer{}, data, nil); err != nil {
+		return &DecodeError{Part: PartDrawing, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package icons
+
+import (
+	"testing"
+
+	"gioui.org/widget"
+)
+
+// Sinks keep the compiler from optimizing the benchmarked work away.
+var widgetSink *widget.Icon
+
+// BenchmarkEager measures making a `*widget.Icon` of every icon up front, which is what
+// importing this package cost before icons were decoded lazily. Importing it now costs
+// nothing at run time, because the icons are static data.
+func BenchmarkEager(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, e := range entries {
+			var err error
+			widgetSink, err = widget.NewIcon(e.Icon.Data())
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkFirstUse measures the first call to Widget of an icon, which fully decodes it
+// and makes its `*widget.Icon`. Each iteration uses a new copy of the next icon.
+func BenchmarkFirstUse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		e := entries[i%len(entries)]
+		ic := Icon{src: e.Icon.src, mirror: e.Icon.mirror}
+		widgetSink = ic.Widget()
+	}
+}
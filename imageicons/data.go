@@ -0,0 +1,165 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package imageicons contains the icons in the Image category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package imageicons
+
+import "gio.tools/icons"
+
+var (
+	AddAPhoto             = icons.ImageAddAPhoto
+	AddToPhotos           = icons.ImageAddToPhotos
+	Adjust                = icons.ImageAdjust
+	Assistant             = icons.ImageAssistant
+	AssistantPhoto        = icons.ImageAssistantPhoto
+	Audiotrack            = icons.ImageAudiotrack
+	BlurCircular          = icons.ImageBlurCircular
+	BlurLinear            = icons.ImageBlurLinear
+	BlurOff               = icons.ImageBlurOff
+	BlurOn                = icons.ImageBlurOn
+	Brightness1           = icons.ImageBrightness1
+	Brightness2           = icons.ImageBrightness2
+	Brightness3           = icons.ImageBrightness3
+	Brightness4           = icons.ImageBrightness4
+	Brightness5           = icons.ImageBrightness5
+	Brightness6           = icons.ImageBrightness6
+	Brightness7           = icons.ImageBrightness7
+	BrokenImage           = icons.ImageBrokenImage
+	Brush                 = icons.ImageBrush
+	BurstMode             = icons.ImageBurstMode
+	Camera                = icons.ImageCamera
+	CameraAlt             = icons.ImageCameraAlt
+	CameraFront           = icons.ImageCameraFront
+	CameraRear            = icons.ImageCameraRear
+	CameraRoll            = icons.ImageCameraRoll
+	CenterFocusStrong     = icons.ImageCenterFocusStrong
+	CenterFocusWeak       = icons.ImageCenterFocusWeak
+	Collections           = icons.ImageCollections
+	CollectionsBookmark   = icons.ImageCollectionsBookmark
+	ColorLens             = icons.ImageColorLens
+	Colorize              = icons.ImageColorize
+	Compare               = icons.ImageCompare
+	ControlPoint          = icons.ImageControlPoint
+	ControlPointDuplicate = icons.ImageControlPointDuplicate
+	Crop                  = icons.ImageCrop
+	Crop169               = icons.ImageCrop169
+	Crop32                = icons.ImageCrop32
+	Crop54                = icons.ImageCrop54
+	Crop75                = icons.ImageCrop75
+	CropDIN               = icons.ImageCropDIN
+	CropFree              = icons.ImageCropFree
+	CropLandscape         = icons.ImageCropLandscape
+	CropOriginal          = icons.ImageCropOriginal
+	CropPortrait          = icons.ImageCropPortrait
+	CropRotate            = icons.ImageCropRotate
+	CropSquare            = icons.ImageCropSquare
+	Dehaze                = icons.ImageDehaze
+	Details               = icons.ImageDetails
+	Edit                  = icons.ImageEdit
+	Exposure              = icons.ImageExposure
+	ExposureNeg1          = icons.ImageExposureNeg1
+	ExposureNeg2          = icons.ImageExposureNeg2
+	ExposurePlus1         = icons.ImageExposurePlus1
+	ExposurePlus2         = icons.ImageExposurePlus2
+	ExposureZero          = icons.ImageExposureZero
+	Filter                = icons.ImageFilter
+	Filter1               = icons.ImageFilter1
+	Filter2               = icons.ImageFilter2
+	Filter3               = icons.ImageFilter3
+	Filter4               = icons.ImageFilter4
+	Filter5               = icons.ImageFilter5
+	Filter6               = icons.ImageFilter6
+	Filter7               = icons.ImageFilter7
+	Filter8               = icons.ImageFilter8
+	Filter9               = icons.ImageFilter9
+	Filter9Plus           = icons.ImageFilter9Plus
+	FilterBAndW           = icons.ImageFilterBAndW
+	FilterCenterFocus     = icons.ImageFilterCenterFocus
+	FilterDrama           = icons.ImageFilterDrama
+	FilterFrames          = icons.ImageFilterFrames
+	FilterHDR             = icons.ImageFilterHDR
+	FilterNone            = icons.ImageFilterNone
+	FilterTiltShift       = icons.ImageFilterTiltShift
+	FilterVintage         = icons.ImageFilterVintage
+	Flare                 = icons.ImageFlare
+	FlashAuto             = icons.ImageFlashAuto
+	FlashOff              = icons.ImageFlashOff
+	FlashOn               = icons.ImageFlashOn
+	Flip                  = icons.ImageFlip
+	Gradient              = icons.ImageGradient
+	Grain                 = icons.ImageGrain
+	GridOff               = icons.ImageGridOff
+	GridOn                = icons.ImageGridOn
+	HDROff                = icons.ImageHDROff
+	HDROn                 = icons.ImageHDROn
+	HDRStrong             = icons.ImageHDRStrong
+	HDRWeak               = icons.ImageHDRWeak
+	Healing               = icons.ImageHealing
+	ISO                   = icons.ImageISO
+	Image                 = icons.ImageImage
+	ImageAspectRatio      = icons.ImageImageAspectRatio
+	Landscape             = icons.ImageLandscape
+	LeakAdd               = icons.ImageLeakAdd
+	LeakRemove            = icons.ImageLeakRemove
+	Lens                  = icons.ImageLens
+	LinkedCamera          = icons.ImageLinkedCamera
+	Looks                 = icons.ImageLooks
+	Looks3                = icons.ImageLooks3
+	Looks4                = icons.ImageLooks4
+	Looks5                = icons.ImageLooks5
+	Looks6                = icons.ImageLooks6
+	LooksOne              = icons.ImageLooksOne
+	LooksTwo              = icons.ImageLooksTwo
+	Loupe                 = icons.ImageLoupe
+	MonochromePhotos      = icons.ImageMonochromePhotos
+	MovieCreation         = icons.ImageMovieCreation
+	MovieFilter           = icons.ImageMovieFilter
+	MusicNote             = icons.ImageMusicNote
+	Nature                = icons.ImageNature
+	NaturePeople          = icons.ImageNaturePeople
+	NavigateBefore        = icons.ImageNavigateBefore
+	NavigateNext          = icons.ImageNavigateNext
+	Palette               = icons.ImagePalette
+	Panorama              = icons.ImagePanorama
+	PanoramaFishEye       = icons.ImagePanoramaFishEye
+	PanoramaHorizontal    = icons.ImagePanoramaHorizontal
+	PanoramaVertical      = icons.ImagePanoramaVertical
+	PanoramaWideAngle     = icons.ImagePanoramaWideAngle
+	Photo                 = icons.ImagePhoto
+	PhotoAlbum            = icons.ImagePhotoAlbum
+	PhotoCamera           = icons.ImagePhotoCamera
+	PhotoFilter           = icons.ImagePhotoFilter
+	PhotoLibrary          = icons.ImagePhotoLibrary
+	PhotoSizeSelectActual = icons.ImagePhotoSizeSelectActual
+	PhotoSizeSelectLarge  = icons.ImagePhotoSizeSelectLarge
+	PhotoSizeSelectSmall  = icons.ImagePhotoSizeSelectSmall
+	PictureAsPDF          = icons.ImagePictureAsPDF
+	Portrait              = icons.ImagePortrait
+	RemoveRedEye          = icons.ImageRemoveRedEye
+	Rotate90DegreesCCW    = icons.ImageRotate90DegreesCCW
+	RotateLeft            = icons.ImageRotateLeft
+	RotateRight           = icons.ImageRotateRight
+	Slideshow             = icons.ImageSlideshow
+	Straighten            = icons.ImageStraighten
+	Style                 = icons.ImageStyle
+	SwitchCamera          = icons.ImageSwitchCamera
+	SwitchVideo           = icons.ImageSwitchVideo
+	TagFaces              = icons.ImageTagFaces
+	Texture               = icons.ImageTexture
+	TimeLapse             = icons.ImageTimeLapse
+	Timer                 = icons.ImageTimer
+	Timer10               = icons.ImageTimer10
+	Timer3                = icons.ImageTimer3
+	TimerOff              = icons.ImageTimerOff
+	Tonality              = icons.ImageTonality
+	Transform             = icons.ImageTransform
+	Tune                  = icons.ImageTune
+	ViewComfy             = icons.ImageViewComfy
+	ViewCompact           = icons.ImageViewCompact
+	Vignette              = icons.ImageVignette
+	WBAuto                = icons.ImageWBAuto
+	WBCloudy              = icons.ImageWBCloudy
+	WBIncandescent        = icons.ImageWBIncandescent
+	WBIridescent          = icons.ImageWBIridescent
+	WBSunny               = icons.ImageWBSunny
+)
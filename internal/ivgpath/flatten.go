@@ -0,0 +1,158 @@
+package ivgpath
+
+import "math"
+
+// Point is a point in a drawing's view box space.
+type Point struct {
+	X, Y float32
+}
+
+// Contour is a closed polygon approximating one sub-path. Its last point is not a repeat
+// of the first.
+type Contour []Point
+
+// Contours flattens every visible, solid coloured shape in the drawing into polygons,
+// with curves approximated by line segments no longer than tolerance (in view box units).
+// Shapes limited to a level of detail are left out, as are gradient filled shapes.
+func (d *Drawing) Contours(tolerance float32) []Contour {
+	var out []Contour
+	for i := range d.Shapes {
+		s := &d.Shapes[i]
+		if s.Gradient || s.Color.A == 0 || s.LOD0 > 0 || !math.IsInf(float64(s.LOD1), +1) {
+			continue
+		}
+		out = flattenShape(out, s.Segments, tolerance)
+	}
+	return out
+}
+
+func flattenShape(out []Contour, segs []Segment, tol float32) []Contour {
+	var (
+		cur  Contour
+		x, y float32
+	)
+	finish := func() {
+		if len(cur) > 2 {
+			out = append(out, cur)
+		}
+		cur = nil
+	}
+	for _, seg := range segs {
+		a := seg.Args
+		switch seg.Op {
+		case MoveTo:
+			finish()
+			cur = Contour{{a[0], a[1]}}
+		case LineTo:
+			cur = append(cur, Point{a[0], a[1]})
+		case QuadTo:
+			// Elevate to a cubic so that there's only one curve flattener.
+			x1 := x + 2.0/3*(a[0]-x)
+			y1 := y + 2.0/3*(a[1]-y)
+			x2 := a[2] + 2.0/3*(a[0]-a[2])
+			y2 := a[3] + 2.0/3*(a[1]-a[3])
+			cur = flattenCube(cur, x, y, x1, y1, x2, y2, a[2], a[3], tol)
+		case CubeTo:
+			cur = flattenCube(cur, x, y, a[0], a[1], a[2], a[3], a[4], a[5], tol)
+		case ArcTo:
+			cur = flattenArc(cur, x, y, a, tol)
+		case Close:
+			finish()
+		}
+		if seg.Op != Close {
+			x, y = seg.End()
+		}
+	}
+	finish()
+	return out
+}
+
+func flattenCube(c Contour, x0, y0, x1, y1, x2, y2, x3, y3, tol float32) Contour {
+	// The control polygon's length bounds the curve's length.
+	l := dist(x0, y0, x1, y1) + dist(x1, y1, x2, y2) + dist(x2, y2, x3, y3)
+	n := segmentCount(l, tol)
+	for i := 1; i <= n; i++ {
+		t := float32(i) / float32(n)
+		mt := 1 - t
+		a, b, cc, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+		c = append(c, Point{
+			a*x0 + b*x1 + cc*x2 + d*x3,
+			a*y0 + b*y1 + cc*y2 + d*y3,
+		})
+	}
+	return c
+}
+
+// flattenArc flattens an SVG style elliptical arc, converting it to a center
+// parameterization in the same way as iconvg.Rasterizer.
+func flattenArc(c Contour, x1f, y1f float32, a [7]float32, tol float32) Contour {
+	rx, ry := math.Abs(float64(a[0])), math.Abs(float64(a[1]))
+	largeArc, sweep := a[3] != 0, a[4] != 0
+	x2f, y2f := a[5], a[6]
+	if !(rx > 0 && ry > 0) {
+		return append(c, Point{x2f, y2f})
+	}
+	x1, y1, x2, y2 := float64(x1f), float64(y1f), float64(x2f), float64(y2f)
+	phi := 2 * math.Pi * float64(a[2])
+	cosPhi, sinPhi := math.Cos(phi), math.Sin(phi)
+
+	halfDx, halfDy := (x1-x2)/2, (y1-y2)/2
+	x1p := +cosPhi*halfDx + sinPhi*halfDy
+	y1p := -sinPhi*halfDx + cosPhi*halfDy
+	if check := x1p*x1p/(rx*rx) + y1p*y1p/(ry*ry); check > 1 {
+		s := math.Sqrt(check)
+		rx, ry = rx*s, ry*s
+	}
+	rxSq, rySq := rx*rx, ry*ry
+	step := 0.0
+	if v := rxSq*rySq/(rxSq*y1p*y1p+rySq*x1p*x1p) - 1; v > 0 {
+		step = math.Sqrt(v)
+	}
+	if largeArc == sweep {
+		step = -step
+	}
+	cxp := +step * rx * y1p / ry
+	cyp := -step * ry * x1p / rx
+	cx := cosPhi*cxp - sinPhi*cyp + (x1+x2)/2
+	cy := sinPhi*cxp + cosPhi*cyp + (y1+y2)/2
+
+	theta1 := vecAngle(1, 0, (x1p-cxp)/rx, (y1p-cyp)/ry)
+	delta := vecAngle((x1p-cxp)/rx, (y1p-cyp)/ry, (-x1p-cxp)/rx, (-y1p-cyp)/ry)
+	if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	} else if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	}
+
+	n := segmentCount(float32(math.Abs(delta)*math.Max(rx, ry)), tol)
+	for i := 1; i <= n; i++ {
+		th := theta1 + delta*float64(i)/float64(n)
+		ex, ey := rx*math.Cos(th), ry*math.Sin(th)
+		c = append(c, Point{
+			float32(cx + cosPhi*ex - sinPhi*ey),
+			float32(cy + sinPhi*ex + cosPhi*ey),
+		})
+	}
+	// Land exactly on the end point.
+	c[len(c)-1] = Point{x2f, y2f}
+	return c
+}
+
+// vecAngle returns the signed angle from the u vector to the v vector.
+func vecAngle(ux, uy, vx, vy float64) float64 {
+	cos := (ux*vx + uy*vy) / (math.Hypot(ux, uy) * math.Hypot(vx, vy))
+	ret := math.Acos(math.Max(-1, math.Min(1, cos)))
+	if ux*vy < uy*vx {
+		return -ret
+	}
+	return ret
+}
+
+func segmentCount(length, tol float32) int {
+	n := int(math.Ceil(float64(length / tol)))
+	return max(1, min(n, 64))
+}
+
+func dist(x0, y0, x1, y1 float32) float32 {
+	return float32(math.Hypot(float64(x1-x0), float64(y1-y0)))
+}
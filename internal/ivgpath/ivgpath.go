@@ -0,0 +1,282 @@
+// Package ivgpath decodes IconVG data into filled shapes made of absolute path segments,
+// for uses that need an icon's geometry rather than a rasterized image.
+package ivgpath
+
+import (
+	"image/color"
+	"math"
+
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// Op is the kind of a path segment.
+type Op uint8
+
+const (
+	MoveTo Op = iota // Args: x, y.
+	LineTo           // Args: x, y.
+	QuadTo           // Args: x1, y1, x, y.
+	CubeTo           // Args: x1, y1, x2, y2, x, y.
+	ArcTo            // Args: rx, ry, x-axis rotation in turns, large arc (0/1), sweep (0/1), x, y.
+	Close            // No args.
+)
+
+// Segment is a single path command. Every coordinate is absolute, in the drawing's view
+// box space, and smooth curves have their implicit control points resolved.
+type Segment struct {
+	Op   Op
+	Args [7]float32
+}
+
+// End returns the point that the segment finishes at, which isn't meaningful for Close.
+func (s Segment) End() (x, y float32) {
+	switch s.Op {
+	case MoveTo, LineTo:
+		return s.Args[0], s.Args[1]
+	case QuadTo:
+		return s.Args[2], s.Args[3]
+	case CubeTo:
+		return s.Args[4], s.Args[5]
+	case ArcTo:
+		return s.Args[5], s.Args[6]
+	}
+	return 0, 0
+}
+
+// Shape is a single filled IconVG path, which may contain several sub-paths.
+type Shape struct {
+	// Color is the resolved, alpha-premultiplied fill colour. It is only meaningful when
+	// Gradient is false.
+	Color color.RGBA
+	// Gradient reports whether the path was filled with a gradient, which isn't
+	// represented here.
+	Gradient bool
+	// LOD0 and LOD1 are the level of detail range, in pixels of height, that the shape is
+	// drawn at.
+	LOD0, LOD1 float32
+	Segments   []Segment
+}
+
+// Drawing is a decoded IconVG graphic.
+type Drawing struct {
+	ViewBox iconvg.Rectangle
+	Shapes  []Shape
+}
+
+// Decode decodes the IconVG data into a Drawing, using the given palette instead of the
+// graphic's suggested one if it is non-nil.
+func Decode(data []byte, pal *iconvg.Palette) (*Drawing, error) {
+	var r recorder
+	if err := iconvg.Decode(&r, data, &iconvg.DecodeOptions{Palette: pal}); err != nil {
+		return nil, err
+	}
+	return &r.d, nil
+}
+
+const (
+	smoothNone = iota
+	smoothQuad
+	smoothCube
+)
+
+// recorder is an iconvg.Destination that records each path as absolute segments,
+// following the same rules as iconvg.Rasterizer.
+type recorder struct {
+	d        Drawing
+	metadata iconvg.Metadata
+
+	lod0, lod1 float32
+	cSel       uint8
+	nSel       uint8
+	cReg       [64]color.RGBA
+
+	shape *Shape
+	// The current point and the start of the current sub-path.
+	x, y           float32
+	startX, startY float32
+
+	prevSmooth  int
+	prevSmoothX float32
+	prevSmoothY float32
+}
+
+func (r *recorder) Reset(m iconvg.Metadata) {
+	r.d = Drawing{ViewBox: m.ViewBox}
+	r.metadata = m
+	r.lod0 = 0
+	r.lod1 = float32(math.Inf(+1))
+	r.cSel = 0
+	r.nSel = 0
+	r.cReg = m.Palette
+	r.shape = nil
+	r.prevSmooth = smoothNone
+}
+
+func (r *recorder) SetCSel(cSel uint8) { r.cSel = cSel & 0x3f }
+func (r *recorder) SetNSel(nSel uint8) { r.nSel = nSel & 0x3f }
+
+func (r *recorder) SetCReg(adj uint8, incr bool, c iconvg.Color) {
+	r.cReg[(r.cSel-adj)&0x3f] = c.Resolve(&r.metadata.Palette, &r.cReg)
+	if incr {
+		r.cSel++
+	}
+}
+
+func (r *recorder) SetNReg(adj uint8, incr bool, f float32) {
+	// Number registers only parameterize gradients, which aren't recorded.
+	if incr {
+		r.nSel++
+	}
+}
+
+func (r *recorder) SetLOD(lod0, lod1 float32) {
+	r.lod0, r.lod1 = lod0, lod1
+}
+
+func (r *recorder) StartPath(adj uint8, x, y float32) {
+	c := r.cReg[(r.cSel-adj)&0x3f]
+	// This is the same test as iconvg's validAlphaPremulColor. Anything else is either
+	// a gradient or invalid.
+	gradient := !(c.R <= c.A && c.G <= c.A && c.B <= c.A)
+	r.d.Shapes = append(r.d.Shapes, Shape{
+		Color:    c,
+		Gradient: gradient,
+		LOD0:     r.lod0,
+		LOD1:     r.lod1,
+	})
+	r.shape = &r.d.Shapes[len(r.d.Shapes)-1]
+	r.moveTo(x, y)
+}
+
+func (r *recorder) ClosePathEndPath() {
+	r.close()
+	r.shape = nil
+}
+
+func (r *recorder) ClosePathAbsMoveTo(x, y float32) {
+	r.close()
+	r.moveTo(x, y)
+}
+
+func (r *recorder) ClosePathRelMoveTo(x, y float32) {
+	r.close()
+	r.moveTo(r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsHLineTo(x float32) { r.lineTo(x, r.y) }
+func (r *recorder) RelHLineTo(x float32) { r.lineTo(r.x+x, r.y) }
+func (r *recorder) AbsVLineTo(y float32) { r.lineTo(r.x, y) }
+func (r *recorder) RelVLineTo(y float32) { r.lineTo(r.x, r.y+y) }
+func (r *recorder) AbsLineTo(x, y float32) {
+	r.lineTo(x, y)
+}
+
+func (r *recorder) RelLineTo(x, y float32) {
+	r.lineTo(r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsSmoothQuadTo(x, y float32) {
+	x1, y1 := r.implicitSmoothPoint(smoothQuad)
+	r.quadTo(x1, y1, x, y)
+}
+
+func (r *recorder) RelSmoothQuadTo(x, y float32) {
+	x1, y1 := r.implicitSmoothPoint(smoothQuad)
+	r.quadTo(x1, y1, r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsQuadTo(x1, y1, x, y float32) {
+	r.quadTo(x1, y1, x, y)
+}
+
+func (r *recorder) RelQuadTo(x1, y1, x, y float32) {
+	r.quadTo(r.x+x1, r.y+y1, r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsSmoothCubeTo(x2, y2, x, y float32) {
+	x1, y1 := r.implicitSmoothPoint(smoothCube)
+	r.cubeTo(x1, y1, x2, y2, x, y)
+}
+
+func (r *recorder) RelSmoothCubeTo(x2, y2, x, y float32) {
+	x1, y1 := r.implicitSmoothPoint(smoothCube)
+	r.cubeTo(x1, y1, r.x+x2, r.y+y2, r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsCubeTo(x1, y1, x2, y2, x, y float32) {
+	r.cubeTo(x1, y1, x2, y2, x, y)
+}
+
+func (r *recorder) RelCubeTo(x1, y1, x2, y2, x, y float32) {
+	r.cubeTo(r.x+x1, r.y+y1, r.x+x2, r.y+y2, r.x+x, r.y+y)
+}
+
+func (r *recorder) AbsArcTo(rx, ry, xAxisRotation float32, largeArc, sweep bool, x, y float32) {
+	r.arcTo(rx, ry, xAxisRotation, largeArc, sweep, x, y)
+}
+
+func (r *recorder) RelArcTo(rx, ry, xAxisRotation float32, largeArc, sweep bool, x, y float32) {
+	r.arcTo(rx, ry, xAxisRotation, largeArc, sweep, r.x+x, r.y+y)
+}
+
+func (r *recorder) add(seg Segment) {
+	if r.shape == nil {
+		return
+	}
+	r.shape.Segments = append(r.shape.Segments, seg)
+}
+
+func (r *recorder) moveTo(x, y float32) {
+	r.prevSmooth = smoothNone
+	r.add(Segment{Op: MoveTo, Args: [7]float32{x, y}})
+	r.x, r.y = x, y
+	r.startX, r.startY = x, y
+}
+
+func (r *recorder) close() {
+	r.prevSmooth = smoothNone
+	r.add(Segment{Op: Close})
+	r.x, r.y = r.startX, r.startY
+}
+
+func (r *recorder) lineTo(x, y float32) {
+	r.prevSmooth = smoothNone
+	r.add(Segment{Op: LineTo, Args: [7]float32{x, y}})
+	r.x, r.y = x, y
+}
+
+func (r *recorder) quadTo(x1, y1, x, y float32) {
+	r.prevSmooth = smoothQuad
+	r.prevSmoothX, r.prevSmoothY = x1, y1
+	r.add(Segment{Op: QuadTo, Args: [7]float32{x1, y1, x, y}})
+	r.x, r.y = x, y
+}
+
+func (r *recorder) cubeTo(x1, y1, x2, y2, x, y float32) {
+	r.prevSmooth = smoothCube
+	r.prevSmoothX, r.prevSmoothY = x2, y2
+	r.add(Segment{Op: CubeTo, Args: [7]float32{x1, y1, x2, y2, x, y}})
+	r.x, r.y = x, y
+}
+
+func (r *recorder) arcTo(rx, ry, xAxisRotation float32, largeArc, sweep bool, x, y float32) {
+	r.prevSmooth = smoothNone
+	r.add(Segment{Op: ArcTo, Args: [7]float32{rx, ry, xAxisRotation, b2f(largeArc), b2f(sweep), x, y}})
+	r.x, r.y = x, y
+}
+
+// implicitSmoothPoint returns the implicit first control point of a smooth curve, which is
+// the reflection of the previous curve's last control point if it was of the same kind.
+func (r *recorder) implicitSmoothPoint(kind int) (x, y float32) {
+	if r.prevSmooth != kind {
+		return r.x, r.y
+	}
+	return 2*r.x - r.prevSmoothX, 2*r.y - r.prevSmoothY
+}
+
+func b2f(b bool) float32 {
+	if b {
+		return 1
+	}
+	return 0
+}
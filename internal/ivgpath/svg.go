@@ -0,0 +1,74 @@
+package ivgpath
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"strconv"
+)
+
+// WriteSVG writes the drawing as a standalone SVG document that is size pixels wide.
+// Shapes whose level of detail range excludes the document's height in pixels are left
+// out, as are gradient filled shapes since their gradients aren't recorded.
+//
+// IconVG fills paths with the non-zero winding rule, and every coordinate is written
+// exactly, so re-encoding the SVG paths produces the same shapes.
+func WriteSVG(w io.Writer, d *Drawing, size int) error {
+	bw := bufio.NewWriter(w)
+	vb := d.ViewBox
+	dx, dy := vb.AspectRatio()
+	height := float32(size) * dy / dx
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%s" viewBox="%s %s %s %s">`+"\n",
+		size, num(height), num(vb.Min[0]), num(vb.Min[1]), num(dx), num(dy))
+	for i := range d.Shapes {
+		s := &d.Shapes[i]
+		if s.Gradient || s.Color.A == 0 || !(s.LOD0 <= height && height < s.LOD1) {
+			continue
+		}
+		fmt.Fprintf(bw, `<path fill="%s"`, hexColor(s.Color))
+		if s.Color.A != 0xff {
+			fmt.Fprintf(bw, ` fill-opacity="%s"`, num(float32(s.Color.A)/0xff))
+		}
+		fmt.Fprint(bw, ` fill-rule="nonzero" d="`)
+		writePathData(bw, s.Segments)
+		fmt.Fprint(bw, "\"/>\n")
+	}
+	fmt.Fprint(bw, "</svg>\n")
+	return bw.Flush()
+}
+
+func writePathData(w *bufio.Writer, segs []Segment) {
+	for i, seg := range segs {
+		if i > 0 {
+			w.WriteByte(' ')
+		}
+		a := seg.Args
+		switch seg.Op {
+		case MoveTo:
+			fmt.Fprintf(w, "M%s %s", num(a[0]), num(a[1]))
+		case LineTo:
+			fmt.Fprintf(w, "L%s %s", num(a[0]), num(a[1]))
+		case QuadTo:
+			fmt.Fprintf(w, "Q%s %s %s %s", num(a[0]), num(a[1]), num(a[2]), num(a[3]))
+		case CubeTo:
+			fmt.Fprintf(w, "C%s %s %s %s %s %s", num(a[0]), num(a[1]), num(a[2]), num(a[3]), num(a[4]), num(a[5]))
+		case ArcTo:
+			// IconVG measures the x-axis rotation in turns, SVG in degrees.
+			fmt.Fprintf(w, "A%s %s %s %s %s %s %s", num(a[0]), num(a[1]), num(a[2]*360), num(a[3]), num(a[4]), num(a[5]), num(a[6]))
+		case Close:
+			w.WriteByte('Z')
+		}
+	}
+}
+
+// hexColor returns the CSS hex notation of the non-premultiplied colour, without alpha.
+func hexColor(c color.RGBA) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+}
+
+func num(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
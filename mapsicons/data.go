@@ -0,0 +1,78 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package mapsicons contains the icons in the Maps category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package mapsicons
+
+import "gio.tools/icons"
+
+var (
+	AddLocation            = icons.MapsAddLocation
+	Beenhere               = icons.MapsBeenhere
+	Directions             = icons.MapsDirections
+	DirectionsBike         = icons.MapsDirectionsBike
+	DirectionsBoat         = icons.MapsDirectionsBoat
+	DirectionsBus          = icons.MapsDirectionsBus
+	DirectionsCar          = icons.MapsDirectionsCar
+	DirectionsRailway      = icons.MapsDirectionsRailway
+	DirectionsRun          = icons.MapsDirectionsRun
+	DirectionsSubway       = icons.MapsDirectionsSubway
+	DirectionsTransit      = icons.MapsDirectionsTransit
+	DirectionsWalk         = icons.MapsDirectionsWalk
+	EVStation              = icons.MapsEVStation
+	EditLocation           = icons.MapsEditLocation
+	Flight                 = icons.MapsFlight
+	Hotel                  = icons.MapsHotel
+	Layers                 = icons.MapsLayers
+	LayersClear            = icons.MapsLayersClear
+	LocalATM               = icons.MapsLocalATM
+	LocalActivity          = icons.MapsLocalActivity
+	LocalAirport           = icons.MapsLocalAirport
+	LocalBar               = icons.MapsLocalBar
+	LocalCafe              = icons.MapsLocalCafe
+	LocalCarWash           = icons.MapsLocalCarWash
+	LocalConvenienceStore  = icons.MapsLocalConvenienceStore
+	LocalDining            = icons.MapsLocalDining
+	LocalDrink             = icons.MapsLocalDrink
+	LocalFlorist           = icons.MapsLocalFlorist
+	LocalGasStation        = icons.MapsLocalGasStation
+	LocalGroceryStore      = icons.MapsLocalGroceryStore
+	LocalHospital          = icons.MapsLocalHospital
+	LocalHotel             = icons.MapsLocalHotel
+	LocalLaundryService    = icons.MapsLocalLaundryService
+	LocalLibrary           = icons.MapsLocalLibrary
+	LocalMall              = icons.MapsLocalMall
+	LocalMovies            = icons.MapsLocalMovies
+	LocalOffer             = icons.MapsLocalOffer
+	LocalParking           = icons.MapsLocalParking
+	LocalPharmacy          = icons.MapsLocalPharmacy
+	LocalPhone             = icons.MapsLocalPhone
+	LocalPizza             = icons.MapsLocalPizza
+	LocalPlay              = icons.MapsLocalPlay
+	LocalPostOffice        = icons.MapsLocalPostOffice
+	LocalPrintshop         = icons.MapsLocalPrintshop
+	LocalSee               = icons.MapsLocalSee
+	LocalShipping          = icons.MapsLocalShipping
+	LocalTaxi              = icons.MapsLocalTaxi
+	Map                    = icons.MapsMap
+	MyLocation             = icons.MapsMyLocation
+	Navigation             = icons.MapsNavigation
+	NearMe                 = icons.MapsNearMe
+	PersonPin              = icons.MapsPersonPin
+	PersonPinCircle        = icons.MapsPersonPinCircle
+	PinDrop                = icons.MapsPinDrop
+	Place                  = icons.MapsPlace
+	RateReview             = icons.MapsRateReview
+	Restaurant             = icons.MapsRestaurant
+	RestaurantMenu         = icons.MapsRestaurantMenu
+	Satellite              = icons.MapsSatellite
+	StoreMallDirectory     = icons.MapsStoreMallDirectory
+	StreetView             = icons.MapsStreetView
+	Subway                 = icons.MapsSubway
+	Terrain                = icons.MapsTerrain
+	Traffic                = icons.MapsTraffic
+	Train                  = icons.MapsTrain
+	Tram                   = icons.MapsTram
+	TransferWithinAStation = icons.MapsTransferWithinAStation
+	ZoomOutMap             = icons.MapsZoomOutMap
+)
@@ -0,0 +1,261 @@
+package icons
+
+import (
+	"image/color"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"gio.tools/icons/internal/ivgpath"
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// The default duration of a Morph transition.
+const defaultMorphDuration = 250 * time.Millisecond
+
+// Morph animates smoothly from one icon's shape to another's, such as play to pause or
+// menu to close, instead of swapping between them. Both icons are flattened into
+// polygons that are paired up and resampled to the same number of points, and each frame
+// of the transition draws the points interpolated between the two.
+//
+// The zero value shows From; set From and To before calling Layout.
+type Morph struct {
+	From, To *Icon
+	// Duration of a full transition. The zero value is 250ms.
+	Duration time.Duration
+	// Easing is applied to the transition. If nil, EaseInOut is used.
+	Easing Easing
+
+	tr transition // Towards To when its target is true.
+
+	// Cached values.
+	pairs              []contourPair
+	pairsFrom, pairsTo *Icon
+	pairsMirror        [2]bool
+}
+
+// contourPair is a contour of each icon, resampled to the same number of points, in a
+// unit square.
+type contourPair struct {
+	from, to []f32.Point
+}
+
+// ShowingTo reports whether the morph is showing, or animating towards, To.
+func (m *Morph) ShowingTo() bool {
+	return m.tr.target
+}
+
+// Toggle starts animating towards whichever icon isn't the current target.
+func (m *Morph) Toggle(gtx layout.Context) {
+	m.SetTarget(gtx, !m.tr.target)
+}
+
+// SetTarget starts animating towards To if to is true, or From otherwise. It does nothing
+// if that is already the target.
+func (m *Morph) SetTarget(gtx layout.Context, to bool) {
+	m.tr.set(gtx, to, m.duration())
+}
+
+func (m *Morph) duration() time.Duration {
+	if m.Duration > 0 {
+		return m.Duration
+	}
+	return defaultMorphDuration
+}
+
+// Layout displays the morph with its size set to the X minimum constraint, in the same way
+// as `Icon.Layout`.
+func (m *Morph) Layout(gtx layout.Context, col color.NRGBA) layout.Dimensions {
+	p := m.tr.progress(gtx.Now, m.duration())
+	switch p {
+	case 0:
+		return m.From.Layout(gtx, col)
+	case 1:
+		return m.To.Layout(gtx, col)
+	}
+	gtx.Execute(op.InvalidateCmd{})
+
+	size := iconSize(gtx)
+	// Directional icons are mirrored in right-to-left layouts, so that the shapes in
+	// between match both ends.
+	mirror := [2]bool{m.From.rtlMirrored(gtx), m.To.rtlMirrored(gtx)}
+	if m.pairsFrom != m.From || m.pairsTo != m.To || m.pairsMirror != mirror {
+		m.pairs = morphPairs(m.From, m.To, mirror)
+		m.pairsFrom, m.pairsTo, m.pairsMirror = m.From, m.To, mirror
+	}
+	ease := m.Easing
+	if ease == nil {
+		ease = EaseInOut
+	}
+	t := ease(p)
+	sx, sy := float32(size.X), float32(size.Y)
+	var path clip.Path
+	path.Begin(gtx.Ops)
+	for _, pair := range m.pairs {
+		for i := range pair.from {
+			a, b := pair.from[i], pair.to[i]
+			pt := f32.Pt((a.X+(b.X-a.X)*t)*sx, (a.Y+(b.Y-a.Y)*t)*sy)
+			if i == 0 {
+				path.MoveTo(pt)
+			} else {
+				path.LineTo(pt)
+			}
+		}
+		path.Close()
+	}
+	paint.FillShape(gtx.Ops, col, clip.Outline{Path: path.End()}.Op())
+	return layout.Dimensions{Size: size}
+}
+
+// The number of points every contour is resampled to.
+const morphPoints = 96
+
+// morphPairs flattens both icons and pairs up their contours. Contours are paired with
+// others of the same winding direction, largest first, so that outlines turn into
+// outlines and holes into holes. Any contour without a partner grows out of, or shrinks
+// into, its own center. Each icon is mirrored horizontally if the corresponding element of
+// mirror is set.
+func morphPairs(from, to *Icon, mirror [2]bool) []contourPair {
+	a := unitContours(from, mirror[0])
+	b := unitContours(to, mirror[1])
+	var pairs []contourPair
+	for _, clockwise := range []bool{true, false} {
+		as, bs := filterWinding(a, clockwise), filterWinding(b, clockwise)
+		for i := 0; i < max(len(as), len(bs)); i++ {
+			var p contourPair
+			switch {
+			case i >= len(as):
+				p.to = resample(bs[i], morphPoints)
+				p.from = collapsed(p.to)
+			case i >= len(bs):
+				p.from = resample(as[i], morphPoints)
+				p.to = collapsed(p.from)
+			default:
+				p.from = resample(as[i], morphPoints)
+				p.to = alignStart(p.from, resample(bs[i], morphPoints))
+			}
+			pairs = append(pairs, p)
+		}
+	}
+	return pairs
+}
+
+// unitContours returns the icon's contours scaled to a unit square, sorted by decreasing
+// area, and mirrored horizontally if mirror is set.
+func unitContours(ic *Icon, mirror bool) [][]f32.Point {
+	d, err := ivgpath.Decode(ic.Data(), nil)
+	if err != nil {
+		return nil
+	}
+	vb := d.ViewBox
+	w, h := vb.AspectRatio()
+	var out [][]f32.Point
+	for _, c := range d.Contours(w / 200) {
+		pts := make([]f32.Point, len(c))
+		for i, p := range c {
+			pts[i] = f32.Pt((p.X-vb.Min[0])/w, (p.Y-vb.Min[1])/h)
+			if mirror {
+				pts[i].X = 1 - pts[i].X
+			}
+		}
+		// Mirroring reverses the winding direction, which tells outlines from holes, so
+		// it is restored.
+		if mirror {
+			slices.Reverse(pts)
+		}
+		out = append(out, pts)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return math.Abs(float64(signedArea(out[i]))) > math.Abs(float64(signedArea(out[j])))
+	})
+	return out
+}
+
+func filterWinding(cs [][]f32.Point, clockwise bool) [][]f32.Point {
+	var out [][]f32.Point
+	for _, c := range cs {
+		if (signedArea(c) > 0) == clockwise {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// signedArea is positive for contours that are clockwise on screen.
+func signedArea(c []f32.Point) float32 {
+	var a float32
+	for i := range c {
+		p, q := c[i], c[(i+1)%len(c)]
+		a += p.X*q.Y - q.X*p.Y
+	}
+	return a / 2
+}
+
+// resample returns n points spaced evenly along the closed contour.
+func resample(c []f32.Point, n int) []f32.Point {
+	lens := make([]float32, len(c)+1)
+	for i := range c {
+		lens[i+1] = lens[i] + dist(c[i], c[(i+1)%len(c)])
+	}
+	total := lens[len(c)]
+	out := make([]f32.Point, n)
+	seg := 0
+	for i := range out {
+		at := total * float32(i) / float32(n)
+		for seg < len(c)-1 && lens[seg+1] < at {
+			seg++
+		}
+		p, q := c[seg], c[(seg+1)%len(c)]
+		t := float32(0)
+		if l := lens[seg+1] - lens[seg]; l > 0 {
+			t = (at - lens[seg]) / l
+		}
+		out[i] = f32.Pt(p.X+(q.X-p.X)*t, p.Y+(q.Y-p.Y)*t)
+	}
+	return out
+}
+
+// collapsed returns a contour with every point at the center of c.
+func collapsed(c []f32.Point) []f32.Point {
+	var center f32.Point
+	for _, p := range c {
+		center = center.Add(p)
+	}
+	center = center.Div(float32(len(c)))
+	out := make([]f32.Point, len(c))
+	for i := range out {
+		out[i] = center
+	}
+	return out
+}
+
+// alignStart rotates the points of b so that they travel the least total distance when
+// morphing from a.
+func alignStart(a, b []f32.Point) []f32.Point {
+	best, bestShift := float32(math.MaxFloat32), 0
+	for shift := range b {
+		var sum float32
+		for i := range a {
+			d := a[i].Sub(b[(i+shift)%len(b)])
+			sum += d.X*d.X + d.Y*d.Y
+		}
+		if sum < best {
+			best, bestShift = sum, shift
+		}
+	}
+	out := make([]f32.Point, len(b))
+	for i := range out {
+		out[i] = b[(i+bestShift)%len(b)]
+	}
+	return out
+}
+
+func dist(a, b f32.Point) float32 {
+	d := b.Sub(a)
+	return float32(math.Hypot(float64(d.X), float64(d.Y)))
+}
@@ -0,0 +1,37 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package navigation contains the icons in the Navigation category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package navigation
+
+import "gio.tools/icons"
+
+var (
+	Apps                   = icons.NavigationApps
+	ArrowBack              = icons.NavigationArrowBack
+	ArrowDownward          = icons.NavigationArrowDownward
+	ArrowDropDown          = icons.NavigationArrowDropDown
+	ArrowDropDownCircle    = icons.NavigationArrowDropDownCircle
+	ArrowDropUp            = icons.NavigationArrowDropUp
+	ArrowForward           = icons.NavigationArrowForward
+	ArrowUpward            = icons.NavigationArrowUpward
+	Cancel                 = icons.NavigationCancel
+	Check                  = icons.NavigationCheck
+	ChevronLeft            = icons.NavigationChevronLeft
+	ChevronRight           = icons.NavigationChevronRight
+	Close                  = icons.NavigationClose
+	ExpandLess             = icons.NavigationExpandLess
+	ExpandMore             = icons.NavigationExpandMore
+	FirstPage              = icons.NavigationFirstPage
+	Fullscreen             = icons.NavigationFullscreen
+	FullscreenExit         = icons.NavigationFullscreenExit
+	LastPage               = icons.NavigationLastPage
+	Menu                   = icons.NavigationMenu
+	MoreHoriz              = icons.NavigationMoreHoriz
+	MoreVert               = icons.NavigationMoreVert
+	Refresh                = icons.NavigationRefresh
+	SubdirectoryArrowLeft  = icons.NavigationSubdirectoryArrowLeft
+	SubdirectoryArrowRight = icons.NavigationSubdirectoryArrowRight
+	UnfoldLess             = icons.NavigationUnfoldLess
+	UnfoldMore             = icons.NavigationUnfoldMore
+)
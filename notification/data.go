@@ -0,0 +1,64 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package notification contains the icons in the Notification category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package notification
+
+import "gio.tools/icons"
+
+var (
+	ADB                        = icons.NotificationADB
+	AirlineSeatFlat            = icons.NotificationAirlineSeatFlat
+	AirlineSeatFlatAngled      = icons.NotificationAirlineSeatFlatAngled
+	AirlineSeatIndividualSuite = icons.NotificationAirlineSeatIndividualSuite
+	AirlineSeatLegroomExtra    = icons.NotificationAirlineSeatLegroomExtra
+	AirlineSeatLegroomNormal   = icons.NotificationAirlineSeatLegroomNormal
+	AirlineSeatLegroomReduced  = icons.NotificationAirlineSeatLegroomReduced
+	AirlineSeatReclineExtra    = icons.NotificationAirlineSeatReclineExtra
+	AirlineSeatReclineNormal   = icons.NotificationAirlineSeatReclineNormal
+	BluetoothAudio             = icons.NotificationBluetoothAudio
+	ConfirmationNumber         = icons.NotificationConfirmationNumber
+	DiscFull                   = icons.NotificationDiscFull
+	DoNotDisturb               = icons.NotificationDoNotDisturb
+	DoNotDisturbAlt            = icons.NotificationDoNotDisturbAlt
+	DoNotDisturbOff            = icons.NotificationDoNotDisturbOff
+	DoNotDisturbOn             = icons.NotificationDoNotDisturbOn
+	DriveETA                   = icons.NotificationDriveETA
+	EnhancedEncryption         = icons.NotificationEnhancedEncryption
+	EventAvailable             = icons.NotificationEventAvailable
+	EventBusy                  = icons.NotificationEventBusy
+	EventNote                  = icons.NotificationEventNote
+	FolderSpecial              = icons.NotificationFolderSpecial
+	LiveTV                     = icons.NotificationLiveTV
+	MMS                        = icons.NotificationMMS
+	More                       = icons.NotificationMore
+	NetworkCheck               = icons.NotificationNetworkCheck
+	NetworkLocked              = icons.NotificationNetworkLocked
+	NoEncryption               = icons.NotificationNoEncryption
+	OnDemandVideo              = icons.NotificationOnDemandVideo
+	PersonalVideo              = icons.NotificationPersonalVideo
+	PhoneBluetoothSpeaker      = icons.NotificationPhoneBluetoothSpeaker
+	PhoneForwarded             = icons.NotificationPhoneForwarded
+	PhoneInTalk                = icons.NotificationPhoneInTalk
+	PhoneLocked                = icons.NotificationPhoneLocked
+	PhoneMissed                = icons.NotificationPhoneMissed
+	PhonePaused                = icons.NotificationPhonePaused
+	Power                      = icons.NotificationPower
+	PriorityHigh               = icons.NotificationPriorityHigh
+	RVHookup                   = icons.NotificationRVHookup
+	SDCard                     = icons.NotificationSDCard
+	SIMCardAlert               = icons.NotificationSIMCardAlert
+	SMS                        = icons.NotificationSMS
+	SMSFailed                  = icons.NotificationSMSFailed
+	Sync                       = icons.NotificationSync
+	SyncDisabled               = icons.NotificationSyncDisabled
+	SyncProblem                = icons.NotificationSyncProblem
+	SystemUpdate               = icons.NotificationSystemUpdate
+	TapAndPlay                 = icons.NotificationTapAndPlay
+	TimeToLeave                = icons.NotificationTimeToLeave
+	VPNLock                    = icons.NotificationVPNLock
+	Vibration                  = icons.NotificationVibration
+	VoiceChat                  = icons.NotificationVoiceChat
+	WC                         = icons.NotificationWC
+	WiFi                       = icons.NotificationWiFi
+)
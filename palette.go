@@ -0,0 +1,108 @@
+package icons
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// Palette is the set of colours a multi-colour icon is drawn with. They are assigned to
+// the first three IconVG palette entries; every other entry is set to Primary, so icons
+// that only use the first entry (such as the bundled Material icons) are drawn entirely
+// in Primary.
+type Palette struct {
+	Primary   color.NRGBA // IconVG palette entry 0.
+	Secondary color.NRGBA // IconVG palette entry 1.
+	Accent    color.NRGBA // IconVG palette entry 2.
+}
+
+// SingleColor returns a palette that draws every part of an icon in c, which is the
+// same as laying out a `widget.Icon` with c.
+func SingleColor(c color.NRGBA) Palette {
+	return Palette{Primary: c, Secondary: c, Accent: c}
+}
+
+// iconvg returns the IconVG palette that gives the same result as `widget.Icon` for a
+// single colour.
+func (p Palette) iconvg() iconvg.Palette {
+	pal := uniformPalette(linearRGBA(p.Primary))
+	pal[1] = linearRGBA(p.Secondary)
+	pal[2] = linearRGBA(p.Accent)
+	return pal
+}
+
+// PaletteIcon draws an icon with a full palette instead of a single colour, so two-tone
+// and accented IconVG icons keep their colour registers. The bundled Material icons only
+// use the first palette entry; make the Icon of a multi-colour IconVG icon with NewIcon.
+// The zero value is not usable; set Icon before calling Layout.
+type PaletteIcon struct {
+	Icon *Icon
+
+	// Cached values.
+	op      paint.ImageOp
+	imgSize int
+	imgPal  Palette
+}
+
+// Layout displays the icon with its size set to the X minimum constraint, in the same
+// way as `Icon.Layout`.
+func (p *PaletteIcon) Layout(gtx layout.Context, pal Palette) layout.Dimensions {
+	size := iconSize(gtx)
+	if p.Icon.rtlMirrored(gtx) {
+		defer mirrorOp(size).Push(gtx.Ops).Pop()
+	}
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+
+	ico := p.image(size.X, pal)
+	ico.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	return layout.Dimensions{
+		Size: ico.Size(),
+	}
+}
+
+func (p *PaletteIcon) image(sz int, pal Palette) paint.ImageOp {
+	if sz == p.imgSize && pal == p.imgPal {
+		return p.op
+	}
+	p.op = paint.NewImageOp(paletteImage(p.Icon.Data(), sz, pal))
+	p.imgSize = sz
+	p.imgPal = pal
+	return p.op
+}
+
+// paletteImage rasterizes IconVG data sz pixels wide with the palette's colours.
+func paletteImage(data []byte, sz int, pal Palette) *image.RGBA {
+	m, _ := iconvg.DecodeMetadata(data)
+	img := image.NewRGBA(imageRect(m, sz))
+	ivgPal := pal.iconvg()
+	_ = rasterize(img, data, &ivgPal)
+	return img
+}
+
+// linearRGBA converts the colour the same way `widget.Icon` does before rasterizing.
+func linearRGBA(c color.NRGBA) color.RGBA {
+	if c.A == 0xff {
+		return color.RGBA(c)
+	}
+	a := float32(c.A) / 0xff
+	return color.RGBA{
+		R: uint8(sRGBToLinear(float32(c.R)/0xff)*a*255 + .5),
+		G: uint8(sRGBToLinear(float32(c.G)/0xff)*a*255 + .5),
+		B: uint8(sRGBToLinear(float32(c.B)/0xff)*a*255 + .5),
+		A: c.A,
+	}
+}
+
+func sRGBToLinear(c float32) float32 {
+	// Formula from EXT_sRGB.
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return float32(math.Pow(float64((c+0.055)/1.055), 2.4))
+}
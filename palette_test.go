@@ -0,0 +1,50 @@
+package icons
+
+import (
+	"image/color"
+	"testing"
+
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// TestPaletteIcon draws an icon with a band in each of the first three palette entries,
+// and checks that each band comes out in its Palette colour.
+func TestPaletteIcon(t *testing.T) {
+	var enc iconvg.Encoder
+	for i, x := range []float32{-32, -16, 16} {
+		enc.SetCReg(0, false, iconvg.PaletteIndexColor(uint8(i)))
+		enc.StartPath(0, x, -32)
+		enc.AbsHLineTo(x + 16 + float32(i%2)*16)
+		enc.AbsVLineTo(32)
+		enc.AbsHLineTo(x)
+		enc.ClosePathEndPath()
+	}
+	data, err := enc.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ic, err := NewIcon(data, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pal := Palette{
+		Primary:   color.NRGBA{R: 0xff, A: 0xff},
+		Secondary: color.NRGBA{G: 0xff, A: 0xff},
+		Accent:    color.NRGBA{B: 0xff, A: 0xff},
+	}
+	// At 24px, the bands are 6, 12 and 6px wide.
+	img := paletteImage(ic.Data(), 24, pal)
+	for _, c := range []struct {
+		x    int
+		want color.NRGBA
+	}{
+		{3, pal.Primary},
+		{12, pal.Secondary},
+		{21, pal.Accent},
+	} {
+		if got := color.NRGBAModel.Convert(img.At(c.x, 12)); got != c.want {
+			t.Errorf("pixel %d = %v, want %v", c.x, got, c.want)
+		}
+	}
+}
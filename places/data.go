@@ -0,0 +1,29 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package places contains the icons in the Places category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package places
+
+import "gio.tools/icons"
+
+var (
+	ACUnit         = icons.PlacesACUnit
+	AirportShuttle = icons.PlacesAirportShuttle
+	AllInclusive   = icons.PlacesAllInclusive
+	BeachAccess    = icons.PlacesBeachAccess
+	BusinessCenter = icons.PlacesBusinessCenter
+	Casino         = icons.PlacesCasino
+	ChildCare      = icons.PlacesChildCare
+	ChildFriendly  = icons.PlacesChildFriendly
+	FitnessCenter  = icons.PlacesFitnessCenter
+	FreeBreakfast  = icons.PlacesFreeBreakfast
+	GolfCourse     = icons.PlacesGolfCourse
+	HotTub         = icons.PlacesHotTub
+	Kitchen        = icons.PlacesKitchen
+	Pool           = icons.PlacesPool
+	RVHookup       = icons.PlacesRVHookup
+	RoomService    = icons.PlacesRoomService
+	SmokeFree      = icons.PlacesSmokeFree
+	SmokingRooms   = icons.PlacesSmokingRooms
+	Spa            = icons.PlacesSpa
+)
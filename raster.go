@@ -0,0 +1,94 @@
+package icons
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"golang.org/x/exp/shiny/iconvg"
+)
+
+// Image rasterizes the icon in software into a new `*image.RGBA` that is size pixels wide,
+// with its height following the icon's aspect ratio. Every palette colour is set to c.
+// Unlike `Layout`, this doesn't need a Gio window or a GPU. A negative size is an error,
+// and any other error is a `*DecodeError`.
+func (ic *Icon) Image(size int, c color.NRGBA) (*image.RGBA, error) {
+	if err := checkSize(size); err != nil {
+		return nil, err
+	}
+	m, err := ic.metadata()
+	if err != nil {
+		return nil, err
+	}
+	img := image.NewRGBA(imageRect(m, size))
+	pal := uniformPalette(color.RGBAModel.Convert(c).(color.RGBA))
+	if err := rasterize(img, ic.Data(), &pal); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// Alpha rasterizes the icon's coverage in software into a new `*image.Alpha` that is size
+// pixels wide, with its height following the icon's aspect ratio. It is useful as a mask
+// when compositing. A negative size is an error, and any other error is a `*DecodeError`.
+func (ic *Icon) Alpha(size int) (*image.Alpha, error) {
+	if err := checkSize(size); err != nil {
+		return nil, err
+	}
+	m, err := ic.metadata()
+	if err != nil {
+		return nil, err
+	}
+	img := image.NewAlpha(imageRect(m, size))
+	pal := uniformPalette(color.RGBA{255, 255, 255, 255})
+	if err := rasterize(img, ic.Data(), &pal); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// checkSize reports an error for a negative image size, which `image.NewRGBA` and
+// `image.NewAlpha` would panic on.
+func checkSize(size int) error {
+	if size < 0 {
+		return fmt.Errorf("icons: negative image size %d", size)
+	}
+	return nil
+}
+
+func (ic *Icon) metadata() (iconvg.Metadata, error) {
+	m, err := iconvg.DecodeMetadata(ic.Data())
+	if err != nil {
+		return m, decodeAll(ic.Data())
+	}
+	return m, nil
+}
+
+// imageRect returns the bounds of an image size pixels wide with the aspect ratio of the
+// given metadata's view box, matching how `widget.Icon` sizes its images.
+func imageRect(m iconvg.Metadata, size int) image.Rectangle {
+	dx, dy := m.ViewBox.AspectRatio()
+	return image.Rectangle{Max: image.Point{X: size, Y: int(float32(size) * dy / dx)}}
+}
+
+func uniformPalette(c color.RGBA) iconvg.Palette {
+	var pal iconvg.Palette
+	for i := range pal {
+		pal[i] = c
+	}
+	return pal
+}
+
+// rasterize draws the IconVG data onto the whole of dst using the given palette.
+func rasterize(dst draw.Image, data []byte, pal *iconvg.Palette) error {
+	var z iconvg.Rasterizer
+	z.SetDstImage(dst, dst.Bounds(), draw.Src)
+	if err := iconvg.Decode(&z, data, &iconvg.DecodeOptions{Palette: pal}); err != nil {
+		if derr := decodeAll(data); derr != nil {
+			return derr
+		}
+		return &DecodeError{Part: PartDrawing, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package icons
+
+import "sort"
+
+// Entry describes one of the icons in this package, or in another IconSet.
+type Entry struct {
+	Name      string // The variable name, e.g. "ActionSearch".
+	HumanName string // The variable name split into words, e.g. "Action Search".
+	Category  Category
+	ShortName string // The human readable name without its category, e.g. "Search".
+	// Description is a default accessible description for screen readers, e.g. "Find in
+	// page" for ActionFindInPage. It is made from the name and, for icons that the name
+	// doesn't tell apart from others, a keyword, e.g. "Star, filled" for ToggleStar and
+	// "Star, outline" for ToggleStarBorder. Controls should prefer describing what they
+	// do.
+	Description string
+	Icon        *Icon
+	// Keywords are extra search terms, such as "trash" for ActionDelete, that aren't part
+	// of the name: the icon's tags in Google's icon metadata, if the generator had it,
+	// and a hand-curated list for common icons. Icons may have none.
+	Keywords []string
+	// Aliases are the names of other icons whose data is identical to this one's.
+	Aliases []string
+}
+
+// Lookup returns the icon with the given variable name (e.g. "ActionSearch"), reporting
+// whether it exists. The names of icons that were renamed or removed upstream are
+// resolved as by Deprecated.
+func Lookup(name string) (*Icon, bool) {
+	if i, ok := lookupIndex(name); ok {
+		return entries[i].Icon, true
+	}
+	return nil, false
+}
+
+// Deprecated reports whether name is the name of an icon that was renamed or removed
+// upstream, which is kept as a deprecated variable, and returns the current name that it
+// resolves to. A removed icon resolves to a placeholder.
+func Deprecated(name string) (current string, ok bool) {
+	// The generated table is sorted by old name, like the entries.
+	i := sort.Search(len(deprecatedNames), func(i int) bool { return deprecatedNames[i].old >= name })
+	if i < len(deprecatedNames) && deprecatedNames[i].old == name {
+		return deprecatedNames[i].current, true
+	}
+	return "", false
+}
+
+// deprecatedName is a name that was renamed or removed upstream.
+type deprecatedName struct {
+	old, current string
+}
+
+// lookupIndex returns the index of the named icon's entry, resolving deprecated names.
+func lookupIndex(name string) (int, bool) {
+	if i, ok := entryIndex(name); ok {
+		return i, true
+	}
+	if current, ok := Deprecated(name); ok {
+		return entryIndex(current)
+	}
+	return 0, false
+}
+
+// entryIndex returns the index of the named icon's entry.
+func entryIndex(name string) (int, bool) {
+	// The generated entries are sorted by name, so we can binary search instead of
+	// building a map at init.
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Name >= name })
+	return i, i < len(entries) && entries[i].Name == name
+}
+
+// Names returns the variable names of every icon in sorted order. The returned slice is
+// a copy and may be modified by the caller.
+func Names() []string {
+	names := make([]string, len(entries))
+	for i := range entries {
+		names[i] = entries[i].Name
+	}
+	return names
+}
+
+// All calls yield for every icon in name order, stopping early if it returns false.
+func All(yield func(Entry) bool) {
+	for i := range entries {
+		if !yield(entries[i]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package icons
+
+import (
+	"image"
+	"image/color"
+	"slices"
+	"strings"
+	"unicode"
+
+	"gioui.org/font"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"golang.org/x/image/math/fixed"
+)
+
+// Span is a run of styled text, or an icon, in a RichText. Zero style fields use the
+// RichText's.
+type Span struct {
+	Text string
+	// Icon, if set, is drawn in place of Text. It is as tall as the font's ascent and
+	// sits on the baseline.
+	Icon  *Icon
+	Font  font.Font
+	Size  unit.Sp
+	Color color.NRGBA
+}
+
+// RichText lays out text with icons inline, such as "press [search icon] to search",
+// wrapping the icons along with the words around them. Lines only break at spaces and at
+// newlines. In a right-to-left locale, the items of each line are placed from right to
+// left.
+type RichText struct {
+	Spans []Span
+	// The default style of the spans. Size defaults to 16sp and Color to black.
+	Font      font.Font
+	Size      unit.Sp
+	Color     color.NRGBA
+	Alignment text.Alignment
+
+	// Cached values.
+	items     []richItem
+	itemSpans []Span
+	itemsKey  richKey
+}
+
+// richKey is what the measured items depend on, other than the spans.
+type richKey struct {
+	font    font.Font
+	size    unit.Sp
+	color   color.NRGBA
+	pxPerSp float32
+	locale  system.Locale
+	shaper  *text.Shaper
+}
+
+// richItem is a word, a run of spaces, a line break or an icon, measured for layout.
+type richItem struct {
+	glyphs  []text.Glyph
+	minX    int // The left edge of the glyphs, which needn't be the first one's.
+	icon    *Icon
+	color   color.NRGBA
+	width   int
+	ascent  int
+	descent int
+	space   bool // Whether the item is whitespace, which is dropped at the end of a line.
+	newline bool
+}
+
+// Layout lays out the text, wrapping it to the maximum width constraint.
+func (t *RichText) Layout(gtx layout.Context, shaper *text.Shaper) layout.Dimensions {
+	key := richKey{
+		font:    t.Font,
+		size:    t.Size,
+		color:   t.Color,
+		pxPerSp: gtx.Metric.PxPerSp,
+		locale:  gtx.Locale,
+		shaper:  shaper,
+	}
+	if t.itemsKey != key || !slices.Equal(t.itemSpans, t.Spans) {
+		t.items = t.measure(gtx, shaper)
+		t.itemSpans = append(t.itemSpans[:0], t.Spans...)
+		t.itemsKey = key
+	}
+	items := t.items
+
+	var (
+		lines   []richLine
+		cur     richLine
+		wrapped bool // Whether the current line follows a wrapped one.
+	)
+	finish := func() {
+		// Trailing spaces take up no room.
+		for len(cur.items) > 0 && cur.items[len(cur.items)-1].space {
+			cur.width -= cur.items[len(cur.items)-1].width
+			cur.items = cur.items[:len(cur.items)-1]
+		}
+		lines = append(lines, cur)
+		cur = richLine{}
+	}
+	maxWidth := gtx.Constraints.Max.X
+	for _, it := range items {
+		switch {
+		case it.newline:
+			cur.ascent = max(cur.ascent, it.ascent)
+			cur.descent = max(cur.descent, it.descent)
+			finish()
+			wrapped = false
+			continue
+		case it.space && len(cur.items) == 0 && wrapped:
+			// Leading spaces on a wrapped line are dropped.
+			continue
+		case !it.space && cur.width+it.width > maxWidth && len(cur.items) > 0:
+			finish()
+			wrapped = true
+		}
+		cur.items = append(cur.items, it)
+		cur.width += it.width
+		cur.ascent = max(cur.ascent, it.ascent)
+		cur.descent = max(cur.descent, it.descent)
+	}
+	if len(cur.items) > 0 || len(lines) == 0 {
+		finish()
+	}
+
+	width := 0
+	for _, l := range lines {
+		width = max(width, l.width)
+	}
+	width = gtx.Constraints.Constrain(image.Pt(width, 0)).X
+	rtl := gtx.Locale.Direction.Progression() == system.TowardOrigin
+	y := 0
+	for _, l := range lines {
+		// The alignment is relative to the direction of the text.
+		x := 0
+		switch {
+		case t.Alignment == text.Middle:
+			x = (width - l.width) / 2
+		case (t.Alignment == text.End) != rtl:
+			x = width - l.width
+		}
+		baseline := y + l.ascent
+		if rtl {
+			x += l.width
+		}
+		for _, it := range l.items {
+			if rtl {
+				x -= it.width
+			}
+			t.drawItem(gtx, shaper, it, image.Pt(x, baseline))
+			if !rtl {
+				x += it.width
+			}
+		}
+		y = baseline + l.descent
+	}
+	return layout.Dimensions{
+		Size:     gtx.Constraints.Constrain(image.Pt(width, y)),
+		Baseline: y - lines[0].ascent,
+	}
+}
+
+type richLine struct {
+	items           []richItem
+	width           int
+	ascent, descent int
+}
+
+// measure splits the spans into words, spaces, line breaks and icons, and measures them.
+func (t *RichText) measure(gtx layout.Context, shaper *text.Shaper) []richItem {
+	var items []richItem
+	for _, s := range t.Spans {
+		fnt, size, col := s.Font, s.Size, s.Color
+		if fnt == (font.Font{}) {
+			fnt = t.Font
+		}
+		if size == 0 {
+			size = t.Size
+		}
+		if size == 0 {
+			size = 16
+		}
+		if col == (color.NRGBA{}) {
+			col = t.Color
+		}
+		if col == (color.NRGBA{}) {
+			col = color.NRGBA{A: 0xff}
+		}
+		params := text.Parameters{
+			Font:     fnt,
+			PxPerEm:  fixed.I(gtx.Sp(size)),
+			MaxLines: 1,
+			MaxWidth: 1 << 24,
+			Locale:   gtx.Locale,
+		}
+		if s.Icon != nil {
+			// Measure the font to size the icon to its ascent.
+			m := shapeItem(shaper, params, "X")
+			items = append(items, richItem{
+				icon:    s.Icon,
+				color:   col,
+				width:   m.ascent,
+				ascent:  m.ascent,
+				descent: m.descent,
+			})
+			continue
+		}
+		for _, tok := range splitWords(s.Text) {
+			if tok == "\n" {
+				m := shapeItem(shaper, params, " ")
+				items = append(items, richItem{newline: true, ascent: m.ascent, descent: m.descent})
+				continue
+			}
+			it := shapeItem(shaper, params, tok)
+			it.color = col
+			it.space = strings.TrimSpace(tok) == ""
+			items = append(items, it)
+		}
+	}
+	return items
+}
+
+// shapeItem shapes a single line of text.
+func shapeItem(shaper *text.Shaper, params text.Parameters, s string) richItem {
+	shaper.LayoutString(params, s)
+	var it richItem
+	var adv fixed.Int26_6
+	for {
+		g, ok := shaper.NextGlyph()
+		if !ok {
+			break
+		}
+		if len(it.glyphs) == 0 || g.X.Floor() < it.minX {
+			it.minX = g.X.Floor()
+		}
+		it.glyphs = append(it.glyphs, g)
+		adv += g.Advance
+		it.ascent = max(it.ascent, g.Ascent.Ceil())
+		it.descent = max(it.descent, g.Descent.Ceil())
+	}
+	it.width = adv.Ceil()
+	return it
+}
+
+// splitWords splits s into words, runs of spaces and newlines, in order.
+func splitWords(s string) []string {
+	var out []string
+	start, prevSpace := 0, false
+	for i, r := range s {
+		space := unicode.IsSpace(r)
+		if i > start && (r == '\n' || space != prevSpace) {
+			out = append(out, s[start:i])
+			start = i
+		}
+		if r == '\n' {
+			out = append(out, "\n")
+			start = i + 1
+		}
+		prevSpace = space
+	}
+	if start < len(s) {
+		out = append(out, s[start:])
+	}
+	return out
+}
+
+// drawItem draws the item with its left edge and baseline at the given point.
+func (t *RichText) drawItem(gtx layout.Context, shaper *text.Shaper, it richItem, at image.Point) {
+	if it.space {
+		return
+	}
+	if it.icon != nil {
+		defer op.Offset(image.Pt(at.X, at.Y-it.ascent)).Push(gtx.Ops).Pop()
+		gtx.Constraints = layout.Exact(image.Pt(it.width, it.ascent))
+		it.icon.Layout(gtx, it.color)
+		return
+	}
+	if len(it.glyphs) == 0 {
+		return
+	}
+	// The shaped path starts at the first glyph on the baseline, and in right-to-left
+	// text the first glyph isn't the leftmost.
+	defer op.Offset(image.Pt(at.X+it.glyphs[0].X.Floor()-it.minX, at.Y)).Push(gtx.Ops).Pop()
+	outline := clip.Outline{Path: shaper.Shape(it.glyphs)}.Op().Push(gtx.Ops)
+	paint.ColorOp{Color: it.color}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	outline.Pop()
+	if call := shaper.Bitmaps(it.glyphs); call != (op.CallOp{}) {
+		call.Add(gtx.Ops)
+	}
+}
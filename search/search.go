@@ -0,0 +1,183 @@
+// Package search finds icons by name and keyword, ranking the results so that the best
+// match comes first. It is fast enough to run on every keystroke.
+//
+// A query is split into words, and an icon matches if every word matches it. Each word is
+// scored by the best of these, from strongest to weakest:
+//
+//   - the whole name, with or without its category, e.g. "search" for ActionSearch;
+//   - the start of the name;
+//   - the initials of the name's words, e.g. "afip" or "fip" for ActionFindInPage;
+//   - one of the icon's keywords, e.g. "trash" for ActionDelete;
+//   - the start of one of the name's words;
+//   - the start of the initials;
+//   - the start of a keyword;
+//   - anywhere in the name;
+//   - anywhere in a keyword;
+//   - the word's letters in order anywhere in the name, e.g. "srch" for ActionSearch.
+//
+// Icons without keywords are only found by their names. See icons.Entry.Keywords.
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"gio.tools/icons"
+)
+
+// Scores of the different kinds of match. Shorter names get a small boost on top, so that
+// the closest match ranks first.
+const (
+	scoreExact       = 1000
+	scorePrefix      = 800
+	scoreInitials    = 700
+	scoreKeyword     = 650
+	scoreWordPrefix  = 600
+	scoreInitialsPre = 500
+	scoreKeywordPre  = 450
+	scoreSubstring   = 400
+	scoreKeywordSub  = 300
+	scoreFuzzy       = 100
+)
+
+// Result is an icon that matched a query.
+type Result struct {
+	Index int // The index of the entry in the slice the Index was made from.
+	Entry icons.Entry
+	Score int // Higher is better.
+}
+
+// Index is a set of icons prepared for searching. It is safe for concurrent use.
+type Index struct {
+	entries []icons.Entry
+	items   []item
+}
+
+// item is an entry's lowercase search terms.
+type item struct {
+	name      string   // e.g. "actionfindinpage"
+	short     string   // e.g. "findinpage"
+	words     []string // e.g. "action", "find", "in", "page"
+	initials  string   // e.g. "afip"
+	shortInit string   // e.g. "fip"
+	keywords  []string
+}
+
+// New returns an index of the given entries.
+func New(entries []icons.Entry) *Index {
+	ix := &Index{entries: entries, items: make([]item, len(entries))}
+	for i, e := range entries {
+		words := strings.Fields(strings.ToLower(e.HumanName))
+		if len(words) == 0 {
+			words = []string{strings.ToLower(e.Name)}
+		}
+		shortWords := strings.Fields(strings.ToLower(e.ShortName))
+		it := item{
+			name:     strings.ToLower(e.Name),
+			short:    strings.Join(shortWords, ""),
+			words:    words,
+			keywords: e.Keywords,
+		}
+		for _, w := range words {
+			it.initials += w[:1]
+		}
+		for _, w := range shortWords {
+			it.shortInit += w[:1]
+		}
+		ix.items[i] = it
+	}
+	return ix
+}
+
+// Search returns the entries matching the query, best first, with ties in the order the
+// index was made in. If limit is greater than zero, at most that many results are
+// returned. An empty query matches nothing.
+func (ix *Index) Search(query string, limit int) []Result {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil
+	}
+	var results []Result
+	for i := range ix.items {
+		it := &ix.items[i]
+		total := 0
+		for _, t := range terms {
+			s := it.score(t)
+			if s == 0 {
+				total = 0
+				break
+			}
+			total += s
+		}
+		if total == 0 {
+			continue
+		}
+		// Prefer shorter names among otherwise equal matches.
+		total += max(0, 50-len(it.name))
+		results = append(results, Result{Index: i, Entry: ix.entries[i], Score: total})
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// score returns how well a single lowercase query term matches the item, or zero if it
+// doesn't.
+func (it *item) score(t string) int {
+	switch {
+	case t == it.name || t == it.short:
+		return scoreExact
+	case strings.HasPrefix(it.name, t) || strings.HasPrefix(it.short, t):
+		return scorePrefix
+	case len(t) > 1 && (t == it.initials || t == it.shortInit):
+		return scoreInitials
+	}
+	best := 0
+	for _, kw := range it.keywords {
+		switch {
+		case kw == t:
+			return scoreKeyword
+		case strings.HasPrefix(kw, t):
+			best = max(best, scoreKeywordPre)
+		case strings.Contains(kw, t):
+			best = max(best, scoreKeywordSub)
+		}
+	}
+	for _, w := range it.words {
+		if strings.HasPrefix(w, t) {
+			return scoreWordPrefix
+		}
+	}
+	if len(t) > 1 && (strings.HasPrefix(it.initials, t) || strings.HasPrefix(it.shortInit, t)) {
+		return max(best, scoreInitialsPre)
+	}
+	if i := strings.Index(it.name, t); i >= 0 {
+		// Earlier is better.
+		return max(best, scoreSubstring-min(i, 50))
+	}
+	if best > 0 {
+		return best
+	}
+	return fuzzy(it.name, t)
+}
+
+// fuzzy scores t by whether its letters appear in order in name, favouring runs of
+// consecutive letters. It returns zero if they don't all appear.
+func fuzzy(name, t string) int {
+	score, run, j := scoreFuzzy, 0, 0
+	for i := 0; i < len(name) && j < len(t); i++ {
+		if name[i] != t[j] {
+			run = 0
+			continue
+		}
+		j++
+		run++
+		score += run * 2
+	}
+	if j < len(t) {
+		return 0
+	}
+	return min(score, scoreKeywordSub-1)
+}
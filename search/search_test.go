@@ -0,0 +1,51 @@
+package search
+
+import (
+	"testing"
+
+	"gio.tools/icons"
+)
+
+var resultsSink []Result
+
+func TestSearch(t *testing.T) {
+	ix := New(icons.Material.Entries())
+	for _, c := range []struct {
+		query string
+		want  string // The name of the top result, or "" for none.
+	}{
+		{"search", "ActionSearch"},              // The whole short name.
+		{"favor", "ActionFavorite"},             // The start of the name.
+		{"afip", "ActionFindInPage"},            // Initials.
+		{"fip", "ActionFindInPage"},             // Initials without the category.
+		{"trash", "ActionDelete"},               // A keyword.
+		{"gear", "ActionSettings"},              // A keyword.
+		{"magnifying", "ActionSearch"},          // The start of a keyword.
+		{"srch", "ActionSearch"},                // Fuzzy.
+		{"arrow back", "NavigationArrowBack"},   // Several words.
+		{"acc circ", "ActionAccountCircle"},     // The starts of several words.
+		{"ARROW  Back ", "NavigationArrowBack"}, // Case and spaces are ignored.
+		{"zzzz", ""},
+		{"", ""},
+		{"   ", ""},
+	} {
+		got := ""
+		if r := ix.Search(c.query, 1); len(r) > 0 {
+			got = r[0].Entry.Name
+		}
+		if got != c.want {
+			t.Errorf("Search(%q) = %q, want %q", c.query, got, c.want)
+		}
+	}
+}
+
+// BenchmarkSearch measures a search of every icon, as is done on each keystroke.
+func BenchmarkSearch(b *testing.B) {
+	ix := New(icons.Material.Entries())
+	queries := []string{"a", "arrow", "arr back", "delete", "srch", "fip"}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resultsSink = ix.Search(queries[i%len(queries)], 0)
+	}
+}
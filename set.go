@@ -0,0 +1,103 @@
+package icons
+
+import (
+	"fmt"
+	"sync"
+)
+
+// IconSet is a collection of icons, such as the Material icons in this package, that can
+// be used interchangeably with other collections. A set outside this package makes the
+// Icons of its entries with NewIcon.
+type IconSet interface {
+	// Name returns the set's unique name, e.g. "Material".
+	Name() string
+	// Info returns the set's metadata.
+	Info() SetInfo
+	// Entries returns every icon in the set in name order. The returned slice is a copy
+	// and may be modified by the caller.
+	Entries() []Entry
+	// Lookup returns the entry of the icon with the given name, reporting whether it
+	// exists. A set may resolve deprecated names, in which case the entry's Name is the
+	// current one.
+	Lookup(name string) (Entry, bool)
+}
+
+// SetInfo describes an IconSet.
+type SetInfo struct {
+	Title   string // A human readable title, e.g. "Material Design Icons".
+	Author  string
+	URL     string // Where the icons come from.
+	License string // An SPDX license identifier, e.g. "Apache-2.0".
+	// Package is the name of the Go package that the icons' variables are in, if any, so
+	// that, for example, "icons" and "ActionSearch" refer to icons.ActionSearch.
+	Package string
+}
+
+// Material is the set of icons in this package. Icons from other sets usually have
+// CategoryNone.
+var Material IconSet = materialSet{}
+
+type materialSet struct{}
+
+func (materialSet) Name() string { return "Material" }
+
+func (materialSet) Info() SetInfo {
+	return SetInfo{
+		Title:   "Material Design Icons",
+		Author:  "Google",
+		URL:     "https://github.com/google/material-design-icons",
+		License: "Apache-2.0",
+		Package: "icons",
+	}
+}
+
+func (materialSet) Entries() []Entry {
+	return append([]Entry(nil), entries[:]...)
+}
+
+func (materialSet) Lookup(name string) (Entry, bool) {
+	if i, ok := lookupIndex(name); ok {
+		return entries[i], true
+	}
+	return Entry{}, false
+}
+
+var (
+	setsMu sync.RWMutex
+	sets   = []IconSet{Material}
+)
+
+// RegisterSet makes an icon set available from Sets and FindSet. It panics if a set with
+// the same name is already registered, so it's usually called from the init function of
+// the package that provides the set.
+func RegisterSet(s IconSet) {
+	setsMu.Lock()
+	defer setsMu.Unlock()
+	for _, other := range sets {
+		if other.Name() == s.Name() {
+			panic(fmt.Sprintf("icons: RegisterSet called twice for set %q", s.Name()))
+		}
+	}
+	sets = append(sets, s)
+}
+
+// Sets returns every registered icon set in the order they were registered, starting
+// with Material.
+func Sets() []IconSet {
+	setsMu.RLock()
+	defer setsMu.RUnlock()
+	return append([]IconSet(nil), sets...)
+}
+
+// FindSet returns the registered icon set with the given name, reporting whether it
+// exists.
+func FindSet(name string) (IconSet, bool) {
+	setsMu.RLock()
+	defer setsMu.RUnlock()
+	for _, s := range sets {
+		if s.Name() == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,72 @@
+package icons
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeSet is an icon set like one from another package, whose icons are made with
+// NewIcon.
+type fakeSet struct {
+	entries []Entry
+}
+
+func (s *fakeSet) Name() string { return "Fake" }
+
+func (s *fakeSet) Info() SetInfo { return SetInfo{Title: "Fake Icons", License: "CC0-1.0"} }
+
+func (s *fakeSet) Entries() []Entry { return append([]Entry(nil), s.entries...) }
+
+func (s *fakeSet) Lookup(name string) (Entry, bool) {
+	for _, e := range s.entries {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+func TestRegisterSet(t *testing.T) {
+	if _, err := NewIcon([]byte("not IconVG"), false); err == nil {
+		t.Error("NewIcon accepted malformed data")
+	}
+	ic, err := NewIcon(ActionSearch.Data(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ic.AutoMirror() {
+		t.Error("NewIcon's icon isn't auto-mirrored")
+	}
+	fake := &fakeSet{entries: []Entry{{Name: "Magnifier", HumanName: "Magnifier", Icon: ic}}}
+	RegisterSet(fake)
+
+	set, ok := FindSet("Fake")
+	if !ok {
+		t.Fatal("FindSet didn't find the registered set")
+	}
+	e, ok := set.Lookup("Magnifier")
+	if !ok || e.Icon != ic {
+		t.Fatalf("Lookup(%q) = %v, %v", "Magnifier", e, ok)
+	}
+	if e.Category != CategoryNone {
+		t.Errorf("an entry without a category is in %v", e.Category)
+	}
+	if e.Icon.Widget() == nil {
+		t.Error("the icon has no widget")
+	}
+	if n := len(set.Entries()); n != 1 {
+		t.Errorf("the set has %d entries, want 1", n)
+	}
+	if err := Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	// Validate must also decode the icons of other sets.
+	bad := []byte("\x89IVG")
+	fake.entries[0].Icon = &Icon{src: &bad}
+	defer func() { fake.entries[0].Icon = ic }()
+	var derr *DecodeError
+	if err := Validate(); !errors.As(err, &derr) || derr.Set != "Fake" || derr.Name != "Magnifier" {
+		t.Errorf("Validate = %v, want an error for Fake.Magnifier", err)
+	}
+}
@@ -0,0 +1,40 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package social contains the icons in the Social category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package social
+
+import "gio.tools/icons"
+
+var (
+	Cake                      = icons.SocialCake
+	Domain                    = icons.SocialDomain
+	Group                     = icons.SocialGroup
+	GroupAdd                  = icons.SocialGroupAdd
+	LocationCity              = icons.SocialLocationCity
+	Mood                      = icons.SocialMood
+	MoodBad                   = icons.SocialMoodBad
+	Notifications             = icons.SocialNotifications
+	NotificationsActive       = icons.SocialNotificationsActive
+	NotificationsNone         = icons.SocialNotificationsNone
+	NotificationsOff          = icons.SocialNotificationsOff
+	NotificationsPaused       = icons.SocialNotificationsPaused
+	Pages                     = icons.SocialPages
+	PartyMode                 = icons.SocialPartyMode
+	People                    = icons.SocialPeople
+	PeopleOutline             = icons.SocialPeopleOutline
+	Person                    = icons.SocialPerson
+	PersonAdd                 = icons.SocialPersonAdd
+	PersonOutline             = icons.SocialPersonOutline
+	PlusOne                   = icons.SocialPlusOne
+	Poll                      = icons.SocialPoll
+	Public                    = icons.SocialPublic
+	School                    = icons.SocialSchool
+	SentimentDissatisfied     = icons.SocialSentimentDissatisfied
+	SentimentNeutral          = icons.SocialSentimentNeutral
+	SentimentSatisfied        = icons.SocialSentimentSatisfied
+	SentimentVeryDissatisfied = icons.SocialSentimentVeryDissatisfied
+	SentimentVerySatisfied    = icons.SocialSentimentVerySatisfied
+	Share                     = icons.SocialShare
+	WhatsHot                  = icons.SocialWhatsHot
+)
@@ -0,0 +1,112 @@
+package icons
+
+import (
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/widget/material"
+)
+
+// State is the interaction state of the control that an icon is on.
+type State struct {
+	Hovered  bool
+	Pressed  bool
+	Focused  bool
+	Dragged  bool
+	Disabled bool
+	Selected bool
+}
+
+// StateLayers are the opacities of the state layer, a translucent overlay in the content
+// colour that shows a control's state. Only the strongest state is shown, in the order
+// dragged, pressed, focused and hovered.
+type StateLayers struct {
+	Hovered float32
+	Focused float32
+	Pressed float32
+	Dragged float32
+}
+
+// MaterialStateLayers are the state layer opacities of Material Design 3.
+var MaterialStateLayers = StateLayers{
+	Hovered: 0.08,
+	Focused: 0.10,
+	Pressed: 0.10,
+	Dragged: 0.16,
+}
+
+// The opacity of disabled content in Material Design 3.
+const materialDisabledOpacity = 0.38
+
+// StateStyle derives the colours of an icon and its state layer from a theme and the
+// control's State, so that every control reacts to hovering, pressing and so on in the
+// same way.
+type StateStyle struct {
+	// Color is the colour of the icon in its normal state.
+	Color color.NRGBA
+	// SelectedColor is the colour of the icon when it is selected.
+	SelectedColor color.NRGBA
+	Layers        StateLayers
+	// DisabledOpacity is the opacity of the icon when it is disabled, when it also has no
+	// state layer.
+	DisabledOpacity float32
+}
+
+// NewStateStyle returns a style with the theme's foreground colour, its contrast
+// background colour for selected icons, and Material's opacities.
+func NewStateStyle(th *material.Theme) StateStyle {
+	return StateStyle{
+		Color:           th.Fg,
+		SelectedColor:   th.ContrastBg,
+		Layers:          MaterialStateLayers,
+		DisabledOpacity: materialDisabledOpacity,
+	}
+}
+
+// IconColor returns the colour of an icon in the given state.
+func (s StateStyle) IconColor(st State) color.NRGBA {
+	c := s.Color
+	if st.Selected {
+		c = s.SelectedColor
+	}
+	if st.Disabled {
+		c.A = uint8(float32(c.A) * s.DisabledOpacity)
+	}
+	return c
+}
+
+// LayerColor returns the colour of the state layer in the given state, which is
+// transparent if the control is disabled or in no state that has a layer.
+func (s StateStyle) LayerColor(st State) color.NRGBA {
+	if st.Disabled {
+		return color.NRGBA{}
+	}
+	var opacity float32
+	switch {
+	case st.Dragged:
+		opacity = s.Layers.Dragged
+	case st.Pressed:
+		opacity = s.Layers.Pressed
+	case st.Focused:
+		opacity = s.Layers.Focused
+	case st.Hovered:
+		opacity = s.Layers.Hovered
+	}
+	c := s.IconColor(st)
+	c.A = uint8(float32(c.A) * opacity)
+	return c
+}
+
+// LayoutLayer fills the shape with the state layer, if there is one.
+func (s StateStyle) LayoutLayer(gtx layout.Context, st State, shape clip.Op) {
+	if c := s.LayerColor(st); c.A > 0 {
+		paint.FillShape(gtx.Ops, c, shape)
+	}
+}
+
+// Icon returns an IconStyle drawing the icon in its colour for the given state.
+func (s StateStyle) Icon(ic *Icon, st State) IconStyle {
+	return NewIconStyle(ic, s.IconColor(st))
+}
@@ -0,0 +1,91 @@
+package icons
+
+import (
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// IconStyle lays out an icon with a transform, opacity, padding and alignment, so that
+// rotating a chevron, mirroring an arrow or dimming a disabled icon doesn't need
+// hand-rolled ops at every call site.
+type IconStyle struct {
+	Icon  *Icon
+	Color color.NRGBA
+	// Size is the width and height of the icon itself, excluding Padding. If zero, the X
+	// minimum constraint is used as with `Icon.Layout`.
+	Size unit.Dp
+	// Rotation is a fixed clockwise rotation around the icon's center, in radians.
+	Rotation float32
+	// Spin is a continuous clockwise rotation speed, in radians per second, added on top
+	// of Rotation. While it is non-zero the icon redraws every frame.
+	Spin float32
+	// FlipH and FlipV mirror the icon horizontally and vertically about its center.
+	FlipH, FlipV bool
+	// Opacity is in the range [0, 1], where 0 is invisible. NewIconStyle sets it to 1.
+	Opacity float32
+	// Padding is the space around the icon.
+	Padding layout.Inset
+	// Alignment places the padded icon within the minimum constraints. The zero value
+	// aligns it to the top left.
+	Alignment layout.Direction
+}
+
+// NewIconStyle returns a style that draws the icon in the given colour, fully opaque and
+// without any transform.
+func NewIconStyle(ic *Icon, col color.NRGBA) IconStyle {
+	return IconStyle{Icon: ic, Color: col, Opacity: 1}
+}
+
+// Layout lays out the icon according to the style.
+func (s IconStyle) Layout(gtx layout.Context) layout.Dimensions {
+	return s.Alignment.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return s.Padding.Layout(gtx, s.layIcon)
+	})
+}
+
+func (s IconStyle) layIcon(gtx layout.Context) layout.Dimensions {
+	if s.Size > 0 {
+		gtx.Constraints.Min.X = gtx.Dp(s.Size)
+	}
+	size := iconSize(gtx)
+	gtx.Constraints = layout.Exact(size)
+
+	angle := s.Rotation
+	if s.Spin != 0 {
+		secs := float64(gtx.Now.UnixNano()) / 1e9
+		angle += float32(math.Mod(secs*float64(s.Spin), 2*math.Pi))
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	if angle != 0 || s.FlipH || s.FlipV {
+		origin := f32.Pt(float32(size.X)/2, float32(size.Y)/2)
+		af := f32.Affine2D{}
+		if s.FlipH || s.FlipV {
+			af = af.Scale(origin, f32.Pt(flipScale(s.FlipH), flipScale(s.FlipV)))
+		}
+		if angle != 0 {
+			af = af.Rotate(origin, angle)
+		}
+		defer op.Affine(af).Push(gtx.Ops).Pop()
+	}
+	if s.Opacity <= 0 {
+		return layout.Dimensions{Size: size}
+	}
+	if s.Opacity < 1 {
+		defer paint.PushOpacity(gtx.Ops, s.Opacity).Pop()
+	}
+	s.Icon.Layout(gtx, s.Color)
+	return layout.Dimensions{Size: size}
+}
+
+func flipScale(flip bool) float32 {
+	if flip {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,24 @@
+package icons
+
+import (
+	"image/color"
+	"io"
+
+	"gio.tools/icons/internal/ivgpath"
+)
+
+// SVG writes the icon as a standalone SVG document that is size pixels wide, with every
+// palette colour set to c. The document keeps the icon's view box and path geometry
+// exactly, so it can be edited in a vector editor and converted back without changing
+// the shape. Any decoding error is a `*DecodeError`.
+func (ic *Icon) SVG(w io.Writer, size int, c color.NRGBA) error {
+	pal := uniformPalette(color.RGBAModel.Convert(c).(color.RGBA))
+	d, err := ivgpath.Decode(ic.Data(), &pal)
+	if err != nil {
+		if derr := decodeAll(ic.Data()); derr != nil {
+			return derr
+		}
+		return &DecodeError{Part: PartDrawing, Err: err}
+	}
+	return ivgpath.WriteSVG(w, d, size)
+}
@@ -0,0 +1,18 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+// Package toggle contains the icons in the Toggle category, named without their category
+// prefix. Each variable is the same *icons.Icon as its counterpart in package icons.
+package toggle
+
+import "gio.tools/icons"
+
+var (
+	CheckBox              = icons.ToggleCheckBox
+	CheckBoxOutlineBlank  = icons.ToggleCheckBoxOutlineBlank
+	IndeterminateCheckBox = icons.ToggleIndeterminateCheckBox
+	RadioButtonChecked    = icons.ToggleRadioButtonChecked
+	RadioButtonUnchecked  = icons.ToggleRadioButtonUnchecked
+	Star                  = icons.ToggleStar
+	StarBorder            = icons.ToggleStarBorder
+	StarHalf              = icons.ToggleStarHalf
+)
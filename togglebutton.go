@@ -0,0 +1,142 @@
+package icons
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/widget"
+)
+
+// The default duration of a ToggleButton transition.
+const defaultToggleDuration = 200 * time.Millisecond
+
+// Transition is how a ToggleButton changes from one icon to the other.
+type Transition uint8
+
+const (
+	// TransitionCrossfade fades one icon out as the other fades in.
+	TransitionCrossfade Transition = iota
+	// TransitionRotate turns one icon out a quarter turn as the other turns in, fading
+	// between them.
+	TransitionRotate
+	// TransitionMorph morphs one icon's shape into the other's, as with Morph.
+	TransitionMorph
+	// TransitionNone swaps the icons immediately.
+	TransitionNone
+)
+
+// ToggleButton is an icon button for a boolean, such as ToggleStarBorder and ToggleStar
+// or AVPlayArrow and AVPause, that swaps between its icons with a transition. Like
+// `widget.Bool`, which it embeds, it holds the Value, and Update reports when a click
+// changes it. Setting Value directly also animates the change.
+type ToggleButton struct {
+	widget.Bool
+	// Off and On are the icons shown when Value is false and true.
+	Off, On    *Icon
+	Transition Transition
+	// Duration of a full transition. The zero value is 200ms.
+	Duration time.Duration
+	// Easing is applied to the transition. If nil, EaseInOut is used.
+	Easing Easing
+	// Padding is the space around the icon, which is still part of the button.
+	Padding layout.Inset
+
+	tr     transition // Towards On when its target is true.
+	morph  Morph
+	inited bool
+}
+
+// Layout lays out the button with its icon's size set to the X minimum constraint, in
+// the same way as `Icon.Layout`, and with its colours and a circular state layer from
+// style, using the selected colour while Value is true. The description is for assistive
+// technology, such as screen readers, and should say what the button does.
+func (b *ToggleButton) Layout(gtx layout.Context, style StateStyle, desc string) layout.Dimensions {
+	return b.Bool.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		// Bool reports the Value as selected, and the class makes it a switch.
+		semantic.Switch.Add(gtx.Ops)
+		semantic.DescriptionOp(desc).Add(gtx.Ops)
+		st := State{
+			Selected: b.Value,
+			Hovered:  b.Hovered(),
+			Pressed:  b.Pressed(),
+			Focused:  gtx.Focused(&b.Bool),
+			Disabled: !gtx.Enabled(),
+		}
+		m := op.Record(gtx.Ops)
+		dims := b.Padding.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return b.layIcon(gtx, style.IconColor(st))
+		})
+		call := m.Stop()
+		style.LayoutLayer(gtx, st, clip.Ellipse{Max: dims.Size}.Op(gtx.Ops))
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
+
+func (b *ToggleButton) duration() time.Duration {
+	if b.Duration > 0 {
+		return b.Duration
+	}
+	return defaultToggleDuration
+}
+
+func (b *ToggleButton) layIcon(gtx layout.Context, col color.NRGBA) layout.Dimensions {
+	if !b.inited {
+		// Show the initial Value without animating to it.
+		b.tr.target, b.morph.tr.target = b.Value, b.Value
+		b.inited = true
+	}
+	if b.Transition == TransitionMorph {
+		b.morph.From, b.morph.To = b.Off, b.On
+		b.morph.Duration, b.morph.Easing = b.duration(), b.Easing
+		b.morph.SetTarget(gtx, b.Value)
+		return b.morph.Layout(gtx, col)
+	}
+
+	b.tr.set(gtx, b.Value, b.duration())
+	p := b.tr.progress(gtx.Now, b.duration())
+	if b.Transition == TransitionNone {
+		p = 0
+		if b.Value {
+			p = 1
+		}
+	}
+	switch p {
+	case 0:
+		return b.Off.Layout(gtx, col)
+	case 1:
+		return b.On.Layout(gtx, col)
+	}
+	gtx.Execute(op.InvalidateCmd{})
+
+	ease := b.Easing
+	if ease == nil {
+		ease = EaseInOut
+	}
+	t := ease(p)
+	size := iconSize(gtx)
+	gtx.Constraints = layout.Exact(size)
+	center := layout.FPt(size).Mul(0.5)
+	draw := func(ic *Icon, opacity, angle float32) {
+		if angle != 0 {
+			defer op.Affine(f32.Affine2D{}.Rotate(center, angle)).Push(gtx.Ops).Pop()
+		}
+		defer paint.PushOpacity(gtx.Ops, opacity).Pop()
+		ic.Layout(gtx, col)
+	}
+	var turn float32
+	if b.Transition == TransitionRotate {
+		turn = math.Pi / 2
+	}
+	// Off turns out clockwise as On turns in from a quarter turn counterclockwise.
+	draw(b.Off, 1-t, t*turn)
+	draw(b.On, t, (t-1)*turn)
+	return layout.Dimensions{Size: size}
+}
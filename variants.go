@@ -0,0 +1,11202 @@
+// generated by go run ./cmd/gen. DO NOT EDIT
+
+package icons
+
+import "sort"
+
+// Variant is a Material Symbols style of the icons, with the same names as this package's
+// variables. Icons that the style has no source for are the filled icon instead, as
+// reported by Fallback.
+type Variant struct {
+	AVAVTimer                                   *Icon
+	AVAddToQueue                                *Icon
+	AVAirplay                                   *Icon
+	AVAlbum                                     *Icon
+	AVArtTrack                                  *Icon
+	AVBrandingWatermark                         *Icon
+	AVCallToAction                              *Icon
+	AVClosedCaption                             *Icon
+	AVEqualizer                                 *Icon
+	AVExplicit                                  *Icon
+	AVFastForward                               *Icon
+	AVFastRewind                                *Icon
+	AVFeaturedPlayList                          *Icon
+	AVFeaturedVideo                             *Icon
+	AVFiberDVR                                  *Icon
+	AVFiberManualRecord                         *Icon
+	AVFiberNew                                  *Icon
+	AVFiberPin                                  *Icon
+	AVFiberSmartRecord                          *Icon
+	AVForward10                                 *Icon
+	AVForward30                                 *Icon
+	AVForward5                                  *Icon
+	AVGames                                     *Icon
+	AVHD                                        *Icon
+	AVHearing                                   *Icon
+	AVHighQuality                               *Icon
+	AVLibraryAdd                                *Icon
+	AVLibraryBooks                              *Icon
+	AVLibraryMusic                              *Icon
+	AVLoop                                      *Icon
+	AVMic                                       *Icon
+	AVMicNone                                   *Icon
+	AVMicOff                                    *Icon
+	AVMovie                                     *Icon
+	AVMusicVideo                                *Icon
+	AVNewReleases                               *Icon
+	AVNotInterested                             *Icon
+	AVNote                                      *Icon
+	AVPause                                     *Icon
+	AVPauseCircleFilled                         *Icon
+	AVPauseCircleOutline                        *Icon
+	AVPlayArrow                                 *Icon
+	AVPlayCircleFilled                          *Icon
+	AVPlayCircleOutline                         *Icon
+	AVPlaylistAdd                               *Icon
+	AVPlaylistAddCheck                          *Icon
+	AVPlaylistPlay                              *Icon
+	AVQueue                                     *Icon
+	AVQueueMusic                                *Icon
+	AVQueuePlayNext                             *Icon
+	AVRadio                                     *Icon
+	AVRecentActors                              *Icon
+	AVRemoveFromQueue                           *Icon
+	AVRepeat                                    *Icon
+	AVRepeatOne                                 *Icon
+	AVReplay                                    *Icon
+	AVReplay10                                  *Icon
+	AVReplay30                                  *Icon
+	AVReplay5                                   *Icon
+	AVShuffle                                   *Icon
+	AVSkipNext                                  *Icon
+	AVSkipPrevious                              *Icon
+	AVSlowMotionVideo                           *Icon
+	AVSnooze                                    *Icon
+	AVSortByAlpha                               *Icon
+	AVStop                                      *Icon
+	AVSubscriptions                             *Icon
+	AVSubtitles                                 *Icon
+	AVSurroundSound                             *Icon
+	AVVideoCall                                 *Icon
+	AVVideoLabel                                *Icon
+	AVVideoLibrary                              *Icon
+	AVVideocam                                  *Icon
+	AVVideocamOff                               *Icon
+	AVVolumeDown                                *Icon
+	AVVolumeMute                                *Icon
+	AVVolumeOff                                 *Icon
+	AVVolumeUp                                  *Icon
+	AVWeb                                       *Icon
+	AVWebAsset                                  *Icon
+	Action3DRotation                            *Icon
+	ActionAccessibility                         *Icon
+	ActionAccessible                            *Icon
+	ActionAccountBalance                        *Icon
+	ActionAccountBalanceWallet                  *Icon
+	ActionAccountBox                            *Icon
+	ActionAccountCircle                         *Icon
+	ActionAddShoppingCart                       *Icon
+	ActionAlarm                                 *Icon
+	ActionAlarmAdd                              *Icon
+	ActionAlarmOff                              *Icon
+	ActionAlarmOn                               *Icon
+	ActionAllOut                                *Icon
+	ActionAndroid                               *Icon
+	ActionAnnouncement                          *Icon
+	ActionAspectRatio                           *Icon
+	ActionAssessment                            *Icon
+	ActionAssignment                            *Icon
+	ActionAssignmentInd                         *Icon
+	ActionAssignmentLate                        *Icon
+	ActionAssignmentReturn                      *Icon
+	ActionAssignmentReturned                    *Icon
+	ActionAssignmentTurnedIn                    *Icon
+	ActionAutorenew                             *Icon
+	ActionBackup                                *Icon
+	ActionBook                                  *Icon
+	ActionBookmark                              *Icon
+	ActionBookmarkBorder                        *Icon
+	ActionBugReport                             *Icon
+	ActionBuild                                 *Icon
+	ActionCached                                *Icon
+	ActionCameraEnhance                         *Icon
+	ActionCardGiftcard                          *Icon
+	ActionCardMembership                        *Icon
+	ActionCardTravel                            *Icon
+	ActionChangeHistory                         *Icon
+	ActionCheckCircle                           *Icon
+	ActionChromeReaderMode                      *Icon
+	ActionClass                                 *Icon
+	ActionCode                                  *Icon
+	ActionCompareArrows                         *Icon
+	ActionCopyright                             *Icon
+	ActionCreditCard                            *Icon
+	ActionDNS                                   *Icon
+	ActionDashboard                             *Icon
+	ActionDateRange                             *Icon
+	ActionDelete                                *Icon
+	ActionDeleteForever                         *Icon
+	ActionDescription                           *Icon
+	ActionDone                                  *Icon
+	ActionDoneAll                               *Icon
+	ActionDonutLarge                            *Icon
+	ActionDonutSmall                            *Icon
+	ActionEject                                 *Icon
+	ActionEuroSymbol                            *Icon
+	ActionEvent                                 *Icon
+	ActionEventSeat                             *Icon
+	ActionExitToApp                             *Icon
+	ActionExplore                               *Icon
+	ActionExtension                             *Icon
+	ActionFace                                  *Icon
+	ActionFavorite                              *Icon
+	ActionFavoriteBorder                        *Icon
+	ActionFeedback                              *Icon
+	ActionFindInPage                            *Icon
+	ActionFindReplace                           *Icon
+	ActionFingerprint                           *Icon
+	ActionFlightLand                            *Icon
+	ActionFlightTakeoff                         *Icon
+	ActionFlipToBack                            *Icon
+	ActionFlipToFront                           *Icon
+	ActionGIF                                   *Icon
+	ActionGTranslate                            *Icon
+	ActionGavel                                 *Icon
+	ActionGetApp                                *Icon
+	ActionGrade                                 *Icon
+	ActionGroupWork                             *Icon
+	ActionHTTP                                  *Icon
+	ActionHTTPS                                 *Icon
+	ActionHelp                                  *Icon
+	ActionHelpOutline                           *Icon
+	ActionHighlightOff                          *Icon
+	ActionHistory                               *Icon
+	ActionHome                                  *Icon
+	ActionHourglassEmpty                        *Icon
+	ActionHourglassFull                         *Icon
+	ActionImportantDevices                      *Icon
+	ActionInfo                                  *Icon
+	ActionInfoOutline                           *Icon
+	ActionInput                                 *Icon
+	ActionInvertColors                          *Icon
+	ActionLabel                                 *Icon
+	ActionLabelOutline                          *Icon
+	ActionLanguage                              *Icon
+	ActionLaunch                                *Icon
+	ActionLightbulbOutline                      *Icon
+	ActionLineStyle                             *Icon
+	ActionLineWeight                            *Icon
+	ActionList                                  *Icon
+	ActionLock                                  *Icon
+	ActionLockOpen                              *Icon
+	ActionLockOutline                           *Icon
+	ActionLoyalty                               *Icon
+	ActionMarkUnreadMailbox                     *Icon
+	ActionMotorcycle                            *Icon
+	ActionNoteAdd                               *Icon
+	ActionOfflinePin                            *Icon
+	ActionOpacity                               *Icon
+	ActionOpenInBrowser                         *Icon
+	ActionOpenInNew                             *Icon
+	ActionOpenWith                              *Icon
+	ActionPageview                              *Icon
+	ActionPanTool                               *Icon
+	ActionPayment                               *Icon
+	ActionPermCameraMic                         *Icon
+	ActionPermContactCalendar                   *Icon
+	ActionPermDataSetting                       *Icon
+	ActionPermDeviceInformation                 *Icon
+	ActionPermIdentity                          *Icon
+	ActionPermMedia                             *Icon
+	ActionPermPhoneMsg                          *Icon
+	ActionPermScanWiFi                          *Icon
+	ActionPets                                  *Icon
+	ActionPictureInPicture                      *Icon
+	ActionPictureInPictureAlt                   *Icon
+	ActionPlayForWork                           *Icon
+	ActionPolymer                               *Icon
+	ActionPowerSettingsNew                      *Icon
+	ActionPregnantWoman                         *Icon
+	ActionPrint                                 *Icon
+	ActionQueryBuilder                          *Icon
+	ActionQuestionAnswer                        *Icon
+	ActionReceipt                               *Icon
+	ActionRecordVoiceOver                       *Icon
+	ActionRedeem                                *Icon
+	ActionRemoveShoppingCart                    *Icon
+	ActionReorder                               *Icon
+	ActionReportProblem                         *Icon
+	ActionRestore                               *Icon
+	ActionRestorePage                           *Icon
+	ActionRoom                                  *Icon
+	ActionRoundedCorner                         *Icon
+	ActionRowing                                *Icon
+	ActionSchedule                              *Icon
+	ActionSearch                                *Icon
+	ActionSettings                              *Icon
+	ActionSettingsApplications                  *Icon
+	ActionSettingsBackupRestore                 *Icon
+	ActionSettingsBluetooth                     *Icon
+	ActionSettingsBrightness                    *Icon
+	ActionSettingsCell                          *Icon
+	ActionSettingsEthernet                      *Icon
+	ActionSettingsInputAntenna                  *Icon
+	ActionSettingsInputComponent                *Icon
+	ActionSettingsInputComposite                *Icon
+	ActionSettingsInputHDMI                     *Icon
+	ActionSettingsInputSVideo                   *Icon
+	ActionSettingsOverscan                      *Icon
+	ActionSettingsPhone                         *Icon
+	ActionSettingsPower                         *Icon
+	ActionSettingsRemote                        *Icon
+	ActionSettingsVoice                         *Icon
+	ActionShop                                  *Icon
+	ActionShopTwo                               *Icon
+	ActionShoppingBasket                        *Icon
+	ActionShoppingCart                          *Icon
+	ActionSpeakerNotes                          *Icon
+	ActionSpeakerNotesOff                       *Icon
+	ActionSpellcheck                            *Icon
+	ActionStarRate                              *Icon
+	ActionStars                                 *Icon
+	ActionStore                                 *Icon
+	ActionSubject                               *Icon
+	ActionSupervisorAccount                     *Icon
+	ActionSwapHoriz                             *Icon
+	ActionSwapVert                              *Icon
+	ActionSwapVerticalCircle                    *Icon
+	ActionSystemUpdateAlt                       *Icon
+	ActionTOC                                   *Icon
+	ActionTab                                   *Icon
+	ActionTabUnselected                         *Icon
+	ActionTheaters                              *Icon
+	ActionThumbDown                             *Icon
+	ActionThumbUp                               *Icon
+	ActionThumbsUpDown                          *Icon
+	ActionTimeline                              *Icon
+	ActionToday                                 *Icon
+	ActionToll                                  *Icon
+	ActionTouchApp                              *Icon
+	ActionTrackChanges                          *Icon
+	ActionTranslate                             *Icon
+	ActionTrendingDown                          *Icon
+	ActionTrendingFlat                          *Icon
+	ActionTrendingUp                            *Icon
+	ActionTurnedIn                              *Icon
+	ActionTurnedInNot                           *Icon
+	ActionUpdate                                *Icon
+	ActionVerifiedUser                          *Icon
+	ActionViewAgenda                            *Icon
+	ActionViewArray                             *Icon
+	ActionViewCarousel                          *Icon
+	ActionViewColumn                            *Icon
+	ActionViewDay                               *Icon
+	ActionViewHeadline                          *Icon
+	ActionViewList                              *Icon
+	ActionViewModule                            *Icon
+	ActionViewQuilt                             *Icon
+	ActionViewStream                            *Icon
+	ActionViewWeek                              *Icon
+	ActionVisibility                            *Icon
+	ActionVisibilityOff                         *Icon
+	ActionWatchLater                            *Icon
+	ActionWork                                  *Icon
+	ActionYoutubeSearchedFor                    *Icon
+	ActionZoomIn                                *Icon
+	ActionZoomOut                               *Icon
+	AlertAddAlert                               *Icon
+	AlertError                                  *Icon
+	AlertErrorOutline                           *Icon
+	AlertWarning                                *Icon
+	CommunicationBusiness                       *Icon
+	CommunicationCall                           *Icon
+	CommunicationCallEnd                        *Icon
+	CommunicationCallMade                       *Icon
+	CommunicationCallMerge                      *Icon
+	CommunicationCallMissed                     *Icon
+	CommunicationCallMissedOutgoing             *Icon
+	CommunicationCallReceived                   *Icon
+	CommunicationCallSplit                      *Icon
+	CommunicationChat                           *Icon
+	CommunicationChatBubble                     *Icon
+	CommunicationChatBubbleOutline              *Icon
+	CommunicationClearAll                       *Icon
+	CommunicationComment                        *Icon
+	CommunicationContactMail                    *Icon
+	CommunicationContactPhone                   *Icon
+	CommunicationContacts                       *Icon
+	CommunicationDialerSIP                      *Icon
+	CommunicationDialpad                        *Icon
+	CommunicationEmail                          *Icon
+	CommunicationForum                          *Icon
+	CommunicationImportContacts                 *Icon
+	CommunicationImportExport                   *Icon
+	CommunicationInvertColorsOff                *Icon
+	CommunicationLiveHelp                       *Icon
+	CommunicationLocationOff                    *Icon
+	CommunicationLocationOn                     *Icon
+	CommunicationMailOutline                    *Icon
+	CommunicationMessage                        *Icon
+	CommunicationNoSIM                          *Icon
+	CommunicationPhone                          *Icon
+	CommunicationPhoneLinkErase                 *Icon
+	CommunicationPhoneLinkLock                  *Icon
+	CommunicationPhoneLinkRing                  *Icon
+	CommunicationPhoneLinkSetup                 *Icon
+	CommunicationPortableWiFiOff                *Icon
+	CommunicationPresentToAll                   *Icon
+	CommunicationRSSFeed                        *Icon
+	CommunicationRingVolume                     *Icon
+	CommunicationScreenShare                    *Icon
+	CommunicationSpeakerPhone                   *Icon
+	CommunicationStayCurrentLandscape           *Icon
+	CommunicationStayCurrentPortrait            *Icon
+	CommunicationStayPrimaryLandscape           *Icon
+	CommunicationStayPrimaryPortrait            *Icon
+	CommunicationStopScreenShare                *Icon
+	CommunicationSwapCalls                      *Icon
+	CommunicationTextSMS                        *Icon
+	CommunicationVPNKey                         *Icon
+	CommunicationVoicemail                      *Icon
+	ContentAdd                                  *Icon
+	ContentAddBox                               *Icon
+	ContentAddCircle                            *Icon
+	ContentAddCircleOutline                     *Icon
+	ContentArchive                              *Icon
+	ContentBackspace                            *Icon
+	ContentBlock                                *Icon
+	ContentClear                                *Icon
+	ContentContentCopy                          *Icon
+	ContentContentCut                           *Icon
+	ContentContentPaste                         *Icon
+	ContentCreate                               *Icon
+	ContentDeleteSweep                          *Icon
+	ContentDrafts                               *Icon
+	ContentFilterList                           *Icon
+	ContentFlag                                 *Icon
+	ContentFontDownload                         *Icon
+	ContentForward                              *Icon
+	ContentGesture                              *Icon
+	ContentInbox                                *Icon
+	ContentLink                                 *Icon
+	ContentLowPriority                          *Icon
+	ContentMail                                 *Icon
+	ContentMarkUnread                           *Icon
+	ContentMoveToInbox                          *Icon
+	ContentNextWeek                             *Icon
+	ContentRedo                                 *Icon
+	ContentRemove                               *Icon
+	ContentRemoveCircle                         *Icon
+	ContentRemoveCircleOutline                  *Icon
+	ContentReply                                *Icon
+	ContentReplyAll                             *Icon
+	ContentReport                               *Icon
+	ContentSave                                 *Icon
+	ContentSelectAll                            *Icon
+	ContentSend                                 *Icon
+	ContentSort                                 *Icon
+	ContentTextFormat                           *Icon
+	ContentUnarchive                            *Icon
+	ContentUndo                                 *Icon
+	ContentWeekend                              *Icon
+	DeviceAccessAlarm                           *Icon
+	DeviceAccessAlarms                          *Icon
+	DeviceAccessTime                            *Icon
+	DeviceAddAlarm                              *Icon
+	DeviceAirplaneModeActive                    *Icon
+	DeviceAirplaneModeInactive                  *Icon
+	DeviceBattery20                             *Icon
+	DeviceBattery30                             *Icon
+	DeviceBattery50                             *Icon
+	DeviceBattery60                             *Icon
+	DeviceBattery80                             *Icon
+	DeviceBattery90                             *Icon
+	DeviceBatteryAlert                          *Icon
+	DeviceBatteryCharging20                     *Icon
+	DeviceBatteryCharging30                     *Icon
+	DeviceBatteryCharging50                     *Icon
+	DeviceBatteryCharging60                     *Icon
+	DeviceBatteryCharging80                     *Icon
+	DeviceBatteryCharging90                     *Icon
+	DeviceBatteryChargingFull                   *Icon
+	DeviceBatteryFull                           *Icon
+	DeviceBatteryStd                            *Icon
+	DeviceBatteryUnknown                        *Icon
+	DeviceBluetooth                             *Icon
+	DeviceBluetoothConnected                    *Icon
+	DeviceBluetoothDisabled                     *Icon
+	DeviceBluetoothSearching                    *Icon
+	DeviceBrightnessAuto                        *Icon
+	DeviceBrightnessHigh                        *Icon
+	DeviceBrightnessLow                         *Icon
+	DeviceBrightnessMedium                      *Icon
+	DeviceDVR                                   *Icon
+	DeviceDataUsage                             *Icon
+	DeviceDeveloperMode                         *Icon
+	DeviceDevices                               *Icon
+	DeviceGPSFixed                              *Icon
+	DeviceGPSNotFixed                           *Icon
+	DeviceGPSOff                                *Icon
+	DeviceGraphicEq                             *Icon
+	DeviceLocationDisabled                      *Icon
+	DeviceLocationSearching                     *Icon
+	DeviceNFC                                   *Icon
+	DeviceNetworkCell                           *Icon
+	DeviceNetworkWiFi                           *Icon
+	DeviceSDStorage                             *Icon
+	DeviceScreenLockLandscape                   *Icon
+	DeviceScreenLockPortrait                    *Icon
+	DeviceScreenLockRotation                    *Icon
+	DeviceScreenRotation                        *Icon
+	DeviceSettingsSystemDaydream                *Icon
+	DeviceSignalCellular0Bar                    *Icon
+	DeviceSignalCellular1Bar                    *Icon
+	DeviceSignalCellular2Bar                    *Icon
+	DeviceSignalCellular3Bar                    *Icon
+	DeviceSignalCellular4Bar                    *Icon
+	DeviceSignalCellularConnectedNoInternet0Bar *Icon
+	DeviceSignalCellularConnectedNoInternet1Bar *Icon
+	DeviceSignalCellularConnectedNoInternet2Bar *Icon
+	DeviceSignalCellularConnectedNoInternet3Bar *Icon
+	DeviceSignalCellularConnectedNoInternet4Bar *Icon
+	DeviceSignalCellularNoSIM                   *Icon
+	DeviceSignalCellularNull                    *Icon
+	DeviceSignalCellularOff                     *Icon
+	DeviceSignalWiFi0Bar                        *Icon
+	DeviceSignalWiFi1Bar                        *Icon
+	DeviceSignalWiFi1BarLock                    *Icon
+	DeviceSignalWiFi2Bar                        *Icon
+	DeviceSignalWiFi2BarLock                    *Icon
+	DeviceSignalWiFi3Bar                        *Icon
+	DeviceSignalWiFi3BarLock                    *Icon
+	DeviceSignalWiFi4Bar                        *Icon
+	DeviceSignalWiFi4BarLock                    *Icon
+	DeviceSignalWiFiOff                         *Icon
+	DeviceStorage                               *Icon
+	DeviceUSB                                   *Icon
+	DeviceWallpaper                             *Icon
+	DeviceWiFiLock                              *Icon
+	DeviceWiFiTethering                         *Icon
+	DeviceWidgets                               *Icon
+	EditorAttachFile                            *Icon
+	EditorAttachMoney                           *Icon
+	EditorBorderAll                             *Icon
+	EditorBorderBottom                          *Icon
+	EditorBorderClear                           *Icon
+	EditorBorderColor                           *Icon
+	EditorBorderHorizontal                      *Icon
+	EditorBorderInner                           *Icon
+	EditorBorderLeft                            *Icon
+	EditorBorderOuter                           *Icon
+	EditorBorderRight                           *Icon
+	EditorBorderStyle                           *Icon
+	EditorBorderTop                             *Icon
+	EditorBorderVertical                        *Icon
+	EditorBubbleChart                           *Icon
+	EditorDragHandle                            *Icon
+	EditorFormatAlignCenter                     *Icon
+	EditorFormatAlignJustify                    *Icon
+	EditorFormatAlignLeft                       *Icon
+	EditorFormatAlignRight                      *Icon
+	EditorFormatBold                            *Icon
+	EditorFormatClear                           *Icon
+	EditorFormatColorFill                       *Icon
+	EditorFormatColorReset                      *Icon
+	EditorFormatColorText                       *Icon
+	EditorFormatIndentDecrease                  *Icon
+	EditorFormatIndentIncrease                  *Icon
+	EditorFormatItalic                          *Icon
+	EditorFormatLineSpacing                     *Icon
+	EditorFormatListBulleted                    *Icon
+	EditorFormatListNumbered                    *Icon
+	EditorFormatPaint                           *Icon
+	EditorFormatQuote                           *Icon
+	EditorFormatShapes                          *Icon
+	EditorFormatSize                            *Icon
+	EditorFormatStrikethrough                   *Icon
+	EditorFormatTextDirectionLToR               *Icon
+	EditorFormatTextDirectionRToL               *Icon
+	EditorFormatUnderlined                      *Icon
+	EditorFunctions                             *Icon
+	EditorHighlight                             *Icon
+	EditorInsertChart                           *Icon
+	EditorInsertComment                         *Icon
+	EditorInsertDriveFile                       *Icon
+	EditorInsertEmoticon                        *Icon
+	EditorInsertInvitation                      *Icon
+	EditorInsertLink                            *Icon
+	EditorInsertPhoto                           *Icon
+	EditorLinearScale                           *Icon
+	EditorMergeType                             *Icon
+	EditorModeComment                           *Icon
+	EditorModeEdit                              *Icon
+	EditorMonetizationOn                        *Icon
+	EditorMoneyOff                              *Icon
+	EditorMultilineChart                        *Icon
+	EditorPieChart                              *Icon
+	EditorPieChartOutlined                      *Icon
+	EditorPublish                               *Icon
+	EditorShortText                             *Icon
+	EditorShowChart                             *Icon
+	EditorSpaceBar                              *Icon
+	EditorStrikethroughS                        *Icon
+	EditorTextFields                            *Icon
+	EditorTitle                                 *Icon
+	EditorVerticalAlignBottom                   *Icon
+	EditorVerticalAlignCenter                   *Icon
+	EditorVerticalAlignTop                      *Icon
+	EditorWrapText                              *Icon
+	FileAttachment                              *Icon
+	FileCloud                                   *Icon
+	FileCloudCircle                             *Icon
+	FileCloudDone                               *Icon
+	FileCloudDownload                           *Icon
+	FileCloudOff                                *Icon
+	FileCloudQueue                              *Icon
+	FileCloudUpload                             *Icon
+	FileCreateNewFolder                         *Icon
+	FileFileDownload                            *Icon
+	FileFileUpload                              *Icon
+	FileFolder                                  *Icon
+	FileFolderOpen                              *Icon
+	FileFolderShared                            *Icon
+	HardwareCast                                *Icon
+	HardwareCastConnected                       *Icon
+	HardwareComputer                            *Icon
+	HardwareDesktopMac                          *Icon
+	HardwareDesktopWindows                      *Icon
+	HardwareDeveloperBoard                      *Icon
+	HardwareDeviceHub                           *Icon
+	HardwareDevicesOther                        *Icon
+	HardwareDock                                *Icon
+	HardwareGamepad                             *Icon
+	HardwareHeadset                             *Icon
+	HardwareHeadsetMic                          *Icon
+	HardwareKeyboard                            *Icon
+	HardwareKeyboardArrowDown                   *Icon
+	HardwareKeyboardArrowLeft                   *Icon
+	HardwareKeyboardArrowRight                  *Icon
+	HardwareKeyboardArrowUp                     *Icon
+	HardwareKeyboardBackspace                   *Icon
+	HardwareKeyboardCapslock                    *Icon
+	HardwareKeyboardHide                        *Icon
+	HardwareKeyboardReturn                      *Icon
+	HardwareKeyboardTab                         *Icon
+	HardwareKeyboardVoice                       *Icon
+	HardwareLaptop                              *Icon
+	HardwareLaptopChromebook                    *Icon
+	HardwareLaptopMac                           *Icon
+	HardwareLaptopWindows                       *Icon
+	HardwareMemory                              *Icon
+	HardwareMouse                               *Icon
+	HardwarePhoneAndroid                        *Icon
+	HardwarePhoneIPhone                         *Icon
+	HardwarePhoneLink                           *Icon
+	HardwarePhoneLinkOff                        *Icon
+	HardwarePowerInput                          *Icon
+	HardwareRouter                              *Icon
+	HardwareSIMCard                             *Icon
+	HardwareScanner                             *Icon
+	HardwareSecurity                            *Icon
+	HardwareSmartphone                          *Icon
+	HardwareSpeaker                             *Icon
+	HardwareSpeakerGroup                        *Icon
+	HardwareTV                                  *Icon
+	HardwareTablet                              *Icon
+	HardwareTabletAndroid                       *Icon
+	HardwareTabletMac                           *Icon
+	HardwareToys                                *Icon
+	HardwareVideogameAsset                      *Icon
+	HardwareWatch                               *Icon
+	ImageAddAPhoto                              *Icon
+	ImageAddToPhotos                            *Icon
+	ImageAdjust                                 *Icon
+	ImageAssistant                              *Icon
+	ImageAssistantPhoto                         *Icon
+	ImageAudiotrack                             *Icon
+	ImageBlurCircular                           *Icon
+	ImageBlurLinear                             *Icon
+	ImageBlurOff                                *Icon
+	ImageBlurOn                                 *Icon
+	ImageBrightness1                            *Icon
+	ImageBrightness2                            *Icon
+	ImageBrightness3                            *Icon
+	ImageBrightness4                            *Icon
+	ImageBrightness5                            *Icon
+	ImageBrightness6                            *Icon
+	ImageBrightness7                            *Icon
+	ImageBrokenImage                            *Icon
+	ImageBrush                                  *Icon
+	ImageBurstMode                              *Icon
+	ImageCamera                                 *Icon
+	ImageCameraAlt                              *Icon
+	ImageCameraFront                            *Icon
+	ImageCameraRear                             *Icon
+	ImageCameraRoll                             *Icon
+	ImageCenterFocusStrong                      *Icon
+	ImageCenterFocusWeak                        *Icon
+	ImageCollections                            *Icon
+	ImageCollectionsBookmark                    *Icon
+	ImageColorLens                              *Icon
+	ImageColorize                               *Icon
+	ImageCompare                                *Icon
+	ImageControlPoint                           *Icon
+	ImageControlPointDuplicate                  *Icon
+	ImageCrop                                   *Icon
+	ImageCrop169                                *Icon
+	ImageCrop32                                 *Icon
+	ImageCrop54                                 *Icon
+	ImageCrop75                                 *Icon
+	ImageCropDIN                                *Icon
+	ImageCropFree                               *Icon
+	ImageCropLandscape                          *Icon
+	ImageCropOriginal                           *Icon
+	ImageCropPortrait                           *Icon
+	ImageCropRotate                             *Icon
+	ImageCropSquare                             *Icon
+	ImageDehaze                                 *Icon
+	ImageDetails                                *Icon
+	ImageEdit                                   *Icon
+	ImageExposure                               *Icon
+	ImageExposureNeg1                           *Icon
+	ImageExposureNeg2                           *Icon
+	ImageExposurePlus1                          *Icon
+	ImageExposurePlus2                          *Icon
+	ImageExposureZero                           *Icon
+	ImageFilter                                 *Icon
+	ImageFilter1                                *Icon
+	ImageFilter2                                *Icon
+	ImageFilter3                                *Icon
+	ImageFilter4                                *Icon
+	ImageFilter5                                *Icon
+	ImageFilter6                                *Icon
+	ImageFilter7                                *Icon
+	ImageFilter8                                *Icon
+	ImageFilter9                                *Icon
+	ImageFilter9Plus                            *Icon
+	ImageFilterBAndW                            *Icon
+	ImageFilterCenterFocus                      *Icon
+	ImageFilterDrama                            *Icon
+	ImageFilterFrames                           *Icon
+	ImageFilterHDR                              *Icon
+	ImageFilterNone                             *Icon
+	ImageFilterTiltShift                        *Icon
+	ImageFilterVintage                          *Icon
+	ImageFlare                                  *Icon
+	ImageFlashAuto                              *Icon
+	ImageFlashOff                               *Icon
+	ImageFlashOn                                *Icon
+	ImageFlip                                   *Icon
+	ImageGradient                               *Icon
+	ImageGrain                                  *Icon
+	ImageGridOff                                *Icon
+	ImageGridOn                                 *Icon
+	ImageHDROff                                 *Icon
+	ImageHDROn                                  *Icon
+	ImageHDRStrong                              *Icon
+	ImageHDRWeak                                *Icon
+	ImageHealing                                *Icon
+	ImageISO                                    *Icon
+	ImageImage                                  *Icon
+	ImageImageAspectRatio                       *Icon
+	ImageLandscape                              *Icon
+	ImageLeakAdd                                *Icon
+	ImageLeakRemove                             *Icon
+	ImageLens                                   *Icon
+	ImageLinkedCamera                           *Icon
+	ImageLooks                                  *Icon
+	ImageLooks3                                 *Icon
+	ImageLooks4                                 *Icon
+	ImageLooks5                                 *Icon
+	ImageLooks6                                 *Icon
+	ImageLooksOne                               *Icon
+	ImageLooksTwo                               *Icon
+	ImageLoupe                                  *Icon
+	ImageMonochromePhotos                       *Icon
+	ImageMovieCreation                          *Icon
+	ImageMovieFilter                            *Icon
+	ImageMusicNote                              *Icon
+	ImageNature                                 *Icon
+	ImageNaturePeople                           *Icon
+	ImageNavigateBefore                         *Icon
+	ImageNavigateNext                           *Icon
+	ImagePalette                                *Icon
+	ImagePanorama                               *Icon
+	ImagePanoramaFishEye                        *Icon
+	ImagePanoramaHorizontal                     *Icon
+	ImagePanoramaVertical                       *Icon
+	ImagePanoramaWideAngle                      *Icon
+	ImagePhoto                                  *Icon
+	ImagePhotoAlbum                             *Icon
+	ImagePhotoCamera                            *Icon
+	ImagePhotoFilter                            *Icon
+	ImagePhotoLibrary                           *Icon
+	ImagePhotoSizeSelectActual                  *Icon
+	ImagePhotoSizeSelectLarge                   *Icon
+	ImagePhotoSizeSelectSmall                   *Icon
+	ImagePictureAsPDF                           *Icon
+	ImagePortrait                               *Icon
+	ImageRemoveRedEye                           *Icon
+	ImageRotate90DegreesCCW                     *Icon
+	ImageRotateLeft                             *Icon
+	ImageRotateRight                            *Icon
+	ImageSlideshow                              *Icon
+	ImageStraighten                             *Icon
+	ImageStyle                                  *Icon
+	ImageSwitchCamera                           *Icon
+	ImageSwitchVideo                            *Icon
+	ImageTagFaces                               *Icon
+	ImageTexture                                *Icon
+	ImageTimeLapse                              *Icon
+	ImageTimer                                  *Icon
+	ImageTimer10                                *Icon
+	ImageTimer3                                 *Icon
+	ImageTimerOff                               *Icon
+	ImageTonality                               *Icon
+	ImageTransform                              *Icon
+	ImageTune                                   *Icon
+	ImageViewComfy                              *Icon
+	ImageViewCompact                            *Icon
+	ImageVignette                               *Icon
+	ImageWBAuto                                 *Icon
+	ImageWBCloudy                               *Icon
+	ImageWBIncandescent                         *Icon
+	ImageWBIridescent                           *Icon
+	ImageWBSunny                                *Icon
+	MapsAddLocation                             *Icon
+	MapsBeenhere                                *Icon
+	MapsDirections                              *Icon
+	MapsDirectionsBike                          *Icon
+	MapsDirectionsBoat                          *Icon
+	MapsDirectionsBus                           *Icon
+	MapsDirectionsCar                           *Icon
+	MapsDirectionsRailway                       *Icon
+	MapsDirectionsRun                           *Icon
+	MapsDirectionsSubway                        *Icon
+	MapsDirectionsTransit                       *Icon
+	MapsDirectionsWalk                          *Icon
+	MapsEVStation                               *Icon
+	MapsEditLocation                            *Icon
+	MapsFlight                                  *Icon
+	MapsHotel                                   *Icon
+	MapsLayers                                  *Icon
+	MapsLayersClear                             *Icon
+	MapsLocalATM                                *Icon
+	MapsLocalActivity                           *Icon
+	MapsLocalAirport                            *Icon
+	MapsLocalBar                                *Icon
+	MapsLocalCafe                               *Icon
+	MapsLocalCarWash                            *Icon
+	MapsLocalConvenienceStore                   *Icon
+	MapsLocalDining                             *Icon
+	MapsLocalDrink                              *Icon
+	MapsLocalFlorist                            *Icon
+	MapsLocalGasStation                         *Icon
+	MapsLocalGroceryStore                       *Icon
+	MapsLocalHospital                           *Icon
+	MapsLocalHotel                              *Icon
+	MapsLocalLaundryService                     *Icon
+	MapsLocalLibrary                            *Icon
+	MapsLocalMall                               *Icon
+	MapsLocalMovies                             *Icon
+	MapsLocalOffer                              *Icon
+	MapsLocalParking                            *Icon
+	MapsLocalPharmacy                           *Icon
+	MapsLocalPhone                              *Icon
+	MapsLocalPizza                              *Icon
+	MapsLocalPlay                               *Icon
+	MapsLocalPostOffice                         *Icon
+	MapsLocalPrintshop                          *Icon
+	MapsLocalSee                                *Icon
+	MapsLocalShipping                           *Icon
+	MapsLocalTaxi                               *Icon
+	MapsMap                                     *Icon
+	MapsMyLocation                              *Icon
+	MapsNavigation                              *Icon
+	MapsNearMe                                  *Icon
+	MapsPersonPin                               *Icon
+	MapsPersonPinCircle                         *Icon
+	MapsPinDrop                                 *Icon
+	MapsPlace                                   *Icon
+	MapsRateReview                              *Icon
+	MapsRestaurant                              *Icon
+	MapsRestaurantMenu                          *Icon
+	MapsSatellite                               *Icon
+	MapsStoreMallDirectory                      *Icon
+	MapsStreetView                              *Icon
+	MapsSubway                                  *Icon
+	MapsTerrain                                 *Icon
+	MapsTraffic                                 *Icon
+	MapsTrain                                   *Icon
+	MapsTram                                    *Icon
+	MapsTransferWithinAStation                  *Icon
+	MapsZoomOutMap                              *Icon
+	NavigationApps                              *Icon
+	NavigationArrowBack                         *Icon
+	NavigationArrowDownward                     *Icon
+	NavigationArrowDropDown                     *Icon
+	NavigationArrowDropDownCircle               *Icon
+	NavigationArrowDropUp                       *Icon
+	NavigationArrowForward                      *Icon
+	NavigationArrowUpward                       *Icon
+	NavigationCancel                            *Icon
+	NavigationCheck                             *Icon
+	NavigationChevronLeft                       *Icon
+	NavigationChevronRight                      *Icon
+	NavigationClose                             *Icon
+	NavigationExpandLess                        *Icon
+	NavigationExpandMore                        *Icon
+	NavigationFirstPage                         *Icon
+	NavigationFullscreen                        *Icon
+	NavigationFullscreenExit                    *Icon
+	NavigationLastPage                          *Icon
+	NavigationMenu                              *Icon
+	NavigationMoreHoriz                         *Icon
+	NavigationMoreVert                          *Icon
+	NavigationRefresh                           *Icon
+	NavigationSubdirectoryArrowLeft             *Icon
+	NavigationSubdirectoryArrowRight            *Icon
+	NavigationUnfoldLess                        *Icon
+	NavigationUnfoldMore                        *Icon
+	NotificationADB                             *Icon
+	NotificationAirlineSeatFlat                 *Icon
+	NotificationAirlineSeatFlatAngled           *Icon
+	NotificationAirlineSeatIndividualSuite      *Icon
+	NotificationAirlineSeatLegroomExtra         *Icon
+	NotificationAirlineSeatLegroomNormal        *Icon
+	NotificationAirlineSeatLegroomReduced       *Icon
+	NotificationAirlineSeatReclineExtra         *Icon
+	NotificationAirlineSeatReclineNormal        *Icon
+	NotificationBluetoothAudio                  *Icon
+	NotificationConfirmationNumber              *Icon
+	NotificationDiscFull                        *Icon
+	NotificationDoNotDisturb                    *Icon
+	NotificationDoNotDisturbAlt                 *Icon
+	NotificationDoNotDisturbOff                 *Icon
+	NotificationDoNotDisturbOn                  *Icon
+	NotificationDriveETA                        *Icon
+	NotificationEnhancedEncryption              *Icon
+	NotificationEventAvailable                  *Icon
+	NotificationEventBusy                       *Icon
+	NotificationEventNote                       *Icon
+	NotificationFolderSpecial                   *Icon
+	NotificationLiveTV                          *Icon
+	NotificationMMS                             *Icon
+	NotificationMore                            *Icon
+	NotificationNetworkCheck                    *Icon
+	NotificationNetworkLocked                   *Icon
+	NotificationNoEncryption                    *Icon
+	NotificationOnDemandVideo                   *Icon
+	NotificationPersonalVideo                   *Icon
+	NotificationPhoneBluetoothSpeaker           *Icon
+	NotificationPhoneForwarded                  *Icon
+	NotificationPhoneInTalk                     *Icon
+	NotificationPhoneLocked                     *Icon
+	NotificationPhoneMissed                     *Icon
+	NotificationPhonePaused                     *Icon
+	NotificationPower                           *Icon
+	NotificationPriorityHigh                    *Icon
+	NotificationRVHookup                        *Icon
+	NotificationSDCard                          *Icon
+	NotificationSIMCardAlert                    *Icon
+	NotificationSMS                             *Icon
+	NotificationSMSFailed                       *Icon
+	NotificationSync                            *Icon
+	NotificationSyncDisabled                    *Icon
+	NotificationSyncProblem                     *Icon
+	NotificationSystemUpdate                    *Icon
+	NotificationTapAndPlay                      *Icon
+	NotificationTimeToLeave                     *Icon
+	NotificationVPNLock                         *Icon
+	NotificationVibration                       *Icon
+	NotificationVoiceChat                       *Icon
+	NotificationWC                              *Icon
+	NotificationWiFi                            *Icon
+	PlacesACUnit                                *Icon
+	PlacesAirportShuttle                        *Icon
+	PlacesAllInclusive                          *Icon
+	PlacesBeachAccess                           *Icon
+	PlacesBusinessCenter                        *Icon
+	PlacesCasino                                *Icon
+	PlacesChildCare                             *Icon
+	PlacesChildFriendly                         *Icon
+	PlacesFitnessCenter                         *Icon
+	PlacesFreeBreakfast                         *Icon
+	PlacesGolfCourse                            *Icon
+	PlacesHotTub                                *Icon
+	PlacesKitchen                               *Icon
+	PlacesPool                                  *Icon
+	PlacesRVHookup                              *Icon
+	PlacesRoomService                           *Icon
+	PlacesSmokeFree                             *Icon
+	PlacesSmokingRooms                          *Icon
+	PlacesSpa                                   *Icon
+	SocialCake                                  *Icon
+	SocialDomain                                *Icon
+	SocialGroup                                 *Icon
+	SocialGroupAdd                              *Icon
+	SocialLocationCity                          *Icon
+	SocialMood                                  *Icon
+	SocialMoodBad                               *Icon
+	SocialNotifications                         *Icon
+	SocialNotificationsActive                   *Icon
+	SocialNotificationsNone                     *Icon
+	SocialNotificationsOff                      *Icon
+	SocialNotificationsPaused                   *Icon
+	SocialPages                                 *Icon
+	SocialPartyMode                             *Icon
+	SocialPeople                                *Icon
+	SocialPeopleOutline                         *Icon
+	SocialPerson                                *Icon
+	SocialPersonAdd                             *Icon
+	SocialPersonOutline                         *Icon
+	SocialPlusOne                               *Icon
+	SocialPoll                                  *Icon
+	SocialPublic                                *Icon
+	SocialSchool                                *Icon
+	SocialSentimentDissatisfied                 *Icon
+	SocialSentimentNeutral                      *Icon
+	SocialSentimentSatisfied                    *Icon
+	SocialSentimentVeryDissatisfied             *Icon
+	SocialSentimentVerySatisfied                *Icon
+	SocialShare                                 *Icon
+	SocialWhatsHot                              *Icon
+	ToggleCheckBox                              *Icon
+	ToggleCheckBoxOutlineBlank                  *Icon
+	ToggleIndeterminateCheckBox                 *Icon
+	ToggleRadioButtonChecked                    *Icon
+	ToggleRadioButtonUnchecked                  *Icon
+	ToggleStar                                  *Icon
+	ToggleStarBorder                            *Icon
+	ToggleStarHalf                              *Icon
+
+	fallbacks []string // The sorted names of the icons that have no source.
+}
+
+// The Material Symbols styles that sources were vendored for.
+var (
+	Outlined = Variant{
+		AVAVTimer: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x64, 0x76, 0xb0, 0xa9, 0x76, 0x1d,
+			0x96, 0x4d, 0x72, 0x39, 0x94, 0x0d, 0x6f, 0xf5, 0x90, 0xc9, 0x6b, 0xb5, 0x8d, 0xe5, 0x69, 0x59,
+			0x89, 0x50, 0x8a, 0x50, 0x80, 0x50, 0x31, 0x7d, 0xad, 0x68, 0x89, 0x7a, 0x40, 0x99, 0x6a, 0x71,
+			0x75, 0x60, 0xdd, 0x6b, 0x66, 0xa5, 0x6d, 0xe9, 0x70, 0x40, 0xad, 0x71, 0x25, 0x6d, 0x02, 0x89,
+			0x83, 0x7e, 0xbd, 0x80, 0xdd, 0x81, 0x69, 0x71, 0x89, 0x72, 0x60, 0xcd, 0x6e, 0x55, 0x75, 0x69,
+			0x6d, 0xc5, 0x78, 0x40, 0x58, 0x80, 0x60, 0x58, 0x55, 0x88, 0xd5, 0x71, 0x2d, 0x8e, 0x40, 0x80,
+			0xa8, 0x60, 0x55, 0x88, 0xa8, 0x2d, 0x8e, 0x2d, 0x8e, 0x40, 0xa8, 0x80, 0x60, 0xa8, 0x25, 0x78,
+			0xbd, 0x8e, 0x5d, 0x72, 0x40, 0x99, 0x81, 0x11, 0x6c, 0xe8, 0xbd, 0x72, 0xe6, 0x99, 0x7d, 0xe8,
+			0x50, 0xe6, 0xdd, 0x7f, 0x60, 0xd9, 0x84, 0x50, 0x41, 0x89, 0xe9, 0x69, 0x40, 0xf1, 0x90, 0x09,
+			0x6f, 0x60, 0x35, 0x94, 0x45, 0x72, 0x19, 0x96, 0xa5, 0x76, 0x40, 0xb0, 0x80, 0x63, 0xb0, 0x8a,
+			0x1d, 0x96, 0x59, 0x89, 0x39, 0x94, 0xb5, 0x8d, 0xf5, 0x90, 0xf5, 0x90, 0xb5, 0x8d, 0x39, 0x94,
+			0x59, 0x89, 0x1d, 0x96, 0x8a, 0xb0, 0x80, 0xb0, 0xe2, 0xe1, 0x7f, 0x25, 0x91, 0x67, 0xcd, 0x7e,
+			0x25, 0x91, 0x11, 0x7e, 0x69, 0x90, 0x55, 0x7d, 0xb1, 0x8f, 0x55, 0x7d, 0x9d, 0x8e, 0x55, 0x7d,
+			0x89, 0x8d, 0x11, 0x7e, 0xcd, 0x8c, 0xc9, 0x7e, 0x11, 0x8c, 0xdd, 0x7f, 0x11, 0x8c, 0xf1, 0x80,
+			0x11, 0x8c, 0xad, 0x81, 0xcd, 0x8c, 0x69, 0x82, 0x85, 0x8d, 0x69, 0x82, 0x99, 0x8e, 0x69, 0x82,
+			0xad, 0x8f, 0xad, 0x81, 0x69, 0x90, 0xf5, 0x80, 0x25, 0x91, 0xe1, 0x7f, 0x25, 0x91, 0xe2, 0x9d,
+			0x8e, 0x69, 0x82, 0x67, 0x89, 0x8d, 0x69, 0x82, 0xcd, 0x8c, 0xad, 0x81, 0x11, 0x8c, 0xf5, 0x80,
+			0x11, 0x8c, 0xe1, 0x7f, 0x11, 0x8c, 0xcd, 0x7e, 0xcd, 0x8c, 0x11, 0x7e, 0x85, 0x8d, 0x55, 0x7d,
+			0x99, 0x8e, 0x55, 0x7d, 0xad, 0x8f, 0x55, 0x7d, 0x69, 0x90, 0x11, 0x7e, 0x25, 0x91, 0xc9, 0x7e,
+			0x25, 0x91, 0xdd, 0x7f, 0x25, 0x91, 0xf1, 0x80, 0x69, 0x90, 0xad, 0x81, 0xb1, 0x8f, 0x69, 0x82,
+			0x9d, 0x8e, 0x69, 0x82, 0xe2, 0x59, 0x71, 0x69, 0x82, 0x67, 0x45, 0x70, 0x69, 0x82, 0x89, 0x6f,
+			0xad, 0x81, 0xcd, 0x6e, 0xf5, 0x80, 0xcd, 0x6e, 0xe1, 0x7f, 0xcd, 0x6e, 0xcd, 0x7e, 0x89, 0x6f,
+			0x11, 0x7e, 0x41, 0x70, 0x55, 0x7d, 0x55, 0x71, 0x55, 0x7d, 0x69, 0x72, 0x55, 0x7d, 0x25, 0x73,
+			0x11, 0x7e, 0xdd, 0x73, 0xc9, 0x7e, 0xdd, 0x73, 0xdd, 0x7f, 0xdd, 0x73, 0xf1, 0x80, 0x25, 0x73,
+			0xad, 0x81, 0x6d, 0x72, 0x69, 0x82, 0x59, 0x71, 0x69, 0x82, 0xe1,
+		}},
+		AVAddToQueue: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x90, 0xe6, 0x84, 0xe8, 0x55, 0x7f, 0xe6,
+			0xad, 0x8a, 0xe8, 0x55, 0x7b, 0xe6, 0x84, 0xe8, 0xad, 0x72, 0xe6, 0x7c, 0xe8, 0x55, 0x7b, 0xe6,
+			0x55, 0x75, 0xe8, 0x55, 0x7f, 0xe6, 0x7c, 0xe8, 0x90, 0xe2, 0x6c, 0xb0, 0xe8, 0xad, 0x92, 0xe6,
+			0x55, 0x69, 0x60, 0xbd, 0x67, 0xad, 0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e,
+			0xe8, 0x58, 0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xad,
+			0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6,
+			0x94, 0xe8, 0xb0, 0xe6, 0x6c, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6,
+			0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		AVAirplay: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x72, 0xb0, 0x01, 0x80, 0x45, 0x8a, 0xbd,
+			0x8d, 0xb0, 0xe6, 0x35, 0x72, 0xe2, 0x55, 0x65, 0xad, 0x8e, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69,
+			0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50,
+			0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x51, 0x90,
+			0x7d, 0x99, 0x7d, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x8e, 0xe6,
+			0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe6, 0x45, 0x71, 0xe8, 0xad, 0x92,
+			0xe6, 0x55, 0x69, 0x60, 0xb1, 0x67, 0xad, 0x92, 0x85, 0x66, 0x7d, 0x91, 0x40, 0x55, 0x65, 0xad,
+			0x8e, 0xe2, 0x80, 0x55, 0x7f, 0xe1,
+		}},
+		AVAlbum: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xf1, 0x8a, 0x60, 0xad, 0x84, 0xf1, 0x8a,
+			0x90, 0xc5, 0x87, 0x40, 0x55, 0x8b, 0x80, 0x60, 0x55, 0x8b, 0x45, 0x7b, 0x09, 0x88, 0xf9, 0x77,
+			0x40, 0x80, 0xad, 0x74, 0x60, 0x69, 0x7b, 0xad, 0x74, 0x3d, 0x78, 0x70, 0x40, 0x11, 0x75, 0x80,
+			0x60, 0x11, 0x75, 0x99, 0x84, 0x3d, 0x78, 0xc5, 0x87, 0x40, 0x80, 0xf1, 0x8a, 0xe2, 0x80, 0xad,
+			0x82, 0x60, 0xdd, 0x7e, 0xad, 0x82, 0x19, 0x7e, 0xe9, 0x81, 0x40, 0x55, 0x7d, 0x80, 0x60, 0x55,
+			0x7d, 0xdd, 0x7e, 0x19, 0x7e, 0x19, 0x7e, 0x40, 0x80, 0x55, 0x7d, 0x60, 0x25, 0x81, 0x55, 0x7d,
+			0xe9, 0x81, 0x19, 0x7e, 0x40, 0xad, 0x82, 0x80, 0x60, 0xad, 0x82, 0x25, 0x81, 0xe9, 0x81, 0xe9,
+			0x81, 0x40, 0x80, 0xad, 0x82, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75,
+			0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a,
+			0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d,
+			0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96,
+			0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91,
+			0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90,
+			0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40,
+			0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80,
+			0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80,
+			0xe1,
+		}},
+		AVArtTrack:          AVArtTrack,
+		AVBrandingWatermark: AVBrandingWatermark,
+		AVCallToAction:      AVCallToAction,
+		AVClosedCaption: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x55, 0x95, 0x60, 0x69, 0x6a, 0x55, 0x95,
+			0x35, 0x69, 0x25, 0x94, 0x40, 0x50, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x50, 0x11, 0x6d, 0x35,
+			0x69, 0xdd, 0x6b, 0x40, 0x58, 0xad, 0x6a, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0xad, 0x6a, 0xcd, 0x96,
+			0xdd, 0x6b, 0x40, 0xb0, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xb0, 0xf1, 0x92, 0xcd, 0x96, 0x25,
+			0x94, 0x40, 0xa8, 0x55, 0x95, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x91, 0xe6, 0xa8, 0xe8, 0xad, 0x6e,
+			0xe6, 0x58, 0xe8, 0x55, 0x91, 0xe2, 0x64, 0xf1, 0x87, 0xe6, 0x79, 0x7b, 0x60, 0x51, 0x7c, 0xf1,
+			0x87, 0xe5, 0x7c, 0x5d, 0x87, 0x40, 0x79, 0x7d, 0xf1, 0x85, 0xe8, 0x25, 0x83, 0xe6, 0x25, 0x7a,
+			0xe8, 0x99, 0x84, 0xe6, 0x55, 0x73, 0xe8, 0x69, 0x7b, 0xe6, 0x25, 0x7a, 0xe8, 0xdd, 0x7c, 0xe6,
+			0x79, 0x7d, 0xe8, 0x11, 0x7a, 0x60, 0x79, 0x7d, 0x39, 0x79, 0xe5, 0x7c, 0xa5, 0x78, 0x40, 0x79,
+			0x7b, 0x11, 0x78, 0xe6, 0x64, 0x60, 0x29, 0x71, 0x11, 0x78, 0x95, 0x70, 0xa5, 0x78, 0x40, 0x60,
+			0x11, 0x7a, 0xe8, 0xf1, 0x85, 0x60, 0x60, 0xc9, 0x86, 0x95, 0x70, 0x5d, 0x87, 0x40, 0x64, 0xf1,
+			0x87, 0xe2, 0x99, 0x84, 0xf1, 0x87, 0xe6, 0x11, 0x8e, 0x60, 0xdd, 0x8e, 0xf1, 0x87, 0x79, 0x8f,
+			0x55, 0x87, 0x40, 0x11, 0x90, 0xf1, 0x85, 0xe8, 0x25, 0x83, 0xe6, 0xbd, 0x8c, 0xe8, 0x99, 0x84,
+			0xe6, 0xf1, 0x85, 0xe8, 0x69, 0x7b, 0xe6, 0xbd, 0x8c, 0xe8, 0xdd, 0x7c, 0xe6, 0x11, 0x90, 0xe8,
+			0x11, 0x7a, 0x60, 0x11, 0x90, 0x45, 0x79, 0x79, 0x8f, 0xad, 0x78, 0x40, 0x11, 0x8e, 0x11, 0x78,
+			0xe6, 0x99, 0x84, 0x60, 0xcd, 0x83, 0x11, 0x78, 0x35, 0x83, 0xad, 0x78, 0x40, 0x99, 0x82, 0x11,
+			0x7a, 0xe8, 0xf1, 0x85, 0x60, 0x99, 0x82, 0xbd, 0x86, 0x35, 0x83, 0x55, 0x87, 0x40, 0x99, 0x84,
+			0xf1, 0x87, 0xe2, 0x58, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVEqualizer: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x55, 0x95, 0xe8, 0x80, 0xe6, 0x68,
+			0xe8, 0x55, 0x95, 0xe6, 0xad, 0x6a, 0xe2, 0x55, 0x7b, 0x55, 0x95, 0xe8, 0xad, 0x6a, 0xe6, 0xad,
+			0x84, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x7b, 0xe2, 0x98, 0x55, 0x95, 0xe8, 0x70, 0xe6, 0x55, 0x95,
+			0xe8, 0x55, 0x95, 0xe6, 0x98, 0xe1,
+		}},
+		AVExplicit: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x78, 0x55, 0x8d, 0xe6, 0x89, 0x87, 0xe8,
+			0x55, 0x89, 0xe6, 0x69, 0x7c, 0xe8, 0x45, 0x82, 0xe6, 0x89, 0x87, 0xe8, 0x45, 0x7e, 0xe6, 0x69,
+			0x7c, 0xe8, 0x25, 0x77, 0xe6, 0x89, 0x87, 0xe8, 0x25, 0x73, 0xe6, 0x69, 0x78, 0xe8, 0x55, 0x8d,
+			0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58,
+			0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95,
+			0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96,
+			0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58,
+			0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58, 0xe1,
+		}},
+		AVFastForward: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x66, 0xa0, 0xe8, 0x60, 0x01, 0x7c, 0x80,
+			0xf1, 0x66, 0xa0, 0xe2, 0x11, 0x82, 0xa0, 0xe8, 0x60, 0x01, 0x25, 0x99, 0x80, 0x11, 0x82, 0xa0,
+			0xe2, 0xf1, 0x6a, 0x80, 0xe2, 0x11, 0x86, 0x80, 0xe2, 0xf1, 0x6a, 0x55, 0x88, 0x01, 0x6e, 0x80,
+			0xf1, 0x6a, 0xad, 0x77, 0xe8, 0x55, 0x88, 0xe2, 0x11, 0x86, 0x55, 0x88, 0x01, 0x25, 0x92, 0x80,
+			0x11, 0x86, 0xad, 0x77, 0xe8, 0x55, 0x88, 0xe1,
+		}},
+		AVFastRewind: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x98, 0xa0, 0x01, 0xdd, 0x81, 0x80, 0xf1,
+			0x98, 0x60, 0xe8, 0xa0, 0xe2, 0x25, 0x7e, 0xa0, 0x01, 0x11, 0x67, 0x80, 0x25, 0x7e, 0x60, 0xe8,
+			0xa0, 0xe2, 0x25, 0x7a, 0x80, 0xe2, 0xf1, 0x94, 0x80, 0xe2, 0x25, 0x7a, 0x55, 0x88, 0xe8, 0xad,
+			0x77, 0x01, 0x11, 0x6e, 0x80, 0x25, 0x7a, 0x55, 0x88, 0xe2, 0xf1, 0x94, 0x55, 0x88, 0xe8, 0xad,
+			0x77, 0x01, 0xdd, 0x88, 0x80, 0xf1, 0x94, 0x55, 0x88, 0xe1,
+		}},
+		AVFeaturedPlayList: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x6f, 0xbd, 0x81, 0xe6, 0xdd, 0x87, 0xe8,
+			0xbd, 0x7d, 0xe6, 0xcd, 0x6f, 0xe8, 0xbd, 0x81, 0xe2, 0xcd, 0x6f, 0x25, 0x79, 0xe6, 0xdd, 0x87,
+			0xe8, 0x25, 0x75, 0xe6, 0xcd, 0x6f, 0xe8, 0x25, 0x79, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd,
+			0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60,
+			0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55,
+			0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6,
+			0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69,
+			0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}, mirror: true},
+		AVFeaturedVideo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x6c, 0xcd, 0x84, 0xe6, 0x8a, 0xe8, 0x45,
+			0x72, 0xe6, 0xf1, 0x6c, 0xe8, 0xcd, 0x84, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVFiberDVR:          AVFiberDVR,
+		AVFiberManualRecord: AVFiberManualRecord,
+		AVFiberNew:          AVFiberNew,
+		AVFiberPin:          AVFiberPin,
+		AVFiberSmartRecord:  AVFiberSmartRecord,
+		AVForward10: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x70, 0x55, 0x8b, 0xe8, 0x35, 0x7d, 0xe6, 0x69,
+			0x74, 0xe8, 0xf1, 0x79, 0xe6, 0x55, 0x7b, 0xe8, 0x55, 0x8b, 0xe6, 0x70, 0xe2, 0xcd, 0x81, 0x55,
+			0x8b, 0x61, 0x8d, 0x80, 0x55, 0x8b, 0xb5, 0x7f, 0x7d, 0x8a, 0xdd, 0x7e, 0xa5, 0x89, 0xdd, 0x7e,
+			0x69, 0x88, 0xe8, 0xdd, 0x7c, 0x61, 0xdd, 0x7e, 0xa1, 0x7b, 0xb5, 0x7f, 0xc9, 0x7a, 0x8d, 0x80,
+			0xf1, 0x79, 0xcd, 0x81, 0xf1, 0x79, 0xe6, 0x55, 0x87, 0x61, 0x95, 0x88, 0xf1, 0x79, 0x6d, 0x89,
+			0xc9, 0x7a, 0x45, 0x8a, 0xa1, 0x7b, 0x45, 0x8a, 0xdd, 0x7c, 0xe8, 0x69, 0x88, 0x61, 0x45, 0x8a,
+			0xa5, 0x89, 0x6d, 0x89, 0x7d, 0x8a, 0x95, 0x88, 0x55, 0x8b, 0x55, 0x87, 0x55, 0x8b, 0xe6, 0xcd,
+			0x81, 0xe2, 0x35, 0x82, 0x90, 0xe6, 0xf1, 0x86, 0xe8, 0x35, 0x7d, 0xe6, 0x35, 0x82, 0xe8, 0x90,
+			0xe2, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5, 0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93,
+			0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c, 0x40, 0x50, 0xad, 0x82, 0x60, 0x50, 0xad,
+			0x7d, 0xdd, 0x69, 0x4d, 0x79, 0x40, 0x5e, 0xad, 0x71, 0x60, 0x45, 0x72, 0x69, 0x6e, 0xa5, 0x76,
+			0x89, 0x6c, 0x40, 0x80, 0xad, 0x6a, 0xe6, 0x69, 0x81, 0x05, 0x35, 0x7c, 0x79, 0x65, 0xf1, 0x7e,
+			0xbd, 0x62, 0xbd, 0x88, 0x89, 0x6c, 0xf1, 0x7e, 0x55, 0x76, 0x35, 0x7c, 0x99, 0x73, 0x25, 0x81,
+			0xad, 0x6e, 0xe6, 0x80, 0x61, 0xa5, 0x77, 0xad, 0x6e, 0xd5, 0x71, 0x7d, 0x74, 0x58, 0x51, 0x7a,
+			0x58, 0xad, 0x82, 0x40, 0xd5, 0x71, 0xd9, 0x90, 0x60, 0xa5, 0x77, 0xad, 0x96, 0x80, 0xad, 0x96,
+			0x40, 0x2d, 0x8e, 0xd9, 0x90, 0x60, 0xa8, 0x09, 0x8b, 0xa8, 0xad, 0x82, 0xe6, 0xb0, 0x60, 0xb0,
+			0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad, 0x93, 0x60, 0xbd, 0x8d, 0xf1, 0x96, 0x5d,
+			0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		AVForward30: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x72, 0x55, 0x8b, 0xe8, 0x90, 0xe6, 0xcd,
+			0x7a, 0xe8, 0x45, 0x84, 0xe6, 0x55, 0x75, 0xe8, 0x82, 0xe6, 0xcd, 0x7a, 0xe8, 0x35, 0x7d, 0xe6,
+			0xbd, 0x72, 0xe8, 0xf1, 0x79, 0xe6, 0x35, 0x7b, 0x61, 0x75, 0x7c, 0xf1, 0x79, 0x4d, 0x7d, 0xc9,
+			0x7a, 0x25, 0x7e, 0xa1, 0x7b, 0x25, 0x7e, 0xdd, 0x7c, 0xe8, 0x69, 0x88, 0x61, 0x25, 0x7e, 0xa5,
+			0x89, 0x4d, 0x7d, 0x7d, 0x8a, 0x75, 0x7c, 0x55, 0x8b, 0x35, 0x7b, 0x55, 0x8b, 0xe6, 0xbd, 0x72,
+			0xe2, 0xdd, 0x84, 0x55, 0x8b, 0x61, 0xa1, 0x83, 0x55, 0x8b, 0xc9, 0x82, 0x7d, 0x8a, 0xf1, 0x81,
+			0xa5, 0x89, 0xf1, 0x81, 0x69, 0x88, 0xe8, 0xdd, 0x7c, 0x61, 0xf1, 0x81, 0xa1, 0x7b, 0xc9, 0x82,
+			0xc9, 0x7a, 0xa1, 0x83, 0xf1, 0x79, 0xdd, 0x84, 0xf1, 0x79, 0xe6, 0x69, 0x8a, 0x61, 0xa5, 0x8b,
+			0xf1, 0x79, 0x7d, 0x8c, 0xc9, 0x7a, 0x55, 0x8d, 0xa1, 0x7b, 0x55, 0x8d, 0xdd, 0x7c, 0xe8, 0x69,
+			0x88, 0x61, 0x55, 0x8d, 0xa5, 0x89, 0x7d, 0x8c, 0x7d, 0x8a, 0xa5, 0x8b, 0x55, 0x8b, 0x69, 0x8a,
+			0x55, 0x8b, 0xe6, 0xdd, 0x84, 0xe2, 0x45, 0x85, 0x90, 0xe6, 0x94, 0xe8, 0x35, 0x7d, 0xe6, 0x45,
+			0x85, 0xe8, 0x90, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5, 0x76, 0xcd, 0x98, 0x40,
+			0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c, 0x40, 0x50, 0xad, 0x82,
+			0x60, 0x50, 0xad, 0x7d, 0xdd, 0x69, 0x4d, 0x79, 0x40, 0x5e, 0xad, 0x71, 0x60, 0x45, 0x72, 0x69,
+			0x6e, 0xa5, 0x76, 0x89, 0x6c, 0x40, 0x80, 0xad, 0x6a, 0xe6, 0x69, 0x81, 0x05, 0x35, 0x7c, 0x79,
+			0x65, 0xf1, 0x7e, 0xbd, 0x62, 0xbd, 0x88, 0x89, 0x6c, 0xf1, 0x7e, 0x55, 0x76, 0x35, 0x7c, 0x99,
+			0x73, 0x25, 0x81, 0xad, 0x6e, 0xe6, 0x80, 0x61, 0xa5, 0x77, 0xad, 0x6e, 0xd5, 0x71, 0x7d, 0x74,
+			0x58, 0x51, 0x7a, 0x58, 0xad, 0x82, 0x40, 0xd5, 0x71, 0xd9, 0x90, 0x60, 0xa5, 0x77, 0xad, 0x96,
+			0x80, 0xad, 0x96, 0x40, 0x2d, 0x8e, 0xd9, 0x90, 0x60, 0xa8, 0x09, 0x8b, 0xa8, 0xad, 0x82, 0xe6,
+			0xb0, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad, 0x93, 0x60, 0xbd, 0x8d,
+			0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		AVForward5: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5,
+			0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c,
+			0x40, 0x50, 0xad, 0x82, 0x60, 0x50, 0xad, 0x7d, 0xdd, 0x69, 0x4d, 0x79, 0x40, 0x5e, 0xad, 0x71,
+			0x60, 0x45, 0x72, 0x69, 0x6e, 0xa5, 0x76, 0x89, 0x6c, 0x40, 0x80, 0xad, 0x6a, 0xe6, 0x69, 0x81,
+			0x05, 0x35, 0x7c, 0x79, 0x65, 0xf1, 0x7e, 0xbd, 0x62, 0xbd, 0x88, 0x89, 0x6c, 0xf1, 0x7e, 0x55,
+			0x76, 0x35, 0x7c, 0x99, 0x73, 0x25, 0x81, 0xad, 0x6e, 0xe6, 0x80, 0x61, 0xa5, 0x77, 0xad, 0x6e,
+			0xd5, 0x71, 0x7d, 0x74, 0x58, 0x51, 0x7a, 0x58, 0xad, 0x82, 0x40, 0xd5, 0x71, 0xd9, 0x90, 0x60,
+			0xa5, 0x77, 0xad, 0x96, 0x80, 0xad, 0x96, 0x40, 0x2d, 0x8e, 0xd9, 0x90, 0x60, 0xa8, 0x09, 0x8b,
+			0xa8, 0xad, 0x82, 0xe6, 0xb0, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad,
+			0x93, 0x60, 0xbd, 0x8d, 0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x55,
+			0x79, 0x55, 0x8b, 0xe8, 0x90, 0xe6, 0xcd, 0x81, 0xe8, 0x45, 0x84, 0xe6, 0x55, 0x79, 0xe8, 0xf1,
+			0x79, 0xe6, 0x11, 0x85, 0xe8, 0x35, 0x7d, 0xe6, 0xad, 0x7c, 0xe8, 0x82, 0xe6, 0xcd, 0x82, 0x61,
+			0xc5, 0x83, 0x82, 0x6d, 0x84, 0xa9, 0x81, 0x11, 0x85, 0x4d, 0x82, 0x11, 0x85, 0x45, 0x83, 0xe8,
+			0x11, 0x89, 0x61, 0x11, 0x85, 0x09, 0x8a, 0x6d, 0x84, 0xb1, 0x8a, 0xc5, 0x83, 0x55, 0x8b, 0xcd,
+			0x82, 0x55, 0x8b, 0xe6, 0x55, 0x79, 0xe1,
+		}},
+		AVGames: AVGames,
+		AVHD: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x35, 0x88, 0xe6, 0x55, 0x73, 0xe8, 0x45,
+			0x82, 0xe6, 0x74, 0xe8, 0x35, 0x88, 0xe6, 0x55, 0x7d, 0xe8, 0xcd, 0x77, 0xe6, 0x74, 0xe8, 0xf1,
+			0x7e, 0xe6, 0x55, 0x73, 0xe8, 0xcd, 0x77, 0xe6, 0x60, 0xe8, 0x35, 0x88, 0xe2, 0xad, 0x82, 0x35,
+			0x88, 0xe6, 0xad, 0x8c, 0x60, 0x9c, 0x35, 0x88, 0x9e, 0x35, 0x87, 0x40, 0xa0, 0xdd, 0x84, 0xe8,
+			0x25, 0x7b, 0x60, 0xa0, 0xcd, 0x79, 0x9e, 0xcd, 0x78, 0x40, 0xad, 0x8c, 0xcd, 0x77, 0xe6, 0xad,
+			0x82, 0xe8, 0x35, 0x88, 0xe2, 0x8c, 0xdd, 0x84, 0xe8, 0x25, 0x7b, 0xe6, 0xad, 0x8c, 0xe8, 0xdd,
+			0x84, 0xe6, 0x8c, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25,
+			0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66,
+			0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79,
+			0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92,
+			0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVHearing: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x8d, 0x99, 0x9a, 0x60, 0x11, 0x8a, 0x99,
+			0x9a, 0x45, 0x87, 0x89, 0x98, 0x40, 0x25, 0x83, 0x11, 0x93, 0x60, 0xcd, 0x81, 0xad, 0x8f, 0x81,
+			0x80, 0xc5, 0x8d, 0x40, 0x99, 0x7b, 0x92, 0x60, 0x35, 0x77, 0x79, 0x85, 0x45, 0x75, 0x79, 0x81,
+			0x40, 0x55, 0x73, 0xbd, 0x77, 0x60, 0x55, 0x73, 0xbd, 0x6f, 0x69, 0x78, 0xa5, 0x6a, 0x40, 0x79,
+			0x85, 0x89, 0x65, 0x60, 0x55, 0x8d, 0x89, 0x65, 0x81, 0x92, 0x71, 0x6a, 0x40, 0xb0, 0xf1, 0x76,
+			0xe6, 0xa8, 0x60, 0xad, 0x93, 0x11, 0x71, 0xa5, 0x8f, 0x4d, 0x6d, 0x40, 0x79, 0x85, 0x89, 0x69,
+			0x60, 0x79, 0x7f, 0x89, 0x69, 0x69, 0x7b, 0xa5, 0x6d, 0x40, 0x55, 0x77, 0xbd, 0x77, 0x60, 0x55,
+			0x77, 0x89, 0x7c, 0x35, 0x79, 0x09, 0x80, 0x40, 0x69, 0x7f, 0xcd, 0x86, 0x60, 0x84, 0xbd, 0x88,
+			0x91, 0x83, 0x96, 0x40, 0x89, 0x86, 0x99, 0x90, 0x60, 0xad, 0x87, 0x69, 0x93, 0x81, 0x89, 0xaa,
+			0x40, 0xdd, 0x8d, 0x99, 0x96, 0x60, 0x35, 0x90, 0x99, 0x96, 0xe9, 0x91, 0xaa, 0x40, 0xf1, 0x93,
+			0x11, 0x91, 0xe6, 0xf1, 0x97, 0x60, 0x99, 0x97, 0x11, 0x95, 0xbd, 0x94, 0xd5, 0x97, 0x40, 0xdd,
+			0x8d, 0x99, 0x9a, 0xe2, 0xf1, 0x6f, 0xdd, 0x8b, 0x60, 0x25, 0x6c, 0xad, 0x87, 0x11, 0x6a, 0x71,
+			0x82, 0x40, 0x50, 0x79, 0x77, 0x60, 0x50, 0xcd, 0x71, 0xf9, 0x69, 0x81, 0x6c, 0x40, 0xf1, 0x6f,
+			0x25, 0x63, 0x00, 0xf1, 0x72, 0xcd, 0x65, 0x60, 0x79, 0x6f, 0x55, 0x69, 0xbd, 0x6d, 0xe9, 0x6d,
+			0x40, 0x58, 0x79, 0x77, 0x60, 0x58, 0x69, 0x7c, 0xc5, 0x6d, 0xf9, 0x80, 0x40, 0xf1, 0x72, 0x25,
+			0x89, 0x00, 0xf1, 0x6f, 0xdd, 0x8b, 0xe2, 0x79, 0x85, 0xf1, 0x7d, 0x60, 0xdd, 0x82, 0xf1, 0x7d,
+			0x11, 0x81, 0x25, 0x7c, 0x40, 0x45, 0x7f, 0xbd, 0x77, 0x60, 0x45, 0x7f, 0x25, 0x75, 0x11, 0x81,
+			0x45, 0x73, 0x40, 0x79, 0x85, 0x69, 0x71, 0x60, 0x11, 0x88, 0x69, 0x71, 0xf1, 0x89, 0x45, 0x73,
+			0x40, 0xcd, 0x8b, 0xbd, 0x77, 0x60, 0xcd, 0x8b, 0x55, 0x7a, 0xf1, 0x89, 0x25, 0x7c, 0x40, 0x79,
+			0x85, 0xf1, 0x7d, 0xe1,
+		}},
+		AVHighQuality: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x87, 0x89, 0x8c, 0xe6, 0x96, 0xe8, 0x35,
+			0x88, 0xe6, 0xbd, 0x8d, 0x61, 0xb5, 0x8e, 0x35, 0x88, 0x59, 0x8f, 0x91, 0x87, 0xa0, 0xf1, 0x86,
+			0xa0, 0x8c, 0xe8, 0x11, 0x7a, 0x61, 0xa0, 0x1d, 0x79, 0x59, 0x8f, 0x75, 0x78, 0xb5, 0x8e, 0xcd,
+			0x77, 0xbd, 0x8d, 0xcd, 0x77, 0xe6, 0x55, 0x85, 0x60, 0x69, 0x84, 0xcd, 0x77, 0x89, 0x83, 0x75,
+			0x78, 0x40, 0xad, 0x82, 0x11, 0x7a, 0xe8, 0x8c, 0x61, 0xad, 0x82, 0xf1, 0x86, 0x89, 0x83, 0x91,
+			0x87, 0x69, 0x84, 0x35, 0x88, 0x55, 0x85, 0x35, 0x88, 0xe6, 0xad, 0x87, 0xe8, 0x89, 0x8c, 0xe2,
+			0x60, 0x35, 0x88, 0xe6, 0x55, 0x73, 0xe8, 0x45, 0x82, 0xe6, 0x74, 0xe8, 0x35, 0x88, 0xe6, 0x55,
+			0x7d, 0xe8, 0xcd, 0x77, 0xe6, 0x74, 0xe8, 0xf1, 0x7e, 0xe6, 0x55, 0x73, 0xe8, 0xcd, 0x77, 0xe6,
+			0x60, 0xe8, 0x35, 0x88, 0xe2, 0x8c, 0xdd, 0x84, 0xe8, 0x25, 0x7b, 0xe6, 0xad, 0x8c, 0xe8, 0xdd,
+			0x84, 0xe6, 0x8c, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25,
+			0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66,
+			0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79,
+			0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92,
+			0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVLibraryAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x84, 0xcd, 0x86, 0xe6, 0x8c, 0xe8, 0x7c, 0xe6,
+			0xcd, 0x8e, 0xe8, 0x74, 0xe6, 0x8c, 0xe8, 0x35, 0x71, 0xe6, 0x84, 0xe8, 0x74, 0xe6, 0x35, 0x79,
+			0xe8, 0x7c, 0xe6, 0x84, 0xe8, 0xcd, 0x86, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad,
+			0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d,
+			0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad,
+			0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40,
+			0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92,
+			0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55,
+			0x69, 0xe1,
+		}},
+		AVLibraryBooks: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x76, 0x88, 0xe6, 0xdd, 0x85, 0xe8, 0x80,
+			0xe6, 0xdd, 0x76, 0xe8, 0x88, 0xe2, 0xdd, 0x76, 0x7c, 0xe6, 0x35, 0x91, 0xe8, 0x74, 0xe6, 0xdd,
+			0x76, 0xe8, 0x7c, 0xe2, 0xdd, 0x76, 0x70, 0xe6, 0x35, 0x91, 0xe8, 0x68, 0xe6, 0xdd, 0x76, 0xe8,
+			0x70, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40,
+			0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66,
+			0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89,
+			0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99,
+			0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6,
+			0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a,
+			0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55,
+			0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		AVLibraryMusic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x80, 0xcd, 0x89, 0x60, 0x25, 0x83, 0xcd,
+			0x89, 0x11, 0x85, 0xe9, 0x87, 0x40, 0x8e, 0x45, 0x83, 0xe8, 0x79, 0x72, 0xe6, 0xbd, 0x8e, 0xe8,
+			0x79, 0x6e, 0xe6, 0x55, 0x84, 0xe8, 0x45, 0x7e, 0x62, 0x99, 0x83, 0xad, 0x7d, 0x95, 0x82, 0x55,
+			0x7d, 0x91, 0x81, 0x7a, 0x69, 0x80, 0x7a, 0xcd, 0x7d, 0x7a, 0x78, 0xd5, 0x7e, 0x40, 0x35, 0x7a,
+			0x4d, 0x83, 0x60, 0x35, 0x7a, 0xf1, 0x85, 0x78, 0xdd, 0x87, 0x40, 0x69, 0x80, 0xcd, 0x89, 0xe2,
+			0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d,
+			0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55,
+			0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40,
+			0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91,
+			0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96,
+			0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd,
+			0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6,
+			0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55,
+			0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		AVLoop: AVLoop,
+		AVMic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xcd, 0x83, 0x61, 0x25, 0x7d, 0xcd, 0x83,
+			0x35, 0x7b, 0xbd, 0x81, 0x45, 0x79, 0xad, 0x7f, 0x45, 0x79, 0xbd, 0x7c, 0xe8, 0x58, 0x61, 0x45,
+			0x79, 0x39, 0x69, 0x3d, 0x7b, 0x49, 0x67, 0x31, 0x7d, 0x55, 0x65, 0x80, 0x55, 0x65, 0x40, 0xc5,
+			0x84, 0x49, 0x67, 0x60, 0xbd, 0x86, 0x39, 0x69, 0xbd, 0x86, 0x58, 0xe8, 0xbd, 0x7c, 0x61, 0xbd,
+			0x86, 0xad, 0x7f, 0xcd, 0x84, 0xbd, 0x81, 0xdd, 0x82, 0xcd, 0x83, 0x80, 0xcd, 0x83, 0xe2, 0x80,
+			0x99, 0x74, 0xe2, 0x7c, 0xb0, 0xe8, 0xf1, 0x8e, 0x60, 0xf1, 0x76, 0x35, 0x8e, 0x25, 0x72, 0x92,
+			0x40, 0x55, 0x6d, 0xbd, 0x7c, 0xe6, 0x55, 0x71, 0x60, 0x55, 0x71, 0xcd, 0x82, 0xa1, 0x75, 0xf1,
+			0x86, 0x40, 0xfd, 0x7f, 0x11, 0x8b, 0x61, 0x11, 0x86, 0x11, 0x8b, 0x5d, 0x8a, 0xf1, 0x86, 0xad,
+			0x8e, 0xcd, 0x82, 0xad, 0x8e, 0xbd, 0x7c, 0xe6, 0xad, 0x92, 0x60, 0xad, 0x92, 0xcd, 0x83, 0xdd,
+			0x8d, 0x92, 0x40, 0x84, 0xf1, 0x8e, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x80, 0xcd, 0x7f, 0x60, 0x35,
+			0x81, 0xcd, 0x7f, 0xf9, 0x81, 0xe9, 0x7e, 0x40, 0xbd, 0x82, 0xbd, 0x7c, 0xe8, 0x58, 0x63, 0xbd,
+			0x82, 0xdd, 0x6a, 0xf5, 0x81, 0x19, 0x6a, 0x29, 0x81, 0x55, 0x69, 0x80, 0x55, 0x69, 0xd9, 0x7e,
+			0x55, 0x69, 0x0d, 0x7e, 0x19, 0x6a, 0x45, 0x7d, 0xdd, 0x6a, 0x45, 0x7d, 0x58, 0xe8, 0xbd, 0x7c,
+			0x60, 0x45, 0x7d, 0x7c, 0x09, 0x7e, 0xe9, 0x7e, 0x40, 0x80, 0xcd, 0x7f, 0xe1,
+		}},
+		AVMicNone: AVMicNone,
+		AVMicOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x8d, 0xf1, 0x87, 0x00, 0xdd, 0x8a, 0x11,
+			0x85, 0x60, 0x45, 0x8c, 0x55, 0x83, 0xf1, 0x8c, 0x2d, 0x81, 0x40, 0x99, 0x8d, 0xbd, 0x7c, 0xe6,
+			0x99, 0x91, 0x60, 0x99, 0x91, 0xcd, 0x7f, 0x99, 0x90, 0xad, 0x82, 0x40, 0xbd, 0x8d, 0xf1, 0x87,
+			0xe2, 0xbd, 0x7e, 0xf1, 0x78, 0xe2, 0x35, 0x85, 0x69, 0x7f, 0x00, 0xad, 0x81, 0xf1, 0x7b, 0xe8,
+			0x11, 0x6c, 0x63, 0xad, 0x81, 0xe9, 0x6a, 0xe1, 0x80, 0x21, 0x6a, 0x19, 0x80, 0x55, 0x69, 0xf1,
+			0x7e, 0x55, 0x69, 0xc5, 0x7d, 0x55, 0x69, 0xfd, 0x7c, 0x21, 0x6a, 0x35, 0x7c, 0xe9, 0x6a, 0x35,
+			0x7c, 0x11, 0x6c, 0xe8, 0x69, 0x76, 0x00, 0x35, 0x78, 0x69, 0x72, 0xe8, 0x11, 0x6c, 0x61, 0x35,
+			0x78, 0x45, 0x69, 0x29, 0x7a, 0x4d, 0x67, 0x21, 0x7c, 0x55, 0x65, 0xed, 0x7e, 0x55, 0x65, 0x40,
+			0xb5, 0x83, 0x4d, 0x67, 0x60, 0xad, 0x85, 0x45, 0x69, 0xad, 0x85, 0x11, 0x6c, 0xe8, 0xbd, 0x7c,
+			0x60, 0xad, 0x85, 0x45, 0x7d, 0x91, 0x85, 0x11, 0x7e, 0x40, 0x35, 0x85, 0x69, 0x7f, 0xe2, 0xf1,
+			0x7c, 0xb0, 0xe8, 0xf1, 0x8e, 0x60, 0xdd, 0x75, 0x35, 0x8e, 0x11, 0x71, 0x92, 0x40, 0x45, 0x6c,
+			0xbd, 0x7c, 0xe6, 0x45, 0x70, 0x60, 0x45, 0x70, 0xcd, 0x82, 0x91, 0x74, 0xf1, 0x86, 0x40, 0xf1,
+			0x7e, 0x11, 0x8b, 0x61, 0x79, 0x81, 0x11, 0x8b, 0xd1, 0x83, 0x41, 0x8a, 0x25, 0x86, 0x6d, 0x89,
+			0x11, 0x88, 0xf1, 0x87, 0x00, 0xf1, 0x8a, 0xcd, 0x8a, 0x61, 0xdd, 0x88, 0x89, 0x8c, 0x55, 0x86,
+			0x95, 0x8d, 0xcd, 0x83, 0x9d, 0x8e, 0xf1, 0x80, 0xf1, 0x8e, 0xe8, 0xb0, 0xe6, 0xf1, 0x7c, 0xe2,
+			0x69, 0x97, 0x55, 0x9c, 0x03, 0x69, 0x62, 0x55, 0x67, 0xf1, 0x64, 0xcd, 0x64, 0xf1, 0x99, 0xcd,
+			0x99, 0x69, 0x97, 0x55, 0x9c, 0xe1,
+		}},
+		AVMovie: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xad, 0x6a, 0x00, 0x45, 0x6e, 0xcd,
+			0x74, 0xe6, 0xf1, 0x76, 0x00, 0x64, 0xad, 0x6a, 0xe6, 0xf1, 0x77, 0x00, 0xdd, 0x7c, 0xcd, 0x74,
+			0xe6, 0x89, 0x85, 0x00, 0x99, 0x80, 0xad, 0x6a, 0xe6, 0x89, 0x86, 0x00, 0x79, 0x8b, 0xcd, 0x74,
+			0xe6, 0x25, 0x94, 0x00, 0x35, 0x8f, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a,
+			0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1,
+			0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67,
+			0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55,
+			0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe2, 0x55, 0x69, 0xcd,
+			0x78, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xcd, 0x78, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69,
+			0xcd, 0x78, 0xe8, 0x55, 0x91, 0xe8, 0xcd, 0x78, 0xe1,
+		}},
+		AVMusicVideo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7c, 0xad, 0x8c, 0x60, 0x35, 0x7f, 0xad,
+			0x8c, 0xf1, 0x80, 0xe9, 0x8a, 0x40, 0xad, 0x82, 0xad, 0x86, 0xe8, 0x25, 0x78, 0xe6, 0xdd, 0x89,
+			0xe8, 0x55, 0x73, 0xe6, 0x45, 0x80, 0xe8, 0xbd, 0x81, 0x60, 0x99, 0x7f, 0x45, 0x81, 0x91, 0x7e,
+			0xf9, 0x80, 0x40, 0xad, 0x7c, 0xad, 0x80, 0x60, 0x25, 0x7a, 0xad, 0x80, 0x69, 0x78, 0x71, 0x82,
+			0x40, 0xad, 0x76, 0xad, 0x86, 0x60, 0xad, 0x76, 0x25, 0x89, 0x69, 0x78, 0xe9, 0x8a, 0x40, 0xad,
+			0x7c, 0xad, 0x8c, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25,
+			0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66,
+			0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79,
+			0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92,
+			0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVNewReleases: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x77, 0xb8, 0x13, 0x64, 0x55, 0x93, 0xf1,
+			0x67, 0x45, 0x91, 0x11, 0x69, 0x79, 0x87, 0xad, 0x62, 0x80, 0x11, 0x69, 0x99, 0x78, 0xf1, 0x67,
+			0xcd, 0x6e, 0x64, 0xbd, 0x6c, 0x11, 0x77, 0x48, 0x80, 0x25, 0x68, 0xf1, 0x88, 0x48, 0x11, 0x8e,
+			0xbd, 0x6c, 0x11, 0x98, 0xcd, 0x6e, 0xf1, 0x96, 0x99, 0x78, 0x55, 0x9d, 0x80, 0xf1, 0x96, 0x79,
+			0x87, 0x11, 0x98, 0x45, 0x91, 0x11, 0x8e, 0x55, 0x93, 0xf1, 0x88, 0xb8, 0x80, 0xdd, 0x97, 0x11,
+			0x77, 0xb8, 0xe2, 0xdd, 0x78, 0xbd, 0x96, 0x13, 0x80, 0xbd, 0x93, 0x55, 0x87, 0xbd, 0x96, 0xcd,
+			0x8b, 0x11, 0x90, 0x99, 0x93, 0x11, 0x8e, 0xcd, 0x92, 0x25, 0x86, 0x35, 0x98, 0x80, 0xcd, 0x92,
+			0xbd, 0x79, 0x99, 0x93, 0xcd, 0x71, 0xcd, 0x8b, 0xf1, 0x6f, 0x35, 0x87, 0x45, 0x69, 0x80, 0x45,
+			0x6c, 0xad, 0x78, 0x45, 0x69, 0x35, 0x74, 0xf1, 0x6f, 0x69, 0x6c, 0xcd, 0x71, 0x35, 0x6d, 0xbd,
+			0x79, 0xcd, 0x67, 0x80, 0x35, 0x6d, 0x25, 0x86, 0x69, 0x6c, 0x35, 0x8e, 0x35, 0x74, 0x11, 0x90,
+			0xdd, 0x78, 0xbd, 0x96, 0xe2, 0x80, 0x80, 0xe2, 0x25, 0x7d, 0xdd, 0x88, 0x05, 0x45, 0x8c, 0xdd,
+			0x79, 0x45, 0x89, 0x25, 0x77, 0x25, 0x7d, 0x25, 0x83, 0xcd, 0x76, 0x89, 0x7c, 0xbd, 0x73, 0x89,
+			0x7f, 0x25, 0x7d, 0xdd, 0x88, 0xe1,
+		}},
+		AVNotInterested: AVNotInterested,
+		AVNote: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad,
+			0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a,
+			0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0xbd, 0x86, 0x00, 0x55,
+			0x95, 0xf1, 0x73, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40,
+			0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xbd, 0x84, 0xbd, 0x75, 0xe8, 0x55, 0x69, 0xe6,
+			0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0xbd, 0x75, 0xe6, 0xbd, 0x84, 0xe2, 0xad,
+			0x6e, 0x55, 0x69, 0xe8, 0xbd, 0x75, 0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		AVPause: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x86, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0xad,
+			0x92, 0xe8, 0xad, 0x92, 0xe6, 0x86, 0xe2, 0x55, 0x6d, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0x7a,
+			0xe8, 0xad, 0x92, 0xe6, 0x55, 0x6d, 0xe2, 0x8e, 0xad, 0x8e, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x71,
+			0xe6, 0x8e, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0x72, 0xe8, 0x55, 0x71, 0xe6,
+			0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x71, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x71,
+			0xe2, 0x8e, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe1,
+		}},
+		AVPauseCircleFilled:  AVPauseCircleFilled,
+		AVPauseCircleOutline: AVPauseCircleOutline,
+		AVPlayArrow: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0x79, 0x92, 0xe8, 0x25, 0x6d, 0x01,
+			0xad, 0x92, 0xcd, 0x7f, 0x55, 0x75, 0x79, 0x92, 0xe2, 0x55, 0x79, 0xcd, 0x7f, 0xe2, 0x55, 0x79,
+			0x35, 0x8b, 0x01, 0x45, 0x8b, 0xcd, 0x7f, 0x55, 0x79, 0x69, 0x74, 0xe8, 0x35, 0x8b, 0xe1,
+		}},
+		AVPlayCircleFilled:  AVPlayCircleFilled,
+		AVPlayCircleOutline: AVPlayCircleOutline,
+		AVPlaylistAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x94, 0xe8, 0x8c, 0xe6, 0x78, 0xe8, 0x94,
+			0xe6, 0x50, 0xe2, 0x50, 0x7e, 0xe8, 0x76, 0xe6, 0x55, 0x87, 0xe8, 0x7e, 0xe6, 0x50, 0xe2, 0x50,
+			0x68, 0xe8, 0x60, 0xe6, 0x55, 0x87, 0xe8, 0x68, 0xe6, 0x50, 0xe2, 0x55, 0x8b, 0x55, 0x95, 0xe8,
+			0x94, 0xe6, 0x80, 0xe8, 0x8c, 0xe6, 0x55, 0x8b, 0xe8, 0xad, 0x7a, 0xe6, 0x55, 0x8f, 0xe8, 0x8c,
+			0xe6, 0xad, 0x9a, 0xe8, 0x94, 0xe6, 0x55, 0x8f, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x8b, 0xe1,
+		}, mirror: true},
+		AVPlaylistAddCheck: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xf1, 0x89, 0xe8, 0xf1, 0x85, 0xe6, 0x69,
+			0x7c, 0xe8, 0xf1, 0x89, 0xe6, 0x50, 0xe2, 0x50, 0xf1, 0x7e, 0xe8, 0xf1, 0x7a, 0xe6, 0x89, 0x87,
+			0xe8, 0xf1, 0x7e, 0xe6, 0x50, 0xe2, 0x50, 0xf1, 0x73, 0xe8, 0xf1, 0x6f, 0xe6, 0x89, 0x87, 0xe8,
+			0xf1, 0x73, 0xe6, 0x50, 0xe2, 0x79, 0x8b, 0x99, 0x92, 0x05, 0x69, 0x82, 0x89, 0x89, 0x35, 0x85,
+			0xad, 0x86, 0x79, 0x8b, 0xdd, 0x8c, 0xcd, 0x97, 0x89, 0x80, 0xad, 0x9a, 0x69, 0x83, 0x79, 0x8b,
+			0x99, 0x92, 0xe1,
+		}, mirror: true},
+		AVPlaylistPlay: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x66, 0x94, 0xe8, 0x8c, 0xe6, 0x11, 0x7b,
+			0xe8, 0x94, 0xe6, 0xad, 0x66, 0xe2, 0xad, 0x66, 0x7e, 0xe8, 0x76, 0xe6, 0x35, 0x86, 0xe8, 0x7e,
+			0xe6, 0xad, 0x66, 0xe2, 0xad, 0x66, 0x68, 0xe8, 0x60, 0xe6, 0x35, 0x86, 0xe8, 0x68, 0xe6, 0xad,
+			0x66, 0xe2, 0xcd, 0x8a, 0xb0, 0xe8, 0x89, 0x82, 0x01, 0x55, 0x99, 0x45, 0x8d, 0xcd, 0x8a, 0xb0,
+			0xe1,
+		}},
+		AVQueue: AVQueue,
+		AVQueueMusic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8a, 0x55, 0x95, 0x61, 0x69, 0x87, 0x55,
+			0x95, 0x11, 0x85, 0x19, 0x93, 0xbd, 0x82, 0xdd, 0x90, 0xbd, 0x82, 0xa9, 0x8d, 0x40, 0xf9, 0x84,
+			0x3d, 0x88, 0x61, 0x35, 0x87, 0x8c, 0x69, 0x8a, 0x8c, 0x79, 0x8b, 0x8c, 0x81, 0x8c, 0x35, 0x86,
+			0x40, 0x89, 0x8e, 0xdd, 0x86, 0xe8, 0x60, 0xe6, 0xad, 0x9a, 0xe8, 0xbd, 0x74, 0xe6, 0x89, 0x92,
+			0xe8, 0xbd, 0x8d, 0x61, 0x89, 0x92, 0xe9, 0x90, 0x3d, 0x90, 0x1d, 0x93, 0xf5, 0x8d, 0x55, 0x95,
+			0xad, 0x8a, 0x55, 0x95, 0xe2, 0x50, 0x94, 0xe8, 0x8c, 0xe6, 0x69, 0x7c, 0xe8, 0x94, 0xe6, 0x50,
+			0xe2, 0x50, 0x7e, 0xe8, 0x76, 0xe6, 0x89, 0x87, 0xe8, 0x7e, 0xe6, 0x50, 0xe2, 0x50, 0x68, 0xe8,
+			0x60, 0xe6, 0x89, 0x87, 0xe8, 0x68, 0xe6, 0x50, 0xe1,
+		}, mirror: true},
+		AVQueuePlayNext: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x94, 0xb8, 0x05, 0x99, 0x91, 0xf1, 0x98,
+			0xdd, 0x99, 0xad, 0x90, 0xad, 0x91, 0x79, 0x88, 0xbd, 0x94, 0x69, 0x85, 0x11, 0xa0, 0xad, 0x90,
+			0xbd, 0x94, 0xb8, 0xe2, 0x6c, 0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0xad,
+			0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69,
+			0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50,
+			0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xcd, 0x7f, 0xe6, 0xad, 0x96, 0xe8, 0x58,
+			0xe6, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe6, 0xbd, 0x8d, 0xe8, 0xad, 0x92, 0xe6, 0x94, 0xe8, 0xb0,
+			0xe6, 0x6c, 0xe2, 0xf1, 0x7d, 0x69, 0x87, 0xe6, 0xf1, 0x81, 0xe8, 0xbd, 0x7e, 0xe6, 0x99, 0x8a,
+			0xe8, 0xbd, 0x7a, 0xe6, 0xf1, 0x81, 0xe8, 0x11, 0x72, 0xe6, 0xf1, 0x7d, 0xe8, 0xbd, 0x7a, 0xe6,
+			0x45, 0x75, 0xe8, 0xbd, 0x7e, 0xe6, 0xf1, 0x7d, 0xe8, 0x69, 0x87, 0xe2, 0x55, 0x69, 0xad, 0x8e,
+			0xe8, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		AVRadio: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad,
+			0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x99, 0x74, 0x60, 0x55, 0x65,
+			0x25, 0x73, 0x55, 0x66, 0x09, 0x72, 0x40, 0xad, 0x68, 0x69, 0x70, 0x02, 0xbd, 0x89, 0x11, 0x63,
+			0x96, 0x69, 0x66, 0x11, 0x73, 0x60, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x60, 0x79, 0x99, 0x35,
+			0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0xad, 0x96, 0x60, 0xad, 0x9a, 0x45, 0x98, 0x79, 0x99, 0x79,
+			0x99, 0x40, 0xad, 0x96, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0xad, 0x96, 0xe6, 0xad,
+			0x96, 0xe8, 0x84, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe2, 0x49, 0x75, 0x79, 0x92, 0x61, 0x70,
+			0x79, 0x92, 0xdd, 0x79, 0x99, 0x90, 0xbd, 0x7b, 0xb5, 0x8e, 0xbd, 0x7b, 0xfd, 0x8b, 0x40, 0xdd,
+			0x79, 0x69, 0x87, 0x60, 0xf9, 0x77, 0x89, 0x85, 0x41, 0x75, 0x89, 0x85, 0x40, 0xad, 0x70, 0x69,
+			0x87, 0x60, 0xcd, 0x6e, 0x4d, 0x89, 0xcd, 0x6e, 0x05, 0x8c, 0x40, 0xad, 0x70, 0x99, 0x90, 0x60,
+			0x91, 0x72, 0x79, 0x92, 0x49, 0x75, 0x79, 0x92, 0xe2, 0x55, 0x69, 0x7c, 0xe6, 0x98, 0xe8, 0x74,
+			0xe6, 0xa0, 0xe8, 0x7c, 0xe6, 0xad, 0x96, 0xe8, 0x68, 0xe6, 0x55, 0x69, 0xe8, 0x7c, 0xe2, 0x55,
+			0x69, 0xad, 0x96, 0xe8, 0x84, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		AVRecentActors: AVRecentActors,
+		AVRemoveFromQueue: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0x25, 0x7f, 0xe6, 0xad, 0x8a, 0xe8,
+			0x25, 0x7b, 0xe6, 0x55, 0x75, 0xe8, 0x25, 0x7f, 0xe2, 0x6c, 0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x55,
+			0x69, 0x60, 0xbd, 0x67, 0xad, 0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e, 0xe8,
+			0x58, 0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xad, 0x8e,
+			0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x94,
+			0xe8, 0xb0, 0xe6, 0x6c, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55,
+			0x69, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		AVRepeat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0xad, 0x9a, 0x03, 0x50, 0xa0, 0xad,
+			0x72, 0x55, 0x85, 0x79, 0x75, 0x45, 0x88, 0xbd, 0x6f, 0x9c, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x83,
+			0xe6, 0xad, 0x92, 0xe8, 0xa4, 0xe6, 0xbd, 0x6f, 0x01, 0x79, 0x75, 0xbd, 0x97, 0xad, 0x72, 0xad,
+			0x9a, 0xe2, 0x55, 0x6d, 0xad, 0x7c, 0xe8, 0x5c, 0xe6, 0x45, 0x90, 0x05, 0x89, 0x8a, 0x45, 0x68,
+			0x55, 0x8d, 0x55, 0x65, 0xb0, 0x60, 0x55, 0x8d, 0xad, 0x7a, 0x89, 0x8a, 0xbd, 0x77, 0x45, 0x90,
+			0x64, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x7c, 0xe6, 0x55, 0x6d, 0xe1,
+		}},
+		AVRepeatOne: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0xad, 0x9a, 0x03, 0x50, 0xa0, 0xad,
+			0x72, 0x55, 0x85, 0x79, 0x75, 0x45, 0x88, 0xbd, 0x6f, 0x9c, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x83,
+			0xe6, 0xad, 0x92, 0xe8, 0xa4, 0xe6, 0xbd, 0x6f, 0x01, 0x79, 0x75, 0xbd, 0x97, 0xad, 0x72, 0xad,
+			0x9a, 0xe2, 0x11, 0x7f, 0xdd, 0x87, 0xe8, 0x45, 0x7b, 0xe6, 0x55, 0x7b, 0xe8, 0x70, 0xe6, 0x55,
+			0x82, 0xe8, 0xdd, 0x87, 0xe6, 0x11, 0x7f, 0xe2, 0x55, 0x6d, 0xad, 0x7c, 0xe8, 0x5c, 0xe6, 0x45,
+			0x90, 0x05, 0x89, 0x8a, 0x45, 0x68, 0x55, 0x8d, 0x55, 0x65, 0xb0, 0x60, 0x55, 0x8d, 0xad, 0x7a,
+			0x89, 0x8a, 0xbd, 0x77, 0x45, 0x90, 0x64, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x7c, 0xe6, 0x55, 0x6d,
+			0xe1,
+		}},
+		AVReplay: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5,
+			0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c,
+			0x40, 0x50, 0xad, 0x82, 0xe6, 0x58, 0x60, 0x58, 0x96, 0xd5, 0x71, 0xd5, 0x90, 0x40, 0x80, 0xad,
+			0x96, 0x60, 0x55, 0x88, 0xad, 0x96, 0x2d, 0x8e, 0xd5, 0x90, 0x40, 0xa8, 0xad, 0x82, 0x60, 0xa8,
+			0x55, 0x7a, 0x55, 0x8e, 0x81, 0x74, 0x40, 0x55, 0x80, 0xad, 0x6e, 0xe6, 0xcd, 0x7e, 0x05, 0xad,
+			0x83, 0x89, 0x73, 0xf1, 0x80, 0x55, 0x76, 0x25, 0x77, 0x89, 0x6c, 0xf1, 0x80, 0xbd, 0x62, 0xad,
+			0x83, 0x79, 0x65, 0x79, 0x7e, 0xad, 0x6a, 0xe6, 0x80, 0x60, 0x8a, 0xad, 0x6a, 0x5d, 0x89, 0x89,
+			0x6c, 0x40, 0xa2, 0xad, 0x71, 0x60, 0x45, 0x94, 0xf1, 0x74, 0x25, 0x96, 0x4d, 0x79, 0x40, 0xb0,
+			0xad, 0x82, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad, 0x93, 0x60, 0xbd,
+			0x8d, 0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		AVReplay10: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5,
+			0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c,
+			0x40, 0x50, 0xad, 0x82, 0xe6, 0x58, 0x61, 0x58, 0x96, 0xd5, 0x71, 0xd5, 0x90, 0xa5, 0x77, 0xad,
+			0x96, 0x80, 0xad, 0x96, 0x40, 0x2d, 0x8e, 0xd9, 0x90, 0x60, 0xa8, 0x09, 0x8b, 0xa8, 0xad, 0x82,
+			0x40, 0x55, 0x8e, 0x7d, 0x74, 0x60, 0xad, 0x88, 0xad, 0x6e, 0x55, 0x80, 0xad, 0x6e, 0xe6, 0xdd,
+			0x7e, 0x05, 0xbd, 0x83, 0x89, 0x73, 0xf1, 0x80, 0x55, 0x76, 0x25, 0x77, 0x89, 0x6c, 0xf1, 0x80,
+			0xbd, 0x62, 0xad, 0x83, 0x79, 0x65, 0x79, 0x7e, 0xad, 0x6a, 0xe6, 0x80, 0x60, 0x8a, 0xad, 0x6a,
+			0x5d, 0x89, 0x89, 0x6c, 0x40, 0xa2, 0xad, 0x71, 0x60, 0x45, 0x94, 0xf1, 0x74, 0x25, 0x96, 0x4d,
+			0x79, 0x40, 0xb0, 0xad, 0x82, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad,
+			0x93, 0x60, 0xbd, 0x8d, 0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x70,
+			0x55, 0x8b, 0xe8, 0x35, 0x7d, 0xe6, 0x69, 0x74, 0xe8, 0xf1, 0x79, 0xe6, 0x55, 0x7b, 0xe8, 0x55,
+			0x8b, 0xe6, 0x70, 0xe2, 0xcd, 0x81, 0x55, 0x8b, 0x61, 0x8d, 0x80, 0x55, 0x8b, 0xb5, 0x7f, 0x7d,
+			0x8a, 0xdd, 0x7e, 0xa5, 0x89, 0xdd, 0x7e, 0x69, 0x88, 0xe8, 0xdd, 0x7c, 0x61, 0xdd, 0x7e, 0xa1,
+			0x7b, 0xb5, 0x7f, 0xc9, 0x7a, 0x8d, 0x80, 0xf1, 0x79, 0xcd, 0x81, 0xf1, 0x79, 0xe6, 0x55, 0x87,
+			0x61, 0x95, 0x88, 0xf1, 0x79, 0x6d, 0x89, 0xc9, 0x7a, 0x45, 0x8a, 0xa1, 0x7b, 0x45, 0x8a, 0xdd,
+			0x7c, 0xe8, 0x69, 0x88, 0x61, 0x45, 0x8a, 0xa5, 0x89, 0x6d, 0x89, 0x7d, 0x8a, 0x95, 0x88, 0x55,
+			0x8b, 0x55, 0x87, 0x55, 0x8b, 0xe6, 0xcd, 0x81, 0xe2, 0x35, 0x82, 0x90, 0xe6, 0xf1, 0x86, 0xe8,
+			0x35, 0x7d, 0xe6, 0x35, 0x82, 0xe8, 0x90, 0xe1,
+		}},
+		AVReplay30: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5,
+			0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c,
+			0x40, 0x50, 0xad, 0x82, 0xe6, 0x58, 0x61, 0x58, 0x96, 0xd5, 0x71, 0xd5, 0x90, 0xa5, 0x77, 0xad,
+			0x96, 0x80, 0xad, 0x96, 0x40, 0x2d, 0x8e, 0xd9, 0x90, 0x60, 0xa8, 0x09, 0x8b, 0xa8, 0xad, 0x82,
+			0x40, 0x55, 0x8e, 0x7d, 0x74, 0x60, 0xad, 0x88, 0xad, 0x6e, 0x55, 0x80, 0xad, 0x6e, 0xe6, 0xdd,
+			0x7e, 0x05, 0xbd, 0x83, 0x89, 0x73, 0xf1, 0x80, 0x55, 0x76, 0x25, 0x77, 0x89, 0x6c, 0xf1, 0x80,
+			0xbd, 0x62, 0xad, 0x83, 0x79, 0x65, 0x79, 0x7e, 0xad, 0x6a, 0xe6, 0x80, 0x60, 0x8a, 0xad, 0x6a,
+			0x5d, 0x89, 0x89, 0x6c, 0x40, 0xa2, 0xad, 0x71, 0x60, 0x45, 0x94, 0xf1, 0x74, 0x25, 0x96, 0x4d,
+			0x79, 0x40, 0xb0, 0xad, 0x82, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad,
+			0x93, 0x60, 0xbd, 0x8d, 0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0xbd,
+			0x72, 0x55, 0x8b, 0xe8, 0x90, 0xe6, 0xcd, 0x7a, 0xe8, 0x55, 0x84, 0xe6, 0x55, 0x75, 0xe8, 0x82,
+			0xe6, 0xcd, 0x7a, 0xe8, 0x45, 0x7d, 0xe6, 0xbd, 0x72, 0xe8, 0xf1, 0x79, 0xe6, 0x35, 0x7b, 0x61,
+			0x75, 0x7c, 0xf1, 0x79, 0x4d, 0x7d, 0xc9, 0x7a, 0x25, 0x7e, 0xa1, 0x7b, 0x25, 0x7e, 0xdd, 0x7c,
+			0xe8, 0x69, 0x88, 0x61, 0x25, 0x7e, 0xa5, 0x89, 0x4d, 0x7d, 0x7d, 0x8a, 0x75, 0x7c, 0x55, 0x8b,
+			0x35, 0x7b, 0x55, 0x8b, 0xe6, 0xbd, 0x72, 0xe2, 0xdd, 0x84, 0x55, 0x8b, 0x61, 0xa1, 0x83, 0x55,
+			0x8b, 0xc9, 0x82, 0x7d, 0x8a, 0xf1, 0x81, 0xa5, 0x89, 0xf1, 0x81, 0x69, 0x88, 0xe8, 0xdd, 0x7c,
+			0x61, 0xf1, 0x81, 0xa1, 0x7b, 0xc9, 0x82, 0xc9, 0x7a, 0xa1, 0x83, 0xf1, 0x79, 0xdd, 0x84, 0xf1,
+			0x79, 0xe6, 0x69, 0x8a, 0x61, 0xa5, 0x8b, 0xf1, 0x79, 0x7d, 0x8c, 0xc9, 0x7a, 0x55, 0x8d, 0xa1,
+			0x7b, 0x55, 0x8d, 0xdd, 0x7c, 0xe8, 0x69, 0x88, 0x61, 0x55, 0x8d, 0xa5, 0x89, 0x7d, 0x8c, 0x7d,
+			0x8a, 0xa5, 0x8b, 0x55, 0x8b, 0x69, 0x8a, 0x55, 0x8b, 0xe6, 0xdd, 0x84, 0xe2, 0x45, 0x85, 0x90,
+			0xe6, 0x94, 0xe8, 0x45, 0x7d, 0xe6, 0x45, 0x85, 0xe8, 0x90, 0xe1,
+		}},
+		AVReplay5: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x76, 0xad, 0x9a, 0xa5,
+			0x76, 0xcd, 0x98, 0x40, 0x5e, 0xad, 0x93, 0x60, 0xbd, 0x6b, 0x69, 0x90, 0xdd, 0x69, 0x09, 0x8c,
+			0x40, 0x50, 0xad, 0x82, 0xe6, 0x58, 0x60, 0x58, 0x96, 0xd5, 0x71, 0xd5, 0x90, 0x40, 0x80, 0xad,
+			0x96, 0x60, 0x55, 0x88, 0xad, 0x96, 0x2d, 0x8e, 0xd5, 0x90, 0x40, 0xa8, 0xad, 0x82, 0x60, 0xa8,
+			0x55, 0x7a, 0x55, 0x8e, 0x81, 0x74, 0x40, 0x55, 0x80, 0xad, 0x6e, 0xe6, 0xdd, 0x7e, 0x05, 0xbd,
+			0x83, 0x89, 0x73, 0xf1, 0x80, 0x55, 0x76, 0x25, 0x77, 0x89, 0x6c, 0xf1, 0x80, 0xbd, 0x62, 0xad,
+			0x83, 0x79, 0x65, 0x79, 0x7e, 0xad, 0x6a, 0xe6, 0x80, 0x60, 0x8a, 0xad, 0x6a, 0x5d, 0x89, 0x89,
+			0x6c, 0x40, 0xa2, 0xad, 0x71, 0x60, 0x45, 0x94, 0xf1, 0x74, 0x25, 0x96, 0x4d, 0x79, 0x40, 0xb0,
+			0xad, 0x82, 0x60, 0xb0, 0xad, 0x87, 0x25, 0x96, 0x09, 0x8c, 0x40, 0xa2, 0xad, 0x93, 0x60, 0xbd,
+			0x8d, 0xf1, 0x96, 0x5d, 0x89, 0xcd, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x55, 0x79, 0x55, 0x8b,
+			0xe8, 0x90, 0xe6, 0xcd, 0x81, 0xe8, 0x45, 0x84, 0xe6, 0x55, 0x79, 0xe8, 0xf1, 0x79, 0xe6, 0x11,
+			0x85, 0xe8, 0x35, 0x7d, 0xe6, 0xad, 0x7c, 0xe8, 0x82, 0xe6, 0xcd, 0x82, 0x60, 0xbd, 0x83, 0x82,
+			0x69, 0x84, 0xad, 0x81, 0x40, 0x11, 0x85, 0x45, 0x83, 0xe8, 0x11, 0x89, 0x60, 0x11, 0x85, 0x94,
+			0x69, 0x84, 0xad, 0x8a, 0x40, 0xcd, 0x82, 0x55, 0x8b, 0xe6, 0x55, 0x79, 0xe1,
+		}},
+		AVShuffle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x86, 0xac, 0xe8, 0xa4, 0xe6, 0x11, 0x8f,
+			0x02, 0xcd, 0x82, 0xcd, 0x85, 0x99, 0x85, 0xf1, 0x82, 0xa4, 0x35, 0x8f, 0xe8, 0xbd, 0x86, 0xe6,
+			0xac, 0xe8, 0xac, 0xe6, 0xbd, 0x86, 0xe2, 0xcd, 0x6c, 0xac, 0x01, 0x54, 0x25, 0x93, 0x35, 0x8f,
+			0xf1, 0x6d, 0xe6, 0xbd, 0x86, 0xe8, 0xf1, 0x69, 0xe6, 0xac, 0xe8, 0x35, 0x79, 0xe6, 0xa4, 0xe8,
+			0xcd, 0x70, 0x00, 0xcd, 0x6c, 0xac, 0xe2, 0x55, 0x7a, 0x11, 0x7d, 0x03, 0x54, 0xcd, 0x6c, 0xdd,
+			0x6c, 0xf1, 0x69, 0x35, 0x7d, 0x35, 0x7a, 0x55, 0x7a, 0x11, 0x7d, 0xe1,
+		}},
+		AVSkipNext: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8d, 0xa0, 0xe8, 0x60, 0xe6, 0x55, 0x91,
+			0xe8, 0xa0, 0xe6, 0x55, 0x8d, 0xe2, 0xad, 0x6e, 0xa0, 0xe8, 0x60, 0x01, 0xbd, 0x85, 0x80, 0xad,
+			0x6e, 0xa0, 0xe2, 0xad, 0x72, 0x80, 0xe2, 0xad, 0x72, 0x55, 0x88, 0x01, 0xbd, 0x7e, 0x80, 0xad,
+			0x72, 0xad, 0x77, 0xe8, 0x55, 0x88, 0xe1,
+		}},
+		AVSkipPrevious: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xa0, 0xe8, 0x60, 0xe6, 0xad, 0x72,
+			0xe8, 0xa0, 0xe6, 0xad, 0x6e, 0xe2, 0x55, 0x91, 0xa0, 0x01, 0x45, 0x7a, 0x80, 0x55, 0x91, 0x60,
+			0xe8, 0xa0, 0xe2, 0x55, 0x8d, 0x80, 0xe2, 0x55, 0x8d, 0x55, 0x88, 0xe8, 0xad, 0x77, 0x01, 0x45,
+			0x81, 0x80, 0x55, 0x8d, 0x55, 0x88, 0xe1,
+		}},
+		AVSlowMotionVideo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x6b, 0x69, 0x90, 0x60, 0x89, 0x68, 0x69,
+			0x8d, 0x19, 0x67, 0xb5, 0x89, 0x40, 0x69, 0x65, 0x84, 0xe6, 0x89, 0x69, 0x60, 0xdd, 0x69, 0x25,
+			0x85, 0x56, 0x11, 0x88, 0x40, 0x25, 0x6e, 0x69, 0x8d, 0x00, 0x11, 0x6b, 0x69, 0x90, 0xe2, 0x69,
+			0x65, 0xad, 0x7c, 0x60, 0x89, 0x65, 0xad, 0x78, 0x09, 0x67, 0x6a, 0x40, 0x11, 0x6b, 0x45, 0x6e,
+			0x00, 0x25, 0x6e, 0x45, 0x71, 0x60, 0x35, 0x6c, 0xcd, 0x73, 0x09, 0x6b, 0xad, 0x76, 0x40, 0x89,
+			0x69, 0xad, 0x7c, 0xe6, 0x69, 0x65, 0xe2, 0x69, 0x7d, 0x11, 0x9a, 0x60, 0x79, 0x79, 0x89, 0x99,
+			0xcd, 0x75, 0x35, 0x98, 0x40, 0x5e, 0x89, 0x94, 0x00, 0x11, 0x72, 0x55, 0x91, 0x60, 0x99, 0x74,
+			0x11, 0x93, 0x79, 0x77, 0x4d, 0x94, 0x40, 0x69, 0x7d, 0x11, 0x96, 0xe8, 0x11, 0x9a, 0xe2, 0x35,
+			0x72, 0x55, 0x6d, 0x00, 0x5e, 0x25, 0x6a, 0x60, 0x35, 0x72, 0xbd, 0x67, 0xe9, 0x75, 0x71, 0x66,
+			0x40, 0x99, 0x7d, 0x99, 0x64, 0xe8, 0x99, 0x68, 0x60, 0x79, 0x7a, 0x25, 0x69, 0x99, 0x77, 0x4d,
+			0x6a, 0x40, 0x35, 0x72, 0x55, 0x6d, 0xe2, 0xcd, 0x79, 0xbd, 0x8a, 0xe8, 0xf1, 0x73, 0x01, 0xad,
+			0x8b, 0x55, 0x7f, 0xcd, 0x79, 0xbd, 0x8a, 0xe2, 0xf1, 0x82, 0x11, 0x9a, 0xe8, 0x11, 0x96, 0x60,
+			0x79, 0x8b, 0xcd, 0x94, 0x11, 0x91, 0x5d, 0x8e, 0x40, 0xad, 0x96, 0x55, 0x7f, 0x60, 0xad, 0x96,
+			0xbd, 0x76, 0x11, 0x91, 0x4d, 0x70, 0x40, 0xf1, 0x82, 0x99, 0x68, 0xe8, 0x99, 0x64, 0x60, 0x35,
+			0x8d, 0x99, 0x65, 0xf1, 0x93, 0x4d, 0x6d, 0x40, 0xad, 0x9a, 0x55, 0x7f, 0x60, 0xad, 0x9a, 0xad,
+			0x89, 0xf1, 0x93, 0x55, 0x91, 0x40, 0xf1, 0x82, 0x11, 0x9a, 0xe1,
+		}},
+		AVSnooze: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x79, 0xf1, 0x89, 0xe6, 0x69, 0x86, 0xe8,
+			0x99, 0x86, 0xe6, 0x25, 0x7e, 0x00, 0x69, 0x86, 0x45, 0x7d, 0xe8, 0x74, 0xe6, 0x89, 0x79, 0xe8,
+			0x55, 0x7d, 0xe6, 0xf1, 0x81, 0x00, 0x89, 0x79, 0xcd, 0x86, 0xe8, 0xf1, 0x89, 0xe2, 0xf1, 0x7f,
+			0x89, 0x9a, 0x60, 0x76, 0x89, 0x9a, 0xa5, 0x76, 0xad, 0x98, 0x40, 0x09, 0x6f, 0x91, 0x93, 0x60,
+			0xcd, 0x6b, 0x55, 0x90, 0xe9, 0x69, 0xf9, 0x8b, 0x40, 0x50, 0xa1, 0x82, 0x60, 0x50, 0xa5, 0x7d,
+			0xe9, 0x69, 0x49, 0x79, 0x40, 0x09, 0x6f, 0xad, 0x71, 0x60, 0x45, 0x72, 0x69, 0x6e, 0xa5, 0x76,
+			0x89, 0x6c, 0x40, 0xf1, 0x7f, 0xad, 0x6a, 0x60, 0xdd, 0x84, 0xad, 0x6a, 0x3d, 0x89, 0x89, 0x6c,
+			0x40, 0xdd, 0x90, 0xad, 0x71, 0x60, 0x25, 0x94, 0xf1, 0x74, 0xac, 0x49, 0x79, 0x40, 0xdd, 0x97,
+			0xa1, 0x82, 0x60, 0xdd, 0x97, 0x99, 0x87, 0xac, 0xf9, 0x8b, 0x40, 0xdd, 0x90, 0x91, 0x93, 0x60,
+			0x99, 0x8d, 0xcd, 0x96, 0x3d, 0x89, 0xad, 0x98, 0x40, 0xf1, 0x7f, 0x89, 0x9a, 0xe2, 0xf1, 0x7f,
+			0xbd, 0x82, 0xe2, 0x45, 0x6e, 0x35, 0x66, 0x03, 0x11, 0x71, 0x52, 0x25, 0x66, 0x89, 0x73, 0x55,
+			0x63, 0xbd, 0x70, 0x45, 0x6e, 0x35, 0x66, 0xe2, 0x99, 0x91, 0x35, 0x66, 0x03, 0x89, 0x9c, 0xbd,
+			0x70, 0xbd, 0x99, 0x89, 0x73, 0xcd, 0x8e, 0x52, 0x99, 0x91, 0x35, 0x66, 0xe2, 0xf1, 0x7f, 0x89,
+			0x96, 0x60, 0x45, 0x88, 0x89, 0x96, 0x11, 0x8e, 0xbd, 0x90, 0x40, 0xdd, 0x93, 0x99, 0x82, 0x60,
+			0xdd, 0x93, 0x45, 0x7a, 0x11, 0x8e, 0x79, 0x74, 0x40, 0xf1, 0x7f, 0xad, 0x6e, 0x60, 0x99, 0x77,
+			0xad, 0x6e, 0xcd, 0x71, 0x79, 0x74, 0x40, 0x58, 0x9d, 0x82, 0x60, 0x58, 0xf1, 0x8a, 0xcd, 0x71,
+			0xbd, 0x90, 0x40, 0xf1, 0x7f, 0x89, 0x96, 0xe1,
+		}},
+		AVSortByAlpha: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x55, 0x8d, 0x00, 0x25, 0x70, 0xad,
+			0x72, 0xe6, 0x55, 0x74, 0x00, 0x11, 0x7f, 0x55, 0x8d, 0xe6, 0xdd, 0x7a, 0x00, 0x55, 0x78, 0xbd,
+			0x86, 0xe6, 0x11, 0x6c, 0x00, 0x89, 0x69, 0x55, 0x8d, 0xe6, 0x55, 0x65, 0xe2, 0x69, 0x6d, 0x45,
+			0x83, 0xe6, 0x6e, 0x00, 0x55, 0x72, 0xf1, 0x76, 0xe6, 0x11, 0x72, 0x00, 0x69, 0x6d, 0x45, 0x83,
+			0xe2, 0x89, 0x84, 0x55, 0x8d, 0xe8, 0x35, 0x89, 0x00, 0x11, 0x94, 0x25, 0x76, 0xe6, 0xbd, 0x85,
+			0xe8, 0xad, 0x72, 0xe6, 0xdd, 0x97, 0xe8, 0xdd, 0x76, 0x00, 0x79, 0x88, 0xdd, 0x89, 0xe6, 0xb0,
+			0xe8, 0x55, 0x8d, 0xe6, 0x89, 0x84, 0xe2, 0x99, 0x79, 0xbd, 0x6b, 0x01, 0x80, 0x55, 0x65, 0x69,
+			0x86, 0xbd, 0x6b, 0xe6, 0x99, 0x79, 0xe2, 0x80, 0xad, 0x9a, 0x00, 0x99, 0x79, 0x45, 0x94, 0xe6,
+			0x69, 0x86, 0x00, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		AVStop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x68, 0x68, 0xe8, 0x98, 0xe8, 0x68, 0xe2, 0x60,
+			0xa0, 0xe8, 0x60, 0xe6, 0xa0, 0xe8, 0xa0, 0xe6, 0x60, 0xe2, 0x68, 0x98, 0xe6, 0x98, 0xe8, 0x68,
+			0xe6, 0x68, 0xe8, 0x98, 0xe1,
+		}},
+		AVSubscriptions: AVSubscriptions,
+		AVSubtitles: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0xad, 0x88, 0xe6, 0x90, 0xe8, 0xad, 0x84,
+			0xe6, 0x60, 0xe8, 0xad, 0x88, 0xe2, 0x98, 0xad, 0x88, 0xe6, 0xa0, 0xe8, 0xad, 0x84, 0xe6, 0x98,
+			0xe8, 0xad, 0x88, 0xe2, 0x60, 0xad, 0x80, 0xe6, 0x68, 0xe8, 0xad, 0x7c, 0xe6, 0x60, 0xe8, 0xad,
+			0x80, 0xe2, 0x70, 0xad, 0x80, 0xe6, 0xa0, 0xe8, 0xad, 0x7c, 0xe6, 0x70, 0xe8, 0xad, 0x80, 0xe2,
+			0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55,
+			0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40,
+			0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94,
+			0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96,
+			0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVSurroundSound: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x31, 0x80, 0x79, 0x87, 0x67, 0x45, 0x83, 0x79,
+			0x87, 0x71, 0x85, 0x51, 0x85, 0x99, 0x87, 0x29, 0x83, 0x99, 0x87, 0x15, 0x80, 0x99, 0x87, 0x7a,
+			0x71, 0x85, 0xd5, 0x7a, 0x4d, 0x83, 0xad, 0x78, 0x39, 0x80, 0xad, 0x78, 0x25, 0x7d, 0xad, 0x78,
+			0xf9, 0x7a, 0xd5, 0x7a, 0xcd, 0x78, 0xf9, 0x7c, 0xcd, 0x78, 0x11, 0x80, 0xcd, 0x78, 0x25, 0x83,
+			0xf5, 0x7a, 0x4d, 0x85, 0x1d, 0x7d, 0x79, 0x87, 0x31, 0x80, 0x79, 0x87, 0xe2, 0x9a, 0xdd, 0x8c,
+			0x60, 0x89, 0x8f, 0x55, 0x8a, 0xe9, 0x90, 0x8e, 0x40, 0x45, 0x92, 0x11, 0x80, 0x60, 0x45, 0x92,
+			0x79, 0x7c, 0xe9, 0x90, 0x25, 0x79, 0x40, 0x9a, 0x45, 0x73, 0x00, 0x25, 0x8a, 0x25, 0x76, 0x60,
+			0x11, 0x8c, 0x35, 0x78, 0x2d, 0x8d, 0xc1, 0x7a, 0x40, 0x45, 0x8e, 0x15, 0x80, 0x60, 0x45, 0x8e,
+			0xdd, 0x82, 0x2d, 0x8d, 0x69, 0x85, 0x40, 0x25, 0x8a, 0x94, 0x00, 0x9a, 0xdd, 0x8c, 0xe2, 0x69,
+			0x73, 0xdd, 0x8c, 0x00, 0x45, 0x76, 0x94, 0x60, 0x55, 0x74, 0xf1, 0x87, 0x3d, 0x73, 0x65, 0x85,
+			0x40, 0x25, 0x72, 0x11, 0x80, 0x60, 0x25, 0x72, 0x45, 0x7d, 0x3d, 0x73, 0xbd, 0x7a, 0x40, 0x45,
+			0x76, 0x25, 0x76, 0x00, 0x69, 0x73, 0x45, 0x73, 0x60, 0xdd, 0x70, 0xcd, 0x75, 0x81, 0x6f, 0x25,
+			0x79, 0x40, 0x25, 0x6e, 0x11, 0x80, 0x60, 0x25, 0x6e, 0xad, 0x83, 0x81, 0x6f, 0x8e, 0x40, 0x69,
+			0x73, 0xdd, 0x8c, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xb1, 0x67, 0x55, 0x95, 0x85, 0x66, 0x29,
+			0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x05, 0x6d, 0x85, 0x66,
+			0xd9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x51, 0x98, 0xad, 0x6a, 0x7d,
+			0x99, 0xd9, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xfd, 0x92,
+			0x7d, 0x99, 0x29, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVVideoCall: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x78, 0xad, 0x8a, 0xe6, 0xcd, 0x7c, 0xe8,
+			0xdd, 0x81, 0xe6, 0x99, 0x85, 0xe8, 0xdd, 0x7d, 0xe6, 0xcd, 0x7c, 0xe8, 0x11, 0x75, 0xe6, 0xcd,
+			0x78, 0xe8, 0xdd, 0x7d, 0xe6, 0x60, 0xe8, 0xdd, 0x81, 0xe6, 0xcd, 0x78, 0xe8, 0xad, 0x8a, 0xe2,
+			0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55,
+			0x69, 0xad, 0x6a, 0xe6, 0x98, 0x60, 0x99, 0x8d, 0xad, 0x6a, 0xcd, 0x8e, 0xdd, 0x6b, 0x40, 0xa0,
+			0xad, 0x6e, 0xe8, 0x7a, 0x00, 0xad, 0x9a, 0x55, 0x72, 0xe8, 0xad, 0x8d, 0x00, 0xa0, 0x86, 0xe8,
+			0x55, 0x91, 0x60, 0xa0, 0xf1, 0x92, 0xcd, 0x8e, 0x25, 0x94, 0x40, 0x98, 0x55, 0x95, 0xe6, 0x55,
+			0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0x98, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVVideoLabel: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0xbd, 0x88, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0xbd,
+			0x88, 0xe1,
+		}},
+		AVVideoLibrary: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x7c, 0x25, 0x87, 0x01, 0x99, 0x8d, 0x78,
+			0x45, 0x7c, 0xdd, 0x70, 0xe8, 0x25, 0x87, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad,
+			0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d,
+			0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad,
+			0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40,
+			0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92,
+			0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55,
+			0x69, 0xe1,
+		}},
+		AVVideocam: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x98, 0x60, 0x99, 0x8d,
+			0xad, 0x6a, 0xcd, 0x8e, 0xdd, 0x6b, 0x40, 0xa0, 0xad, 0x6e, 0xe8, 0x7a, 0x00, 0xad, 0x9a, 0x55,
+			0x72, 0xe8, 0xad, 0x8d, 0x00, 0xa0, 0x86, 0xe8, 0x55, 0x91, 0x60, 0xa0, 0xf1, 0x92, 0xcd, 0x8e,
+			0x25, 0x94, 0x40, 0x98, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0x98,
+			0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		AVVideocamOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x9a, 0xad, 0x8d, 0x00, 0xa0, 0x86, 0xe8,
+			0x69, 0x8a, 0x00, 0x98, 0x69, 0x86, 0xe8, 0xad, 0x6e, 0xe6, 0x45, 0x74, 0x00, 0x45, 0x70, 0xad,
+			0x6a, 0xe6, 0x98, 0x60, 0x99, 0x8d, 0xad, 0x6a, 0xcd, 0x8e, 0xdd, 0x6b, 0x40, 0xa0, 0xad, 0x6e,
+			0xe8, 0x7a, 0x00, 0xad, 0x9a, 0x55, 0x72, 0xe8, 0xad, 0x8d, 0xe2, 0x89, 0x98, 0x35, 0x9e, 0x03,
+			0x99, 0x62, 0x45, 0x68, 0x69, 0x65, 0x79, 0x65, 0x55, 0x9b, 0x69, 0x9b, 0x89, 0x98, 0x35, 0x9e,
+			0xe2, 0x45, 0x80, 0xad, 0x7a, 0xe2, 0x79, 0x7a, 0x25, 0x80, 0xe2, 0x99, 0x6a, 0xad, 0x6a, 0x00,
+			0x99, 0x6e, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe6, 0x98, 0xe8, 0x11, 0x8c, 0x00,
+			0xa0, 0x11, 0x90, 0xe8, 0x55, 0x91, 0x60, 0xa0, 0xf1, 0x92, 0xcd, 0x8e, 0x25, 0x94, 0x40, 0x98,
+			0x55, 0x95, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55,
+			0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40,
+			0x55, 0x69, 0xad, 0x6a, 0xe6, 0x99, 0x6a, 0xe1,
+		}},
+		AVVolumeDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0x90, 0xe8, 0x70, 0xe6, 0x70, 0x00,
+			0x55, 0x85, 0xad, 0x6a, 0xe8, 0x55, 0x95, 0x00, 0x70, 0x90, 0xe6, 0x55, 0x6d, 0xe2, 0x55, 0x89,
+			0x35, 0x8b, 0xe8, 0xbd, 0x74, 0x60, 0xf1, 0x8c, 0xdd, 0x75, 0x25, 0x8f, 0x72, 0x40, 0x55, 0x91,
+			0x80, 0x60, 0x55, 0x91, 0xf1, 0x83, 0x25, 0x8f, 0x8e, 0x40, 0x55, 0x89, 0x35, 0x8b, 0xe2, 0x55,
+			0x81, 0xcd, 0x74, 0x00, 0xcd, 0x79, 0x78, 0xe6, 0x55, 0x71, 0xe8, 0x88, 0xe6, 0xcd, 0x79, 0x00,
+			0x55, 0x81, 0x45, 0x8b, 0xe8, 0xcd, 0x74, 0xe2, 0x35, 0x79, 0x80, 0xe1,
+		}},
+		AVVolumeMute: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0x90, 0xe8, 0x70, 0xe6, 0x55, 0x7d,
+			0x00, 0xad, 0x8a, 0xad, 0x6a, 0xe8, 0x55, 0x95, 0x00, 0x55, 0x7d, 0x90, 0xe6, 0xad, 0x72, 0xe2,
+			0xad, 0x76, 0x88, 0xe6, 0x25, 0x7f, 0x00, 0xad, 0x86, 0x45, 0x8b, 0xe8, 0xcd, 0x74, 0x00, 0x25,
+			0x7f, 0x78, 0xe6, 0xad, 0x76, 0xe8, 0x88, 0xe2, 0x99, 0x7e, 0x80, 0xe1,
+		}},
+		AVVolumeOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x96, 0x45, 0x9c, 0x00, 0x69, 0x8d, 0x79,
+			0x93, 0x60, 0x89, 0x8b, 0xcd, 0x94, 0x5d, 0x89, 0xc5, 0x95, 0x40, 0xdd, 0x84, 0x45, 0x97, 0xe8,
+			0x25, 0x93, 0x60, 0x69, 0x86, 0xad, 0x92, 0xd5, 0x87, 0x19, 0x92, 0x40, 0x89, 0x8a, 0x99, 0x90,
+			0x00, 0x89, 0x7f, 0x89, 0x85, 0xe8, 0x55, 0x95, 0x00, 0x35, 0x72, 0x90, 0xe6, 0x89, 0x67, 0xe8,
+			0x70, 0xe6, 0xf1, 0x71, 0x03, 0x45, 0x63, 0x55, 0x69, 0x25, 0x66, 0x79, 0x66, 0x11, 0x99, 0x55,
+			0x99, 0x35, 0x96, 0x45, 0x9c, 0xe2, 0xcd, 0x93, 0xcd, 0x8c, 0x00, 0xf1, 0x90, 0xf1, 0x89, 0x60,
+			0x45, 0x92, 0xad, 0x87, 0xe9, 0x92, 0x25, 0x85, 0x40, 0x89, 0x93, 0xf1, 0x7f, 0x60, 0x89, 0x93,
+			0x0d, 0x79, 0x89, 0x8f, 0xa1, 0x73, 0x40, 0xdd, 0x84, 0xbd, 0x6c, 0xe8, 0x99, 0x68, 0x60, 0x25,
+			0x8d, 0x79, 0x6a, 0x55, 0x92, 0xf9, 0x70, 0x40, 0x89, 0x97, 0xf1, 0x7f, 0x60, 0x89, 0x97, 0x55,
+			0x83, 0x99, 0x96, 0x99, 0x86, 0x40, 0xcd, 0x93, 0xcd, 0x8c, 0xe2, 0xdd, 0x8a, 0xdd, 0x83, 0x00,
+			0xdd, 0x84, 0xdd, 0x7d, 0xe8, 0x35, 0x75, 0x60, 0x90, 0xad, 0x76, 0xc5, 0x89, 0x99, 0x79, 0x40,
+			0x89, 0x8b, 0x80, 0x60, 0x89, 0x8b, 0x82, 0x5d, 0x8b, 0xf9, 0x81, 0x40, 0xdd, 0x8a, 0xdd, 0x83,
+			0xe2, 0x89, 0x7f, 0x89, 0x78, 0x01, 0x99, 0x78, 0x99, 0x71, 0x89, 0x7f, 0xad, 0x6a, 0xe8, 0x89,
+			0x78, 0xe2, 0x89, 0x7b, 0x99, 0x8b, 0xe8, 0x99, 0x81, 0x00, 0xf1, 0x75, 0x78, 0xe6, 0x89, 0x6b,
+			0xe8, 0x88, 0xe6, 0xf1, 0x73, 0x00, 0x89, 0x7b, 0x99, 0x8b, 0xe2, 0xbd, 0x78, 0xcd, 0x7e, 0xe1,
+		}},
+		AVVolumeUp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x85, 0x45, 0x97, 0xe8, 0x25, 0x93, 0x60,
+			0xcd, 0x8b, 0x45, 0x91, 0xe9, 0x8f, 0xf9, 0x8b, 0x40, 0xa8, 0xf1, 0x7f, 0x60, 0xa8, 0x35, 0x79,
+			0xf1, 0x8f, 0xdd, 0x73, 0x40, 0x55, 0x85, 0xbd, 0x6c, 0xe8, 0x99, 0x68, 0x60, 0x99, 0x8d, 0x79,
+			0x6a, 0xcd, 0x92, 0xf9, 0x70, 0x40, 0xb0, 0xf1, 0x7f, 0x60, 0xb0, 0x69, 0x88, 0xcd, 0x92, 0xe9,
+			0x8e, 0x40, 0x55, 0x85, 0x45, 0x97, 0xe2, 0x50, 0x90, 0xe8, 0x70, 0xe6, 0xad, 0x72, 0x00, 0x80,
+			0xad, 0x6a, 0xe8, 0x55, 0x95, 0x00, 0xad, 0x72, 0x90, 0xe6, 0x50, 0xe2, 0x88, 0x35, 0x8b, 0xe8,
+			0xbd, 0x74, 0x60, 0xad, 0x87, 0xdd, 0x75, 0xd5, 0x89, 0x72, 0x40, 0x98, 0x80, 0x60, 0x98, 0xcd,
+			0x83, 0xcd, 0x89, 0xf1, 0x86, 0x40, 0x88, 0x35, 0x8b, 0xe2, 0x78, 0xcd, 0x74, 0x00, 0x79, 0x74,
+			0x78, 0xe6, 0x58, 0xe8, 0x88, 0xe6, 0x79, 0x74, 0x00, 0x78, 0x45, 0x8b, 0xe8, 0xcd, 0x74, 0xe2,
+			0xbd, 0x75, 0x80, 0xe1,
+		}},
+		AVWeb: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0x11, 0x88, 0xe8, 0x79, 0x86, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x11, 0x8c, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0x99, 0x77, 0xe6, 0x11, 0x8c, 0xe8,
+			0x55, 0x91, 0xe2, 0x55, 0x69, 0x79, 0x82, 0xe6, 0x11, 0x88, 0xe8, 0x99, 0x77, 0xe6, 0x55, 0x69,
+			0xe8, 0x79, 0x82, 0xe1,
+		}},
+		AVWebAsset: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0x45, 0x74, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe1,
+		}},
+		Action3DRotation:           Action3DRotation,
+		ActionAccessibility:        ActionAccessibility,
+		ActionAccessible:           ActionAccessible,
+		ActionAccountBalance:       ActionAccountBalance,
+		ActionAccountBalanceWallet: ActionAccountBalanceWallet,
+		ActionAccountBox:           ActionAccountBox,
+		ActionAccountCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x6e, 0x9e, 0x60, 0x66, 0x11, 0x8c, 0x25,
+			0x77, 0x81, 0x8a, 0x40, 0x80, 0xf1, 0x88, 0x60, 0xbd, 0x84, 0xf1, 0x88, 0xe9, 0x88, 0x81, 0x8a,
+			0x40, 0x45, 0x91, 0x9e, 0x60, 0x35, 0x94, 0x69, 0x8b, 0x71, 0x95, 0xbd, 0x87, 0x40, 0xad, 0x96,
+			0x80, 0x60, 0xad, 0x96, 0x55, 0x76, 0x2d, 0x90, 0xd5, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x55,
+			0x76, 0x55, 0x69, 0xd5, 0x6f, 0xd5, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x11, 0x84,
+			0x99, 0x6a, 0xbd, 0x87, 0x40, 0xcd, 0x6e, 0x9e, 0xe2, 0xfd, 0x7f, 0x84, 0x61, 0x25, 0x7c, 0x84,
+			0x81, 0x79, 0x5d, 0x7f, 0xdd, 0x76, 0xb5, 0x7c, 0xdd, 0x76, 0xdd, 0x78, 0x40, 0x85, 0x79, 0x5d,
+			0x72, 0x60, 0x29, 0x7c, 0xbd, 0x6f, 0x05, 0x80, 0xbd, 0x6f, 0x40, 0x81, 0x86, 0x61, 0x72, 0x60,
+			0x25, 0x89, 0x09, 0x75, 0x25, 0x89, 0xe1, 0x78, 0x40, 0x7d, 0x86, 0x5d, 0x7f, 0x60, 0xd9, 0x83,
+			0x84, 0xfd, 0x7f, 0x84, 0xe2, 0x09, 0x80, 0xad, 0x9a, 0x61, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75,
+			0x91, 0x98, 0xcd, 0x70, 0x79, 0x96, 0x2d, 0x6d, 0xd5, 0x92, 0x40, 0x71, 0x67, 0x59, 0x8a, 0x60,
+			0x55, 0x65, 0x81, 0x85, 0x55, 0x65, 0xfd, 0x7f, 0x40, 0x71, 0x67, 0xa5, 0x75, 0x60, 0x89, 0x69,
+			0xcd, 0x70, 0x2d, 0x6d, 0x2d, 0x6d, 0x40, 0xa9, 0x75, 0x71, 0x67, 0x60, 0x81, 0x7a, 0x55, 0x65,
+			0x05, 0x80, 0x55, 0x65, 0x40, 0x5d, 0x8a, 0x71, 0x67, 0x60, 0x35, 0x8f, 0x89, 0x69, 0xd5, 0x92,
+			0x2d, 0x6d, 0x40, 0x91, 0x98, 0xa5, 0x75, 0x60, 0xad, 0x9a, 0x79, 0x7a, 0xad, 0x9a, 0xf9, 0x7f,
+			0x40, 0x91, 0x98, 0x55, 0x8a, 0x60, 0x79, 0x96, 0x35, 0x8f, 0xd5, 0x92, 0xd5, 0x92, 0x40, 0x5d,
+			0x8a, 0x91, 0x98, 0x60, 0x89, 0x85, 0xad, 0x9a, 0x09, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96,
+			0x60, 0xad, 0x83, 0xad, 0x96, 0x2d, 0x87, 0x99, 0x95, 0x40, 0x11, 0x8e, 0xdd, 0x91, 0x60, 0xad,
+			0x8a, 0x79, 0x8f, 0x25, 0x87, 0x35, 0x8e, 0x40, 0x80, 0xf1, 0x8c, 0x60, 0x69, 0x7c, 0xf1, 0x8c,
+			0xdd, 0x78, 0x35, 0x8e, 0x40, 0xf1, 0x71, 0xdd, 0x91, 0x60, 0x55, 0x75, 0x89, 0x94, 0xd5, 0x78,
+			0x99, 0x95, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x7c, 0x60, 0x45, 0x82, 0x7c, 0xb5, 0x83, 0x91,
+			0x7c, 0x40, 0x25, 0x85, 0xdd, 0x78, 0x60, 0x25, 0x85, 0x99, 0x76, 0xb5, 0x83, 0x2d, 0x75, 0x40,
+			0x80, 0xbd, 0x73, 0x60, 0xbd, 0x7d, 0xbd, 0x73, 0x4d, 0x7c, 0x2d, 0x75, 0x40, 0xdd, 0x7a, 0xdd,
+			0x78, 0x60, 0xdd, 0x7a, 0x25, 0x7b, 0x4d, 0x7c, 0x91, 0x7c, 0x40, 0x80, 0x7c, 0xe2, 0x80, 0xdd,
+			0x78, 0xe2, 0x80, 0xcd, 0x91, 0xe1,
+		}},
+		ActionAddShoppingCart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7e, 0x25, 0x77, 0xe8, 0xf1, 0x6e, 0xe6, 0xbd,
+			0x76, 0xe8, 0xf1, 0x6a, 0xe6, 0x7e, 0xe8, 0xbd, 0x62, 0xe6, 0x86, 0xe8, 0xf1, 0x6a, 0xe6, 0x35,
+			0x8b, 0xe8, 0xf1, 0x6e, 0xe6, 0x86, 0xe8, 0x25, 0x77, 0xe6, 0x7e, 0xe2, 0x51, 0x73, 0xad, 0x9a,
+			0x67, 0x55, 0x71, 0xad, 0x9a, 0xf1, 0x6f, 0x41, 0x99, 0x89, 0x6e, 0xd9, 0x97, 0x89, 0x6e, 0xdd,
+			0x95, 0x89, 0x6e, 0xdd, 0x93, 0xf5, 0x6f, 0x79, 0x92, 0x5d, 0x71, 0x11, 0x91, 0x59, 0x73, 0x11,
+			0x91, 0x55, 0x75, 0x11, 0x91, 0xbd, 0x76, 0x7d, 0x92, 0x25, 0x78, 0xe5, 0x93, 0x25, 0x78, 0xe1,
+			0x95, 0x25, 0x78, 0xdd, 0x97, 0xb9, 0x76, 0x45, 0x99, 0x51, 0x75, 0xad, 0x9a, 0x51, 0x73, 0xad,
+			0x9a, 0xe2, 0x41, 0x8e, 0xad, 0x9a, 0x67, 0x45, 0x8c, 0xad, 0x9a, 0xdd, 0x8a, 0x41, 0x99, 0x79,
+			0x89, 0xd9, 0x97, 0x79, 0x89, 0xdd, 0x95, 0x79, 0x89, 0xdd, 0x93, 0xe1, 0x8a, 0x79, 0x92, 0x4d,
+			0x8c, 0x11, 0x91, 0x49, 0x8e, 0x11, 0x91, 0x45, 0x90, 0x11, 0x91, 0xad, 0x91, 0x7d, 0x92, 0x11,
+			0x93, 0xe5, 0x93, 0x11, 0x93, 0xe1, 0x95, 0x11, 0x93, 0xdd, 0x97, 0xa9, 0x91, 0x45, 0x99, 0x3d,
+			0x90, 0xad, 0x9a, 0x41, 0x8e, 0xad, 0x9a, 0xe2, 0x55, 0x73, 0xdd, 0x8c, 0x60, 0x89, 0x70, 0xdd,
+			0x8c, 0x3d, 0x6f, 0x99, 0x8a, 0x40, 0x45, 0x6f, 0x8c, 0x01, 0x55, 0x73, 0x99, 0x7e, 0x55, 0x69,
+			0x55, 0x69, 0xe6, 0x25, 0x64, 0xe8, 0x55, 0x65, 0xe6, 0xdd, 0x6b, 0x00, 0x35, 0x77, 0x99, 0x7d,
+			0xe6, 0xad, 0x8a, 0x02, 0x11, 0x95, 0xf1, 0x6a, 0x89, 0x98, 0xcd, 0x6c, 0x55, 0x8e, 0x45, 0x7f,
+			0x61, 0xb5, 0x8d, 0x61, 0x80, 0xb1, 0x8c, 0xfd, 0x80, 0xad, 0x8b, 0x99, 0x81, 0x45, 0x8a, 0x99,
+			0x81, 0xe6, 0x45, 0x76, 0x00, 0x25, 0x72, 0xdd, 0x88, 0xe6, 0x11, 0x93, 0xe8, 0xdd, 0x8c, 0xe6,
+			0x55, 0x73, 0xe1,
+		}},
+		ActionAlarm: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7f, 0x89, 0x9a, 0x60, 0x76, 0x89, 0x9a,
+			0xa5, 0x76, 0xad, 0x98, 0x40, 0x09, 0x6f, 0x91, 0x93, 0x60, 0xcd, 0x6b, 0x55, 0x90, 0xe9, 0x69,
+			0xf9, 0x8b, 0x40, 0x50, 0xa1, 0x82, 0x60, 0x50, 0xa5, 0x7d, 0xe9, 0x69, 0x49, 0x79, 0x40, 0x09,
+			0x6f, 0xad, 0x71, 0x60, 0x45, 0x72, 0x69, 0x6e, 0xa5, 0x76, 0x89, 0x6c, 0x40, 0xf1, 0x7f, 0xad,
+			0x6a, 0x60, 0xdd, 0x84, 0xad, 0x6a, 0x3d, 0x89, 0x89, 0x6c, 0x40, 0xdd, 0x90, 0xad, 0x71, 0x60,
+			0x25, 0x94, 0xf1, 0x74, 0xac, 0x49, 0x79, 0x40, 0xdd, 0x97, 0xa1, 0x82, 0x60, 0xdd, 0x97, 0x99,
+			0x87, 0xac, 0xf9, 0x8b, 0x40, 0xdd, 0x90, 0x91, 0x93, 0x60, 0x99, 0x8d, 0xcd, 0x96, 0x3d, 0x89,
+			0xad, 0x98, 0x40, 0xf1, 0x7f, 0x89, 0x9a, 0xe2, 0xf1, 0x7f, 0xbd, 0x82, 0xe2, 0x90, 0x79, 0x8d,
+			0x01, 0xcd, 0x8a, 0xad, 0x8a, 0x25, 0x82, 0x84, 0xe8, 0x55, 0x75, 0xe6, 0x25, 0x7e, 0xe8, 0x99,
+			0x83, 0x00, 0x90, 0x79, 0x8d, 0xe2, 0x45, 0x6e, 0x35, 0x66, 0x03, 0x11, 0x71, 0x52, 0x25, 0x66,
+			0x89, 0x73, 0x55, 0x63, 0xbd, 0x70, 0x45, 0x6e, 0x35, 0x66, 0xe2, 0x99, 0x91, 0x35, 0x66, 0x03,
+			0x89, 0x9c, 0xbd, 0x70, 0xbd, 0x99, 0x89, 0x73, 0xcd, 0x8e, 0x52, 0x99, 0x91, 0x35, 0x66, 0xe2,
+			0xf1, 0x7f, 0x89, 0x96, 0x60, 0x45, 0x88, 0x89, 0x96, 0x11, 0x8e, 0xbd, 0x90, 0x40, 0xdd, 0x93,
+			0x99, 0x82, 0x60, 0xdd, 0x93, 0x45, 0x7a, 0x11, 0x8e, 0x79, 0x74, 0x40, 0xf1, 0x7f, 0xad, 0x6e,
+			0x60, 0x99, 0x77, 0xad, 0x6e, 0xcd, 0x71, 0x79, 0x74, 0x40, 0x58, 0x9d, 0x82, 0x60, 0x58, 0xf1,
+			0x8a, 0xcd, 0x71, 0xbd, 0x90, 0x40, 0xf1, 0x7f, 0x89, 0x96, 0xe1,
+		}},
+		ActionAlarmAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7d, 0x11, 0x8d, 0xe6, 0xf1, 0x81, 0xe8,
+			0x99, 0x84, 0xe6, 0x55, 0x8a, 0xe8, 0x89, 0x80, 0xe6, 0xf1, 0x81, 0xe8, 0x25, 0x78, 0xe6, 0xf1,
+			0x7d, 0xe8, 0x89, 0x80, 0xe6, 0x89, 0x75, 0xe8, 0x99, 0x84, 0xe6, 0xf1, 0x7d, 0xe8, 0x11, 0x8d,
+			0xe2, 0xf1, 0x7f, 0x89, 0x9a, 0x60, 0x76, 0x89, 0x9a, 0xa5, 0x76, 0xad, 0x98, 0x40, 0x09, 0x6f,
+			0x91, 0x93, 0x60, 0xcd, 0x6b, 0x55, 0x90, 0xe9, 0x69, 0xf9, 0x8b, 0x40, 0x50, 0xa1, 0x82, 0x60,
+			0x50, 0xa5, 0x7d, 0xe9, 0x69, 0x49, 0x79, 0x40, 0x09, 0x6f, 0xad, 0x71, 0x60, 0x45, 0x72, 0x69,
+			0x6e, 0xa5, 0x76, 0x89, 0x6c, 0x40, 0xf1, 0x7f, 0xad, 0x6a, 0x60, 0xdd, 0x84, 0xad, 0x6a, 0x3d,
+			0x89, 0x89, 0x6c, 0x40, 0xdd, 0x90, 0xad, 0x71, 0x60, 0x25, 0x94, 0xf1, 0x74, 0xac, 0x49, 0x79,
+			0x40, 0xdd, 0x97, 0xa1, 0x82, 0x60, 0xdd, 0x97, 0x99, 0x87, 0xac, 0xf9, 0x8b, 0x40, 0xdd, 0x90,
+			0x91, 0x93, 0x60, 0x99, 0x8d, 0xcd, 0x96, 0x3d, 0x89, 0xad, 0x98, 0x40, 0xf1, 0x7f, 0x89, 0x9a,
+			0xe2, 0xf1, 0x7f, 0xbd, 0x82, 0xe2, 0x45, 0x6e, 0x35, 0x66, 0x03, 0x11, 0x71, 0x52, 0x25, 0x66,
+			0x89, 0x73, 0x55, 0x63, 0xbd, 0x70, 0x45, 0x6e, 0x35, 0x66, 0xe2, 0x99, 0x91, 0x35, 0x66, 0x03,
+			0x89, 0x9c, 0xbd, 0x70, 0xbd, 0x99, 0x89, 0x73, 0xcd, 0x8e, 0x52, 0x99, 0x91, 0x35, 0x66, 0xe2,
+			0xf1, 0x7f, 0x89, 0x96, 0x60, 0x45, 0x88, 0x89, 0x96, 0x11, 0x8e, 0xbd, 0x90, 0x40, 0xdd, 0x93,
+			0x99, 0x82, 0x60, 0xdd, 0x93, 0x45, 0x7a, 0x11, 0x8e, 0x79, 0x74, 0x40, 0xf1, 0x7f, 0xad, 0x6e,
+			0x60, 0x99, 0x77, 0xad, 0x6e, 0xcd, 0x71, 0x79, 0x74, 0x40, 0x58, 0x9d, 0x82, 0x60, 0x58, 0xf1,
+			0x8a, 0xcd, 0x71, 0xbd, 0x90, 0x40, 0xf1, 0x7f, 0x89, 0x96, 0xe1,
+		}},
+		ActionAlarmOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x94, 0xad, 0x8e, 0x00, 0x35, 0x91, 0xad,
+			0x8b, 0x60, 0x55, 0x92, 0x99, 0x89, 0xdd, 0x92, 0x4d, 0x87, 0x40, 0x69, 0x93, 0x99, 0x82, 0x60,
+			0x89, 0x93, 0x11, 0x7a, 0x79, 0x8d, 0x69, 0x74, 0x40, 0x11, 0x7f, 0xbd, 0x6e, 0x60, 0xcd, 0x7c,
+			0xbd, 0x6e, 0x91, 0x7a, 0x45, 0x6f, 0x40, 0x69, 0x76, 0xdd, 0x70, 0x00, 0x79, 0x73, 0xf1, 0x6d,
+			0x60, 0x35, 0x76, 0x45, 0x6c, 0x11, 0x79, 0x81, 0x6b, 0x40, 0x11, 0x7f, 0xbd, 0x6a, 0x60, 0x11,
+			0x84, 0xbd, 0x6a, 0x81, 0x88, 0x91, 0x6c, 0x40, 0x45, 0x90, 0x99, 0x71, 0x60, 0x99, 0x93, 0xcd,
+			0x74, 0x89, 0x95, 0x2d, 0x79, 0x40, 0x69, 0x97, 0x99, 0x82, 0x60, 0x55, 0x97, 0xbd, 0x85, 0x89,
+			0x96, 0xcd, 0x88, 0x40, 0x35, 0x94, 0xad, 0x8e, 0xe2, 0xdd, 0x98, 0xdd, 0x73, 0x03, 0xf1, 0x8d,
+			0xdd, 0x68, 0xf1, 0x90, 0x45, 0x66, 0xf1, 0x9b, 0xcd, 0x70, 0xdd, 0x98, 0xdd, 0x73, 0xe2, 0x11,
+			0x98, 0xdd, 0x9d, 0x00, 0x35, 0x8f, 0xaa, 0x60, 0x35, 0x8c, 0xad, 0x97, 0x11, 0x88, 0x2d, 0x99,
+			0x40, 0x79, 0x7f, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0x35, 0x76, 0xcd, 0x98, 0x40, 0xad,
+			0x6e, 0xad, 0x93, 0x60, 0x79, 0x6b, 0x69, 0x90, 0x99, 0x69, 0x98, 0x40, 0xbd, 0x67, 0x89, 0x82,
+			0x60, 0xbd, 0x67, 0x45, 0x7e, 0x25, 0x69, 0x79, 0x7a, 0x40, 0x79, 0x6d, 0x11, 0x73, 0x07, 0x54,
+			0x99, 0x6f, 0x11, 0x66, 0x89, 0x73, 0x11, 0x63, 0x89, 0x70, 0x4e, 0x99, 0x6c, 0x55, 0x62, 0xf1,
+			0x67, 0x35, 0x65, 0x11, 0x65, 0xf1, 0x9a, 0xb6, 0x11, 0x98, 0xdd, 0x9d, 0xe2, 0x79, 0x7f, 0x89,
+			0x96, 0x60, 0xf1, 0x82, 0x79, 0x96, 0x35, 0x86, 0x4d, 0x95, 0x40, 0x35, 0x8c, 0xf1, 0x91, 0x00,
+			0x25, 0x70, 0xdd, 0x75, 0x60, 0xdd, 0x6d, 0x99, 0x78, 0xb5, 0x6c, 0xd5, 0x7b, 0x40, 0x89, 0x6b,
+			0x99, 0x82, 0x60, 0x89, 0x6b, 0xf1, 0x8a, 0x45, 0x71, 0xcd, 0x90, 0x40, 0x79, 0x7f, 0x89, 0x96,
+			0xe2, 0x35, 0x7e, 0xdd, 0x83, 0xe2, 0xcd, 0x83, 0x45, 0x7e, 0xe1,
+		}},
+		ActionAlarmOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7d, 0x99, 0x8b, 0x05, 0x98, 0xbd, 0x7c,
+			0x25, 0x89, 0xdd, 0x79, 0x25, 0x7d, 0xdd, 0x85, 0xdd, 0x76, 0x99, 0x7f, 0x68, 0x79, 0x82, 0x25,
+			0x7d, 0x99, 0x8b, 0xe2, 0xf1, 0x7f, 0x89, 0x9a, 0x60, 0x76, 0x89, 0x9a, 0xa5, 0x76, 0xad, 0x98,
+			0x40, 0x09, 0x6f, 0x91, 0x93, 0x60, 0xcd, 0x6b, 0x55, 0x90, 0xe9, 0x69, 0xf9, 0x8b, 0x40, 0x50,
+			0xa1, 0x82, 0x60, 0x50, 0xa5, 0x7d, 0xe9, 0x69, 0x49, 0x79, 0x40, 0x09, 0x6f, 0xad, 0x71, 0x60,
+			0x45, 0x72, 0x69, 0x6e, 0xa5, 0x76, 0x89, 0x6c, 0x40, 0xf1, 0x7f, 0xad, 0x6a, 0x60, 0xdd, 0x84,
+			0xad, 0x6a, 0x3d, 0x89, 0x89, 0x6c, 0x40, 0xdd, 0x90, 0xad, 0x71, 0x60, 0x25, 0x94, 0xf1, 0x74,
+			0xac, 0x49, 0x79, 0x40, 0xdd, 0x97, 0xa1, 0x82, 0x60, 0xdd, 0x97, 0x99, 0x87, 0xac, 0xf9, 0x8b,
+			0x40, 0xdd, 0x90, 0x91, 0x93, 0x60, 0x99, 0x8d, 0xcd, 0x96, 0x3d, 0x89, 0xad, 0x98, 0x40, 0xf1,
+			0x7f, 0x89, 0x9a, 0xe2, 0xf1, 0x7f, 0xbd, 0x82, 0xe2, 0x45, 0x6e, 0x35, 0x66, 0x03, 0x11, 0x71,
+			0x52, 0x25, 0x66, 0x89, 0x73, 0x55, 0x63, 0xbd, 0x70, 0x45, 0x6e, 0x35, 0x66, 0xe2, 0x99, 0x91,
+			0x35, 0x66, 0x03, 0x89, 0x9c, 0xbd, 0x70, 0xbd, 0x99, 0x89, 0x73, 0xcd, 0x8e, 0x52, 0x99, 0x91,
+			0x35, 0x66, 0xe2, 0xf1, 0x7f, 0x89, 0x96, 0x60, 0x45, 0x88, 0x89, 0x96, 0x11, 0x8e, 0xbd, 0x90,
+			0x40, 0xdd, 0x93, 0x99, 0x82, 0x60, 0xdd, 0x93, 0x45, 0x7a, 0x11, 0x8e, 0x79, 0x74, 0x40, 0xf1,
+			0x7f, 0xad, 0x6e, 0x60, 0x99, 0x77, 0xad, 0x6e, 0xcd, 0x71, 0x79, 0x74, 0x40, 0x58, 0x9d, 0x82,
+			0x60, 0x58, 0xf1, 0x8a, 0xcd, 0x71, 0xbd, 0x90, 0x40, 0xf1, 0x7f, 0x89, 0x96, 0xe1,
+		}},
+		ActionAllOut: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0x55, 0x8c, 0xe6, 0x58, 0xe8,
+			0xa8, 0xe6, 0xad, 0x73, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x55, 0x8c, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8,
+			0xe8, 0x55, 0x8c, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0x55, 0x8c, 0xe2, 0x80, 0xa8, 0x60, 0xad, 0x77,
+			0xa8, 0xd5, 0x71, 0x2d, 0x8e, 0x40, 0x58, 0x80, 0x60, 0x58, 0xad, 0x77, 0xd5, 0x71, 0xd5, 0x71,
+			0x40, 0x80, 0x58, 0x60, 0x55, 0x88, 0x58, 0x2d, 0x8e, 0xd5, 0x71, 0x40, 0xa8, 0x80, 0x60, 0xa8,
+			0x55, 0x88, 0x2d, 0x8e, 0x2d, 0x8e, 0x40, 0x80, 0xa8, 0xe2, 0x80, 0xa0, 0x67, 0xa5, 0x86, 0xa0,
+			0x51, 0x8b, 0x51, 0x8b, 0xa0, 0xa5, 0x86, 0xa0, 0x80, 0xa0, 0x5d, 0x79, 0x51, 0x8b, 0xb1, 0x74,
+			0xa5, 0x86, 0x60, 0x80, 0x60, 0x5d, 0x79, 0x60, 0xb1, 0x74, 0xb1, 0x74, 0x60, 0x5d, 0x79, 0x60,
+			0x80, 0x60, 0xa5, 0x86, 0xb1, 0x74, 0x51, 0x8b, 0x5d, 0x79, 0xa0, 0x80, 0xa0, 0xe2, 0x50, 0xad,
+			0x73, 0xe8, 0x50, 0xe6, 0xad, 0x73, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xad, 0x73, 0xe6, 0x50, 0xe2,
+			0xa8, 0xad, 0x73, 0xe8, 0x58, 0xe6, 0x55, 0x8c, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0xad, 0x73, 0xe6,
+			0xa8, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionAndroid: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0x11, 0x90, 0x60, 0x35, 0x63, 0x92,
+			0x4e, 0xf9, 0x82, 0x40, 0x11, 0x71, 0x69, 0x79, 0x00, 0x11, 0x6c, 0xcd, 0x70, 0x60, 0xdd, 0x6b,
+			0x35, 0x70, 0x09, 0x6c, 0x99, 0x6f, 0x40, 0xbd, 0x6c, 0xad, 0x6e, 0x60, 0x55, 0x6d, 0x55, 0x6e,
+			0x09, 0x6e, 0x89, 0x6e, 0x40, 0x11, 0x6f, 0x55, 0x6f, 0x00, 0x68, 0xcd, 0x77, 0x60, 0xbd, 0x79,
+			0x55, 0x75, 0x80, 0x55, 0x75, 0x40, 0x98, 0xcd, 0x77, 0x00, 0xa2, 0x55, 0x6f, 0x60, 0x55, 0x91,
+			0xbd, 0x6e, 0x09, 0x92, 0x89, 0x6e, 0x40, 0x55, 0x93, 0xad, 0x6e, 0x60, 0xdd, 0x93, 0x5e, 0x19,
+			0x94, 0xa5, 0x6f, 0x40, 0xa8, 0xcd, 0x70, 0x00, 0xf1, 0x8e, 0x69, 0x79, 0x60, 0x35, 0x95, 0xf1,
+			0x7c, 0xb2, 0xf9, 0x82, 0x40, 0x55, 0x9d, 0x11, 0x90, 0xe6, 0xad, 0x62, 0xe2, 0xad, 0x72, 0xbd,
+			0x88, 0x60, 0x68, 0xbd, 0x88, 0x6a, 0xbd, 0x87, 0x40, 0x6c, 0x69, 0x85, 0x60, 0x6c, 0x11, 0x84,
+			0x6a, 0x11, 0x83, 0x40, 0xad, 0x72, 0x11, 0x82, 0x60, 0x55, 0x71, 0x11, 0x82, 0x55, 0x70, 0x11,
+			0x83, 0x40, 0x55, 0x6f, 0x69, 0x85, 0x60, 0x55, 0x6f, 0xbd, 0x86, 0x55, 0x70, 0xbd, 0x87, 0x40,
+			0xad, 0x72, 0xbd, 0x88, 0xe2, 0x55, 0x8d, 0xbd, 0x88, 0x60, 0xad, 0x8e, 0xbd, 0x88, 0xad, 0x8f,
+			0xbd, 0x87, 0x40, 0xad, 0x90, 0x69, 0x85, 0x60, 0xad, 0x90, 0x11, 0x84, 0xad, 0x8f, 0x11, 0x83,
+			0x40, 0x55, 0x8d, 0x11, 0x82, 0x60, 0x98, 0x11, 0x82, 0x96, 0x11, 0x83, 0x40, 0x94, 0x69, 0x85,
+			0x60, 0x94, 0xbd, 0x86, 0x96, 0xbd, 0x87, 0x40, 0x55, 0x8d, 0xbd, 0x88, 0xe1,
+		}},
+		ActionAnnouncement: ActionAnnouncement,
+		ActionAspectRatio: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x86, 0xbd, 0x8d, 0xe6, 0x35, 0x93, 0xe8,
+			0xcd, 0x80, 0xe6, 0x35, 0x8f, 0xe8, 0xbd, 0x89, 0xe6, 0x79, 0x86, 0xe8, 0xbd, 0x8d, 0xe2, 0xdd,
+			0x6c, 0x35, 0x7f, 0xe6, 0xdd, 0x70, 0xe8, 0x45, 0x76, 0xe6, 0x99, 0x79, 0xe8, 0x45, 0x72, 0xe6,
+			0xdd, 0x6c, 0xe8, 0x35, 0x7f, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89,
+			0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d,
+			0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad,
+			0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a,
+			0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55,
+			0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2,
+			0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ActionAssessment:         ActionAssessment,
+		ActionAssignment:         ActionAssignment,
+		ActionAssignmentInd:      ActionAssignmentInd,
+		ActionAssignmentLate:     ActionAssignmentLate,
+		ActionAssignmentReturn:   ActionAssignmentReturn,
+		ActionAssignmentReturned: ActionAssignmentReturned,
+		ActionAssignmentTurnedIn: ActionAssignmentTurnedIn,
+		ActionAutorenew: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x6d, 0xf1, 0x89, 0x60, 0xbd, 0x6b, 0x89,
+			0x87, 0x35, 0x6b, 0x19, 0x85, 0x40, 0xad, 0x6a, 0x25, 0x80, 0x60, 0xad, 0x6a, 0x69, 0x77, 0xf9,
+			0x70, 0x19, 0x71, 0x40, 0x80, 0xcd, 0x6a, 0xe6, 0xdd, 0x82, 0x05, 0x89, 0x7d, 0x79, 0x65, 0x25,
+			0x80, 0xdd, 0x62, 0x11, 0x8a, 0xcd, 0x6c, 0x25, 0x80, 0xbd, 0x76, 0x79, 0x7d, 0x11, 0x74, 0xbd,
+			0x82, 0xcd, 0x6e, 0xe6, 0x80, 0x60, 0xdd, 0x78, 0xcd, 0x6e, 0xc5, 0x73, 0xe9, 0x73, 0x40, 0xad,
+			0x6e, 0x25, 0x80, 0x60, 0xad, 0x6e, 0x11, 0x82, 0x09, 0x6f, 0xcd, 0x83, 0x40, 0xf1, 0x6f, 0x11,
+			0x87, 0x00, 0x11, 0x6d, 0xf1, 0x89, 0xe2, 0xbd, 0x7f, 0x55, 0x9d, 0x03, 0xcd, 0x75, 0x69, 0x93,
+			0xbd, 0x7f, 0x79, 0x89, 0x55, 0x82, 0x11, 0x8c, 0x7a, 0x69, 0x91, 0xe6, 0x80, 0x60, 0x25, 0x87,
+			0x69, 0x91, 0x3d, 0x8c, 0x4d, 0x8c, 0x40, 0x55, 0x91, 0x11, 0x80, 0x60, 0x55, 0x91, 0x25, 0x7e,
+			0xa2, 0x69, 0x7c, 0x40, 0xa0, 0x25, 0x79, 0x00, 0xdd, 0x92, 0x45, 0x76, 0x60, 0x35, 0x94, 0xad,
+			0x78, 0xc5, 0x94, 0x19, 0x7b, 0x40, 0x55, 0x95, 0x11, 0x80, 0x60, 0x55, 0x95, 0xcd, 0x88, 0x09,
+			0x8f, 0x19, 0x8f, 0x40, 0x80, 0x69, 0x95, 0xe6, 0x7a, 0x01, 0x55, 0x82, 0xbd, 0x9a, 0xbd, 0x7f,
+			0x55, 0x9d, 0xe1,
+		}},
+		ActionBackup: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0x55, 0x95, 0x60, 0xf1, 0x6a, 0x55,
+			0x95, 0xcd, 0x66, 0x35, 0x91, 0x40, 0xad, 0x62, 0x55, 0x87, 0x60, 0xad, 0x62, 0x25, 0x82, 0xf9,
+			0x65, 0x2d, 0x7e, 0x40, 0x79, 0x6e, 0x69, 0x79, 0x60, 0xcd, 0x6f, 0xf1, 0x72, 0xbd, 0x74, 0xd5,
+			0x6e, 0x40, 0x25, 0x80, 0xbd, 0x6a, 0x60, 0xad, 0x87, 0xbd, 0x6a, 0xc5, 0x8c, 0x2d, 0x70, 0x40,
+			0xdd, 0x91, 0x35, 0x7d, 0xe8, 0xcd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xe9, 0x81, 0x40,
+			0x55, 0x9d, 0x11, 0x8a, 0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55, 0x95,
+			0xe6, 0x84, 0x60, 0x69, 0x80, 0x55, 0x95, 0x35, 0x7f, 0x25, 0x94, 0x40, 0x7c, 0x55, 0x91, 0xe8,
+			0x25, 0x80, 0x05, 0x79, 0x78, 0xad, 0x85, 0x99, 0x75, 0xcd, 0x82, 0x80, 0x69, 0x78, 0x69, 0x8a,
+			0xcd, 0x82, 0x89, 0x87, 0xad, 0x85, 0x84, 0x25, 0x80, 0xe8, 0x55, 0x91, 0xe6, 0x11, 0x92, 0x60,
+			0x11, 0x95, 0x55, 0x91, 0x35, 0x97, 0x35, 0x8f, 0x40, 0x55, 0x99, 0x11, 0x8a, 0x60, 0x55, 0x99,
+			0x11, 0x87, 0x35, 0x97, 0xf1, 0x84, 0x40, 0x11, 0x92, 0xcd, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35,
+			0x7d, 0x60, 0xdd, 0x8d, 0x45, 0x77, 0xd5, 0x89, 0x66, 0x40, 0xdd, 0x7f, 0xbd, 0x6e, 0x60, 0xf1,
+			0x79, 0xbd, 0x6e, 0xdd, 0x75, 0x66, 0x40, 0xcd, 0x71, 0x35, 0x7d, 0xe6, 0x89, 0x70, 0x60, 0x69,
+			0x6c, 0x35, 0x7d, 0x89, 0x69, 0x19, 0x80, 0x40, 0xad, 0x66, 0x45, 0x87, 0x61, 0xad, 0x66, 0x69,
+			0x8b, 0x99, 0x69, 0x5d, 0x8e, 0x89, 0x6c, 0x55, 0x91, 0xad, 0x70, 0x55, 0x91, 0xe6, 0x74, 0xe8,
+			0x55, 0x95, 0xe6, 0xad, 0x70, 0xe2, 0x80, 0x84, 0xe1,
+		}},
+		ActionBook: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad,
+			0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a,
+			0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0x55, 0x91, 0x60, 0xf1,
+			0x92, 0x55, 0x65, 0x25, 0x94, 0x89, 0x66, 0x40, 0x55, 0x95, 0x55, 0x69, 0xe8, 0xad, 0x96, 0x60,
+			0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e,
+			0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8d, 0xe8, 0x11,
+			0x7b, 0x01, 0xdd, 0x86, 0x55, 0x77, 0x69, 0x80, 0x11, 0x7b, 0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6e,
+			0xe8, 0xad, 0x96, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe2, 0x69,
+			0x80, 0x11, 0x7b, 0x03, 0xdd, 0x86, 0x55, 0x77, 0x55, 0x8d, 0x11, 0x7b, 0xdd, 0x86, 0x55, 0x77,
+			0x69, 0x80, 0x11, 0x7b, 0xe1,
+		}},
+		ActionBookmark: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xb0, 0xe8, 0xad, 0x6b, 0x60, 0x55,
+			0x6d, 0x11, 0x6a, 0x89, 0x6e, 0xdd, 0x68, 0x40, 0x55, 0x71, 0xad, 0x67, 0xe6, 0xad, 0x8e, 0x60,
+			0x45, 0x90, 0xad, 0x67, 0x79, 0x91, 0xdd, 0x68, 0x40, 0xad, 0x92, 0xad, 0x6b, 0xe8, 0xb0, 0x01,
+			0x80, 0xa0, 0x55, 0x6d, 0xb0, 0xe2, 0x55, 0x71, 0xf1, 0x91, 0x01, 0x80, 0xbd, 0x8b, 0xad, 0x8e,
+			0xf1, 0x91, 0xe8, 0xad, 0x6b, 0xe6, 0x55, 0x71, 0xe8, 0xf1, 0x91, 0xe2, 0x55, 0x71, 0xad, 0x6b,
+			0xe6, 0xad, 0x8e, 0xe6, 0x55, 0x71, 0xe1,
+		}},
+		ActionBookmarkBorder: ActionBookmarkBorder,
+		ActionBugReport: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x60, 0xad, 0x7b, 0xb0, 0xf1, 0x77,
+			0xf1, 0x95, 0x40, 0x69, 0x72, 0xa0, 0xe6, 0xad, 0x6a, 0xe8, 0x98, 0xe6, 0xcd, 0x70, 0x60, 0x55,
+			0x70, 0x45, 0x8a, 0x55, 0x70, 0x81, 0x88, 0xe8, 0xf1, 0x84, 0xe6, 0x99, 0x6a, 0xe8, 0xf1, 0x80,
+			0xe6, 0x55, 0x70, 0x60, 0x55, 0x70, 0x7e, 0x5d, 0x70, 0x19, 0x7d, 0x40, 0xf1, 0x70, 0x55, 0x79,
+			0xe6, 0xad, 0x6a, 0xe8, 0x55, 0x75, 0xe6, 0xad, 0x72, 0x60, 0x99, 0x73, 0x79, 0x73, 0x25, 0x75,
+			0x11, 0x72, 0x40, 0x89, 0x78, 0xbd, 0x6f, 0x02, 0x69, 0x73, 0xad, 0x6a, 0x11, 0x76, 0x50, 0x55,
+			0x7c, 0x45, 0x6e, 0x60, 0x35, 0x7e, 0x99, 0x6d, 0x19, 0x80, 0x99, 0x6d, 0x40, 0xdd, 0x83, 0x45,
+			0x6e, 0x02, 0x25, 0x8a, 0x50, 0xcd, 0x8c, 0xad, 0x6a, 0xbd, 0x87, 0xbd, 0x6f, 0x60, 0x99, 0x89,
+			0xad, 0x70, 0x09, 0x8b, 0x19, 0x72, 0x40, 0x89, 0x8d, 0x55, 0x75, 0xe6, 0x69, 0x95, 0xe8, 0x55,
+			0x79, 0xe6, 0x11, 0x8f, 0x60, 0xad, 0x8f, 0x35, 0x7b, 0xa5, 0x8f, 0x19, 0x7d, 0x40, 0x99, 0x8f,
+			0xf1, 0x80, 0xe6, 0x69, 0x95, 0xe8, 0xf1, 0x84, 0xe6, 0x99, 0x8f, 0x60, 0x99, 0x8f, 0xbd, 0x86,
+			0xa5, 0x8f, 0x81, 0x88, 0x40, 0x35, 0x8f, 0x98, 0xe6, 0x69, 0x95, 0xe8, 0xa0, 0xe6, 0xad, 0x8d,
+			0x60, 0xf1, 0x8b, 0xf1, 0x93, 0x2d, 0x88, 0xf9, 0x95, 0x40, 0x80, 0xb0, 0xe2, 0x80, 0xa8, 0x60,
+			0xcd, 0x84, 0xa8, 0x35, 0x88, 0xa5, 0x90, 0x40, 0x99, 0x8b, 0x79, 0x88, 0xe8, 0x55, 0x7d, 0x60,
+			0x99, 0x8b, 0x89, 0x78, 0x35, 0x88, 0x2d, 0x75, 0x40, 0x80, 0xcd, 0x71, 0x60, 0x35, 0x7b, 0xcd,
+			0x71, 0xcd, 0x77, 0x2d, 0x75, 0x40, 0x69, 0x74, 0x55, 0x7d, 0xe8, 0x79, 0x88, 0x60, 0x69, 0x74,
+			0x45, 0x8d, 0xcd, 0x77, 0xa5, 0x90, 0x40, 0x80, 0xa8, 0xe2, 0xad, 0x7a, 0xad, 0x8a, 0xe6, 0x55,
+			0x85, 0xe8, 0xad, 0x86, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x8a, 0xe2, 0xad, 0x7a, 0x25, 0x7f, 0xe6,
+			0x55, 0x85, 0xe8, 0x25, 0x7b, 0xe6, 0xad, 0x7a, 0xe8, 0x25, 0x7f, 0xe2, 0x80, 0xf1, 0x82, 0xe6,
+			0x09, 0x80, 0xe6, 0x80, 0xe6, 0x09, 0x80, 0xe6, 0x80, 0xe6, 0x09, 0x80, 0xe6, 0x80, 0xe6, 0x09,
+			0x80, 0xe6, 0x80, 0xe1,
+		}},
+		ActionBuild: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x9e, 0x79, 0x97, 0x00, 0xcd, 0x7d, 0x25, 0x86,
+			0x60, 0x45, 0x7c, 0xad, 0x86, 0xbd, 0x7a, 0x8e, 0x40, 0x99, 0x77, 0x55, 0x87, 0x63, 0x21, 0x71,
+			0x55, 0x87, 0x99, 0x6c, 0xd5, 0x82, 0x11, 0x68, 0x51, 0x7e, 0x11, 0x68, 0xdd, 0x77, 0x11, 0x68,
+			0xcd, 0x75, 0x9d, 0x68, 0xd9, 0x73, 0x29, 0x69, 0xe1, 0x71, 0x25, 0x6a, 0x25, 0x70, 0x02, 0xcd,
+			0x73, 0xcd, 0x79, 0xf1, 0x79, 0x11, 0x74, 0x60, 0x25, 0x6a, 0x64, 0xbd, 0x71, 0x21, 0x69, 0xa9,
+			0x73, 0x91, 0x68, 0x99, 0x75, 0x50, 0x99, 0x77, 0x50, 0x39, 0x7e, 0x50, 0xd9, 0x82, 0xa1, 0x6c,
+			0x79, 0x87, 0x41, 0x71, 0x79, 0x87, 0xdd, 0x77, 0x79, 0x87, 0x79, 0x79, 0x25, 0x87, 0x76, 0x40,
+			0x45, 0x86, 0x11, 0x7e, 0x00, 0x89, 0x97, 0x45, 0x8f, 0x61, 0x45, 0x98, 0xa0, 0x45, 0x98, 0x09,
+			0x91, 0x45, 0x98, 0x11, 0x92, 0x89, 0x97, 0xcd, 0x92, 0x00, 0x79, 0x92, 0x79, 0x97, 0x60, 0xc1,
+			0x91, 0x35, 0x98, 0xc1, 0x90, 0x35, 0x98, 0x40, 0x9e, 0x79, 0x97, 0xe2, 0xdd, 0x90, 0xad, 0x93,
+			0x01, 0x89, 0x93, 0xa2, 0x55, 0x81, 0xcd, 0x7e, 0x60, 0x69, 0x82, 0x69, 0x7d, 0xf1, 0x82, 0x81,
+			0x7b, 0x40, 0x79, 0x83, 0xdd, 0x77, 0x60, 0x79, 0x83, 0xdd, 0x72, 0xc5, 0x7f, 0x69, 0x6f, 0x40,
+			0x55, 0x77, 0xdd, 0x6b, 0x00, 0x11, 0x7e, 0xbd, 0x72, 0x60, 0xad, 0x7e, 0x55, 0x73, 0xad, 0x7e,
+			0x35, 0x74, 0x40, 0x11, 0x7e, 0xad, 0x75, 0x00, 0x45, 0x75, 0xf1, 0x7d, 0x60, 0xad, 0x74, 0x89,
+			0x7e, 0xcd, 0x73, 0x89, 0x7e, 0x40, 0x55, 0x72, 0xf1, 0x7d, 0x00, 0xdd, 0x6b, 0x89, 0x77, 0x60,
+			0x11, 0x6c, 0xad, 0x7c, 0x85, 0x6f, 0x80, 0x40, 0x99, 0x77, 0x55, 0x83, 0x60, 0x45, 0x79, 0x55,
+			0x83, 0x25, 0x7b, 0xcd, 0x82, 0x40, 0x69, 0x7e, 0x35, 0x81, 0x00, 0xdd, 0x90, 0xad, 0x93, 0xe2,
+			0xbd, 0x7f, 0xbd, 0x7f, 0xe1,
+		}},
+		ActionCached: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x79, 0x95, 0x60, 0x55, 0x77, 0x79,
+			0x95, 0x09, 0x71, 0x2d, 0x8f, 0x40, 0xbd, 0x6a, 0x25, 0x80, 0xe8, 0x25, 0x7d, 0x05, 0x69, 0x65,
+			0x79, 0x82, 0xcd, 0x62, 0xdd, 0x7f, 0xbd, 0x6c, 0xf1, 0x75, 0xad, 0x76, 0xdd, 0x7f, 0x11, 0x74,
+			0x79, 0x82, 0xbd, 0x6e, 0x25, 0x7d, 0xe8, 0x25, 0x80, 0x60, 0xbd, 0x6e, 0x45, 0x87, 0xd5, 0x73,
+			0x5d, 0x8c, 0x40, 0x11, 0x80, 0x79, 0x91, 0x60, 0x84, 0x79, 0x91, 0xbd, 0x83, 0x25, 0x91, 0x40,
+			0x8e, 0x25, 0x90, 0x00, 0xdd, 0x89, 0xa6, 0x60, 0x79, 0x87, 0x55, 0x94, 0x09, 0x85, 0xe9, 0x94,
+			0x40, 0x11, 0x80, 0x79, 0x95, 0xe2, 0x55, 0x93, 0x35, 0x8a, 0x02, 0x69, 0x89, 0x45, 0x80, 0x11,
+			0x8c, 0x99, 0x7d, 0x55, 0x91, 0xdd, 0x82, 0xe8, 0x25, 0x80, 0x60, 0x55, 0x91, 0x72, 0x3d, 0x8c,
+			0xe9, 0x73, 0x40, 0x80, 0xcd, 0x6e, 0x60, 0x11, 0x7e, 0xcd, 0x6e, 0x55, 0x7c, 0x2d, 0x6f, 0x40,
+			0x11, 0x79, 0x11, 0x70, 0x00, 0x35, 0x76, 0x35, 0x6d, 0x60, 0x99, 0x78, 0xdd, 0x6b, 0x09, 0x7b,
+			0x55, 0x6b, 0x40, 0x80, 0xcd, 0x6a, 0x60, 0xbd, 0x88, 0xcd, 0x6a, 0x09, 0x8f, 0x19, 0x71, 0x40,
+			0x55, 0x95, 0x25, 0x80, 0xe8, 0x86, 0x02, 0xad, 0x9a, 0xad, 0x7d, 0x45, 0x9d, 0x45, 0x80, 0x55,
+			0x93, 0x35, 0x8a, 0xe1,
+		}},
+		ActionCameraEnhance: ActionCameraEnhance,
+		ActionCardGiftcard:  ActionCardGiftcard,
+		ActionCardMembership: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x79, 0x82, 0xe8, 0x55, 0x89, 0xe6,
+			0xad, 0x96, 0xe8, 0x79, 0x82, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96,
+			0x60, 0x51, 0x98, 0x55, 0x65, 0x7d, 0x99, 0x85, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x55,
+			0x89, 0x60, 0xad, 0x9a, 0xfd, 0x8a, 0x7d, 0x99, 0x29, 0x8c, 0x40, 0xad, 0x96, 0x55, 0x8d, 0xe6,
+			0xbd, 0x89, 0xe8, 0xad, 0x9a, 0x01, 0x80, 0xbd, 0x95, 0x45, 0x76, 0xad, 0x9a, 0xe8, 0x55, 0x8d,
+			0xe6, 0x55, 0x69, 0x60, 0xb1, 0x67, 0x55, 0x8d, 0x85, 0x66, 0x29, 0x8c, 0x40, 0x55, 0x65, 0x55,
+			0x89, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xb1, 0x67, 0x85, 0x66, 0x85, 0x66, 0x40, 0x55, 0x69,
+			0x55, 0x65, 0xe2, 0x55, 0x69, 0x45, 0x7b, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69,
+			0xe8, 0x45, 0x7b, 0xe2, 0x55, 0x69, 0x55, 0x89, 0xe8, 0x55, 0x69, 0xe8, 0x55, 0x89, 0xe1,
+		}},
+		ActionCardTravel: ActionCardTravel,
+		ActionChangeHistory: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x55, 0x95, 0x01, 0x11, 0x80, 0xad,
+			0x6a, 0xad, 0x9a, 0x55, 0x95, 0xe6, 0x55, 0x65, 0xe2, 0x79, 0x6c, 0x55, 0x91, 0xe6, 0x89, 0x93,
+			0x01, 0x11, 0x80, 0x55, 0x72, 0x79, 0x6c, 0x55, 0x91, 0xe2, 0x80, 0xcd, 0x81, 0xe1,
+		}},
+		ActionCheckCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7c, 0x25, 0x8c, 0x05, 0xf1, 0x8e, 0x45,
+			0x79, 0xdd, 0x8b, 0x45, 0x76, 0x11, 0x7c, 0x11, 0x86, 0x11, 0x74, 0x11, 0x7e, 0x11, 0x71, 0x11,
+			0x81, 0x11, 0x7c, 0x25, 0x8c, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75,
+			0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a,
+			0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d,
+			0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96,
+			0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91,
+			0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90,
+			0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40,
+			0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80,
+			0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80,
+			0xe1,
+		}},
+		ActionChromeReaderMode: ActionChromeReaderMode,
+		ActionClass:            ActionClass,
+		ActionCode: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0xdd, 0x8f, 0x05, 0x55, 0x65, 0xdd,
+			0x7f, 0x79, 0x75, 0xbd, 0x6f, 0x55, 0x78, 0x99, 0x72, 0x11, 0x6b, 0xdd, 0x7f, 0x35, 0x78, 0x9a,
+			0x55, 0x75, 0xdd, 0x8f, 0xe2, 0x89, 0x8a, 0xa0, 0x05, 0xad, 0x87, 0x25, 0x8d, 0xf1, 0x94, 0xdd,
+			0x7f, 0xcd, 0x87, 0xbd, 0x72, 0xad, 0x8a, 0xdd, 0x6f, 0xad, 0x9a, 0xdd, 0x7f, 0x89, 0x8a, 0xa0,
+			0xe1,
+		}},
+		ActionCompareArrows: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x75, 0x55, 0x95, 0x01, 0x55, 0x72, 0x89,
+			0x92, 0x69, 0x7a, 0x79, 0x8a, 0xe6, 0x55, 0x65, 0xe8, 0x79, 0x86, 0xe6, 0x69, 0x7a, 0x03, 0x55,
+			0x72, 0x69, 0x7e, 0x25, 0x75, 0x99, 0x7b, 0x84, 0x79, 0x88, 0x25, 0x75, 0x55, 0x95, 0xe2, 0xdd,
+			0x8a, 0x69, 0x84, 0x03, 0x7c, 0x89, 0x77, 0xdd, 0x8a, 0xad, 0x6a, 0xad, 0x8d, 0x79, 0x6d, 0x99,
+			0x85, 0x89, 0x75, 0xe6, 0xad, 0x9a, 0xe8, 0x89, 0x79, 0xe6, 0x99, 0x85, 0x01, 0xad, 0x8d, 0x99,
+			0x81, 0xdd, 0x8a, 0x69, 0x84, 0xe1,
+		}},
+		ActionCopyright: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7a, 0xad, 0x8a, 0xe6, 0xbd, 0x85, 0x60,
+			0xad, 0x86, 0xad, 0x8a, 0x19, 0x87, 0x09, 0x8a, 0x40, 0x89, 0x87, 0x79, 0x88, 0xe8, 0x99, 0x83,
+			0xe6, 0x89, 0x83, 0xe8, 0xad, 0x86, 0xe6, 0x69, 0x7c, 0xe8, 0x55, 0x79, 0xe6, 0x89, 0x83, 0xe8,
+			0x79, 0x7c, 0xe6, 0x89, 0x87, 0xe8, 0x99, 0x77, 0x60, 0x89, 0x87, 0xad, 0x76, 0x19, 0x87, 0x6c,
+			0x40, 0xbd, 0x85, 0x55, 0x75, 0xe6, 0x35, 0x7a, 0x60, 0x45, 0x79, 0x55, 0x75, 0xd5, 0x78, 0x6c,
+			0x40, 0x69, 0x78, 0x99, 0x77, 0xe8, 0x79, 0x88, 0x60, 0x69, 0x78, 0x69, 0x89, 0xd5, 0x78, 0x09,
+			0x8a, 0x40, 0x35, 0x7a, 0xad, 0x8a, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad,
+			0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55,
+			0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d,
+			0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65,
+			0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79,
+			0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85,
+			0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a,
+			0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11,
+			0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f,
+			0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69,
+			0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80,
+			0x80, 0xe1,
+		}},
+		ActionCreditCard: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e,
+			0xe2, 0x55, 0x69, 0xf1, 0x75, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0xf1,
+			0x75, 0xe2, 0x55, 0x69, 0x89, 0x7e, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0x89, 0x7e, 0xe6,
+			0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ActionDNS: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x21, 0x73, 0x35, 0x70, 0x67, 0xbd, 0x71, 0x35,
+			0x70, 0xc5, 0x70, 0x2d, 0x71, 0xcd, 0x6f, 0x29, 0x72, 0xcd, 0x6f, 0x8d, 0x73, 0xcd, 0x6f, 0xf1,
+			0x74, 0xc9, 0x70, 0xe9, 0x75, 0xc1, 0x71, 0xdd, 0x76, 0x25, 0x73, 0xdd, 0x76, 0x89, 0x74, 0xdd,
+			0x76, 0x81, 0x75, 0xe5, 0x75, 0x79, 0x76, 0xed, 0x74, 0x79, 0x76, 0x89, 0x73, 0x79, 0x76, 0x25,
+			0x72, 0x7d, 0x75, 0x2d, 0x71, 0x85, 0x74, 0x35, 0x70, 0x21, 0x73, 0x35, 0x70, 0xe2, 0x21, 0x73,
+			0xcd, 0x8b, 0x67, 0xbd, 0x71, 0xcd, 0x8b, 0xc5, 0x70, 0xc9, 0x8c, 0xcd, 0x6f, 0xc1, 0x8d, 0xcd,
+			0x6f, 0x25, 0x8f, 0xcd, 0x6f, 0x89, 0x90, 0xc9, 0x70, 0x81, 0x91, 0xc1, 0x71, 0x79, 0x92, 0x25,
+			0x73, 0x79, 0x92, 0x89, 0x74, 0x79, 0x92, 0x81, 0x75, 0x7d, 0x91, 0x79, 0x76, 0x85, 0x90, 0x79,
+			0x76, 0x21, 0x8f, 0x79, 0x76, 0xbd, 0x8d, 0x7d, 0x75, 0xc5, 0x8c, 0x85, 0x74, 0xcd, 0x8b, 0x21,
+			0x73, 0xcd, 0x8b, 0xe2, 0x45, 0x6a, 0x11, 0x68, 0xe6, 0xad, 0x95, 0x60, 0xbd, 0x96, 0x11, 0x68,
+			0x5d, 0x97, 0xb5, 0x68, 0x40, 0xb0, 0x6d, 0x6a, 0xe8, 0x55, 0x7c, 0x61, 0xb0, 0x81, 0x7d, 0x5d,
+			0x97, 0x49, 0x7e, 0xbd, 0x96, 0x11, 0x7f, 0xad, 0x95, 0x11, 0x7f, 0xe6, 0x45, 0x6a, 0x61, 0x45,
+			0x69, 0x11, 0x7f, 0xa5, 0x68, 0x49, 0x7e, 0x50, 0x81, 0x7d, 0x50, 0x55, 0x7c, 0xe8, 0x6d, 0x6a,
+			0x60, 0x50, 0x55, 0x69, 0xa5, 0x68, 0xb5, 0x68, 0x40, 0x45, 0x6a, 0x11, 0x68, 0xe2, 0x58, 0x11,
+			0x6c, 0xe8, 0x11, 0x7b, 0xe6, 0xa8, 0xe8, 0x11, 0x6c, 0xe6, 0x58, 0xe2, 0x45, 0x6a, 0x99, 0x83,
+			0xe6, 0x69, 0x95, 0x60, 0x69, 0x96, 0x99, 0x83, 0x35, 0x97, 0x71, 0x84, 0x40, 0xb0, 0x55, 0x86,
+			0xe8, 0xf1, 0x97, 0x60, 0xb0, 0x45, 0x99, 0x35, 0x97, 0xf9, 0x99, 0x40, 0x69, 0x95, 0xad, 0x9a,
+			0xe6, 0x99, 0x6a, 0x60, 0x89, 0x69, 0xad, 0x9a, 0xc5, 0x68, 0xf9, 0x99, 0x40, 0x50, 0xf1, 0x97,
+			0xe8, 0x55, 0x86, 0x60, 0x50, 0x45, 0x85, 0xa5, 0x68, 0x71, 0x84, 0x40, 0x45, 0x6a, 0x99, 0x83,
+			0xe2, 0x58, 0x99, 0x87, 0xe8, 0xad, 0x96, 0xe6, 0xa8, 0xe8, 0x99, 0x87, 0xe6, 0x58, 0xe2, 0x58,
+			0x11, 0x6c, 0xe8, 0x11, 0x7b, 0xe8, 0x11, 0x6c, 0xe2, 0x58, 0x99, 0x87, 0xe8, 0xad, 0x96, 0xe8,
+			0x99, 0x87, 0xe1,
+		}},
+		ActionDashboard: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x84, 0x74, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x74,
+			0xe6, 0x84, 0xe2, 0x50, 0x84, 0xe8, 0x50, 0xe6, 0x7c, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x84, 0xb0,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0x84, 0xe2, 0x50, 0xb0, 0xe8, 0x8c, 0xe6, 0x7c, 0xe8,
+			0xb0, 0xe6, 0x50, 0xe2, 0x58, 0x7c, 0xe6, 0x74, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x7c, 0xe2, 0x8c,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x84, 0xe6, 0x8c, 0xe8, 0xa8, 0xe2, 0x8c, 0x6c, 0xe6, 0xa8, 0xe8, 0x58,
+			0xe6, 0x8c, 0xe8, 0x6c, 0xe2, 0x58, 0xa8, 0xe6, 0x74, 0xe8, 0x94, 0xe6, 0x58, 0xe8, 0xa8, 0xe2,
+			0x74, 0x7c, 0xe2, 0x8c, 0x6c, 0xe2, 0x8c, 0x84, 0xe2, 0x74, 0x94, 0xe1,
+		}},
+		ActionDateRange: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x74, 0xbd, 0x85, 0x60, 0x45, 0x73, 0xbd,
+			0x85, 0x81, 0x72, 0xf9, 0x84, 0x40, 0xbd, 0x71, 0x11, 0x83, 0x60, 0xbd, 0x71, 0xf1, 0x81, 0x81,
+			0x72, 0x2d, 0x81, 0x40, 0x69, 0x74, 0x69, 0x80, 0x60, 0x89, 0x75, 0x69, 0x80, 0x4d, 0x76, 0x2d,
+			0x81, 0x40, 0x11, 0x77, 0x11, 0x83, 0x60, 0x11, 0x77, 0x35, 0x84, 0x4d, 0x76, 0xf9, 0x84, 0x40,
+			0x69, 0x74, 0xbd, 0x85, 0xe2, 0x35, 0x80, 0xbd, 0x85, 0x60, 0x11, 0x7f, 0xbd, 0x85, 0x4d, 0x7e,
+			0xf9, 0x84, 0x40, 0x89, 0x7d, 0x11, 0x83, 0x60, 0x89, 0x7d, 0xf1, 0x81, 0x4d, 0x7e, 0x2d, 0x81,
+			0x40, 0x35, 0x80, 0x69, 0x80, 0x60, 0x55, 0x81, 0x69, 0x80, 0x19, 0x82, 0x2d, 0x81, 0x40, 0xdd,
+			0x82, 0x11, 0x83, 0x60, 0xdd, 0x82, 0x35, 0x84, 0x19, 0x82, 0xf9, 0x84, 0x40, 0x35, 0x80, 0xbd,
+			0x85, 0xe2, 0x89, 0x8b, 0xbd, 0x85, 0x60, 0x69, 0x8a, 0xbd, 0x85, 0xa5, 0x89, 0xf9, 0x84, 0x40,
+			0xdd, 0x88, 0x11, 0x83, 0x60, 0xdd, 0x88, 0xf1, 0x81, 0xa5, 0x89, 0x2d, 0x81, 0x40, 0x89, 0x8b,
+			0x69, 0x80, 0x60, 0xad, 0x8c, 0x69, 0x80, 0x71, 0x8d, 0x2d, 0x81, 0x40, 0x35, 0x8e, 0x11, 0x83,
+			0x60, 0x35, 0x8e, 0x35, 0x84, 0x71, 0x8d, 0xf9, 0x84, 0x40, 0x89, 0x8b, 0xbd, 0x85, 0xe2, 0x58,
+			0xad, 0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a, 0x35, 0x69, 0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8,
+			0x55, 0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35, 0x69, 0x89, 0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55,
+			0x70, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65,
+			0xe6, 0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89,
+			0x6a, 0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad, 0x96, 0x60, 0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99,
+			0x40, 0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2, 0x58, 0xad, 0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58,
+			0xe8, 0xad, 0x96, 0xe2, 0x58, 0x6c, 0xe6, 0xa8, 0xe8, 0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2,
+			0x58, 0x6c, 0xe8, 0x55, 0x6d, 0xe8, 0x6c, 0xe1,
+		}},
+		ActionDelete: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x71, 0xb0, 0x60, 0xc1, 0x6f, 0xb0, 0x95,
+			0x6e, 0xd5, 0x96, 0x40, 0x69, 0x6d, 0xa8, 0xe8, 0x5c, 0xe6, 0xad, 0x6a, 0xe8, 0x54, 0xe6, 0x35,
+			0x77, 0xe8, 0x50, 0xe6, 0xcd, 0x88, 0xe8, 0x54, 0xe6, 0x55, 0x95, 0xe8, 0x5c, 0xe6, 0x99, 0x92,
+			0xe8, 0xa8, 0x60, 0x99, 0x92, 0x99, 0x95, 0x69, 0x91, 0xcd, 0x96, 0x40, 0x99, 0x8e, 0xb0, 0xe6,
+			0x69, 0x71, 0xe2, 0x99, 0x8e, 0x5c, 0xe6, 0x69, 0x71, 0xe8, 0xa8, 0xe6, 0x99, 0x8e, 0xe8, 0x5c,
+			0xe2, 0x79, 0x78, 0x45, 0x8e, 0xe6, 0x79, 0x7c, 0xe8, 0xad, 0x73, 0xe6, 0x79, 0x78, 0xe8, 0x45,
+			0x8e, 0xe2, 0x89, 0x83, 0x45, 0x8e, 0xe6, 0x89, 0x87, 0xe8, 0xad, 0x73, 0xe6, 0x89, 0x83, 0xe8,
+			0x45, 0x8e, 0xe2, 0x69, 0x71, 0x5c, 0xe8, 0xa8, 0xe8, 0x5c, 0xe1,
+		}},
+		ActionDeleteForever: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x78, 0x11, 0x8c, 0x0b, 0x80, 0x88, 0x90,
+			0x11, 0x8c, 0x25, 0x8b, 0xdd, 0x88, 0x35, 0x83, 0xcd, 0x80, 0x25, 0x8b, 0xbd, 0x78, 0x90, 0x89,
+			0x75, 0x80, 0x99, 0x7d, 0x11, 0x78, 0x89, 0x75, 0xdd, 0x74, 0xbd, 0x78, 0xdd, 0x7c, 0xcd, 0x80,
+			0xdd, 0x74, 0xdd, 0x88, 0x11, 0x78, 0x11, 0x8c, 0xe2, 0x69, 0x71, 0xb0, 0x60, 0xcd, 0x6f, 0xb0,
+			0x99, 0x6e, 0xcd, 0x96, 0x40, 0x69, 0x6d, 0xa8, 0xe8, 0x5c, 0xe6, 0xad, 0x6a, 0xe8, 0x54, 0xe6,
+			0x35, 0x77, 0xe8, 0x50, 0xe6, 0xcd, 0x88, 0xe8, 0x54, 0xe6, 0x55, 0x95, 0xe8, 0x5c, 0xe6, 0x99,
+			0x92, 0xe8, 0xa8, 0x60, 0x99, 0x92, 0x99, 0x95, 0x69, 0x91, 0xcd, 0x96, 0x40, 0x99, 0x8e, 0xb0,
+			0xe6, 0x69, 0x71, 0xe2, 0x99, 0x8e, 0x5c, 0xe6, 0x69, 0x71, 0xe8, 0xa8, 0xe6, 0x99, 0x8e, 0xe8,
+			0x5c, 0xe2, 0x69, 0x71, 0x5c, 0xe8, 0xa8, 0xe8, 0x5c, 0xe1,
+		}},
+		ActionDescription: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x75, 0x55, 0x8f, 0xe6, 0xbd, 0x8a, 0xe8,
+			0x55, 0x8b, 0xe6, 0x45, 0x75, 0xe8, 0x55, 0x8f, 0xe2, 0x45, 0x75, 0x88, 0xe6, 0xbd, 0x8a, 0xe8,
+			0x80, 0xe6, 0x45, 0x75, 0xe8, 0x88, 0xe2, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad, 0x9a,
+			0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a, 0xbd,
+			0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0xbd, 0x86, 0x00, 0x55, 0x95,
+			0xf1, 0x73, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55,
+			0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xbd, 0x84, 0xbd, 0x75, 0xe8, 0x55, 0x69, 0xe6, 0xad,
+			0x6e, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0xbd, 0x75, 0xe6, 0xbd, 0x84, 0xe2, 0xad, 0x6e,
+			0x55, 0x69, 0xe8, 0xbd, 0x75, 0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ActionDone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x79, 0x99, 0x8f, 0x05, 0x45, 0x6a, 0xad,
+			0x80, 0x25, 0x6d, 0xcd, 0x7d, 0x35, 0x79, 0xdd, 0x89, 0xcd, 0x92, 0x45, 0x70, 0xad, 0x95, 0x25,
+			0x73, 0x35, 0x79, 0x99, 0x8f, 0xe1,
+		}},
+		ActionDoneAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x73, 0xdd, 0x8f, 0x04, 0xad, 0x64, 0xf1,
+			0x80, 0x89, 0x67, 0x11, 0x7e, 0x99, 0x73, 0x25, 0x8a, 0x79, 0x76, 0x9a, 0x99, 0x73, 0xdd, 0x8f,
+			0xe2, 0xf1, 0x7e, 0xdd, 0x8f, 0x05, 0x60, 0xf1, 0x80, 0xdd, 0x72, 0x11, 0x7e, 0xf1, 0x7e, 0x25,
+			0x8a, 0x89, 0x98, 0x89, 0x70, 0x69, 0x9b, 0x69, 0x73, 0xf1, 0x7e, 0xdd, 0x8f, 0xe2, 0xf1, 0x7e,
+			0x89, 0x84, 0x03, 0x11, 0x7c, 0xad, 0x81, 0x35, 0x8d, 0x89, 0x70, 0x11, 0x90, 0x69, 0x73, 0xf1,
+			0x7e, 0x89, 0x84, 0xe1,
+		}},
+		ActionDonutLarge: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7e, 0x79, 0x9a, 0x60, 0x99, 0x73, 0xad,
+			0x99, 0x81, 0x6c, 0x11, 0x92, 0x40, 0x69, 0x65, 0x80, 0x60, 0x69, 0x65, 0x79, 0x75, 0x81, 0x6c,
+			0xe9, 0x6d, 0x40, 0x25, 0x7e, 0x89, 0x65, 0xe8, 0x55, 0x6c, 0x60, 0x79, 0x76, 0x11, 0x6d, 0x45,
+			0x71, 0xad, 0x72, 0x40, 0x11, 0x6c, 0x80, 0x60, 0x11, 0x6c, 0xbd, 0x87, 0x45, 0x71, 0x5d, 0x8d,
+			0x40, 0x25, 0x7e, 0xad, 0x93, 0xe8, 0x79, 0x9a, 0xe2, 0x25, 0x82, 0x79, 0x9a, 0xe8, 0xad, 0x93,
+			0x60, 0x35, 0x89, 0x25, 0x93, 0x2d, 0x8e, 0x19, 0x8e, 0x40, 0xf1, 0x93, 0x84, 0xe6, 0x99, 0x9a,
+			0x60, 0xdd, 0x99, 0xf1, 0x8b, 0xf9, 0x92, 0xdd, 0x92, 0x40, 0x25, 0x82, 0x79, 0x9a, 0xe2, 0xf1,
+			0x93, 0x7c, 0x60, 0x35, 0x93, 0xf1, 0x76, 0x35, 0x8e, 0xe9, 0x71, 0x40, 0x25, 0x82, 0x45, 0x6c,
+			0xe8, 0x79, 0x65, 0x60, 0x98, 0x35, 0x66, 0xf1, 0x92, 0x25, 0x6d, 0x40, 0x99, 0x9a, 0x7c, 0xe6,
+			0xf1, 0x93, 0xe1,
+		}},
+		ActionDonutSmall: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x82, 0x79, 0x65, 0x61, 0x45, 0x8c, 0x69,
+			0x66, 0xf1, 0x92, 0x15, 0x6d, 0x9d, 0x99, 0xc5, 0x73, 0x89, 0x9a, 0x45, 0x7d, 0xe6, 0x99, 0x87,
+			0x60, 0x11, 0x87, 0x89, 0x7b, 0xc5, 0x85, 0x55, 0x7a, 0x40, 0xbd, 0x82, 0x99, 0x78, 0xe8, 0x79,
+			0x65, 0xe2, 0xbd, 0x86, 0x89, 0x6a, 0xe8, 0x25, 0x76, 0x60, 0xad, 0x87, 0xbd, 0x76, 0x89, 0x88,
+			0x81, 0x77, 0x40, 0xf1, 0x89, 0x45, 0x79, 0xe6, 0x79, 0x95, 0x60, 0xcd, 0x93, 0xf1, 0x73, 0xf1,
+			0x8f, 0x09, 0x70, 0x40, 0xbd, 0x86, 0x89, 0x6a, 0xe2, 0x45, 0x7d, 0x79, 0x65, 0xe8, 0x99, 0x78,
+			0x63, 0xf1, 0x7a, 0x89, 0x79, 0x81, 0x79, 0x9d, 0x7b, 0x11, 0x78, 0xb1, 0x7d, 0x11, 0x78, 0x35,
+			0x80, 0x11, 0x78, 0x9d, 0x82, 0x81, 0x79, 0x89, 0x84, 0xf1, 0x7a, 0x79, 0x86, 0x45, 0x7d, 0x59,
+			0x87, 0xe8, 0x89, 0x9a, 0x60, 0x11, 0x73, 0x99, 0x99, 0x3d, 0x6c, 0x11, 0x92, 0x40, 0x69, 0x65,
+			0x35, 0x80, 0x60, 0x69, 0x65, 0xdd, 0x75, 0x35, 0x6c, 0x2d, 0x6e, 0x40, 0x45, 0x7d, 0x79, 0x65,
+			0xe2, 0x45, 0x79, 0x89, 0x6a, 0x60, 0x64, 0x89, 0x6c, 0xb5, 0x6d, 0x99, 0x72, 0x40, 0x69, 0x69,
+			0x35, 0x80, 0x60, 0x69, 0x69, 0x99, 0x87, 0xcd, 0x6d, 0x81, 0x8d, 0x40, 0x45, 0x79, 0x89, 0x95,
+			0xe8, 0xf1, 0x89, 0x60, 0xcd, 0x76, 0x45, 0x88, 0x71, 0x75, 0xbd, 0x85, 0x40, 0x11, 0x74, 0x35,
+			0x80, 0x60, 0x11, 0x74, 0x35, 0x7d, 0x69, 0x75, 0x89, 0x7a, 0x40, 0x45, 0x79, 0x25, 0x76, 0xe8,
+			0x89, 0x6a, 0xe2, 0x99, 0x87, 0xbd, 0x82, 0xe6, 0x89, 0x9a, 0x61, 0x99, 0x99, 0x45, 0x8c, 0xed,
+			0x92, 0xf1, 0x92, 0x3d, 0x8c, 0x9d, 0x99, 0xbd, 0x82, 0x89, 0x9a, 0xe8, 0x55, 0x87, 0x60, 0x79,
+			0x84, 0xcd, 0x86, 0xc5, 0x85, 0xa5, 0x85, 0x40, 0x99, 0x87, 0xbd, 0x82, 0xe2, 0xf1, 0x89, 0xbd,
+			0x86, 0x61, 0x45, 0x89, 0xad, 0x87, 0x81, 0x88, 0x89, 0x88, 0xbd, 0x87, 0x69, 0x89, 0xbd, 0x86,
+			0xf1, 0x89, 0xe8, 0x79, 0x95, 0x60, 0x11, 0x8c, 0xf1, 0x93, 0xf1, 0x8f, 0xa0, 0x40, 0x79, 0x95,
+			0xbd, 0x86, 0xe6, 0xf1, 0x89, 0xe2, 0x11, 0x74, 0x11, 0x80, 0xe2, 0xf1, 0x89, 0x45, 0x79, 0xe2,
+			0xf1, 0x89, 0xbd, 0x86, 0xe1,
+		}},
+		ActionEject: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x6d, 0xe2, 0xf1, 0x6d, 0x89, 0x88, 0x01, 0x80, 0x55,
+			0x6d, 0x11, 0x92, 0x89, 0x88, 0xe6, 0xf1, 0x6d, 0xe2, 0x11, 0x80, 0x89, 0x84, 0xe2, 0x45, 0x75,
+			0x89, 0x84, 0xe6, 0xbd, 0x8a, 0x01, 0x80, 0xad, 0x74, 0x45, 0x75, 0x89, 0x84, 0xe1,
+		}},
+		ActionEuroSymbol: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x88, 0xb0, 0x60, 0x80, 0xb0, 0xf1, 0x79,
+			0x79, 0x93, 0x40, 0xf1, 0x71, 0x89, 0x87, 0xe6, 0x50, 0xe8, 0x89, 0x83, 0xe6, 0x62, 0x60, 0xdd,
+			0x70, 0xbd, 0x82, 0xcd, 0x70, 0xc5, 0x81, 0x40, 0xbd, 0x70, 0x80, 0x60, 0xbd, 0x70, 0x35, 0x7f,
+			0xcd, 0x70, 0x3d, 0x7e, 0x40, 0x62, 0x79, 0x7c, 0xe6, 0x50, 0xe8, 0x79, 0x78, 0xe6, 0xf1, 0x71,
+			0x60, 0x11, 0x74, 0xcd, 0x70, 0x09, 0x7a, 0x69, 0x6c, 0x40, 0x25, 0x88, 0x50, 0x60, 0xbd, 0x8c,
+			0x50, 0xc5, 0x90, 0x81, 0x69, 0x40, 0x11, 0x98, 0x11, 0x6e, 0x00, 0xad, 0x94, 0x79, 0x71, 0x60,
+			0x25, 0x92, 0x25, 0x6f, 0xdd, 0x8e, 0xf1, 0x6d, 0x40, 0x25, 0x88, 0xbd, 0x6c, 0x60, 0xbd, 0x81,
+			0xbd, 0x6c, 0x3d, 0x7d, 0xd5, 0x6f, 0x40, 0x6e, 0x79, 0x78, 0xe6, 0x35, 0x88, 0xe8, 0x79, 0x7c,
+			0xe6, 0xcd, 0x75, 0x60, 0x99, 0x75, 0x45, 0x7d, 0x89, 0x75, 0x3d, 0x7e, 0x40, 0x79, 0x75, 0x80,
+			0x60, 0x79, 0x75, 0xcd, 0x80, 0x89, 0x75, 0xc5, 0x81, 0x40, 0xcd, 0x75, 0x89, 0x83, 0xe6, 0x35,
+			0x88, 0xe8, 0x89, 0x87, 0xe6, 0x6e, 0x60, 0xad, 0x78, 0x35, 0x8d, 0x69, 0x7d, 0x3d, 0x90, 0x40,
+			0x25, 0x88, 0x45, 0x93, 0x60, 0x99, 0x8b, 0x45, 0x93, 0xe9, 0x8e, 0x11, 0x92, 0x40, 0xad, 0x94,
+			0x89, 0x8e, 0x00, 0x11, 0x98, 0xdd, 0x91, 0x60, 0x89, 0x94, 0xaa, 0x89, 0x90, 0x81, 0x96, 0x40,
+			0x25, 0x88, 0xb0, 0xe1,
+		}},
+		ActionEvent: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xc9, 0x87, 0x55, 0x91, 0x61, 0x11, 0x85, 0x55,
+			0x91, 0x35, 0x83, 0x75, 0x8f, 0x55, 0x81, 0x95, 0x8d, 0x55, 0x81, 0xdd, 0x8a, 0x40, 0x39, 0x83,
+			0x45, 0x86, 0x60, 0x19, 0x85, 0x69, 0x84, 0xd1, 0x87, 0x69, 0x84, 0x40, 0x69, 0x8c, 0x49, 0x86,
+			0x60, 0x45, 0x8e, 0x29, 0x88, 0x45, 0x8e, 0xe1, 0x8a, 0x40, 0x65, 0x8c, 0x79, 0x8f, 0x60, 0x85,
+			0x8a, 0x55, 0x91, 0xc9, 0x87, 0x55, 0x91, 0xe2, 0x58, 0xad, 0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a,
+			0x35, 0x69, 0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35,
+			0x69, 0x89, 0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74,
+			0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6,
+			0xa8, 0x60, 0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a, 0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad,
+			0x96, 0x60, 0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40, 0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2,
+			0x58, 0xad, 0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0x58, 0x6c, 0xe6,
+			0xa8, 0xe8, 0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2, 0x58, 0x6c, 0xe8, 0x55, 0x6d, 0xe8, 0x6c,
+			0xe1,
+		}},
+		ActionEventSeat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xb0, 0xe8, 0x55, 0x89, 0xe6, 0x55,
+			0x95, 0xe8, 0xb0, 0xe6, 0x55, 0x91, 0xe8, 0x55, 0x8d, 0xe6, 0xad, 0x6e, 0xe8, 0xb0, 0xe6, 0xad,
+			0x6a, 0xe2, 0x55, 0x6b, 0xad, 0x84, 0x67, 0xf1, 0x69, 0xad, 0x84, 0xf9, 0x68, 0xb1, 0x83, 0x50,
+			0xb9, 0x82, 0x50, 0x55, 0x81, 0x50, 0xf1, 0x7f, 0xf9, 0x68, 0xf9, 0x7e, 0xf5, 0x69, 0x7c, 0x59,
+			0x6b, 0x7c, 0xbd, 0x6c, 0x7c, 0xb5, 0x6d, 0xf9, 0x7e, 0xad, 0x6e, 0xf5, 0x7f, 0xad, 0x6e, 0x59,
+			0x81, 0xad, 0x6e, 0xbd, 0x82, 0xb1, 0x6d, 0xb5, 0x83, 0xb9, 0x6c, 0xad, 0x84, 0x55, 0x6b, 0xad,
+			0x84, 0xe2, 0xad, 0x72, 0x55, 0x85, 0xe8, 0x58, 0x60, 0xad, 0x72, 0x59, 0x6a, 0xd9, 0x73, 0x2d,
+			0x69, 0x40, 0xad, 0x76, 0x50, 0xe6, 0x55, 0x89, 0x60, 0xfd, 0x8a, 0x50, 0x29, 0x8c, 0x2d, 0x69,
+			0x40, 0x55, 0x8d, 0x58, 0xe8, 0x55, 0x85, 0xe6, 0xad, 0x72, 0xe2, 0xa9, 0x94, 0xad, 0x84, 0x67,
+			0x45, 0x93, 0xad, 0x84, 0x4d, 0x92, 0xb1, 0x83, 0x55, 0x91, 0xb9, 0x82, 0x55, 0x91, 0x55, 0x81,
+			0x55, 0x91, 0xf1, 0x7f, 0x51, 0x92, 0xf9, 0x7e, 0x49, 0x93, 0x7c, 0xad, 0x94, 0x7c, 0x11, 0x96,
+			0x7c, 0x09, 0x97, 0xf9, 0x7e, 0xb0, 0xf5, 0x7f, 0xb0, 0x59, 0x81, 0xb0, 0xbd, 0x82, 0x09, 0x97,
+			0xb5, 0x83, 0x0d, 0x96, 0xad, 0x84, 0xa9, 0x94, 0xad, 0x84, 0xe2, 0xad, 0x76, 0x55, 0x81, 0xe6,
+			0x55, 0x89, 0xe8, 0x58, 0xe6, 0xad, 0x76, 0xe8, 0x55, 0x81, 0xe2, 0xad, 0x76, 0x55, 0x81, 0xe6,
+			0x55, 0x89, 0xe6, 0xad, 0x76, 0xe1,
+		}},
+		ActionExitToApp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x8c, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6,
+			0x58, 0xe8, 0x74, 0xe6, 0x50, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x89,
+			0x7b, 0xdd, 0x8c, 0x01, 0x89, 0x78, 0xdd, 0x89, 0x69, 0x80, 0x84, 0xe6, 0x50, 0xe8, 0x7c, 0xe6,
+			0x69, 0x80, 0x03, 0x89, 0x78, 0x25, 0x76, 0x89, 0x7b, 0x25, 0x73, 0x69, 0x88, 0x80, 0x89, 0x7b,
+			0xdd, 0x8c, 0xe1,
+		}, mirror: true},
+		ActionExplore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x71, 0x79, 0x8e, 0x03, 0xdd, 0x84, 0xf1,
+			0x84, 0x69, 0x8e, 0x99, 0x71, 0x11, 0x7b, 0x25, 0x7b, 0x89, 0x71, 0x79, 0x8e, 0xe2, 0x80, 0xad,
+			0x82, 0x60, 0xdd, 0x7e, 0xad, 0x82, 0x19, 0x7e, 0xe9, 0x81, 0x40, 0x55, 0x7d, 0x80, 0x60, 0x55,
+			0x7d, 0xdd, 0x7e, 0x19, 0x7e, 0x19, 0x7e, 0x40, 0x80, 0x55, 0x7d, 0x60, 0x25, 0x81, 0x55, 0x7d,
+			0xe9, 0x81, 0x19, 0x7e, 0x40, 0xad, 0x82, 0x80, 0x60, 0xad, 0x82, 0x25, 0x81, 0xe9, 0x81, 0xe9,
+			0x81, 0x40, 0x80, 0xad, 0x82, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75,
+			0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a,
+			0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d,
+			0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96,
+			0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91,
+			0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90,
+			0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40,
+			0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80,
+			0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80,
+			0xe1,
+		}},
+		ActionExtension: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x77, 0xb0, 0xe6, 0x58, 0x60, 0x69, 0x6a,
+			0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x45, 0x88, 0x60, 0xf1, 0x6a, 0xf1, 0x87,
+			0x09, 0x6d, 0xf9, 0x85, 0x40, 0x25, 0x6f, 0x25, 0x81, 0x60, 0x25, 0x6f, 0x45, 0x7e, 0x09, 0x6d,
+			0x4d, 0x7c, 0x40, 0x50, 0x74, 0xe8, 0x45, 0x6e, 0x60, 0x50, 0xad, 0x6c, 0x35, 0x69, 0x79, 0x6b,
+			0x40, 0x58, 0x45, 0x6a, 0xe6, 0xcd, 0x77, 0x60, 0x89, 0x78, 0x99, 0x67, 0x71, 0x7a, 0xcd, 0x65,
+			0x40, 0x7e, 0x48, 0x60, 0xad, 0x81, 0x48, 0x91, 0x83, 0xcd, 0x65, 0x40, 0x35, 0x86, 0x45, 0x6a,
+			0xe6, 0xbd, 0x91, 0x60, 0x55, 0x93, 0x45, 0x6a, 0x89, 0x94, 0x79, 0x6b, 0x40, 0xbd, 0x95, 0x45,
+			0x6e, 0xe8, 0xcd, 0x79, 0x60, 0x69, 0x98, 0x89, 0x7a, 0x19, 0x9a, 0x91, 0x7c, 0x40, 0xcd, 0x9b,
+			0x45, 0x81, 0x60, 0xcd, 0x9b, 0xf1, 0x83, 0x19, 0x9a, 0xbd, 0x85, 0x40, 0xbd, 0x95, 0x45, 0x88,
+			0xe8, 0xa8, 0x60, 0xbd, 0x95, 0x99, 0x95, 0x89, 0x94, 0xcd, 0x96, 0x40, 0xbd, 0x91, 0xb0, 0xe6,
+			0x8c, 0x60, 0xad, 0x85, 0xcd, 0x94, 0xa5, 0x83, 0xd5, 0x92, 0x40, 0xdd, 0x7e, 0xdd, 0x90, 0x60,
+			0x25, 0x7c, 0xdd, 0x90, 0x19, 0x7a, 0xd5, 0x92, 0x40, 0xbd, 0x77, 0xb0, 0xe2, 0x58, 0xa8, 0xe6,
+			0xad, 0x74, 0x61, 0x55, 0x76, 0xf1, 0x8f, 0x55, 0x79, 0x69, 0x8e, 0x51, 0x7c, 0xdd, 0x8c, 0xdd,
+			0x7e, 0xdd, 0x8c, 0x40, 0x69, 0x84, 0x69, 0x8e, 0x60, 0x69, 0x87, 0xf1, 0x8f, 0x11, 0x89, 0xa8,
+			0xe6, 0xbd, 0x91, 0xe8, 0x55, 0x84, 0xe6, 0xbd, 0x94, 0x60, 0x11, 0x96, 0x55, 0x84, 0xf1, 0x96,
+			0x79, 0x83, 0x40, 0xcd, 0x97, 0x45, 0x81, 0x60, 0xcd, 0x97, 0xf1, 0x7f, 0xf1, 0x96, 0x11, 0x7f,
+			0x40, 0xbd, 0x94, 0x35, 0x7e, 0xe6, 0xbd, 0x91, 0xe8, 0x45, 0x6e, 0xe6, 0x11, 0x82, 0xe8, 0x11,
+			0x6b, 0x60, 0x11, 0x82, 0xbd, 0x69, 0x35, 0x81, 0xdd, 0x68, 0x40, 0x7e, 0x50, 0x60, 0xad, 0x7d,
+			0x50, 0xcd, 0x7c, 0xdd, 0x68, 0x40, 0xf1, 0x7b, 0x11, 0x6b, 0xe8, 0x45, 0x6e, 0xe6, 0x58, 0xe8,
+			0xf1, 0x76, 0x62, 0x35, 0x6f, 0x21, 0x78, 0x2d, 0x71, 0xe9, 0x7a, 0x25, 0x73, 0xb5, 0x7d, 0x25,
+			0x73, 0x25, 0x81, 0x25, 0x73, 0x89, 0x84, 0x2d, 0x71, 0x55, 0x87, 0x40, 0x58, 0x55, 0x8b, 0xe8,
+			0xa8, 0xe2, 0xf1, 0x81, 0x7c, 0xe1,
+		}},
+		ActionFace: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x95, 0x77, 0x79, 0x86, 0x67, 0x11, 0x76, 0x79,
+			0x86, 0x09, 0x75, 0x6d, 0x85, 0x68, 0x61, 0x84, 0x68, 0xdd, 0x82, 0x68, 0x55, 0x81, 0x0d, 0x75,
+			0x4d, 0x80, 0x19, 0x76, 0x45, 0x7f, 0x9d, 0x77, 0x45, 0x7f, 0x25, 0x79, 0x45, 0x7f, 0x2d, 0x7a,
+			0x51, 0x80, 0x35, 0x7b, 0x5d, 0x81, 0x35, 0x7b, 0xe1, 0x82, 0x35, 0x7b, 0x69, 0x84, 0x29, 0x7a,
+			0x71, 0x85, 0x1d, 0x79, 0x79, 0x86, 0x95, 0x77, 0x79, 0x86, 0xe2, 0x75, 0x88, 0x79, 0x86, 0x67,
+			0xf1, 0x86, 0x79, 0x86, 0xe9, 0x85, 0x6d, 0x85, 0xdd, 0x84, 0x61, 0x84, 0xdd, 0x84, 0xdd, 0x82,
+			0xdd, 0x84, 0x55, 0x81, 0xed, 0x85, 0x4d, 0x80, 0xf9, 0x86, 0x45, 0x7f, 0x7d, 0x88, 0x45, 0x7f,
+			0x94, 0x45, 0x7f, 0x09, 0x8b, 0x51, 0x80, 0x11, 0x8c, 0x5d, 0x81, 0x11, 0x8c, 0xe1, 0x82, 0x11,
+			0x8c, 0x69, 0x84, 0x05, 0x8b, 0x71, 0x85, 0xf9, 0x89, 0x79, 0x86, 0x75, 0x88, 0x79, 0x86, 0xe2,
+			0x80, 0xad, 0x96, 0x68, 0x7d, 0x89, 0xad, 0x96, 0x15, 0x90, 0x11, 0x90, 0xad, 0x96, 0x79, 0x89,
+			0xad, 0x96, 0xf9, 0x7f, 0xad, 0x96, 0x45, 0x7e, 0x69, 0x96, 0x99, 0x7c, 0x25, 0x96, 0xf1, 0x7a,
+			0xbd, 0x95, 0x89, 0x79, 0x69, 0x94, 0xdd, 0x79, 0xd9, 0x92, 0x74, 0x4d, 0x91, 0x25, 0x7a, 0x99,
+			0x8f, 0x25, 0x7a, 0x21, 0x89, 0x25, 0x7a, 0x5d, 0x83, 0x79, 0x77, 0x99, 0x7d, 0xcd, 0x74, 0x89,
+			0x79, 0xdd, 0x6f, 0x45, 0x77, 0x45, 0x75, 0x09, 0x73, 0x4d, 0x79, 0x40, 0x55, 0x69, 0x89, 0x7f,
+			0xe8, 0x80, 0x61, 0x55, 0x69, 0x7d, 0x89, 0xed, 0x6f, 0x15, 0x90, 0x85, 0x76, 0xad, 0x96, 0x80,
+			0xad, 0x96, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40,
+			0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65,
+			0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60,
+			0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55,
+			0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91,
+			0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a,
+			0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80,
+			0xad, 0x9a, 0xe2, 0xdd, 0x79, 0x35, 0x6a, 0x60, 0xbd, 0x7f, 0x11, 0x71, 0xb5, 0x84, 0x99, 0x73,
+			0x40, 0x99, 0x8f, 0x25, 0x76, 0x60, 0x35, 0x91, 0x25, 0x76, 0x25, 0x92, 0x11, 0x76, 0x40, 0x35,
+			0x94, 0xad, 0x75, 0x60, 0x35, 0x91, 0x45, 0x70, 0x09, 0x8c, 0xcd, 0x6c, 0x40, 0x80, 0x55, 0x69,
+			0x60, 0x35, 0x7e, 0x55, 0x69, 0x99, 0x7c, 0x99, 0x69, 0x40, 0xdd, 0x79, 0x35, 0x6a, 0xe2, 0xf1,
+			0x69, 0xcd, 0x7a, 0x60, 0x25, 0x6d, 0x99, 0x79, 0x3d, 0x71, 0x5d, 0x75, 0x40, 0x11, 0x77, 0x25,
+			0x6b, 0x60, 0x45, 0x71, 0xbd, 0x6d, 0x4d, 0x6e, 0xc5, 0x71, 0x40, 0xf1, 0x69, 0xcd, 0x7a, 0xe2,
+			0xdd, 0x79, 0x35, 0x6a, 0xe2, 0x11, 0x77, 0x25, 0x6b, 0xe1,
+		}},
+		ActionFavorite: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xf1, 0x97, 0x00, 0x45, 0x7d, 0x79, 0x95,
+			0x61, 0x39, 0x76, 0xfd, 0x8e, 0x9d, 0x71, 0x4d, 0x8a, 0x5a, 0x99, 0x85, 0x45, 0x6a, 0xe9, 0x81,
+			0x40, 0x71, 0x66, 0x35, 0x7b, 0x63, 0x55, 0x65, 0x35, 0x78, 0x55, 0x65, 0x25, 0x75, 0x55, 0x65,
+			0x21, 0x6f, 0x5d, 0x69, 0x19, 0x6b, 0x69, 0x6d, 0x11, 0x67, 0x55, 0x73, 0x11, 0x67, 0x25, 0x77,
+			0x11, 0x67, 0x5d, 0x7a, 0xdd, 0x68, 0x40, 0x80, 0x11, 0x6e, 0x60, 0xcd, 0x82, 0x79, 0x6a, 0xf1,
+			0x85, 0xc5, 0x68, 0x40, 0xad, 0x8c, 0x11, 0x67, 0x62, 0x99, 0x92, 0x11, 0x67, 0xa5, 0x96, 0x19,
+			0x6b, 0xad, 0x9a, 0x21, 0x6f, 0xad, 0x9a, 0x25, 0x75, 0xad, 0x9a, 0x35, 0x78, 0x91, 0x99, 0x35,
+			0x7b, 0x40, 0xbd, 0x95, 0xe9, 0x81, 0x61, 0xa6, 0x99, 0x85, 0x65, 0x8e, 0x4d, 0x8a, 0xc9, 0x89,
+			0xfd, 0x8e, 0xbd, 0x82, 0x79, 0x95, 0x00, 0x80, 0xf1, 0x97, 0xe2, 0x80, 0xad, 0x92, 0x61, 0xc1,
+			0x86, 0x79, 0x8c, 0x1d, 0x8b, 0x09, 0x88, 0x79, 0x8f, 0x99, 0x83, 0x09, 0x92, 0x45, 0x80, 0x40,
+			0xa5, 0x95, 0x55, 0x7a, 0x61, 0xad, 0x96, 0xb9, 0x77, 0xad, 0x96, 0x25, 0x75, 0xad, 0x96, 0xbd,
+			0x70, 0xdd, 0x93, 0xe9, 0x6d, 0x40, 0xb1, 0x8c, 0x11, 0x6b, 0x61, 0x41, 0x89, 0x11, 0x6b, 0x55,
+			0x86, 0x2d, 0x6d, 0x69, 0x83, 0x45, 0x6f, 0x99, 0x81, 0x11, 0x73, 0xe6, 0x55, 0x7e, 0x62, 0x99,
+			0x7c, 0x55, 0x6f, 0xad, 0x79, 0x35, 0x6d, 0xc1, 0x76, 0x11, 0x6b, 0x51, 0x73, 0x11, 0x6b, 0xf1,
+			0x6e, 0x11, 0x6b, 0x25, 0x6c, 0xe9, 0x6d, 0x40, 0x55, 0x69, 0x29, 0x75, 0x60, 0x55, 0x69, 0xbd,
+			0x77, 0x5d, 0x6a, 0x5d, 0x7a, 0x40, 0xf9, 0x6d, 0x5d, 0x80, 0x60, 0x89, 0x70, 0xbd, 0x83, 0xf1,
+			0x74, 0x25, 0x88, 0x40, 0x80, 0xad, 0x92, 0xe2, 0x80, 0xdd, 0x7e, 0xe1,
+		}},
+		ActionFavoriteBorder: ActionFavoriteBorder,
+		ActionFeedback: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x89, 0x87, 0x67, 0xf1, 0x80, 0x89, 0x87,
+			0x91, 0x81, 0xe9, 0x86, 0x35, 0x82, 0x45, 0x86, 0x35, 0x82, 0x55, 0x85, 0x35, 0x82, 0x69, 0x84,
+			0x91, 0x81, 0xc5, 0x83, 0xf1, 0x80, 0x25, 0x83, 0x80, 0x25, 0x83, 0x11, 0x7f, 0x25, 0x83, 0x71,
+			0x7e, 0xc5, 0x83, 0xcd, 0x7d, 0x65, 0x84, 0xcd, 0x7d, 0x55, 0x85, 0xcd, 0x7d, 0x45, 0x86, 0x71,
+			0x7e, 0xe9, 0x86, 0x11, 0x7f, 0x89, 0x87, 0x80, 0x89, 0x87, 0xe2, 0x7c, 0x11, 0x7e, 0xe6, 0x84,
+			0xe8, 0x55, 0x6d, 0xe6, 0x7c, 0xe8, 0x11, 0x7e, 0xe2, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8,
+			0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60,
+			0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6,
+			0x55, 0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55, 0x69,
+			0xe8, 0x98, 0xe1,
+		}},
+		ActionFindInPage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x89, 0x88, 0x60, 0x89, 0x82, 0x89, 0x88,
+			0x2d, 0x84, 0x91, 0x86, 0x40, 0xcd, 0x85, 0x84, 0x60, 0xcd, 0x85, 0xcd, 0x7f, 0x11, 0x84, 0x5d,
+			0x7e, 0x40, 0x80, 0xf1, 0x7c, 0x60, 0xad, 0x7d, 0xf1, 0x7c, 0xf1, 0x7b, 0x5d, 0x7e, 0x40, 0x35,
+			0x7a, 0x84, 0x60, 0x35, 0x7a, 0x99, 0x84, 0xd5, 0x7b, 0x91, 0x86, 0x40, 0x80, 0x89, 0x88, 0xe2,
+			0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a,
+			0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a, 0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad,
+			0x6e, 0x55, 0x65, 0xe6, 0x69, 0x85, 0x00, 0x55, 0x95, 0x45, 0x75, 0xe8, 0xad, 0x96, 0x60, 0x55,
+			0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2,
+			0x80, 0x80, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x11, 0x91, 0x00, 0x79, 0x85, 0x11, 0x8b, 0x60,
+			0x25, 0x84, 0xdd, 0x8b, 0xd5, 0x82, 0x35, 0x8c, 0x40, 0x80, 0x89, 0x8c, 0x60, 0xdd, 0x7b, 0x89,
+			0x8c, 0x09, 0x79, 0xb5, 0x89, 0x40, 0x35, 0x76, 0xbd, 0x82, 0x60, 0x35, 0x76, 0x99, 0x7e, 0x09,
+			0x79, 0xc5, 0x7b, 0x40, 0x80, 0xf1, 0x78, 0x60, 0x25, 0x84, 0xf1, 0x78, 0xf9, 0x86, 0xc5, 0x7b,
+			0x40, 0xcd, 0x89, 0xbd, 0x82, 0x60, 0xcd, 0x89, 0x45, 0x84, 0x5d, 0x89, 0xad, 0x85, 0x40, 0x11,
+			0x88, 0x25, 0x88, 0x00, 0x55, 0x91, 0x69, 0x91, 0xe8, 0x11, 0x77, 0x00, 0x99, 0x83, 0x55, 0x69,
+			0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		ActionFindReplace: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x6a, 0xdd, 0x79, 0x60, 0x11, 0x6c, 0x35,
+			0x73, 0x4d, 0x71, 0xf1, 0x6e, 0x40, 0x55, 0x7d, 0xad, 0x6a, 0x60, 0xad, 0x81, 0xad, 0x6a, 0x71,
+			0x85, 0xad, 0x6c, 0x40, 0x98, 0x11, 0x72, 0xe8, 0xad, 0x6a, 0xe6, 0xa0, 0xe8, 0xdd, 0x79, 0xe6,
+			0xcd, 0x80, 0xe8, 0xdd, 0x75, 0xe6, 0xbd, 0x89, 0x60, 0x99, 0x87, 0xad, 0x72, 0x5d, 0x84, 0xad,
+			0x70, 0x40, 0x55, 0x7d, 0xad, 0x6e, 0x60, 0x35, 0x78, 0xad, 0x6e, 0x2d, 0x74, 0xc5, 0x71, 0x40,
+			0x5e, 0xdd, 0x79, 0xe6, 0xf1, 0x6a, 0xe2, 0x25, 0x95, 0xb0, 0x00, 0xdd, 0x88, 0xbd, 0x8b, 0x60,
+			0x69, 0x86, 0xcd, 0x8d, 0x79, 0x83, 0xdd, 0x8e, 0x40, 0x55, 0x7d, 0xf1, 0x8f, 0x60, 0x72, 0xf1,
+			0x8f, 0x2d, 0x75, 0x25, 0x8e, 0x40, 0xad, 0x6e, 0xf1, 0x88, 0xe8, 0xa0, 0xe6, 0xad, 0x6a, 0xe8,
+			0xcd, 0x80, 0xe6, 0xdd, 0x79, 0xe8, 0xcd, 0x84, 0xe6, 0x99, 0x70, 0x60, 0xcd, 0x72, 0x90, 0x25,
+			0x76, 0xf9, 0x89, 0x40, 0x55, 0x7d, 0xf1, 0x8b, 0x60, 0x55, 0x82, 0xf1, 0x8b, 0x3d, 0x86, 0xcd,
+			0x88, 0x40, 0x69, 0x8b, 0xcd, 0x80, 0xe6, 0x79, 0x8f, 0x60, 0x25, 0x8f, 0x11, 0x83, 0x2d, 0x8e,
+			0x19, 0x85, 0x40, 0xbd, 0x8b, 0xdd, 0x88, 0x01, 0xb0, 0x25, 0x95, 0x25, 0x95, 0xb0, 0xe1,
+		}},
+		ActionFingerprint: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x68, 0x11, 0x79, 0x60, 0xf1, 0x67, 0xf1,
+			0x78, 0xf1, 0x67, 0xa5, 0x78, 0x40, 0x11, 0x68, 0x11, 0x78, 0x60, 0x35, 0x6c, 0x55, 0x72, 0x89,
+			0x72, 0x35, 0x6f, 0x40, 0x11, 0x80, 0x11, 0x6c, 0x60, 0x45, 0x87, 0x11, 0x6c, 0xa5, 0x8d, 0x25,
+			0x6f, 0x40, 0x35, 0x98, 0xf1, 0x77, 0x60, 0x69, 0x98, 0x45, 0x78, 0x5d, 0x98, 0x79, 0x78, 0x40,
+			0x25, 0x98, 0xdd, 0x78, 0x60, 0xf1, 0x97, 0x11, 0x79, 0xa5, 0x97, 0x11, 0x79, 0x40, 0x11, 0x97,
+			0xbd, 0x78, 0x60, 0x25, 0x93, 0x45, 0x73, 0x09, 0x8d, 0x55, 0x70, 0x40, 0x11, 0x80, 0x69, 0x6d,
+			0x60, 0x35, 0x79, 0x69, 0x6d, 0x35, 0x73, 0x5d, 0x70, 0x40, 0x35, 0x69, 0xbd, 0x78, 0x60, 0xf1,
+			0x68, 0x11, 0x79, 0xb5, 0x68, 0x25, 0x79, 0x40, 0x35, 0x68, 0x11, 0x79, 0xe2, 0x90, 0x99, 0x9a,
+			0x60, 0x25, 0x81, 0xdd, 0x98, 0xb5, 0x7c, 0xbd, 0x93, 0x40, 0x45, 0x78, 0x45, 0x87, 0x60, 0x45,
+			0x78, 0x25, 0x84, 0x91, 0x7a, 0x84, 0x40, 0x11, 0x80, 0xdd, 0x7f, 0x60, 0x45, 0x83, 0xdd, 0x7f,
+			0x91, 0x85, 0x84, 0x40, 0xdd, 0x87, 0x45, 0x87, 0x60, 0xdd, 0x87, 0xcd, 0x89, 0xd5, 0x89, 0x89,
+			0x8b, 0x40, 0x69, 0x8e, 0x45, 0x8d, 0x60, 0xf1, 0x90, 0x45, 0x8d, 0xd5, 0x92, 0x89, 0x8b, 0x40,
+			0xbd, 0x94, 0x45, 0x87, 0x60, 0xbd, 0x94, 0x11, 0x7f, 0xa5, 0x8e, 0x89, 0x79, 0x40, 0x11, 0x80,
+			0x68, 0x60, 0x99, 0x77, 0x68, 0x81, 0x71, 0x89, 0x79, 0x40, 0x69, 0x6b, 0x45, 0x87, 0x60, 0x69,
+			0x6b, 0xdd, 0x88, 0xc5, 0x6b, 0x71, 0x8b, 0x40, 0x55, 0x6d, 0x45, 0x91, 0x60, 0x79, 0x6d, 0x99,
+			0x91, 0x55, 0x6d, 0xc5, 0x91, 0x40, 0x5a, 0x11, 0x92, 0x60, 0xbd, 0x6c, 0x35, 0x92, 0x71, 0x6c,
+			0x25, 0x92, 0x40, 0x58, 0xbd, 0x91, 0x60, 0x25, 0x6b, 0x35, 0x8f, 0xa5, 0x6a, 0x91, 0x8c, 0x40,
+			0x25, 0x6a, 0x45, 0x87, 0x60, 0x25, 0x6a, 0xad, 0x7e, 0xad, 0x70, 0x91, 0x78, 0x40, 0x11, 0x80,
+			0x79, 0x72, 0x60, 0x25, 0x89, 0x79, 0x72, 0xa5, 0x8f, 0x79, 0x78, 0x40, 0x25, 0x96, 0x45, 0x87,
+			0x60, 0x25, 0x96, 0x55, 0x8a, 0xdd, 0x93, 0x79, 0x8c, 0x40, 0x69, 0x8e, 0x99, 0x8e, 0x60, 0x25,
+			0x8b, 0x99, 0x8e, 0xcd, 0x88, 0x79, 0x8c, 0x40, 0x79, 0x86, 0x45, 0x87, 0x60, 0x79, 0x86, 0xad,
+			0x84, 0x91, 0x84, 0xf1, 0x82, 0x40, 0x11, 0x80, 0x35, 0x81, 0x60, 0x79, 0x7d, 0x35, 0x81, 0x89,
+			0x7b, 0xf1, 0x82, 0x40, 0x99, 0x79, 0x45, 0x87, 0x60, 0x99, 0x79, 0x35, 0x8e, 0xcd, 0x7d, 0xd5,
+			0x92, 0x40, 0x45, 0x88, 0x55, 0x99, 0x60, 0xad, 0x88, 0x79, 0x99, 0xc5, 0x88, 0xad, 0x99, 0x40,
+			0xcd, 0x88, 0x25, 0x9a, 0x60, 0xbd, 0x88, 0x79, 0x9a, 0x89, 0x88, 0x99, 0x9a, 0x40, 0x90, 0x99,
+			0x9a, 0xe2, 0x79, 0x70, 0x99, 0x6a, 0x60, 0x25, 0x70, 0xbd, 0x6a, 0xf9, 0x6f, 0xa5, 0x6a, 0x40,
+			0xad, 0x6f, 0x55, 0x6a, 0x60, 0x89, 0x6f, 0x35, 0x6a, 0x89, 0x6f, 0xf1, 0x69, 0x40, 0xbd, 0x6f,
+			0x89, 0x69, 0x60, 0x89, 0x73, 0x79, 0x67, 0xb5, 0x77, 0x69, 0x66, 0x40, 0x11, 0x80, 0x55, 0x65,
+			0x60, 0x69, 0x84, 0x55, 0x65, 0x91, 0x88, 0x69, 0x66, 0x40, 0x89, 0x90, 0x69, 0x69, 0x60, 0xdd,
+			0x90, 0x89, 0x69, 0xe9, 0x90, 0xcd, 0x69, 0x40, 0xcd, 0x90, 0x45, 0x6a, 0x60, 0xad, 0x90, 0x79,
+			0x6a, 0x71, 0x90, 0x99, 0x6a, 0x40, 0xdd, 0x8f, 0x99, 0x6a, 0x60, 0x35, 0x8c, 0xcd, 0x68, 0x35,
+			0x88, 0xc5, 0x67, 0x40, 0x11, 0x80, 0xbd, 0x66, 0x60, 0xf1, 0x7b, 0xbd, 0x66, 0x70, 0xb5, 0x67,
+			0x40, 0x79, 0x70, 0x99, 0x6a, 0xe2, 0x69, 0x79, 0xdd, 0x99, 0x60, 0x89, 0x75, 0xdd, 0x95, 0x5d,
+			0x73, 0x79, 0x91, 0x40, 0x35, 0x71, 0x45, 0x87, 0x60, 0x35, 0x71, 0x55, 0x81, 0x91, 0x75, 0x45,
+			0x7d, 0x40, 0x11, 0x80, 0x35, 0x79, 0x60, 0x35, 0x86, 0x35, 0x79, 0xa5, 0x8a, 0x45, 0x7d, 0x40,
+			0x11, 0x8f, 0x45, 0x87, 0x60, 0x11, 0x8f, 0x99, 0x87, 0xe9, 0x8e, 0xc5, 0x87, 0x40, 0x69, 0x8e,
+			0xf1, 0x87, 0x60, 0x11, 0x8e, 0xf1, 0x87, 0xdd, 0x8d, 0xc5, 0x87, 0x40, 0xad, 0x8d, 0x45, 0x87,
+			0x60, 0xad, 0x8d, 0xdd, 0x81, 0xa5, 0x89, 0x35, 0x7e, 0x40, 0x11, 0x80, 0x89, 0x7a, 0x60, 0x89,
+			0x7a, 0x89, 0x7a, 0x91, 0x76, 0x35, 0x7e, 0x40, 0x99, 0x72, 0x45, 0x87, 0x60, 0x99, 0x72, 0xf1,
+			0x8c, 0x91, 0x74, 0xf1, 0x90, 0x40, 0x69, 0x7a, 0xf1, 0x98, 0x60, 0xad, 0x7a, 0x35, 0x99, 0xa5,
+			0x7a, 0x71, 0x99, 0x40, 0x69, 0x7a, 0xdd, 0x99, 0x60, 0x45, 0x7a, 0xb4, 0xf9, 0x79, 0x19, 0x9a,
+			0x40, 0x69, 0x79, 0xdd, 0x99, 0xe2, 0xcd, 0x8d, 0xaa, 0x60, 0xf1, 0x87, 0xaa, 0xa5, 0x83, 0x19,
+			0x91, 0x40, 0x55, 0x7f, 0x45, 0x87, 0x60, 0x55, 0x7f, 0xf1, 0x86, 0x81, 0x7f, 0xbd, 0x86, 0x40,
+			0x80, 0x89, 0x86, 0x60, 0x55, 0x80, 0x89, 0x86, 0x81, 0x80, 0xbd, 0x86, 0x40, 0xad, 0x80, 0x45,
+			0x87, 0x60, 0xad, 0x80, 0xad, 0x8c, 0xa5, 0x84, 0x2d, 0x90, 0x40, 0xcd, 0x8d, 0xad, 0x93, 0x60,
+			0x55, 0x8e, 0xad, 0x93, 0x11, 0x8f, 0x99, 0x93, 0x40, 0xad, 0x90, 0x69, 0x93, 0x60, 0xa2, 0x55,
+			0x93, 0x35, 0x91, 0x81, 0x93, 0x40, 0x79, 0x91, 0xdd, 0x93, 0x60, 0x89, 0x91, 0x25, 0x94, 0x71,
+			0x91, 0x55, 0x94, 0x40, 0xa2, 0x99, 0x94, 0x60, 0xcd, 0x8f, 0xf1, 0x94, 0xe9, 0x8e, 0xf9, 0x94,
+			0x40, 0xcd, 0x8d, 0xaa, 0xe1,
+		}},
+		ActionFlightLand:    ActionFlightLand,
+		ActionFlightTakeoff: ActionFlightTakeoff,
+		ActionFlipToBack: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0xdd, 0x71, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x25, 0x8e, 0xe8,
+			0xb0, 0xe6, 0x58, 0xe2, 0x99, 0x75, 0x69, 0x8e, 0x60, 0x68, 0x69, 0x8e, 0xcd, 0x72, 0x35, 0x8d,
+			0x40, 0x99, 0x71, 0x69, 0x8a, 0xe6, 0x99, 0x75, 0xe8, 0x69, 0x8e, 0xe2, 0x99, 0x71, 0x89, 0x84,
+			0xe8, 0x25, 0x7e, 0xe6, 0x99, 0x75, 0xe8, 0x89, 0x84, 0xe6, 0x99, 0x71, 0xe2, 0x99, 0x71, 0x45,
+			0x78, 0xe8, 0xdd, 0x71, 0xe6, 0x99, 0x75, 0xe8, 0x45, 0x78, 0xe6, 0x99, 0x71, 0xe2, 0x99, 0x71,
+			0x58, 0x60, 0x99, 0x71, 0x69, 0x6a, 0xcd, 0x72, 0x35, 0x69, 0x40, 0x99, 0x75, 0x50, 0xe8, 0x58,
+			0xe6, 0x99, 0x71, 0xe2, 0x79, 0x7b, 0x69, 0x8e, 0xe8, 0x69, 0x8a, 0xe6, 0xdd, 0x81, 0xe8, 0x69,
+			0x8e, 0xe6, 0x79, 0x7b, 0xe2, 0x79, 0x7b, 0x58, 0xe8, 0x50, 0xe6, 0xdd, 0x81, 0xe8, 0x58, 0xe6,
+			0x79, 0x7b, 0xe2, 0xbd, 0x87, 0x69, 0x8e, 0xe8, 0x69, 0x8a, 0xe6, 0x25, 0x8e, 0xe8, 0x69, 0x8e,
+			0xe6, 0xbd, 0x87, 0xe2, 0xbd, 0x87, 0x58, 0xe8, 0x50, 0xe6, 0x25, 0x8e, 0xe8, 0x58, 0xe6, 0xbd,
+			0x87, 0xe2, 0xa8, 0x69, 0x8e, 0xe8, 0x69, 0x8a, 0xe6, 0xb0, 0x60, 0xb0, 0x98, 0xcd, 0x96, 0x35,
+			0x8d, 0x40, 0xa8, 0x69, 0x8e, 0xe2, 0xa8, 0x89, 0x84, 0xe8, 0x25, 0x7e, 0xe6, 0xb0, 0xe8, 0x89,
+			0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x45, 0x78, 0xe8, 0xdd, 0x71, 0xe6, 0xb0, 0xe8, 0x45, 0x78, 0xe6,
+			0xa8, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0,
+			0x58, 0xe6, 0xa8, 0xe1,
+		}},
+		ActionFlipToFront: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe6, 0x58, 0xe8, 0xb0, 0xe2, 0x50, 0x69, 0x8e, 0xe8, 0xcd, 0x88,
+			0xe6, 0x58, 0xe8, 0x69, 0x8e, 0xe6, 0x50, 0xe2, 0x50, 0x35, 0x83, 0xe8, 0x99, 0x7d, 0xe6, 0x58,
+			0xe8, 0x35, 0x83, 0xe6, 0x50, 0xe2, 0x50, 0x11, 0x78, 0xe8, 0x79, 0x72, 0xe6, 0x58, 0xe8, 0x11,
+			0x78, 0xe6, 0x50, 0xe2, 0x99, 0x71, 0xb0, 0xe8, 0xa8, 0xe6, 0x35, 0x77, 0xe8, 0xb0, 0xe6, 0x99,
+			0x71, 0xe2, 0x99, 0x75, 0x69, 0x8e, 0x60, 0x68, 0x69, 0x8e, 0xcd, 0x72, 0x35, 0x8d, 0x40, 0x99,
+			0x71, 0x69, 0x8a, 0xe8, 0x58, 0x60, 0x99, 0x71, 0x69, 0x6a, 0xcd, 0x72, 0x35, 0x69, 0x40, 0x99,
+			0x75, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0x69, 0x8a, 0x60, 0xb0, 0x98, 0xcd, 0x96, 0x35, 0x8d, 0x40, 0xa8, 0x69, 0x8e, 0xe6, 0x99, 0x75,
+			0xe2, 0x99, 0x75, 0x69, 0x8a, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x99, 0x75, 0xe8, 0x69, 0x8a, 0xe2,
+			0xcd, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x69, 0x82, 0xe8, 0xb0, 0xe6, 0xcd, 0x7c, 0xe2, 0x90, 0xb0,
+			0xe8, 0xa8, 0xe6, 0x99, 0x8d, 0xe8, 0xb0, 0xe6, 0x90, 0xe1,
+		}},
+		ActionGIF: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7f, 0x90, 0xe8, 0x70, 0xe6, 0x69, 0x82,
+			0xe8, 0x90, 0xe6, 0x35, 0x7f, 0xe2, 0x99, 0x6f, 0x90, 0x61, 0x95, 0x6e, 0x90, 0xf5, 0x6d, 0x5d,
+			0x87, 0x55, 0x6d, 0xbd, 0x86, 0x55, 0x6d, 0xbd, 0x85, 0xe8, 0x45, 0x7a, 0x60, 0x55, 0x6d, 0x45,
+			0x79, 0xf5, 0x6d, 0xa5, 0x78, 0x40, 0x99, 0x6f, 0x70, 0xe6, 0xdd, 0x77, 0x61, 0xe5, 0x78, 0x70,
+			0x85, 0x79, 0xa5, 0x78, 0x25, 0x7a, 0x45, 0x79, 0x25, 0x7a, 0x45, 0x7a, 0xe8, 0x35, 0x7b, 0xe6,
+			0x89, 0x70, 0xe8, 0xcd, 0x84, 0xe6, 0xf1, 0x76, 0xe8, 0x80, 0xe6, 0x25, 0x7a, 0xe8, 0xbd, 0x85,
+			0x60, 0x25, 0x7a, 0xbd, 0x86, 0x85, 0x79, 0x5d, 0x87, 0x40, 0xdd, 0x77, 0x90, 0xe6, 0x99, 0x6f,
+			0xe2, 0x35, 0x87, 0x90, 0xe8, 0x70, 0xe6, 0xad, 0x92, 0xe8, 0x35, 0x7b, 0xe6, 0x69, 0x8a, 0xe8,
+			0x35, 0x7f, 0xe6, 0xcd, 0x8f, 0xe8, 0x69, 0x82, 0xe6, 0x69, 0x8a, 0xe8, 0x90, 0xe6, 0x35, 0x87,
+			0xe1,
+		}},
+		ActionGTranslate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0xad, 0x9a, 0x00, 0x55, 0x7d, 0xad,
+			0x92, 0xe6, 0xad, 0x6a, 0x60, 0x55, 0x68, 0xad, 0x92, 0xd5, 0x66, 0x2d, 0x91, 0x40, 0x55, 0x65,
+			0x55, 0x8d, 0xe8, 0xad, 0x6a, 0x60, 0x55, 0x65, 0x55, 0x68, 0xd5, 0x66, 0xd5, 0x66, 0x40, 0xad,
+			0x6a, 0x55, 0x65, 0xe6, 0xad, 0x7a, 0x00, 0x7a, 0x4d, 0x6d, 0xe6, 0x55, 0x95, 0x61, 0xad, 0x97,
+			0x4d, 0x6d, 0x2d, 0x99, 0xc9, 0x6e, 0xad, 0x9a, 0x49, 0x70, 0xad, 0x9a, 0x99, 0x72, 0xe8, 0x55,
+			0x95, 0x60, 0xad, 0x9a, 0xad, 0x97, 0x2d, 0x99, 0x2d, 0x99, 0x40, 0x59, 0x95, 0xad, 0x9a, 0xe6,
+			0x11, 0x80, 0xe2, 0x1d, 0x73, 0xf1, 0x86, 0x60, 0xbd, 0x77, 0xf1, 0x86, 0xad, 0x7a, 0xf5, 0x83,
+			0x40, 0x99, 0x7d, 0x35, 0x7c, 0xe8, 0x2d, 0x7b, 0x60, 0x99, 0x7d, 0xbd, 0x7a, 0x79, 0x7d, 0x69,
+			0x7a, 0xe6, 0xdd, 0x72, 0xe8, 0x79, 0x7e, 0xe6, 0xdd, 0x78, 0x63, 0x55, 0x78, 0x65, 0x80, 0xd5,
+			0x76, 0x79, 0x81, 0x55, 0x75, 0x89, 0x82, 0x35, 0x73, 0x89, 0x82, 0x99, 0x70, 0x89, 0x82, 0xbd,
+			0x6e, 0xa5, 0x80, 0xdd, 0x6c, 0xc5, 0x7e, 0xdd, 0x6c, 0x78, 0x40, 0xbd, 0x6e, 0x5d, 0x77, 0x62,
+			0x99, 0x70, 0x79, 0x75, 0x31, 0x73, 0x79, 0x75, 0x61, 0x74, 0x79, 0x75, 0x71, 0x75, 0xe9, 0x75,
+			0x81, 0x76, 0x55, 0x76, 0x5d, 0x77, 0x35, 0x77, 0x00, 0x99, 0x7a, 0x25, 0x74, 0x60, 0x45, 0x79,
+			0xad, 0x72, 0x45, 0x77, 0xdd, 0x71, 0x40, 0x25, 0x73, 0x11, 0x71, 0x60, 0x99, 0x6e, 0x11, 0x71,
+			0x71, 0x6b, 0x45, 0x74, 0x40, 0x45, 0x68, 0x78, 0x61, 0x45, 0x68, 0x89, 0x80, 0x75, 0x6b, 0xbd,
+			0x83, 0xa1, 0x6e, 0xf1, 0x86, 0x1d, 0x73, 0xf1, 0x86, 0xe2, 0xf5, 0x84, 0x35, 0x88, 0x00, 0x79,
+			0x86, 0xcd, 0x86, 0x60, 0x89, 0x85, 0xad, 0x85, 0xbd, 0x84, 0xa5, 0x84, 0x40, 0x35, 0x83, 0x69,
+			0x82, 0x00, 0xf5, 0x84, 0x35, 0x88, 0xe2, 0x49, 0x88, 0xcd, 0x84, 0x61, 0x2d, 0x8a, 0x99, 0x82,
+			0x29, 0x8b, 0x99, 0x80, 0x21, 0x8c, 0x99, 0x7e, 0x79, 0x8c, 0x79, 0x7d, 0xe6, 0xdd, 0x81, 0x00,
+			0x99, 0x82, 0x55, 0x80, 0xe6, 0x45, 0x85, 0x61, 0xcd, 0x85, 0x51, 0x81, 0x89, 0x86, 0x75, 0x82,
+			0x45, 0x87, 0x99, 0x83, 0x49, 0x88, 0xcd, 0x84, 0xe2, 0xbd, 0x82, 0xb0, 0xe6, 0x55, 0x95, 0x61,
+			0x79, 0x96, 0xb0, 0x3d, 0x97, 0x3d, 0x97, 0xb0, 0x79, 0x96, 0xb0, 0x55, 0x95, 0xe8, 0x99, 0x72,
+			0x60, 0xb0, 0x79, 0x71, 0x3d, 0x97, 0xb5, 0x70, 0x40, 0x55, 0x95, 0xf1, 0x6f, 0xe6, 0xdd, 0x7d,
+			0x00, 0xf1, 0x80, 0xcd, 0x7a, 0xe6, 0x35, 0x86, 0xe8, 0xf1, 0x77, 0xe6, 0xf1, 0x88, 0xe8, 0xcd,
+			0x7a, 0xe6, 0xbd, 0x92, 0xe8, 0x79, 0x7d, 0xe6, 0x45, 0x8f, 0x61, 0xad, 0x8e, 0x80, 0x55, 0x8d,
+			0x69, 0x82, 0x98, 0xd1, 0x84, 0x35, 0x8a, 0xe5, 0x86, 0x05, 0x79, 0x91, 0x11, 0x8e, 0x89, 0x8f,
+			0xa0, 0x45, 0x88, 0xcd, 0x88, 0xdd, 0x85, 0x35, 0x8b, 0x11, 0x88, 0xad, 0x92, 0xbd, 0x82, 0xb0,
+			0xe1,
+		}},
+		ActionGavel:  ActionGavel,
+		ActionGetApp: ActionGetApp,
+		ActionGrade: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x75, 0x55, 0x92, 0x09, 0x80, 0x11, 0x8c,
+			0x79, 0x8a, 0x69, 0x92, 0xad, 0x87, 0x89, 0x86, 0xdd, 0x90, 0x89, 0x7e, 0xbd, 0x84, 0x79, 0x7d,
+			0x80, 0x45, 0x72, 0x45, 0x7b, 0x69, 0x7d, 0x25, 0x6f, 0x79, 0x7e, 0x55, 0x78, 0x79, 0x86, 0x89,
+			0x75, 0x55, 0x92, 0xe2, 0x89, 0x6f, 0xad, 0x9a, 0x09, 0xdd, 0x73, 0xf1, 0x87, 0x55, 0x65, 0x55,
+			0x7b, 0x89, 0x78, 0xad, 0x79, 0x80, 0x50, 0x79, 0x87, 0xad, 0x79, 0xad, 0x9a, 0x55, 0x7b, 0x25,
+			0x8c, 0xf1, 0x87, 0x79, 0x90, 0xad, 0x9a, 0x80, 0xbd, 0x90, 0x89, 0x6f, 0xad, 0x9a, 0xe2, 0x80,
+			0x86, 0xe1,
+		}},
+		ActionGroupWork: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x79, 0xad, 0x8a, 0x62, 0xdd, 0x7b, 0xad,
+			0x8a, 0x45, 0x7d, 0x3d, 0x89, 0xad, 0x7e, 0xd1, 0x87, 0xad, 0x7e, 0xd1, 0x85, 0xad, 0x7e, 0xcd,
+			0x83, 0x45, 0x7d, 0x5d, 0x82, 0x40, 0xd5, 0x79, 0xf1, 0x80, 0x62, 0xcd, 0x77, 0xf1, 0x80, 0x5d,
+			0x76, 0x5d, 0x82, 0xf1, 0x74, 0xcd, 0x83, 0xf1, 0x74, 0xcd, 0x85, 0xf1, 0x74, 0xcd, 0x87, 0x5d,
+			0x76, 0x3d, 0x89, 0x40, 0xcd, 0x79, 0xad, 0x8a, 0xe2, 0x31, 0x86, 0xad, 0x8a, 0x67, 0x35, 0x88,
+			0xad, 0x8a, 0xa5, 0x89, 0x3d, 0x89, 0x11, 0x8b, 0xd1, 0x87, 0x11, 0x8b, 0xd1, 0x85, 0x11, 0x8b,
+			0xcd, 0x83, 0xa5, 0x89, 0x5d, 0x82, 0x35, 0x88, 0xf1, 0x80, 0x35, 0x86, 0xf1, 0x80, 0x35, 0x84,
+			0xf1, 0x80, 0xc5, 0x82, 0x5d, 0x82, 0x55, 0x81, 0xcd, 0x83, 0x55, 0x81, 0xcd, 0x85, 0x55, 0x81,
+			0xcd, 0x87, 0xc5, 0x82, 0x3d, 0x89, 0x31, 0x84, 0xad, 0x8a, 0x31, 0x86, 0xad, 0x8a, 0xe2, 0x80,
+			0x35, 0x7e, 0x60, 0x84, 0x35, 0x7e, 0x71, 0x83, 0xcd, 0x7c, 0x40, 0xdd, 0x84, 0x5d, 0x79, 0x62,
+			0xdd, 0x84, 0x55, 0x77, 0x71, 0x83, 0xe9, 0x75, 0x05, 0x82, 0x79, 0x74, 0x80, 0x79, 0x74, 0x7c,
+			0x79, 0x74, 0x91, 0x7c, 0xe5, 0x75, 0x40, 0x25, 0x7b, 0x55, 0x79, 0x61, 0x25, 0x7b, 0x69, 0x7b,
+			0x91, 0x7c, 0xcd, 0x7c, 0xfd, 0x7d, 0x35, 0x7e, 0x80, 0x35, 0x7e, 0xe2, 0x05, 0x80, 0xad, 0x9a,
+			0x60, 0x81, 0x7a, 0xad, 0x9a, 0xa9, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x63, 0x89,
+			0x69, 0x35, 0x8f, 0x71, 0x67, 0x59, 0x8a, 0x55, 0x65, 0x7d, 0x85, 0x55, 0x65, 0xf9, 0x7f, 0x55,
+			0x65, 0x75, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x89, 0x69, 0xbd, 0x70, 0x2d, 0x6d, 0x25, 0x6d, 0x40,
+			0xa9, 0x75, 0x71, 0x67, 0x62, 0x85, 0x7a, 0x55, 0x65, 0x09, 0x80, 0x55, 0x65, 0x8d, 0x85, 0x55,
+			0x65, 0x69, 0x8a, 0x71, 0x67, 0x45, 0x8f, 0x89, 0x69, 0xdd, 0x92, 0x25, 0x6d, 0x40, 0x91, 0x98,
+			0x99, 0x75, 0x61, 0xad, 0x9a, 0x79, 0x7a, 0xad, 0x9a, 0xfd, 0x7f, 0xad, 0x9a, 0x81, 0x85, 0x91,
+			0x98, 0x59, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x61, 0x45, 0x8f, 0x71, 0x96, 0x69, 0x8a, 0x8d,
+			0x98, 0x89, 0x85, 0xad, 0x9a, 0x05, 0x80, 0xad, 0x9a, 0xe2, 0x09, 0x80, 0xad, 0x96, 0x60, 0x79,
+			0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0xf9, 0x7f, 0x64, 0xad, 0x96, 0x89,
+			0x76, 0x15, 0x90, 0xf1, 0x6f, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x99, 0x76, 0x55, 0x69,
+			0xf9, 0x6f, 0xed, 0x6f, 0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x55, 0x69, 0x69, 0x89, 0xf9,
+			0x6f, 0x09, 0x90, 0x40, 0x09, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionHTTP: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0x90, 0xe8, 0x70, 0xe6, 0xdd, 0x65,
+			0xe8, 0xad, 0x7d, 0xe6, 0x89, 0x6d, 0xe8, 0x70, 0xe6, 0xbd, 0x70, 0xe8, 0x90, 0xe6, 0x89, 0x6d,
+			0xe8, 0xdd, 0x80, 0xe6, 0xdd, 0x65, 0xe8, 0x90, 0xe6, 0xad, 0x62, 0xe2, 0x89, 0x77, 0x90, 0xe8,
+			0x35, 0x7b, 0xe6, 0x35, 0x72, 0xe8, 0x70, 0xe6, 0x11, 0x80, 0xe8, 0x35, 0x7b, 0xe6, 0xbd, 0x7a,
+			0xe8, 0x90, 0xe6, 0x89, 0x77, 0xe2, 0xdd, 0x86, 0x90, 0xe8, 0x35, 0x7b, 0xe6, 0x89, 0x81, 0xe8,
+			0x70, 0xe6, 0x69, 0x8f, 0xe8, 0x35, 0x7b, 0xe6, 0x11, 0x8a, 0xe8, 0x90, 0xe6, 0xdd, 0x86, 0xe2,
+			0xdd, 0x90, 0x90, 0xe8, 0x70, 0xe6, 0x11, 0x9a, 0x60, 0x99, 0x9b, 0x70, 0x79, 0x9c, 0xdd, 0x78,
+			0x40, 0x55, 0x9d, 0x45, 0x7b, 0xe8, 0x25, 0x7f, 0x60, 0x55, 0x9d, 0xad, 0x80, 0x79, 0x9c, 0x81,
+			0x81, 0x40, 0x11, 0x9a, 0x55, 0x82, 0xe6, 0x11, 0x94, 0xe8, 0x90, 0xe6, 0xdd, 0x90, 0xe2, 0x11,
+			0x94, 0x25, 0x7f, 0xe6, 0x25, 0x9a, 0xe8, 0x35, 0x7b, 0xe6, 0x11, 0x94, 0xe8, 0x25, 0x7f, 0xe1,
+		}},
+		ActionHTTPS: ActionHTTPS,
+		ActionHelp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x80, 0x89, 0x8f, 0x60, 0x55, 0x81, 0x89,
+			0x8f, 0x11, 0x82, 0xcd, 0x8e, 0x40, 0xcd, 0x82, 0x9a, 0x60, 0xcd, 0x82, 0xf1, 0x8b, 0x11, 0x82,
+			0x35, 0x8b, 0x40, 0x45, 0x80, 0x79, 0x8a, 0x60, 0x35, 0x7f, 0x79, 0x8a, 0x79, 0x7e, 0x35, 0x8b,
+			0x40, 0xbd, 0x7d, 0x9a, 0x60, 0xbd, 0x7d, 0x11, 0x8e, 0x79, 0x7e, 0xcd, 0x8e, 0x40, 0x45, 0x80,
+			0x89, 0x8f, 0xe2, 0xf1, 0x7d, 0xcd, 0x85, 0xe6, 0xdd, 0x81, 0x60, 0xdd, 0x81, 0x11, 0x84, 0x4d,
+			0x82, 0xa5, 0x82, 0x40, 0x8a, 0x55, 0x7f, 0x60, 0x11, 0x87, 0x99, 0x7d, 0xf1, 0x87, 0xf1, 0x7b,
+			0x40, 0xcd, 0x88, 0x45, 0x78, 0x60, 0xcd, 0x88, 0xbd, 0x74, 0x81, 0x86, 0x99, 0x72, 0x40, 0x69,
+			0x80, 0x79, 0x70, 0x60, 0x25, 0x7d, 0x79, 0x70, 0xa5, 0x7a, 0x19, 0x72, 0x40, 0x6e, 0x99, 0x76,
+			0x00, 0x89, 0x7a, 0xf1, 0x77, 0x60, 0x45, 0x7b, 0x11, 0x76, 0xbd, 0x7c, 0x09, 0x75, 0x40, 0x35,
+			0x80, 0x68, 0x60, 0x79, 0x82, 0x68, 0xdd, 0x83, 0x3d, 0x75, 0x40, 0x45, 0x85, 0x69, 0x78, 0x60,
+			0x45, 0x85, 0xdd, 0x79, 0x69, 0x84, 0x2d, 0x7b, 0x40, 0xdd, 0x81, 0xdd, 0x7d, 0x60, 0xdd, 0x7f,
+			0x99, 0x7f, 0xe9, 0x7e, 0x4d, 0x81, 0x40, 0xf1, 0x7d, 0xcd, 0x85, 0xe2, 0x80, 0xad, 0x9a, 0x60,
+			0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69,
+			0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71,
+			0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71,
+			0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd,
+			0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80,
+			0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45,
+			0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60,
+			0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89,
+			0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f,
+			0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40,
+			0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionHelpOutline:  ActionHelpOutline,
+		ActionHighlightOff: ActionHighlightOff,
+		ActionHistory: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x7f, 0xb0, 0x60, 0xdd, 0x75, 0xb0, 0xf1,
+			0x6e, 0xf9, 0x90, 0x40, 0x50, 0xf1, 0x7f, 0xe6, 0x58, 0x60, 0x58, 0x45, 0x88, 0xbd, 0x71, 0x25,
+			0x8e, 0x40, 0xcd, 0x7f, 0xa8, 0x60, 0x45, 0x88, 0xa8, 0x25, 0x8e, 0x11, 0x8e, 0x40, 0xa8, 0xad,
+			0x7f, 0x60, 0xa8, 0x69, 0x77, 0x11, 0x8e, 0xb5, 0x71, 0x40, 0xcd, 0x7f, 0x58, 0x60, 0x45, 0x7b,
+			0x58, 0x4d, 0x77, 0x11, 0x6e, 0x40, 0x69, 0x70, 0x89, 0x73, 0xe6, 0x69, 0x77, 0xe8, 0x89, 0x77,
+			0xe6, 0x79, 0x69, 0xe8, 0xad, 0x69, 0xe6, 0x79, 0x6d, 0xe8, 0xbd, 0x70, 0x60, 0xf1, 0x70, 0xad,
+			0x6c, 0xb5, 0x75, 0x55, 0x6a, 0x40, 0xcd, 0x7f, 0x50, 0x60, 0xcd, 0x84, 0x50, 0x35, 0x89, 0xdd,
+			0x69, 0x40, 0xe9, 0x90, 0xf9, 0x6e, 0x60, 0x35, 0x94, 0x35, 0x72, 0x19, 0x96, 0x89, 0x76, 0x40,
+			0xb0, 0xdd, 0x7f, 0x60, 0xb0, 0xdd, 0x84, 0x19, 0x96, 0x45, 0x89, 0x40, 0xe9, 0x90, 0xf1, 0x90,
+			0x60, 0x99, 0x8d, 0x35, 0x94, 0x35, 0x89, 0x19, 0x96, 0x40, 0xcd, 0x7f, 0xb0, 0xe2, 0x55, 0x88,
+			0xdd, 0x8a, 0x00, 0x11, 0x7e, 0xbd, 0x80, 0xe8, 0x79, 0x72, 0xe6, 0x11, 0x82, 0xe8, 0x11, 0x7f,
+			0x01, 0x35, 0x8b, 0x90, 0x55, 0x88, 0xdd, 0x8a, 0xe1,
+		}},
+		ActionHome: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xa8, 0xe6, 0xad, 0x78, 0xe8, 0x55,
+			0x83, 0xe6, 0x55, 0x87, 0xe8, 0xa8, 0xe6, 0x55, 0x91, 0xe8, 0x74, 0x01, 0x80, 0x5a, 0xad, 0x6e,
+			0x74, 0xe8, 0xa8, 0xe2, 0xad, 0x6a, 0xb0, 0xe8, 0x70, 0x01, 0x80, 0x50, 0x55, 0x95, 0x70, 0xe8,
+			0xb0, 0xe6, 0x55, 0x83, 0xe8, 0x55, 0x87, 0xe6, 0xad, 0x7c, 0xe8, 0xb0, 0xe6, 0xad, 0x6a, 0xe2,
+			0x80, 0x79, 0x80, 0xe1,
+		}},
+		ActionHourglassEmpty: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x75, 0xad, 0x96, 0xe6, 0xf1, 0x8a, 0xe8,
+			0x35, 0x8e, 0x60, 0xf1, 0x8a, 0x89, 0x89, 0xc5, 0x87, 0x2d, 0x86, 0x40, 0x80, 0xcd, 0x82, 0x60,
+			0x69, 0x7b, 0xcd, 0x82, 0x3d, 0x78, 0x2d, 0x86, 0x40, 0x11, 0x75, 0x35, 0x8e, 0xe8, 0xad, 0x96,
+			0xe2, 0x80, 0x35, 0x7d, 0x60, 0x99, 0x84, 0x35, 0x7d, 0xc5, 0x87, 0xd5, 0x79, 0x40, 0xf1, 0x8a,
+			0xbd, 0x71, 0xe8, 0x55, 0x69, 0xe6, 0x11, 0x75, 0xe8, 0xbd, 0x71, 0x60, 0x11, 0x75, 0x79, 0x76,
+			0x3d, 0x78, 0xd5, 0x79, 0x40, 0x80, 0x35, 0x7d, 0xe2, 0xad, 0x6a, 0xad, 0x9a, 0xe8, 0xad, 0x96,
+			0xe6, 0x11, 0x71, 0xe8, 0x35, 0x8e, 0x60, 0x11, 0x71, 0x89, 0x89, 0x81, 0x73, 0xa5, 0x85, 0x40,
+			0x45, 0x7a, 0x80, 0x60, 0xf1, 0x75, 0x45, 0x7e, 0x81, 0x73, 0x55, 0x7a, 0x40, 0x11, 0x71, 0xbd,
+			0x71, 0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x95, 0xe8, 0x55, 0x69,
+			0xe6, 0xf1, 0x8e, 0xe8, 0xbd, 0x71, 0x60, 0xf1, 0x8e, 0x69, 0x76, 0x81, 0x8c, 0x55, 0x7a, 0x40,
+			0xbd, 0x85, 0x80, 0x60, 0x11, 0x8a, 0xbd, 0x81, 0x81, 0x8c, 0xa5, 0x85, 0x40, 0xf1, 0x8e, 0x35,
+			0x8e, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x95, 0xe8, 0xad, 0x9a, 0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		ActionHourglassFull: ActionHourglassFull,
+		ActionImportantDevices: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8d, 0xb0, 0x60, 0x35, 0x8c, 0xb0, 0x71,
+			0x8b, 0x3d, 0x97, 0x40, 0xad, 0x8a, 0x55, 0x95, 0xe8, 0x11, 0x7f, 0x60, 0xad, 0x8a, 0xf1, 0x7d,
+			0x71, 0x8b, 0x2d, 0x7d, 0x40, 0x55, 0x8d, 0x69, 0x7c, 0xe6, 0xb0, 0x60, 0x25, 0x99, 0x69, 0x7c,
+			0xe9, 0x99, 0x2d, 0x7d, 0x40, 0xad, 0x9a, 0x11, 0x7f, 0xe8, 0x55, 0x95, 0x60, 0xad, 0x9a, 0x79,
+			0x96, 0xe9, 0x99, 0x3d, 0x97, 0x40, 0xb0, 0xb0, 0xe6, 0x55, 0x8d, 0xe2, 0x55, 0x8d, 0xa8, 0xe6,
+			0xb0, 0xe8, 0x69, 0x80, 0xe6, 0x55, 0x8d, 0xe8, 0xa8, 0xe2, 0x55, 0x75, 0xb0, 0xe8, 0xa8, 0xe6,
+			0x55, 0x7b, 0xe8, 0x55, 0x8d, 0xe6, 0x55, 0x69, 0x60, 0xb1, 0x67, 0x55, 0x8d, 0x85, 0x66, 0x29,
+			0x8c, 0x40, 0x55, 0x65, 0x55, 0x89, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x59, 0x6a, 0x85, 0x66, 0x2d,
+			0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0x45, 0x91, 0x60, 0xa6, 0x50, 0x2d, 0x94, 0x2d, 0x69, 0x40,
+			0x55, 0x95, 0x58, 0xe8, 0x69, 0x78, 0xe6, 0x55, 0x91, 0xe8, 0x58, 0xe6, 0x5d, 0x91, 0xe6, 0x55,
+			0x69, 0xe8, 0x55, 0x89, 0xe6, 0xad, 0x86, 0xe8, 0x55, 0x8d, 0xe6, 0x55, 0x7f, 0xe8, 0xa8, 0xe6,
+			0x55, 0x85, 0xe8, 0xb0, 0xe6, 0x55, 0x75, 0xe2, 0xad, 0x77, 0x11, 0x84, 0x03, 0x55, 0x7d, 0xbd,
+			0x7f, 0x86, 0x11, 0x84, 0xf1, 0x80, 0x7a, 0xad, 0x86, 0x69, 0x78, 0xe6, 0x89, 0x7f, 0x01, 0x55,
+			0x7d, 0x79, 0x71, 0x25, 0x7b, 0x69, 0x78, 0xe6, 0x68, 0x01, 0xbd, 0x79, 0x7a, 0xad, 0x77, 0x11,
+			0x84, 0xe2, 0x69, 0x7d, 0xad, 0x7a, 0xe1,
+		}},
+		ActionInfo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7e, 0x55, 0x8d, 0xe6, 0x35, 0x82, 0xe8,
+			0x55, 0x7d, 0xe6, 0x35, 0x7e, 0xe8, 0x55, 0x8d, 0xe2, 0x80, 0x69, 0x78, 0x60, 0xf1, 0x80, 0x69,
+			0x78, 0x91, 0x81, 0xc9, 0x77, 0x40, 0x35, 0x82, 0x45, 0x76, 0x62, 0x35, 0x82, 0x4d, 0x75, 0x91,
+			0x81, 0xa9, 0x74, 0xf1, 0x80, 0x68, 0x80, 0x68, 0x11, 0x7f, 0x68, 0x71, 0x7e, 0xa9, 0x74, 0x40,
+			0xcd, 0x7d, 0x45, 0x76, 0x61, 0xcd, 0x7d, 0x2d, 0x77, 0x71, 0x7e, 0xc9, 0x77, 0x11, 0x7f, 0x69,
+			0x78, 0x80, 0x69, 0x78, 0xe2, 0x05, 0x80, 0xad, 0x9a, 0x60, 0x81, 0x7a, 0xad, 0x9a, 0xa9, 0x75,
+			0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x63, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x59, 0x8a,
+			0x55, 0x65, 0x7d, 0x85, 0x55, 0x65, 0xf9, 0x7f, 0x55, 0x65, 0x75, 0x7a, 0x71, 0x67, 0x99, 0x75,
+			0x89, 0x69, 0xbd, 0x70, 0x2d, 0x6d, 0x25, 0x6d, 0x40, 0xa9, 0x75, 0x71, 0x67, 0x62, 0x85, 0x7a,
+			0x55, 0x65, 0x09, 0x80, 0x55, 0x65, 0x8d, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x45, 0x8f,
+			0x89, 0x69, 0xdd, 0x92, 0x25, 0x6d, 0x40, 0x91, 0x98, 0x99, 0x75, 0x61, 0xad, 0x9a, 0x79, 0x7a,
+			0xad, 0x9a, 0xfd, 0x7f, 0xad, 0x9a, 0x81, 0x85, 0x91, 0x98, 0x59, 0x8a, 0x40, 0xdd, 0x92, 0xd5,
+			0x92, 0x61, 0x45, 0x8f, 0x71, 0x96, 0x69, 0x8a, 0x8d, 0x98, 0x89, 0x85, 0xad, 0x9a, 0x05, 0x80,
+			0xad, 0x9a, 0xe2, 0x09, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90,
+			0x40, 0xad, 0x96, 0xf9, 0x7f, 0x64, 0xad, 0x96, 0x89, 0x76, 0x15, 0x90, 0xf1, 0x6f, 0x7d, 0x89,
+			0x55, 0x69, 0x80, 0x55, 0x69, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xed, 0x6f, 0x55, 0x69, 0x85,
+			0x76, 0x55, 0x69, 0x80, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x09, 0x80, 0xad,
+			0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionInfoOutline: ActionInfoOutline,
+		ActionInput: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0x11, 0x86, 0xe6, 0x55, 0x69,
+			0xe8, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x11, 0x7a, 0xe6,
+			0x55, 0x65, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55,
+			0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40,
+			0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94,
+			0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x99, 0x7e, 0xcd, 0x8b, 0x01, 0xbd, 0x7b,
+			0xf1, 0x88, 0x99, 0x82, 0x11, 0x82, 0xe6, 0x55, 0x65, 0xe8, 0x11, 0x7e, 0xe6, 0x99, 0x82, 0x03,
+			0xbd, 0x7b, 0x35, 0x77, 0x99, 0x7e, 0x55, 0x74, 0x55, 0x8a, 0x11, 0x80, 0x99, 0x7e, 0xcd, 0x8b,
+			0xe1,
+		}, mirror: true},
+		ActionInvertColors: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x60, 0x35, 0x77, 0xb0, 0xf1, 0x70,
+			0xe9, 0x91, 0x40, 0xad, 0x6a, 0x86, 0x60, 0xad, 0x6a, 0x99, 0x7e, 0x55, 0x6c, 0xd5, 0x7a, 0x40,
+			0xf1, 0x70, 0x25, 0x74, 0x01, 0x80, 0x55, 0x65, 0x11, 0x8f, 0x25, 0x74, 0x60, 0xa4, 0x11, 0x77,
+			0xad, 0x93, 0xd5, 0x7a, 0x40, 0x55, 0x95, 0x86, 0x60, 0x55, 0x95, 0xbd, 0x8b, 0x19, 0x8f, 0xdd,
+			0x91, 0x40, 0x80, 0xb0, 0xe2, 0x80, 0xa8, 0xe8, 0xf1, 0x6a, 0x00, 0x99, 0x73, 0x25, 0x77, 0x60,
+			0x35, 0x71, 0x89, 0x79, 0xf1, 0x6f, 0x79, 0x7c, 0x40, 0xad, 0x6e, 0x86, 0x60, 0xad, 0x6e, 0x25,
+			0x8a, 0xc5, 0x73, 0x11, 0x8f, 0x40, 0x80, 0xa8, 0xe1,
+		}},
+		ActionLabel: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xb0, 0x80, 0x00, 0xbd, 0x8c, 0xf1, 0x8f, 0x60,
+			0xdd, 0x8b, 0x25, 0x91, 0xad, 0x8a, 0xe9, 0x91, 0x40, 0x90, 0xad, 0x92, 0xe6, 0x58, 0x60, 0x59,
+			0x6a, 0xad, 0x92, 0x2d, 0x69, 0x7d, 0x91, 0x40, 0x50, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0x50,
+			0xb1, 0x6f, 0x2d, 0x69, 0x85, 0x6e, 0x40, 0x58, 0x55, 0x6d, 0xe6, 0x90, 0x60, 0x79, 0x89, 0x55,
+			0x6d, 0xad, 0x8a, 0x19, 0x6e, 0x40, 0xbd, 0x8c, 0x11, 0x70, 0x00, 0xb0, 0x80, 0xe2, 0xa6, 0x80,
+			0x00, 0xbd, 0x88, 0x55, 0x71, 0xe6, 0x58, 0xe8, 0xad, 0x8e, 0xe6, 0xbd, 0x88, 0x00, 0xa6, 0x80,
+			0xe2, 0x58, 0x80, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe8, 0x80, 0xe1,
+		}, mirror: true},
+		ActionLabelOutline: ActionLabelOutline,
+		ActionLanguage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x69, 0x7a, 0xad, 0x9a,
+			0x89, 0x75, 0x91, 0x98, 0x40, 0x11, 0x6d, 0xdd, 0x92, 0x60, 0x79, 0x69, 0x45, 0x8f, 0x69, 0x67,
+			0x5d, 0x8a, 0x40, 0x55, 0x65, 0xdd, 0x7f, 0x60, 0x55, 0x65, 0x45, 0x7a, 0x69, 0x67, 0x71, 0x75,
+			0x40, 0x11, 0x6d, 0x5a, 0x60, 0xad, 0x70, 0x69, 0x69, 0x89, 0x75, 0x5d, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x99, 0x85, 0x55, 0x65, 0x79, 0x8a, 0x5d, 0x67, 0x40, 0xf1, 0x92, 0x5a, 0x60, 0x89,
+			0x96, 0x99, 0x70, 0x99, 0x98, 0x71, 0x75, 0x40, 0xad, 0x9a, 0xdd, 0x7f, 0x60, 0xad, 0x9a, 0x79,
+			0x85, 0x99, 0x98, 0x5d, 0x8a, 0x40, 0xf1, 0x92, 0xdd, 0x92, 0x60, 0x55, 0x8f, 0x79, 0x96, 0x79,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xcd, 0x96, 0x60, 0x55, 0x82, 0x69, 0x94,
+			0xe9, 0x83, 0x4d, 0x91, 0x40, 0x79, 0x86, 0xf1, 0x89, 0xe6, 0x99, 0x79, 0x60, 0x89, 0x7a, 0xf1,
+			0x8d, 0x19, 0x7c, 0x25, 0x91, 0x40, 0x80, 0xcd, 0x96, 0xe2, 0x55, 0x7a, 0xac, 0x60, 0xad, 0x78,
+			0x79, 0x93, 0x79, 0x77, 0x89, 0x90, 0x40, 0x79, 0x75, 0xf1, 0x89, 0xe6, 0x79, 0x6b, 0x60, 0x5c,
+			0xad, 0x8e, 0x55, 0x71, 0x5d, 0x91, 0x40, 0x55, 0x7a, 0xac, 0xe2, 0xbd, 0x85, 0xf1, 0x95, 0x60,
+			0x89, 0x8a, 0x69, 0x94, 0x5d, 0x8e, 0x55, 0x91, 0x40, 0x89, 0x94, 0xf1, 0x89, 0xe6, 0x99, 0x8a,
+			0x60, 0xbd, 0x89, 0x89, 0x8d, 0x91, 0x88, 0x79, 0x90, 0x40, 0xbd, 0x85, 0xf1, 0x95, 0xe2, 0x25,
+			0x6a, 0xf1, 0x85, 0xe6, 0xbd, 0x74, 0x60, 0x89, 0x74, 0x25, 0x84, 0x81, 0x74, 0xb5, 0x82, 0x40,
+			0x79, 0x74, 0xdd, 0x7f, 0x60, 0x79, 0x74, 0x35, 0x7e, 0x89, 0x74, 0xe9, 0x7c, 0x40, 0xcd, 0x74,
+			0x74, 0xe6, 0x25, 0x6a, 0x60, 0xad, 0x69, 0x99, 0x7b, 0x81, 0x69, 0xdd, 0x7c, 0x40, 0x55, 0x69,
+			0xdd, 0x7f, 0x60, 0x55, 0x69, 0x99, 0x81, 0x81, 0x69, 0xf9, 0x82, 0x40, 0x25, 0x6a, 0xf1, 0x85,
+			0xe2, 0xdd, 0x78, 0xf1, 0x85, 0xe6, 0x35, 0x87, 0x60, 0x79, 0x87, 0xdd, 0x83, 0x89, 0x87, 0x91,
+			0x82, 0x40, 0x99, 0x87, 0xdd, 0x7f, 0x60, 0x99, 0x87, 0x89, 0x7e, 0x89, 0x87, 0x4d, 0x7d, 0x40,
+			0x35, 0x87, 0x74, 0xe6, 0xdd, 0x78, 0x60, 0x99, 0x78, 0x11, 0x7c, 0x89, 0x78, 0x4d, 0x7d, 0x40,
+			0x79, 0x78, 0xdd, 0x7f, 0x60, 0x79, 0x78, 0x45, 0x81, 0x89, 0x78, 0x91, 0x82, 0x40, 0xdd, 0x78,
+			0xf1, 0x85, 0xe2, 0x35, 0x8b, 0xf1, 0x85, 0xe6, 0xdd, 0x95, 0x60, 0x55, 0x96, 0x55, 0x84, 0x81,
+			0x96, 0xf9, 0x82, 0x40, 0xad, 0x96, 0xdd, 0x7f, 0x60, 0xad, 0x96, 0x25, 0x7e, 0x81, 0x96, 0xdd,
+			0x7c, 0x40, 0xdd, 0x95, 0x74, 0xe6, 0x45, 0x8b, 0x60, 0x79, 0x8b, 0x55, 0x7c, 0x89, 0x8b, 0x91,
+			0x7d, 0x40, 0x99, 0x8b, 0xdd, 0x7f, 0x60, 0x99, 0x8b, 0x55, 0x81, 0x81, 0x8b, 0xa5, 0x82, 0x40,
+			0x35, 0x8b, 0xf1, 0x85, 0xe2, 0x89, 0x8a, 0x6c, 0xe6, 0x89, 0x94, 0x60, 0x55, 0x92, 0x69, 0x71,
+			0x81, 0x8e, 0x55, 0x6e, 0x40, 0xad, 0x85, 0x54, 0x60, 0x55, 0x87, 0x79, 0x6c, 0x81, 0x88, 0x55,
+			0x6f, 0x40, 0x89, 0x8a, 0x6c, 0xe2, 0x99, 0x79, 0x6c, 0xe6, 0x89, 0x86, 0x60, 0xcd, 0x85, 0x79,
+			0x72, 0x11, 0x84, 0x2d, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0xdd, 0x7d, 0x25, 0x6b, 0x69, 0x7c,
+			0x11, 0x6e, 0x40, 0x99, 0x79, 0x6c, 0xe2, 0x79, 0x6b, 0x6c, 0xe6, 0x89, 0x75, 0x60, 0x45, 0x76,
+			0x69, 0x72, 0x69, 0x77, 0x91, 0x6f, 0x40, 0x45, 0x7a, 0x11, 0x6a, 0x60, 0x45, 0x75, 0x55, 0x6b,
+			0x89, 0x71, 0x55, 0x6e, 0x40, 0x79, 0x6b, 0x6c, 0xe1,
+		}},
+		ActionLaunch:           ActionLaunch,
+		ActionLightbulbOutline: ActionLightbulbOutline,
+		ActionLineStyle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0x58,
+			0xe8, 0x55, 0x95, 0xe6, 0x50, 0xe2, 0x50, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0xf1, 0x75, 0xe8,
+			0x55, 0x8d, 0xe6, 0x50, 0xe2, 0x50, 0x55, 0x85, 0xe8, 0x55, 0x81, 0xe6, 0x7c, 0xe8, 0x55, 0x85,
+			0xe6, 0x50, 0xe2, 0x50, 0x55, 0x7d, 0xe8, 0xad, 0x6a, 0xe6, 0xb0, 0xe8, 0x55, 0x7d, 0xe6, 0x50,
+			0xe2, 0x66, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0x6e, 0xe8, 0x55, 0x95, 0xe6, 0x66, 0xe2, 0x72,
+			0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0x8e, 0xe8, 0x55, 0x8d, 0xe6, 0x72, 0xe2, 0x7c, 0x55, 0x95,
+			0xe8, 0x55, 0x91, 0xe6, 0x84, 0xe8, 0x55, 0x95, 0xe6, 0x7c, 0xe2, 0x84, 0x55, 0x85, 0xe8, 0x55,
+			0x81, 0xe6, 0xb0, 0xe8, 0x55, 0x85, 0xe6, 0x84, 0xe2, 0x92, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6,
+			0x9a, 0xe8, 0x55, 0x95, 0xe6, 0x92, 0xe2, 0x11, 0x8a, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0xb0,
+			0xe8, 0x55, 0x8d, 0xe6, 0x11, 0x8a, 0xe2, 0xa8, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0xb0, 0xe8,
+			0x55, 0x95, 0xe6, 0xa8, 0xe1,
+		}},
+		ActionLineWeight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x95, 0xe8, 0x55, 0x93, 0xe6, 0xb0,
+			0xe8, 0x55, 0x95, 0xe6, 0x50, 0xe2, 0x50, 0x55, 0x8f, 0xe8, 0x55, 0x8b, 0xe6, 0xb0, 0xe8, 0x55,
+			0x8f, 0xe6, 0x50, 0xe2, 0x50, 0x55, 0x87, 0xe8, 0x55, 0x81, 0xe6, 0xb0, 0xe8, 0x55, 0x87, 0xe6,
+			0x50, 0xe2, 0x50, 0x55, 0x7d, 0xe8, 0xad, 0x6a, 0xe6, 0xb0, 0xe8, 0x55, 0x7d, 0xe6, 0x50, 0xe1,
+		}},
+		ActionList: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xfd, 0x69, 0x55, 0x8d, 0x67, 0x25, 0x69, 0x55,
+			0x8d, 0x91, 0x68, 0xc1, 0x8c, 0x50, 0x2d, 0x8c, 0x50, 0x55, 0x8b, 0x50, 0x79, 0x8a, 0x95, 0x68,
+			0xe9, 0x89, 0x29, 0x69, 0x55, 0x89, 0x05, 0x6a, 0x55, 0x89, 0xdd, 0x6a, 0x55, 0x89, 0x71, 0x6b,
+			0xe9, 0x89, 0x58, 0x7d, 0x8a, 0x58, 0x59, 0x8b, 0x58, 0x35, 0x8c, 0x6d, 0x6b, 0xc5, 0x8c, 0xd9,
+			0x6a, 0x55, 0x8d, 0xfd, 0x69, 0x55, 0x8d, 0xe2, 0xfd, 0x69, 0x84, 0x67, 0x25, 0x69, 0x84, 0x91,
+			0x68, 0x6d, 0x81, 0x50, 0xd9, 0x80, 0x50, 0xfd, 0x7f, 0x50, 0x25, 0x7f, 0x95, 0x68, 0x91, 0x7e,
+			0x29, 0x69, 0x7c, 0x05, 0x6a, 0x7c, 0xdd, 0x6a, 0x7c, 0x71, 0x6b, 0x95, 0x7e, 0x58, 0x29, 0x7f,
+			0x58, 0x05, 0x80, 0x58, 0xdd, 0x80, 0x6d, 0x6b, 0x71, 0x81, 0xd9, 0x6a, 0x84, 0xfd, 0x69, 0x84,
+			0xe2, 0xfd, 0x69, 0xad, 0x76, 0x67, 0x25, 0x69, 0xad, 0x76, 0x91, 0x68, 0x19, 0x76, 0x50, 0x85,
+			0x75, 0x50, 0xa9, 0x74, 0x50, 0xcd, 0x73, 0x95, 0x68, 0x3d, 0x73, 0x29, 0x69, 0xad, 0x72, 0x05,
+			0x6a, 0xad, 0x72, 0xdd, 0x6a, 0xad, 0x72, 0x71, 0x6b, 0x41, 0x73, 0x58, 0xd5, 0x73, 0x58, 0xad,
+			0x74, 0x58, 0x89, 0x75, 0x6d, 0x6b, 0x19, 0x76, 0xd9, 0x6a, 0xad, 0x76, 0xfd, 0x69, 0xad, 0x76,
+			0xe2, 0x55, 0x73, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0xb0, 0xe8, 0x55, 0x8d, 0xe6, 0x55, 0x73,
+			0xe2, 0x55, 0x73, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x55, 0x73, 0xe2, 0x55, 0x73,
+			0xad, 0x76, 0xe8, 0xad, 0x72, 0xe6, 0xb0, 0xe8, 0xad, 0x76, 0xe6, 0x55, 0x73, 0xe1,
+		}, mirror: true},
+		ActionLock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x05, 0x6d, 0xad,
+			0x9a, 0xd9, 0x6b, 0x7d, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0x60, 0xad, 0x6a,
+			0x15, 0x78, 0xd9, 0x6b, 0xe9, 0x76, 0x40, 0xad, 0x6e, 0xbd, 0x75, 0xe6, 0x55, 0x73, 0xe8, 0x55,
+			0x6f, 0x61, 0x55, 0x73, 0x15, 0x6a, 0x0d, 0x77, 0x61, 0x66, 0xc1, 0x7a, 0xad, 0x62, 0x80, 0xad,
+			0x62, 0x40, 0xf9, 0x88, 0x61, 0x66, 0x60, 0xad, 0x8c, 0x15, 0x6a, 0xad, 0x8c, 0x55, 0x6f, 0xe8,
+			0xbd, 0x75, 0xe6, 0x55, 0x91, 0x60, 0xfd, 0x92, 0xbd, 0x75, 0x29, 0x94, 0xe9, 0x76, 0x40, 0x55,
+			0x95, 0xbd, 0x79, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x51, 0x98, 0x29, 0x94, 0x7d, 0x99, 0x40,
+			0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8,
+			0xbd, 0x79, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe2, 0x05, 0x80, 0x55, 0x8d, 0x60, 0x25, 0x82,
+			0x55, 0x8d, 0xa5, 0x83, 0xdd, 0x8b, 0x40, 0x25, 0x85, 0x55, 0x88, 0x60, 0x25, 0x85, 0x55, 0x86,
+			0xa1, 0x83, 0xb5, 0x84, 0x40, 0xfd, 0x7f, 0x11, 0x83, 0x60, 0xdd, 0x7d, 0x11, 0x83, 0x5d, 0x7c,
+			0xb5, 0x84, 0x40, 0xdd, 0x7a, 0x5d, 0x88, 0x60, 0xdd, 0x7a, 0x69, 0x8a, 0x61, 0x7c, 0xdd, 0x8b,
+			0x40, 0x05, 0x80, 0x55, 0x8d, 0xe2, 0x55, 0x77, 0xbd, 0x75, 0xe6, 0xad, 0x88, 0xe8, 0x55, 0x6f,
+			0x63, 0xad, 0x88, 0xb9, 0x6b, 0x25, 0x86, 0x31, 0x69, 0x9d, 0x83, 0xad, 0x66, 0x05, 0x80, 0xad,
+			0x66, 0x69, 0x7c, 0xad, 0x66, 0xdd, 0x79, 0x31, 0x69, 0x55, 0x77, 0xb9, 0x6b, 0x55, 0x77, 0x55,
+			0x6f, 0xe8, 0xbd, 0x75, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		ActionLockOpen: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xbd, 0x75, 0xe6, 0xad, 0x88, 0xe8,
+			0x55, 0x6f, 0x63, 0xad, 0x88, 0xb9, 0x6b, 0x25, 0x86, 0x31, 0x69, 0x9d, 0x83, 0xad, 0x66, 0x05,
+			0x80, 0xad, 0x66, 0x69, 0x7c, 0xad, 0x66, 0xdd, 0x79, 0x31, 0x69, 0x55, 0x77, 0xb9, 0x6b, 0x55,
+			0x77, 0x55, 0x6f, 0xe6, 0x55, 0x73, 0x60, 0x55, 0x73, 0x11, 0x6a, 0x0d, 0x77, 0x5d, 0x66, 0x40,
+			0x80, 0xad, 0x62, 0x60, 0x45, 0x85, 0xad, 0x62, 0xf9, 0x88, 0x61, 0x66, 0x40, 0xad, 0x8c, 0x55,
+			0x6f, 0xe8, 0xbd, 0x75, 0xe6, 0x55, 0x91, 0x60, 0xfd, 0x92, 0xbd, 0x75, 0x29, 0x94, 0xe9, 0x76,
+			0x40, 0x55, 0x95, 0xbd, 0x79, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x51, 0x98, 0x29, 0x94, 0x7d,
+			0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0x60, 0x05, 0x6d, 0xad, 0x9a, 0xd9, 0x6b,
+			0x7d, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0x60, 0xad, 0x6a, 0x15, 0x78, 0xd9,
+			0x6b, 0xe9, 0x76, 0x40, 0xad, 0x6e, 0xbd, 0x75, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91,
+			0xe8, 0xbd, 0x79, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe2, 0x05, 0x80, 0x55, 0x8d, 0x60, 0x25,
+			0x82, 0x55, 0x8d, 0xa5, 0x83, 0xdd, 0x8b, 0x40, 0x25, 0x85, 0x55, 0x88, 0x60, 0x25, 0x85, 0x55,
+			0x86, 0xa1, 0x83, 0xb5, 0x84, 0x40, 0xfd, 0x7f, 0x11, 0x83, 0x60, 0xdd, 0x7d, 0x11, 0x83, 0x5d,
+			0x7c, 0xb5, 0x84, 0x40, 0xdd, 0x7a, 0x5d, 0x88, 0x60, 0xdd, 0x7a, 0x69, 0x8a, 0x61, 0x7c, 0xdd,
+			0x8b, 0x40, 0x05, 0x80, 0x55, 0x8d, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0xe8, 0xad,
+			0x96, 0xe1,
+		}},
+		ActionLockOutline: ActionLockOutline,
+		ActionLoyalty: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x82, 0x89, 0x8e, 0x00, 0x45, 0x8c, 0x35,
+			0x85, 0x60, 0x9a, 0x79, 0x84, 0x55, 0x8d, 0x91, 0x83, 0x40, 0xad, 0x8d, 0xad, 0x81, 0x60, 0xad,
+			0x8d, 0x89, 0x7f, 0x2d, 0x8c, 0x09, 0x7e, 0x40, 0x89, 0x88, 0x89, 0x7c, 0x60, 0x35, 0x87, 0x89,
+			0x7c, 0xdd, 0x85, 0x69, 0x7d, 0x40, 0xf1, 0x82, 0x35, 0x80, 0x60, 0x55, 0x81, 0x45, 0x7e, 0x80,
+			0x69, 0x7d, 0x40, 0x55, 0x7d, 0x89, 0x7c, 0x60, 0x35, 0x7b, 0x89, 0x7c, 0xb5, 0x79, 0x09, 0x7e,
+			0x40, 0x35, 0x78, 0xad, 0x81, 0x60, 0x35, 0x78, 0xad, 0x82, 0x89, 0x78, 0x91, 0x83, 0x40, 0x99,
+			0x79, 0x35, 0x85, 0x00, 0xf1, 0x82, 0x89, 0x8e, 0xe2, 0x45, 0x85, 0x89, 0x99, 0x60, 0x11, 0x84,
+			0xbd, 0x9a, 0x5d, 0x82, 0xbd, 0x9a, 0x40, 0x79, 0x7f, 0x89, 0x99, 0x00, 0x79, 0x66, 0x89, 0x80,
+			0x60, 0xcd, 0x65, 0xdd, 0x7f, 0x91, 0x65, 0x25, 0x7f, 0x40, 0x55, 0x65, 0x99, 0x7d, 0xe8, 0x55,
+			0x69, 0x60, 0x55, 0x65, 0x99, 0x67, 0x79, 0x66, 0x79, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6,
+			0x99, 0x7d, 0x60, 0x69, 0x7e, 0x55, 0x65, 0x35, 0x7f, 0x91, 0x65, 0x40, 0xad, 0x80, 0x79, 0x66,
+			0x00, 0x89, 0x99, 0x55, 0x7f, 0x60, 0xcd, 0x9a, 0x99, 0x80, 0xcd, 0x9a, 0x4d, 0x82, 0x40, 0x89,
+			0x99, 0x45, 0x85, 0x00, 0x45, 0x85, 0x89, 0x99, 0xe2, 0x89, 0x82, 0xcd, 0x96, 0x01, 0xcd, 0x96,
+			0x89, 0x82, 0x99, 0x7d, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x99, 0x7d, 0x00, 0x89, 0x82, 0xcd,
+			0x96, 0xe2, 0x55, 0x70, 0xbd, 0x73, 0x60, 0xbd, 0x71, 0xbd, 0x73, 0xc5, 0x72, 0xb5, 0x72, 0x40,
+			0xcd, 0x73, 0x45, 0x70, 0x60, 0xcd, 0x73, 0xdd, 0x6e, 0xc5, 0x72, 0xd5, 0x6d, 0x40, 0x55, 0x70,
+			0xcd, 0x6c, 0x60, 0xf1, 0x6e, 0xcd, 0x6c, 0xe9, 0x6d, 0xd5, 0x6d, 0x40, 0xdd, 0x6c, 0x45, 0x70,
+			0x60, 0xdd, 0x6c, 0xad, 0x71, 0xe9, 0x6d, 0xb5, 0x72, 0x40, 0x55, 0x70, 0xbd, 0x73, 0xe2, 0x55,
+			0x69, 0x55, 0x69, 0xe1,
+		}},
+		ActionMarkUnreadMailbox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x35, 0x84, 0xe8, 0x55, 0x7a, 0xe6, 0x55,
+			0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x7a, 0xe6, 0x70, 0xe8, 0x55, 0x76, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x76, 0x79, 0x99, 0x89, 0x77, 0x40, 0xad, 0x9a, 0x55, 0x7a,
+			0xe8, 0xad, 0x96, 0x60, 0xad, 0x9a, 0x45, 0x98, 0x79, 0x99, 0x79, 0x99, 0x40, 0xad, 0x96, 0xad,
+			0x9a, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65,
+			0xad, 0x96, 0xe8, 0x55, 0x7a, 0x60, 0x55, 0x65, 0xbd, 0x78, 0x89, 0x66, 0x89, 0x77, 0x40, 0x55,
+			0x69, 0x55, 0x76, 0xe6, 0x60, 0xe8, 0x55, 0x65, 0xe6, 0x88, 0xe8, 0x60, 0xe6, 0x68, 0xe8, 0x35,
+			0x84, 0xe6, 0x60, 0xe2, 0x55, 0x69, 0x55, 0x7a, 0xe8, 0x35, 0x84, 0xe8, 0x55, 0x7a, 0xe8, 0xad,
+			0x96, 0xe8, 0x55, 0x7a, 0xe1,
+		}},
+		ActionMotorcycle: ActionMotorcycle,
+		ActionNoteAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x69, 0x90, 0xe6, 0x84, 0xe8, 0xcd, 0x87,
+			0xe6, 0xad, 0x8a, 0xe8, 0xcd, 0x83, 0xe6, 0x84, 0xe8, 0x25, 0x7b, 0xe6, 0x7c, 0xe8, 0xcd, 0x83,
+			0xe6, 0x55, 0x75, 0xe8, 0xcd, 0x87, 0xe6, 0x7c, 0xe8, 0x69, 0x90, 0xe2, 0xad, 0x6e, 0xad, 0x9a,
+			0x60, 0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55,
+			0x69, 0x60, 0xad, 0x6a, 0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6,
+			0xbd, 0x86, 0x00, 0x55, 0x95, 0xf1, 0x73, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25,
+			0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xbd, 0x84, 0xbd, 0x75,
+			0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0xbd, 0x75, 0xe6,
+			0xbd, 0x84, 0xe2, 0xad, 0x6e, 0x55, 0x69, 0xe8, 0xbd, 0x75, 0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96,
+			0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ActionOfflinePin: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0x55, 0x8d, 0xe6, 0xad, 0x8a, 0xe8,
+			0x55, 0x89, 0xe6, 0x55, 0x75, 0xe8, 0x55, 0x8d, 0xe2, 0x25, 0x7d, 0xf1, 0x84, 0x05, 0x11, 0x8c,
+			0x6c, 0x11, 0x89, 0x66, 0x25, 0x7d, 0xf1, 0x7e, 0xdd, 0x76, 0xad, 0x78, 0xdd, 0x73, 0xad, 0x7b,
+			0x25, 0x7d, 0xf1, 0x84, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91,
+			0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40,
+			0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25,
+			0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89,
+			0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd,
+			0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98,
+			0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98,
+			0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09,
+			0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80,
+			0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60,
+			0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionOpacity: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x60, 0x25, 0x77, 0xb0, 0xe9, 0x70,
+			0xf9, 0x91, 0x40, 0xad, 0x6a, 0x11, 0x83, 0x60, 0xad, 0x6a, 0xad, 0x7e, 0x5d, 0x6c, 0xdd, 0x7a,
+			0x40, 0x62, 0x25, 0x74, 0x01, 0x80, 0x55, 0x65, 0x9e, 0x25, 0x74, 0x60, 0xf1, 0x91, 0x11, 0x77,
+			0xa5, 0x93, 0xdd, 0x7a, 0x40, 0x55, 0x95, 0x11, 0x83, 0x60, 0x55, 0x95, 0xf1, 0x8b, 0x19, 0x8f,
+			0xf9, 0x91, 0x40, 0x80, 0xb0, 0xe2, 0xdd, 0x6e, 0x55, 0x85, 0xe6, 0x25, 0x91, 0x60, 0xa4, 0xbd,
+			0x80, 0x25, 0x90, 0xdd, 0x7c, 0x40, 0x69, 0x8c, 0x35, 0x77, 0x01, 0x80, 0x56, 0x99, 0x73, 0x35,
+			0x77, 0x60, 0xbd, 0x71, 0x72, 0xdd, 0x6f, 0xdd, 0x7c, 0x40, 0xdd, 0x6e, 0x55, 0x85, 0xe1,
+		}},
+		ActionOpenInBrowser: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x8c, 0xe8, 0xa8,
+			0xe6, 0xa8, 0xe8, 0x60, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x74, 0xe8, 0xb0, 0xe6, 0x58, 0xe2, 0x7c,
+			0xb0, 0xe8, 0x11, 0x85, 0x05, 0x79, 0x78, 0x99, 0x8a, 0x99, 0x75, 0xbd, 0x87, 0x80, 0x55, 0x7d,
+			0x69, 0x8a, 0xbd, 0x87, 0x89, 0x87, 0x99, 0x8a, 0x84, 0x11, 0x85, 0xe8, 0xb0, 0xe6, 0x7c, 0xe1,
+		}},
+		ActionOpenInNew: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0x99, 0x7e, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x69, 0x81,
+			0xe6, 0xb0, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6,
+			0x58, 0xe2, 0x79, 0x79, 0x69, 0x89, 0x01, 0xad, 0x76, 0x89, 0x86, 0x35, 0x91, 0x58, 0xe6, 0x99,
+			0x82, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x69, 0x7d, 0xe6, 0xa8, 0xe8, 0xdd, 0x6e, 0x00, 0x79, 0x79,
+			0x69, 0x89, 0xe1,
+		}, mirror: true},
+		ActionOpenWith: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x02, 0x25, 0x75, 0xcd, 0x8f,
+			0x11, 0x78, 0xdd, 0x8c, 0x7c, 0xcd, 0x92, 0xe8, 0x35, 0x86, 0xe6, 0x84, 0xe8, 0xcd, 0x92, 0x02,
+			0xf1, 0x87, 0xdd, 0x8c, 0xdd, 0x8a, 0xcd, 0x8f, 0x80, 0xad, 0x9a, 0xe2, 0xdd, 0x6f, 0x89, 0x8a,
+			0x03, 0x55, 0x65, 0x80, 0xf1, 0x6f, 0x69, 0x75, 0xdd, 0x72, 0x55, 0x78, 0x35, 0x6d, 0x7c, 0xe6,
+			0xcd, 0x79, 0xe8, 0x84, 0xe6, 0x35, 0x6d, 0x01, 0xcd, 0x72, 0x99, 0x87, 0xdd, 0x6f, 0x89, 0x8a,
+			0xe2, 0x25, 0x90, 0x89, 0x8a, 0x01, 0x35, 0x8d, 0x99, 0x87, 0xcd, 0x92, 0x84, 0xe6, 0x45, 0x86,
+			0xe8, 0x7c, 0xe6, 0xcd, 0x92, 0x03, 0x35, 0x8d, 0x69, 0x78, 0x25, 0x90, 0x79, 0x75, 0xad, 0x9a,
+			0x80, 0x25, 0x90, 0x89, 0x8a, 0xe2, 0x7c, 0xbd, 0x79, 0xe8, 0x35, 0x6d, 0x05, 0x69, 0x78, 0xcd,
+			0x72, 0x79, 0x75, 0xdd, 0x6f, 0x80, 0x55, 0x65, 0x89, 0x8a, 0xdd, 0x6f, 0x99, 0x87, 0xcd, 0x72,
+			0x84, 0x35, 0x6d, 0xe8, 0xbd, 0x79, 0xe6, 0x7c, 0xe1,
+		}},
+		ActionPageview: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xb1, 0x67, 0x55,
+			0x95, 0x85, 0x66, 0x29, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x05, 0x6d, 0x85, 0x66, 0xd9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x51,
+			0x98, 0xad, 0x6a, 0x7d, 0x99, 0xd9, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xfd, 0x92, 0x7d, 0x99, 0x29, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0xcd, 0x8b, 0x25,
+			0x8e, 0x01, 0x99, 0x8e, 0x55, 0x8b, 0x90, 0xbd, 0x84, 0x64, 0xf1, 0x88, 0x51, 0x83, 0x69, 0x89,
+			0xc5, 0x81, 0xdd, 0x89, 0x39, 0x80, 0xdd, 0x89, 0x89, 0x7e, 0xdd, 0x89, 0xd9, 0x79, 0xa1, 0x86,
+			0x8d, 0x76, 0x61, 0x83, 0x45, 0x73, 0xbd, 0x7e, 0x45, 0x73, 0xfd, 0x79, 0x45, 0x73, 0xa9, 0x76,
+			0x89, 0x76, 0x40, 0x55, 0x73, 0x89, 0x7e, 0x60, 0x55, 0x73, 0x45, 0x83, 0xa9, 0x76, 0x89, 0x86,
+			0x40, 0xbd, 0x7e, 0xcd, 0x89, 0x60, 0x79, 0x80, 0xcd, 0x89, 0x2d, 0x82, 0x3d, 0x89, 0x40, 0x35,
+			0x85, 0x89, 0x87, 0x00, 0xcd, 0x8b, 0x25, 0x8e, 0xe2, 0xbd, 0x7e, 0xcd, 0x85, 0x60, 0xad, 0x7b,
+			0xcd, 0x85, 0x81, 0x79, 0xb1, 0x83, 0x40, 0x55, 0x77, 0x85, 0x7e, 0x60, 0x55, 0x77, 0x79, 0x7b,
+			0x81, 0x79, 0x5d, 0x79, 0x40, 0xb5, 0x7e, 0x45, 0x77, 0x60, 0xbd, 0x81, 0x45, 0x77, 0xcd, 0x83,
+			0x69, 0x79, 0x40, 0xdd, 0x85, 0x89, 0x7e, 0x60, 0xdd, 0x85, 0x89, 0x81, 0xcd, 0x83, 0xad, 0x83,
+			0x40, 0xbd, 0x7e, 0xcd, 0x85, 0xe1,
+		}},
+		ActionPanTool: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x7a, 0x55, 0x9d, 0x60, 0x72, 0x55, 0x9d,
+			0x5d, 0x77, 0x81, 0x9c, 0x40, 0xbd, 0x74, 0x25, 0x9a, 0x01, 0x99, 0x63, 0xcd, 0x80, 0xf1, 0x64,
+			0xbd, 0x7f, 0x60, 0x11, 0x66, 0xbd, 0x7e, 0x91, 0x67, 0x79, 0x7e, 0x40, 0x89, 0x6a, 0x59, 0x7f,
+			0x00, 0xad, 0x72, 0x89, 0x85, 0xe8, 0x54, 0x62, 0xad, 0x72, 0x29, 0x69, 0x41, 0x73, 0x95, 0x68,
+			0xd5, 0x73, 0x50, 0xad, 0x74, 0x50, 0x89, 0x75, 0x50, 0x19, 0x76, 0x95, 0x68, 0x40, 0xad, 0x76,
+			0x54, 0xe8, 0x35, 0x8d, 0x01, 0x11, 0x6b, 0xad, 0x84, 0x11, 0x78, 0xdd, 0x97, 0x60, 0x89, 0x78,
+			0x99, 0x98, 0x3d, 0x79, 0xf9, 0x98, 0x40, 0xcd, 0x7a, 0x55, 0x99, 0xe6, 0x9c, 0x60, 0x89, 0x90,
+			0x55, 0x99, 0x45, 0x92, 0x99, 0x97, 0x40, 0xa8, 0x55, 0x93, 0xe8, 0xad, 0x6c, 0x62, 0xa8, 0xd1,
+			0x6b, 0x95, 0x94, 0x3d, 0x6b, 0x29, 0x95, 0xad, 0x6a, 0x05, 0x96, 0xad, 0x6a, 0xdd, 0x96, 0xad,
+			0x6a, 0x71, 0x97, 0x3d, 0x6b, 0x40, 0xb0, 0xad, 0x6c, 0xe8, 0x55, 0x93, 0x60, 0xb0, 0x89, 0x97,
+			0x19, 0x95, 0x71, 0x9a, 0x40, 0x9c, 0x55, 0x9d, 0xe6, 0xcd, 0x7a, 0xe2, 0xcd, 0x7d, 0x80, 0xe8,
+			0xad, 0x64, 0x62, 0xcd, 0x7d, 0xd1, 0x63, 0x61, 0x7e, 0x3d, 0x63, 0xf5, 0x7e, 0xad, 0x62, 0xd1,
+			0x7f, 0xad, 0x62, 0xad, 0x80, 0xad, 0x62, 0x3d, 0x81, 0x3d, 0x63, 0x40, 0xcd, 0x81, 0xad, 0x64,
+			0xe8, 0x80, 0xe6, 0xcd, 0x7d, 0xe2, 0xf1, 0x88, 0x80, 0xe8, 0x55, 0x67, 0x62, 0xf1, 0x88, 0x7d,
+			0x66, 0x85, 0x89, 0xe9, 0x65, 0x19, 0x8a, 0x55, 0x65, 0xf1, 0x8a, 0x55, 0x65, 0xcd, 0x8b, 0x55,
+			0x65, 0x5d, 0x8c, 0xe9, 0x65, 0x40, 0xf1, 0x8c, 0x55, 0x67, 0xe8, 0x80, 0xe6, 0xf1, 0x88, 0xe2,
+			0x89, 0x7f, 0xad, 0x8c, 0xe1,
+		}},
+		ActionPayment: ActionPayment,
+		ActionPermCameraMic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7d, 0xa8, 0xe6, 0xf1, 0x81, 0xe8, 0x99,
+			0x8e, 0x60, 0x99, 0x87, 0xdd, 0x8d, 0x91, 0x8b, 0xad, 0x89, 0x40, 0x89, 0x8f, 0x99, 0x7f, 0xe6,
+			0x89, 0x8b, 0x60, 0x89, 0x8b, 0x11, 0x84, 0x2d, 0x88, 0x5d, 0x87, 0x40, 0x05, 0x80, 0xad, 0x8a,
+			0x61, 0x39, 0x7b, 0xad, 0x8a, 0xd1, 0x77, 0x5d, 0x87, 0x69, 0x74, 0x11, 0x84, 0x69, 0x74, 0x99,
+			0x7f, 0xe6, 0x69, 0x70, 0x60, 0x69, 0x70, 0x79, 0x85, 0x55, 0x74, 0xad, 0x89, 0x40, 0xf1, 0x7d,
+			0x99, 0x8e, 0xe8, 0xa8, 0xe2, 0x80, 0x88, 0x60, 0xf1, 0x81, 0x88, 0x2d, 0x83, 0xc5, 0x82, 0x40,
+			0x69, 0x84, 0x99, 0x7f, 0xe8, 0x68, 0x60, 0x69, 0x84, 0x11, 0x72, 0x2d, 0x83, 0xd5, 0x70, 0x40,
+			0x80, 0x99, 0x6f, 0x60, 0x11, 0x7e, 0x99, 0x6f, 0xcd, 0x7c, 0xd5, 0x70, 0x40, 0x89, 0x7b, 0x68,
+			0xe8, 0x99, 0x7f, 0x60, 0x89, 0x7b, 0x89, 0x81, 0xcd, 0x7c, 0xc5, 0x82, 0x40, 0x80, 0x88, 0xe2,
+			0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8,
+			0xcd, 0x71, 0x60, 0x55, 0x65, 0x45, 0x70, 0x89, 0x66, 0x09, 0x6f, 0x40, 0x55, 0x69, 0xcd, 0x6d,
+			0xe6, 0x25, 0x73, 0x00, 0x70, 0x50, 0xe6, 0x90, 0x00, 0xdd, 0x8c, 0xcd, 0x6d, 0xe6, 0xad, 0x96,
+			0x60, 0x35, 0x98, 0xcd, 0x6d, 0x71, 0x99, 0x09, 0x6f, 0x40, 0xad, 0x9a, 0xcd, 0x71, 0xe8, 0xa8,
+			0x60, 0xad, 0x9a, 0x99, 0x95, 0x71, 0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69,
+			0xe2, 0xad, 0x96, 0xa8, 0xe8, 0xcd, 0x71, 0xe6, 0x96, 0x00, 0x25, 0x86, 0x58, 0xe6, 0xdd, 0x79,
+			0x00, 0x6a, 0xcd, 0x71, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe6, 0xad, 0x96, 0xe2, 0x80, 0xdd, 0x82,
+			0xe1,
+		}},
+		ActionPermContactCalendar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf9, 0x7f, 0xa0, 0x60, 0x99, 0x7b, 0xa0, 0x99,
+			0x77, 0x71, 0x91, 0x40, 0x60, 0xf1, 0x95, 0xe8, 0xad, 0x96, 0xe6, 0xa0, 0xe8, 0x55, 0x96, 0x60,
+			0xad, 0x8c, 0x55, 0x93, 0x81, 0x88, 0xad, 0x91, 0x40, 0xf9, 0x7f, 0xa0, 0xe2, 0x58, 0xa8, 0x60,
+			0xdd, 0x6f, 0x35, 0x90, 0x09, 0x75, 0x19, 0x8e, 0x40, 0x80, 0x98, 0x60, 0xcd, 0x85, 0x98, 0xf1,
+			0x8a, 0x19, 0x8e, 0x40, 0xa8, 0xa8, 0xe8, 0x55, 0x6d, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x80, 0xbd,
+			0x85, 0x60, 0x35, 0x7c, 0xbd, 0x85, 0x91, 0x79, 0x19, 0x83, 0x40, 0xf1, 0x76, 0xad, 0x7c, 0x60,
+			0xf1, 0x76, 0xdd, 0x78, 0x91, 0x79, 0x3d, 0x76, 0x40, 0x80, 0x99, 0x73, 0x60, 0xcd, 0x83, 0x99,
+			0x73, 0x71, 0x86, 0x3d, 0x76, 0x40, 0x11, 0x89, 0xad, 0x7c, 0x60, 0x11, 0x89, 0x79, 0x80, 0x71,
+			0x86, 0x19, 0x83, 0x40, 0x80, 0xbd, 0x85, 0xe2, 0x05, 0x80, 0xbd, 0x81, 0x67, 0x25, 0x82, 0xbd,
+			0x81, 0x99, 0x83, 0x41, 0x80, 0x11, 0x85, 0xc5, 0x7e, 0x11, 0x85, 0xa9, 0x7c, 0x11, 0x85, 0x89,
+			0x7a, 0x95, 0x83, 0x11, 0x79, 0x1d, 0x82, 0x99, 0x77, 0xfd, 0x7f, 0x99, 0x77, 0xdd, 0x7d, 0x99,
+			0x77, 0x69, 0x7c, 0x15, 0x79, 0xf1, 0x7a, 0x91, 0x7a, 0xf1, 0x7a, 0xb1, 0x7c, 0xf1, 0x7a, 0xcd,
+			0x7e, 0x6d, 0x7c, 0x45, 0x80, 0xe5, 0x7d, 0xbd, 0x81, 0x05, 0x80, 0xbd, 0x81, 0xe2, 0x58, 0xad,
+			0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a, 0x35, 0x69, 0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55,
+			0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35, 0x69, 0x89, 0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70,
+			0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6,
+			0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a,
+			0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad, 0x96, 0x60, 0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40,
+			0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2, 0x80, 0xad, 0x7c, 0xe2, 0x80, 0xad, 0x96, 0xe6, 0xa0, 0xe6,
+			0x60, 0xe6, 0x80, 0xe1,
+		}},
+		ActionPermDataSetting: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0x00, 0xad, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x80, 0x60, 0xbd, 0x99, 0x35, 0x80, 0xbd, 0x98, 0xdd, 0x7f, 0x40, 0xad, 0x96,
+			0x45, 0x7f, 0xe8, 0xf1, 0x6e, 0x00, 0xf1, 0x6e, 0xad, 0x96, 0xe6, 0x86, 0x60, 0x79, 0x83, 0xcd,
+			0x97, 0x88, 0xc5, 0x98, 0x40, 0x45, 0x85, 0xad, 0x9a, 0xe6, 0x55, 0x65, 0xe2, 0xf1, 0x6e, 0xad,
+			0x96, 0x02, 0xad, 0x96, 0xf1, 0x6e, 0xcd, 0x82, 0xcd, 0x82, 0xf1, 0x6e, 0xad, 0x96, 0xe2, 0x11,
+			0x91, 0x45, 0x9d, 0x00, 0xbd, 0x90, 0x11, 0x9a, 0x60, 0x35, 0x8f, 0x99, 0x99, 0xf1, 0x8d, 0xd5,
+			0x98, 0x40, 0xbd, 0x8b, 0x35, 0x97, 0x02, 0xf1, 0x88, 0x89, 0x98, 0x99, 0x86, 0xf1, 0x94, 0x25,
+			0x89, 0xf1, 0x92, 0x60, 0xcd, 0x88, 0xad, 0x91, 0xcd, 0x88, 0x2d, 0x90, 0x40, 0x25, 0x89, 0x69,
+			0x8d, 0x02, 0x99, 0x86, 0x69, 0x8b, 0xf1, 0x88, 0xbd, 0x87, 0xbd, 0x8b, 0x11, 0x89, 0x60, 0xad,
+			0x8c, 0x45, 0x88, 0xf1, 0x8d, 0x81, 0x87, 0x40, 0xbd, 0x90, 0x45, 0x86, 0x00, 0x11, 0x91, 0x86,
+			0xe6, 0x11, 0x95, 0x00, 0x79, 0x95, 0x45, 0x86, 0x60, 0xae, 0xbd, 0x86, 0x45, 0x98, 0x81, 0x87,
+			0x40, 0x79, 0x9a, 0x11, 0x89, 0x02, 0x45, 0x9d, 0xbd, 0x87, 0x99, 0x9f, 0x69, 0x8b, 0x11, 0x9d,
+			0x69, 0x8d, 0x60, 0x69, 0x9d, 0xad, 0x8e, 0x69, 0x9d, 0x2d, 0x90, 0x40, 0x11, 0x9d, 0xf1, 0x92,
+			0x02, 0x99, 0x9f, 0xf1, 0x94, 0x45, 0x9d, 0x89, 0x98, 0x79, 0x9a, 0x35, 0x97, 0x60, 0x89, 0x99,
+			0x11, 0x98, 0x45, 0x98, 0xd5, 0x98, 0x40, 0x79, 0x95, 0x11, 0x9a, 0x00, 0x11, 0x95, 0x45, 0x9d,
+			0xe6, 0x11, 0x91, 0xe2, 0x11, 0x93, 0xf1, 0x96, 0x60, 0xac, 0xf1, 0x96, 0xf1, 0x97, 0xaa, 0x40,
+			0xdd, 0x99, 0x25, 0x90, 0x60, 0xdd, 0x99, 0x35, 0x8d, 0xf1, 0x97, 0x45, 0x8b, 0x40, 0x11, 0x93,
+			0x55, 0x89, 0x60, 0x25, 0x90, 0x55, 0x89, 0x35, 0x8e, 0x45, 0x8b, 0x40, 0x45, 0x8c, 0x25, 0x90,
+			0x60, 0x45, 0x8c, 0x11, 0x93, 0x35, 0x8e, 0xaa, 0x40, 0x11, 0x93, 0xf1, 0x96, 0xe1,
+		}},
+		ActionPermDeviceInformation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x25, 0x8c, 0xe8, 0x11, 0x7b, 0xe6, 0x84,
+			0xe8, 0x25, 0x8c, 0xe6, 0x7c, 0xe2, 0x80, 0x25, 0x78, 0x62, 0x11, 0x7f, 0x25, 0x78, 0x71, 0x7e,
+			0x81, 0x77, 0xcd, 0x7d, 0xe1, 0x76, 0xcd, 0x7d, 0xf1, 0x75, 0xcd, 0x7d, 0x6a, 0x69, 0x7e, 0x5d,
+			0x74, 0x40, 0xf1, 0x7f, 0xbd, 0x73, 0x63, 0xe5, 0x80, 0xbd, 0x73, 0x8d, 0x81, 0x5d, 0x74, 0x35,
+			0x82, 0x6a, 0x35, 0x82, 0xf1, 0x75, 0x35, 0x82, 0xdd, 0x76, 0x91, 0x81, 0x81, 0x77, 0xf1, 0x80,
+			0x25, 0x78, 0x80, 0x25, 0x78, 0xe2, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55, 0x9d, 0x89,
+			0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d, 0x11, 0x65,
+			0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45, 0x90, 0xad,
+			0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60, 0xad, 0x92,
+			0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71, 0xe2, 0x55,
+			0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x97, 0xe6, 0x55, 0x71, 0xe2,
+			0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8, 0x55, 0x93,
+			0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71, 0xe8, 0xad,
+			0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0x55,
+			0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		ActionPermIdentity: ActionPermIdentity,
+		ActionPermMedia: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x76, 0x88, 0xe6, 0xbd, 0x91, 0x03, 0xf1,
+			0x88, 0x45, 0x78, 0x99, 0x81, 0x84, 0xad, 0x7c, 0x45, 0x7c, 0x45, 0x76, 0x88, 0xe2, 0xad, 0x66,
+			0xb0, 0x60, 0x05, 0x65, 0xb0, 0xd9, 0x63, 0xd5, 0x96, 0x40, 0xad, 0x62, 0xa8, 0xe8, 0xad, 0x6e,
+			0xe6, 0xad, 0x66, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0xad, 0x66, 0xe2, 0xad, 0x6e, 0xa0,
+			0x60, 0x05, 0x6d, 0xa0, 0xd9, 0x6b, 0xd5, 0x8e, 0x40, 0xad, 0x6a, 0x98, 0xe8, 0x55, 0x69, 0x60,
+			0xad, 0x6a, 0xb1, 0x67, 0xd9, 0x6b, 0x85, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0xad, 0x7e,
+			0x00, 0x88, 0xad, 0x6a, 0xe6, 0x55, 0x99, 0x60, 0xfd, 0x9a, 0xad, 0x6a, 0x29, 0x9c, 0xd9, 0x6b,
+			0x40, 0x55, 0x9d, 0xad, 0x6e, 0xe8, 0x98, 0x60, 0x55, 0x9d, 0xa9, 0x8d, 0x29, 0x9c, 0xd5, 0x8e,
+			0x40, 0x55, 0x99, 0xa0, 0xe6, 0xad, 0x6e, 0xe2, 0xad, 0x6e, 0x98, 0xe6, 0x55, 0x99, 0xe8, 0xad,
+			0x6e, 0xe6, 0x55, 0x82, 0x00, 0x7a, 0x55, 0x69, 0xe6, 0xad, 0x6e, 0xe8, 0x98, 0xe2, 0xad, 0x6e,
+			0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		ActionPermPhoneMsg: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x81, 0x69, 0x7f, 0xe8, 0x54, 0x60, 0x11,
+			0x81, 0x29, 0x69, 0xa5, 0x81, 0x95, 0x68, 0x40, 0x11, 0x83, 0x50, 0xe6, 0xac, 0x60, 0xd9, 0x96,
+			0x50, 0x6d, 0x97, 0x95, 0x68, 0x40, 0xb0, 0x54, 0xe8, 0xcd, 0x76, 0x60, 0xb0, 0xa9, 0x77, 0x6d,
+			0x97, 0x39, 0x78, 0x40, 0xac, 0xcd, 0x78, 0xe6, 0x90, 0x00, 0x11, 0x81, 0x69, 0x7f, 0xe2, 0x11,
+			0x85, 0xcd, 0x74, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x11, 0x85, 0xe8, 0xcd, 0x74, 0xe2, 0x11, 0x85,
+			0xcd, 0x74, 0xe8, 0x58, 0xe8, 0xcd, 0x74, 0xe2, 0xaa, 0xb0, 0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85,
+			0x45, 0x94, 0x40, 0x55, 0x76, 0xad, 0x89, 0x60, 0x79, 0x6f, 0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a,
+			0x40, 0x50, 0x56, 0x60, 0x50, 0xb9, 0x69, 0xdd, 0x68, 0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55,
+			0x74, 0x60, 0x45, 0x75, 0x50, 0xf1, 0x75, 0xad, 0x68, 0x40, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9,
+			0x78, 0xb5, 0x72, 0x61, 0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78, 0x71, 0x74, 0x79, 0x78, 0x35, 0x75,
+			0xed, 0x77, 0xc1, 0x75, 0x00, 0x45, 0x71, 0x79, 0x7c, 0x60, 0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1,
+			0x81, 0x40, 0x35, 0x79, 0xbd, 0x86, 0x60, 0xad, 0x7b, 0x45, 0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40,
+			0x25, 0x84, 0x11, 0x8f, 0x00, 0x79, 0x8a, 0x89, 0x88, 0x60, 0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c,
+			0x89, 0x87, 0x40, 0xbd, 0x8d, 0x69, 0x87, 0x00, 0xad, 0x95, 0x25, 0x89, 0x60, 0xad, 0x96, 0x69,
+			0x89, 0x55, 0x97, 0x35, 0x8a, 0x40, 0xb0, 0x98, 0xe8, 0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97,
+			0x25, 0x97, 0x40, 0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25,
+			0x73, 0x58, 0xe6, 0x58, 0x60, 0x25, 0x6c, 0xcd, 0x6e, 0xe9, 0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f,
+			0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40,
+			0xa8, 0xa8, 0xe8, 0xdd, 0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f,
+			0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0xe1,
+		}},
+		ActionPermScanWiFi: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x00, 0x40, 0x70, 0x60, 0x99, 0x66,
+			0xbd, 0x71, 0xbd, 0x6e, 0x35, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x45,
+			0x91, 0x35, 0x6e, 0x40, 0xc0, 0x70, 0x00, 0x80, 0xb0, 0xe2, 0x80, 0x89, 0x92, 0x00, 0x25, 0x9a,
+			0x69, 0x78, 0x60, 0x55, 0x94, 0xdd, 0x73, 0xd5, 0x8d, 0x45, 0x71, 0x40, 0x80, 0xad, 0x6e, 0x60,
+			0xad, 0x78, 0xad, 0x6e, 0x2d, 0x72, 0x45, 0x71, 0x40, 0xdd, 0x65, 0x69, 0x78, 0x00, 0x80, 0x89,
+			0x92, 0xe2, 0x7c, 0x92, 0xe8, 0x55, 0x7a, 0xe6, 0x84, 0xe8, 0x92, 0xe6, 0x7c, 0xe2, 0x80, 0x6e,
+			0x60, 0x35, 0x7f, 0x6e, 0x99, 0x7e, 0x69, 0x76, 0x40, 0x7c, 0x6a, 0x60, 0x7c, 0x35, 0x74, 0x99,
+			0x7e, 0x99, 0x73, 0x40, 0x80, 0x66, 0x60, 0xcd, 0x80, 0x66, 0x69, 0x81, 0x99, 0x73, 0x40, 0x84,
+			0x6a, 0x60, 0x84, 0xcd, 0x75, 0x69, 0x81, 0x69, 0x76, 0x40, 0x80, 0x6e, 0xe1,
+		}},
+		ActionPets: ActionPets,
+		ActionPictureInPicture: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x7d, 0x79, 0x82, 0xe6, 0x11, 0x94, 0xe8,
+			0x55, 0x71, 0xe6, 0xbd, 0x7d, 0xe8, 0x79, 0x82, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67,
+			0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55,
+			0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60,
+			0x51, 0x98, 0xad, 0x6a, 0x7d, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91,
+			0x60, 0xad, 0x9a, 0xf1, 0x92, 0x7d, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55,
+			0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0xbd, 0x81,
+			0x79, 0x7e, 0xe8, 0x55, 0x75, 0xe6, 0x11, 0x90, 0xe8, 0x79, 0x7e, 0xe6, 0xbd, 0x81, 0xe1,
+		}},
+		ActionPictureInPictureAlt: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x7d, 0x55, 0x8e, 0xe6, 0x11, 0x94, 0xe8,
+			0x35, 0x7d, 0xe6, 0xbd, 0x7d, 0xe8, 0x55, 0x8e, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67,
+			0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55,
+			0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60,
+			0x51, 0x98, 0xad, 0x6a, 0x7d, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91,
+			0x60, 0xad, 0x9a, 0xf1, 0x92, 0x7d, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55,
+			0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0xbd, 0x81,
+			0x55, 0x8a, 0xe8, 0x35, 0x81, 0xe6, 0x11, 0x90, 0xe8, 0x55, 0x8a, 0xe6, 0xbd, 0x81, 0xe1,
+		}},
+		ActionPlayForWork: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x95, 0x60, 0x79, 0x79, 0x55, 0x95,
+			0xbd, 0x74, 0xf9, 0x90, 0x40, 0x60, 0x25, 0x86, 0xe6, 0x68, 0x60, 0x68, 0xf1, 0x8a, 0x91, 0x77,
+			0x25, 0x8e, 0x40, 0x80, 0x55, 0x91, 0x60, 0xdd, 0x84, 0x55, 0x91, 0x71, 0x88, 0x25, 0x8e, 0x40,
+			0x98, 0x25, 0x86, 0xe6, 0xa0, 0x60, 0xa0, 0x99, 0x8c, 0x45, 0x8b, 0xf9, 0x90, 0x40, 0x80, 0x55,
+			0x95, 0xe2, 0xcd, 0x7f, 0x25, 0x88, 0x02, 0x11, 0x75, 0x69, 0x7d, 0xdd, 0x77, 0x89, 0x7a, 0xcd,
+			0x7d, 0x79, 0x80, 0xe8, 0x79, 0x6d, 0xe6, 0xcd, 0x81, 0xe8, 0x69, 0x80, 0x02, 0xad, 0x87, 0x89,
+			0x7a, 0x89, 0x8a, 0x69, 0x7d, 0xcd, 0x7f, 0x25, 0x88, 0xe1,
+		}},
+		ActionPolymer: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x6d, 0x55, 0x95, 0x01, 0x55, 0x61, 0x80,
+			0x55, 0x6d, 0xad, 0x6a, 0xe6, 0x79, 0x76, 0x02, 0x89, 0x6a, 0x80, 0x89, 0x72, 0x69, 0x8e, 0x79,
+			0x88, 0xad, 0x6a, 0xe6, 0x99, 0x92, 0x01, 0xad, 0x9e, 0x80, 0xad, 0x92, 0x55, 0x95, 0xe6, 0x89,
+			0x89, 0x02, 0x79, 0x95, 0x80, 0x69, 0x8d, 0xbd, 0x71, 0x99, 0x77, 0x55, 0x95, 0xe6, 0x35, 0x6d,
+			0xe1,
+		}},
+		ActionPowerSettingsNew: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xcd, 0x82, 0xe8, 0xbd, 0x67, 0xe6, 0x84,
+			0xe8, 0xcd, 0x82, 0xe6, 0x7c, 0xe2, 0x80, 0x25, 0x98, 0x60, 0x11, 0x7b, 0x25, 0x98, 0xb5, 0x76,
+			0x3d, 0x96, 0x40, 0x11, 0x6f, 0x11, 0x91, 0x60, 0xcd, 0x6b, 0xcd, 0x8d, 0xe9, 0x69, 0x71, 0x89,
+			0x40, 0x50, 0x25, 0x80, 0x60, 0x50, 0xcd, 0x7a, 0x45, 0x6a, 0x2d, 0x76, 0x40, 0xad, 0x70, 0xf1,
+			0x6d, 0x00, 0x79, 0x73, 0xbd, 0x70, 0x60, 0xf1, 0x6f, 0x99, 0x73, 0xf9, 0x6d, 0x91, 0x77, 0x40,
+			0x58, 0x21, 0x80, 0x65, 0x58, 0x79, 0x88, 0xd5, 0x71, 0x4d, 0x8e, 0xad, 0x77, 0x25, 0x94, 0x80,
+			0x25, 0x94, 0x5d, 0x88, 0x25, 0x94, 0x2d, 0x8e, 0x4d, 0x8e, 0xa8, 0x79, 0x88, 0xa8, 0x21, 0x80,
+			0xa8, 0x89, 0x7b, 0x09, 0x92, 0x81, 0x77, 0x11, 0x90, 0x79, 0x73, 0xad, 0x8c, 0xbd, 0x70, 0x00,
+			0x89, 0x8f, 0xf1, 0x6d, 0x60, 0x89, 0x93, 0x55, 0x71, 0xc5, 0x95, 0x11, 0x76, 0x40, 0xb0, 0x25,
+			0x80, 0x60, 0xb0, 0x11, 0x85, 0x19, 0x96, 0x71, 0x89, 0x40, 0xf9, 0x90, 0x11, 0x91, 0x60, 0xbd,
+			0x8d, 0x55, 0x94, 0x5d, 0x89, 0x3d, 0x96, 0x40, 0x80, 0x25, 0x98, 0xe1,
+		}},
+		ActionPregnantWoman: ActionPregnantWoman,
+		ActionPrint: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x8b, 0xcd, 0x74, 0xe8, 0x58, 0xe6, 0x25,
+			0x74, 0xe8, 0xcd, 0x74, 0xe6, 0x25, 0x70, 0xe8, 0x50, 0xe6, 0xdd, 0x8f, 0xe8, 0xcd, 0x74, 0xe6,
+			0xdd, 0x8b, 0xe2, 0x55, 0x69, 0xcd, 0x78, 0xe6, 0xad, 0x96, 0xe6, 0x55, 0x69, 0xe2, 0x45, 0x91,
+			0x25, 0x7f, 0x60, 0x11, 0x92, 0x25, 0x7f, 0xad, 0x92, 0x89, 0x7e, 0x40, 0x45, 0x93, 0x25, 0x7d,
+			0x60, 0x45, 0x93, 0x55, 0x7c, 0xad, 0x92, 0xbd, 0x7b, 0x40, 0x45, 0x91, 0x25, 0x7b, 0x60, 0x79,
+			0x90, 0x25, 0x7b, 0xdd, 0x8f, 0xbd, 0x7b, 0x40, 0x45, 0x8f, 0x25, 0x7d, 0x60, 0x45, 0x8f, 0xf1,
+			0x7d, 0xdd, 0x8f, 0x89, 0x7e, 0x40, 0x45, 0x91, 0x25, 0x7f, 0xe2, 0xdd, 0x8b, 0xa8, 0xe8, 0x35,
+			0x87, 0xe6, 0x25, 0x74, 0xe8, 0xa8, 0xe6, 0xdd, 0x8b, 0xe2, 0xdd, 0x8f, 0xb0, 0xe6, 0x25, 0x70,
+			0xe8, 0x45, 0x8c, 0xe6, 0x55, 0x65, 0xe8, 0xdd, 0x7b, 0x61, 0x55, 0x65, 0xdd, 0x78, 0x5d, 0x67,
+			0xd5, 0x76, 0x69, 0x69, 0xcd, 0x74, 0x69, 0x6c, 0xcd, 0x74, 0xe6, 0x99, 0x93, 0x61, 0x9d, 0x96,
+			0xcd, 0x74, 0xa5, 0x98, 0xd5, 0x76, 0xad, 0x9a, 0xdd, 0x78, 0xad, 0x9a, 0xdd, 0x7b, 0xe8, 0x45,
+			0x8c, 0xe6, 0xdd, 0x8f, 0xe8, 0xb0, 0xe2, 0xad, 0x96, 0x45, 0x88, 0xe8, 0xdd, 0x7b, 0x61, 0xad,
+			0x96, 0x89, 0x7a, 0xc9, 0x95, 0xad, 0x79, 0xe9, 0x94, 0xcd, 0x78, 0x99, 0x93, 0xcd, 0x78, 0xe6,
+			0x69, 0x6c, 0x61, 0x19, 0x6b, 0xcd, 0x78, 0x39, 0x6a, 0xb1, 0x79, 0x55, 0x69, 0x91, 0x7a, 0x55,
+			0x69, 0xdd, 0x7b, 0xe8, 0x45, 0x88, 0xe6, 0x25, 0x70, 0xe8, 0x35, 0x83, 0xe6, 0xdd, 0x8f, 0xe8,
+			0x45, 0x88, 0xe6, 0xad, 0x96, 0xe1,
+		}},
+		ActionQueryBuilder:   ActionQueryBuilder,
+		ActionQuestionAnswer: ActionQuestionAnswer,
+		ActionReceipt: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x99, 0x9a, 0xe8, 0x69, 0x65, 0x0b, 0x58,
+			0x65, 0x69, 0x60, 0x69, 0x65, 0x68, 0x65, 0x69, 0x70, 0x69, 0x65, 0x78, 0x65, 0x69, 0x80, 0x69,
+			0x65, 0x88, 0x65, 0x69, 0x90, 0x69, 0x65, 0x98, 0x65, 0x69, 0xa0, 0x69, 0x65, 0xa8, 0x65, 0x69,
+			0xb0, 0x69, 0x65, 0xe8, 0x99, 0x9a, 0x0b, 0xa8, 0x9d, 0x96, 0xa0, 0x99, 0x9a, 0x98, 0x9d, 0x96,
+			0x90, 0x99, 0x9a, 0x88, 0x9d, 0x96, 0x80, 0x99, 0x9a, 0x78, 0x9d, 0x96, 0x70, 0x99, 0x9a, 0x68,
+			0x9d, 0x96, 0x60, 0x99, 0x9a, 0x58, 0x9d, 0x96, 0x50, 0x99, 0x9a, 0xe2, 0xcd, 0x6f, 0x45, 0x8c,
+			0xe6, 0x79, 0x90, 0xe8, 0x45, 0x88, 0xe6, 0xcd, 0x6f, 0xe8, 0x45, 0x8c, 0xe2, 0xcd, 0x6f, 0x84,
+			0xe6, 0x79, 0x90, 0xe8, 0x7c, 0xe6, 0xcd, 0x6f, 0xe8, 0x84, 0xe2, 0xcd, 0x6f, 0xad, 0x77, 0xe6,
+			0x79, 0x90, 0xe8, 0xad, 0x73, 0xe6, 0xcd, 0x6f, 0xe8, 0xad, 0x77, 0xe2, 0x58, 0xdd, 0x93, 0xe6,
+			0xa8, 0xe8, 0x25, 0x6c, 0xe6, 0x58, 0xe8, 0xdd, 0x93, 0xe2, 0x58, 0x25, 0x6c, 0xe8, 0xdd, 0x93,
+			0xe8, 0x25, 0x6c, 0xe1,
+		}},
+		ActionRecordVoiceOver: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x95, 0xad, 0x8b, 0x00, 0x11, 0x92, 0x55,
+			0x88, 0x60, 0x45, 0x95, 0xf1, 0x84, 0xf9, 0x96, 0x5d, 0x81, 0x40, 0xad, 0x98, 0x99, 0x78, 0x60,
+			0xad, 0x98, 0x69, 0x73, 0xf9, 0x96, 0xd5, 0x6f, 0x40, 0x11, 0x92, 0xdd, 0x68, 0x00, 0x69, 0x95,
+			0x89, 0x65, 0x60, 0x25, 0x99, 0x69, 0x69, 0x3d, 0x9b, 0xf9, 0x6d, 0x40, 0x55, 0x9d, 0x99, 0x78,
+			0x60, 0x55, 0x9d, 0xad, 0x7e, 0x3d, 0x9b, 0x3d, 0x83, 0x40, 0x69, 0x95, 0xad, 0x8b, 0xe2, 0x25,
+			0x8c, 0x35, 0x82, 0x00, 0xcd, 0x88, 0xdd, 0x7e, 0x60, 0x94, 0x89, 0x7d, 0xad, 0x8a, 0x09, 0x7c,
+			0x40, 0x55, 0x8b, 0x99, 0x78, 0x60, 0x55, 0x8b, 0xad, 0x76, 0xad, 0x8a, 0x2d, 0x75, 0x40, 0xcd,
+			0x88, 0x55, 0x72, 0x00, 0x25, 0x8c, 0x5e, 0x60, 0xdd, 0x8d, 0xdd, 0x70, 0xf1, 0x8e, 0x5d, 0x73,
+			0x40, 0xa0, 0x99, 0x78, 0x60, 0xa0, 0x55, 0x7b, 0xf1, 0x8e, 0xd5, 0x7d, 0x40, 0x25, 0x8c, 0x35,
+			0x82, 0xe2, 0x70, 0x99, 0x82, 0x60, 0x99, 0x73, 0x99, 0x82, 0xcd, 0x70, 0xcd, 0x7f, 0x40, 0x5c,
+			0x99, 0x78, 0x60, 0x5c, 0x35, 0x74, 0xcd, 0x70, 0x69, 0x71, 0x40, 0x70, 0x99, 0x6e, 0x60, 0x69,
+			0x7c, 0x99, 0x6e, 0x35, 0x7f, 0x69, 0x71, 0x40, 0x84, 0x99, 0x78, 0x60, 0x84, 0x7a, 0x35, 0x7f,
+			0xcd, 0x7f, 0x40, 0x70, 0x99, 0x82, 0xe2, 0xad, 0x62, 0xb0, 0xe8, 0xbd, 0x91, 0x60, 0xad, 0x62,
+			0x35, 0x8f, 0xf1, 0x63, 0x71, 0x8d, 0x40, 0x35, 0x67, 0xad, 0x8a, 0x60, 0x99, 0x6a, 0xf1, 0x88,
+			0x3d, 0x6f, 0xcd, 0x87, 0x40, 0x70, 0xad, 0x86, 0x60, 0x25, 0x7c, 0xad, 0x86, 0xbd, 0x80, 0xcd,
+			0x87, 0x40, 0xbd, 0x88, 0xad, 0x8a, 0x60, 0xbd, 0x8a, 0xad, 0x8b, 0x09, 0x8c, 0x71, 0x8d, 0x40,
+			0x55, 0x8d, 0xbd, 0x91, 0xe8, 0xb0, 0xe6, 0xad, 0x62, 0xe2, 0xad, 0x66, 0xa8, 0xe6, 0x55, 0x89,
+			0xe8, 0xbd, 0x91, 0x60, 0x55, 0x89, 0xad, 0x90, 0xc5, 0x88, 0xc5, 0x8f, 0x40, 0x25, 0x87, 0x45,
+			0x8e, 0x60, 0xf1, 0x83, 0x79, 0x8c, 0xdd, 0x7f, 0x91, 0x8b, 0x40, 0x70, 0xad, 0x8a, 0x60, 0x35,
+			0x74, 0xad, 0x8a, 0x19, 0x70, 0xa5, 0x8b, 0x40, 0xcd, 0x68, 0x45, 0x8e, 0x60, 0xdd, 0x67, 0xbd,
+			0x8e, 0x45, 0x67, 0xb5, 0x8f, 0x40, 0xad, 0x66, 0xbd, 0x91, 0xe8, 0xa8, 0xe2, 0x70, 0x99, 0x7e,
+			0x60, 0x99, 0x7a, 0x99, 0x7e, 0x4d, 0x7c, 0xe9, 0x7c, 0x40, 0x7c, 0x99, 0x78, 0x60, 0x7c, 0x6c,
+			0x4d, 0x7c, 0x4d, 0x74, 0x40, 0x70, 0x99, 0x72, 0x60, 0x69, 0x75, 0x99, 0x72, 0xb5, 0x73, 0x4d,
+			0x74, 0x40, 0x64, 0x99, 0x78, 0x60, 0x64, 0x35, 0x7b, 0xb5, 0x73, 0xe9, 0x7c, 0x40, 0x70, 0x99,
+			0x7e, 0xe2, 0x70, 0x99, 0x78, 0xe2, 0x70, 0xa8, 0xe1,
+		}},
+		ActionRedeem: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x89, 0x8d, 0xe8, 0xa8, 0xe6, 0xad,
+			0x96, 0xe8, 0x89, 0x8d, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x60, 0xe6, 0xad, 0x71, 0x60, 0x55,
+			0x71, 0x69, 0x6f, 0x25, 0x71, 0x81, 0x6e, 0x40, 0xf1, 0x70, 0xdd, 0x6c, 0x63, 0xf1, 0x70, 0xbd,
+			0x69, 0x25, 0x73, 0x89, 0x67, 0x55, 0x75, 0x55, 0x65, 0x69, 0x78, 0x55, 0x65, 0x75, 0x7a, 0x55,
+			0x65, 0x31, 0x7c, 0x5d, 0x66, 0xf1, 0x7d, 0x69, 0x67, 0xf1, 0x7e, 0x52, 0x01, 0x09, 0x80, 0xbd,
+			0x6a, 0x25, 0x81, 0x52, 0x60, 0x35, 0x82, 0x55, 0x67, 0xe1, 0x83, 0x55, 0x66, 0x40, 0x75, 0x87,
+			0x55, 0x65, 0x62, 0xad, 0x8a, 0x55, 0x65, 0xdd, 0x8c, 0x81, 0x67, 0x11, 0x8f, 0xad, 0x69, 0x11,
+			0x8f, 0xe5, 0x6c, 0x11, 0x8f, 0x99, 0x6d, 0xe9, 0x8e, 0x55, 0x6e, 0x40, 0x69, 0x8e, 0x60, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0x60, 0x79, 0x99, 0x35, 0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0xa8,
+			0x60, 0xad, 0x9a, 0x99, 0x95, 0x79, 0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69,
+			0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0x68, 0x60, 0x55,
+			0x65, 0x69, 0x72, 0x89, 0x66, 0x35, 0x71, 0x40, 0x55, 0x69, 0x60, 0xe2, 0x55, 0x69, 0x69, 0x86,
+			0xe6, 0xad, 0x96, 0xe8, 0x68, 0xe6, 0x11, 0x86, 0x05, 0xbd, 0x8c, 0x45, 0x7d, 0x89, 0x89, 0xad,
+			0x7f, 0x80, 0x79, 0x72, 0x79, 0x76, 0xad, 0x7f, 0x45, 0x73, 0x45, 0x7d, 0xf1, 0x79, 0x68, 0xe6,
+			0x55, 0x69, 0xe8, 0x69, 0x86, 0xe2, 0x79, 0x78, 0x69, 0x70, 0x60, 0xf1, 0x79, 0x69, 0x70, 0xf9,
+			0x7a, 0x5d, 0x6f, 0x40, 0x78, 0xdd, 0x6c, 0x60, 0x78, 0x69, 0x6b, 0xf9, 0x7a, 0x5d, 0x6a, 0x40,
+			0x79, 0x78, 0x55, 0x69, 0x60, 0x6e, 0x55, 0x69, 0xf9, 0x75, 0x5d, 0x6a, 0x40, 0xf1, 0x74, 0xdd,
+			0x6c, 0x60, 0xf1, 0x74, 0x55, 0x6e, 0xf9, 0x75, 0x5d, 0x6f, 0x40, 0x79, 0x78, 0x69, 0x70, 0xe2,
+			0x79, 0x87, 0x69, 0x70, 0x61, 0x92, 0x69, 0x70, 0x09, 0x8a, 0x5d, 0x6f, 0x11, 0x8b, 0x55, 0x6e,
+			0x11, 0x8b, 0xdd, 0x6c, 0x40, 0x09, 0x8a, 0x5d, 0x6a, 0x61, 0x92, 0x55, 0x69, 0x79, 0x87, 0x55,
+			0x69, 0x11, 0x86, 0x55, 0x69, 0x09, 0x85, 0x5d, 0x6a, 0x40, 0x88, 0xdd, 0x6c, 0x60, 0x88, 0x55,
+			0x6e, 0x09, 0x85, 0x5d, 0x6f, 0x40, 0x79, 0x87, 0x69, 0x70, 0xe1,
+		}},
+		ActionRemoveShoppingCart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8a, 0xdd, 0x81, 0xe6, 0x55, 0x88, 0x00,
+			0x69, 0x84, 0xdd, 0x7d, 0xe6, 0x11, 0x8a, 0x00, 0x79, 0x92, 0xad, 0x6e, 0xe6, 0x11, 0x75, 0x00,
+			0x11, 0x71, 0xad, 0x6a, 0xe6, 0x55, 0x94, 0x60, 0x11, 0x96, 0xad, 0x6a, 0xdd, 0x96, 0x19, 0x6c,
+			0x40, 0xbd, 0x96, 0x35, 0x6f, 0x00, 0x55, 0x8d, 0x45, 0x80, 0x60, 0xf1, 0x8c, 0x82, 0x55, 0x8c,
+			0x71, 0x81, 0x40, 0xad, 0x8a, 0xdd, 0x81, 0xe2, 0x21, 0x73, 0x99, 0x9a, 0x67, 0x25, 0x71, 0x99,
+			0x9a, 0xbd, 0x6f, 0x31, 0x99, 0x55, 0x6e, 0xc5, 0x97, 0x55, 0x6e, 0xc9, 0x95, 0x55, 0x6e, 0xcd,
+			0x93, 0xc1, 0x6f, 0x69, 0x92, 0x29, 0x71, 0xa2, 0x25, 0x73, 0xa2, 0x25, 0x75, 0xa2, 0x89, 0x76,
+			0x6d, 0x92, 0xf1, 0x77, 0xd5, 0x93, 0xf1, 0x77, 0xd1, 0x95, 0xf1, 0x77, 0xcd, 0x97, 0x85, 0x76,
+			0x35, 0x99, 0x1d, 0x75, 0x99, 0x9a, 0x21, 0x73, 0x99, 0x9a, 0xe2, 0xbd, 0x98, 0xad, 0x9d, 0x00,
+			0xad, 0x87, 0xbd, 0x8c, 0xe6, 0x79, 0x72, 0x60, 0xf1, 0x6f, 0xbd, 0x8c, 0xbd, 0x6e, 0xe9, 0x8a,
+			0x40, 0xcd, 0x6e, 0xf1, 0x86, 0x05, 0x79, 0x73, 0x25, 0x7f, 0xbd, 0x6d, 0xad, 0x72, 0x11, 0x63,
+			0x50, 0xf1, 0x65, 0x25, 0x65, 0x99, 0x9b, 0xcd, 0x9a, 0xbd, 0x98, 0xad, 0x9d, 0xe2, 0xad, 0x83,
+			0xbd, 0x88, 0x00, 0xf1, 0x7c, 0xcd, 0x81, 0xe6, 0x99, 0x76, 0x00, 0x69, 0x72, 0xbd, 0x88, 0xe6,
+			0xad, 0x83, 0xe2, 0x11, 0x8a, 0xdd, 0x7d, 0xe6, 0x69, 0x84, 0xe6, 0x11, 0x8a, 0xe2, 0xdd, 0x8d,
+			0x99, 0x9a, 0x63, 0xf1, 0x8b, 0x99, 0x9a, 0x81, 0x8a, 0x31, 0x99, 0x11, 0x89, 0xc5, 0x97, 0x11,
+			0x89, 0xc9, 0x95, 0x11, 0x89, 0xcd, 0x93, 0x81, 0x8a, 0x69, 0x92, 0xf1, 0x8b, 0xa2, 0xdd, 0x8d,
+			0xa2, 0x40, 0x3d, 0x91, 0x6d, 0x92, 0x62, 0xad, 0x92, 0xd5, 0x93, 0xad, 0x92, 0xd1, 0x95, 0xad,
+			0x92, 0xcd, 0x97, 0x3d, 0x91, 0x35, 0x99, 0xcd, 0x8f, 0x99, 0x9a, 0xdd, 0x8d, 0x99, 0x9a, 0xe1,
+		}},
+		ActionReorder: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6, 0xb0,
+			0xe8, 0xad, 0x92, 0xe6, 0x50, 0xe2, 0x50, 0x89, 0x87, 0xe8, 0x89, 0x83, 0xe6, 0xb0, 0xe8, 0x89,
+			0x87, 0xe6, 0x50, 0xe2, 0x50, 0x79, 0x7c, 0xe8, 0x79, 0x78, 0xe6, 0xb0, 0xe8, 0x79, 0x7c, 0xe6,
+			0x50, 0xe2, 0x50, 0x55, 0x71, 0xe8, 0x55, 0x6d, 0xe6, 0xb0, 0xe8, 0x55, 0x71, 0xe6, 0x50, 0xe1,
+		}},
+		ActionReportProblem: ActionReportProblem,
+		ActionRestore:       ActionRestore,
+		ActionRestorePage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x80, 0x45, 0x8f, 0x60, 0x55, 0x85, 0x45,
+			0x8f, 0xf1, 0x88, 0xad, 0x8b, 0x40, 0x89, 0x8c, 0xdd, 0x82, 0x60, 0x89, 0x8c, 0xad, 0x7d, 0xf1,
+			0x88, 0x11, 0x7a, 0x40, 0x25, 0x80, 0x79, 0x76, 0x60, 0x25, 0x7d, 0x79, 0x76, 0xad, 0x7a, 0xbd,
+			0x77, 0x40, 0x99, 0x76, 0xbd, 0x7a, 0xe8, 0x25, 0x76, 0xe6, 0x99, 0x73, 0xe8, 0x45, 0x80, 0xe6,
+			0xdd, 0x7d, 0xe8, 0x45, 0x7d, 0xe6, 0xdd, 0x78, 0x60, 0xf1, 0x79, 0xbd, 0x7b, 0xf9, 0x7b, 0xc5,
+			0x7a, 0x40, 0x25, 0x80, 0xcd, 0x79, 0x60, 0x25, 0x84, 0xcd, 0x79, 0xad, 0x86, 0x55, 0x7c, 0x40,
+			0x35, 0x89, 0xdd, 0x82, 0x60, 0x35, 0x89, 0xdd, 0x86, 0xad, 0x86, 0x71, 0x89, 0x40, 0x29, 0x80,
+			0x98, 0x61, 0xed, 0x7c, 0x98, 0x81, 0x7a, 0x45, 0x8a, 0x11, 0x78, 0x89, 0x88, 0x25, 0x77, 0xcd,
+			0x85, 0xe6, 0xbd, 0x73, 0x60, 0xf1, 0x74, 0x25, 0x8a, 0x45, 0x78, 0xb5, 0x8c, 0x40, 0x25, 0x80,
+			0x45, 0x8f, 0xe2, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79, 0x99,
+			0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a, 0xbd, 0x67, 0xdd, 0x6b, 0x89,
+			0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0x69, 0x85, 0x00, 0x55, 0x95, 0x45, 0x75, 0xe8, 0xad,
+			0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6,
+			0xad, 0x6e, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0x11, 0x77, 0x00, 0x99, 0x83,
+			0x55, 0x69, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0x55, 0x69,
+			0xe8, 0xad, 0x96, 0xe1,
+		}},
+		ActionRoom: ActionRoom,
+		ActionRoundedCorner: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x66,
+			0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e,
+			0xe8, 0x58, 0xe6, 0x66, 0xe2, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2,
+			0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6,
+			0xb0, 0xe8, 0xb0, 0xe6, 0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8,
+			0xe2, 0xb0, 0x84, 0xe6, 0xa8, 0xe8, 0x6a, 0x60, 0xa8, 0x35, 0x71, 0x69, 0x91, 0x99, 0x6e, 0x40,
+			0x96, 0x58, 0xe6, 0x7c, 0xe8, 0x50, 0xe6, 0x96, 0x61, 0x65, 0x90, 0x50, 0x35, 0x94, 0xcd, 0x6b,
+			0xb0, 0x9d, 0x6f, 0xb0, 0x6a, 0xe8, 0x84, 0xe1,
+		}},
+		ActionRowing: ActionRowing,
+		ActionSchedule: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x89, 0xdd, 0x8c, 0x01, 0xcd, 0x8c, 0xdd,
+			0x89, 0x35, 0x82, 0x35, 0x7f, 0xe8, 0xcd, 0x71, 0xe6, 0x35, 0x7e, 0xe8, 0xcd, 0x80, 0x00, 0xcd,
+			0x89, 0xdd, 0x8c, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98,
+			0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55,
+			0x65, 0x80, 0x60, 0x55, 0x65, 0x89, 0x7a, 0x71, 0x67, 0xad, 0x75, 0x40, 0x2d, 0x6d, 0x2d, 0x6d,
+			0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x79, 0x85,
+			0x55, 0x65, 0x55, 0x8a, 0x71, 0x67, 0x40, 0xd5, 0x92, 0x2d, 0x6d, 0x60, 0x79, 0x96, 0xcd, 0x70,
+			0x91, 0x98, 0xad, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55,
+			0x8a, 0x40, 0xd5, 0x92, 0xd5, 0x92, 0x60, 0x35, 0x8f, 0x79, 0x96, 0x55, 0x8a, 0x91, 0x98, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x55, 0x89, 0xad, 0x96, 0xa0,
+			0xa0, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0xad, 0x76, 0xa0, 0x60, 0x40, 0x80, 0x55, 0x69,
+			0x60, 0xad, 0x76, 0x55, 0x69, 0x60, 0x60, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x55, 0x89,
+			0x60, 0xa0, 0x40, 0x80, 0xad, 0x96, 0xe1,
+		}},
+		ActionSearch: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x95, 0xf1, 0x97, 0x00, 0x89, 0x83, 0x69,
+			0x86, 0x60, 0x89, 0x81, 0x25, 0x88, 0xe1, 0x7e, 0x19, 0x89, 0x40, 0x35, 0x79, 0x11, 0x8a, 0x61,
+			0xfd, 0x71, 0x11, 0x8a, 0x5a, 0x11, 0x85, 0x50, 0x11, 0x80, 0x50, 0x72, 0x40, 0x5a, 0xf1, 0x6c,
+			0x60, 0x64, 0xf1, 0x67, 0x19, 0x79, 0xf1, 0x67, 0x40, 0x2d, 0x85, 0xf1, 0x6c, 0x62, 0x25, 0x8a,
+			0xf1, 0x71, 0x25, 0x8a, 0x05, 0x79, 0x25, 0x8a, 0xdd, 0x7b, 0x35, 0x89, 0x89, 0x7e, 0x45, 0x88,
+			0x35, 0x81, 0x69, 0x86, 0x89, 0x83, 0x01, 0xb0, 0xaa, 0x11, 0x95, 0xf1, 0x97, 0xe2, 0x25, 0x79,
+			0x11, 0x86, 0x60, 0x8d, 0x7e, 0x11, 0x86, 0x59, 0x82, 0x3d, 0x82, 0x40, 0x25, 0x86, 0x72, 0x60,
+			0x25, 0x86, 0x99, 0x73, 0x59, 0x82, 0xc5, 0x6f, 0x40, 0x25, 0x79, 0xf1, 0x6b, 0x61, 0xa9, 0x73,
+			0xf1, 0x6b, 0xd5, 0x6f, 0xc5, 0x6f, 0x58, 0x99, 0x73, 0x58, 0x72, 0x40, 0xd5, 0x6f, 0x3d, 0x82,
+			0x60, 0xa9, 0x73, 0x11, 0x86, 0x25, 0x79, 0x11, 0x86, 0xe1,
+		}},
+		ActionSettings: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x79, 0xad, 0x9a, 0x00, 0x89, 0x78, 0x45,
+			0x92, 0x60, 0x45, 0x77, 0xcd, 0x91, 0xdd, 0x75, 0xa2, 0x40, 0x69, 0x73, 0x55, 0x8f, 0x02, 0x89,
+			0x6b, 0xf1, 0x92, 0x55, 0x65, 0x90, 0x89, 0x6c, 0xbd, 0x82, 0x60, 0x69, 0x6c, 0x25, 0x82, 0x5d,
+			0x6c, 0x5d, 0x81, 0x40, 0x55, 0x6c, 0x80, 0x60, 0x55, 0x6c, 0x69, 0x7f, 0x5d, 0x6c, 0xa5, 0x7e,
+			0x40, 0x89, 0x6c, 0x45, 0x7d, 0x02, 0x55, 0x65, 0x70, 0x89, 0x6b, 0x11, 0x6d, 0x69, 0x73, 0xad,
+			0x70, 0x60, 0x79, 0x74, 0xcd, 0x6f, 0xdd, 0x75, 0x5e, 0x40, 0x89, 0x78, 0xcd, 0x6d, 0x00, 0xdd,
+			0x79, 0x55, 0x65, 0xe6, 0x25, 0x86, 0x00, 0x79, 0x87, 0xbd, 0x6d, 0x60, 0xbd, 0x88, 0x35, 0x6e,
+			0x2d, 0x8a, 0xf9, 0x6e, 0x40, 0x99, 0x8c, 0xad, 0x70, 0x02, 0x79, 0x94, 0x11, 0x6d, 0xad, 0x9a,
+			0x70, 0x79, 0x93, 0x25, 0x7d, 0x60, 0x99, 0x93, 0xcd, 0x7d, 0xa5, 0x93, 0x91, 0x7e, 0x40, 0xad,
+			0x93, 0x80, 0x60, 0xad, 0x93, 0xad, 0x80, 0xa5, 0x93, 0x69, 0x81, 0x40, 0x79, 0x93, 0xcd, 0x82,
+			0x02, 0xad, 0x9a, 0x90, 0x79, 0x94, 0xf1, 0x92, 0x99, 0x8c, 0x55, 0x8f, 0x60, 0x89, 0x8b, 0x35,
+			0x90, 0x2d, 0x8a, 0x09, 0x91, 0x40, 0x79, 0x87, 0x45, 0x92, 0x00, 0x25, 0x86, 0xad, 0x9a, 0xe6,
+			0xdd, 0x79, 0xe2, 0x80, 0xad, 0x88, 0x60, 0x99, 0x83, 0xad, 0x88, 0x25, 0x86, 0x25, 0x86, 0x40,
+			0xad, 0x88, 0x80, 0x60, 0xad, 0x88, 0x69, 0x7c, 0x25, 0x86, 0xdd, 0x79, 0x40, 0x80, 0x55, 0x77,
+			0x60, 0x69, 0x7c, 0x55, 0x77, 0xdd, 0x79, 0xdd, 0x79, 0x40, 0x55, 0x77, 0x80, 0x60, 0x55, 0x77,
+			0x99, 0x83, 0xdd, 0x79, 0x25, 0x86, 0x40, 0x80, 0xad, 0x88, 0xe2, 0x80, 0xad, 0x84, 0x60, 0x11,
+			0x7e, 0xad, 0x84, 0xb5, 0x7c, 0x4d, 0x83, 0x40, 0x55, 0x7b, 0x80, 0x60, 0x55, 0x7b, 0x11, 0x7e,
+			0xb5, 0x7c, 0xb5, 0x7c, 0x40, 0x80, 0x55, 0x7b, 0x60, 0xf1, 0x81, 0x55, 0x7b, 0x4d, 0x83, 0xb5,
+			0x7c, 0x40, 0xad, 0x84, 0x80, 0x60, 0xad, 0x84, 0xf1, 0x81, 0x4d, 0x83, 0x4d, 0x83, 0x40, 0x80,
+			0xad, 0x84, 0xe2, 0x80, 0x80, 0xe2, 0x11, 0x7d, 0xad, 0x96, 0xe6, 0xf1, 0x82, 0x00, 0xdd, 0x83,
+			0x35, 0x8f, 0x60, 0x11, 0x86, 0xad, 0x8e, 0x09, 0x88, 0x89, 0x8d, 0x40, 0x99, 0x8b, 0xcd, 0x8a,
+			0x02, 0xad, 0x92, 0xdd, 0x8d, 0x55, 0x95, 0x11, 0x89, 0x11, 0x8f, 0x79, 0x84, 0x60, 0x55, 0x8f,
+			0x55, 0x83, 0x81, 0x8f, 0x3d, 0x82, 0x40, 0xad, 0x8f, 0x80, 0x60, 0xad, 0x8f, 0xdd, 0x7e, 0x89,
+			0x8f, 0xc5, 0x7d, 0x40, 0x11, 0x8f, 0x89, 0x7b, 0x02, 0x55, 0x95, 0xf1, 0x76, 0xad, 0x92, 0x25,
+			0x72, 0x99, 0x8b, 0x35, 0x75, 0x60, 0x11, 0x8a, 0x79, 0x73, 0x25, 0x88, 0x4d, 0x72, 0x40, 0xdd,
+			0x83, 0xcd, 0x70, 0x00, 0xf1, 0x82, 0x55, 0x69, 0xe6, 0x11, 0x7d, 0x00, 0x25, 0x7c, 0xcd, 0x70,
+			0x60, 0xdd, 0x79, 0x45, 0x71, 0xe9, 0x77, 0x69, 0x72, 0x40, 0x69, 0x74, 0x35, 0x75, 0x02, 0x55,
+			0x6d, 0x25, 0x72, 0xad, 0x6a, 0xf1, 0x76, 0xf1, 0x70, 0x89, 0x7b, 0x60, 0xad, 0x70, 0xad, 0x7c,
+			0x81, 0x70, 0xc5, 0x7d, 0x40, 0x55, 0x70, 0x80, 0x60, 0x55, 0x70, 0x25, 0x81, 0x81, 0x70, 0x3d,
+			0x82, 0x40, 0xf1, 0x70, 0x79, 0x84, 0x02, 0xad, 0x6a, 0x11, 0x89, 0x55, 0x6d, 0xdd, 0x8d, 0x69,
+			0x74, 0xcd, 0x8a, 0x60, 0x6c, 0x69, 0x8c, 0xf9, 0x77, 0x89, 0x8d, 0x40, 0x25, 0x7c, 0x35, 0x8f,
+			0x00, 0x11, 0x7d, 0xad, 0x96, 0xe1,
+		}},
+		ActionSettingsApplications: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7e, 0xf1, 0x8c, 0xe6, 0xdd, 0x81, 0x00,
+			0x89, 0x82, 0x55, 0x89, 0x60, 0xdd, 0x83, 0xf1, 0x88, 0xcd, 0x84, 0x55, 0x88, 0x40, 0x89, 0x86,
+			0xf1, 0x86, 0x02, 0xad, 0x8a, 0x35, 0x88, 0x69, 0x8c, 0x99, 0x84, 0x45, 0x89, 0x99, 0x82, 0x60,
+			0x89, 0x89, 0x35, 0x81, 0x89, 0x89, 0x80, 0x40, 0x45, 0x89, 0x69, 0x7d, 0x02, 0x69, 0x8c, 0x69,
+			0x7b, 0xad, 0x8a, 0xcd, 0x77, 0x89, 0x86, 0x11, 0x79, 0x60, 0xbd, 0x85, 0x45, 0x78, 0xcd, 0x84,
+			0xad, 0x77, 0x40, 0x89, 0x82, 0xad, 0x76, 0x00, 0xdd, 0x81, 0x11, 0x73, 0xe6, 0x25, 0x7e, 0x00,
+			0x79, 0x7d, 0xad, 0x76, 0x60, 0x25, 0x7c, 0x11, 0x77, 0x35, 0x7b, 0xad, 0x77, 0x40, 0x79, 0x79,
+			0x11, 0x79, 0x02, 0x55, 0x75, 0xcd, 0x77, 0x99, 0x73, 0x69, 0x7b, 0xbd, 0x76, 0x69, 0x7d, 0x60,
+			0x79, 0x76, 0xcd, 0x7e, 0x79, 0x76, 0x80, 0x40, 0xbd, 0x76, 0x99, 0x82, 0x02, 0x99, 0x73, 0x99,
+			0x84, 0x55, 0x75, 0x35, 0x88, 0x79, 0x79, 0xf1, 0x86, 0x60, 0x45, 0x7a, 0xbd, 0x87, 0x35, 0x7b,
+			0x55, 0x88, 0x40, 0x79, 0x7d, 0x55, 0x89, 0x00, 0x25, 0x7e, 0xf1, 0x8c, 0xe2, 0x80, 0xad, 0x85,
+			0x60, 0x99, 0x7d, 0xad, 0x85, 0xf9, 0x7b, 0x09, 0x84, 0x40, 0x55, 0x7a, 0x80, 0x60, 0x55, 0x7a,
+			0x99, 0x7d, 0xf9, 0x7b, 0xf9, 0x7b, 0x40, 0x80, 0x55, 0x7a, 0x60, 0x69, 0x82, 0x55, 0x7a, 0x09,
+			0x84, 0xf9, 0x7b, 0x40, 0xad, 0x85, 0x80, 0x60, 0xad, 0x85, 0x69, 0x82, 0x09, 0x84, 0x09, 0x84,
+			0x40, 0x80, 0xad, 0x85, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40,
+			0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6,
+			0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0,
+			0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8,
+			0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58, 0xe1,
+		}},
+		ActionSettingsBackupRestore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x8a, 0x67, 0xf1, 0x7d, 0x8a, 0x79, 0x7c,
+			0x89, 0x83, 0x76, 0x11, 0x82, 0x76, 0x80, 0x76, 0xf1, 0x7d, 0x79, 0x7c, 0x79, 0x7c, 0xf1, 0x7d,
+			0x76, 0x80, 0x76, 0x11, 0x82, 0x76, 0x89, 0x83, 0x79, 0x7c, 0x8a, 0xf1, 0x7d, 0x8a, 0x80, 0x8a,
+			0x11, 0x82, 0x89, 0x83, 0x89, 0x83, 0x11, 0x82, 0x8a, 0x80, 0x8a, 0xe2, 0x80, 0xb0, 0x60, 0x6c,
+			0xb0, 0x5e, 0xf9, 0x90, 0x40, 0x50, 0xf1, 0x7f, 0xe6, 0x58, 0x60, 0x58, 0x45, 0x88, 0xd5, 0x71,
+			0x25, 0x8e, 0x40, 0x80, 0xa8, 0x61, 0x5d, 0x88, 0xa8, 0x2d, 0x8e, 0x2d, 0x8e, 0xa8, 0x5d, 0x88,
+			0xa8, 0x80, 0x40, 0x2d, 0x8e, 0xd5, 0x71, 0x61, 0x5d, 0x88, 0x58, 0x80, 0x58, 0x69, 0x7b, 0x58,
+			0x69, 0x77, 0x09, 0x6e, 0x40, 0x69, 0x70, 0x89, 0x73, 0xe6, 0x55, 0x77, 0xe8, 0x89, 0x77, 0xe6,
+			0x79, 0x69, 0xe8, 0xad, 0x69, 0xe6, 0x79, 0x6d, 0xe8, 0xbd, 0x70, 0x60, 0x62, 0x99, 0x6c, 0xd1,
+			0x75, 0x4d, 0x6a, 0x40, 0x80, 0x50, 0x60, 0x8a, 0x50, 0x5d, 0x89, 0xe9, 0x69, 0x40, 0xf9, 0x90,
+			0x09, 0x6f, 0x60, 0x35, 0x94, 0x45, 0x72, 0x19, 0x96, 0xa5, 0x76, 0x40, 0xb0, 0x80, 0x60, 0xb0,
+			0x8a, 0x19, 0x96, 0x5d, 0x89, 0x40, 0xf9, 0x90, 0xf9, 0x90, 0x60, 0xbd, 0x8d, 0x35, 0x94, 0x5d,
+			0x89, 0x19, 0x96, 0x40, 0x80, 0xb0, 0xe1,
+		}},
+		ActionSettingsBluetooth: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x65, 0x74, 0xc0, 0x67, 0x89, 0x73, 0xc0, 0xf9,
+			0x72, 0x6d, 0x9f, 0x69, 0x72, 0xd9, 0x9e, 0x69, 0x72, 0xfd, 0x9d, 0x69, 0x72, 0x25, 0x9d, 0xfd,
+			0x72, 0x91, 0x9c, 0x91, 0x73, 0xb8, 0x69, 0x74, 0xb8, 0x45, 0x75, 0xb8, 0xd5, 0x75, 0x95, 0x9c,
+			0x69, 0x76, 0x29, 0x9d, 0x69, 0x76, 0x05, 0x9e, 0x69, 0x76, 0xdd, 0x9e, 0xd5, 0x75, 0x71, 0x9f,
+			0x41, 0x75, 0xc0, 0x65, 0x74, 0xc0, 0xe2, 0xfd, 0x7f, 0xc0, 0x67, 0x25, 0x7f, 0xc0, 0x91, 0x7e,
+			0x6d, 0x9f, 0x7c, 0xd9, 0x9e, 0x7c, 0xfd, 0x9d, 0x7c, 0x25, 0x9d, 0x95, 0x7e, 0x91, 0x9c, 0x29,
+			0x7f, 0xb8, 0x05, 0x80, 0xb8, 0xdd, 0x80, 0xb8, 0x71, 0x81, 0x95, 0x9c, 0x84, 0x29, 0x9d, 0x84,
+			0x05, 0x9e, 0x84, 0xdd, 0x9e, 0x6d, 0x81, 0x71, 0x9f, 0xd9, 0x80, 0xc0, 0xfd, 0x7f, 0xc0, 0xe2,
+			0x55, 0x8b, 0xc0, 0x67, 0x79, 0x8a, 0xc0, 0xe9, 0x89, 0x6d, 0x9f, 0x55, 0x89, 0xd9, 0x9e, 0x55,
+			0x89, 0xfd, 0x9d, 0x55, 0x89, 0x25, 0x9d, 0xe9, 0x89, 0x91, 0x9c, 0x7d, 0x8a, 0xb8, 0x59, 0x8b,
+			0xb8, 0x35, 0x8c, 0xb8, 0xc5, 0x8c, 0x95, 0x9c, 0x55, 0x8d, 0x29, 0x9d, 0x55, 0x8d, 0x05, 0x9e,
+			0x55, 0x8d, 0xdd, 0x9e, 0xc1, 0x8c, 0x71, 0x9f, 0x2d, 0x8c, 0xc0, 0x55, 0x8b, 0xc0, 0xe2, 0x11,
+			0x7e, 0x69, 0x94, 0xe8, 0x79, 0x7f, 0x05, 0x25, 0x71, 0x69, 0x8c, 0x55, 0x6e, 0x99, 0x89, 0x45,
+			0x7d, 0xad, 0x7a, 0x55, 0x6e, 0xbd, 0x6b, 0x25, 0x71, 0xf1, 0x68, 0x11, 0x7e, 0xdd, 0x75, 0xe8,
+			0xf1, 0x60, 0xe6, 0x11, 0x80, 0x03, 0x55, 0x8e, 0x35, 0x6f, 0xdd, 0x82, 0xad, 0x7a, 0x55, 0x8e,
+			0x25, 0x86, 0x11, 0x80, 0x69, 0x94, 0xe6, 0x11, 0x7e, 0xe2, 0x11, 0x82, 0xad, 0x8c, 0x01, 0xbd,
+			0x88, 0x25, 0x86, 0x11, 0x82, 0x79, 0x7f, 0xe8, 0xad, 0x8c, 0xe2, 0x11, 0x82, 0xdd, 0x75, 0x01,
+			0xbd, 0x88, 0x35, 0x6f, 0x11, 0x82, 0xad, 0x68, 0xe8, 0xdd, 0x75, 0xe1,
+		}},
+		ActionSettingsBrightness: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x25, 0x8d, 0x00, 0xad, 0x83, 0x79, 0x89,
+			0xe6, 0xf1, 0x89, 0xe8, 0x11, 0x83, 0x01, 0x45, 0x8d, 0xbd, 0x7f, 0xf1, 0x89, 0x79, 0x7c, 0xe8,
+			0x25, 0x76, 0xe6, 0x99, 0x83, 0x01, 0x80, 0x89, 0x72, 0x69, 0x7c, 0x25, 0x76, 0xe6, 0x45, 0x76,
+			0xe8, 0x79, 0x7c, 0x01, 0xf1, 0x72, 0xcd, 0x7f, 0x45, 0x76, 0x25, 0x83, 0xe8, 0x79, 0x89, 0xe6,
+			0x69, 0x7c, 0x00, 0x80, 0x25, 0x8d, 0xe2, 0x80, 0xcd, 0x87, 0xe8, 0xf1, 0x77, 0x61, 0x45, 0x83,
+			0xf1, 0x77, 0x91, 0x85, 0x41, 0x7a, 0xdd, 0x87, 0x91, 0x7c, 0xdd, 0x87, 0xdd, 0x7f, 0x40, 0x95,
+			0x85, 0x7d, 0x85, 0x60, 0x49, 0x83, 0xcd, 0x87, 0x80, 0xcd, 0x87, 0xe2, 0x55, 0x69, 0x55, 0x95,
+			0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e,
+			0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55,
+			0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6,
+			0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91,
+			0xe1,
+		}},
+		ActionSettingsCell: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa5, 0x74, 0xc0, 0x60, 0xcd, 0x73, 0xc0, 0x3d,
+			0x73, 0x6d, 0x9f, 0x40, 0xad, 0x72, 0xbc, 0x60, 0xad, 0x72, 0x35, 0x9d, 0x3d, 0x73, 0x99, 0x9c,
+			0x40, 0xad, 0x74, 0xb8, 0x60, 0x79, 0x75, 0xb8, 0x11, 0x76, 0x99, 0x9c, 0x40, 0xad, 0x76, 0x09,
+			0x9e, 0x60, 0xad, 0x76, 0xdd, 0x9e, 0x11, 0x76, 0x71, 0x9f, 0x40, 0xa5, 0x74, 0xc0, 0xe2, 0xfd,
+			0x7f, 0xc0, 0x67, 0x25, 0x7f, 0xc0, 0x91, 0x7e, 0x6d, 0x9f, 0x7c, 0xd9, 0x9e, 0x7c, 0xfd, 0x9d,
+			0x7c, 0x25, 0x9d, 0x95, 0x7e, 0x91, 0x9c, 0x29, 0x7f, 0xb8, 0x05, 0x80, 0xb8, 0xdd, 0x80, 0xb8,
+			0x71, 0x81, 0x95, 0x9c, 0x84, 0x29, 0x9d, 0x84, 0x05, 0x9e, 0x84, 0xdd, 0x9e, 0x6d, 0x81, 0x71,
+			0x9f, 0xd9, 0x80, 0xc0, 0xfd, 0x7f, 0xc0, 0xe2, 0x55, 0x8b, 0xc0, 0x60, 0x79, 0x8a, 0xc0, 0xe9,
+			0x89, 0x6d, 0x9f, 0x40, 0x55, 0x89, 0xbc, 0x62, 0x55, 0x89, 0x35, 0x9d, 0xe9, 0x89, 0x99, 0x9c,
+			0x7d, 0x8a, 0xb8, 0x59, 0x8b, 0xb8, 0x35, 0x8c, 0xb8, 0xc5, 0x8c, 0x99, 0x9c, 0x40, 0x55, 0x8d,
+			0x09, 0x9e, 0x61, 0x55, 0x8d, 0xdd, 0x9e, 0xc1, 0x8c, 0x71, 0x9f, 0x2d, 0x8c, 0xc0, 0x55, 0x8b,
+			0xc0, 0xe2, 0x68, 0x55, 0x95, 0x60, 0x79, 0x72, 0x55, 0x95, 0x3d, 0x71, 0x19, 0x94, 0x40, 0x60,
+			0x55, 0x91, 0xe8, 0x48, 0x60, 0x60, 0x69, 0x62, 0x3d, 0x71, 0x35, 0x61, 0x40, 0x68, 0x40, 0xe6,
+			0x98, 0x60, 0x89, 0x8d, 0x40, 0xc5, 0x8e, 0x35, 0x61, 0x40, 0xa0, 0x48, 0xe8, 0x55, 0x91, 0x60,
+			0xa0, 0xdd, 0x92, 0xc5, 0x8e, 0x19, 0x94, 0x40, 0x98, 0x55, 0x95, 0xe6, 0x68, 0xe2, 0x68, 0x55,
+			0x91, 0xe6, 0x98, 0xe8, 0x55, 0x8d, 0xe6, 0x68, 0xe8, 0x55, 0x91, 0xe2, 0x68, 0x55, 0x89, 0xe6,
+			0x98, 0xe8, 0x58, 0xe6, 0x68, 0xe8, 0x55, 0x89, 0xe2, 0x68, 0x50, 0xe6, 0x98, 0xe8, 0x48, 0xe6,
+			0x68, 0xe8, 0x50, 0xe2, 0x68, 0x50, 0xe8, 0x48, 0xe8, 0x50, 0xe2, 0x68, 0x55, 0x91, 0xe8, 0x55,
+			0x8d, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ActionSettingsEthernet: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8d, 0xa0, 0x05, 0x89, 0x8a, 0x35, 0x8d,
+			0x99, 0x97, 0x80, 0x89, 0x8a, 0xcd, 0x72, 0x55, 0x8d, 0x60, 0x55, 0x9d, 0x80, 0x55, 0x8d, 0xa0,
+			0xe2, 0xad, 0x72, 0xa0, 0x05, 0xad, 0x62, 0x80, 0xad, 0x72, 0x60, 0x79, 0x75, 0xcd, 0x72, 0x69,
+			0x68, 0x80, 0x79, 0x75, 0x35, 0x8d, 0xad, 0x72, 0xa0, 0xe2, 0x55, 0x75, 0x55, 0x82, 0x60, 0x69,
+			0x74, 0x55, 0x82, 0xb5, 0x73, 0xa5, 0x81, 0x40, 0x66, 0x80, 0x60, 0x66, 0x11, 0x7f, 0xb5, 0x73,
+			0x5d, 0x7e, 0x40, 0x55, 0x75, 0xad, 0x7d, 0x60, 0x45, 0x76, 0xad, 0x7d, 0xf9, 0x76, 0x5d, 0x7e,
+			0x40, 0xad, 0x77, 0x80, 0x60, 0xad, 0x77, 0xf1, 0x80, 0xf9, 0x76, 0xa5, 0x81, 0x40, 0x55, 0x75,
+			0x55, 0x82, 0xe2, 0x80, 0x55, 0x82, 0x60, 0x11, 0x7f, 0x55, 0x82, 0x5d, 0x7e, 0xa5, 0x81, 0x40,
+			0xad, 0x7d, 0x80, 0x60, 0xad, 0x7d, 0x11, 0x7f, 0x5d, 0x7e, 0x5d, 0x7e, 0x40, 0x80, 0xad, 0x7d,
+			0x60, 0xf1, 0x80, 0xad, 0x7d, 0xa5, 0x81, 0x5d, 0x7e, 0x40, 0x55, 0x82, 0x80, 0x60, 0x55, 0x82,
+			0xf1, 0x80, 0xa5, 0x81, 0xa5, 0x81, 0x40, 0x80, 0x55, 0x82, 0xe2, 0xad, 0x8a, 0x55, 0x82, 0x60,
+			0xbd, 0x89, 0x55, 0x82, 0x09, 0x89, 0xa5, 0x81, 0x40, 0x55, 0x88, 0x80, 0x60, 0x55, 0x88, 0x11,
+			0x7f, 0x09, 0x89, 0x5d, 0x7e, 0x40, 0xad, 0x8a, 0xad, 0x7d, 0x60, 0x99, 0x8b, 0xad, 0x7d, 0x4d,
+			0x8c, 0x5d, 0x7e, 0x40, 0x9a, 0x80, 0x60, 0x9a, 0xf1, 0x80, 0x4d, 0x8c, 0xa5, 0x81, 0x40, 0xad,
+			0x8a, 0x55, 0x82, 0xe1,
+		}},
+		ActionSettingsInputAntenna: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0x80, 0x60, 0xad, 0x62, 0xdd, 0x79,
+			0xf9, 0x64, 0x89, 0x74, 0x40, 0x45, 0x6b, 0x3d, 0x6b, 0x60, 0x45, 0x6f, 0x45, 0x67, 0x99, 0x74,
+			0xf9, 0x64, 0x40, 0x80, 0xad, 0x62, 0x60, 0x11, 0x86, 0xad, 0x62, 0x69, 0x8b, 0xf9, 0x64, 0x40,
+			0xbd, 0x94, 0x3d, 0x6b, 0x60, 0xbd, 0x98, 0x35, 0x6f, 0x09, 0x9b, 0x89, 0x74, 0x40, 0x55, 0x9d,
+			0x80, 0xe6, 0x55, 0x99, 0x60, 0x55, 0x99, 0xb9, 0x7a, 0x55, 0x97, 0x1d, 0x76, 0x40, 0xe9, 0x91,
+			0x0d, 0x6e, 0x65, 0x79, 0x8e, 0x99, 0x6a, 0xd5, 0x89, 0xa5, 0x68, 0x35, 0x85, 0xad, 0x66, 0xfd,
+			0x7f, 0xad, 0x66, 0xc9, 0x7a, 0xad, 0x66, 0x2d, 0x76, 0xa1, 0x68, 0x91, 0x71, 0x99, 0x6a, 0x1d,
+			0x6e, 0x09, 0x6e, 0xad, 0x6a, 0x79, 0x71, 0xad, 0x68, 0x19, 0x76, 0xad, 0x66, 0xb5, 0x7a, 0xad,
+			0x66, 0x80, 0xe6, 0xad, 0x62, 0xe2, 0x55, 0x6e, 0x80, 0x63, 0x55, 0x6e, 0x99, 0x78, 0x85, 0x73,
+			0x79, 0x73, 0xb1, 0x78, 0x55, 0x6e, 0x05, 0x80, 0x55, 0x6e, 0x55, 0x87, 0x55, 0x6e, 0x81, 0x8c,
+			0x79, 0x73, 0xad, 0x91, 0x99, 0x78, 0xad, 0x91, 0x80, 0xe6, 0xad, 0x8d, 0x60, 0xad, 0x8d, 0x45,
+			0x7a, 0xad, 0x89, 0x4d, 0x76, 0x40, 0x80, 0x55, 0x72, 0x60, 0x55, 0x7a, 0x55, 0x72, 0x55, 0x76,
+			0x4d, 0x76, 0x40, 0x55, 0x72, 0x80, 0xe6, 0x55, 0x6e, 0xe2, 0x70, 0xbd, 0x9b, 0x01, 0x35, 0x75,
+			0xf1, 0x98, 0x7c, 0x25, 0x90, 0xe8, 0xbd, 0x85, 0x60, 0x35, 0x7c, 0xf1, 0x84, 0x19, 0x7b, 0x5d,
+			0x83, 0x40, 0x74, 0x80, 0x60, 0x74, 0x7d, 0x7d, 0xc1, 0x7b, 0xbd, 0x7b, 0x40, 0x05, 0x80, 0x74,
+			0x60, 0x89, 0x82, 0x74, 0x45, 0x84, 0xbd, 0x7b, 0x40, 0x8c, 0x80, 0x60, 0x8c, 0xcd, 0x81, 0xe9,
+			0x84, 0x5d, 0x83, 0x40, 0x84, 0xbd, 0x85, 0xe8, 0x25, 0x90, 0x03, 0xcd, 0x8a, 0xf1, 0x98, 0x90,
+			0xbd, 0x9b, 0x80, 0xbd, 0x93, 0x70, 0xbd, 0x9b, 0xe1,
+		}},
+		ActionSettingsInputComponent: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x68, 0x55, 0x9d, 0xe8, 0xa4, 0x60, 0xf1,
+			0x65, 0x25, 0x91, 0x4d, 0x64, 0x2d, 0x8f, 0x40, 0xad, 0x62, 0xdd, 0x8a, 0xe8, 0x55, 0x70, 0xe6,
+			0x35, 0x68, 0xe8, 0xad, 0x64, 0x60, 0x35, 0x68, 0xbd, 0x63, 0xbd, 0x68, 0x35, 0x63, 0x40, 0x35,
+			0x6a, 0xad, 0x62, 0x60, 0x25, 0x6b, 0xad, 0x62, 0xad, 0x6b, 0x35, 0x63, 0x40, 0x35, 0x6c, 0xad,
+			0x64, 0xe8, 0x55, 0x70, 0xe6, 0xbd, 0x71, 0xe8, 0xdd, 0x8a, 0x60, 0xbd, 0x71, 0x35, 0x8d, 0x25,
+			0x70, 0x2d, 0x8f, 0x40, 0x35, 0x6c, 0xa4, 0xe8, 0x55, 0x9d, 0xe6, 0x35, 0x68, 0xe2, 0x7c, 0x55,
+			0x9d, 0xe8, 0xa4, 0x60, 0xbd, 0x7b, 0x25, 0x91, 0x19, 0x7a, 0x2d, 0x8f, 0x40, 0x79, 0x78, 0xdd,
+			0x8a, 0xe8, 0x55, 0x70, 0xe6, 0x7c, 0xe8, 0xad, 0x64, 0x60, 0x7c, 0xbd, 0x63, 0x89, 0x7e, 0x35,
+			0x63, 0x40, 0x80, 0xad, 0x62, 0x60, 0xf1, 0x80, 0xad, 0x62, 0x79, 0x81, 0x35, 0x63, 0x40, 0x84,
+			0xad, 0x64, 0xe8, 0x55, 0x70, 0xe6, 0x89, 0x87, 0xe8, 0xdd, 0x8a, 0x60, 0x89, 0x87, 0x35, 0x8d,
+			0xf1, 0x85, 0x2d, 0x8f, 0x40, 0x84, 0xa4, 0xe8, 0x55, 0x9d, 0xe6, 0x7c, 0xe2, 0xcd, 0x93, 0x55,
+			0x9d, 0xe8, 0xa4, 0x60, 0x89, 0x91, 0x25, 0x91, 0xe9, 0x8f, 0x2d, 0x8f, 0x40, 0x45, 0x8e, 0xdd,
+			0x8a, 0xe8, 0x55, 0x70, 0xe6, 0xcd, 0x93, 0xe8, 0xad, 0x64, 0x60, 0xcd, 0x93, 0xbd, 0x63, 0x55,
+			0x94, 0x35, 0x63, 0x40, 0xcd, 0x95, 0xad, 0x62, 0x60, 0xbd, 0x96, 0xad, 0x62, 0x45, 0x97, 0x35,
+			0x63, 0x40, 0xcd, 0x97, 0xad, 0x64, 0xe8, 0x55, 0x70, 0xe6, 0x55, 0x9d, 0xe8, 0xdd, 0x8a, 0x60,
+			0x55, 0x9d, 0x35, 0x8d, 0xb5, 0x9b, 0x2d, 0x8f, 0x40, 0xcd, 0x97, 0xa4, 0xe8, 0x55, 0x9d, 0xe6,
+			0xcd, 0x93, 0xe2, 0xad, 0x66, 0x55, 0x74, 0xe8, 0x99, 0x80, 0xe6, 0xbd, 0x6d, 0xe8, 0x55, 0x74,
+			0xe6, 0xad, 0x66, 0xe2, 0x79, 0x7c, 0x55, 0x74, 0xe8, 0x99, 0x80, 0xe6, 0x89, 0x83, 0xe8, 0x55,
+			0x74, 0xe6, 0x79, 0x7c, 0xe2, 0x45, 0x92, 0x55, 0x74, 0xe8, 0x99, 0x80, 0xe6, 0x55, 0x99, 0xe8,
+			0x55, 0x74, 0xe6, 0x45, 0x92, 0xe2, 0x35, 0x6a, 0x35, 0x8e, 0x60, 0xad, 0x6b, 0x35, 0x8e, 0xb5,
+			0x6c, 0x2d, 0x8d, 0x40, 0xbd, 0x6d, 0xad, 0x8a, 0xe8, 0x99, 0x84, 0xe6, 0xad, 0x66, 0xe8, 0xad,
+			0x8a, 0x60, 0xad, 0x66, 0x25, 0x8c, 0xb5, 0x67, 0x2d, 0x8d, 0x40, 0x35, 0x6a, 0x35, 0x8e, 0xe2,
+			0x80, 0x35, 0x8e, 0x60, 0x79, 0x81, 0x35, 0x8e, 0x81, 0x82, 0x2d, 0x8d, 0x40, 0x89, 0x83, 0xad,
+			0x8a, 0xe8, 0x99, 0x84, 0xe6, 0x79, 0x7c, 0xe8, 0xad, 0x8a, 0x60, 0x79, 0x7c, 0x25, 0x8c, 0x81,
+			0x7d, 0x2d, 0x8d, 0x40, 0x80, 0x35, 0x8e, 0xe2, 0xcd, 0x95, 0x35, 0x8e, 0x60, 0x45, 0x97, 0x35,
+			0x8e, 0x4d, 0x98, 0x2d, 0x8d, 0x40, 0x55, 0x99, 0xad, 0x8a, 0xe8, 0x99, 0x84, 0xe6, 0x45, 0x92,
+			0xe8, 0xad, 0x8a, 0x60, 0x45, 0x92, 0x25, 0x8c, 0x4d, 0x93, 0x2d, 0x8d, 0x40, 0xcd, 0x95, 0x35,
+			0x8e, 0xe2, 0x35, 0x6a, 0x99, 0x82, 0xe2, 0x80, 0x99, 0x82, 0xe2, 0xcd, 0x95, 0x99, 0x82, 0xe2,
+			0xad, 0x66, 0x99, 0x80, 0xe6, 0xbd, 0x6d, 0xe6, 0xad, 0x66, 0xe2, 0x79, 0x7c, 0x99, 0x80, 0xe6,
+			0x89, 0x83, 0xe6, 0x79, 0x7c, 0xe2, 0x45, 0x92, 0x99, 0x80, 0xe6, 0x55, 0x99, 0xe6, 0x45, 0x92,
+			0xe2, 0x35, 0x6a, 0x99, 0x84, 0xe6, 0xad, 0x66, 0xe6, 0xbd, 0x6d, 0xe6, 0x35, 0x6a, 0xe2, 0x80,
+			0x99, 0x84, 0xe6, 0x79, 0x7c, 0xe6, 0x89, 0x83, 0xe6, 0x80, 0xe2, 0xcd, 0x95, 0x99, 0x84, 0xe6,
+			0x45, 0x92, 0xe6, 0x55, 0x99, 0xe6, 0xcd, 0x95, 0xe1,
+		}},
+		ActionSettingsInputComposite: ActionSettingsInputComposite,
+		ActionSettingsInputHDMI:      ActionSettingsInputHDMI,
+		ActionSettingsInputSVideo:    ActionSettingsInputSVideo,
+		ActionSettingsOverscan:       ActionSettingsOverscan,
+		ActionSettingsPhone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x7e, 0x7a, 0x60, 0x79, 0x7d, 0x7a, 0xb5,
+			0x7c, 0x3d, 0x7c, 0x40, 0xf1, 0x7b, 0x55, 0x7a, 0x60, 0xf1, 0x7b, 0x35, 0x79, 0xb5, 0x7c, 0x71,
+			0x78, 0x40, 0x99, 0x7e, 0xad, 0x77, 0x60, 0xbd, 0x7f, 0xad, 0x77, 0x81, 0x80, 0x71, 0x78, 0x40,
+			0x45, 0x81, 0x55, 0x7a, 0x60, 0x45, 0x81, 0x79, 0x7b, 0x81, 0x80, 0x3d, 0x7c, 0x40, 0x99, 0x7e,
+			0x7a, 0xe2, 0x25, 0x8a, 0x7a, 0x61, 0x09, 0x89, 0x7a, 0x49, 0x88, 0x3d, 0x7c, 0x89, 0x87, 0x79,
+			0x7b, 0x89, 0x87, 0x55, 0x7a, 0x40, 0x49, 0x88, 0x71, 0x78, 0x62, 0x09, 0x89, 0xad, 0x77, 0x25,
+			0x8a, 0xad, 0x77, 0x4d, 0x8b, 0xad, 0x77, 0x15, 0x8c, 0x71, 0x78, 0xdd, 0x8c, 0x35, 0x79, 0xdd,
+			0x8c, 0x55, 0x7a, 0x40, 0x15, 0x8c, 0x3d, 0x7c, 0x60, 0x4d, 0x8b, 0x7a, 0x25, 0x8a, 0x7a, 0xe2,
+			0xf1, 0x95, 0x7a, 0x61, 0xc5, 0x94, 0x7a, 0xfd, 0x93, 0x3d, 0x7c, 0x35, 0x93, 0x79, 0x7b, 0x35,
+			0x93, 0x55, 0x7a, 0x40, 0xfd, 0x93, 0x71, 0x78, 0x62, 0xc5, 0x94, 0xad, 0x77, 0xf1, 0x95, 0xad,
+			0x77, 0x09, 0x97, 0xad, 0x77, 0xc9, 0x97, 0x71, 0x78, 0x89, 0x98, 0x35, 0x79, 0x89, 0x98, 0x55,
+			0x7a, 0x40, 0xc9, 0x97, 0x3d, 0x7c, 0x60, 0x09, 0x97, 0x7a, 0xf1, 0x95, 0x7a, 0xe2, 0xaa, 0xb0,
+			0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85, 0x45, 0x94, 0x40, 0x55, 0x76, 0xad, 0x89, 0x60, 0x79, 0x6f,
+			0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a, 0x40, 0x50, 0x56, 0x60, 0x50, 0xb9, 0x69, 0xdd, 0x68, 0xdd,
+			0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x60, 0x45, 0x75, 0x50, 0xf1, 0x75, 0xad, 0x68, 0x40,
+			0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9, 0x78, 0xb5, 0x72, 0x61, 0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78,
+			0x71, 0x74, 0x79, 0x78, 0x35, 0x75, 0xed, 0x77, 0xc1, 0x75, 0x00, 0x45, 0x71, 0x79, 0x7c, 0x60,
+			0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1, 0x81, 0x40, 0x35, 0x79, 0xbd, 0x86, 0x60, 0xad, 0x7b, 0x45,
+			0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40, 0x25, 0x84, 0x11, 0x8f, 0x00, 0x79, 0x8a, 0x89, 0x88, 0x60,
+			0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c, 0x89, 0x87, 0x40, 0xbd, 0x8d, 0x69, 0x87, 0x00, 0xad, 0x95,
+			0x25, 0x89, 0x60, 0xad, 0x96, 0x69, 0x89, 0x55, 0x97, 0x35, 0x8a, 0x40, 0xb0, 0x98, 0xe8, 0xaa,
+			0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40, 0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78,
+			0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6, 0x58, 0x60, 0x25, 0x6c, 0xcd, 0x6e, 0xe9,
+			0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45,
+			0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd, 0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b,
+			0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0xe1,
+		}},
+		ActionSettingsPower: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x69, 0x7e, 0xe8, 0x55, 0x65, 0xe6, 0x84,
+			0xe8, 0x69, 0x7e, 0xe6, 0x7c, 0xe2, 0xf9, 0x7f, 0x55, 0x90, 0x60, 0x69, 0x77, 0x55, 0x90, 0x69,
+			0x71, 0x59, 0x8a, 0x40, 0x69, 0x6b, 0xbd, 0x7b, 0x60, 0x69, 0x6b, 0xad, 0x76, 0xc5, 0x6d, 0x4d,
+			0x72, 0x40, 0x69, 0x74, 0xbd, 0x6a, 0x00, 0x45, 0x77, 0x99, 0x6d, 0x60, 0xad, 0x73, 0x25, 0x70,
+			0x89, 0x71, 0xc5, 0x73, 0x40, 0x69, 0x6f, 0xbd, 0x7b, 0x61, 0x69, 0x6f, 0x99, 0x82, 0x45, 0x74,
+			0x79, 0x87, 0x25, 0x79, 0x55, 0x8c, 0x80, 0x55, 0x8c, 0x40, 0xbd, 0x8b, 0x79, 0x87, 0x61, 0x99,
+			0x90, 0x99, 0x82, 0x99, 0x90, 0xbd, 0x7b, 0x99, 0x90, 0x69, 0x77, 0x89, 0x8e, 0x89, 0x73, 0x40,
+			0xdd, 0x88, 0x99, 0x6d, 0x00, 0xbd, 0x8b, 0xbd, 0x6a, 0x60, 0x11, 0x90, 0xad, 0x6d, 0x55, 0x92,
+			0x2d, 0x72, 0x40, 0x99, 0x94, 0xbd, 0x7b, 0x60, 0x99, 0x94, 0x55, 0x84, 0x91, 0x8e, 0x55, 0x8a,
+			0x40, 0xf9, 0x7f, 0x55, 0x90, 0xe2, 0xad, 0x74, 0x25, 0xa0, 0x61, 0x81, 0x73, 0x25, 0xa0, 0xb9,
+			0x72, 0x59, 0x9f, 0xf1, 0x71, 0x91, 0x9e, 0xf1, 0x71, 0x69, 0x9d, 0x40, 0xb9, 0x72, 0x75, 0x9b,
+			0x62, 0x81, 0x73, 0xad, 0x9a, 0xad, 0x74, 0xad, 0x9a, 0xd5, 0x75, 0xad, 0x9a, 0x9d, 0x76, 0x75,
+			0x9b, 0x69, 0x77, 0x3d, 0x9c, 0x69, 0x77, 0x69, 0x9d, 0x40, 0x9d, 0x76, 0x59, 0x9f, 0x60, 0xd5,
+			0x75, 0x25, 0xa0, 0xad, 0x74, 0x25, 0xa0, 0xe2, 0x80, 0x25, 0xa0, 0x61, 0xd9, 0x7e, 0x25, 0xa0,
+			0x0d, 0x7e, 0x59, 0x9f, 0x45, 0x7d, 0x91, 0x9e, 0x45, 0x7d, 0x69, 0x9d, 0x40, 0x0d, 0x7e, 0x75,
+			0x9b, 0x62, 0xd9, 0x7e, 0xad, 0x9a, 0x80, 0xad, 0x9a, 0x29, 0x81, 0xad, 0x9a, 0xf5, 0x81, 0x75,
+			0x9b, 0xbd, 0x82, 0x3d, 0x9c, 0xbd, 0x82, 0x69, 0x9d, 0x40, 0xf5, 0x81, 0x59, 0x9f, 0x60, 0x29,
+			0x81, 0x25, 0xa0, 0x80, 0x25, 0xa0, 0xe2, 0x55, 0x8b, 0x25, 0xa0, 0x61, 0x2d, 0x8a, 0x25, 0xa0,
+			0x65, 0x89, 0x59, 0x9f, 0x99, 0x88, 0x91, 0x9e, 0x99, 0x88, 0x69, 0x9d, 0x40, 0x65, 0x89, 0x75,
+			0x9b, 0x60, 0x2d, 0x8a, 0xad, 0x9a, 0x55, 0x8b, 0xad, 0x9a, 0x40, 0x49, 0x8d, 0x75, 0x9b, 0x60,
+			0x11, 0x8e, 0x3d, 0x9c, 0x11, 0x8e, 0x69, 0x9d, 0x40, 0x49, 0x8d, 0x59, 0x9f, 0x60, 0x81, 0x8c,
+			0x25, 0xa0, 0x55, 0x8b, 0x25, 0xa0, 0xe1,
+		}},
+		ActionSettingsRemote: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x77, 0x55, 0x9d, 0x60, 0xcd, 0x76, 0x55,
+			0x9d, 0x11, 0x76, 0x9d, 0x9c, 0x40, 0x55, 0x75, 0xcd, 0x9a, 0xe8, 0x89, 0x7a, 0x60, 0x55, 0x75,
+			0x75, 0x79, 0x11, 0x76, 0xbd, 0x78, 0x40, 0xdd, 0x77, 0x70, 0xe6, 0x25, 0x88, 0x60, 0x35, 0x89,
+			0x70, 0xf1, 0x89, 0xbd, 0x78, 0x40, 0xad, 0x8a, 0x89, 0x7a, 0xe8, 0xcd, 0x9a, 0x60, 0xad, 0x8a,
+			0xe1, 0x9b, 0xf1, 0x89, 0x9d, 0x9c, 0x40, 0x25, 0x88, 0x55, 0x9d, 0xe6, 0xdd, 0x77, 0xe2, 0xfd,
+			0x7f, 0x79, 0x85, 0x62, 0x2d, 0x81, 0x79, 0x85, 0xfd, 0x81, 0xa9, 0x84, 0xcd, 0x82, 0xd5, 0x83,
+			0xcd, 0x82, 0xa9, 0x82, 0xcd, 0x82, 0x79, 0x81, 0xfd, 0x81, 0x99, 0x80, 0x40, 0x80, 0xbd, 0x7f,
+			0x62, 0xc5, 0x7e, 0xbd, 0x7f, 0xed, 0x7d, 0x95, 0x80, 0x11, 0x7d, 0x71, 0x81, 0x11, 0x7d, 0xad,
+			0x82, 0x11, 0x7d, 0xd9, 0x83, 0xf1, 0x7d, 0xa9, 0x84, 0x40, 0xfd, 0x7f, 0x79, 0x85, 0xe2, 0x99,
+			0x75, 0xf1, 0x72, 0x00, 0xcd, 0x72, 0x25, 0x70, 0x60, 0x79, 0x75, 0x79, 0x6d, 0xdd, 0x78, 0x11,
+			0x6c, 0x40, 0x80, 0xad, 0x6a, 0x60, 0xbd, 0x83, 0xad, 0x6a, 0x25, 0x87, 0x11, 0x6c, 0x40, 0x35,
+			0x8d, 0x25, 0x70, 0x00, 0x69, 0x8a, 0xf1, 0x72, 0x62, 0x45, 0x88, 0xdd, 0x70, 0x99, 0x85, 0xc5,
+			0x6f, 0xed, 0x82, 0xad, 0x6e, 0x80, 0xad, 0x6e, 0x11, 0x7d, 0xad, 0x6e, 0x69, 0x7a, 0xc5, 0x6f,
+			0x40, 0x99, 0x75, 0xf1, 0x72, 0xe2, 0x5c, 0x69, 0x6b, 0x00, 0x35, 0x6b, 0x89, 0x68, 0x60, 0x69,
+			0x6f, 0x79, 0x64, 0xc5, 0x74, 0x3d, 0x62, 0x40, 0x80, 0x40, 0x60, 0xdd, 0x85, 0x40, 0x3d, 0x8b,
+			0x3d, 0x62, 0x40, 0xad, 0x94, 0xad, 0x68, 0x00, 0xcd, 0x91, 0x79, 0x6b, 0x60, 0x45, 0x8e, 0xdd,
+			0x67, 0xa5, 0x89, 0xf1, 0x65, 0x40, 0xfd, 0x7f, 0x48, 0x61, 0xf1, 0x7a, 0x48, 0x45, 0x76, 0xe9,
+			0x65, 0x99, 0x71, 0xcd, 0x67, 0x5c, 0x69, 0x6b, 0xe2, 0x55, 0x79, 0x55, 0x99, 0xe6, 0xad, 0x86,
+			0xe8, 0x78, 0xe6, 0x55, 0x79, 0xe8, 0x55, 0x99, 0xe2, 0x55, 0x79, 0x55, 0x99, 0xe6, 0xad, 0x86,
+			0xe6, 0x55, 0x79, 0xe1,
+		}},
+		ActionSettingsVoice: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xed, 0x74, 0xc0, 0x67, 0x11, 0x74, 0xc0, 0x81,
+			0x73, 0x6d, 0x9f, 0xf1, 0x72, 0xd9, 0x9e, 0xf1, 0x72, 0xfd, 0x9d, 0xf1, 0x72, 0x25, 0x9d, 0x85,
+			0x73, 0x91, 0x9c, 0x19, 0x74, 0xb8, 0xf1, 0x74, 0xb8, 0xcd, 0x75, 0xb8, 0x5d, 0x76, 0x95, 0x9c,
+			0xf1, 0x76, 0x29, 0x9d, 0xf1, 0x76, 0x05, 0x9e, 0xf1, 0x76, 0xdd, 0x9e, 0x5d, 0x76, 0x71, 0x9f,
+			0xc9, 0x75, 0xc0, 0xed, 0x74, 0xc0, 0xe2, 0x80, 0xc0, 0x61, 0x2d, 0x7f, 0xc0, 0xa1, 0x7e, 0x69,
+			0x9f, 0x11, 0x7e, 0xcd, 0x9e, 0x11, 0x7e, 0xbc, 0x40, 0xa1, 0x7e, 0x99, 0x9c, 0x64, 0x35, 0x7f,
+			0xb8, 0x09, 0x80, 0xb8, 0xdd, 0x80, 0xb8, 0x79, 0x81, 0x95, 0x9c, 0x11, 0x82, 0x29, 0x9d, 0x11,
+			0x82, 0x05, 0x9e, 0x11, 0x82, 0xdd, 0x9e, 0x79, 0x81, 0x71, 0x9f, 0xe1, 0x80, 0xc0, 0x80, 0xc0,
+			0xe2, 0x21, 0x8b, 0xc0, 0x67, 0x45, 0x8a, 0xc0, 0xb5, 0x89, 0x6d, 0x9f, 0x25, 0x89, 0xd9, 0x9e,
+			0x25, 0x89, 0xfd, 0x9d, 0x25, 0x89, 0x25, 0x9d, 0xb9, 0x89, 0x91, 0x9c, 0x4d, 0x8a, 0xb8, 0x25,
+			0x8b, 0xb8, 0x98, 0xb8, 0x91, 0x8c, 0x95, 0x9c, 0x25, 0x8d, 0x29, 0x9d, 0x25, 0x8d, 0x05, 0x9e,
+			0x25, 0x8d, 0xdd, 0x9e, 0x91, 0x8c, 0x71, 0x9f, 0xfd, 0x8b, 0xc0, 0x21, 0x8b, 0xc0, 0xe2, 0x80,
+			0xcd, 0x83, 0x61, 0x25, 0x7d, 0xcd, 0x83, 0x35, 0x7b, 0xbd, 0x81, 0x45, 0x79, 0xad, 0x7f, 0x45,
+			0x79, 0xbd, 0x7c, 0xe8, 0x58, 0x61, 0x45, 0x79, 0x39, 0x69, 0x3d, 0x7b, 0x49, 0x67, 0x31, 0x7d,
+			0x55, 0x65, 0x80, 0x55, 0x65, 0x40, 0xc5, 0x84, 0x49, 0x67, 0x60, 0xbd, 0x86, 0x39, 0x69, 0xbd,
+			0x86, 0x58, 0xe8, 0xbd, 0x7c, 0x61, 0xbd, 0x86, 0xad, 0x7f, 0xcd, 0x84, 0xbd, 0x81, 0xdd, 0x82,
+			0xcd, 0x83, 0x80, 0xcd, 0x83, 0xe2, 0x80, 0x99, 0x74, 0xe2, 0x7c, 0xb0, 0xe8, 0xf1, 0x8e, 0x60,
+			0xf1, 0x76, 0x35, 0x8e, 0x25, 0x72, 0x92, 0x40, 0x55, 0x6d, 0xbd, 0x7c, 0xe6, 0x55, 0x71, 0x60,
+			0x55, 0x71, 0xcd, 0x82, 0xa1, 0x75, 0xf1, 0x86, 0x40, 0xfd, 0x7f, 0x11, 0x8b, 0x61, 0x11, 0x86,
+			0x11, 0x8b, 0x5d, 0x8a, 0xf1, 0x86, 0xad, 0x8e, 0xcd, 0x82, 0xad, 0x8e, 0xbd, 0x7c, 0xe6, 0xad,
+			0x92, 0x60, 0xad, 0x92, 0xcd, 0x83, 0xdd, 0x8d, 0x92, 0x40, 0x84, 0xf1, 0x8e, 0xe8, 0xb0, 0xe6,
+			0x7c, 0xe2, 0x80, 0xcd, 0x7f, 0x60, 0x35, 0x81, 0xcd, 0x7f, 0xf9, 0x81, 0xe9, 0x7e, 0x40, 0xbd,
+			0x82, 0xbd, 0x7c, 0xe8, 0x58, 0x63, 0xbd, 0x82, 0xdd, 0x6a, 0xf5, 0x81, 0x19, 0x6a, 0x29, 0x81,
+			0x55, 0x69, 0x80, 0x55, 0x69, 0xd9, 0x7e, 0x55, 0x69, 0x0d, 0x7e, 0x19, 0x6a, 0x45, 0x7d, 0xdd,
+			0x6a, 0x45, 0x7d, 0x58, 0xe8, 0xbd, 0x7c, 0x60, 0x45, 0x7d, 0x7c, 0x09, 0x7e, 0xe9, 0x7e, 0x40,
+			0x80, 0xcd, 0x7f, 0xe1,
+		}},
+		ActionShop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x79, 0xbd, 0x8f, 0x01, 0x55, 0x8b, 0x11,
+			0x84, 0x99, 0x79, 0x55, 0x78, 0xe8, 0xbd, 0x8f, 0xe2, 0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0,
+			0x89, 0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0x60, 0xe6, 0x55, 0x75, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x75, 0xbd, 0x67, 0x89, 0x76, 0x89, 0x66, 0x40, 0x55, 0x79, 0x55, 0x65, 0xe6, 0xad,
+			0x86, 0x60, 0x45, 0x88, 0x55, 0x65, 0x79, 0x89, 0x89, 0x66, 0x40, 0xad, 0x8a, 0x55, 0x69, 0xe8,
+			0x60, 0xe6, 0xad, 0x9a, 0xe8, 0xa8, 0x60, 0xad, 0x9a, 0x99, 0x95, 0x79, 0x99, 0xcd, 0x96, 0x40,
+			0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0xa8, 0xe6, 0xad, 0x96, 0xe8, 0x68, 0xe6,
+			0x55, 0x69, 0xe8, 0xa8, 0xe2, 0x55, 0x79, 0x60, 0xe6, 0xad, 0x86, 0xe8, 0x55, 0x69, 0xe6, 0x55,
+			0x79, 0xe8, 0x60, 0xe2, 0x55, 0x69, 0xa8, 0xe8, 0x68, 0xe8, 0xa8, 0xe1,
+		}},
+		ActionShopTwo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x90, 0x01, 0x55, 0x8b, 0x69, 0x7f, 0x7c,
+			0xcd, 0x76, 0xe8, 0x90, 0xe2, 0xad, 0x66, 0xad, 0x9a, 0x60, 0x11, 0x65, 0xad, 0x9a, 0xdd, 0x63,
+			0x79, 0x99, 0x40, 0xad, 0x62, 0xad, 0x96, 0xe8, 0x11, 0x78, 0xe6, 0xad, 0x66, 0xe8, 0xad, 0x96,
+			0xe6, 0x45, 0x8f, 0xe8, 0xad, 0x9a, 0xe6, 0xad, 0x66, 0xe2, 0xad, 0x6e, 0xad, 0x92, 0x60, 0x11,
+			0x6d, 0xad, 0x92, 0xdd, 0x6b, 0x79, 0x91, 0x40, 0xad, 0x6a, 0xad, 0x8e, 0xe8, 0x25, 0x6c, 0xe6,
+			0xcd, 0x7a, 0xe8, 0xad, 0x66, 0x60, 0xcd, 0x7a, 0x11, 0x65, 0x78, 0xdd, 0x63, 0x40, 0xcd, 0x7e,
+			0xad, 0x62, 0xe6, 0x35, 0x89, 0x60, 0xcd, 0x8a, 0xad, 0x62, 0x98, 0xdd, 0x63, 0x40, 0x35, 0x8d,
+			0xad, 0x66, 0xe8, 0x25, 0x6c, 0xe6, 0x55, 0x9d, 0xe8, 0xad, 0x8e, 0x60, 0x55, 0x9d, 0x45, 0x90,
+			0x25, 0x9c, 0x79, 0x91, 0x40, 0x55, 0x99, 0xad, 0x92, 0xe6, 0xad, 0x6e, 0xe2, 0xad, 0x6e, 0xad,
+			0x8e, 0xe6, 0x55, 0x99, 0xe8, 0x25, 0x70, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x8e, 0xe2, 0xcd, 0x7e,
+			0x25, 0x6c, 0xe6, 0x35, 0x89, 0xe8, 0xad, 0x66, 0xe6, 0xcd, 0x7e, 0xe8, 0x25, 0x6c, 0xe2, 0xad,
+			0x6e, 0xad, 0x8e, 0xe8, 0x25, 0x70, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ActionShoppingBasket: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x6d, 0xb0, 0x60, 0x69, 0x6c, 0xb0, 0x4d,
+			0x6b, 0x35, 0x97, 0x40, 0xdd, 0x69, 0x11, 0x95, 0x00, 0x89, 0x62, 0x89, 0x7a, 0x61, 0x45, 0x62,
+			0x99, 0x79, 0xdd, 0x62, 0xcd, 0x78, 0x71, 0x63, 0x70, 0x79, 0x64, 0x70, 0xe6, 0xf1, 0x71, 0x00,
+			0x45, 0x7e, 0x4c, 0x60, 0x99, 0x7e, 0x99, 0x65, 0x7e, 0x5d, 0x65, 0x40, 0xf1, 0x7f, 0x25, 0x65,
+			0x60, 0x79, 0x80, 0x25, 0x65, 0xdd, 0x80, 0x5d, 0x65, 0x40, 0x99, 0x81, 0x4c, 0x00, 0xdd, 0x8d,
+			0x70, 0xe6, 0x99, 0x9b, 0x61, 0xa1, 0x9c, 0x70, 0x39, 0x9d, 0xcd, 0x78, 0xcd, 0x9d, 0x99, 0x79,
+			0x89, 0x9d, 0x89, 0x7a, 0x00, 0x25, 0x96, 0x11, 0x95, 0x60, 0xcd, 0x95, 0x69, 0x96, 0xb5, 0x94,
+			0x35, 0x97, 0x40, 0x45, 0x92, 0xb0, 0xe6, 0xbd, 0x6d, 0xe2, 0xbd, 0x6d, 0xa8, 0xe6, 0x45, 0x92,
+			0x00, 0xb2, 0x78, 0xe6, 0x11, 0x67, 0x00, 0xbd, 0x6d, 0xa8, 0xe2, 0x05, 0x80, 0x98, 0x60, 0xad,
+			0x81, 0x98, 0xd5, 0x82, 0xd5, 0x8a, 0x40, 0x88, 0xfd, 0x87, 0x60, 0x88, 0x55, 0x86, 0xd5, 0x82,
+			0x2d, 0x85, 0x40, 0xfd, 0x7f, 0x88, 0x60, 0x55, 0x7e, 0x88, 0x2d, 0x7d, 0x2d, 0x85, 0x40, 0x78,
+			0x05, 0x88, 0x60, 0x78, 0xad, 0x89, 0x2d, 0x7d, 0xd5, 0x8a, 0x40, 0x05, 0x80, 0x98, 0xe2, 0xcd,
+			0x76, 0x70, 0xe6, 0x92, 0x01, 0xf1, 0x7f, 0xad, 0x6a, 0xcd, 0x76, 0x70, 0xe2, 0x11, 0x80, 0x90,
+			0xe1,
+		}},
+		ActionShoppingCart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x21, 0x73, 0x99, 0x9a, 0x67, 0x25, 0x71, 0x99,
+			0x9a, 0xbd, 0x6f, 0x31, 0x99, 0x55, 0x6e, 0xc5, 0x97, 0x55, 0x6e, 0xc9, 0x95, 0x55, 0x6e, 0xcd,
+			0x93, 0xc1, 0x6f, 0x69, 0x92, 0x29, 0x71, 0xa2, 0x25, 0x73, 0xa2, 0x25, 0x75, 0xa2, 0x89, 0x76,
+			0x6d, 0x92, 0xf1, 0x77, 0xd5, 0x93, 0xf1, 0x77, 0xd1, 0x95, 0xf1, 0x77, 0xcd, 0x97, 0x85, 0x76,
+			0x35, 0x99, 0x1d, 0x75, 0x99, 0x9a, 0x21, 0x73, 0x99, 0x9a, 0xe2, 0xc9, 0x8d, 0x99, 0x9a, 0x67,
+			0xcd, 0x8b, 0x99, 0x9a, 0x69, 0x8a, 0x31, 0x99, 0x92, 0xc5, 0x97, 0x92, 0xc9, 0x95, 0x92, 0xcd,
+			0x93, 0x6d, 0x8a, 0x69, 0x92, 0xd5, 0x8b, 0xa2, 0xd1, 0x8d, 0xa2, 0xcd, 0x8f, 0xa2, 0x35, 0x91,
+			0x6d, 0x92, 0x99, 0x92, 0xd5, 0x93, 0x99, 0x92, 0xd1, 0x95, 0x99, 0x92, 0xcd, 0x97, 0x31, 0x91,
+			0x35, 0x99, 0xc5, 0x8f, 0x99, 0x9a, 0xc9, 0x8d, 0x99, 0x9a, 0xe2, 0xad, 0x6f, 0x99, 0x6e, 0x00,
+			0x6e, 0xcd, 0x7d, 0xe6, 0x35, 0x8a, 0x00, 0x89, 0x92, 0x99, 0x6e, 0xe6, 0xad, 0x6f, 0xe2, 0xad,
+			0x6d, 0x99, 0x6a, 0xe6, 0xf1, 0x94, 0x61, 0x79, 0x96, 0x99, 0x6a, 0x45, 0x97, 0x58, 0x11, 0x98,
+			0x69, 0x6d, 0x45, 0x97, 0xcd, 0x6e, 0x00, 0x45, 0x8e, 0x7e, 0x61, 0x89, 0x8d, 0x45, 0x80, 0x5d,
+			0x8c, 0x09, 0x81, 0x31, 0x8b, 0xcd, 0x81, 0xcd, 0x89, 0xcd, 0x81, 0xe6, 0x99, 0x75, 0x00, 0xdd,
+			0x71, 0xbd, 0x88, 0xe6, 0x99, 0x92, 0xe8, 0xbd, 0x8c, 0xe6, 0x79, 0x72, 0x60, 0xad, 0x6f, 0xbd,
+			0x8c, 0x71, 0x6e, 0xdd, 0x8a, 0x40, 0x79, 0x6e, 0xad, 0x86, 0x01, 0xbd, 0x72, 0xcd, 0x7e, 0x99,
+			0x68, 0x55, 0x69, 0xe6, 0x69, 0x63, 0xe8, 0x55, 0x65, 0xe6, 0x35, 0x6b, 0x00, 0xad, 0x6d, 0x99,
+			0x6a, 0xe2, 0x6e, 0xcd, 0x7d, 0xe6, 0x35, 0x8a, 0xe6, 0x6e, 0xe1,
+		}},
+		ActionSpeakerNotes: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x72, 0x69, 0x85, 0x60, 0xdd, 0x73, 0x69,
+			0x85, 0xa5, 0x74, 0xa5, 0x84, 0x40, 0x69, 0x75, 0xbd, 0x82, 0x60, 0x69, 0x75, 0x99, 0x81, 0xa5,
+			0x74, 0xd5, 0x80, 0x40, 0xbd, 0x72, 0x11, 0x80, 0x60, 0x99, 0x71, 0x11, 0x80, 0xd5, 0x70, 0xd5,
+			0x80, 0x40, 0x11, 0x70, 0xbd, 0x82, 0x60, 0x11, 0x70, 0xdd, 0x83, 0xd5, 0x70, 0xa5, 0x84, 0x40,
+			0xbd, 0x72, 0x69, 0x85, 0xe2, 0xbd, 0x72, 0x69, 0x7d, 0x60, 0xdd, 0x73, 0x69, 0x7d, 0xa5, 0x74,
+			0xa5, 0x7c, 0x40, 0x69, 0x75, 0xbd, 0x7a, 0x60, 0x69, 0x75, 0x99, 0x79, 0xa5, 0x74, 0xd5, 0x78,
+			0x40, 0xbd, 0x72, 0x11, 0x78, 0x60, 0x99, 0x71, 0x11, 0x78, 0xd5, 0x70, 0xd5, 0x78, 0x40, 0x11,
+			0x70, 0xbd, 0x7a, 0x60, 0x11, 0x70, 0xdd, 0x7b, 0xd5, 0x70, 0xa5, 0x7c, 0x40, 0xbd, 0x72, 0x69,
+			0x7d, 0xe2, 0xbd, 0x72, 0x69, 0x75, 0x60, 0xdd, 0x73, 0x69, 0x75, 0xa5, 0x74, 0xa5, 0x74, 0x40,
+			0x69, 0x75, 0xbd, 0x72, 0x60, 0x69, 0x75, 0x99, 0x71, 0xa5, 0x74, 0xd5, 0x70, 0x40, 0xbd, 0x72,
+			0x11, 0x70, 0x60, 0x99, 0x71, 0x11, 0x70, 0xd5, 0x70, 0xd5, 0x70, 0x40, 0x11, 0x70, 0xbd, 0x72,
+			0x60, 0x11, 0x70, 0xdd, 0x73, 0xd5, 0x70, 0xa5, 0x74, 0x40, 0xbd, 0x72, 0x69, 0x75, 0xe2, 0xad,
+			0x7a, 0xbd, 0x84, 0xe6, 0x90, 0xe8, 0xbd, 0x80, 0xe6, 0xad, 0x7a, 0xe8, 0xbd, 0x84, 0xe2, 0xad,
+			0x7a, 0xbd, 0x7c, 0xe6, 0x11, 0x90, 0xe8, 0xbd, 0x78, 0xe6, 0xad, 0x7a, 0xe8, 0xbd, 0x7c, 0xe2,
+			0xad, 0x7a, 0xbd, 0x74, 0xe6, 0x11, 0x90, 0xe8, 0xbd, 0x70, 0xe6, 0xad, 0x7a, 0xe8, 0xbd, 0x74,
+			0xe2, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89,
+			0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99,
+			0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99,
+			0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e,
+			0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e,
+			0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		ActionSpeakerNotesOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x72, 0x25, 0x85, 0x60, 0x11, 0x71, 0x25,
+			0x85, 0x4d, 0x70, 0x5d, 0x84, 0x40, 0x89, 0x6f, 0x79, 0x82, 0x60, 0x89, 0x6f, 0x55, 0x81, 0x4d,
+			0x70, 0x91, 0x80, 0x40, 0x35, 0x72, 0xcd, 0x7f, 0x60, 0x55, 0x73, 0xcd, 0x7f, 0x19, 0x74, 0x91,
+			0x80, 0x40, 0xdd, 0x74, 0x79, 0x82, 0x60, 0xdd, 0x74, 0x99, 0x83, 0x19, 0x74, 0x5d, 0x84, 0x40,
+			0x35, 0x72, 0x25, 0x85, 0xe2, 0x99, 0x96, 0xa0, 0x00, 0x99, 0x92, 0x98, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x69, 0xe6, 0xdd, 0x6f, 0x00, 0xdd, 0x6b, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98,
+			0x55, 0x65, 0x71, 0x99, 0x91, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a,
+			0x89, 0x8d, 0x81, 0x99, 0xc5, 0x8e, 0x40, 0x99, 0x96, 0xa0, 0xe2, 0x86, 0x79, 0x7c, 0x00, 0x7e,
+			0x79, 0x78, 0xe6, 0x69, 0x90, 0xe8, 0x79, 0x7c, 0xe6, 0x86, 0xe2, 0x55, 0x99, 0x25, 0x9e, 0x00,
+			0x35, 0x8b, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x11, 0x6a, 0x03, 0xbd, 0x63,
+			0x89, 0x68, 0x79, 0x66, 0x99, 0x65, 0x35, 0x9c, 0x55, 0x9b, 0x55, 0x99, 0x25, 0x9e, 0xe2, 0x45,
+			0x78, 0x7a, 0xe2, 0x45, 0x81, 0xad, 0x7a, 0xe2, 0x35, 0x72, 0x25, 0x7d, 0x60, 0x11, 0x71, 0x25,
+			0x7d, 0x4d, 0x70, 0x5d, 0x7c, 0x40, 0x89, 0x6f, 0x79, 0x7a, 0x60, 0x89, 0x6f, 0x55, 0x79, 0x4d,
+			0x70, 0x91, 0x78, 0x40, 0x35, 0x72, 0xcd, 0x77, 0x60, 0x55, 0x73, 0xcd, 0x77, 0x19, 0x74, 0x91,
+			0x78, 0x40, 0xdd, 0x74, 0x79, 0x7a, 0x60, 0xdd, 0x74, 0x99, 0x7b, 0x19, 0x74, 0x5d, 0x7c, 0x40,
+			0x35, 0x72, 0x25, 0x7d, 0xe2, 0x76, 0x79, 0x74, 0x00, 0x69, 0x79, 0xdd, 0x72, 0xe8, 0x79, 0x70,
+			0xe6, 0x69, 0x90, 0xe8, 0x79, 0x74, 0xe6, 0x76, 0xe2, 0x55, 0x69, 0x11, 0x6e, 0xe8, 0x55, 0x91,
+			0x00, 0x45, 0x6e, 0x98, 0xe6, 0x35, 0x87, 0x00, 0x55, 0x69, 0x11, 0x6e, 0xe1,
+		}},
+		ActionSpellcheck: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x85, 0xad, 0x9a, 0x05, 0xf1, 0x7a, 0x11,
+			0x90, 0xbd, 0x7d, 0x45, 0x8d, 0x89, 0x85, 0x11, 0x95, 0x89, 0x95, 0x11, 0x85, 0x55, 0x98, 0xdd,
+			0x87, 0x89, 0x85, 0xad, 0x9a, 0xe2, 0x50, 0x35, 0x8b, 0x00, 0x55, 0x75, 0x11, 0x68, 0xe6, 0xbd,
+			0x79, 0x00, 0x11, 0x87, 0x35, 0x8b, 0xe6, 0x99, 0x82, 0x00, 0x7e, 0xbd, 0x81, 0xe6, 0xdd, 0x6f,
+			0x00, 0x45, 0x6c, 0x35, 0x8b, 0xe6, 0x50, 0xe2, 0x25, 0x71, 0xdd, 0x7d, 0xe6, 0xbd, 0x7d, 0x00,
+			0x99, 0x77, 0xf1, 0x6c, 0xe6, 0x45, 0x77, 0x00, 0x25, 0x71, 0xdd, 0x7d, 0xe1,
+		}},
+		ActionStarRate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x76, 0xcd, 0x8f, 0x03, 0x80, 0xdd, 0x88,
+			0x25, 0x89, 0xcd, 0x8f, 0x79, 0x85, 0x45, 0x84, 0xdd, 0x8d, 0xcd, 0x7e, 0xe6, 0xcd, 0x83, 0x01,
+			0x80, 0x45, 0x73, 0x35, 0x7c, 0xcd, 0x7e, 0xe6, 0x25, 0x72, 0x01, 0x89, 0x7a, 0x45, 0x84, 0xdd,
+			0x76, 0xcd, 0x8f, 0xe2, 0x89, 0x6f, 0xad, 0x9a, 0x01, 0xbd, 0x75, 0x69, 0x86, 0x55, 0x65, 0xad,
+			0x7a, 0xe6, 0x99, 0x79, 0x01, 0x80, 0x55, 0x65, 0x69, 0x86, 0xad, 0x7a, 0xe6, 0xad, 0x9a, 0x03,
+			0x45, 0x8a, 0x69, 0x86, 0x79, 0x90, 0xad, 0x9a, 0x80, 0x25, 0x8e, 0x89, 0x6f, 0xad, 0x9a, 0xe2,
+			0x80, 0x89, 0x81, 0xe1,
+		}},
+		ActionStars: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0xa0, 0x03, 0x80, 0xdd, 0x87, 0xad,
+			0x8a, 0xa0, 0x69, 0x86, 0xdd, 0x82, 0x11, 0x91, 0x55, 0x7b, 0xe6, 0x11, 0x84, 0x01, 0x80, 0xcd,
+			0x6d, 0xdd, 0x7b, 0x55, 0x7b, 0xe6, 0xdd, 0x6e, 0x01, 0x89, 0x79, 0xdd, 0x82, 0x55, 0x75, 0xa0,
+			0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d,
+			0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60,
+			0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70,
+			0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69,
+			0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99,
+			0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd,
+			0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a,
+			0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96,
+			0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99,
+			0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89,
+			0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionStore: ActionStore,
+		ActionSubject: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0x69, 0x84, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x89, 0x87, 0xe8, 0x89, 0x83,
+			0xe6, 0x55, 0x95, 0xe8, 0x89, 0x87, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x79, 0x7c, 0xe8, 0x79,
+			0x78, 0xe6, 0x55, 0x95, 0xe8, 0x79, 0x7c, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x55, 0x71, 0xe8,
+			0x55, 0x6d, 0xe6, 0x55, 0x95, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x6a, 0xe1,
+		}, mirror: true},
+		ActionSupervisorAccount: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x8d, 0x99, 0x86, 0x67, 0xf9, 0x8a, 0x99,
+			0x86, 0x29, 0x89, 0xc9, 0x84, 0x55, 0x87, 0xf9, 0x82, 0x55, 0x87, 0x55, 0x80, 0x55, 0x87, 0xb5,
+			0x7d, 0x29, 0x89, 0xe5, 0x7b, 0xf9, 0x8a, 0x11, 0x7a, 0x99, 0x8d, 0x11, 0x7a, 0x3d, 0x90, 0x11,
+			0x7a, 0x0d, 0x92, 0xe5, 0x7b, 0xdd, 0x93, 0xb5, 0x7d, 0xdd, 0x93, 0x55, 0x80, 0xdd, 0x93, 0xf9,
+			0x82, 0x0d, 0x92, 0xc9, 0x84, 0x3d, 0x90, 0x99, 0x86, 0x99, 0x8d, 0x99, 0x86, 0xe2, 0x89, 0x80,
+			0x55, 0x95, 0xe8, 0xf1, 0x91, 0x60, 0x89, 0x80, 0x35, 0x90, 0x45, 0x81, 0xf9, 0x8e, 0x40, 0x55,
+			0x83, 0x11, 0x8d, 0x60, 0xcd, 0x85, 0xcd, 0x8b, 0x55, 0x88, 0x35, 0x8b, 0x40, 0x99, 0x8d, 0x99,
+			0x8a, 0x60, 0x55, 0x90, 0x99, 0x8a, 0xdd, 0x92, 0x2d, 0x8b, 0x40, 0xdd, 0x97, 0x11, 0x8d, 0x60,
+			0x35, 0x99, 0xad, 0x8d, 0xf1, 0x99, 0xf1, 0x8e, 0x40, 0xad, 0x9a, 0xf1, 0x91, 0xe8, 0x55, 0x95,
+			0xe6, 0x89, 0x80, 0xe2, 0xad, 0x7a, 0xf1, 0x7f, 0x60, 0x45, 0x76, 0xf1, 0x7f, 0x79, 0x73, 0x25,
+			0x7d, 0x40, 0xad, 0x70, 0xf1, 0x75, 0x60, 0xad, 0x70, 0x89, 0x71, 0x79, 0x73, 0xbd, 0x6e, 0x40,
+			0xad, 0x7a, 0xf1, 0x6b, 0x60, 0x11, 0x7f, 0xf1, 0x6b, 0xdd, 0x81, 0xbd, 0x6e, 0x40, 0xad, 0x84,
+			0xf1, 0x75, 0x60, 0xad, 0x84, 0x55, 0x7a, 0xdd, 0x81, 0x25, 0x7d, 0x40, 0xad, 0x7a, 0xf1, 0x7f,
+			0xe2, 0xad, 0x7a, 0xf1, 0x75, 0xe2, 0x55, 0x65, 0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60, 0x55, 0x65,
+			0xcd, 0x8c, 0x79, 0x66, 0xe9, 0x8a, 0x40, 0xd9, 0x69, 0x90, 0x60, 0x55, 0x6e, 0x8c, 0x71, 0x72,
+			0x8a, 0x40, 0xa9, 0x7a, 0x88, 0x60, 0xcd, 0x7c, 0x88, 0x79, 0x7e, 0x35, 0x84, 0x40, 0x11, 0x82,
+			0xcd, 0x84, 0x01, 0x5d, 0x80, 0x81, 0x86, 0xad, 0x7e, 0x35, 0x88, 0x60, 0xcd, 0x7d, 0x11, 0x88,
+			0xcd, 0x7c, 0x09, 0x88, 0x40, 0xad, 0x7a, 0x90, 0x62, 0xe5, 0x76, 0x90, 0x49, 0x73, 0xc5, 0x88,
+			0xad, 0x6f, 0x89, 0x89, 0x79, 0x6b, 0x99, 0x8b, 0x89, 0x6a, 0x11, 0x8c, 0xf1, 0x69, 0x11, 0x8d,
+			0x40, 0x55, 0x69, 0x11, 0x8f, 0xe8, 0x55, 0x91, 0xe6, 0x89, 0x7c, 0xe8, 0x55, 0x95, 0xe6, 0x55,
+			0x65, 0xe2, 0x89, 0x7c, 0x55, 0x91, 0xe2, 0xad, 0x7a, 0xf1, 0x7b, 0x60, 0x45, 0x7d, 0xf1, 0x7b,
+			0xf9, 0x7e, 0x3d, 0x7a, 0x40, 0xad, 0x80, 0xf1, 0x75, 0x60, 0xad, 0x80, 0x55, 0x73, 0xf9, 0x7e,
+			0xa5, 0x71, 0x40, 0xad, 0x7a, 0xf1, 0x6f, 0x60, 0x11, 0x78, 0xf1, 0x6f, 0x5d, 0x76, 0xa5, 0x71,
+			0x40, 0xad, 0x74, 0xf1, 0x75, 0x60, 0xad, 0x74, 0x89, 0x78, 0x5d, 0x76, 0x3d, 0x7a, 0x40, 0xad,
+			0x7a, 0xf1, 0x7b, 0xe1,
+		}},
+		ActionSwapHoriz: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x72, 0x55, 0x95, 0x03, 0x55, 0x65, 0x79,
+			0x88, 0x35, 0x72, 0x99, 0x7b, 0x6a, 0x69, 0x7e, 0xf1, 0x6c, 0x79, 0x86, 0xe6, 0x84, 0xe8, 0x79,
+			0x8a, 0xe6, 0xf1, 0x6c, 0x01, 0x6a, 0x89, 0x92, 0x35, 0x72, 0x55, 0x95, 0xe2, 0xcd, 0x8d, 0x69,
+			0x84, 0x01, 0x96, 0x99, 0x81, 0x11, 0x93, 0x89, 0x79, 0xe6, 0x7c, 0xe8, 0x89, 0x75, 0xe6, 0x11,
+			0x93, 0x03, 0x96, 0x79, 0x6d, 0xcd, 0x8d, 0xad, 0x6a, 0xad, 0x9a, 0x89, 0x77, 0xcd, 0x8d, 0x69,
+			0x84, 0xe1,
+		}},
+		ActionSwapVert: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x75, 0x84, 0xe8, 0xf1, 0x6c, 0x05, 0x79,
+			0x6d, 0x6a, 0xad, 0x6a, 0x35, 0x72, 0x89, 0x77, 0x55, 0x65, 0x69, 0x84, 0x35, 0x72, 0x99, 0x81,
+			0x6a, 0x89, 0x79, 0xf1, 0x6c, 0xe8, 0x84, 0xe6, 0x89, 0x75, 0xe2, 0x79, 0x88, 0xad, 0x9a, 0x02,
+			0x99, 0x7b, 0xcd, 0x8d, 0x69, 0x7e, 0x96, 0x79, 0x86, 0x11, 0x93, 0xe8, 0x7c, 0xe6, 0x79, 0x8a,
+			0xe8, 0x11, 0x93, 0x02, 0x89, 0x92, 0x96, 0x55, 0x95, 0xcd, 0x8d, 0x79, 0x88, 0xad, 0x9a, 0xe1,
+		}},
+		ActionSwapVerticalCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x85, 0xbd, 0x91, 0x02, 0xa0, 0x55, 0x87,
+			0x25, 0x8d, 0x89, 0x84, 0x99, 0x87, 0x11, 0x8a, 0xe8, 0x55, 0x7d, 0xe6, 0x99, 0x83, 0xe8, 0x11,
+			0x8a, 0x02, 0x35, 0x7e, 0x99, 0x84, 0x55, 0x7b, 0x79, 0x87, 0x99, 0x85, 0xbd, 0x91, 0xe2, 0x89,
+			0x78, 0xad, 0x82, 0xe6, 0x89, 0x7c, 0xe8, 0xf1, 0x75, 0x05, 0x84, 0x55, 0x7b, 0xcd, 0x84, 0x89,
+			0x78, 0x89, 0x7a, 0x45, 0x6e, 0x25, 0x70, 0xad, 0x78, 0x66, 0x79, 0x7b, 0x89, 0x78, 0xf1, 0x75,
+			0xe8, 0xad, 0x82, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98,
+			0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55,
+			0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d,
+			0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85,
+			0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70,
+			0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55,
+			0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90,
+			0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55,
+			0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55,
+			0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ActionSystemUpdateAlt: ActionSystemUpdateAlt,
+		ActionTOC: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0xad,
+			0x8c, 0xe8, 0x55, 0x8d, 0xe6, 0x50, 0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0xad, 0x8c, 0xe8, 0x84,
+			0xe6, 0x50, 0xe2, 0x50, 0xad, 0x76, 0xe8, 0xad, 0x72, 0xe6, 0xad, 0x8c, 0xe8, 0xad, 0x76, 0xe6,
+			0x50, 0xe2, 0xfd, 0x95, 0x55, 0x8d, 0x67, 0x25, 0x95, 0x55, 0x8d, 0x91, 0x94, 0xc1, 0x8c, 0xa8,
+			0x2d, 0x8c, 0xa8, 0x55, 0x8b, 0xa8, 0x79, 0x8a, 0x95, 0x94, 0xe9, 0x89, 0x29, 0x95, 0x55, 0x89,
+			0x05, 0x96, 0x55, 0x89, 0xdd, 0x96, 0x55, 0x89, 0x71, 0x97, 0xe9, 0x89, 0xb0, 0x7d, 0x8a, 0xb0,
+			0x59, 0x8b, 0xb0, 0x35, 0x8c, 0x6d, 0x97, 0xc5, 0x8c, 0xd9, 0x96, 0x55, 0x8d, 0xfd, 0x95, 0x55,
+			0x8d, 0xe2, 0xfd, 0x95, 0x84, 0x67, 0x25, 0x95, 0x84, 0x91, 0x94, 0x6d, 0x81, 0xa8, 0xd9, 0x80,
+			0xa8, 0xfd, 0x7f, 0xa8, 0x25, 0x7f, 0x95, 0x94, 0x91, 0x7e, 0x29, 0x95, 0x7c, 0x05, 0x96, 0x7c,
+			0xdd, 0x96, 0x7c, 0x71, 0x97, 0x95, 0x7e, 0xb0, 0x29, 0x7f, 0xb0, 0x05, 0x80, 0xb0, 0xdd, 0x80,
+			0x6d, 0x97, 0x71, 0x81, 0xd9, 0x96, 0x84, 0xfd, 0x95, 0x84, 0xe2, 0xfd, 0x95, 0xad, 0x76, 0x67,
+			0x25, 0x95, 0xad, 0x76, 0x91, 0x94, 0x19, 0x76, 0xa8, 0x85, 0x75, 0xa8, 0xa9, 0x74, 0xa8, 0xcd,
+			0x73, 0x95, 0x94, 0x3d, 0x73, 0x29, 0x95, 0xad, 0x72, 0x05, 0x96, 0xad, 0x72, 0xdd, 0x96, 0xad,
+			0x72, 0x71, 0x97, 0x41, 0x73, 0xb0, 0xd5, 0x73, 0xb0, 0xad, 0x74, 0xb0, 0x89, 0x75, 0x6d, 0x97,
+			0x19, 0x76, 0xd9, 0x96, 0xad, 0x76, 0xfd, 0x95, 0xad, 0x76, 0xe1,
+		}, mirror: true},
+		ActionTab: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8,
+			0x35, 0x7a, 0xe6, 0xf1, 0x82, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55,
+			0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55,
+			0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69,
+			0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad,
+			0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40,
+			0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8,
+			0x55, 0x91, 0xe1,
+		}},
+		ActionTabUnselected: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x95,
+			0xe2, 0x55, 0x65, 0x69, 0x8b, 0xe8, 0xbd, 0x85, 0xe6, 0x55, 0x69, 0xe8, 0x69, 0x8b, 0xe6, 0x55,
+			0x65, 0xe2, 0x55, 0x65, 0xdd, 0x7f, 0xe8, 0x35, 0x7a, 0xe6, 0x55, 0x69, 0xe8, 0xdd, 0x7f, 0xe6,
+			0x55, 0x65, 0xe2, 0x55, 0x65, 0x55, 0x74, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89,
+			0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xdd, 0x6e, 0xe8, 0xad, 0x6e, 0xe6, 0x55,
+			0x69, 0xe8, 0x55, 0x74, 0xe6, 0x55, 0x65, 0xe2, 0x35, 0x6f, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x74, 0xe8, 0x55, 0x95, 0xe6, 0x35, 0x6f, 0xe2, 0xad, 0x74, 0xad, 0x6e, 0xe8, 0xad, 0x6a,
+			0xe6, 0x35, 0x7a, 0xe8, 0xad, 0x6e, 0xe6, 0xad, 0x74, 0xe2, 0x89, 0x7a, 0x55, 0x95, 0xe8, 0x55,
+			0x91, 0xe6, 0x80, 0xe8, 0x55, 0x95, 0xe6, 0x89, 0x7a, 0xe2, 0x80, 0x45, 0x7a, 0xe8, 0xad, 0x6a,
+			0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad,
+			0x6e, 0xe8, 0x45, 0x7a, 0xe6, 0x80, 0xe2, 0xdd, 0x85, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0x55,
+			0x8b, 0xe8, 0x55, 0x95, 0xe6, 0xdd, 0x85, 0xe2, 0x35, 0x91, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x96, 0xe8, 0xad, 0x8b, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92,
+			0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x35, 0x91, 0xe2, 0xad, 0x96, 0xcd,
+			0x85, 0xe8, 0x25, 0x80, 0xe6, 0xad, 0x9a, 0xe8, 0xcd, 0x85, 0xe6, 0xad, 0x96, 0xe1,
+		}},
+		ActionTheaters: ActionTheaters,
+		ActionThumbDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x70, 0x50, 0xe6, 0xbd, 0x8d, 0xe8, 0x25,
+			0x8a, 0x01, 0x35, 0x7b, 0x55, 0x9d, 0x99, 0x78, 0x45, 0x9b, 0x60, 0x35, 0x78, 0xf1, 0x9a, 0x70,
+			0x55, 0x9a, 0x40, 0xcd, 0x77, 0xdd, 0x98, 0xe8, 0x35, 0x98, 0x00, 0xcd, 0x7a, 0x25, 0x8a, 0xe6,
+			0xdd, 0x66, 0x60, 0x45, 0x65, 0x25, 0x8a, 0x11, 0x64, 0xf1, 0x88, 0x40, 0xdd, 0x62, 0x25, 0x86,
+			0xe8, 0xad, 0x80, 0x60, 0xdd, 0x62, 0x35, 0x80, 0xc5, 0x62, 0xb5, 0x7f, 0x40, 0xdd, 0x62, 0xbd,
+			0x7e, 0x00, 0x45, 0x6b, 0x69, 0x6b, 0x61, 0xdd, 0x6b, 0xfd, 0x69, 0x3d, 0x6d, 0xfd, 0x68, 0xa1,
+			0x6e, 0x50, 0x25, 0x70, 0x50, 0xe2, 0xbd, 0x89, 0x58, 0xe6, 0x45, 0x6f, 0x00, 0xdd, 0x66, 0xf1,
+			0x7f, 0xe8, 0x25, 0x86, 0xe6, 0xbd, 0x7f, 0x01, 0x35, 0x7c, 0xbd, 0x96, 0xbd, 0x89, 0x79, 0x88,
+			0xe8, 0x58, 0xe2, 0xbd, 0x89, 0x79, 0x88, 0xe8, 0x58, 0xe8, 0x79, 0x88, 0xe2, 0xbd, 0x8d, 0x25,
+			0x8a, 0xe8, 0x25, 0x86, 0xe6, 0x99, 0x96, 0xe8, 0x58, 0xe6, 0xbd, 0x8d, 0xe8, 0x50, 0xe6, 0x99,
+			0x9a, 0xe8, 0x25, 0x8a, 0xe6, 0xbd, 0x8d, 0xe1,
+		}},
+		ActionThumbUp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x8f, 0xb0, 0xe6, 0x25, 0x72, 0xe8, 0xdd,
+			0x75, 0x01, 0xad, 0x84, 0xad, 0x62, 0x45, 0x87, 0xbd, 0x64, 0x60, 0xad, 0x87, 0x11, 0x65, 0xdd,
+			0x87, 0xad, 0x65, 0x40, 0x11, 0x88, 0x25, 0x67, 0xe8, 0xcd, 0x67, 0x00, 0x11, 0x85, 0xdd, 0x75,
+			0xe6, 0xb2, 0x60, 0x99, 0x9a, 0xdd, 0x75, 0xcd, 0x9b, 0x11, 0x77, 0x40, 0xba, 0xdd, 0x79, 0xe8,
+			0x55, 0x7f, 0x60, 0xba, 0xcd, 0x7f, 0x19, 0x9d, 0x4d, 0x80, 0x40, 0xba, 0x45, 0x81, 0x00, 0x99,
+			0x94, 0x99, 0x94, 0x61, 0x05, 0x94, 0x05, 0x96, 0xa1, 0x92, 0x05, 0x97, 0x41, 0x91, 0xb0, 0xbd,
+			0x8f, 0xb0, 0xe2, 0x25, 0x76, 0xa8, 0xe6, 0x99, 0x90, 0x00, 0xb2, 0x11, 0x80, 0xe8, 0xdd, 0x79,
+			0xe6, 0x25, 0x80, 0x01, 0xad, 0x83, 0x45, 0x69, 0x25, 0x76, 0x89, 0x77, 0xe8, 0xa8, 0xe2, 0x25,
+			0x76, 0x89, 0x77, 0xe8, 0xa8, 0xe8, 0x89, 0x77, 0xe2, 0x25, 0x72, 0xdd, 0x75, 0xe8, 0xdd, 0x79,
+			0xe6, 0x45, 0x69, 0xe8, 0xa8, 0xe6, 0x25, 0x72, 0xe8, 0xb0, 0xe6, 0x45, 0x65, 0xe8, 0xdd, 0x75,
+			0xe6, 0x25, 0x72, 0xe1,
+		}},
+		ActionThumbsUpDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x48, 0x55, 0x85, 0x60, 0x69, 0x62, 0x55, 0x85,
+			0x35, 0x61, 0x25, 0x84, 0x40, 0x40, 0x55, 0x81, 0xe8, 0x55, 0x6f, 0x60, 0x40, 0xad, 0x6e, 0x35,
+			0x60, 0x25, 0x6e, 0x40, 0xf1, 0x60, 0x5a, 0x01, 0xf1, 0x6d, 0x40, 0x99, 0x6f, 0xd5, 0x61, 0x60,
+			0xf1, 0x6f, 0x35, 0x62, 0x25, 0x70, 0x91, 0x62, 0x40, 0x55, 0x70, 0x69, 0x63, 0xe8, 0xf1, 0x63,
+			0x00, 0x45, 0x6e, 0x55, 0x6d, 0xe6, 0xad, 0x7c, 0x60, 0x15, 0x7e, 0x55, 0x6d, 0x0d, 0x7f, 0x4d,
+			0x6e, 0x40, 0x80, 0xad, 0x70, 0xe8, 0x45, 0x73, 0x60, 0x80, 0xbd, 0x73, 0xf1, 0x7f, 0x19, 0x74,
+			0x40, 0xcd, 0x7f, 0x99, 0x74, 0x00, 0x99, 0x79, 0x45, 0x83, 0x61, 0x31, 0x79, 0x41, 0x84, 0x4d,
+			0x78, 0xc9, 0x84, 0x69, 0x77, 0x55, 0x85, 0x6c, 0x55, 0x85, 0xe6, 0x48, 0xe2, 0x6c, 0x55, 0x81,
+			0x00, 0x78, 0x69, 0x73, 0xe8, 0x55, 0x71, 0xe6, 0x45, 0x69, 0x01, 0x25, 0x6b, 0x79, 0x68, 0x48,
+			0xad, 0x6f, 0xe8, 0x55, 0x81, 0xe6, 0x6c, 0xe2, 0x11, 0x92, 0xc0, 0x00, 0x69, 0x90, 0x2d, 0x9e,
+			0x60, 0x11, 0x90, 0xcd, 0x9d, 0xdd, 0x8f, 0x71, 0x9d, 0x40, 0xad, 0x8f, 0x99, 0x9c, 0xe8, 0x11,
+			0x9c, 0x00, 0xbd, 0x91, 0xad, 0x92, 0xe6, 0x55, 0x83, 0x60, 0xed, 0x81, 0xad, 0x92, 0xf5, 0x80,
+			0xb5, 0x91, 0x40, 0x80, 0x55, 0x8f, 0xe8, 0xbd, 0x8c, 0x60, 0x80, 0x45, 0x8c, 0x11, 0x80, 0xe9,
+			0x8b, 0x40, 0x35, 0x80, 0x69, 0x8b, 0x00, 0x69, 0x86, 0xbd, 0x7c, 0x60, 0xcd, 0x86, 0xbd, 0x7b,
+			0xb5, 0x87, 0x35, 0x7b, 0x40, 0x94, 0xad, 0x7a, 0xe6, 0xb8, 0x60, 0x99, 0x9d, 0xad, 0x7a, 0xcd,
+			0x9e, 0xdd, 0x7b, 0x40, 0xc0, 0xad, 0x7e, 0xe8, 0xad, 0x90, 0x60, 0xc0, 0x55, 0x91, 0xcd, 0x9f,
+			0xdd, 0x91, 0x40, 0x11, 0x9f, 0xa6, 0x00, 0x11, 0x92, 0xc0, 0xe2, 0x94, 0xad, 0x7e, 0x00, 0x88,
+			0x99, 0x8c, 0xe8, 0xad, 0x8e, 0xe6, 0xbd, 0x96, 0x01, 0xdd, 0x94, 0x89, 0x97, 0xb8, 0x55, 0x90,
+			0xe8, 0xad, 0x7e, 0xe6, 0x94, 0xe2, 0x48, 0x89, 0x80, 0xe8, 0xad, 0x6f, 0xe8, 0x89, 0x80, 0xe2,
+			0xb8, 0x79, 0x7f, 0xe8, 0x55, 0x90, 0xe8, 0x79, 0x7f, 0xe1,
+		}},
+		ActionTimeline: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x67, 0xa0, 0x62, 0x69, 0x65, 0xa0, 0x09,
+			0x64, 0xa1, 0x8e, 0xad, 0x62, 0x41, 0x8d, 0xad, 0x62, 0x55, 0x8b, 0xad, 0x62, 0x69, 0x89, 0x09,
+			0x64, 0x09, 0x88, 0x40, 0x51, 0x67, 0xad, 0x86, 0x61, 0xad, 0x67, 0xad, 0x86, 0x50, 0xb5, 0x86,
+			0x55, 0x68, 0xbd, 0x86, 0xdd, 0x68, 0xdd, 0x86, 0x00, 0x35, 0x76, 0x89, 0x79, 0x60, 0x11, 0x76,
+			0x72, 0x09, 0x76, 0xad, 0x78, 0x40, 0x6c, 0xfd, 0x77, 0x62, 0x6c, 0x11, 0x76, 0x61, 0x77, 0xb5,
+			0x74, 0xc1, 0x78, 0x55, 0x73, 0xad, 0x7a, 0x55, 0x73, 0x99, 0x7c, 0x55, 0x73, 0xf9, 0x7d, 0xb5,
+			0x74, 0x40, 0x55, 0x7f, 0x05, 0x78, 0x60, 0x55, 0x7f, 0x25, 0x78, 0x25, 0x7f, 0x89, 0x79, 0x00,
+			0x79, 0x86, 0xdd, 0x80, 0x60, 0x8e, 0xbd, 0x80, 0x55, 0x87, 0xb5, 0x80, 0x40, 0x90, 0xad, 0x80,
+			0x60, 0x55, 0x88, 0xad, 0x80, 0xad, 0x88, 0xb5, 0x80, 0x40, 0x89, 0x89, 0xdd, 0x80, 0x00, 0x35,
+			0x94, 0x35, 0x76, 0x60, 0x11, 0x94, 0xad, 0x75, 0x09, 0x94, 0x55, 0x75, 0x40, 0xa8, 0xa9, 0x74,
+			0x64, 0xa8, 0xbd, 0x72, 0x61, 0x95, 0x5d, 0x71, 0xc1, 0x96, 0x60, 0xad, 0x98, 0x60, 0x99, 0x9a,
+			0x60, 0xf9, 0x9b, 0x61, 0x71, 0x55, 0x9d, 0xc1, 0x72, 0x55, 0x9d, 0xad, 0x74, 0x55, 0x9d, 0x99,
+			0x76, 0xf9, 0x9b, 0xf9, 0x77, 0x40, 0xb1, 0x98, 0x55, 0x79, 0x61, 0x55, 0x98, 0x55, 0x79, 0xb0,
+			0x4d, 0x79, 0xad, 0x97, 0x45, 0x79, 0x25, 0x97, 0x25, 0x79, 0x00, 0x79, 0x8c, 0xcd, 0x83, 0x60,
+			0x99, 0x8c, 0x55, 0x84, 0xa5, 0x8c, 0xad, 0x84, 0x40, 0xad, 0x8c, 0x59, 0x85, 0x62, 0xad, 0x8c,
+			0x45, 0x87, 0x4d, 0x8b, 0xa5, 0x88, 0xed, 0x89, 0x94, 0x90, 0x94, 0x11, 0x86, 0x94, 0xb5, 0x84,
+			0xa5, 0x88, 0x40, 0x55, 0x83, 0x59, 0x85, 0x61, 0x55, 0x83, 0x8a, 0x5d, 0x83, 0xad, 0x84, 0x69,
+			0x83, 0x55, 0x84, 0x89, 0x83, 0xcd, 0x83, 0x00, 0x35, 0x7c, 0x79, 0x7c, 0x60, 0xad, 0x7b, 0x99,
+			0x7c, 0x55, 0x7b, 0xa5, 0x7c, 0x40, 0xa9, 0x7a, 0xad, 0x7c, 0x60, 0x89, 0x7a, 0xad, 0x7c, 0x25,
+			0x79, 0x79, 0x7c, 0x00, 0xcd, 0x6b, 0xcd, 0x89, 0x60, 0xf1, 0x6b, 0x55, 0x8a, 0xf9, 0x6b, 0xad,
+			0x8a, 0x40, 0x58, 0x59, 0x8b, 0x61, 0x58, 0x45, 0x8d, 0xa1, 0x6a, 0xa5, 0x8e, 0x41, 0x69, 0xa0,
+			0x55, 0x67, 0xa0, 0xe1,
+		}},
+		ActionToday: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x85, 0x77, 0x55, 0x8b, 0x61, 0xcd, 0x74, 0x55,
+			0x8b, 0xf1, 0x72, 0x75, 0x89, 0x11, 0x71, 0x95, 0x87, 0x11, 0x71, 0xdd, 0x84, 0x40, 0xf5, 0x72,
+			0x45, 0x80, 0x60, 0xd5, 0x74, 0x69, 0x7e, 0x8d, 0x77, 0x69, 0x7e, 0x40, 0x25, 0x7c, 0x49, 0x80,
+			0x60, 0x7c, 0x29, 0x82, 0x7c, 0xe1, 0x84, 0x40, 0x21, 0x7c, 0x79, 0x89, 0x60, 0x41, 0x7a, 0x55,
+			0x8b, 0x85, 0x77, 0x55, 0x8b, 0xe2, 0x58, 0xad, 0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a, 0x35, 0x69,
+			0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35, 0x69, 0x89,
+			0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74, 0xe8, 0x55,
+			0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6, 0xa8, 0x60,
+			0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a, 0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad, 0x96, 0x60,
+			0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40, 0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2, 0x58, 0xad,
+			0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0x58, 0x6c, 0xe6, 0xa8, 0xe8,
+			0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2, 0x58, 0x6c, 0xe8, 0x55, 0x6d, 0xe8, 0x6c, 0xe1,
+		}},
+		ActionToll: ActionToll,
+		ActionTouchApp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7d, 0xad, 0x9a, 0x60, 0xbd, 0x7b, 0xad,
+			0x9a, 0xf1, 0x79, 0xdd, 0x99, 0x40, 0xf1, 0x76, 0x89, 0x97, 0x01, 0x79, 0x68, 0x25, 0x85, 0xdd,
+			0x69, 0x99, 0x83, 0x60, 0xcd, 0x6a, 0x99, 0x82, 0x2d, 0x6c, 0x5d, 0x82, 0x40, 0xbd, 0x6e, 0xcd,
+			0x82, 0x00, 0x55, 0x75, 0x55, 0x86, 0xe8, 0x5c, 0x62, 0x55, 0x75, 0x29, 0x6d, 0xe9, 0x75, 0x95,
+			0x6c, 0x7d, 0x76, 0x58, 0x59, 0x77, 0x58, 0x35, 0x78, 0x58, 0xc5, 0x78, 0x95, 0x6c, 0x40, 0x55,
+			0x79, 0x5c, 0xe8, 0x9a, 0x01, 0xbd, 0x6f, 0xdd, 0x87, 0x25, 0x7a, 0x11, 0x95, 0x61, 0xcd, 0x7a,
+			0xdd, 0x95, 0xb9, 0x7b, 0x45, 0x96, 0xa5, 0x7c, 0xad, 0x96, 0xad, 0x7d, 0xad, 0x96, 0xe6, 0x55,
+			0x8b, 0x60, 0xdd, 0x8d, 0xad, 0x96, 0x99, 0x8f, 0xf1, 0x94, 0x40, 0x55, 0x91, 0xad, 0x90, 0xe8,
+			0x55, 0x85, 0x60, 0x55, 0x91, 0xa5, 0x83, 0x31, 0x90, 0x7d, 0x82, 0x40, 0x55, 0x8d, 0x55, 0x81,
+			0xe6, 0xad, 0x7e, 0xe8, 0x55, 0x7d, 0xe6, 0x51, 0x8d, 0x60, 0xa9, 0x90, 0x55, 0x7d, 0xa6, 0xad,
+			0x7f, 0x40, 0x55, 0x95, 0x55, 0x85, 0xe8, 0xad, 0x90, 0x60, 0x55, 0x95, 0xdd, 0x94, 0x71, 0x92,
+			0xc5, 0x97, 0x40, 0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x7d, 0xe2, 0x89, 0x6d, 0xad, 0x73, 0x62,
+			0xcd, 0x6c, 0x6d, 0x72, 0x65, 0x6c, 0xf9, 0x70, 0x58, 0x85, 0x6f, 0x58, 0xfd, 0x6d, 0x58, 0x45,
+			0x69, 0x51, 0x6f, 0xf9, 0x65, 0x40, 0x55, 0x77, 0xad, 0x62, 0x63, 0x09, 0x7c, 0xad, 0x62, 0x5d,
+			0x7f, 0xfd, 0x65, 0xad, 0x82, 0x4d, 0x69, 0xad, 0x82, 0x5c, 0xad, 0x82, 0x89, 0x6f, 0x45, 0x82,
+			0xfd, 0x70, 0xe1, 0x81, 0x6d, 0x72, 0x25, 0x81, 0xad, 0x73, 0x00, 0xad, 0x7d, 0xad, 0x71, 0x60,
+			0x25, 0x7e, 0xdd, 0x70, 0x69, 0x7e, 0xf1, 0x6f, 0x40, 0xad, 0x7e, 0xf9, 0x6d, 0x64, 0xad, 0x7e,
+			0xf1, 0x6a, 0x89, 0x7c, 0xcd, 0x68, 0x65, 0x7a, 0xad, 0x66, 0x55, 0x77, 0xad, 0x66, 0x45, 0x74,
+			0xad, 0x66, 0x25, 0x72, 0xd1, 0x68, 0x60, 0xf1, 0x6a, 0x60, 0x5c, 0x60, 0x5e, 0x45, 0x70, 0xf1,
+			0x6f, 0x40, 0x62, 0xad, 0x71, 0x00, 0x89, 0x6d, 0xad, 0x73, 0xe2, 0x89, 0x80, 0x94, 0xe1,
+		}},
+		ActionTrackChanges: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a,
+			0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67,
+			0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0xe6, 0x84, 0xe8, 0x11, 0x7b, 0x60, 0x79, 0x83, 0xad, 0x7b, 0x69, 0x84, 0x7a, 0x40, 0x55,
+			0x85, 0x80, 0x60, 0x55, 0x85, 0x35, 0x82, 0xc5, 0x83, 0xc5, 0x83, 0x40, 0x80, 0x55, 0x85, 0x60,
+			0xcd, 0x7d, 0x55, 0x85, 0x3d, 0x7c, 0xc5, 0x83, 0x40, 0xad, 0x7a, 0x80, 0x60, 0xad, 0x7a, 0x55,
+			0x7e, 0x99, 0x7b, 0x7a, 0x40, 0x7c, 0x11, 0x7b, 0xe8, 0x25, 0x74, 0x60, 0xad, 0x79, 0xdd, 0x74,
+			0xd5, 0x76, 0x2d, 0x78, 0x40, 0x68, 0x80, 0x60, 0x68, 0x8a, 0x81, 0x77, 0x81, 0x88, 0x40, 0x80,
+			0x98, 0x60, 0x8a, 0x98, 0x81, 0x88, 0x81, 0x88, 0x40, 0x98, 0x80, 0x60, 0x98, 0x45, 0x7d, 0xf1,
+			0x8a, 0x76, 0x40, 0x90, 0x11, 0x77, 0x00, 0xdd, 0x8a, 0x35, 0x74, 0x60, 0x35, 0x8d, 0x69, 0x76,
+			0x99, 0x8e, 0x71, 0x79, 0x40, 0xa0, 0x80, 0x60, 0xa0, 0xad, 0x86, 0x55, 0x8b, 0x55, 0x8b, 0x40,
+			0x80, 0xa0, 0x60, 0x55, 0x79, 0xa0, 0xad, 0x74, 0x55, 0x8b, 0x40, 0x60, 0x80, 0x60, 0x60, 0xcd,
+			0x79, 0x68, 0x4d, 0x75, 0x40, 0x7c, 0x11, 0x70, 0xe8, 0x69, 0x69, 0x60, 0x45, 0x75, 0x25, 0x6a,
+			0x4d, 0x6f, 0x99, 0x70, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x79, 0x89, 0xf1, 0x6f, 0x11,
+			0x90, 0x40, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x11, 0x90, 0x40, 0xad,
+			0x96, 0x80, 0x60, 0xad, 0x96, 0x11, 0x7b, 0xc5, 0x94, 0xdd, 0x76, 0x40, 0x89, 0x8f, 0x89, 0x6f,
+			0x00, 0x69, 0x92, 0xad, 0x6c, 0x60, 0x35, 0x96, 0x55, 0x70, 0x71, 0x98, 0x4d, 0x75, 0x40, 0xad,
+			0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92,
+			0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		ActionTranslate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7f, 0xad, 0x9a, 0x00, 0xbd, 0x8b, 0xad,
+			0x7a, 0xe6, 0x35, 0x91, 0x00, 0x99, 0x9d, 0xad, 0x9a, 0xe6, 0xcd, 0x97, 0x00, 0x11, 0x95, 0x45,
+			0x92, 0xe6, 0x45, 0x88, 0x00, 0x25, 0x85, 0xad, 0x9a, 0xe6, 0xad, 0x7f, 0xe2, 0xbd, 0x89, 0x99,
+			0x8d, 0xe6, 0x35, 0x93, 0x00, 0x89, 0x8e, 0xad, 0x80, 0xe6, 0x69, 0x8e, 0x00, 0xbd, 0x89, 0x99,
+			0x8d, 0xe2, 0xad, 0x6a, 0xad, 0x92, 0x01, 0x4e, 0x9e, 0x99, 0x74, 0x69, 0x81, 0x60, 0x11, 0x72,
+			0x79, 0x7e, 0x19, 0x70, 0x81, 0x7b, 0x40, 0xad, 0x6c, 0x55, 0x75, 0xe6, 0x79, 0x72, 0x60, 0x99,
+			0x73, 0x89, 0x77, 0xf9, 0x74, 0x81, 0x79, 0x40, 0x11, 0x78, 0x89, 0x7d, 0x60, 0x11, 0x7b, 0x69,
+			0x7a, 0x11, 0x7d, 0x09, 0x77, 0x40, 0x79, 0x80, 0x60, 0xe6, 0xad, 0x62, 0xe8, 0xad, 0x6a, 0xe6,
+			0x55, 0x75, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x6a, 0xe6, 0x55, 0x8d, 0xe8, 0x60,
+			0xe6, 0xcd, 0x85, 0x60, 0x55, 0x84, 0x99, 0x74, 0xe9, 0x81, 0x09, 0x79, 0x40, 0xf1, 0x7b, 0x79,
+			0x81, 0x03, 0x79, 0x82, 0x11, 0x88, 0x79, 0x80, 0x79, 0x8d, 0x70, 0x55, 0x85, 0xad, 0x6a, 0xad,
+			0x92, 0xe1,
+		}},
+		ActionTrendingDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x8b, 0xa0, 0xe8, 0x98, 0xe6, 0xa8, 0x06,
+			0xf1, 0x83, 0xf1, 0x7b, 0xcd, 0x78, 0x11, 0x87, 0x55, 0x65, 0xad, 0x73, 0x35, 0x68, 0xcd, 0x70,
+			0xbd, 0x78, 0x55, 0x81, 0xdd, 0x83, 0x35, 0x76, 0xbd, 0x96, 0x11, 0x89, 0xe8, 0xdd, 0x80, 0xe6,
+			0xad, 0x9a, 0xe8, 0xa0, 0xe6, 0x89, 0x8b, 0xe1,
+		}, mirror: true},
+		ActionTrendingFlat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x8e, 0xf1, 0x8b, 0x01, 0xf1, 0x8b, 0x25,
+			0x89, 0xa6, 0x11, 0x82, 0xe6, 0x50, 0xe8, 0x11, 0x7e, 0xe6, 0x11, 0x93, 0x03, 0x98, 0x6e, 0xcd,
+			0x8e, 0x35, 0x74, 0xad, 0x9a, 0x11, 0x80, 0xcd, 0x8e, 0xf1, 0x8b, 0xe1,
+		}, mirror: true},
+		ActionTrendingUp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x68, 0xa0, 0x03, 0x55, 0x65, 0x25, 0x8d,
+			0xcd, 0x78, 0xbd, 0x79, 0xf1, 0x83, 0xdd, 0x84, 0xa8, 0xcd, 0x74, 0xe6, 0x89, 0x8b, 0xe8, 0xcd,
+			0x70, 0xe6, 0xad, 0x9a, 0xe8, 0xf1, 0x7f, 0xe6, 0xbd, 0x96, 0xe8, 0xbd, 0x77, 0x02, 0xdd, 0x83,
+			0x99, 0x8a, 0xbd, 0x78, 0x79, 0x7f, 0x35, 0x68, 0xa0, 0xe1,
+		}, mirror: true},
+		ActionTurnedIn:    ActionTurnedIn,
+		ActionTurnedInNot: ActionTurnedInNot,
+		ActionUpdate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x80, 0xb0, 0x60, 0x35, 0x7b, 0xb0, 0xcd,
+			0x76, 0x19, 0x96, 0x40, 0x19, 0x6f, 0xf1, 0x90, 0x60, 0xcd, 0x6b, 0xad, 0x8d, 0xe9, 0x69, 0x45,
+			0x89, 0x40, 0x50, 0xdd, 0x7f, 0x60, 0x50, 0xdd, 0x7a, 0xe9, 0x69, 0x89, 0x76, 0x40, 0x19, 0x6f,
+			0xf9, 0x6e, 0x60, 0x69, 0x72, 0xbd, 0x6b, 0xcd, 0x76, 0xdd, 0x69, 0x40, 0x35, 0x80, 0x50, 0x60,
+			0x89, 0x85, 0x50, 0x4d, 0x8a, 0x55, 0x6a, 0x40, 0x89, 0x92, 0xbd, 0x70, 0xe8, 0xad, 0x69, 0xe6,
+			0x89, 0x96, 0xe8, 0x89, 0x77, 0xe6, 0x99, 0x88, 0xe8, 0x89, 0x73, 0xe6, 0x99, 0x8f, 0x60, 0xad,
+			0x8c, 0x25, 0x70, 0xb5, 0x88, 0x11, 0x6e, 0x40, 0x35, 0x80, 0x58, 0x60, 0xdd, 0x77, 0x58, 0xf1,
+			0x71, 0xb5, 0x71, 0x40, 0x58, 0xad, 0x7f, 0x60, 0x58, 0x25, 0x88, 0xdd, 0x71, 0x11, 0x8e, 0x40,
+			0x35, 0x80, 0xa8, 0x60, 0x89, 0x88, 0xa8, 0x45, 0x8e, 0x25, 0x8e, 0x40, 0xa8, 0xf1, 0x7f, 0xe6,
+			0xb0, 0x60, 0xb0, 0xf1, 0x89, 0x11, 0x91, 0xf9, 0x90, 0x40, 0x35, 0x80, 0xb0, 0xe2, 0x55, 0x88,
+			0xdd, 0x8a, 0x00, 0x11, 0x7e, 0xbd, 0x80, 0xe8, 0x79, 0x72, 0xe6, 0x11, 0x82, 0xe8, 0x11, 0x7f,
+			0x01, 0x35, 0x8b, 0x90, 0x55, 0x88, 0xdd, 0x8a, 0xe1,
+		}},
+		ActionVerifiedUser: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7d, 0xdd, 0x88, 0x05, 0x45, 0x8c, 0xad,
+			0x79, 0x79, 0x89, 0xf1, 0x76, 0x45, 0x7d, 0x25, 0x83, 0x89, 0x76, 0x69, 0x7c, 0x99, 0x73, 0x55,
+			0x7f, 0x11, 0x7d, 0xdd, 0x88, 0xe2, 0x80, 0x99, 0x9a, 0x60, 0xad, 0x76, 0x45, 0x98, 0xad, 0x70,
+			0xc5, 0x8f, 0x40, 0xad, 0x6a, 0x25, 0x7d, 0xe8, 0x45, 0x6d, 0x01, 0x80, 0x45, 0x65, 0x55, 0x95,
+			0x45, 0x6d, 0xe8, 0x25, 0x7d, 0x60, 0x55, 0x95, 0x45, 0x87, 0x55, 0x8f, 0xc5, 0x8f, 0x40, 0x80,
+			0x99, 0x9a, 0xe2, 0x80, 0x79, 0x96, 0x60, 0xad, 0x87, 0xf1, 0x93, 0x81, 0x8c, 0xe9, 0x8c, 0x40,
+			0x55, 0x91, 0x25, 0x7d, 0xe8, 0x11, 0x70, 0x01, 0x80, 0x89, 0x69, 0xad, 0x6e, 0x11, 0x70, 0xe8,
+			0x25, 0x7d, 0x60, 0xad, 0x6e, 0xdd, 0x85, 0x81, 0x73, 0xe9, 0x8c, 0x40, 0x80, 0x79, 0x96, 0xe2,
+			0x80, 0x80, 0xe1,
+		}},
+		ActionViewAgenda: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x7c, 0x60, 0x69, 0x6a, 0x7c, 0x35, 0x69,
+			0xcd, 0x7c, 0x40, 0x50, 0x74, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0x74, 0x60, 0xb0, 0x99, 0x7b, 0xcd, 0x96, 0xcd, 0x7c, 0x40, 0xa8, 0x7c, 0xe6, 0x58, 0xe2, 0x58,
+			0x74, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x74, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0,
+			0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x8c, 0x60, 0x50, 0x69, 0x84, 0x35, 0x69, 0x35,
+			0x83, 0x40, 0x58, 0x84, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x84, 0xcd, 0x96, 0x35, 0x83, 0x40, 0xb0,
+			0x8c, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58,
+			0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x8c, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0x74,
+			0xe8, 0x58, 0xe2, 0x58, 0x8c, 0xe8, 0xa8, 0xe8, 0x8c, 0xe1,
+		}},
+		ActionViewArray: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x75, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe8,
+			0x55, 0x71, 0xe2, 0x11, 0x90, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0xb0, 0xe8, 0xad, 0x92, 0xe6,
+			0x11, 0x90, 0xe2, 0xf1, 0x71, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0x11, 0x8e, 0xe8, 0xad, 0x92,
+			0xe6, 0xf1, 0x71, 0xe2, 0x50, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0xf1, 0x6f, 0xe8, 0xad, 0x92,
+			0xe6, 0x50, 0xe2, 0xf1, 0x75, 0xad, 0x8e, 0xe6, 0x11, 0x8a, 0xe8, 0x55, 0x71, 0xe6, 0xf1, 0x75,
+			0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ActionViewCarousel: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x66, 0xe6, 0xad, 0x6e, 0xe8, 0x9a,
+			0xe6, 0x55, 0x65, 0xe8, 0x66, 0xe2, 0xad, 0x72, 0x55, 0x6d, 0xe6, 0x55, 0x8d, 0xe8, 0xad, 0x92,
+			0xe6, 0xad, 0x72, 0xe8, 0x55, 0x6d, 0xe2, 0xad, 0x76, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe8, 0x55,
+			0x71, 0xe2, 0x55, 0x91, 0x66, 0xe6, 0xad, 0x9a, 0xe8, 0x9a, 0xe6, 0x55, 0x91, 0xe8, 0x66, 0xe2,
+			0xad, 0x76, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe6, 0x55, 0x89, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x76,
+			0xe1,
+		}},
+		ActionViewColumn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6, 0xb0,
+			0xe8, 0xad, 0x92, 0xe6, 0x50, 0xe2, 0x58, 0xad, 0x8e, 0xe6, 0xad, 0x76, 0xe8, 0x55, 0x71, 0xe6,
+			0x58, 0xe8, 0xad, 0x8e, 0xe2, 0xad, 0x7a, 0xad, 0x8e, 0xe6, 0x55, 0x85, 0xe8, 0x55, 0x71, 0xe6,
+			0xad, 0x7a, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x89, 0xad, 0x8e, 0xe6, 0xa8, 0xe8, 0x55, 0x71, 0xe6,
+			0x55, 0x89, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ActionViewDay: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0xb0,
+			0xe8, 0x55, 0x95, 0xe6, 0x50, 0xe2, 0x50, 0xad, 0x6e, 0xe8, 0xad, 0x6a, 0xe6, 0xb0, 0xe8, 0xad,
+			0x6e, 0xe6, 0x50, 0xe2, 0x58, 0xf1, 0x89, 0x60, 0x69, 0x6a, 0xf1, 0x89, 0x35, 0x69, 0xbd, 0x88,
+			0x40, 0x50, 0xf1, 0x85, 0xe8, 0x11, 0x7a, 0x60, 0x50, 0x79, 0x78, 0x35, 0x69, 0x45, 0x77, 0x40,
+			0x58, 0x11, 0x76, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x11, 0x76, 0xcd, 0x96, 0x45, 0x77, 0x40, 0xb0,
+			0x11, 0x7a, 0xe8, 0xf1, 0x85, 0x60, 0xb0, 0x89, 0x87, 0xcd, 0x96, 0xbd, 0x88, 0x40, 0xa8, 0xf1,
+			0x89, 0xe6, 0x58, 0xe2, 0x58, 0xf1, 0x85, 0xe6, 0xa8, 0xe8, 0x11, 0x7a, 0xe6, 0x58, 0xe8, 0xf1,
+			0x85, 0xe2, 0x58, 0x11, 0x7a, 0xe8, 0xf1, 0x85, 0xe8, 0x11, 0x7a, 0xe1,
+		}},
+		ActionViewHeadline: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x35, 0x87, 0xe8, 0x35, 0x83, 0xe6,
+			0x55, 0x95, 0xe8, 0x35, 0x87, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0xdd, 0x91, 0xe8, 0xdd, 0x8d,
+			0xe6, 0x55, 0x95, 0xe8, 0xdd, 0x91, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0xcd, 0x7c, 0xe8, 0xcd,
+			0x78, 0xe6, 0x55, 0x95, 0xe8, 0xcd, 0x7c, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x25, 0x72, 0xe8,
+			0x25, 0x6e, 0xe6, 0x55, 0x95, 0xe8, 0x25, 0x72, 0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		ActionViewList: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x77, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8,
+			0x35, 0x88, 0xe6, 0x55, 0x77, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0xcd, 0x77, 0xe6, 0x55, 0x73,
+			0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0xcd, 0x77, 0xe2, 0x55, 0x69, 0x45, 0x84, 0xe6, 0x55,
+			0x73, 0xe8, 0xcd, 0x7b, 0xe6, 0x55, 0x69, 0xe8, 0x45, 0x84, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6,
+			0x55, 0x73, 0xe8, 0x35, 0x88, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x77, 0x45, 0x84,
+			0xe6, 0xad, 0x96, 0xe8, 0xcd, 0x7b, 0xe6, 0x55, 0x77, 0xe8, 0x45, 0x84, 0xe2, 0x55, 0x77, 0xcd,
+			0x77, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x77, 0xe8, 0xcd, 0x77, 0xe2, 0x55, 0x69,
+			0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91,
+			0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad,
+			0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a,
+			0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad,
+			0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe1,
+		}, mirror: true},
+		ActionViewModule: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x68, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6,
+			0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x11, 0x68, 0xe2, 0x69, 0x89, 0x7c, 0xe6, 0xa8, 0xe8, 0x55, 0x71,
+			0xe6, 0x69, 0x89, 0xe8, 0x7c, 0xe2, 0xad, 0x7a, 0x7c, 0xe6, 0x69, 0x85, 0xe8, 0x55, 0x71, 0xe6,
+			0xad, 0x7a, 0xe8, 0x7c, 0xe2, 0x11, 0x6c, 0x7c, 0xe6, 0xad, 0x76, 0xe8, 0x55, 0x71, 0xe6, 0x11,
+			0x6c, 0xe8, 0x7c, 0xe2, 0x11, 0x6c, 0xad, 0x8e, 0xe6, 0xad, 0x76, 0xe8, 0x84, 0xe6, 0x11, 0x6c,
+			0xe8, 0xad, 0x8e, 0xe2, 0xad, 0x7a, 0xad, 0x8e, 0xe6, 0x69, 0x85, 0xe8, 0x84, 0xe6, 0xad, 0x7a,
+			0xe8, 0xad, 0x8e, 0xe2, 0x69, 0x89, 0xad, 0x8e, 0xe6, 0xa8, 0xe8, 0x84, 0xe6, 0x69, 0x89, 0xe8,
+			0xad, 0x8e, 0xe1,
+		}},
+		ActionViewQuilt: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x6d, 0xe6, 0xb0, 0xe8, 0xad, 0x92,
+			0xe6, 0x50, 0xe8, 0x55, 0x6d, 0xe2, 0x74, 0x55, 0x71, 0xe8, 0x7c, 0xe6, 0xa8, 0xe8, 0x55, 0x71,
+			0xe6, 0x74, 0xe2, 0xa8, 0x84, 0xe6, 0x45, 0x89, 0xe8, 0xad, 0x8e, 0xe6, 0xa8, 0xe8, 0x84, 0xe2,
+			0x74, 0x84, 0xe8, 0xad, 0x8e, 0xe6, 0xcd, 0x84, 0xe8, 0x84, 0xe6, 0x74, 0xe2, 0x58, 0xad, 0x8e,
+			0xe6, 0x6c, 0xe8, 0x55, 0x71, 0xe6, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ActionViewStream: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa8, 0xad, 0x8e, 0xe8, 0x84, 0xe6, 0x58, 0xe8,
+			0xad, 0x8e, 0xe6, 0xa8, 0xe2, 0xa8, 0x7c, 0xe8, 0x55, 0x71, 0xe6, 0x58, 0xe8, 0x7c, 0xe6, 0xa8,
+			0xe2, 0x58, 0xad, 0x92, 0x60, 0x69, 0x6a, 0xad, 0x92, 0x35, 0x69, 0x79, 0x91, 0x40, 0x50, 0xad,
+			0x8e, 0xe8, 0x55, 0x71, 0x60, 0x50, 0xbd, 0x6f, 0x35, 0x69, 0x89, 0x6e, 0x40, 0x58, 0x55, 0x6d,
+			0xe6, 0xa8, 0x60, 0x99, 0x95, 0x55, 0x6d, 0xcd, 0x96, 0x89, 0x6e, 0x40, 0xb0, 0x55, 0x71, 0xe8,
+			0xad, 0x8e, 0x60, 0xb0, 0x45, 0x90, 0xcd, 0x96, 0x79, 0x91, 0x40, 0xa8, 0xad, 0x92, 0xe6, 0x58,
+			0xe1,
+		}},
+		ActionViewWeek: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xcd, 0x75, 0xe8,
+			0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0xcd, 0x79, 0x55, 0x91, 0xe6, 0x35, 0x86,
+			0xe8, 0xad, 0x6e, 0xe6, 0xcd, 0x79, 0xe8, 0x55, 0x91, 0xe2, 0x35, 0x8a, 0x55, 0x91, 0xe6, 0xad,
+			0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x35, 0x8a, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60,
+			0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e,
+			0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8,
+			0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95,
+			0xe6, 0x55, 0x69, 0xe1,
+		}},
+		ActionVisibility: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x05, 0x80, 0x94, 0x67, 0xbd, 0x84, 0x94, 0x09,
+			0x88, 0xb1, 0x86, 0x55, 0x8b, 0x65, 0x83, 0x55, 0x8b, 0xa9, 0x7e, 0x55, 0x8b, 0xf1, 0x79, 0x09,
+			0x88, 0xa5, 0x76, 0xb9, 0x84, 0x55, 0x73, 0xfd, 0x7f, 0x55, 0x73, 0x45, 0x7b, 0x55, 0x73, 0xf9,
+			0x77, 0xa5, 0x76, 0xad, 0x74, 0xf5, 0x79, 0xad, 0x74, 0xad, 0x7e, 0xad, 0x74, 0x69, 0x83, 0xf9,
+			0x77, 0xb5, 0x86, 0x49, 0x7b, 0x94, 0x05, 0x80, 0x94, 0xe2, 0xfd, 0x7f, 0x25, 0x86, 0x67, 0xdd,
+			0x7c, 0x25, 0x86, 0xb5, 0x7a, 0xf5, 0x83, 0x89, 0x78, 0xc5, 0x81, 0x89, 0x78, 0xa9, 0x7e, 0x89,
+			0x78, 0x89, 0x7b, 0xb9, 0x7a, 0x5d, 0x79, 0xe5, 0x7c, 0x35, 0x77, 0x05, 0x80, 0x35, 0x77, 0x25,
+			0x83, 0x35, 0x77, 0x4d, 0x85, 0x61, 0x79, 0x79, 0x87, 0x91, 0x7b, 0x79, 0x87, 0xb1, 0x7e, 0x79,
+			0x87, 0xcd, 0x81, 0x49, 0x85, 0xf9, 0x83, 0x1d, 0x83, 0x25, 0x86, 0xfd, 0x7f, 0x25, 0x86, 0xe2,
+			0x80, 0xad, 0x92, 0x60, 0x45, 0x76, 0xad, 0x92, 0x69, 0x6e, 0x25, 0x8d, 0x40, 0xad, 0x62, 0xad,
+			0x7e, 0x60, 0x89, 0x66, 0xbd, 0x75, 0x69, 0x6e, 0x35, 0x70, 0x40, 0x80, 0xad, 0x6a, 0x60, 0xbd,
+			0x89, 0xad, 0x6a, 0x99, 0x91, 0x35, 0x70, 0x40, 0x55, 0x9d, 0xad, 0x7e, 0x60, 0x79, 0x99, 0x99,
+			0x87, 0x99, 0x91, 0x25, 0x8d, 0x40, 0x80, 0xad, 0x92, 0xe2, 0x80, 0xad, 0x7e, 0xe2, 0xfd, 0x7f,
+			0xad, 0x8e, 0x67, 0x11, 0x88, 0xad, 0x8e, 0xd5, 0x8e, 0x4d, 0x8a, 0x99, 0x95, 0xf1, 0x85, 0x25,
+			0x99, 0xad, 0x7e, 0x99, 0x95, 0x69, 0x77, 0xd9, 0x8e, 0x09, 0x73, 0x19, 0x88, 0xad, 0x6e, 0x05,
+			0x80, 0xad, 0x6e, 0xf1, 0x77, 0xad, 0x6e, 0x2d, 0x71, 0x09, 0x73, 0x69, 0x6a, 0x69, 0x77, 0xcd,
+			0x66, 0xad, 0x7e, 0x69, 0x6a, 0xf1, 0x85, 0x29, 0x71, 0x4d, 0x8a, 0xe9, 0x77, 0xad, 0x8e, 0xfd,
+			0x7f, 0xad, 0x8e, 0xe1,
+		}},
+		ActionVisibilityOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x89, 0x11, 0x84, 0x00, 0x8e, 0x25, 0x81,
+			0x60, 0xbd, 0x88, 0x69, 0x7c, 0x35, 0x85, 0x45, 0x79, 0x40, 0x89, 0x7d, 0xad, 0x77, 0x00, 0x99,
+			0x7a, 0xbd, 0x74, 0x60, 0xbd, 0x7b, 0x68, 0x25, 0x7d, 0xad, 0x73, 0x40, 0x80, 0x55, 0x73, 0x60,
+			0xbd, 0x84, 0x55, 0x73, 0x09, 0x88, 0xa5, 0x76, 0x40, 0x55, 0x8b, 0xad, 0x7e, 0x60, 0x55, 0x8b,
+			0x25, 0x80, 0xf9, 0x8a, 0x91, 0x81, 0x40, 0xf1, 0x89, 0x11, 0x84, 0xe2, 0x89, 0x92, 0xad, 0x8c,
+			0x00, 0xdd, 0x8f, 0x94, 0x60, 0x25, 0x93, 0x99, 0x87, 0x91, 0x95, 0xa5, 0x84, 0x40, 0x25, 0x99,
+			0xad, 0x7e, 0x60, 0xcd, 0x95, 0x45, 0x77, 0x25, 0x8f, 0xf9, 0x72, 0x40, 0xad, 0x80, 0xad, 0x6e,
+			0x60, 0xdd, 0x7d, 0xad, 0x6e, 0xf1, 0x7a, 0x35, 0x6f, 0x40, 0x55, 0x76, 0x79, 0x70, 0x00, 0x45,
+			0x73, 0x55, 0x6d, 0x60, 0x99, 0x75, 0x45, 0x6c, 0x3d, 0x79, 0x79, 0x6b, 0x40, 0x55, 0x80, 0xad,
+			0x6a, 0x60, 0xdd, 0x89, 0xad, 0x6a, 0xc5, 0x91, 0x19, 0x70, 0x40, 0x55, 0x9d, 0xad, 0x7e, 0x60,
+			0x99, 0x9b, 0xf1, 0x82, 0xdd, 0x98, 0x79, 0x86, 0x40, 0x89, 0x92, 0xad, 0x8c, 0xe2, 0x69, 0x96,
+			0xbd, 0x9b, 0x00, 0x35, 0x8b, 0xbd, 0x90, 0x60, 0xdd, 0x88, 0xad, 0x91, 0xf1, 0x85, 0x2d, 0x92,
+			0x40, 0x80, 0xad, 0x92, 0x60, 0x45, 0x76, 0xad, 0x92, 0x55, 0x6e, 0x3d, 0x8d, 0x40, 0xad, 0x62,
+			0xad, 0x7e, 0x60, 0x48, 0x35, 0x7b, 0x5d, 0x66, 0xe9, 0x77, 0x40, 0x25, 0x6c, 0x99, 0x71, 0x03,
+			0xbd, 0x63, 0x35, 0x69, 0x89, 0x66, 0x55, 0x66, 0xb2, 0xcd, 0x98, 0x69, 0x96, 0xbd, 0x9b, 0xe2,
+			0xdd, 0x6e, 0x69, 0x74, 0x60, 0x69, 0x6c, 0x35, 0x76, 0x19, 0x6a, 0x25, 0x79, 0x40, 0xcd, 0x66,
+			0xad, 0x7e, 0x60, 0x35, 0x6a, 0x11, 0x86, 0x09, 0x71, 0x5d, 0x8a, 0x40, 0x89, 0x80, 0xad, 0x8e,
+			0x60, 0xbd, 0x82, 0xad, 0x8e, 0xdd, 0x84, 0x69, 0x8e, 0x40, 0x11, 0x88, 0x99, 0x8d, 0x00, 0xcd,
+			0x83, 0x55, 0x89, 0x60, 0x11, 0x83, 0xad, 0x89, 0x84, 0xd5, 0x89, 0x40, 0x80, 0x94, 0x60, 0x55,
+			0x7b, 0x94, 0x70, 0xbd, 0x86, 0x40, 0xad, 0x74, 0xad, 0x7e, 0x60, 0xad, 0x74, 0xad, 0x7d, 0xd5,
+			0x74, 0xad, 0x7c, 0x40, 0x55, 0x75, 0xdd, 0x7a, 0x00, 0xdd, 0x6e, 0x69, 0x74, 0xe2, 0x35, 0x83,
+			0xdd, 0x7d, 0xe2, 0x79, 0x7b, 0xbd, 0x81, 0xe1,
+		}},
+		ActionWatchLater: ActionWatchLater,
+		ActionWork: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89,
+			0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0x68, 0x60, 0x55, 0x65, 0x69, 0x72, 0x89, 0x66,
+			0x35, 0x71, 0x40, 0x55, 0x69, 0x60, 0xe6, 0x55, 0x75, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x75, 0xbd,
+			0x67, 0x89, 0x76, 0x89, 0x66, 0x40, 0x55, 0x79, 0x55, 0x65, 0xe6, 0xad, 0x86, 0x60, 0x45, 0x88,
+			0x55, 0x65, 0x79, 0x89, 0x89, 0x66, 0x40, 0xad, 0x8a, 0x55, 0x69, 0xe8, 0x60, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x60, 0x79, 0x99, 0x35, 0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0xa8, 0x60, 0xad,
+			0x9a, 0x99, 0x95, 0x79, 0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69, 0xe2, 0x55,
+			0x69, 0xa8, 0xe6, 0xad, 0x96, 0xe8, 0x68, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe2, 0x55, 0x79, 0x60,
+			0xe6, 0xad, 0x86, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x79, 0xe8, 0x60, 0xe2, 0x55, 0x69, 0xa8, 0xe8,
+			0x68, 0xe8, 0xa8, 0xe1,
+		}},
+		ActionYoutubeSearchedFor: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x97, 0x79, 0x96, 0x00, 0x55, 0x87, 0x69,
+			0x86, 0x60, 0x55, 0x85, 0x25, 0x88, 0xad, 0x82, 0x19, 0x89, 0x40, 0x7a, 0x11, 0x8a, 0x60, 0x72,
+			0x11, 0x8a, 0xa5, 0x75, 0x79, 0x88, 0x40, 0xf1, 0x6f, 0x25, 0x84, 0x00, 0xcd, 0x72, 0x45, 0x81,
+			0x60, 0x99, 0x74, 0x79, 0x83, 0x3d, 0x77, 0xc5, 0x84, 0x40, 0xf1, 0x7c, 0x11, 0x86, 0x60, 0x55,
+			0x82, 0x11, 0x86, 0x25, 0x86, 0x3d, 0x82, 0x40, 0xf1, 0x89, 0x72, 0x60, 0xf1, 0x89, 0x99, 0x73,
+			0x25, 0x86, 0xc5, 0x6f, 0x40, 0xf1, 0x7c, 0xf1, 0x6b, 0x60, 0x79, 0x77, 0xf1, 0x6b, 0xa5, 0x73,
+			0xc5, 0x6f, 0x40, 0xcd, 0x6f, 0x72, 0x05, 0x68, 0xad, 0x74, 0xdd, 0x76, 0x89, 0x77, 0x11, 0x6e,
+			0x55, 0x80, 0x45, 0x65, 0x89, 0x77, 0x25, 0x68, 0xad, 0x74, 0xdd, 0x6b, 0x69, 0x78, 0x60, 0x25,
+			0x6c, 0x89, 0x71, 0x62, 0xbd, 0x6c, 0x40, 0xf1, 0x7c, 0xf1, 0x67, 0x60, 0x88, 0xf1, 0x67, 0xf9,
+			0x88, 0xf1, 0x6c, 0x40, 0xf1, 0x8d, 0x72, 0x60, 0xf1, 0x8d, 0xdd, 0x7b, 0x9a, 0x89, 0x7e, 0x40,
+			0x35, 0x8a, 0x89, 0x83, 0x01, 0x55, 0x9a, 0x89, 0x93, 0x69, 0x97, 0x79, 0x96, 0xe1,
+		}},
+		ActionZoomIn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x95, 0xf1, 0x97, 0x00, 0x89, 0x83, 0x69,
+			0x86, 0x60, 0x89, 0x81, 0x25, 0x88, 0xe1, 0x7e, 0x19, 0x89, 0x40, 0x35, 0x79, 0x11, 0x8a, 0x61,
+			0xfd, 0x71, 0x11, 0x8a, 0x5a, 0x11, 0x85, 0x50, 0x11, 0x80, 0x50, 0x72, 0x40, 0x5a, 0xf1, 0x6c,
+			0x60, 0x64, 0xf1, 0x67, 0x19, 0x79, 0xf1, 0x67, 0x40, 0x2d, 0x85, 0xf1, 0x6c, 0x62, 0x25, 0x8a,
+			0xf1, 0x71, 0x25, 0x8a, 0x05, 0x79, 0x25, 0x8a, 0xdd, 0x7b, 0x35, 0x89, 0x89, 0x7e, 0x45, 0x88,
+			0x35, 0x81, 0x69, 0x86, 0x89, 0x83, 0x01, 0xb0, 0xaa, 0x11, 0x95, 0xf1, 0x97, 0xe2, 0x25, 0x79,
+			0x11, 0x86, 0x60, 0x8d, 0x7e, 0x11, 0x86, 0x59, 0x82, 0x3d, 0x82, 0x40, 0x25, 0x86, 0x72, 0x60,
+			0x25, 0x86, 0x99, 0x73, 0x59, 0x82, 0xc5, 0x6f, 0x40, 0x25, 0x79, 0xf1, 0x6b, 0x61, 0xa9, 0x73,
+			0xf1, 0x6b, 0xd5, 0x6f, 0xc5, 0x6f, 0x58, 0x99, 0x73, 0x58, 0x72, 0x40, 0xd5, 0x6f, 0x3d, 0x82,
+			0x60, 0xa9, 0x73, 0x11, 0x86, 0x25, 0x79, 0x11, 0x86, 0xe2, 0x11, 0x77, 0x69, 0x80, 0xe8, 0xf1,
+			0x7a, 0xe6, 0x99, 0x71, 0xe8, 0xf1, 0x76, 0xe6, 0x11, 0x77, 0xe8, 0x89, 0x71, 0xe6, 0x11, 0x7b,
+			0xe8, 0xf1, 0x76, 0xe6, 0x79, 0x80, 0xe8, 0xf1, 0x7a, 0xe6, 0x11, 0x7b, 0xe8, 0x69, 0x80, 0xe6,
+			0x11, 0x77, 0xe1,
+		}},
+		ActionZoomOut: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x95, 0xf1, 0x97, 0x00, 0x89, 0x83, 0x69,
+			0x86, 0x60, 0x89, 0x81, 0x25, 0x88, 0xe1, 0x7e, 0x19, 0x89, 0x40, 0x35, 0x79, 0x11, 0x8a, 0x61,
+			0xfd, 0x71, 0x11, 0x8a, 0x5a, 0x11, 0x85, 0x50, 0x11, 0x80, 0x50, 0x72, 0x40, 0x5a, 0xf1, 0x6c,
+			0x60, 0x64, 0xf1, 0x67, 0x19, 0x79, 0xf1, 0x67, 0x40, 0x2d, 0x85, 0xf1, 0x6c, 0x62, 0x25, 0x8a,
+			0xf1, 0x71, 0x25, 0x8a, 0x05, 0x79, 0x25, 0x8a, 0xdd, 0x7b, 0x35, 0x89, 0x89, 0x7e, 0x45, 0x88,
+			0x35, 0x81, 0x69, 0x86, 0x89, 0x83, 0x01, 0xb0, 0xaa, 0x11, 0x95, 0xf1, 0x97, 0xe2, 0x25, 0x79,
+			0x11, 0x86, 0x60, 0x8d, 0x7e, 0x11, 0x86, 0x59, 0x82, 0x3d, 0x82, 0x40, 0x25, 0x86, 0x72, 0x60,
+			0x25, 0x86, 0x99, 0x73, 0x59, 0x82, 0xc5, 0x6f, 0x40, 0x25, 0x79, 0xf1, 0x6b, 0x61, 0xa9, 0x73,
+			0xf1, 0x6b, 0xd5, 0x6f, 0xc5, 0x6f, 0x58, 0x99, 0x73, 0x58, 0x72, 0x40, 0xd5, 0x6f, 0x3d, 0x82,
+			0x60, 0xa9, 0x73, 0x11, 0x86, 0x25, 0x79, 0x11, 0x86, 0xe2, 0x55, 0x72, 0xf1, 0x7a, 0xe8, 0xf1,
+			0x76, 0xe6, 0xbd, 0x7f, 0xe8, 0xf1, 0x7a, 0xe6, 0x55, 0x72, 0xe1,
+		}},
+		AlertAddAlert: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x79, 0x87, 0xe6, 0x84, 0xe8, 0xdd, 0x81,
+			0xe6, 0x99, 0x87, 0xe8, 0xdd, 0x7d, 0xe6, 0x84, 0xe8, 0x45, 0x78, 0xe6, 0x7c, 0xe8, 0xdd, 0x7d,
+			0xe6, 0x69, 0x78, 0xe8, 0xdd, 0x81, 0xe6, 0x7c, 0xe8, 0x79, 0x87, 0xe2, 0xad, 0x6a, 0xad, 0x92,
+			0xe8, 0xad, 0x8e, 0xe6, 0x60, 0xe8, 0x69, 0x7a, 0x60, 0x60, 0xcd, 0x74, 0x4d, 0x73, 0x5d, 0x70,
+			0x40, 0x78, 0xcd, 0x6a, 0xe8, 0x55, 0x69, 0x60, 0x78, 0xad, 0x67, 0x2d, 0x7d, 0x81, 0x66, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0xad, 0x81, 0x55, 0x65, 0xd5, 0x82, 0x81, 0x66, 0x40, 0x88, 0x55, 0x69,
+			0xe8, 0xcd, 0x6a, 0x60, 0x69, 0x89, 0xf1, 0x6b, 0xb5, 0x8c, 0x5d, 0x70, 0x40, 0xa0, 0x69, 0x7a,
+			0xe8, 0xad, 0x8e, 0xe6, 0x55, 0x95, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x6a, 0xe2, 0x80, 0x89, 0x7e,
+			0xe2, 0x80, 0xad, 0x9a, 0x60, 0xcd, 0x7d, 0xad, 0x9a, 0x3d, 0x7c, 0x19, 0x99, 0x40, 0xad, 0x7a,
+			0x55, 0x95, 0xe6, 0x55, 0x85, 0x60, 0x55, 0x85, 0x89, 0x97, 0xc5, 0x83, 0x19, 0x99, 0x40, 0x80,
+			0xad, 0x9a, 0xe2, 0x68, 0xad, 0x8e, 0xe6, 0x98, 0xe8, 0x69, 0x7a, 0x60, 0x98, 0x69, 0x75, 0x81,
+			0x88, 0xe9, 0x71, 0x40, 0x80, 0x69, 0x6e, 0x60, 0x76, 0x69, 0x6e, 0x81, 0x77, 0xe9, 0x71, 0x40,
+			0x68, 0x69, 0x7a, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		AlertError: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x8d, 0x67, 0xf1, 0x80, 0x55, 0x8d,
+			0x91, 0x81, 0xb5, 0x8c, 0x35, 0x82, 0x11, 0x8c, 0x35, 0x82, 0x25, 0x8b, 0x35, 0x82, 0x35, 0x8a,
+			0x91, 0x81, 0x91, 0x89, 0xf1, 0x80, 0xf1, 0x88, 0x80, 0xf1, 0x88, 0x11, 0x7f, 0xf1, 0x88, 0x71,
+			0x7e, 0x91, 0x89, 0xcd, 0x7d, 0x35, 0x8a, 0xcd, 0x7d, 0x21, 0x8b, 0xcd, 0x7d, 0x11, 0x8c, 0x71,
+			0x7e, 0xb5, 0x8c, 0x11, 0x7f, 0x55, 0x8d, 0x80, 0x55, 0x8d, 0xe2, 0x35, 0x7e, 0x25, 0x83, 0xe6,
+			0x35, 0x82, 0xe8, 0x45, 0x72, 0xe6, 0x35, 0x7e, 0xe8, 0x25, 0x83, 0xe2, 0x05, 0x80, 0xad, 0x9a,
+			0x60, 0x81, 0x7a, 0xad, 0x9a, 0xa9, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x63, 0x89,
+			0x69, 0x35, 0x8f, 0x71, 0x67, 0x59, 0x8a, 0x55, 0x65, 0x7d, 0x85, 0x55, 0x65, 0xf9, 0x7f, 0x55,
+			0x65, 0x75, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x89, 0x69, 0xbd, 0x70, 0x2d, 0x6d, 0x25, 0x6d, 0x40,
+			0xa9, 0x75, 0x71, 0x67, 0x62, 0x85, 0x7a, 0x55, 0x65, 0x09, 0x80, 0x55, 0x65, 0x8d, 0x85, 0x55,
+			0x65, 0x69, 0x8a, 0x71, 0x67, 0x45, 0x8f, 0x89, 0x69, 0xdd, 0x92, 0x25, 0x6d, 0x40, 0x91, 0x98,
+			0x99, 0x75, 0x61, 0xad, 0x9a, 0x79, 0x7a, 0xad, 0x9a, 0xfd, 0x7f, 0xad, 0x9a, 0x81, 0x85, 0x91,
+			0x98, 0x59, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x61, 0x45, 0x8f, 0x71, 0x96, 0x69, 0x8a, 0x8d,
+			0x98, 0x89, 0x85, 0xad, 0x9a, 0x05, 0x80, 0xad, 0x9a, 0xe2, 0x09, 0x80, 0xad, 0x96, 0x60, 0x79,
+			0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0xf9, 0x7f, 0x64, 0xad, 0x96, 0x89,
+			0x76, 0x15, 0x90, 0xf1, 0x6f, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x99, 0x76, 0x55, 0x69,
+			0xf9, 0x6f, 0xed, 0x6f, 0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x55, 0x69, 0x69, 0x89, 0xf9,
+			0x6f, 0x09, 0x90, 0x40, 0x09, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		AlertErrorOutline: AlertErrorOutline,
+		AlertWarning: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0xb0, 0x01, 0x80, 0x55, 0x65, 0x55,
+			0x9d, 0xb0, 0xe6, 0xad, 0x62, 0xe2, 0x99, 0x69, 0xa8, 0xe6, 0x69, 0x96, 0x01, 0x80, 0x55, 0x6d,
+			0x99, 0x69, 0xa8, 0xe2, 0x49, 0x80, 0x35, 0x90, 0x67, 0x25, 0x81, 0x35, 0x90, 0xb5, 0x81, 0xa1,
+			0x8f, 0x45, 0x82, 0x0d, 0x8f, 0x45, 0x82, 0x31, 0x8e, 0x45, 0x82, 0x55, 0x8d, 0xb1, 0x81, 0xc5,
+			0x8c, 0x1d, 0x81, 0x35, 0x8c, 0x41, 0x80, 0x35, 0x8c, 0x69, 0x7f, 0x35, 0x8c, 0xd5, 0x7e, 0xc9,
+			0x8c, 0x45, 0x7e, 0x5d, 0x8d, 0x45, 0x7e, 0x39, 0x8e, 0x45, 0x7e, 0x11, 0x8f, 0xd9, 0x7e, 0xa5,
+			0x8f, 0x6d, 0x7f, 0x35, 0x90, 0x49, 0x80, 0x35, 0x90, 0xe2, 0x45, 0x7e, 0xcd, 0x88, 0xe6, 0x45,
+			0x82, 0xe8, 0xdd, 0x79, 0xe6, 0x45, 0x7e, 0xe8, 0xcd, 0x88, 0xe2, 0x80, 0xad, 0x80, 0xe1,
+		}},
+		CommunicationBusiness:           CommunicationBusiness,
+		CommunicationCall:               CommunicationCall,
+		CommunicationCallEnd:            CommunicationCallEnd,
+		CommunicationCallMade:           CommunicationCallMade,
+		CommunicationCallMerge:          CommunicationCallMerge,
+		CommunicationCallMissed:         CommunicationCallMissed,
+		CommunicationCallMissedOutgoing: CommunicationCallMissedOutgoing,
+		CommunicationCallReceived:       CommunicationCallReceived,
+		CommunicationCallSplit:          CommunicationCallSplit,
+		CommunicationChat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x69, 0x85, 0xe6, 0xdd, 0x84, 0xe8, 0x69,
+			0x81, 0xe6, 0x60, 0xe8, 0x69, 0x85, 0xe2, 0x60, 0xbd, 0x7c, 0xe6, 0xa0, 0xe8, 0xbd, 0x78, 0xe6,
+			0x60, 0xe8, 0xbd, 0x7c, 0xe2, 0x60, 0x11, 0x74, 0xe6, 0xa0, 0xe8, 0x11, 0x70, 0xe6, 0x60, 0xe8,
+			0x11, 0x74, 0xe2, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89,
+			0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65,
+			0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d,
+			0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2,
+			0x45, 0x6e, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x00,
+			0x45, 0x6e, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}, mirror: true},
+		CommunicationChatBubble: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60,
+			0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98,
+			0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00,
+			0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55,
+			0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8,
+			0x98, 0xe1,
+		}},
+		CommunicationChatBubbleOutline: CommunicationChatBubbleOutline,
+		CommunicationClearAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0x55,
+			0x8d, 0xe8, 0x55, 0x8d, 0xe6, 0x50, 0xe2, 0x55, 0x6d, 0x84, 0xe8, 0x7c, 0xe6, 0xad, 0x92, 0xe8,
+			0x84, 0xe6, 0x55, 0x6d, 0xe2, 0xad, 0x72, 0xad, 0x76, 0xe8, 0xad, 0x72, 0xe6, 0xb0, 0xe8, 0xad,
+			0x76, 0xe6, 0xad, 0x72, 0xe1,
+		}},
+		CommunicationComment: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x55, 0x85, 0xe6, 0xa0, 0xe8, 0x55, 0x81,
+			0xe6, 0x60, 0xe8, 0x55, 0x85, 0xe2, 0x60, 0xad, 0x7c, 0xe6, 0xa0, 0xe8, 0xad, 0x78, 0xe6, 0x60,
+			0xe8, 0xad, 0x7c, 0xe2, 0x60, 0x68, 0xe6, 0xa0, 0xe8, 0x60, 0xe6, 0x60, 0xe8, 0x68, 0xe2, 0xad,
+			0x9a, 0xad, 0x9a, 0x00, 0xa0, 0xa0, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0xa0, 0x89, 0x66, 0xcd,
+			0x8e, 0x40, 0x55, 0x65, 0x98, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89,
+			0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99,
+			0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x9a, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55,
+			0x69, 0xe8, 0x98, 0xe2, 0xbd, 0x91, 0x98, 0x00, 0xad, 0x96, 0x55, 0x91, 0xe8, 0x55, 0x69, 0xe6,
+			0x55, 0x69, 0xe8, 0x98, 0xe6, 0xbd, 0x91, 0xe1,
+		}, mirror: true},
+		CommunicationContactMail: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x85, 0x11, 0x7d, 0xe6, 0x55, 0x97, 0xe8,
+			0xad, 0x70, 0xe6, 0x79, 0x85, 0xe8, 0x11, 0x7d, 0xe2, 0x79, 0x8e, 0x99, 0x7a, 0x00, 0x79, 0x87,
+			0x55, 0x75, 0xe8, 0xad, 0x72, 0x01, 0x69, 0x8e, 0xf1, 0x77, 0x55, 0x95, 0xad, 0x72, 0xe8, 0x55,
+			0x75, 0x00, 0x79, 0x8e, 0x99, 0x7a, 0xe2, 0x48, 0xb0, 0x60, 0x69, 0x62, 0xb0, 0x35, 0x61, 0xcd,
+			0x96, 0x40, 0x40, 0xa8, 0xe8, 0x58, 0x60, 0x40, 0x69, 0x6a, 0x35, 0x61, 0x35, 0x69, 0x40, 0x48,
+			0x50, 0xe6, 0xb8, 0x60, 0x99, 0x9d, 0x50, 0xcd, 0x9e, 0x35, 0x69, 0x40, 0xc0, 0x58, 0xe8, 0xa8,
+			0x60, 0xc0, 0x99, 0x95, 0xcd, 0x9e, 0xcd, 0x96, 0x40, 0xb8, 0xb0, 0xe6, 0x48, 0xe2, 0x69, 0x87,
+			0xa8, 0xe6, 0xb8, 0xe8, 0x58, 0xe6, 0x48, 0xe8, 0xa8, 0xe6, 0x79, 0x64, 0x60, 0x69, 0x67, 0x69,
+			0x8f, 0xf9, 0x6b, 0xbd, 0x8c, 0x40, 0xf1, 0x75, 0x11, 0x8a, 0x60, 0x55, 0x7b, 0x11, 0x8a, 0xe9,
+			0x7f, 0xbd, 0x8c, 0x40, 0x69, 0x87, 0xa8, 0xe2, 0xf1, 0x75, 0x55, 0x85, 0x60, 0x45, 0x79, 0x55,
+			0x85, 0x99, 0x7b, 0x86, 0x40, 0xf1, 0x7d, 0x55, 0x7d, 0x60, 0xf1, 0x7d, 0x74, 0x99, 0x7b, 0xad,
+			0x77, 0x40, 0xf1, 0x75, 0x55, 0x75, 0x60, 0x99, 0x72, 0x55, 0x75, 0x45, 0x70, 0xad, 0x77, 0x40,
+			0xf1, 0x6d, 0x55, 0x7d, 0x60, 0xf1, 0x6d, 0xad, 0x80, 0x45, 0x70, 0x86, 0x40, 0xf1, 0x75, 0x55,
+			0x85, 0xe2, 0x89, 0x69, 0xa8, 0xe6, 0x55, 0x82, 0x61, 0xf5, 0x7f, 0x31, 0x91, 0xb9, 0x7c, 0xa1,
+			0x8f, 0x79, 0x79, 0x11, 0x8e, 0xf1, 0x75, 0x11, 0x8e, 0x40, 0x2d, 0x6f, 0xa5, 0x8f, 0x60, 0xf1,
+			0x6b, 0x35, 0x91, 0x89, 0x69, 0xa8, 0xe2, 0xf1, 0x75, 0x55, 0x81, 0x60, 0x3d, 0x74, 0x55, 0x81,
+			0x15, 0x73, 0x31, 0x80, 0x40, 0xf1, 0x71, 0x55, 0x7d, 0x60, 0xf1, 0x71, 0xa5, 0x7b, 0x15, 0x73,
+			0x7d, 0x7a, 0x40, 0xf1, 0x75, 0x55, 0x79, 0x60, 0xa5, 0x77, 0x55, 0x79, 0xc9, 0x78, 0x7d, 0x7a,
+			0x40, 0xf1, 0x79, 0x55, 0x7d, 0x60, 0xf1, 0x79, 0x09, 0x7f, 0xc9, 0x78, 0x31, 0x80, 0x40, 0xf1,
+			0x75, 0x55, 0x81, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		CommunicationContactPhone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x48, 0xb0, 0x60, 0x69, 0x62, 0xb0, 0x35, 0x61,
+			0xcd, 0x96, 0x40, 0x40, 0xa8, 0xe8, 0x58, 0x60, 0x40, 0x69, 0x6a, 0x35, 0x61, 0x35, 0x69, 0x40,
+			0x48, 0x50, 0xe6, 0xb8, 0x60, 0x99, 0x9d, 0x50, 0xcd, 0x9e, 0x35, 0x69, 0x40, 0xc0, 0x58, 0xe8,
+			0xa8, 0x60, 0xc0, 0x99, 0x95, 0xcd, 0x9e, 0xcd, 0x96, 0x40, 0xb8, 0xb0, 0xe6, 0x48, 0xe2, 0x69,
+			0x87, 0xa8, 0xe6, 0xb8, 0xe8, 0x58, 0xe6, 0x48, 0xe8, 0xa8, 0xe6, 0x79, 0x64, 0x60, 0x69, 0x67,
+			0x69, 0x8f, 0xf9, 0x6b, 0xbd, 0x8c, 0x40, 0xf1, 0x75, 0x11, 0x8a, 0x60, 0x55, 0x7b, 0x11, 0x8a,
+			0xe9, 0x7f, 0xbd, 0x8c, 0x40, 0x69, 0x87, 0xa8, 0xe2, 0xf1, 0x75, 0x55, 0x85, 0x60, 0x45, 0x79,
+			0x55, 0x85, 0x99, 0x7b, 0x86, 0x40, 0xf1, 0x7d, 0x55, 0x7d, 0x60, 0xf1, 0x7d, 0x74, 0x99, 0x7b,
+			0xad, 0x77, 0x40, 0xf1, 0x75, 0x55, 0x75, 0x60, 0x99, 0x72, 0x55, 0x75, 0x45, 0x70, 0xad, 0x77,
+			0x40, 0xf1, 0x6d, 0x55, 0x7d, 0x60, 0xf1, 0x6d, 0xad, 0x80, 0x45, 0x70, 0x86, 0x40, 0xf1, 0x75,
+			0x55, 0x85, 0xe2, 0x69, 0x92, 0xcd, 0x90, 0x01, 0x89, 0x97, 0xbd, 0x8b, 0xbd, 0x93, 0x55, 0x86,
+			0xe6, 0x11, 0x8f, 0x60, 0x79, 0x8e, 0xad, 0x84, 0x3d, 0x8e, 0x3d, 0x83, 0x40, 0x9c, 0x11, 0x80,
+			0x60, 0x9c, 0x55, 0x7e, 0x3d, 0x8e, 0xf1, 0x7c, 0x40, 0x11, 0x8f, 0xcd, 0x79, 0xe6, 0xbd, 0x93,
+			0x01, 0x89, 0x97, 0x69, 0x74, 0x69, 0x92, 0x55, 0x6f, 0x60, 0xbd, 0x8e, 0x55, 0x72, 0xb5, 0x8c,
+			0xbd, 0x76, 0x40, 0xad, 0x8a, 0x11, 0x80, 0x60, 0xad, 0x8a, 0x8a, 0xb5, 0x8c, 0x69, 0x89, 0x40,
+			0x69, 0x92, 0xcd, 0x90, 0xe2, 0x89, 0x69, 0xa8, 0xe6, 0x55, 0x82, 0x61, 0xf5, 0x7f, 0x31, 0x91,
+			0xb9, 0x7c, 0xa1, 0x8f, 0x79, 0x79, 0x11, 0x8e, 0xf1, 0x75, 0x11, 0x8e, 0x40, 0x2d, 0x6f, 0xa5,
+			0x8f, 0x60, 0xf1, 0x6b, 0x35, 0x91, 0x89, 0x69, 0xa8, 0xe2, 0xf1, 0x75, 0x55, 0x81, 0x60, 0x3d,
+			0x74, 0x55, 0x81, 0x15, 0x73, 0x31, 0x80, 0x40, 0xf1, 0x71, 0x55, 0x7d, 0x60, 0xf1, 0x71, 0xa5,
+			0x7b, 0x15, 0x73, 0x7d, 0x7a, 0x40, 0xf1, 0x75, 0x55, 0x79, 0x60, 0xa5, 0x77, 0x55, 0x79, 0xc9,
+			0x78, 0x7d, 0x7a, 0x40, 0xf1, 0x79, 0x55, 0x7d, 0x60, 0xf1, 0x79, 0x09, 0x7f, 0xc9, 0x78, 0x31,
+			0x80, 0x40, 0xf1, 0x75, 0x55, 0x81, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		CommunicationContacts: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x69, 0x55, 0x9d, 0xe8, 0x55, 0x99, 0xe6,
+			0x69, 0x96, 0xe8, 0x55, 0x9d, 0xe6, 0x99, 0x69, 0xe2, 0x99, 0x69, 0xad, 0x66, 0xe8, 0xad, 0x62,
+			0xe6, 0x69, 0x96, 0xe8, 0xad, 0x66, 0xe6, 0x99, 0x69, 0xe2, 0x80, 0x69, 0x82, 0x60, 0x55, 0x83,
+			0x69, 0x82, 0x99, 0x85, 0x25, 0x80, 0x40, 0xdd, 0x87, 0x89, 0x7a, 0x60, 0xdd, 0x87, 0x35, 0x77,
+			0x99, 0x85, 0xf1, 0x74, 0x40, 0x80, 0xad, 0x72, 0x60, 0xad, 0x7c, 0xad, 0x72, 0x69, 0x7a, 0xf1,
+			0x74, 0x40, 0x25, 0x78, 0x89, 0x7a, 0x60, 0x25, 0x78, 0xdd, 0x7d, 0x69, 0x7a, 0x25, 0x80, 0x40,
+			0x80, 0x69, 0x82, 0xe2, 0xcd, 0x68, 0x55, 0x95, 0x60, 0x35, 0x67, 0x55, 0x95, 0x4c, 0x25, 0x94,
+			0x40, 0xcd, 0x64, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0xcd, 0x64, 0xf1, 0x6c, 0x4c, 0xcd, 0x6b,
+			0x40, 0xcd, 0x68, 0xad, 0x6a, 0xe6, 0x35, 0x97, 0x60, 0xcd, 0x98, 0xad, 0x6a, 0xb4, 0xdd, 0x6b,
+			0x40, 0x35, 0x9b, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0x35, 0x9b, 0xf1, 0x92, 0xb4, 0x25, 0x94,
+			0x40, 0x35, 0x97, 0x55, 0x95, 0xe6, 0xcd, 0x68, 0xe2, 0xad, 0x6e, 0x55, 0x91, 0x60, 0x11, 0x72,
+			0x25, 0x8d, 0xbd, 0x76, 0x09, 0x8b, 0x40, 0xf9, 0x7f, 0xf1, 0x88, 0x60, 0x89, 0x84, 0xf1, 0x88,
+			0x4d, 0x89, 0x09, 0x8b, 0x40, 0x55, 0x91, 0x55, 0x91, 0xe6, 0x35, 0x97, 0xe8, 0xad, 0x6e, 0xe6,
+			0xcd, 0x68, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x6e, 0xe2, 0xf1, 0x74, 0x55, 0x91, 0xe6, 0x35, 0x8b,
+			0x60, 0x25, 0x89, 0x55, 0x8f, 0x5d, 0x86, 0x25, 0x8e, 0x40, 0x80, 0xf1, 0x8c, 0x60, 0x69, 0x7c,
+			0xf1, 0x8c, 0xb5, 0x79, 0x25, 0x8e, 0x40, 0xf1, 0x74, 0x55, 0x91, 0xe2, 0x05, 0x80, 0x69, 0x7e,
+			0x60, 0x69, 0x7e, 0x69, 0x7e, 0x4d, 0x7d, 0x45, 0x7d, 0x40, 0x35, 0x7c, 0x89, 0x7a, 0x60, 0x35,
+			0x7c, 0xf1, 0x78, 0x4d, 0x7d, 0xcd, 0x77, 0x40, 0xfd, 0x7f, 0xad, 0x76, 0x60, 0x99, 0x81, 0xad,
+			0x76, 0xb5, 0x82, 0xcd, 0x77, 0x40, 0xcd, 0x83, 0x89, 0x7a, 0x60, 0xcd, 0x83, 0x25, 0x7c, 0xb5,
+			0x82, 0x45, 0x7d, 0x40, 0x05, 0x80, 0x69, 0x7e, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		CommunicationDialerSIP: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xaa, 0xb0, 0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85,
+			0x45, 0x94, 0x40, 0x55, 0x76, 0xad, 0x89, 0x60, 0x79, 0x6f, 0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a,
+			0x40, 0x50, 0x56, 0x60, 0x50, 0xb9, 0x69, 0xdd, 0x68, 0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55,
+			0x74, 0x60, 0x45, 0x75, 0x50, 0xf1, 0x75, 0xad, 0x68, 0x40, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9,
+			0x78, 0xb5, 0x72, 0x61, 0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78, 0x71, 0x74, 0x79, 0x78, 0x35, 0x75,
+			0xed, 0x77, 0xc1, 0x75, 0x00, 0x45, 0x71, 0x79, 0x7c, 0x60, 0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1,
+			0x81, 0x40, 0x35, 0x79, 0xbd, 0x86, 0x60, 0xad, 0x7b, 0x45, 0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40,
+			0x25, 0x84, 0x11, 0x8f, 0x00, 0x79, 0x8a, 0x89, 0x88, 0x60, 0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c,
+			0x89, 0x87, 0x40, 0xbd, 0x8d, 0x69, 0x87, 0x00, 0xad, 0x95, 0x25, 0x89, 0x60, 0xad, 0x96, 0x69,
+			0x89, 0x55, 0x97, 0x35, 0x8a, 0x40, 0xb0, 0x98, 0xe8, 0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97,
+			0x25, 0x97, 0x40, 0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25,
+			0x73, 0x58, 0xe6, 0x58, 0x60, 0x25, 0x6c, 0xcd, 0x6e, 0xe9, 0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f,
+			0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40,
+			0xa8, 0xa8, 0xe8, 0xdd, 0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f,
+			0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0xe2, 0x25, 0x7f, 0xad, 0x75, 0xe8, 0x79, 0x73, 0xe6, 0xdd,
+			0x84, 0xe8, 0xf1, 0x6f, 0xe6, 0x25, 0x7f, 0xe8, 0x50, 0xe6, 0x11, 0x87, 0xe8, 0x35, 0x6a, 0xe6,
+			0x55, 0x81, 0xe8, 0xbd, 0x6d, 0xe6, 0x11, 0x87, 0xe8, 0xad, 0x75, 0xe6, 0x25, 0x7f, 0xe2, 0x89,
+			0x8a, 0xad, 0x75, 0xe8, 0x50, 0xe6, 0xbd, 0x8c, 0xe8, 0xad, 0x75, 0xe6, 0x89, 0x8a, 0xe2, 0x35,
+			0x90, 0xad, 0x75, 0xe8, 0x50, 0xe6, 0x25, 0x98, 0xe8, 0xf1, 0x6f, 0xe6, 0x69, 0x92, 0xe8, 0xad,
+			0x75, 0xe6, 0x35, 0x90, 0xe2, 0x69, 0x92, 0xbd, 0x6d, 0xe6, 0xf1, 0x95, 0xe8, 0x35, 0x6a, 0xe6,
+			0x69, 0x92, 0xe8, 0xbd, 0x6d, 0xe1,
+		}},
+		CommunicationDialpad: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x9d, 0x67, 0x11, 0x7e, 0x55, 0x9d,
+			0xb5, 0x7c, 0xf5, 0x9b, 0x55, 0x7b, 0x99, 0x9a, 0x55, 0x7b, 0xa9, 0x98, 0x55, 0x7b, 0xbd, 0x96,
+			0xb5, 0x7c, 0x5d, 0x95, 0x15, 0x7e, 0xa8, 0x80, 0xa8, 0xf1, 0x81, 0xa8, 0x4d, 0x83, 0x61, 0x95,
+			0xad, 0x84, 0xc1, 0x96, 0xad, 0x84, 0xad, 0x98, 0xad, 0x84, 0x99, 0x9a, 0x4d, 0x83, 0xf9, 0x9b,
+			0xed, 0x81, 0x55, 0x9d, 0x80, 0x55, 0x9d, 0xe2, 0x55, 0x6f, 0x58, 0x67, 0x69, 0x6d, 0x58, 0x09,
+			0x6c, 0xa1, 0x6a, 0xad, 0x6a, 0x41, 0x69, 0xad, 0x6a, 0x55, 0x67, 0xad, 0x6a, 0x69, 0x65, 0x0d,
+			0x6c, 0x09, 0x64, 0x69, 0x6d, 0xad, 0x62, 0x59, 0x6f, 0xad, 0x62, 0x45, 0x71, 0xad, 0x62, 0xa5,
+			0x72, 0x0d, 0x64, 0x68, 0x69, 0x65, 0x68, 0x59, 0x67, 0x68, 0x45, 0x69, 0xa1, 0x72, 0xa5, 0x6a,
+			0x41, 0x71, 0x58, 0x55, 0x6f, 0x58, 0xe2, 0x55, 0x6f, 0x69, 0x7c, 0x67, 0x69, 0x6d, 0x69, 0x7c,
+			0x09, 0x6c, 0x09, 0x7b, 0xad, 0x6a, 0xa9, 0x79, 0xad, 0x6a, 0xbd, 0x77, 0xad, 0x6a, 0xcd, 0x75,
+			0x0d, 0x6c, 0x71, 0x74, 0x69, 0x6d, 0x11, 0x73, 0x59, 0x6f, 0x11, 0x73, 0x45, 0x71, 0x11, 0x73,
+			0xa5, 0x72, 0x71, 0x74, 0x68, 0xd1, 0x75, 0x68, 0xbd, 0x77, 0x68, 0xad, 0x79, 0xa1, 0x72, 0x09,
+			0x7b, 0x41, 0x71, 0x69, 0x7c, 0x55, 0x6f, 0x69, 0x7c, 0xe2, 0x55, 0x6f, 0xdd, 0x8c, 0x67, 0x69,
+			0x6d, 0xdd, 0x8c, 0x09, 0x6c, 0x81, 0x8b, 0xad, 0x6a, 0x21, 0x8a, 0xad, 0x6a, 0x31, 0x88, 0xad,
+			0x6a, 0x45, 0x86, 0x0d, 0x6c, 0xe9, 0x84, 0x69, 0x6d, 0x89, 0x83, 0x59, 0x6f, 0x89, 0x83, 0x45,
+			0x71, 0x89, 0x83, 0xa5, 0x72, 0xe9, 0x84, 0x68, 0x49, 0x86, 0x68, 0x35, 0x88, 0x68, 0x25, 0x8a,
+			0xa1, 0x72, 0x81, 0x8b, 0x41, 0x71, 0xdd, 0x8c, 0x55, 0x6f, 0xdd, 0x8c, 0xe2, 0xa9, 0x90, 0x58,
+			0x67, 0xbd, 0x8e, 0x58, 0x5d, 0x8d, 0xa1, 0x6a, 0x98, 0x41, 0x69, 0x98, 0x55, 0x67, 0x98, 0x69,
+			0x65, 0x61, 0x8d, 0x09, 0x64, 0xc1, 0x8e, 0xad, 0x62, 0xad, 0x90, 0xad, 0x62, 0x99, 0x92, 0xad,
+			0x62, 0xf9, 0x93, 0x0d, 0x64, 0x55, 0x95, 0x69, 0x65, 0x55, 0x95, 0x59, 0x67, 0x55, 0x95, 0x45,
+			0x69, 0xf5, 0x93, 0xa5, 0x6a, 0x99, 0x92, 0x58, 0xa9, 0x90, 0x58, 0xe2, 0x80, 0xdd, 0x8c, 0x67,
+			0x11, 0x7e, 0xdd, 0x8c, 0xb5, 0x7c, 0x81, 0x8b, 0x55, 0x7b, 0x21, 0x8a, 0x55, 0x7b, 0x31, 0x88,
+			0x55, 0x7b, 0x45, 0x86, 0xb5, 0x7c, 0xe9, 0x84, 0x15, 0x7e, 0x89, 0x83, 0x80, 0x89, 0x83, 0xf1,
+			0x81, 0x89, 0x83, 0x4d, 0x83, 0xe9, 0x84, 0xad, 0x84, 0x49, 0x86, 0xad, 0x84, 0x35, 0x88, 0xad,
+			0x84, 0x25, 0x8a, 0x4d, 0x83, 0x81, 0x8b, 0xed, 0x81, 0xdd, 0x8c, 0x80, 0xdd, 0x8c, 0xe2, 0xa9,
+			0x90, 0xdd, 0x8c, 0x67, 0xbd, 0x8e, 0xdd, 0x8c, 0x5d, 0x8d, 0x81, 0x8b, 0x98, 0x21, 0x8a, 0x98,
+			0x31, 0x88, 0x98, 0x45, 0x86, 0x61, 0x8d, 0xe9, 0x84, 0xc1, 0x8e, 0x89, 0x83, 0xad, 0x90, 0x89,
+			0x83, 0x99, 0x92, 0x89, 0x83, 0xf9, 0x93, 0xe9, 0x84, 0x55, 0x95, 0x49, 0x86, 0x55, 0x95, 0x35,
+			0x88, 0x55, 0x95, 0x25, 0x8a, 0xf5, 0x93, 0x81, 0x8b, 0x99, 0x92, 0xdd, 0x8c, 0xa9, 0x90, 0xdd,
+			0x8c, 0xe2, 0xa9, 0x90, 0x69, 0x7c, 0x67, 0xbd, 0x8e, 0x69, 0x7c, 0x5d, 0x8d, 0x09, 0x7b, 0x98,
+			0xa9, 0x79, 0x98, 0xbd, 0x77, 0x98, 0xcd, 0x75, 0x61, 0x8d, 0x71, 0x74, 0xc1, 0x8e, 0x11, 0x73,
+			0xad, 0x90, 0x11, 0x73, 0x99, 0x92, 0x11, 0x73, 0xf9, 0x93, 0x71, 0x74, 0x55, 0x95, 0xd1, 0x75,
+			0x55, 0x95, 0xbd, 0x77, 0x55, 0x95, 0xad, 0x79, 0xf5, 0x93, 0x09, 0x7b, 0x99, 0x92, 0x69, 0x7c,
+			0xa9, 0x90, 0x69, 0x7c, 0xe2, 0x80, 0x69, 0x7c, 0x67, 0x11, 0x7e, 0x69, 0x7c, 0xb5, 0x7c, 0x09,
+			0x7b, 0x55, 0x7b, 0xa9, 0x79, 0x55, 0x7b, 0xbd, 0x77, 0x55, 0x7b, 0xcd, 0x75, 0xb5, 0x7c, 0x71,
+			0x74, 0x15, 0x7e, 0x11, 0x73, 0x80, 0x11, 0x73, 0xf1, 0x81, 0x11, 0x73, 0x4d, 0x83, 0x71, 0x74,
+			0xad, 0x84, 0xd1, 0x75, 0xad, 0x84, 0xbd, 0x77, 0xad, 0x84, 0xad, 0x79, 0x4d, 0x83, 0x09, 0x7b,
+			0xed, 0x81, 0x69, 0x7c, 0x80, 0x69, 0x7c, 0xe2, 0x80, 0x58, 0x67, 0x11, 0x7e, 0x58, 0xb5, 0x7c,
+			0xa1, 0x6a, 0x55, 0x7b, 0x41, 0x69, 0x55, 0x7b, 0x55, 0x67, 0x55, 0x7b, 0x69, 0x65, 0xb5, 0x7c,
+			0x09, 0x64, 0x15, 0x7e, 0xad, 0x62, 0x80, 0xad, 0x62, 0xf1, 0x81, 0xad, 0x62, 0x4d, 0x83, 0x0d,
+			0x64, 0xad, 0x84, 0x69, 0x65, 0xad, 0x84, 0x59, 0x67, 0xad, 0x84, 0x45, 0x69, 0x4d, 0x83, 0xa5,
+			0x6a, 0xed, 0x81, 0x58, 0x80, 0x58, 0xe1,
+		}},
+		CommunicationEmail: CommunicationEmail,
+		CommunicationForum: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x72, 0xa0, 0x60, 0xcd, 0x71, 0xa0, 0xe9,
+			0x70, 0x11, 0x8f, 0x40, 0x60, 0x35, 0x8d, 0xe8, 0xad, 0x86, 0xe6, 0x55, 0x91, 0xe8, 0x60, 0xe6,
+			0xb0, 0x60, 0xf1, 0x98, 0x60, 0xcd, 0x99, 0xf1, 0x70, 0x40, 0xad, 0x9a, 0xdd, 0x72, 0xe8, 0x99,
+			0x9a, 0x00, 0x11, 0x90, 0xa0, 0xe6, 0xbd, 0x72, 0xe2, 0x55, 0x65, 0x55, 0x8d, 0xe8, 0x25, 0x68,
+			0x60, 0x55, 0x65, 0x35, 0x67, 0x35, 0x66, 0x45, 0x66, 0x40, 0x50, 0x55, 0x65, 0xe6, 0x99, 0x8a,
+			0x60, 0x99, 0x8b, 0x55, 0x65, 0x79, 0x8c, 0x3d, 0x66, 0x40, 0x55, 0x8d, 0x25, 0x68, 0xe8, 0xdd,
+			0x7f, 0x60, 0x55, 0x8d, 0xcd, 0x80, 0x79, 0x8c, 0xbd, 0x81, 0x40, 0x99, 0x8a, 0xad, 0x82, 0xe6,
+			0x60, 0x00, 0x55, 0x65, 0x55, 0x8d, 0xe2, 0x55, 0x89, 0xad, 0x7e, 0xe8, 0x55, 0x69, 0xe6, 0x55,
+			0x69, 0xe8, 0x88, 0x00, 0x45, 0x6e, 0xad, 0x7e, 0xe6, 0x55, 0x89, 0xe2, 0x55, 0x69, 0xad, 0x7e,
+			0xe8, 0x55, 0x69, 0xe8, 0xad, 0x7e, 0xe1,
+		}, mirror: true},
+		CommunicationImportContacts: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x70, 0x98, 0x60, 0x1d, 0x74, 0x98, 0x7d,
+			0x77, 0xd5, 0x8c, 0x40, 0x25, 0x7e, 0x55, 0x8f, 0xe8, 0xdd, 0x72, 0x62, 0x25, 0x7b, 0xdd, 0x70,
+			0xa1, 0x77, 0xc5, 0x6f, 0x21, 0x74, 0xad, 0x6e, 0x89, 0x70, 0xad, 0x6e, 0x5c, 0xad, 0x6e, 0x91,
+			0x6b, 0x4d, 0x6f, 0x40, 0xad, 0x66, 0xdd, 0x70, 0xe8, 0xcd, 0x8d, 0x60, 0xbd, 0x68, 0xdd, 0x8c,
+			0x5d, 0x6b, 0x71, 0x8c, 0x40, 0x89, 0x70, 0x98, 0xe2, 0x25, 0x82, 0x55, 0x8f, 0x60, 0x79, 0x85,
+			0xad, 0x8d, 0xad, 0x88, 0xd5, 0x8c, 0x40, 0x79, 0x8f, 0x98, 0x60, 0xa4, 0x98, 0xb5, 0x94, 0x69,
+			0x8c, 0x40, 0x55, 0x99, 0x79, 0x8d, 0xe8, 0xdd, 0x70, 0x62, 0x11, 0x97, 0xbd, 0x6f, 0x8d, 0x94,
+			0x35, 0x6f, 0x09, 0x92, 0xad, 0x6e, 0x79, 0x8f, 0xad, 0x6e, 0xdd, 0x8b, 0xad, 0x6e, 0x81, 0x88,
+			0xc5, 0x6f, 0x40, 0x25, 0x82, 0xdd, 0x72, 0xe8, 0x55, 0x8f, 0xe2, 0x25, 0x80, 0x55, 0x95, 0x60,
+			0xbd, 0x7c, 0xcd, 0x92, 0xbd, 0x78, 0x71, 0x91, 0x40, 0x89, 0x70, 0x11, 0x90, 0x63, 0x19, 0x6e,
+			0x11, 0x90, 0xc1, 0x6b, 0xad, 0x90, 0x69, 0x69, 0x45, 0x91, 0x11, 0x67, 0x25, 0x92, 0x89, 0x65,
+			0xdd, 0x92, 0x19, 0x64, 0xf1, 0x91, 0xad, 0x62, 0xa2, 0xad, 0x62, 0x45, 0x8f, 0xe8, 0x69, 0x70,
+			0x60, 0xad, 0x62, 0x69, 0x6f, 0x25, 0x63, 0x91, 0x6e, 0x40, 0x89, 0x64, 0x45, 0x6d, 0x62, 0x55,
+			0x67, 0xf1, 0x6b, 0x5d, 0x6a, 0x4d, 0x6b, 0x65, 0x6d, 0xad, 0x6a, 0x89, 0x70, 0xad, 0x6a, 0xbd,
+			0x74, 0xad, 0x6a, 0xb5, 0x78, 0xcd, 0x6b, 0x40, 0x25, 0x80, 0x45, 0x6f, 0x60, 0x89, 0x83, 0xf1,
+			0x6c, 0x71, 0x87, 0xcd, 0x6b, 0x40, 0x79, 0x8f, 0xad, 0x6a, 0x62, 0x99, 0x92, 0xad, 0x6a, 0x99,
+			0x95, 0x4d, 0x6b, 0x99, 0x98, 0xf1, 0x6b, 0x69, 0x9b, 0x45, 0x6d, 0x55, 0x9c, 0xbd, 0x6d, 0xd5,
+			0x9c, 0x91, 0x6e, 0x40, 0x55, 0x9d, 0x69, 0x70, 0xe8, 0x45, 0x8f, 0x64, 0x55, 0x9d, 0x21, 0x91,
+			0xd5, 0x9b, 0x19, 0x92, 0x55, 0x9a, 0x11, 0x93, 0xdd, 0x98, 0x25, 0x92, 0x99, 0x96, 0x35, 0x91,
+			0x41, 0x94, 0xa5, 0x90, 0xe9, 0x91, 0x11, 0x90, 0x79, 0x8f, 0x11, 0x90, 0x45, 0x8b, 0x11, 0x90,
+			0x69, 0x87, 0x79, 0x91, 0x40, 0x25, 0x80, 0x55, 0x95, 0xe2, 0x69, 0x72, 0x69, 0x7f, 0xe1,
+		}},
+		CommunicationImportExport: CommunicationImportExport,
+		CommunicationInvertColorsOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x92, 0x99, 0x8d, 0x00, 0x80, 0x25, 0x7b,
+			0xe8, 0xf1, 0x6a, 0x03, 0xdd, 0x77, 0x66, 0x6a, 0x25, 0x70, 0x80, 0x55, 0x65, 0x11, 0x8f, 0x25,
+			0x74, 0x60, 0x25, 0x92, 0x25, 0x77, 0xbd, 0x93, 0x3d, 0x7b, 0x40, 0x55, 0x95, 0x11, 0x83, 0x60,
+			0x55, 0x95, 0xdd, 0x85, 0x89, 0x94, 0xc5, 0x88, 0x40, 0x79, 0x92, 0x99, 0x8d, 0xe2, 0xbd, 0x95,
+			0x45, 0x9c, 0x00, 0x11, 0x8d, 0x99, 0x93, 0x60, 0x11, 0x8a, 0xdd, 0x95, 0xc5, 0x86, 0xf1, 0x96,
+			0x40, 0x80, 0xb0, 0x60, 0x35, 0x77, 0xb0, 0xf1, 0x70, 0xe9, 0x91, 0x40, 0xad, 0x6a, 0x86, 0x60,
+			0xad, 0x6a, 0x99, 0x7f, 0xbd, 0x6b, 0x55, 0x7c, 0x40, 0x55, 0x6f, 0xdd, 0x75, 0x03, 0xcd, 0x63,
+			0x55, 0x6a, 0xad, 0x66, 0x79, 0x67, 0x99, 0x98, 0x69, 0x99, 0xbd, 0x95, 0x45, 0x9c, 0xe2, 0x80,
+			0xa8, 0xe8, 0x79, 0x86, 0x00, 0x35, 0x72, 0xad, 0x78, 0x60, 0x35, 0x70, 0x25, 0x7b, 0x71, 0x6f,
+			0xcd, 0x7d, 0x40, 0xad, 0x6e, 0x86, 0x60, 0xad, 0x6e, 0x25, 0x8a, 0xc5, 0x73, 0x11, 0x8f, 0x40,
+			0x80, 0xa8, 0xe1,
+		}},
+		CommunicationLiveHelp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x80, 0x9a, 0x61, 0x79, 0x81, 0x9a, 0x35,
+			0x82, 0x45, 0x8c, 0xf1, 0x82, 0x89, 0x8b, 0xf1, 0x82, 0x79, 0x8a, 0x40, 0x35, 0x82, 0xad, 0x88,
+			0x60, 0x79, 0x81, 0xf1, 0x87, 0x65, 0x80, 0xf1, 0x87, 0x40, 0x99, 0x7e, 0xad, 0x88, 0x60, 0xdd,
+			0x7d, 0x69, 0x89, 0xdd, 0x7d, 0x79, 0x8a, 0x40, 0x9d, 0x7e, 0x45, 0x8c, 0x60, 0x59, 0x7f, 0x9a,
+			0x69, 0x80, 0x9a, 0xe2, 0x35, 0x7e, 0x25, 0x83, 0xe6, 0x84, 0x60, 0x84, 0x25, 0x82, 0x25, 0x82,
+			0x5d, 0x81, 0x40, 0x99, 0x82, 0xdd, 0x7f, 0x60, 0xf1, 0x82, 0x25, 0x7f, 0x89, 0x83, 0x69, 0x7e,
+			0x40, 0x05, 0x85, 0xcd, 0x7c, 0x60, 0x35, 0x87, 0x99, 0x7a, 0x11, 0x88, 0xe9, 0x78, 0x40, 0xf1,
+			0x88, 0x55, 0x75, 0x60, 0xf1, 0x88, 0xcd, 0x71, 0xad, 0x86, 0xbd, 0x6f, 0x40, 0x91, 0x80, 0xad,
+			0x6d, 0x61, 0x1d, 0x7d, 0xad, 0x6d, 0xb1, 0x7a, 0x4d, 0x6f, 0x45, 0x78, 0xf1, 0x70, 0x25, 0x77,
+			0xcd, 0x73, 0x00, 0xad, 0x7a, 0x45, 0x75, 0x62, 0x99, 0x7b, 0x69, 0x73, 0x15, 0x7d, 0x71, 0x72,
+			0x91, 0x7e, 0x79, 0x71, 0x89, 0x80, 0x79, 0x71, 0xad, 0x82, 0x79, 0x71, 0xe9, 0x83, 0x89, 0x72,
+			0x40, 0x25, 0x85, 0x79, 0x75, 0x60, 0x25, 0x85, 0xcd, 0x76, 0x69, 0x84, 0x09, 0x78, 0x40, 0xf1,
+			0x81, 0xf9, 0x7a, 0x63, 0xcd, 0x80, 0x78, 0x19, 0x80, 0xdd, 0x7c, 0x69, 0x7f, 0xb9, 0x7d, 0xf1,
+			0x7e, 0xad, 0x7e, 0x89, 0x7e, 0xa1, 0x7f, 0x61, 0x7e, 0xad, 0x80, 0x35, 0x7e, 0xbd, 0x81, 0x35,
+			0x7e, 0x25, 0x83, 0xe2, 0x80, 0x55, 0x9d, 0x00, 0x70, 0x55, 0x95, 0xe6, 0x58, 0x60, 0x69, 0x6a,
+			0x55, 0x95, 0x35, 0x69, 0x19, 0x94, 0x40, 0x50, 0x55, 0x91, 0xe8, 0x55, 0x69, 0x60, 0x50, 0xbd,
+			0x67, 0x35, 0x69, 0x89, 0x66, 0x40, 0x58, 0x55, 0x65, 0xe6, 0xa8, 0x60, 0x89, 0x95, 0x55, 0x65,
+			0xc5, 0x96, 0x89, 0x66, 0x40, 0xb0, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x60, 0xb0, 0xdd, 0x92, 0xc5,
+			0x96, 0x19, 0x94, 0x40, 0xa8, 0x55, 0x95, 0xe6, 0x90, 0x00, 0x80, 0x55, 0x9d, 0xe2, 0x58, 0x55,
+			0x91, 0xe6, 0x99, 0x79, 0x01, 0x80, 0xbd, 0x97, 0x69, 0x86, 0x55, 0x91, 0xe6, 0xa8, 0xe8, 0x55,
+			0x69, 0xe6, 0x58, 0xe8, 0x55, 0x91, 0xe2, 0x80, 0x89, 0x7d, 0xe1,
+		}},
+		CommunicationLocationOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x84, 0xad, 0x7a, 0x62, 0xad, 0x84, 0xbd,
+			0x78, 0x4d, 0x83, 0x61, 0x77, 0xed, 0x81, 0x6c, 0x80, 0x6c, 0x11, 0x7f, 0x6c, 0x4d, 0x7e, 0x55,
+			0x76, 0x40, 0xf1, 0x7c, 0x35, 0x77, 0x00, 0x79, 0x83, 0xbd, 0x7d, 0x60, 0x88, 0x25, 0x7d, 0x55,
+			0x84, 0x5d, 0x7c, 0x40, 0xad, 0x84, 0xad, 0x7a, 0xe2, 0xcd, 0x8f, 0x11, 0x8a, 0x00, 0xdd, 0x8c,
+			0x25, 0x87, 0x60, 0x25, 0x8f, 0xbd, 0x83, 0x3d, 0x90, 0xbd, 0x80, 0x40, 0x55, 0x91, 0x35, 0x7b,
+			0x62, 0x55, 0x91, 0x59, 0x73, 0x51, 0x8c, 0x59, 0x6e, 0x4d, 0x87, 0x55, 0x69, 0x80, 0x55, 0x69,
+			0x69, 0x7c, 0x55, 0x69, 0x4d, 0x79, 0x91, 0x6a, 0x40, 0xdd, 0x73, 0x25, 0x6e, 0x00, 0x11, 0x71,
+			0x55, 0x6b, 0x60, 0x11, 0x74, 0x69, 0x68, 0xf5, 0x77, 0xdd, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x79, 0x88, 0x55, 0x65, 0xe9, 0x8e, 0x45, 0x6b, 0x40, 0x55, 0x95, 0x35, 0x7b, 0x60, 0x55, 0x95,
+			0x99, 0x7e, 0xf9, 0x93, 0x4d, 0x82, 0x40, 0xcd, 0x8f, 0x11, 0x8a, 0xe2, 0xcd, 0x87, 0x89, 0x8d,
+			0x00, 0x89, 0x6f, 0x45, 0x75, 0x60, 0x11, 0x6f, 0x99, 0x76, 0xdd, 0x6e, 0x19, 0x78, 0x40, 0xad,
+			0x6e, 0x35, 0x7b, 0x60, 0xad, 0x6e, 0x35, 0x80, 0x66, 0xc5, 0x86, 0x40, 0x80, 0x69, 0x95, 0x60,
+			0x45, 0x82, 0x55, 0x93, 0x35, 0x84, 0x5d, 0x91, 0x40, 0xcd, 0x87, 0x89, 0x8d, 0xe2, 0x89, 0x97,
+			0x45, 0x9d, 0x00, 0xad, 0x8a, 0x69, 0x90, 0x60, 0x79, 0x88, 0xdd, 0x92, 0xd5, 0x85, 0x71, 0x95,
+			0x40, 0x80, 0xad, 0x9a, 0x60, 0x45, 0x75, 0x89, 0x91, 0xf9, 0x6f, 0xb5, 0x89, 0x40, 0xad, 0x6a,
+			0x35, 0x7b, 0x61, 0xad, 0x6a, 0xb1, 0x78, 0x19, 0x6b, 0x69, 0x76, 0x89, 0x6b, 0x25, 0x74, 0x69,
+			0x6c, 0x25, 0x72, 0x03, 0xbd, 0x61, 0x79, 0x67, 0x99, 0x64, 0x99, 0x64, 0x69, 0x9a, 0x69, 0x9a,
+			0x89, 0x97, 0x45, 0x9d, 0xe2, 0xad, 0x7b, 0x69, 0x81, 0xe2, 0x69, 0x80, 0xad, 0x7a, 0xe1,
+		}},
+		CommunicationLocationOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x7f, 0x67, 0xf1, 0x81, 0x55, 0x7f,
+			0x4d, 0x83, 0xf5, 0x7d, 0xad, 0x84, 0x99, 0x7c, 0xad, 0x84, 0xa9, 0x7a, 0xad, 0x84, 0xbd, 0x78,
+			0x4d, 0x83, 0x5d, 0x77, 0xed, 0x81, 0x6c, 0x80, 0x6c, 0x11, 0x7e, 0x6c, 0xb5, 0x7c, 0x61, 0x77,
+			0x55, 0x7b, 0xc1, 0x78, 0x55, 0x7b, 0xad, 0x7a, 0x55, 0x7b, 0x99, 0x7c, 0xb5, 0x7c, 0xf9, 0x7d,
+			0x15, 0x7e, 0x55, 0x7f, 0x80, 0x55, 0x7f, 0xe2, 0x80, 0x69, 0x95, 0x60, 0xdd, 0x88, 0x55, 0x8d,
+			0x19, 0x8d, 0xc5, 0x86, 0x40, 0x55, 0x91, 0x35, 0x7b, 0x61, 0x55, 0x91, 0x59, 0x73, 0x51, 0x8c,
+			0x59, 0x6e, 0x4d, 0x87, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xb1, 0x73, 0x59, 0x6e, 0x61, 0xad,
+			0x6e, 0x59, 0x73, 0xad, 0x6e, 0x35, 0x7b, 0xad, 0x6e, 0x35, 0x80, 0x66, 0xc5, 0x86, 0x40, 0x80,
+			0x69, 0x95, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x45, 0x75, 0x89, 0x91, 0xf9, 0x6f, 0xb5, 0x89, 0x40,
+			0xad, 0x6a, 0x35, 0x7b, 0x60, 0xad, 0x6a, 0x35, 0x71, 0x19, 0x71, 0x45, 0x6b, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x79, 0x88, 0x55, 0x65, 0xe9, 0x8e, 0x45, 0x6b, 0x40, 0x55, 0x95, 0x35, 0x7b, 0x60,
+			0x55, 0x95, 0xdd, 0x81, 0x09, 0x90, 0xb5, 0x89, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x7a,
+			0xe1,
+		}},
+		CommunicationMailOutline: CommunicationMailOutline,
+		CommunicationMessage:     CommunicationMessage,
+		CommunicationNoSIM: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x95, 0x35, 0x90, 0x00, 0x55, 0x91, 0x35,
+			0x8c, 0xe8, 0x55, 0x69, 0xe6, 0x79, 0x7c, 0x02, 0x79, 0x75, 0x55, 0x70, 0x89, 0x72, 0x69, 0x6d,
+			0x99, 0x7a, 0x55, 0x65, 0xe6, 0x55, 0x91, 0x60, 0xfd, 0x92, 0x55, 0x65, 0x29, 0x94, 0x89, 0x66,
+			0x40, 0x55, 0x95, 0x55, 0x69, 0xe8, 0x35, 0x90, 0xe2, 0x69, 0x98, 0x89, 0x9e, 0x01, 0x25, 0x70,
+			0x35, 0x76, 0xad, 0x6e, 0xad, 0x77, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0xdd, 0x91, 0x00,
+			0x55, 0x95, 0xdd, 0x95, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99,
+			0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0x60, 0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79,
+			0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0xad, 0x75, 0x04, 0x25, 0x6d, 0x45, 0x73, 0x99, 0x62,
+			0xbd, 0x68, 0x69, 0x65, 0xf1, 0x65, 0x35, 0x9b, 0xbd, 0x9b, 0x69, 0x98, 0x89, 0x9e, 0xe2, 0xad,
+			0x83, 0x89, 0x7e, 0xe2, 0x99, 0x80, 0xad, 0x86, 0xe1,
+		}},
+		CommunicationPhone:          CommunicationPhone,
+		CommunicationPhoneLinkErase: CommunicationPhoneLinkErase,
+		CommunicationPhoneLinkLock:  CommunicationPhoneLinkLock,
+		CommunicationPhoneLinkRing:  CommunicationPhoneLinkRing,
+		CommunicationPhoneLinkSetup: CommunicationPhoneLinkSetup,
+		CommunicationPortableWiFiOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x96, 0xf1, 0x9b, 0x00, 0x25, 0x75, 0xdd,
+			0x7a, 0x60, 0x99, 0x74, 0x78, 0x4d, 0x74, 0x4d, 0x7d, 0x40, 0x68, 0x80, 0x60, 0x68, 0xbd, 0x82,
+			0x11, 0x75, 0x8a, 0x40, 0x70, 0xf1, 0x88, 0x00, 0x25, 0x75, 0xcd, 0x8b, 0x60, 0xcd, 0x72, 0x99,
+			0x89, 0x69, 0x71, 0x91, 0x86, 0x40, 0x60, 0x80, 0x60, 0x60, 0xcd, 0x7d, 0x91, 0x70, 0xc5, 0x7b,
+			0x40, 0x35, 0x72, 0xf1, 0x77, 0x00, 0x55, 0x6d, 0x11, 0x73, 0x60, 0x79, 0x6b, 0xdd, 0x75, 0x69,
+			0x6a, 0x25, 0x79, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0xf1, 0x84, 0x3d, 0x6b, 0x25, 0x89,
+			0x40, 0x79, 0x70, 0x79, 0x90, 0x00, 0x99, 0x6d, 0x55, 0x93, 0x60, 0xcd, 0x69, 0xad, 0x8f, 0x91,
+			0x67, 0xb5, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x89, 0x7b, 0xb5, 0x66, 0x89, 0x77,
+			0x40, 0x79, 0x6a, 0x35, 0x70, 0x03, 0x11, 0x64, 0xcd, 0x69, 0xf1, 0x66, 0xf1, 0x66, 0x11, 0x99,
+			0x11, 0x99, 0x35, 0x96, 0xf1, 0x9b, 0xe2, 0x89, 0x95, 0xcd, 0x8f, 0x00, 0x99, 0x92, 0xdd, 0x8c,
+			0x60, 0x89, 0x94, 0x25, 0x8a, 0x99, 0x95, 0xdd, 0x86, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96,
+			0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x69, 0x7c, 0x55, 0x69, 0x25,
+			0x79, 0x5d, 0x6a, 0x40, 0x11, 0x73, 0x55, 0x6d, 0x00, 0x35, 0x70, 0x79, 0x6a, 0x60, 0x99, 0x73,
+			0x11, 0x68, 0x91, 0x77, 0xb5, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69,
+			0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99,
+			0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x69, 0x84, 0x4d, 0x99, 0x69, 0x88, 0x40, 0x89,
+			0x95, 0xcd, 0x8f, 0xe2, 0xcd, 0x8d, 0x11, 0x88, 0x00, 0xdd, 0x8a, 0x25, 0x85, 0x60, 0x69, 0x8b,
+			0x88, 0xb5, 0x8b, 0xb5, 0x82, 0x40, 0x98, 0x80, 0x60, 0x98, 0x76, 0x81, 0x88, 0x81, 0x77, 0x40,
+			0x80, 0x68, 0x60, 0x99, 0x7e, 0x68, 0x4d, 0x7d, 0x4d, 0x74, 0x40, 0xdd, 0x7a, 0x25, 0x75, 0x00,
+			0xf1, 0x77, 0x35, 0x72, 0x60, 0xad, 0x79, 0x25, 0x71, 0xbd, 0x7b, 0x91, 0x70, 0x40, 0x80, 0x60,
+			0x60, 0xad, 0x86, 0x60, 0x55, 0x8b, 0xad, 0x74, 0x40, 0xa0, 0x80, 0x60, 0xa0, 0x35, 0x82, 0x71,
+			0x8f, 0x45, 0x84, 0x40, 0xcd, 0x8d, 0x11, 0x88, 0xe1,
+		}},
+		CommunicationPresentToAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7e, 0x25, 0x8a, 0xe6, 0x84, 0xe8, 0x35,
+			0x7d, 0x05, 0x45, 0x87, 0x99, 0x82, 0x25, 0x8a, 0xbd, 0x7f, 0xf1, 0x7f, 0x99, 0x75, 0xcd, 0x75,
+			0xbd, 0x7f, 0xad, 0x78, 0x99, 0x82, 0x11, 0x7e, 0x35, 0x7d, 0xe8, 0x25, 0x8a, 0xe2, 0x55, 0x69,
+			0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91,
+			0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad,
+			0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a,
+			0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad,
+			0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad,
+			0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8,
+			0x55, 0x91, 0xe1,
+		}},
+		CommunicationRSSFeed: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x6e, 0xb0, 0x60, 0xcd, 0x6b, 0xb0, 0xe9,
+			0x69, 0x19, 0x96, 0x40, 0x50, 0x79, 0x91, 0x60, 0x50, 0xbd, 0x8e, 0xe9, 0x69, 0xd5, 0x8c, 0x40,
+			0x89, 0x6e, 0xf1, 0x8a, 0x60, 0x45, 0x71, 0xf1, 0x8a, 0x2d, 0x73, 0xd5, 0x8c, 0x40, 0x11, 0x75,
+			0x79, 0x91, 0x60, 0x11, 0x75, 0x35, 0x94, 0x2d, 0x73, 0x19, 0x96, 0x40, 0x89, 0x6e, 0xb0, 0xe2,
+			0x55, 0x8f, 0xb0, 0x60, 0x55, 0x8f, 0xdd, 0x8f, 0x3d, 0x8c, 0xb5, 0x88, 0x40, 0xcd, 0x83, 0x35,
+			0x7c, 0x60, 0x79, 0x7e, 0xdd, 0x76, 0x4d, 0x77, 0xc5, 0x73, 0x40, 0x50, 0xad, 0x70, 0xe8, 0xad,
+			0x6a, 0x60, 0x69, 0x71, 0xad, 0x6a, 0xa5, 0x79, 0x3d, 0x6e, 0x40, 0x09, 0x88, 0xf9, 0x77, 0x60,
+			0x35, 0x8e, 0x25, 0x7e, 0xc5, 0x91, 0x5d, 0x86, 0x40, 0x55, 0x95, 0xb0, 0xe6, 0x55, 0x8f, 0xe2,
+			0x79, 0x7f, 0xb0, 0x60, 0x79, 0x7f, 0x79, 0x8d, 0x72, 0xbd, 0x86, 0x40, 0x50, 0x80, 0xe8, 0x74,
+			0x60, 0x79, 0x6e, 0x74, 0xdd, 0x73, 0x45, 0x7c, 0x40, 0x2d, 0x7d, 0x89, 0x82, 0x60, 0x11, 0x81,
+			0x89, 0x86, 0x45, 0x83, 0x09, 0x8c, 0x40, 0x79, 0x85, 0xb0, 0xe6, 0x79, 0x7f, 0xe1,
+		}},
+		CommunicationRingVolume: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x69, 0x45, 0x96, 0x00, 0xcd, 0x62, 0xa0,
+			0x60, 0x35, 0x62, 0x69, 0x8f, 0x3d, 0x62, 0x99, 0x8e, 0x40, 0xcd, 0x62, 0x25, 0x8d, 0x60, 0x45,
+			0x68, 0xbd, 0x86, 0xf1, 0x6f, 0x5d, 0x83, 0x40, 0x80, 0x80, 0x60, 0x69, 0x88, 0x80, 0x11, 0x90,
+			0x5d, 0x83, 0x40, 0x35, 0x9d, 0x25, 0x8d, 0x60, 0xbd, 0x9d, 0xcd, 0x8d, 0xc5, 0x9d, 0x99, 0x8e,
+			0x40, 0x35, 0x9d, 0xa0, 0x00, 0xf1, 0x96, 0x45, 0x96, 0x60, 0x69, 0x96, 0xcd, 0x96, 0x69, 0x95,
+			0xdd, 0x96, 0x40, 0xcd, 0x93, 0x79, 0x96, 0x00, 0x35, 0x8c, 0xcd, 0x90, 0x60, 0xcd, 0x8b, 0x81,
+			0x90, 0x99, 0x8b, 0x19, 0x90, 0x40, 0x69, 0x8b, 0x35, 0x8f, 0xe8, 0xf1, 0x85, 0x60, 0x99, 0x88,
+			0xdd, 0x84, 0xb5, 0x85, 0x71, 0x84, 0x40, 0x80, 0x88, 0x60, 0x35, 0x7d, 0x88, 0x4d, 0x7a, 0x71,
+			0x84, 0x40, 0x99, 0x74, 0xf1, 0x85, 0xe8, 0x35, 0x8f, 0x60, 0x99, 0x74, 0xb5, 0x8f, 0x69, 0x74,
+			0x19, 0x90, 0x40, 0xcd, 0x73, 0xcd, 0x90, 0x00, 0x31, 0x6c, 0x7d, 0x96, 0x61, 0x69, 0x6b, 0x11,
+			0x97, 0x8d, 0x6a, 0xae, 0xb5, 0x69, 0xf1, 0x96, 0x11, 0x69, 0x45, 0x96, 0xe2, 0x45, 0x70, 0x55,
+			0x87, 0x60, 0xbd, 0x6d, 0x99, 0x88, 0x69, 0x6b, 0x5d, 0x8a, 0x40, 0xf1, 0x66, 0x25, 0x8e, 0x01,
+			0xbd, 0x6a, 0x11, 0x92, 0x45, 0x70, 0x9c, 0xe8, 0x55, 0x87, 0xe2, 0x69, 0x8f, 0x11, 0x87, 0xe8,
+			0x99, 0x8d, 0x01, 0x35, 0x95, 0xa4, 0x11, 0x99, 0x25, 0x8e, 0x60, 0xf1, 0x96, 0xf1, 0x8b, 0x81,
+			0x94, 0x3d, 0x8a, 0x40, 0x69, 0x8f, 0x11, 0x87, 0xe2, 0x45, 0x70, 0x55, 0x87, 0xe2, 0x69, 0x8f,
+			0x11, 0x87, 0xe2, 0x7c, 0xdd, 0x71, 0xe8, 0x55, 0x65, 0xe6, 0x84, 0xe8, 0xdd, 0x71, 0xe6, 0x7c,
+			0xe2, 0x55, 0x8f, 0x55, 0x79, 0x03, 0x79, 0x8c, 0x69, 0x76, 0x55, 0x95, 0xad, 0x6d, 0xf1, 0x97,
+			0x99, 0x70, 0x55, 0x8f, 0x55, 0x79, 0xe2, 0xf1, 0x70, 0x55, 0x79, 0x03, 0x50, 0x99, 0x70, 0xdd,
+			0x6a, 0xad, 0x6d, 0x89, 0x73, 0x69, 0x76, 0xf1, 0x70, 0x55, 0x79, 0xe1,
+		}},
+		CommunicationScreenShare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x76, 0x35, 0x86, 0xe6, 0x35, 0x7a, 0xe8,
+			0x35, 0x80, 0x61, 0x35, 0x7a, 0x9d, 0x7e, 0x45, 0x7b, 0x8d, 0x7d, 0x59, 0x7c, 0x79, 0x7c, 0xf1,
+			0x7d, 0x79, 0x7c, 0xe6, 0x55, 0x83, 0xe8, 0x25, 0x81, 0x01, 0x94, 0x79, 0x7a, 0x55, 0x83, 0xcd,
+			0x73, 0xe8, 0x79, 0x78, 0xe6, 0xf1, 0x7d, 0x61, 0xb9, 0x7a, 0x79, 0x78, 0x75, 0x78, 0xb9, 0x7a,
+			0x35, 0x76, 0xfd, 0x7c, 0x35, 0x76, 0x35, 0x80, 0xe8, 0x35, 0x86, 0xe2, 0xad, 0x64, 0xb0, 0x62,
+			0xd1, 0x63, 0xb0, 0x3d, 0x63, 0x6d, 0x97, 0xad, 0x62, 0xd9, 0x96, 0xad, 0x62, 0xfd, 0x95, 0xad,
+			0x62, 0x25, 0x95, 0x3d, 0x63, 0x91, 0x94, 0x40, 0xad, 0x64, 0xa8, 0xe6, 0x55, 0x9b, 0x62, 0x31,
+			0x9c, 0xa8, 0xc5, 0x9c, 0x95, 0x94, 0x55, 0x9d, 0x29, 0x95, 0x55, 0x9d, 0x05, 0x96, 0x55, 0x9d,
+			0xdd, 0x96, 0xc5, 0x9c, 0x71, 0x97, 0x40, 0x55, 0x9b, 0xb0, 0xe6, 0xad, 0x64, 0xe2, 0x55, 0x69,
+			0xa0, 0x60, 0xbd, 0x67, 0xa0, 0x89, 0x66, 0xcd, 0x8e, 0x40, 0x55, 0x65, 0x98, 0xe8, 0x58, 0x60,
+			0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96, 0x60,
+			0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0x98, 0x60, 0xad, 0x9a,
+			0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69,
+			0x98, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8,
+			0x58, 0xe8, 0x98, 0xe1,
+		}},
+		CommunicationSpeakerPhone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x73, 0x25, 0x73, 0x00, 0xcd, 0x70, 0x45,
+			0x70, 0x60, 0xbd, 0x73, 0xad, 0x6d, 0xa5, 0x77, 0x25, 0x6c, 0x40, 0x80, 0x99, 0x6a, 0x60, 0x79,
+			0x84, 0x99, 0x6a, 0x5d, 0x88, 0x25, 0x6c, 0x40, 0x35, 0x8f, 0x45, 0x70, 0x00, 0x55, 0x8c, 0x25,
+			0x73, 0x60, 0xbd, 0x89, 0xcd, 0x70, 0x89, 0x86, 0xb5, 0x6f, 0x40, 0x80, 0x99, 0x6e, 0x60, 0xad,
+			0x7c, 0x99, 0x6e, 0x79, 0x79, 0xb5, 0x6f, 0x40, 0xad, 0x73, 0x25, 0x73, 0xe2, 0x35, 0x6e, 0x99,
+			0x6d, 0x00, 0x55, 0x6b, 0xbd, 0x6a, 0x60, 0x35, 0x6f, 0x25, 0x67, 0xad, 0x74, 0xdd, 0x64, 0x40,
+			0x80, 0x99, 0x62, 0x60, 0xdd, 0x85, 0x99, 0x62, 0x55, 0x8b, 0xdd, 0x64, 0x40, 0xad, 0x94, 0xbd,
+			0x6a, 0x00, 0xcd, 0x91, 0x99, 0x6d, 0x60, 0x35, 0x8e, 0x55, 0x6a, 0xb5, 0x89, 0x79, 0x68, 0x40,
+			0x80, 0x99, 0x66, 0x60, 0xcd, 0x7a, 0x99, 0x66, 0x4d, 0x76, 0x79, 0x68, 0x40, 0x35, 0x6e, 0x99,
+			0x6d, 0xe2, 0x99, 0x78, 0xad, 0x9a, 0x60, 0x6e, 0xad, 0x9a, 0xcd, 0x75, 0x79, 0x99, 0x40, 0x99,
+			0x74, 0xad, 0x96, 0xe8, 0x69, 0x7d, 0x60, 0x99, 0x74, 0xcd, 0x7b, 0xcd, 0x75, 0x99, 0x7a, 0x40,
+			0x99, 0x78, 0x69, 0x79, 0xe6, 0x55, 0x87, 0x60, 0xf1, 0x88, 0x69, 0x79, 0x25, 0x8a, 0x99, 0x7a,
+			0x40, 0x55, 0x8b, 0x69, 0x7d, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x8b, 0x45, 0x98, 0x25, 0x8a, 0x79,
+			0x99, 0x40, 0x55, 0x87, 0xad, 0x9a, 0xe6, 0x99, 0x78, 0xe2, 0x55, 0x87, 0xad, 0x96, 0xe8, 0x69,
+			0x7d, 0xe6, 0x99, 0x78, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x87, 0xe2, 0x55, 0x87, 0xad, 0x96, 0xe6,
+			0x99, 0x78, 0xe6, 0x55, 0x87, 0xe1,
+		}},
+		CommunicationStayCurrentLandscape: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x66, 0xad, 0x92, 0x60, 0x11, 0x65, 0xad,
+			0x92, 0xdd, 0x63, 0x79, 0x91, 0x40, 0xad, 0x62, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0xad, 0x62,
+			0xbd, 0x6f, 0xdd, 0x63, 0x89, 0x6e, 0x40, 0xad, 0x66, 0x55, 0x6d, 0xe6, 0x55, 0x99, 0x60, 0xf1,
+			0x9a, 0x55, 0x6d, 0x25, 0x9c, 0x89, 0x6e, 0x40, 0x55, 0x9d, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60,
+			0x55, 0x9d, 0x45, 0x90, 0x25, 0x9c, 0x79, 0x91, 0x40, 0x55, 0x99, 0xad, 0x92, 0xe6, 0xad, 0x66,
+			0xe2, 0xad, 0x68, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe8, 0xad, 0x8e, 0xe6, 0xad,
+			0x68, 0xe2, 0xad, 0x6c, 0xad, 0x8e, 0xe6, 0x55, 0x93, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x6c, 0xe8,
+			0xad, 0x8e, 0xe2, 0x55, 0x97, 0xad, 0x8e, 0xe6, 0x55, 0x99, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x97,
+			0xe8, 0xad, 0x8e, 0xe2, 0xad, 0x68, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe6, 0xad, 0x68, 0xe2, 0x55,
+			0x97, 0x55, 0x71, 0xe6, 0x55, 0x99, 0xe6, 0x55, 0x97, 0xe1,
+		}},
+		CommunicationStayCurrentPortrait: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55,
+			0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d,
+			0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45,
+			0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60,
+			0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x97, 0xe6, 0x55,
+			0x71, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8,
+			0x55, 0x93, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x55,
+			0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		CommunicationStayPrimaryLandscape: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x66, 0xad, 0x92, 0x60, 0x11, 0x65, 0xad,
+			0x92, 0xdd, 0x63, 0x79, 0x91, 0x40, 0xad, 0x62, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0xad, 0x62,
+			0xbd, 0x6f, 0xdd, 0x63, 0x89, 0x6e, 0x40, 0xad, 0x66, 0x55, 0x6d, 0xe6, 0x55, 0x99, 0x60, 0xf1,
+			0x9a, 0x55, 0x6d, 0x25, 0x9c, 0x89, 0x6e, 0x40, 0x55, 0x9d, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60,
+			0x55, 0x9d, 0x45, 0x90, 0x25, 0x9c, 0x79, 0x91, 0x40, 0x55, 0x99, 0xad, 0x92, 0xe6, 0xad, 0x66,
+			0xe2, 0xad, 0x68, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe8, 0xad, 0x8e, 0xe6, 0xad,
+			0x68, 0xe2, 0xad, 0x6c, 0xad, 0x8e, 0xe6, 0x55, 0x93, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x6c, 0xe8,
+			0xad, 0x8e, 0xe2, 0x55, 0x97, 0xad, 0x8e, 0xe6, 0x55, 0x99, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x97,
+			0xe8, 0xad, 0x8e, 0xe2, 0xad, 0x68, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe6, 0xad, 0x68, 0xe2, 0x55,
+			0x97, 0x55, 0x71, 0xe6, 0x55, 0x99, 0xe6, 0x55, 0x97, 0xe1,
+		}},
+		CommunicationStayPrimaryPortrait: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xf1, 0x8c, 0x67, 0x11, 0x7f, 0xf1, 0x8c,
+			0x71, 0x7e, 0x4d, 0x8c, 0xcd, 0x7d, 0xad, 0x8b, 0xcd, 0x7d, 0xbd, 0x8a, 0xcd, 0x7d, 0xcd, 0x89,
+			0x71, 0x7e, 0x2d, 0x89, 0x11, 0x7f, 0x89, 0x88, 0x80, 0x89, 0x88, 0xf1, 0x80, 0x89, 0x88, 0x91,
+			0x81, 0x2d, 0x89, 0x35, 0x82, 0xcd, 0x89, 0x35, 0x82, 0xbd, 0x8a, 0x35, 0x82, 0xad, 0x8b, 0x91,
+			0x81, 0x4d, 0x8c, 0xf1, 0x80, 0xf1, 0x8c, 0x80, 0xf1, 0x8c, 0xe2, 0x11, 0x7e, 0x99, 0x85, 0xe8,
+			0xbd, 0x73, 0xe6, 0x11, 0x82, 0xe8, 0x99, 0x85, 0xe6, 0x11, 0x7e, 0xe2, 0x55, 0x71, 0x55, 0x9d,
+			0x60, 0xbd, 0x6f, 0x55, 0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad,
+			0x66, 0x60, 0x55, 0x6d, 0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6,
+			0xad, 0x8e, 0x60, 0x45, 0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66,
+			0xe8, 0x55, 0x99, 0x60, 0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55,
+			0x9d, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8,
+			0x55, 0x97, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c,
+			0xe6, 0x55, 0x71, 0xe8, 0x55, 0x93, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad,
+			0x66, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8,
+			0xad, 0x68, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		CommunicationStopScreenShare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x84, 0xad, 0x7f, 0x00, 0x99, 0x7d, 0x79,
+			0x78, 0xe6, 0x55, 0x83, 0xe8, 0xcd, 0x73, 0x01, 0x94, 0x79, 0x7a, 0xcd, 0x84, 0xad, 0x7f, 0xe2,
+			0xad, 0x95, 0x99, 0x90, 0x00, 0xad, 0x91, 0x99, 0x8c, 0xe6, 0x45, 0x96, 0xe8, 0x69, 0x6c, 0xe6,
+			0x79, 0x71, 0x00, 0x79, 0x6d, 0x69, 0x68, 0xe6, 0x45, 0x96, 0x60, 0xdd, 0x97, 0x69, 0x68, 0x11,
+			0x99, 0x99, 0x69, 0x40, 0x45, 0x9a, 0x69, 0x6c, 0xe8, 0x99, 0x8c, 0x60, 0x45, 0x9a, 0x55, 0x8e,
+			0xf1, 0x98, 0x89, 0x8f, 0x40, 0xad, 0x95, 0x99, 0x90, 0xe2, 0xcd, 0x97, 0x25, 0x9e, 0x00, 0x55,
+			0x91, 0xad, 0x97, 0xe6, 0xad, 0x62, 0xe8, 0xad, 0x93, 0xe6, 0x55, 0x8d, 0x00, 0x45, 0x8a, 0x99,
+			0x90, 0xe6, 0xcd, 0x69, 0x60, 0x35, 0x68, 0x99, 0x90, 0x4e, 0x69, 0x8f, 0x40, 0xcd, 0x65, 0x99,
+			0x8c, 0xe8, 0x25, 0x6c, 0x03, 0x25, 0x62, 0x79, 0x68, 0x4a, 0x99, 0x65, 0xad, 0x9a, 0x45, 0x9b,
+			0xcd, 0x97, 0x25, 0x9e, 0xe2, 0x35, 0x7a, 0x89, 0x80, 0xe8, 0x35, 0x86, 0xe6, 0x35, 0x76, 0xe8,
+			0x35, 0x80, 0x60, 0x35, 0x76, 0x89, 0x7f, 0x55, 0x76, 0xb5, 0x7e, 0x40, 0xcd, 0x76, 0x2d, 0x7d,
+			0x00, 0xcd, 0x69, 0x25, 0x70, 0xe8, 0x99, 0x8c, 0xe6, 0x45, 0x86, 0x00, 0x35, 0x7a, 0x89, 0x80,
+			0xe2, 0x99, 0x81, 0x79, 0x7c, 0xe2, 0x11, 0x78, 0x55, 0x7e, 0xe1,
+		}},
+		CommunicationSwapCalls: CommunicationSwapCalls,
+		CommunicationTextSMS:   CommunicationTextSMS,
+		CommunicationVPNKey: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0xa0, 0x60, 0x58, 0xa0, 0x55, 0x67,
+			0x55, 0x8b, 0x40, 0xad, 0x62, 0x80, 0x60, 0xad, 0x62, 0x55, 0x79, 0x55, 0x67, 0xad, 0x74, 0x40,
+			0xad, 0x72, 0x60, 0x60, 0xdd, 0x77, 0x60, 0x71, 0x7b, 0x81, 0x72, 0x40, 0xbd, 0x80, 0x25, 0x79,
+			0xe6, 0x55, 0x9d, 0xe8, 0xdd, 0x86, 0xe6, 0x45, 0x96, 0xe8, 0xa0, 0xe6, 0xdd, 0x89, 0xe8, 0xdd,
+			0x86, 0xe6, 0xbd, 0x80, 0x60, 0x7e, 0x96, 0x71, 0x7b, 0x81, 0x8d, 0x40, 0xad, 0x72, 0xa0, 0xe2,
+			0xad, 0x72, 0x98, 0x60, 0x69, 0x77, 0x98, 0x71, 0x7a, 0xdd, 0x88, 0x40, 0x7c, 0xdd, 0x82, 0xe6,
+			0x25, 0x8e, 0xe8, 0x98, 0xe6, 0x45, 0x92, 0xe8, 0xdd, 0x82, 0xe6, 0x55, 0x99, 0xe8, 0x25, 0x7d,
+			0xe6, 0x7c, 0x60, 0x79, 0x7d, 0x45, 0x7a, 0x71, 0x7a, 0x25, 0x77, 0x40, 0xad, 0x72, 0x68, 0x60,
+			0xad, 0x6d, 0x68, 0x2d, 0x6a, 0x81, 0x77, 0x40, 0xad, 0x66, 0x80, 0x60, 0xad, 0x66, 0x8a, 0x2d,
+			0x6a, 0x81, 0x88, 0x40, 0xad, 0x72, 0x98, 0xe2, 0xad, 0x72, 0x89, 0x84, 0x60, 0x99, 0x74, 0x89,
+			0x84, 0xe9, 0x75, 0x3d, 0x83, 0x40, 0x35, 0x77, 0x80, 0x60, 0x35, 0x77, 0x11, 0x7e, 0xe9, 0x75,
+			0xc5, 0x7c, 0x40, 0xad, 0x72, 0x79, 0x7b, 0x60, 0xbd, 0x70, 0x79, 0x7b, 0x71, 0x6f, 0xc5, 0x7c,
+			0x40, 0x25, 0x6e, 0x80, 0x60, 0x25, 0x6e, 0xf1, 0x81, 0x71, 0x6f, 0x3d, 0x83, 0x40, 0xad, 0x72,
+			0x89, 0x84, 0xe2, 0xad, 0x72, 0x80, 0xe1,
+		}},
+		CommunicationVoicemail: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x70, 0xad, 0x8a, 0x60, 0xbd, 0x6b, 0xad,
+			0x8a, 0x3d, 0x68, 0x2d, 0x87, 0x40, 0xbd, 0x64, 0xad, 0x7e, 0x60, 0xbd, 0x64, 0xad, 0x79, 0x3d,
+			0x68, 0x2d, 0x76, 0x40, 0xbd, 0x70, 0xad, 0x72, 0x60, 0xbd, 0x75, 0xad, 0x72, 0x3d, 0x79, 0x2d,
+			0x76, 0x40, 0xbd, 0x7c, 0xad, 0x7e, 0x61, 0xbd, 0x7c, 0xe9, 0x80, 0xd5, 0x7b, 0xfd, 0x82, 0xf1,
+			0x7a, 0x11, 0x85, 0x69, 0x79, 0xad, 0x86, 0xe6, 0xad, 0x86, 0x60, 0x25, 0x85, 0x8a, 0x3d, 0x84,
+			0xf9, 0x82, 0x40, 0x55, 0x83, 0xad, 0x7e, 0x60, 0x55, 0x83, 0xad, 0x79, 0xd5, 0x86, 0x2d, 0x76,
+			0x40, 0x55, 0x8f, 0xad, 0x72, 0x60, 0x55, 0x94, 0xad, 0x72, 0xdd, 0x97, 0x2d, 0x76, 0x40, 0x69,
+			0x9b, 0xad, 0x7e, 0x61, 0x69, 0x9b, 0xad, 0x83, 0xe1, 0x97, 0x2d, 0x87, 0x5d, 0x94, 0xad, 0x8a,
+			0x55, 0x8f, 0xad, 0x8a, 0xe6, 0xbd, 0x70, 0xe2, 0xbd, 0x70, 0xad, 0x86, 0x67, 0x19, 0x74, 0xad,
+			0x86, 0x69, 0x76, 0x59, 0x84, 0xbd, 0x78, 0x09, 0x82, 0xbd, 0x78, 0xad, 0x7e, 0xbd, 0x78, 0x51,
+			0x7b, 0x69, 0x76, 0xfd, 0x78, 0x19, 0x74, 0xad, 0x76, 0xbd, 0x70, 0xad, 0x76, 0x61, 0x6d, 0xad,
+			0x76, 0x0d, 0x6b, 0xfd, 0x78, 0xbd, 0x68, 0x51, 0x7b, 0xbd, 0x68, 0xad, 0x7e, 0xbd, 0x68, 0x09,
+			0x82, 0x0d, 0x6b, 0x59, 0x84, 0x61, 0x6d, 0xad, 0x86, 0xbd, 0x70, 0xad, 0x86, 0xe2, 0x55, 0x8f,
+			0xad, 0x86, 0x67, 0xb1, 0x92, 0xad, 0x86, 0x05, 0x95, 0x59, 0x84, 0x55, 0x97, 0x09, 0x82, 0x55,
+			0x97, 0xad, 0x7e, 0x55, 0x97, 0x51, 0x7b, 0x05, 0x95, 0xfd, 0x78, 0xb1, 0x92, 0xad, 0x76, 0x55,
+			0x8f, 0xad, 0x76, 0xf9, 0x8b, 0xad, 0x76, 0xa9, 0x89, 0xfd, 0x78, 0x55, 0x87, 0x51, 0x7b, 0x55,
+			0x87, 0xad, 0x7e, 0x55, 0x87, 0x09, 0x82, 0xa9, 0x89, 0x59, 0x84, 0xf9, 0x8b, 0xad, 0x86, 0x55,
+			0x8f, 0xad, 0x86, 0xe1,
+		}},
+		ContentAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x84, 0xe6, 0x55, 0x6d, 0xe8, 0x7c, 0xe6,
+			0x7c, 0xe8, 0x55, 0x6d, 0xe6, 0x84, 0xe8, 0x7c, 0xe6, 0xad, 0x92, 0xe8, 0x84, 0xe6, 0x84, 0xe8,
+			0xad, 0x92, 0xe6, 0x7c, 0xe8, 0x84, 0xe1,
+		}},
+		ContentAddBox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x55, 0x8d, 0xe6, 0x84, 0xe8, 0x84, 0xe6,
+			0x55, 0x8d, 0xe8, 0x7c, 0xe6, 0x84, 0xe8, 0xad, 0x72, 0xe6, 0x7c, 0xe8, 0x7c, 0xe6, 0xad, 0x72,
+			0xe8, 0x84, 0xe6, 0x7c, 0xe8, 0x55, 0x8d, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58,
+			0xe1,
+		}},
+		ContentAddCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7e, 0x55, 0x8d, 0xe6, 0x35, 0x82, 0xe8,
+			0x45, 0x82, 0xe6, 0x55, 0x8d, 0xe8, 0x45, 0x7e, 0xe6, 0x35, 0x82, 0xe8, 0xad, 0x72, 0xe6, 0x35,
+			0x7e, 0xe8, 0x45, 0x7e, 0xe6, 0xad, 0x72, 0xe8, 0x45, 0x82, 0xe6, 0x35, 0x7e, 0xe8, 0x55, 0x8d,
+			0xe2, 0x05, 0x80, 0xad, 0x9a, 0x60, 0x81, 0x7a, 0xad, 0x9a, 0xa9, 0x75, 0x91, 0x98, 0x40, 0x2d,
+			0x6d, 0xd5, 0x92, 0x63, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x59, 0x8a, 0x55, 0x65, 0x7d, 0x85,
+			0x55, 0x65, 0xf9, 0x7f, 0x55, 0x65, 0x75, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x89, 0x69, 0xbd, 0x70,
+			0x2d, 0x6d, 0x25, 0x6d, 0x40, 0xa9, 0x75, 0x71, 0x67, 0x62, 0x85, 0x7a, 0x55, 0x65, 0x09, 0x80,
+			0x55, 0x65, 0x8d, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x45, 0x8f, 0x89, 0x69, 0xdd, 0x92,
+			0x25, 0x6d, 0x40, 0x91, 0x98, 0x99, 0x75, 0x61, 0xad, 0x9a, 0x79, 0x7a, 0xad, 0x9a, 0xfd, 0x7f,
+			0xad, 0x9a, 0x81, 0x85, 0x91, 0x98, 0x59, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x61, 0x45, 0x8f,
+			0x71, 0x96, 0x69, 0x8a, 0x8d, 0x98, 0x89, 0x85, 0xad, 0x9a, 0x05, 0x80, 0xad, 0x9a, 0xe2, 0x09,
+			0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0xf9,
+			0x7f, 0x64, 0xad, 0x96, 0x89, 0x76, 0x15, 0x90, 0xf1, 0x6f, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55,
+			0x69, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xed, 0x6f, 0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80,
+			0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x09, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80,
+			0xe1,
+		}},
+		ContentAddCircleOutline: ContentAddCircleOutline,
+		ContentArchive: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x25, 0x71, 0x60, 0x50, 0x25, 0x70, 0x35, 0x68, 0x71, 0x6f,
+			0x40, 0xf1, 0x68, 0x25, 0x6e, 0x00, 0xad, 0x6c, 0x11, 0x69, 0x60, 0x35, 0x6d, 0x79, 0x68, 0xe9,
+			0x6d, 0x3d, 0x68, 0x40, 0x91, 0x6f, 0x50, 0xe6, 0x71, 0x90, 0x60, 0x69, 0x91, 0x50, 0x11, 0x92,
+			0x3d, 0x68, 0x40, 0x45, 0x93, 0x11, 0x69, 0x00, 0x11, 0x97, 0x25, 0x6e, 0x60, 0x99, 0x97, 0xbd,
+			0x6e, 0xcd, 0x97, 0x71, 0x6f, 0x40, 0xb0, 0x25, 0x71, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd,
+			0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x25, 0x6d, 0x11, 0x6f, 0xe6, 0xcd, 0x92,
+			0x00, 0x61, 0x90, 0x58, 0xe6, 0x89, 0x6f, 0x00, 0x25, 0x6d, 0x11, 0x6f, 0xe2, 0x58, 0x11, 0x73,
+			0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x11, 0x73, 0xe6, 0x58, 0xe2, 0x80, 0x9c, 0x02, 0x69, 0x8a, 0x99,
+			0x83, 0xbd, 0x87, 0xf1, 0x80, 0x84, 0xad, 0x86, 0xe8, 0x45, 0x79, 0xe6, 0x7c, 0xe8, 0xad, 0x86,
+			0x02, 0x45, 0x78, 0xf1, 0x80, 0x99, 0x75, 0x99, 0x83, 0x80, 0x9c, 0xe2, 0x58, 0xa8, 0xe6, 0xa8,
+			0xe6, 0x58, 0xe1,
+		}},
+		ContentBackspace: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x70, 0xad, 0x92, 0x60, 0x89, 0x76, 0xad, 0x92,
+			0x55, 0x75, 0xe9, 0x91, 0x40, 0x45, 0x73, 0xf1, 0x8f, 0x01, 0x50, 0x80, 0x45, 0x73, 0x11, 0x70,
+			0x60, 0x25, 0x74, 0xdd, 0x6e, 0x55, 0x75, 0x19, 0x6e, 0x40, 0x70, 0x55, 0x6d, 0xe6, 0xa8, 0x60,
+			0xa9, 0x95, 0x55, 0x6d, 0xd5, 0x96, 0x85, 0x6e, 0x40, 0xb0, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60,
+			0xb0, 0x51, 0x90, 0xd5, 0x96, 0x7d, 0x91, 0x40, 0xa8, 0xad, 0x92, 0xe6, 0x70, 0xe2, 0xa8, 0xad,
+			0x8e, 0xe8, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x45, 0x77, 0xad, 0x8e, 0xe6, 0xa8, 0xe8, 0x55,
+			0x71, 0xe6, 0x45, 0x77, 0x01, 0x5a, 0x80, 0x45, 0x77, 0xad, 0x8e, 0xe2, 0xdd, 0x7d, 0x45, 0x8a,
+			0x0b, 0x55, 0x85, 0xcd, 0x82, 0xcd, 0x8c, 0x45, 0x8a, 0xad, 0x8f, 0x69, 0x87, 0x25, 0x88, 0x80,
+			0x89, 0x8f, 0x99, 0x78, 0xad, 0x8c, 0xbd, 0x75, 0x55, 0x85, 0x35, 0x7d, 0xdd, 0x7d, 0xbd, 0x75,
+			0x76, 0x99, 0x78, 0x89, 0x82, 0x80, 0x76, 0x69, 0x87, 0xdd, 0x7d, 0x45, 0x8a, 0xe1,
+		}, mirror: true},
+		ContentBlock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a,
+			0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67,
+			0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60,
+			0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89,
+			0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x63, 0x7d, 0x89, 0xad, 0x96,
+			0x15, 0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0xad, 0x96, 0xf5, 0x7b, 0x45,
+			0x95, 0x39, 0x78, 0xdd, 0x93, 0x79, 0x74, 0x55, 0x91, 0x69, 0x71, 0x00, 0x69, 0x71, 0x55, 0x91,
+			0x61, 0x69, 0x74, 0xf1, 0x93, 0x2d, 0x78, 0x4d, 0x95, 0xf1, 0x7b, 0xad, 0x96, 0x80, 0xad, 0x96,
+			0xe2, 0xbd, 0x6e, 0x99, 0x8e, 0x00, 0x99, 0x8e, 0xbd, 0x6e, 0x60, 0x89, 0x8b, 0x25, 0x6c, 0xc9,
+			0x87, 0xbd, 0x6a, 0x40, 0x80, 0x55, 0x69, 0x63, 0x85, 0x76, 0x55, 0x69, 0xed, 0x6f, 0xed, 0x6f,
+			0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x55, 0x69, 0x11, 0x84, 0xcd, 0x6a, 0xd5, 0x87, 0x45,
+			0x6c, 0x99, 0x8b, 0xbd, 0x6e, 0x99, 0x8e, 0xe1,
+		}},
+		ContentClear: ContentClear,
+		ContentContentCopy: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x99, 0x9a, 0x60, 0x69, 0x6a, 0x99, 0x9a,
+			0x35, 0x69, 0x69, 0x99, 0x40, 0x50, 0x99, 0x96, 0xe8, 0x69, 0x6e, 0xe6, 0x58, 0xe8, 0x99, 0x96,
+			0xe6, 0x99, 0x8b, 0xe8, 0x99, 0x9a, 0xe6, 0x58, 0xe2, 0x68, 0x99, 0x92, 0x60, 0x69, 0x72, 0x99,
+			0x92, 0x35, 0x71, 0x69, 0x91, 0x40, 0x60, 0x99, 0x8e, 0xe8, 0x45, 0x69, 0x60, 0x60, 0xad, 0x67,
+			0x35, 0x71, 0x79, 0x66, 0x40, 0x68, 0x45, 0x65, 0xe6, 0x55, 0x91, 0x60, 0xf1, 0x92, 0x45, 0x65,
+			0x25, 0x94, 0x79, 0x66, 0x40, 0x55, 0x95, 0x45, 0x69, 0xe8, 0x99, 0x8e, 0x60, 0x55, 0x95, 0x35,
+			0x90, 0x25, 0x94, 0x69, 0x91, 0x40, 0x55, 0x91, 0x99, 0x92, 0xe6, 0x68, 0xe2, 0x68, 0x99, 0x8e,
+			0xe6, 0x55, 0x91, 0xe8, 0x45, 0x69, 0xe6, 0x68, 0xe8, 0x99, 0x8e, 0xe2, 0x68, 0x99, 0x8e, 0xe8,
+			0x45, 0x69, 0xe8, 0x99, 0x8e, 0xe1,
+		}},
+		ContentContentCut: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x94, 0x69, 0x98, 0x01, 0x11, 0x80, 0x55,
+			0x84, 0x45, 0x78, 0x25, 0x8c, 0x60, 0x72, 0x45, 0x8d, 0x2d, 0x79, 0x55, 0x8e, 0x40, 0x55, 0x79,
+			0xad, 0x90, 0x60, 0x55, 0x79, 0xf1, 0x94, 0x79, 0x76, 0xcd, 0x97, 0x40, 0x55, 0x6f, 0xad, 0x9a,
+			0x60, 0x11, 0x6b, 0xad, 0x9a, 0x35, 0x68, 0xcd, 0x97, 0x40, 0x55, 0x65, 0xad, 0x90, 0x60, 0x55,
+			0x65, 0x69, 0x8c, 0x35, 0x68, 0x89, 0x89, 0x40, 0x55, 0x6f, 0xad, 0x86, 0x60, 0x89, 0x70, 0xad,
+			0x86, 0xb5, 0x71, 0x8e, 0x40, 0x25, 0x74, 0x90, 0x01, 0xdd, 0x7b, 0x45, 0x80, 0x68, 0x69, 0x78,
+			0x60, 0xdd, 0x72, 0xf1, 0x78, 0xb5, 0x71, 0x25, 0x79, 0x40, 0x55, 0x6f, 0x55, 0x79, 0x60, 0x11,
+			0x6b, 0x55, 0x79, 0x35, 0x68, 0x79, 0x76, 0x40, 0x55, 0x65, 0x55, 0x6f, 0x60, 0x55, 0x65, 0x11,
+			0x6b, 0x35, 0x68, 0x35, 0x68, 0x40, 0x55, 0x6f, 0x55, 0x65, 0x60, 0x99, 0x73, 0x55, 0x65, 0x79,
+			0x76, 0x35, 0x68, 0x40, 0x55, 0x79, 0x55, 0x6f, 0x60, 0x55, 0x79, 0x99, 0x70, 0x2d, 0x79, 0xbd,
+			0x71, 0x40, 0x79, 0x78, 0xdd, 0x73, 0x00, 0xbd, 0x9a, 0x25, 0x96, 0xe8, 0x69, 0x98, 0xe6, 0x25,
+			0x94, 0xe2, 0xf1, 0x87, 0xdd, 0x7c, 0x01, 0x89, 0x83, 0x79, 0x78, 0x25, 0x94, 0xdd, 0x67, 0xe6,
+			0xbd, 0x9a, 0xe8, 0x11, 0x6a, 0x00, 0xf1, 0x87, 0xdd, 0x7c, 0xe2, 0x55, 0x6f, 0x55, 0x75, 0x60,
+			0xdd, 0x71, 0x55, 0x75, 0x99, 0x73, 0x99, 0x73, 0x40, 0x55, 0x75, 0x55, 0x6f, 0x60, 0x55, 0x75,
+			0xcd, 0x6c, 0x99, 0x73, 0x11, 0x6b, 0x40, 0x55, 0x6f, 0x55, 0x69, 0x60, 0xcd, 0x6c, 0x55, 0x69,
+			0x11, 0x6b, 0x11, 0x6b, 0x40, 0x55, 0x69, 0x55, 0x6f, 0x60, 0x55, 0x69, 0xdd, 0x71, 0x11, 0x6b,
+			0x99, 0x73, 0x40, 0x55, 0x6f, 0x55, 0x75, 0xe2, 0x35, 0x80, 0x89, 0x81, 0x60, 0xbd, 0x80, 0x89,
+			0x81, 0x19, 0x81, 0x2d, 0x81, 0x40, 0x79, 0x81, 0x45, 0x80, 0x60, 0x79, 0x81, 0xbd, 0x7f, 0x19,
+			0x81, 0x5d, 0x7f, 0x40, 0x35, 0x80, 0x7e, 0x60, 0xad, 0x7f, 0x7e, 0x4d, 0x7f, 0x5d, 0x7f, 0x40,
+			0xf1, 0x7e, 0x45, 0x80, 0x60, 0xf1, 0x7e, 0xcd, 0x80, 0x4d, 0x7f, 0x2d, 0x81, 0x40, 0x35, 0x80,
+			0x89, 0x81, 0xe2, 0x55, 0x6f, 0xad, 0x96, 0x60, 0xdd, 0x71, 0xad, 0x96, 0x99, 0x73, 0xf1, 0x94,
+			0x40, 0x55, 0x75, 0xad, 0x90, 0x60, 0x55, 0x75, 0x25, 0x8e, 0x99, 0x73, 0x69, 0x8c, 0x40, 0x55,
+			0x6f, 0xad, 0x8a, 0x60, 0xcd, 0x6c, 0xad, 0x8a, 0x11, 0x6b, 0x69, 0x8c, 0x40, 0x55, 0x69, 0xad,
+			0x90, 0x60, 0x55, 0x69, 0x35, 0x93, 0x11, 0x6b, 0xf1, 0x94, 0x40, 0x55, 0x6f, 0xad, 0x96, 0xe1,
+		}},
+		ContentContentPaste: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x45, 0x6a, 0xb0, 0x25, 0x69,
+			0xdd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x45, 0x6a, 0x25, 0x69, 0x25, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0x79, 0x79, 0x60, 0xf1, 0x79, 0xad, 0x65, 0xc5, 0x7b, 0x2d, 0x64, 0x40, 0x80,
+			0xad, 0x62, 0x60, 0x69, 0x82, 0xad, 0x62, 0x3d, 0x84, 0x2d, 0x64, 0x40, 0x89, 0x86, 0x50, 0xe6,
+			0xa8, 0x60, 0xbd, 0x95, 0x50, 0xdd, 0x96, 0x25, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0,
+			0xbd, 0x95, 0xdd, 0x96, 0xdd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8,
+			0xe8, 0x58, 0xe6, 0xa0, 0xe8, 0x64, 0xe6, 0x60, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x80,
+			0x58, 0x60, 0x25, 0x81, 0x58, 0xe9, 0x81, 0x3d, 0x6b, 0x40, 0xad, 0x82, 0x55, 0x69, 0x60, 0xad,
+			0x82, 0x35, 0x68, 0xe9, 0x81, 0x71, 0x67, 0x40, 0x80, 0xad, 0x66, 0x60, 0xdd, 0x7e, 0xad, 0x66,
+			0x19, 0x7e, 0x71, 0x67, 0x40, 0x55, 0x7d, 0x55, 0x69, 0x60, 0x55, 0x7d, 0x79, 0x6a, 0x19, 0x7e,
+			0x3d, 0x6b, 0x40, 0x80, 0x58, 0xe1,
+		}},
+		ContentCreate: ContentCreate,
+		ContentDeleteSweep: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x90, 0xad, 0x90, 0xe8, 0xad, 0x8c, 0xe6, 0xad,
+			0x91, 0xe8, 0xad, 0x90, 0xe6, 0x90, 0xe2, 0x90, 0x25, 0x78, 0xe8, 0x25, 0x74, 0xe6, 0xad, 0x9a,
+			0xe8, 0x25, 0x78, 0xe6, 0x90, 0xe2, 0x90, 0x69, 0x84, 0xe8, 0x69, 0x80, 0xe6, 0xad, 0x97, 0xe8,
+			0x69, 0x84, 0xe6, 0x90, 0xe2, 0x55, 0x68, 0x66, 0xe6, 0x55, 0x65, 0xe8, 0x5e, 0xe6, 0xad, 0x70,
+			0xe8, 0x58, 0xe6, 0xad, 0x79, 0xe8, 0x5e, 0xe6, 0x8a, 0xe8, 0x66, 0xe6, 0x84, 0xe8, 0xad, 0x8e,
+			0x60, 0x84, 0x45, 0x90, 0xcd, 0x80, 0x79, 0x91, 0x40, 0x7c, 0xad, 0x92, 0xe6, 0x55, 0x6c, 0x60,
+			0xbd, 0x6a, 0xad, 0x92, 0x89, 0x69, 0x79, 0x91, 0x40, 0x55, 0x68, 0xad, 0x8e, 0xe8, 0x66, 0xe2,
+			0x55, 0x6c, 0x66, 0xe8, 0xad, 0x8e, 0xe6, 0x7c, 0xe8, 0x66, 0xe6, 0x55, 0x6c, 0xe2, 0x55, 0x6c,
+			0x66, 0xe8, 0xad, 0x8e, 0xe8, 0x66, 0xe1,
+		}},
+		ContentDrafts: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x62, 0x00, 0xbd, 0x98, 0x79, 0x71,
+			0x60, 0xdd, 0x99, 0x11, 0x72, 0x45, 0x9a, 0x19, 0x73, 0x40, 0xad, 0x9a, 0x25, 0x75, 0xe8, 0xa8,
+			0x60, 0xad, 0x9a, 0x99, 0x95, 0x79, 0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69,
+			0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0x25, 0x75, 0x60,
+			0x55, 0x65, 0x25, 0x74, 0xc5, 0x65, 0x19, 0x73, 0x40, 0x45, 0x67, 0x79, 0x71, 0x00, 0x80, 0xad,
+			0x62, 0xe2, 0x80, 0xbd, 0x81, 0x03, 0x69, 0x96, 0x99, 0x74, 0x80, 0x25, 0x67, 0x99, 0x69, 0x99,
+			0x74, 0x80, 0xbd, 0x81, 0xe2, 0x80, 0x35, 0x86, 0x00, 0x55, 0x69, 0xdd, 0x78, 0xe8, 0xa8, 0xe6,
+			0xad, 0x96, 0xe8, 0xdd, 0x78, 0x00, 0x80, 0x35, 0x86, 0xe2, 0x80, 0xa8, 0xe6, 0xad, 0x96, 0xe6,
+			0x55, 0x69, 0xe6, 0x80, 0xe1,
+		}},
+		ContentFilterList: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7a, 0xa0, 0xe8, 0x98, 0xe6, 0x55, 0x85,
+			0xe8, 0xa0, 0xe6, 0xad, 0x7a, 0xe2, 0x60, 0x84, 0xe8, 0x7c, 0xe6, 0xa0, 0xe8, 0x84, 0xe6, 0x60,
+			0xe2, 0x50, 0x68, 0xe8, 0x60, 0xe6, 0xb0, 0xe8, 0x68, 0xe6, 0x50, 0xe1,
+		}, mirror: true},
+		ContentFlag: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xb0, 0xe8, 0xad, 0x6a, 0xe6, 0x35,
+			0x84, 0x00, 0x79, 0x85, 0x69, 0x70, 0xe6, 0x55, 0x95, 0xe8, 0x11, 0x89, 0xe6, 0x45, 0x84, 0x00,
+			0x86, 0x69, 0x83, 0xe6, 0x55, 0x71, 0xe8, 0xb0, 0xe6, 0x55, 0x6d, 0xe2, 0x55, 0x81, 0xdd, 0x79,
+			0xe2, 0xad, 0x87, 0x11, 0x85, 0xe6, 0x55, 0x91, 0xe8, 0x69, 0x74, 0xe6, 0x11, 0x82, 0x00, 0xcd,
+			0x80, 0xad, 0x6e, 0xe6, 0x55, 0x71, 0xe8, 0x69, 0x7f, 0xe6, 0x69, 0x86, 0x00, 0xad, 0x87, 0x11,
+			0x85, 0xe1,
+		}},
+		ContentFontDownload: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x71, 0xa0, 0xe6, 0x11, 0x76, 0x00, 0x11,
+			0x79, 0xbd, 0x87, 0xe6, 0x8e, 0x00, 0x94, 0xa0, 0xe6, 0x69, 0x8e, 0x00, 0x25, 0x82, 0x60, 0xe6,
+			0xcd, 0x7d, 0x00, 0x99, 0x71, 0xa0, 0xe2, 0x79, 0x7a, 0x11, 0x84, 0x00, 0xf1, 0x7f, 0xbd, 0x74,
+			0xe6, 0x11, 0x80, 0x00, 0x89, 0x85, 0x11, 0x84, 0xe6, 0x79, 0x7a, 0xe2, 0x55, 0x69, 0xad, 0x9a,
+			0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55,
+			0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69,
+			0xe8, 0xad, 0x96, 0x60, 0xad, 0x9a, 0x45, 0x98, 0x79, 0x99, 0x79, 0x99, 0x40, 0xad, 0x96, 0xad,
+			0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x69, 0x69, 0xad, 0x96, 0xe6, 0xbd, 0x96, 0xe8, 0x55, 0x69, 0xe6,
+			0x69, 0x69, 0xe8, 0xad, 0x96, 0xe2, 0x69, 0x69, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe8, 0x55, 0x69,
+			0xe1,
+		}},
+		ContentForward: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x8a, 0xcd, 0x8c, 0x05, 0x11, 0x88, 0xf1,
+			0x89, 0xf1, 0x94, 0x11, 0x7d, 0x11, 0x88, 0x35, 0x70, 0xf1, 0x8a, 0x55, 0x6d, 0xad, 0x9a, 0x11,
+			0x7d, 0xf1, 0x8a, 0xcd, 0x8c, 0xe2, 0x69, 0x65, 0xad, 0x92, 0xe8, 0x45, 0x88, 0x60, 0x69, 0x65,
+			0x99, 0x82, 0x2d, 0x69, 0xd5, 0x7e, 0x40, 0x99, 0x72, 0x11, 0x7b, 0xe6, 0xf1, 0x86, 0x05, 0x11,
+			0x7c, 0x35, 0x70, 0xf1, 0x7e, 0x55, 0x6d, 0xad, 0x8e, 0x11, 0x7d, 0xf1, 0x7e, 0xcd, 0x8c, 0x11,
+			0x7c, 0xf1, 0x89, 0xf1, 0x86, 0x11, 0x7f, 0xe6, 0x99, 0x72, 0x60, 0x99, 0x6e, 0x11, 0x7f, 0x58,
+			0xad, 0x81, 0x40, 0x69, 0x69, 0x45, 0x88, 0xe8, 0xad, 0x92, 0xe6, 0x69, 0x65, 0xe1,
+		}, mirror: true},
+		ContentGesture: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x85, 0x25, 0x98, 0x60, 0x84, 0x25, 0x98,
+			0xb5, 0x7f, 0xc5, 0x95, 0x40, 0x69, 0x7d, 0x99, 0x8f, 0x60, 0x69, 0x7d, 0x45, 0x8c, 0xf9, 0x7e,
+			0xdd, 0x89, 0x40, 0xdd, 0x82, 0xdd, 0x85, 0x60, 0x35, 0x85, 0x45, 0x84, 0xd5, 0x87, 0x71, 0x83,
+			0x40, 0x99, 0x8c, 0x69, 0x82, 0x60, 0x69, 0x8c, 0x99, 0x7e, 0xf9, 0x8a, 0xf1, 0x7c, 0x40, 0xf1,
+			0x86, 0x45, 0x7b, 0x60, 0x55, 0x84, 0x45, 0x7b, 0x11, 0x82, 0xd5, 0x7c, 0x40, 0x78, 0x99, 0x83,
+			0x60, 0xcd, 0x77, 0x35, 0x89, 0x3d, 0x75, 0x25, 0x8b, 0x40, 0x99, 0x6f, 0x11, 0x8d, 0x60, 0xbd,
+			0x6c, 0x11, 0x8d, 0x5d, 0x6a, 0x25, 0x8b, 0x40, 0x50, 0x89, 0x84, 0x60, 0x50, 0x69, 0x82, 0x81,
+			0x69, 0x09, 0x7f, 0x40, 0xad, 0x6e, 0xbd, 0x75, 0x60, 0x69, 0x70, 0x35, 0x73, 0x2d, 0x71, 0x89,
+			0x71, 0x40, 0xf1, 0x71, 0xbd, 0x6e, 0x60, 0xf1, 0x71, 0x5c, 0x89, 0x71, 0x91, 0x6d, 0x40, 0x55,
+			0x70, 0x25, 0x6d, 0x60, 0x69, 0x6f, 0x25, 0x6d, 0x45, 0x6e, 0xd5, 0x6d, 0x40, 0xcd, 0x6b, 0x35,
+			0x70, 0x00, 0x89, 0x68, 0xcd, 0x6c, 0x60, 0x79, 0x6a, 0x89, 0x6a, 0x69, 0x6c, 0x79, 0x69, 0x40,
+			0x55, 0x70, 0x69, 0x68, 0x60, 0x25, 0x73, 0x69, 0x68, 0xe9, 0x74, 0x45, 0x6a, 0x40, 0xad, 0x76,
+			0xf1, 0x6e, 0x60, 0xad, 0x76, 0x45, 0x71, 0x71, 0x75, 0x99, 0x73, 0x40, 0x89, 0x71, 0x74, 0x60,
+			0x89, 0x6e, 0x79, 0x7e, 0xa5, 0x6d, 0x99, 0x80, 0x40, 0xbd, 0x6c, 0xdd, 0x84, 0x60, 0xbd, 0x6c,
+			0x8e, 0xd5, 0x6d, 0xad, 0x87, 0x40, 0x25, 0x70, 0x55, 0x88, 0x60, 0xbd, 0x71, 0x55, 0x88, 0x69,
+			0x73, 0xcd, 0x86, 0x40, 0x89, 0x78, 0xbd, 0x80, 0x60, 0x11, 0x7d, 0xdd, 0x7a, 0x69, 0x80, 0xb5,
+			0x78, 0x40, 0x89, 0x87, 0x89, 0x76, 0x60, 0xf1, 0x8b, 0x89, 0x76, 0x79, 0x8e, 0xc5, 0x79, 0x40,
+			0x55, 0x91, 0x35, 0x82, 0xe6, 0xb0, 0xe8, 0xf1, 0x86, 0xe6, 0x55, 0x91, 0x60, 0xad, 0x90, 0x45,
+			0x91, 0xbd, 0x8c, 0xb5, 0x94, 0x40, 0x69, 0x85, 0x25, 0x98, 0xe2, 0x99, 0x85, 0x69, 0x93, 0x60,
+			0xad, 0x87, 0x69, 0x93, 0x09, 0x8a, 0xbd, 0x90, 0x40, 0xbd, 0x8c, 0x11, 0x87, 0x60, 0x79, 0x89,
+			0x69, 0x87, 0xcd, 0x85, 0xbd, 0x89, 0x40, 0x25, 0x82, 0x11, 0x90, 0x60, 0x25, 0x82, 0x99, 0x91,
+			0x09, 0x83, 0x81, 0x92, 0x40, 0x99, 0x85, 0x69, 0x93, 0xe1,
+		}},
+		ContentInbox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0xf1, 0x8a, 0xe6, 0x45, 0x8a, 0x60, 0x89, 0x88, 0x99, 0x8d, 0xc5, 0x85,
+			0x09, 0x8f, 0x40, 0x80, 0x79, 0x90, 0x60, 0x7a, 0x79, 0x90, 0x3d, 0x7a, 0x09, 0x8f, 0x40, 0xbd,
+			0x75, 0xf1, 0x8a, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x05, 0x80, 0x79, 0x8c, 0x61, 0xbd, 0x82, 0x79,
+			0x8c, 0xf1, 0x84, 0xe9, 0x8a, 0x25, 0x87, 0x55, 0x89, 0xad, 0x88, 0xf1, 0x86, 0xe6, 0xa8, 0xe8,
+			0x58, 0xe6, 0x58, 0xe8, 0xf1, 0x86, 0xe6, 0x55, 0x77, 0x61, 0xdd, 0x78, 0x55, 0x89, 0x15, 0x7b,
+			0xe9, 0x8a, 0x4d, 0x7d, 0x79, 0x8c, 0x05, 0x80, 0x79, 0x8c, 0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe6,
+			0x58, 0xe1,
+		}},
+		ContentLink: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x55, 0x8d, 0xe6, 0xad, 0x72, 0x60, 0x25,
+			0x6d, 0x55, 0x8d, 0x3d, 0x69, 0x71, 0x89, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a,
+			0x3d, 0x69, 0x91, 0x76, 0x40, 0xad, 0x72, 0xad, 0x72, 0xe6, 0x7c, 0xe8, 0xad, 0x76, 0xe6, 0xad,
+			0x72, 0x63, 0xc9, 0x6e, 0xad, 0x76, 0x11, 0x6c, 0x65, 0x79, 0x55, 0x69, 0x1d, 0x7c, 0x55, 0x69,
+			0xfd, 0x7f, 0x55, 0x69, 0xdd, 0x83, 0x11, 0x6c, 0x99, 0x86, 0xc9, 0x6e, 0x55, 0x89, 0xad, 0x72,
+			0x55, 0x89, 0xe6, 0x7c, 0xe8, 0x55, 0x8d, 0xe2, 0xad, 0x75, 0x84, 0xe8, 0x7c, 0xe6, 0x55, 0x8a,
+			0xe8, 0x84, 0xe6, 0xad, 0x75, 0xe2, 0x84, 0x55, 0x8d, 0xe8, 0x55, 0x89, 0xe6, 0x55, 0x8d, 0x63,
+			0x39, 0x91, 0x55, 0x89, 0xf1, 0x93, 0x9d, 0x86, 0xad, 0x96, 0xe5, 0x83, 0xad, 0x96, 0x05, 0x80,
+			0xad, 0x96, 0x25, 0x7c, 0xf1, 0x93, 0x69, 0x79, 0x39, 0x91, 0xad, 0x76, 0x55, 0x8d, 0xad, 0x76,
+			0xe6, 0x84, 0xe8, 0xad, 0x72, 0xe6, 0x55, 0x8d, 0x60, 0xdd, 0x92, 0xad, 0x72, 0xc5, 0x96, 0x91,
+			0x76, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0xc5, 0x96, 0x71, 0x89, 0x40, 0x55,
+			0x8d, 0x55, 0x8d, 0xe6, 0x84, 0xe1,
+		}},
+		ContentLowPriority: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x75, 0x35, 0x97, 0x01, 0xdd, 0x72, 0x69,
+			0x94, 0x25, 0x77, 0xa0, 0x60, 0xcd, 0x6f, 0xa0, 0x91, 0x6a, 0x96, 0x40, 0x55, 0x65, 0xad, 0x7e,
+			0x60, 0x55, 0x65, 0x89, 0x77, 0x4d, 0x6a, 0x71, 0x72, 0x40, 0x69, 0x76, 0x55, 0x6d, 0xe6, 0x89,
+			0x80, 0xe8, 0x55, 0x71, 0xe6, 0x69, 0x76, 0x60, 0xf1, 0x70, 0x55, 0x71, 0x25, 0x6d, 0x3d, 0x75,
+			0x40, 0x55, 0x69, 0xad, 0x7e, 0x60, 0x55, 0x69, 0x55, 0x84, 0x81, 0x6d, 0x2d, 0x88, 0x40, 0x79,
+			0x77, 0x98, 0x03, 0x11, 0x73, 0x99, 0x87, 0xdd, 0x75, 0xcd, 0x84, 0xf1, 0x7e, 0xf1, 0x8d, 0xad,
+			0x75, 0x35, 0x97, 0xe2, 0x25, 0x86, 0xa0, 0xe8, 0x98, 0xe6, 0xad, 0x9a, 0xe8, 0xa0, 0xe6, 0x25,
+			0x86, 0xe2, 0x25, 0x86, 0xad, 0x80, 0xe8, 0xad, 0x7c, 0xe6, 0xad, 0x9a, 0xe8, 0xad, 0x80, 0xe6,
+			0x25, 0x86, 0xe2, 0x89, 0x84, 0x55, 0x71, 0xe8, 0x55, 0x6d, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x71,
+			0xe6, 0x89, 0x84, 0xe1,
+		}},
+		ContentMail: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x80, 0x35, 0x81, 0x00, 0x55, 0x69, 0x55, 0x72, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x72, 0x00, 0x80, 0x35, 0x81, 0xe2, 0x80, 0x35, 0x7d, 0x00, 0x69, 0x96, 0xad, 0x6e, 0xe6,
+			0xad, 0x69, 0x00, 0x80, 0x35, 0x7d, 0xe2, 0x55, 0x69, 0x55, 0x72, 0xe8, 0xad, 0x6e, 0xe8, 0x55,
+			0x91, 0xe8, 0x55, 0x72, 0xe1,
+		}},
+		ContentMarkUnread: ContentMarkUnread,
+		ContentMoveToInbox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0xf1, 0x8a, 0xe6, 0x45, 0x8a, 0x60, 0x89, 0x88, 0x99, 0x8d, 0xc5, 0x85,
+			0x09, 0x8f, 0x40, 0x80, 0x79, 0x90, 0x60, 0x7a, 0x79, 0x90, 0x3d, 0x7a, 0x09, 0x8f, 0x40, 0xbd,
+			0x75, 0xf1, 0x8a, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x05, 0x80, 0x79, 0x8c, 0x61, 0xbd, 0x82, 0x79,
+			0x8c, 0xf1, 0x84, 0xe9, 0x8a, 0x25, 0x87, 0x55, 0x89, 0xad, 0x88, 0xf1, 0x86, 0xe6, 0xa8, 0xe8,
+			0x58, 0xe6, 0x58, 0xe8, 0xf1, 0x86, 0xe6, 0x55, 0x77, 0x61, 0xdd, 0x78, 0x55, 0x89, 0x15, 0x7b,
+			0xe9, 0x8a, 0x4d, 0x7d, 0x79, 0x8c, 0x05, 0x80, 0x79, 0x8c, 0xe2, 0x80, 0xdd, 0x83, 0x02, 0xcd,
+			0x75, 0xad, 0x79, 0xad, 0x78, 0xcd, 0x76, 0x7c, 0x25, 0x7c, 0xe8, 0x89, 0x6f, 0xe6, 0x84, 0xe8,
+			0x25, 0x7c, 0x02, 0x55, 0x87, 0xcd, 0x76, 0x35, 0x8a, 0xad, 0x79, 0x80, 0xdd, 0x83, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe6, 0x58, 0xe1,
+		}},
+		ContentNextWeek: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x7c, 0xbd, 0x8d, 0x05, 0x25, 0x86, 0x88,
+			0x55, 0x7c, 0x35, 0x7a, 0x79, 0x7a, 0x11, 0x7c, 0x69, 0x82, 0x88, 0x89, 0x7a, 0xdd, 0x8b, 0x69,
+			0x7c, 0xbd, 0x8d, 0xe2, 0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd, 0x96, 0x40,
+			0x55, 0x65, 0xa8, 0xe8, 0x68, 0x60, 0x55, 0x65, 0x69, 0x72, 0x89, 0x66, 0x35, 0x71, 0x40, 0x55,
+			0x69, 0x60, 0xe6, 0x55, 0x75, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x75, 0xbd, 0x67, 0x89, 0x76, 0x89,
+			0x66, 0x40, 0x55, 0x79, 0x55, 0x65, 0xe6, 0xad, 0x86, 0x60, 0x45, 0x88, 0x55, 0x65, 0x79, 0x89,
+			0x89, 0x66, 0x40, 0xad, 0x8a, 0x55, 0x69, 0xe8, 0x60, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x60,
+			0x79, 0x99, 0x35, 0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0xa8, 0x60, 0xad, 0x9a, 0x99, 0x95, 0x79,
+			0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0xa8, 0xe6, 0xad,
+			0x96, 0xe8, 0x68, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe2, 0x55, 0x79, 0x60, 0xe6, 0xad, 0x86, 0xe8,
+			0x55, 0x69, 0xe6, 0x55, 0x79, 0xe8, 0x60, 0xe2, 0x55, 0x69, 0xa8, 0xe8, 0x68, 0xe8, 0xa8, 0xe1,
+		}},
+		ContentRedo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7a, 0xad, 0x92, 0x60, 0xcd, 0x73, 0xad,
+			0x92, 0x3d, 0x6f, 0x69, 0x8e, 0x40, 0xad, 0x6a, 0xdd, 0x83, 0x60, 0xad, 0x6a, 0x99, 0x7d, 0x3d,
+			0x6f, 0x55, 0x79, 0x40, 0x25, 0x7a, 0x11, 0x75, 0xe6, 0xbd, 0x8d, 0x05, 0x25, 0x86, 0x79, 0x6d,
+			0xf1, 0x88, 0xad, 0x6a, 0x55, 0x95, 0x11, 0x77, 0xf1, 0x88, 0x79, 0x83, 0x25, 0x86, 0xad, 0x80,
+			0xbd, 0x8d, 0x11, 0x79, 0xe6, 0x11, 0x7a, 0x60, 0x69, 0x75, 0x11, 0x79, 0x09, 0x72, 0x2d, 0x7c,
+			0x40, 0xad, 0x6e, 0xdd, 0x83, 0x60, 0xad, 0x6e, 0x79, 0x88, 0x09, 0x72, 0x91, 0x8b, 0x40, 0x11,
+			0x7a, 0xad, 0x8e, 0xe6, 0xbd, 0x8e, 0xe8, 0xad, 0x92, 0xe6, 0x25, 0x7a, 0xe1,
+		}, mirror: true},
+		ContentRemove: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0x84, 0xe8, 0x7c, 0xe6, 0xad, 0x92,
+			0xe8, 0x84, 0xe6, 0x55, 0x6d, 0xe1,
+		}},
+		ContentRemoveCircle:        ContentRemoveCircle,
+		ContentRemoveCircleOutline: ContentRemoveCircleOutline,
+		ContentReply: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa8, 0xad, 0x92, 0xe8, 0x45, 0x88, 0x60, 0xa8,
+			0x45, 0x84, 0x69, 0x91, 0xad, 0x81, 0x40, 0xcd, 0x8a, 0x11, 0x7f, 0xe6, 0xbd, 0x6f, 0x05, 0x99,
+			0x7a, 0xf1, 0x89, 0xbd, 0x77, 0xcd, 0x8c, 0x50, 0x11, 0x7d, 0xbd, 0x77, 0x55, 0x6d, 0x99, 0x7a,
+			0x35, 0x70, 0xbd, 0x6f, 0x11, 0x7b, 0xe6, 0xcd, 0x8a, 0x60, 0x79, 0x90, 0x11, 0x7b, 0x3d, 0x94,
+			0xd5, 0x7e, 0x40, 0xb0, 0x45, 0x88, 0xe8, 0xad, 0x92, 0xe6, 0xa8, 0xe1,
+		}, mirror: true},
+		ContentReplyAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x75, 0xcd, 0x8c, 0x05, 0x55, 0x65, 0x11,
+			0x7d, 0x11, 0x75, 0x55, 0x6d, 0xf1, 0x77, 0x35, 0x70, 0x11, 0x6b, 0x11, 0x7d, 0xf1, 0x77, 0xf1,
+			0x89, 0x11, 0x75, 0xcd, 0x8c, 0xe2, 0x99, 0x96, 0xad, 0x92, 0xe8, 0x45, 0x88, 0x60, 0x99, 0x96,
+			0x45, 0x84, 0xa8, 0xad, 0x81, 0x40, 0x69, 0x8d, 0x11, 0x7f, 0xe6, 0x11, 0x79, 0x05, 0xf1, 0x83,
+			0xf1, 0x89, 0x11, 0x81, 0xcd, 0x8c, 0x55, 0x71, 0x11, 0x7d, 0x11, 0x81, 0x55, 0x6d, 0xf1, 0x83,
+			0x35, 0x70, 0x11, 0x79, 0x11, 0x7b, 0xe6, 0x69, 0x8d, 0x60, 0x11, 0x93, 0x11, 0x7b, 0xd5, 0x96,
+			0xd5, 0x7e, 0x40, 0x99, 0x9a, 0x45, 0x88, 0xe8, 0xad, 0x92, 0xe6, 0x99, 0x96, 0xe1,
+		}, mirror: true},
+		ContentReport: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x45, 0x8d, 0x60, 0xf1, 0x80, 0x45, 0x8d,
+			0xa5, 0x81, 0x91, 0x8c, 0x40, 0x55, 0x82, 0xf1, 0x8a, 0x60, 0x55, 0x82, 0x94, 0xa5, 0x81, 0x4d,
+			0x89, 0x40, 0x80, 0x99, 0x88, 0x60, 0x11, 0x7f, 0x99, 0x88, 0x5d, 0x7e, 0x4d, 0x89, 0x40, 0xad,
+			0x7d, 0xf1, 0x8a, 0x60, 0xad, 0x7d, 0xdd, 0x8b, 0x5d, 0x7e, 0x91, 0x8c, 0x40, 0x80, 0x45, 0x8d,
+			0xe2, 0x7c, 0xad, 0x83, 0xe6, 0x84, 0xe8, 0x25, 0x72, 0xe6, 0x7c, 0xe8, 0xad, 0x83, 0xe2, 0x6c,
+			0xb0, 0x00, 0x50, 0x94, 0xe8, 0x6c, 0x00, 0x6c, 0x50, 0xe6, 0x94, 0x00, 0xb0, 0x6c, 0xe8, 0x94,
+			0x00, 0x94, 0xb0, 0xe6, 0x6c, 0xe2, 0xad, 0x77, 0xa8, 0xe6, 0x55, 0x88, 0x00, 0xa8, 0x55, 0x88,
+			0xe8, 0xad, 0x77, 0x00, 0x55, 0x88, 0x58, 0xe6, 0xad, 0x77, 0x00, 0x58, 0xad, 0x77, 0xe8, 0x55,
+			0x88, 0x00, 0xad, 0x77, 0xa8, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ContentSave: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xb0, 0x79, 0x72, 0xe8, 0xa8, 0x60, 0xb0, 0x99,
+			0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0x89, 0x8d, 0x00, 0xb0, 0x79, 0x72, 0xe2, 0xa8, 0x45, 0x74, 0x00, 0xbd, 0x8b,
+			0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x45, 0x74, 0xe2, 0xfd, 0x7f, 0xad, 0x8f, 0x67,
+			0xdd, 0x82, 0xad, 0x8f, 0xe9, 0x84, 0xa9, 0x8d, 0xf1, 0x86, 0xa1, 0x8b, 0xf1, 0x86, 0xc1, 0x88,
+			0xf1, 0x86, 0xdd, 0x85, 0xed, 0x84, 0xd5, 0x83, 0xe5, 0x82, 0xcd, 0x81, 0x05, 0x80, 0xcd, 0x81,
+			0x25, 0x7d, 0xcd, 0x81, 0x19, 0x7b, 0xd1, 0x83, 0x11, 0x79, 0xd5, 0x85, 0x11, 0x79, 0xb9, 0x88,
+			0x11, 0x79, 0x99, 0x8b, 0x15, 0x7b, 0xa5, 0x8d, 0x1d, 0x7d, 0xad, 0x8f, 0xfd, 0x7f, 0xad, 0x8f,
+			0xe2, 0x89, 0x6f, 0x11, 0x79, 0xe6, 0x69, 0x87, 0xe8, 0x89, 0x6f, 0xe6, 0x89, 0x6f, 0xe8, 0x11,
+			0x79, 0xe2, 0x58, 0x45, 0x74, 0xe8, 0xa8, 0xe8, 0x58, 0xe8, 0x45, 0x74, 0xe1,
+		}},
+		ContentSelectAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0xa0, 0xe8, 0x60, 0xe6, 0xa0, 0xe8, 0xa0,
+			0xe6, 0x60, 0xe2, 0x68, 0x98, 0xe6, 0x98, 0xe8, 0x68, 0xe6, 0x68, 0xe8, 0x98, 0xe2, 0x58, 0xb0,
+			0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe6, 0x58, 0xe8, 0xb0, 0xe2,
+			0x50, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe8, 0x58, 0xe6,
+			0x50, 0xe2, 0x60, 0xb0, 0xe8, 0xa8, 0xe6, 0x55, 0x78, 0xe8, 0xb0, 0xe6, 0x60, 0xe2, 0x60, 0x58,
+			0xe8, 0x50, 0xe6, 0x55, 0x78, 0xe8, 0x58, 0xe6, 0x60, 0xe2, 0x55, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6,
+			0xad, 0x83, 0xe8, 0xb0, 0xe6, 0x55, 0x7c, 0xe2, 0x55, 0x7c, 0x58, 0xe8, 0x50, 0xe6, 0xad, 0x83,
+			0xe8, 0x58, 0xe6, 0x55, 0x7c, 0xe2, 0xad, 0x87, 0xb0, 0xe8, 0xa8, 0xe6, 0xa0, 0xe8, 0xb0, 0xe6,
+			0xad, 0x87, 0xe2, 0xad, 0x87, 0x58, 0xe8, 0x50, 0xe6, 0xa0, 0xe8, 0x58, 0xe6, 0xad, 0x87, 0xe2,
+			0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8,
+			0xb0, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0,
+			0x58, 0xe6, 0xa8, 0xe2, 0x50, 0xa0, 0xe8, 0xad, 0x87, 0xe6, 0x58, 0xe8, 0xa0, 0xe6, 0x50, 0xe2,
+			0x50, 0xad, 0x83, 0xe8, 0x45, 0x7c, 0xe6, 0x58, 0xe8, 0xad, 0x83, 0xe6, 0x50, 0xe2, 0x50, 0x45,
+			0x78, 0xe8, 0x60, 0xe6, 0x58, 0xe8, 0x45, 0x78, 0xe6, 0x50, 0xe2, 0xa8, 0xa0, 0xe8, 0xad, 0x87,
+			0xe6, 0xb0, 0xe8, 0xa0, 0xe6, 0xa8, 0xe2, 0xa8, 0xad, 0x83, 0xe8, 0x45, 0x7c, 0xe6, 0xb0, 0xe8,
+			0xad, 0x83, 0xe6, 0xa8, 0xe2, 0xa8, 0x45, 0x78, 0xe8, 0x60, 0xe6, 0xb0, 0xe8, 0x45, 0x78, 0xe6,
+			0xa8, 0xe1,
+		}},
+		ContentSend: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x95, 0xe8, 0xad, 0x6a, 0x01, 0xad,
+			0x9a, 0x80, 0x50, 0x55, 0x95, 0xe2, 0x58, 0x25, 0x8f, 0x01, 0x45, 0x90, 0x80, 0x58, 0xad, 0x70,
+			0xe8, 0xdd, 0x7b, 0x01, 0x25, 0x7c, 0x80, 0x58, 0x88, 0xe8, 0x25, 0x8f, 0xe2, 0x58, 0x25, 0x8f,
+			0xe8, 0xad, 0x70, 0xe8, 0x25, 0x8f, 0xe1,
+		}, mirror: true},
+		ContentSort: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xa0, 0xe8, 0x98, 0xe6, 0x70, 0xe8, 0xa0,
+			0xe6, 0x50, 0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0x90, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x68,
+			0xe8, 0x60, 0xe6, 0xb0, 0xe8, 0x68, 0xe6, 0x50, 0xe1,
+		}, mirror: true},
+		ContentTextFormat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x6d, 0xe2, 0x69, 0x72, 0x90, 0x00, 0x11, 0x7e, 0xad,
+			0x6a, 0xe6, 0xf1, 0x81, 0x00, 0x99, 0x8d, 0x90, 0xe6, 0xf1, 0x89, 0x00, 0xf1, 0x86, 0x11, 0x80,
+			0xe6, 0x11, 0x79, 0x00, 0x11, 0x76, 0x90, 0xe6, 0x69, 0x72, 0xe2, 0x35, 0x7a, 0x11, 0x7d, 0xe6,
+			0xcd, 0x85, 0x00, 0x25, 0x80, 0x45, 0x6e, 0xe6, 0xdd, 0x7f, 0x00, 0x35, 0x7a, 0x11, 0x7d, 0xe1,
+		}},
+		ContentUnarchive: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x25, 0x71, 0x60, 0x50, 0x25, 0x70, 0x35, 0x68, 0x71, 0x6f,
+			0x40, 0xf1, 0x68, 0x25, 0x6e, 0x00, 0xad, 0x6c, 0x11, 0x69, 0x60, 0x35, 0x6d, 0x79, 0x68, 0xe9,
+			0x6d, 0x3d, 0x68, 0x40, 0x91, 0x6f, 0x50, 0xe6, 0x71, 0x90, 0x60, 0x69, 0x91, 0x50, 0x11, 0x92,
+			0x3d, 0x68, 0x40, 0x45, 0x93, 0x11, 0x69, 0x00, 0x11, 0x97, 0x25, 0x6e, 0x60, 0x99, 0x97, 0xbd,
+			0x6e, 0xcd, 0x97, 0x71, 0x6f, 0x40, 0xb0, 0x25, 0x71, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd,
+			0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x25, 0x6d, 0x11, 0x6f, 0xe6, 0xcd, 0x92,
+			0x00, 0x61, 0x90, 0x58, 0xe6, 0x89, 0x6f, 0x00, 0x25, 0x6d, 0x11, 0x6f, 0xe2, 0x58, 0x11, 0x73,
+			0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x11, 0x73, 0xe6, 0x58, 0xe2, 0x7c, 0x9c, 0xe6, 0x84, 0xe8, 0x99,
+			0x80, 0x05, 0xbd, 0x87, 0x55, 0x86, 0x69, 0x8a, 0xad, 0x83, 0x80, 0x45, 0x79, 0x99, 0x75, 0xad,
+			0x83, 0x45, 0x78, 0x55, 0x86, 0x7c, 0x99, 0x80, 0xe8, 0x9c, 0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe6,
+			0x58, 0xe1,
+		}},
+		ContentUndo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x71, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0xf1, 0x85, 0x60, 0x99, 0x8a, 0xad, 0x8e, 0xf9, 0x8d, 0x91, 0x8b, 0x40, 0x55, 0x91, 0xdd, 0x83,
+			0x60, 0x55, 0x91, 0x45, 0x7f, 0xf9, 0x8d, 0x2d, 0x7c, 0x40, 0xf1, 0x85, 0x11, 0x79, 0xe6, 0x45,
+			0x72, 0x05, 0xdd, 0x79, 0xad, 0x80, 0x11, 0x77, 0x79, 0x83, 0xad, 0x6a, 0x11, 0x77, 0x11, 0x77,
+			0xad, 0x6a, 0xdd, 0x79, 0x79, 0x6d, 0x45, 0x72, 0x11, 0x75, 0xe6, 0xdd, 0x85, 0x60, 0x35, 0x8c,
+			0x11, 0x75, 0xc5, 0x90, 0x55, 0x79, 0x40, 0x55, 0x95, 0xdd, 0x83, 0x60, 0x55, 0x95, 0x25, 0x8a,
+			0xc5, 0x90, 0x69, 0x8e, 0x40, 0xdd, 0x85, 0xad, 0x92, 0xe6, 0x45, 0x71, 0xe1,
+		}, mirror: true},
+		ContentWeekend: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x6a, 0xdd, 0x71, 0x60, 0x25, 0x6a, 0xdd,
+			0x6e, 0x3d, 0x6c, 0xc5, 0x6c, 0x40, 0x55, 0x71, 0xad, 0x6a, 0xe6, 0xad, 0x8e, 0x60, 0xad, 0x91,
+			0xad, 0x6a, 0xc5, 0x93, 0xc5, 0x6c, 0x40, 0xdd, 0x95, 0xdd, 0x71, 0xe8, 0x69, 0x77, 0x60, 0x11,
+			0x99, 0x11, 0x77, 0x35, 0x9b, 0xcd, 0x78, 0x40, 0x55, 0x9d, 0xcd, 0x7d, 0xe8, 0x25, 0x8e, 0x60,
+			0x55, 0x9d, 0x25, 0x91, 0x3d, 0x9b, 0x3d, 0x93, 0x40, 0x25, 0x96, 0x55, 0x95, 0xe6, 0xdd, 0x69,
+			0x60, 0xdd, 0x66, 0x55, 0x95, 0xc5, 0x64, 0x3d, 0x93, 0x40, 0xad, 0x62, 0x25, 0x8e, 0xe8, 0xcd,
+			0x7d, 0x60, 0xad, 0x62, 0x89, 0x7a, 0xd5, 0x64, 0xcd, 0x78, 0x40, 0x25, 0x6a, 0x69, 0x77, 0xe8,
+			0xdd, 0x71, 0xe2, 0x25, 0x6e, 0xdd, 0x71, 0xe8, 0x55, 0x79, 0x60, 0x11, 0x6f, 0x45, 0x7a, 0x99,
+			0x6f, 0x2d, 0x7b, 0x40, 0x25, 0x70, 0xcd, 0x7d, 0xe8, 0x11, 0x86, 0xe6, 0xdd, 0x8f, 0xe8, 0xcd,
+			0x7d, 0x60, 0xdd, 0x8f, 0x11, 0x7c, 0x69, 0x90, 0x2d, 0x7b, 0x40, 0xdd, 0x91, 0x55, 0x79, 0xe8,
+			0xdd, 0x71, 0x60, 0xdd, 0x91, 0x79, 0x70, 0xf9, 0x90, 0x91, 0x6f, 0x40, 0xad, 0x8e, 0xad, 0x6e,
+			0xe6, 0x55, 0x71, 0x60, 0xf1, 0x6f, 0xad, 0x6e, 0x09, 0x6f, 0x91, 0x6f, 0x40, 0x25, 0x6e, 0xdd,
+			0x71, 0xe2, 0xdd, 0x93, 0x11, 0x8a, 0xe6, 0x25, 0x6c, 0xe8, 0xcd, 0x7d, 0x60, 0x25, 0x6c, 0x25,
+			0x7d, 0xf9, 0x6b, 0xa5, 0x7c, 0x40, 0x71, 0x6b, 0xc5, 0x7b, 0x60, 0x11, 0x6b, 0x69, 0x7b, 0x91,
+			0x6a, 0x3d, 0x7b, 0x40, 0x6d, 0x69, 0x11, 0x7b, 0x60, 0x11, 0x68, 0x11, 0x7b, 0x5d, 0x67, 0xc5,
+			0x7b, 0x40, 0xad, 0x66, 0xcd, 0x7d, 0xe8, 0x25, 0x8e, 0x60, 0xad, 0x66, 0x89, 0x8f, 0x91, 0x67,
+			0x71, 0x90, 0x40, 0xdd, 0x69, 0x55, 0x91, 0xe6, 0x25, 0x96, 0x60, 0x89, 0x97, 0x55, 0x91, 0x71,
+			0x98, 0x71, 0x90, 0x40, 0x55, 0x99, 0x25, 0x8e, 0xe8, 0xcd, 0x7d, 0x60, 0x55, 0x99, 0x79, 0x7c,
+			0xa5, 0x98, 0xc5, 0x7b, 0x40, 0x95, 0x96, 0x11, 0x7b, 0x63, 0xf1, 0x95, 0x11, 0x7b, 0x71, 0x95,
+			0x3d, 0x7b, 0xf1, 0x94, 0x69, 0x7b, 0x99, 0x94, 0xc5, 0x7b, 0x41, 0x94, 0x25, 0x7c, 0x11, 0x94,
+			0xa5, 0x7c, 0xdd, 0x93, 0x25, 0x7d, 0xdd, 0x93, 0xcd, 0x7d, 0xe8, 0x11, 0x8a, 0xe2, 0x80, 0x11,
+			0x8a, 0xe2, 0x80, 0x55, 0x91, 0xe2, 0x80, 0x11, 0x86, 0xe1,
+		}},
+		DeviceAccessAlarm:          DeviceAccessAlarm,
+		DeviceAccessAlarms:         DeviceAccessAlarms,
+		DeviceAccessTime:           DeviceAccessTime,
+		DeviceAddAlarm:             DeviceAddAlarm,
+		DeviceAirplaneModeActive:   DeviceAirplaneModeActive,
+		DeviceAirplaneModeInactive: DeviceAirplaneModeInactive,
+		DeviceBattery20:            DeviceBattery20,
+		DeviceBattery30:            DeviceBattery30,
+		DeviceBattery50:            DeviceBattery50,
+		DeviceBattery60:            DeviceBattery60,
+		DeviceBattery80:            DeviceBattery80,
+		DeviceBattery90:            DeviceBattery90,
+		DeviceBatteryAlert: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x25, 0x85, 0xe6, 0x84, 0xe8, 0x25, 0x75,
+			0xe6, 0x7c, 0xe8, 0x25, 0x85, 0xe2, 0x09, 0x80, 0x25, 0x8f, 0x60, 0xdd, 0x80, 0x25, 0x8f, 0x71,
+			0x81, 0x89, 0x8e, 0x40, 0x84, 0x19, 0x8d, 0x60, 0x84, 0x45, 0x8c, 0x6d, 0x81, 0xb5, 0x8b, 0x40,
+			0x80, 0x25, 0x8b, 0x60, 0x35, 0x7f, 0x25, 0x8b, 0x99, 0x7e, 0xb5, 0x8b, 0x40, 0x7c, 0x25, 0x8d,
+			0x60, 0x7c, 0xf1, 0x8d, 0x99, 0x7e, 0x89, 0x8e, 0x40, 0x09, 0x80, 0x25, 0x8f, 0xe2, 0xad, 0x74,
+			0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a, 0x40, 0xad, 0x72, 0xad, 0x98,
+			0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d, 0x6a, 0x40, 0xad, 0x74, 0x99,
+			0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8, 0x99, 0x69, 0xe6, 0x55, 0x8b,
+			0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55, 0x8d, 0x99, 0x6b, 0xe8, 0xad,
+			0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40, 0x55, 0x8b, 0xad, 0x9a, 0xe6,
+			0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8, 0x99, 0x6d, 0xe6, 0xad, 0x76,
+			0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging20: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging30: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging50: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging60: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging80: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryCharging90: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryChargingFull: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0x79, 0x8f, 0x00, 0x55, 0x85, 0x79,
+			0x7f, 0xe6, 0x55, 0x81, 0xe8, 0xcd, 0x74, 0x00, 0xad, 0x7a, 0xcd, 0x84, 0xe6, 0xad, 0x7e, 0xe8,
+			0x79, 0x8f, 0xe2, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad, 0x9a, 0x3d, 0x73, 0x19, 0x9a,
+			0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72, 0xc1, 0x6a, 0x3d, 0x73, 0x2d,
+			0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x65, 0xe6, 0x55, 0x85, 0xe8,
+			0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5, 0x8c, 0x2d, 0x6a, 0x40, 0x55,
+			0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99, 0xc5, 0x8c, 0x19, 0x9a, 0x40,
+			0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89, 0xe8,
+			0x99, 0x6d, 0xe6, 0xad, 0x76, 0xe8, 0xad, 0x96, 0xe2, 0xad, 0x76, 0xad, 0x96, 0xe6, 0x55, 0x89,
+			0xe6, 0xad, 0x76, 0xe1,
+		}},
+		DeviceBatteryFull: DeviceBatteryFull,
+		DeviceBatteryStd:  DeviceBatteryStd,
+		DeviceBatteryUnknown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x74, 0xad, 0x9a, 0x60, 0xd1, 0x73, 0xad,
+			0x9a, 0x3d, 0x73, 0x19, 0x9a, 0x40, 0xad, 0x72, 0xad, 0x98, 0xe8, 0x99, 0x6b, 0x60, 0xad, 0x72,
+			0xc1, 0x6a, 0x3d, 0x73, 0x2d, 0x6a, 0x40, 0xad, 0x74, 0x99, 0x69, 0xe6, 0xad, 0x7a, 0xe8, 0x55,
+			0x65, 0xe6, 0x55, 0x85, 0xe8, 0x99, 0x69, 0xe6, 0x55, 0x8b, 0x60, 0x31, 0x8c, 0x99, 0x69, 0xc5,
+			0x8c, 0x2d, 0x6a, 0x40, 0x55, 0x8d, 0x99, 0x6b, 0xe8, 0xad, 0x98, 0x60, 0x55, 0x8d, 0x85, 0x99,
+			0xc5, 0x8c, 0x19, 0x9a, 0x40, 0x55, 0x8b, 0xad, 0x9a, 0xe6, 0xad, 0x74, 0xe2, 0x89, 0x7f, 0x25,
+			0x8f, 0x67, 0x79, 0x80, 0x25, 0x8f, 0x2d, 0x81, 0x71, 0x8e, 0xdd, 0x81, 0xc1, 0x8d, 0xdd, 0x81,
+			0xd1, 0x8c, 0xdd, 0x81, 0xdd, 0x8b, 0x2d, 0x81, 0x2d, 0x8b, 0x7d, 0x80, 0x79, 0x8a, 0x8d, 0x7f,
+			0x79, 0x8a, 0x99, 0x7e, 0x79, 0x8a, 0xe9, 0x7d, 0x29, 0x8b, 0x35, 0x7d, 0xd9, 0x8b, 0x35, 0x7d,
+			0xcd, 0x8c, 0x35, 0x7d, 0xbd, 0x8d, 0xe5, 0x7d, 0x71, 0x8e, 0x95, 0x7e, 0x25, 0x8f, 0x89, 0x7f,
+			0x25, 0x8f, 0xe2, 0xf1, 0x7d, 0x8e, 0xe6, 0x35, 0x81, 0x62, 0x35, 0x81, 0xdd, 0x84, 0x19, 0x82,
+			0x99, 0x83, 0x86, 0x55, 0x82, 0x11, 0x84, 0x35, 0x81, 0x35, 0x85, 0x11, 0x80, 0x11, 0x86, 0xe9,
+			0x7e, 0x40, 0xf1, 0x86, 0xe9, 0x7b, 0x62, 0xf1, 0x86, 0xad, 0x78, 0xf1, 0x84, 0xe1, 0x76, 0xf1,
+			0x82, 0x11, 0x75, 0x89, 0x7f, 0x11, 0x75, 0x7a, 0x11, 0x75, 0x09, 0x7b, 0x79, 0x76, 0x40, 0x35,
+			0x78, 0x45, 0x7a, 0x00, 0x45, 0x7b, 0x55, 0x7b, 0x60, 0xcd, 0x7b, 0xdd, 0x79, 0xe9, 0x7c, 0x09,
+			0x79, 0x40, 0x89, 0x7f, 0x35, 0x78, 0x60, 0x79, 0x81, 0x35, 0x78, 0x91, 0x82, 0x25, 0x79, 0x40,
+			0xad, 0x83, 0xcd, 0x7b, 0x60, 0xad, 0x83, 0x55, 0x7d, 0xcd, 0x82, 0x45, 0x7e, 0x40, 0xd5, 0x80,
+			0x45, 0x80, 0x60, 0xbd, 0x7f, 0x55, 0x81, 0xd5, 0x7e, 0xcd, 0x82, 0x40, 0xf1, 0x7d, 0x8e, 0xe1,
+		}},
+		DeviceBluetooth: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xbd, 0x99, 0xe8, 0xcd, 0x84, 0x05, 0x11,
+			0x71, 0xbd, 0x91, 0x45, 0x6e, 0xf1, 0x8e, 0x35, 0x7d, 0x80, 0x45, 0x6e, 0x11, 0x71, 0x11, 0x71,
+			0x45, 0x6e, 0x7c, 0x35, 0x7b, 0xe8, 0x45, 0x66, 0xe6, 0x80, 0x03, 0x45, 0x8e, 0x89, 0x74, 0xcd,
+			0x82, 0x80, 0x45, 0x8e, 0x79, 0x8b, 0x80, 0xbd, 0x99, 0xe6, 0x7c, 0xe2, 0x84, 0x35, 0x7b, 0x01,
+			0xad, 0x88, 0x89, 0x74, 0x84, 0x5c, 0xe8, 0x35, 0x7b, 0xe2, 0x84, 0xa4, 0x01, 0xad, 0x88, 0x79,
+			0x8b, 0x84, 0xcd, 0x84, 0xe8, 0xa4, 0xe1,
+		}},
+		DeviceBluetoothConnected: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7e, 0xbd, 0x99, 0xe8, 0xcd, 0x84, 0x05,
+			0x25, 0x71, 0xbd, 0x91, 0x55, 0x6e, 0xf1, 0x8e, 0x45, 0x7d, 0x80, 0x55, 0x6e, 0x11, 0x71, 0x25,
+			0x71, 0x45, 0x6e, 0x11, 0x7e, 0x35, 0x7b, 0xe8, 0x45, 0x66, 0xe6, 0x11, 0x80, 0x03, 0x55, 0x8e,
+			0x89, 0x74, 0xdd, 0x82, 0x80, 0x55, 0x8e, 0x79, 0x8b, 0x11, 0x80, 0xbd, 0x99, 0xe6, 0x11, 0x7e,
+			0xe2, 0x11, 0x82, 0x35, 0x7b, 0x01, 0xbd, 0x88, 0x89, 0x74, 0x11, 0x82, 0x5c, 0xe8, 0x35, 0x7b,
+			0xe2, 0x11, 0x82, 0xa4, 0x01, 0xbd, 0x88, 0x79, 0x8b, 0x11, 0x82, 0xcd, 0x84, 0xe8, 0xa4, 0xe2,
+			0x55, 0x6d, 0x35, 0x83, 0x61, 0xf9, 0x6b, 0x35, 0x83, 0x05, 0x6b, 0x45, 0x82, 0x11, 0x6a, 0x55,
+			0x81, 0x11, 0x6a, 0x80, 0x40, 0x05, 0x6b, 0xad, 0x7d, 0x62, 0xf9, 0x6b, 0xad, 0x7c, 0x55, 0x6d,
+			0xad, 0x7c, 0xb1, 0x6e, 0xad, 0x7c, 0xa5, 0x6f, 0xad, 0x7d, 0x99, 0x70, 0xad, 0x7e, 0x99, 0x70,
+			0x80, 0x40, 0xa5, 0x6f, 0x45, 0x82, 0x60, 0xb1, 0x6e, 0x35, 0x83, 0x55, 0x6d, 0x35, 0x83, 0xe2,
+			0xad, 0x92, 0x35, 0x83, 0x61, 0x51, 0x91, 0x35, 0x83, 0x5d, 0x90, 0x45, 0x82, 0x69, 0x8f, 0x55,
+			0x81, 0x69, 0x8f, 0x80, 0x40, 0x5d, 0x90, 0xad, 0x7d, 0x62, 0x51, 0x91, 0xad, 0x7c, 0xad, 0x92,
+			0xad, 0x7c, 0x09, 0x94, 0xad, 0x7c, 0xfd, 0x94, 0xad, 0x7d, 0xf1, 0x95, 0xad, 0x7e, 0xf1, 0x95,
+			0x80, 0x40, 0xfd, 0x94, 0x45, 0x82, 0x60, 0x09, 0x94, 0x35, 0x83, 0xad, 0x92, 0x35, 0x83, 0xe1,
+		}},
+		DeviceBluetoothDisabled: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x95, 0x45, 0x9c, 0x01, 0x99, 0x89, 0x25,
+			0x90, 0x80, 0xbd, 0x99, 0xe6, 0x7c, 0xe8, 0xcd, 0x84, 0x06, 0x11, 0x71, 0xbd, 0x91, 0x45, 0x6e,
+			0xf1, 0x8e, 0x55, 0x7b, 0xdd, 0x81, 0xbd, 0x63, 0x45, 0x6a, 0x89, 0x66, 0x79, 0x67, 0x89, 0x98,
+			0x79, 0x99, 0xbd, 0x95, 0x45, 0x9c, 0xe2, 0x84, 0xa4, 0x01, 0xad, 0x86, 0x55, 0x8d, 0x84, 0xad,
+			0x88, 0xe8, 0xa4, 0xe2, 0xbd, 0x83, 0x11, 0x7f, 0x02, 0xf1, 0x80, 0x35, 0x7c, 0xad, 0x88, 0x89,
+			0x74, 0x84, 0x5c, 0xe8, 0x45, 0x7d, 0x00, 0x7c, 0x45, 0x79, 0xe8, 0x45, 0x66, 0xe6, 0x80, 0x01,
+			0x45, 0x8e, 0x89, 0x74, 0xbd, 0x83, 0x11, 0x7f, 0xe1,
+		}},
+		DeviceBluetoothSearching: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x78, 0xbd, 0x99, 0xe8, 0xcd, 0x84, 0x05,
+			0x35, 0x6b, 0xbd, 0x91, 0x69, 0x68, 0xf1, 0x8e, 0x55, 0x77, 0x80, 0x69, 0x68, 0x11, 0x71, 0x35,
+			0x6b, 0x45, 0x6e, 0x25, 0x78, 0x35, 0x7b, 0xe8, 0x45, 0x66, 0xe6, 0x25, 0x7a, 0x03, 0x69, 0x88,
+			0x89, 0x74, 0xf1, 0x7c, 0x80, 0x69, 0x88, 0x79, 0x8b, 0x25, 0x7a, 0xbd, 0x99, 0xe6, 0x25, 0x78,
+			0xe2, 0x25, 0x7c, 0x35, 0x7b, 0x01, 0xcd, 0x82, 0x89, 0x74, 0x25, 0x7c, 0x5c, 0xe8, 0x35, 0x7b,
+			0xe2, 0x25, 0x7c, 0xa4, 0x01, 0xcd, 0x82, 0x79, 0x8b, 0x25, 0x7c, 0xcd, 0x84, 0xe8, 0xa4, 0xe2,
+			0xf1, 0x8b, 0xcd, 0x85, 0x01, 0x45, 0x86, 0x80, 0xf1, 0x8b, 0x55, 0x7a, 0x60, 0x79, 0x8c, 0xad,
+			0x7b, 0xc5, 0x8c, 0x19, 0x7d, 0x40, 0x11, 0x8d, 0x80, 0x60, 0x11, 0x8d, 0x89, 0x81, 0xc5, 0x8c,
+			0xf9, 0x82, 0x40, 0xf1, 0x8b, 0xcd, 0x85, 0xe2, 0xcd, 0x93, 0x79, 0x8d, 0x00, 0xdd, 0x90, 0x99,
+			0x8a, 0x60, 0x35, 0x92, 0x25, 0x88, 0xe9, 0x92, 0x79, 0x85, 0x40, 0x99, 0x93, 0x80, 0x60, 0x99,
+			0x93, 0x35, 0x7d, 0xe9, 0x92, 0x89, 0x7a, 0x40, 0xdd, 0x90, 0x69, 0x75, 0x00, 0xcd, 0x93, 0x69,
+			0x72, 0x60, 0xbd, 0x95, 0x79, 0x75, 0xad, 0x96, 0xf1, 0x78, 0x40, 0x99, 0x97, 0x80, 0x60, 0x99,
+			0x97, 0x99, 0x83, 0xad, 0x96, 0x8e, 0x40, 0xcd, 0x93, 0x79, 0x8d, 0xe1,
+		}},
+		DeviceBrightnessAuto: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x74, 0xad, 0x8b, 0xe6, 0x55, 0x78, 0x00,
+			0xcd, 0x7a, 0x69, 0x84, 0xe6, 0x69, 0x85, 0x00, 0xf1, 0x87, 0xad, 0x8b, 0xe6, 0x79, 0x8b, 0x00,
+			0xad, 0x81, 0x89, 0x71, 0xe6, 0x79, 0x7e, 0x00, 0xad, 0x74, 0xad, 0x8b, 0xe2, 0xcd, 0x7b, 0x35,
+			0x81, 0x00, 0xe5, 0x7f, 0x55, 0x76, 0xe6, 0x35, 0x80, 0x00, 0x55, 0x84, 0x35, 0x81, 0xe6, 0xcd,
+			0x7b, 0xe2, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55, 0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1,
+			0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8, 0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01,
+			0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55, 0x95, 0xe8, 0x11, 0x77, 0x01, 0x45,
+			0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6, 0xf1, 0x88, 0x00, 0x11, 0x80, 0x11,
+			0x9e, 0xe2, 0x11, 0x80, 0xf1, 0x7f, 0xe2, 0x11, 0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55, 0x91,
+			0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8, 0xad,
+			0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad, 0x6e,
+			0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6, 0xad,
+			0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe1,
+		}},
+		DeviceBrightnessHigh: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x66, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x55, 0x91, 0x31, 0x87, 0x55, 0x91, 0xf1, 0x7f, 0x55, 0x91, 0xbd,
+			0x78, 0x4d, 0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xd1, 0x78, 0xad,
+			0x6e, 0xbd, 0x73, 0xb5, 0x73, 0xad, 0x6e, 0xbd, 0x78, 0xad, 0x6e, 0xf1, 0x7f, 0xad, 0x6e, 0x31,
+			0x87, 0xbd, 0x73, 0x45, 0x8c, 0x40, 0x11, 0x80, 0x55, 0x91, 0xe2, 0x09, 0x80, 0x55, 0x8d, 0x60,
+			0x79, 0x7a, 0x55, 0x8d, 0x91, 0x76, 0x71, 0x89, 0x40, 0xad, 0x72, 0xf9, 0x7f, 0x60, 0xad, 0x72,
+			0x69, 0x7a, 0x91, 0x76, 0x89, 0x76, 0x40, 0x09, 0x80, 0xad, 0x72, 0x60, 0x99, 0x85, 0xad, 0x72,
+			0x79, 0x89, 0x89, 0x76, 0x40, 0x55, 0x8d, 0xf9, 0x7f, 0x60, 0x55, 0x8d, 0x89, 0x85, 0x79, 0x89,
+			0x71, 0x89, 0x40, 0x09, 0x80, 0x55, 0x8d, 0xe2, 0x11, 0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55,
+			0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8,
+			0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad,
+			0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80, 0xf1, 0x7f, 0xe2, 0x11, 0x80, 0x90, 0x62,
+			0xad, 0x7c, 0x90, 0x59, 0x7a, 0xa9, 0x85, 0x70, 0x55, 0x83, 0x70, 0xf1, 0x7f, 0x70, 0x99, 0x7c,
+			0x59, 0x7a, 0x4d, 0x7a, 0x40, 0x11, 0x80, 0x70, 0x60, 0x69, 0x83, 0x70, 0xb5, 0x85, 0x4d, 0x7a,
+			0x40, 0x90, 0xf1, 0x7f, 0x61, 0x90, 0x55, 0x83, 0xb5, 0x85, 0xa9, 0x85, 0x69, 0x83, 0x90, 0x11,
+			0x80, 0x90, 0xe1,
+		}},
+		DeviceBrightnessLow: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x66, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x55, 0x91, 0x31, 0x87, 0x55, 0x91, 0xf1, 0x7f, 0x55, 0x91, 0xbd,
+			0x78, 0x4d, 0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xd1, 0x78, 0xad,
+			0x6e, 0xbd, 0x73, 0xb5, 0x73, 0xad, 0x6e, 0xbd, 0x78, 0xad, 0x6e, 0xf1, 0x7f, 0xad, 0x6e, 0x31,
+			0x87, 0xbd, 0x73, 0x45, 0x8c, 0x40, 0x11, 0x80, 0x55, 0x91, 0xe2, 0x09, 0x80, 0x55, 0x8d, 0x60,
+			0x79, 0x7a, 0x55, 0x8d, 0x91, 0x76, 0x71, 0x89, 0x40, 0xad, 0x72, 0xf9, 0x7f, 0x60, 0xad, 0x72,
+			0x69, 0x7a, 0x91, 0x76, 0x89, 0x76, 0x40, 0x09, 0x80, 0xad, 0x72, 0x60, 0x99, 0x85, 0xad, 0x72,
+			0x79, 0x89, 0x89, 0x76, 0x40, 0x55, 0x8d, 0xf9, 0x7f, 0x60, 0x55, 0x8d, 0x89, 0x85, 0x79, 0x89,
+			0x71, 0x89, 0x40, 0x09, 0x80, 0x55, 0x8d, 0xe2, 0x11, 0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55,
+			0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8,
+			0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad,
+			0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80, 0xf1, 0x7f, 0xe1,
+		}},
+		DeviceBrightnessMedium: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x60, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x40, 0x55, 0x91, 0xf1, 0x7f, 0x61, 0x55, 0x91, 0xbd, 0x78, 0x4d,
+			0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0x11,
+			0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55, 0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99,
+			0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8, 0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69,
+			0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad, 0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad,
+			0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80,
+			0xf1, 0x7f, 0xe1,
+		}},
+		DeviceDVR: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0xdd, 0x84, 0x67, 0x99, 0x73, 0xdd,
+			0x84, 0x3d, 0x74, 0x3d, 0x84, 0xdd, 0x74, 0x9d, 0x83, 0xdd, 0x74, 0xad, 0x82, 0xdd, 0x74, 0xbd,
+			0x81, 0x3d, 0x74, 0x19, 0x81, 0x9d, 0x73, 0x79, 0x80, 0xad, 0x72, 0x79, 0x80, 0xbd, 0x71, 0x79,
+			0x80, 0x19, 0x71, 0x19, 0x81, 0x79, 0x70, 0xbd, 0x81, 0x79, 0x70, 0xad, 0x82, 0x79, 0x70, 0x99,
+			0x83, 0x19, 0x71, 0x3d, 0x84, 0xbd, 0x71, 0xdd, 0x84, 0xad, 0x72, 0xdd, 0x84, 0xe2, 0xad, 0x72,
+			0x35, 0x7a, 0x67, 0x99, 0x73, 0x35, 0x7a, 0x3d, 0x74, 0x91, 0x79, 0xdd, 0x74, 0xf1, 0x78, 0xdd,
+			0x74, 0x70, 0xdd, 0x74, 0x11, 0x77, 0x3d, 0x74, 0x71, 0x76, 0x9d, 0x73, 0xcd, 0x75, 0xad, 0x72,
+			0xcd, 0x75, 0xbd, 0x71, 0xcd, 0x75, 0x19, 0x71, 0x71, 0x76, 0x79, 0x70, 0x11, 0x77, 0x79, 0x70,
+			0x70, 0x79, 0x70, 0xf1, 0x78, 0x19, 0x71, 0x91, 0x79, 0xbd, 0x71, 0x35, 0x7a, 0xad, 0x72, 0x35,
+			0x7a, 0xe2, 0x70, 0xad, 0x84, 0xe6, 0xa0, 0xe8, 0xad, 0x80, 0xe6, 0x70, 0xe8, 0xad, 0x84, 0xe2,
+			0x70, 0x74, 0xe6, 0xa0, 0xe8, 0x6c, 0xe6, 0x70, 0xe8, 0x74, 0xe2, 0x6c, 0xb0, 0xe8, 0xad, 0x92,
+			0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0xad, 0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad,
+			0x8e, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50,
+			0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8,
+			0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92,
+			0xe6, 0x94, 0xe8, 0xb0, 0xe6, 0x6c, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x58,
+			0xe6, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x58, 0xe8, 0xad, 0x8e,
+			0xe1,
+		}},
+		DeviceDataUsage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0xad, 0x9a, 0x60, 0x99, 0x7a, 0xad,
+			0x9a, 0xbd, 0x75, 0x91, 0x98, 0x40, 0x3d, 0x6d, 0xdd, 0x92, 0x60, 0x99, 0x69, 0x45, 0x8f, 0x81,
+			0x67, 0x69, 0x8a, 0x40, 0x69, 0x65, 0x11, 0x80, 0x60, 0x69, 0x65, 0x89, 0x75, 0x81, 0x6c, 0xf1,
+			0x6d, 0x40, 0x25, 0x7e, 0x89, 0x65, 0xe8, 0x55, 0x6c, 0x60, 0x79, 0x76, 0x11, 0x6d, 0x55, 0x71,
+			0xb5, 0x72, 0x40, 0x35, 0x6c, 0x11, 0x80, 0x60, 0x35, 0x6c, 0x55, 0x88, 0x64, 0x19, 0x8e, 0x40,
+			0x11, 0x80, 0xdd, 0x93, 0x60, 0xdd, 0x84, 0xdd, 0x93, 0x25, 0x89, 0xb5, 0x91, 0x40, 0x69, 0x90,
+			0x99, 0x8b, 0x00, 0x45, 0x96, 0x9e, 0x60, 0x69, 0x92, 0x89, 0x94, 0x91, 0x8c, 0x99, 0x97, 0x40,
+			0x11, 0x80, 0xad, 0x9a, 0xe2, 0x35, 0x98, 0x69, 0x8b, 0x00, 0x55, 0x92, 0x25, 0x88, 0x60, 0x25,
+			0x93, 0x11, 0x86, 0x89, 0x93, 0x09, 0x84, 0x40, 0xf1, 0x93, 0x80, 0x60, 0xf1, 0x93, 0x45, 0x78,
+			0xd5, 0x8e, 0x99, 0x72, 0x40, 0x25, 0x82, 0x45, 0x6c, 0xe8, 0x79, 0x65, 0x60, 0x99, 0x8c, 0x35,
+			0x66, 0xad, 0x93, 0xc5, 0x6d, 0x40, 0xbd, 0x9a, 0xbd, 0x7f, 0x60, 0xbd, 0x9a, 0xbd, 0x82, 0x19,
+			0x9a, 0xa5, 0x85, 0x40, 0x35, 0x98, 0x69, 0x8b, 0xe1,
+		}},
+		DeviceDeveloperMode: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x76, 0x45, 0x8c, 0x05, 0xad, 0x6a, 0x80,
+			0xf1, 0x76, 0xbd, 0x73, 0xbd, 0x79, 0xad, 0x76, 0x69, 0x70, 0x80, 0xbd, 0x79, 0x55, 0x89, 0xf1,
+			0x76, 0x45, 0x8c, 0xe2, 0x55, 0x6d, 0x45, 0x8e, 0xe6, 0x55, 0x71, 0xe8, 0x55, 0x93, 0xe6, 0xad,
+			0x8e, 0xe8, 0x45, 0x8e, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x99, 0x60, 0xad, 0x92, 0xf1, 0x9a, 0x79,
+			0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71, 0x60, 0xbd, 0x6f, 0x55, 0x9d,
+			0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0x45, 0x8e, 0xe2, 0x55, 0x71, 0xf1,
+			0x70, 0xe6, 0x55, 0x6d, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d, 0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63,
+			0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45, 0x90, 0xad, 0x62, 0x79, 0x91, 0xdd,
+			0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0xf1, 0x70, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6,
+			0x55, 0x71, 0xe8, 0xf1, 0x70, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e,
+			0xe8, 0x55, 0x97, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad,
+			0x66, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x68, 0xe2, 0x11, 0x89, 0x45, 0x8c, 0x05, 0x45, 0x86, 0x55,
+			0x89, 0x99, 0x8f, 0x80, 0x45, 0x86, 0xad, 0x76, 0x11, 0x89, 0xbd, 0x73, 0x55, 0x95, 0x80, 0x11,
+			0x89, 0x45, 0x8c, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x55,
+			0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		DeviceDevices: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x55, 0x95, 0xe8, 0x55, 0x8f, 0xe6,
+			0xad, 0x6a, 0xe8, 0xad, 0x6e, 0x60, 0xad, 0x6a, 0x05, 0x6d, 0xd9, 0x6b, 0xd9, 0x6b, 0x40, 0xad,
+			0x6e, 0xad, 0x6a, 0xe6, 0xb0, 0xe8, 0xad, 0x6e, 0xe6, 0xad, 0x6e, 0xe8, 0x55, 0x8f, 0xe6, 0xad,
+			0x7e, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x84, 0x55, 0x95, 0x60, 0xd1, 0x83, 0x55,
+			0x95, 0x3d, 0x83, 0xc5, 0x94, 0x40, 0xad, 0x82, 0x55, 0x93, 0xe8, 0xad, 0x74, 0x60, 0xad, 0x82,
+			0xd1, 0x73, 0x3d, 0x83, 0x3d, 0x73, 0x40, 0xad, 0x84, 0xad, 0x72, 0xe6, 0xad, 0x98, 0x60, 0x85,
+			0x99, 0xad, 0x72, 0x19, 0x9a, 0x3d, 0x73, 0x40, 0xad, 0x9a, 0xad, 0x74, 0xe8, 0x55, 0x93, 0x60,
+			0xad, 0x9a, 0x31, 0x94, 0x19, 0x9a, 0xc5, 0x94, 0x40, 0xad, 0x98, 0x55, 0x95, 0xe6, 0xad, 0x84,
+			0xe2, 0xad, 0x86, 0x55, 0x8f, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x76, 0xe6, 0xad, 0x86, 0xe8, 0x55,
+			0x8f, 0xe2, 0xad, 0x86, 0x55, 0x8f, 0xe6, 0xad, 0x96, 0xe6, 0xad, 0x86, 0xe1,
+		}},
+		DeviceGPSFixed:    DeviceGPSFixed,
+		DeviceGPSNotFixed: DeviceGPSNotFixed,
+		DeviceGPSOff:      DeviceGPSOff,
+		DeviceGraphicEq: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x66, 0xa0, 0xe8, 0x60, 0xe6, 0x6e, 0xe8, 0xa0,
+			0xe6, 0x66, 0xe2, 0x7c, 0xad, 0x9a, 0xe8, 0x55, 0x65, 0xe6, 0x84, 0xe8, 0xad, 0x9a, 0xe6, 0x7c,
+			0xe2, 0x50, 0x55, 0x85, 0xe8, 0xad, 0x7a, 0xe6, 0x58, 0xe8, 0x55, 0x85, 0xe6, 0x50, 0xe2, 0x92,
+			0xa0, 0xe8, 0x60, 0xe6, 0x9a, 0xe8, 0xa0, 0xe6, 0x92, 0xe2, 0xa8, 0x55, 0x85, 0xe8, 0xad, 0x7a,
+			0xe6, 0xb0, 0xe8, 0x55, 0x85, 0xe6, 0xa8, 0xe1,
+		}},
+		DeviceLocationDisabled:  DeviceLocationDisabled,
+		DeviceLocationSearching: DeviceLocationSearching,
+		DeviceNFC: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0xa0, 0xe6, 0xa0, 0xe8, 0x60, 0xe6, 0x84,
+			0x60, 0x45, 0x80, 0x60, 0x25, 0x7f, 0x25, 0x71, 0x40, 0x7c, 0x68, 0xe8, 0x89, 0x7c, 0x60, 0x7a,
+			0x1d, 0x7d, 0x81, 0x7c, 0xfd, 0x7d, 0x40, 0x78, 0x80, 0x60, 0x78, 0xbd, 0x81, 0x25, 0x7d, 0xdd,
+			0x82, 0x40, 0x80, 0x88, 0x60, 0xbd, 0x81, 0x88, 0xdd, 0x82, 0xdd, 0x82, 0x40, 0x88, 0x80, 0x61,
+			0x88, 0xd9, 0x7e, 0x79, 0x83, 0xfd, 0x7d, 0xf1, 0x82, 0x25, 0x7d, 0x84, 0x89, 0x7c, 0xe8, 0x68,
+			0xe6, 0x98, 0xe8, 0x98, 0xe6, 0x68, 0xe8, 0x68, 0xe6, 0x89, 0x7b, 0xe8, 0x60, 0xe6, 0x60, 0xe8,
+			0xa0, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8,
+			0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99,
+			0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd,
+			0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6,
+			0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58, 0xe1,
+		}},
+		DeviceNetworkCell: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x65, 0xad, 0x9a, 0x00, 0xbd, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x69, 0x65, 0xe2, 0xbd, 0x8d, 0xad, 0x96, 0xe6, 0xbd, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0xbd, 0x8d, 0xf1, 0x77, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		DeviceNetworkWiFi: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x00, 0x40, 0x70, 0x60, 0x79, 0x66,
+			0xcd, 0x71, 0xad, 0x6e, 0x3d, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x55,
+			0x91, 0x3d, 0x6e, 0x40, 0xc0, 0x70, 0x00, 0x80, 0xb0, 0xe2, 0xad, 0x68, 0x35, 0x7b, 0x60, 0xbd,
+			0x6d, 0x35, 0x77, 0x89, 0x73, 0xf1, 0x74, 0x40, 0x80, 0xad, 0x72, 0x60, 0xad, 0x86, 0xad, 0x72,
+			0x79, 0x8c, 0xf1, 0x74, 0x40, 0x55, 0x97, 0x35, 0x7b, 0x00, 0x25, 0x9a, 0x69, 0x78, 0x60, 0x55,
+			0x94, 0xdd, 0x73, 0xd5, 0x8d, 0x45, 0x71, 0x40, 0x80, 0xad, 0x6e, 0x60, 0xad, 0x78, 0xad, 0x6e,
+			0x2d, 0x72, 0x45, 0x71, 0x40, 0xdd, 0x65, 0x69, 0x78, 0x00, 0xad, 0x68, 0x35, 0x7b, 0xe1,
+		}},
+		DeviceSDStorage: DeviceSDStorage,
+		DeviceScreenLockLandscape: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x74, 0xad, 0x8a, 0x60, 0x25, 0x79, 0xad, 0x8a,
+			0x91, 0x78, 0x19, 0x8a, 0x40, 0x70, 0xad, 0x88, 0xe8, 0x55, 0x7f, 0x60, 0x70, 0x79, 0x7e, 0x91,
+			0x78, 0xe9, 0x7d, 0x40, 0x74, 0x55, 0x7d, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x7a, 0x60, 0xad, 0x7a,
+			0x79, 0x78, 0x3d, 0x7c, 0xe9, 0x76, 0x40, 0x80, 0x55, 0x75, 0x60, 0x35, 0x82, 0x55, 0x75, 0xc5,
+			0x83, 0xe9, 0x76, 0x40, 0x55, 0x85, 0xad, 0x7a, 0xe8, 0x55, 0x7d, 0xe6, 0x8c, 0x60, 0xdd, 0x86,
+			0x55, 0x7d, 0x71, 0x87, 0xe9, 0x7d, 0x40, 0x90, 0x55, 0x7f, 0xe8, 0xbd, 0x88, 0x61, 0x90, 0x89,
+			0x89, 0x69, 0x87, 0x19, 0x8a, 0xd5, 0x86, 0xad, 0x8a, 0x8c, 0xad, 0x8a, 0xe6, 0x74, 0xe2, 0xad,
+			0x7c, 0x55, 0x7d, 0xe6, 0x55, 0x83, 0xe8, 0xad, 0x7a, 0x60, 0x55, 0x83, 0x55, 0x79, 0x55, 0x82,
+			0x71, 0x78, 0x40, 0x80, 0x89, 0x77, 0x61, 0xad, 0x7e, 0x89, 0x77, 0xad, 0x7d, 0x71, 0x78, 0xad,
+			0x7c, 0x55, 0x79, 0xad, 0x7c, 0xad, 0x7a, 0xe8, 0x55, 0x7d, 0xe2, 0xad, 0x66, 0xad, 0x92, 0x60,
+			0x11, 0x65, 0xad, 0x92, 0xdd, 0x63, 0x79, 0x91, 0x40, 0xad, 0x62, 0xad, 0x8e, 0xe8, 0x55, 0x71,
+			0x60, 0xad, 0x62, 0xbd, 0x6f, 0xdd, 0x63, 0x89, 0x6e, 0x40, 0xad, 0x66, 0x55, 0x6d, 0xe6, 0x55,
+			0x99, 0x60, 0xf1, 0x9a, 0x55, 0x6d, 0x25, 0x9c, 0x89, 0x6e, 0x40, 0x55, 0x9d, 0x55, 0x71, 0xe8,
+			0xad, 0x8e, 0x60, 0x55, 0x9d, 0x45, 0x90, 0x25, 0x9c, 0x79, 0x91, 0x40, 0x55, 0x99, 0xad, 0x92,
+			0xe6, 0xad, 0x66, 0xe2, 0xad, 0x68, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe8, 0xad,
+			0x8e, 0xe6, 0xad, 0x68, 0xe2, 0xad, 0x6c, 0xad, 0x8e, 0xe6, 0x55, 0x93, 0xe8, 0x55, 0x71, 0xe6,
+			0xad, 0x6c, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x97, 0xad, 0x8e, 0xe6, 0x55, 0x99, 0xe8, 0x55, 0x71,
+			0xe6, 0x55, 0x97, 0xe8, 0xad, 0x8e, 0xe2, 0xad, 0x68, 0x55, 0x71, 0xe6, 0xad, 0x66, 0xe6, 0xad,
+			0x68, 0xe2, 0x55, 0x97, 0x55, 0x71, 0xe6, 0x55, 0x99, 0xe6, 0x55, 0x97, 0xe1,
+		}},
+		DeviceScreenLockPortrait: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x74, 0xad, 0x8a, 0x60, 0x25, 0x79, 0xad, 0x8a,
+			0x91, 0x78, 0x19, 0x8a, 0x40, 0x70, 0xad, 0x88, 0xe8, 0x55, 0x7f, 0x60, 0x70, 0x79, 0x7e, 0x91,
+			0x78, 0xe9, 0x7d, 0x40, 0x74, 0x55, 0x7d, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x7a, 0x60, 0xad, 0x7a,
+			0x79, 0x78, 0x3d, 0x7c, 0xe9, 0x76, 0x40, 0x80, 0x55, 0x75, 0x60, 0x35, 0x82, 0x55, 0x75, 0xc5,
+			0x83, 0xe9, 0x76, 0x40, 0x55, 0x85, 0xad, 0x7a, 0xe8, 0x55, 0x7d, 0xe6, 0x8c, 0x60, 0xdd, 0x86,
+			0x55, 0x7d, 0x71, 0x87, 0xe9, 0x7d, 0x40, 0x90, 0x55, 0x7f, 0xe8, 0xbd, 0x88, 0x61, 0x90, 0x89,
+			0x89, 0x69, 0x87, 0x19, 0x8a, 0xd5, 0x86, 0xad, 0x8a, 0x8c, 0xad, 0x8a, 0xe6, 0x74, 0xe2, 0xad,
+			0x7c, 0x55, 0x7d, 0xe6, 0x55, 0x83, 0xe8, 0xad, 0x7a, 0x60, 0x55, 0x83, 0x55, 0x79, 0x55, 0x82,
+			0x71, 0x78, 0x40, 0x80, 0x89, 0x77, 0x61, 0xad, 0x7e, 0x89, 0x77, 0xad, 0x7d, 0x71, 0x78, 0xad,
+			0x7c, 0x55, 0x79, 0xad, 0x7c, 0xad, 0x7a, 0xe8, 0x55, 0x7d, 0xe2, 0x55, 0x71, 0x55, 0x9d, 0x60,
+			0xbd, 0x6f, 0x55, 0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66,
+			0x60, 0x55, 0x6d, 0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad,
+			0x8e, 0x60, 0x45, 0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8,
+			0x55, 0x99, 0x60, 0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d,
+			0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55,
+			0x97, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6,
+			0x55, 0x71, 0xe8, 0x55, 0x93, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66,
+			0xe6, 0x55, 0x71, 0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad,
+			0x68, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		DeviceScreenLockRotation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x90, 0x55, 0x7f, 0x01, 0xbd, 0x93, 0x79,
+			0x7c, 0x45, 0x97, 0x80, 0x60, 0x89, 0x98, 0x45, 0x81, 0x89, 0x98, 0x11, 0x83, 0x40, 0x45, 0x97,
+			0x25, 0x86, 0x00, 0x35, 0x8c, 0x25, 0x91, 0x60, 0xdd, 0x8a, 0x79, 0x92, 0x25, 0x89, 0x79, 0x92,
+			0x40, 0x11, 0x86, 0x25, 0x91, 0x00, 0x69, 0x70, 0x79, 0x7b, 0x60, 0x11, 0x6f, 0x25, 0x7a, 0x11,
+			0x6f, 0x69, 0x78, 0x40, 0x69, 0x70, 0x55, 0x75, 0x00, 0x79, 0x7b, 0x55, 0x6a, 0x60, 0xcd, 0x7c,
+			0x52, 0x89, 0x7e, 0x52, 0x40, 0x99, 0x81, 0x55, 0x6a, 0x06, 0xcd, 0x84, 0x89, 0x6d, 0x84, 0x69,
+			0x70, 0x89, 0x7e, 0xf1, 0x6c, 0x11, 0x73, 0x69, 0x78, 0x25, 0x89, 0x89, 0x8e, 0x99, 0x94, 0x11,
+			0x83, 0xdd, 0x90, 0x55, 0x7f, 0xe2, 0x8d, 0x83, 0xf1, 0x9d, 0x63, 0xf9, 0x7c, 0xf1, 0x9d, 0x25,
+			0x77, 0x71, 0x9b, 0x55, 0x71, 0xf1, 0x98, 0x5a, 0x91, 0x94, 0xad, 0x68, 0x35, 0x90, 0x2d, 0x66,
+			0x65, 0x8a, 0xad, 0x63, 0x95, 0x84, 0xad, 0x63, 0x7c, 0xe6, 0xad, 0x67, 0x60, 0xad, 0x67, 0x55,
+			0x83, 0x99, 0x69, 0x19, 0x88, 0x40, 0xdd, 0x6e, 0x89, 0x90, 0x60, 0x35, 0x72, 0x35, 0x94, 0xc5,
+			0x76, 0x91, 0x96, 0x40, 0x89, 0x80, 0x79, 0x99, 0x02, 0xdd, 0x77, 0xcd, 0x90, 0xbd, 0x7a, 0xf1,
+			0x8d, 0xf1, 0x89, 0x25, 0x9d, 0x61, 0x69, 0x88, 0x89, 0x9d, 0xf1, 0x86, 0xbd, 0x9d, 0x79, 0x85,
+			0xf1, 0x9d, 0x8d, 0x83, 0xf1, 0x9d, 0xe2, 0x25, 0x8c, 0x70, 0x60, 0x25, 0x8b, 0x70, 0x4d, 0x8a,
+			0x2d, 0x77, 0x40, 0x79, 0x89, 0x55, 0x75, 0xe8, 0x55, 0x6d, 0x60, 0x79, 0x89, 0x55, 0x6c, 0x4d,
+			0x8a, 0x81, 0x6b, 0x40, 0x25, 0x8c, 0xad, 0x6a, 0xe6, 0x35, 0x8c, 0xe8, 0xdd, 0x67, 0x60, 0x35,
+			0x8c, 0xad, 0x65, 0xb5, 0x8d, 0x2d, 0x64, 0x40, 0x69, 0x91, 0xad, 0x62, 0x60, 0x99, 0x93, 0xad,
+			0x62, 0x19, 0x95, 0x2d, 0x64, 0x40, 0x99, 0x96, 0xdd, 0x67, 0xe8, 0xad, 0x6a, 0xe6, 0xbd, 0x96,
+			0x60, 0xbd, 0x97, 0xad, 0x6a, 0x91, 0x98, 0x81, 0x6b, 0x40, 0x69, 0x99, 0x55, 0x6d, 0xe8, 0x55,
+			0x75, 0x60, 0x69, 0x99, 0x55, 0x76, 0x91, 0x98, 0x2d, 0x77, 0x40, 0xbd, 0x96, 0x70, 0xe6, 0x25,
+			0x8c, 0xe2, 0xbd, 0x8e, 0xad, 0x6a, 0xe6, 0x11, 0x94, 0xe8, 0xdd, 0x67, 0x60, 0x11, 0x94, 0xad,
+			0x66, 0x55, 0x93, 0xf1, 0x65, 0x40, 0x69, 0x91, 0x35, 0x65, 0x60, 0x35, 0x90, 0x35, 0x65, 0x79,
+			0x8f, 0xf1, 0x65, 0x40, 0xbd, 0x8e, 0xdd, 0x67, 0xe8, 0xad, 0x6a, 0xe2, 0x88, 0xbd, 0x7d, 0xe1,
+		}},
+		DeviceScreenRotation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x82, 0x45, 0x93, 0x00, 0xbd, 0x6c, 0x99,
+			0x7d, 0x60, 0x89, 0x6b, 0x45, 0x7c, 0x89, 0x6b, 0x89, 0x7a, 0x40, 0xbd, 0x6c, 0x79, 0x77, 0x00,
+			0xcd, 0x77, 0x79, 0x6c, 0x60, 0x72, 0x25, 0x6b, 0xcd, 0x7a, 0x25, 0x6b, 0x40, 0xf1, 0x7d, 0x79,
+			0x6c, 0x00, 0x99, 0x93, 0x25, 0x82, 0x60, 0xdd, 0x94, 0x69, 0x83, 0xdd, 0x94, 0x35, 0x85, 0x40,
+			0x99, 0x93, 0x45, 0x88, 0x00, 0x89, 0x88, 0x45, 0x93, 0x60, 0x55, 0x87, 0x99, 0x94, 0x89, 0x85,
+			0x99, 0x94, 0x40, 0x69, 0x82, 0x45, 0x93, 0xe2, 0x79, 0x85, 0xad, 0x90, 0x03, 0xf1, 0x90, 0x35,
+			0x85, 0xdd, 0x7a, 0x11, 0x6f, 0x69, 0x6f, 0x89, 0x7a, 0x79, 0x85, 0xad, 0x90, 0xe2, 0xdd, 0x7f,
+			0x11, 0xa0, 0x63, 0x4d, 0x79, 0x11, 0xa0, 0x81, 0x73, 0x91, 0x9d, 0xb1, 0x6d, 0x11, 0x9b, 0x59,
+			0x69, 0xb5, 0x96, 0x4a, 0x55, 0x92, 0x81, 0x62, 0x85, 0x8c, 0x40, 0xb5, 0x86, 0x40, 0x25, 0x80,
+			0xe6, 0x48, 0x60, 0x48, 0x79, 0x85, 0xe9, 0x65, 0x3d, 0x8a, 0x40, 0x25, 0x6b, 0xb5, 0x92, 0x60,
+			0x79, 0x6e, 0x69, 0x96, 0x09, 0x73, 0xbd, 0x98, 0x40, 0xdd, 0x7c, 0x99, 0x9b, 0x02, 0x35, 0x74,
+			0xf1, 0x92, 0x11, 0x77, 0x11, 0x90, 0x45, 0x86, 0x45, 0x9f, 0x61, 0xbd, 0x84, 0xbd, 0x9f, 0x19,
+			0x83, 0xe9, 0x9f, 0x79, 0x81, 0x11, 0xa0, 0xdd, 0x7f, 0x11, 0xa0, 0xe2, 0xb8, 0x25, 0x80, 0x60,
+			0xb8, 0xcd, 0x7a, 0x25, 0x9a, 0x09, 0x76, 0x40, 0xf9, 0x94, 0x89, 0x6d, 0x60, 0xad, 0x91, 0xcd,
+			0x69, 0x25, 0x8d, 0x71, 0x67, 0x40, 0x55, 0x83, 0x89, 0x64, 0x02, 0xdd, 0x8b, 0x11, 0x6d, 0x92,
+			0xf1, 0x6f, 0xcd, 0x79, 0xbd, 0x60, 0x60, 0x55, 0x7b, 0x55, 0x60, 0xd5, 0x7c, 0x2d, 0x60, 0x40,
+			0xdd, 0x7f, 0x40, 0x60, 0x89, 0x86, 0x40, 0x5d, 0x8c, 0x89, 0x62, 0x40, 0x91, 0x96, 0x71, 0x69,
+			0x60, 0xf1, 0x9a, 0xcd, 0x6d, 0x79, 0x9d, 0xa5, 0x73, 0x40, 0xc0, 0x25, 0x80, 0xe6, 0xb8, 0xe2,
+			0x35, 0x80, 0xdd, 0x7f, 0xe1,
+		}},
+		DeviceSettingsSystemDaydream: DeviceSettingsSystemDaydream,
+		DeviceSignalCellular0Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x65, 0xad, 0x9a, 0x00, 0x99, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x45, 0x65, 0xe2, 0xdd, 0x6e, 0xad, 0x96, 0xe6, 0x99, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0xdd, 0x6e, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellular1Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x65, 0xad, 0x9a, 0x00, 0xbd, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x69, 0x65, 0xe2, 0x11, 0x7b, 0xad, 0x96, 0xe6, 0xbd, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0x11, 0x7b, 0x99, 0x8a, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellular2Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x65, 0xad, 0x9a, 0x00, 0xbd, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x69, 0x65, 0xe2, 0x11, 0x83, 0xad, 0x96, 0xe6, 0xbd, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0x11, 0x83, 0x99, 0x82, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellular3Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x65, 0xad, 0x9a, 0x00, 0xbd, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x69, 0x65, 0xe2, 0x69, 0x88, 0xad, 0x96, 0xe6, 0xbd, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0x69, 0x88, 0x45, 0x7d, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellular4Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x65, 0xad, 0x9a, 0x00, 0xbd, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x69, 0x65, 0xe1,
+		}},
+		DeviceSignalCellularConnectedNoInternet0Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0x00, 0xad, 0x9a, 0x55,
+			0x65, 0xe8, 0x99, 0x76, 0xe6, 0xad, 0x96, 0xe8, 0x5e, 0x00, 0xf1, 0x6e, 0xad, 0x96, 0xe6, 0xad,
+			0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x98, 0xad, 0x9a, 0x60, 0xdd, 0x97, 0xad,
+			0x9a, 0x45, 0x97, 0x11, 0x9a, 0x40, 0xad, 0x96, 0xad, 0x98, 0x60, 0xad, 0x96, 0xdd, 0x97, 0x45,
+			0x97, 0x45, 0x97, 0x40, 0xad, 0x98, 0xad, 0x96, 0x60, 0x79, 0x99, 0xad, 0x96, 0x11, 0x9a, 0x45,
+			0x97, 0x40, 0xad, 0x9a, 0xad, 0x98, 0x60, 0xad, 0x9a, 0x79, 0x99, 0x11, 0x9a, 0x11, 0x9a, 0x40,
+			0xad, 0x98, 0xad, 0x9a, 0xe2, 0xad, 0x96, 0xad, 0x92, 0xe8, 0x99, 0x7a, 0xe6, 0xad, 0x9a, 0xe8,
+			0xad, 0x92, 0xe6, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellularConnectedNoInternet1Bar: DeviceSignalCellularConnectedNoInternet1Bar,
+		DeviceSignalCellularConnectedNoInternet2Bar: DeviceSignalCellularConnectedNoInternet2Bar,
+		DeviceSignalCellularConnectedNoInternet3Bar: DeviceSignalCellularConnectedNoInternet3Bar,
+		DeviceSignalCellularConnectedNoInternet4Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0x00, 0xad, 0x9a, 0x55,
+			0x65, 0xe8, 0x99, 0x7e, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x98,
+			0xad, 0x9a, 0x60, 0xdd, 0x97, 0xad, 0x9a, 0x45, 0x97, 0x11, 0x9a, 0x40, 0xad, 0x96, 0xad, 0x98,
+			0x60, 0xad, 0x96, 0xdd, 0x97, 0x45, 0x97, 0x45, 0x97, 0x40, 0xad, 0x98, 0xad, 0x96, 0x60, 0x79,
+			0x99, 0xad, 0x96, 0x11, 0x9a, 0x45, 0x97, 0x40, 0xad, 0x9a, 0xad, 0x98, 0x60, 0xad, 0x9a, 0x79,
+			0x99, 0x11, 0x9a, 0x11, 0x9a, 0x40, 0xad, 0x98, 0xad, 0x9a, 0xe2, 0xad, 0x96, 0xad, 0x92, 0xe8,
+			0x99, 0x82, 0xe6, 0xad, 0x9a, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellularNoSIM: DeviceSignalCellularNoSIM,
+		DeviceSignalCellularNull: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x65, 0xad, 0x9a, 0x00, 0x99, 0x9a, 0x55,
+			0x65, 0xe8, 0xad, 0x9a, 0xe6, 0x45, 0x65, 0xe2, 0xdd, 0x6e, 0xad, 0x96, 0xe6, 0x99, 0x96, 0xe8,
+			0xf1, 0x6e, 0x00, 0xdd, 0x6e, 0xad, 0x96, 0xe1,
+		}},
+		DeviceSignalCellularOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x6e, 0xad, 0x96, 0xe6, 0xf1, 0x90, 0x01,
+			0xf1, 0x7f, 0xad, 0x85, 0xf1, 0x6e, 0xad, 0x96, 0xe2, 0x55, 0x98, 0x11, 0x9e, 0x00, 0xf1, 0x94,
+			0xad, 0x9a, 0xe6, 0x55, 0x65, 0x04, 0x25, 0x7d, 0xdd, 0x82, 0x45, 0x67, 0x5a, 0x25, 0x6a, 0x25,
+			0x6a, 0x35, 0x9b, 0x35, 0x9b, 0x55, 0x98, 0x11, 0x9e, 0xe2, 0xad, 0x9a, 0xf1, 0x94, 0x00, 0xad,
+			0x96, 0xf1, 0x90, 0xe8, 0xf1, 0x6e, 0x02, 0xad, 0x85, 0xf1, 0x7f, 0xdd, 0x82, 0x25, 0x7d, 0xad,
+			0x9a, 0x55, 0x65, 0xe8, 0xf1, 0x94, 0xe2, 0x35, 0x8e, 0x69, 0x88, 0xe2, 0x79, 0x88, 0x25, 0x8e,
+			0xe1,
+		}},
+		DeviceSignalWiFi0Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x00, 0x40, 0x70, 0x60, 0x99, 0x66,
+			0xbd, 0x71, 0xbd, 0x6e, 0x35, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x45,
+			0x91, 0x35, 0x6e, 0x40, 0xc0, 0x70, 0x00, 0x80, 0xb0, 0xe2, 0x80, 0x89, 0x92, 0x00, 0x25, 0x9a,
+			0x69, 0x78, 0x60, 0x55, 0x94, 0xdd, 0x73, 0xd5, 0x8d, 0x45, 0x71, 0x40, 0x80, 0xad, 0x6e, 0x60,
+			0xad, 0x78, 0xad, 0x6e, 0x2d, 0x72, 0x45, 0x71, 0x40, 0xdd, 0x65, 0x69, 0x78, 0x00, 0x80, 0x89,
+			0x92, 0xe1,
+		}},
+		DeviceSignalWiFi1Bar:     DeviceSignalWiFi1Bar,
+		DeviceSignalWiFi1BarLock: DeviceSignalWiFi1BarLock,
+		DeviceSignalWiFi2Bar:     DeviceSignalWiFi2Bar,
+		DeviceSignalWiFi2BarLock: DeviceSignalWiFi2BarLock,
+		DeviceSignalWiFi3Bar:     DeviceSignalWiFi3Bar,
+		DeviceSignalWiFi3BarLock: DeviceSignalWiFi3BarLock,
+		DeviceSignalWiFi4Bar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x00, 0x40, 0x70, 0x60, 0x99, 0x66,
+			0xbd, 0x71, 0xbd, 0x6e, 0x35, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x45,
+			0x91, 0x35, 0x6e, 0x40, 0xc0, 0x70, 0x00, 0x80, 0xb0, 0xe1,
+		}},
+		DeviceSignalWiFi4BarLock: DeviceSignalWiFi4BarLock,
+		DeviceSignalWiFiOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x8e, 0x25, 0x89, 0x01, 0x25, 0x8c, 0x69,
+			0x86, 0x25, 0x9a, 0x69, 0x78, 0x60, 0x55, 0x94, 0xdd, 0x73, 0xd5, 0x8d, 0x45, 0x71, 0x40, 0x80,
+			0xad, 0x6e, 0x60, 0x55, 0x7d, 0xad, 0x6e, 0xc5, 0x7a, 0x5e, 0x40, 0xbd, 0x75, 0x11, 0x70, 0x00,
+			0x79, 0x72, 0xcd, 0x6c, 0x60, 0xbd, 0x75, 0xbd, 0x6b, 0x19, 0x79, 0x35, 0x6b, 0x40, 0x80, 0xad,
+			0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x45, 0x91, 0x35, 0x6e, 0x40, 0xc0, 0x70, 0x00, 0xdd, 0x8e,
+			0x25, 0x89, 0xe2, 0x80, 0x89, 0x92, 0x01, 0x69, 0x86, 0x25, 0x8c, 0x99, 0x6d, 0x55, 0x73, 0x60,
+			0x99, 0x6b, 0x69, 0x74, 0xad, 0x69, 0xad, 0x75, 0x40, 0xdd, 0x65, 0x69, 0x78, 0x00, 0x80, 0x89,
+			0x92, 0xe2, 0xcd, 0x97, 0x89, 0x9d, 0x02, 0x25, 0x89, 0xdd, 0x8e, 0x80, 0xb0, 0x40, 0x70, 0x60,
+			0x69, 0x62, 0xad, 0x75, 0x09, 0x65, 0xbd, 0x73, 0x40, 0x89, 0x6a, 0x45, 0x70, 0x03, 0x79, 0x62,
+			0x35, 0x68, 0x55, 0x65, 0x55, 0x65, 0xad, 0x9a, 0xad, 0x9a, 0xcd, 0x97, 0x89, 0x9d, 0xe2, 0xf1,
+			0x80, 0x35, 0x7b, 0xe2, 0x74, 0xbd, 0x7f, 0xe1,
+		}},
+		DeviceStorage: DeviceStorage,
+		DeviceUSB: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0xdd, 0x7d, 0xad, 0x9a,
+			0x89, 0x7c, 0x55, 0x99, 0x40, 0x35, 0x7b, 0xdd, 0x95, 0x60, 0x35, 0x7b, 0x69, 0x94, 0xf1, 0x7b,
+			0x35, 0x93, 0x40, 0x7c, 0x45, 0x91, 0xe8, 0xdd, 0x89, 0xe6, 0x25, 0x74, 0x60, 0x89, 0x72, 0xdd,
+			0x89, 0x55, 0x71, 0xad, 0x88, 0x40, 0x25, 0x70, 0xdd, 0x85, 0xe8, 0x99, 0x7e, 0x62, 0xcd, 0x6e,
+			0x7c, 0x11, 0x6e, 0xd5, 0x7c, 0x55, 0x6d, 0xa9, 0x7b, 0x55, 0x6d, 0x29, 0x7a, 0x55, 0x6d, 0x70,
+			0xad, 0x6e, 0xad, 0x76, 0x40, 0x25, 0x72, 0x55, 0x75, 0x60, 0x45, 0x74, 0x55, 0x75, 0x99, 0x75,
+			0xad, 0x76, 0x40, 0xf1, 0x76, 0x29, 0x7a, 0x60, 0xf1, 0x76, 0xad, 0x7b, 0x35, 0x76, 0xd5, 0x7c,
+			0x40, 0x25, 0x74, 0x99, 0x7e, 0xe8, 0xdd, 0x85, 0xe6, 0x7c, 0xe8, 0x35, 0x6f, 0xe6, 0xad, 0x78,
+			0x01, 0x80, 0x45, 0x65, 0x55, 0x87, 0x35, 0x6f, 0xe6, 0x84, 0xe8, 0xdd, 0x85, 0xe6, 0xdd, 0x8b,
+			0xe8, 0xf1, 0x7e, 0xe6, 0x11, 0x89, 0xe8, 0x55, 0x75, 0xe6, 0xad, 0x92, 0xe8, 0xf1, 0x7e, 0xe6,
+			0xdd, 0x8f, 0xe8, 0xdd, 0x85, 0x60, 0xdd, 0x8f, 0x79, 0x87, 0xad, 0x8e, 0xad, 0x88, 0x40, 0xdd,
+			0x8b, 0xdd, 0x89, 0xe6, 0x84, 0xe8, 0x45, 0x91, 0x62, 0x55, 0x83, 0xfd, 0x91, 0x11, 0x84, 0x41,
+			0x93, 0xcd, 0x84, 0x89, 0x94, 0xcd, 0x84, 0xdd, 0x95, 0xcd, 0x84, 0xb0, 0x79, 0x83, 0x55, 0x99,
+			0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		DeviceWallpaper: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x84, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x7c, 0xe8, 0xb0, 0xe6,
+			0x58, 0xe2, 0x84, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x84, 0xe6, 0xb0, 0xe8, 0xa8, 0x60, 0xb0,
+			0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x84, 0xe2, 0xcd, 0x6f, 0x25, 0x8e,
+			0x03, 0xad, 0x77, 0x88, 0xdd, 0x7d, 0x79, 0x8c, 0xad, 0x86, 0x11, 0x81, 0x79, 0x90, 0x25, 0x8e,
+			0xe6, 0xcd, 0x6f, 0xe2, 0x50, 0x7c, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69,
+			0x40, 0x58, 0x50, 0xe6, 0x7c, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x7c, 0xe6, 0x50, 0xe2, 0xa8, 0x7c,
+			0xe8, 0x58, 0xe6, 0x84, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69,
+			0x40, 0xb0, 0x58, 0xe8, 0x7c, 0xe6, 0xa8, 0xe2, 0x99, 0x89, 0x74, 0x67, 0x0d, 0x88, 0x74, 0x05,
+			0x87, 0xfd, 0x78, 0x8c, 0xf5, 0x77, 0x8c, 0x69, 0x76, 0x8c, 0xd9, 0x74, 0x05, 0x87, 0xd1, 0x73,
+			0x0d, 0x88, 0xcd, 0x72, 0x99, 0x89, 0xcd, 0x72, 0x29, 0x8b, 0xcd, 0x72, 0x31, 0x8c, 0xd1, 0x73,
+			0x35, 0x8d, 0xd9, 0x74, 0x35, 0x8d, 0x69, 0x76, 0x35, 0x8d, 0xf5, 0x77, 0x31, 0x8c, 0xfd, 0x78,
+			0x29, 0x8b, 0x74, 0x99, 0x89, 0x74, 0xe1,
+		}},
+		DeviceWiFiLock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x8d, 0xb0, 0x60, 0x55, 0x8c, 0xb0, 0x91,
+			0x8b, 0x3d, 0x97, 0x40, 0xcd, 0x8a, 0x5d, 0x95, 0xe8, 0x71, 0x8d, 0x61, 0xcd, 0x8a, 0x51, 0x8c,
+			0x91, 0x8b, 0x91, 0x8b, 0x55, 0x8c, 0xcd, 0x8a, 0x79, 0x8d, 0xcd, 0x8a, 0xe6, 0x89, 0x8d, 0xe8,
+			0x35, 0x88, 0x63, 0x89, 0x8d, 0xf1, 0x85, 0x25, 0x8f, 0x59, 0x84, 0xc1, 0x90, 0xbd, 0x82, 0x05,
+			0x93, 0xbd, 0x82, 0x45, 0x95, 0xbd, 0x82, 0xdd, 0x96, 0x59, 0x84, 0x79, 0x98, 0xf1, 0x85, 0x79,
+			0x98, 0x35, 0x88, 0xe8, 0xcd, 0x8a, 0xe6, 0x89, 0x98, 0x60, 0xad, 0x99, 0xcd, 0x8a, 0x71, 0x9a,
+			0x91, 0x8b, 0x40, 0x35, 0x9b, 0x71, 0x8d, 0xe8, 0x5d, 0x95, 0x61, 0x35, 0x9b, 0x7d, 0x96, 0x71,
+			0x9a, 0x3d, 0x97, 0xad, 0x99, 0xb0, 0x89, 0x98, 0xb0, 0xe6, 0x79, 0x8d, 0xe2, 0x35, 0x90, 0xcd,
+			0x8a, 0xe6, 0xcd, 0x95, 0xe8, 0x35, 0x88, 0x60, 0xcd, 0x95, 0xf1, 0x86, 0x09, 0x95, 0x2d, 0x86,
+			0x40, 0xa6, 0x69, 0x85, 0x60, 0xbd, 0x91, 0x69, 0x85, 0xf9, 0x90, 0x2d, 0x86, 0x40, 0x35, 0x90,
+			0x35, 0x88, 0xe8, 0xcd, 0x8a, 0xe2, 0x80, 0xb0, 0x00, 0x40, 0x70, 0x60, 0x99, 0x66, 0xbd, 0x71,
+			0xbd, 0x6e, 0x35, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x25, 0x89, 0xad, 0x6a, 0x45, 0x91, 0x35,
+			0x6e, 0x40, 0xc0, 0x70, 0x00, 0x45, 0x98, 0xbd, 0x7f, 0x61, 0x35, 0x97, 0x55, 0x7f, 0x19, 0x96,
+			0x11, 0x7f, 0xfd, 0x94, 0xc9, 0x7e, 0xcd, 0x93, 0xbd, 0x7e, 0x00, 0x25, 0x9a, 0x69, 0x78, 0x60,
+			0x55, 0x94, 0xdd, 0x73, 0xd5, 0x8d, 0x45, 0x71, 0x40, 0x80, 0xad, 0x6e, 0x60, 0xad, 0x78, 0xad,
+			0x6e, 0x2d, 0x72, 0x45, 0x71, 0x40, 0xdd, 0x65, 0x69, 0x78, 0x01, 0x80, 0x89, 0x92, 0x69, 0x84,
+			0x25, 0x8e, 0x61, 0x75, 0x84, 0x59, 0x8f, 0xb1, 0x84, 0x79, 0x90, 0xf1, 0x84, 0x99, 0x91, 0x55,
+			0x85, 0xad, 0x92, 0x00, 0x80, 0xb0, 0xe2, 0x80, 0x99, 0x80, 0xe1,
+		}},
+		DeviceWiFiTethering: DeviceWiFiTethering,
+		DeviceWidgets: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x8d, 0xf1, 0x80, 0x03, 0x11, 0x7f, 0xbd,
+			0x72, 0x45, 0x8d, 0x89, 0x64, 0x79, 0x9b, 0xbd, 0x72, 0x45, 0x8d, 0xf1, 0x80, 0xe2, 0x50, 0x25,
+			0x7c, 0xe8, 0x11, 0x68, 0xe6, 0x11, 0x7c, 0xe8, 0x25, 0x7c, 0xe6, 0x50, 0xe2, 0xdd, 0x83, 0xb0,
+			0xe8, 0xf1, 0x83, 0xe6, 0xf1, 0x97, 0xe8, 0xb0, 0xe6, 0xdd, 0x83, 0xe2, 0x50, 0xb0, 0xe8, 0xf1,
+			0x83, 0xe6, 0x11, 0x7c, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x58, 0x25, 0x78, 0xe6, 0x11, 0x78, 0xe8,
+			0x11, 0x6c, 0xe6, 0x58, 0xe8, 0x25, 0x78, 0xe2, 0x79, 0x8d, 0x89, 0x7b, 0x03, 0x11, 0x96, 0xf1,
+			0x72, 0x79, 0x8d, 0x55, 0x6a, 0xdd, 0x84, 0xf1, 0x72, 0x79, 0x8d, 0x89, 0x7b, 0xe2, 0xdd, 0x87,
+			0xa8, 0xe6, 0xf1, 0x93, 0xe8, 0xf1, 0x87, 0xe6, 0xdd, 0x87, 0xe8, 0xa8, 0xe2, 0x58, 0xa8, 0xe6,
+			0x11, 0x78, 0xe8, 0xf1, 0x87, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x11, 0x78, 0x25, 0x78, 0xe2, 0xdd,
+			0x84, 0xf1, 0x72, 0xe2, 0x11, 0x78, 0xf1, 0x87, 0xe2, 0xdd, 0x87, 0xf1, 0x87, 0xe1,
+		}},
+		EditorAttachFile: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7e, 0xad, 0x9a, 0x60, 0x99, 0x78, 0xad,
+			0x9a, 0x4d, 0x74, 0x81, 0x96, 0x40, 0x60, 0x45, 0x8c, 0xe8, 0x99, 0x6f, 0x60, 0x60, 0x55, 0x6b,
+			0x09, 0x73, 0x55, 0x68, 0x40, 0x55, 0x7a, 0x55, 0x65, 0x60, 0xad, 0x7e, 0x55, 0x65, 0xad, 0x81,
+			0x55, 0x68, 0x40, 0xad, 0x84, 0xad, 0x6f, 0xe8, 0xf1, 0x89, 0x60, 0xad, 0x84, 0x79, 0x8c, 0xf1,
+			0x82, 0x3d, 0x8e, 0x40, 0xad, 0x7e, 0xa0, 0x60, 0x25, 0x7c, 0xa0, 0x69, 0x7a, 0x19, 0x8e, 0x40,
+			0xad, 0x78, 0x99, 0x89, 0xe8, 0x79, 0x6f, 0xe6, 0x55, 0x7b, 0xe8, 0xcd, 0x89, 0x60, 0x55, 0x7b,
+			0x45, 0x8b, 0x4d, 0x7c, 0x4d, 0x8c, 0x40, 0xad, 0x7e, 0x55, 0x8d, 0x60, 0x11, 0x80, 0x55, 0x8d,
+			0x09, 0x81, 0x55, 0x8c, 0x40, 0x84, 0xf1, 0x89, 0xe8, 0x99, 0x6f, 0x60, 0x84, 0x69, 0x6c, 0xc5,
+			0x7f, 0x35, 0x6a, 0x40, 0x55, 0x7a, 0x50, 0x60, 0x25, 0x77, 0x50, 0xe9, 0x74, 0x35, 0x6a, 0x40,
+			0xad, 0x72, 0x99, 0x6f, 0xe8, 0x69, 0x8c, 0x60, 0xad, 0x72, 0x45, 0x91, 0x35, 0x76, 0xa5, 0x94,
+			0x40, 0xad, 0x7e, 0xb0, 0x60, 0xad, 0x83, 0xb0, 0x2d, 0x87, 0x99, 0x94, 0x40, 0xad, 0x8a, 0x45,
+			0x8c, 0xe8, 0x79, 0x6f, 0xe6, 0x55, 0x8d, 0xe8, 0x35, 0x8c, 0x60, 0x55, 0x8d, 0x45, 0x92, 0x09,
+			0x89, 0x79, 0x96, 0x40, 0xad, 0x7e, 0xad, 0x9a, 0xe1,
+		}},
+		EditorAttachMoney: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7e, 0xb0, 0xe8, 0x69, 0x92, 0x60, 0x45,
+			0x7a, 0xbd, 0x91, 0xd5, 0x77, 0x81, 0x8f, 0x40, 0x55, 0x74, 0xdd, 0x89, 0x00, 0x11, 0x78, 0x55,
+			0x88, 0x60, 0x35, 0x79, 0x89, 0x8b, 0x55, 0x7b, 0x19, 0x8d, 0x40, 0x79, 0x80, 0xad, 0x8e, 0x60,
+			0xad, 0x83, 0xad, 0x8e, 0xbd, 0x85, 0x11, 0x8d, 0x40, 0xcd, 0x87, 0xad, 0x88, 0x60, 0xcd, 0x87,
+			0xbd, 0x85, 0xf9, 0x85, 0x25, 0x84, 0x40, 0x11, 0x7f, 0xdd, 0x80, 0x60, 0x45, 0x7a, 0x55, 0x7f,
+			0xe9, 0x77, 0xcd, 0x7c, 0x40, 0x89, 0x75, 0x79, 0x76, 0x60, 0x89, 0x75, 0xcd, 0x72, 0xe9, 0x77,
+			0x55, 0x70, 0x40, 0x11, 0x7e, 0x89, 0x6d, 0xe8, 0x50, 0xe6, 0x11, 0x82, 0xe8, 0x89, 0x6d, 0x60,
+			0x11, 0x85, 0xdd, 0x6d, 0x3d, 0x87, 0x81, 0x6f, 0x40, 0x89, 0x8a, 0xad, 0x73, 0x00, 0xcd, 0x86,
+			0x45, 0x75, 0x60, 0xdd, 0x85, 0x25, 0x73, 0x4d, 0x84, 0x2d, 0x72, 0x40, 0x35, 0x80, 0x35, 0x71,
+			0x60, 0x25, 0x7d, 0x35, 0x71, 0x55, 0x7b, 0x99, 0x72, 0x40, 0x89, 0x79, 0x69, 0x76, 0x60, 0x89,
+			0x79, 0xf1, 0x78, 0x89, 0x7b, 0x81, 0x7a, 0x40, 0xf1, 0x82, 0xbd, 0x7d, 0x60, 0x79, 0x87, 0x25,
+			0x7f, 0xa5, 0x89, 0xc5, 0x81, 0x40, 0xcd, 0x8b, 0x69, 0x88, 0x60, 0xcd, 0x8b, 0x99, 0x8c, 0x55,
+			0x89, 0x2d, 0x8f, 0x40, 0x11, 0x82, 0x79, 0x92, 0xe8, 0xb0, 0xe6, 0x11, 0x7e, 0xe1,
+		}},
+		EditorBorderAll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0xa8, 0xa8, 0xe8, 0x84, 0xe6, 0x84, 0xe8, 0xa8, 0xe6, 0xa8, 0xe2, 0xa8, 0x58,
+			0xe6, 0x84, 0xe8, 0x7c, 0xe6, 0xa8, 0xe8, 0x58, 0xe2, 0x58, 0x58, 0xe8, 0x7c, 0xe6, 0x7c, 0xe8,
+			0x58, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0x7c, 0xe8, 0x84, 0xe6, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		EditorBorderBottom: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58,
+			0xe6, 0xa8, 0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0xa8, 0xe2, 0xa8, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8,
+			0x9a, 0xe6, 0xa8, 0xe2, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x92,
+			0x58, 0xe8, 0x50, 0xe6, 0x9a, 0xe8, 0x58, 0xe6, 0x92, 0xe2, 0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a,
+			0xe8, 0x84, 0xe6, 0x92, 0xe2, 0x7c, 0x58, 0xe8, 0x50, 0xe6, 0x84, 0xe8, 0x58, 0xe6, 0x7c, 0xe2,
+			0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84, 0xe8, 0x6e, 0xe6, 0x7c, 0xe2, 0x7c, 0x84, 0xe8, 0x7c, 0xe6,
+			0x84, 0xe8, 0x84, 0xe6, 0x7c, 0xe2, 0x7c, 0x9a, 0xe8, 0x92, 0xe6, 0x84, 0xe8, 0x9a, 0xe6, 0x7c,
+			0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2, 0x66, 0x84, 0xe8, 0x7c,
+			0xe6, 0x6e, 0xe8, 0x84, 0xe6, 0x66, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58, 0xe6,
+			0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8, 0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x84, 0xe8,
+			0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a,
+			0xe6, 0x50, 0xe1,
+		}},
+		EditorBorderClear: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x66,
+			0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e,
+			0xe8, 0x84, 0xe6, 0x66, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2,
+			0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x7c, 0x9a, 0xe8, 0x92, 0xe6,
+			0x84, 0xe8, 0x9a, 0xe6, 0x7c, 0xe2, 0x7c, 0x84, 0xe8, 0x7c, 0xe6, 0x84, 0xe8, 0x84, 0xe6, 0x7c,
+			0xe2, 0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84, 0xe8, 0x6e, 0xe6, 0x7c, 0xe2, 0x7c, 0x58, 0xe8, 0x50,
+			0xe6, 0x84, 0xe8, 0x58, 0xe6, 0x7c, 0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6,
+			0x92, 0xe2, 0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92, 0xe2, 0x92, 0x58, 0xe8,
+			0x50, 0xe6, 0x9a, 0xe8, 0x58, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2, 0xa8, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8,
+			0x6e, 0xe6, 0xa8, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0xa8, 0xe1,
+		}},
+		EditorBorderColor: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xc0, 0xe8, 0xf1, 0x97, 0xe6, 0xad,
+			0x9a, 0xe8, 0xc0, 0xe6, 0x55, 0x65, 0xe2, 0xcd, 0x6a, 0xdd, 0x90, 0xe8, 0x90, 0x02, 0xad, 0x83,
+			0x25, 0x6f, 0x89, 0x8c, 0x70, 0xad, 0x73, 0xdd, 0x90, 0xe6, 0xcd, 0x6a, 0xe2, 0xcd, 0x6e, 0xdd,
+			0x8c, 0xe6, 0xcd, 0x71, 0x02, 0xcd, 0x86, 0xdd, 0x77, 0xcd, 0x83, 0xdd, 0x74, 0xcd, 0x6e, 0xdd,
+			0x89, 0xe8, 0xdd, 0x8c, 0xe2, 0x79, 0x8f, 0x11, 0x75, 0x01, 0x99, 0x86, 0x35, 0x6c, 0x35, 0x8c,
+			0x99, 0x66, 0x60, 0xf1, 0x8c, 0xbd, 0x65, 0xdd, 0x8d, 0xb5, 0x65, 0x40, 0xbd, 0x8f, 0x99, 0x66,
+			0x00, 0xf1, 0x94, 0xcd, 0x6b, 0x60, 0xcd, 0x95, 0xad, 0x6c, 0xcd, 0x95, 0xa5, 0x6d, 0x40, 0x11,
+			0x95, 0x79, 0x6f, 0x00, 0x79, 0x8f, 0x11, 0x75, 0xe2, 0xcd, 0x6e, 0xdd, 0x8c, 0xe1,
+		}},
+		EditorBorderHorizontal: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58,
+			0xe6, 0x50, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2, 0x7c, 0x58,
+			0xe8, 0x50, 0xe6, 0x84, 0xe8, 0x58, 0xe6, 0x7c, 0xe2, 0x92, 0x58, 0xe8, 0x50, 0xe6, 0x9a, 0xe8,
+			0x58, 0xe6, 0x92, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0xa8, 0xe2, 0x50,
+			0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8, 0x6e, 0xe6, 0x50, 0xe2, 0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84,
+			0xe8, 0x6e, 0xe6, 0x7c, 0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0xa8, 0xe2,
+			0x50, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6,
+			0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x7c, 0x9a, 0xe8, 0x92, 0xe6, 0x84, 0xe8, 0x9a, 0xe6, 0x7c,
+			0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2, 0x50, 0xb0, 0xe8, 0xa8,
+			0xe6, 0x58, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x66, 0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6,
+			0x66, 0xe2, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x92, 0xb0, 0xe8,
+			0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0xa8, 0xe1,
+		}},
+		EditorBorderInner: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58,
+			0xe6, 0x50, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2, 0x92, 0x58,
+			0xe8, 0x50, 0xe6, 0x9a, 0xe8, 0x58, 0xe6, 0x92, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8,
+			0x58, 0xe6, 0xa8, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8, 0x6e, 0xe6, 0x50, 0xe2, 0xa8,
+			0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0xa8, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58,
+			0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2,
+			0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x66, 0xb0, 0xe8, 0xa8, 0xe6,
+			0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92,
+			0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0xa8, 0xe2, 0x7c, 0xb0, 0xe8, 0x84,
+			0xe6, 0x50, 0xe8, 0x7c, 0xe6, 0x7c, 0xe8, 0x50, 0xe6, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84,
+			0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe1,
+		}},
+		EditorBorderLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0xa8, 0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0x7c, 0xb0,
+			0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x66, 0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8,
+			0xb0, 0xe6, 0x66, 0xe2, 0x50, 0xb0, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0xa8,
+			0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2, 0x7c, 0x9a, 0xe8, 0x92, 0xe6, 0x84,
+			0xe8, 0x9a, 0xe6, 0x7c, 0xe2, 0xa8, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2,
+			0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92, 0xe2, 0x7c, 0x84, 0xe8, 0x7c, 0xe6,
+			0x84, 0xe8, 0x84, 0xe6, 0x7c, 0xe2, 0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e, 0xe8, 0x84, 0xe6, 0x66,
+			0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0xa8, 0xe2, 0x7c, 0x6e, 0xe8, 0x66,
+			0xe6, 0x84, 0xe8, 0x6e, 0xe6, 0x7c, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6,
+			0xa8, 0xe2, 0x92, 0x58, 0xe8, 0x50, 0xe6, 0x9a, 0xe8, 0x58, 0xe6, 0x92, 0xe2, 0x7c, 0x58, 0xe8,
+			0x50, 0xe6, 0x84, 0xe8, 0x58, 0xe6, 0x7c, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58,
+			0xe6, 0x66, 0xe1,
+		}},
+		EditorBorderOuter: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e, 0xe8, 0x84,
+			0xe6, 0x66, 0xe2, 0x7c, 0x9a, 0xe8, 0x92, 0xe6, 0x84, 0xe8, 0x9a, 0xe6, 0x7c, 0xe2, 0x7c, 0x84,
+			0xe8, 0x7c, 0xe6, 0x84, 0xe8, 0x84, 0xe6, 0x7c, 0xe2, 0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84, 0xe8,
+			0x6e, 0xe6, 0x7c, 0xe2, 0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x50, 0xb0, 0xe8, 0x50, 0xe6, 0xb0,
+			0xe8, 0xb0, 0xe6, 0x50, 0xe1,
+		}},
+		EditorBorderRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58,
+			0xe6, 0x50, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2, 0x7c, 0x58,
+			0xe8, 0x50, 0xe6, 0x84, 0xe8, 0x58, 0xe6, 0x7c, 0xe2, 0x92, 0x58, 0xe8, 0x50, 0xe6, 0x9a, 0xe8,
+			0x58, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0xa8, 0xe2, 0x50,
+			0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8, 0x6e, 0xe6, 0x50, 0xe2, 0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84,
+			0xe8, 0x6e, 0xe6, 0x7c, 0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2,
+			0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e, 0xe8, 0x84, 0xe6, 0x66, 0xe2, 0x7c, 0x84, 0xe8, 0x7c, 0xe6,
+			0x84, 0xe8, 0x84, 0xe6, 0x7c, 0xe2, 0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92,
+			0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x7c, 0x9a, 0xe8, 0x92,
+			0xe6, 0x84, 0xe8, 0x9a, 0xe6, 0x7c, 0xe2, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0, 0xe6,
+			0x50, 0xe2, 0x66, 0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x7c, 0xb0, 0xe8,
+			0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0,
+			0xe6, 0x92, 0xe1,
+		}},
+		EditorBorderStyle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58,
+			0xe6, 0x58, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0x66, 0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6,
+			0x66, 0xe2, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x92, 0xb0, 0xe8,
+			0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2, 0xa8, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8,
+			0x6e, 0xe6, 0xa8, 0xe1,
+		}},
+		EditorBorderTop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x66,
+			0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e,
+			0xe8, 0x84, 0xe6, 0x66, 0xe2, 0x7c, 0xb0, 0xe8, 0xa8, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2,
+			0x7c, 0x9a, 0xe8, 0x92, 0xe6, 0x84, 0xe8, 0x9a, 0xe6, 0x7c, 0xe2, 0x7c, 0x84, 0xe8, 0x7c, 0xe6,
+			0x84, 0xe8, 0x84, 0xe6, 0x7c, 0xe2, 0x7c, 0x6e, 0xe8, 0x66, 0xe6, 0x84, 0xe8, 0x6e, 0xe6, 0x7c,
+			0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6, 0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0x92, 0x84, 0xe8, 0x7c,
+			0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6,
+			0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0xa8, 0xe2, 0xa8, 0x84, 0xe8,
+			0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e,
+			0xe6, 0xa8, 0xe1,
+		}},
+		EditorBorderVertical: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0x58, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x58, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0x58, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x58, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0x58, 0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x66,
+			0xb0, 0xe8, 0xa8, 0xe6, 0x6e, 0xe8, 0xb0, 0xe6, 0x66, 0xe2, 0x66, 0x84, 0xe8, 0x7c, 0xe6, 0x6e,
+			0xe8, 0x84, 0xe6, 0x66, 0xe2, 0x66, 0x58, 0xe8, 0x50, 0xe6, 0x6e, 0xe8, 0x58, 0xe6, 0x66, 0xe2,
+			0x7c, 0xb0, 0xe8, 0x50, 0xe6, 0x84, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x92, 0xb0, 0xe8, 0xa8, 0xe6,
+			0x9a, 0xe8, 0xb0, 0xe6, 0x92, 0xe2, 0x92, 0x84, 0xe8, 0x7c, 0xe6, 0x9a, 0xe8, 0x84, 0xe6, 0x92,
+			0xe2, 0x92, 0x58, 0xe8, 0x50, 0xe6, 0x9a, 0xe8, 0x58, 0xe6, 0x92, 0xe2, 0xa8, 0xb0, 0xe8, 0xa8,
+			0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0xa8, 0xe2, 0xa8, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6,
+			0xa8, 0xe2, 0xa8, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0xa8, 0xe2, 0xa8, 0x6e, 0xe8,
+			0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0xa8, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58,
+			0xe6, 0xa8, 0xe1,
+		}},
+		EditorBubbleChart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x72, 0x35, 0x90, 0x60, 0x5c, 0x35, 0x90,
+			0x56, 0x35, 0x8d, 0x40, 0x50, 0xdd, 0x85, 0x60, 0x50, 0x89, 0x81, 0x56, 0x89, 0x7e, 0x40, 0x55,
+			0x72, 0x89, 0x7b, 0x60, 0xad, 0x76, 0x89, 0x7b, 0xad, 0x79, 0x89, 0x7e, 0x40, 0xad, 0x7c, 0xdd,
+			0x85, 0x60, 0xad, 0x7c, 0x35, 0x8a, 0xad, 0x79, 0x35, 0x8d, 0x40, 0x55, 0x72, 0x35, 0x90, 0xe2,
+			0x55, 0x72, 0x35, 0x8c, 0x60, 0x6a, 0x35, 0x8c, 0xd5, 0x76, 0x5d, 0x8a, 0x40, 0xad, 0x78, 0xdd,
+			0x85, 0x60, 0xad, 0x78, 0x35, 0x83, 0xd5, 0x76, 0x5d, 0x81, 0x40, 0x55, 0x72, 0x89, 0x7f, 0x60,
+			0xad, 0x6f, 0x89, 0x7f, 0xd5, 0x6d, 0x5d, 0x81, 0x40, 0x58, 0xdd, 0x85, 0x60, 0x58, 0x89, 0x88,
+			0xd5, 0x6d, 0x5d, 0x8a, 0x40, 0x55, 0x72, 0x35, 0x8c, 0xe2, 0x55, 0x8c, 0xad, 0x84, 0x60, 0x55,
+			0x86, 0xad, 0x84, 0x2d, 0x82, 0x81, 0x80, 0x40, 0x7c, 0x55, 0x76, 0x60, 0x7c, 0x55, 0x70, 0x2d,
+			0x82, 0x2d, 0x6c, 0x40, 0x59, 0x8c, 0x50, 0x60, 0x55, 0x92, 0x50, 0x81, 0x96, 0x2d, 0x6c, 0x40,
+			0xad, 0x9a, 0x59, 0x76, 0x60, 0xad, 0x9a, 0x55, 0x7c, 0x81, 0x96, 0x81, 0x80, 0x40, 0x55, 0x8c,
+			0xad, 0x84, 0xe2, 0xfd, 0x86, 0xb0, 0x67, 0xf1, 0x83, 0xb0, 0xcd, 0x81, 0xdd, 0x95, 0xad, 0x7f,
+			0xb9, 0x93, 0xad, 0x7f, 0xa9, 0x90, 0xad, 0x7f, 0x99, 0x8d, 0xd1, 0x81, 0x79, 0x8b, 0xf5, 0x83,
+			0x55, 0x89, 0x05, 0x87, 0x55, 0x89, 0x11, 0x8a, 0x55, 0x89, 0x35, 0x8c, 0x79, 0x8b, 0x55, 0x8e,
+			0x9d, 0x8d, 0x55, 0x8e, 0xad, 0x90, 0x55, 0x8e, 0xbd, 0x93, 0x31, 0x8c, 0xdd, 0x95, 0x0d, 0x8a,
+			0xb0, 0xfd, 0x86, 0xb0, 0xe2, 0x51, 0x8c, 0xad, 0x80, 0x60, 0xad, 0x90, 0xad, 0x80, 0xad, 0x93,
+			0xb1, 0x7d, 0x40, 0xad, 0x96, 0x59, 0x76, 0x60, 0xad, 0x96, 0x64, 0xb1, 0x93, 0x5e, 0x40, 0x59,
+			0x8c, 0x58, 0x60, 0x90, 0x58, 0x8a, 0xfd, 0x6e, 0x40, 0x84, 0x51, 0x76, 0x60, 0x84, 0xad, 0x7a,
+			0xfd, 0x84, 0xad, 0x7d, 0x40, 0x51, 0x8c, 0xad, 0x80, 0xe2, 0xfd, 0x86, 0xa8, 0x67, 0x69, 0x88,
+			0xa8, 0x5d, 0x89, 0x0d, 0x93, 0x55, 0x8a, 0x19, 0x92, 0x55, 0x8a, 0xad, 0x90, 0x55, 0x8a, 0x45,
+			0x8f, 0x61, 0x89, 0x4d, 0x8e, 0x6d, 0x88, 0x55, 0x8d, 0x05, 0x87, 0x55, 0x8d, 0x99, 0x85, 0x55,
+			0x8d, 0xa5, 0x84, 0x49, 0x8e, 0xad, 0x83, 0x41, 0x8f, 0xad, 0x83, 0xa9, 0x90, 0xad, 0x83, 0x11,
+			0x92, 0xa1, 0x84, 0x09, 0x93, 0x95, 0x85, 0xa8, 0xfd, 0x86, 0xa8, 0xe2, 0x55, 0x8c, 0x55, 0x76,
+			0xe2, 0x55, 0x72, 0xdd, 0x85, 0xe2, 0x8e, 0xad, 0x90, 0xe1,
+		}},
+		EditorDragHandle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x8c, 0xe8, 0x84, 0xe6, 0x55, 0x95,
+			0xe8, 0x8c, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x7c, 0xe8, 0x74, 0xe6, 0x55, 0x95, 0xe8, 0x7c,
+			0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		EditorFormatAlignCenter: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0xad, 0x72, 0x9a, 0xe8, 0x92, 0xe6, 0x55, 0x8d, 0xe8, 0x9a, 0xe6, 0xad, 0x72,
+			0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0xad, 0x72, 0x6e, 0xe8,
+			0x66, 0xe6, 0x55, 0x8d, 0xe8, 0x6e, 0xe6, 0xad, 0x72, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0,
+			0xe8, 0x58, 0xe6, 0x50, 0xe1,
+		}},
+		EditorFormatAlignJustify: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0x50, 0xe1,
+		}},
+		EditorFormatAlignLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x50, 0x9a, 0xe8, 0x92, 0xe6, 0x90, 0xe8, 0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x6e, 0xe8, 0x66, 0xe6, 0x90, 0xe8,
+			0x6e, 0xe6, 0x50, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0x50, 0xe1,
+		}},
+		EditorFormatAlignRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58,
+			0xe6, 0x50, 0xe2, 0x70, 0x6e, 0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0x70, 0xe2, 0x50, 0x84,
+			0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x70, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8,
+			0x9a, 0xe6, 0x70, 0xe2, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0x50, 0xe1,
+		}},
+		EditorFormatBold: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x72, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6,
+			0x89, 0x81, 0x60, 0xf1, 0x85, 0x55, 0x6d, 0x2d, 0x89, 0x25, 0x70, 0x40, 0x69, 0x8c, 0x35, 0x77,
+			0x60, 0x69, 0x8c, 0xbd, 0x79, 0x96, 0xdd, 0x7b, 0x40, 0x45, 0x87, 0x25, 0x7f, 0xe8, 0x89, 0x7f,
+			0x60, 0x25, 0x8a, 0x79, 0x80, 0xe9, 0x8b, 0xdd, 0x82, 0x40, 0xad, 0x8d, 0x45, 0x88, 0x60, 0xad,
+			0x8d, 0xcd, 0x8c, 0x2d, 0x8a, 0xbd, 0x8f, 0x40, 0x84, 0xad, 0x92, 0xe6, 0x55, 0x72, 0xe2, 0x11,
+			0x78, 0x99, 0x8d, 0xe6, 0xad, 0x81, 0x60, 0x35, 0x84, 0x99, 0x8d, 0x11, 0x86, 0xf1, 0x8b, 0x40,
+			0xf1, 0x87, 0xbd, 0x87, 0x60, 0xf1, 0x87, 0x45, 0x85, 0x11, 0x86, 0x99, 0x83, 0x40, 0xad, 0x81,
+			0xf1, 0x81, 0xe6, 0x11, 0x78, 0xe8, 0x99, 0x8d, 0xe2, 0x11, 0x78, 0x25, 0x7d, 0xe6, 0x25, 0x81,
+			0x60, 0x79, 0x83, 0x25, 0x7d, 0x2d, 0x85, 0x99, 0x7b, 0x40, 0xdd, 0x86, 0xbd, 0x77, 0x60, 0xdd,
+			0x86, 0x69, 0x75, 0x2d, 0x85, 0xd5, 0x73, 0x40, 0x25, 0x81, 0x45, 0x72, 0xe6, 0x11, 0x78, 0xe8,
+			0x25, 0x7d, 0xe1,
+		}},
+		EditorFormatClear: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x81, 0x11, 0x7d, 0x01, 0xad, 0x7c, 0x70,
+			0x45, 0x7e, 0x45, 0x74, 0xe6, 0x72, 0x00, 0x11, 0x72, 0x55, 0x6d, 0xe6, 0x55, 0x95, 0xe8, 0x68,
+			0xe6, 0xad, 0x85, 0x00, 0xcd, 0x81, 0x11, 0x7d, 0xe2, 0xbd, 0x95, 0x45, 0x9c, 0x01, 0x79, 0x7e,
+			0xf1, 0x84, 0x99, 0x78, 0xad, 0x92, 0xe6, 0x55, 0x71, 0x04, 0x55, 0x79, 0xdd, 0x7f, 0xbd, 0x63,
+			0x45, 0x6a, 0x89, 0x66, 0x79, 0x67, 0x89, 0x98, 0x79, 0x99, 0xbd, 0x95, 0x45, 0x9c, 0xe1,
+		}},
+		EditorFormatColorFill: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x62, 0xdd, 0x62, 0x01, 0xdd, 0x73, 0x40, 0x0d,
+			0x8b, 0x2d, 0x77, 0x61, 0x99, 0x8c, 0xbd, 0x78, 0x99, 0x8c, 0xf9, 0x7a, 0x99, 0x8c, 0x39, 0x7d,
+			0x11, 0x8b, 0xbd, 0x7e, 0x00, 0x35, 0x7f, 0x99, 0x8a, 0x60, 0xad, 0x7d, 0x25, 0x8c, 0x99, 0x7b,
+			0x25, 0x8c, 0x40, 0x70, 0x99, 0x8a, 0x00, 0x25, 0x6c, 0xbd, 0x7e, 0x61, 0x99, 0x6a, 0x39, 0x7d,
+			0x99, 0x6a, 0xf9, 0x7a, 0x99, 0x6a, 0xb9, 0x78, 0x25, 0x6c, 0x35, 0x77, 0x01, 0xbd, 0x78, 0x99,
+			0x6a, 0x62, 0xdd, 0x62, 0xe2, 0x99, 0x7b, 0x79, 0x6d, 0x00, 0x55, 0x6e, 0xbd, 0x7a, 0xe6, 0xdd,
+			0x88, 0x00, 0x99, 0x7b, 0x79, 0x6d, 0xe2, 0x21, 0x92, 0x45, 0x8d, 0x62, 0x25, 0x90, 0x45, 0x8d,
+			0xbd, 0x8e, 0xdd, 0x8b, 0x55, 0x8d, 0x75, 0x8a, 0x55, 0x8d, 0x79, 0x88, 0x55, 0x8d, 0x51, 0x87,
+			0xdd, 0x8d, 0xfd, 0x85, 0x40, 0x55, 0x8f, 0x45, 0x83, 0x60, 0xdd, 0x8f, 0x69, 0x82, 0xa5, 0x90,
+			0x69, 0x81, 0x40, 0x25, 0x92, 0x89, 0x7f, 0x60, 0xdd, 0x92, 0x69, 0x80, 0xa5, 0x93, 0x69, 0x81,
+			0x40, 0xf1, 0x94, 0x45, 0x83, 0x60, 0xdd, 0x95, 0xad, 0x84, 0x69, 0x96, 0xfd, 0x85, 0x40, 0xf1,
+			0x96, 0x79, 0x88, 0x61, 0xf1, 0x96, 0x75, 0x8a, 0x85, 0x95, 0xdd, 0x8b, 0x1d, 0x94, 0x45, 0x8d,
+			0x21, 0x92, 0x45, 0x8d, 0xe2, 0x55, 0x65, 0x11, 0xa0, 0xe8, 0xb0, 0xe6, 0xad, 0x9a, 0xe8, 0x11,
+			0xa0, 0xe6, 0x55, 0x65, 0xe1,
+		}},
+		EditorFormatColorReset: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x92, 0x99, 0x8d, 0x00, 0x79, 0x8f, 0x99,
+			0x8a, 0x60, 0x45, 0x90, 0x92, 0xcd, 0x90, 0xcd, 0x86, 0x40, 0x55, 0x91, 0x86, 0x60, 0x55, 0x91,
+			0xad, 0x7f, 0xdd, 0x8f, 0x69, 0x7c, 0x40, 0x11, 0x8c, 0xdd, 0x76, 0x04, 0x80, 0xf1, 0x6a, 0xf1,
+			0x77, 0x11, 0x73, 0x11, 0x75, 0x35, 0x70, 0x80, 0x55, 0x65, 0x9e, 0x25, 0x74, 0x60, 0xf1, 0x91,
+			0x11, 0x77, 0xa5, 0x93, 0xf1, 0x7a, 0x40, 0x55, 0x95, 0x86, 0x60, 0x55, 0x95, 0x99, 0x85, 0x89,
+			0x94, 0x71, 0x88, 0x40, 0x79, 0x92, 0x99, 0x8d, 0xe2, 0xbd, 0x95, 0x45, 0x9c, 0x00, 0x11, 0x8d,
+			0x99, 0x93, 0x60, 0x79, 0x8a, 0xbd, 0x95, 0x09, 0x87, 0xdd, 0x96, 0x40, 0x80, 0xb0, 0x60, 0x35,
+			0x77, 0xb0, 0xf1, 0x70, 0xe9, 0x91, 0x40, 0xad, 0x6a, 0x86, 0x60, 0xad, 0x6a, 0x35, 0x7f, 0xcd,
+			0x6b, 0xf1, 0x7b, 0x40, 0x55, 0x6f, 0xdd, 0x75, 0x03, 0xcd, 0x63, 0x55, 0x6a, 0xad, 0x66, 0x79,
+			0x67, 0x99, 0x98, 0x69, 0x99, 0xbd, 0x95, 0x45, 0x9c, 0xe2, 0x80, 0xa8, 0x60, 0x11, 0x83, 0xa8,
+			0xb5, 0x85, 0x35, 0x93, 0x40, 0x45, 0x8a, 0xbd, 0x90, 0x00, 0x35, 0x72, 0xad, 0x78, 0x60, 0x99,
+			0x70, 0x99, 0x7a, 0xa5, 0x6f, 0x2d, 0x7d, 0x40, 0xad, 0x6e, 0x86, 0x60, 0xad, 0x6e, 0x25, 0x8a,
+			0xc5, 0x73, 0x11, 0x8f, 0x40, 0x80, 0xa8, 0xe2, 0x45, 0x7e, 0xdd, 0x83, 0xe2, 0x11, 0x85, 0x79,
+			0x7d, 0xe1,
+		}},
+		EditorFormatColorText: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x4c, 0xc0, 0xe8, 0xad, 0x96, 0xe6, 0xb4, 0xe8,
+			0xc0, 0xe6, 0x4c, 0xe2, 0xad, 0x6e, 0x55, 0x8d, 0x00, 0x55, 0x7d, 0x50, 0xe6, 0xad, 0x82, 0x00,
+			0x55, 0x91, 0x55, 0x8d, 0xe6, 0x55, 0x8c, 0x00, 0x89, 0x88, 0x55, 0x83, 0xe6, 0x79, 0x77, 0x00,
+			0xad, 0x73, 0x55, 0x8d, 0xe6, 0xad, 0x6e, 0xe2, 0x11, 0x79, 0x11, 0x7f, 0xe6, 0xf1, 0x86, 0x00,
+			0x25, 0x80, 0x5a, 0xe6, 0xdd, 0x7f, 0x00, 0x11, 0x79, 0x11, 0x7f, 0xe1,
+		}},
+		EditorFormatIndentDecrease: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x55, 0x7d, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0x55, 0x7d, 0xe2,
+			0x55, 0x7d, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x55, 0x7d, 0xe2, 0x55, 0x7d, 0x6e,
+			0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0x55, 0x7d, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0,
+			0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x69, 0x71, 0x25, 0x89, 0x01, 0x50, 0xcd, 0x7f, 0x69, 0x71, 0xbd,
+			0x76, 0xe8, 0x25, 0x89, 0xe1,
+		}, mirror: true},
+		EditorFormatIndentIncrease: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0,
+			0xe6, 0x50, 0xe2, 0x55, 0x7d, 0x9a, 0xe8, 0x92, 0xe6, 0xb0, 0xe8, 0x9a, 0xe6, 0x55, 0x7d, 0xe2,
+			0x55, 0x7d, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x55, 0x7d, 0xe2, 0x55, 0x7d, 0x6e,
+			0xe8, 0x66, 0xe6, 0xb0, 0xe8, 0x6e, 0xe6, 0x55, 0x7d, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0,
+			0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x50, 0x25, 0x89, 0xe8, 0xbd, 0x76, 0x01, 0x69, 0x71, 0xcd, 0x7f,
+			0x50, 0x25, 0x89, 0xe1,
+		}, mirror: true},
+		EditorFormatItalic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x6e, 0xbd, 0x92, 0xe8, 0x69, 0x8d, 0xe6,
+			0xdd, 0x77, 0x00, 0x25, 0x81, 0x25, 0x72, 0xe6, 0x89, 0x76, 0xe8, 0xcd, 0x6c, 0xe6, 0xdd, 0x8f,
+			0xe8, 0x25, 0x72, 0xe6, 0xf1, 0x86, 0x00, 0xad, 0x7d, 0x69, 0x8d, 0xe6, 0x45, 0x88, 0xe8, 0xbd,
+			0x92, 0xe6, 0xf1, 0x6e, 0xe1,
+		}},
+		EditorFormatLineSpacing: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x69, 0x95, 0x02, 0x55, 0x65, 0xbd, 0x8a,
+			0x25, 0x68, 0xf1, 0x87, 0x5c, 0xad, 0x8d, 0xe8, 0x79, 0x72, 0x05, 0x25, 0x68, 0x35, 0x78, 0x55,
+			0x65, 0x69, 0x75, 0x60, 0xbd, 0x6a, 0xad, 0x7a, 0x69, 0x75, 0xdd, 0x77, 0x35, 0x78, 0x64, 0x79,
+			0x72, 0xe8, 0xad, 0x8d, 0x02, 0xdd, 0x77, 0xf1, 0x87, 0xad, 0x7a, 0xbd, 0x8a, 0x60, 0x69, 0x95,
+			0xe2, 0x80, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6, 0xad, 0x9a, 0xe8, 0xad, 0x92, 0xe6, 0x80, 0xe2,
+			0x80, 0x84, 0xe8, 0x7c, 0xe6, 0xad, 0x9a, 0xe8, 0x84, 0xe6, 0x80, 0xe2, 0x80, 0x55, 0x71, 0xe8,
+			0x55, 0x6d, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x71, 0xe6, 0x80, 0xe1,
+		}},
+		EditorFormatListBulleted: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x79, 0xcd, 0x92, 0xe8, 0xcd, 0x8e, 0xe6,
+			0xb0, 0xe8, 0xcd, 0x92, 0xe6, 0x25, 0x79, 0xe2, 0x25, 0x79, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8,
+			0x84, 0xe6, 0x25, 0x79, 0xe2, 0x25, 0x79, 0x25, 0x71, 0xe8, 0x25, 0x6d, 0xe6, 0xb0, 0xe8, 0x25,
+			0x71, 0xe6, 0x25, 0x79, 0xe2, 0x99, 0x6c, 0x45, 0x95, 0x61, 0xbd, 0x6a, 0x45, 0x95, 0x65, 0x69,
+			0xa8, 0x11, 0x68, 0xbd, 0x92, 0x11, 0x68, 0xd5, 0x90, 0x40, 0x5d, 0x69, 0xa5, 0x8d, 0x60, 0xad,
+			0x6a, 0x55, 0x8c, 0x91, 0x6c, 0x55, 0x8c, 0x40, 0xbd, 0x6f, 0xad, 0x8d, 0x62, 0x62, 0x9e, 0x62,
+			0xdd, 0x90, 0x62, 0xb1, 0x92, 0xb5, 0x6f, 0xf9, 0x93, 0x6d, 0x6e, 0x45, 0x95, 0x99, 0x6c, 0x45,
+			0x95, 0xe2, 0x99, 0x6c, 0x79, 0x84, 0x61, 0xbd, 0x6a, 0x79, 0x84, 0x65, 0x69, 0x29, 0x83, 0x11,
+			0x68, 0xd9, 0x81, 0x11, 0x68, 0x80, 0x40, 0x65, 0x69, 0xd9, 0x7c, 0x62, 0xbd, 0x6a, 0x89, 0x7b,
+			0x99, 0x6c, 0x89, 0x7b, 0x6d, 0x6e, 0x89, 0x7b, 0xb5, 0x6f, 0xd9, 0x7c, 0x62, 0x29, 0x7e, 0x62,
+			0x80, 0x40, 0xb5, 0x6f, 0x29, 0x83, 0x60, 0x6d, 0x6e, 0x79, 0x84, 0x99, 0x6c, 0x79, 0x84, 0xe2,
+			0x89, 0x6c, 0x99, 0x73, 0x61, 0xb1, 0x6a, 0x99, 0x73, 0x61, 0x69, 0x49, 0x72, 0x11, 0x68, 0xf9,
+			0x70, 0x11, 0x68, 0x25, 0x6f, 0x40, 0x61, 0x69, 0xfd, 0x6b, 0x62, 0xb1, 0x6a, 0xad, 0x6a, 0x89,
+			0x6c, 0xad, 0x6a, 0x61, 0x6e, 0xad, 0x6a, 0xb1, 0x6f, 0xfd, 0x6b, 0x62, 0x4d, 0x6d, 0x62, 0x25,
+			0x6f, 0x40, 0xb1, 0x6f, 0x49, 0x72, 0x60, 0x61, 0x6e, 0x99, 0x73, 0x89, 0x6c, 0x99, 0x73, 0xe1,
+		}, mirror: true},
+		EditorFormatListNumbered: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xad, 0x9a, 0xe8, 0xad, 0x96, 0xe6, 0xad,
+			0x6e, 0xe8, 0xad, 0x94, 0xe6, 0xad, 0x6a, 0xe8, 0xad, 0x90, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x8e,
+			0xe6, 0x50, 0xe8, 0xad, 0x8a, 0xe6, 0x60, 0x60, 0x25, 0x71, 0xad, 0x8a, 0xe9, 0x71, 0x71, 0x8b,
+			0x40, 0xad, 0x72, 0x55, 0x8d, 0xe8, 0xa0, 0x60, 0xad, 0x72, 0x25, 0x91, 0xe9, 0x71, 0xe9, 0x91,
+			0x40, 0x60, 0xad, 0x92, 0x60, 0x25, 0x71, 0xad, 0x92, 0xe9, 0x71, 0x71, 0x93, 0x40, 0xad, 0x72,
+			0x55, 0x95, 0xe8, 0xb0, 0x60, 0xad, 0x72, 0x25, 0x99, 0xe9, 0x71, 0xe9, 0x99, 0x40, 0x60, 0xad,
+			0x9a, 0xe6, 0x50, 0xe2, 0x50, 0x90, 0xe8, 0xad, 0x80, 0x60, 0x50, 0x89, 0x7f, 0xc5, 0x68, 0xc5,
+			0x7e, 0x40, 0xad, 0x6a, 0x7c, 0xe6, 0xad, 0x6e, 0xe8, 0x78, 0xe6, 0x50, 0xe8, 0x70, 0xe6, 0x60,
+			0x60, 0x25, 0x71, 0x70, 0xe9, 0x71, 0xc5, 0x78, 0x40, 0xad, 0x72, 0xad, 0x7a, 0xe8, 0x55, 0x7f,
+			0x60, 0xad, 0x72, 0x79, 0x80, 0xe9, 0x71, 0x3d, 0x81, 0x40, 0x60, 0x84, 0xe6, 0x58, 0xe8, 0x88,
+			0xe6, 0xad, 0x72, 0xe8, 0x90, 0xe6, 0x50, 0xe2, 0x58, 0x55, 0x75, 0xe8, 0x55, 0x69, 0xe6, 0x50,
+			0xe8, 0x55, 0x65, 0xe6, 0x60, 0xe8, 0x55, 0x75, 0xe6, 0x58, 0xe2, 0x99, 0x78, 0x11, 0x92, 0xe8,
+			0x11, 0x8e, 0xe6, 0xb0, 0xe8, 0x11, 0x92, 0xe6, 0x99, 0x78, 0xe2, 0x99, 0x78, 0xdd, 0x81, 0xe8,
+			0xdd, 0x7d, 0xe6, 0xb0, 0xe8, 0xdd, 0x81, 0xe6, 0x99, 0x78, 0xe2, 0x99, 0x78, 0xad, 0x71, 0xe8,
+			0xad, 0x6d, 0xe6, 0xb0, 0xe8, 0xad, 0x71, 0xe6, 0x99, 0x78, 0xe1,
+		}, mirror: true},
+		EditorFormatPaint: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7d, 0xad, 0x9a, 0x60, 0x89, 0x7b, 0xad,
+			0x9a, 0x55, 0x7a, 0x7d, 0x99, 0x40, 0x25, 0x79, 0xad, 0x96, 0xe8, 0x55, 0x89, 0xe6, 0xad, 0x6e,
+			0x60, 0x11, 0x6d, 0x55, 0x89, 0xdd, 0x6b, 0x25, 0x88, 0x40, 0xad, 0x6a, 0x55, 0x85, 0xe8, 0x25,
+			0x71, 0x60, 0xad, 0x6a, 0x79, 0x6d, 0x51, 0x6d, 0xbd, 0x6a, 0x40, 0xad, 0x73, 0x50, 0xe6, 0x55,
+			0x95, 0xe8, 0x55, 0x85, 0x60, 0x55, 0x95, 0xf1, 0x86, 0x25, 0x94, 0x25, 0x88, 0x40, 0x55, 0x91,
+			0x55, 0x89, 0xe6, 0xdd, 0x86, 0xe8, 0xad, 0x96, 0x60, 0xdd, 0x86, 0x51, 0x98, 0xad, 0x85, 0x7d,
+			0x99, 0x40, 0xdd, 0x82, 0xad, 0x9a, 0xe6, 0x25, 0x7d, 0xe2, 0xad, 0x6e, 0x11, 0x7b, 0xe6, 0x55,
+			0x91, 0xe8, 0x58, 0xe6, 0x99, 0x8d, 0xe8, 0x69, 0x77, 0xe6, 0x99, 0x89, 0xe8, 0x58, 0xe6, 0xdd,
+			0x84, 0xe8, 0xad, 0x71, 0xe6, 0xdd, 0x80, 0xe8, 0x58, 0xe6, 0xad, 0x73, 0x60, 0x89, 0x71, 0x58,
+			0x19, 0x70, 0x89, 0x6d, 0x40, 0xad, 0x6e, 0x25, 0x71, 0xe8, 0x11, 0x7b, 0xe2, 0xad, 0x6e, 0x55,
+			0x85, 0xe6, 0x55, 0x91, 0xe8, 0x11, 0x7f, 0xe6, 0xad, 0x6e, 0xe8, 0x55, 0x85, 0xe2, 0xad, 0x6e,
+			0x11, 0x7f, 0xe8, 0x55, 0x85, 0xe8, 0x11, 0x7f, 0xe1,
+		}},
+		EditorFormatQuote: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x86, 0xad, 0x7e, 0xe6, 0x55, 0x91, 0xe8,
+			0x68, 0xe6, 0xad, 0x86, 0xe8, 0xad, 0x7e, 0xe2, 0xad, 0x6e, 0xad, 0x7e, 0xe6, 0x55, 0x79, 0xe8,
+			0x68, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x7e, 0xe2, 0xbd, 0x89, 0x55, 0x8d, 0x00, 0x11, 0x8f, 0xad,
+			0x82, 0xe6, 0xad, 0x82, 0xe8, 0x60, 0xe6, 0x55, 0x95, 0xe8, 0x35, 0x83, 0x00, 0x45, 0x90, 0x55,
+			0x8d, 0xe6, 0xbd, 0x89, 0xe2, 0xbd, 0x71, 0x55, 0x8d, 0x00, 0x11, 0x77, 0xad, 0x82, 0xe6, 0xad,
+			0x6a, 0xe8, 0x60, 0xe6, 0x55, 0x7d, 0xe8, 0x35, 0x83, 0x00, 0x45, 0x78, 0x55, 0x8d, 0xe6, 0xbd,
+			0x71, 0xe2, 0x68, 0x55, 0x79, 0xe2, 0x98, 0x55, 0x79, 0xe1,
+		}},
+		EditorFormatShapes: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0x55, 0x9d, 0xe8, 0x25, 0x8f, 0xe6,
+			0xf1, 0x67, 0xe8, 0xdd, 0x70, 0xe6, 0xad, 0x62, 0xe8, 0xad, 0x62, 0xe6, 0xdd, 0x70, 0xe8, 0xf1,
+			0x67, 0xe6, 0x25, 0x8f, 0xe8, 0xad, 0x62, 0xe6, 0x55, 0x9d, 0xe8, 0xdd, 0x70, 0xe6, 0x11, 0x98,
+			0xe8, 0x25, 0x8f, 0xe6, 0x55, 0x9d, 0xe8, 0x55, 0x9d, 0xe6, 0x25, 0x8f, 0xe8, 0x11, 0x98, 0xe6,
+			0xdd, 0x70, 0xe8, 0x55, 0x9d, 0xe6, 0xad, 0x62, 0xe2, 0xdd, 0x70, 0x11, 0x94, 0xe6, 0x25, 0x8f,
+			0xe8, 0x25, 0x8f, 0xe6, 0x11, 0x94, 0xe8, 0xdd, 0x70, 0xe6, 0x25, 0x8f, 0xe8, 0xf1, 0x6b, 0xe6,
+			0xdd, 0x70, 0xe8, 0xdd, 0x70, 0xe6, 0xf1, 0x6b, 0xe8, 0x25, 0x8f, 0xe6, 0xdd, 0x70, 0xe8, 0x11,
+			0x94, 0xe2, 0x79, 0x74, 0x99, 0x8b, 0x00, 0x69, 0x7e, 0x79, 0x71, 0xe6, 0x89, 0x81, 0x00, 0x89,
+			0x8b, 0x99, 0x8b, 0xe6, 0x25, 0x88, 0x00, 0x79, 0x85, 0x69, 0x84, 0xe6, 0xdd, 0x7a, 0x00, 0x35,
+			0x78, 0x99, 0x8b, 0xe6, 0x79, 0x74, 0xe2, 0xcd, 0x7b, 0x55, 0x81, 0xe6, 0x35, 0x84, 0x00, 0x35,
+			0x80, 0x45, 0x76, 0xe6, 0xbd, 0x7f, 0x00, 0xcd, 0x7b, 0x55, 0x81, 0xe2, 0xad, 0x66, 0xdd, 0x6c,
+			0xe6, 0xdd, 0x6c, 0xe8, 0xad, 0x66, 0xe6, 0xad, 0x66, 0xe8, 0xdd, 0x6c, 0xe2, 0x25, 0x93, 0xdd,
+			0x6c, 0xe6, 0x55, 0x99, 0xe8, 0xad, 0x66, 0xe6, 0x25, 0x93, 0xe8, 0xdd, 0x6c, 0xe2, 0x25, 0x93,
+			0x55, 0x99, 0xe6, 0x55, 0x99, 0xe8, 0x25, 0x93, 0xe6, 0x25, 0x93, 0xe8, 0x55, 0x99, 0xe2, 0xad,
+			0x66, 0x55, 0x99, 0xe6, 0xdd, 0x6c, 0xe8, 0x25, 0x93, 0xe6, 0xad, 0x66, 0xe8, 0x55, 0x99, 0xe2,
+			0xdd, 0x6c, 0xdd, 0x6c, 0xe2, 0x25, 0x93, 0xdd, 0x6c, 0xe2, 0x25, 0x93, 0x25, 0x93, 0xe2, 0xdd,
+			0x6c, 0x25, 0x93, 0xe1,
+		}},
+		EditorFormatSize: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x8c, 0x55, 0x95, 0xe8, 0x55, 0x71, 0xe6, 0x70,
+			0xe8, 0xad, 0x6a, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x71, 0xe6, 0xad, 0x8c, 0xe8, 0x55, 0x95, 0xe6,
+			0x8c, 0xe2, 0x5c, 0x55, 0x95, 0xe8, 0xad, 0x7e, 0xe6, 0x55, 0x65, 0xe8, 0x70, 0xe6, 0x55, 0x7d,
+			0xe8, 0xad, 0x7e, 0xe6, 0xad, 0x74, 0xe8, 0x55, 0x95, 0xe6, 0x5c, 0xe1,
+		}},
+		EditorFormatStrikethrough: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x84, 0xe8, 0xad, 0x80, 0xe6,
+			0xad, 0x9a, 0xe8, 0xad, 0x84, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x7c, 0xad, 0x7c, 0xe8, 0x55, 0x71,
+			0xe6, 0x55, 0x6d, 0xe8, 0xad, 0x6a, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x83, 0xe8,
+			0xad, 0x7c, 0xe6, 0xad, 0x7c, 0xe2, 0xad, 0x7c, 0x55, 0x95, 0xe8, 0xad, 0x88, 0xe6, 0x55, 0x83,
+			0xe8, 0x55, 0x95, 0xe6, 0xad, 0x7c, 0xe1,
+		}},
+		EditorFormatTextDirectionLToR: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x74, 0x90, 0xe8, 0x55, 0x79, 0x60, 0x99, 0x75,
+			0x55, 0x79, 0x79, 0x72, 0x79, 0x76, 0x40, 0x55, 0x6f, 0x5d, 0x6f, 0x60, 0x55, 0x6f, 0x25, 0x6b,
+			0x3d, 0x72, 0x3d, 0x68, 0x40, 0x55, 0x79, 0x55, 0x65, 0xe6, 0x98, 0xe8, 0x55, 0x69, 0xe6, 0x8c,
+			0xe8, 0x90, 0xe6, 0x84, 0xe8, 0x55, 0x69, 0xe6, 0x7c, 0xe8, 0x90, 0xe6, 0x74, 0xe2, 0x45, 0x8e,
+			0xad, 0x9a, 0x01, 0x79, 0x8b, 0xdd, 0x97, 0x69, 0x90, 0xf1, 0x92, 0xe6, 0x50, 0xe8, 0xf1, 0x8e,
+			0xe6, 0x69, 0x90, 0x03, 0x79, 0x8b, 0x94, 0x45, 0x8e, 0x35, 0x87, 0xb0, 0xf1, 0x90, 0x45, 0x8e,
+			0xad, 0x9a, 0xe2, 0x74, 0x55, 0x75, 0xe8, 0x55, 0x69, 0x60, 0x35, 0x77, 0x55, 0x69, 0x45, 0x75,
+			0xf1, 0x6a, 0x40, 0x55, 0x73, 0x55, 0x6f, 0x60, 0x55, 0x73, 0x25, 0x72, 0x45, 0x75, 0xbd, 0x73,
+			0x40, 0x74, 0x55, 0x75, 0xe2, 0x74, 0x55, 0x6f, 0xe1,
+		}},
+		EditorFormatTextDirectionRToL: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x71, 0xad, 0x9a, 0x03, 0x50, 0xf1, 0x90,
+			0xbd, 0x71, 0x35, 0x87, 0x89, 0x74, 0x94, 0x99, 0x6f, 0xf1, 0x8e, 0xe6, 0xb0, 0xe8, 0xf1, 0x92,
+			0xe6, 0x99, 0x6f, 0x01, 0x89, 0x74, 0xdd, 0x97, 0xbd, 0x71, 0xad, 0x9a, 0xe2, 0x74, 0x90, 0xe8,
+			0x55, 0x79, 0x60, 0x99, 0x75, 0x55, 0x79, 0x79, 0x72, 0x79, 0x76, 0x40, 0x55, 0x6f, 0x5d, 0x6f,
+			0x60, 0x55, 0x6f, 0x25, 0x6b, 0x3d, 0x72, 0x3d, 0x68, 0x40, 0x55, 0x79, 0x55, 0x65, 0xe6, 0x98,
+			0xe8, 0x55, 0x69, 0xe6, 0x8c, 0xe8, 0x90, 0xe6, 0x84, 0xe8, 0x55, 0x69, 0xe6, 0x7c, 0xe8, 0x90,
+			0xe6, 0x74, 0xe2, 0x74, 0x55, 0x75, 0xe8, 0x55, 0x69, 0x60, 0x35, 0x77, 0x55, 0x69, 0x45, 0x75,
+			0xf1, 0x6a, 0x40, 0x55, 0x73, 0x55, 0x6f, 0x60, 0x55, 0x73, 0x25, 0x72, 0x45, 0x75, 0xbd, 0x73,
+			0x40, 0x74, 0x55, 0x75, 0xe2, 0x74, 0x55, 0x6f, 0xe1,
+		}},
+		EditorFormatUnderlined: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xad, 0x96, 0xe8, 0xad, 0x92, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x6d, 0xe2, 0x80, 0x55, 0x8d, 0x60, 0x55, 0x79, 0x55,
+			0x8d, 0x91, 0x75, 0x71, 0x89, 0x40, 0xcd, 0x71, 0xdd, 0x7e, 0xe8, 0x50, 0xe6, 0x55, 0x77, 0xe8,
+			0xdd, 0x7e, 0x60, 0x55, 0x77, 0x11, 0x83, 0x99, 0x79, 0x99, 0x85, 0x40, 0x80, 0x25, 0x88, 0x60,
+			0x25, 0x84, 0x25, 0x88, 0x69, 0x86, 0x99, 0x85, 0x40, 0xad, 0x88, 0xdd, 0x7e, 0xe8, 0x50, 0xe6,
+			0x35, 0x8e, 0xe8, 0xdd, 0x7e, 0x60, 0x35, 0x8e, 0x89, 0x85, 0x71, 0x8a, 0x71, 0x89, 0x40, 0x80,
+			0x55, 0x8d, 0xe1,
+		}},
+		EditorFunctions: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x55, 0x95, 0xe8, 0x25, 0x91, 0x01, 0x55,
+			0x82, 0x80, 0x60, 0xdd, 0x6e, 0xe8, 0xad, 0x6a, 0xe6, 0xa0, 0xe8, 0x55, 0x71, 0xe6, 0x69, 0x7a,
+			0x01, 0x11, 0x8a, 0x80, 0x69, 0x7a, 0xad, 0x8e, 0xe6, 0xa0, 0xe8, 0x55, 0x95, 0xe6, 0x60, 0xe1,
+		}},
+		EditorHighlight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x5e, 0x55, 0x74, 0x03, 0x55, 0x69, 0xbd, 0x6e,
+			0x35, 0x6c, 0xdd, 0x6b, 0xcd, 0x71, 0x89, 0x71, 0x5e, 0x55, 0x74, 0xe2, 0x7c, 0x55, 0x6d, 0xe8,
+			0x55, 0x65, 0xe6, 0x84, 0xe8, 0x55, 0x6d, 0xe6, 0x7c, 0xe2, 0xa2, 0x79, 0x74, 0x03, 0x25, 0x8e,
+			0x99, 0x71, 0xdd, 0x93, 0x58, 0xad, 0x96, 0xcd, 0x6e, 0xa2, 0x79, 0x74, 0xe2, 0x70, 0xad, 0x9a,
+			0xe8, 0x55, 0x8d, 0x00, 0x60, 0x55, 0x85, 0xe8, 0x70, 0xe6, 0xa0, 0xe8, 0x55, 0x85, 0x00, 0x90,
+			0x55, 0x8d, 0xe8, 0xad, 0x9a, 0xe6, 0x70, 0xe2, 0x78, 0xad, 0x96, 0xe6, 0x88, 0xe8, 0xad, 0x8b,
+			0x00, 0x98, 0xad, 0x83, 0xe8, 0x78, 0xe6, 0x68, 0xe8, 0xad, 0x83, 0x00, 0x78, 0xad, 0x8b, 0xe8,
+			0xad, 0x96, 0xe2, 0x80, 0x55, 0x89, 0xe1,
+		}},
+		EditorInsertChart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x72, 0x89, 0x8d, 0xe6, 0xf1, 0x76, 0xe8,
+			0x35, 0x7b, 0xe6, 0xf1, 0x72, 0xe8, 0x89, 0x8d, 0xe2, 0x7c, 0x89, 0x8d, 0xe6, 0x84, 0xe8, 0x79,
+			0x72, 0xe6, 0x7c, 0xe8, 0x89, 0x8d, 0xe2, 0x11, 0x89, 0x89, 0x8d, 0xe6, 0x11, 0x8d, 0xe8, 0xad,
+			0x83, 0xe6, 0x11, 0x89, 0xe8, 0x89, 0x8d, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58,
+			0xe1,
+		}},
+		EditorInsertComment:    EditorInsertComment,
+		EditorInsertDriveFile:  EditorInsertDriveFile,
+		EditorInsertEmoticon:   EditorInsertEmoticon,
+		EditorInsertInvitation: EditorInsertInvitation,
+		EditorInsertLink:       EditorInsertLink,
+		EditorInsertPhoto:      EditorInsertPhoto,
+		EditorLinearScale: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8d, 0x55, 0x8d, 0x60, 0x69, 0x88, 0x55,
+			0x8d, 0xbd, 0x84, 0x35, 0x8a, 0x40, 0x25, 0x80, 0x84, 0xe6, 0x69, 0x72, 0x60, 0xbd, 0x71, 0xf1,
+			0x83, 0xf9, 0x6f, 0x4d, 0x85, 0x40, 0xf1, 0x6b, 0xad, 0x86, 0x60, 0x35, 0x69, 0xad, 0x86, 0x3d,
+			0x67, 0xb5, 0x84, 0x40, 0x45, 0x65, 0x80, 0x60, 0x45, 0x65, 0x45, 0x7d, 0x3d, 0x67, 0x4d, 0x7b,
+			0x40, 0xf1, 0x6b, 0x55, 0x79, 0x60, 0x35, 0x6e, 0x55, 0x79, 0xf9, 0x6f, 0xb5, 0x7a, 0x40, 0x69,
+			0x72, 0x7c, 0xe6, 0x25, 0x80, 0x60, 0x11, 0x81, 0xf1, 0x78, 0xbd, 0x84, 0xcd, 0x75, 0x40, 0x55,
+			0x8d, 0xad, 0x72, 0x60, 0xdd, 0x92, 0xad, 0x72, 0xc5, 0x96, 0x91, 0x76, 0x40, 0xad, 0x9a, 0x80,
+			0x60, 0xad, 0x9a, 0x89, 0x85, 0xc5, 0x96, 0x71, 0x89, 0x40, 0x55, 0x8d, 0x55, 0x8d, 0xe2, 0x55,
+			0x8d, 0x55, 0x89, 0x60, 0x45, 0x91, 0x55, 0x89, 0xf9, 0x93, 0xa5, 0x86, 0x40, 0xad, 0x96, 0x80,
+			0x60, 0xad, 0x96, 0x11, 0x7c, 0xf9, 0x93, 0x5d, 0x79, 0x40, 0x55, 0x8d, 0xad, 0x76, 0x60, 0x69,
+			0x89, 0xad, 0x76, 0xb5, 0x86, 0x5d, 0x79, 0x40, 0x88, 0x80, 0x60, 0x88, 0xf1, 0x83, 0xb5, 0x86,
+			0xa5, 0x86, 0x40, 0x55, 0x8d, 0x55, 0x89, 0xe1,
+		}},
+		EditorMergeType: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x8d, 0x55, 0x95, 0x00, 0xbd, 0x7d, 0x99,
+			0x85, 0xe8, 0xbd, 0x6f, 0x05, 0x99, 0x75, 0xdd, 0x77, 0xbd, 0x72, 0x6a, 0xbd, 0x7f, 0x50, 0xbd,
+			0x8c, 0x6a, 0xdd, 0x89, 0xdd, 0x77, 0xbd, 0x81, 0xbd, 0x6f, 0xe8, 0xf1, 0x83, 0x01, 0x45, 0x90,
+			0x79, 0x92, 0x79, 0x8d, 0x55, 0x95, 0xe2, 0x35, 0x72, 0x55, 0x95, 0x03, 0x55, 0x6f, 0x79, 0x92,
+			0x25, 0x7a, 0xad, 0x87, 0x7a, 0x89, 0x8a, 0x35, 0x72, 0x55, 0x95, 0xe1,
+		}, mirror: true},
+		EditorModeComment: EditorModeComment,
+		EditorModeEdit:    EditorModeEdit,
+		EditorMonetizationOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x7e, 0x25, 0x93, 0xe6, 0xbd, 0x81, 0xe8,
+			0xad, 0x8f, 0x60, 0xcd, 0x85, 0x35, 0x8f, 0x11, 0x88, 0x2d, 0x8d, 0x40, 0x55, 0x8a, 0xbd, 0x87,
+			0x60, 0x55, 0x8a, 0x55, 0x84, 0x69, 0x88, 0x35, 0x82, 0x40, 0xdd, 0x81, 0x25, 0x7e, 0x60, 0x7c,
+			0x89, 0x7c, 0x45, 0x7c, 0x45, 0x7b, 0x40, 0x89, 0x7a, 0xdd, 0x77, 0x60, 0x89, 0x7a, 0xcd, 0x75,
+			0x09, 0x7c, 0x99, 0x74, 0x40, 0x25, 0x80, 0x69, 0x73, 0x60, 0x25, 0x82, 0x69, 0x73, 0x99, 0x83,
+			0x55, 0x74, 0x40, 0x11, 0x86, 0x25, 0x77, 0x00, 0x45, 0x89, 0x99, 0x75, 0x60, 0x25, 0x88, 0x45,
+			0x73, 0x45, 0x86, 0xf1, 0x71, 0x40, 0xdd, 0x81, 0x55, 0x70, 0xe8, 0xf1, 0x6c, 0xe6, 0x35, 0x7e,
+			0xe8, 0x55, 0x70, 0x60, 0xcd, 0x7a, 0xcd, 0x70, 0xd5, 0x78, 0xd5, 0x72, 0x40, 0xdd, 0x76, 0xdd,
+			0x77, 0x60, 0xdd, 0x76, 0x25, 0x7b, 0xdd, 0x78, 0x11, 0x7d, 0x40, 0xdd, 0x7e, 0xad, 0x80, 0x60,
+			0x55, 0x83, 0x89, 0x82, 0x8a, 0x09, 0x84, 0x40, 0xad, 0x86, 0xbd, 0x87, 0x60, 0xad, 0x86, 0xdd,
+			0x89, 0xe9, 0x84, 0x2d, 0x8b, 0x40, 0x79, 0x80, 0x79, 0x8c, 0x60, 0xdd, 0x7d, 0x79, 0x8c, 0xd5,
+			0x7b, 0x96, 0x40, 0x72, 0x8e, 0x00, 0x99, 0x75, 0x25, 0x88, 0x60, 0x6e, 0x35, 0x8b, 0x09, 0x79,
+			0xf9, 0x8c, 0x40, 0x11, 0x7e, 0x89, 0x8f, 0xe8, 0x25, 0x93, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89,
+			0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35,
+			0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67,
+			0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67,
+			0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92,
+			0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60,
+			0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f,
+			0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79,
+			0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76,
+			0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1,
+			0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80,
+			0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		EditorMoneyOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x86, 0x79, 0x76, 0x60, 0x89, 0x85, 0x35,
+			0x74, 0xcd, 0x83, 0xf1, 0x72, 0x40, 0xad, 0x7f, 0xad, 0x71, 0x60, 0x99, 0x7d, 0xad, 0x71, 0x78,
+			0x5d, 0x72, 0x40, 0x45, 0x79, 0x69, 0x74, 0x00, 0x79, 0x76, 0x99, 0x71, 0x60, 0xdd, 0x77, 0x60,
+			0xb5, 0x79, 0x11, 0x6f, 0x40, 0x99, 0x7d, 0xdd, 0x6d, 0xe8, 0x50, 0xe6, 0x99, 0x81, 0xe8, 0xcd,
+			0x6d, 0x60, 0x69, 0x84, 0x25, 0x6e, 0xb5, 0x86, 0xd5, 0x6f, 0x40, 0x55, 0x8a, 0xbd, 0x74, 0x00,
+			0x79, 0x86, 0x79, 0x76, 0xe2, 0xbd, 0x95, 0x45, 0x9c, 0x00, 0xf1, 0x88, 0x79, 0x8f, 0x60, 0x79,
+			0x87, 0xdd, 0x90, 0x91, 0x85, 0x89, 0x91, 0x40, 0x99, 0x81, 0x55, 0x92, 0xe8, 0xb0, 0xe6, 0x99,
+			0x7d, 0xe8, 0x45, 0x92, 0x60, 0xcd, 0x79, 0xbd, 0x91, 0x45, 0x77, 0x3d, 0x8f, 0x40, 0xad, 0x73,
+			0x25, 0x89, 0x00, 0xad, 0x77, 0xbd, 0x87, 0x60, 0x99, 0x78, 0x25, 0x8b, 0xb5, 0x7a, 0xcd, 0x8c,
+			0x40, 0x80, 0x79, 0x8e, 0x60, 0xbd, 0x81, 0x79, 0x8e, 0x5d, 0x83, 0x09, 0x8e, 0x40, 0x8c, 0x89,
+			0x8c, 0x03, 0xcd, 0x63, 0x55, 0x6a, 0xad, 0x66, 0x79, 0x67, 0x99, 0x98, 0x69, 0x99, 0xbd, 0x95,
+			0x45, 0x9c, 0xe1,
+		}},
+		EditorMultilineChart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x68, 0x45, 0x91, 0x03, 0x55, 0x65, 0x69,
+			0x8e, 0x11, 0x79, 0xad, 0x7a, 0x11, 0x84, 0xbd, 0x85, 0x55, 0x8d, 0x55, 0x7b, 0x60, 0x55, 0x89,
+			0xbd, 0x76, 0x4d, 0x84, 0x19, 0x74, 0x40, 0x35, 0x79, 0x79, 0x71, 0x60, 0x69, 0x74, 0x79, 0x71,
+			0x09, 0x70, 0x35, 0x73, 0x40, 0x25, 0x68, 0x11, 0x78, 0x00, 0x55, 0x65, 0x35, 0x75, 0x60, 0x79,
+			0x69, 0x79, 0x71, 0x81, 0x6e, 0x79, 0x6f, 0x40, 0x35, 0x79, 0x79, 0x6d, 0x60, 0xdd, 0x7f, 0x79,
+			0x6d, 0xb5, 0x85, 0x5d, 0x70, 0x40, 0xf1, 0x8f, 0x69, 0x78, 0x02, 0xf1, 0x97, 0x55, 0x6f, 0xad,
+			0x9a, 0x45, 0x72, 0x69, 0x92, 0xad, 0x7b, 0x60, 0xad, 0x94, 0x11, 0x7f, 0x11, 0x96, 0x5d, 0x83,
+			0x40, 0x25, 0x98, 0x45, 0x8c, 0xe6, 0x25, 0x94, 0x60, 0xbd, 0x93, 0xbd, 0x88, 0x91, 0x92, 0x69,
+			0x85, 0x40, 0x89, 0x8f, 0xdd, 0x7e, 0x02, 0x45, 0x84, 0x89, 0x8b, 0x11, 0x79, 0x55, 0x80, 0x25,
+			0x68, 0x45, 0x91, 0xe1,
+		}},
+		EditorPieChart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x84, 0x7c, 0xe6, 0x99, 0x96, 0x60, 0xdd, 0x95,
+			0xdd, 0x75, 0x09, 0x90, 0xe9, 0x6f, 0x40, 0x84, 0x69, 0x69, 0xe8, 0x7c, 0xe2, 0x7c, 0x99, 0x96,
+			0xe8, 0x69, 0x69, 0x60, 0x45, 0x75, 0x25, 0x6a, 0x4d, 0x6f, 0xad, 0x70, 0x40, 0x55, 0x69, 0x80,
+			0x60, 0x55, 0x69, 0xcd, 0x88, 0x4d, 0x6f, 0x55, 0x8f, 0x40, 0x7c, 0x99, 0x96, 0xe2, 0x84, 0x99,
+			0x96, 0x60, 0x35, 0x8a, 0x11, 0x96, 0x09, 0x90, 0x19, 0x90, 0x40, 0x99, 0x96, 0x84, 0xe6, 0x84,
+			0xe8, 0x99, 0x96, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99,
+			0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69,
+			0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25,
+			0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65,
+			0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79,
+			0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85,
+			0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a,
+			0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		EditorPieChartOutlined: EditorPieChartOutlined,
+		EditorPublish: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x55, 0x95, 0xe8, 0x99, 0x7c, 0x05, 0x6c,
+			0x99, 0x84, 0x25, 0x73, 0xbd, 0x81, 0x80, 0xdd, 0x74, 0xdd, 0x8c, 0xbd, 0x81, 0x94, 0x99, 0x84,
+			0x84, 0x99, 0x7c, 0xe8, 0x55, 0x95, 0xe6, 0x7c, 0xe2, 0xad, 0x6a, 0x35, 0x78, 0xe8, 0xad, 0x6e,
+			0x60, 0xad, 0x6a, 0x11, 0x6d, 0xdd, 0x6b, 0xdd, 0x6b, 0x40, 0xad, 0x6e, 0xad, 0x6a, 0xe6, 0x55,
+			0x91, 0x60, 0xf1, 0x92, 0xad, 0x6a, 0x25, 0x94, 0xdd, 0x6b, 0x40, 0x55, 0x95, 0xad, 0x6e, 0xe8,
+			0x35, 0x78, 0xe6, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe6, 0xad, 0x6e, 0xe8, 0x35, 0x78, 0xe6, 0xad,
+			0x6a, 0xe1,
+		}},
+		EditorShortText: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x8c, 0xe8, 0x84, 0xe6, 0x99, 0x84,
+			0xe8, 0x8c, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x7c, 0xe8, 0x74, 0xe6, 0x55, 0x95, 0xe8, 0x7c,
+			0xe6, 0xad, 0x6a, 0xe1,
+		}, mirror: true},
+		EditorShowChart: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x68, 0x55, 0x91, 0x07, 0x55, 0x65, 0x45,
+			0x8e, 0x55, 0x79, 0x45, 0x7a, 0x88, 0x8a, 0xdd, 0x97, 0xad, 0x6e, 0xad, 0x9a, 0x69, 0x71, 0x88,
+			0x96, 0x55, 0x79, 0x69, 0x80, 0x69, 0x68, 0x55, 0x91, 0xe1,
+		}},
+		EditorSpaceBar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x90, 0xe8, 0x70, 0xe6, 0xad, 0x6e,
+			0xe8, 0x88, 0xe6, 0x55, 0x91, 0xe8, 0x70, 0xe6, 0x55, 0x95, 0xe8, 0x90, 0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		EditorStrikethroughS: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x81, 0x55, 0x95, 0x60, 0x69, 0x7c, 0x55,
+			0x95, 0x25, 0x78, 0x91, 0x92, 0x40, 0xf1, 0x71, 0x25, 0x8b, 0x00, 0x89, 0x76, 0x35, 0x89, 0x60,
+			0xdd, 0x77, 0x69, 0x8c, 0xdd, 0x7a, 0x55, 0x8e, 0x40, 0x99, 0x81, 0x45, 0x90, 0x60, 0x11, 0x85,
+			0x45, 0x90, 0x25, 0x87, 0x79, 0x8e, 0x40, 0x35, 0x89, 0x99, 0x89, 0x60, 0x35, 0x89, 0x11, 0x88,
+			0x91, 0x88, 0x5d, 0x86, 0x40, 0xcd, 0x86, 0x55, 0x83, 0xe6, 0x69, 0x8c, 0x60, 0x55, 0x8d, 0xdd,
+			0x84, 0xcd, 0x8d, 0x69, 0x86, 0x40, 0x45, 0x8e, 0x99, 0x89, 0x60, 0x45, 0x8e, 0xcd, 0x8e, 0xbd,
+			0x8a, 0x11, 0x92, 0x40, 0x99, 0x81, 0x55, 0x95, 0xe2, 0x55, 0x65, 0x55, 0x7f, 0xe8, 0x55, 0x7b,
+			0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x7f, 0xe6, 0x55, 0x65, 0xe2, 0x99, 0x7f, 0x45, 0x6a, 0x60, 0x88,
+			0x45, 0x6a, 0x69, 0x87, 0x55, 0x6c, 0x40, 0x69, 0x8c, 0x11, 0x72, 0x00, 0xcd, 0x87, 0x25, 0x74,
+			0x60, 0xdd, 0x86, 0xdd, 0x71, 0xb5, 0x84, 0x99, 0x70, 0x40, 0x99, 0x7f, 0x55, 0x6f, 0x60, 0x55,
+			0x7c, 0x55, 0x6f, 0x55, 0x7a, 0xf1, 0x70, 0x40, 0x55, 0x78, 0x55, 0x75, 0x60, 0x55, 0x78, 0xdd,
+			0x75, 0x69, 0x78, 0x55, 0x76, 0x40, 0x99, 0x78, 0x55, 0x77, 0xe6, 0xad, 0x73, 0x60, 0x89, 0x73,
+			0xcd, 0x76, 0x79, 0x73, 0x45, 0x76, 0x40, 0x69, 0x73, 0x35, 0x75, 0x60, 0x69, 0x73, 0x55, 0x70,
+			0xcd, 0x76, 0x4d, 0x6d, 0x40, 0x99, 0x7f, 0x45, 0x6a, 0xe1,
+		}},
+		EditorTextFields: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x73, 0x55, 0x95, 0xe8, 0x55, 0x71, 0xe6,
+			0x55, 0x65, 0xe8, 0xad, 0x6a, 0xe6, 0x90, 0xe8, 0x55, 0x71, 0xe6, 0x74, 0xe8, 0x55, 0x95, 0xe6,
+			0x55, 0x73, 0xe2, 0x55, 0x8b, 0x55, 0x95, 0xe8, 0xad, 0x7e, 0xe6, 0xad, 0x82, 0xe8, 0x70, 0xe6,
+			0xad, 0x9a, 0xe8, 0xad, 0x7e, 0xe6, 0xa4, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x8b, 0xe1,
+		}},
+		EditorTitle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7c, 0x55, 0x95, 0xe8, 0x55, 0x71, 0xe6,
+			0x55, 0x6d, 0xe8, 0xad, 0x6a, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x83, 0xe8, 0x55,
+			0x95, 0xe6, 0xad, 0x7c, 0xe1,
+		}},
+		EditorVerticalAlignBottom: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xb0, 0xe8, 0xa8, 0xe6, 0x55, 0x95,
+			0xe8, 0xb0, 0xe6, 0xad, 0x6a, 0xe2, 0x80, 0xa0, 0x02, 0x11, 0x73, 0x11, 0x83, 0xf1, 0x75, 0x35,
+			0x80, 0x7c, 0x45, 0x88, 0xe8, 0x50, 0xe6, 0x84, 0xe8, 0x45, 0x88, 0x02, 0xcd, 0x89, 0x79, 0x80,
+			0xad, 0x8c, 0x55, 0x83, 0x80, 0xa0, 0xe1,
+		}},
+		EditorVerticalAlignCenter: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xad, 0x9a, 0xe8, 0x35, 0x8d, 0x05, 0x45,
+			0x78, 0xf1, 0x92, 0x55, 0x75, 0xa0, 0x80, 0x55, 0x85, 0xad, 0x8a, 0xa0, 0xbd, 0x87, 0xf1, 0x92,
+			0x84, 0x35, 0x8d, 0xe8, 0xad, 0x9a, 0xe6, 0x7c, 0xe2, 0xad, 0x6a, 0x84, 0xe8, 0x7c, 0xe6, 0x55,
+			0x95, 0xe8, 0x84, 0xe6, 0xad, 0x6a, 0xe2, 0x80, 0xad, 0x7a, 0x02, 0x55, 0x75, 0x60, 0x45, 0x78,
+			0x11, 0x6d, 0x7c, 0xcd, 0x72, 0xe8, 0x55, 0x65, 0xe6, 0x84, 0xe8, 0xcd, 0x72, 0x02, 0xbd, 0x87,
+			0x11, 0x6d, 0xad, 0x8a, 0x60, 0x80, 0xad, 0x7a, 0xe1,
+		}},
+		EditorVerticalAlignTop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0x58, 0xe8, 0x50, 0xe6, 0x55, 0x95,
+			0xe8, 0x58, 0xe6, 0xad, 0x6a, 0xe2, 0x7c, 0xb0, 0xe8, 0xbd, 0x77, 0x05, 0xf1, 0x75, 0xcd, 0x7f,
+			0x11, 0x73, 0xf1, 0x7c, 0x80, 0x60, 0xad, 0x8c, 0xad, 0x7c, 0xcd, 0x89, 0x89, 0x7f, 0x84, 0xbd,
+			0x77, 0xe8, 0xb0, 0xe6, 0x7c, 0xe1,
+		}},
+		EditorWrapText: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x87, 0x35, 0x97, 0x03, 0x35, 0x7e, 0xf1,
+			0x8d, 0x79, 0x87, 0xad, 0x84, 0x45, 0x8a, 0x89, 0x87, 0xcd, 0x85, 0x98, 0xe6, 0x89, 0x8e, 0x60,
+			0xdd, 0x90, 0x98, 0x71, 0x92, 0x4d, 0x8a, 0x40, 0xa8, 0x45, 0x86, 0x60, 0xa8, 0xf1, 0x83, 0x71,
+			0x92, 0x45, 0x82, 0x40, 0x89, 0x8e, 0x99, 0x80, 0xe6, 0xad, 0x6a, 0xe8, 0x99, 0x7c, 0xe6, 0x89,
+			0x8e, 0x60, 0x89, 0x92, 0x99, 0x7c, 0x45, 0x95, 0x71, 0x7f, 0x40, 0xb0, 0x45, 0x86, 0x60, 0xb0,
+			0x45, 0x8a, 0x45, 0x95, 0x25, 0x8d, 0x40, 0x89, 0x8e, 0xa0, 0xe6, 0xcd, 0x85, 0x01, 0x45, 0x8a,
+			0x55, 0x94, 0x79, 0x87, 0x35, 0x97, 0xe2, 0xad, 0x6a, 0xa0, 0xe8, 0x98, 0xe6, 0x89, 0x7a, 0xe8,
+			0xa0, 0xe6, 0xad, 0x6a, 0xe2, 0xad, 0x6a, 0x55, 0x71, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x95, 0xe8,
+			0x55, 0x71, 0xe6, 0xad, 0x6a, 0xe1,
+		}, mirror: true},
+		FileAttachment: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x73, 0xad, 0x8e, 0x61, 0xcd, 0x6d, 0xad,
+			0x8e, 0x91, 0x69, 0x5d, 0x8a, 0x55, 0x65, 0x11, 0x86, 0x55, 0x65, 0x80, 0x40, 0x81, 0x69, 0xa5,
+			0x75, 0x60, 0xad, 0x6d, 0x55, 0x71, 0xbd, 0x73, 0x55, 0x71, 0xe6, 0x69, 0x90, 0x63, 0xa5, 0x94,
+			0x55, 0x71, 0xa9, 0x97, 0x61, 0x74, 0xad, 0x9a, 0x69, 0x77, 0xad, 0x9a, 0xad, 0x7b, 0xad, 0x9a,
+			0xf1, 0x7f, 0xa9, 0x97, 0xf9, 0x82, 0xa5, 0x94, 0x8c, 0x69, 0x90, 0x8c, 0xe6, 0x11, 0x76, 0x60,
+			0x85, 0x73, 0x8c, 0xc5, 0x71, 0x41, 0x84, 0x40, 0x60, 0xfd, 0x7f, 0x60, 0x60, 0x79, 0x7d, 0xd5,
+			0x71, 0xbd, 0x7b, 0x40, 0x35, 0x76, 0x74, 0xe6, 0x69, 0x90, 0xe8, 0xad, 0x7c, 0xe6, 0x25, 0x76,
+			0x63, 0xbd, 0x74, 0xad, 0x7c, 0xb5, 0x73, 0xa1, 0x7d, 0xad, 0x72, 0x95, 0x7e, 0xad, 0x72, 0xfd,
+			0x7f, 0xad, 0x72, 0x69, 0x81, 0xad, 0x73, 0x5d, 0x82, 0xad, 0x74, 0x55, 0x83, 0x11, 0x76, 0x55,
+			0x83, 0xe6, 0x69, 0x90, 0x62, 0x99, 0x93, 0x55, 0x83, 0xcd, 0x95, 0x1d, 0x81, 0xb0, 0xe5, 0x7e,
+			0xb0, 0xb1, 0x7b, 0xb0, 0x79, 0x78, 0xcd, 0x95, 0x3d, 0x76, 0x40, 0x69, 0x90, 0x68, 0xe6, 0x99,
+			0x73, 0x60, 0xbd, 0x6e, 0x68, 0x5d, 0x6b, 0x89, 0x77, 0x40, 0x50, 0x80, 0x60, 0x50, 0x8a, 0x71,
+			0x6b, 0x81, 0x88, 0x40, 0xcd, 0x73, 0x98, 0xe6, 0x69, 0x90, 0xe8, 0xad, 0x8e, 0xe6, 0xdd, 0x73,
+			0xe1,
+		}},
+		FileCloud: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x70, 0x55, 0x95, 0x60, 0xdd, 0x6a, 0x55,
+			0x95, 0xc5, 0x66, 0x3d, 0x91, 0x40, 0xad, 0x62, 0x45, 0x87, 0x60, 0xad, 0x62, 0x11, 0x82, 0x4c,
+			0x25, 0x7e, 0x40, 0x79, 0x6e, 0x69, 0x79, 0x60, 0xcd, 0x6f, 0xf1, 0x72, 0xbd, 0x74, 0xd5, 0x6e,
+			0x40, 0x25, 0x80, 0xbd, 0x6a, 0x60, 0x99, 0x87, 0xbd, 0x6a, 0xbd, 0x8c, 0x2d, 0x70, 0x40, 0xdd,
+			0x91, 0x35, 0x7d, 0xe8, 0xcd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xe9, 0x81, 0x40, 0x55,
+			0x9d, 0x11, 0x8a, 0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55, 0x95, 0xe6,
+			0xbd, 0x70, 0xe2, 0xbd, 0x70, 0x55, 0x91, 0xe6, 0x11, 0x92, 0x60, 0x11, 0x95, 0x55, 0x91, 0x35,
+			0x97, 0x35, 0x8f, 0x40, 0x55, 0x99, 0x11, 0x8a, 0x60, 0x55, 0x99, 0x11, 0x87, 0x35, 0x97, 0xf1,
+			0x84, 0x40, 0x11, 0x92, 0xcd, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35, 0x7d, 0x60, 0xdd, 0x8d, 0x25,
+			0x77, 0xcd, 0x89, 0xf1, 0x72, 0x40, 0xdd, 0x7f, 0xbd, 0x6e, 0x60, 0x74, 0xbd, 0x6e, 0xe9, 0x75,
+			0xf1, 0x72, 0x40, 0xcd, 0x71, 0x35, 0x7d, 0xe6, 0x89, 0x70, 0x60, 0x69, 0x6c, 0x35, 0x7d, 0x89,
+			0x69, 0x19, 0x80, 0x40, 0xad, 0x66, 0x45, 0x87, 0x60, 0xad, 0x66, 0x79, 0x8b, 0x99, 0x69, 0x69,
+			0x8e, 0x40, 0xbd, 0x70, 0x55, 0x91, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		FileCloudCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x76, 0xcd, 0x89, 0xe6, 0x99, 0x8a, 0x60,
+			0xbd, 0x8d, 0xcd, 0x89, 0xa0, 0xd5, 0x87, 0x40, 0x45, 0x92, 0xdd, 0x82, 0x60, 0x45, 0x92, 0xdd,
+			0x7f, 0xa0, 0xf1, 0x7d, 0x40, 0x99, 0x8a, 0x78, 0x60, 0x45, 0x8a, 0xdd, 0x77, 0x79, 0x87, 0xe9,
+			0x74, 0x40, 0x89, 0x80, 0xf1, 0x71, 0x60, 0x11, 0x7d, 0xf1, 0x71, 0x3d, 0x7a, 0xd5, 0x73, 0x40,
+			0x79, 0x76, 0x11, 0x79, 0x60, 0xdd, 0x72, 0x11, 0x79, 0x55, 0x70, 0x81, 0x7b, 0x40, 0xcd, 0x6d,
+			0x79, 0x81, 0x60, 0xcd, 0x6d, 0x8a, 0x55, 0x70, 0x69, 0x87, 0x40, 0x79, 0x76, 0xcd, 0x89, 0xe2,
+			0x79, 0x76, 0xcd, 0x85, 0x60, 0x89, 0x74, 0xcd, 0x85, 0x2d, 0x73, 0x91, 0x84, 0x40, 0xcd, 0x71,
+			0x79, 0x81, 0x60, 0xcd, 0x71, 0x99, 0x7f, 0x2d, 0x73, 0x55, 0x7e, 0x40, 0x79, 0x76, 0x11, 0x7d,
+			0xe6, 0xf1, 0x79, 0xe8, 0x78, 0x60, 0xf1, 0x79, 0x69, 0x79, 0xe9, 0x7b, 0xad, 0x77, 0x40, 0x89,
+			0x80, 0xf1, 0x75, 0x60, 0x11, 0x83, 0xf1, 0x75, 0xd5, 0x84, 0xb5, 0x77, 0x40, 0x99, 0x86, 0x78,
+			0xe8, 0x80, 0xe6, 0x99, 0x8a, 0x60, 0x98, 0x80, 0x25, 0x8d, 0xc5, 0x80, 0x40, 0x45, 0x8e, 0xdd,
+			0x82, 0x60, 0x45, 0x8e, 0x35, 0x84, 0x2d, 0x8d, 0x8a, 0x40, 0x99, 0x8a, 0xcd, 0x85, 0xe6, 0x79,
+			0x76, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d,
+			0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80,
+			0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd,
+			0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65,
+			0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98,
+			0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40,
+			0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad,
+			0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad,
+			0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60,
+			0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69,
+			0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		FileCloudDone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x7b, 0x69, 0x8d, 0x05, 0xcd, 0x8a, 0x11,
+			0x7e, 0x25, 0x88, 0x69, 0x7b, 0x89, 0x7b, 0x90, 0xdd, 0x74, 0x55, 0x81, 0x25, 0x72, 0x11, 0x84,
+			0x79, 0x7b, 0x69, 0x8d, 0xe2, 0xbd, 0x70, 0x55, 0x95, 0x60, 0xdd, 0x6a, 0x55, 0x95, 0xc5, 0x66,
+			0x3d, 0x91, 0x40, 0xad, 0x62, 0x45, 0x87, 0x60, 0xad, 0x62, 0x11, 0x82, 0x4c, 0x25, 0x7e, 0x40,
+			0x79, 0x6e, 0x69, 0x79, 0x60, 0xcd, 0x6f, 0xf1, 0x72, 0xbd, 0x74, 0xd5, 0x6e, 0x40, 0x25, 0x80,
+			0xbd, 0x6a, 0x60, 0x99, 0x87, 0xbd, 0x6a, 0xbd, 0x8c, 0x2d, 0x70, 0x40, 0xdd, 0x91, 0x35, 0x7d,
+			0xe8, 0xcd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xe9, 0x81, 0x40, 0x55, 0x9d, 0x11, 0x8a,
+			0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55, 0x95, 0xe6, 0xbd, 0x70, 0xe2,
+			0xbd, 0x70, 0x55, 0x91, 0xe6, 0x11, 0x92, 0x60, 0x11, 0x95, 0x55, 0x91, 0x35, 0x97, 0x35, 0x8f,
+			0x40, 0x55, 0x99, 0x11, 0x8a, 0x60, 0x55, 0x99, 0x11, 0x87, 0x35, 0x97, 0xf1, 0x84, 0x40, 0x11,
+			0x92, 0xcd, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35, 0x7d, 0x60, 0xdd, 0x8d, 0x25, 0x77, 0xcd, 0x89,
+			0xf1, 0x72, 0x40, 0xdd, 0x7f, 0xbd, 0x6e, 0x60, 0x74, 0xbd, 0x6e, 0xe9, 0x75, 0xf1, 0x72, 0x40,
+			0xcd, 0x71, 0x35, 0x7d, 0xe6, 0x89, 0x70, 0x60, 0x69, 0x6c, 0x35, 0x7d, 0x89, 0x69, 0x19, 0x80,
+			0x40, 0xad, 0x66, 0x45, 0x87, 0x60, 0xad, 0x66, 0x79, 0x8b, 0x99, 0x69, 0x69, 0x8e, 0x40, 0xbd,
+			0x70, 0x55, 0x91, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		FileCloudDownload: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x70, 0x55, 0x95, 0x60, 0xdd, 0x6a, 0x55,
+			0x95, 0xc5, 0x66, 0x3d, 0x91, 0x40, 0xad, 0x62, 0x45, 0x87, 0x60, 0xad, 0x62, 0x84, 0x09, 0x66,
+			0x19, 0x7e, 0x40, 0x79, 0x6e, 0x69, 0x79, 0x60, 0x79, 0x6f, 0xcd, 0x73, 0xf1, 0x73, 0x81, 0x6f,
+			0x40, 0x11, 0x7e, 0x35, 0x6b, 0x60, 0xad, 0x7f, 0x35, 0x6b, 0xdd, 0x80, 0x19, 0x6c, 0x40, 0x11,
+			0x82, 0x89, 0x6e, 0xe8, 0x25, 0x82, 0x05, 0x99, 0x87, 0x99, 0x7c, 0x79, 0x8a, 0x79, 0x7f, 0x11,
+			0x80, 0xdd, 0x89, 0xad, 0x75, 0x79, 0x7f, 0x89, 0x78, 0x99, 0x7c, 0x11, 0x7e, 0x25, 0x82, 0xe8,
+			0xdd, 0x6e, 0x60, 0x55, 0x78, 0x99, 0x6f, 0x11, 0x75, 0xe9, 0x73, 0x40, 0xcd, 0x71, 0x35, 0x7d,
+			0xe6, 0x89, 0x70, 0x60, 0x79, 0x6c, 0x35, 0x7d, 0x91, 0x69, 0x11, 0x80, 0x40, 0xad, 0x66, 0x45,
+			0x87, 0x60, 0xad, 0x66, 0x99, 0x8b, 0xad, 0x69, 0x79, 0x8e, 0x40, 0xbd, 0x70, 0x55, 0x91, 0xe6,
+			0x11, 0x92, 0x60, 0x11, 0x95, 0x55, 0x91, 0x35, 0x97, 0x35, 0x8f, 0x40, 0x55, 0x99, 0x11, 0x8a,
+			0x60, 0x55, 0x99, 0x11, 0x87, 0x35, 0x97, 0xf1, 0x84, 0x40, 0x11, 0x92, 0xcd, 0x82, 0xe6, 0xdd,
+			0x8d, 0xe8, 0x35, 0x7d, 0x60, 0xdd, 0x8d, 0xad, 0x78, 0xad, 0x8b, 0x5d, 0x75, 0x40, 0x8c, 0x25,
+			0x70, 0xe8, 0xcd, 0x6b, 0x60, 0x69, 0x8b, 0xbd, 0x6d, 0xa5, 0x8e, 0x89, 0x72, 0x40, 0xdd, 0x91,
+			0x35, 0x7d, 0xe8, 0xcd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xdd, 0x81, 0x40, 0x55, 0x9d,
+			0x11, 0x8a, 0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55, 0x95, 0xe6, 0xbd,
+			0x70, 0xe2, 0x80, 0x35, 0x7e, 0xe1,
+		}},
+		FileCloudOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x96, 0x45, 0x9c, 0x00, 0xdd, 0x8e, 0x99,
+			0x94, 0xe6, 0x89, 0x70, 0x60, 0xad, 0x6a, 0x99, 0x94, 0xad, 0x66, 0xad, 0x90, 0x40, 0xad, 0x62,
+			0xdd, 0x86, 0x60, 0xad, 0x62, 0x89, 0x81, 0x09, 0x66, 0xf1, 0x7d, 0x40, 0x79, 0x6e, 0x89, 0x79,
+			0x60, 0x99, 0x6e, 0x99, 0x78, 0xe9, 0x6e, 0x71, 0x77, 0x40, 0xbd, 0x6f, 0x55, 0x75, 0x03, 0xad,
+			0x64, 0x45, 0x6a, 0x79, 0x67, 0x79, 0x67, 0x69, 0x99, 0x69, 0x99, 0x89, 0x96, 0x45, 0x9c, 0xe2,
+			0x89, 0x70, 0x99, 0x90, 0xe6, 0x96, 0x00, 0x66, 0x99, 0x78, 0x60, 0x45, 0x72, 0x99, 0x79, 0x09,
+			0x72, 0xdd, 0x7a, 0x40, 0xcd, 0x71, 0x55, 0x7d, 0xe6, 0x89, 0x70, 0x60, 0x69, 0x6c, 0x55, 0x7d,
+			0x89, 0x69, 0xf9, 0x7f, 0x40, 0xad, 0x66, 0xb5, 0x86, 0x60, 0xad, 0x66, 0xcd, 0x8a, 0x89, 0x69,
+			0xb5, 0x8d, 0x40, 0x89, 0x70, 0x99, 0x90, 0xe2, 0xf1, 0x7e, 0x89, 0x84, 0xe2, 0xf1, 0x98, 0x89,
+			0x92, 0x00, 0xcd, 0x95, 0x69, 0x8f, 0x60, 0x79, 0x97, 0x45, 0x8e, 0x69, 0x98, 0xdd, 0x8c, 0x40,
+			0x55, 0x99, 0x89, 0x89, 0x60, 0x55, 0x99, 0xad, 0x86, 0x45, 0x97, 0xa5, 0x84, 0x40, 0x55, 0x92,
+			0x99, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35, 0x7d, 0x60, 0xdd, 0x8d, 0x55, 0x77, 0xcd, 0x89, 0x5d,
+			0x73, 0x40, 0xe5, 0x7f, 0x69, 0x6f, 0x60, 0x7c, 0x69, 0x6f, 0xd5, 0x7b, 0x60, 0x40, 0xdd, 0x77,
+			0xf1, 0x71, 0x00, 0x11, 0x75, 0x25, 0x6f, 0x60, 0x79, 0x77, 0x35, 0x6d, 0x3d, 0x7a, 0x4d, 0x6c,
+			0x40, 0xdd, 0x7f, 0x69, 0x6b, 0x60, 0x45, 0x87, 0x69, 0x6b, 0x91, 0x8c, 0xad, 0x70, 0x40, 0xdd,
+			0x91, 0x55, 0x7d, 0xe8, 0xbd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xbd, 0x81, 0x40, 0x55,
+			0x9d, 0x89, 0x89, 0x60, 0x55, 0x9d, 0xdd, 0x8b, 0x3d, 0x9c, 0x71, 0x8e, 0x40, 0xf1, 0x98, 0x89,
+			0x92, 0xe2, 0xdd, 0x86, 0xad, 0x80, 0xe1,
+		}},
+		FileCloudQueue: FileCloudQueue,
+		FileCloudUpload: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0x55, 0x95, 0x60, 0xf1, 0x6a, 0x55,
+			0x95, 0xcd, 0x66, 0x35, 0x91, 0x40, 0xad, 0x62, 0x55, 0x87, 0x60, 0xad, 0x62, 0x25, 0x82, 0xf9,
+			0x65, 0x2d, 0x7e, 0x40, 0x79, 0x6e, 0x69, 0x79, 0x60, 0xcd, 0x6f, 0xf1, 0x72, 0xbd, 0x74, 0xd5,
+			0x6e, 0x40, 0x25, 0x80, 0xbd, 0x6a, 0x60, 0xad, 0x87, 0xbd, 0x6a, 0xc5, 0x8c, 0x2d, 0x70, 0x40,
+			0xdd, 0x91, 0x35, 0x7d, 0xe8, 0xcd, 0x7e, 0x60, 0xad, 0x96, 0xad, 0x7e, 0xb4, 0xe9, 0x81, 0x40,
+			0x55, 0x9d, 0x11, 0x8a, 0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55, 0x95,
+			0xe6, 0x84, 0x60, 0x69, 0x80, 0x55, 0x95, 0x35, 0x7f, 0x25, 0x94, 0x40, 0x7c, 0x55, 0x91, 0xe8,
+			0x25, 0x80, 0x05, 0x79, 0x78, 0xad, 0x85, 0x99, 0x75, 0xcd, 0x82, 0x80, 0x69, 0x78, 0x69, 0x8a,
+			0xcd, 0x82, 0x89, 0x87, 0xad, 0x85, 0x84, 0x25, 0x80, 0xe8, 0x55, 0x91, 0xe6, 0x11, 0x92, 0x60,
+			0x11, 0x95, 0x55, 0x91, 0x35, 0x97, 0x35, 0x8f, 0x40, 0x55, 0x99, 0x11, 0x8a, 0x60, 0x55, 0x99,
+			0x11, 0x87, 0x35, 0x97, 0xf1, 0x84, 0x40, 0x11, 0x92, 0xcd, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35,
+			0x7d, 0x60, 0xdd, 0x8d, 0x45, 0x77, 0xd5, 0x89, 0x66, 0x40, 0xdd, 0x7f, 0xbd, 0x6e, 0x60, 0xf1,
+			0x79, 0xbd, 0x6e, 0xdd, 0x75, 0x66, 0x40, 0xcd, 0x71, 0x35, 0x7d, 0xe6, 0x89, 0x70, 0x60, 0x69,
+			0x6c, 0x35, 0x7d, 0x89, 0x69, 0x19, 0x80, 0x40, 0xad, 0x66, 0x45, 0x87, 0x61, 0xad, 0x66, 0x69,
+			0x8b, 0x99, 0x69, 0x5d, 0x8e, 0x89, 0x6c, 0x55, 0x91, 0xad, 0x70, 0x55, 0x91, 0xe6, 0x74, 0xe8,
+			0x55, 0x95, 0xe6, 0xad, 0x70, 0xe2, 0x80, 0x84, 0xe1,
+		}},
+		FileCreateNewFolder: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x84, 0xad, 0x8a, 0xe6, 0xad, 0x88, 0xe8,
+			0xad, 0x84, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x80, 0xe6, 0xad, 0x88, 0xe8, 0xad, 0x7a, 0xe6, 0xad,
+			0x84, 0xe8, 0xad, 0x80, 0xe6, 0xad, 0x7e, 0xe8, 0xad, 0x84, 0xe6, 0xad, 0x84, 0xe8, 0xad, 0x8a,
+			0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x19, 0x94, 0x40, 0x55,
+			0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x25, 0x6d, 0x89, 0x66, 0xe9, 0x6b, 0x40,
+			0x55, 0x69, 0xad, 0x6a, 0xe6, 0x11, 0x7c, 0x00, 0x11, 0x80, 0xad, 0x6e, 0xe6, 0xad, 0x96, 0x60,
+			0x35, 0x98, 0xad, 0x6e, 0x71, 0x99, 0xe9, 0x6f, 0x40, 0xad, 0x9a, 0xad, 0x72, 0xe8, 0x55, 0x91,
+			0x60, 0xad, 0x9a, 0xdd, 0x92, 0x71, 0x99, 0x19, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55,
+			0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x72, 0xe6, 0x69, 0x7e, 0x00,
+			0x69, 0x7a, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8,
+			0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		FileFileDownload: FileFileDownload,
+		FileFileUpload:   FileFileUpload,
+		FileFolder: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x19, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x25, 0x6d, 0x89, 0x66, 0xe9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x11, 0x7c, 0x00, 0x11,
+			0x80, 0xad, 0x6e, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98, 0xad, 0x6e, 0x71, 0x99, 0xe9, 0x6f, 0x40,
+			0xad, 0x9a, 0xad, 0x72, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xdd, 0x92, 0x71, 0x99, 0x19, 0x94,
+			0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96,
+			0xe8, 0xad, 0x72, 0xe6, 0x69, 0x7e, 0x00, 0x69, 0x7a, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		FileFolderOpen: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xcd, 0x67, 0x55,
+			0x95, 0x91, 0x66, 0x19, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x25, 0x6d, 0x91, 0x66, 0xe9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x11, 0x7c, 0x00, 0x11,
+			0x80, 0xad, 0x6e, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98, 0xad, 0x6e, 0x71, 0x99, 0xe9, 0x6f, 0x40,
+			0xad, 0x9a, 0xad, 0x72, 0xe6, 0x55, 0x7e, 0x00, 0x55, 0x7a, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8,
+			0x55, 0x91, 0x00, 0x25, 0x70, 0xad, 0x76, 0xe6, 0xad, 0x9e, 0x00, 0x89, 0x97, 0x45, 0x92, 0x60,
+			0x25, 0x97, 0xdd, 0x93, 0x11, 0x96, 0x99, 0x94, 0x40, 0x55, 0x93, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x89, 0x6d, 0x55, 0x91, 0xe6, 0xad, 0x93, 0x00, 0x45, 0x99, 0xad, 0x7a, 0xe6, 0x25, 0x73,
+			0x00, 0x89, 0x6d, 0x55, 0x91, 0xe2, 0x89, 0x6d, 0x55, 0x91, 0x01, 0x25, 0x73, 0xad, 0x7a, 0x89,
+			0x6d, 0x55, 0x91, 0xe2, 0x55, 0x69, 0xad, 0x72, 0xe8, 0xad, 0x6e, 0xe8, 0xad, 0x72, 0xe1,
+		}},
+		FileFolderShared: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x7d, 0xad, 0x8c, 0xe6, 0x99, 0x92, 0xe8,
+			0x69, 0x8b, 0x60, 0x99, 0x92, 0x99, 0x88, 0xc5, 0x8f, 0xd5, 0x86, 0x40, 0xf1, 0x87, 0x11, 0x85,
+			0x60, 0xf1, 0x82, 0x11, 0x85, 0x19, 0x80, 0xd5, 0x86, 0x40, 0x45, 0x7d, 0x69, 0x8b, 0xe8, 0xad,
+			0x8c, 0xe2, 0xed, 0x87, 0x11, 0x81, 0x67, 0xf1, 0x89, 0x11, 0x81, 0x5d, 0x8b, 0xa5, 0x7f, 0xcd,
+			0x8c, 0x35, 0x7e, 0xcd, 0x8c, 0x35, 0x7c, 0xcd, 0x8c, 0x35, 0x7a, 0x61, 0x8b, 0xc5, 0x78, 0xf1,
+			0x89, 0x55, 0x77, 0xf1, 0x87, 0x55, 0x77, 0xf1, 0x85, 0x55, 0x77, 0x81, 0x84, 0xc5, 0x78, 0x11,
+			0x83, 0x31, 0x7a, 0x11, 0x83, 0x31, 0x7c, 0x11, 0x83, 0x35, 0x7e, 0x81, 0x84, 0xa5, 0x7f, 0xed,
+			0x85, 0x11, 0x81, 0xed, 0x87, 0x11, 0x81, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x19, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x25, 0x6d, 0x89, 0x66, 0xe9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x11, 0x7c, 0x00, 0x11,
+			0x80, 0xad, 0x6e, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98, 0xad, 0x6e, 0x71, 0x99, 0xe9, 0x6f, 0x40,
+			0xad, 0x9a, 0xad, 0x72, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xdd, 0x92, 0x71, 0x99, 0x19, 0x94,
+			0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96,
+			0xe8, 0xad, 0x72, 0xe6, 0x69, 0x7e, 0x00, 0x69, 0x7a, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		HardwareCast: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x80, 0xe2, 0xbd, 0x96, 0x55, 0x95,
+			0xe6, 0x11, 0x88, 0x60, 0x11, 0x88, 0x55, 0x94, 0x90, 0x55, 0x93, 0x40, 0xcd, 0x87, 0x55, 0x91,
+			0xe6, 0xbd, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x69, 0x69, 0xe8, 0xad, 0x72, 0x60, 0x69, 0x68, 0x89,
+			0x72, 0x69, 0x67, 0x79, 0x72, 0x40, 0x69, 0x65, 0x69, 0x72, 0xe8, 0xad, 0x6e, 0x60, 0x69, 0x65,
+			0x05, 0x6d, 0x95, 0x66, 0xd9, 0x6b, 0x40, 0x69, 0x69, 0xad, 0x6a, 0xe6, 0xbd, 0x96, 0x60, 0x65,
+			0x98, 0xad, 0x6a, 0x91, 0x99, 0xd9, 0x6b, 0x40, 0xbd, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xbd, 0x9a, 0xfd, 0x92, 0x91, 0x99, 0x29, 0x94, 0x40, 0xbd, 0x96, 0x55, 0x95, 0xe2, 0x69, 0x65,
+			0x55, 0x95, 0xe8, 0x69, 0x8e, 0x61, 0x2d, 0x68, 0x69, 0x8e, 0x21, 0x6a, 0x6d, 0x90, 0x11, 0x6c,
+			0x71, 0x92, 0x11, 0x6c, 0x55, 0x95, 0xe6, 0x69, 0x65, 0xe2, 0xbd, 0x72, 0x55, 0x95, 0x61, 0xbd,
+			0x72, 0xb1, 0x8f, 0xdd, 0x6e, 0xb9, 0x8b, 0x56, 0xbd, 0x87, 0x69, 0x65, 0xbd, 0x87, 0xe8, 0xbd,
+			0x83, 0x61, 0xa5, 0x6c, 0xbd, 0x83, 0xb1, 0x71, 0xe9, 0x88, 0xbd, 0x76, 0x11, 0x8e, 0xbd, 0x76,
+			0x55, 0x95, 0xe6, 0xbd, 0x72, 0xe2, 0x69, 0x7d, 0x55, 0x95, 0x60, 0x69, 0x7d, 0x55, 0x90, 0x89,
+			0x7b, 0xe9, 0x8b, 0x40, 0x69, 0x76, 0x2d, 0x84, 0x60, 0x25, 0x73, 0xdd, 0x80, 0xc5, 0x6e, 0xf9,
+			0x7e, 0x40, 0x69, 0x65, 0x11, 0x7d, 0xe8, 0x11, 0x79, 0x60, 0x35, 0x6b, 0x11, 0x79, 0x4d, 0x70,
+			0x4d, 0x7b, 0x40, 0x35, 0x79, 0x5d, 0x81, 0x60, 0x7a, 0x35, 0x85, 0x35, 0x7f, 0x5d, 0x8a, 0x40,
+			0x69, 0x81, 0x55, 0x95, 0xe6, 0x69, 0x7d, 0xe1,
+		}},
+		HardwareCastConnected: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x91, 0x98, 0xe6, 0xcd, 0x86, 0x60, 0x79,
+			0x86, 0xf1, 0x8a, 0x25, 0x86, 0xd5, 0x89, 0x40, 0x79, 0x85, 0x90, 0xe6, 0x69, 0x8d, 0xe8, 0x70,
+			0xe6, 0x45, 0x78, 0x61, 0x55, 0x76, 0xbd, 0x76, 0x35, 0x74, 0xbd, 0x75, 0x15, 0x72, 0xc1, 0x74,
+			0xcd, 0x6f, 0x68, 0xe6, 0x69, 0x91, 0xe8, 0x98, 0xe2, 0x80, 0x80, 0xe2, 0x69, 0x65, 0x55, 0x95,
+			0xe8, 0x69, 0x8e, 0x61, 0x2d, 0x68, 0x69, 0x8e, 0x21, 0x6a, 0x6d, 0x90, 0x11, 0x6c, 0x71, 0x92,
+			0x11, 0x6c, 0x55, 0x95, 0xe6, 0x69, 0x65, 0xe2, 0xbd, 0x72, 0x55, 0x95, 0x61, 0xbd, 0x72, 0xb1,
+			0x8f, 0xdd, 0x6e, 0xb9, 0x8b, 0x56, 0xbd, 0x87, 0x69, 0x65, 0xbd, 0x87, 0xe8, 0xbd, 0x83, 0x61,
+			0xa5, 0x6c, 0xbd, 0x83, 0xb1, 0x71, 0xe9, 0x88, 0xbd, 0x76, 0x11, 0x8e, 0xbd, 0x76, 0x55, 0x95,
+			0xe6, 0xbd, 0x72, 0xe2, 0x69, 0x7d, 0x55, 0x95, 0x60, 0x69, 0x7d, 0x55, 0x90, 0x89, 0x7b, 0xe9,
+			0x8b, 0x40, 0x69, 0x76, 0x2d, 0x84, 0x60, 0x25, 0x73, 0xdd, 0x80, 0xc5, 0x6e, 0xf9, 0x7e, 0x40,
+			0x69, 0x65, 0x11, 0x7d, 0xe8, 0x11, 0x79, 0x60, 0x35, 0x6b, 0x11, 0x79, 0x4d, 0x70, 0x4d, 0x7b,
+			0x40, 0x35, 0x79, 0x5d, 0x81, 0x60, 0x7a, 0x35, 0x85, 0x35, 0x7f, 0x5d, 0x8a, 0x40, 0x69, 0x81,
+			0x55, 0x95, 0xe6, 0x69, 0x7d, 0xe2, 0xbd, 0x96, 0x55, 0x95, 0xe6, 0x11, 0x88, 0x60, 0x11, 0x88,
+			0x55, 0x94, 0x90, 0x55, 0x93, 0x40, 0xcd, 0x87, 0x55, 0x91, 0xe6, 0xbd, 0x96, 0xe8, 0xad, 0x6e,
+			0xe6, 0x69, 0x69, 0xe8, 0xad, 0x72, 0x60, 0x69, 0x68, 0x89, 0x72, 0x69, 0x67, 0x79, 0x72, 0x40,
+			0x69, 0x65, 0x69, 0x72, 0xe8, 0xad, 0x6e, 0x60, 0x69, 0x65, 0x05, 0x6d, 0x95, 0x66, 0xd9, 0x6b,
+			0x40, 0x69, 0x69, 0xad, 0x6a, 0xe6, 0xbd, 0x96, 0x60, 0x65, 0x98, 0xad, 0x6a, 0x91, 0x99, 0xd9,
+			0x6b, 0x40, 0xbd, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xbd, 0x9a, 0xfd, 0x92, 0x91, 0x99,
+			0x29, 0x94, 0x40, 0xbd, 0x96, 0x55, 0x95, 0xe1,
+		}},
+		HardwareComputer: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x64, 0xb0, 0x62, 0xd1, 0x63, 0xb0, 0x3d,
+			0x63, 0x6d, 0x97, 0xad, 0x62, 0xd9, 0x96, 0xad, 0x62, 0xfd, 0x95, 0xad, 0x62, 0x25, 0x95, 0x3d,
+			0x63, 0x91, 0x94, 0x40, 0xad, 0x64, 0xa8, 0xe6, 0x55, 0x9b, 0x62, 0x31, 0x9c, 0xa8, 0xc5, 0x9c,
+			0x95, 0x94, 0x55, 0x9d, 0x29, 0x95, 0x55, 0x9d, 0x05, 0x96, 0x55, 0x9d, 0xdd, 0x96, 0xc5, 0x9c,
+			0x71, 0x97, 0x40, 0x55, 0x9b, 0xb0, 0xe6, 0xad, 0x64, 0xe2, 0x55, 0x69, 0xa0, 0x60, 0xbd, 0x67,
+			0xa0, 0x89, 0x66, 0xcd, 0x8e, 0x40, 0x55, 0x65, 0x98, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69, 0x6a,
+			0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50, 0x79,
+			0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99,
+			0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x98, 0xe6, 0xad, 0x96,
+			0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x58, 0xe8, 0x98, 0xe1,
+		}},
+		HardwareDesktopMac: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x76, 0xb0, 0xe8, 0xad, 0x95, 0x00, 0x78,
+			0xa0, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0xa0, 0x89, 0x66, 0xcd, 0x8e, 0x40, 0x55, 0x65, 0x98,
+			0xe8, 0x58, 0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0x98,
+			0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x88, 0x00,
+			0x99, 0x89, 0xad, 0x95, 0xe8, 0xb0, 0xe6, 0x69, 0x76, 0xe2, 0x55, 0x69, 0x99, 0x85, 0xe6, 0xad,
+			0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0x99, 0x85, 0xe2, 0x55, 0x69, 0x99, 0x85, 0xe8, 0x58,
+			0xe8, 0x99, 0x85, 0xe1,
+		}},
+		HardwareDesktopWindows: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x76, 0xb0, 0xe8, 0xa8, 0xe6, 0x78, 0xe8,
+			0x55, 0x8d, 0xe6, 0x55, 0x69, 0x60, 0xbd, 0x67, 0x55, 0x8d, 0x89, 0x66, 0x25, 0x8c, 0x40, 0x55,
+			0x65, 0x55, 0x89, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55,
+			0x69, 0x50, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a,
+			0x58, 0xe8, 0x55, 0x89, 0x60, 0xad, 0x9a, 0xf1, 0x8a, 0x79, 0x99, 0x25, 0x8c, 0x40, 0xad, 0x96,
+			0x55, 0x8d, 0xe6, 0x88, 0xe8, 0xa8, 0xe6, 0xbd, 0x89, 0xe8, 0xb0, 0xe6, 0x45, 0x76, 0xe2, 0x55,
+			0x69, 0x55, 0x89, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x89, 0xe2, 0x55,
+			0x69, 0x55, 0x89, 0xe8, 0x58, 0xe8, 0x55, 0x89, 0xe1,
+		}},
+		HardwareDeveloperBoard: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x54, 0xb0, 0x60, 0x69, 0x68, 0xb0, 0x35, 0x67,
+			0xcd, 0x96, 0x40, 0x4c, 0xa8, 0xe8, 0x58, 0x60, 0x4c, 0x69, 0x6a, 0x35, 0x67, 0x35, 0x69, 0x40,
+			0x54, 0x50, 0xe6, 0xa4, 0x60, 0x99, 0x93, 0x50, 0xcd, 0x94, 0x35, 0x69, 0x40, 0xac, 0x58, 0xe8,
+			0x60, 0xe6, 0xb4, 0xe8, 0x68, 0xe6, 0xac, 0xe8, 0x7c, 0xe6, 0xb4, 0xe8, 0x84, 0xe6, 0xac, 0xe8,
+			0x98, 0xe6, 0xb4, 0xe8, 0xa0, 0xe6, 0xac, 0xe8, 0xa8, 0x60, 0xac, 0x99, 0x95, 0xcd, 0x94, 0xcd,
+			0x96, 0x40, 0xa4, 0xb0, 0xe6, 0x54, 0xe2, 0x54, 0xa8, 0xe6, 0xa4, 0xe8, 0x58, 0xe6, 0x54, 0xe8,
+			0xa8, 0xe2, 0x5c, 0xa0, 0xe6, 0xdd, 0x7e, 0xe8, 0xad, 0x82, 0xe6, 0x5c, 0xe8, 0xa0, 0xe2, 0xdd,
+			0x80, 0x99, 0x79, 0xe6, 0x9c, 0xe8, 0x60, 0xe6, 0xdd, 0x80, 0xe8, 0x99, 0x79, 0xe2, 0x5c, 0xad,
+			0x80, 0xe6, 0xdd, 0x7e, 0xe8, 0x60, 0xe6, 0x5c, 0xe8, 0xad, 0x80, 0xe2, 0xdd, 0x80, 0xa0, 0xe6,
+			0x9c, 0xe8, 0x99, 0x7b, 0xe6, 0xdd, 0x80, 0xe8, 0xa0, 0xe2, 0x54, 0x58, 0xe8, 0xa8, 0xe8, 0x58,
+			0xe1,
+		}},
+		HardwareDeviceHub: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0x98, 0xe6, 0x45, 0x72, 0x00,
+			0x7c, 0x45, 0x80, 0xe8, 0x55, 0x76, 0x67, 0xad, 0x7b, 0x89, 0x75, 0x2d, 0x7a, 0xa1, 0x73, 0xad,
+			0x78, 0xb9, 0x71, 0xad, 0x78, 0x55, 0x6f, 0xad, 0x78, 0x49, 0x6c, 0xd1, 0x7a, 0x25, 0x6a, 0xf5,
+			0x7c, 0x50, 0x05, 0x80, 0x50, 0x11, 0x83, 0x50, 0x35, 0x85, 0x25, 0x6a, 0x55, 0x87, 0x49, 0x6c,
+			0x55, 0x87, 0x55, 0x6f, 0x55, 0x87, 0xb9, 0x71, 0xd5, 0x85, 0xa1, 0x73, 0x55, 0x84, 0x89, 0x75,
+			0x84, 0x55, 0x76, 0xe8, 0x45, 0x80, 0x00, 0xbd, 0x8d, 0x98, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6, 0x98,
+			0xe8, 0x45, 0x90, 0x01, 0x80, 0x45, 0x84, 0x68, 0x45, 0x90, 0xe8, 0xb0, 0xe6, 0x50, 0xe1,
+		}},
+		HardwareDevicesOther: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x68, 0x55, 0x95, 0xe6, 0x55, 0x69, 0x60, 0xb1,
+			0x67, 0x55, 0x95, 0x85, 0x66, 0x29, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60,
+			0x55, 0x65, 0x05, 0x6d, 0x85, 0x66, 0xd9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x55, 0x95,
+			0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe6, 0x68, 0xe8, 0x55, 0x95, 0xe2, 0xfd,
+			0x7d, 0xa0, 0x67, 0xf1, 0x7f, 0xa0, 0x4d, 0x81, 0xa5, 0x8e, 0xad, 0x82, 0x49, 0x8d, 0xad, 0x82,
+			0x59, 0x8b, 0xad, 0x82, 0x69, 0x89, 0x51, 0x81, 0x09, 0x88, 0xf5, 0x7f, 0xad, 0x86, 0x05, 0x7e,
+			0xad, 0x86, 0x11, 0x7c, 0xad, 0x86, 0xb5, 0x7a, 0x09, 0x88, 0x55, 0x79, 0x65, 0x89, 0x55, 0x79,
+			0x55, 0x8b, 0x55, 0x79, 0x45, 0x8d, 0xb1, 0x7a, 0xa5, 0x8e, 0x0d, 0x7c, 0xa0, 0xfd, 0x7d, 0xa0,
+			0xe2, 0x55, 0x79, 0x55, 0x95, 0xe8, 0xa2, 0x60, 0x25, 0x78, 0xa0, 0x69, 0x77, 0x89, 0x8e, 0x40,
+			0xad, 0x76, 0x55, 0x8b, 0x60, 0xad, 0x76, 0x99, 0x89, 0x69, 0x77, 0x25, 0x88, 0x40, 0x55, 0x79,
+			0xa5, 0x85, 0xe8, 0x55, 0x81, 0xe6, 0xad, 0x82, 0xe8, 0xad, 0x85, 0x60, 0xdd, 0x83, 0xad, 0x86,
+			0x99, 0x84, 0x25, 0x88, 0x40, 0x55, 0x85, 0x55, 0x8b, 0x60, 0x55, 0x85, 0x11, 0x8d, 0x99, 0x84,
+			0x89, 0x8e, 0x40, 0xad, 0x82, 0x09, 0x91, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x79, 0xe2, 0xad, 0x98,
+			0x55, 0x95, 0xe6, 0x94, 0x60, 0x29, 0x89, 0x55, 0x95, 0x95, 0x88, 0xc5, 0x94, 0x40, 0x90, 0x55,
+			0x93, 0xe8, 0x74, 0x60, 0x90, 0x29, 0x79, 0x95, 0x88, 0x95, 0x78, 0x40, 0x94, 0x70, 0xe6, 0xad,
+			0x98, 0x60, 0x85, 0x99, 0x70, 0x19, 0x9a, 0x95, 0x78, 0x40, 0xad, 0x9a, 0x74, 0xe8, 0x55, 0x93,
+			0x60, 0xad, 0x9a, 0x31, 0x94, 0x19, 0x9a, 0xc5, 0x94, 0x40, 0xad, 0x98, 0x55, 0x95, 0xe2, 0x98,
+			0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0x78, 0xe6, 0x98, 0xe8, 0x55, 0x91, 0xe2, 0x98, 0x55, 0x91,
+			0xe6, 0xad, 0x96, 0xe6, 0x98, 0xe1,
+		}},
+		HardwareDock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0x55, 0x9d, 0xe8, 0x55, 0x99, 0xe6,
+			0xad, 0x8a, 0xe8, 0x55, 0x9d, 0xe6, 0x55, 0x75, 0xe2, 0xad, 0x72, 0x55, 0x95, 0x60, 0x11, 0x71,
+			0x55, 0x95, 0xdd, 0x6f, 0x25, 0x94, 0x40, 0xad, 0x6e, 0x55, 0x91, 0xe8, 0xad, 0x66, 0x60, 0xad,
+			0x6e, 0x11, 0x65, 0xdd, 0x6f, 0xdd, 0x63, 0x40, 0xad, 0x72, 0xad, 0x62, 0xe6, 0x55, 0x8d, 0x60,
+			0xf1, 0x8e, 0xad, 0x62, 0x25, 0x90, 0xdd, 0x63, 0x40, 0x55, 0x91, 0xad, 0x66, 0xe8, 0x55, 0x91,
+			0x60, 0x55, 0x91, 0xf1, 0x92, 0x25, 0x90, 0x25, 0x94, 0x40, 0x55, 0x8d, 0x55, 0x95, 0xe6, 0xad,
+			0x72, 0xe2, 0xad, 0x72, 0x55, 0x8d, 0xe8, 0x55, 0x91, 0xe6, 0x55, 0x8d, 0xe8, 0x55, 0x8d, 0xe6,
+			0xad, 0x72, 0xe2, 0xad, 0x72, 0x55, 0x89, 0xe6, 0x55, 0x8d, 0xe8, 0xad, 0x6e, 0xe6, 0xad, 0x72,
+			0xe8, 0x55, 0x89, 0xe2, 0xad, 0x72, 0xad, 0x6a, 0xe6, 0x55, 0x8d, 0xe8, 0xad, 0x66, 0xe6, 0xad,
+			0x72, 0xe8, 0xad, 0x6a, 0xe2, 0xad, 0x72, 0xad, 0x6a, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x6a, 0xe2,
+			0xad, 0x72, 0x55, 0x8d, 0xe8, 0x55, 0x91, 0xe8, 0x55, 0x8d, 0xe1,
+		}},
+		HardwareGamepad: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xbd, 0x75, 0xe2, 0x45, 0x8a, 0x80, 0xe2,
+			0xcd, 0x75, 0x80, 0xe2, 0x80, 0x35, 0x8a, 0xe2, 0x80, 0x45, 0x7b, 0x00, 0x99, 0x78, 0xcd, 0x73,
+			0xe8, 0x55, 0x65, 0xe6, 0x79, 0x87, 0xe8, 0xcd, 0x73, 0x00, 0x80, 0x45, 0x7b, 0xe2, 0x35, 0x8c,
+			0x69, 0x87, 0x01, 0xbd, 0x84, 0x80, 0x35, 0x8c, 0x89, 0x78, 0xe6, 0xad, 0x9a, 0xe8, 0x69, 0x87,
+			0xe6, 0x35, 0x8c, 0xe2, 0x55, 0x65, 0x69, 0x87, 0xe8, 0x89, 0x78, 0xe6, 0xcd, 0x73, 0x01, 0x45,
+			0x7b, 0x80, 0xcd, 0x73, 0x69, 0x87, 0xe6, 0x55, 0x65, 0xe2, 0x99, 0x78, 0xad, 0x9a, 0xe8, 0x35,
+			0x8c, 0x01, 0x80, 0xbd, 0x84, 0x79, 0x87, 0x35, 0x8c, 0xe8, 0xad, 0x9a, 0xe6, 0x99, 0x78, 0xe2,
+			0x80, 0xbd, 0x75, 0x00, 0x79, 0x83, 0x45, 0x72, 0xe8, 0x55, 0x69, 0xe6, 0x99, 0x7c, 0xe8, 0x45,
+			0x72, 0x00, 0x80, 0xbd, 0x75, 0xe2, 0x55, 0x69, 0x69, 0x83, 0xe6, 0x55, 0x72, 0x01, 0xcd, 0x75,
+			0x80, 0x55, 0x72, 0x89, 0x7c, 0xe6, 0x55, 0x69, 0xe8, 0x69, 0x83, 0xe2, 0x99, 0x7c, 0xad, 0x96,
+			0xe6, 0x79, 0x83, 0xe8, 0xad, 0x8d, 0x01, 0x80, 0x35, 0x8a, 0x99, 0x7c, 0xad, 0x8d, 0xe8, 0xad,
+			0x96, 0xe2, 0xbd, 0x8d, 0x69, 0x83, 0xe6, 0xad, 0x96, 0xe8, 0x89, 0x7c, 0xe6, 0xbd, 0x8d, 0x01,
+			0x45, 0x8a, 0x80, 0xbd, 0x8d, 0x69, 0x83, 0xe1,
+		}},
+		HardwareHeadset: HardwareHeadset,
+		HardwareHeadsetMic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x80, 0x55, 0x9d, 0xe8, 0x55, 0x99, 0xe6,
+			0xa8, 0xe8, 0xbd, 0x95, 0xe6, 0x25, 0x8a, 0xe8, 0x84, 0xe6, 0xa8, 0xe8, 0x79, 0x7d, 0x60, 0xa8,
+			0x35, 0x75, 0x35, 0x8e, 0x3d, 0x6f, 0x40, 0x25, 0x80, 0x45, 0x69, 0x60, 0xdd, 0x77, 0x45, 0x69,
+			0xf1, 0x71, 0x3d, 0x6f, 0x40, 0x58, 0x79, 0x7d, 0xe8, 0x84, 0xe6, 0xdd, 0x75, 0xe8, 0xbd, 0x95,
+			0xe6, 0x58, 0x60, 0x69, 0x6a, 0xbd, 0x95, 0x35, 0x69, 0x89, 0x94, 0x40, 0x50, 0xbd, 0x91, 0xe8,
+			0x79, 0x7d, 0x61, 0x50, 0x7d, 0x78, 0xe9, 0x69, 0x15, 0x74, 0xcd, 0x6b, 0xad, 0x6f, 0x11, 0x6f,
+			0x5d, 0x6c, 0x40, 0xc1, 0x76, 0x2d, 0x67, 0x64, 0x29, 0x7b, 0x45, 0x65, 0x25, 0x80, 0x45, 0x65,
+			0x25, 0x85, 0x45, 0x65, 0x81, 0x89, 0x2d, 0x67, 0xdd, 0x8d, 0x11, 0x69, 0x11, 0x91, 0x5d, 0x6c,
+			0x49, 0x94, 0xad, 0x6f, 0x25, 0x96, 0x15, 0x74, 0xb0, 0x7d, 0x78, 0xb0, 0x79, 0x7d, 0xe8, 0x55,
+			0x99, 0x60, 0xb0, 0xf1, 0x9a, 0xcd, 0x96, 0x25, 0x9c, 0x40, 0xa8, 0x55, 0x9d, 0xe6, 0x25, 0x80,
+			0xe2, 0x58, 0xbd, 0x91, 0xe6, 0xdd, 0x71, 0xe8, 0x8c, 0xe6, 0x58, 0xe8, 0xbd, 0x91, 0xe2, 0x25,
+			0x8e, 0xbd, 0x91, 0xe6, 0xa8, 0xe8, 0x8c, 0xe6, 0x25, 0x8e, 0xe8, 0xbd, 0x91, 0xe2, 0xa8, 0xbd,
+			0x91, 0xe6, 0x25, 0x8e, 0xe6, 0xa8, 0xe2, 0xdd, 0x71, 0xbd, 0x91, 0xe6, 0x58, 0xe6, 0xdd, 0x71,
+			0xe1,
+		}},
+		HardwareKeyboard: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xad, 0x92, 0x60, 0xbd, 0x67, 0xad,
+			0x92, 0x89, 0x66, 0x71, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0x55, 0x65,
+			0xbd, 0x6f, 0x89, 0x66, 0x89, 0x6e, 0x40, 0x55, 0x69, 0x55, 0x6d, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0x55, 0x6d, 0x79, 0x99, 0x89, 0x6e, 0x40, 0xad, 0x9a, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x35, 0x90, 0x79, 0x99, 0x71, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0xe2, 0x68, 0x55, 0x8a, 0xe6, 0x98, 0xe8, 0x55, 0x86, 0xe6, 0x68, 0xe8, 0x55, 0x8a, 0xe2,
+			0x89, 0x6d, 0x84, 0xe6, 0x89, 0x71, 0xe8, 0x7c, 0xe6, 0x89, 0x6d, 0xe8, 0x84, 0xe2, 0xcd, 0x75,
+			0x84, 0xe6, 0xcd, 0x79, 0xe8, 0x7c, 0xe6, 0xcd, 0x75, 0xe8, 0x84, 0xe2, 0x7c, 0x84, 0xe6, 0x84,
+			0xe8, 0x7c, 0xe6, 0x7c, 0xe8, 0x84, 0xe2, 0x45, 0x86, 0x84, 0xe6, 0x45, 0x8a, 0xe8, 0x7c, 0xe6,
+			0x45, 0x86, 0xe8, 0x84, 0xe2, 0x79, 0x8e, 0x84, 0xe6, 0x79, 0x92, 0xe8, 0x7c, 0xe6, 0x79, 0x8e,
+			0xe8, 0x84, 0xe2, 0x89, 0x6d, 0xad, 0x79, 0xe6, 0x89, 0x71, 0xe8, 0xad, 0x75, 0xe6, 0x89, 0x6d,
+			0xe8, 0xad, 0x79, 0xe2, 0xcd, 0x75, 0xad, 0x79, 0xe6, 0xcd, 0x79, 0xe8, 0xad, 0x75, 0xe6, 0xcd,
+			0x75, 0xe8, 0xad, 0x79, 0xe2, 0x7c, 0xad, 0x79, 0xe6, 0x84, 0xe8, 0xad, 0x75, 0xe6, 0x7c, 0xe8,
+			0xad, 0x79, 0xe2, 0x45, 0x86, 0xad, 0x79, 0xe6, 0x45, 0x8a, 0xe8, 0xad, 0x75, 0xe6, 0x45, 0x86,
+			0xe8, 0xad, 0x79, 0xe2, 0x79, 0x8e, 0xad, 0x79, 0xe6, 0x79, 0x92, 0xe8, 0xad, 0x75, 0xe6, 0x79,
+			0x8e, 0xe8, 0xad, 0x79, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		HardwareKeyboardArrowDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x11, 0x89, 0x05, 0x60, 0x11, 0x79, 0xdd,
+			0x72, 0x35, 0x76, 0x80, 0x55, 0x83, 0x25, 0x8d, 0x35, 0x76, 0xa0, 0x11, 0x79, 0x80, 0x11, 0x89,
+			0xe1,
+		}},
+		HardwareKeyboardArrowLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x85, 0xa0, 0x05, 0x55, 0x75, 0xf1, 0x7f,
+			0x69, 0x85, 0xdd, 0x6f, 0x45, 0x88, 0xbd, 0x72, 0x11, 0x7b, 0xf1, 0x7f, 0x45, 0x88, 0x25, 0x8d,
+			0x69, 0x85, 0xa0, 0xe1,
+		}, mirror: true},
+		HardwareKeyboardArrowRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x83, 0xf1, 0x7f, 0x05, 0x25, 0x76, 0xbd,
+			0x72, 0x72, 0xdd, 0x6f, 0x11, 0x89, 0xf1, 0x7f, 0x72, 0xa0, 0x25, 0x76, 0x25, 0x8d, 0x55, 0x83,
+			0xf1, 0x7f, 0xe1,
+		}, mirror: true},
+		HardwareKeyboardArrowUp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x11, 0x7b, 0x05, 0xdd, 0x72, 0x35, 0x88,
+			0x60, 0x55, 0x85, 0x80, 0x55, 0x75, 0xa0, 0x55, 0x85, 0x25, 0x8d, 0x35, 0x88, 0x80, 0x11, 0x7b,
+			0xe1,
+		}},
+		HardwareKeyboardBackspace: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x77, 0xdd, 0x8f, 0x03, 0x50, 0xf1, 0x7f,
+			0xf1, 0x77, 0x60, 0xcd, 0x7a, 0xdd, 0x72, 0xbd, 0x6f, 0xf1, 0x7d, 0xe6, 0xb0, 0xe8, 0xf1, 0x81,
+			0xe6, 0xbd, 0x6f, 0x01, 0xcd, 0x7a, 0x9a, 0xf1, 0x77, 0xdd, 0x8f, 0xe1,
+		}, mirror: true},
+		HardwareKeyboardCapslock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0xa0, 0xe8, 0x98, 0xe6, 0xa0, 0xe8, 0xa0,
+			0xe6, 0x60, 0xe2, 0x80, 0xf1, 0x6e, 0x05, 0xad, 0x8f, 0x99, 0x7e, 0xcd, 0x8c, 0x79, 0x81, 0x80,
+			0xad, 0x74, 0x79, 0x73, 0x35, 0x81, 0x99, 0x70, 0x55, 0x7e, 0x80, 0xf1, 0x6e, 0xe1,
+		}},
+		HardwareKeyboardHide: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x9d, 0x00, 0x35, 0x76, 0x79, 0x93,
+			0xe6, 0xdd, 0x89, 0x00, 0x80, 0x55, 0x9d, 0xe2, 0x55, 0x69, 0x55, 0x8d, 0x60, 0xbd, 0x67, 0x55,
+			0x8d, 0x89, 0x66, 0x25, 0x8c, 0x40, 0x55, 0x65, 0x55, 0x89, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x69,
+			0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x50,
+			0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0x55, 0x89, 0x60, 0xad, 0x9a, 0xf1, 0x8a,
+			0x79, 0x99, 0x25, 0x8c, 0x40, 0xad, 0x96, 0x55, 0x8d, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55,
+			0x89, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x89, 0xe2, 0x99, 0x75, 0x8a,
+			0xe6, 0x79, 0x8a, 0xe8, 0x82, 0xe6, 0x99, 0x75, 0xe8, 0x8a, 0xe2, 0x25, 0x6d, 0xad, 0x7c, 0xe6,
+			0x25, 0x71, 0xe8, 0xad, 0x78, 0xe6, 0x25, 0x6d, 0xe8, 0xad, 0x7c, 0xe2, 0x99, 0x75, 0xad, 0x7c,
+			0xe6, 0x99, 0x79, 0xe8, 0xad, 0x78, 0xe6, 0x99, 0x75, 0xe8, 0xad, 0x7c, 0xe2, 0x7c, 0xad, 0x7c,
+			0xe6, 0x84, 0xe8, 0xad, 0x78, 0xe6, 0x7c, 0xe8, 0xad, 0x7c, 0xe2, 0x79, 0x86, 0xad, 0x7c, 0xe6,
+			0x79, 0x8a, 0xe8, 0xad, 0x78, 0xe6, 0x79, 0x86, 0xe8, 0xad, 0x7c, 0xe2, 0xdd, 0x8e, 0xad, 0x7c,
+			0xe6, 0xdd, 0x92, 0xe8, 0xad, 0x78, 0xe6, 0xdd, 0x8e, 0xe8, 0xad, 0x7c, 0xe2, 0x25, 0x6d, 0x55,
+			0x74, 0xe6, 0x25, 0x71, 0xe8, 0x55, 0x70, 0xe6, 0x25, 0x6d, 0xe8, 0x55, 0x74, 0xe2, 0x99, 0x75,
+			0x55, 0x74, 0xe6, 0x99, 0x79, 0xe8, 0x55, 0x70, 0xe6, 0x99, 0x75, 0xe8, 0x55, 0x74, 0xe2, 0x7c,
+			0x55, 0x74, 0xe6, 0x84, 0xe8, 0x55, 0x70, 0xe6, 0x7c, 0xe8, 0x55, 0x74, 0xe2, 0x79, 0x86, 0x55,
+			0x74, 0xe6, 0x79, 0x8a, 0xe8, 0x55, 0x70, 0xe6, 0x79, 0x86, 0xe8, 0x55, 0x74, 0xe2, 0xdd, 0x8e,
+			0x55, 0x74, 0xe6, 0xdd, 0x92, 0xe8, 0x55, 0x70, 0xe6, 0xdd, 0x8e, 0xe8, 0x55, 0x74, 0xe2, 0x55,
+			0x69, 0x55, 0x89, 0xe8, 0x58, 0xe8, 0x55, 0x89, 0xe1,
+		}},
+		HardwareKeyboardReturn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x77, 0xa0, 0x03, 0x50, 0x11, 0x80, 0xf1,
+			0x77, 0x25, 0x70, 0xcd, 0x7a, 0x66, 0xad, 0x6f, 0x25, 0x7e, 0xe6, 0xa8, 0xe8, 0xad, 0x72, 0xe6,
+			0xb0, 0xe8, 0x11, 0x82, 0xe6, 0xbd, 0x6f, 0x01, 0xcd, 0x7a, 0x25, 0x8d, 0xf1, 0x77, 0xa0, 0xe1,
+		}, mirror: true},
+		HardwareKeyboardTab: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x96, 0xa0, 0xe8, 0x60, 0xe6, 0xad, 0x9a,
+			0xe8, 0xa0, 0xe6, 0xad, 0x96, 0xe2, 0x80, 0x11, 0x90, 0x01, 0x25, 0x7d, 0x45, 0x8d, 0x69, 0x88,
+			0x84, 0xe6, 0x55, 0x65, 0xe8, 0x7c, 0xe6, 0x69, 0x88, 0x03, 0x35, 0x7d, 0xbd, 0x72, 0x80, 0xf1,
+			0x6f, 0x11, 0x90, 0x80, 0x80, 0x11, 0x90, 0xe1,
+		}, mirror: true},
+		HardwareKeyboardVoice: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xcd, 0x83, 0x61, 0x25, 0x7d, 0xcd, 0x83,
+			0x35, 0x7b, 0xbd, 0x81, 0x45, 0x79, 0xad, 0x7f, 0x45, 0x79, 0xbd, 0x7c, 0xe8, 0x58, 0x61, 0x45,
+			0x79, 0x39, 0x69, 0x3d, 0x7b, 0x49, 0x67, 0x31, 0x7d, 0x55, 0x65, 0x80, 0x55, 0x65, 0x40, 0xc5,
+			0x84, 0x49, 0x67, 0x60, 0xbd, 0x86, 0x39, 0x69, 0xbd, 0x86, 0x58, 0xe8, 0xbd, 0x7c, 0x61, 0xbd,
+			0x86, 0xad, 0x7f, 0xcd, 0x84, 0xbd, 0x81, 0xdd, 0x82, 0xcd, 0x83, 0x80, 0xcd, 0x83, 0xe2, 0x80,
+			0x99, 0x74, 0xe2, 0x7c, 0xb0, 0xe8, 0xf1, 0x8e, 0x60, 0xf1, 0x76, 0x35, 0x8e, 0x25, 0x72, 0x92,
+			0x40, 0x55, 0x6d, 0xbd, 0x7c, 0xe6, 0x55, 0x71, 0x60, 0x55, 0x71, 0xcd, 0x82, 0xa1, 0x75, 0xf1,
+			0x86, 0x40, 0xfd, 0x7f, 0x11, 0x8b, 0x61, 0x11, 0x86, 0x11, 0x8b, 0x5d, 0x8a, 0xf1, 0x86, 0xad,
+			0x8e, 0xcd, 0x82, 0xad, 0x8e, 0xbd, 0x7c, 0xe6, 0xad, 0x92, 0x60, 0xad, 0x92, 0xcd, 0x83, 0xdd,
+			0x8d, 0x92, 0x40, 0x84, 0xf1, 0x8e, 0xe8, 0xb0, 0xe6, 0x7c, 0xe2, 0x80, 0xcd, 0x7f, 0x60, 0x35,
+			0x81, 0xcd, 0x7f, 0xf9, 0x81, 0xe9, 0x7e, 0x40, 0xbd, 0x82, 0xbd, 0x7c, 0xe8, 0x58, 0x63, 0xbd,
+			0x82, 0xdd, 0x6a, 0xf5, 0x81, 0x19, 0x6a, 0x29, 0x81, 0x55, 0x69, 0x80, 0x55, 0x69, 0xd9, 0x7e,
+			0x55, 0x69, 0x0d, 0x7e, 0x19, 0x6a, 0x45, 0x7d, 0xdd, 0x6a, 0x45, 0x7d, 0x58, 0xe8, 0xbd, 0x7c,
+			0x60, 0x45, 0x7d, 0x7c, 0x09, 0x7e, 0xe9, 0x7e, 0x40, 0x80, 0xcd, 0x7f, 0xe1,
+		}},
+		HardwareLaptop: HardwareLaptop,
+		HardwareLaptopChromebook: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x40, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0x55,
+			0x65, 0xe8, 0x50, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x91, 0xe6, 0xc0, 0xe8, 0x55, 0x95, 0xe6, 0x40,
+			0xe2, 0x69, 0x7a, 0x55, 0x91, 0xe6, 0xbd, 0x85, 0xe8, 0x89, 0x8e, 0xe6, 0x69, 0x7a, 0xe8, 0x55,
+			0x91, 0xe2, 0x99, 0x69, 0x89, 0x8a, 0xe6, 0xf1, 0x96, 0xe8, 0x58, 0xe6, 0x99, 0x69, 0xe8, 0x89,
+			0x8a, 0xe2, 0x99, 0x69, 0x89, 0x8a, 0xe8, 0x58, 0xe8, 0x89, 0x8a, 0xe1,
+		}},
+		HardwareLaptopMac: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x63, 0x55, 0x95, 0x61, 0x3d, 0x62, 0x55,
+			0x95, 0x1d, 0x61, 0x29, 0x94, 0x40, 0xfd, 0x92, 0x40, 0x55, 0x91, 0xe6, 0x69, 0x69, 0x60, 0xcd,
+			0x67, 0x55, 0x91, 0x99, 0x66, 0x25, 0x90, 0x40, 0x69, 0x65, 0x55, 0x8d, 0xe8, 0x58, 0x60, 0x69,
+			0x65, 0x69, 0x6a, 0x99, 0x66, 0x35, 0x69, 0x40, 0x69, 0x69, 0x50, 0xe6, 0x99, 0x96, 0x60, 0x35,
+			0x98, 0x50, 0x69, 0x99, 0x35, 0x69, 0x40, 0x99, 0x9a, 0x58, 0xe8, 0x55, 0x8d, 0x60, 0x99, 0x9a,
+			0xf1, 0x8e, 0x69, 0x99, 0x25, 0x90, 0x40, 0x99, 0x96, 0x55, 0x91, 0xe6, 0xc0, 0x60, 0xc0, 0xa6,
+			0xd5, 0x9e, 0x2d, 0x94, 0x40, 0xb8, 0x55, 0x95, 0xe6, 0xcd, 0x63, 0xe2, 0x80, 0xdd, 0x93, 0x67,
+			0xf9, 0x80, 0xdd, 0x93, 0x9d, 0x81, 0x39, 0x93, 0x45, 0x82, 0x91, 0x92, 0x45, 0x82, 0x99, 0x91,
+			0x45, 0x82, 0xa5, 0x90, 0x9d, 0x81, 0xfd, 0x8f, 0xf9, 0x80, 0x55, 0x8f, 0x80, 0x55, 0x8f, 0x09,
+			0x7f, 0x55, 0x8f, 0x65, 0x7e, 0xfd, 0x8f, 0xbd, 0x7d, 0xa5, 0x90, 0xbd, 0x7d, 0x99, 0x91, 0xbd,
+			0x7d, 0x91, 0x92, 0x65, 0x7e, 0x39, 0x93, 0x09, 0x7f, 0xdd, 0x93, 0x80, 0xdd, 0x93, 0xe2, 0x69,
+			0x69, 0x55, 0x8d, 0xe6, 0x99, 0x96, 0xe8, 0x58, 0xe6, 0x69, 0x69, 0xe8, 0x55, 0x8d, 0xe2, 0x69,
+			0x69, 0x55, 0x8d, 0xe8, 0x58, 0xe8, 0x55, 0x8d, 0xe1,
+		}},
+		HardwareLaptopWindows: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x40, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0x69,
+			0x69, 0xe8, 0x89, 0x8e, 0x60, 0xcd, 0x67, 0x89, 0x8e, 0x99, 0x66, 0x55, 0x8d, 0x40, 0x69, 0x65,
+			0x89, 0x8a, 0xe8, 0x58, 0x60, 0x69, 0x65, 0x69, 0x6a, 0x99, 0x66, 0x35, 0x69, 0x40, 0x69, 0x69,
+			0x50, 0xe6, 0x99, 0x96, 0x60, 0x35, 0x98, 0x50, 0x69, 0x99, 0x35, 0x69, 0x40, 0x99, 0x9a, 0x58,
+			0xe8, 0x89, 0x8a, 0x60, 0x99, 0x9a, 0x25, 0x8c, 0x69, 0x99, 0x55, 0x8d, 0x40, 0x99, 0x96, 0x89,
+			0x8e, 0xe8, 0x55, 0x91, 0xe6, 0xc0, 0xe8, 0x55, 0x95, 0xe6, 0x40, 0xe2, 0x69, 0x69, 0x89, 0x8a,
+			0xe6, 0x99, 0x96, 0xe8, 0x58, 0xe6, 0x69, 0x69, 0xe8, 0x89, 0x8a, 0xe2, 0x69, 0x69, 0x89, 0x8a,
+			0xe8, 0x58, 0xe8, 0x89, 0x8a, 0xe1,
+		}},
+		HardwareMemory: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x79, 0xdd, 0x86, 0xe8, 0x35, 0x79, 0xe6,
+			0xcd, 0x86, 0xe8, 0xdd, 0x86, 0xe6, 0x25, 0x79, 0xe2, 0x25, 0x7d, 0xdd, 0x82, 0xe6, 0xcd, 0x82,
+			0xe8, 0x35, 0x7d, 0xe6, 0x25, 0x7d, 0xe8, 0xdd, 0x82, 0xe2, 0x70, 0xb0, 0xe8, 0xad, 0x92, 0xe6,
+			0x55, 0x71, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e,
+			0xe8, 0x90, 0xe6, 0x50, 0xe8, 0x88, 0xe6, 0x55, 0x6d, 0xe8, 0xbd, 0x7b, 0xe6, 0x50, 0xe8, 0xbd,
+			0x77, 0xe6, 0x55, 0x6d, 0xe8, 0x11, 0x71, 0x60, 0x55, 0x6d, 0x79, 0x6f, 0x89, 0x6e, 0x45, 0x6e,
+			0x40, 0x55, 0x71, 0x11, 0x6d, 0xe6, 0x70, 0xe8, 0x50, 0xe6, 0x78, 0xe8, 0x11, 0x6d, 0xe6, 0x45,
+			0x84, 0xe8, 0x50, 0xe6, 0x45, 0x88, 0xe8, 0x11, 0x6d, 0xe6, 0xf1, 0x8e, 0x60, 0x89, 0x90, 0x11,
+			0x6d, 0xbd, 0x91, 0x45, 0x6e, 0x40, 0xf1, 0x92, 0x11, 0x71, 0xe8, 0xbd, 0x77, 0xe6, 0xb0, 0xe8,
+			0xbd, 0x7b, 0xe6, 0xf1, 0x92, 0xe8, 0x88, 0xe6, 0xb0, 0xe8, 0x90, 0xe6, 0xf1, 0x92, 0xe8, 0xad,
+			0x8e, 0x60, 0xf1, 0x92, 0x45, 0x90, 0xbd, 0x91, 0x79, 0x91, 0x40, 0xf1, 0x8e, 0xad, 0x92, 0xe6,
+			0x45, 0x88, 0xe8, 0xb0, 0xe6, 0x45, 0x84, 0xe8, 0xad, 0x92, 0xe6, 0x78, 0xe8, 0xb0, 0xe6, 0x70,
+			0xe2, 0xf1, 0x8e, 0xad, 0x8e, 0xe8, 0x11, 0x71, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe6, 0xf1,
+			0x8e, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		HardwareMouse: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x25, 0x78, 0xad, 0x9a,
+			0xbd, 0x72, 0x45, 0x95, 0x40, 0x55, 0x6d, 0x90, 0xe8, 0xad, 0x76, 0x60, 0x55, 0x6d, 0xcd, 0x6e,
+			0xbd, 0x72, 0x69, 0x69, 0x40, 0x80, 0x48, 0x60, 0xdd, 0x87, 0x48, 0x45, 0x8d, 0x69, 0x69, 0x40,
+			0xad, 0x92, 0xad, 0x76, 0xe8, 0x90, 0x60, 0xad, 0x92, 0xdd, 0x8f, 0x45, 0x8d, 0x45, 0x95, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x84, 0xad, 0x76, 0xe6, 0xad, 0x8e, 0x60, 0xad, 0x8e, 0x45, 0x71, 0x25,
+			0x8b, 0x11, 0x6d, 0x40, 0x84, 0x25, 0x68, 0xe8, 0xad, 0x76, 0xe2, 0x55, 0x71, 0xad, 0x76, 0xe6,
+			0x7c, 0xe8, 0x25, 0x68, 0x60, 0x69, 0x78, 0xdd, 0x68, 0xdd, 0x74, 0x11, 0x6d, 0x40, 0x55, 0x71,
+			0xad, 0x76, 0xe2, 0xfd, 0x7f, 0xad, 0x96, 0x61, 0x11, 0x86, 0xad, 0x96, 0x5d, 0x8a, 0x61, 0x92,
+			0xad, 0x8e, 0x19, 0x8e, 0xad, 0x8e, 0x90, 0xe8, 0xad, 0x7a, 0xe6, 0x55, 0x71, 0xe8, 0x90, 0x61,
+			0x55, 0x71, 0x19, 0x8e, 0xa1, 0x75, 0x61, 0x92, 0xe9, 0x79, 0xad, 0x96, 0xfd, 0x7f, 0xad, 0x96,
+			0xe2, 0x80, 0xad, 0x7a, 0xe2, 0x84, 0xad, 0x76, 0xe2, 0x7c, 0xad, 0x76, 0xe2, 0x80, 0xad, 0x7a,
+			0xe1,
+		}},
+		HardwarePhoneAndroid: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x7a, 0xad, 0x97, 0xe6, 0x79, 0x85, 0xe8,
+			0xaa, 0xe6, 0x89, 0x7a, 0xe8, 0xad, 0x97, 0xe2, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55,
+			0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d,
+			0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45,
+			0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60,
+			0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x93, 0xe6, 0x55,
+			0x71, 0xe2, 0x55, 0x71, 0x55, 0x8f, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8,
+			0x55, 0x8f, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x93, 0xe2, 0x55,
+			0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe1,
+		}},
+		HardwarePhoneIPhone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55,
+			0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d,
+			0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45,
+			0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60,
+			0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x93, 0xe6, 0x55,
+			0x71, 0xe2, 0x05, 0x80, 0x55, 0x98, 0x67, 0xdd, 0x80, 0x55, 0x98, 0x71, 0x81, 0xc1, 0x97, 0x84,
+			0x2d, 0x97, 0x84, 0x55, 0x96, 0x84, 0x79, 0x95, 0x6d, 0x81, 0xe9, 0x94, 0xd9, 0x80, 0x55, 0x94,
+			0xfd, 0x7f, 0x55, 0x94, 0x25, 0x7f, 0x55, 0x94, 0x91, 0x7e, 0xe9, 0x94, 0x7c, 0x7d, 0x95, 0x7c,
+			0x59, 0x96, 0x7c, 0x35, 0x97, 0x95, 0x7e, 0xc5, 0x97, 0x29, 0x7f, 0x55, 0x98, 0x05, 0x80, 0x55,
+			0x98, 0xe2, 0x55, 0x71, 0x55, 0x8f, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8,
+			0x55, 0x8f, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x93, 0xe2, 0x55,
+			0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe1,
+		}},
+		HardwarePhoneLink:    HardwarePhoneLink,
+		HardwarePhoneLinkOff: HardwarePhoneLinkOff,
+		HardwarePowerInput: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x8c, 0xe8, 0x84, 0xe6, 0x99, 0x73,
+			0xe8, 0x8c, 0xe6, 0x55, 0x65, 0xe2, 0x99, 0x77, 0x8c, 0xe8, 0x84, 0xe6, 0xcd, 0x85, 0xe8, 0x8c,
+			0xe6, 0x99, 0x77, 0xe2, 0xcd, 0x89, 0x8c, 0xe8, 0x84, 0xe6, 0xb0, 0xe8, 0x8c, 0xe6, 0xcd, 0x89,
+			0xe2, 0x55, 0x65, 0x7c, 0xe8, 0x74, 0xe6, 0xb0, 0xe8, 0x7c, 0xe6, 0x55, 0x65, 0xe1,
+		}},
+		HardwareRouter: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x6b, 0xb0, 0x60, 0xf1, 0x69, 0xb0, 0xbd,
+			0x68, 0xcd, 0x96, 0x40, 0x89, 0x67, 0xa8, 0xe8, 0x35, 0x86, 0x60, 0x89, 0x67, 0x99, 0x84, 0xbd,
+			0x68, 0x69, 0x83, 0x40, 0x89, 0x6b, 0x35, 0x82, 0xe6, 0x55, 0x8a, 0xe8, 0x69, 0x76, 0xe6, 0x55,
+			0x8e, 0xe8, 0x35, 0x82, 0xe6, 0x45, 0x95, 0x60, 0xdd, 0x96, 0x35, 0x82, 0x11, 0x98, 0x69, 0x83,
+			0x40, 0x45, 0x99, 0x35, 0x86, 0xe8, 0xa8, 0x60, 0x45, 0x99, 0x99, 0x95, 0x11, 0x98, 0xcd, 0x96,
+			0x40, 0x45, 0x95, 0xb0, 0xe6, 0x89, 0x6b, 0xe2, 0x45, 0x95, 0xa8, 0xe8, 0x35, 0x86, 0xe6, 0x89,
+			0x6b, 0xe8, 0xa8, 0xe6, 0x45, 0x95, 0xe2, 0xbd, 0x74, 0x21, 0x8d, 0x67, 0xbd, 0x74, 0xf1, 0x8b,
+			0xed, 0x73, 0x25, 0x8b, 0x21, 0x73, 0x55, 0x8a, 0xed, 0x71, 0x55, 0x8a, 0xbd, 0x70, 0x55, 0x8a,
+			0xf1, 0x6f, 0x25, 0x8b, 0x25, 0x6f, 0xf5, 0x8b, 0x25, 0x6f, 0x25, 0x8d, 0x25, 0x6f, 0x55, 0x8e,
+			0xf1, 0x6f, 0x25, 0x8f, 0xc1, 0x70, 0xf1, 0x8f, 0xf1, 0x71, 0xf1, 0x8f, 0x25, 0x73, 0xf1, 0x8f,
+			0xf1, 0x73, 0x21, 0x8f, 0xbd, 0x74, 0x51, 0x8e, 0xbd, 0x74, 0x21, 0x8d, 0xe2, 0x99, 0x7e, 0x21,
+			0x8d, 0x67, 0x99, 0x7e, 0xf1, 0x8b, 0xcd, 0x7d, 0x25, 0x8b, 0xfd, 0x7c, 0x55, 0x8a, 0xcd, 0x7b,
+			0x55, 0x8a, 0x99, 0x7a, 0x55, 0x8a, 0xcd, 0x79, 0x25, 0x8b, 0x72, 0xf5, 0x8b, 0x72, 0x25, 0x8d,
+			0x72, 0x55, 0x8e, 0xd1, 0x79, 0x25, 0x8f, 0x9d, 0x7a, 0xf1, 0x8f, 0xd1, 0x7b, 0xf1, 0x8f, 0x7a,
+			0xf1, 0x8f, 0xcd, 0x7d, 0x21, 0x8f, 0x99, 0x7e, 0x51, 0x8e, 0x99, 0x7e, 0x21, 0x8d, 0xe2, 0xad,
+			0x85, 0xf1, 0x8f, 0x67, 0xdd, 0x86, 0xf1, 0x8f, 0xad, 0x87, 0x21, 0x8f, 0x79, 0x88, 0x51, 0x8e,
+			0x79, 0x88, 0x21, 0x8d, 0x79, 0x88, 0xf1, 0x8b, 0xa9, 0x87, 0x25, 0x8b, 0xdd, 0x86, 0x55, 0x8a,
+			0xa9, 0x85, 0x55, 0x8a, 0x79, 0x84, 0x55, 0x8a, 0xad, 0x83, 0x25, 0x8b, 0xdd, 0x82, 0xf5, 0x8b,
+			0xdd, 0x82, 0x25, 0x8d, 0xdd, 0x82, 0x55, 0x8e, 0xad, 0x83, 0x25, 0x8f, 0x7d, 0x84, 0xf1, 0x8f,
+			0xad, 0x85, 0xf1, 0x8f, 0xe2, 0x8e, 0x89, 0x73, 0x00, 0x45, 0x84, 0xcd, 0x70, 0x61, 0xdd, 0x85,
+			0x35, 0x6f, 0xe5, 0x87, 0x45, 0x6e, 0xed, 0x89, 0x55, 0x6d, 0x55, 0x8c, 0x55, 0x6d, 0x40, 0xc5,
+			0x90, 0x45, 0x6e, 0x60, 0xcd, 0x92, 0x35, 0x6f, 0x69, 0x94, 0xcd, 0x70, 0x00, 0xad, 0x91, 0x89,
+			0x73, 0x60, 0xbd, 0x90, 0x99, 0x72, 0x55, 0x8f, 0xf9, 0x71, 0x40, 0x5d, 0x8c, 0x55, 0x71, 0x60,
+			0xcd, 0x8a, 0x55, 0x71, 0x5d, 0x89, 0xf9, 0x71, 0x40, 0x8e, 0x89, 0x73, 0xe2, 0x55, 0x81, 0xdd,
+			0x6d, 0x00, 0x69, 0x7e, 0xf1, 0x6a, 0x60, 0x99, 0x80, 0xbd, 0x68, 0x45, 0x84, 0x09, 0x67, 0x40,
+			0x55, 0x8c, 0x55, 0x65, 0x60, 0xbd, 0x90, 0x55, 0x65, 0x69, 0x94, 0x09, 0x67, 0x40, 0x45, 0x9a,
+			0xf1, 0x6a, 0x00, 0x55, 0x97, 0xdd, 0x6d, 0x60, 0x99, 0x95, 0xf1, 0x6b, 0xc5, 0x92, 0xa5, 0x6a,
+			0x40, 0x55, 0x8c, 0x55, 0x69, 0x60, 0xbd, 0x88, 0x55, 0x69, 0xe9, 0x85, 0xa5, 0x6a, 0x40, 0x55,
+			0x81, 0xdd, 0x6d, 0xe2, 0x89, 0x6b, 0xa8, 0xe8, 0x35, 0x86, 0xe8, 0xa8, 0xe1,
+		}},
+		HardwareSIMCard: HardwareSIMCard,
+		HardwareScanner: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x92, 0x55, 0x81, 0x02, 0xbd, 0x69, 0x55,
+			0x71, 0x35, 0x6b, 0x55, 0x6d, 0x35, 0x94, 0x99, 0x7d, 0x60, 0x55, 0x96, 0x79, 0x7e, 0x2d, 0x97,
+			0xdd, 0x7f, 0x40, 0xb0, 0xad, 0x83, 0xe8, 0x55, 0x91, 0x60, 0xb0, 0xf1, 0x92, 0xcd, 0x96, 0x25,
+			0x94, 0x40, 0xa8, 0x55, 0x95, 0xe6, 0x58, 0x60, 0x69, 0x6a, 0x55, 0x95, 0x35, 0x69, 0x25, 0x94,
+			0x40, 0x50, 0x55, 0x91, 0xe8, 0x55, 0x85, 0x60, 0x50, 0xbd, 0x83, 0x35, 0x69, 0x89, 0x82, 0x40,
+			0x58, 0x55, 0x81, 0xe6, 0x11, 0x92, 0xe2, 0xa8, 0x55, 0x91, 0xe8, 0x55, 0x85, 0xe6, 0x58, 0xe8,
+			0x55, 0x91, 0xe6, 0xa8, 0xe2, 0x55, 0x79, 0x55, 0x8d, 0xe6, 0xa0, 0xe8, 0x55, 0x89, 0xe6, 0x55,
+			0x79, 0xe8, 0x55, 0x8d, 0xe2, 0x05, 0x72, 0x55, 0x8d, 0x67, 0xdd, 0x72, 0x55, 0x8d, 0x71, 0x73,
+			0xc1, 0x8c, 0x68, 0x2d, 0x8c, 0x68, 0x55, 0x8b, 0x68, 0x79, 0x8a, 0x6d, 0x73, 0xe9, 0x89, 0xd9,
+			0x72, 0x55, 0x89, 0xfd, 0x71, 0x55, 0x89, 0x25, 0x71, 0x55, 0x89, 0x91, 0x70, 0xe9, 0x89, 0x60,
+			0x7d, 0x8a, 0x60, 0x59, 0x8b, 0x60, 0x35, 0x8c, 0x95, 0x70, 0xc5, 0x8c, 0x29, 0x71, 0x55, 0x8d,
+			0x05, 0x72, 0x55, 0x8d, 0xe2, 0x58, 0x55, 0x91, 0xe8, 0x55, 0x85, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		HardwareSecurity: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0xad, 0x76, 0x55, 0x98,
+			0xad, 0x70, 0xd5, 0x8f, 0x40, 0xad, 0x6a, 0x35, 0x7d, 0xe8, 0x55, 0x6d, 0x01, 0x80, 0x55, 0x65,
+			0x55, 0x95, 0x55, 0x6d, 0xe8, 0x35, 0x7d, 0x60, 0x55, 0x95, 0x55, 0x87, 0x55, 0x8f, 0xd5, 0x8f,
+			0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x89, 0x96, 0x60, 0x11, 0x87, 0x35, 0x94, 0xb5, 0x8b, 0xf9,
+			0x8d, 0x40, 0x25, 0x91, 0x80, 0xe6, 0x80, 0xe8, 0xad, 0x69, 0x00, 0xad, 0x6e, 0x25, 0x70, 0xe8,
+			0x35, 0x7d, 0x60, 0xad, 0x6e, 0x7c, 0xb5, 0x6e, 0x91, 0x7e, 0x40, 0xdd, 0x6e, 0x80, 0xe6, 0x80,
+			0xe8, 0x89, 0x96, 0xe1,
+		}},
+		HardwareSmartphone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55,
+			0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d,
+			0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45,
+			0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60,
+			0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x97, 0xe6, 0x55,
+			0x71, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8,
+			0x55, 0x93, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x55,
+			0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		HardwareSpeaker: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8e, 0xad, 0x9a, 0xe6, 0x55, 0x71, 0x60,
+			0xb1, 0x6f, 0xad, 0x9a, 0x85, 0x6e, 0x7d, 0x99, 0x40, 0x55, 0x6d, 0xad, 0x96, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x6d, 0xb1, 0x67, 0x85, 0x6e, 0x85, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad,
+			0x8e, 0x60, 0x51, 0x90, 0x55, 0x65, 0x7d, 0x91, 0x85, 0x66, 0x40, 0xad, 0x92, 0x55, 0x69, 0xe8,
+			0xad, 0x96, 0x60, 0xad, 0x92, 0x51, 0x98, 0x7d, 0x91, 0x7d, 0x99, 0x40, 0xad, 0x8e, 0xad, 0x9a,
+			0xe2, 0xad, 0x8e, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x96, 0xe6, 0xad,
+			0x8e, 0xe2, 0x11, 0x80, 0xbd, 0x77, 0x60, 0x35, 0x82, 0xbd, 0x77, 0xad, 0x83, 0x45, 0x76, 0x40,
+			0x25, 0x85, 0xad, 0x72, 0x60, 0x25, 0x85, 0x89, 0x70, 0xad, 0x83, 0x11, 0x6f, 0x40, 0x11, 0x80,
+			0x99, 0x6d, 0x60, 0xf1, 0x7d, 0x99, 0x6d, 0x79, 0x7c, 0x11, 0x6f, 0x40, 0x76, 0xad, 0x72, 0x60,
+			0x76, 0xcd, 0x74, 0x79, 0x7c, 0x45, 0x76, 0x40, 0x11, 0x80, 0xbd, 0x77, 0xe2, 0x80, 0xf1, 0x92,
+			0x60, 0x99, 0x84, 0xf1, 0x92, 0xc5, 0x87, 0xc5, 0x8f, 0x40, 0xf1, 0x8a, 0x90, 0x60, 0xf1, 0x8a,
+			0x69, 0x83, 0xc5, 0x87, 0x3d, 0x80, 0x40, 0x80, 0x11, 0x7d, 0x60, 0x69, 0x7b, 0x11, 0x7d, 0x3d,
+			0x78, 0x3d, 0x80, 0x40, 0x11, 0x75, 0x90, 0x60, 0x11, 0x75, 0x99, 0x8c, 0x3d, 0x78, 0xc5, 0x8f,
+			0x40, 0x80, 0xf1, 0x92, 0xe2, 0x80, 0xf1, 0x8e, 0x60, 0x25, 0x7d, 0xf1, 0x8e, 0x19, 0x7b, 0xe5,
+			0x8c, 0x40, 0x11, 0x79, 0x90, 0x60, 0x11, 0x79, 0x25, 0x85, 0x1d, 0x7b, 0x19, 0x83, 0x40, 0x80,
+			0x11, 0x81, 0x60, 0xdd, 0x82, 0x11, 0x81, 0xe9, 0x84, 0x1d, 0x83, 0x40, 0xf1, 0x86, 0x90, 0x60,
+			0xf1, 0x86, 0xdd, 0x8a, 0xe5, 0x84, 0xe9, 0x8c, 0x40, 0x80, 0xf1, 0x8e, 0xe2, 0x55, 0x71, 0x55,
+			0x69, 0xe8, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		HardwareSpeakerGroup: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x76, 0x55, 0x91, 0xe6, 0xa8, 0xe8, 0xad,
+			0x66, 0xe6, 0x99, 0x76, 0xe8, 0x55, 0x91, 0xe2, 0x99, 0x76, 0x55, 0x95, 0x60, 0x11, 0x75, 0x55,
+			0x95, 0xdd, 0x73, 0x19, 0x94, 0x40, 0xad, 0x72, 0x55, 0x91, 0xe8, 0xad, 0x66, 0x60, 0xad, 0x72,
+			0x25, 0x65, 0xdd, 0x73, 0xe9, 0x63, 0x40, 0x99, 0x76, 0xad, 0x62, 0xe6, 0xa8, 0x60, 0x89, 0x95,
+			0xad, 0x62, 0xc5, 0x96, 0xe9, 0x63, 0x40, 0xb0, 0xad, 0x66, 0xe8, 0x55, 0x91, 0x60, 0xb0, 0xdd,
+			0x92, 0xc5, 0x96, 0x19, 0x94, 0x40, 0xa8, 0x55, 0x95, 0xe6, 0x99, 0x76, 0xe2, 0x55, 0x85, 0xcd,
+			0x75, 0x60, 0x95, 0x87, 0xcd, 0x75, 0x29, 0x89, 0x45, 0x74, 0x40, 0xbd, 0x8a, 0x69, 0x70, 0x65,
+			0xbd, 0x8a, 0x35, 0x6e, 0x29, 0x89, 0xad, 0x6c, 0x95, 0x87, 0x25, 0x6b, 0x55, 0x85, 0x25, 0x6b,
+			0x25, 0x83, 0x25, 0x6b, 0x9d, 0x81, 0xad, 0x6c, 0x11, 0x80, 0x35, 0x6e, 0x11, 0x80, 0x69, 0x70,
+			0x11, 0x80, 0xbd, 0x72, 0x9d, 0x81, 0x45, 0x74, 0x25, 0x83, 0xcd, 0x75, 0x55, 0x85, 0xcd, 0x75,
+			0xe2, 0x69, 0x85, 0xdd, 0x8c, 0x61, 0x39, 0x89, 0xdd, 0x8c, 0xe9, 0x8b, 0x2d, 0x8a, 0x99, 0x8e,
+			0x79, 0x87, 0x99, 0x8e, 0x89, 0x83, 0x40, 0xe9, 0x8b, 0xe9, 0x7c, 0x62, 0x39, 0x89, 0x35, 0x7a,
+			0x69, 0x85, 0x35, 0x7a, 0x7d, 0x81, 0x35, 0x7a, 0xb5, 0x7e, 0xe9, 0x7c, 0xf1, 0x7b, 0x99, 0x7f,
+			0xf1, 0x7b, 0x89, 0x83, 0x40, 0xb5, 0x7e, 0x2d, 0x8a, 0x60, 0x7d, 0x81, 0xdd, 0x8c, 0x69, 0x85,
+			0xdd, 0x8c, 0xe2, 0x3d, 0x85, 0xdd, 0x88, 0x62, 0x86, 0xdd, 0x88, 0x79, 0x81, 0x51, 0x87, 0xf1,
+			0x7f, 0xc5, 0x85, 0xf1, 0x7f, 0x8d, 0x83, 0xf1, 0x7f, 0x55, 0x81, 0x79, 0x81, 0xc5, 0x7f, 0x40,
+			0x3d, 0x85, 0x35, 0x7e, 0x63, 0x79, 0x87, 0x35, 0x7e, 0x09, 0x89, 0xc1, 0x7f, 0x99, 0x8a, 0x4d,
+			0x81, 0x99, 0x8a, 0x85, 0x83, 0x99, 0x8a, 0xbd, 0x85, 0x09, 0x89, 0x4d, 0x87, 0x79, 0x87, 0xdd,
+			0x88, 0x3d, 0x85, 0xdd, 0x88, 0xe2, 0x99, 0x82, 0x55, 0x9d, 0xe6, 0xad, 0x6e, 0x60, 0x25, 0x6d,
+			0x55, 0x9d, 0xe9, 0x6b, 0x19, 0x9c, 0x40, 0xad, 0x6a, 0x55, 0x99, 0xe8, 0xad, 0x6a, 0xe6, 0xad,
+			0x6e, 0xe8, 0x55, 0x99, 0xe6, 0x99, 0x82, 0xe8, 0x55, 0x9d, 0xe2, 0x99, 0x76, 0xad, 0x66, 0xe8,
+			0x55, 0x91, 0xe8, 0xad, 0x66, 0xe1,
+		}},
+		HardwareTV: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x6c, 0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x69,
+			0x60, 0xbd, 0x67, 0xad, 0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e, 0xe8, 0x58,
+			0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x94, 0xe8,
+			0xb0, 0xe6, 0x6c, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69,
+			0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		HardwareTablet: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x66, 0x55, 0x95, 0x60, 0x05, 0x65, 0x55,
+			0x95, 0xd9, 0x63, 0x29, 0x94, 0x40, 0xad, 0x62, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0xad, 0x62,
+			0x05, 0x6d, 0xd9, 0x63, 0xd9, 0x6b, 0x40, 0xad, 0x66, 0xad, 0x6a, 0xe6, 0x55, 0x99, 0x60, 0xfd,
+			0x9a, 0xad, 0x6a, 0x29, 0x9c, 0xd9, 0x6b, 0x40, 0x55, 0x9d, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0x55, 0x9d, 0xfd, 0x92, 0x29, 0x9c, 0x29, 0x94, 0x40, 0x55, 0x99, 0x55, 0x95, 0xe6, 0xad, 0x66,
+			0xe2, 0xad, 0x68, 0xad, 0x6e, 0xe6, 0xad, 0x66, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x68, 0xe8, 0xad,
+			0x6e, 0xe2, 0xad, 0x6c, 0x55, 0x91, 0xe6, 0x55, 0x93, 0xe8, 0xad, 0x6e, 0xe6, 0xad, 0x6c, 0xe8,
+			0x55, 0x91, 0xe2, 0x55, 0x97, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe6, 0x55, 0x99, 0xe8, 0xad, 0x6e,
+			0xe6, 0x55, 0x97, 0xe2, 0x55, 0x97, 0xad, 0x6e, 0xe6, 0x55, 0x99, 0xe6, 0x55, 0x97, 0xe2, 0xad,
+			0x68, 0xad, 0x6e, 0xe6, 0xad, 0x66, 0xe6, 0xad, 0x68, 0xe1,
+		}},
+		HardwareTabletAndroid: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x55, 0x9d, 0x60, 0x59, 0x6a, 0x55, 0x9d,
+			0x2d, 0x69, 0x29, 0x9c, 0x40, 0x50, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x50, 0x05, 0x65, 0x2d,
+			0x69, 0xd9, 0x63, 0x40, 0x58, 0xad, 0x62, 0xe6, 0xa8, 0x60, 0xa9, 0x95, 0xad, 0x62, 0xd5, 0x96,
+			0xd9, 0x63, 0x40, 0xb0, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60, 0xb0, 0xfd, 0x9a, 0xd5, 0x96, 0x29,
+			0x9c, 0x40, 0xa8, 0x55, 0x9d, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe6, 0xa8,
+			0xe8, 0x55, 0x93, 0xe6, 0x58, 0xe2, 0x89, 0x7a, 0xad, 0x97, 0xe6, 0x79, 0x85, 0xe8, 0xaa, 0xe6,
+			0x89, 0x7a, 0xe8, 0xad, 0x97, 0xe2, 0x58, 0x55, 0x8f, 0xe6, 0xa8, 0xe8, 0xad, 0x6c, 0xe6, 0x58,
+			0xe8, 0x55, 0x8f, 0xe2, 0x58, 0xad, 0x68, 0xe6, 0xa8, 0xe8, 0xad, 0x66, 0xe6, 0x58, 0xe8, 0xad,
+			0x68, 0xe2, 0x58, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x58, 0x55, 0x93, 0xe8,
+			0x55, 0x99, 0xe8, 0x55, 0x93, 0xe1,
+		}},
+		HardwareTabletMac: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x05, 0x80, 0x55, 0x98, 0x67, 0xdd, 0x80, 0x55,
+			0x98, 0x71, 0x81, 0xc1, 0x97, 0x84, 0x2d, 0x97, 0x84, 0x55, 0x96, 0x84, 0x79, 0x95, 0x6d, 0x81,
+			0xe9, 0x94, 0xd9, 0x80, 0x55, 0x94, 0xfd, 0x7f, 0x55, 0x94, 0x25, 0x7f, 0x55, 0x94, 0x91, 0x7e,
+			0xe9, 0x94, 0x7c, 0x7d, 0x95, 0x7c, 0x59, 0x96, 0x7c, 0x35, 0x97, 0x95, 0x7e, 0xc5, 0x97, 0x29,
+			0x7f, 0x55, 0x98, 0x05, 0x80, 0x55, 0x98, 0xe2, 0x58, 0x55, 0x9d, 0x60, 0x59, 0x6a, 0x55, 0x9d,
+			0x2d, 0x69, 0x29, 0x9c, 0x40, 0x50, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x50, 0x05, 0x65, 0x2d,
+			0x69, 0xd9, 0x63, 0x40, 0x58, 0xad, 0x62, 0xe6, 0xa8, 0x60, 0xa9, 0x95, 0xad, 0x62, 0xd5, 0x96,
+			0xd9, 0x63, 0x40, 0xb0, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60, 0xb0, 0xfd, 0x9a, 0xd5, 0x96, 0x29,
+			0x9c, 0x40, 0xa8, 0x55, 0x9d, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x93, 0xe8, 0x55, 0x99, 0xe6, 0xa8,
+			0xe8, 0x55, 0x93, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x8f, 0xe6, 0xa8, 0xe8, 0xad, 0x6c, 0xe6, 0x58,
+			0xe8, 0x55, 0x8f, 0xe2, 0x58, 0xad, 0x68, 0xe6, 0xa8, 0xe8, 0xad, 0x66, 0xe6, 0x58, 0xe8, 0xad,
+			0x68, 0xe2, 0x58, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8, 0xad, 0x68, 0xe2, 0x58, 0x55, 0x93, 0xe8,
+			0x55, 0x99, 0xe8, 0x55, 0x93, 0xe1,
+		}},
+		HardwareToys: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x72, 0x55, 0x95, 0x60, 0x69, 0x6f, 0x55,
+			0x95, 0x19, 0x6d, 0x19, 0x93, 0x40, 0x99, 0x6a, 0xad, 0x8d, 0x60, 0x25, 0x68, 0x25, 0x8c, 0xc5,
+			0x66, 0xc5, 0x89, 0x40, 0x69, 0x65, 0x99, 0x84, 0x60, 0x69, 0x65, 0x25, 0x81, 0x71, 0x67, 0x5d,
+			0x7e, 0x40, 0xcd, 0x6c, 0xdd, 0x7a, 0x01, 0x69, 0x67, 0x35, 0x75, 0x25, 0x66, 0x89, 0x76, 0x60,
+			0x89, 0x65, 0x35, 0x77, 0xb5, 0x64, 0x35, 0x77, 0x40, 0x45, 0x63, 0x91, 0x76, 0x60, 0xad, 0x62,
+			0xf1, 0x75, 0xad, 0x62, 0x25, 0x75, 0x40, 0x45, 0x63, 0xad, 0x73, 0x00, 0xad, 0x68, 0x11, 0x6e,
+			0x60, 0x45, 0x69, 0x79, 0x6d, 0x19, 0x6a, 0x79, 0x6d, 0x40, 0x89, 0x6b, 0x19, 0x6e, 0x60, 0x25,
+			0x6c, 0xbd, 0x6e, 0x25, 0x6c, 0x89, 0x6f, 0x40, 0x89, 0x6b, 0xf1, 0x70, 0x02, 0x45, 0x6a, 0x55,
+			0x72, 0x11, 0x6f, 0x79, 0x77, 0xad, 0x71, 0xad, 0x6f, 0x61, 0x69, 0x72, 0x79, 0x6d, 0x3d, 0x74,
+			0x11, 0x6c, 0x11, 0x76, 0xad, 0x6a, 0x55, 0x78, 0xad, 0x6a, 0xe6, 0xcd, 0x87, 0x61, 0x19, 0x8a,
+			0xad, 0x6a, 0xf5, 0x8b, 0x11, 0x6c, 0xcd, 0x8d, 0x79, 0x6d, 0x79, 0x8e, 0xad, 0x6f, 0x00, 0xcd,
+			0x91, 0xbd, 0x7a, 0x60, 0x99, 0x95, 0x11, 0x7b, 0x25, 0x98, 0xd5, 0x7d, 0x40, 0xad, 0x9a, 0x99,
+			0x84, 0x62, 0xad, 0x9a, 0x69, 0x87, 0x4d, 0x99, 0xc5, 0x89, 0xf1, 0x97, 0x25, 0x8c, 0x79, 0x95,
+			0xad, 0x8d, 0x45, 0x95, 0xdd, 0x90, 0xf9, 0x92, 0x19, 0x93, 0x40, 0xad, 0x8d, 0x55, 0x95, 0x60,
+			0xdd, 0x8a, 0x55, 0x95, 0xc5, 0x88, 0x69, 0x93, 0x40, 0x25, 0x86, 0xad, 0x8e, 0xe6, 0xf1, 0x79,
+			0x60, 0x69, 0x79, 0x79, 0x91, 0x4d, 0x77, 0x69, 0x93, 0x40, 0x69, 0x72, 0x55, 0x95, 0xe2, 0x45,
+			0x72, 0x99, 0x7a, 0xe6, 0xf1, 0x7d, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x78, 0x60, 0x5d, 0x77, 0xad,
+			0x6e, 0x9d, 0x76, 0x4d, 0x6f, 0x40, 0x89, 0x75, 0xdd, 0x70, 0x00, 0x45, 0x72, 0x99, 0x7a, 0xe2,
+			0xf1, 0x81, 0x99, 0x7a, 0xe6, 0x99, 0x8d, 0x00, 0x9d, 0x8a, 0xe5, 0x70, 0x61, 0x45, 0x8a, 0xf1,
+			0x6f, 0x85, 0x89, 0x4d, 0x6f, 0xc5, 0x88, 0xad, 0x6e, 0xcd, 0x87, 0xad, 0x6e, 0xe6, 0xf1, 0x81,
+			0xe8, 0x99, 0x7a, 0xe2, 0xf1, 0x79, 0xad, 0x8a, 0xe6, 0x25, 0x86, 0x60, 0xdd, 0x86, 0x45, 0x88,
+			0x92, 0xcd, 0x86, 0x40, 0xad, 0x8d, 0x55, 0x85, 0x60, 0xcd, 0x8f, 0x55, 0x85, 0x99, 0x91, 0x79,
+			0x86, 0x40, 0x89, 0x94, 0x69, 0x89, 0x60, 0xad, 0x95, 0x89, 0x88, 0x2d, 0x96, 0x3d, 0x87, 0x40,
+			0xad, 0x96, 0x99, 0x84, 0x60, 0xad, 0x96, 0x11, 0x82, 0xf1, 0x94, 0x55, 0x80, 0x40, 0xad, 0x90,
+			0x99, 0x7e, 0xe6, 0x69, 0x6f, 0x60, 0xdd, 0x6c, 0x99, 0x7e, 0x25, 0x6b, 0x55, 0x80, 0x40, 0x69,
+			0x69, 0x99, 0x84, 0x60, 0x69, 0x69, 0xf1, 0x85, 0xf1, 0x69, 0x3d, 0x87, 0x40, 0x89, 0x6b, 0x69,
+			0x89, 0x62, 0x99, 0x6c, 0x79, 0x87, 0x71, 0x6e, 0x69, 0x86, 0x49, 0x70, 0x55, 0x85, 0x69, 0x72,
+			0x55, 0x85, 0xf1, 0x74, 0x55, 0x85, 0x11, 0x77, 0xcd, 0x86, 0x40, 0xf1, 0x79, 0xad, 0x8a, 0xe2,
+			0x69, 0x72, 0x55, 0x91, 0x60, 0x19, 0x74, 0x55, 0x91, 0x41, 0x75, 0x31, 0x90, 0x40, 0x69, 0x76,
+			0x55, 0x8d, 0x60, 0x69, 0x76, 0xa5, 0x8b, 0x41, 0x75, 0x7d, 0x8a, 0x40, 0x69, 0x72, 0x55, 0x89,
+			0x60, 0xb5, 0x70, 0x55, 0x89, 0x8d, 0x6f, 0x7d, 0x8a, 0x40, 0x69, 0x6e, 0x55, 0x8d, 0x60, 0x69,
+			0x6e, 0x09, 0x8f, 0x8d, 0x6f, 0x31, 0x90, 0x40, 0x69, 0x72, 0x55, 0x91, 0xe2, 0xad, 0x8d, 0x55,
+			0x91, 0x60, 0x5d, 0x8f, 0x55, 0x91, 0x85, 0x90, 0x31, 0x90, 0x40, 0xad, 0x91, 0x55, 0x8d, 0x60,
+			0xad, 0x91, 0xa5, 0x8b, 0x85, 0x90, 0x7d, 0x8a, 0x40, 0xad, 0x8d, 0x55, 0x89, 0x60, 0xf9, 0x8b,
+			0x55, 0x89, 0xd1, 0x8a, 0x7d, 0x8a, 0x40, 0xad, 0x89, 0x55, 0x8d, 0x60, 0xad, 0x89, 0x09, 0x8f,
+			0xd1, 0x8a, 0x31, 0x90, 0x40, 0xad, 0x8d, 0x55, 0x91, 0xe2, 0x11, 0x80, 0x99, 0x84, 0xe1,
+		}},
+		HardwareVideogameAsset: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xa0, 0x60, 0xbd, 0x67, 0xa0, 0x89,
+			0x66, 0xcd, 0x8e, 0x40, 0x55, 0x65, 0x98, 0xe8, 0x68, 0x60, 0x55, 0x65, 0x69, 0x72, 0x89, 0x66,
+			0x35, 0x71, 0x40, 0x55, 0x69, 0x60, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x60, 0x79, 0x99, 0x35,
+			0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e,
+			0x40, 0xad, 0x96, 0xa0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x68,
+			0xe6, 0x55, 0x69, 0xe8, 0x98, 0xe2, 0x55, 0x73, 0xf1, 0x88, 0xe6, 0x55, 0x77, 0xe8, 0x84, 0xe6,
+			0x45, 0x7e, 0xe8, 0x7c, 0xe6, 0x55, 0x77, 0xe8, 0x11, 0x77, 0xe6, 0x55, 0x73, 0xe8, 0x7c, 0xe6,
+			0x69, 0x6c, 0xe8, 0x84, 0xe6, 0x55, 0x73, 0xe8, 0xf1, 0x88, 0xe2, 0x89, 0x87, 0xbd, 0x87, 0x60,
+			0xbd, 0x88, 0xbd, 0x87, 0xa5, 0x89, 0xd5, 0x86, 0x40, 0x89, 0x8a, 0xbd, 0x84, 0x60, 0x89, 0x8a,
+			0x89, 0x83, 0xa5, 0x89, 0xa5, 0x82, 0x40, 0x89, 0x87, 0xbd, 0x81, 0x60, 0x55, 0x86, 0xbd, 0x81,
+			0x71, 0x85, 0xa5, 0x82, 0x40, 0x89, 0x84, 0xbd, 0x84, 0x60, 0x89, 0x84, 0xf1, 0x85, 0x71, 0x85,
+			0xd5, 0x86, 0x40, 0x89, 0x87, 0xbd, 0x87, 0xe2, 0x89, 0x8d, 0x35, 0x7e, 0x60, 0xbd, 0x8e, 0x35,
+			0x7e, 0xa5, 0x8f, 0x4d, 0x7d, 0x40, 0x89, 0x90, 0x35, 0x7b, 0x60, 0x89, 0x90, 0x74, 0xa5, 0x8f,
+			0x19, 0x79, 0x40, 0x89, 0x8d, 0x35, 0x78, 0x60, 0x55, 0x8c, 0x35, 0x78, 0x71, 0x8b, 0x19, 0x79,
+			0x40, 0x89, 0x8a, 0x35, 0x7b, 0x60, 0x89, 0x8a, 0x69, 0x7c, 0x71, 0x8b, 0x4d, 0x7d, 0x40, 0x89,
+			0x8d, 0x35, 0x7e, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x68, 0xe8, 0x98, 0xe1,
+		}},
+		HardwareWatch: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x78, 0xad, 0x9a, 0x00, 0x45, 0x75, 0x45,
+			0x8f, 0x60, 0x99, 0x71, 0xf1, 0x8c, 0x79, 0x6f, 0xf1, 0x88, 0x40, 0x55, 0x6d, 0x80, 0x60, 0x55,
+			0x6d, 0x11, 0x7b, 0x79, 0x6f, 0x09, 0x77, 0x40, 0x45, 0x75, 0xbd, 0x70, 0x00, 0x69, 0x78, 0x55,
+			0x65, 0xe6, 0x99, 0x87, 0x00, 0xbd, 0x8a, 0xbd, 0x70, 0x60, 0x69, 0x8e, 0x66, 0x89, 0x90, 0x09,
+			0x77, 0x40, 0xad, 0x92, 0x80, 0x60, 0xad, 0x92, 0xf1, 0x84, 0x89, 0x90, 0xf1, 0x88, 0x40, 0xbd,
+			0x8a, 0x45, 0x8f, 0x00, 0x99, 0x87, 0xad, 0x9a, 0xe6, 0x69, 0x78, 0xe2, 0x80, 0xad, 0x8e, 0x60,
+			0x25, 0x86, 0xad, 0x8e, 0x69, 0x8a, 0x69, 0x8a, 0x40, 0xad, 0x8e, 0x80, 0x60, 0xad, 0x8e, 0xdd,
+			0x79, 0x69, 0x8a, 0x99, 0x75, 0x40, 0x80, 0x55, 0x71, 0x60, 0xdd, 0x79, 0x55, 0x71, 0x99, 0x75,
+			0x99, 0x75, 0x40, 0x55, 0x71, 0x80, 0x60, 0x55, 0x71, 0x25, 0x86, 0x99, 0x75, 0x69, 0x8a, 0x40,
+			0x80, 0xad, 0x8e, 0xe2, 0x35, 0x7a, 0x69, 0x6e, 0x60, 0xbd, 0x7b, 0xf1, 0x6d, 0x2d, 0x7d, 0xb5,
+			0x6d, 0x40, 0x80, 0x79, 0x6d, 0x60, 0x69, 0x81, 0x79, 0x6d, 0xd5, 0x82, 0xb5, 0x6d, 0x40, 0xcd,
+			0x85, 0x69, 0x6e, 0x00, 0x99, 0x84, 0x55, 0x69, 0xe6, 0x69, 0x7b, 0x00, 0x35, 0x7a, 0x69, 0x6e,
+			0xe2, 0x69, 0x7b, 0xad, 0x96, 0xe6, 0x99, 0x84, 0x00, 0xcd, 0x85, 0x99, 0x91, 0x60, 0x45, 0x84,
+			0xa4, 0xd5, 0x82, 0x3d, 0x92, 0x40, 0x80, 0x79, 0x92, 0x60, 0x99, 0x7e, 0x79, 0x92, 0x2d, 0x7d,
+			0x3d, 0x92, 0x40, 0x35, 0x7a, 0x99, 0x91, 0x00, 0x69, 0x7b, 0xad, 0x96, 0xe2, 0x35, 0x7a, 0x55,
+			0x69, 0xe6, 0xcd, 0x85, 0xe6, 0x35, 0x7a, 0xe2, 0x69, 0x7b, 0xad, 0x96, 0xe6, 0x35, 0x7a, 0xe6,
+			0xcd, 0x85, 0xe6, 0x69, 0x7b, 0xe1,
+		}},
+		ImageAddAPhoto: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x93, 0x69, 0x74, 0xe8, 0xad, 0x6e, 0xe6,
+			0xcd, 0x8d, 0xe8, 0xad, 0x6a, 0xe6, 0x89, 0x93, 0xe8, 0xdd, 0x64, 0xe6, 0x89, 0x97, 0xe8, 0xad,
+			0x6a, 0xe6, 0x55, 0x9d, 0xe8, 0xad, 0x6e, 0xe6, 0x89, 0x97, 0xe8, 0x69, 0x74, 0xe6, 0x89, 0x93,
+			0xe2, 0x45, 0x66, 0xad, 0x9a, 0x60, 0xad, 0x64, 0xad, 0x9a, 0x79, 0x63, 0x79, 0x99, 0x40, 0x45,
+			0x62, 0xad, 0x96, 0xe8, 0x79, 0x74, 0x60, 0x45, 0x62, 0xf1, 0x72, 0x79, 0x63, 0xb5, 0x71, 0x40,
+			0x45, 0x66, 0x79, 0x70, 0xe6, 0x11, 0x70, 0x00, 0xf1, 0x74, 0xad, 0x6a, 0xe6, 0x99, 0x87, 0xe8,
+			0xad, 0x6e, 0xe6, 0xcd, 0x76, 0x00, 0xf1, 0x71, 0x79, 0x74, 0xe6, 0x45, 0x66, 0xe8, 0xad, 0x96,
+			0xe6, 0x99, 0x93, 0xe8, 0xad, 0x7a, 0xe6, 0x99, 0x97, 0xe8, 0xad, 0x96, 0x60, 0x99, 0x97, 0x45,
+			0x98, 0x5d, 0x96, 0x79, 0x99, 0x40, 0x99, 0x93, 0xad, 0x9a, 0xe6, 0x45, 0x66, 0xe2, 0xe9, 0x7c,
+			0xf1, 0x90, 0x60, 0xbd, 0x81, 0xf1, 0x90, 0x8a, 0xad, 0x8d, 0x40, 0x45, 0x88, 0x91, 0x85, 0x60,
+			0x45, 0x88, 0xbd, 0x80, 0x8a, 0x81, 0x7d, 0x40, 0xe9, 0x7c, 0x45, 0x7a, 0x60, 0x11, 0x78, 0x45,
+			0x7a, 0xd5, 0x74, 0x81, 0x7d, 0x40, 0x99, 0x71, 0x91, 0x85, 0x60, 0x99, 0x71, 0x69, 0x8a, 0xd5,
+			0x74, 0xad, 0x8d, 0x40, 0xe9, 0x7c, 0xf1, 0x90, 0xe2, 0xe9, 0x7c, 0xf1, 0x8c, 0x60, 0xbd, 0x79,
+			0xf1, 0x8c, 0xad, 0x77, 0xd5, 0x8a, 0x40, 0x99, 0x75, 0x91, 0x85, 0x60, 0x99, 0x75, 0x69, 0x82,
+			0xad, 0x77, 0x55, 0x80, 0x40, 0xe9, 0x7c, 0x45, 0x7e, 0x60, 0x11, 0x80, 0x45, 0x7e, 0x2d, 0x82,
+			0x55, 0x80, 0x40, 0x45, 0x84, 0x91, 0x85, 0x60, 0x45, 0x84, 0xbd, 0x88, 0x2d, 0x82, 0xd5, 0x8a,
+			0x40, 0xe9, 0x7c, 0xf1, 0x8c, 0xe2, 0xf1, 0x7c, 0x99, 0x85, 0xe1,
+		}},
+		ImageAddToPhotos: ImageAddToPhotos,
+		ImageAdjust: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x05, 0x80, 0xad, 0x86, 0x67, 0xcd, 0x82, 0xad,
+			0x86, 0xbd, 0x84, 0xb9, 0x84, 0xad, 0x86, 0xc5, 0x82, 0xad, 0x86, 0xfd, 0x7f, 0xad, 0x86, 0x35,
+			0x7d, 0xb9, 0x84, 0x45, 0x7b, 0xc5, 0x82, 0x55, 0x79, 0xfd, 0x7f, 0x55, 0x79, 0x35, 0x7d, 0x55,
+			0x79, 0x45, 0x7b, 0x49, 0x7b, 0x55, 0x79, 0x3d, 0x7d, 0x55, 0x79, 0x05, 0x80, 0x55, 0x79, 0xcd,
+			0x82, 0x49, 0x7b, 0xbd, 0x84, 0x3d, 0x7d, 0xad, 0x86, 0x05, 0x80, 0xad, 0x86, 0xe2, 0x05, 0x80,
+			0xad, 0x9a, 0x60, 0x81, 0x7a, 0xad, 0x9a, 0xa9, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92,
+			0x63, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x59, 0x8a, 0x55, 0x65, 0x7d, 0x85, 0x55, 0x65, 0xf9,
+			0x7f, 0x55, 0x65, 0x75, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x89, 0x69, 0xbd, 0x70, 0x2d, 0x6d, 0x25,
+			0x6d, 0x40, 0xa9, 0x75, 0x71, 0x67, 0x62, 0x85, 0x7a, 0x55, 0x65, 0x09, 0x80, 0x55, 0x65, 0x8d,
+			0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x45, 0x8f, 0x89, 0x69, 0xdd, 0x92, 0x25, 0x6d, 0x40,
+			0x91, 0x98, 0x99, 0x75, 0x61, 0xad, 0x9a, 0x79, 0x7a, 0xad, 0x9a, 0xfd, 0x7f, 0xad, 0x9a, 0x81,
+			0x85, 0x91, 0x98, 0x59, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x61, 0x45, 0x8f, 0x71, 0x96, 0x69,
+			0x8a, 0x8d, 0x98, 0x89, 0x85, 0xad, 0x9a, 0x05, 0x80, 0xad, 0x9a, 0xe2, 0x09, 0x80, 0xad, 0x96,
+			0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0xf9, 0x7f, 0x64, 0xad,
+			0x96, 0x89, 0x76, 0x15, 0x90, 0xf1, 0x6f, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x99, 0x76,
+			0x55, 0x69, 0xf9, 0x6f, 0xed, 0x6f, 0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x55, 0x69, 0x69,
+			0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x09, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageAssistant:      ImageAssistant,
+		ImageAssistantPhoto: ImageAssistantPhoto,
+		ImageAudiotrack:     ImageAudiotrack,
+		ImageBlurCircular: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x7a, 0x11, 0x7d, 0x61, 0x95, 0x7b, 0x11,
+			0x7d, 0x51, 0x7c, 0x51, 0x7c, 0x11, 0x7d, 0x95, 0x7b, 0x11, 0x7d, 0x79, 0x7a, 0x40, 0x51, 0x7c,
+			0x9d, 0x78, 0x62, 0x95, 0x7b, 0xdd, 0x77, 0x79, 0x7a, 0xdd, 0x77, 0x5d, 0x79, 0xdd, 0x77, 0x9d,
+			0x78, 0x9d, 0x78, 0xdd, 0x77, 0x5d, 0x79, 0xdd, 0x77, 0x79, 0x7a, 0x40, 0x9d, 0x78, 0x51, 0x7c,
+			0x60, 0x5d, 0x79, 0x11, 0x7d, 0x79, 0x7a, 0x11, 0x7d, 0xe2, 0x79, 0x7a, 0x35, 0x88, 0x67, 0x95,
+			0x7b, 0x35, 0x88, 0x51, 0x7c, 0x75, 0x87, 0x11, 0x7d, 0xb5, 0x86, 0x11, 0x7d, 0x99, 0x85, 0x11,
+			0x7d, 0x81, 0x84, 0x51, 0x7c, 0xc1, 0x83, 0x95, 0x7b, 0x86, 0x79, 0x7a, 0x86, 0x5d, 0x79, 0x86,
+			0x9d, 0x78, 0xc1, 0x83, 0xdd, 0x77, 0x81, 0x84, 0xdd, 0x77, 0x99, 0x85, 0xdd, 0x77, 0xb5, 0x86,
+			0x9d, 0x78, 0x75, 0x87, 0x5d, 0x79, 0x35, 0x88, 0x79, 0x7a, 0x35, 0x88, 0xe2, 0xdd, 0x71, 0x11,
+			0x7c, 0x67, 0x81, 0x72, 0x11, 0x7c, 0xfd, 0x72, 0x99, 0x7b, 0x79, 0x73, 0x1d, 0x7b, 0x79, 0x73,
+			0x79, 0x7a, 0x79, 0x73, 0xd5, 0x79, 0xfd, 0x72, 0x59, 0x79, 0x81, 0x72, 0xdd, 0x78, 0xdd, 0x71,
+			0xdd, 0x78, 0x3d, 0x71, 0xdd, 0x78, 0xc1, 0x70, 0x59, 0x79, 0x45, 0x70, 0xd5, 0x79, 0x45, 0x70,
+			0x79, 0x7a, 0x45, 0x70, 0x1d, 0x7b, 0xc1, 0x70, 0x99, 0x7b, 0x3d, 0x71, 0x11, 0x7c, 0xdd, 0x71,
+			0x11, 0x7c, 0xe2, 0x71, 0x7a, 0xcd, 0x8f, 0x67, 0x11, 0x7b, 0xcd, 0x8f, 0x91, 0x7b, 0x51, 0x8f,
+			0x11, 0x7c, 0xd9, 0x8e, 0x11, 0x7c, 0x35, 0x8e, 0x11, 0x7c, 0x91, 0x8d, 0x95, 0x7b, 0x15, 0x8d,
+			0x19, 0x7b, 0x99, 0x8c, 0x75, 0x7a, 0x99, 0x8c, 0xcd, 0x79, 0x99, 0x8c, 0x55, 0x79, 0x15, 0x8d,
+			0xdd, 0x78, 0x91, 0x8d, 0xdd, 0x78, 0x35, 0x8e, 0xdd, 0x78, 0xd9, 0x8e, 0x59, 0x79, 0x51, 0x8f,
+			0xd1, 0x79, 0xcd, 0x8f, 0x71, 0x7a, 0xcd, 0x8f, 0xe2, 0xdd, 0x71, 0x35, 0x87, 0x67, 0x81, 0x72,
+			0x35, 0x87, 0xfd, 0x72, 0xb9, 0x86, 0x79, 0x73, 0x3d, 0x86, 0x79, 0x73, 0x99, 0x85, 0x79, 0x73,
+			0xf5, 0x84, 0xfd, 0x72, 0x7d, 0x84, 0x81, 0x72, 0x88, 0xdd, 0x71, 0x88, 0x3d, 0x71, 0x88, 0xc1,
+			0x70, 0x7d, 0x84, 0x45, 0x70, 0xf5, 0x84, 0x45, 0x70, 0x99, 0x85, 0x45, 0x70, 0x3d, 0x86, 0xc1,
+			0x70, 0xb9, 0x86, 0x3d, 0x71, 0x35, 0x87, 0xdd, 0x71, 0x35, 0x87, 0xe2, 0x79, 0x7a, 0x79, 0x73,
+			0x67, 0x1d, 0x7b, 0x79, 0x73, 0x99, 0x7b, 0xfd, 0x72, 0x11, 0x7c, 0x81, 0x72, 0x11, 0x7c, 0xdd,
+			0x71, 0x11, 0x7c, 0x3d, 0x71, 0x99, 0x7b, 0xc1, 0x70, 0x1d, 0x7b, 0x45, 0x70, 0x79, 0x7a, 0x45,
+			0x70, 0xd5, 0x79, 0x45, 0x70, 0x59, 0x79, 0xc1, 0x70, 0xdd, 0x78, 0x3d, 0x71, 0xdd, 0x78, 0xdd,
+			0x71, 0xdd, 0x78, 0x81, 0x72, 0x59, 0x79, 0xfd, 0x72, 0xd5, 0x79, 0x79, 0x73, 0x79, 0x7a, 0x79,
+			0x73, 0xe2, 0x99, 0x85, 0x11, 0x7d, 0x61, 0xb5, 0x86, 0x11, 0x7d, 0x75, 0x87, 0x51, 0x7c, 0x35,
+			0x88, 0x95, 0x7b, 0x35, 0x88, 0x79, 0x7a, 0x40, 0x75, 0x87, 0x9d, 0x78, 0x60, 0xb5, 0x86, 0xdd,
+			0x77, 0x99, 0x85, 0xdd, 0x77, 0x40, 0xc1, 0x83, 0x9d, 0x78, 0x60, 0x86, 0x5d, 0x79, 0x86, 0x79,
+			0x7a, 0x40, 0xc1, 0x83, 0x51, 0x7c, 0x60, 0x81, 0x84, 0x11, 0x7d, 0x99, 0x85, 0x11, 0x7d, 0xe2,
+			0x99, 0x85, 0x79, 0x73, 0x67, 0x3d, 0x86, 0x79, 0x73, 0xb9, 0x86, 0xfd, 0x72, 0x35, 0x87, 0x81,
+			0x72, 0x35, 0x87, 0xdd, 0x71, 0x35, 0x87, 0x3d, 0x71, 0xb9, 0x86, 0xc1, 0x70, 0x3d, 0x86, 0x45,
+			0x70, 0x99, 0x85, 0x45, 0x70, 0xf5, 0x84, 0x45, 0x70, 0x7d, 0x84, 0xc1, 0x70, 0x88, 0x3d, 0x71,
+			0x88, 0xdd, 0x71, 0x88, 0x81, 0x72, 0x7d, 0x84, 0xfd, 0x72, 0xf5, 0x84, 0x79, 0x73, 0x99, 0x85,
+			0x79, 0x73, 0xe2, 0x35, 0x8e, 0x35, 0x87, 0x67, 0xd9, 0x8e, 0x35, 0x87, 0x51, 0x8f, 0xb9, 0x86,
+			0xcd, 0x8f, 0x3d, 0x86, 0xcd, 0x8f, 0x99, 0x85, 0xcd, 0x8f, 0xf5, 0x84, 0x51, 0x8f, 0x7d, 0x84,
+			0xd9, 0x8e, 0x88, 0x35, 0x8e, 0x88, 0x91, 0x8d, 0x88, 0x15, 0x8d, 0x7d, 0x84, 0x99, 0x8c, 0xf5,
+			0x84, 0x99, 0x8c, 0x99, 0x85, 0x99, 0x8c, 0x3d, 0x86, 0x15, 0x8d, 0xb9, 0x86, 0x91, 0x8d, 0x35,
+			0x87, 0x35, 0x8e, 0x35, 0x87, 0xe2, 0x35, 0x8e, 0x11, 0x7c, 0x67, 0xd9, 0x8e, 0x11, 0x7c, 0x51,
+			0x8f, 0x99, 0x7b, 0xcd, 0x8f, 0x1d, 0x7b, 0xcd, 0x8f, 0x79, 0x7a, 0xcd, 0x8f, 0xd5, 0x79, 0x51,
+			0x8f, 0x59, 0x79, 0xd9, 0x8e, 0xdd, 0x78, 0x35, 0x8e, 0xdd, 0x78, 0x91, 0x8d, 0xdd, 0x78, 0x15,
+			0x8d, 0x59, 0x79, 0x99, 0x8c, 0xd5, 0x79, 0x99, 0x8c, 0x79, 0x7a, 0x99, 0x8c, 0x1d, 0x7b, 0x15,
+			0x8d, 0x99, 0x7b, 0x91, 0x8d, 0x11, 0x7c, 0x35, 0x8e, 0x11, 0x7c, 0xe2, 0x80, 0xad, 0x9a, 0x60,
+			0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69,
+			0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71,
+			0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71,
+			0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd,
+			0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80,
+			0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45,
+			0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x09, 0x80, 0xad, 0x96,
+			0x60, 0x69, 0x89, 0xad, 0x96, 0x09, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96,
+			0x99, 0x76, 0x09, 0x90, 0xf9, 0x6f, 0x40, 0x09, 0x80, 0x55, 0x69, 0x60, 0xad, 0x76, 0x55, 0x69,
+			0x60, 0xf9, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0x60, 0x09, 0x90, 0x40,
+			0x09, 0x80, 0xad, 0x96, 0xe2, 0x99, 0x85, 0xcd, 0x8f, 0x67, 0x3d, 0x86, 0xcd, 0x8f, 0xb9, 0x86,
+			0x51, 0x8f, 0x35, 0x87, 0xd9, 0x8e, 0x35, 0x87, 0x35, 0x8e, 0x35, 0x87, 0x91, 0x8d, 0xb9, 0x86,
+			0x15, 0x8d, 0x3d, 0x86, 0x99, 0x8c, 0x99, 0x85, 0x99, 0x8c, 0xf5, 0x84, 0x99, 0x8c, 0x7d, 0x84,
+			0x15, 0x8d, 0x88, 0x91, 0x8d, 0x88, 0x35, 0x8e, 0x88, 0xd9, 0x8e, 0x7d, 0x84, 0x51, 0x8f, 0xf5,
+			0x84, 0xcd, 0x8f, 0x99, 0x85, 0xcd, 0x8f, 0xe2, 0x99, 0x85, 0x35, 0x88, 0x63, 0xb5, 0x86, 0x35,
+			0x88, 0x75, 0x87, 0x75, 0x87, 0x35, 0x88, 0xb5, 0x86, 0x35, 0x88, 0x99, 0x85, 0x35, 0x88, 0x81,
+			0x84, 0x75, 0x87, 0xc1, 0x83, 0xb5, 0x86, 0x86, 0x99, 0x85, 0x86, 0x40, 0xc1, 0x83, 0xc1, 0x83,
+			0x62, 0x86, 0x81, 0x84, 0x86, 0x99, 0x85, 0x86, 0xb5, 0x86, 0xc1, 0x83, 0x75, 0x87, 0x81, 0x84,
+			0x35, 0x88, 0x99, 0x85, 0x35, 0x88, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageBlurLinear: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x6d, 0x99, 0x8e, 0x60, 0xad, 0x6e, 0x99,
+			0x8e, 0xd5, 0x6f, 0x71, 0x8d, 0x40, 0x62, 0xbd, 0x8a, 0x60, 0x62, 0x35, 0x89, 0xd5, 0x6f, 0x09,
+			0x88, 0x40, 0x25, 0x6d, 0xdd, 0x86, 0x60, 0x99, 0x6b, 0xdd, 0x86, 0x71, 0x6a, 0x09, 0x88, 0x40,
+			0x45, 0x69, 0xbd, 0x8a, 0x60, 0x45, 0x69, 0x45, 0x8c, 0x71, 0x6a, 0x71, 0x8d, 0x40, 0x25, 0x6d,
+			0x99, 0x8e, 0xe2, 0x70, 0x55, 0x82, 0x67, 0x11, 0x79, 0x55, 0x82, 0xcd, 0x79, 0x9d, 0x81, 0x89,
+			0x7a, 0xe1, 0x80, 0x89, 0x7a, 0xd1, 0x7f, 0x89, 0x7a, 0xbd, 0x7e, 0xd1, 0x79, 0x7c, 0x15, 0x79,
+			0x45, 0x7d, 0x70, 0x45, 0x7d, 0xf1, 0x76, 0x45, 0x7d, 0x35, 0x76, 0x7c, 0x79, 0x75, 0xb9, 0x7e,
+			0x79, 0x75, 0xcd, 0x7f, 0x79, 0x75, 0xdd, 0x80, 0x31, 0x76, 0x99, 0x81, 0xed, 0x76, 0x55, 0x82,
+			0x70, 0x55, 0x82, 0xe2, 0x70, 0x69, 0x77, 0x67, 0x11, 0x79, 0x69, 0x77, 0xcd, 0x79, 0xad, 0x76,
+			0x89, 0x7a, 0xf5, 0x75, 0x89, 0x7a, 0xe1, 0x74, 0x89, 0x7a, 0xcd, 0x73, 0xd1, 0x79, 0x11, 0x73,
+			0x15, 0x79, 0x55, 0x72, 0x70, 0x55, 0x72, 0xf1, 0x76, 0x55, 0x72, 0x35, 0x76, 0x11, 0x73, 0x79,
+			0x75, 0xc9, 0x73, 0x79, 0x75, 0xdd, 0x74, 0x79, 0x75, 0xf1, 0x75, 0x31, 0x76, 0xad, 0x76, 0xed,
+			0x76, 0x69, 0x77, 0x70, 0x69, 0x77, 0xe2, 0x50, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0xe8, 0xb0, 0xe6,
+			0x50, 0xe2, 0x25, 0x6d, 0xbd, 0x78, 0x60, 0xad, 0x6e, 0xbd, 0x78, 0xd5, 0x6f, 0x91, 0x77, 0x40,
+			0x62, 0xdd, 0x74, 0x60, 0x62, 0x55, 0x73, 0xd5, 0x6f, 0x2d, 0x72, 0x40, 0x25, 0x6d, 0x62, 0x60,
+			0x99, 0x6b, 0x62, 0x71, 0x6a, 0x2d, 0x72, 0x40, 0x45, 0x69, 0xdd, 0x74, 0x60, 0x45, 0x69, 0x69,
+			0x76, 0x71, 0x6a, 0x91, 0x77, 0x40, 0x25, 0x6d, 0xbd, 0x78, 0xe2, 0x25, 0x6d, 0xad, 0x83, 0x60,
+			0xad, 0x6e, 0xad, 0x83, 0xd5, 0x6f, 0x81, 0x82, 0x40, 0x62, 0xcd, 0x7f, 0x60, 0x62, 0x45, 0x7e,
+			0xd5, 0x6f, 0x19, 0x7d, 0x40, 0x25, 0x6d, 0xf1, 0x7b, 0x60, 0x99, 0x6b, 0xf1, 0x7b, 0x71, 0x6a,
+			0x19, 0x7d, 0x40, 0x45, 0x69, 0xcd, 0x7f, 0x60, 0x45, 0x69, 0x55, 0x81, 0x71, 0x6a, 0x81, 0x82,
+			0x40, 0x25, 0x6d, 0xad, 0x83, 0xe2, 0x70, 0x89, 0x8d, 0x67, 0x11, 0x79, 0x89, 0x8d, 0xcd, 0x79,
+			0xd1, 0x8c, 0x89, 0x7a, 0x15, 0x8c, 0x89, 0x7a, 0x96, 0x89, 0x7a, 0xf1, 0x89, 0xd1, 0x79, 0x35,
+			0x89, 0x15, 0x79, 0x79, 0x88, 0x70, 0x79, 0x88, 0xf1, 0x76, 0x79, 0x88, 0x35, 0x76, 0x31, 0x89,
+			0x79, 0x75, 0xed, 0x89, 0x79, 0x75, 0x96, 0x79, 0x75, 0x11, 0x8c, 0x31, 0x76, 0xcd, 0x8c, 0xed,
+			0x76, 0x89, 0x8d, 0x70, 0x89, 0x8d, 0xe2, 0x35, 0x8e, 0x45, 0x8c, 0x60, 0xcd, 0x8e, 0x45, 0x8c,
+			0x45, 0x8f, 0xcd, 0x8b, 0x40, 0xbd, 0x8f, 0xbd, 0x8a, 0x60, 0xbd, 0x8f, 0x25, 0x8a, 0x45, 0x8f,
+			0xad, 0x89, 0x40, 0x35, 0x8e, 0x35, 0x89, 0x60, 0x99, 0x8d, 0x35, 0x89, 0x25, 0x8d, 0xad, 0x89,
+			0x40, 0xad, 0x8c, 0xbd, 0x8a, 0x60, 0xad, 0x8c, 0x55, 0x8b, 0x25, 0x8d, 0xcd, 0x8b, 0x40, 0x35,
+			0x8e, 0x45, 0x8c, 0xe2, 0x50, 0x58, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x58, 0xe6, 0x50, 0xe2, 0x35,
+			0x8e, 0x69, 0x76, 0x60, 0xcd, 0x8e, 0x69, 0x76, 0x45, 0x8f, 0xf1, 0x75, 0x40, 0xbd, 0x8f, 0xdd,
+			0x74, 0x60, 0xbd, 0x8f, 0x45, 0x74, 0x45, 0x8f, 0xcd, 0x73, 0x40, 0x35, 0x8e, 0x55, 0x73, 0x60,
+			0x99, 0x8d, 0x55, 0x73, 0x25, 0x8d, 0xcd, 0x73, 0x40, 0xad, 0x8c, 0xdd, 0x74, 0x60, 0xad, 0x8c,
+			0x79, 0x75, 0x25, 0x8d, 0xf1, 0x75, 0x40, 0x35, 0x8e, 0x69, 0x76, 0xe2, 0x35, 0x8e, 0x55, 0x81,
+			0x60, 0xcd, 0x8e, 0x55, 0x81, 0x45, 0x8f, 0xdd, 0x80, 0x40, 0xbd, 0x8f, 0xcd, 0x7f, 0x60, 0xbd,
+			0x8f, 0x35, 0x7f, 0x45, 0x8f, 0xbd, 0x7e, 0x40, 0x35, 0x8e, 0x45, 0x7e, 0x60, 0x99, 0x8d, 0x45,
+			0x7e, 0x25, 0x8d, 0xbd, 0x7e, 0x40, 0xad, 0x8c, 0xcd, 0x7f, 0x60, 0xad, 0x8c, 0x69, 0x80, 0x25,
+			0x8d, 0xdd, 0x80, 0x40, 0x35, 0x8e, 0x55, 0x81, 0xe2, 0x86, 0x69, 0x77, 0x67, 0x11, 0x84, 0x69,
+			0x77, 0xcd, 0x84, 0xad, 0x76, 0x89, 0x85, 0xf5, 0x75, 0x89, 0x85, 0xe1, 0x74, 0x89, 0x85, 0xcd,
+			0x73, 0xd1, 0x84, 0x11, 0x73, 0x15, 0x84, 0x55, 0x72, 0x86, 0x55, 0x72, 0xf1, 0x81, 0x55, 0x72,
+			0x35, 0x81, 0x11, 0x73, 0x79, 0x80, 0xc9, 0x73, 0x79, 0x80, 0xdd, 0x74, 0x79, 0x80, 0xf1, 0x75,
+			0x31, 0x81, 0xad, 0x76, 0xed, 0x81, 0x69, 0x77, 0x86, 0x69, 0x77, 0xe2, 0x86, 0x55, 0x82, 0x67,
+			0x11, 0x84, 0x55, 0x82, 0xcd, 0x84, 0x9d, 0x81, 0x89, 0x85, 0xe1, 0x80, 0x89, 0x85, 0xd1, 0x7f,
+			0x89, 0x85, 0xbd, 0x7e, 0xd1, 0x84, 0x7c, 0x15, 0x84, 0x45, 0x7d, 0x86, 0x45, 0x7d, 0xf1, 0x81,
+			0x45, 0x7d, 0x35, 0x81, 0x7c, 0x79, 0x80, 0xb9, 0x7e, 0x79, 0x80, 0xcd, 0x7f, 0x79, 0x80, 0xdd,
+			0x80, 0x31, 0x81, 0x99, 0x81, 0xed, 0x81, 0x55, 0x82, 0x86, 0x55, 0x82, 0xe2, 0x86, 0x89, 0x8d,
+			0x67, 0x11, 0x84, 0x89, 0x8d, 0xcd, 0x84, 0xd1, 0x8c, 0x89, 0x85, 0x15, 0x8c, 0x89, 0x85, 0x96,
+			0x89, 0x85, 0xf1, 0x89, 0xd1, 0x84, 0x35, 0x89, 0x15, 0x84, 0x79, 0x88, 0x86, 0x79, 0x88, 0xf1,
+			0x81, 0x79, 0x88, 0x35, 0x81, 0x31, 0x89, 0x79, 0x80, 0xed, 0x89, 0x79, 0x80, 0x96, 0x79, 0x80,
+			0x11, 0x8c, 0x31, 0x81, 0xcd, 0x8c, 0xed, 0x81, 0x89, 0x8d, 0x86, 0x89, 0x8d, 0xe2, 0x50, 0xa8,
+			0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		ImageBlurOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x96, 0xf1, 0x9b, 0x03, 0xf1, 0x64, 0xf1,
+			0x69, 0xcd, 0x67, 0x11, 0x67, 0xcd, 0x99, 0x11, 0x99, 0xf1, 0x96, 0xf1, 0x9b, 0xe2, 0xcd, 0x7a,
+			0x55, 0x99, 0x60, 0x35, 0x7a, 0x55, 0x99, 0xcd, 0x79, 0xf1, 0x98, 0x40, 0x69, 0x79, 0xf1, 0x97,
+			0x60, 0x69, 0x79, 0x55, 0x97, 0xcd, 0x79, 0xf1, 0x96, 0x40, 0xcd, 0x7a, 0x89, 0x96, 0x60, 0x69,
+			0x7b, 0x89, 0x96, 0xcd, 0x7b, 0xf1, 0x96, 0x40, 0x35, 0x7c, 0xf1, 0x97, 0x60, 0x35, 0x7c, 0x89,
+			0x98, 0xcd, 0x7b, 0xf1, 0x98, 0x40, 0xcd, 0x7a, 0x55, 0x99, 0xe2, 0x99, 0x85, 0x55, 0x99, 0x60,
+			0x8a, 0x55, 0x99, 0x99, 0x84, 0xf1, 0x98, 0x40, 0x35, 0x84, 0xf1, 0x97, 0x60, 0x35, 0x84, 0x55,
+			0x97, 0x99, 0x84, 0xf1, 0x96, 0x40, 0x99, 0x85, 0x89, 0x96, 0x60, 0x35, 0x86, 0x89, 0x96, 0x99,
+			0x86, 0xf1, 0x96, 0x40, 0x8e, 0xf1, 0x97, 0x60, 0x8e, 0x89, 0x98, 0x99, 0x86, 0xf1, 0x98, 0x40,
+			0x99, 0x85, 0x55, 0x99, 0xe2, 0x60, 0x89, 0x92, 0x67, 0xf1, 0x6e, 0x89, 0x92, 0x35, 0x6e, 0xd1,
+			0x91, 0x79, 0x6d, 0x15, 0x91, 0x79, 0x6d, 0xa0, 0x79, 0x6d, 0xf1, 0x8e, 0x31, 0x6e, 0x35, 0x8e,
+			0xed, 0x6e, 0x79, 0x8d, 0x60, 0x79, 0x8d, 0x11, 0x71, 0x79, 0x8d, 0xcd, 0x71, 0x31, 0x8e, 0x89,
+			0x72, 0xed, 0x8e, 0x89, 0x72, 0xa0, 0x89, 0x72, 0x11, 0x91, 0xd1, 0x71, 0xcd, 0x91, 0x15, 0x71,
+			0x89, 0x92, 0x60, 0x89, 0x92, 0xe2, 0xd1, 0x7a, 0x89, 0x92, 0x67, 0xbd, 0x79, 0x89, 0x92, 0x72,
+			0xd1, 0x91, 0x45, 0x78, 0x15, 0x91, 0x45, 0x78, 0xa0, 0x45, 0x78, 0xf1, 0x8e, 0x72, 0x35, 0x8e,
+			0xb9, 0x79, 0x79, 0x8d, 0xcd, 0x7a, 0x79, 0x8d, 0xdd, 0x7b, 0x79, 0x8d, 0x99, 0x7c, 0x31, 0x8e,
+			0x55, 0x7d, 0xed, 0x8e, 0x55, 0x7d, 0xa0, 0x55, 0x7d, 0x11, 0x91, 0x9d, 0x7c, 0xcd, 0x91, 0xe1,
+			0x7b, 0x89, 0x92, 0xd1, 0x7a, 0x89, 0x92, 0xe2, 0x35, 0x85, 0x89, 0x92, 0x67, 0x25, 0x84, 0x89,
+			0x92, 0x69, 0x83, 0xd1, 0x91, 0xad, 0x82, 0x15, 0x91, 0xad, 0x82, 0xa0, 0xad, 0x82, 0xf1, 0x8e,
+			0x65, 0x83, 0x35, 0x8e, 0x21, 0x84, 0x79, 0x8d, 0x31, 0x85, 0x79, 0x8d, 0x45, 0x86, 0x79, 0x8d,
+			0x8e, 0x31, 0x8e, 0xbd, 0x87, 0xed, 0x8e, 0xbd, 0x87, 0xa0, 0xbd, 0x87, 0x11, 0x91, 0x8e, 0xcd,
+			0x91, 0x49, 0x86, 0x89, 0x92, 0x35, 0x85, 0x89, 0x92, 0xe2, 0xcd, 0x7a, 0xcd, 0x88, 0x67, 0x3d,
+			0x79, 0xcd, 0x88, 0x39, 0x78, 0xc9, 0x87, 0x35, 0x77, 0xc5, 0x86, 0x35, 0x77, 0x35, 0x85, 0x35,
+			0x77, 0xa5, 0x83, 0x39, 0x78, 0xa1, 0x82, 0x3d, 0x79, 0x99, 0x81, 0xcd, 0x7a, 0x99, 0x81, 0x5d,
+			0x7c, 0x99, 0x81, 0x61, 0x7d, 0xa1, 0x82, 0x69, 0x7e, 0xa5, 0x83, 0x69, 0x7e, 0x35, 0x85, 0x69,
+			0x7e, 0xc5, 0x86, 0x61, 0x7d, 0xc9, 0x87, 0x5d, 0x7c, 0xcd, 0x88, 0xcd, 0x7a, 0xcd, 0x88, 0xe2,
+			0x60, 0xbd, 0x87, 0x67, 0xf1, 0x6e, 0xbd, 0x87, 0x35, 0x6e, 0x8e, 0x79, 0x6d, 0x49, 0x86, 0x79,
+			0x6d, 0x35, 0x85, 0x79, 0x6d, 0x25, 0x84, 0x31, 0x6e, 0x69, 0x83, 0xed, 0x6e, 0xad, 0x82, 0x60,
+			0xad, 0x82, 0x11, 0x71, 0xad, 0x82, 0xcd, 0x71, 0x65, 0x83, 0x89, 0x72, 0x21, 0x84, 0x89, 0x72,
+			0x31, 0x85, 0x89, 0x72, 0x45, 0x86, 0xd1, 0x71, 0x8e, 0x15, 0x71, 0xbd, 0x87, 0x60, 0xbd, 0x87,
+			0xe2, 0xa0, 0xbd, 0x87, 0x00, 0x79, 0x8d, 0x35, 0x85, 0x62, 0x79, 0x8d, 0x25, 0x84, 0x35, 0x8e,
+			0x69, 0x83, 0xf1, 0x8e, 0xad, 0x82, 0xa0, 0xad, 0x82, 0x15, 0x91, 0xad, 0x82, 0xd1, 0x91, 0x65,
+			0x83, 0x40, 0x89, 0x92, 0x35, 0x85, 0x61, 0x89, 0x92, 0x45, 0x86, 0xcd, 0x91, 0x8e, 0x11, 0x91,
+			0xbd, 0x87, 0xa0, 0xbd, 0x87, 0xe2, 0x11, 0x68, 0x99, 0x86, 0x60, 0x79, 0x67, 0x99, 0x86, 0x11,
+			0x67, 0x35, 0x86, 0x40, 0xad, 0x66, 0x35, 0x85, 0x60, 0xad, 0x66, 0x99, 0x84, 0x11, 0x67, 0x35,
+			0x84, 0x40, 0x11, 0x68, 0xcd, 0x83, 0x60, 0xad, 0x68, 0xcd, 0x83, 0x11, 0x69, 0x35, 0x84, 0x40,
+			0x79, 0x69, 0x35, 0x85, 0x60, 0x79, 0x69, 0xcd, 0x85, 0x11, 0x69, 0x35, 0x86, 0x40, 0x11, 0x68,
+			0x99, 0x86, 0xe2, 0xf1, 0x97, 0x99, 0x86, 0x60, 0x55, 0x97, 0x99, 0x86, 0xf1, 0x96, 0x35, 0x86,
+			0x40, 0x89, 0x96, 0x35, 0x85, 0x60, 0x89, 0x96, 0x99, 0x84, 0xf1, 0x96, 0x35, 0x84, 0x40, 0xf1,
+			0x97, 0xcd, 0x83, 0x60, 0x89, 0x98, 0xcd, 0x83, 0xf1, 0x98, 0x35, 0x84, 0x40, 0x55, 0x99, 0x35,
+			0x85, 0x60, 0x55, 0x99, 0xcd, 0x85, 0xf1, 0x98, 0x35, 0x86, 0x40, 0xf1, 0x97, 0x99, 0x86, 0xe2,
+			0x79, 0x86, 0x35, 0x7e, 0x00, 0xcd, 0x81, 0x89, 0x79, 0x60, 0x35, 0x82, 0x79, 0x78, 0x25, 0x83,
+			0xd5, 0x77, 0x40, 0x35, 0x85, 0x35, 0x77, 0x62, 0xc5, 0x86, 0x35, 0x77, 0xc9, 0x87, 0x39, 0x78,
+			0xcd, 0x88, 0x3d, 0x79, 0xcd, 0x88, 0xcd, 0x7a, 0xcd, 0x88, 0xf1, 0x7b, 0x2d, 0x88, 0xdd, 0x7c,
+			0x40, 0x79, 0x86, 0x35, 0x7e, 0xe2, 0x60, 0x55, 0x7d, 0x67, 0xf1, 0x6e, 0x55, 0x7d, 0x35, 0x6e,
+			0x9d, 0x7c, 0x79, 0x6d, 0xe1, 0x7b, 0x79, 0x6d, 0xd1, 0x7a, 0x79, 0x6d, 0xbd, 0x79, 0x31, 0x6e,
+			0x72, 0xed, 0x6e, 0x45, 0x78, 0x60, 0x45, 0x78, 0x11, 0x71, 0x45, 0x78, 0xcd, 0x71, 0x72, 0x89,
+			0x72, 0xb9, 0x79, 0x89, 0x72, 0xcd, 0x7a, 0x89, 0x72, 0xdd, 0x7b, 0xd1, 0x71, 0x99, 0x7c, 0x15,
+			0x71, 0x55, 0x7d, 0x60, 0x55, 0x7d, 0xe2, 0xa0, 0x55, 0x7d, 0x67, 0xf1, 0x8e, 0x55, 0x7d, 0x35,
+			0x8e, 0x9d, 0x7c, 0x79, 0x8d, 0xe1, 0x7b, 0x79, 0x8d, 0xd1, 0x7a, 0x79, 0x8d, 0xbd, 0x79, 0x31,
+			0x8e, 0x72, 0xed, 0x8e, 0x45, 0x78, 0xa0, 0x45, 0x78, 0x11, 0x91, 0x45, 0x78, 0xcd, 0x91, 0x72,
+			0x89, 0x92, 0xb9, 0x79, 0x89, 0x92, 0xcd, 0x7a, 0x89, 0x92, 0xdd, 0x7b, 0xd1, 0x91, 0x99, 0x7c,
+			0x15, 0x91, 0x55, 0x7d, 0xa0, 0x55, 0x7d, 0xe2, 0x11, 0x68, 0x35, 0x7c, 0x60, 0x79, 0x67, 0x35,
+			0x7c, 0x11, 0x67, 0xcd, 0x7b, 0x40, 0xad, 0x66, 0xcd, 0x7a, 0x60, 0xad, 0x66, 0x35, 0x7a, 0x11,
+			0x67, 0xcd, 0x79, 0x40, 0x11, 0x68, 0x69, 0x79, 0x60, 0xad, 0x68, 0x69, 0x79, 0x11, 0x69, 0xcd,
+			0x79, 0x40, 0x79, 0x69, 0xcd, 0x7a, 0x60, 0x79, 0x69, 0x69, 0x7b, 0x11, 0x69, 0xcd, 0x7b, 0x40,
+			0x11, 0x68, 0x35, 0x7c, 0xe2, 0xf1, 0x97, 0x35, 0x7c, 0x60, 0x55, 0x97, 0x35, 0x7c, 0xf1, 0x96,
+			0xcd, 0x7b, 0x40, 0x89, 0x96, 0xcd, 0x7a, 0x60, 0x89, 0x96, 0x35, 0x7a, 0xf1, 0x96, 0xcd, 0x79,
+			0x40, 0xf1, 0x97, 0x69, 0x79, 0x60, 0x89, 0x98, 0x69, 0x79, 0xf1, 0x98, 0xcd, 0x79, 0x40, 0x55,
+			0x99, 0xcd, 0x7a, 0x60, 0x55, 0x99, 0x69, 0x7b, 0xf1, 0x98, 0xcd, 0x7b, 0x40, 0xf1, 0x97, 0x35,
+			0x7c, 0xe2, 0x35, 0x85, 0x89, 0x72, 0x67, 0x25, 0x84, 0x89, 0x72, 0x69, 0x83, 0xd1, 0x71, 0xad,
+			0x82, 0x15, 0x71, 0xad, 0x82, 0x60, 0xad, 0x82, 0xf1, 0x6e, 0x65, 0x83, 0x35, 0x6e, 0x21, 0x84,
+			0x79, 0x6d, 0x31, 0x85, 0x79, 0x6d, 0x45, 0x86, 0x79, 0x6d, 0x8e, 0x31, 0x6e, 0xbd, 0x87, 0xed,
+			0x6e, 0xbd, 0x87, 0x60, 0xbd, 0x87, 0x11, 0x71, 0x8e, 0xcd, 0x71, 0x49, 0x86, 0x89, 0x72, 0x35,
+			0x85, 0x89, 0x72, 0xe2, 0xcd, 0x7a, 0x89, 0x72, 0x00, 0x45, 0x78, 0x60, 0x60, 0x45, 0x78, 0xf1,
+			0x6e, 0x72, 0x35, 0x6e, 0x40, 0xd5, 0x7a, 0x79, 0x6d, 0x62, 0xdd, 0x7b, 0x79, 0x6d, 0x99, 0x7c,
+			0x31, 0x6e, 0x55, 0x7d, 0xed, 0x6e, 0x55, 0x7d, 0x60, 0x55, 0x7d, 0x15, 0x71, 0x99, 0x7c, 0xd1,
+			0x71, 0x40, 0xcd, 0x7a, 0x89, 0x72, 0xe2, 0xa0, 0x89, 0x72, 0x67, 0xf1, 0x8e, 0x89, 0x72, 0x35,
+			0x8e, 0xd1, 0x71, 0x79, 0x8d, 0x15, 0x71, 0x79, 0x8d, 0x60, 0x79, 0x8d, 0xf1, 0x6e, 0x31, 0x8e,
+			0x35, 0x6e, 0xed, 0x8e, 0x79, 0x6d, 0xa0, 0x79, 0x6d, 0x11, 0x91, 0x79, 0x6d, 0xcd, 0x91, 0x31,
+			0x6e, 0x89, 0x92, 0xed, 0x6e, 0x89, 0x92, 0x60, 0x89, 0x92, 0x11, 0x71, 0xd1, 0x91, 0xcd, 0x71,
+			0x15, 0x91, 0x89, 0x72, 0xa0, 0x89, 0x72, 0xe2, 0xcd, 0x7a, 0x79, 0x69, 0x60, 0x35, 0x7a, 0x79,
+			0x69, 0xcd, 0x79, 0x11, 0x69, 0x40, 0x69, 0x79, 0x11, 0x68, 0x60, 0x69, 0x79, 0x79, 0x67, 0xcd,
+			0x79, 0x11, 0x67, 0x40, 0xcd, 0x7a, 0xad, 0x66, 0x60, 0x69, 0x7b, 0xad, 0x66, 0xcd, 0x7b, 0x11,
+			0x67, 0x40, 0x35, 0x7c, 0x11, 0x68, 0x60, 0x35, 0x7c, 0xad, 0x68, 0xcd, 0x7b, 0x11, 0x69, 0x40,
+			0xcd, 0x7a, 0x79, 0x69, 0xe2, 0x35, 0x85, 0x79, 0x69, 0x60, 0x99, 0x84, 0x79, 0x69, 0x35, 0x84,
+			0x11, 0x69, 0x40, 0xcd, 0x83, 0x11, 0x68, 0x60, 0xcd, 0x83, 0x79, 0x67, 0x35, 0x84, 0x11, 0x67,
+			0x40, 0x35, 0x85, 0xad, 0x66, 0x60, 0xcd, 0x85, 0xad, 0x66, 0x35, 0x86, 0x11, 0x67, 0x40, 0x99,
+			0x86, 0x11, 0x68, 0x60, 0x99, 0x86, 0xad, 0x68, 0x35, 0x86, 0x11, 0x69, 0x40, 0x35, 0x85, 0x79,
+			0x69, 0xe1,
+		}},
+		ImageBlurOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x68, 0x99, 0x86, 0x60, 0x79, 0x67, 0x99,
+			0x86, 0x11, 0x67, 0x35, 0x86, 0x40, 0xad, 0x66, 0x35, 0x85, 0x60, 0xad, 0x66, 0x99, 0x84, 0x11,
+			0x67, 0x35, 0x84, 0x40, 0x11, 0x68, 0xcd, 0x83, 0x60, 0xad, 0x68, 0xcd, 0x83, 0x11, 0x69, 0x35,
+			0x84, 0x40, 0x79, 0x69, 0x35, 0x85, 0x60, 0x79, 0x69, 0xcd, 0x85, 0x11, 0x69, 0x35, 0x86, 0x40,
+			0x11, 0x68, 0x99, 0x86, 0xe2, 0x11, 0x68, 0x35, 0x7c, 0x60, 0x79, 0x67, 0x35, 0x7c, 0x11, 0x67,
+			0xcd, 0x7b, 0x40, 0xad, 0x66, 0xcd, 0x7a, 0x60, 0xad, 0x66, 0x35, 0x7a, 0x11, 0x67, 0xcd, 0x79,
+			0x40, 0x11, 0x68, 0x69, 0x79, 0x60, 0xad, 0x68, 0x69, 0x79, 0x11, 0x69, 0xcd, 0x79, 0x40, 0x79,
+			0x69, 0xcd, 0x7a, 0x60, 0x79, 0x69, 0x69, 0x7b, 0x11, 0x69, 0xcd, 0x7b, 0x40, 0x11, 0x68, 0x35,
+			0x7c, 0xe2, 0x60, 0x89, 0x92, 0x67, 0xf1, 0x6e, 0x89, 0x92, 0x35, 0x6e, 0xd1, 0x91, 0x79, 0x6d,
+			0x15, 0x91, 0x79, 0x6d, 0xa0, 0x79, 0x6d, 0xf1, 0x8e, 0x31, 0x6e, 0x35, 0x8e, 0xed, 0x6e, 0x79,
+			0x8d, 0x60, 0x79, 0x8d, 0x11, 0x71, 0x79, 0x8d, 0xcd, 0x71, 0x31, 0x8e, 0x89, 0x72, 0xed, 0x8e,
+			0x89, 0x72, 0xa0, 0x89, 0x72, 0x11, 0x91, 0xd1, 0x71, 0xcd, 0x91, 0x15, 0x71, 0x89, 0x92, 0x60,
+			0x89, 0x92, 0xe2, 0x60, 0xbd, 0x87, 0x67, 0xf1, 0x6e, 0xbd, 0x87, 0x35, 0x6e, 0x8e, 0x79, 0x6d,
+			0x49, 0x86, 0x79, 0x6d, 0x35, 0x85, 0x79, 0x6d, 0x25, 0x84, 0x31, 0x6e, 0x69, 0x83, 0xed, 0x6e,
+			0xad, 0x82, 0x60, 0xad, 0x82, 0x11, 0x71, 0xad, 0x82, 0xcd, 0x71, 0x65, 0x83, 0x89, 0x72, 0x21,
+			0x84, 0x89, 0x72, 0x31, 0x85, 0x89, 0x72, 0x45, 0x86, 0xd1, 0x71, 0x8e, 0x15, 0x71, 0xbd, 0x87,
+			0x60, 0xbd, 0x87, 0xe2, 0x60, 0x55, 0x7d, 0x67, 0xf1, 0x6e, 0x55, 0x7d, 0x35, 0x6e, 0x9d, 0x7c,
+			0x79, 0x6d, 0xe1, 0x7b, 0x79, 0x6d, 0xd1, 0x7a, 0x79, 0x6d, 0xbd, 0x79, 0x31, 0x6e, 0x72, 0xed,
+			0x6e, 0x45, 0x78, 0x60, 0x45, 0x78, 0x11, 0x71, 0x45, 0x78, 0xcd, 0x71, 0x72, 0x89, 0x72, 0xb9,
+			0x79, 0x89, 0x72, 0xcd, 0x7a, 0x89, 0x72, 0xdd, 0x7b, 0xd1, 0x71, 0x99, 0x7c, 0x15, 0x71, 0x55,
+			0x7d, 0x60, 0x55, 0x7d, 0xe2, 0x60, 0x89, 0x72, 0x67, 0xf1, 0x6e, 0x89, 0x72, 0x35, 0x6e, 0xd1,
+			0x71, 0x79, 0x6d, 0x15, 0x71, 0x79, 0x6d, 0x60, 0x79, 0x6d, 0xf1, 0x6e, 0x31, 0x6e, 0x35, 0x6e,
+			0xed, 0x6e, 0x79, 0x6d, 0x60, 0x79, 0x6d, 0x11, 0x71, 0x79, 0x6d, 0xcd, 0x71, 0x31, 0x6e, 0x89,
+			0x72, 0xed, 0x6e, 0x89, 0x72, 0x60, 0x89, 0x72, 0x11, 0x71, 0xd1, 0x71, 0xcd, 0x71, 0x15, 0x71,
+			0x89, 0x72, 0x60, 0x89, 0x72, 0xe2, 0xcd, 0x7a, 0xcd, 0x88, 0x60, 0x4d, 0x79, 0xcd, 0x88, 0x41,
+			0x78, 0xc1, 0x87, 0x40, 0x35, 0x77, 0x35, 0x85, 0x60, 0x35, 0x77, 0xb5, 0x83, 0x41, 0x78, 0xa9,
+			0x82, 0x40, 0xcd, 0x7a, 0x99, 0x81, 0x60, 0x4d, 0x7c, 0x99, 0x81, 0x59, 0x7d, 0xa9, 0x82, 0x40,
+			0x69, 0x7e, 0x35, 0x85, 0x60, 0x69, 0x7e, 0xb5, 0x86, 0x59, 0x7d, 0xc1, 0x87, 0x40, 0xcd, 0x7a,
+			0xcd, 0x88, 0xe2, 0xcd, 0x7a, 0x69, 0x7e, 0x60, 0x4d, 0x79, 0x69, 0x7e, 0x41, 0x78, 0x59, 0x7d,
+			0x40, 0x35, 0x77, 0xcd, 0x7a, 0x60, 0x35, 0x77, 0x4d, 0x79, 0x41, 0x78, 0x41, 0x78, 0x40, 0xcd,
+			0x7a, 0x35, 0x77, 0x60, 0x4d, 0x7c, 0x35, 0x77, 0x59, 0x7d, 0x41, 0x78, 0x40, 0x69, 0x7e, 0xcd,
+			0x7a, 0x60, 0x69, 0x7e, 0x4d, 0x7c, 0x59, 0x7d, 0x59, 0x7d, 0x40, 0xcd, 0x7a, 0x69, 0x7e, 0xe2,
+			0xd1, 0x7a, 0x89, 0x92, 0x67, 0xbd, 0x79, 0x89, 0x92, 0x72, 0xd1, 0x91, 0x45, 0x78, 0x15, 0x91,
+			0x45, 0x78, 0xa0, 0x45, 0x78, 0xf1, 0x8e, 0x72, 0x35, 0x8e, 0xb9, 0x79, 0x79, 0x8d, 0xcd, 0x7a,
+			0x79, 0x8d, 0xdd, 0x7b, 0x79, 0x8d, 0x99, 0x7c, 0x31, 0x8e, 0x55, 0x7d, 0xed, 0x8e, 0x55, 0x7d,
+			0xa0, 0x55, 0x7d, 0x11, 0x91, 0x9d, 0x7c, 0xcd, 0x91, 0xe1, 0x7b, 0x89, 0x92, 0xd1, 0x7a, 0x89,
+			0x92, 0xe2, 0xd1, 0x7a, 0x89, 0x72, 0x67, 0xbd, 0x79, 0x89, 0x72, 0x72, 0xd1, 0x71, 0x45, 0x78,
+			0x15, 0x71, 0x45, 0x78, 0x60, 0x45, 0x78, 0xf1, 0x6e, 0x72, 0x35, 0x6e, 0xb9, 0x79, 0x79, 0x6d,
+			0xcd, 0x7a, 0x79, 0x6d, 0xdd, 0x7b, 0x79, 0x6d, 0x99, 0x7c, 0x31, 0x6e, 0x55, 0x7d, 0xed, 0x6e,
+			0x55, 0x7d, 0x60, 0x55, 0x7d, 0x11, 0x71, 0x9d, 0x7c, 0xcd, 0x71, 0xe1, 0x7b, 0x89, 0x72, 0xd1,
+			0x7a, 0x89, 0x72, 0xe2, 0xcd, 0x7a, 0x55, 0x99, 0x60, 0x35, 0x7a, 0x55, 0x99, 0xcd, 0x79, 0xf1,
+			0x98, 0x40, 0x69, 0x79, 0xf1, 0x97, 0x60, 0x69, 0x79, 0x55, 0x97, 0xcd, 0x79, 0xf1, 0x96, 0x40,
+			0xcd, 0x7a, 0x89, 0x96, 0x60, 0x69, 0x7b, 0x89, 0x96, 0xcd, 0x7b, 0xf1, 0x96, 0x40, 0x35, 0x7c,
+			0xf1, 0x97, 0x60, 0x35, 0x7c, 0x89, 0x98, 0xcd, 0x7b, 0xf1, 0x98, 0x40, 0xcd, 0x7a, 0x55, 0x99,
+			0xe2, 0xcd, 0x7a, 0x79, 0x69, 0x60, 0x35, 0x7a, 0x79, 0x69, 0xcd, 0x79, 0x11, 0x69, 0x40, 0x69,
+			0x79, 0x11, 0x68, 0x60, 0x69, 0x79, 0x79, 0x67, 0xcd, 0x79, 0x11, 0x67, 0x40, 0xcd, 0x7a, 0xad,
+			0x66, 0x60, 0x69, 0x7b, 0xad, 0x66, 0xcd, 0x7b, 0x11, 0x67, 0x40, 0x35, 0x7c, 0x11, 0x68, 0x60,
+			0x35, 0x7c, 0xad, 0x68, 0xcd, 0x7b, 0x11, 0x69, 0x40, 0xcd, 0x7a, 0x79, 0x69, 0xe2, 0x35, 0x85,
+			0xcd, 0x88, 0x60, 0xb5, 0x83, 0xcd, 0x88, 0xa9, 0x82, 0xc1, 0x87, 0x40, 0x99, 0x81, 0x35, 0x85,
+			0x60, 0x99, 0x81, 0xb5, 0x83, 0xa9, 0x82, 0xa9, 0x82, 0x40, 0x35, 0x85, 0x99, 0x81, 0x60, 0xb5,
+			0x86, 0x99, 0x81, 0xc1, 0x87, 0xa9, 0x82, 0x40, 0xcd, 0x88, 0x35, 0x85, 0x60, 0xcd, 0x88, 0xb5,
+			0x86, 0xc1, 0x87, 0xc1, 0x87, 0x40, 0x35, 0x85, 0xcd, 0x88, 0xe2, 0x35, 0x85, 0x69, 0x7e, 0x60,
+			0xb5, 0x83, 0x69, 0x7e, 0xa9, 0x82, 0x59, 0x7d, 0x40, 0x99, 0x81, 0xcd, 0x7a, 0x60, 0x99, 0x81,
+			0x4d, 0x79, 0xa9, 0x82, 0x41, 0x78, 0x40, 0x35, 0x85, 0x35, 0x77, 0x60, 0xb5, 0x86, 0x35, 0x77,
+			0xc1, 0x87, 0x41, 0x78, 0x40, 0xcd, 0x88, 0xcd, 0x7a, 0x60, 0xcd, 0x88, 0x4d, 0x7c, 0xc1, 0x87,
+			0x59, 0x7d, 0x40, 0x35, 0x85, 0x69, 0x7e, 0xe2, 0x35, 0x85, 0x89, 0x92, 0x67, 0x25, 0x84, 0x89,
+			0x92, 0x69, 0x83, 0xd1, 0x91, 0xad, 0x82, 0x15, 0x91, 0xad, 0x82, 0xa0, 0xad, 0x82, 0xf1, 0x8e,
+			0x65, 0x83, 0x35, 0x8e, 0x21, 0x84, 0x79, 0x8d, 0x31, 0x85, 0x79, 0x8d, 0x45, 0x86, 0x79, 0x8d,
+			0x8e, 0x31, 0x8e, 0xbd, 0x87, 0xed, 0x8e, 0xbd, 0x87, 0xa0, 0xbd, 0x87, 0x11, 0x91, 0x8e, 0xcd,
+			0x91, 0x49, 0x86, 0x89, 0x92, 0x35, 0x85, 0x89, 0x92, 0xe2, 0x35, 0x85, 0x89, 0x72, 0x67, 0x25,
+			0x84, 0x89, 0x72, 0x69, 0x83, 0xd1, 0x71, 0xad, 0x82, 0x15, 0x71, 0xad, 0x82, 0x60, 0xad, 0x82,
+			0xf1, 0x6e, 0x65, 0x83, 0x35, 0x6e, 0x21, 0x84, 0x79, 0x6d, 0x31, 0x85, 0x79, 0x6d, 0x45, 0x86,
+			0x79, 0x6d, 0x8e, 0x31, 0x6e, 0xbd, 0x87, 0xed, 0x6e, 0xbd, 0x87, 0x60, 0xbd, 0x87, 0x11, 0x71,
+			0x8e, 0xcd, 0x71, 0x49, 0x86, 0x89, 0x72, 0x35, 0x85, 0x89, 0x72, 0xe2, 0x35, 0x85, 0x79, 0x69,
+			0x60, 0x99, 0x84, 0x79, 0x69, 0x35, 0x84, 0x11, 0x69, 0x40, 0xcd, 0x83, 0x11, 0x68, 0x60, 0xcd,
+			0x83, 0x79, 0x67, 0x35, 0x84, 0x11, 0x67, 0x40, 0x35, 0x85, 0xad, 0x66, 0x60, 0xcd, 0x85, 0xad,
+			0x66, 0x35, 0x86, 0x11, 0x67, 0x40, 0x99, 0x86, 0x11, 0x68, 0x60, 0x99, 0x86, 0xad, 0x68, 0x35,
+			0x86, 0x11, 0x69, 0x40, 0x35, 0x85, 0x79, 0x69, 0xe2, 0x99, 0x85, 0x55, 0x99, 0x60, 0x8a, 0x55,
+			0x99, 0x99, 0x84, 0xf1, 0x98, 0x40, 0x35, 0x84, 0xf1, 0x97, 0x60, 0x35, 0x84, 0x55, 0x97, 0x99,
+			0x84, 0xf1, 0x96, 0x40, 0x99, 0x85, 0x89, 0x96, 0x60, 0x35, 0x86, 0x89, 0x96, 0x99, 0x86, 0xf1,
+			0x96, 0x40, 0x8e, 0xf1, 0x97, 0x60, 0x8e, 0x89, 0x98, 0x99, 0x86, 0xf1, 0x98, 0x40, 0x99, 0x85,
+			0x55, 0x99, 0xe2, 0xa0, 0x89, 0x92, 0x67, 0xf1, 0x8e, 0x89, 0x92, 0x35, 0x8e, 0xd1, 0x91, 0x79,
+			0x8d, 0x15, 0x91, 0x79, 0x8d, 0xa0, 0x79, 0x8d, 0xf1, 0x8e, 0x31, 0x8e, 0x35, 0x8e, 0xed, 0x8e,
+			0x79, 0x8d, 0xa0, 0x79, 0x8d, 0x11, 0x91, 0x79, 0x8d, 0xcd, 0x91, 0x31, 0x8e, 0x89, 0x92, 0xed,
+			0x8e, 0x89, 0x92, 0xa0, 0x89, 0x92, 0x11, 0x91, 0xd1, 0x91, 0xcd, 0x91, 0x15, 0x91, 0x89, 0x92,
+			0xa0, 0x89, 0x92, 0xe2, 0xa0, 0xbd, 0x87, 0x67, 0xf1, 0x8e, 0xbd, 0x87, 0x35, 0x8e, 0x8e, 0x79,
+			0x8d, 0x49, 0x86, 0x79, 0x8d, 0x35, 0x85, 0x79, 0x8d, 0x25, 0x84, 0x31, 0x8e, 0x69, 0x83, 0xed,
+			0x8e, 0xad, 0x82, 0xa0, 0xad, 0x82, 0x11, 0x91, 0xad, 0x82, 0xcd, 0x91, 0x65, 0x83, 0x89, 0x92,
+			0x21, 0x84, 0x89, 0x92, 0x31, 0x85, 0x89, 0x92, 0x45, 0x86, 0xd1, 0x91, 0x8e, 0x15, 0x91, 0xbd,
+			0x87, 0xa0, 0xbd, 0x87, 0xe2, 0xa0, 0x55, 0x7d, 0x67, 0xf1, 0x8e, 0x55, 0x7d, 0x35, 0x8e, 0x9d,
+			0x7c, 0x79, 0x8d, 0xe1, 0x7b, 0x79, 0x8d, 0xd1, 0x7a, 0x79, 0x8d, 0xbd, 0x79, 0x31, 0x8e, 0x72,
+			0xed, 0x8e, 0x45, 0x78, 0xa0, 0x45, 0x78, 0x11, 0x91, 0x45, 0x78, 0xcd, 0x91, 0x72, 0x89, 0x92,
+			0xb9, 0x79, 0x89, 0x92, 0xcd, 0x7a, 0x89, 0x92, 0xdd, 0x7b, 0xd1, 0x91, 0x99, 0x7c, 0x15, 0x91,
+			0x55, 0x7d, 0xa0, 0x55, 0x7d, 0xe2, 0xa0, 0x89, 0x72, 0x67, 0xf1, 0x8e, 0x89, 0x72, 0x35, 0x8e,
+			0xd1, 0x71, 0x79, 0x8d, 0x15, 0x71, 0x79, 0x8d, 0x60, 0x79, 0x8d, 0xf1, 0x6e, 0x31, 0x8e, 0x35,
+			0x6e, 0xed, 0x8e, 0x79, 0x6d, 0xa0, 0x79, 0x6d, 0x11, 0x91, 0x79, 0x6d, 0xcd, 0x91, 0x31, 0x6e,
+			0x89, 0x92, 0xed, 0x6e, 0x89, 0x92, 0x60, 0x89, 0x92, 0x11, 0x71, 0xd1, 0x91, 0xcd, 0x71, 0x15,
+			0x91, 0x89, 0x72, 0xa0, 0x89, 0x72, 0xe2, 0xf1, 0x97, 0x99, 0x86, 0x60, 0x55, 0x97, 0x99, 0x86,
+			0xf1, 0x96, 0x35, 0x86, 0x40, 0x89, 0x96, 0x35, 0x85, 0x60, 0x89, 0x96, 0x99, 0x84, 0xf1, 0x96,
+			0x35, 0x84, 0x40, 0xf1, 0x97, 0xcd, 0x83, 0x60, 0x89, 0x98, 0xcd, 0x83, 0xf1, 0x98, 0x35, 0x84,
+			0x40, 0x55, 0x99, 0x35, 0x85, 0x60, 0x55, 0x99, 0xcd, 0x85, 0xf1, 0x98, 0x35, 0x86, 0x40, 0xf1,
+			0x97, 0x99, 0x86, 0xe2, 0xf1, 0x97, 0x35, 0x7c, 0x60, 0x55, 0x97, 0x35, 0x7c, 0xf1, 0x96, 0xcd,
+			0x7b, 0x40, 0x89, 0x96, 0xcd, 0x7a, 0x60, 0x89, 0x96, 0x35, 0x7a, 0xf1, 0x96, 0xcd, 0x79, 0x40,
+			0xf1, 0x97, 0x69, 0x79, 0x60, 0x89, 0x98, 0x69, 0x79, 0xf1, 0x98, 0xcd, 0x79, 0x40, 0x55, 0x99,
+			0xcd, 0x7a, 0x60, 0x55, 0x99, 0x69, 0x7b, 0xf1, 0x98, 0xcd, 0x7b, 0x40, 0xf1, 0x97, 0x35, 0x7c,
+			0xe1,
+		}},
+		ImageBrightness1: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a,
+			0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67,
+			0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60,
+			0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79,
+			0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96,
+			0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1,
+			0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55,
+			0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2,
+			0x80, 0x80, 0xe1,
+		}},
+		ImageBrightness2: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x78, 0x55, 0x69, 0x60, 0x99, 0x77, 0x55,
+			0x69, 0x35, 0x76, 0x79, 0x69, 0x40, 0x11, 0x74, 0xcd, 0x69, 0x60, 0x55, 0x78, 0x99, 0x6e, 0x99,
+			0x7a, 0x45, 0x74, 0x40, 0xdd, 0x7c, 0x80, 0x60, 0xdd, 0x7c, 0x11, 0x86, 0x99, 0x7a, 0xc5, 0x8b,
+			0x40, 0x25, 0x74, 0x25, 0x96, 0x60, 0xdd, 0x74, 0x55, 0x96, 0x35, 0x76, 0x81, 0x96, 0x40, 0x11,
+			0x79, 0xad, 0x96, 0x61, 0x69, 0x82, 0xad, 0x96, 0xf9, 0x88, 0x25, 0x90, 0x89, 0x8f, 0x99, 0x89,
+			0x89, 0x8f, 0x80, 0x40, 0xe9, 0x88, 0xdd, 0x6f, 0x60, 0x45, 0x82, 0x55, 0x69, 0xf1, 0x78, 0x55,
+			0x69, 0xe2, 0x79, 0x79, 0x55, 0x65, 0x61, 0xdd, 0x7e, 0x55, 0x65, 0x99, 0x83, 0x5d, 0x67, 0x55,
+			0x88, 0x69, 0x69, 0xe9, 0x8b, 0xf9, 0x6c, 0x40, 0x81, 0x91, 0x69, 0x75, 0x60, 0x89, 0x93, 0x45,
+			0x7a, 0x89, 0x93, 0xf1, 0x7f, 0x40, 0x79, 0x91, 0x7d, 0x8a, 0x65, 0x69, 0x8f, 0x61, 0x8f, 0xe1,
+			0x8b, 0xfd, 0x92, 0x55, 0x88, 0x99, 0x96, 0x95, 0x83, 0xa5, 0x98, 0xd1, 0x7e, 0xad, 0x9a, 0x69,
+			0x79, 0xad, 0x9a, 0xc9, 0x75, 0xad, 0x9a, 0x55, 0x72, 0xcd, 0x99, 0xdd, 0x6e, 0xf1, 0x98, 0x89,
+			0x6c, 0x99, 0x97, 0x69, 0x72, 0x35, 0x93, 0xa5, 0x75, 0x11, 0x8d, 0x40, 0xdd, 0x78, 0x09, 0x80,
+			0x60, 0xdd, 0x78, 0x25, 0x79, 0x99, 0x75, 0xf1, 0x72, 0x40, 0x79, 0x6c, 0x79, 0x68, 0x61, 0xcd,
+			0x6e, 0x25, 0x67, 0x4d, 0x72, 0x3d, 0x66, 0xcd, 0x75, 0x55, 0x65, 0x79, 0x79, 0x55, 0x65, 0xe2,
+			0xdd, 0x7c, 0x11, 0x80, 0xe1,
+		}},
+		ImageBrightness3: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x7f, 0x45, 0x95, 0x60, 0x89, 0x86, 0xcd,
+			0x92, 0x99, 0x8a, 0xf9, 0x8c, 0x40, 0xad, 0x8e, 0xe9, 0x7f, 0x60, 0xad, 0x8e, 0xad, 0x78, 0x89,
+			0x8a, 0xd5, 0x72, 0x40, 0x99, 0x7f, 0x89, 0x6a, 0x61, 0x35, 0x83, 0x25, 0x6f, 0xdd, 0x84, 0x9d,
+			0x74, 0x89, 0x86, 0x19, 0x7a, 0x89, 0x86, 0xe9, 0x7f, 0x40, 0xf1, 0x84, 0x35, 0x8b, 0x60, 0x55,
+			0x83, 0xad, 0x90, 0xbd, 0x7f, 0x45, 0x95, 0xe2, 0x89, 0x78, 0x99, 0x9a, 0x62, 0x1d, 0x77, 0x99,
+			0x9a, 0xb9, 0x75, 0x79, 0x9a, 0x55, 0x74, 0x55, 0x9a, 0x66, 0x25, 0x9a, 0xad, 0x7a, 0xae, 0x99,
+			0x7e, 0xb5, 0x8f, 0x40, 0x89, 0x82, 0xf1, 0x7f, 0x60, 0x89, 0x82, 0x79, 0x77, 0x99, 0x7e, 0x2d,
+			0x70, 0x40, 0x66, 0xbd, 0x65, 0x60, 0x55, 0x74, 0x69, 0x65, 0xb5, 0x75, 0x55, 0x65, 0x40, 0x69,
+			0x78, 0x45, 0x65, 0x61, 0xdd, 0x7d, 0x45, 0x65, 0xa9, 0x82, 0x5d, 0x67, 0x79, 0x87, 0x79, 0x69,
+			0x96, 0x19, 0x6d, 0x40, 0x99, 0x90, 0x91, 0x75, 0x60, 0xad, 0x92, 0x69, 0x7a, 0xad, 0x92, 0xe9,
+			0x7f, 0x40, 0xa5, 0x90, 0x45, 0x8a, 0x62, 0x99, 0x8e, 0x25, 0x8f, 0x11, 0x8b, 0xc1, 0x92, 0x89,
+			0x87, 0x61, 0x96, 0xc1, 0x82, 0x7d, 0x98, 0xf9, 0x7d, 0x99, 0x9a, 0x89, 0x78, 0x99, 0x9a, 0xe2,
+			0x89, 0x86, 0xf1, 0x7f, 0xe1,
+		}},
+		ImageBrightness4: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x80, 0xf1, 0x7f, 0xe2, 0x11, 0x80, 0x79, 0x98,
+			0x00, 0x45, 0x87, 0x55, 0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55,
+			0x91, 0xbd, 0x78, 0xe8, 0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78,
+			0xad, 0x6e, 0xe6, 0xad, 0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87,
+			0xe8, 0x55, 0x91, 0xe6, 0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0xf1, 0x7f, 0x55, 0x91,
+			0x60, 0x55, 0x87, 0x55, 0x91, 0x55, 0x8c, 0x4d, 0x8c, 0x40, 0x55, 0x91, 0xf1, 0x7f, 0x60, 0x55,
+			0x91, 0x99, 0x78, 0x4d, 0x8c, 0x91, 0x73, 0x40, 0xf1, 0x7f, 0x89, 0x6e, 0x60, 0x25, 0x7e, 0x89,
+			0x6e, 0x79, 0x7c, 0xe9, 0x6e, 0x40, 0xdd, 0x78, 0x25, 0x70, 0x60, 0x79, 0x7d, 0x45, 0x72, 0x45,
+			0x80, 0x89, 0x76, 0x40, 0x11, 0x83, 0xf1, 0x7f, 0x60, 0x11, 0x83, 0x11, 0x85, 0x45, 0x80, 0x55,
+			0x89, 0x40, 0xdd, 0x78, 0xcd, 0x8f, 0x60, 0x79, 0x7a, 0x89, 0x90, 0x3d, 0x7c, 0xf1, 0x90, 0x40,
+			0xf1, 0x7f, 0x55, 0x91, 0xe1,
+		}},
+		ImageBrightness5: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x66, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x55, 0x91, 0x31, 0x87, 0x55, 0x91, 0xf1, 0x7f, 0x55, 0x91, 0xbd,
+			0x78, 0x4d, 0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xd1, 0x78, 0xad,
+			0x6e, 0xbd, 0x73, 0xb5, 0x73, 0xad, 0x6e, 0xbd, 0x78, 0xad, 0x6e, 0xf1, 0x7f, 0xad, 0x6e, 0x31,
+			0x87, 0xbd, 0x73, 0x45, 0x8c, 0x40, 0x11, 0x80, 0x55, 0x91, 0xe2, 0x09, 0x80, 0x55, 0x8d, 0x60,
+			0x79, 0x7a, 0x55, 0x8d, 0x91, 0x76, 0x71, 0x89, 0x40, 0xad, 0x72, 0xf9, 0x7f, 0x60, 0xad, 0x72,
+			0x69, 0x7a, 0x91, 0x76, 0x89, 0x76, 0x40, 0x09, 0x80, 0xad, 0x72, 0x60, 0x99, 0x85, 0xad, 0x72,
+			0x79, 0x89, 0x89, 0x76, 0x40, 0x55, 0x8d, 0xf9, 0x7f, 0x60, 0x55, 0x8d, 0x89, 0x85, 0x79, 0x89,
+			0x71, 0x89, 0x40, 0x09, 0x80, 0x55, 0x8d, 0xe2, 0x11, 0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55,
+			0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8,
+			0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad,
+			0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80, 0xf1, 0x7f, 0xe1,
+		}},
+		ImageBrightness6: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x60, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x40, 0x55, 0x91, 0xf1, 0x7f, 0x61, 0x55, 0x91, 0xbd, 0x78, 0x4d,
+			0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0x11,
+			0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55, 0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99,
+			0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8, 0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69,
+			0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad, 0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad,
+			0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80,
+			0xf1, 0x7f, 0xe1,
+		}},
+		ImageBrightness7: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0x11, 0x9e, 0x00, 0x11, 0x77, 0x55,
+			0x95, 0xe6, 0xad, 0x6a, 0xe8, 0xf1, 0x88, 0x01, 0xbd, 0x61, 0x80, 0xad, 0x6a, 0x11, 0x77, 0xe8,
+			0xad, 0x6a, 0xe6, 0x11, 0x77, 0x01, 0x11, 0x80, 0xbd, 0x61, 0xf1, 0x88, 0xad, 0x6a, 0xe6, 0x55,
+			0x95, 0xe8, 0x11, 0x77, 0x01, 0x45, 0x9e, 0x80, 0x55, 0x95, 0xf1, 0x88, 0xe8, 0x55, 0x95, 0xe6,
+			0xf1, 0x88, 0x00, 0x11, 0x80, 0x11, 0x9e, 0xe2, 0x11, 0x80, 0x55, 0x91, 0x66, 0x45, 0x87, 0x55,
+			0x91, 0x4d, 0x8c, 0x45, 0x8c, 0x55, 0x91, 0x31, 0x87, 0x55, 0x91, 0xf1, 0x7f, 0x55, 0x91, 0xbd,
+			0x78, 0x4d, 0x8c, 0xb5, 0x73, 0x45, 0x87, 0xad, 0x6e, 0x11, 0x80, 0xad, 0x6e, 0xd1, 0x78, 0xad,
+			0x6e, 0xbd, 0x73, 0xb5, 0x73, 0xad, 0x6e, 0xbd, 0x78, 0xad, 0x6e, 0xf1, 0x7f, 0xad, 0x6e, 0x31,
+			0x87, 0xbd, 0x73, 0x45, 0x8c, 0x40, 0x11, 0x80, 0x55, 0x91, 0xe2, 0x09, 0x80, 0x55, 0x8d, 0x60,
+			0x79, 0x7a, 0x55, 0x8d, 0x91, 0x76, 0x71, 0x89, 0x40, 0xad, 0x72, 0xf9, 0x7f, 0x60, 0xad, 0x72,
+			0x69, 0x7a, 0x91, 0x76, 0x89, 0x76, 0x40, 0x09, 0x80, 0xad, 0x72, 0x60, 0x99, 0x85, 0xad, 0x72,
+			0x79, 0x89, 0x89, 0x76, 0x40, 0x55, 0x8d, 0xf9, 0x7f, 0x60, 0x55, 0x8d, 0x89, 0x85, 0x79, 0x89,
+			0x71, 0x89, 0x40, 0x09, 0x80, 0x55, 0x8d, 0xe2, 0x11, 0x80, 0x79, 0x98, 0x00, 0x45, 0x87, 0x55,
+			0x91, 0xe6, 0x55, 0x91, 0xe8, 0x45, 0x87, 0x01, 0x99, 0x98, 0x80, 0x55, 0x91, 0xbd, 0x78, 0xe8,
+			0xad, 0x6e, 0xe6, 0x45, 0x87, 0x01, 0x11, 0x80, 0x69, 0x67, 0xbd, 0x78, 0xad, 0x6e, 0xe6, 0xad,
+			0x6e, 0xe8, 0xbd, 0x78, 0x01, 0x69, 0x67, 0x80, 0xad, 0x6e, 0x45, 0x87, 0xe8, 0x55, 0x91, 0xe6,
+			0xad, 0x78, 0x00, 0x11, 0x80, 0x79, 0x98, 0xe2, 0x80, 0xf1, 0x7f, 0xe2, 0x11, 0x80, 0x90, 0x62,
+			0xad, 0x7c, 0x90, 0x59, 0x7a, 0xa9, 0x85, 0x70, 0x55, 0x83, 0x70, 0xf1, 0x7f, 0x70, 0x99, 0x7c,
+			0x59, 0x7a, 0x4d, 0x7a, 0x40, 0x11, 0x80, 0x70, 0x60, 0x69, 0x83, 0x70, 0xb5, 0x85, 0x4d, 0x7a,
+			0x40, 0x90, 0xf1, 0x7f, 0x61, 0x90, 0x55, 0x83, 0xb5, 0x85, 0xa9, 0x85, 0x69, 0x83, 0x90, 0x11,
+			0x80, 0x90, 0xe1,
+		}},
+		ImageBrokenImage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0xdd,
+			0x6e, 0x11, 0x83, 0x03, 0x55, 0x7a, 0x99, 0x77, 0xad, 0x85, 0xf1, 0x82, 0x11, 0x91, 0x89, 0x77,
+			0xa8, 0x79, 0x7a, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x35, 0x80, 0x00, 0xdd, 0x6e, 0x11, 0x83, 0xe2,
+			0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x25, 0x80, 0x04, 0x11, 0x91, 0x35, 0x7d, 0xad, 0x85, 0x99, 0x88,
+			0x55, 0x7a, 0x45, 0x7d, 0xdd, 0x6e, 0xbd, 0x88, 0x58, 0xdd, 0x85, 0xe8, 0xa8, 0xe2, 0x58, 0xa8,
+			0xe8, 0x25, 0x80, 0xe8, 0x25, 0x84, 0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		ImageBrush: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6e, 0x35, 0x98, 0x62, 0x15, 0x6c, 0x35,
+			0x98, 0xe1, 0x69, 0x71, 0x97, 0xad, 0x67, 0xad, 0x96, 0x4c, 0xf1, 0x94, 0x55, 0x68, 0x25, 0x94,
+			0x55, 0x69, 0x99, 0x92, 0x40, 0x55, 0x6a, 0x79, 0x8e, 0x62, 0x55, 0x6a, 0x8d, 0x8b, 0x61, 0x6c,
+			0x85, 0x89, 0x6d, 0x6e, 0x79, 0x87, 0x59, 0x71, 0x79, 0x87, 0x45, 0x74, 0x79, 0x87, 0x4d, 0x76,
+			0x85, 0x89, 0x40, 0x55, 0x78, 0x79, 0x8e, 0x60, 0x55, 0x78, 0xbd, 0x92, 0x71, 0x75, 0x79, 0x95,
+			0x40, 0x55, 0x6e, 0x35, 0x98, 0xe2, 0x55, 0x6e, 0x35, 0x94, 0x60, 0xad, 0x70, 0x35, 0x94, 0x81,
+			0x72, 0x89, 0x92, 0x40, 0x55, 0x74, 0x79, 0x8e, 0x60, 0x55, 0x74, 0x25, 0x8d, 0x81, 0x73, 0x4d,
+			0x8c, 0x40, 0x55, 0x71, 0x79, 0x8b, 0x60, 0x60, 0x79, 0x8b, 0x2d, 0x6f, 0x4d, 0x8c, 0x40, 0x55,
+			0x6e, 0x79, 0x8e, 0x60, 0x55, 0x6e, 0x11, 0x91, 0xc5, 0x6d, 0x4d, 0x92, 0x40, 0xad, 0x6b, 0xcd,
+			0x93, 0x60, 0x11, 0x6c, 0xdd, 0x93, 0x5a, 0x09, 0x94, 0x40, 0x55, 0x6e, 0x35, 0x94, 0xe2, 0xad,
+			0x7d, 0x69, 0x88, 0x01, 0xad, 0x77, 0x11, 0x82, 0xbd, 0x90, 0x52, 0x60, 0xad, 0x91, 0x11, 0x68,
+			0xcd, 0x92, 0x09, 0x68, 0x40, 0xf1, 0x94, 0x52, 0x00, 0xdd, 0x96, 0xf1, 0x6a, 0x60, 0xdd, 0x97,
+			0xf1, 0x6b, 0xd5, 0x97, 0x19, 0x6d, 0x40, 0xdd, 0x96, 0x35, 0x6f, 0x00, 0xad, 0x7d, 0x69, 0x88,
+			0xe2, 0x55, 0x71, 0x79, 0x8e, 0xe1,
+		}},
+		ImageBurstMode: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0xad, 0x92, 0xe8, 0x55, 0x6d, 0xe6,
+			0xad, 0x66, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x62, 0xe2, 0x55, 0x6d, 0xad, 0x92, 0xe8, 0x55, 0x6d,
+			0xe6, 0x55, 0x71, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x6d, 0xe2, 0x78, 0xad, 0x92, 0x60, 0x69, 0x7a,
+			0xad, 0x92, 0x35, 0x79, 0x79, 0x91, 0x40, 0x70, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0x70, 0xbd,
+			0x6f, 0x35, 0x79, 0x89, 0x6e, 0x40, 0x78, 0x55, 0x6d, 0xe6, 0x55, 0x99, 0x60, 0xf1, 0x9a, 0x55,
+			0x6d, 0x25, 0x9c, 0x89, 0x6e, 0x40, 0x55, 0x9d, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60, 0x55, 0x9d,
+			0x45, 0x90, 0x25, 0x9c, 0x79, 0x91, 0x40, 0x55, 0x99, 0xad, 0x92, 0xe6, 0x78, 0xe2, 0x78, 0xad,
+			0x8e, 0xe6, 0x55, 0x99, 0xe8, 0x55, 0x71, 0xe6, 0x78, 0xe8, 0xad, 0x8e, 0xe2, 0x80, 0x35, 0x88,
+			0xe6, 0x99, 0x95, 0x03, 0xf1, 0x8e, 0x55, 0x7f, 0x55, 0x89, 0xad, 0x86, 0x45, 0x85, 0x35, 0x81,
+			0x80, 0x35, 0x88, 0xe2, 0x78, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ImageCamera: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x7e, 0xdd, 0x77, 0xe6, 0x45, 0x95, 0x60,
+			0x69, 0x93, 0xf1, 0x72, 0x55, 0x8f, 0x2d, 0x6f, 0x40, 0x11, 0x86, 0x54, 0x00, 0x45, 0x7e, 0xdd,
+			0x77, 0xe2, 0x11, 0x78, 0x69, 0x7d, 0x00, 0xad, 0x83, 0x79, 0x69, 0x60, 0xdd, 0x82, 0x69, 0x69,
+			0xdd, 0x81, 0x5d, 0x69, 0x40, 0x80, 0x55, 0x69, 0x60, 0x35, 0x7b, 0x55, 0x69, 0x35, 0x77, 0x11,
+			0x6b, 0x40, 0x60, 0xdd, 0x6f, 0x00, 0x11, 0x78, 0x69, 0x7d, 0xe2, 0x54, 0xcd, 0x85, 0xe6, 0xcd,
+			0x79, 0x00, 0x79, 0x6e, 0x99, 0x71, 0x60, 0xf1, 0x6b, 0x99, 0x74, 0xa5, 0x6a, 0x4d, 0x78, 0x40,
+			0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x81, 0x79, 0x69, 0xf1, 0x82, 0x40, 0x54, 0xcd, 0x85,
+			0xe2, 0x74, 0xf1, 0x95, 0x00, 0x84, 0x25, 0x88, 0xe6, 0xcd, 0x6a, 0x60, 0xad, 0x6c, 0x11, 0x8d,
+			0xb5, 0x70, 0xd5, 0x90, 0x40, 0x74, 0xf1, 0x95, 0xe2, 0x80, 0xad, 0x96, 0x60, 0xcd, 0x84, 0xad,
+			0x96, 0xd5, 0x88, 0xf1, 0x94, 0x40, 0xa0, 0x25, 0x90, 0x01, 0x90, 0x99, 0x82, 0x55, 0x7c, 0x89,
+			0x96, 0x60, 0x35, 0x7d, 0x99, 0x96, 0x2d, 0x7e, 0xa5, 0x96, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x99,
+			0x91, 0x69, 0x8e, 0x60, 0xdd, 0x93, 0x99, 0x8b, 0x45, 0x95, 0xcd, 0x87, 0x40, 0xad, 0x96, 0x80,
+			0x60, 0xad, 0x96, 0x89, 0x7e, 0x89, 0x96, 0x09, 0x7d, 0x40, 0x11, 0x96, 0x25, 0x7a, 0xe6, 0x45,
+			0x86, 0x00, 0x99, 0x91, 0x69, 0x8e, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a,
+			0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f,
+			0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0xa5,
+			0x75, 0x40, 0x2d, 0x6d, 0x2d, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x5d, 0x8a, 0x71, 0x67, 0x40, 0xd5, 0x92, 0x2d,
+			0x6d, 0x60, 0x79, 0x96, 0xcd, 0x70, 0x91, 0x98, 0xa5, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad,
+			0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xd5, 0x92, 0xd5, 0x92, 0x60, 0x35, 0x8f, 0x79,
+			0x96, 0x5d, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe1,
+		}},
+		ImageCameraAlt: ImageCameraAlt,
+		ImageCameraFront: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x7a, 0xb8, 0x01, 0xdd, 0x77, 0x25, 0x99,
+			0xad, 0x7b, 0x55, 0x95, 0xe6, 0x55, 0x6d, 0xe8, 0x55, 0x91, 0xe6, 0xcd, 0x7b, 0x03, 0xdd, 0x77,
+			0x69, 0x8d, 0xbd, 0x7a, 0x89, 0x8a, 0x79, 0x83, 0x45, 0x93, 0xbd, 0x7a, 0xb8, 0xe2, 0x55, 0x6d,
+			0xdd, 0x8d, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55,
+			0x71, 0x55, 0x65, 0xe6, 0xad, 0x8e, 0x60, 0x45, 0x90, 0x55, 0x65, 0x79, 0x91, 0x89, 0x66, 0x40,
+			0xad, 0x92, 0x55, 0x69, 0xe8, 0xdd, 0x8d, 0xe6, 0xad, 0x8e, 0xe8, 0x79, 0x88, 0x60, 0xbd, 0x8b,
+			0xbd, 0x86, 0x2d, 0x88, 0xd5, 0x85, 0x40, 0x80, 0xf1, 0x84, 0x60, 0x69, 0x7b, 0xf1, 0x84, 0xdd,
+			0x77, 0xd5, 0x85, 0x40, 0x55, 0x71, 0x79, 0x88, 0xe8, 0xdd, 0x8d, 0xe6, 0x55, 0x6d, 0xe2, 0x55,
+			0x71, 0xdd, 0x83, 0x60, 0xcd, 0x74, 0x55, 0x82, 0x81, 0x78, 0x91, 0x81, 0x40, 0x80, 0xcd, 0x80,
+			0x61, 0xcd, 0x83, 0xcd, 0x80, 0x7d, 0x87, 0x91, 0x81, 0x2d, 0x8b, 0x55, 0x82, 0xad, 0x8e, 0xdd,
+			0x83, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xdd, 0x83, 0xe2, 0x55, 0x85, 0x55, 0x95, 0xe8,
+			0x55, 0x91, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x85, 0xe2, 0xfd, 0x7f, 0xf1, 0x7c,
+			0x67, 0xdd, 0x7c, 0xf1, 0x7c, 0xb5, 0x7a, 0xc1, 0x7a, 0x89, 0x78, 0x91, 0x78, 0x89, 0x78, 0x75,
+			0x75, 0x89, 0x78, 0x55, 0x72, 0xb9, 0x7a, 0x2d, 0x70, 0xe5, 0x7c, 0x5c, 0x05, 0x80, 0x5c, 0x25,
+			0x83, 0x5c, 0x4d, 0x85, 0x31, 0x70, 0x79, 0x87, 0x5d, 0x72, 0x79, 0x87, 0x7d, 0x75, 0x79, 0x87,
+			0x99, 0x78, 0x49, 0x85, 0xc5, 0x7a, 0x1d, 0x83, 0xf1, 0x7c, 0xfd, 0x7f, 0xf1, 0x7c, 0xe2, 0x80,
+			0xf1, 0x78, 0x60, 0x79, 0x81, 0xf1, 0x78, 0x79, 0x82, 0xf1, 0x77, 0x40, 0x79, 0x83, 0x79, 0x75,
+			0x60, 0x79, 0x83, 0x68, 0x79, 0x82, 0x66, 0x40, 0x80, 0x64, 0x60, 0x89, 0x7e, 0x64, 0x89, 0x7d,
+			0x66, 0x40, 0x89, 0x7c, 0x79, 0x75, 0x60, 0x89, 0x7c, 0xf1, 0x76, 0x89, 0x7d, 0xf1, 0x77, 0x40,
+			0x80, 0xf1, 0x78, 0xe2, 0x80, 0xf1, 0x84, 0xe2, 0x80, 0x79, 0x75, 0xe1,
+		}},
+		ImageCameraRear: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xdd, 0x8d, 0xe8, 0x55, 0x69, 0x60,
+			0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x8e,
+			0x60, 0x45, 0x90, 0x55, 0x65, 0x79, 0x91, 0x89, 0x66, 0x40, 0xad, 0x92, 0x55, 0x69, 0xe8, 0xdd,
+			0x8d, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xdd, 0x8d, 0xe6, 0x55, 0x6d,
+			0xe2, 0x05, 0x80, 0x55, 0x79, 0x60, 0xad, 0x81, 0x55, 0x79, 0xd5, 0x82, 0x29, 0x78, 0x40, 0x88,
+			0x55, 0x75, 0x60, 0x88, 0xad, 0x73, 0xd5, 0x82, 0x81, 0x72, 0x40, 0xfd, 0x7f, 0x55, 0x71, 0x60,
+			0x55, 0x7e, 0x55, 0x71, 0x2d, 0x7d, 0x85, 0x72, 0x40, 0x78, 0x59, 0x75, 0x60, 0x78, 0x6e, 0x2d,
+			0x7d, 0x2d, 0x78, 0x40, 0x05, 0x80, 0x55, 0x79, 0xe2, 0xbd, 0x7a, 0xb8, 0x01, 0xdd, 0x77, 0x25,
+			0x99, 0xad, 0x7b, 0x55, 0x95, 0xe6, 0x55, 0x6d, 0xe8, 0x55, 0x91, 0xe6, 0xcd, 0x7b, 0x03, 0xdd,
+			0x77, 0x69, 0x8d, 0xbd, 0x7a, 0x89, 0x8a, 0x79, 0x83, 0x45, 0x93, 0xbd, 0x7a, 0xb8, 0xe2, 0x55,
+			0x85, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x85, 0xe2,
+			0x25, 0x80, 0x69, 0x7b, 0xe1,
+		}},
+		ImageCameraRoll: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x9a, 0x55, 0x95, 0xe6, 0x55, 0x85, 0xe8,
+			0xad, 0x96, 0x60, 0x55, 0x85, 0x51, 0x98, 0x29, 0x84, 0x7d, 0x99, 0x40, 0x55, 0x81, 0xad, 0x9a,
+			0xe6, 0x55, 0x69, 0x60, 0xb1, 0x67, 0xad, 0x9a, 0x85, 0x66, 0x7d, 0x99, 0x40, 0x55, 0x65, 0xad,
+			0x96, 0xe8, 0x58, 0x60, 0x55, 0x65, 0x59, 0x6a, 0x85, 0x66, 0x2d, 0x69, 0x40, 0x55, 0x69, 0x50,
+			0xe6, 0x55, 0x6d, 0xe8, 0x55, 0x65, 0x60, 0x55, 0x6d, 0x35, 0x64, 0x19, 0x6e, 0x71, 0x63, 0x40,
+			0x60, 0xad, 0x62, 0xe6, 0xad, 0x7a, 0x60, 0xcd, 0x7b, 0xad, 0x62, 0x91, 0x7c, 0x71, 0x63, 0x40,
+			0x55, 0x7d, 0x55, 0x65, 0xe8, 0x50, 0xe6, 0x55, 0x81, 0x60, 0xfd, 0x82, 0x50, 0x29, 0x84, 0x2d,
+			0x69, 0x40, 0x55, 0x85, 0x58, 0xe8, 0x55, 0x6d, 0xe6, 0xad, 0x9a, 0xe8, 0x55, 0x95, 0xe2, 0xad,
+			0x96, 0x55, 0x91, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x81, 0xe8, 0x58, 0xe6, 0x55, 0x79, 0xe8, 0xad,
+			0x66, 0xe6, 0x55, 0x71, 0xe8, 0x58, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x81, 0xe8,
+			0x55, 0x91, 0xe6, 0xad, 0x96, 0xe2, 0x55, 0x79, 0x55, 0x8d, 0xe6, 0x55, 0x7d, 0xe8, 0x55, 0x89,
+			0xe6, 0x55, 0x79, 0xe8, 0x55, 0x8d, 0xe2, 0x55, 0x79, 0x55, 0x79, 0xe6, 0x55, 0x7d, 0xe8, 0x55,
+			0x75, 0xe6, 0x55, 0x79, 0xe8, 0x55, 0x79, 0xe2, 0x88, 0x55, 0x8d, 0xe6, 0x90, 0xe8, 0x55, 0x89,
+			0xe6, 0x88, 0xe8, 0x55, 0x8d, 0xe2, 0x88, 0x55, 0x79, 0xe6, 0x90, 0xe8, 0x55, 0x75, 0xe6, 0x88,
+			0xe8, 0x55, 0x79, 0xe2, 0xad, 0x8e, 0x55, 0x8d, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x89, 0xe6, 0xad,
+			0x8e, 0xe8, 0x55, 0x8d, 0xe2, 0xad, 0x8e, 0x55, 0x79, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x75, 0xe6,
+			0xad, 0x8e, 0xe8, 0x55, 0x79, 0xe2, 0x80, 0xad, 0x7e, 0xe1,
+		}},
+		ImageCenterFocusStrong: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x79, 0x77, 0xe8,
+			0xb0, 0xe6, 0x58, 0xe2, 0x89, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0xb0,
+			0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x89, 0x88,
+			0xe2, 0x50, 0x79, 0x77, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58,
+			0x50, 0xe6, 0x79, 0x77, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0x50, 0xe2, 0xa8, 0x79,
+			0x77, 0xe8, 0x58, 0xe6, 0x89, 0x88, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96,
+			0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0xa8, 0xe2, 0x80, 0x79, 0x8c, 0x67, 0xd5,
+			0x7a, 0x79, 0x8c, 0x31, 0x77, 0xd1, 0x88, 0x89, 0x73, 0x2d, 0x85, 0x89, 0x73, 0x80, 0x89, 0x73,
+			0xd5, 0x7a, 0x31, 0x77, 0x31, 0x77, 0xd5, 0x7a, 0x89, 0x73, 0x80, 0x89, 0x73, 0x2d, 0x85, 0x89,
+			0x73, 0xd1, 0x88, 0x31, 0x77, 0x79, 0x8c, 0xd5, 0x7a, 0x79, 0x8c, 0x80, 0x79, 0x8c, 0x2d, 0x85,
+			0xd1, 0x88, 0xd1, 0x88, 0x2d, 0x85, 0x79, 0x8c, 0x80, 0x79, 0x8c, 0xe2, 0x80, 0x79, 0x88, 0x60,
+			0x99, 0x83, 0x79, 0x88, 0x09, 0x86, 0x09, 0x86, 0x40, 0x79, 0x88, 0x80, 0x60, 0x79, 0x88, 0x69,
+			0x7c, 0x09, 0x86, 0xf9, 0x79, 0x40, 0x80, 0x89, 0x77, 0x60, 0x69, 0x7c, 0x89, 0x77, 0xf9, 0x79,
+			0xf9, 0x79, 0x40, 0x89, 0x77, 0x80, 0x60, 0x89, 0x77, 0x99, 0x83, 0xf9, 0x79, 0x09, 0x86, 0x40,
+			0x80, 0x79, 0x88, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageCenterFocusWeak: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x89, 0x89, 0x60, 0xcd, 0x7b, 0x89, 0x89,
+			0x25, 0x79, 0xdd, 0x86, 0x40, 0x79, 0x76, 0x80, 0x60, 0x79, 0x76, 0xcd, 0x7b, 0x25, 0x79, 0x25,
+			0x79, 0x40, 0x80, 0x79, 0x76, 0x60, 0x35, 0x84, 0x79, 0x76, 0xdd, 0x86, 0x25, 0x79, 0x40, 0x89,
+			0x89, 0x80, 0x60, 0x89, 0x89, 0x35, 0x84, 0xdd, 0x86, 0xdd, 0x86, 0x40, 0x80, 0x89, 0x89, 0xe2,
+			0x80, 0x89, 0x85, 0x60, 0x55, 0x82, 0x89, 0x85, 0xf1, 0x83, 0xf1, 0x83, 0x40, 0x89, 0x85, 0x80,
+			0x60, 0x89, 0x85, 0xad, 0x7d, 0xf1, 0x83, 0x11, 0x7c, 0x40, 0x80, 0x79, 0x7a, 0x60, 0xad, 0x7d,
+			0x79, 0x7a, 0x11, 0x7c, 0x11, 0x7c, 0x40, 0x79, 0x7a, 0x80, 0x60, 0x79, 0x7a, 0x55, 0x82, 0x11,
+			0x7c, 0xf1, 0x83, 0x40, 0x80, 0x89, 0x85, 0xe2, 0x80, 0x80, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a,
+			0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0x58, 0xe8, 0xa8, 0xe6,
+			0x79, 0x77, 0xe8, 0xb0, 0xe6, 0x58, 0xe2, 0x89, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x89,
+			0x88, 0xe6, 0xb0, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0,
+			0xe6, 0x89, 0x88, 0xe2, 0x50, 0x79, 0x77, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35,
+			0x69, 0x40, 0x58, 0x50, 0xe6, 0x79, 0x77, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0x50,
+			0xe2, 0xa8, 0x79, 0x77, 0xe8, 0x58, 0xe6, 0x89, 0x88, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95,
+			0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0xa8, 0xe1,
+		}},
+		ImageCollections: ImageCollections,
+		ImageCollectionsBookmark: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x69, 0xe6, 0xad, 0x92, 0xe8, 0xdd, 0x7b, 0x01, 0x79, 0x8c, 0x11, 0x78, 0x45, 0x86, 0xdd,
+			0x7b, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60,
+			0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8,
+			0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92,
+			0xe6, 0x55, 0x71, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79,
+			0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x45, 0x86, 0x55, 0x69, 0xe6, 0xad, 0x92,
+			0xe6, 0x45, 0x86, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe6, 0xad, 0x96, 0xe6, 0x55, 0x71, 0xe1,
+		}},
+		ImageColorLens: ImageColorLens,
+		ImageColorize: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xcd, 0x8c, 0x04, 0x25, 0x81,
+			0xad, 0x73, 0x55, 0x7c, 0xdd, 0x6e, 0x11, 0x7f, 0x25, 0x6c, 0x35, 0x85, 0x45, 0x72, 0xad, 0x8e,
+			0xcd, 0x68, 0x60, 0x69, 0x8f, 0x11, 0x68, 0x3d, 0x90, 0x11, 0x68, 0x40, 0xcd, 0x91, 0xcd, 0x68,
+			0x00, 0x35, 0x97, 0x35, 0x6e, 0x60, 0xf1, 0x97, 0xf1, 0x6e, 0xf1, 0x97, 0xc5, 0x6f, 0x40, 0x35,
+			0x97, 0x55, 0x71, 0x04, 0xbd, 0x8d, 0xcd, 0x7a, 0xdd, 0x93, 0xf1, 0x80, 0x25, 0x91, 0xad, 0x83,
+			0x55, 0x8c, 0xdd, 0x7e, 0x35, 0x73, 0xb0, 0xe6, 0x50, 0xe2, 0x58, 0xa8, 0xe6, 0xcd, 0x71, 0x02,
+			0x79, 0x89, 0x55, 0x7c, 0xad, 0x83, 0x89, 0x76, 0x58, 0x35, 0x8e, 0xe8, 0xa8, 0xe2, 0xdd, 0x8a,
+			0x70, 0x03, 0x25, 0x93, 0xbd, 0x6f, 0x45, 0x90, 0xdd, 0x6c, 0x90, 0x25, 0x75, 0xdd, 0x8a, 0x70,
+			0xe2, 0xdd, 0x8a, 0x70, 0x01, 0x90, 0x25, 0x75, 0xdd, 0x8a, 0x70, 0xe1,
+		}},
+		ImageCompare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7c, 0x55, 0x9d, 0xe8, 0xb0, 0xe6, 0x58,
+			0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69,
+			0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6, 0x25, 0x7c, 0xe8, 0xad, 0x62, 0xe6, 0x25,
+			0x80, 0xe8, 0x55, 0x9d, 0xe6, 0x25, 0x7c, 0xe2, 0x58, 0x35, 0x91, 0xe6, 0x25, 0x7c, 0xe8, 0xbd,
+			0x7e, 0x00, 0x58, 0x35, 0x91, 0xe2, 0x25, 0x84, 0xb0, 0xe8, 0x7e, 0x00, 0xa8, 0x35, 0x91, 0xe8,
+			0x58, 0xe6, 0x25, 0x84, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69,
+			0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0,
+			0xe6, 0x25, 0x84, 0xe1,
+		}},
+		ImageControlPoint: ImageControlPoint,
+		ImageControlPointDuplicate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x86, 0x25, 0x8a, 0xe6, 0x11, 0x8a, 0xe8,
+			0xdd, 0x81, 0xe6, 0x45, 0x92, 0xe8, 0xdd, 0x7d, 0xe6, 0x11, 0x8a, 0xe8, 0xad, 0x75, 0xe6, 0x11,
+			0x86, 0xe8, 0xdd, 0x7d, 0xe6, 0xcd, 0x7d, 0xe8, 0xdd, 0x81, 0xe6, 0x11, 0x86, 0xe8, 0x25, 0x8a,
+			0xe2, 0x89, 0x6f, 0x69, 0x96, 0x61, 0x89, 0x68, 0x99, 0x93, 0x45, 0x64, 0x85, 0x8d, 0x40, 0x71,
+			0x87, 0x40, 0xf5, 0x7f, 0x40, 0x4d, 0x64, 0x69, 0x72, 0x60, 0x99, 0x68, 0x55, 0x6c, 0x89, 0x6f,
+			0x89, 0x69, 0xe8, 0x11, 0x6e, 0x62, 0x45, 0x6a, 0x79, 0x70, 0x25, 0x67, 0x51, 0x75, 0x48, 0x29,
+			0x7a, 0x48, 0xf1, 0x7f, 0x48, 0xcd, 0x85, 0x25, 0x67, 0x99, 0x8a, 0x40, 0x89, 0x6f, 0xdd, 0x91,
+			0xe8, 0x69, 0x96, 0xe2, 0x90, 0xf1, 0x97, 0x60, 0x86, 0xf1, 0x97, 0xa5, 0x7e, 0x09, 0x96, 0x40,
+			0x09, 0x77, 0xe9, 0x90, 0x60, 0xcd, 0x73, 0xad, 0x8d, 0xe9, 0x71, 0x4d, 0x89, 0x40, 0x60, 0xf1,
+			0x7f, 0x60, 0x60, 0xf1, 0x7a, 0xe9, 0x71, 0x91, 0x76, 0x40, 0x09, 0x77, 0xf9, 0x6e, 0x60, 0x45,
+			0x7a, 0xbd, 0x6b, 0xa5, 0x7e, 0xd5, 0x69, 0x40, 0x90, 0xf1, 0x67, 0x60, 0x9a, 0xf1, 0x67, 0x5d,
+			0x91, 0xd5, 0x69, 0x40, 0xf9, 0x98, 0xf9, 0x6e, 0x60, 0x35, 0x9c, 0x35, 0x72, 0x19, 0x9e, 0x91,
+			0x76, 0x40, 0xc0, 0xf1, 0x7f, 0x60, 0xc0, 0xf1, 0x84, 0x19, 0x9e, 0x4d, 0x89, 0x40, 0xf9, 0x98,
+			0xe9, 0x90, 0x60, 0xbd, 0x95, 0x25, 0x94, 0x5d, 0x91, 0x09, 0x96, 0x40, 0x90, 0xf1, 0x97, 0xe2,
+			0x90, 0xf1, 0x7f, 0xe2, 0x09, 0x88, 0xf1, 0x93, 0x64, 0x55, 0x90, 0xf1, 0x93, 0x2d, 0x96, 0x1d,
+			0x8e, 0xb8, 0x4d, 0x88, 0xb8, 0xf1, 0x7f, 0xb8, 0xad, 0x77, 0x2d, 0x96, 0xcd, 0x71, 0x5d, 0x90,
+			0xf1, 0x6b, 0x90, 0xf1, 0x6b, 0xbd, 0x7f, 0xf1, 0x6b, 0xdd, 0x79, 0xcd, 0x71, 0x40, 0x68, 0xf1,
+			0x7f, 0x61, 0x68, 0x4d, 0x88, 0xdd, 0x79, 0x1d, 0x8e, 0xbd, 0x7f, 0xf1, 0x93, 0x09, 0x88, 0xf1,
+			0x93, 0xe1,
+		}},
+		ImageCrop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8e, 0x55, 0x9d, 0xe8, 0x55, 0x92, 0xe6,
+			0xad, 0x71, 0x60, 0x11, 0x70, 0x55, 0x92, 0xdd, 0x6e, 0x25, 0x91, 0x40, 0xad, 0x6d, 0x55, 0x8e,
+			0xe8, 0xad, 0x71, 0xe6, 0xad, 0x62, 0xe8, 0xad, 0x6d, 0xe6, 0xad, 0x6d, 0xe8, 0xad, 0x62, 0xe6,
+			0xad, 0x71, 0xe8, 0x55, 0x8e, 0xe6, 0x55, 0x9d, 0xe8, 0x55, 0x92, 0xe6, 0x55, 0x92, 0xe8, 0x55,
+			0x9d, 0xe6, 0x55, 0x8e, 0xe2, 0x55, 0x8e, 0x55, 0x8a, 0xe8, 0xad, 0x71, 0xe6, 0xad, 0x75, 0xe8,
+			0xad, 0x6d, 0xe6, 0x55, 0x8e, 0x60, 0xf1, 0x8f, 0xad, 0x6d, 0x25, 0x91, 0xdd, 0x6e, 0x40, 0x55,
+			0x92, 0xad, 0x71, 0xe8, 0x55, 0x8a, 0xe6, 0x55, 0x8e, 0xe1,
+		}},
+		ImageCrop169: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x55, 0x8d, 0x60, 0x69, 0x6a, 0x55, 0x8d,
+			0x35, 0x69, 0x25, 0x8c, 0x40, 0x50, 0x55, 0x89, 0xe8, 0xad, 0x76, 0x60, 0x50, 0x11, 0x75, 0x35,
+			0x69, 0xdd, 0x73, 0x40, 0x58, 0xad, 0x72, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0xad, 0x72, 0xcd, 0x96,
+			0xdd, 0x73, 0x40, 0xb0, 0xad, 0x76, 0xe8, 0x55, 0x89, 0x60, 0xb0, 0xf1, 0x8a, 0xcd, 0x96, 0x25,
+			0x8c, 0x40, 0xa8, 0x55, 0x8d, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x89, 0xe6, 0xa8, 0xe8, 0xad, 0x76,
+			0xe6, 0x58, 0xe8, 0x55, 0x89, 0xe2, 0x58, 0x55, 0x89, 0xe8, 0xad, 0x76, 0xe8, 0x55, 0x89, 0xe1,
+		}},
+		ImageCrop32: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xa0, 0x60, 0x69, 0x6a, 0xa0, 0x35, 0x69,
+			0xcd, 0x8e, 0x40, 0x50, 0x98, 0xe8, 0x68, 0x60, 0x50, 0x69, 0x72, 0x35, 0x69, 0x35, 0x71, 0x40,
+			0x58, 0x60, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x60, 0xcd, 0x96, 0x35, 0x71, 0x40, 0xb0, 0x68, 0xe8,
+			0x98, 0x60, 0xb0, 0x99, 0x8d, 0xcd, 0x96, 0xcd, 0x8e, 0x40, 0xa8, 0xa0, 0xe6, 0x58, 0xe2, 0x58,
+			0x98, 0xe6, 0xa8, 0xe8, 0x68, 0xe6, 0x58, 0xe8, 0x98, 0xe2, 0x58, 0x98, 0xe8, 0x68, 0xe8, 0x98,
+			0xe1,
+		}},
+		ImageCrop54: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0x55, 0x95, 0x60, 0x69, 0x6a, 0x55, 0x95,
+			0x35, 0x69, 0x25, 0x94, 0x40, 0x50, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x50, 0x11, 0x6d, 0x35,
+			0x69, 0xdd, 0x6b, 0x40, 0x58, 0xad, 0x6a, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0xad, 0x6a, 0xcd, 0x96,
+			0xdd, 0x6b, 0x40, 0xb0, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60, 0xb0, 0xf1, 0x92, 0xcd, 0x96, 0x25,
+			0x94, 0x40, 0xa8, 0x55, 0x95, 0xe6, 0x58, 0xe2, 0x58, 0x55, 0x91, 0xe6, 0xa8, 0xe8, 0xad, 0x6e,
+			0xe6, 0x58, 0xe8, 0x55, 0x91, 0xe2, 0x58, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ImageCrop75: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xad, 0x92, 0x60, 0x69, 0x6a, 0xad, 0x92,
+			0x35, 0x69, 0x79, 0x91, 0x40, 0x50, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0x60, 0x50, 0xcd, 0x6f, 0x35,
+			0x69, 0x91, 0x6e, 0x40, 0x58, 0x55, 0x6d, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x55, 0x6d, 0xcd, 0x96,
+			0x91, 0x6e, 0x40, 0xb0, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0x60, 0xb0, 0x45, 0x90, 0xcd, 0x96, 0x79,
+			0x91, 0x40, 0xa8, 0xad, 0x92, 0xe6, 0x58, 0xe2, 0x58, 0xad, 0x8e, 0xe6, 0xa8, 0xe8, 0x55, 0x71,
+			0xe6, 0x58, 0xe8, 0xad, 0x8e, 0xe2, 0x58, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		ImageCropDIN: ImageCropDIN,
+		ImageCropFree: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x79, 0x77, 0xe8,
+			0xb0, 0xe6, 0x58, 0xe2, 0x89, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0xb0,
+			0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x89, 0x88,
+			0xe2, 0x50, 0x79, 0x77, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58,
+			0x50, 0xe6, 0x79, 0x77, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0x50, 0xe2, 0xa8, 0x79,
+			0x77, 0xe8, 0x58, 0xe6, 0x89, 0x88, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96,
+			0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0xa8, 0xe1,
+		}},
+		ImageCropLandscape: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ImageCropOriginal: ImageCropOriginal,
+		ImageCropPortrait: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0x60,
+			0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69,
+			0x60, 0xad, 0x6a, 0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0x55,
+			0x91, 0x60, 0xf1, 0x92, 0x55, 0x65, 0x25, 0x94, 0x89, 0x66, 0x40, 0x55, 0x95, 0x55, 0x69, 0xe8,
+			0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a,
+			0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6e, 0xe8, 0xad,
+			0x96, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		ImageCropRotate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0xc0, 0x60, 0xcd, 0x79, 0xc0, 0x3d,
+			0x74, 0xc5, 0x9d, 0x40, 0x55, 0x6a, 0x99, 0x97, 0x60, 0x4c, 0xad, 0x93, 0x45, 0x63, 0x55, 0x8e,
+			0x40, 0xf1, 0x5f, 0xcd, 0x82, 0xe6, 0x48, 0x60, 0x69, 0x64, 0xdd, 0x87, 0xbd, 0x66, 0x55, 0x8c,
+			0x40, 0xb5, 0x6c, 0x35, 0x94, 0x60, 0x55, 0x70, 0x99, 0x97, 0xf9, 0x74, 0xb5, 0x99, 0x40, 0x99,
+			0x7e, 0x11, 0x9c, 0x02, 0x89, 0x79, 0xae, 0x69, 0x7c, 0x25, 0x94, 0x69, 0x87, 0x25, 0x9f, 0x60,
+			0xad, 0x85, 0x99, 0x9f, 0xbd, 0x83, 0xcd, 0x9f, 0x40, 0x11, 0x80, 0xc0, 0xe2, 0x79, 0x88, 0x11,
+			0x92, 0xe8, 0x69, 0x8c, 0xe6, 0xbd, 0x77, 0x60, 0x6c, 0x69, 0x8c, 0xdd, 0x74, 0x45, 0x8b, 0x40,
+			0xbd, 0x73, 0x69, 0x88, 0xe8, 0xad, 0x77, 0xe6, 0x11, 0x6e, 0xe8, 0xad, 0x73, 0xe6, 0xbd, 0x73,
+			0xe8, 0x5c, 0xe6, 0xbd, 0x77, 0xe8, 0x69, 0x88, 0xe6, 0x25, 0x92, 0xe8, 0x69, 0x8c, 0xe6, 0x79,
+			0x8c, 0xe8, 0x11, 0x92, 0xe6, 0x79, 0x88, 0xe2, 0x79, 0x88, 0x69, 0x84, 0xe8, 0xad, 0x77, 0xe6,
+			0xbd, 0x7b, 0xe8, 0xad, 0x73, 0xe6, 0x79, 0x88, 0x60, 0x35, 0x8a, 0xad, 0x73, 0x55, 0x8b, 0xcd,
+			0x74, 0x40, 0x79, 0x8c, 0xad, 0x77, 0xe8, 0x69, 0x84, 0xe6, 0x79, 0x88, 0xe2, 0x11, 0x9c, 0x35,
+			0x7d, 0x60, 0xad, 0x9b, 0x25, 0x78, 0x55, 0x99, 0xad, 0x73, 0x40, 0x5d, 0x93, 0xcd, 0x6b, 0x60,
+			0xbd, 0x8f, 0x69, 0x68, 0x19, 0x8b, 0x4d, 0x66, 0x40, 0x79, 0x81, 0xf1, 0x63, 0x02, 0x89, 0x86,
+			0x52, 0xad, 0x83, 0xdd, 0x6b, 0xad, 0x78, 0xdd, 0x60, 0x60, 0x69, 0x7a, 0x69, 0x60, 0x55, 0x7c,
+			0x35, 0x60, 0x40, 0x11, 0x80, 0x40, 0x60, 0x45, 0x86, 0x40, 0xdd, 0x8b, 0x3d, 0x62, 0x40, 0xc5,
+			0x95, 0x69, 0x68, 0x60, 0x11, 0x9a, 0x55, 0x6c, 0xcd, 0x9c, 0xad, 0x71, 0x40, 0x11, 0xa0, 0x35,
+			0x7d, 0xe6, 0x11, 0x9c, 0xe1,
+		}},
+		ImageCropSquare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0xa8, 0xe8, 0x58, 0xe8, 0xa8,
+			0xe1,
+		}},
+		ImageDehaze: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x55, 0x71, 0xe8, 0x55, 0x6d, 0xe6, 0xb0,
+			0xe8, 0x55, 0x71, 0xe6, 0x50, 0xe2, 0x50, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6, 0xb0, 0xe8, 0xad,
+			0x92, 0xe6, 0x50, 0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe1,
+		}},
+		ImageDetails: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xb0, 0x01, 0x80, 0x50, 0xad, 0x9a,
+			0xb0, 0xe6, 0x55, 0x65, 0xe2, 0x25, 0x6c, 0xa8, 0xe6, 0x7c, 0xe8, 0xdd, 0x73, 0x00, 0x25, 0x6c,
+			0xa8, 0xe2, 0x84, 0xa8, 0xe6, 0xdd, 0x93, 0x00, 0x84, 0xdd, 0x73, 0xe8, 0xa8, 0xe1,
+		}},
+		ImageEdit: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xa8, 0xe6, 0xf1, 0x6e, 0x02, 0x79, 0x8c,
+			0x79, 0x76, 0x89, 0x89, 0x89, 0x73, 0x58, 0x11, 0x91, 0xe8, 0xa8, 0xe2, 0xf1, 0x94, 0x99, 0x73,
+			0x01, 0x69, 0x8c, 0x11, 0x6b, 0x35, 0x8f, 0x45, 0x68, 0x60, 0x55, 0x90, 0x25, 0x67, 0xa4, 0x25,
+			0x67, 0x40, 0xcd, 0x94, 0x45, 0x68, 0x00, 0xbd, 0x97, 0x35, 0x6b, 0x60, 0xdd, 0x98, 0x55, 0x6c,
+			0xdd, 0x98, 0x5c, 0x40, 0xbd, 0x97, 0xcd, 0x70, 0x00, 0xf1, 0x94, 0x99, 0x73, 0xe2, 0x25, 0x92,
+			0x69, 0x76, 0x00, 0x89, 0x70, 0xb0, 0xe6, 0x50, 0xe8, 0x79, 0x8f, 0x01, 0x99, 0x89, 0xdd, 0x6d,
+			0x25, 0x92, 0x69, 0x76, 0xe2, 0x96, 0x6a, 0x02, 0x89, 0x89, 0x89, 0x73, 0x79, 0x8c, 0x79, 0x76,
+			0x96, 0x6a, 0xe1,
+		}},
+		ImageExposure: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0x00, 0x58, 0xa8, 0xe2, 0x99, 0x87, 0xcd, 0x90, 0xe8, 0xf1, 0x8a,
+			0xe6, 0xbd, 0x81, 0xe8, 0x99, 0x87, 0xe6, 0x99, 0x87, 0xe8, 0xbd, 0x81, 0xe6, 0xf1, 0x8a, 0xe8,
+			0x99, 0x87, 0xe6, 0xcd, 0x90, 0xe8, 0xf1, 0x8a, 0xe6, 0xf1, 0x8a, 0xe8, 0xcd, 0x90, 0xe6, 0x99,
+			0x87, 0xe2, 0x35, 0x6f, 0x79, 0x75, 0xe6, 0xad, 0x7c, 0xe8, 0x25, 0x72, 0xe6, 0x35, 0x6f, 0xe8,
+			0x79, 0x75, 0xe1,
+		}},
+		ImageExposureNeg1: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x7a, 0xad, 0x84, 0xe6, 0x50, 0xe8, 0xad,
+			0x80, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x84, 0xe2, 0x55, 0x8b, 0xad, 0x92, 0xe8, 0x89, 0x73, 0x02,
+			0xbd, 0x84, 0x45, 0x78, 0x69, 0x82, 0xbd, 0x74, 0x99, 0x8c, 0x55, 0x6d, 0xe6, 0xa0, 0xe8, 0xad,
+			0x92, 0xe6, 0x55, 0x8b, 0xe1,
+		}},
+		ImageExposureNeg2: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x80, 0xad, 0x92, 0xe8, 0x9c, 0x00, 0x11,
+			0x8e, 0xf1, 0x7f, 0x60, 0x55, 0x90, 0x99, 0x7d, 0x5d, 0x91, 0xad, 0x7b, 0x40, 0x69, 0x92, 0xad,
+			0x77, 0x60, 0x69, 0x92, 0xdd, 0x74, 0xbd, 0x90, 0x3d, 0x73, 0x40, 0x25, 0x8c, 0x99, 0x71, 0x60,
+			0x99, 0x89, 0x99, 0x71, 0xbd, 0x87, 0xd5, 0x72, 0x40, 0x11, 0x85, 0x35, 0x76, 0x00, 0xf1, 0x80,
+			0x89, 0x74, 0x60, 0x45, 0x82, 0x35, 0x71, 0x4d, 0x85, 0x45, 0x6f, 0x40, 0x25, 0x8c, 0x55, 0x6d,
+			0x60, 0xdd, 0x90, 0x55, 0x6d, 0xd5, 0x93, 0x35, 0x70, 0x40, 0xcd, 0x96, 0xad, 0x77, 0x60, 0xcd,
+			0x96, 0x69, 0x7a, 0x89, 0x95, 0xf1, 0x7c, 0x40, 0x45, 0x91, 0x79, 0x82, 0x01, 0x89, 0x85, 0x79,
+			0x8e, 0xad, 0x85, 0xad, 0x8e, 0xe6, 0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x45, 0x80, 0xe2, 0xad, 0x7a,
+			0xad, 0x84, 0xe6, 0x50, 0xe8, 0xad, 0x80, 0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x84, 0xe1,
+		}},
+		ImageExposurePlus1: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0x55, 0x8d, 0xe8, 0xad, 0x84, 0xe6,
+			0x50, 0xe8, 0xad, 0x80, 0xe6, 0xad, 0x70, 0xe8, 0x70, 0xe6, 0xad, 0x74, 0xe8, 0xad, 0x80, 0xe6,
+			0x55, 0x7d, 0xe8, 0xad, 0x84, 0xe6, 0xad, 0x74, 0xe8, 0x55, 0x8d, 0xe6, 0xad, 0x70, 0xe2, 0x55,
+			0x8b, 0xad, 0x92, 0xe8, 0x89, 0x73, 0x02, 0xbd, 0x84, 0x45, 0x78, 0x69, 0x82, 0xbd, 0x74, 0x99,
+			0x8c, 0x55, 0x6d, 0xe6, 0xa0, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x8b, 0xe1,
+		}},
+		ImageExposurePlus2: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0x55, 0x8d, 0xe8, 0xad, 0x84, 0xe6,
+			0x50, 0xe8, 0xad, 0x80, 0xe6, 0xad, 0x70, 0xe8, 0x70, 0xe6, 0xad, 0x74, 0xe8, 0xad, 0x80, 0xe6,
+			0x55, 0x7d, 0xe8, 0xad, 0x84, 0xe6, 0xad, 0x74, 0xe8, 0x55, 0x8d, 0xe6, 0xad, 0x70, 0xe2, 0x45,
+			0x80, 0xad, 0x92, 0xe8, 0x9c, 0x00, 0x11, 0x8e, 0xf1, 0x7f, 0x60, 0x55, 0x90, 0x99, 0x7d, 0x5d,
+			0x91, 0xad, 0x7b, 0x40, 0x69, 0x92, 0xad, 0x77, 0x60, 0x69, 0x92, 0xdd, 0x74, 0xbd, 0x90, 0x3d,
+			0x73, 0x40, 0x25, 0x8c, 0x99, 0x71, 0x60, 0x99, 0x89, 0x99, 0x71, 0xbd, 0x87, 0xd5, 0x72, 0x40,
+			0x11, 0x85, 0x35, 0x76, 0x00, 0xf1, 0x80, 0x89, 0x74, 0x60, 0x45, 0x82, 0x35, 0x71, 0x4d, 0x85,
+			0x45, 0x6f, 0x40, 0x25, 0x8c, 0x55, 0x6d, 0x60, 0xdd, 0x90, 0x55, 0x6d, 0xd5, 0x93, 0x35, 0x70,
+			0x40, 0xcd, 0x96, 0xad, 0x77, 0x60, 0xcd, 0x96, 0x69, 0x7a, 0x89, 0x95, 0xf1, 0x7c, 0x40, 0x45,
+			0x91, 0x79, 0x82, 0x01, 0x89, 0x85, 0x79, 0x8e, 0xad, 0x85, 0xad, 0x8e, 0xe6, 0xb0, 0xe8, 0xad,
+			0x92, 0xe6, 0x45, 0x80, 0xe1,
+		}},
+		ImageExposureZero: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x92, 0x60, 0x55, 0x79, 0xad, 0x92,
+			0x55, 0x75, 0x5d, 0x8d, 0x40, 0x55, 0x71, 0x80, 0x60, 0x55, 0x71, 0xf1, 0x77, 0x55, 0x75, 0xa5,
+			0x72, 0x40, 0x80, 0x55, 0x6d, 0x60, 0xad, 0x86, 0x55, 0x6d, 0xad, 0x8a, 0xa5, 0x72, 0x40, 0xad,
+			0x8e, 0x80, 0x60, 0xad, 0x8e, 0x11, 0x88, 0xad, 0x8a, 0x5d, 0x8d, 0x40, 0x80, 0xad, 0x92, 0xe2,
+			0x80, 0x89, 0x8e, 0x60, 0x11, 0x85, 0x89, 0x8e, 0x99, 0x87, 0x2d, 0x8a, 0x40, 0x25, 0x8a, 0x80,
+			0x60, 0x25, 0x8a, 0x35, 0x7a, 0x99, 0x87, 0xd5, 0x75, 0x40, 0x80, 0x79, 0x71, 0x60, 0xf1, 0x7a,
+			0x79, 0x71, 0x69, 0x78, 0xd5, 0x75, 0x40, 0xdd, 0x75, 0x80, 0x60, 0xdd, 0x75, 0xcd, 0x85, 0x69,
+			0x78, 0x2d, 0x8a, 0x40, 0x80, 0x89, 0x8e, 0xe1,
+		}},
+		ImageFilter: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x6e, 0xdd, 0x86, 0xe6, 0x11, 0x91, 0x03, 0x99,
+			0x88, 0x79, 0x7b, 0xbd, 0x81, 0x79, 0x84, 0x35, 0x7d, 0xad, 0x7e, 0x6e, 0xdd, 0x86, 0xe2, 0x55,
+			0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad,
+			0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71,
+			0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad,
+			0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40,
+			0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67,
+			0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55,
+			0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71,
+			0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter1: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x83, 0x79, 0x89, 0xe6, 0x69, 0x87, 0xe8,
+			0x79, 0x6e, 0xe6, 0xdd, 0x7d, 0xe8, 0x79, 0x72, 0xe6, 0x69, 0x83, 0xe8, 0x79, 0x89, 0xe2, 0x55,
+			0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad,
+			0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71,
+			0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad,
+			0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40,
+			0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67,
+			0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55,
+			0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71,
+			0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter2: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x7c, 0x79, 0x89, 0xe6, 0xdd, 0x8b, 0xe8,
+			0x79, 0x85, 0xe6, 0x89, 0x80, 0xe8, 0x7c, 0xe6, 0xdd, 0x87, 0x60, 0x79, 0x89, 0x7c, 0xad, 0x8a,
+			0xcd, 0x7c, 0x40, 0xdd, 0x8b, 0x74, 0xe8, 0x79, 0x72, 0x60, 0xdd, 0x8b, 0xdd, 0x70, 0xad, 0x8a,
+			0xad, 0x6f, 0x40, 0xdd, 0x87, 0x79, 0x6e, 0xe6, 0x89, 0x7c, 0xe8, 0x79, 0x72, 0xe6, 0xdd, 0x87,
+			0xe8, 0x74, 0xe6, 0x89, 0x80, 0x60, 0xe5, 0x7e, 0x74, 0xb5, 0x7d, 0x35, 0x7b, 0x40, 0x89, 0x7c,
+			0x7c, 0xe8, 0x79, 0x89, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e,
+			0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89,
+			0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65,
+			0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45,
+			0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71,
+			0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55,
+			0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad,
+			0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a,
+			0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter3: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x76, 0x79, 0x89, 0xe6, 0x55, 0x86, 0x60, 0xf1,
+			0x87, 0x79, 0x89, 0x25, 0x89, 0x45, 0x88, 0x40, 0x55, 0x8a, 0x79, 0x85, 0xe8, 0xdd, 0x7f, 0x60,
+			0x55, 0x8a, 0x11, 0x7e, 0x55, 0x89, 0x09, 0x7d, 0x40, 0x8e, 0x78, 0x60, 0x55, 0x88, 0x78, 0x55,
+			0x89, 0x19, 0x7b, 0x40, 0x55, 0x8a, 0x69, 0x78, 0xe8, 0x79, 0x72, 0x60, 0x55, 0x8a, 0xdd, 0x70,
+			0x25, 0x89, 0xad, 0x6f, 0x40, 0x55, 0x86, 0x79, 0x6e, 0xe6, 0x76, 0xe8, 0x79, 0x72, 0xe6, 0x55,
+			0x86, 0xe8, 0x74, 0xe6, 0xcd, 0x80, 0xe8, 0x7c, 0xe6, 0x55, 0x86, 0xe8, 0x79, 0x85, 0xe6, 0x76,
+			0xe8, 0x79, 0x89, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79,
+			0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e,
+			0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79,
+			0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90,
+			0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad,
+			0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69,
+			0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96,
+			0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6,
+			0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter4: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x86, 0x79, 0x89, 0xe6, 0x35, 0x8a, 0xe8,
+			0x79, 0x6e, 0xe6, 0x35, 0x86, 0xe8, 0x55, 0x78, 0xe6, 0xdd, 0x7e, 0xe8, 0x79, 0x6e, 0xe6, 0xdd,
+			0x7a, 0xe8, 0x55, 0x7c, 0xe6, 0x35, 0x86, 0xe8, 0x79, 0x89, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60,
+			0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8,
+			0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92,
+			0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55,
+			0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66,
+			0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96,
+			0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter5: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x7c, 0x79, 0x89, 0xe6, 0xad, 0x87, 0x60,
+			0x45, 0x89, 0x79, 0x89, 0x79, 0x8a, 0x45, 0x88, 0x40, 0xad, 0x8b, 0x79, 0x85, 0xe8, 0x7c, 0x60,
+			0xad, 0x8b, 0x69, 0x7c, 0x79, 0x8a, 0x35, 0x7b, 0x40, 0xad, 0x87, 0x74, 0xe6, 0x55, 0x80, 0xe8,
+			0x79, 0x72, 0xe6, 0xad, 0x8b, 0xe8, 0x79, 0x6e, 0xe6, 0x55, 0x7c, 0xe8, 0x7c, 0xe6, 0xad, 0x87,
+			0xe8, 0x79, 0x85, 0xe6, 0x55, 0x7c, 0xe8, 0x79, 0x89, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd,
+			0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60,
+			0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6,
+			0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79,
+			0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e,
+			0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter6: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x80, 0x79, 0x89, 0xe6, 0xad, 0x87, 0x60,
+			0x45, 0x89, 0x79, 0x89, 0x79, 0x8a, 0x45, 0x88, 0x40, 0xad, 0x8b, 0x79, 0x85, 0xe8, 0x7c, 0x60,
+			0xad, 0x8b, 0x69, 0x7c, 0x79, 0x8a, 0x35, 0x7b, 0x40, 0xad, 0x87, 0x74, 0xe6, 0x55, 0x80, 0xe8,
+			0x79, 0x72, 0xe6, 0xcd, 0x88, 0xe8, 0x79, 0x6e, 0xe6, 0x55, 0x80, 0x60, 0xbd, 0x7e, 0x79, 0x6e,
+			0x89, 0x7d, 0xad, 0x6f, 0x40, 0x55, 0x7c, 0x79, 0x72, 0xe8, 0x79, 0x85, 0x60, 0x55, 0x7c, 0x11,
+			0x87, 0x89, 0x7d, 0x45, 0x88, 0x40, 0x55, 0x80, 0x79, 0x89, 0xe2, 0x55, 0x80, 0x7c, 0xe6, 0xad,
+			0x87, 0xe8, 0x79, 0x85, 0xe6, 0x55, 0x80, 0xe8, 0x7c, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd,
+			0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60,
+			0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6,
+			0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79,
+			0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e,
+			0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter7: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7e, 0x79, 0x89, 0xe6, 0x35, 0x82, 0x00,
+			0xad, 0x8b, 0x79, 0x72, 0xe8, 0x79, 0x6e, 0xe6, 0x55, 0x7c, 0xe8, 0x79, 0x72, 0xe6, 0xad, 0x87,
+			0x00, 0x35, 0x7e, 0x79, 0x89, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89,
+			0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67,
+			0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55,
+			0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a,
+			0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55,
+			0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2,
+			0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65,
+			0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad,
+			0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter8: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x80, 0x79, 0x89, 0xe6, 0xad, 0x87, 0x60,
+			0x45, 0x89, 0x79, 0x89, 0x79, 0x8a, 0x45, 0x88, 0x40, 0xad, 0x8b, 0x79, 0x85, 0xe8, 0x25, 0x7f,
+			0x60, 0xad, 0x8b, 0x55, 0x7d, 0xb5, 0x8a, 0x45, 0x7c, 0x40, 0x69, 0x88, 0x35, 0x7b, 0x60, 0xbd,
+			0x89, 0x35, 0x7b, 0xb5, 0x8a, 0x4d, 0x7a, 0x40, 0xad, 0x8b, 0x99, 0x77, 0xe8, 0x62, 0x60, 0xad,
+			0x8b, 0x69, 0x6f, 0x79, 0x8a, 0x35, 0x6e, 0x40, 0xad, 0x87, 0x5a, 0xe6, 0x55, 0x80, 0x60, 0xbd,
+			0x7e, 0x5a, 0x89, 0x7d, 0x35, 0x6e, 0x40, 0x55, 0x7c, 0x62, 0xe8, 0x99, 0x77, 0x60, 0x55, 0x7c,
+			0x69, 0x79, 0x55, 0x7d, 0x4d, 0x7a, 0x40, 0xad, 0x7f, 0x35, 0x7b, 0x60, 0x55, 0x7e, 0x35, 0x7b,
+			0x55, 0x7d, 0x45, 0x7c, 0x40, 0x55, 0x7c, 0x25, 0x7f, 0xe8, 0x79, 0x85, 0x60, 0x55, 0x7c, 0x11,
+			0x87, 0x89, 0x7d, 0x45, 0x88, 0x40, 0x55, 0x80, 0x79, 0x89, 0xe2, 0x55, 0x80, 0x62, 0xe6, 0xad,
+			0x87, 0xe8, 0x35, 0x79, 0xe6, 0x55, 0x80, 0xe8, 0x62, 0xe2, 0x55, 0x80, 0x79, 0x85, 0xe8, 0x35,
+			0x7d, 0xe6, 0xad, 0x87, 0xe8, 0x79, 0x85, 0xe6, 0x55, 0x80, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60,
+			0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69,
+			0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad,
+			0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8,
+			0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92,
+			0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55,
+			0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66,
+			0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96,
+			0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter9: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x7f, 0x79, 0x89, 0xe6, 0xad, 0x87, 0x60,
+			0x45, 0x89, 0x79, 0x89, 0x79, 0x8a, 0x45, 0x88, 0x40, 0xad, 0x8b, 0x79, 0x85, 0xe8, 0x79, 0x72,
+			0x60, 0xad, 0x8b, 0xdd, 0x70, 0x79, 0x8a, 0xad, 0x6f, 0x40, 0xad, 0x87, 0x79, 0x6e, 0xe6, 0x55,
+			0x80, 0x60, 0xbd, 0x7e, 0x79, 0x6e, 0x89, 0x7d, 0xad, 0x6f, 0x40, 0x55, 0x7c, 0x79, 0x72, 0xe8,
+			0x74, 0x60, 0x55, 0x7c, 0x99, 0x7b, 0x89, 0x7d, 0xcd, 0x7c, 0x40, 0x55, 0x80, 0x7c, 0xe6, 0xad,
+			0x87, 0xe8, 0x79, 0x85, 0xe6, 0x45, 0x7f, 0xe8, 0x79, 0x89, 0xe2, 0xad, 0x87, 0x74, 0xe6, 0x55,
+			0x80, 0xe8, 0x79, 0x72, 0xe6, 0xad, 0x87, 0xe8, 0x74, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd,
+			0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60,
+			0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad,
+			0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6,
+			0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71,
+			0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79,
+			0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6,
+			0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e,
+			0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImageFilter9Plus: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x79, 0xbd, 0x86, 0xe6, 0x89, 0x7f, 0x60,
+			0x31, 0x81, 0xbd, 0x86, 0x5d, 0x82, 0x89, 0x85, 0x40, 0x89, 0x83, 0xbd, 0x82, 0xe8, 0x45, 0x75,
+			0x60, 0x89, 0x83, 0xad, 0x73, 0x5d, 0x82, 0x79, 0x72, 0x40, 0x89, 0x7f, 0x45, 0x71, 0xe6, 0xcd,
+			0x7a, 0x60, 0x35, 0x79, 0x45, 0x71, 0x70, 0x79, 0x72, 0x40, 0xcd, 0x76, 0x45, 0x75, 0xe8, 0x74,
+			0x60, 0xcd, 0x76, 0x99, 0x7b, 0x70, 0xcd, 0x7c, 0x40, 0xcd, 0x7a, 0x7c, 0xe6, 0x89, 0x7f, 0xe8,
+			0xbd, 0x82, 0xe6, 0xbd, 0x79, 0xe8, 0xbd, 0x86, 0xe2, 0x89, 0x7f, 0x74, 0xe6, 0xcd, 0x7a, 0xe8,
+			0x45, 0x75, 0xe6, 0x89, 0x7f, 0xe8, 0x74, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad,
+			0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d,
+			0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad,
+			0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40,
+			0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92,
+			0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55,
+			0x69, 0xe2, 0x69, 0x8c, 0xad, 0x83, 0xe6, 0x69, 0x90, 0xe8, 0xdd, 0x7d, 0xe6, 0xf1, 0x95, 0xe8,
+			0xdd, 0x79, 0xe6, 0x69, 0x90, 0xe8, 0x55, 0x74, 0xe6, 0x69, 0x8c, 0xe8, 0xdd, 0x79, 0xe6, 0xdd,
+			0x86, 0xe8, 0xdd, 0x7d, 0xe6, 0x69, 0x8c, 0xe8, 0xad, 0x83, 0xe1,
+		}},
+		ImageFilterBAndW: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa8, 0xb0, 0xe6, 0x58, 0x60, 0x69, 0x6a, 0xb0,
+			0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35,
+			0x69, 0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0,
+			0x58, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe2, 0x58,
+			0xa8, 0xe6, 0x80, 0xe8, 0x35, 0x7d, 0x00, 0xa8, 0xa8, 0xe8, 0x58, 0xe6, 0x80, 0xe8, 0x35, 0x7d,
+			0x00, 0x58, 0xa8, 0xe1,
+		}},
+		ImageFilterCenterFocus: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xf1, 0x86, 0x60, 0x7a, 0xf1, 0x86, 0x09,
+			0x7b, 0xf9, 0x84, 0x40, 0x11, 0x79, 0x80, 0x60, 0x11, 0x79, 0x7a, 0x09, 0x7b, 0x09, 0x7b, 0x40,
+			0x80, 0x11, 0x79, 0x60, 0x86, 0x11, 0x79, 0xf9, 0x84, 0x09, 0x7b, 0x40, 0xf1, 0x86, 0x80, 0x60,
+			0xf1, 0x86, 0x86, 0xf9, 0x84, 0xf9, 0x84, 0x40, 0x80, 0xf1, 0x86, 0xe2, 0x80, 0xf1, 0x82, 0x60,
+			0x35, 0x81, 0xf1, 0x82, 0x11, 0x82, 0x11, 0x82, 0x40, 0xf1, 0x82, 0x80, 0x60, 0xf1, 0x82, 0xcd,
+			0x7e, 0x11, 0x82, 0xf1, 0x7d, 0x40, 0x80, 0x11, 0x7d, 0x60, 0xcd, 0x7e, 0x11, 0x7d, 0xf1, 0x7d,
+			0xf1, 0x7d, 0x40, 0x11, 0x7d, 0x80, 0x60, 0x11, 0x7d, 0x35, 0x81, 0xf1, 0x7d, 0x11, 0x82, 0x40,
+			0x80, 0xf1, 0x82, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50,
+			0xa8, 0xe8, 0x89, 0x88, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x79, 0x77, 0xe8, 0xb0, 0xe6, 0x58, 0xe2,
+			0x89, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0xb0, 0xe8, 0xa8, 0x60, 0xb0,
+			0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x89, 0x88, 0xe2, 0x50, 0x79, 0x77,
+			0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6, 0x79, 0x77,
+			0xe8, 0x58, 0xe6, 0x58, 0xe8, 0x79, 0x77, 0xe6, 0x50, 0xe2, 0xa8, 0x79, 0x77, 0xe8, 0x58, 0xe6,
+			0x89, 0x88, 0xe8, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0,
+			0x58, 0xe8, 0x79, 0x77, 0xe6, 0xa8, 0xe1,
+		}},
+		ImageFilterDrama: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x70, 0x55, 0x95, 0x60, 0xdd, 0x6a, 0x55,
+			0x95, 0xc5, 0x66, 0x3d, 0x91, 0x40, 0xad, 0x62, 0x45, 0x87, 0x60, 0xad, 0x62, 0xdd, 0x81, 0x09,
+			0x66, 0x19, 0x7e, 0x40, 0x79, 0x6e, 0x69, 0x79, 0x60, 0xad, 0x6f, 0x25, 0x73, 0xa5, 0x74, 0xe9,
+			0x6e, 0x40, 0x11, 0x80, 0xad, 0x6a, 0x60, 0x79, 0x87, 0xad, 0x6a, 0xad, 0x8c, 0x19, 0x70, 0x40,
+			0xdd, 0x91, 0x35, 0x7d, 0xe8, 0xcd, 0x7e, 0x60, 0xdd, 0x96, 0xcd, 0x7e, 0x19, 0x9a, 0x09, 0x82,
+			0x40, 0x55, 0x9d, 0x11, 0x8a, 0x60, 0x55, 0x9d, 0xad, 0x8e, 0xb4, 0xa4, 0x40, 0x11, 0x92, 0x55,
+			0x95, 0xe6, 0xbd, 0x70, 0xe2, 0xbd, 0x70, 0x55, 0x91, 0xe6, 0x11, 0x92, 0x60, 0x11, 0x95, 0x55,
+			0x91, 0x35, 0x97, 0x35, 0x8f, 0x40, 0x55, 0x99, 0x11, 0x8a, 0x60, 0x55, 0x99, 0x11, 0x87, 0x35,
+			0x97, 0xf1, 0x84, 0x40, 0x11, 0x92, 0xcd, 0x82, 0xe6, 0xdd, 0x8d, 0xe8, 0x35, 0x7d, 0x60, 0xdd,
+			0x8d, 0x55, 0x77, 0xe9, 0x89, 0x66, 0x40, 0x11, 0x80, 0xad, 0x6e, 0x60, 0x69, 0x7b, 0xad, 0x6e,
+			0x79, 0x77, 0x91, 0x71, 0x40, 0x55, 0x72, 0x55, 0x79, 0x60, 0xad, 0x77, 0x11, 0x7a, 0x25, 0x7b,
+			0x99, 0x7d, 0x40, 0x99, 0x7e, 0xad, 0x86, 0xe6, 0x99, 0x7a, 0x60, 0x99, 0x7a, 0x79, 0x82, 0xad,
+			0x77, 0xd5, 0x7f, 0x40, 0x89, 0x70, 0x35, 0x7d, 0x60, 0x55, 0x6c, 0x35, 0x7d, 0x81, 0x69, 0x25,
+			0x80, 0x40, 0xad, 0x66, 0x45, 0x87, 0x60, 0xad, 0x66, 0x79, 0x8b, 0x99, 0x69, 0x69, 0x8e, 0x40,
+			0xbd, 0x70, 0x55, 0x91, 0xe1,
+		}},
+		ImageFilterFrames: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad,
+			0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x55, 0x75, 0x01, 0x80,
+			0x40, 0xad, 0x8a, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd,
+			0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0x60, 0xad, 0x9a, 0x45, 0x98, 0x79, 0x99,
+			0x79, 0x99, 0x40, 0xad, 0x96, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0xad, 0x96, 0xe6,
+			0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe2, 0x60, 0xa0, 0xe8, 0x55,
+			0x75, 0xe6, 0xa0, 0xe8, 0xa0, 0xe6, 0x60, 0xe2, 0x68, 0x98, 0xe6, 0x98, 0xe8, 0x55, 0x79, 0xe6,
+			0x68, 0xe8, 0x98, 0xe2, 0x80, 0xbd, 0x82, 0xe1,
+		}},
+		ImageFilterHDR: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0xa0, 0x01, 0xad, 0x72, 0xad, 0x7a,
+			0xad, 0x7f, 0x98, 0xe6, 0x55, 0x95, 0x04, 0x55, 0x85, 0xbd, 0x76, 0x7a, 0xcd, 0x81, 0x79, 0x7a,
+			0x79, 0x7e, 0x55, 0x85, 0x60, 0x55, 0x9d, 0xa0, 0xe6, 0xad, 0x62, 0xe2, 0xad, 0x84, 0x98, 0xe2,
+			0xad, 0x6a, 0x98, 0xe6, 0xad, 0x7a, 0x01, 0xad, 0x72, 0x55, 0x81, 0xad, 0x6a, 0x98, 0xe2, 0xad,
+			0x6a, 0x98, 0xe6, 0xad, 0x7a, 0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		ImageFilterNone: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad,
+			0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d,
+			0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad,
+			0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40,
+			0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92,
+			0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55,
+			0x69, 0xe1,
+		}},
+		ImageFilterTiltShift: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x56, 0xf1, 0x90, 0x60, 0x69, 0x68, 0xf1, 0x8d,
+			0x19, 0x67, 0x45, 0x8a, 0x40, 0x55, 0x65, 0xad, 0x82, 0xe6, 0x79, 0x69, 0x60, 0x54, 0xbd, 0x85,
+			0x11, 0x6b, 0x99, 0x88, 0x40, 0x11, 0x6e, 0xf1, 0x8d, 0x00, 0x56, 0xf1, 0x90, 0xe2, 0x55, 0x65,
+			0x55, 0x7d, 0x60, 0xf1, 0x65, 0x79, 0x79, 0x45, 0x67, 0xcd, 0x75, 0x40, 0x56, 0x11, 0x6f, 0x00,
+			0x11, 0x6e, 0x11, 0x72, 0x60, 0x25, 0x6c, 0x89, 0x74, 0x11, 0x6b, 0x69, 0x77, 0x40, 0x79, 0x69,
+			0x55, 0x7d, 0xe6, 0x55, 0x65, 0xe2, 0x35, 0x7d, 0x89, 0x9a, 0x60, 0x55, 0x79, 0x11, 0x9a, 0xad,
+			0x75, 0xbd, 0x98, 0x40, 0xf1, 0x6e, 0xaa, 0x00, 0xf1, 0x71, 0xdd, 0x91, 0x60, 0x79, 0x74, 0xad,
+			0x93, 0x4d, 0x77, 0xdd, 0x94, 0x40, 0x35, 0x7d, 0x89, 0x96, 0xe8, 0x89, 0x9a, 0xe2, 0x25, 0x72,
+			0x25, 0x6e, 0x00, 0xf1, 0x6e, 0x56, 0x60, 0x11, 0x72, 0x99, 0x68, 0xc5, 0x75, 0x45, 0x67, 0x40,
+			0x69, 0x7d, 0x79, 0x65, 0xe8, 0x79, 0x69, 0x60, 0x55, 0x7a, 0xf1, 0x69, 0x79, 0x77, 0x19, 0x6b,
+			0x40, 0x25, 0x72, 0x25, 0x6e, 0xe2, 0x11, 0x80, 0xad, 0x86, 0x60, 0x45, 0x7d, 0xad, 0x86, 0x55,
+			0x7b, 0xbd, 0x84, 0x40, 0x69, 0x79, 0x80, 0x60, 0x69, 0x79, 0x35, 0x7d, 0x55, 0x7b, 0x45, 0x7b,
+			0x40, 0x11, 0x80, 0x55, 0x79, 0x60, 0xdd, 0x82, 0x55, 0x79, 0xcd, 0x84, 0x45, 0x7b, 0x40, 0xbd,
+			0x86, 0x80, 0x60, 0xbd, 0x86, 0xcd, 0x82, 0xcd, 0x84, 0xbd, 0x84, 0x40, 0x11, 0x80, 0xad, 0x86,
+			0xe2, 0x89, 0x82, 0x89, 0x9a, 0xe8, 0x89, 0x96, 0x60, 0xad, 0x85, 0x11, 0x96, 0x89, 0x88, 0xd5,
+			0x94, 0x40, 0x9c, 0xdd, 0x91, 0x00, 0x25, 0x91, 0xaa, 0x60, 0x9c, 0x79, 0x97, 0x45, 0x8a, 0xc5,
+			0x98, 0x40, 0x89, 0x82, 0x89, 0x9a, 0xe2, 0x25, 0x8e, 0x25, 0x6e, 0x60, 0x99, 0x8b, 0x55, 0x6c,
+			0xbd, 0x88, 0x25, 0x6b, 0x40, 0xbd, 0x82, 0x79, 0x69, 0xe8, 0x79, 0x65, 0x60, 0xad, 0x86, 0xf1,
+			0x65, 0x4d, 0x8a, 0x4d, 0x67, 0x40, 0x25, 0x91, 0x56, 0x00, 0x25, 0x8e, 0x25, 0x6e, 0xe2, 0x11,
+			0x95, 0xf1, 0x90, 0x00, 0x11, 0x92, 0xf1, 0x8d, 0x60, 0xf1, 0x93, 0x79, 0x8b, 0xaa, 0x99, 0x88,
+			0x40, 0x99, 0x96, 0xad, 0x82, 0xe6, 0xbd, 0x9a, 0x60, 0x25, 0x9a, 0x89, 0x86, 0xd5, 0x98, 0x35,
+			0x8a, 0x40, 0x11, 0x95, 0xf1, 0x90, 0xe2, 0x99, 0x96, 0x55, 0x7d, 0x60, 0x11, 0x96, 0x45, 0x7a,
+			0xaa, 0x69, 0x77, 0x40, 0x11, 0x92, 0x11, 0x72, 0x00, 0x11, 0x95, 0x11, 0x6f, 0x60, 0xad, 0x97,
+			0x11, 0x72, 0xf9, 0x98, 0xbd, 0x75, 0x40, 0xbd, 0x9a, 0x55, 0x7d, 0xe6, 0x99, 0x96, 0xe1,
+		}},
+		ImageFilterVintage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7f, 0xad, 0x9a, 0x60, 0x35, 0x7c, 0xad,
+			0x9a, 0x71, 0x79, 0x19, 0x98, 0x40, 0x11, 0x76, 0x55, 0x91, 0x60, 0x25, 0x72, 0xdd, 0x92, 0xb5,
+			0x6e, 0x99, 0x91, 0x40, 0x55, 0x69, 0x25, 0x8d, 0x60, 0x69, 0x67, 0xf1, 0x89, 0x11, 0x68, 0x25,
+			0x86, 0x40, 0x99, 0x6c, 0x80, 0x60, 0x99, 0x68, 0x99, 0x7d, 0x50, 0xd5, 0x79, 0x40, 0x55, 0x69,
+			0xdd, 0x72, 0x60, 0x45, 0x6b, 0xad, 0x6f, 0xb5, 0x6e, 0x69, 0x6e, 0x40, 0x11, 0x76, 0xad, 0x6e,
+			0x60, 0xad, 0x76, 0x79, 0x6a, 0x71, 0x79, 0xe9, 0x67, 0x40, 0xf1, 0x7f, 0x55, 0x65, 0x60, 0xad,
+			0x83, 0x55, 0x65, 0x71, 0x86, 0xe9, 0x67, 0x40, 0xcd, 0x89, 0xad, 0x6e, 0x60, 0xbd, 0x8d, 0x25,
+			0x6d, 0x2d, 0x91, 0x69, 0x6e, 0x40, 0x89, 0x96, 0xdd, 0x72, 0x60, 0x79, 0x98, 0x11, 0x76, 0xdd,
+			0x97, 0xd5, 0x79, 0x40, 0x45, 0x93, 0x80, 0x60, 0x25, 0x97, 0x55, 0x82, 0xc5, 0x97, 0x2d, 0x86,
+			0x40, 0x89, 0x96, 0x25, 0x8d, 0x60, 0x99, 0x94, 0x55, 0x90, 0x2d, 0x91, 0x99, 0x91, 0x40, 0xcd,
+			0x89, 0x55, 0x91, 0x60, 0x35, 0x89, 0x89, 0x95, 0x71, 0x86, 0x19, 0x98, 0x40, 0xf1, 0x7f, 0xad,
+			0x9a, 0xe2, 0xf1, 0x7f, 0xad, 0x96, 0x60, 0x55, 0x83, 0xad, 0x96, 0xd5, 0x84, 0xd5, 0x93, 0x40,
+			0xbd, 0x84, 0x55, 0x8e, 0x00, 0x99, 0x82, 0xcd, 0x8a, 0x60, 0xdd, 0x81, 0x96, 0x35, 0x81, 0x11,
+			0x8b, 0x40, 0xf1, 0x7f, 0x25, 0x8b, 0x60, 0x55, 0x7f, 0x25, 0x8b, 0xad, 0x7e, 0x11, 0x8b, 0x40,
+			0x45, 0x7d, 0xcd, 0x8a, 0x00, 0x25, 0x7b, 0x55, 0x8e, 0x60, 0x89, 0x79, 0xa2, 0x09, 0x7b, 0xd5,
+			0x93, 0x40, 0xf1, 0x7f, 0xad, 0x96, 0xe2, 0xcd, 0x6c, 0x25, 0x8b, 0x60, 0x89, 0x6e, 0x11, 0x8e,
+			0x71, 0x71, 0x45, 0x8e, 0x40, 0x69, 0x76, 0x99, 0x8b, 0x00, 0x89, 0x78, 0x99, 0x88, 0x60, 0x25,
+			0x78, 0x35, 0x88, 0x91, 0x77, 0x89, 0x87, 0x40, 0x99, 0x76, 0x35, 0x86, 0x60, 0x35, 0x76, 0x89,
+			0x85, 0xdd, 0x75, 0xb5, 0x84, 0x40, 0x55, 0x75, 0xcd, 0x82, 0xe6, 0x45, 0x71, 0x60, 0x25, 0x6e,
+			0xcd, 0x82, 0x99, 0x6c, 0x81, 0x85, 0x40, 0xcd, 0x6c, 0x25, 0x8b, 0xe2, 0x69, 0x89, 0x99, 0x8b,
+			0x60, 0x69, 0x8b, 0x89, 0x8e, 0x5d, 0x8e, 0x4d, 0x8e, 0x40, 0x11, 0x93, 0x25, 0x8b, 0x60, 0xcd,
+			0x94, 0x35, 0x88, 0x45, 0x93, 0x81, 0x85, 0x40, 0x99, 0x8e, 0xcd, 0x82, 0xe6, 0x89, 0x8a, 0x60,
+			0x55, 0x8a, 0xdd, 0x83, 0x94, 0xb5, 0x84, 0x40, 0x55, 0x89, 0x35, 0x86, 0x60, 0x92, 0xdd, 0x86,
+			0x81, 0x88, 0x79, 0x87, 0x40, 0x55, 0x87, 0x99, 0x88, 0x00, 0x69, 0x89, 0x99, 0x8b, 0xe2, 0x11,
+			0x80, 0x80, 0xe2, 0x55, 0x75, 0x35, 0x7d, 0x00, 0xdd, 0x75, 0x99, 0x7b, 0x60, 0x25, 0x76, 0xcd,
+			0x7a, 0x99, 0x76, 0x11, 0x7a, 0x40, 0x89, 0x77, 0xa5, 0x78, 0x60, 0x70, 0xf1, 0x77, 0x89, 0x78,
+			0x69, 0x77, 0x00, 0x79, 0x76, 0x79, 0x74, 0x60, 0x69, 0x74, 0x89, 0x71, 0x69, 0x71, 0xb5, 0x71,
+			0x40, 0xcd, 0x6c, 0xdd, 0x74, 0x60, 0x56, 0xdd, 0x77, 0x91, 0x6c, 0x89, 0x7a, 0x40, 0x45, 0x71,
+			0x35, 0x7d, 0xe6, 0x55, 0x75, 0xe2, 0x99, 0x8e, 0x35, 0x7d, 0x60, 0xbd, 0x91, 0x35, 0x7d, 0x3d,
+			0x93, 0x71, 0x7a, 0x40, 0x11, 0x93, 0xdd, 0x74, 0x60, 0x55, 0x91, 0xf1, 0x71, 0x71, 0x8e, 0xc5,
+			0x71, 0x40, 0x69, 0x89, 0x79, 0x74, 0x00, 0x55, 0x87, 0x69, 0x77, 0x60, 0xdd, 0x87, 0xcd, 0x77,
+			0x55, 0x88, 0x89, 0x78, 0x40, 0x45, 0x89, 0x11, 0x7a, 0x60, 0xbd, 0x89, 0xdd, 0x7a, 0x09, 0x8a,
+			0x91, 0x7b, 0x40, 0x89, 0x8a, 0x35, 0x7d, 0xe6, 0x99, 0x8e, 0xe2, 0x45, 0x7d, 0x35, 0x75, 0x60,
+			0x7c, 0x11, 0x75, 0xad, 0x7e, 0xf9, 0x74, 0x40, 0xf1, 0x7f, 0xdd, 0x74, 0x60, 0x89, 0x80, 0xdd,
+			0x74, 0x35, 0x81, 0xf9, 0x74, 0x40, 0x99, 0x82, 0x35, 0x75, 0x00, 0xbd, 0x84, 0xad, 0x71, 0x60,
+			0x55, 0x86, 0xf1, 0x6e, 0xd5, 0x84, 0x25, 0x6c, 0x40, 0xf1, 0x7f, 0x55, 0x69, 0x60, 0x89, 0x7c,
+			0x55, 0x69, 0x09, 0x7b, 0x25, 0x6c, 0x40, 0x25, 0x7b, 0xad, 0x71, 0x00, 0x45, 0x7d, 0x35, 0x75,
+			0xe2, 0x45, 0x7d, 0x35, 0x75, 0x60, 0x7c, 0x11, 0x75, 0xad, 0x7e, 0xf9, 0x74, 0x40, 0xf1, 0x7f,
+			0xdd, 0x74, 0x60, 0x89, 0x80, 0xdd, 0x74, 0x35, 0x81, 0xf9, 0x74, 0x40, 0x99, 0x82, 0x35, 0x75,
+			0x60, 0xdd, 0x81, 0x11, 0x75, 0x35, 0x81, 0xf9, 0x74, 0x40, 0xf1, 0x7f, 0xdd, 0x74, 0x60, 0x55,
+			0x7f, 0xdd, 0x74, 0xad, 0x7e, 0xf9, 0x74, 0x40, 0x45, 0x7d, 0x35, 0x75, 0xe2, 0x99, 0x76, 0x35,
+			0x86, 0x60, 0x35, 0x76, 0x89, 0x85, 0xdd, 0x75, 0xb5, 0x84, 0x40, 0x55, 0x75, 0xcd, 0x82, 0x60,
+			0x89, 0x75, 0xdd, 0x83, 0xdd, 0x75, 0xb5, 0x84, 0x40, 0x99, 0x76, 0x35, 0x86, 0x60, 0x6e, 0xdd,
+			0x86, 0x91, 0x77, 0x89, 0x87, 0x40, 0x89, 0x78, 0x99, 0x88, 0x60, 0x25, 0x78, 0x35, 0x88, 0x91,
+			0x77, 0x89, 0x87, 0x40, 0x99, 0x76, 0x35, 0x86, 0xe2, 0x55, 0x75, 0x35, 0x7d, 0x00, 0xdd, 0x75,
+			0x99, 0x7b, 0x60, 0x25, 0x76, 0xcd, 0x7a, 0x99, 0x76, 0x11, 0x7a, 0x40, 0x89, 0x77, 0xa5, 0x78,
+			0x61, 0x70, 0xf1, 0x77, 0x89, 0x78, 0x69, 0x77, 0x70, 0xf1, 0x77, 0x89, 0x77, 0xa5, 0x78, 0x40,
+			0x99, 0x76, 0x11, 0x7a, 0x60, 0x25, 0x76, 0xcd, 0x7a, 0xdd, 0x75, 0x99, 0x7b, 0x00, 0x55, 0x75,
+			0x35, 0x7d, 0xe2, 0xf1, 0x7f, 0x25, 0x8b, 0x60, 0x55, 0x7f, 0x25, 0x8b, 0xad, 0x7e, 0x11, 0x8b,
+			0x40, 0x45, 0x7d, 0xcd, 0x8a, 0x60, 0x7c, 0x96, 0xad, 0x7e, 0x11, 0x8b, 0x40, 0xf1, 0x7f, 0x25,
+			0x8b, 0x60, 0x89, 0x80, 0x25, 0x8b, 0x35, 0x81, 0x11, 0x8b, 0x40, 0x99, 0x82, 0xcd, 0x8a, 0x60,
+			0xdd, 0x81, 0x96, 0x35, 0x81, 0x11, 0x8b, 0x40, 0xf1, 0x7f, 0x25, 0x8b, 0xe2, 0x55, 0x87, 0x99,
+			0x88, 0x60, 0x90, 0x11, 0x88, 0x81, 0x88, 0x79, 0x87, 0x40, 0x55, 0x89, 0x35, 0x86, 0x60, 0xad,
+			0x89, 0x89, 0x85, 0x94, 0xb5, 0x84, 0x40, 0x89, 0x8a, 0xcd, 0x82, 0x60, 0x55, 0x8a, 0xdd, 0x83,
+			0x94, 0xb5, 0x84, 0x40, 0x55, 0x89, 0x35, 0x86, 0x60, 0x92, 0xdd, 0x86, 0x81, 0x88, 0x79, 0x87,
+			0x40, 0x55, 0x87, 0x99, 0x88, 0xe2, 0x89, 0x8a, 0x35, 0x7d, 0x60, 0x55, 0x8a, 0x45, 0x7c, 0x09,
+			0x8a, 0x91, 0x7b, 0x40, 0x45, 0x89, 0x11, 0x7a, 0x60, 0xcd, 0x88, 0x45, 0x79, 0x55, 0x88, 0x89,
+			0x78, 0x40, 0x55, 0x87, 0x69, 0x77, 0x60, 0xdd, 0x87, 0xcd, 0x77, 0x55, 0x88, 0x89, 0x78, 0x40,
+			0x45, 0x89, 0x11, 0x7a, 0x60, 0xbd, 0x89, 0xdd, 0x7a, 0x09, 0x8a, 0x91, 0x7b, 0x40, 0x89, 0x8a,
+			0x35, 0x7d, 0xe2, 0x80, 0x25, 0x87, 0x60, 0xf1, 0x82, 0x25, 0x87, 0x09, 0x85, 0x09, 0x85, 0x40,
+			0x25, 0x87, 0x80, 0x60, 0x25, 0x87, 0x11, 0x7d, 0x09, 0x85, 0xf9, 0x7a, 0x40, 0x80, 0xdd, 0x78,
+			0x60, 0x11, 0x7d, 0xdd, 0x78, 0xf9, 0x7a, 0xf9, 0x7a, 0x40, 0xdd, 0x78, 0x80, 0x60, 0xdd, 0x78,
+			0xf1, 0x82, 0xf9, 0x7a, 0x09, 0x85, 0x40, 0x80, 0x25, 0x87, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageFlare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0x84, 0xe8, 0x7c, 0xe6, 0x25, 0x72,
+			0xe8, 0x84, 0xe6, 0xad, 0x62, 0xe2, 0xbd, 0x74, 0x89, 0x77, 0x03, 0x69, 0x6f, 0x35, 0x72, 0x35,
+			0x72, 0x69, 0x6f, 0x89, 0x77, 0xbd, 0x74, 0xbd, 0x74, 0x89, 0x77, 0xe2, 0x7c, 0x25, 0x72, 0xe8,
+			0xad, 0x62, 0xe6, 0x84, 0xe8, 0x25, 0x72, 0xe6, 0x7c, 0xe2, 0x45, 0x8b, 0x89, 0x77, 0x03, 0x79,
+			0x88, 0xbd, 0x74, 0xcd, 0x8d, 0x69, 0x6f, 0x99, 0x90, 0x35, 0x72, 0x45, 0x8b, 0x89, 0x77, 0xe2,
+			0xdd, 0x8d, 0x84, 0xe8, 0x7c, 0xe6, 0x55, 0x9d, 0xe8, 0x84, 0xe6, 0xdd, 0x8d, 0xe2, 0x80, 0xcd,
+			0x86, 0x60, 0x2d, 0x7d, 0xcd, 0x86, 0x31, 0x7b, 0xd1, 0x84, 0x40, 0x35, 0x79, 0x80, 0x60, 0x35,
+			0x79, 0x2d, 0x7d, 0x31, 0x7b, 0x31, 0x7b, 0x40, 0x80, 0x35, 0x79, 0x60, 0xd5, 0x82, 0x35, 0x79,
+			0xd1, 0x84, 0x31, 0x7b, 0x40, 0xcd, 0x86, 0x80, 0x60, 0xcd, 0x86, 0xd5, 0x82, 0xd1, 0x84, 0xd1,
+			0x84, 0x40, 0x80, 0xcd, 0x86, 0xe2, 0x25, 0x8e, 0xf1, 0x90, 0x03, 0x79, 0x88, 0x45, 0x8b, 0x45,
+			0x8b, 0x79, 0x88, 0xf1, 0x90, 0x25, 0x8e, 0x25, 0x8e, 0xf1, 0x90, 0xe2, 0xdd, 0x71, 0xf1, 0x90,
+			0x03, 0x11, 0x6f, 0x25, 0x8e, 0xbd, 0x74, 0x79, 0x88, 0x89, 0x77, 0x45, 0x8b, 0xdd, 0x71, 0xf1,
+			0x90, 0xe2, 0x7c, 0x55, 0x9d, 0xe8, 0xdd, 0x8d, 0xe6, 0x84, 0xe8, 0x55, 0x9d, 0xe6, 0x7c, 0xe1,
+		}},
+		ImageFlashAuto: ImageFlashAuto,
+		ImageFlashOff:  ImageFlashOff,
+		ImageFlashOn:   ImageFlashOn,
+		ImageFlip: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x78, 0xb0, 0xe6, 0x58, 0x60, 0x59, 0x6a,
+			0xb0, 0x2d, 0x69, 0xd5, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x59, 0x6a, 0x2d, 0x69,
+			0x2d, 0x69, 0x40, 0x58, 0x50, 0xe6, 0x55, 0x78, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe6, 0x55,
+			0x78, 0xe8, 0xb0, 0xe2, 0x25, 0x7e, 0x55, 0x9d, 0xe8, 0xad, 0x62, 0xe6, 0x25, 0x82, 0xe8, 0x55,
+			0x9d, 0xe6, 0x25, 0x7e, 0xe2, 0xbd, 0x87, 0xb0, 0xe8, 0xa8, 0xe6, 0xa0, 0xe8, 0xb0, 0xe6, 0xbd,
+			0x87, 0xe2, 0xbd, 0x87, 0x58, 0xe8, 0x50, 0xe6, 0xa0, 0xe8, 0x58, 0xe6, 0xbd, 0x87, 0xe2, 0xa8,
+			0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0,
+			0xe2, 0xa8, 0xa0, 0xe8, 0xad, 0x87, 0xe6, 0xb0, 0xe8, 0xa0, 0xe6, 0xa8, 0xe2, 0xa8, 0xad, 0x83,
+			0xe8, 0x45, 0x7c, 0xe6, 0xb0, 0xe8, 0xad, 0x83, 0xe6, 0xa8, 0xe2, 0xa8, 0x45, 0x78, 0xe8, 0x60,
+			0xe6, 0xb0, 0xe8, 0x45, 0x78, 0xe6, 0xa8, 0xe2, 0xa8, 0x58, 0xe8, 0x50, 0x60, 0x99, 0x95, 0x50,
+			0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe6, 0xa8, 0xe1,
+		}},
+		ImageGradient: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x7d, 0xcd, 0x82, 0xe8, 0x11, 0x7d, 0xe6,
+			0xcd, 0x82, 0xe8, 0xcd, 0x82, 0xe6, 0x25, 0x7d, 0xe2, 0x69, 0x77, 0x89, 0x88, 0xe8, 0xcd, 0x82,
+			0xe6, 0x25, 0x7d, 0xe8, 0x89, 0x88, 0xe6, 0x69, 0x77, 0xe2, 0xcd, 0x82, 0x89, 0x88, 0xe8, 0xcd,
+			0x82, 0xe6, 0x89, 0x88, 0xe8, 0x89, 0x88, 0xe6, 0xcd, 0x82, 0xe2, 0x89, 0x88, 0xcd, 0x82, 0xe8,
+			0x11, 0x7d, 0xe6, 0x45, 0x8e, 0xe8, 0xcd, 0x82, 0xe6, 0x89, 0x88, 0xe2, 0xbd, 0x71, 0xcd, 0x82,
+			0xe8, 0x11, 0x7d, 0xe6, 0x69, 0x77, 0xe8, 0xcd, 0x82, 0xe6, 0xbd, 0x71, 0xe2, 0x58, 0xb0, 0x60,
+			0x59, 0x6a, 0xb0, 0x2d, 0x69, 0xd5, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x59, 0x6a,
+			0x2d, 0x69, 0x2d, 0x69, 0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0xa9, 0x95, 0x50, 0xd5, 0x96, 0x2d,
+			0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0, 0xa9, 0x95, 0xd5, 0x96, 0xd5, 0x96, 0x40, 0xa8,
+			0xb0, 0xe6, 0x58, 0xe2, 0xbd, 0x71, 0xa8, 0xe6, 0x69, 0x77, 0xe8, 0x45, 0x8e, 0xe6, 0xbd, 0x71,
+			0xe8, 0xa8, 0xe2, 0x25, 0x7d, 0xa8, 0xe6, 0xcd, 0x82, 0xe8, 0x45, 0x8e, 0xe6, 0x25, 0x7d, 0xe8,
+			0xa8, 0xe2, 0xa8, 0xa8, 0xe8, 0x45, 0x8e, 0xe8, 0xa8, 0xe2, 0x58, 0x45, 0x8e, 0xe6, 0xb5, 0x71,
+			0xe8, 0x89, 0x88, 0xe6, 0x69, 0x77, 0xe8, 0x45, 0x8e, 0xe6, 0x21, 0x7d, 0xe8, 0x89, 0x88, 0xe6,
+			0xd5, 0x82, 0xe8, 0x45, 0x8e, 0xe6, 0x89, 0x88, 0xe8, 0x89, 0x88, 0xe6, 0x45, 0x8e, 0xe8, 0x45,
+			0x8e, 0xe6, 0xa8, 0xe8, 0x89, 0x88, 0xe6, 0x45, 0x8e, 0xe8, 0xcd, 0x82, 0xe6, 0xa8, 0xe8, 0x58,
+			0xe6, 0x58, 0xe8, 0xd5, 0x82, 0xe6, 0xb9, 0x71, 0xe8, 0x89, 0x88, 0xe6, 0x58, 0xe8, 0x45, 0x8e,
+			0xe2, 0x58, 0xa8, 0xe8, 0x58, 0xe8, 0xa8, 0xe2, 0xa8, 0xcd, 0x82, 0xe8, 0x89, 0x88, 0xe8, 0xcd,
+			0x82, 0xe2, 0x89, 0x88, 0x45, 0x8e, 0xe8, 0xa8, 0xe6, 0x45, 0x8e, 0xe8, 0x45, 0x8e, 0xe6, 0x89,
+			0x88, 0xe1,
+		}},
+		ImageGrain: ImageGrain,
+		ImageGridOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x9a, 0x55, 0x94, 0x00, 0xad, 0x96, 0x55,
+			0x90, 0xe8, 0x94, 0xe6, 0x55, 0x90, 0x00, 0x55, 0x8c, 0x8c, 0xe6, 0xad, 0x96, 0xe8, 0x69, 0x79,
+			0xe6, 0x99, 0x8a, 0xe8, 0x45, 0x84, 0x00, 0x99, 0x86, 0x45, 0x80, 0xe8, 0x69, 0x79, 0xe6, 0xbd,
+			0x7f, 0x00, 0xbd, 0x7b, 0x69, 0x75, 0xe6, 0x99, 0x86, 0xe8, 0x55, 0x69, 0xe6, 0xf1, 0x79, 0xe8,
+			0x99, 0x73, 0x00, 0xf1, 0x75, 0x99, 0x6f, 0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6f, 0x00, 0xad, 0x6b,
+			0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98, 0x55, 0x65, 0x71, 0x99, 0x91, 0x66, 0x40, 0xad,
+			0x9a, 0x55, 0x69, 0xe8, 0x55, 0x94, 0xe2, 0x99, 0x8a, 0x69, 0x75, 0xe6, 0xad, 0x96, 0xe8, 0x55,
+			0x69, 0xe6, 0x99, 0x8a, 0xe8, 0x69, 0x75, 0xe2, 0x25, 0x9c, 0x25, 0xa1, 0x00, 0x99, 0x95, 0xad,
+			0x9a, 0xe6, 0x55, 0x69, 0x60, 0xcd, 0x67, 0xad, 0x9a, 0x91, 0x66, 0x71, 0x99, 0x40, 0x55, 0x65,
+			0xad, 0x96, 0xe8, 0x55, 0x6a, 0x03, 0xbd, 0x5e, 0xcd, 0x63, 0x99, 0x61, 0x11, 0x61, 0xf1, 0x9e,
+			0x55, 0x9e, 0x25, 0x9c, 0x25, 0xa1, 0xe2, 0x99, 0x8a, 0xad, 0x96, 0xe6, 0x99, 0x91, 0x00, 0x99,
+			0x8a, 0xad, 0x8f, 0xe8, 0xad, 0x96, 0xe2, 0xf1, 0x79, 0x8c, 0xe6, 0x11, 0x81, 0x00, 0xf1, 0x79,
+			0x7e, 0xe8, 0x8c, 0xe2, 0xf1, 0x79, 0xad, 0x96, 0xe6, 0x99, 0x86, 0xe8, 0x99, 0x8b, 0x00, 0xf1,
+			0x84, 0x94, 0xe6, 0xf1, 0x79, 0xe8, 0xad, 0x96, 0xe2, 0x55, 0x69, 0x69, 0x75, 0xe6, 0x55, 0x70,
+			0x00, 0x55, 0x69, 0x45, 0x6e, 0xe8, 0x69, 0x75, 0xe2, 0x55, 0x69, 0x8c, 0xe6, 0xf1, 0x75, 0xe8,
+			0x76, 0x00, 0x55, 0x74, 0x69, 0x79, 0xe6, 0x55, 0x69, 0xe8, 0x8c, 0xe2, 0xf1, 0x75, 0xad, 0x96,
+			0xe8, 0x94, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xf1, 0x75, 0xe1,
+		}},
+		ImageGridOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xad, 0x76, 0xe8, 0x55, 0x89, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0xad, 0x7a, 0xa8, 0xe6,
+			0x55, 0x85, 0xe8, 0x55, 0x89, 0xe6, 0xad, 0x7a, 0xe8, 0xa8, 0xe2, 0x55, 0x89, 0xa8, 0xe6, 0xa8,
+			0xe8, 0x55, 0x89, 0xe6, 0x55, 0x89, 0xe8, 0xa8, 0xe2, 0x58, 0x55, 0x85, 0xe6, 0xad, 0x76, 0xe8,
+			0xad, 0x7a, 0xe6, 0x58, 0xe8, 0x55, 0x85, 0xe2, 0xad, 0x7a, 0x55, 0x85, 0xe6, 0x55, 0x85, 0xe8,
+			0xad, 0x7a, 0xe6, 0xad, 0x7a, 0xe8, 0x55, 0x85, 0xe2, 0x55, 0x89, 0x55, 0x85, 0xe6, 0xa8, 0xe8,
+			0xad, 0x7a, 0xe6, 0x55, 0x89, 0xe8, 0x55, 0x85, 0xe2, 0x58, 0xad, 0x76, 0xe6, 0xad, 0x76, 0xe8,
+			0x58, 0xe6, 0x58, 0xe8, 0xad, 0x76, 0xe2, 0xad, 0x7a, 0xad, 0x76, 0xe6, 0x55, 0x85, 0xe8, 0x58,
+			0xe6, 0xad, 0x7a, 0xe8, 0xad, 0x76, 0xe2, 0x55, 0x89, 0xad, 0x76, 0xe6, 0xa8, 0xe8, 0x58, 0xe6,
+			0x55, 0x89, 0xe8, 0xad, 0x76, 0xe1,
+		}},
+		ImageHDROff:    ImageHDROff,
+		ImageHDROn:     ImageHDROn,
+		ImageHDRStrong: ImageHDRStrong,
+		ImageHDRWeak:   ImageHDRWeak,
+		ImageHealing:   ImageHealing,
+		ImageISO:       ImageISO,
+		ImageImage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0xbd, 0x6f, 0x89, 0x8d, 0xe6, 0x55,
+			0x90, 0x03, 0x89, 0x86, 0x79, 0x80, 0xbd, 0x7d, 0xdd, 0x8b, 0x89, 0x77, 0x69, 0x83, 0xbd, 0x6f,
+			0x89, 0x8d, 0xe2, 0x58, 0xa8, 0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		ImageImageAspectRatio: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x70, 0xbd, 0x7e, 0xe6, 0x11, 0x74, 0xe8,
+			0xbd, 0x7a, 0xe6, 0x11, 0x70, 0xe8, 0xbd, 0x7e, 0xe2, 0x69, 0x7b, 0xbd, 0x7e, 0xe6, 0x69, 0x7f,
+			0xe8, 0xbd, 0x7a, 0xe6, 0x69, 0x7b, 0xe8, 0xbd, 0x7e, 0xe2, 0x99, 0x86, 0x69, 0x8a, 0xe6, 0x99,
+			0x8a, 0xe8, 0x69, 0x86, 0xe6, 0x99, 0x86, 0xe8, 0x69, 0x8a, 0xe2, 0x99, 0x86, 0xbd, 0x7e, 0xe6,
+			0x99, 0x8a, 0xe8, 0xbd, 0x7a, 0xe6, 0x99, 0x86, 0xe8, 0xbd, 0x7e, 0xe2, 0x55, 0x69, 0x55, 0x95,
+			0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6,
+			0xad, 0x96, 0x60, 0x45, 0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e,
+			0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55,
+			0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6,
+			0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91,
+			0xe1,
+		}},
+		ImageLandscape: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x62, 0xa0, 0x01, 0xad, 0x72, 0xad, 0x7a,
+			0xad, 0x7f, 0x98, 0xe6, 0x55, 0x95, 0x04, 0x55, 0x85, 0xbd, 0x76, 0x7a, 0xcd, 0x81, 0x79, 0x7a,
+			0x79, 0x7e, 0x55, 0x85, 0x60, 0x55, 0x9d, 0xa0, 0xe6, 0xad, 0x62, 0xe2, 0xad, 0x84, 0x98, 0xe2,
+			0xad, 0x6a, 0x98, 0xe6, 0xad, 0x7a, 0x01, 0xad, 0x72, 0x55, 0x81, 0xad, 0x6a, 0x98, 0xe2, 0xad,
+			0x6a, 0x98, 0xe6, 0xad, 0x7a, 0xe6, 0xad, 0x6a, 0xe1,
+		}},
+		ImageLeakAdd:      ImageLeakAdd,
+		ImageLeakRemove:   ImageLeakRemove,
+		ImageLens:         ImageLens,
+		ImageLinkedCamera: ImageLinkedCamera,
+		ImageLooks:        ImageLooks,
+		ImageLooks3:       ImageLooks3,
+		ImageLooks4:       ImageLooks4,
+		ImageLooks5:       ImageLooks5,
+		ImageLooks6:       ImageLooks6,
+		ImageLooksOne:     ImageLooksOne,
+		ImageLooksTwo:     ImageLooksTwo,
+		ImageLoupe: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x79, 0x8d, 0xe6, 0x84, 0xe8, 0x45, 0x82,
+			0xe6, 0x35, 0x8d, 0xe8, 0x45, 0x7e, 0xe6, 0x84, 0xe8, 0x11, 0x73, 0xe6, 0x7c, 0xe8, 0x45, 0x7e,
+			0xe6, 0xcd, 0x72, 0xe8, 0x45, 0x82, 0xe6, 0x7c, 0xe8, 0x79, 0x8d, 0xe2, 0x80, 0xad, 0x9a, 0x60,
+			0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69,
+			0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71,
+			0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71,
+			0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd,
+			0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80,
+			0xe8, 0x35, 0x95, 0x61, 0xad, 0x9a, 0x75, 0x97, 0x11, 0x99, 0x11, 0x99, 0x75, 0x97, 0xad, 0x9a,
+			0x35, 0x95, 0xad, 0x9a, 0xe6, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad, 0x96, 0x15,
+			0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed, 0x6f, 0x60,
+			0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55, 0x69, 0x85,
+			0x76, 0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96, 0x80, 0xad,
+			0x96, 0xe2, 0x80, 0x45, 0x80, 0xe1,
+		}},
+		ImageMonochromePhotos: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89,
+			0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0xcd, 0x71, 0x60, 0x55, 0x65, 0x35, 0x70, 0x89,
+			0x66, 0x5e, 0x40, 0x55, 0x69, 0xcd, 0x6d, 0xe6, 0x25, 0x73, 0x00, 0x70, 0x50, 0xe6, 0x90, 0x00,
+			0xdd, 0x8c, 0xcd, 0x6d, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0xcd, 0x6d, 0x79, 0x99, 0x5e, 0x40,
+			0xad, 0x9a, 0xcd, 0x71, 0xe8, 0xa8, 0x60, 0xad, 0x9a, 0x99, 0x95, 0x79, 0x99, 0xcd, 0x96, 0x40,
+			0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69, 0xe2, 0x80, 0xa8, 0xe6, 0xad, 0x96, 0xe8, 0xcd, 0x71, 0xe6,
+			0x80, 0xe8, 0x99, 0x77, 0x60, 0x25, 0x7b, 0x99, 0x77, 0xe9, 0x77, 0xd5, 0x7a, 0x40, 0xad, 0x74,
+			0xf1, 0x82, 0x60, 0xad, 0x74, 0xbd, 0x87, 0xe9, 0x77, 0x96, 0x40, 0x80, 0x45, 0x8e, 0xe8, 0xa8,
+			0xe2, 0x80, 0x45, 0x8e, 0xe8, 0x45, 0x8a, 0x60, 0xf1, 0x82, 0x45, 0x8a, 0x25, 0x85, 0x11, 0x88,
+			0x40, 0x55, 0x87, 0xf1, 0x82, 0x60, 0x55, 0x87, 0xf1, 0x7f, 0x25, 0x85, 0xc5, 0x7d, 0x40, 0x80,
+			0x99, 0x7b, 0xe8, 0x99, 0x77, 0x60, 0xcd, 0x84, 0x99, 0x77, 0x11, 0x88, 0xd5, 0x7a, 0x40, 0x55,
+			0x8b, 0xf1, 0x82, 0x60, 0x55, 0x8b, 0xbd, 0x87, 0x11, 0x88, 0x96, 0x40, 0x80, 0x45, 0x8e, 0xe2,
+			0x80, 0x45, 0x8a, 0x60, 0x7a, 0x45, 0x8a, 0xd5, 0x7a, 0x11, 0x88, 0x40, 0xad, 0x78, 0xf1, 0x82,
+			0x60, 0xad, 0x78, 0xf1, 0x7f, 0xd5, 0x7a, 0xc5, 0x7d, 0x40, 0x80, 0x99, 0x7b, 0xe8, 0x45, 0x8a,
+			0xe1,
+		}},
+		ImageMovieCreation: ImageMovieCreation,
+		ImageMovieFilter:   ImageMovieFilter,
+		ImageMusicNote: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x7a, 0xb0, 0x60, 0x6c, 0xb0, 0x19, 0x73,
+			0x19, 0x95, 0x40, 0x35, 0x70, 0x9c, 0x60, 0x35, 0x70, 0xcd, 0x89, 0x19, 0x73, 0xe9, 0x86, 0x40,
+			0x35, 0x7a, 0x88, 0x60, 0x11, 0x7c, 0x88, 0x91, 0x7d, 0x89, 0x84, 0x40, 0x35, 0x80, 0x8c, 0xe8,
+			0x50, 0xe6, 0xcd, 0x8f, 0xe8, 0x62, 0xe6, 0x35, 0x84, 0xe8, 0x9c, 0x60, 0x35, 0x84, 0x35, 0x92,
+			0x4d, 0x81, 0x19, 0x95, 0x40, 0x35, 0x7a, 0xb0, 0xe1,
+		}},
+		ImageNature: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6f, 0xad, 0x9a, 0xe8, 0xad, 0x96, 0xe6,
+			0x7c, 0xe8, 0xad, 0x8a, 0xe6, 0x70, 0x60, 0x79, 0x72, 0xad, 0x8a, 0x91, 0x6e, 0xc5, 0x86, 0x40,
+			0xad, 0x6a, 0x55, 0x7d, 0x60, 0xad, 0x6a, 0x55, 0x79, 0xdd, 0x6c, 0xf9, 0x75, 0x40, 0xcd, 0x72,
+			0x11, 0x71, 0x60, 0x69, 0x73, 0x11, 0x6c, 0x2d, 0x77, 0xb5, 0x68, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x11, 0x85, 0x55, 0x65, 0xd5, 0x88, 0xb5, 0x68, 0x40, 0x35, 0x8d, 0x11, 0x71, 0x60, 0xf1, 0x90,
+			0x99, 0x72, 0x25, 0x93, 0xf9, 0x75, 0x40, 0x55, 0x95, 0x55, 0x7d, 0x60, 0x55, 0x95, 0xdd, 0x82,
+			0x71, 0x91, 0xc5, 0x86, 0x40, 0x90, 0xad, 0x8a, 0xe6, 0x84, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x91,
+			0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x6f, 0xe2, 0x70, 0xad, 0x86, 0xe6, 0x90, 0x62, 0xe5, 0x8b, 0xad,
+			0x86, 0x9d, 0x8e, 0xf1, 0x83, 0x55, 0x91, 0x39, 0x81, 0x55, 0x91, 0x55, 0x7d, 0x55, 0x91, 0x89,
+			0x7a, 0xbd, 0x8f, 0x3d, 0x78, 0x40, 0x99, 0x8b, 0xcd, 0x74, 0x01, 0x79, 0x89, 0xdd, 0x73, 0x35,
+			0x89, 0x89, 0x71, 0x60, 0xbd, 0x88, 0x5c, 0x21, 0x86, 0xad, 0x6b, 0x40, 0x80, 0x55, 0x69, 0x61,
+			0x7d, 0x7c, 0x55, 0x69, 0xe1, 0x79, 0xad, 0x6b, 0x45, 0x77, 0x5c, 0xcd, 0x76, 0x89, 0x71, 0x01,
+			0x89, 0x76, 0xdd, 0x73, 0x69, 0x74, 0xcd, 0x74, 0x60, 0xdd, 0x71, 0xf1, 0x75, 0x45, 0x70, 0x41,
+			0x78, 0x40, 0xad, 0x6e, 0x59, 0x7d, 0x61, 0xad, 0x6e, 0x35, 0x81, 0x65, 0x71, 0xf1, 0x83, 0x1d,
+			0x74, 0xad, 0x86, 0x70, 0xad, 0x86, 0xe2, 0x80, 0x70, 0xe1,
+		}},
+		ImageNaturePeople: ImageNaturePeople,
+		ImageNavigateBefore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x85, 0xa0, 0x05, 0x55, 0x75, 0xf1, 0x7f,
+			0x69, 0x85, 0xdd, 0x6f, 0x45, 0x88, 0xbd, 0x72, 0x11, 0x7b, 0xf1, 0x7f, 0x45, 0x88, 0x25, 0x8d,
+			0x69, 0x85, 0xa0, 0xe1,
+		}, mirror: true},
+		ImageNavigateNext: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x83, 0xf1, 0x7f, 0x05, 0x25, 0x76, 0xbd,
+			0x72, 0x72, 0xdd, 0x6f, 0x11, 0x89, 0xf1, 0x7f, 0x72, 0xa0, 0x25, 0x76, 0x25, 0x8d, 0x55, 0x83,
+			0xf1, 0x7f, 0xe1,
+		}, mirror: true},
+		ImagePalette: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a,
+			0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67,
+			0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x55, 0x7a, 0x79, 0x67, 0x79, 0x75, 0x40,
+			0x4d, 0x6d, 0x5a, 0x60, 0x62, 0x69, 0x69, 0xf9, 0x75, 0x5d, 0x67, 0x40, 0x99, 0x80, 0x55, 0x65,
+			0x60, 0xdd, 0x85, 0x55, 0x65, 0x99, 0x8a, 0x19, 0x67, 0x40, 0xe9, 0x92, 0x58, 0x60, 0x79, 0x96,
+			0x25, 0x6f, 0x91, 0x98, 0x71, 0x73, 0x40, 0xad, 0x9a, 0xdd, 0x7c, 0x60, 0xad, 0x9a, 0x11, 0x84,
+			0x79, 0x96, 0x3d, 0x88, 0x40, 0x55, 0x8b, 0x69, 0x8c, 0xe6, 0x55, 0x86, 0x60, 0x25, 0x85, 0x69,
+			0x8c, 0x45, 0x84, 0x55, 0x8d, 0x40, 0x69, 0x83, 0x69, 0x8f, 0x60, 0x69, 0x83, 0x35, 0x91, 0x5d,
+			0x84, 0x79, 0x92, 0x40, 0x55, 0x85, 0x69, 0x95, 0x60, 0x55, 0x85, 0xf1, 0x97, 0xf1, 0x83, 0x4d,
+			0x99, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x79, 0x70, 0xbd, 0x81, 0x60, 0xcd, 0x71,
+			0xbd, 0x81, 0xcd, 0x72, 0xbd, 0x80, 0x40, 0xcd, 0x73, 0x69, 0x7e, 0x60, 0xcd, 0x73, 0x11, 0x7d,
+			0xcd, 0x72, 0x11, 0x7c, 0x40, 0x79, 0x70, 0x11, 0x7b, 0x60, 0x25, 0x6f, 0x11, 0x7b, 0x25, 0x6e,
+			0x11, 0x7c, 0x40, 0x25, 0x6d, 0x69, 0x7e, 0x60, 0x25, 0x6d, 0xbd, 0x7f, 0x25, 0x6e, 0xbd, 0x80,
+			0x40, 0x79, 0x70, 0xbd, 0x81, 0xe2, 0xdd, 0x78, 0x69, 0x76, 0x60, 0x35, 0x7a, 0x69, 0x76, 0x35,
+			0x7b, 0x69, 0x75, 0x40, 0x35, 0x7c, 0x11, 0x73, 0x60, 0x35, 0x7c, 0xbd, 0x71, 0x35, 0x7b, 0xbd,
+			0x70, 0x40, 0xdd, 0x78, 0xbd, 0x6f, 0x60, 0x89, 0x77, 0xbd, 0x6f, 0x89, 0x76, 0xbd, 0x70, 0x40,
+			0x89, 0x75, 0x11, 0x73, 0x60, 0x89, 0x75, 0x69, 0x74, 0x89, 0x76, 0x69, 0x75, 0x40, 0xdd, 0x78,
+			0x69, 0x76, 0xe2, 0x25, 0x87, 0x69, 0x76, 0x60, 0x79, 0x88, 0x69, 0x76, 0x79, 0x89, 0x69, 0x75,
+			0x40, 0x79, 0x8a, 0x11, 0x73, 0x60, 0x79, 0x8a, 0xbd, 0x71, 0x79, 0x89, 0xbd, 0x70, 0x40, 0x25,
+			0x87, 0xbd, 0x6f, 0x60, 0xcd, 0x85, 0xbd, 0x6f, 0xcd, 0x84, 0xbd, 0x70, 0x40, 0xcd, 0x83, 0x11,
+			0x73, 0x60, 0xcd, 0x83, 0x69, 0x74, 0xcd, 0x84, 0x69, 0x75, 0x40, 0x25, 0x87, 0x69, 0x76, 0xe2,
+			0xdd, 0x8f, 0xbd, 0x81, 0x60, 0x35, 0x91, 0xbd, 0x81, 0x35, 0x92, 0xbd, 0x80, 0x40, 0x35, 0x93,
+			0x69, 0x7e, 0x60, 0x35, 0x93, 0x11, 0x7d, 0x35, 0x92, 0x11, 0x7c, 0x40, 0xdd, 0x8f, 0x11, 0x7b,
+			0x60, 0x89, 0x8e, 0x11, 0x7b, 0x89, 0x8d, 0x11, 0x7c, 0x40, 0x89, 0x8c, 0x69, 0x7e, 0x60, 0x89,
+			0x8c, 0xbd, 0x7f, 0x89, 0x8d, 0xbd, 0x80, 0x40, 0xdd, 0x8f, 0xbd, 0x81, 0xe2, 0x80, 0xad, 0x96,
+			0x60, 0xbd, 0x80, 0xad, 0x96, 0x09, 0x81, 0x5d, 0x96, 0x40, 0x55, 0x81, 0x69, 0x95, 0x60, 0x55,
+			0x81, 0x79, 0x94, 0x5d, 0x80, 0xad, 0x93, 0x40, 0x69, 0x7f, 0x25, 0x90, 0x60, 0x69, 0x7f, 0x11,
+			0x8d, 0x69, 0x81, 0xbd, 0x8a, 0x40, 0x79, 0x86, 0x69, 0x88, 0xe6, 0x55, 0x8b, 0x60, 0x69, 0x90,
+			0x69, 0x88, 0x89, 0x93, 0x71, 0x85, 0x40, 0xad, 0x96, 0xdd, 0x7c, 0x60, 0xad, 0x96, 0x11, 0x74,
+			0xa0, 0xb5, 0x6e, 0x40, 0x99, 0x80, 0x55, 0x69, 0x60, 0xdd, 0x76, 0x55, 0x69, 0x19, 0x70, 0xe9,
+			0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80,
+			0xad, 0x96, 0xe1,
+		}},
+		ImagePanorama: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0xcd, 0x6f, 0xad, 0x8a, 0xe6, 0x45, 0x90, 0x03, 0x79, 0x86, 0x69, 0x7d, 0xbd, 0x7d,
+			0x92, 0x99, 0x77, 0x69, 0x80, 0xcd, 0x6f, 0xad, 0x8a, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad,
+			0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ImagePanoramaFishEye:    ImagePanoramaFishEye,
+		ImagePanoramaHorizontal: ImagePanoramaHorizontal,
+		ImagePanoramaVertical:   ImagePanoramaVertical,
+		ImagePanoramaWideAngle:  ImagePanoramaWideAngle,
+		ImagePhoto: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0xbd, 0x6f, 0x89, 0x8d, 0xe6, 0x55,
+			0x90, 0x03, 0x89, 0x86, 0x79, 0x80, 0xbd, 0x7d, 0xdd, 0x8b, 0x89, 0x77, 0x69, 0x83, 0xbd, 0x6f,
+			0x89, 0x8d, 0xe2, 0x58, 0xa8, 0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		ImagePhotoAlbum: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x11, 0x6d, 0xad,
+			0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x55, 0x69, 0x60, 0xad, 0x6a,
+			0xbd, 0x67, 0xdd, 0x6b, 0x89, 0x66, 0x40, 0xad, 0x6e, 0x55, 0x65, 0xe6, 0x55, 0x91, 0x60, 0xf1,
+			0x92, 0x55, 0x65, 0x25, 0x94, 0x89, 0x66, 0x40, 0x55, 0x95, 0x55, 0x69, 0xe8, 0xad, 0x96, 0x60,
+			0x55, 0x95, 0x45, 0x98, 0x25, 0x94, 0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e,
+			0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8d, 0xe8, 0x11,
+			0x7b, 0x01, 0xdd, 0x86, 0x55, 0x77, 0x69, 0x80, 0x11, 0x7b, 0xe8, 0x55, 0x69, 0xe6, 0xad, 0x6e,
+			0xe8, 0xad, 0x96, 0xe2, 0xf1, 0x72, 0x35, 0x90, 0xe6, 0x69, 0x8d, 0x03, 0xdd, 0x84, 0xcd, 0x84,
+			0xdd, 0x7d, 0xdd, 0x8d, 0x35, 0x79, 0x90, 0xf1, 0x72, 0x35, 0x90, 0xe2, 0xad, 0x6e, 0xad, 0x96,
+			0xe8, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe2, 0x69, 0x80, 0x11, 0x7b, 0x03, 0xdd, 0x86, 0x55, 0x77,
+			0x55, 0x8d, 0x11, 0x7b, 0xdd, 0x86, 0x55, 0x77, 0x69, 0x80, 0x11, 0x7b, 0xe1,
+		}},
+		ImagePhotoCamera: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf9, 0x7f, 0x45, 0x8e, 0x60, 0xcd, 0x84, 0x45,
+			0x8e, 0x11, 0x88, 0x96, 0x40, 0x55, 0x8b, 0xe9, 0x82, 0x60, 0x55, 0x8b, 0x11, 0x7e, 0x11, 0x88,
+			0xd5, 0x7a, 0x40, 0xf9, 0x7f, 0x99, 0x77, 0x60, 0x25, 0x7b, 0x99, 0x77, 0xe9, 0x77, 0xd5, 0x7a,
+			0x40, 0xad, 0x74, 0xe9, 0x82, 0x60, 0xad, 0x74, 0xbd, 0x87, 0xe9, 0x77, 0x96, 0x40, 0xf9, 0x7f,
+			0x45, 0x8e, 0xe2, 0xf9, 0x7f, 0x45, 0x8a, 0x60, 0xcd, 0x7c, 0x45, 0x8a, 0xbd, 0x7a, 0x2d, 0x88,
+			0x40, 0xad, 0x78, 0xe9, 0x82, 0x60, 0xad, 0x78, 0xbd, 0x7f, 0xbd, 0x7a, 0xad, 0x7d, 0x40, 0xf9,
+			0x7f, 0x99, 0x7b, 0x60, 0x25, 0x83, 0x99, 0x7b, 0x3d, 0x85, 0xad, 0x7d, 0x40, 0x55, 0x87, 0xe9,
+			0x82, 0x60, 0x55, 0x87, 0x11, 0x86, 0x3d, 0x85, 0x2d, 0x88, 0x40, 0xf9, 0x7f, 0x45, 0x8a, 0xe2,
+			0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd, 0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8,
+			0xcd, 0x71, 0x60, 0x55, 0x65, 0x45, 0x70, 0x89, 0x66, 0x09, 0x6f, 0x40, 0x55, 0x69, 0xcd, 0x6d,
+			0xe6, 0x25, 0x73, 0x00, 0x70, 0x50, 0xe6, 0x90, 0x00, 0xdd, 0x8c, 0xcd, 0x6d, 0xe6, 0xad, 0x96,
+			0x60, 0x35, 0x98, 0xcd, 0x6d, 0x71, 0x99, 0x09, 0x6f, 0x40, 0xad, 0x9a, 0xcd, 0x71, 0xe8, 0xa8,
+			0x60, 0xad, 0x9a, 0x99, 0x95, 0x71, 0x99, 0xcd, 0x96, 0x40, 0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69,
+			0xe2, 0xad, 0x96, 0xa8, 0xe8, 0xcd, 0x71, 0xe6, 0x96, 0x00, 0x25, 0x86, 0x58, 0xe6, 0xdd, 0x79,
+			0x00, 0x6a, 0xcd, 0x71, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe6, 0xad, 0x96, 0xe2, 0x80, 0xf1, 0x82,
+			0xe1,
+		}},
+		ImagePhotoFilter: ImagePhotoFilter,
+		ImagePhotoLibrary: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x6e, 0xdd, 0x86, 0xe6, 0x11, 0x91, 0x03, 0x99,
+			0x88, 0x79, 0x7b, 0xbd, 0x81, 0x79, 0x84, 0x35, 0x7d, 0xad, 0x7e, 0x6e, 0xdd, 0x86, 0xe2, 0x55,
+			0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f, 0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad,
+			0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71,
+			0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad,
+			0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40,
+			0xad, 0x96, 0xad, 0x92, 0xe6, 0x55, 0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8,
+			0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67,
+			0xad, 0x9a, 0x89, 0x66, 0x79, 0x99, 0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55,
+			0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71,
+			0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0xe1,
+		}},
+		ImagePhotoSizeSelectActual: ImagePhotoSizeSelectActual,
+		ImagePhotoSizeSelectLarge: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0xdd, 0x71, 0xe6, 0x25, 0x8e, 0xe8, 0xb0, 0xe6, 0x58, 0xe2,
+			0xa8, 0xb0, 0xe8, 0xa8, 0xe6, 0xb0, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8,
+			0xb0, 0xe2, 0x79, 0x6e, 0x25, 0x91, 0xe6, 0x90, 0x03, 0x45, 0x7f, 0x99, 0x85, 0xa1, 0x78, 0xbd,
+			0x8e, 0x89, 0x74, 0x92, 0x79, 0x6e, 0x25, 0x91, 0xe2, 0xa8, 0x25, 0x8e, 0xe8, 0x99, 0x88, 0xe6,
+			0xb0, 0xe8, 0x25, 0x8e, 0xe6, 0xa8, 0xe2, 0xa8, 0xbd, 0x82, 0xe8, 0x35, 0x7d, 0xe6, 0xb0, 0xe8,
+			0xbd, 0x82, 0xe6, 0xa8, 0xe2, 0xa8, 0x69, 0x77, 0xe8, 0xdd, 0x71, 0xe6, 0xb0, 0xe8, 0x69, 0x77,
+			0xe6, 0xa8, 0xe2, 0x50, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50,
+			0xe8, 0x58, 0xe6, 0x50, 0xe2, 0xdd, 0x71, 0x58, 0xe8, 0x50, 0xe6, 0x69, 0x77, 0xe8, 0x58, 0xe6,
+			0xdd, 0x71, 0xe2, 0x45, 0x7d, 0x58, 0xe8, 0x50, 0xe6, 0xcd, 0x82, 0xe8, 0x58, 0xe6, 0x45, 0x7d,
+			0xe2, 0x99, 0x88, 0x58, 0xe8, 0x50, 0xe6, 0x25, 0x8e, 0xe8, 0x58, 0xe6, 0x99, 0x88, 0xe2, 0xa8,
+			0x58, 0xe8, 0x50, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe6, 0xa8,
+			0xe1,
+		}},
+		ImagePhotoSizeSelectSmall: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x35, 0x7d, 0xe6, 0xcd, 0x82, 0xe8, 0xb0, 0xe6, 0x58, 0xe2,
+			0x99, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0x25, 0x8e, 0xe8, 0xb0, 0xe6, 0x99, 0x88, 0xe2, 0xa8, 0xb0,
+			0xe8, 0xa8, 0xe6, 0xb0, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe2,
+			0xdd, 0x6a, 0x35, 0x92, 0xe6, 0x25, 0x80, 0x03, 0x35, 0x79, 0x92, 0x11, 0x74, 0x89, 0x8f, 0x55,
+			0x70, 0xcd, 0x8a, 0xdd, 0x6a, 0x35, 0x92, 0xe2, 0xa8, 0x25, 0x8e, 0xe8, 0x99, 0x88, 0xe6, 0xb0,
+			0xe8, 0x25, 0x8e, 0xe6, 0xa8, 0xe2, 0xa8, 0xbd, 0x82, 0xe8, 0x35, 0x7d, 0xe6, 0xb0, 0xe8, 0xbd,
+			0x82, 0xe6, 0xa8, 0xe2, 0x50, 0x69, 0x77, 0xe8, 0xdd, 0x71, 0xe6, 0x58, 0xe8, 0x69, 0x77, 0xe6,
+			0x50, 0xe2, 0xa8, 0x69, 0x77, 0xe8, 0xdd, 0x71, 0xe6, 0xb0, 0xe8, 0x69, 0x77, 0xe6, 0xa8, 0xe2,
+			0x50, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe8, 0x58, 0xe6,
+			0x50, 0xe2, 0xdd, 0x71, 0x58, 0xe8, 0x50, 0xe6, 0x69, 0x77, 0xe8, 0x58, 0xe6, 0xdd, 0x71, 0xe2,
+			0x45, 0x7d, 0x58, 0xe8, 0x50, 0xe6, 0xcd, 0x82, 0xe8, 0x58, 0xe6, 0x45, 0x7d, 0xe2, 0x99, 0x88,
+			0x58, 0xe8, 0x50, 0xe6, 0x25, 0x8e, 0xe8, 0x58, 0xe6, 0x99, 0x88, 0xe2, 0xa8, 0x58, 0xe8, 0x50,
+			0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe6, 0xa8, 0xe1,
+		}},
+		ImagePictureAsPDF: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x76, 0x45, 0x83, 0xe6, 0x89, 0x78, 0xe8,
+			0xbd, 0x7d, 0xe6, 0xbd, 0x7b, 0x61, 0xc9, 0x7c, 0xbd, 0x7d, 0x7d, 0x7d, 0x09, 0x7d, 0x35, 0x7e,
+			0x51, 0x7c, 0x35, 0x7e, 0x45, 0x7b, 0xe8, 0x11, 0x78, 0x61, 0x35, 0x7e, 0x05, 0x77, 0x7d, 0x7d,
+			0x51, 0x76, 0xc9, 0x7c, 0x99, 0x75, 0xbd, 0x7b, 0x99, 0x75, 0xe6, 0x11, 0x76, 0xe8, 0x45, 0x83,
+			0xe2, 0x89, 0x78, 0x45, 0x7b, 0xe8, 0x11, 0x78, 0xe6, 0xbd, 0x7b, 0xe8, 0x45, 0x7b, 0xe6, 0x89,
+			0x78, 0xe2, 0x25, 0x81, 0x45, 0x83, 0xe6, 0xbd, 0x86, 0x61, 0xbd, 0x87, 0x45, 0x83, 0x79, 0x88,
+			0x91, 0x82, 0x35, 0x89, 0xd9, 0x81, 0x35, 0x89, 0xcd, 0x80, 0xe8, 0x11, 0x78, 0x61, 0x35, 0x89,
+			0x05, 0x77, 0x79, 0x88, 0x51, 0x76, 0xbd, 0x87, 0x99, 0x75, 0xbd, 0x86, 0x99, 0x75, 0xe6, 0x25,
+			0x81, 0xe8, 0x45, 0x83, 0xe2, 0x99, 0x83, 0xcd, 0x80, 0xe8, 0x11, 0x78, 0xe6, 0xbd, 0x86, 0xe8,
+			0xcd, 0x80, 0xe6, 0x99, 0x83, 0xe2, 0x79, 0x8c, 0x45, 0x83, 0xe6, 0xf1, 0x8e, 0xe8, 0xbd, 0x7d,
+			0xe6, 0x45, 0x92, 0xe8, 0x45, 0x7b, 0xe6, 0xf1, 0x8e, 0xe8, 0x11, 0x78, 0xe6, 0x45, 0x92, 0xe8,
+			0x99, 0x75, 0xe6, 0x79, 0x8c, 0xe8, 0x45, 0x83, 0xe2, 0x55, 0x71, 0xad, 0x92, 0x60, 0xbd, 0x6f,
+			0xad, 0x92, 0x89, 0x6e, 0x79, 0x91, 0x40, 0x55, 0x6d, 0xad, 0x8e, 0xe8, 0x55, 0x69, 0x60, 0x55,
+			0x6d, 0xbd, 0x67, 0x89, 0x6e, 0x89, 0x66, 0x40, 0x55, 0x71, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60,
+			0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0xad, 0x8e,
+			0x60, 0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x55,
+			0x71, 0xe2, 0x55, 0x71, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x71, 0xe8,
+			0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x9a, 0x60, 0xbd, 0x67, 0xad, 0x9a, 0x89, 0x66, 0x79, 0x99,
+			0x40, 0x55, 0x65, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x96, 0xe6, 0xad,
+			0x92, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x71, 0x55, 0x69, 0xe8, 0xad, 0x8e, 0xe8,
+			0x55, 0x69, 0xe1,
+		}},
+		ImagePortrait:     ImagePortrait,
+		ImageRemoveRedEye: ImageRemoveRedEye,
+		ImageRotate90DegreesCCW: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x82, 0xad, 0x9a, 0x60, 0xbd, 0x7e, 0xad,
+			0x9a, 0xad, 0x7a, 0x71, 0x99, 0x40, 0x79, 0x73, 0x89, 0x95, 0x00, 0x45, 0x76, 0x99, 0x92, 0x60,
+			0x11, 0x79, 0xcd, 0x94, 0x35, 0x7c, 0xbd, 0x95, 0x40, 0xf1, 0x82, 0xad, 0x96, 0x60, 0x11, 0x8b,
+			0xad, 0x96, 0xdd, 0x90, 0xdd, 0x90, 0x40, 0xad, 0x96, 0xf1, 0x82, 0x60, 0xad, 0x96, 0xcd, 0x7a,
+			0xdd, 0x90, 0x6a, 0x40, 0xf1, 0x82, 0x35, 0x6f, 0xe6, 0x25, 0x80, 0x05, 0xbd, 0x85, 0xcd, 0x74,
+			0xcd, 0x82, 0xbd, 0x77, 0x45, 0x78, 0x35, 0x6d, 0xcd, 0x82, 0xad, 0x62, 0xbd, 0x85, 0x99, 0x65,
+			0x25, 0x80, 0x35, 0x6b, 0xe6, 0xf1, 0x82, 0x60, 0xad, 0x8c, 0x35, 0x6b, 0xad, 0x93, 0x35, 0x72,
+			0x40, 0xad, 0x9a, 0xf1, 0x82, 0x60, 0xad, 0x9a, 0xcd, 0x87, 0xc5, 0x98, 0x19, 0x8c, 0x40, 0xa5,
+			0x93, 0xa5, 0x93, 0x60, 0x69, 0x90, 0xdd, 0x96, 0x19, 0x8c, 0xc5, 0x98, 0x40, 0xf1, 0x82, 0xad,
+			0x9a, 0xe2, 0x79, 0x71, 0x55, 0x91, 0x03, 0xad, 0x62, 0x89, 0x82, 0x79, 0x71, 0xbd, 0x73, 0x45,
+			0x80, 0x89, 0x82, 0x79, 0x71, 0x55, 0x91, 0xe2, 0x79, 0x71, 0xdd, 0x8b, 0x03, 0x89, 0x7a, 0xcd,
+			0x82, 0x79, 0x71, 0xbd, 0x79, 0x69, 0x68, 0xcd, 0x82, 0x79, 0x71, 0xdd, 0x8b, 0xe2, 0x79, 0x71,
+			0xcd, 0x82, 0xe1,
+		}},
+		ImageRotateLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7a, 0xad, 0x9a, 0x60, 0xcd, 0x79, 0x35, 0x9a,
+			0xcd, 0x76, 0xb2, 0x40, 0x25, 0x71, 0xcd, 0x95, 0x00, 0x68, 0xdd, 0x92, 0x60, 0x25, 0x76, 0x79,
+			0x94, 0x69, 0x78, 0x5d, 0x95, 0x40, 0x7a, 0xad, 0x96, 0xe8, 0xad, 0x9a, 0xe2, 0x86, 0xad, 0x9a,
+			0xe8, 0xad, 0x96, 0x60, 0x55, 0x8a, 0x45, 0x95, 0x2d, 0x8f, 0xc5, 0x8f, 0x40, 0xa8, 0x79, 0x82,
+			0x60, 0xa8, 0x74, 0x3d, 0x8e, 0x3d, 0x74, 0x40, 0x80, 0x79, 0x6e, 0xe6, 0xad, 0x7e, 0x05, 0xf1,
+			0x83, 0xbd, 0x73, 0x82, 0xad, 0x76, 0xcd, 0x76, 0x79, 0x6c, 0x82, 0x45, 0x62, 0xf1, 0x83, 0x35,
+			0x65, 0xad, 0x7e, 0x79, 0x6a, 0xe6, 0x80, 0x60, 0x8a, 0x79, 0x6a, 0x5d, 0x89, 0x55, 0x6c, 0x40,
+			0xa2, 0x79, 0x71, 0x60, 0x45, 0x94, 0xbd, 0x74, 0x25, 0x96, 0x19, 0x79, 0x40, 0xb0, 0x79, 0x82,
+			0x60, 0xb0, 0xcd, 0x8b, 0x11, 0x92, 0x89, 0x92, 0x40, 0x86, 0xad, 0x9a, 0xe2, 0xf1, 0x6c, 0x99,
+			0x91, 0x60, 0x11, 0x6b, 0x11, 0x8f, 0xd5, 0x69, 0xf9, 0x8b, 0x40, 0x25, 0x68, 0x79, 0x85, 0xe6,
+			0x35, 0x6c, 0x60, 0x89, 0x6c, 0x90, 0x71, 0x6d, 0x55, 0x8a, 0x40, 0xdd, 0x6f, 0xad, 0x8e, 0x00,
+			0xf1, 0x6c, 0x99, 0x91, 0xe2, 0x25, 0x68, 0x79, 0x7f, 0x60, 0x99, 0x68, 0x25, 0x7c, 0xcd, 0x69,
+			0x19, 0x79, 0x40, 0xf1, 0x6c, 0x69, 0x73, 0x00, 0xdd, 0x6f, 0x45, 0x76, 0x60, 0x55, 0x6e, 0x79,
+			0x78, 0x71, 0x6d, 0xcd, 0x7a, 0x40, 0x35, 0x6c, 0x79, 0x7f, 0xe6, 0x25, 0x68, 0xe1,
+		}},
+		ImageRotateRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x83, 0xad, 0x9a, 0xe8, 0xad, 0x96, 0x60,
+			0x79, 0x85, 0x45, 0x96, 0xbd, 0x87, 0x5d, 0x95, 0x40, 0x25, 0x8c, 0xdd, 0x92, 0x00, 0x9e, 0xcd,
+			0x95, 0x60, 0x55, 0x8c, 0xcd, 0x97, 0x55, 0x89, 0xb2, 0x40, 0x25, 0x83, 0xad, 0x9a, 0xe2, 0x25,
+			0x7d, 0xad, 0x9a, 0x60, 0x68, 0x45, 0x99, 0x11, 0x6e, 0x89, 0x92, 0x40, 0x25, 0x68, 0x79, 0x82,
+			0x60, 0x25, 0x68, 0x79, 0x7d, 0x54, 0x19, 0x79, 0x40, 0x25, 0x6f, 0x79, 0x71, 0x60, 0x69, 0x72,
+			0x35, 0x6e, 0xc5, 0x76, 0x55, 0x6c, 0x40, 0x25, 0x80, 0x79, 0x6a, 0xe6, 0x79, 0x81, 0x05, 0x35,
+			0x7c, 0x35, 0x65, 0x25, 0x7f, 0x45, 0x62, 0x55, 0x89, 0x79, 0x6c, 0x25, 0x7f, 0xad, 0x76, 0x35,
+			0x7c, 0xbd, 0x73, 0x79, 0x81, 0x79, 0x6e, 0xe6, 0x25, 0x80, 0x60, 0xad, 0x77, 0x79, 0x6e, 0xe9,
+			0x71, 0x3d, 0x74, 0x40, 0x25, 0x6c, 0x79, 0x82, 0x60, 0x25, 0x6c, 0x45, 0x8a, 0xf9, 0x70, 0xc5,
+			0x8f, 0x40, 0x25, 0x7d, 0xad, 0x96, 0xe8, 0xad, 0x9a, 0xe2, 0x35, 0x93, 0x99, 0x91, 0x00, 0x45,
+			0x90, 0xad, 0x8e, 0x60, 0xcd, 0x91, 0xad, 0x8c, 0xb5, 0x92, 0x55, 0x8a, 0x40, 0xf1, 0x93, 0x79,
+			0x85, 0xe6, 0xb0, 0x60, 0x89, 0x97, 0xdd, 0x88, 0x4d, 0x96, 0xf9, 0x8b, 0x40, 0x35, 0x93, 0x99,
+			0x91, 0xe2, 0xb0, 0x79, 0x7f, 0xe6, 0xf1, 0x93, 0x60, 0x99, 0x93, 0x25, 0x7d, 0xb5, 0x92, 0xcd,
+			0x7a, 0x40, 0x45, 0x90, 0x45, 0x76, 0x00, 0x35, 0x93, 0x69, 0x73, 0x60, 0x25, 0x95, 0x11, 0x76,
+			0x55, 0x96, 0x19, 0x79, 0x40, 0xb0, 0x79, 0x7f, 0xe1,
+		}},
+		ImageSlideshow: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x79, 0x45, 0x8b, 0x01, 0x35, 0x8b, 0x80,
+			0xcd, 0x79, 0xbd, 0x74, 0xe8, 0x45, 0x8b, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58,
+			0xe1,
+		}},
+		ImageStraighten: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0xa0, 0x60, 0xbd, 0x67, 0xa0, 0x89,
+			0x66, 0xcd, 0x8e, 0x40, 0x55, 0x65, 0x98, 0xe8, 0x68, 0x60, 0x55, 0x65, 0x79, 0x72, 0x89, 0x66,
+			0x3d, 0x71, 0x40, 0x55, 0x69, 0x60, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x60, 0x79, 0x99, 0x3d,
+			0x71, 0x40, 0xad, 0x9a, 0x68, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e,
+			0x40, 0xad, 0x96, 0xa0, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x68,
+			0xe6, 0x9c, 0xe8, 0x80, 0xe6, 0x94, 0xe8, 0x68, 0xe6, 0x84, 0xe8, 0x80, 0xe6, 0x7c, 0xe8, 0x68,
+			0xe6, 0x6c, 0xe8, 0x80, 0xe6, 0x64, 0xe8, 0x68, 0xe6, 0x55, 0x69, 0xe8, 0x98, 0xe2, 0x64, 0x80,
+			0xe6, 0x6c, 0xe6, 0x64, 0xe2, 0x7c, 0x80, 0xe6, 0x84, 0xe6, 0x7c, 0xe2, 0x94, 0x80, 0xe6, 0x9c,
+			0xe6, 0x94, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageStyle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x6a, 0x55, 0x96, 0x00, 0x99, 0x68, 0xdd,
+			0x95, 0x60, 0x79, 0x66, 0xf1, 0x94, 0xb5, 0x65, 0xad, 0x92, 0x40, 0x25, 0x66, 0x35, 0x8e, 0x00,
+			0x25, 0x6a, 0x25, 0x85, 0xe8, 0x55, 0x96, 0xe2, 0x89, 0x76, 0x89, 0x9b, 0x60, 0x45, 0x74, 0x89,
+			0x9b, 0xad, 0x72, 0xf1, 0x99, 0x40, 0x11, 0x71, 0x11, 0x96, 0xe8, 0x45, 0x88, 0x00, 0x35, 0x77,
+			0xbd, 0x99, 0x60, 0x55, 0x77, 0x45, 0x9a, 0x99, 0x77, 0xad, 0x9a, 0x40, 0x45, 0x78, 0x89, 0x9b,
+			0xe6, 0x89, 0x76, 0xe2, 0x11, 0x83, 0xf1, 0x99, 0x60, 0xad, 0x81, 0x89, 0x9a, 0x25, 0x80, 0xad,
+			0x99, 0x40, 0xf1, 0x7d, 0x45, 0x97, 0x00, 0x35, 0x71, 0xad, 0x74, 0x60, 0x89, 0x70, 0x25, 0x73,
+			0x55, 0x71, 0xad, 0x71, 0x40, 0xcd, 0x73, 0x89, 0x6f, 0x00, 0x92, 0xdd, 0x67, 0x60, 0x89, 0x8a,
+			0x55, 0x67, 0x98, 0x25, 0x68, 0x40, 0x25, 0x8e, 0x89, 0x6a, 0x00, 0xdd, 0x9a, 0xbd, 0x8c, 0x60,
+			0x55, 0x9b, 0x69, 0x8e, 0xad, 0x9a, 0xa0, 0x40, 0x55, 0x98, 0x35, 0x92, 0x00, 0x11, 0x83, 0xf1,
+			0x99, 0xe2, 0x99, 0x7d, 0xf1, 0x78, 0x60, 0x69, 0x7e, 0xf1, 0x78, 0x7e, 0x55, 0x78, 0x40, 0x99,
+			0x7f, 0xf1, 0x76, 0x60, 0x99, 0x7f, 0x25, 0x76, 0x7e, 0x89, 0x75, 0x40, 0x99, 0x7d, 0xf1, 0x74,
+			0x60, 0xcd, 0x7c, 0xf1, 0x74, 0x35, 0x7c, 0x89, 0x75, 0x40, 0x99, 0x7b, 0xf1, 0x76, 0x60, 0x99,
+			0x7b, 0xbd, 0x77, 0x35, 0x7c, 0x55, 0x78, 0x40, 0x99, 0x7d, 0xf1, 0x78, 0xe2, 0xcd, 0x81, 0x11,
+			0x96, 0x03, 0xae, 0x55, 0x8e, 0x45, 0x8a, 0xbd, 0x6b, 0x11, 0x75, 0x69, 0x73, 0xcd, 0x81, 0x11,
+			0x96, 0xe2, 0x11, 0x75, 0x69, 0x73, 0x01, 0x45, 0x8a, 0xbd, 0x6b, 0x11, 0x75, 0x69, 0x73, 0xe1,
+		}},
+		ImageSwitchCamera: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x78, 0x55, 0x8d, 0x01, 0xad, 0x7b, 0x35,
+			0x8a, 0x25, 0x76, 0x99, 0x84, 0xe6, 0xcd, 0x89, 0x05, 0x35, 0x84, 0x35, 0x8a, 0x55, 0x87, 0x55,
+			0x8d, 0xbd, 0x91, 0xf1, 0x82, 0x55, 0x87, 0x79, 0x78, 0x55, 0x84, 0x79, 0x7b, 0xbd, 0x89, 0xbd,
+			0x80, 0xe6, 0x25, 0x76, 0x03, 0x89, 0x7b, 0x79, 0x7b, 0xad, 0x78, 0x69, 0x78, 0x45, 0x6e, 0xf1,
+			0x82, 0xad, 0x78, 0x55, 0x8d, 0xe2, 0x55, 0x69, 0xb0, 0x60, 0xbd, 0x67, 0xb0, 0x89, 0x66, 0xcd,
+			0x96, 0x40, 0x55, 0x65, 0xa8, 0xe8, 0xcd, 0x71, 0x60, 0x55, 0x65, 0x45, 0x70, 0x89, 0x66, 0x09,
+			0x6f, 0x40, 0x55, 0x69, 0xcd, 0x6d, 0xe6, 0x25, 0x73, 0x00, 0x70, 0x50, 0xe6, 0x90, 0x00, 0xdd,
+			0x8c, 0xcd, 0x6d, 0xe6, 0xad, 0x96, 0x60, 0x35, 0x98, 0xcd, 0x6d, 0x71, 0x99, 0x09, 0x6f, 0x40,
+			0xad, 0x9a, 0xcd, 0x71, 0xe8, 0xa8, 0x60, 0xad, 0x9a, 0x99, 0x95, 0x71, 0x99, 0xcd, 0x96, 0x40,
+			0xad, 0x96, 0xb0, 0xe6, 0x55, 0x69, 0xe2, 0xad, 0x96, 0xa8, 0xe8, 0xcd, 0x71, 0xe6, 0x96, 0x00,
+			0x25, 0x86, 0x58, 0xe6, 0xdd, 0x79, 0x00, 0x6a, 0xcd, 0x71, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe6,
+			0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageSwitchVideo: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x72, 0xcd, 0x88, 0x01, 0x6a, 0x25, 0x86,
+			0xad, 0x70, 0xcd, 0x81, 0xe6, 0xad, 0x84, 0x05, 0x55, 0x80, 0x25, 0x86, 0x86, 0xcd, 0x88, 0x98,
+			0xcd, 0x7f, 0x86, 0xcd, 0x76, 0x55, 0x80, 0x79, 0x79, 0xad, 0x84, 0xcd, 0x7d, 0xe6, 0xad, 0x70,
+			0x03, 0x6a, 0x79, 0x79, 0x55, 0x72, 0xcd, 0x76, 0x55, 0x69, 0xcd, 0x7f, 0x55, 0x72, 0xcd, 0x88,
+			0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55,
+			0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40,
+			0x55, 0x69, 0xad, 0x6a, 0xe6, 0x98, 0x60, 0x99, 0x8d, 0xad, 0x6a, 0xcd, 0x8e, 0xdd, 0x6b, 0x40,
+			0xa0, 0xad, 0x6e, 0xe8, 0x7a, 0x00, 0xad, 0x9a, 0x55, 0x72, 0xe8, 0xad, 0x8d, 0x00, 0xa0, 0x86,
+			0xe8, 0x55, 0x91, 0x60, 0xa0, 0xf1, 0x92, 0xcd, 0x8e, 0x25, 0x94, 0x40, 0x98, 0x55, 0x95, 0xe6,
+			0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0x98, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8,
+			0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ImageTagFaces: ImageTagFaces,
+		ImageTexture: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x56, 0xf1, 0x97, 0x60, 0xcd, 0x69, 0x69, 0x97,
+			0x45, 0x69, 0xc5, 0x96, 0x40, 0x45, 0x68, 0xf1, 0x94, 0x00, 0xaa, 0x25, 0x68, 0x60, 0xac, 0x79,
+			0x68, 0xc5, 0x96, 0x3d, 0x69, 0x40, 0xcd, 0x97, 0x11, 0x6b, 0x00, 0x56, 0xf1, 0x97, 0xe2, 0x50,
+			0x79, 0x85, 0xe8, 0xbd, 0x7f, 0x00, 0xbd, 0x7f, 0x50, 0xe6, 0x79, 0x85, 0x00, 0x50, 0x79, 0x85,
+			0xe2, 0x50, 0x25, 0x70, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58,
+			0x50, 0xe6, 0x25, 0x70, 0x00, 0x50, 0x25, 0x70, 0xe2, 0xdd, 0x8f, 0xb0, 0x00, 0xb0, 0xdd, 0x8f,
+			0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0xdd, 0x8f,
+			0xe2, 0x89, 0x7a, 0xb0, 0x00, 0xb0, 0x89, 0x7a, 0xe8, 0x45, 0x80, 0x00, 0x45, 0x80, 0xb0, 0xe6,
+			0x89, 0x7a, 0xe1,
+		}},
+		ImageTimeLapse: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x92, 0x60, 0xcd, 0x87, 0xad, 0x92,
+			0x3d, 0x8d, 0x3d, 0x8d, 0x40, 0xad, 0x92, 0x80, 0x60, 0xad, 0x92, 0x35, 0x78, 0x3d, 0x8d, 0xc5,
+			0x72, 0x40, 0x80, 0x55, 0x6d, 0xe8, 0x80, 0x00, 0x45, 0x73, 0x55, 0x8d, 0x60, 0xbd, 0x75, 0xf1,
+			0x8f, 0x11, 0x79, 0x4d, 0x91, 0x40, 0x80, 0xad, 0x92, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a,
+			0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f,
+			0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99,
+			0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25,
+			0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad,
+			0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79,
+			0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89,
+			0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11,
+			0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f,
+			0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad,
+			0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ImageTimer: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x70, 0xad, 0x66, 0xe8, 0xad, 0x62, 0xe6, 0x90,
+			0xe8, 0xad, 0x66, 0xe6, 0x70, 0xe2, 0x7c, 0x79, 0x84, 0xe6, 0x84, 0xe8, 0x25, 0x75, 0xe6, 0x7c,
+			0xe8, 0x79, 0x84, 0xe2, 0x80, 0x99, 0x9a, 0x60, 0x11, 0x7b, 0x99, 0x9a, 0xb5, 0x76, 0xb5, 0x98,
+			0x40, 0x11, 0x6f, 0x89, 0x93, 0x60, 0xcd, 0x6b, 0x45, 0x90, 0xe9, 0x69, 0xe9, 0x8b, 0x40, 0x50,
+			0x99, 0x82, 0x60, 0x50, 0xad, 0x7d, 0xe9, 0x69, 0x4d, 0x79, 0x40, 0x11, 0x6f, 0xad, 0x71, 0x60,
+			0x55, 0x72, 0x69, 0x6e, 0xb5, 0x76, 0x81, 0x6c, 0x40, 0x80, 0x99, 0x6a, 0x60, 0x79, 0x84, 0x99,
+			0x6a, 0x69, 0x88, 0x19, 0x6c, 0x40, 0x69, 0x8f, 0x45, 0x70, 0x02, 0xcd, 0x92, 0xdd, 0x6c, 0x99,
+			0x95, 0xad, 0x6f, 0x35, 0x92, 0x11, 0x73, 0x60, 0x99, 0x94, 0xbd, 0x75, 0x4d, 0x96, 0x89, 0x79,
+			0x40, 0xb0, 0x99, 0x82, 0x60, 0xb0, 0x89, 0x87, 0x19, 0x96, 0xe9, 0x8b, 0x40, 0xf1, 0x90, 0x89,
+			0x93, 0x60, 0xad, 0x8d, 0xcd, 0x96, 0x4d, 0x89, 0xb5, 0x98, 0x40, 0x80, 0x99, 0x9a, 0xe2, 0x80,
+			0x99, 0x96, 0x60, 0x55, 0x88, 0x99, 0x96, 0x2d, 0x8e, 0xc5, 0x90, 0x40, 0xa8, 0x99, 0x82, 0x60,
+			0xa8, 0x45, 0x7a, 0x2d, 0x8e, 0x71, 0x74, 0x40, 0x80, 0x99, 0x6e, 0x60, 0xad, 0x77, 0x99, 0x6e,
+			0xd5, 0x71, 0x71, 0x74, 0x40, 0x58, 0x99, 0x82, 0x60, 0x58, 0xf1, 0x8a, 0xd5, 0x71, 0xc5, 0x90,
+			0x40, 0x80, 0x99, 0x96, 0xe2, 0x80, 0xad, 0x82, 0xe1,
+		}},
+		ImageTimer10: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x83, 0x55, 0x8d, 0xe6, 0xa0, 0xe8, 0xad,
+			0x72, 0xe6, 0xad, 0x83, 0xe8, 0x55, 0x8d, 0xe2, 0xad, 0x83, 0xad, 0x92, 0x61, 0x71, 0x81, 0xad,
+			0x92, 0xe5, 0x7f, 0x1d, 0x91, 0x55, 0x7e, 0x91, 0x8f, 0x55, 0x7e, 0x55, 0x8d, 0xe8, 0xad, 0x72,
+			0x61, 0x55, 0x7e, 0x71, 0x70, 0xe5, 0x7f, 0xe5, 0x6e, 0x71, 0x81, 0x55, 0x6d, 0xad, 0x83, 0x55,
+			0x6d, 0xe6, 0xa0, 0x61, 0x39, 0x92, 0x55, 0x6d, 0xc9, 0x93, 0xe5, 0x6e, 0x55, 0x95, 0x71, 0x70,
+			0x55, 0x95, 0xad, 0x72, 0xe8, 0x55, 0x8d, 0x61, 0x55, 0x95, 0x91, 0x8f, 0xc9, 0x93, 0x1d, 0x91,
+			0x39, 0x92, 0xad, 0x92, 0xa0, 0xad, 0x92, 0xe6, 0xad, 0x83, 0xe2, 0x62, 0xad, 0x92, 0xe8, 0xad,
+			0x72, 0xe6, 0xbd, 0x6a, 0xe8, 0x55, 0x6d, 0xe6, 0x55, 0x76, 0xe8, 0xad, 0x92, 0xe6, 0x62, 0xe1,
+		}},
+		ImageTimer3: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x6c, 0xad, 0x92, 0xe8, 0x55, 0x8d, 0xe6, 0x69,
+			0x87, 0xe8, 0xad, 0x82, 0xe6, 0xcd, 0x78, 0xe8, 0x55, 0x7d, 0xe6, 0x69, 0x87, 0xe8, 0xad, 0x72,
+			0xe6, 0x6c, 0xe8, 0x55, 0x6d, 0xe6, 0x69, 0x87, 0x61, 0xa1, 0x89, 0x55, 0x6d, 0x2d, 0x8b, 0xe5,
+			0x6e, 0xbd, 0x8c, 0x71, 0x70, 0xbd, 0x8c, 0xad, 0x72, 0xe8, 0x69, 0x7b, 0x60, 0xbd, 0x8c, 0x55,
+			0x7d, 0x75, 0x8b, 0xbd, 0x7e, 0x40, 0x55, 0x88, 0x25, 0x80, 0x61, 0x2d, 0x8a, 0x25, 0x80, 0x75,
+			0x8b, 0x71, 0x81, 0xbd, 0x8c, 0xbd, 0x82, 0xbd, 0x8c, 0x99, 0x84, 0xe8, 0x55, 0x8d, 0x61, 0xbd,
+			0x8c, 0x91, 0x8f, 0x2d, 0x8b, 0x1d, 0x91, 0xa1, 0x89, 0xad, 0x92, 0x69, 0x87, 0xad, 0x92, 0xe6,
+			0x6c, 0xe1,
+		}},
+		ImageTimerOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x94, 0x45, 0x8f, 0x00, 0x69, 0x91, 0x69,
+			0x8c, 0x60, 0x89, 0x92, 0x89, 0x8a, 0x45, 0x93, 0x90, 0x40, 0xa8, 0x99, 0x82, 0x60, 0xa8, 0x45,
+			0x7a, 0x2d, 0x8e, 0x71, 0x74, 0x40, 0x80, 0x99, 0x6e, 0x60, 0x35, 0x7d, 0x99, 0x6e, 0xa5, 0x7a,
+			0x4d, 0x6f, 0x40, 0x35, 0x76, 0x35, 0x71, 0x00, 0x45, 0x73, 0x45, 0x6e, 0x60, 0x11, 0x76, 0x79,
+			0x6c, 0x5d, 0x79, 0x89, 0x6b, 0x40, 0x80, 0x99, 0x6a, 0x60, 0x89, 0x84, 0x99, 0x6a, 0x71, 0x88,
+			0x19, 0x6c, 0x40, 0x69, 0x8f, 0x45, 0x70, 0x02, 0xcd, 0x92, 0xdd, 0x6c, 0x99, 0x95, 0xad, 0x6f,
+			0x35, 0x92, 0x11, 0x73, 0x60, 0x35, 0x95, 0x55, 0x76, 0x99, 0x96, 0x4d, 0x7a, 0x40, 0xb0, 0x99,
+			0x82, 0x60, 0xb0, 0xf1, 0x85, 0x19, 0x97, 0x2d, 0x89, 0x40, 0x45, 0x94, 0x45, 0x8f, 0xe2, 0x84,
+			0x7a, 0xe8, 0x25, 0x75, 0xe6, 0x7c, 0xe8, 0x72, 0x00, 0x84, 0x7a, 0xe2, 0xdd, 0x95, 0x45, 0x9c,
+			0x00, 0xf1, 0x8e, 0x55, 0x95, 0x60, 0xbd, 0x8b, 0xdd, 0x97, 0x09, 0x88, 0x3d, 0x99, 0x40, 0x80,
+			0x99, 0x9a, 0x60, 0x11, 0x7b, 0x99, 0x9a, 0xb5, 0x76, 0xb5, 0x98, 0x40, 0x11, 0x6f, 0x89, 0x93,
+			0x60, 0xcd, 0x6b, 0x45, 0x90, 0xe9, 0x69, 0xe9, 0x8b, 0x40, 0x50, 0x99, 0x82, 0x60, 0x50, 0x89,
+			0x7e, 0x3d, 0x69, 0xdd, 0x7a, 0x40, 0x45, 0x6d, 0xad, 0x73, 0x03, 0xcd, 0x63, 0x35, 0x6a, 0xad,
+			0x66, 0x55, 0x67, 0xbd, 0x98, 0x69, 0x99, 0xdd, 0x95, 0x45, 0x9c, 0xe2, 0x80, 0x99, 0x96, 0x60,
+			0x79, 0x83, 0x99, 0x96, 0x91, 0x86, 0x89, 0x95, 0x40, 0x45, 0x8c, 0x69, 0x92, 0x00, 0x35, 0x70,
+			0x55, 0x76, 0x60, 0x25, 0x6e, 0xdd, 0x78, 0x11, 0x6d, 0x11, 0x7c, 0x40, 0x58, 0x99, 0x82, 0x60,
+			0x58, 0xf1, 0x8a, 0xd5, 0x71, 0xc5, 0x90, 0x40, 0x80, 0x99, 0x96, 0xe2, 0x70, 0xad, 0x66, 0xe8,
+			0xad, 0x62, 0xe6, 0x90, 0xe8, 0xad, 0x66, 0xe6, 0x70, 0xe2, 0x45, 0x7e, 0x55, 0x84, 0xe2, 0xcd,
+			0x83, 0xcd, 0x7e, 0xe1,
+		}},
+		ImageTonality: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a,
+			0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67,
+			0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60,
+			0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89,
+			0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x7c, 0x99, 0x96, 0xe8, 0x69, 0x69, 0x60, 0x35,
+			0x75, 0x55, 0x6a, 0x45, 0x6f, 0xc5, 0x70, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0xcd, 0x88,
+			0x45, 0x6f, 0x35, 0x8f, 0x40, 0x7c, 0x99, 0x96, 0xe2, 0x84, 0x99, 0x96, 0x60, 0x89, 0x84, 0x55,
+			0x96, 0xf1, 0x86, 0x89, 0x95, 0x40, 0x99, 0x8b, 0x79, 0x93, 0xe6, 0x84, 0xe8, 0x99, 0x96, 0xe2,
+			0x84, 0x79, 0x8f, 0xe6, 0x99, 0x90, 0x60, 0x89, 0x91, 0x55, 0x8e, 0x71, 0x92, 0x35, 0x8d, 0x40,
+			0x11, 0x94, 0xbd, 0x8a, 0xe6, 0x84, 0xe8, 0x79, 0x8f, 0xe2, 0x84, 0xbd, 0x86, 0xe6, 0x99, 0x95,
+			0x60, 0x25, 0x96, 0x99, 0x85, 0x45, 0x96, 0x69, 0x84, 0x40, 0x79, 0x96, 0x84, 0xe6, 0x84, 0xe8,
+			0xbd, 0x86, 0xe2, 0x84, 0x7c, 0xe6, 0x79, 0x96, 0x60, 0x69, 0x96, 0xcd, 0x7c, 0x45, 0x96, 0x99,
+			0x7b, 0x40, 0x99, 0x95, 0x45, 0x79, 0xe6, 0x84, 0xe8, 0x7c, 0xe2, 0x84, 0x45, 0x75, 0xe6, 0x11,
+			0x94, 0x60, 0x69, 0x93, 0xf1, 0x73, 0x79, 0x92, 0xcd, 0x72, 0x00, 0x99, 0x90, 0x89, 0x70, 0xe6,
+			0x84, 0xe8, 0x45, 0x75, 0xe2, 0x84, 0x89, 0x6c, 0xe6, 0x99, 0x8b, 0x60, 0x55, 0x89, 0x45, 0x6b,
+			0xf1, 0x86, 0x71, 0x6a, 0x40, 0x84, 0x69, 0x69, 0xe8, 0x89, 0x6c, 0xe1,
+		}},
+		ImageTransform: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8a, 0x55, 0x9d, 0x02, 0x89, 0x80, 0x35,
+			0x93, 0x55, 0x83, 0x69, 0x90, 0xad, 0x88, 0xbd, 0x95, 0xe8, 0x79, 0x8c, 0xe6, 0x55, 0x77, 0x60,
+			0x99, 0x75, 0x79, 0x8c, 0x79, 0x74, 0x55, 0x8b, 0x40, 0x55, 0x73, 0x79, 0x88, 0xe8, 0x89, 0x77,
+			0xe6, 0x55, 0x65, 0xe8, 0x89, 0x73, 0xe6, 0x55, 0x73, 0xe8, 0x45, 0x6a, 0x05, 0x5c, 0x99, 0x6f,
+			0x35, 0x6b, 0xcd, 0x6c, 0x55, 0x75, 0xad, 0x62, 0x79, 0x7f, 0xcd, 0x6c, 0xad, 0x7c, 0x99, 0x6f,
+			0x55, 0x77, 0x45, 0x6a, 0xe8, 0x79, 0x88, 0xe6, 0xad, 0x9a, 0xe8, 0x79, 0x8c, 0xe6, 0xad, 0x8c,
+			0xe8, 0xbd, 0x95, 0x02, 0xa4, 0x69, 0x90, 0xcd, 0x94, 0x35, 0x93, 0xad, 0x8a, 0x55, 0x9d, 0xe2,
+			0xad, 0x88, 0x79, 0x84, 0xe8, 0x89, 0x77, 0xe6, 0x55, 0x7b, 0xe8, 0x89, 0x73, 0xe6, 0xad, 0x88,
+			0x60, 0x69, 0x8a, 0x89, 0x73, 0x89, 0x8b, 0xad, 0x74, 0x40, 0xad, 0x8c, 0x89, 0x77, 0xe8, 0x79,
+			0x84, 0xe6, 0xad, 0x88, 0xe1,
+		}},
+		ImageTune: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x7c, 0xb0, 0xe8, 0x92, 0xe6, 0x79, 0x80,
+			0xe8, 0x89, 0x8e, 0xe6, 0xb0, 0xe8, 0x89, 0x92, 0xe6, 0x79, 0x80, 0xe8, 0xb0, 0xe6, 0x79, 0x7c,
+			0xe2, 0x50, 0x89, 0x92, 0xe8, 0x89, 0x8e, 0xe6, 0x79, 0x78, 0xe8, 0x89, 0x92, 0xe6, 0x50, 0xe2,
+			0x79, 0x74, 0x79, 0x87, 0xe8, 0x84, 0xe6, 0x50, 0xe8, 0x7c, 0xe6, 0x79, 0x74, 0xe8, 0x69, 0x78,
+			0xe6, 0x79, 0x78, 0xe8, 0x79, 0x87, 0xe6, 0x79, 0x74, 0xe2, 0x79, 0x7c, 0x84, 0xe8, 0x7c, 0xe6,
+			0xb0, 0xe8, 0x84, 0xe6, 0x79, 0x7c, 0xe2, 0x89, 0x87, 0x6e, 0xe8, 0x50, 0xe6, 0x89, 0x8b, 0xe8,
+			0x79, 0x6d, 0xe6, 0xb0, 0xe8, 0x79, 0x71, 0xe6, 0x89, 0x8b, 0xe8, 0x6e, 0xe6, 0x89, 0x87, 0xe2,
+			0x50, 0x79, 0x71, 0xe8, 0x79, 0x6d, 0xe6, 0x89, 0x83, 0xe8, 0x79, 0x71, 0xe6, 0x50, 0xe1,
+		}},
+		ImageViewComfy: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0x55, 0x95, 0xe8, 0xad, 0x6a, 0xe6,
+			0xad, 0x9a, 0xe8, 0x55, 0x95, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x96, 0x7c, 0xe8, 0xad, 0x6e, 0xe6,
+			0x55, 0x69, 0xe8, 0x7c, 0xe6, 0xad, 0x96, 0xe2, 0x89, 0x79, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8,
+			0x84, 0xe6, 0x89, 0x79, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0x89, 0x75, 0xe8,
+			0x84, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		ImageViewCompact: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x94, 0xe8, 0x55, 0x6b, 0xe6,
+			0xad, 0x9a, 0xe8, 0xad, 0x94, 0xe6, 0x55, 0x65, 0xe2, 0x55, 0x69, 0xcd, 0x77, 0xe6, 0xad, 0x71,
+			0xe8, 0x55, 0x6f, 0xe6, 0x55, 0x69, 0xe8, 0xcd, 0x77, 0xe2, 0xad, 0x75, 0xcd, 0x77, 0xe6, 0x7c,
+			0xe8, 0x55, 0x6f, 0xe6, 0xad, 0x75, 0xe8, 0xcd, 0x77, 0xe2, 0x84, 0xcd, 0x77, 0xe6, 0x55, 0x8a,
+			0xe8, 0x55, 0x6f, 0xe6, 0x84, 0xe8, 0xcd, 0x77, 0xe2, 0x55, 0x8e, 0xcd, 0x77, 0xe6, 0xad, 0x96,
+			0xe8, 0x55, 0x6f, 0xe6, 0x55, 0x8e, 0xe8, 0xcd, 0x77, 0xe2, 0x55, 0x8e, 0x45, 0x84, 0xe6, 0xad,
+			0x96, 0xe8, 0xcd, 0x7b, 0xe6, 0x55, 0x8e, 0xe8, 0x45, 0x84, 0xe2, 0x84, 0x45, 0x84, 0xe6, 0x55,
+			0x8a, 0xe8, 0xcd, 0x7b, 0xe6, 0x84, 0xe8, 0x45, 0x84, 0xe2, 0xad, 0x75, 0x45, 0x84, 0xe6, 0x7c,
+			0xe8, 0xcd, 0x7b, 0xe6, 0xad, 0x75, 0xe8, 0x45, 0x84, 0xe2, 0xad, 0x71, 0xcd, 0x7b, 0xe6, 0x55,
+			0x69, 0xe8, 0x45, 0x84, 0xe6, 0xad, 0x71, 0xe8, 0xcd, 0x7b, 0xe2, 0x55, 0x8e, 0xad, 0x90, 0xe6,
+			0xad, 0x96, 0xe8, 0x45, 0x88, 0xe6, 0x55, 0x8e, 0xe8, 0xad, 0x90, 0xe2, 0x84, 0xad, 0x90, 0xe6,
+			0x55, 0x8a, 0xe8, 0x45, 0x88, 0xe6, 0x84, 0xe8, 0xad, 0x90, 0xe2, 0xad, 0x75, 0xad, 0x90, 0xe6,
+			0x7c, 0xe8, 0x45, 0x88, 0xe6, 0xad, 0x75, 0xe8, 0xad, 0x90, 0xe2, 0x55, 0x69, 0xad, 0x90, 0xe6,
+			0xad, 0x71, 0xe8, 0x45, 0x88, 0xe6, 0x55, 0x69, 0xe8, 0xad, 0x90, 0xe1,
+		}},
+		ImageVignette: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55,
+			0x95, 0x89, 0x66, 0x25, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65,
+			0x11, 0x6d, 0x89, 0x66, 0xdd, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x45,
+			0x98, 0xad, 0x6a, 0x79, 0x99, 0xdd, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0x60,
+			0xad, 0x9a, 0xf1, 0x92, 0x79, 0x99, 0x25, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69,
+			0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe2, 0x11, 0x80, 0xbd,
+			0x8a, 0x61, 0xb5, 0x86, 0xbd, 0x8a, 0x85, 0x8b, 0x91, 0x87, 0x55, 0x90, 0x69, 0x84, 0x55, 0x90,
+			0x80, 0x40, 0x85, 0x8b, 0x71, 0x78, 0x62, 0xb5, 0x86, 0x45, 0x75, 0x11, 0x80, 0x45, 0x75, 0x6d,
+			0x79, 0x45, 0x75, 0x9d, 0x74, 0x71, 0x78, 0xcd, 0x6f, 0x99, 0x7b, 0xcd, 0x6f, 0x80, 0x40, 0x9d,
+			0x74, 0x91, 0x87, 0x60, 0x6d, 0x79, 0xbd, 0x8a, 0x11, 0x80, 0xbd, 0x8a, 0xe2, 0x11, 0x80, 0xbd,
+			0x86, 0x60, 0x11, 0x7b, 0xbd, 0x86, 0x71, 0x77, 0xc5, 0x84, 0x40, 0xcd, 0x73, 0x80, 0x60, 0xcd,
+			0x73, 0x35, 0x7d, 0x71, 0x77, 0x3d, 0x7b, 0x40, 0x15, 0x80, 0x45, 0x79, 0x60, 0x11, 0x85, 0x45,
+			0x79, 0xb5, 0x88, 0x3d, 0x7b, 0x40, 0x55, 0x8c, 0x80, 0x60, 0x55, 0x8c, 0xcd, 0x82, 0xb1, 0x88,
+			0xc5, 0x84, 0x40, 0x11, 0x80, 0xbd, 0x86, 0xe1,
+		}},
+		ImageWBAuto:         ImageWBAuto,
+		ImageWBCloudy:       ImageWBCloudy,
+		ImageWBIncandescent: ImageWBIncandescent,
+		ImageWBIridescent:   ImageWBIridescent,
+		ImageWBSunny:        ImageWBSunny,
+		MapsAddLocation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xad, 0x84, 0xe6, 0x84, 0xe8, 0xad, 0x7c,
+			0xe6, 0x94, 0xe8, 0xad, 0x78, 0xe6, 0x84, 0xe8, 0xad, 0x70, 0xe6, 0x7c, 0xe8, 0xad, 0x78, 0xe6,
+			0x6c, 0xe8, 0xad, 0x7c, 0xe6, 0x7c, 0xe8, 0xad, 0x84, 0xe2, 0x80, 0x69, 0x95, 0x60, 0xdd, 0x88,
+			0x55, 0x8d, 0x19, 0x8d, 0xc5, 0x86, 0x40, 0x55, 0x91, 0x35, 0x7b, 0x61, 0x55, 0x91, 0x59, 0x73,
+			0x51, 0x8c, 0x59, 0x6e, 0x4d, 0x87, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xb1, 0x73, 0x59, 0x6e,
+			0x61, 0xad, 0x6e, 0x59, 0x73, 0xad, 0x6e, 0x35, 0x7b, 0xad, 0x6e, 0x35, 0x80, 0x66, 0xc5, 0x86,
+			0x40, 0x80, 0x69, 0x95, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x45, 0x75, 0x89, 0x91, 0xf9, 0x6f, 0xb5,
+			0x89, 0x40, 0xad, 0x6a, 0x35, 0x7b, 0x60, 0xad, 0x6a, 0x35, 0x71, 0x19, 0x71, 0x45, 0x6b, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0x79, 0x88, 0x55, 0x65, 0xe9, 0x8e, 0x45, 0x6b, 0x40, 0x55, 0x95, 0x35,
+			0x7b, 0x60, 0x55, 0x95, 0xdd, 0x81, 0x09, 0x90, 0xb5, 0x89, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80,
+			0xad, 0x7a, 0xe1,
+		}},
+		MapsBeenhere: MapsBeenhere,
+		MapsDirections: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x75, 0x90, 0xe6, 0x55, 0x79, 0xe8, 0x55,
+			0x7f, 0xe6, 0x99, 0x85, 0xe8, 0x8a, 0x01, 0x55, 0x8d, 0x55, 0x7d, 0x99, 0x85, 0x99, 0x75, 0xe8,
+			0x55, 0x7b, 0xe6, 0x55, 0x77, 0x60, 0x7d, 0x76, 0x55, 0x7b, 0xe9, 0x75, 0xe9, 0x7b, 0x40, 0x55,
+			0x75, 0x55, 0x7d, 0xe8, 0x90, 0xe2, 0x80, 0xdd, 0x9a, 0x61, 0x35, 0x7f, 0xdd, 0x9a, 0x71, 0x7e,
+			0x99, 0x9a, 0xad, 0x7d, 0x55, 0x9a, 0x25, 0x7d, 0xcd, 0x99, 0x00, 0x35, 0x66, 0xdd, 0x82, 0x61,
+			0xad, 0x65, 0x55, 0x82, 0x69, 0x65, 0x91, 0x81, 0x25, 0x65, 0xcd, 0x80, 0x25, 0x65, 0x80, 0x40,
+			0x69, 0x65, 0x71, 0x7e, 0x60, 0xad, 0x65, 0xad, 0x7d, 0x35, 0x66, 0x25, 0x7d, 0x00, 0x25, 0x7d,
+			0x35, 0x66, 0x61, 0xad, 0x7d, 0xad, 0x65, 0x71, 0x7e, 0x69, 0x65, 0x35, 0x7f, 0x25, 0x65, 0x80,
+			0x25, 0x65, 0x40, 0x91, 0x81, 0x69, 0x65, 0x60, 0x55, 0x82, 0xad, 0x65, 0xdd, 0x82, 0x35, 0x66,
+			0x00, 0xcd, 0x99, 0x25, 0x7d, 0x61, 0x55, 0x9a, 0xad, 0x7d, 0x99, 0x9a, 0x71, 0x7e, 0xdd, 0x9a,
+			0x35, 0x7f, 0xdd, 0x9a, 0x80, 0x40, 0x99, 0x9a, 0x91, 0x81, 0x60, 0x55, 0x9a, 0x55, 0x82, 0xcd,
+			0x99, 0xdd, 0x82, 0x00, 0xdd, 0x82, 0xcd, 0x99, 0x61, 0x55, 0x82, 0x55, 0x9a, 0x91, 0x81, 0x99,
+			0x9a, 0xcd, 0x80, 0xdd, 0x9a, 0x80, 0xdd, 0x9a, 0xe2, 0x89, 0x74, 0x79, 0x8b, 0x04, 0x80, 0xf1,
+			0x96, 0xf1, 0x96, 0x80, 0x80, 0x11, 0x69, 0x11, 0x69, 0x80, 0x89, 0x74, 0x79, 0x8b, 0xe2, 0x80,
+			0x80, 0xe1,
+		}},
+		MapsDirectionsBike:    MapsDirectionsBike,
+		MapsDirectionsBoat:    MapsDirectionsBoat,
+		MapsDirectionsBus:     MapsDirectionsBus,
+		MapsDirectionsCar:     MapsDirectionsCar,
+		MapsDirectionsRailway: MapsDirectionsRailway,
+		MapsDirectionsRun:     MapsDirectionsRun,
+		MapsDirectionsSubway:  MapsDirectionsSubway,
+		MapsDirectionsTransit: MapsDirectionsTransit,
+		MapsDirectionsWalk:    MapsDirectionsWalk,
+		MapsEVStation:         MapsEVStation,
+		MapsEditLocation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x77, 0x55, 0x83, 0xe6, 0x45, 0x7a, 0x02,
+			0x88, 0x89, 0x79, 0x25, 0x81, 0xad, 0x76, 0x55, 0x77, 0x69, 0x80, 0xe8, 0x55, 0x83, 0xe2, 0x35,
+			0x86, 0x55, 0x77, 0x00, 0x90, 0x89, 0x75, 0x60, 0x35, 0x88, 0x55, 0x75, 0x35, 0x88, 0x09, 0x75,
+			0x40, 0x90, 0x89, 0x74, 0x00, 0x25, 0x86, 0xad, 0x72, 0x60, 0xf1, 0x85, 0x79, 0x72, 0xa5, 0x85,
+			0x79, 0x72, 0x40, 0x25, 0x85, 0xad, 0x72, 0x01, 0x55, 0x83, 0x79, 0x74, 0x35, 0x86, 0x55, 0x77,
+			0xe2, 0x80, 0x69, 0x95, 0x60, 0xdd, 0x88, 0x55, 0x8d, 0x19, 0x8d, 0xc5, 0x86, 0x40, 0x55, 0x91,
+			0x35, 0x7b, 0x60, 0x55, 0x91, 0x55, 0x73, 0x4d, 0x8c, 0x55, 0x6e, 0x40, 0x80, 0x55, 0x69, 0x60,
+			0xbd, 0x78, 0x55, 0x69, 0xb5, 0x73, 0x55, 0x6e, 0x40, 0xad, 0x6e, 0x35, 0x7b, 0x60, 0xad, 0x6e,
+			0x35, 0x80, 0x66, 0xc5, 0x86, 0x40, 0x80, 0x69, 0x95, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x45, 0x75,
+			0x89, 0x91, 0xf9, 0x6f, 0xb5, 0x89, 0x40, 0xad, 0x6a, 0x35, 0x7b, 0x60, 0xad, 0x6a, 0x35, 0x71,
+			0x19, 0x71, 0x45, 0x6b, 0x40, 0x80, 0x55, 0x65, 0x60, 0x79, 0x88, 0x55, 0x65, 0xe9, 0x8e, 0x45,
+			0x6b, 0x40, 0x55, 0x95, 0x35, 0x7b, 0x60, 0x55, 0x95, 0xdd, 0x81, 0x09, 0x90, 0xb5, 0x89, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x7a, 0xe1,
+		}},
+		MapsFlight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x77, 0xad, 0x9a, 0xe8, 0xdd, 0x97, 0x00,
+			0xad, 0x7c, 0xdd, 0x93, 0xe8, 0x25, 0x83, 0x00, 0x55, 0x65, 0x94, 0xe8, 0x25, 0x86, 0x00, 0xad,
+			0x7c, 0x69, 0x78, 0xe8, 0xad, 0x68, 0x60, 0xad, 0x7c, 0x45, 0x67, 0xa5, 0x7d, 0x4d, 0x66, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0x69, 0x81, 0x55, 0x65, 0x5d, 0x82, 0x4d, 0x66, 0x40, 0x55, 0x83, 0xad,
+			0x68, 0xe8, 0x69, 0x78, 0x00, 0xad, 0x9a, 0x25, 0x86, 0xe8, 0x94, 0x00, 0x55, 0x83, 0x25, 0x83,
+			0xe8, 0xdd, 0x93, 0x00, 0xad, 0x88, 0xdd, 0x97, 0xe8, 0xad, 0x9a, 0x01, 0x80, 0x35, 0x98, 0x55,
+			0x77, 0xad, 0x9a, 0xe1,
+		}},
+		MapsHotel: MapsHotel,
+		MapsLayers: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x97, 0x05, 0x50, 0xad, 0x84, 0x55,
+			0x6b, 0x35, 0x82, 0x80, 0x45, 0x92, 0xad, 0x94, 0x35, 0x82, 0xb0, 0xad, 0x84, 0x80, 0x55, 0x97,
+			0xe2, 0x80, 0x35, 0x8d, 0x03, 0x50, 0x89, 0x7a, 0x80, 0xdd, 0x67, 0xb0, 0x89, 0x7a, 0x80, 0x35,
+			0x8d, 0xe2, 0x80, 0x25, 0x79, 0xe2, 0x80, 0x25, 0x88, 0x03, 0x79, 0x91, 0x89, 0x7a, 0x80, 0xf1,
+			0x6c, 0x89, 0x6e, 0x89, 0x7a, 0x80, 0x25, 0x88, 0xe1,
+		}},
+		MapsLayersClear: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x8b, 0x55, 0x84, 0x07, 0x89, 0x88, 0x89,
+			0x81, 0x79, 0x91, 0x89, 0x7a, 0x80, 0xf1, 0x6c, 0x35, 0x79, 0x35, 0x72, 0x55, 0x76, 0x55, 0x6f,
+			0x80, 0xdd, 0x67, 0xb0, 0x89, 0x7a, 0x55, 0x8b, 0x55, 0x84, 0xe2, 0x11, 0x91, 0x11, 0x8a, 0x03,
+			0x35, 0x8e, 0x35, 0x87, 0xad, 0x94, 0x35, 0x82, 0xb0, 0xad, 0x84, 0x11, 0x91, 0x11, 0x8a, 0xe2,
+			0x55, 0x97, 0xad, 0x9b, 0x0d, 0xbd, 0x8a, 0x9e, 0x80, 0x55, 0x97, 0x50, 0xad, 0x84, 0x55, 0x6b,
+			0x35, 0x82, 0x80, 0x45, 0x92, 0xdd, 0x87, 0x25, 0x8c, 0x8a, 0x45, 0x89, 0x80, 0x35, 0x8d, 0x50,
+			0x89, 0x7a, 0x60, 0x45, 0x74, 0xdd, 0x64, 0x35, 0x69, 0xbd, 0x67, 0x55, 0x66, 0x35, 0x9a, 0xcd,
+			0x98, 0x55, 0x97, 0xad, 0x9b, 0xe2, 0xdd, 0x80, 0xdd, 0x79, 0xe1,
+		}},
+		MapsLocalATM:              MapsLocalATM,
+		MapsLocalActivity:         MapsLocalActivity,
+		MapsLocalAirport:          MapsLocalAirport,
+		MapsLocalBar:              MapsLocalBar,
+		MapsLocalCafe:             MapsLocalCafe,
+		MapsLocalCarWash:          MapsLocalCarWash,
+		MapsLocalConvenienceStore: MapsLocalConvenienceStore,
+		MapsLocalDining:           MapsLocalDining,
+		MapsLocalDrink:            MapsLocalDrink,
+		MapsLocalFlorist:          MapsLocalFlorist,
+		MapsLocalGasStation:       MapsLocalGasStation,
+		MapsLocalGroceryStore:     MapsLocalGroceryStore,
+		MapsLocalHospital:         MapsLocalHospital,
+		MapsLocalHotel:            MapsLocalHotel,
+		MapsLocalLaundryService:   MapsLocalLaundryService,
+		MapsLocalLibrary:          MapsLocalLibrary,
+		MapsLocalMall:             MapsLocalMall,
+		MapsLocalMovies:           MapsLocalMovies,
+		MapsLocalOffer:            MapsLocalOffer,
+		MapsLocalParking:          MapsLocalParking,
+		MapsLocalPharmacy:         MapsLocalPharmacy,
+		MapsLocalPhone:            MapsLocalPhone,
+		MapsLocalPizza:            MapsLocalPizza,
+		MapsLocalPlay:             MapsLocalPlay,
+		MapsLocalPostOffice:       MapsLocalPostOffice,
+		MapsLocalPrintshop:        MapsLocalPrintshop,
+		MapsLocalSee:              MapsLocalSee,
+		MapsLocalShipping:         MapsLocalShipping,
+		MapsLocalTaxi:             MapsLocalTaxi,
+		MapsMap: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x88, 0xb0, 0x01, 0x45, 0x77, 0xcd, 0x91,
+			0x55, 0x6b, 0x89, 0x96, 0x60, 0x35, 0x6a, 0x25, 0x97, 0x19, 0x69, 0x79, 0x96, 0x40, 0x50, 0x79,
+			0x94, 0xe8, 0x45, 0x6f, 0x60, 0x50, 0x69, 0x6e, 0x81, 0x68, 0xbd, 0x6d, 0x40, 0xcd, 0x69, 0xbd,
+			0x6c, 0x02, 0x45, 0x77, 0x50, 0xcd, 0x88, 0x25, 0x6e, 0xad, 0x94, 0x69, 0x69, 0x60, 0xcd, 0x95,
+			0xdd, 0x68, 0xe9, 0x96, 0x81, 0x69, 0x40, 0xb0, 0x79, 0x6b, 0xe8, 0x25, 0x91, 0x60, 0xb0, 0xdd,
+			0x91, 0x81, 0x97, 0x69, 0x92, 0x40, 0x45, 0x96, 0x35, 0x93, 0x00, 0xcd, 0x88, 0xb0, 0xe2, 0x89,
+			0x86, 0xa6, 0xe8, 0x55, 0x71, 0x00, 0x79, 0x79, 0xf1, 0x6c, 0xe8, 0x99, 0x8e, 0x00, 0x89, 0x86,
+			0xa6, 0xe2, 0x89, 0x8a, 0xa6, 0x00, 0xa8, 0xdd, 0x8f, 0xe8, 0xbd, 0x6d, 0x00, 0x89, 0x8a, 0x55,
+			0x71, 0xe8, 0xa6, 0xe2, 0x58, 0x35, 0x92, 0x00, 0x79, 0x75, 0x99, 0x8e, 0xe8, 0xf1, 0x6c, 0x00,
+			0x58, 0x11, 0x70, 0xe8, 0x35, 0x92, 0xe2, 0x89, 0x8a, 0x55, 0x71, 0xe8, 0xa6, 0xe8, 0x55, 0x71,
+			0xe2, 0x79, 0x75, 0xf1, 0x6c, 0xe8, 0x99, 0x8e, 0xe8, 0xf1, 0x6c, 0xe1,
+		}},
+		MapsMyLocation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x35, 0x9d, 0xe8, 0x35, 0x98, 0x60, 0xdd,
+			0x74, 0x45, 0x97, 0xcd, 0x6e, 0x35, 0x91, 0x40, 0xcd, 0x67, 0x84, 0xe6, 0xcd, 0x62, 0xe8, 0x7c,
+			0xe6, 0xcd, 0x67, 0x60, 0xbd, 0x68, 0xdd, 0x74, 0xcd, 0x6e, 0xcd, 0x6e, 0x40, 0x7c, 0xcd, 0x67,
+			0xe8, 0xcd, 0x62, 0xe6, 0x84, 0xe8, 0xcd, 0x67, 0x60, 0x25, 0x8b, 0xbd, 0x68, 0x35, 0x91, 0xcd,
+			0x6e, 0x40, 0x35, 0x98, 0x7c, 0xe6, 0x35, 0x9d, 0xe8, 0x84, 0xe6, 0x35, 0x98, 0x60, 0x45, 0x97,
+			0x25, 0x8b, 0x35, 0x91, 0x35, 0x91, 0x40, 0x84, 0x35, 0x98, 0xe8, 0x35, 0x9d, 0xe6, 0x7c, 0xe2,
+			0x80, 0x45, 0x94, 0x60, 0x55, 0x88, 0x45, 0x94, 0x4d, 0x8e, 0x4d, 0x8e, 0x40, 0x45, 0x94, 0x80,
+			0x60, 0x45, 0x94, 0xad, 0x77, 0x4d, 0x8e, 0xb5, 0x71, 0x40, 0x80, 0xbd, 0x6b, 0x60, 0xad, 0x77,
+			0xbd, 0x6b, 0xb5, 0x71, 0xb5, 0x71, 0x40, 0xbd, 0x6b, 0x80, 0x60, 0xbd, 0x6b, 0x55, 0x88, 0xb5,
+			0x71, 0x4d, 0x8e, 0x40, 0x80, 0x45, 0x94, 0xe2, 0x80, 0x94, 0x60, 0xcd, 0x7b, 0x94, 0xe9, 0x78,
+			0x19, 0x87, 0x40, 0x6c, 0x80, 0x60, 0x6c, 0xcd, 0x7b, 0xe9, 0x78, 0xe9, 0x78, 0x40, 0x80, 0x6c,
+			0x60, 0x35, 0x84, 0x6c, 0x19, 0x87, 0xe9, 0x78, 0x40, 0x94, 0x80, 0x60, 0x94, 0x35, 0x84, 0x19,
+			0x87, 0x19, 0x87, 0x40, 0x80, 0x94, 0xe2, 0x80, 0x8c, 0x60, 0x89, 0x82, 0x8c, 0x45, 0x84, 0x45,
+			0x84, 0x40, 0x8c, 0x80, 0x60, 0x8c, 0x79, 0x7d, 0x45, 0x84, 0xbd, 0x7b, 0x40, 0x80, 0x74, 0x60,
+			0x79, 0x7d, 0x74, 0xbd, 0x7b, 0xbd, 0x7b, 0x40, 0x74, 0x80, 0x60, 0x74, 0x89, 0x82, 0xbd, 0x7b,
+			0x45, 0x84, 0x40, 0x80, 0x8c, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		MapsNavigation: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6c, 0xb0, 0x05, 0xad, 0x6a, 0xac, 0x80,
+			0x55, 0x65, 0x55, 0x95, 0xac, 0x55, 0x93, 0xb0, 0x80, 0x35, 0x8f, 0xad, 0x6c, 0xb0, 0xe2, 0x35,
+			0x71, 0x79, 0x91, 0x03, 0x80, 0xf1, 0x8a, 0xcd, 0x8e, 0x79, 0x91, 0x80, 0x35, 0x6f, 0x35, 0x71,
+			0x79, 0x91, 0xe2, 0x80, 0xf1, 0x8a, 0xe1,
+		}},
+		MapsNearMe: MapsNearMe,
+		MapsPersonPin: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x9d, 0x00, 0xf1, 0x77, 0x45, 0x95,
+			0xe6, 0x58, 0x60, 0x69, 0x6a, 0x45, 0x95, 0x35, 0x69, 0x11, 0x94, 0x40, 0x50, 0x45, 0x91, 0xe8,
+			0x45, 0x69, 0x60, 0x50, 0x9d, 0x67, 0x35, 0x69, 0x71, 0x66, 0x40, 0x58, 0x45, 0x65, 0xe6, 0xa8,
+			0x60, 0x99, 0x95, 0x45, 0x65, 0xcd, 0x96, 0x71, 0x66, 0x40, 0xb0, 0x45, 0x69, 0xe8, 0x45, 0x91,
+			0x60, 0xb0, 0xdd, 0x92, 0xcd, 0x96, 0x11, 0x94, 0x40, 0xa8, 0x45, 0x95, 0xe6, 0x11, 0x88, 0x00,
+			0x80, 0x55, 0x9d, 0xe2, 0x58, 0xbd, 0x8e, 0x63, 0x60, 0x11, 0x8b, 0x11, 0x75, 0xc5, 0x88, 0x21,
+			0x7a, 0x79, 0x86, 0x80, 0x79, 0x86, 0xdd, 0x85, 0x79, 0x86, 0xf1, 0x8a, 0xc5, 0x88, 0xa0, 0x11,
+			0x8b, 0xa8, 0xbd, 0x8e, 0xe8, 0x45, 0x69, 0xe6, 0x58, 0xe8, 0xbd, 0x8e, 0xe2, 0x25, 0x80, 0x35,
+			0x81, 0x60, 0x88, 0x35, 0x81, 0xad, 0x86, 0x89, 0x7e, 0x40, 0x55, 0x89, 0x70, 0x60, 0x55, 0x89,
+			0x25, 0x74, 0xad, 0x86, 0x79, 0x71, 0x40, 0x25, 0x80, 0xcd, 0x6e, 0x60, 0x45, 0x7c, 0xcd, 0x6e,
+			0x99, 0x79, 0x79, 0x71, 0x40, 0xf1, 0x76, 0x70, 0x60, 0xf1, 0x76, 0xdd, 0x7b, 0x99, 0x79, 0x89,
+			0x7e, 0x40, 0x25, 0x80, 0x35, 0x81, 0xe2, 0x25, 0x80, 0x35, 0x7d, 0x60, 0xf9, 0x7d, 0x35, 0x7d,
+			0x75, 0x7c, 0xb1, 0x7b, 0x40, 0xf1, 0x7a, 0x70, 0x60, 0xf1, 0x7a, 0xd5, 0x75, 0x75, 0x7c, 0x51,
+			0x74, 0x40, 0x25, 0x80, 0xcd, 0x72, 0x60, 0x4d, 0x82, 0xcd, 0x72, 0xd1, 0x83, 0x51, 0x74, 0x40,
+			0x55, 0x85, 0x70, 0x60, 0x55, 0x85, 0x2d, 0x7a, 0xd1, 0x83, 0xb1, 0x7b, 0x40, 0x25, 0x80, 0x35,
+			0x7d, 0xe2, 0x80, 0x69, 0x97, 0x00, 0x25, 0x86, 0x45, 0x91, 0xe6, 0x55, 0x90, 0xe8, 0xad, 0x90,
+			0x60, 0xbd, 0x8c, 0x99, 0x8d, 0xa1, 0x88, 0x09, 0x8c, 0x40, 0x80, 0x79, 0x8a, 0x60, 0x89, 0x7b,
+			0x79, 0x8a, 0x69, 0x77, 0x09, 0x8c, 0x40, 0xad, 0x6f, 0xad, 0x90, 0xe8, 0x45, 0x91, 0xe6, 0xdd,
+			0x79, 0x00, 0x80, 0x69, 0x97, 0xe2, 0x80, 0x78, 0xe1,
+		}},
+		MapsPersonPinCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x87, 0x62, 0x6d, 0x83, 0x55, 0x87,
+			0x31, 0x86, 0xbd, 0x85, 0xf1, 0x88, 0x25, 0x84, 0x99, 0x8a, 0x79, 0x81, 0x55, 0x88, 0xbd, 0x7f,
+			0xa9, 0x85, 0xdd, 0x7e, 0x40, 0xfd, 0x7f, 0x7c, 0x60, 0x7a, 0x7c, 0x55, 0x7a, 0xdd, 0x7e, 0x40,
+			0x69, 0x75, 0x79, 0x81, 0x61, 0x11, 0x77, 0x25, 0x84, 0xd1, 0x79, 0xbd, 0x85, 0x95, 0x7c, 0x55,
+			0x87, 0x80, 0x55, 0x87, 0xe2, 0x80, 0x74, 0x67, 0xf1, 0x81, 0x74, 0x4d, 0x83, 0xa1, 0x78, 0xad,
+			0x84, 0x41, 0x77, 0xad, 0x84, 0x55, 0x75, 0xad, 0x84, 0x69, 0x73, 0x4d, 0x83, 0x09, 0x72, 0xed,
+			0x81, 0xad, 0x70, 0x80, 0xad, 0x70, 0x11, 0x7e, 0xad, 0x70, 0xb5, 0x7c, 0x0d, 0x72, 0x55, 0x7b,
+			0x69, 0x73, 0x55, 0x7b, 0x59, 0x75, 0x55, 0x7b, 0x45, 0x77, 0xb5, 0x7c, 0xa5, 0x78, 0x15, 0x7e,
+			0x74, 0x80, 0x74, 0xe2, 0x80, 0x69, 0x95, 0x60, 0xdd, 0x88, 0x55, 0x8d, 0x19, 0x8d, 0xc5, 0x86,
+			0x40, 0x55, 0x91, 0x35, 0x7b, 0x61, 0x55, 0x91, 0x59, 0x73, 0x51, 0x8c, 0x59, 0x6e, 0x4d, 0x87,
+			0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xb1, 0x73, 0x59, 0x6e, 0x61, 0xad, 0x6e, 0x59, 0x73, 0xad,
+			0x6e, 0x35, 0x7b, 0xad, 0x6e, 0x35, 0x80, 0x66, 0xc5, 0x86, 0x40, 0x80, 0x69, 0x95, 0xe2, 0x80,
+			0xad, 0x9a, 0x60, 0x45, 0x75, 0x89, 0x91, 0xf9, 0x6f, 0xb5, 0x89, 0x40, 0xad, 0x6a, 0x35, 0x7b,
+			0x60, 0xad, 0x6a, 0x35, 0x71, 0x19, 0x71, 0x45, 0x6b, 0x40, 0x80, 0x55, 0x65, 0x60, 0x79, 0x88,
+			0x55, 0x65, 0xe9, 0x8e, 0x45, 0x6b, 0x40, 0x55, 0x95, 0x35, 0x7b, 0x60, 0x55, 0x95, 0xdd, 0x81,
+			0x09, 0x90, 0xb5, 0x89, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x7a, 0xe1,
+		}},
+		MapsPinDrop: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x25, 0x8c, 0x62, 0xf1, 0x86, 0x8d, 0x86,
+			0x79, 0x8a, 0x61, 0x81, 0x9c, 0x35, 0x7c, 0x9c, 0xbd, 0x77, 0x9c, 0xcd, 0x73, 0x91, 0x8c, 0x11,
+			0x71, 0x40, 0x09, 0x89, 0x9d, 0x6c, 0x64, 0xf1, 0x86, 0xe1, 0x6a, 0x7d, 0x84, 0x1d, 0x6a, 0x09,
+			0x82, 0x55, 0x69, 0x80, 0x55, 0x69, 0xf9, 0x7d, 0x55, 0x69, 0x85, 0x7b, 0x1d, 0x6a, 0x11, 0x79,
+			0xe1, 0x6a, 0xf9, 0x76, 0x9d, 0x6c, 0xdd, 0x74, 0x55, 0x6e, 0x71, 0x73, 0x11, 0x71, 0x40, 0x64,
+			0xbd, 0x77, 0x60, 0x64, 0x35, 0x7c, 0x89, 0x75, 0x61, 0x81, 0x40, 0x80, 0x25, 0x8c, 0xe2, 0x80,
+			0x35, 0x91, 0x62, 0xdd, 0x76, 0x55, 0x8a, 0x71, 0x72, 0x0d, 0x84, 0x5c, 0xc5, 0x7d, 0x5c, 0xc1,
+			0x77, 0x5c, 0x35, 0x73, 0xa5, 0x6f, 0xc5, 0x6f, 0x40, 0xdd, 0x73, 0x54, 0x61, 0x79, 0x76, 0xad,
+			0x67, 0xad, 0x79, 0x81, 0x66, 0xdd, 0x7c, 0x55, 0x65, 0x80, 0x55, 0x65, 0x40, 0x55, 0x86, 0x81,
+			0x66, 0x60, 0x89, 0x89, 0xad, 0x67, 0x25, 0x8c, 0x54, 0x40, 0x5d, 0x90, 0xc5, 0x6f, 0x62, 0xa4,
+			0x35, 0x73, 0xa4, 0xc5, 0x77, 0xa4, 0xcd, 0x7d, 0x91, 0x8d, 0x11, 0x84, 0x25, 0x89, 0x55, 0x8a,
+			0x80, 0x35, 0x91, 0xe2, 0x80, 0x78, 0x67, 0xf1, 0x81, 0x78, 0x4d, 0x83, 0xa1, 0x7a, 0xad, 0x84,
+			0x41, 0x79, 0xad, 0x84, 0x55, 0x77, 0xad, 0x84, 0x69, 0x75, 0x4d, 0x83, 0x09, 0x74, 0xed, 0x81,
+			0xad, 0x72, 0x80, 0xad, 0x72, 0x11, 0x7e, 0xad, 0x72, 0xb5, 0x7c, 0x0d, 0x74, 0x55, 0x7b, 0x69,
+			0x75, 0x55, 0x7b, 0x59, 0x77, 0x55, 0x7b, 0x45, 0x79, 0xb5, 0x7c, 0xa5, 0x7a, 0x15, 0x7e, 0x78,
+			0x80, 0x78, 0xe2, 0x5c, 0xad, 0x9a, 0xe8, 0xad, 0x96, 0xe6, 0xa4, 0xe8, 0xad, 0x9a, 0xe6, 0x5c,
+			0xe2, 0x80, 0xbd, 0x77, 0xe1,
+		}},
+		MapsPlace: MapsPlace,
+		MapsRateReview: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0x55, 0x85, 0xe6, 0x45, 0x75, 0x00, 0x11,
+			0x86, 0xbd, 0x74, 0x60, 0x79, 0x86, 0x55, 0x74, 0x79, 0x86, 0xbd, 0x73, 0x40, 0x11, 0x86, 0xbd,
+			0x72, 0x00, 0x69, 0x82, 0x69, 0x6f, 0x60, 0x11, 0x82, 0x11, 0x6f, 0x91, 0x81, 0x11, 0x6f, 0x40,
+			0xbd, 0x80, 0x69, 0x6f, 0x00, 0x60, 0x69, 0x80, 0xe8, 0x55, 0x85, 0xe2, 0x76, 0x55, 0x85, 0xe6,
+			0xa0, 0xe8, 0x55, 0x81, 0xe6, 0x7e, 0x00, 0x76, 0x55, 0x85, 0xe2, 0x55, 0x65, 0xad, 0x9a, 0xe8,
+			0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65,
+			0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55,
+			0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0,
+			0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55,
+			0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8,
+			0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		MapsRestaurant:     MapsRestaurant,
+		MapsRestaurantMenu: MapsRestaurantMenu,
+		MapsSatellite: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x6f, 0x55, 0x8d, 0xe6, 0x45, 0x90, 0x03,
+			0x79, 0x86, 0x45, 0x80, 0xad, 0x7d, 0xad, 0x8b, 0x79, 0x77, 0x35, 0x83, 0x99, 0x6f, 0x55, 0x8d,
+			0xe2, 0x60, 0xcd, 0x7e, 0x60, 0x35, 0x76, 0xcd, 0x7e, 0x81, 0x7a, 0x7d, 0x7a, 0x40, 0xcd, 0x7e,
+			0x60, 0xe6, 0x35, 0x7b, 0x60, 0x35, 0x7b, 0xad, 0x74, 0xf1, 0x77, 0xf1, 0x77, 0x40, 0x60, 0x35,
+			0x7b, 0xe8, 0xcd, 0x7e, 0xe2, 0x60, 0x11, 0x76, 0x61, 0x99, 0x72, 0x11, 0x76, 0x79, 0x74, 0x49,
+			0x74, 0x55, 0x76, 0x85, 0x72, 0x55, 0x76, 0x60, 0xe6, 0x60, 0xe8, 0x11, 0x76, 0xe2, 0x58, 0xb0,
+			0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69,
+			0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96,
+			0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40,
+			0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2,
+			0x58, 0xa8, 0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		MapsStoreMallDirectory: MapsStoreMallDirectory,
+		MapsStreetView: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xa0, 0x55, 0x7d, 0x60, 0x79, 0x8a, 0x55, 0x7d,
+			0x91, 0x86, 0x71, 0x79, 0x40, 0xad, 0x82, 0x60, 0x60, 0xad, 0x82, 0x79, 0x6a, 0x91, 0x86, 0x91,
+			0x66, 0x40, 0xa0, 0xad, 0x62, 0x60, 0x89, 0x95, 0xad, 0x62, 0x71, 0x99, 0x91, 0x66, 0x40, 0x55,
+			0x9d, 0x60, 0x60, 0x55, 0x9d, 0x89, 0x75, 0x71, 0x99, 0x71, 0x79, 0x40, 0xa0, 0x55, 0x7d, 0xe2,
+			0xcd, 0x69, 0x35, 0x96, 0x60, 0xf1, 0x68, 0x99, 0x95, 0x79, 0x68, 0xad, 0x94, 0x40, 0x50, 0xad,
+			0x92, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0x11, 0x6b, 0x89, 0x69, 0x89, 0x69, 0x40, 0x55, 0x6d, 0x50,
+			0xe6, 0x45, 0x7f, 0x60, 0x55, 0x7e, 0xad, 0x69, 0xd5, 0x7d, 0xad, 0x6b, 0x40, 0x55, 0x7d, 0x60,
+			0x60, 0x55, 0x7d, 0xbd, 0x73, 0xcd, 0x7e, 0x35, 0x77, 0x40, 0xcd, 0x82, 0x35, 0x7d, 0x00, 0xcd,
+			0x69, 0x35, 0x96, 0xe2, 0x80, 0xb0, 0xe8, 0x79, 0x89, 0x60, 0x80, 0x89, 0x86, 0xc5, 0x81, 0x4d,
+			0x84, 0x40, 0x69, 0x86, 0x89, 0x81, 0x60, 0x89, 0x88, 0x82, 0x96, 0xd5, 0x80, 0x40, 0xa0, 0xad,
+			0x80, 0x60, 0x55, 0x92, 0xad, 0x80, 0x4d, 0x94, 0xcd, 0x80, 0x40, 0xb0, 0x55, 0x81, 0xe8, 0xad,
+			0x92, 0x60, 0xb0, 0xf1, 0x94, 0x79, 0x96, 0x79, 0x96, 0x40, 0xad, 0x92, 0xb0, 0xe6, 0x80, 0xe1,
+		}},
+		MapsSubway: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x11, 0x77, 0x60,
+			0x55, 0x65, 0x99, 0x71, 0x2d, 0x68, 0x79, 0x6d, 0x40, 0x11, 0x70, 0x55, 0x67, 0x60, 0xcd, 0x73,
+			0xf1, 0x65, 0x70, 0xa5, 0x65, 0x40, 0x80, 0x55, 0x65, 0x60, 0xcd, 0x83, 0x55, 0x65, 0x09, 0x88,
+			0xa5, 0x65, 0x40, 0xa0, 0x55, 0x67, 0x60, 0x11, 0x95, 0x55, 0x69, 0xdd, 0x97, 0x79, 0x6d, 0x40,
+			0xad, 0x9a, 0x11, 0x77, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x65, 0xe2, 0x25, 0x77, 0xad, 0x96, 0xe6,
+			0xdd, 0x88, 0x00, 0x79, 0x83, 0x45, 0x91, 0xe6, 0x89, 0x7c, 0x00, 0x25, 0x77, 0xad, 0x96, 0xe2,
+			0x66, 0x79, 0x83, 0xe6, 0x9a, 0xe8, 0xcd, 0x77, 0xe6, 0x66, 0xe8, 0x79, 0x83, 0xe2, 0x55, 0x89,
+			0xf1, 0x8b, 0x63, 0x71, 0x8a, 0xf1, 0x8b, 0x31, 0x8b, 0x31, 0x8b, 0xf1, 0x8b, 0x71, 0x8a, 0xf1,
+			0x8b, 0x55, 0x89, 0xf1, 0x8b, 0x3d, 0x88, 0x31, 0x8b, 0x7d, 0x87, 0x71, 0x8a, 0xbd, 0x86, 0x55,
+			0x89, 0xbd, 0x86, 0x40, 0x7d, 0x87, 0x7d, 0x87, 0x62, 0xbd, 0x86, 0x3d, 0x88, 0xbd, 0x86, 0x55,
+			0x89, 0xbd, 0x86, 0x71, 0x8a, 0x7d, 0x87, 0x31, 0x8b, 0x3d, 0x88, 0xf1, 0x8b, 0x55, 0x89, 0xf1,
+			0x8b, 0xe2, 0xad, 0x76, 0xf1, 0x8b, 0x67, 0xc5, 0x77, 0xf1, 0x8b, 0x85, 0x78, 0x31, 0x8b, 0x45,
+			0x79, 0x71, 0x8a, 0x45, 0x79, 0x55, 0x89, 0x45, 0x79, 0x3d, 0x88, 0x85, 0x78, 0x7d, 0x87, 0xc5,
+			0x77, 0xbd, 0x86, 0xad, 0x76, 0xbd, 0x86, 0x91, 0x75, 0xbd, 0x86, 0xd1, 0x74, 0x7d, 0x87, 0x11,
+			0x74, 0x3d, 0x88, 0x11, 0x74, 0x55, 0x89, 0x11, 0x74, 0x71, 0x8a, 0xd1, 0x74, 0x31, 0x8b, 0x91,
+			0x75, 0xf1, 0x8b, 0xad, 0x76, 0xf1, 0x8b, 0xe2, 0x55, 0x69, 0xad, 0x96, 0xe6, 0xf1, 0x73, 0xe8,
+			0x55, 0x95, 0x00, 0x70, 0x45, 0x91, 0x60, 0x79, 0x75, 0x45, 0x91, 0xbd, 0x72, 0x4d, 0x8f, 0x40,
+			0x60, 0x55, 0x89, 0xe8, 0xcd, 0x77, 0x60, 0x60, 0x66, 0x79, 0x74, 0x81, 0x71, 0x40, 0x80, 0x60,
+			0x60, 0x8c, 0x60, 0x96, 0x81, 0x71, 0x40, 0xa0, 0xcd, 0x77, 0xe8, 0x55, 0x89, 0x60, 0xa0, 0x11,
+			0x8d, 0x91, 0x8d, 0x2d, 0x8f, 0x40, 0x90, 0x45, 0x91, 0x00, 0x11, 0x8c, 0x55, 0x95, 0xe8, 0xad,
+			0x96, 0xe6, 0xad, 0x96, 0xe8, 0x11, 0x77, 0x60, 0xad, 0x96, 0xad, 0x72, 0x91, 0x94, 0xc5, 0x6f,
+			0x40, 0x4d, 0x8e, 0x25, 0x6b, 0x60, 0x35, 0x8b, 0xdd, 0x69, 0x69, 0x87, 0x99, 0x69, 0x40, 0x05,
+			0x80, 0x55, 0x69, 0x60, 0x69, 0x7c, 0x55, 0x69, 0xa5, 0x78, 0x99, 0x69, 0x40, 0xc5, 0x71, 0x25,
+			0x6b, 0x60, 0x99, 0x6d, 0xdd, 0x6c, 0x79, 0x6b, 0xc5, 0x6f, 0x40, 0x55, 0x69, 0x11, 0x77, 0xe8,
+			0xad, 0x96, 0xe2, 0x55, 0x69, 0xad, 0x96, 0xe6, 0xad, 0x96, 0xe6, 0x55, 0x69, 0xe1,
+		}},
+		MapsTerrain: MapsTerrain,
+		MapsTraffic: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x69, 0x90, 0x60, 0x99, 0x81, 0x69, 0x90,
+			0xbd, 0x82, 0x45, 0x8f, 0x40, 0xdd, 0x83, 0x89, 0x8c, 0x60, 0xdd, 0x83, 0xf1, 0x8a, 0xbd, 0x82,
+			0xcd, 0x89, 0x40, 0x80, 0xad, 0x88, 0x60, 0x69, 0x7e, 0xad, 0x88, 0x45, 0x7d, 0xcd, 0x89, 0x40,
+			0x25, 0x7c, 0x89, 0x8c, 0x60, 0x25, 0x7c, 0x25, 0x8e, 0x45, 0x7d, 0x45, 0x8f, 0x40, 0x80, 0x69,
+			0x90, 0xe2, 0x80, 0xdd, 0x83, 0x60, 0x99, 0x81, 0xdd, 0x83, 0xbd, 0x82, 0xbd, 0x82, 0x40, 0xdd,
+			0x83, 0x80, 0x60, 0xdd, 0x83, 0x69, 0x7e, 0xbd, 0x82, 0x45, 0x7d, 0x40, 0x80, 0x25, 0x7c, 0x60,
+			0x69, 0x7e, 0x25, 0x7c, 0x45, 0x7d, 0x45, 0x7d, 0x40, 0x25, 0x7c, 0x80, 0x60, 0x25, 0x7c, 0x99,
+			0x81, 0x45, 0x7d, 0xbd, 0x82, 0x40, 0x80, 0xdd, 0x83, 0xe2, 0x80, 0x55, 0x77, 0x60, 0x99, 0x81,
+			0x55, 0x77, 0xbd, 0x82, 0x35, 0x76, 0x40, 0xdd, 0x83, 0x79, 0x73, 0x60, 0xdd, 0x83, 0xdd, 0x71,
+			0xbd, 0x82, 0xbd, 0x70, 0x40, 0x80, 0x99, 0x6f, 0x60, 0x69, 0x7e, 0x99, 0x6f, 0x45, 0x7d, 0xbd,
+			0x70, 0x40, 0x25, 0x7c, 0x79, 0x73, 0x60, 0x25, 0x7c, 0x11, 0x75, 0x45, 0x7d, 0x35, 0x76, 0x40,
+			0x80, 0x55, 0x77, 0xe2, 0x55, 0x72, 0xdd, 0x88, 0xe8, 0x89, 0x83, 0x60, 0xf1, 0x6e, 0xcd, 0x82,
+			0xcd, 0x6c, 0x5d, 0x80, 0x40, 0xad, 0x6a, 0x11, 0x7b, 0xe6, 0x55, 0x72, 0xe8, 0xf1, 0x75, 0x60,
+			0xf1, 0x6e, 0x35, 0x75, 0xcd, 0x6c, 0xcd, 0x72, 0x40, 0xad, 0x6a, 0x89, 0x6d, 0xe6, 0x55, 0x72,
+			0xe8, 0xf1, 0x6b, 0x60, 0x55, 0x72, 0x35, 0x6a, 0x79, 0x73, 0x19, 0x69, 0x40, 0x55, 0x76, 0x50,
+			0xe6, 0xad, 0x89, 0x60, 0x69, 0x8b, 0x50, 0x89, 0x8c, 0x19, 0x69, 0x40, 0xad, 0x8d, 0xf1, 0x6b,
+			0xe8, 0x89, 0x6d, 0xe6, 0x55, 0x95, 0x60, 0x55, 0x95, 0x69, 0x70, 0x35, 0x93, 0xcd, 0x72, 0x40,
+			0xad, 0x8d, 0xf1, 0x75, 0xe8, 0x11, 0x7b, 0xe6, 0x55, 0x95, 0x60, 0x55, 0x95, 0xf1, 0x7d, 0x35,
+			0x93, 0x5d, 0x80, 0x40, 0xad, 0x8d, 0x89, 0x83, 0xe8, 0xdd, 0x88, 0xe6, 0x55, 0x95, 0x60, 0x55,
+			0x95, 0xdd, 0x8b, 0x35, 0x93, 0x35, 0x8e, 0x40, 0xad, 0x8d, 0x45, 0x91, 0xe8, 0xa8, 0x60, 0xad,
+			0x8d, 0xbd, 0x95, 0x89, 0x8c, 0xdd, 0x96, 0x40, 0xad, 0x89, 0xb0, 0xe6, 0x55, 0x76, 0x60, 0x99,
+			0x74, 0xb0, 0x79, 0x73, 0xdd, 0x96, 0x40, 0x55, 0x72, 0xa8, 0xe8, 0x45, 0x91, 0x60, 0xf1, 0x6e,
+			0x89, 0x90, 0xcd, 0x6c, 0x35, 0x8e, 0x40, 0xad, 0x6a, 0xdd, 0x88, 0xe6, 0x55, 0x72, 0xe2, 0x55,
+			0x76, 0xa8, 0xe6, 0xad, 0x89, 0xe8, 0x58, 0xe6, 0x55, 0x76, 0xe8, 0xa8, 0xe2, 0x55, 0x76, 0xa8,
+			0xe8, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		MapsTrain:                  MapsTrain,
+		MapsTram:                   MapsTram,
+		MapsTransferWithinAStation: MapsTransferWithinAStation,
+		MapsZoomOutMap: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xb0, 0xe8, 0xad, 0x88, 0xe6, 0x58, 0xe8,
+			0x25, 0x91, 0x02, 0xdd, 0x75, 0x45, 0x87, 0xbd, 0x78, 0x25, 0x8a, 0xdd, 0x6e, 0xa8, 0xe6, 0x55,
+			0x77, 0xe8, 0xb0, 0xe6, 0x50, 0xe2, 0xad, 0x88, 0xb0, 0xe8, 0xa8, 0xe6, 0x25, 0x91, 0x02, 0x55,
+			0x87, 0x35, 0x8a, 0x35, 0x8a, 0x55, 0x87, 0xa8, 0x25, 0x91, 0xe8, 0xad, 0x88, 0xe6, 0xb0, 0xe8,
+			0xb0, 0xe6, 0xad, 0x88, 0xe2, 0xcd, 0x75, 0xad, 0x78, 0x00, 0x58, 0xdd, 0x6e, 0xe8, 0x55, 0x77,
+			0xe6, 0x50, 0xe8, 0x50, 0xe6, 0x55, 0x77, 0xe8, 0x58, 0xe6, 0xdd, 0x6e, 0x01, 0xad, 0x78, 0xcd,
+			0x75, 0xcd, 0x75, 0xad, 0x78, 0xe2, 0x35, 0x8a, 0xad, 0x78, 0x01, 0x55, 0x87, 0xcd, 0x75, 0x25,
+			0x91, 0x58, 0xe6, 0xad, 0x88, 0xe8, 0x50, 0xe6, 0xb0, 0xe8, 0x55, 0x77, 0xe6, 0xa8, 0xe8, 0xdd,
+			0x6e, 0x00, 0x35, 0x8a, 0xad, 0x78, 0xe1,
+		}},
+		NavigationApps: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x6f, 0x55, 0x95, 0x60, 0x35, 0x6d, 0x55,
+			0x95, 0xf1, 0x6b, 0x11, 0x94, 0x40, 0xad, 0x6a, 0xf1, 0x90, 0x60, 0xad, 0x6a, 0x11, 0x8f, 0xf1,
+			0x6b, 0xcd, 0x8d, 0x40, 0x11, 0x6f, 0x89, 0x8c, 0x60, 0xf1, 0x70, 0x89, 0x8c, 0x35, 0x72, 0xcd,
+			0x8d, 0x40, 0x79, 0x73, 0xf1, 0x90, 0x60, 0x79, 0x73, 0xcd, 0x92, 0x35, 0x72, 0x11, 0x94, 0x40,
+			0x11, 0x6f, 0x55, 0x95, 0xe2, 0x80, 0x55, 0x95, 0x60, 0x25, 0x7e, 0x55, 0x95, 0xdd, 0x7c, 0x11,
+			0x94, 0x40, 0x99, 0x7b, 0xf1, 0x90, 0x60, 0x99, 0x7b, 0x11, 0x8f, 0xdd, 0x7c, 0xcd, 0x8d, 0x40,
+			0x80, 0x89, 0x8c, 0x60, 0xdd, 0x81, 0x89, 0x8c, 0x25, 0x83, 0xcd, 0x8d, 0x40, 0x69, 0x84, 0xf1,
+			0x90, 0x60, 0x69, 0x84, 0xcd, 0x92, 0x25, 0x83, 0x11, 0x94, 0x40, 0x80, 0x55, 0x95, 0xe2, 0xf1,
+			0x90, 0x55, 0x95, 0x60, 0x11, 0x8f, 0x55, 0x95, 0xcd, 0x8d, 0x11, 0x94, 0x40, 0x89, 0x8c, 0xf1,
+			0x90, 0x60, 0x89, 0x8c, 0x11, 0x8f, 0xcd, 0x8d, 0xcd, 0x8d, 0x40, 0xf1, 0x90, 0x89, 0x8c, 0x60,
+			0xcd, 0x92, 0x89, 0x8c, 0x11, 0x94, 0xcd, 0x8d, 0x40, 0x55, 0x95, 0xf1, 0x90, 0x60, 0x55, 0x95,
+			0xcd, 0x92, 0x11, 0x94, 0x11, 0x94, 0x40, 0xf1, 0x90, 0x55, 0x95, 0xe2, 0x11, 0x6f, 0x69, 0x84,
+			0x60, 0x35, 0x6d, 0x69, 0x84, 0xf1, 0x6b, 0x25, 0x83, 0x40, 0xad, 0x6a, 0x80, 0x60, 0xad, 0x6a,
+			0x25, 0x7e, 0xf1, 0x6b, 0xdd, 0x7c, 0x40, 0x11, 0x6f, 0x99, 0x7b, 0x60, 0xf1, 0x70, 0x99, 0x7b,
+			0x35, 0x72, 0xdd, 0x7c, 0x40, 0x79, 0x73, 0x80, 0x60, 0x79, 0x73, 0xdd, 0x81, 0x35, 0x72, 0x25,
+			0x83, 0x40, 0x11, 0x6f, 0x69, 0x84, 0xe2, 0x80, 0x69, 0x84, 0x60, 0x25, 0x7e, 0x69, 0x84, 0xdd,
+			0x7c, 0x25, 0x83, 0x40, 0x99, 0x7b, 0x80, 0x60, 0x99, 0x7b, 0x25, 0x7e, 0xdd, 0x7c, 0xdd, 0x7c,
+			0x40, 0x80, 0x99, 0x7b, 0x60, 0xdd, 0x81, 0x99, 0x7b, 0x25, 0x83, 0xdd, 0x7c, 0x40, 0x69, 0x84,
+			0x80, 0x60, 0x69, 0x84, 0xdd, 0x81, 0x25, 0x83, 0x25, 0x83, 0x40, 0x80, 0x69, 0x84, 0xe2, 0xf1,
+			0x90, 0x69, 0x84, 0x60, 0x11, 0x8f, 0x69, 0x84, 0xcd, 0x8d, 0x25, 0x83, 0x40, 0x89, 0x8c, 0x80,
+			0x60, 0x89, 0x8c, 0x25, 0x7e, 0xcd, 0x8d, 0xdd, 0x7c, 0x40, 0xf1, 0x90, 0x99, 0x7b, 0x60, 0xcd,
+			0x92, 0x99, 0x7b, 0x11, 0x94, 0xdd, 0x7c, 0x40, 0x55, 0x95, 0x80, 0x60, 0x55, 0x95, 0xdd, 0x81,
+			0x11, 0x94, 0x25, 0x83, 0x40, 0xf1, 0x90, 0x69, 0x84, 0xe2, 0x11, 0x6f, 0x79, 0x73, 0x60, 0x35,
+			0x6d, 0x79, 0x73, 0xf1, 0x6b, 0x35, 0x72, 0x40, 0xad, 0x6a, 0x11, 0x6f, 0x60, 0xad, 0x6a, 0x35,
+			0x6d, 0xf1, 0x6b, 0xf1, 0x6b, 0x40, 0x11, 0x6f, 0xad, 0x6a, 0x60, 0xf1, 0x70, 0xad, 0x6a, 0x35,
+			0x72, 0xf1, 0x6b, 0x40, 0x79, 0x73, 0x11, 0x6f, 0x60, 0x79, 0x73, 0xf1, 0x70, 0x35, 0x72, 0x35,
+			0x72, 0x40, 0x11, 0x6f, 0x79, 0x73, 0xe2, 0x80, 0x79, 0x73, 0x60, 0x25, 0x7e, 0x79, 0x73, 0xdd,
+			0x7c, 0x35, 0x72, 0x40, 0x99, 0x7b, 0x11, 0x6f, 0x60, 0x99, 0x7b, 0x35, 0x6d, 0xdd, 0x7c, 0xf1,
+			0x6b, 0x40, 0x80, 0xad, 0x6a, 0x60, 0xdd, 0x81, 0xad, 0x6a, 0x25, 0x83, 0xf1, 0x6b, 0x40, 0x69,
+			0x84, 0x11, 0x6f, 0x60, 0x69, 0x84, 0xf1, 0x70, 0x25, 0x83, 0x35, 0x72, 0x40, 0x80, 0x79, 0x73,
+			0xe2, 0xf1, 0x90, 0x79, 0x73, 0x60, 0x11, 0x8f, 0x79, 0x73, 0xcd, 0x8d, 0x35, 0x72, 0x40, 0x89,
+			0x8c, 0x11, 0x6f, 0x60, 0x89, 0x8c, 0x35, 0x6d, 0xcd, 0x8d, 0xf1, 0x6b, 0x40, 0xf1, 0x90, 0xad,
+			0x6a, 0x60, 0xcd, 0x92, 0xad, 0x6a, 0x11, 0x94, 0xf1, 0x6b, 0x40, 0x55, 0x95, 0x11, 0x6f, 0x60,
+			0x55, 0x95, 0xf1, 0x70, 0x11, 0x94, 0x35, 0x72, 0x40, 0xf1, 0x90, 0x79, 0x73, 0xe1,
+		}},
+		NavigationArrowBack: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x72, 0x84, 0x05, 0xcd, 0x82, 0x89, 0x92,
+			0x80, 0x55, 0x95, 0xad, 0x6a, 0x80, 0x80, 0xad, 0x6a, 0xcd, 0x82, 0x79, 0x6d, 0x45, 0x72, 0x7c,
+			0xe6, 0x55, 0x95, 0xe8, 0x84, 0xe6, 0x45, 0x72, 0xe1,
+		}, mirror: true},
+		NavigationArrowDownward: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xad, 0x6a, 0xe8, 0xbd, 0x8d, 0x05, 0x79,
+			0x6d, 0x35, 0x7d, 0xad, 0x6a, 0x80, 0x80, 0x55, 0x95, 0x55, 0x95, 0x80, 0x89, 0x92, 0x35, 0x7d,
+			0x84, 0xbd, 0x8d, 0xe8, 0xad, 0x6a, 0xe6, 0x7c, 0xe1,
+		}},
+		NavigationArrowDropDown: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x90, 0x00, 0xad, 0x72, 0xbd, 0x7a, 0xe6,
+			0x55, 0x8d, 0x00, 0x80, 0x90, 0xe1,
+		}},
+		NavigationArrowDropDownCircle: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x99, 0x87, 0x00, 0xbd, 0x89, 0xcd, 0x7d,
+			0xe6, 0x35, 0x76, 0x00, 0x80, 0x99, 0x87, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a,
+			0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67,
+			0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60,
+			0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79,
+			0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96,
+			0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1,
+			0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55,
+			0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2,
+			0x80, 0x80, 0xe1,
+		}},
+		NavigationArrowDropUp: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0x55, 0x85, 0x01, 0x80, 0xf1, 0x77,
+			0x55, 0x8d, 0x55, 0x85, 0xe6, 0xad, 0x72, 0xe1,
+		}},
+		NavigationArrowForward: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x8d, 0x84, 0xe6, 0xad, 0x6a, 0xe8, 0x7c,
+			0xe6, 0xbd, 0x8d, 0x05, 0x35, 0x7d, 0x79, 0x6d, 0x80, 0xad, 0x6a, 0x55, 0x95, 0x80, 0x80, 0x55,
+			0x95, 0x35, 0x7d, 0x89, 0x92, 0xbd, 0x8d, 0x84, 0xe1,
+		}, mirror: true},
+		NavigationArrowUpward: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0x55, 0x95, 0xe8, 0x45, 0x72, 0x05, 0x79,
+			0x6d, 0xcd, 0x82, 0xad, 0x6a, 0x80, 0x80, 0xad, 0x6a, 0x55, 0x95, 0x80, 0x89, 0x92, 0xcd, 0x82,
+			0x84, 0x45, 0x72, 0xe8, 0x55, 0x95, 0xe6, 0x7c, 0xe1,
+		}},
+		NavigationCancel: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x6c, 0xcd, 0x8c, 0x0b, 0x80, 0xcd, 0x82, 0x94,
+			0xcd, 0x8c, 0xcd, 0x8c, 0x94, 0xcd, 0x82, 0x80, 0xcd, 0x8c, 0x6c, 0x94, 0x35, 0x73, 0x80, 0x35,
+			0x7d, 0x6c, 0x35, 0x73, 0x35, 0x73, 0x6c, 0x35, 0x7d, 0x80, 0x35, 0x73, 0x94, 0x6c, 0xcd, 0x8c,
+			0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d,
+			0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60,
+			0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70,
+			0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69,
+			0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99,
+			0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd,
+			0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a,
+			0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96,
+			0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99,
+			0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89,
+			0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		NavigationCheck: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x79, 0x99, 0x8f, 0x05, 0x45, 0x6a, 0xad,
+			0x80, 0x25, 0x6d, 0xcd, 0x7d, 0x35, 0x79, 0xdd, 0x89, 0xcd, 0x92, 0x45, 0x70, 0xad, 0x95, 0x25,
+			0x73, 0x35, 0x79, 0x99, 0x8f, 0xe1,
+		}},
+		NavigationChevronLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x85, 0xa0, 0x05, 0x55, 0x75, 0xf1, 0x7f,
+			0x69, 0x85, 0xdd, 0x6f, 0x45, 0x88, 0xbd, 0x72, 0x11, 0x7b, 0xf1, 0x7f, 0x45, 0x88, 0x25, 0x8d,
+			0x69, 0x85, 0xa0, 0xe1,
+		}, mirror: true},
+		NavigationChevronRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x83, 0xf1, 0x7f, 0x05, 0x25, 0x76, 0xbd,
+			0x72, 0x72, 0xdd, 0x6f, 0x11, 0x89, 0xf1, 0x7f, 0x72, 0xa0, 0x25, 0x76, 0x25, 0x8d, 0x55, 0x83,
+			0xf1, 0x7f, 0xe1,
+		}, mirror: true},
+		NavigationClose: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x70, 0x35, 0x92, 0x0b, 0xcd, 0x6d, 0x69,
+			0x8f, 0x35, 0x7d, 0x80, 0xcd, 0x6d, 0x99, 0x70, 0x99, 0x70, 0xcd, 0x6d, 0x80, 0x35, 0x7d, 0x69,
+			0x8f, 0xcd, 0x6d, 0x35, 0x92, 0x99, 0x70, 0xcd, 0x82, 0x80, 0x35, 0x92, 0x69, 0x8f, 0x69, 0x8f,
+			0x35, 0x92, 0x80, 0xcd, 0x82, 0x99, 0x70, 0x35, 0x92, 0xe1,
+		}},
+		NavigationExpandLess: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x72, 0x92, 0x05, 0x60, 0x25, 0x86, 0x80,
+			0x25, 0x76, 0xa0, 0x11, 0x86, 0x25, 0x8d, 0xf1, 0x88, 0x80, 0xcd, 0x7b, 0xdd, 0x72, 0x92, 0xe1,
+		}},
+		NavigationExpandMore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x92, 0x05, 0x60, 0x72, 0xdd, 0x72, 0x25,
+			0x76, 0x80, 0x55, 0x83, 0x25, 0x8d, 0x35, 0x76, 0xa0, 0x11, 0x79, 0x80, 0x92, 0xe1,
+		}},
+		NavigationFirstPage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x60, 0xa0, 0xe8, 0x60, 0xe6, 0x68, 0xe8, 0xa0,
+			0xe6, 0x60, 0xe2, 0xcd, 0x8d, 0xcd, 0x8f, 0x05, 0x35, 0x7e, 0x35, 0x80, 0xcd, 0x8d, 0x99, 0x70,
+			0xad, 0x90, 0x79, 0x73, 0xf1, 0x83, 0x35, 0x80, 0xad, 0x90, 0xf1, 0x8c, 0xcd, 0x8d, 0xcd, 0x8f,
+			0xe1,
+		}, mirror: true},
+		NavigationFullscreen: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0xad, 0x92, 0xe8, 0xcd, 0x85, 0xe6,
+			0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe6, 0x35, 0x7a, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x6d, 0xe2, 0x55,
+			0x6d, 0x35, 0x7a, 0xe8, 0x55, 0x6d, 0xe6, 0x35, 0x7a, 0xe8, 0x55, 0x71, 0xe6, 0x55, 0x71, 0xe8,
+			0x35, 0x7a, 0xe6, 0x55, 0x6d, 0xe2, 0xcd, 0x85, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6, 0xad, 0x8e,
+			0xe8, 0xcd, 0x85, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x92, 0xe6, 0xcd, 0x85, 0xe2, 0xad, 0x8e, 0x35,
+			0x7a, 0xe8, 0x55, 0x71, 0xe6, 0xcd, 0x85, 0xe8, 0x55, 0x6d, 0xe6, 0xad, 0x92, 0xe8, 0x35, 0x7a,
+			0xe6, 0xad, 0x8e, 0xe1,
+		}},
+		NavigationFullscreenExit: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x76, 0xad, 0x92, 0xe8, 0xcd, 0x89, 0xe6,
+			0x55, 0x6d, 0xe8, 0xcd, 0x85, 0xe6, 0x35, 0x7a, 0xe8, 0xad, 0x92, 0xe6, 0x35, 0x76, 0xe2, 0xcd,
+			0x85, 0xad, 0x92, 0xe8, 0xcd, 0x85, 0xe6, 0xad, 0x92, 0xe8, 0xcd, 0x89, 0xe6, 0xcd, 0x89, 0xe8,
+			0xad, 0x92, 0xe6, 0xcd, 0x85, 0xe2, 0x55, 0x6d, 0x35, 0x7a, 0xe8, 0x35, 0x76, 0xe6, 0x35, 0x76,
+			0xe8, 0x55, 0x6d, 0xe6, 0x35, 0x7a, 0xe8, 0x35, 0x7a, 0xe6, 0x55, 0x6d, 0xe2, 0xcd, 0x85, 0x35,
+			0x7a, 0xe8, 0x55, 0x6d, 0xe6, 0xcd, 0x89, 0xe8, 0x35, 0x76, 0xe6, 0xad, 0x92, 0xe8, 0x35, 0x7a,
+			0xe6, 0xcd, 0x85, 0xe1,
+		}},
+		NavigationLastPage: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x72, 0xad, 0x8f, 0x05, 0x45, 0x6f, 0xcd,
+			0x8c, 0x11, 0x7c, 0x80, 0x45, 0x6f, 0x35, 0x73, 0x25, 0x72, 0x55, 0x70, 0xcd, 0x81, 0x80, 0x25,
+			0x72, 0xad, 0x8f, 0xe2, 0x98, 0xa0, 0xe8, 0x60, 0xe6, 0xa0, 0xe8, 0xa0, 0xe6, 0x98, 0xe1,
+		}, mirror: true},
+		NavigationMenu: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0xa0, 0xe8, 0x98, 0xe6, 0xb0, 0xe8, 0xa0,
+			0xe6, 0x50, 0xe2, 0x50, 0x84, 0xe8, 0x7c, 0xe6, 0xb0, 0xe8, 0x84, 0xe6, 0x50, 0xe2, 0x50, 0x68,
+			0xe8, 0x60, 0xe6, 0xb0, 0xe8, 0x68, 0xe6, 0x50, 0xe1,
+		}},
+		NavigationMoreHoriz: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x6d, 0x35, 0x83, 0x67, 0x89, 0x6c, 0x35,
+			0x83, 0x99, 0x6b, 0x41, 0x82, 0xad, 0x6a, 0x51, 0x81, 0xad, 0x6a, 0xfd, 0x7f, 0xad, 0x6a, 0xad,
+			0x7e, 0x9d, 0x6b, 0xbd, 0x7d, 0x8d, 0x6c, 0xcd, 0x7c, 0xe1, 0x6d, 0xcd, 0x7c, 0x35, 0x6f, 0xcd,
+			0x7c, 0x25, 0x70, 0xc1, 0x7d, 0x11, 0x71, 0xb1, 0x7e, 0x11, 0x71, 0x05, 0x80, 0x11, 0x71, 0x55,
+			0x81, 0x21, 0x70, 0x45, 0x82, 0x31, 0x6f, 0x35, 0x83, 0xdd, 0x6d, 0x35, 0x83, 0xe2, 0xfd, 0x7f,
+			0x35, 0x83, 0x67, 0xad, 0x7e, 0x35, 0x83, 0xbd, 0x7d, 0x41, 0x82, 0xcd, 0x7c, 0x51, 0x81, 0xcd,
+			0x7c, 0xfd, 0x7f, 0xcd, 0x7c, 0xad, 0x7e, 0xc1, 0x7d, 0xbd, 0x7d, 0xb1, 0x7e, 0xcd, 0x7c, 0x05,
+			0x80, 0xcd, 0x7c, 0x55, 0x81, 0xcd, 0x7c, 0x45, 0x82, 0xc1, 0x7d, 0x35, 0x83, 0xb1, 0x7e, 0x35,
+			0x83, 0x05, 0x80, 0x35, 0x83, 0x55, 0x81, 0x41, 0x82, 0x45, 0x82, 0x51, 0x81, 0x35, 0x83, 0xfd,
+			0x7f, 0x35, 0x83, 0xe2, 0x21, 0x92, 0x35, 0x83, 0x67, 0xcd, 0x90, 0x35, 0x83, 0xdd, 0x8f, 0x41,
+			0x82, 0xf1, 0x8e, 0x51, 0x81, 0xf1, 0x8e, 0xfd, 0x7f, 0xf1, 0x8e, 0xad, 0x7e, 0xe1, 0x8f, 0xbd,
+			0x7d, 0xd1, 0x90, 0xcd, 0x7c, 0x25, 0x92, 0xcd, 0x7c, 0x79, 0x93, 0xcd, 0x7c, 0x69, 0x94, 0xc1,
+			0x7d, 0x55, 0x95, 0xb1, 0x7e, 0x55, 0x95, 0x05, 0x80, 0x55, 0x95, 0x55, 0x81, 0x65, 0x94, 0x45,
+			0x82, 0x75, 0x93, 0x35, 0x83, 0x21, 0x92, 0x35, 0x83, 0xe1,
+		}},
+		NavigationMoreVert: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xfd, 0x7f, 0x55, 0x95, 0x67, 0xad, 0x7e, 0x55,
+			0x95, 0xbd, 0x7d, 0x65, 0x94, 0xcd, 0x7c, 0x75, 0x93, 0xcd, 0x7c, 0x21, 0x92, 0xcd, 0x7c, 0xcd,
+			0x90, 0xc1, 0x7d, 0xdd, 0x8f, 0xb1, 0x7e, 0xf1, 0x8e, 0x05, 0x80, 0xf1, 0x8e, 0x55, 0x81, 0xf1,
+			0x8e, 0x45, 0x82, 0xe1, 0x8f, 0x35, 0x83, 0xd1, 0x90, 0x35, 0x83, 0x25, 0x92, 0x35, 0x83, 0x79,
+			0x93, 0x41, 0x82, 0x69, 0x94, 0x51, 0x81, 0x55, 0x95, 0xfd, 0x7f, 0x55, 0x95, 0xe2, 0xfd, 0x7f,
+			0x35, 0x83, 0x67, 0xad, 0x7e, 0x35, 0x83, 0xbd, 0x7d, 0x41, 0x82, 0xcd, 0x7c, 0x51, 0x81, 0xcd,
+			0x7c, 0xfd, 0x7f, 0xcd, 0x7c, 0xad, 0x7e, 0xc1, 0x7d, 0xbd, 0x7d, 0xb1, 0x7e, 0xcd, 0x7c, 0x05,
+			0x80, 0xcd, 0x7c, 0x55, 0x81, 0xcd, 0x7c, 0x45, 0x82, 0xc1, 0x7d, 0x35, 0x83, 0xb1, 0x7e, 0x35,
+			0x83, 0x05, 0x80, 0x35, 0x83, 0x55, 0x81, 0x41, 0x82, 0x45, 0x82, 0x51, 0x81, 0x35, 0x83, 0xfd,
+			0x7f, 0x35, 0x83, 0xe2, 0xfd, 0x7f, 0x11, 0x71, 0x67, 0xad, 0x7e, 0x11, 0x71, 0xbd, 0x7d, 0x21,
+			0x70, 0xcd, 0x7c, 0x31, 0x6f, 0xcd, 0x7c, 0xdd, 0x6d, 0xcd, 0x7c, 0x89, 0x6c, 0xc1, 0x7d, 0x99,
+			0x6b, 0xb1, 0x7e, 0xad, 0x6a, 0x05, 0x80, 0xad, 0x6a, 0x55, 0x81, 0xad, 0x6a, 0x45, 0x82, 0x9d,
+			0x6b, 0x35, 0x83, 0x8d, 0x6c, 0x35, 0x83, 0xe1, 0x6d, 0x35, 0x83, 0x35, 0x6f, 0x41, 0x82, 0x25,
+			0x70, 0x51, 0x81, 0x11, 0x71, 0xfd, 0x7f, 0x11, 0x71, 0xe1,
+		}},
+		NavigationRefresh: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x55, 0x95, 0x60, 0x25, 0x77, 0x55, 0x95,
+			0xe9, 0x70, 0x19, 0x8f, 0x40, 0xad, 0x6a, 0x80, 0x60, 0xad, 0x6a, 0x25, 0x77, 0xe9, 0x70, 0xe9,
+			0x70, 0x40, 0x80, 0xad, 0x6a, 0x60, 0xad, 0x85, 0xad, 0x6a, 0xf1, 0x89, 0xf9, 0x6c, 0x40, 0x55,
+			0x91, 0x45, 0x73, 0xe8, 0xad, 0x6a, 0xe6, 0x55, 0x95, 0xe8, 0x99, 0x7b, 0xe6, 0x69, 0x84, 0xe8,
+			0x99, 0x77, 0xe6, 0x99, 0x8f, 0x60, 0x11, 0x8d, 0x99, 0x73, 0x25, 0x89, 0x25, 0x71, 0x40, 0x80,
+			0xad, 0x6e, 0x60, 0xbd, 0x78, 0xad, 0x6e, 0xb5, 0x73, 0xb5, 0x73, 0x40, 0xad, 0x6e, 0x80, 0x60,
+			0xad, 0x6e, 0x45, 0x87, 0xb5, 0x73, 0x4d, 0x8c, 0x40, 0x80, 0x55, 0x91, 0x60, 0x89, 0x85, 0x55,
+			0x91, 0x25, 0x8a, 0x2d, 0x8e, 0x40, 0x89, 0x90, 0xcd, 0x85, 0xe6, 0xad, 0x94, 0x60, 0xbd, 0x92,
+			0xcd, 0x8c, 0x9a, 0x11, 0x91, 0x40, 0x80, 0x55, 0x95, 0xe1,
+		}},
+		NavigationSubdirectoryArrowLeft: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x45, 0x7a, 0xb0, 0x03, 0xad, 0x6a, 0x35, 0x88,
+			0x35, 0x7a, 0xad, 0x78, 0x11, 0x7d, 0x89, 0x7b, 0x45, 0x72, 0x55, 0x86, 0xe6, 0xad, 0x8e, 0xe8,
+			0xad, 0x6a, 0xe6, 0xad, 0x92, 0xe8, 0x55, 0x8a, 0xe6, 0x55, 0x72, 0x01, 0x25, 0x7d, 0x25, 0x95,
+			0x45, 0x7a, 0xb0, 0xe1,
+		}, mirror: true},
+		NavigationSubdirectoryArrowRight: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x85, 0xb0, 0x01, 0xdd, 0x82, 0x25, 0x95,
+			0xad, 0x8d, 0x55, 0x8a, 0xe6, 0x55, 0x6d, 0xe8, 0xad, 0x6a, 0xe6, 0x55, 0x71, 0xe8, 0x55, 0x86,
+			0xe6, 0xbd, 0x8d, 0x03, 0xf1, 0x82, 0x89, 0x7b, 0xcd, 0x85, 0xad, 0x78, 0x55, 0x95, 0x35, 0x88,
+			0xbd, 0x85, 0xb0, 0xe1,
+		}, mirror: true},
+		NavigationUnfoldLess: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x76, 0x55, 0x95, 0x05, 0x68, 0x79, 0x92,
+			0x80, 0x79, 0x86, 0x98, 0x79, 0x92, 0x25, 0x89, 0x55, 0x95, 0x80, 0x35, 0x8c, 0xdd, 0x76, 0x55,
+			0x95, 0xe2, 0x80, 0x89, 0x79, 0x05, 0x68, 0x89, 0x6d, 0xdd, 0x76, 0xad, 0x6a, 0x80, 0xcd, 0x73,
+			0x25, 0x89, 0xad, 0x6a, 0x98, 0x89, 0x6d, 0x80, 0x89, 0x79, 0xe1,
+		}},
+		NavigationUnfoldMore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x05, 0x68, 0x98, 0xf1, 0x76, 0x11,
+			0x89, 0x80, 0x25, 0x92, 0x11, 0x89, 0x11, 0x89, 0x98, 0x98, 0x80, 0xb0, 0xe2, 0xf1, 0x76, 0x35,
+			0x77, 0x05, 0x68, 0x45, 0x74, 0x80, 0x45, 0x68, 0x98, 0x45, 0x74, 0x11, 0x89, 0x35, 0x77, 0x80,
+			0x25, 0x6e, 0xf1, 0x76, 0x35, 0x77, 0xe1,
+		}},
+		NotificationADB: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x6d, 0x45, 0x7d, 0xe8, 0x99, 0x7a, 0x60,
+			0x55, 0x6d, 0xcd, 0x75, 0x89, 0x6f, 0xdd, 0x71, 0x40, 0x55, 0x75, 0x69, 0x6b, 0x02, 0x55, 0x70,
+			0x69, 0x66, 0xbd, 0x72, 0x48, 0x5d, 0x78, 0x99, 0x69, 0x61, 0x19, 0x7a, 0xcd, 0x68, 0x0d, 0x7c,
+			0x5d, 0x68, 0x7c, 0xf1, 0x67, 0x80, 0xf1, 0x67, 0x40, 0xf9, 0x83, 0x5d, 0x68, 0x60, 0xf1, 0x85,
+			0xcd, 0x68, 0xad, 0x87, 0x99, 0x69, 0x02, 0x45, 0x8d, 0x48, 0xad, 0x8f, 0x69, 0x66, 0xad, 0x8a,
+			0x69, 0x6b, 0x61, 0x45, 0x8e, 0xf1, 0x6d, 0x79, 0x90, 0xe1, 0x71, 0xad, 0x92, 0xd5, 0x75, 0xad,
+			0x92, 0x9d, 0x7a, 0xe8, 0x45, 0x7d, 0xe6, 0x55, 0x6d, 0xe2, 0x90, 0xf1, 0x77, 0x60, 0x25, 0x89,
+			0xf1, 0x77, 0xe9, 0x89, 0x2d, 0x77, 0x40, 0xad, 0x8a, 0x45, 0x75, 0x60, 0xad, 0x8a, 0x25, 0x74,
+			0xe9, 0x89, 0x5d, 0x73, 0x40, 0x90, 0x99, 0x72, 0x60, 0xdd, 0x86, 0x99, 0x72, 0x19, 0x86, 0x5d,
+			0x73, 0x40, 0x55, 0x85, 0x45, 0x75, 0x60, 0x55, 0x85, 0x69, 0x76, 0x19, 0x86, 0x2d, 0x77, 0x40,
+			0x90, 0xf1, 0x77, 0xe2, 0x70, 0xf1, 0x77, 0x60, 0x25, 0x79, 0xf1, 0x77, 0xe9, 0x79, 0x2d, 0x77,
+			0x40, 0xad, 0x7a, 0x45, 0x75, 0x60, 0xad, 0x7a, 0x25, 0x74, 0xe9, 0x79, 0x5d, 0x73, 0x40, 0x70,
+			0x99, 0x72, 0x60, 0xdd, 0x76, 0x99, 0x72, 0x19, 0x76, 0x5d, 0x73, 0x40, 0x55, 0x75, 0x45, 0x75,
+			0x60, 0x55, 0x75, 0x69, 0x76, 0x19, 0x76, 0x2d, 0x77, 0x40, 0x70, 0xf1, 0x77, 0xe2, 0x80, 0x55,
+			0x9d, 0x60, 0x35, 0x78, 0x55, 0x9d, 0xc5, 0x72, 0xe9, 0x97, 0x40, 0x55, 0x6d, 0xad, 0x8a, 0xe8,
+			0xf1, 0x7f, 0xe6, 0xad, 0x92, 0xe8, 0xad, 0x8a, 0x60, 0xad, 0x92, 0x79, 0x92, 0x3d, 0x8d, 0xe9,
+			0x97, 0x40, 0x80, 0x55, 0x9d, 0xe1,
+		}},
+		NotificationAirlineSeatFlat:            NotificationAirlineSeatFlat,
+		NotificationAirlineSeatFlatAngled:      NotificationAirlineSeatFlatAngled,
+		NotificationAirlineSeatIndividualSuite: NotificationAirlineSeatIndividualSuite,
+		NotificationAirlineSeatLegroomExtra:    NotificationAirlineSeatLegroomExtra,
+		NotificationAirlineSeatLegroomNormal:   NotificationAirlineSeatLegroomNormal,
+		NotificationAirlineSeatLegroomReduced:  NotificationAirlineSeatLegroomReduced,
+		NotificationAirlineSeatReclineExtra:    NotificationAirlineSeatReclineExtra,
+		NotificationAirlineSeatReclineNormal:   NotificationAirlineSeatReclineNormal,
+		NotificationBluetoothAudio:             NotificationBluetoothAudio,
+		NotificationConfirmationNumber: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x25, 0x8d, 0x60, 0xcd, 0x80, 0x25, 0x8d,
+			0x69, 0x81, 0x89, 0x8c, 0x40, 0x84, 0x25, 0x8b, 0x60, 0x84, 0x55, 0x8a, 0x69, 0x81, 0xbd, 0x89,
+			0x40, 0x80, 0x25, 0x89, 0x60, 0x35, 0x7f, 0x25, 0x89, 0x99, 0x7e, 0xbd, 0x89, 0x40, 0x7c, 0x25,
+			0x8b, 0x60, 0x7c, 0xf1, 0x8b, 0x99, 0x7e, 0x89, 0x8c, 0x40, 0x80, 0x25, 0x8d, 0xe2, 0x80, 0x84,
+			0x60, 0xcd, 0x80, 0x84, 0x69, 0x81, 0x69, 0x81, 0x40, 0x84, 0x80, 0x60, 0x84, 0x35, 0x7f, 0x69,
+			0x81, 0x99, 0x7e, 0x40, 0x80, 0x7c, 0x60, 0x35, 0x7f, 0x7c, 0x99, 0x7e, 0x99, 0x7e, 0x40, 0x7c,
+			0x80, 0x60, 0x7c, 0xcd, 0x80, 0x99, 0x7e, 0x69, 0x81, 0x40, 0x80, 0x84, 0xe2, 0x80, 0xdd, 0x76,
+			0x60, 0xcd, 0x80, 0xdd, 0x76, 0x69, 0x81, 0x45, 0x76, 0x40, 0x84, 0xdd, 0x74, 0x60, 0x84, 0x11,
+			0x74, 0x69, 0x81, 0x79, 0x73, 0x40, 0x80, 0xdd, 0x72, 0x60, 0x35, 0x7f, 0xdd, 0x72, 0x99, 0x7e,
+			0x79, 0x73, 0x40, 0x7c, 0xdd, 0x74, 0x60, 0x7c, 0xad, 0x75, 0x99, 0x7e, 0x45, 0x76, 0x40, 0x80,
+			0xdd, 0x76, 0xe2, 0xad, 0x96, 0x55, 0x95, 0xe6, 0x55, 0x69, 0x60, 0xb1, 0x67, 0x55, 0x95, 0x85,
+			0x66, 0x29, 0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0x25, 0x87, 0x60, 0xcd, 0x67, 0x99, 0x86,
+			0x71, 0x69, 0xa5, 0x84, 0x40, 0x11, 0x6b, 0x80, 0x60, 0x11, 0x6b, 0x55, 0x7d, 0x71, 0x69, 0x55,
+			0x7b, 0x40, 0x55, 0x65, 0xdd, 0x78, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x05, 0x6d, 0x85, 0x66,
+			0xd9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0xad, 0x96, 0x60, 0x51, 0x98, 0xad, 0x6a, 0x7d,
+			0x99, 0xd9, 0x6b, 0x40, 0xad, 0x9a, 0xad, 0x6e, 0xe8, 0xdd, 0x78, 0x60, 0x35, 0x98, 0x55, 0x79,
+			0x91, 0x96, 0x55, 0x7b, 0x40, 0xf1, 0x94, 0x80, 0x60, 0xf1, 0x94, 0xad, 0x82, 0x91, 0x96, 0xa5,
+			0x84, 0x40, 0xad, 0x9a, 0x25, 0x87, 0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xfd, 0x92, 0x7d, 0x99,
+			0x29, 0x94, 0x40, 0xad, 0x96, 0x55, 0x95, 0xe2, 0xad, 0x96, 0x55, 0x91, 0xe8, 0x11, 0x8a, 0x60,
+			0x25, 0x94, 0x55, 0x88, 0x89, 0x92, 0xbd, 0x85, 0x40, 0xf1, 0x90, 0x80, 0x60, 0xf1, 0x90, 0xdd,
+			0x7c, 0x89, 0x92, 0x45, 0x7a, 0x40, 0xad, 0x96, 0xf1, 0x75, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x69,
+			0xe8, 0xf1, 0x75, 0x60, 0xf1, 0x6b, 0xad, 0x77, 0x81, 0x6d, 0x45, 0x7a, 0x40, 0x11, 0x6f, 0x80,
+			0x60, 0x11, 0x6f, 0x25, 0x83, 0x81, 0x6d, 0xbd, 0x85, 0x40, 0x55, 0x69, 0x11, 0x8a, 0xe8, 0x55,
+			0x91, 0xe6, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		NotificationDiscFull: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xe1, 0x7f, 0x55, 0x8b, 0x67, 0x99, 0x84, 0x55,
+			0x8b, 0xe9, 0x87, 0x09, 0x88, 0x35, 0x8b, 0xb9, 0x84, 0x35, 0x8b, 0xfd, 0x7f, 0x35, 0x8b, 0x45,
+			0x7b, 0xe5, 0x87, 0xf9, 0x77, 0x95, 0x84, 0xad, 0x74, 0xdd, 0x7f, 0xad, 0x74, 0x25, 0x7b, 0xad,
+			0x74, 0xd5, 0x77, 0xf9, 0x77, 0x89, 0x74, 0x49, 0x7b, 0x89, 0x74, 0x05, 0x80, 0x89, 0x74, 0xbd,
+			0x84, 0xd9, 0x77, 0x09, 0x88, 0x29, 0x7b, 0x55, 0x8b, 0xe1, 0x7f, 0x55, 0x8b, 0xe2, 0xdd, 0x7f,
+			0xad, 0x82, 0x60, 0xbd, 0x7e, 0xad, 0x82, 0xf9, 0x7d, 0xe9, 0x81, 0x40, 0x35, 0x7d, 0x80, 0x60,
+			0x35, 0x7d, 0xdd, 0x7e, 0xf9, 0x7d, 0x19, 0x7e, 0x40, 0xdd, 0x7f, 0x55, 0x7d, 0x60, 0x82, 0x55,
+			0x7d, 0xc5, 0x81, 0x19, 0x7e, 0x40, 0x89, 0x82, 0x80, 0x60, 0x89, 0x82, 0x25, 0x81, 0xc5, 0x81,
+			0xe9, 0x81, 0x40, 0xdd, 0x7f, 0xad, 0x82, 0xe2, 0xdd, 0x7f, 0xad, 0x9a, 0x60, 0x55, 0x7a, 0xad,
+			0x9a, 0x79, 0x75, 0x91, 0x98, 0x40, 0x5a, 0xdd, 0x92, 0x60, 0x69, 0x69, 0x45, 0x8f, 0x4d, 0x67,
+			0x69, 0x8a, 0x40, 0x35, 0x65, 0x80, 0x60, 0x35, 0x65, 0x79, 0x7a, 0x4d, 0x67, 0x99, 0x75, 0x40,
+			0x5a, 0x25, 0x6d, 0x60, 0x99, 0x70, 0x89, 0x69, 0x79, 0x75, 0x71, 0x67, 0x40, 0xdd, 0x7f, 0x55,
+			0x65, 0x60, 0x69, 0x88, 0x55, 0x65, 0x4d, 0x8f, 0x35, 0x6a, 0x40, 0x35, 0x99, 0x11, 0x77, 0xe6,
+			0xdd, 0x94, 0x60, 0xdd, 0x91, 0xf1, 0x70, 0x45, 0x8c, 0x25, 0x6d, 0x40, 0xdd, 0x7f, 0x55, 0x69,
+			0x61, 0x61, 0x76, 0x55, 0x69, 0xc9, 0x6f, 0xed, 0x6f, 0x35, 0x69, 0x85, 0x76, 0x35, 0x69, 0x80,
+			0x40, 0xc9, 0x6f, 0x15, 0x90, 0x61, 0x61, 0x76, 0xad, 0x96, 0xdd, 0x7f, 0xad, 0x96, 0x45, 0x85,
+			0xad, 0x96, 0x19, 0x8a, 0x3d, 0x94, 0x40, 0x79, 0x92, 0x99, 0x8d, 0xe8, 0x25, 0x93, 0x60, 0xad,
+			0x8e, 0xbd, 0x96, 0xdd, 0x89, 0xb5, 0x98, 0x40, 0xdd, 0x7f, 0xad, 0x9a, 0xe2, 0x79, 0x96, 0xdd,
+			0x90, 0xe8, 0x11, 0x7b, 0xe6, 0x79, 0x9a, 0xe8, 0xdd, 0x90, 0xe6, 0x79, 0x96, 0xe2, 0xad, 0x98,
+			0x35, 0x9a, 0x67, 0xbd, 0x97, 0x35, 0x9a, 0x19, 0x97, 0x91, 0x99, 0x79, 0x96, 0xf1, 0x98, 0x79,
+			0x96, 0xb0, 0x79, 0x96, 0x11, 0x97, 0x19, 0x97, 0x71, 0x96, 0xbd, 0x97, 0xcd, 0x95, 0xad, 0x98,
+			0xcd, 0x95, 0x99, 0x99, 0xcd, 0x95, 0x3d, 0x9a, 0x71, 0x96, 0xdd, 0x9a, 0x11, 0x97, 0xdd, 0x9a,
+			0xb0, 0xdd, 0x9a, 0xf1, 0x98, 0x3d, 0x9a, 0x91, 0x99, 0x9d, 0x99, 0x35, 0x9a, 0xad, 0x98, 0x35,
+			0x9a, 0xe2, 0x35, 0x7f, 0x69, 0x7f, 0xe1,
+		}},
+		NotificationDoNotDisturb:    NotificationDoNotDisturb,
+		NotificationDoNotDisturbAlt: NotificationDoNotDisturbAlt,
+		NotificationDoNotDisturbOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x87, 0xcd, 0x81, 0x00, 0x89, 0x83, 0xcd,
+			0x7d, 0xe6, 0x55, 0x8d, 0xe8, 0xcd, 0x81, 0xe6, 0x89, 0x87, 0xe2, 0x35, 0x96, 0xf1, 0x9b, 0x00,
+			0xdd, 0x8f, 0x99, 0x95, 0x60, 0x89, 0x8c, 0xb0, 0x89, 0x88, 0x55, 0x99, 0x40, 0x80, 0xad, 0x9a,
+			0x60, 0x55, 0x7a, 0xad, 0x9a, 0x79, 0x75, 0xa5, 0x98, 0x40, 0x5a, 0xa6, 0x60, 0x69, 0x69, 0x69,
+			0x8f, 0x5d, 0x67, 0x89, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7b, 0xad, 0x66,
+			0x79, 0x77, 0x40, 0x69, 0x6a, 0x25, 0x70, 0x03, 0x11, 0x64, 0xcd, 0x69, 0xf1, 0x66, 0xf1, 0x66,
+			0x11, 0x99, 0x11, 0x99, 0x35, 0x96, 0xf1, 0x9b, 0xe2, 0x99, 0x95, 0xdd, 0x8f, 0x00, 0xbd, 0x92,
+			0x9a, 0x60, 0x99, 0x94, 0x35, 0x8a, 0xa5, 0x95, 0xf1, 0x86, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad,
+			0x96, 0x55, 0x76, 0x2d, 0x90, 0xd5, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x55, 0x7c, 0x55, 0x69,
+			0x11, 0x79, 0x5d, 0x6a, 0x40, 0x66, 0x45, 0x6d, 0x00, 0x25, 0x70, 0x69, 0x6a, 0x60, 0x79, 0x73,
+			0x50, 0x79, 0x77, 0xad, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60, 0x99, 0x85, 0x55, 0x65, 0x79, 0x8a,
+			0x69, 0x67, 0x40, 0xf1, 0x92, 0x11, 0x6d, 0x60, 0x89, 0x96, 0xad, 0x70, 0x99, 0x98, 0x89, 0x75,
+			0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x84, 0x55, 0x99, 0x89, 0x88, 0x40, 0x99, 0x95,
+			0xdd, 0x8f, 0xe2, 0x9a, 0xbd, 0x92, 0x00, 0x79, 0x7c, 0xcd, 0x81, 0xe6, 0xad, 0x72, 0xe8, 0xcd,
+			0x7d, 0xe6, 0x79, 0x78, 0x00, 0x45, 0x6d, 0x66, 0x60, 0x69, 0x6b, 0xcd, 0x75, 0x5d, 0x6a, 0x11,
+			0x79, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0xad, 0x89, 0xd5, 0x6f, 0x2d, 0x90, 0x40, 0x80,
+			0xad, 0x96, 0x60, 0xad, 0x83, 0xad, 0x96, 0xf1, 0x86, 0xa5, 0x95, 0x40, 0x9a, 0xbd, 0x92, 0xe2,
+			0x89, 0x83, 0xcd, 0x7d, 0xe2, 0x25, 0x7d, 0xdd, 0x82, 0xe1,
+		}},
+		NotificationDoNotDisturbOn: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x72, 0xcd, 0x81, 0xe6, 0x55, 0x8d, 0xe8,
+			0xcd, 0x7d, 0xe6, 0xad, 0x72, 0xe8, 0xcd, 0x81, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad,
+			0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71,
+			0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75,
+			0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80,
+			0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d,
+			0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a,
+			0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96,
+			0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad,
+			0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90,
+			0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40,
+			0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96,
+			0xe2, 0x80, 0x80, 0xe1,
+		}},
+		NotificationDriveETA: NotificationDriveETA,
+		NotificationEnhancedEncryption: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x7c, 0xdd, 0x92, 0xe6, 0x84, 0xe8, 0xdd, 0x89,
+			0xe6, 0x96, 0xe8, 0xdd, 0x85, 0xe6, 0x84, 0xe8, 0xdd, 0x7c, 0xe6, 0x7c, 0xe8, 0xdd, 0x85, 0xe6,
+			0x6a, 0xe8, 0xdd, 0x89, 0xe6, 0x7c, 0xe8, 0xdd, 0x92, 0xe2, 0xad, 0x6e, 0xad, 0x9a, 0x60, 0x05,
+			0x6d, 0xad, 0x9a, 0xd9, 0x6b, 0x7d, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0x60,
+			0xad, 0x6a, 0x15, 0x78, 0xd9, 0x6b, 0xe9, 0x76, 0x40, 0xad, 0x6e, 0xbd, 0x75, 0xe6, 0x55, 0x73,
+			0xe8, 0x55, 0x6f, 0x61, 0x55, 0x73, 0x15, 0x6a, 0x0d, 0x77, 0x61, 0x66, 0xc1, 0x7a, 0xad, 0x62,
+			0x80, 0xad, 0x62, 0x40, 0xf9, 0x88, 0x61, 0x66, 0x60, 0xad, 0x8c, 0x15, 0x6a, 0xad, 0x8c, 0x55,
+			0x6f, 0xe8, 0xbd, 0x75, 0xe6, 0x55, 0x91, 0x60, 0xfd, 0x92, 0xbd, 0x75, 0x29, 0x94, 0xe9, 0x76,
+			0x40, 0x55, 0x95, 0xbd, 0x79, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x51, 0x98, 0x29, 0x94, 0x7d,
+			0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55,
+			0x91, 0xe8, 0xbd, 0x79, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe2, 0x55, 0x77, 0xbd, 0x75, 0xe6,
+			0xad, 0x88, 0xe8, 0x55, 0x6f, 0x63, 0xad, 0x88, 0xb9, 0x6b, 0x25, 0x86, 0x31, 0x69, 0x9d, 0x83,
+			0xad, 0x66, 0x05, 0x80, 0xad, 0x66, 0x69, 0x7c, 0xad, 0x66, 0xdd, 0x79, 0x31, 0x69, 0x55, 0x77,
+			0xb9, 0x6b, 0x55, 0x77, 0x55, 0x6f, 0xe8, 0xbd, 0x75, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe8, 0xbd,
+			0x79, 0xe8, 0xad, 0x96, 0xe1,
+		}},
+		NotificationEventAvailable: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x7c, 0xcd, 0x90, 0x05, 0xad, 0x73, 0xad,
+			0x87, 0x79, 0x76, 0xdd, 0x84, 0xdd, 0x7c, 0x25, 0x8b, 0x25, 0x89, 0xdd, 0x7e, 0xf1, 0x8b, 0xbd,
+			0x81, 0xdd, 0x7c, 0xcd, 0x90, 0xe2, 0x58, 0xad, 0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a, 0x35, 0x69,
+			0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35, 0x69, 0x89,
+			0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74, 0xe8, 0x55,
+			0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6, 0xa8, 0x60,
+			0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a, 0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad, 0x96, 0x60,
+			0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40, 0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2, 0x58, 0xad,
+			0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0x58, 0x6c, 0xe6, 0xa8, 0xe8,
+			0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2, 0x58, 0x6c, 0xe8, 0x55, 0x6d, 0xe8, 0x6c, 0xe1,
+		}},
+		NotificationEventBusy: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x79, 0x79, 0x91, 0x0b, 0x89, 0x76, 0x99,
+			0x8e, 0x35, 0x7d, 0x90, 0x89, 0x76, 0x69, 0x81, 0x69, 0x79, 0x89, 0x7e, 0x80, 0x35, 0x85, 0x99,
+			0x86, 0x89, 0x7e, 0x79, 0x89, 0x69, 0x81, 0xcd, 0x82, 0x90, 0x79, 0x89, 0x99, 0x8e, 0x99, 0x86,
+			0x79, 0x91, 0x80, 0xcd, 0x8a, 0x69, 0x79, 0x79, 0x91, 0xe2, 0x58, 0xad, 0x9a, 0x60, 0x69, 0x6a,
+			0xad, 0x9a, 0x35, 0x69, 0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0xbd,
+			0x6b, 0x35, 0x69, 0x89, 0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70, 0xe8, 0x55, 0x65, 0xe6,
+			0xad, 0x74, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x8f, 0xe8, 0x55,
+			0x69, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a, 0x40, 0xb0, 0x55, 0x6d,
+			0xe8, 0xad, 0x96, 0x60, 0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40, 0xa8, 0xad, 0x9a, 0xe6,
+			0x58, 0xe2, 0x58, 0xad, 0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0x58,
+			0x6c, 0xe6, 0xa8, 0xe8, 0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2, 0x58, 0x6c, 0xe8, 0x55, 0x6d,
+			0xe8, 0x6c, 0xe1,
+		}},
+		NotificationEventNote: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xad, 0x9a, 0x60, 0x69, 0x6a, 0xad, 0x9a,
+			0x35, 0x69, 0x79, 0x99, 0x40, 0x50, 0xad, 0x96, 0xe8, 0x55, 0x6d, 0x60, 0x50, 0xbd, 0x6b, 0x35,
+			0x69, 0x89, 0x6a, 0x40, 0x58, 0x55, 0x69, 0xe6, 0x55, 0x70, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x74,
+			0xe8, 0x55, 0x69, 0xe6, 0x55, 0x8b, 0xe8, 0x55, 0x65, 0xe6, 0xad, 0x8f, 0xe8, 0x55, 0x69, 0xe6,
+			0xa8, 0x60, 0x99, 0x95, 0x55, 0x69, 0xcd, 0x96, 0x89, 0x6a, 0x40, 0xb0, 0x55, 0x6d, 0xe8, 0xad,
+			0x96, 0x60, 0xb0, 0x45, 0x98, 0xcd, 0x96, 0x79, 0x99, 0x40, 0xa8, 0xad, 0x9a, 0xe6, 0x58, 0xe2,
+			0x58, 0xad, 0x96, 0xe6, 0xa8, 0xe8, 0x74, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0x58, 0x6c, 0xe6,
+			0xa8, 0xe8, 0x55, 0x6d, 0xe6, 0x58, 0xe8, 0x6c, 0xe2, 0x58, 0x6c, 0xe8, 0x55, 0x6d, 0xe8, 0x6c,
+			0xe2, 0xad, 0x72, 0x88, 0xe8, 0x80, 0xe6, 0x55, 0x8d, 0xe8, 0x88, 0xe6, 0xad, 0x72, 0xe2, 0xad,
+			0x72, 0xa0, 0xe8, 0x98, 0xe6, 0x45, 0x85, 0xe8, 0xa0, 0xe6, 0xad, 0x72, 0xe1,
+		}},
+		NotificationFolderSpecial: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x81, 0xf1, 0x8b, 0x03, 0xbd, 0x87, 0xdd,
+			0x87, 0x89, 0x8d, 0xf1, 0x8b, 0x55, 0x8b, 0xdd, 0x84, 0x25, 0x91, 0x80, 0xe6, 0x11, 0x8a, 0x01,
+			0xbd, 0x87, 0x11, 0x79, 0x55, 0x85, 0x80, 0xe6, 0x45, 0x7e, 0x01, 0x11, 0x84, 0xdd, 0x84, 0xdd,
+			0x81, 0xf1, 0x8b, 0xe2, 0x55, 0x69, 0x55, 0x95, 0x60, 0xbd, 0x67, 0x55, 0x95, 0x89, 0x66, 0x19,
+			0x94, 0x40, 0x55, 0x65, 0x55, 0x91, 0xe8, 0xad, 0x6e, 0x60, 0x55, 0x65, 0x25, 0x6d, 0x89, 0x66,
+			0xe9, 0x6b, 0x40, 0x55, 0x69, 0xad, 0x6a, 0xe6, 0x11, 0x7c, 0x00, 0x11, 0x80, 0xad, 0x6e, 0xe6,
+			0xad, 0x96, 0x60, 0x35, 0x98, 0xad, 0x6e, 0x71, 0x99, 0xe9, 0x6f, 0x40, 0xad, 0x9a, 0xad, 0x72,
+			0xe8, 0x55, 0x91, 0x60, 0xad, 0x9a, 0xdd, 0x92, 0x71, 0x99, 0x19, 0x94, 0x40, 0xad, 0x96, 0x55,
+			0x95, 0xe6, 0x55, 0x69, 0xe2, 0x55, 0x69, 0x55, 0x91, 0xe6, 0xad, 0x96, 0xe8, 0xad, 0x72, 0xe6,
+			0x69, 0x7e, 0x00, 0x69, 0x7a, 0xad, 0x6e, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0xe2, 0x55, 0x69,
+			0x55, 0x91, 0xe8, 0xad, 0x6e, 0xe8, 0x55, 0x91, 0xe1,
+		}},
+		NotificationLiveTV: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x79, 0xad, 0x88, 0x01, 0x55, 0x8b, 0x55,
+			0x7d, 0x89, 0x79, 0x64, 0xe8, 0xad, 0x88, 0xe2, 0x6c, 0xb0, 0xe8, 0xad, 0x92, 0xe6, 0x55, 0x69,
+			0x60, 0xbd, 0x67, 0xad, 0x92, 0x89, 0x66, 0x79, 0x91, 0x40, 0x55, 0x65, 0xad, 0x8e, 0xe8, 0x58,
+			0x60, 0x55, 0x65, 0x69, 0x6a, 0x89, 0x66, 0x35, 0x69, 0x40, 0x55, 0x69, 0x50, 0xe6, 0xad, 0x96,
+			0x60, 0x45, 0x98, 0x50, 0x79, 0x99, 0x35, 0x69, 0x40, 0xad, 0x9a, 0x58, 0xe8, 0xad, 0x8e, 0x60,
+			0xad, 0x9a, 0x45, 0x90, 0x79, 0x99, 0x79, 0x91, 0x40, 0xad, 0x96, 0xad, 0x92, 0xe6, 0x94, 0xe8,
+			0xb0, 0xe6, 0x6c, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe6, 0xad, 0x96, 0xe8, 0x58, 0xe6, 0x55, 0x69,
+			0xe8, 0xad, 0x8e, 0xe2, 0x55, 0x69, 0xad, 0x8e, 0xe8, 0x58, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		NotificationMMS: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x71, 0x8c, 0xe6, 0x25, 0x90, 0x03, 0xad,
+			0x86, 0x89, 0x79, 0x45, 0x7e, 0xad, 0x84, 0x25, 0x78, 0x25, 0x7d, 0x79, 0x71, 0x8c, 0xe2, 0x55,
+			0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40,
+			0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66,
+			0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e,
+			0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6,
+			0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2,
+			0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		NotificationMore: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x72, 0xbd, 0x82, 0x67, 0x29, 0x7a, 0xbd, 0x82,
+			0xf5, 0x7a, 0xf5, 0x81, 0xbd, 0x7b, 0x29, 0x81, 0xbd, 0x7b, 0x80, 0xbd, 0x7b, 0xd9, 0x7e, 0xf5,
+			0x7a, 0x0d, 0x7e, 0x29, 0x7a, 0x45, 0x7d, 0x72, 0x45, 0x7d, 0xd9, 0x77, 0x45, 0x7d, 0x0d, 0x77,
+			0x0d, 0x7e, 0x45, 0x76, 0xd9, 0x7e, 0x45, 0x76, 0x80, 0x45, 0x76, 0x29, 0x81, 0x0d, 0x77, 0xf5,
+			0x81, 0xd9, 0x77, 0xbd, 0x82, 0x72, 0xbd, 0x82, 0xe2, 0x25, 0x83, 0xbd, 0x82, 0x63, 0x4d, 0x84,
+			0xbd, 0x82, 0x15, 0x85, 0xf5, 0x81, 0xdd, 0x85, 0x29, 0x81, 0xdd, 0x85, 0x80, 0xdd, 0x85, 0xd9,
+			0x7e, 0x15, 0x85, 0x0d, 0x7e, 0x4d, 0x84, 0x45, 0x7d, 0x25, 0x83, 0x45, 0x7d, 0x40, 0x31, 0x81,
+			0x0d, 0x7e, 0x62, 0x69, 0x80, 0xd9, 0x7e, 0x69, 0x80, 0x80, 0x69, 0x80, 0x29, 0x81, 0x31, 0x81,
+			0xf5, 0x81, 0xf9, 0x81, 0xbd, 0x82, 0x25, 0x83, 0xbd, 0x82, 0xe2, 0x45, 0x8d, 0xbd, 0x82, 0x63,
+			0x6d, 0x8e, 0xbd, 0x82, 0x39, 0x8f, 0xf5, 0x81, 0xa0, 0x29, 0x81, 0xa0, 0x80, 0xa0, 0xd9, 0x7e,
+			0x39, 0x8f, 0x0d, 0x7e, 0x6d, 0x8e, 0x45, 0x7d, 0x45, 0x8d, 0x45, 0x7d, 0x40, 0x51, 0x8b, 0x0d,
+			0x7e, 0x62, 0x89, 0x8a, 0xd9, 0x7e, 0x89, 0x8a, 0x80, 0x89, 0x8a, 0x29, 0x81, 0x51, 0x8b, 0xf5,
+			0x81, 0x1d, 0x8c, 0xbd, 0x82, 0x45, 0x8d, 0xbd, 0x82, 0xe2, 0x70, 0xad, 0x92, 0x60, 0x89, 0x76,
+			0xad, 0x92, 0x55, 0x75, 0xe9, 0x91, 0x40, 0x45, 0x73, 0xf1, 0x8f, 0x01, 0x50, 0x80, 0x45, 0x73,
+			0x11, 0x70, 0x60, 0x25, 0x74, 0xdd, 0x6e, 0x55, 0x75, 0x19, 0x6e, 0x40, 0x70, 0x55, 0x6d, 0xe6,
+			0xa8, 0x60, 0xa9, 0x95, 0x55, 0x6d, 0xd5, 0x96, 0x85, 0x6e, 0x40, 0xb0, 0x55, 0x71, 0xe8, 0xad,
+			0x8e, 0x60, 0xb0, 0x51, 0x90, 0xd5, 0x96, 0x7d, 0x91, 0x40, 0xa8, 0xad, 0x92, 0xe6, 0x70, 0xe2,
+			0xa8, 0xad, 0x8e, 0xe8, 0x55, 0x71, 0xe8, 0xad, 0x8e, 0xe2, 0x45, 0x77, 0xad, 0x8e, 0xe6, 0xa8,
+			0xe8, 0x55, 0x71, 0xe6, 0x45, 0x77, 0x01, 0x5a, 0x80, 0x45, 0x77, 0xad, 0x8e, 0xe1,
+		}},
+		NotificationNetworkCheck: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x64, 0x35, 0x7c, 0x00, 0x40, 0x70, 0x60,
+			0x35, 0x66, 0xad, 0x71, 0x89, 0x6e, 0x2d, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x99, 0x81, 0xad,
+			0x6a, 0x99, 0x83, 0xc5, 0x6a, 0x40, 0xbd, 0x87, 0x25, 0x6b, 0x00, 0xf1, 0x84, 0xdd, 0x70, 0x60,
+			0xbd, 0x83, 0xbd, 0x70, 0x89, 0x82, 0xb5, 0x70, 0x40, 0x80, 0xad, 0x70, 0x60, 0x11, 0x78, 0xad,
+			0x70, 0xf9, 0x70, 0xbd, 0x73, 0x40, 0x35, 0x64, 0x35, 0x7c, 0xe2, 0x69, 0x6c, 0x11, 0x84, 0x00,
+			0x35, 0x68, 0xdd, 0x7f, 0x60, 0xcd, 0x6c, 0x69, 0x7b, 0x3d, 0x73, 0x11, 0x79, 0x40, 0xdd, 0x81,
+			0x45, 0x77, 0x00, 0x45, 0x7f, 0xad, 0x7c, 0x60, 0x25, 0x7a, 0x89, 0x7c, 0xf9, 0x74, 0x99, 0x7e,
+			0x40, 0x69, 0x6c, 0x11, 0x84, 0xe2, 0x99, 0x7d, 0x11, 0x95, 0x60, 0xcd, 0x7b, 0x55, 0x94, 0xf9,
+			0x7a, 0x55, 0x92, 0x40, 0x11, 0x7b, 0x69, 0x8e, 0x00, 0xdd, 0x8a, 0xbd, 0x6d, 0x60, 0x11, 0x8b,
+			0x45, 0x6d, 0x91, 0x8b, 0x11, 0x6d, 0x40, 0x99, 0x8c, 0x5a, 0x60, 0x25, 0x8d, 0x25, 0x6d, 0x79,
+			0x8d, 0x99, 0x6d, 0x40, 0xad, 0x8d, 0x99, 0x6e, 0x00, 0x79, 0x84, 0x45, 0x92, 0x60, 0xf1, 0x83,
+			0x69, 0x94, 0xbd, 0x81, 0x19, 0x95, 0x40, 0x99, 0x7d, 0x11, 0x95, 0xe2, 0x99, 0x93, 0x11, 0x84,
+			0x60, 0x89, 0x92, 0xf1, 0x82, 0xa5, 0x90, 0x99, 0x81, 0x40, 0x69, 0x8d, 0xad, 0x7f, 0x00, 0xcd,
+			0x8e, 0xf1, 0x79, 0x60, 0x25, 0x91, 0xbd, 0x7a, 0xad, 0x93, 0x81, 0x7c, 0x40, 0xcd, 0x97, 0xdd,
+			0x7f, 0x00, 0x99, 0x93, 0x11, 0x84, 0xe2, 0xcd, 0x9b, 0x35, 0x7c, 0x60, 0x35, 0x99, 0xad, 0x79,
+			0x25, 0x96, 0x99, 0x77, 0x40, 0x45, 0x90, 0x45, 0x74, 0x00, 0xbd, 0x91, 0x45, 0x6e, 0x60, 0x11,
+			0x96, 0x11, 0x70, 0x79, 0x99, 0x81, 0x72, 0x40, 0xc0, 0x70, 0x00, 0xcd, 0x9b, 0x35, 0x7c, 0xe1,
+		}},
+		NotificationNetworkLocked: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xad, 0x9a, 0x00, 0xad, 0x9a, 0x55,
+			0x65, 0xe8, 0x89, 0x80, 0xe6, 0xad, 0x96, 0xe8, 0xf1, 0x6e, 0x00, 0x5e, 0xad, 0x96, 0xe6, 0x69,
+			0x89, 0xe8, 0xad, 0x9a, 0xe6, 0x55, 0x65, 0xe2, 0xad, 0x8f, 0xad, 0x9a, 0x60, 0xad, 0x8e, 0xad,
+			0x9a, 0xc5, 0x8d, 0xc5, 0x99, 0x40, 0xdd, 0x8c, 0xdd, 0x97, 0xe8, 0xbd, 0x8f, 0x60, 0xdd, 0x8c,
+			0xbd, 0x8e, 0xc5, 0x8d, 0xd5, 0x8d, 0x40, 0xad, 0x8f, 0xf1, 0x8c, 0xe8, 0x45, 0x8a, 0x60, 0xad,
+			0x8f, 0xdd, 0x87, 0x3d, 0x91, 0x35, 0x86, 0x40, 0x25, 0x95, 0x89, 0x84, 0x60, 0x79, 0x97, 0x89,
+			0x84, 0xb2, 0x35, 0x86, 0x40, 0x89, 0x9a, 0x45, 0x8a, 0xe8, 0xf1, 0x8c, 0xe6, 0x99, 0x9a, 0x60,
+			0x89, 0x9b, 0xf1, 0x8c, 0x71, 0x9c, 0xd5, 0x8d, 0x40, 0x55, 0x9d, 0xbd, 0x8f, 0xe8, 0xdd, 0x97,
+			0x60, 0x55, 0x9d, 0xdd, 0x98, 0x71, 0x9c, 0xc5, 0x99, 0x40, 0x99, 0x9a, 0xad, 0x9a, 0xe6, 0xad,
+			0x8f, 0xe2, 0x55, 0x92, 0xf1, 0x8c, 0xe6, 0xf1, 0x97, 0xe8, 0x45, 0x8a, 0x61, 0xf1, 0x97, 0xf9,
+			0x88, 0x2d, 0x97, 0x15, 0x88, 0x69, 0x96, 0x35, 0x87, 0x19, 0x95, 0x35, 0x87, 0x40, 0x11, 0x93,
+			0x15, 0x88, 0x60, 0x55, 0x92, 0xf9, 0x88, 0x55, 0x92, 0x45, 0x8a, 0xe8, 0xf1, 0x8c, 0xe2, 0x45,
+			0x82, 0x55, 0x83, 0xe1,
+		}},
+		NotificationNoEncryption: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x95, 0x89, 0x8f, 0x00, 0x55, 0x91, 0x89,
+			0x8b, 0xe8, 0xbd, 0x79, 0xe6, 0x89, 0x7f, 0x00, 0x89, 0x7b, 0xbd, 0x75, 0xe6, 0xad, 0x88, 0xe8,
+			0x69, 0x6f, 0x61, 0xad, 0x88, 0xc5, 0x6b, 0x09, 0x86, 0x39, 0x69, 0x69, 0x83, 0xad, 0x66, 0xb5,
+			0x7f, 0xad, 0x66, 0x40, 0x71, 0x79, 0x3d, 0x69, 0x60, 0xdd, 0x76, 0xcd, 0x6b, 0xdd, 0x76, 0x69,
+			0x6f, 0xe8, 0x11, 0x71, 0x00, 0x35, 0x73, 0x69, 0x6d, 0x60, 0xcd, 0x73, 0xbd, 0x68, 0x5d, 0x77,
+			0xb5, 0x65, 0x40, 0xbd, 0x7f, 0xad, 0x62, 0x61, 0x21, 0x85, 0xad, 0x62, 0xe5, 0x88, 0x5d, 0x66,
+			0xad, 0x8c, 0x11, 0x6a, 0xad, 0x8c, 0x69, 0x6f, 0xe8, 0xbd, 0x75, 0xe6, 0x55, 0x91, 0x60, 0xfd,
+			0x92, 0xbd, 0x75, 0x29, 0x94, 0xe9, 0x76, 0x40, 0x55, 0x95, 0xbd, 0x79, 0xe8, 0x89, 0x8f, 0xe2,
+			0xcd, 0x99, 0x35, 0xa1, 0x00, 0xf1, 0x92, 0x55, 0x9a, 0x60, 0x69, 0x92, 0x89, 0x9a, 0x11, 0x92,
+			0x99, 0x9a, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0x60, 0x05, 0x6d, 0xad, 0x9a, 0xd9,
+			0x6b, 0x7d, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0xbd, 0x79, 0x60, 0xad, 0x6a, 0x35, 0x78,
+			0xbd, 0x6b, 0x09, 0x77, 0x40, 0x55, 0x6e, 0xbd, 0x75, 0x03, 0x11, 0x5f, 0x79, 0x66, 0xf1, 0x61,
+			0xad, 0x63, 0xad, 0x9c, 0x69, 0x9e, 0xcd, 0x99, 0x35, 0xa1, 0xe2, 0x55, 0x8f, 0xad, 0x96, 0x00,
+			0xad, 0x83, 0x11, 0x8b, 0x60, 0xf1, 0x82, 0xcd, 0x8b, 0x0d, 0x82, 0x25, 0x8c, 0x40, 0x25, 0x80,
+			0x79, 0x8c, 0x60, 0x7c, 0x79, 0x8c, 0x91, 0x7c, 0x11, 0x8b, 0x40, 0x25, 0x7b, 0x8d, 0x87, 0x60,
+			0x25, 0x7b, 0x79, 0x86, 0x81, 0x7b, 0x91, 0x85, 0x40, 0x99, 0x7c, 0xf1, 0x83, 0x00, 0x55, 0x72,
+			0xbd, 0x79, 0xe6, 0xad, 0x6e, 0xe8, 0xad, 0x96, 0xe6, 0x55, 0x8f, 0xe2, 0xdd, 0x80, 0x35, 0x88,
+			0xe2, 0x69, 0x88, 0x99, 0x82, 0xe1,
+		}},
+		NotificationOnDemandVideo: NotificationOnDemandVideo,
+		NotificationPersonalVideo: NotificationPersonalVideo,
+		NotificationPhoneBluetoothSpeaker: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x8c, 0x25, 0x81, 0xe8, 0x35, 0x76, 0x05,
+			0x25, 0x86, 0xf1, 0x7c, 0x69, 0x84, 0x35, 0x7b, 0xad, 0x8c, 0xf1, 0x72, 0x69, 0x84, 0x11, 0x6b,
+			0x25, 0x86, 0x55, 0x69, 0xdd, 0x8c, 0x11, 0x70, 0xe8, 0x25, 0x65, 0xe6, 0x9c, 0x03, 0xf1, 0x95,
+			0x45, 0x6d, 0x25, 0x90, 0xf1, 0x72, 0xf1, 0x95, 0x25, 0x79, 0x9c, 0x25, 0x81, 0xe6, 0xdd, 0x8c,
+			0xe2, 0x89, 0x8f, 0x25, 0x7c, 0x01, 0x79, 0x92, 0x25, 0x79, 0x89, 0x8f, 0x35, 0x76, 0xe8, 0x25,
+			0x7c, 0xe2, 0x89, 0x8f, 0x11, 0x70, 0x01, 0x79, 0x92, 0x45, 0x6d, 0x89, 0x8f, 0xdd, 0x69, 0xe8,
+			0x11, 0x70, 0xe2, 0xaa, 0xb0, 0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85, 0x45, 0x94, 0x40, 0x55, 0x76,
+			0xad, 0x89, 0x60, 0x79, 0x6f, 0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a, 0x40, 0x50, 0x56, 0x60, 0x50,
+			0xb9, 0x69, 0xdd, 0x68, 0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x60, 0x45, 0x75, 0x50,
+			0xf1, 0x75, 0xad, 0x68, 0x40, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9, 0x78, 0xb5, 0x72, 0x61, 0xcd,
+			0x78, 0xad, 0x73, 0xa5, 0x78, 0x71, 0x74, 0x79, 0x78, 0x35, 0x75, 0xed, 0x77, 0xc1, 0x75, 0x00,
+			0x45, 0x71, 0x79, 0x7c, 0x60, 0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1, 0x81, 0x40, 0x35, 0x79, 0xbd,
+			0x86, 0x60, 0xad, 0x7b, 0x45, 0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40, 0x25, 0x84, 0x11, 0x8f, 0x00,
+			0x79, 0x8a, 0x89, 0x88, 0x60, 0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c, 0x89, 0x87, 0x40, 0xbd, 0x8d,
+			0x69, 0x87, 0x00, 0xad, 0x95, 0x25, 0x89, 0x60, 0xad, 0x96, 0x69, 0x89, 0x55, 0x97, 0x35, 0x8a,
+			0x40, 0xb0, 0x98, 0xe8, 0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40, 0xaa, 0xb0,
+			0xe2, 0x45, 0x6f, 0xcd, 0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6, 0x58, 0x60,
+			0x25, 0x6c, 0xcd, 0x6e, 0xe9, 0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87,
+			0xa2, 0x60, 0x99, 0x8a, 0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd, 0x8c,
+			0x01, 0x25, 0x8d, 0x79, 0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87,
+			0xa2, 0xe1,
+		}},
+		NotificationPhoneForwarded: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x90, 0x69, 0x79, 0x01, 0xf1, 0x8d, 0x79,
+			0x76, 0x25, 0x93, 0x45, 0x71, 0xe6, 0x11, 0x83, 0xe8, 0x45, 0x6d, 0xe6, 0x25, 0x93, 0x03, 0x9c,
+			0x25, 0x68, 0xdd, 0x90, 0x55, 0x65, 0xcd, 0x9a, 0x45, 0x6f, 0x99, 0x90, 0x69, 0x79, 0xe2, 0xaa,
+			0xb0, 0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85, 0x45, 0x94, 0x40, 0x55, 0x76, 0xad, 0x89, 0x60, 0x79,
+			0x6f, 0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a, 0x40, 0x50, 0x56, 0x60, 0x50, 0xb9, 0x69, 0xdd, 0x68,
+			0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x60, 0x45, 0x75, 0x50, 0xf1, 0x75, 0xad, 0x68,
+			0x40, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9, 0x78, 0xb5, 0x72, 0x61, 0xcd, 0x78, 0xad, 0x73, 0xa5,
+			0x78, 0x71, 0x74, 0x79, 0x78, 0x35, 0x75, 0xed, 0x77, 0xc1, 0x75, 0x00, 0x45, 0x71, 0x79, 0x7c,
+			0x60, 0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1, 0x81, 0x40, 0x35, 0x79, 0xbd, 0x86, 0x60, 0xad, 0x7b,
+			0x45, 0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40, 0x25, 0x84, 0x11, 0x8f, 0x00, 0x79, 0x8a, 0x89, 0x88,
+			0x60, 0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c, 0x89, 0x87, 0x40, 0xbd, 0x8d, 0x69, 0x87, 0x00, 0xad,
+			0x95, 0x25, 0x89, 0x60, 0xad, 0x96, 0x69, 0x89, 0x55, 0x97, 0x35, 0x8a, 0x40, 0xb0, 0x98, 0xe8,
+			0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40, 0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd,
+			0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6, 0x58, 0x60, 0x25, 0x6c, 0xcd, 0x6e,
+			0xe9, 0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0x60, 0x99, 0x8a,
+			0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd, 0x8c, 0x01, 0x25, 0x8d, 0x79,
+			0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0xe1,
+		}, mirror: true},
+		NotificationPhoneInTalk: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x93, 0x89, 0x7f, 0x60, 0x69, 0x93, 0x79,
+			0x77, 0xcd, 0x8d, 0xdd, 0x71, 0x40, 0x25, 0x80, 0xf1, 0x6b, 0xe8, 0xf1, 0x67, 0x60, 0xf1, 0x84,
+			0x11, 0x68, 0x2d, 0x89, 0xf9, 0x69, 0x40, 0x99, 0x90, 0x11, 0x6f, 0x60, 0xcd, 0x93, 0x45, 0x72,
+			0xb5, 0x95, 0x81, 0x76, 0x40, 0xbd, 0x97, 0x89, 0x7f, 0xe6, 0xbd, 0x93, 0xe2, 0x79, 0x88, 0x89,
+			0x7f, 0x60, 0x25, 0x88, 0x35, 0x7c, 0xcd, 0x85, 0xe9, 0x79, 0x40, 0x25, 0x80, 0x45, 0x77, 0xe8,
+			0x45, 0x73, 0x60, 0x25, 0x85, 0x99, 0x73, 0xa5, 0x88, 0x11, 0x77, 0x40, 0x79, 0x8c, 0x89, 0x7f,
+			0xe6, 0x79, 0x88, 0xe2, 0xaa, 0xb0, 0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85, 0x45, 0x94, 0x40, 0x55,
+			0x76, 0xad, 0x89, 0x60, 0x79, 0x6f, 0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a, 0x40, 0x50, 0x56, 0x60,
+			0x50, 0xb9, 0x69, 0xdd, 0x68, 0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x60, 0x45, 0x75,
+			0x50, 0xf1, 0x75, 0xad, 0x68, 0x40, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9, 0x78, 0xb5, 0x72, 0x61,
+			0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78, 0x71, 0x74, 0x79, 0x78, 0x35, 0x75, 0xed, 0x77, 0xc1, 0x75,
+			0x00, 0x45, 0x71, 0x79, 0x7c, 0x60, 0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1, 0x81, 0x40, 0x35, 0x79,
+			0xbd, 0x86, 0x60, 0xad, 0x7b, 0x45, 0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40, 0x25, 0x84, 0x11, 0x8f,
+			0x00, 0x79, 0x8a, 0x89, 0x88, 0x60, 0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c, 0x89, 0x87, 0x40, 0xbd,
+			0x8d, 0x69, 0x87, 0x00, 0xad, 0x95, 0x25, 0x89, 0x60, 0xad, 0x96, 0x69, 0x89, 0x55, 0x97, 0x35,
+			0x8a, 0x40, 0xb0, 0x98, 0xe8, 0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40, 0xaa,
+			0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6, 0x58,
+			0x60, 0x25, 0x6c, 0xcd, 0x6e, 0xe9, 0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd,
+			0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd,
+			0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd,
+			0x87, 0xa2, 0xe1,
+		}},
+		NotificationPhoneLocked: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xdd, 0x89, 0xbd, 0x7a, 0x60, 0x05, 0x89, 0xbd,
+			0x7a, 0x71, 0x88, 0x29, 0x7a, 0x40, 0xdd, 0x87, 0xbd, 0x78, 0xe8, 0x35, 0x6f, 0x60, 0xdd, 0x87,
+			0x59, 0x6e, 0x71, 0x88, 0xc9, 0x6d, 0x40, 0xdd, 0x89, 0x35, 0x6d, 0xe6, 0xdd, 0x8a, 0xe8, 0x95,
+			0x6a, 0x61, 0xdd, 0x8a, 0x6d, 0x68, 0x55, 0x8c, 0xe1, 0x66, 0xcd, 0x8d, 0x55, 0x65, 0xf1, 0x8f,
+			0x55, 0x65, 0x40, 0x89, 0x93, 0xe1, 0x66, 0x60, 0xaa, 0x6d, 0x68, 0xaa, 0x95, 0x6a, 0xe8, 0x35,
+			0x6d, 0xe6, 0xac, 0x60, 0xd9, 0x96, 0x35, 0x6d, 0x6d, 0x97, 0xc9, 0x6d, 0x40, 0xb0, 0x35, 0x6f,
+			0xe8, 0xbd, 0x78, 0x60, 0xb0, 0x95, 0x79, 0x6d, 0x97, 0x29, 0x7a, 0x40, 0xac, 0xbd, 0x7a, 0xe6,
+			0xdd, 0x89, 0xe2, 0x25, 0x8d, 0x35, 0x6d, 0xe6, 0xcd, 0x92, 0xe8, 0x99, 0x6a, 0x61, 0xcd, 0x92,
+			0x55, 0x69, 0xf9, 0x91, 0x79, 0x68, 0x25, 0x91, 0x99, 0x67, 0xa0, 0x99, 0x67, 0x40, 0x9c, 0x79,
+			0x68, 0x60, 0x25, 0x8d, 0x55, 0x69, 0x25, 0x8d, 0x99, 0x6a, 0xe8, 0x35, 0x6d, 0xe2, 0xaa, 0xb0,
+			0x60, 0x45, 0x8d, 0xb0, 0x3d, 0x85, 0x45, 0x94, 0x40, 0x55, 0x76, 0xad, 0x89, 0x60, 0x79, 0x6f,
+			0xcd, 0x82, 0xbd, 0x6b, 0xc5, 0x7a, 0x40, 0x50, 0x56, 0x60, 0x50, 0xb9, 0x69, 0xdd, 0x68, 0xdd,
+			0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x60, 0x45, 0x75, 0x50, 0xf1, 0x75, 0xad, 0x68, 0x40,
+			0xdd, 0x76, 0x55, 0x6a, 0x00, 0xa9, 0x78, 0xb5, 0x72, 0x61, 0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78,
+			0x71, 0x74, 0x79, 0x78, 0x35, 0x75, 0xed, 0x77, 0xc1, 0x75, 0x00, 0x45, 0x71, 0x79, 0x7c, 0x60,
+			0x66, 0x69, 0x7f, 0xf1, 0x74, 0xf1, 0x81, 0x40, 0x35, 0x79, 0xbd, 0x86, 0x60, 0xad, 0x7b, 0x45,
+			0x89, 0x69, 0x7e, 0x5d, 0x8b, 0x40, 0x25, 0x84, 0x11, 0x8f, 0x00, 0x79, 0x8a, 0x89, 0x88, 0x60,
+			0x25, 0x8b, 0xcd, 0x87, 0x05, 0x8c, 0x89, 0x87, 0x40, 0xbd, 0x8d, 0x69, 0x87, 0x00, 0xad, 0x95,
+			0x25, 0x89, 0x60, 0xad, 0x96, 0x69, 0x89, 0x55, 0x97, 0x35, 0x8a, 0x40, 0xb0, 0x98, 0xe8, 0xaa,
+			0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40, 0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78,
+			0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6, 0x58, 0x60, 0x25, 0x6c, 0xcd, 0x6e, 0xe9,
+			0x6c, 0xe9, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45,
+			0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd, 0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b,
+			0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd, 0x87, 0xa2, 0xe1,
+		}},
+		NotificationPhoneMissed: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x69, 0x45, 0x96, 0x00, 0xcd, 0x62, 0xa0,
+			0x60, 0x35, 0x62, 0x69, 0x8f, 0x3d, 0x62, 0x99, 0x8e, 0x40, 0xcd, 0x62, 0x25, 0x8d, 0x60, 0x45,
+			0x68, 0xbd, 0x86, 0xf1, 0x6f, 0x5d, 0x83, 0x40, 0x80, 0x80, 0x60, 0x69, 0x88, 0x80, 0x11, 0x90,
+			0x5d, 0x83, 0x40, 0x35, 0x9d, 0x25, 0x8d, 0x60, 0xbd, 0x9d, 0xcd, 0x8d, 0xc5, 0x9d, 0x99, 0x8e,
+			0x40, 0x35, 0x9d, 0xa0, 0x00, 0xf1, 0x96, 0x45, 0x96, 0x60, 0x69, 0x96, 0xcd, 0x96, 0x69, 0x95,
+			0xdd, 0x96, 0x40, 0xcd, 0x93, 0x79, 0x96, 0x00, 0x35, 0x8c, 0xcd, 0x90, 0x60, 0xcd, 0x8b, 0x81,
+			0x90, 0x99, 0x8b, 0x19, 0x90, 0x40, 0x69, 0x8b, 0x35, 0x8f, 0xe8, 0xf1, 0x85, 0x60, 0x99, 0x88,
+			0xdd, 0x84, 0xb5, 0x85, 0x71, 0x84, 0x40, 0x80, 0x88, 0x60, 0x35, 0x7d, 0x88, 0x4d, 0x7a, 0x71,
+			0x84, 0x40, 0x99, 0x74, 0xf1, 0x85, 0xe8, 0x35, 0x8f, 0x60, 0x99, 0x74, 0xb5, 0x8f, 0x69, 0x74,
+			0x19, 0x90, 0x40, 0xcd, 0x73, 0xcd, 0x90, 0x00, 0x31, 0x6c, 0x7d, 0x96, 0x61, 0x69, 0x6b, 0x11,
+			0x97, 0x8d, 0x6a, 0xae, 0xb5, 0x69, 0xf1, 0x96, 0x11, 0x69, 0x45, 0x96, 0xe2, 0x45, 0x70, 0x55,
+			0x87, 0x60, 0xbd, 0x6d, 0x99, 0x88, 0x69, 0x6b, 0x5d, 0x8a, 0x40, 0xf1, 0x66, 0x25, 0x8e, 0x01,
+			0xbd, 0x6a, 0x11, 0x92, 0x45, 0x70, 0x9c, 0xe8, 0x55, 0x87, 0xe2, 0x69, 0x8f, 0x11, 0x87, 0xe8,
+			0x99, 0x8d, 0x01, 0x35, 0x95, 0xa4, 0x11, 0x99, 0x25, 0x8e, 0x60, 0xf1, 0x96, 0xf1, 0x8b, 0x81,
+			0x94, 0x3d, 0x8a, 0x40, 0x69, 0x8f, 0x11, 0x87, 0xe2, 0x45, 0x70, 0x55, 0x87, 0xe2, 0x69, 0x8f,
+			0x11, 0x87, 0xe2, 0xf1, 0x7f, 0x25, 0x7d, 0x00, 0xdd, 0x71, 0xdd, 0x6e, 0xe8, 0x6e, 0xe6, 0xdd,
+			0x6d, 0xe8, 0x50, 0xe6, 0xdd, 0x7c, 0xe8, 0x58, 0xe6, 0x79, 0x74, 0x03, 0x80, 0x69, 0x77, 0x69,
+			0x8f, 0xf1, 0x67, 0x45, 0x92, 0xcd, 0x6a, 0xf1, 0x7f, 0x25, 0x7d, 0xe1,
+		}},
+		NotificationPhonePaused: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8a, 0x55, 0x7a, 0xe8, 0x50, 0xe6, 0xad,
+			0x8e, 0xe8, 0x55, 0x7a, 0xe6, 0xad, 0x8a, 0xe2, 0xa8, 0x55, 0x7a, 0xe8, 0x50, 0xe6, 0xb0, 0xe8,
+			0x55, 0x7a, 0xe6, 0xa8, 0xe2, 0xaa, 0xb0, 0x60, 0xdd, 0x8c, 0xb0, 0xd5, 0x84, 0xa8, 0x40, 0x69,
+			0x76, 0x99, 0x89, 0x60, 0x60, 0x35, 0x83, 0x58, 0x2d, 0x7b, 0x40, 0x50, 0x56, 0x60, 0x50, 0xb9,
+			0x69, 0xdd, 0x68, 0xdd, 0x68, 0x40, 0x56, 0x50, 0xe6, 0x55, 0x74, 0x61, 0x3d, 0x75, 0x50, 0xf5,
+			0x75, 0xa5, 0x68, 0xad, 0x76, 0x45, 0x69, 0xdd, 0x76, 0x55, 0x6a, 0x00, 0xad, 0x78, 0xbd, 0x72,
+			0x60, 0xcd, 0x78, 0xad, 0x73, 0xa5, 0x78, 0x71, 0x74, 0x40, 0xf1, 0x77, 0xbd, 0x75, 0x00, 0x45,
+			0x71, 0x79, 0x7c, 0x60, 0x6a, 0xad, 0x82, 0xa5, 0x79, 0x45, 0x87, 0x40, 0x25, 0x84, 0x11, 0x8f,
+			0x00, 0x79, 0x8a, 0x89, 0x88, 0x60, 0x25, 0x8b, 0xcd, 0x87, 0x98, 0x81, 0x87, 0x40, 0xbd, 0x8d,
+			0x69, 0x87, 0x00, 0xad, 0x95, 0x25, 0x89, 0x61, 0xb1, 0x96, 0x5d, 0x89, 0x59, 0x97, 0x25, 0x8a,
+			0xb0, 0xf1, 0x8a, 0xb0, 0x98, 0xe8, 0xaa, 0x60, 0xb0, 0x49, 0x96, 0x25, 0x97, 0x25, 0x97, 0x40,
+			0xaa, 0xb0, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0x01, 0xad, 0x74, 0x55, 0x73, 0x25, 0x73, 0x58, 0xe6,
+			0x58, 0x60, 0x58, 0x99, 0x6e, 0xcd, 0x6c, 0xb5, 0x71, 0x40, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd,
+			0x87, 0xa2, 0x60, 0x99, 0x8a, 0x45, 0x92, 0xcd, 0x8d, 0x11, 0x93, 0x40, 0xa8, 0xa8, 0xe8, 0xdd,
+			0x8c, 0x01, 0x25, 0x8d, 0x79, 0x8b, 0xdd, 0x87, 0xa2, 0xe2, 0x45, 0x6f, 0xcd, 0x78, 0xe2, 0xdd,
+			0x87, 0xa2, 0xe1,
+		}},
+		NotificationPower: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x7d, 0xa8, 0xe6, 0x89, 0x82, 0xe8, 0x89,
+			0x8e, 0x00, 0x98, 0x25, 0x84, 0xe8, 0x69, 0x77, 0xe6, 0x68, 0xe8, 0x25, 0x84, 0x00, 0x79, 0x7d,
+			0x85, 0x8e, 0xe8, 0xa8, 0xe2, 0x79, 0x79, 0xb0, 0xe8, 0x25, 0x90, 0x00, 0x60, 0xbd, 0x85, 0xe8,
+			0x69, 0x77, 0x60, 0x60, 0xc1, 0x75, 0x2d, 0x71, 0x95, 0x74, 0x40, 0x68, 0x69, 0x73, 0xe6, 0xcd,
+			0x78, 0x00, 0xcd, 0x76, 0x69, 0x75, 0xe8, 0x50, 0xe6, 0xcd, 0x7a, 0xe8, 0x69, 0x73, 0xe6, 0x35,
+			0x85, 0xe8, 0x50, 0xe6, 0x35, 0x89, 0xe8, 0x69, 0x75, 0x00, 0x35, 0x87, 0x69, 0x73, 0xe6, 0x98,
+			0x60, 0xa9, 0x8d, 0x69, 0x73, 0xd5, 0x8e, 0x95, 0x74, 0x40, 0xa0, 0x69, 0x77, 0xe8, 0xbd, 0x85,
+			0x00, 0x89, 0x86, 0x25, 0x90, 0xe8, 0xb0, 0xe6, 0x79, 0x79, 0xe2, 0x80, 0xad, 0x85, 0xe1,
+		}},
+		NotificationPriorityHigh: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xb0, 0x67, 0x11, 0x7e, 0xb0, 0xb5, 0x7c,
+			0xa1, 0x96, 0x55, 0x7b, 0x41, 0x95, 0x55, 0x7b, 0x55, 0x93, 0x55, 0x7b, 0x69, 0x91, 0xb5, 0x7c,
+			0x09, 0x90, 0x15, 0x7e, 0xad, 0x8e, 0x80, 0xad, 0x8e, 0xf1, 0x81, 0xad, 0x8e, 0x4d, 0x83, 0x0d,
+			0x90, 0xad, 0x84, 0x69, 0x91, 0xad, 0x84, 0x59, 0x93, 0xad, 0x84, 0x45, 0x95, 0x4d, 0x83, 0xa5,
+			0x96, 0xed, 0x81, 0xb0, 0x80, 0xb0, 0xe2, 0x55, 0x7b, 0x90, 0xe8, 0x50, 0xe6, 0xad, 0x84, 0xe8,
+			0x90, 0xe6, 0x55, 0x7b, 0xe1,
+		}},
+		NotificationRVHookup: NotificationRVHookup,
+		NotificationSDCard: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x79, 0xcd, 0x7c, 0xe6, 0x89, 0x7d, 0xe8,
+			0x55, 0x72, 0xe6, 0x89, 0x79, 0xe8, 0xcd, 0x7c, 0xe2, 0x79, 0x81, 0xcd, 0x7c, 0xe6, 0x79, 0x85,
+			0xe8, 0x55, 0x72, 0xe6, 0x79, 0x81, 0xe8, 0xcd, 0x7c, 0xe2, 0x69, 0x89, 0xcd, 0x7c, 0xe6, 0x69,
+			0x8d, 0xe8, 0x55, 0x72, 0xe6, 0x69, 0x89, 0xe8, 0xcd, 0x7c, 0xe2, 0xad, 0x6e, 0xad, 0x9a, 0x60,
+			0x11, 0x6d, 0xad, 0x9a, 0xdd, 0x6b, 0x79, 0x99, 0x40, 0xad, 0x6a, 0xad, 0x96, 0xe8, 0x45, 0x75,
+			0x00, 0x99, 0x7a, 0x55, 0x65, 0xe6, 0x55, 0x91, 0x60, 0xf1, 0x92, 0x55, 0x65, 0x25, 0x94, 0x89,
+			0x66, 0x40, 0x55, 0x95, 0x55, 0x69, 0xe8, 0xad, 0x96, 0x60, 0x55, 0x95, 0x45, 0x98, 0x25, 0x94,
+			0x79, 0x99, 0x40, 0x55, 0x91, 0xad, 0x9a, 0xe6, 0xad, 0x6e, 0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6,
+			0x55, 0x91, 0xe8, 0x55, 0x69, 0xe6, 0x69, 0x7c, 0x00, 0xad, 0x6e, 0x15, 0x77, 0xe8, 0xad, 0x96,
+			0xe2, 0xad, 0x6e, 0xad, 0x96, 0xe6, 0x55, 0x91, 0xe6, 0xad, 0x6e, 0xe1,
+		}},
+		NotificationSIMCardAlert: NotificationSIMCardAlert,
+		NotificationSMS: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x69, 0x74, 0x25, 0x7d, 0x60, 0x89, 0x75, 0x25,
+			0x7d, 0x4d, 0x76, 0x5d, 0x7c, 0x40, 0x11, 0x77, 0x79, 0x7a, 0x60, 0x11, 0x77, 0x55, 0x79, 0x4d,
+			0x76, 0x91, 0x78, 0x40, 0x69, 0x74, 0xcd, 0x77, 0x60, 0x45, 0x73, 0xcd, 0x77, 0x81, 0x72, 0x91,
+			0x78, 0x40, 0xbd, 0x71, 0x79, 0x7a, 0x60, 0xbd, 0x71, 0x99, 0x7b, 0x81, 0x72, 0x5d, 0x7c, 0x40,
+			0x69, 0x74, 0x25, 0x7d, 0xe2, 0x35, 0x80, 0x25, 0x7d, 0x60, 0x55, 0x81, 0x25, 0x7d, 0x19, 0x82,
+			0x5d, 0x7c, 0x40, 0xdd, 0x82, 0x79, 0x7a, 0x60, 0xdd, 0x82, 0x55, 0x79, 0x19, 0x82, 0x91, 0x78,
+			0x40, 0x35, 0x80, 0xcd, 0x77, 0x60, 0x11, 0x7f, 0xcd, 0x77, 0x4d, 0x7e, 0x91, 0x78, 0x40, 0x89,
+			0x7d, 0x79, 0x7a, 0x60, 0x89, 0x7d, 0x99, 0x7b, 0x4d, 0x7e, 0x5d, 0x7c, 0x40, 0x35, 0x80, 0x25,
+			0x7d, 0xe2, 0x89, 0x8b, 0x25, 0x7d, 0x60, 0xad, 0x8c, 0x25, 0x7d, 0x71, 0x8d, 0x5d, 0x7c, 0x40,
+			0x35, 0x8e, 0x79, 0x7a, 0x60, 0x35, 0x8e, 0x55, 0x79, 0x71, 0x8d, 0x91, 0x78, 0x40, 0x89, 0x8b,
+			0xcd, 0x77, 0x60, 0x69, 0x8a, 0xcd, 0x77, 0xa5, 0x89, 0x91, 0x78, 0x40, 0xdd, 0x88, 0x79, 0x7a,
+			0x60, 0xdd, 0x88, 0x99, 0x7b, 0xa5, 0x89, 0x5d, 0x7c, 0x40, 0x89, 0x8b, 0x25, 0x7d, 0xe2, 0x55,
+			0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd, 0x67, 0x89, 0x66, 0x89, 0x66, 0x40,
+			0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98, 0x55, 0x65, 0x79, 0x99, 0x89, 0x66,
+			0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a, 0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e,
+			0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad, 0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6,
+			0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55, 0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2,
+			0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		NotificationSMSFailed: NotificationSMSFailed,
+		NotificationSync: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x25, 0x6b, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6,
+			0xcd, 0x73, 0x00, 0xcd, 0x72, 0x89, 0x90, 0x60, 0x89, 0x6e, 0x25, 0x8d, 0x99, 0x6c, 0x25, 0x89,
+			0x40, 0xad, 0x6a, 0x35, 0x80, 0x60, 0xad, 0x6a, 0x25, 0x79, 0xd5, 0x6e, 0x81, 0x73, 0x40, 0xcd,
+			0x79, 0xbd, 0x6b, 0xe8, 0xdd, 0x6f, 0x60, 0xcd, 0x74, 0xcd, 0x71, 0xbd, 0x71, 0x4d, 0x76, 0x40,
+			0xad, 0x6e, 0x35, 0x80, 0x60, 0xad, 0x6e, 0x69, 0x84, 0x3d, 0x70, 0x81, 0x87, 0x40, 0x79, 0x74,
+			0xdd, 0x8c, 0x00, 0x79, 0x76, 0x45, 0x8e, 0xe8, 0x8c, 0xe6, 0x79, 0x7a, 0xe8, 0x55, 0x95, 0xe6,
+			0x25, 0x6b, 0xe2, 0x45, 0x86, 0x55, 0x94, 0xe8, 0x25, 0x90, 0x60, 0x55, 0x8b, 0x35, 0x8e, 0x55,
+			0x8e, 0xb5, 0x89, 0x40, 0x55, 0x91, 0xcd, 0x7f, 0x60, 0x55, 0x91, 0x99, 0x7c, 0xc5, 0x8f, 0x4d,
+			0x79, 0x40, 0xad, 0x8b, 0x79, 0x73, 0x00, 0xbd, 0x89, 0xbd, 0x71, 0xe8, 0x74, 0xe6, 0xbd, 0x85,
+			0xe8, 0xad, 0x6a, 0xe6, 0x11, 0x95, 0xe8, 0xad, 0x6e, 0xe6, 0x55, 0x8c, 0x00, 0x55, 0x8d, 0x99,
+			0x6f, 0x60, 0x55, 0x91, 0x55, 0x73, 0x55, 0x93, 0x99, 0x77, 0x40, 0x55, 0x95, 0xcd, 0x7f, 0x60,
+			0x55, 0x95, 0xdd, 0x86, 0x35, 0x91, 0x89, 0x8c, 0x40, 0x45, 0x86, 0x55, 0x94, 0xe1,
+		}},
+		NotificationSyncDisabled: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x95, 0x45, 0x9c, 0x00, 0x89, 0x8b, 0xdd,
+			0x91, 0x60, 0x35, 0x8a, 0xbd, 0x92, 0xf1, 0x88, 0x4d, 0x93, 0x40, 0x45, 0x86, 0x55, 0x94, 0xe8,
+			0x25, 0x90, 0x60, 0xdd, 0x86, 0xf1, 0x8f, 0x79, 0x87, 0xa5, 0x8f, 0x40, 0xad, 0x88, 0x9e, 0x00,
+			0x11, 0x71, 0x69, 0x77, 0x60, 0xcd, 0x6f, 0x79, 0x79, 0x3d, 0x6f, 0xc5, 0x7b, 0x40, 0xad, 0x6e,
+			0x35, 0x80, 0x60, 0xad, 0x6e, 0x11, 0x84, 0x19, 0x70, 0x3d, 0x87, 0x40, 0x79, 0x74, 0xdd, 0x8c,
+			0x00, 0x79, 0x76, 0x45, 0x8e, 0xe8, 0x8c, 0xe6, 0x79, 0x7a, 0xe8, 0x55, 0x95, 0xe6, 0x25, 0x6b,
+			0xe8, 0x55, 0x91, 0xe6, 0xcd, 0x73, 0x00, 0xcd, 0x72, 0x89, 0x90, 0x60, 0x45, 0x6e, 0xf1, 0x8c,
+			0x71, 0x6c, 0xdd, 0x88, 0x40, 0xad, 0x6a, 0x35, 0x80, 0x60, 0xbd, 0x6a, 0x99, 0x7c, 0xad, 0x6b,
+			0xbd, 0x79, 0x40, 0x35, 0x6e, 0x89, 0x74, 0x03, 0xcd, 0x63, 0x25, 0x6a, 0x89, 0x66, 0x69, 0x67,
+			0xad, 0x98, 0x89, 0x99, 0xf1, 0x95, 0x45, 0x9c, 0xe2, 0x25, 0x91, 0x89, 0x8c, 0x00, 0x55, 0x8e,
+			0xbd, 0x89, 0x60, 0xbd, 0x8f, 0x89, 0x87, 0x89, 0x90, 0x11, 0x85, 0x40, 0x55, 0x91, 0xcd, 0x7f,
+			0x60, 0x55, 0x91, 0xbd, 0x7c, 0xd5, 0x8f, 0x71, 0x79, 0x40, 0xad, 0x8b, 0x79, 0x73, 0x00, 0xbd,
+			0x89, 0xbd, 0x71, 0xe8, 0x74, 0xe6, 0xbd, 0x85, 0xe8, 0xad, 0x6a, 0xe6, 0x11, 0x95, 0xe8, 0xad,
+			0x6e, 0xe6, 0x55, 0x8c, 0x00, 0x55, 0x8d, 0x99, 0x6f, 0x60, 0x99, 0x91, 0x99, 0x73, 0x79, 0x93,
+			0xdd, 0x77, 0x40, 0x55, 0x95, 0xcd, 0x7f, 0x60, 0x55, 0x95, 0x69, 0x83, 0x4d, 0x94, 0x89, 0x86,
+			0x40, 0x25, 0x91, 0x89, 0x8c, 0xe2, 0x45, 0x76, 0xad, 0x71, 0x00, 0x89, 0x73, 0xf1, 0x6e, 0x60,
+			0x6a, 0xcd, 0x6d, 0x91, 0x76, 0x09, 0x6d, 0x40, 0xcd, 0x79, 0xbd, 0x6b, 0xe8, 0xdd, 0x6f, 0x60,
+			0xf1, 0x78, 0x35, 0x70, 0x09, 0x78, 0xad, 0x70, 0x40, 0x45, 0x76, 0xad, 0x71, 0xe1,
+		}},
+		NotificationSyncProblem: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xcd, 0x68, 0x55, 0x95, 0xe8, 0x55, 0x91, 0xe6,
+			0x62, 0x00, 0x11, 0x70, 0x69, 0x90, 0x60, 0x11, 0x6c, 0x69, 0x8c, 0x4d, 0x6a, 0x4d, 0x88, 0x40,
+			0x89, 0x68, 0x45, 0x80, 0x60, 0x89, 0x68, 0x72, 0xb5, 0x6c, 0x45, 0x73, 0x40, 0xad, 0x77, 0x55,
+			0x6b, 0xe8, 0x79, 0x6f, 0x60, 0x99, 0x72, 0x79, 0x71, 0x91, 0x6f, 0x11, 0x76, 0x40, 0x89, 0x6c,
+			0x45, 0x80, 0x60, 0x89, 0x6c, 0xbd, 0x83, 0xb5, 0x6d, 0xd5, 0x86, 0x40, 0xad, 0x71, 0x79, 0x8c,
+			0x00, 0xad, 0x73, 0x45, 0x8e, 0xe8, 0x79, 0x86, 0xe6, 0xad, 0x77, 0xe8, 0x55, 0x95, 0xe6, 0xcd,
+			0x68, 0xe2, 0x80, 0x35, 0x8d, 0x60, 0x35, 0x7f, 0x35, 0x8d, 0x99, 0x7e, 0x99, 0x8c, 0x40, 0x7c,
+			0x35, 0x8b, 0x60, 0x7c, 0x69, 0x8a, 0x99, 0x7e, 0xcd, 0x89, 0x40, 0x80, 0x35, 0x89, 0x60, 0xcd,
+			0x80, 0x35, 0x89, 0x69, 0x81, 0xcd, 0x89, 0x40, 0x84, 0x35, 0x8b, 0x60, 0x84, 0x98, 0x69, 0x81,
+			0x99, 0x8c, 0x40, 0x80, 0x35, 0x8d, 0xe2, 0x25, 0x7e, 0x55, 0x83, 0xe8, 0xbd, 0x72, 0xe6, 0x25,
+			0x82, 0xe8, 0x55, 0x83, 0xe6, 0x25, 0x7e, 0xe2, 0x55, 0x88, 0xbd, 0x94, 0xe8, 0x89, 0x90, 0x60,
+			0x69, 0x8d, 0x99, 0x8e, 0x71, 0x90, 0x94, 0x40, 0x79, 0x93, 0xcd, 0x7f, 0x60, 0x79, 0x93, 0x35,
+			0x7c, 0x45, 0x92, 0x19, 0x79, 0x40, 0x55, 0x8e, 0x89, 0x73, 0x00, 0x55, 0x8c, 0xbd, 0x71, 0xe8,
+			0x89, 0x79, 0xe6, 0x55, 0x88, 0xe8, 0xad, 0x6a, 0xe6, 0x35, 0x97, 0xe8, 0xad, 0x6e, 0xe6, 0xf1,
+			0x8e, 0x00, 0xf1, 0x8f, 0x99, 0x6f, 0x60, 0x11, 0x94, 0x69, 0x73, 0xc5, 0x95, 0x99, 0x77, 0x40,
+			0x79, 0x97, 0xcd, 0x7f, 0x60, 0x79, 0x97, 0x8e, 0x45, 0x93, 0xc5, 0x8c, 0x40, 0x55, 0x88, 0xbd,
+			0x94, 0xe1,
+		}},
+		NotificationSystemUpdate: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x71, 0x55, 0x9d, 0x60, 0xbd, 0x6f, 0x55,
+			0x9d, 0x89, 0x6e, 0x25, 0x9c, 0x40, 0x55, 0x6d, 0x55, 0x99, 0xe8, 0xad, 0x66, 0x60, 0x55, 0x6d,
+			0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad, 0x8e, 0x60, 0x45,
+			0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8, 0x55, 0x99, 0x60,
+			0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d, 0xe6, 0x55, 0x71,
+			0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe6, 0xad, 0x8e, 0xe8, 0x55, 0x97, 0xe6, 0x55,
+			0x71, 0xe2, 0x55, 0x71, 0x55, 0x93, 0xe6, 0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8,
+			0x55, 0x93, 0xe2, 0x80, 0xcd, 0x8a, 0x02, 0xad, 0x75, 0x79, 0x80, 0x89, 0x78, 0x99, 0x7d, 0x7c,
+			0x11, 0x83, 0xe8, 0x25, 0x76, 0xe6, 0x84, 0xe8, 0x11, 0x83, 0x02, 0x79, 0x87, 0x99, 0x7d, 0x55,
+			0x8a, 0x79, 0x80, 0x80, 0xcd, 0x8a, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e, 0xe8, 0xad,
+			0x66, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad, 0x66, 0xe8,
+			0xad, 0x68, 0xe2, 0x55, 0x71, 0x55, 0x97, 0xe8, 0x55, 0x99, 0xe8, 0x55, 0x97, 0xe1,
+		}},
+		NotificationTapAndPlay: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x8b, 0x55, 0x9d, 0xe8, 0x55, 0x99, 0xe6,
+			0xad, 0x8e, 0xe8, 0xad, 0x6c, 0xe6, 0x55, 0x71, 0xe8, 0x7e, 0xe6, 0x55, 0x6d, 0xe8, 0xad, 0x66,
+			0x60, 0x55, 0x6d, 0x11, 0x65, 0x89, 0x6e, 0xdd, 0x63, 0x40, 0x55, 0x71, 0xad, 0x62, 0xe6, 0xad,
+			0x8e, 0x60, 0x45, 0x90, 0xad, 0x62, 0x79, 0x91, 0xdd, 0x63, 0x40, 0xad, 0x92, 0xad, 0x66, 0xe8,
+			0x55, 0x99, 0x60, 0xad, 0x92, 0xf1, 0x9a, 0x79, 0x91, 0x25, 0x9c, 0x40, 0xad, 0x8e, 0x55, 0x9d,
+			0xe6, 0xad, 0x8b, 0xe2, 0x55, 0x6d, 0x55, 0x9d, 0xe8, 0xa6, 0x60, 0xad, 0x71, 0xa6, 0xad, 0x74,
+			0xac, 0x40, 0xad, 0x77, 0x55, 0x9d, 0xe6, 0x55, 0x6d, 0xe2, 0xad, 0x7b, 0x55, 0x9d, 0x60, 0xad,
+			0x7b, 0x55, 0x97, 0x81, 0x77, 0x2d, 0x93, 0x40, 0x55, 0x6d, 0x9e, 0xe8, 0x96, 0x61, 0xf9, 0x74,
+			0x96, 0x51, 0x7a, 0x59, 0x90, 0xad, 0x7f, 0xb1, 0x95, 0xad, 0x7f, 0x55, 0x9d, 0xe6, 0xad, 0x7b,
+			0xe2, 0xad, 0x83, 0x55, 0x9d, 0x61, 0xad, 0x83, 0xa8, 0x2d, 0x7d, 0x81, 0x8d, 0xb1, 0x76, 0x8e,
+			0x55, 0x6d, 0x8e, 0xe8, 0x86, 0x63, 0xcd, 0x72, 0x86, 0x9d, 0x77, 0x15, 0x85, 0x69, 0x7c, 0x25,
+			0x87, 0xf9, 0x7f, 0xb5, 0x8a, 0x85, 0x83, 0x41, 0x8e, 0x99, 0x85, 0x11, 0x93, 0xad, 0x87, 0xe1,
+			0x97, 0xad, 0x87, 0x55, 0x9d, 0xe6, 0xad, 0x83, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe6, 0xad, 0x8e,
+			0xe8, 0xad, 0x66, 0xe6, 0x55, 0x71, 0xe8, 0xad, 0x68, 0xe2, 0x55, 0x71, 0xad, 0x68, 0xe8, 0xad,
+			0x66, 0xe8, 0xad, 0x68, 0xe1,
+		}},
+		NotificationTimeToLeave: NotificationTimeToLeave,
+		NotificationVPNLock: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x11, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad,
+			0x9a, 0x99, 0x75, 0x99, 0x98, 0x40, 0x19, 0x6d, 0xf1, 0x92, 0x60, 0x79, 0x69, 0x55, 0x8f, 0x69,
+			0x67, 0x79, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x69, 0x7a, 0x69, 0x67, 0x89, 0x75,
+			0x40, 0x09, 0x6d, 0x11, 0x6d, 0x60, 0x99, 0x70, 0x79, 0x69, 0x5d, 0x75, 0x69, 0x67, 0x40, 0x89,
+			0x7f, 0x55, 0x65, 0x60, 0x89, 0x82, 0x55, 0x65, 0x99, 0x85, 0xe9, 0x65, 0x40, 0x45, 0x8a, 0x35,
+			0x67, 0xe8, 0x58, 0x60, 0x45, 0x8a, 0x45, 0x6e, 0x81, 0x88, 0x09, 0x70, 0x40, 0x79, 0x84, 0xcd,
+			0x71, 0xe6, 0xdd, 0x7c, 0xe8, 0x25, 0x77, 0x60, 0xdd, 0x7c, 0x69, 0x78, 0xf9, 0x7b, 0x4d, 0x79,
+			0x40, 0xcd, 0x79, 0x35, 0x7a, 0xe6, 0x79, 0x74, 0xe8, 0x80, 0xe6, 0x55, 0x85, 0x60, 0xad, 0x86,
+			0x80, 0x91, 0x87, 0xe9, 0x80, 0x40, 0x79, 0x88, 0x25, 0x83, 0xe8, 0x99, 0x8b, 0xe6, 0x25, 0x8b,
+			0x60, 0x11, 0x8d, 0x99, 0x8b, 0x91, 0x8e, 0xad, 0x8c, 0x40, 0x99, 0x90, 0x79, 0x8f, 0x60, 0x99,
+			0x93, 0x11, 0x8c, 0x25, 0x95, 0x2d, 0x88, 0x40, 0xad, 0x96, 0x45, 0x80, 0x60, 0xad, 0x96, 0x45,
+			0x7f, 0x99, 0x96, 0xd5, 0x7d, 0x40, 0x69, 0x96, 0x89, 0x7b, 0xe6, 0x69, 0x9a, 0x60, 0x89, 0x9a,
+			0x35, 0x7c, 0x99, 0x9a, 0x79, 0x7d, 0x40, 0xad, 0x9a, 0xf1, 0x7f, 0x60, 0xad, 0x9a, 0x89, 0x85,
+			0x99, 0x98, 0x71, 0x8a, 0x40, 0xf1, 0x92, 0xf1, 0x92, 0x60, 0x55, 0x8f, 0x89, 0x96, 0x81, 0x8a,
+			0x99, 0x98, 0x40, 0x11, 0x80, 0xad, 0x9a, 0xe2, 0xdd, 0x7c, 0xae, 0xe8, 0xf1, 0x90, 0x60, 0xad,
+			0x7a, 0xf1, 0x90, 0x19, 0x79, 0x5d, 0x8f, 0x40, 0x89, 0x77, 0x99, 0x8b, 0xe8, 0xf1, 0x88, 0x00,
+			0xcd, 0x69, 0x35, 0x7b, 0x60, 0x89, 0x69, 0xbd, 0x7c, 0x71, 0x69, 0xb5, 0x7d, 0x40, 0x55, 0x69,
+			0xcd, 0x7f, 0x60, 0x55, 0x69, 0xbd, 0x88, 0xdd, 0x6e, 0x45, 0x8f, 0x40, 0xdd, 0x7c, 0xae, 0xe2,
+			0x45, 0x90, 0x89, 0x77, 0x60, 0x69, 0x8f, 0x89, 0x77, 0xd5, 0x8e, 0xf9, 0x76, 0x40, 0x45, 0x8e,
+			0x89, 0x75, 0xe8, 0xcd, 0x6b, 0x60, 0x45, 0x8e, 0xf1, 0x6a, 0xd5, 0x8e, 0x5d, 0x6a, 0x40, 0x45,
+			0x90, 0xcd, 0x69, 0xe6, 0x55, 0x91, 0xe8, 0x25, 0x67, 0x60, 0x55, 0x91, 0xf1, 0x64, 0xd5, 0x92,
+			0x55, 0x63, 0x40, 0x79, 0x96, 0xbd, 0x61, 0x60, 0x99, 0x98, 0xbd, 0x61, 0x19, 0x9a, 0x55, 0x63,
+			0x40, 0x99, 0x9b, 0x25, 0x67, 0xe8, 0xcd, 0x69, 0xe6, 0xbd, 0x9c, 0x60, 0x99, 0x9d, 0xcd, 0x69,
+			0x2d, 0x9e, 0x5d, 0x6a, 0x40, 0xbd, 0x9e, 0xcd, 0x6b, 0xe8, 0x89, 0x75, 0x60, 0xbd, 0x9e, 0x69,
+			0x76, 0x2d, 0x9e, 0xf9, 0x76, 0x40, 0xbd, 0x9c, 0x89, 0x77, 0xe6, 0x45, 0x90, 0xe2, 0x99, 0x93,
+			0xcd, 0x69, 0xe6, 0x55, 0x99, 0xe8, 0x25, 0x67, 0x60, 0x55, 0x99, 0xdd, 0x65, 0x89, 0x98, 0xf1,
+			0x64, 0x40, 0x79, 0x96, 0x48, 0x60, 0x35, 0x95, 0x48, 0x69, 0x94, 0xf1, 0x64, 0x40, 0x99, 0x93,
+			0x25, 0x67, 0xe8, 0xcd, 0x69, 0xe1,
+		}},
+		NotificationVibration: NotificationVibration,
+		NotificationVoiceChat: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0xad, 0x7c, 0xe6, 0x55, 0x73, 0xe8,
+			0xad, 0x78, 0xe6, 0xad, 0x70, 0xe8, 0xad, 0x7c, 0xe2, 0x55, 0x77, 0x84, 0xe6, 0x74, 0xe8, 0x55,
+			0x73, 0xe6, 0x55, 0x77, 0xe8, 0x84, 0xe2, 0xad, 0x7e, 0x55, 0x87, 0xe6, 0x55, 0x81, 0xe8, 0x5c,
+			0xe6, 0xad, 0x7e, 0xe8, 0x55, 0x87, 0xe2, 0x8c, 0x84, 0xe6, 0xad, 0x88, 0xe8, 0x55, 0x73, 0xe6,
+			0x8c, 0xe8, 0x84, 0xe2, 0xad, 0x8c, 0xad, 0x7c, 0xe6, 0x55, 0x8f, 0xe8, 0xad, 0x78, 0xe6, 0xad,
+			0x8c, 0xe8, 0xad, 0x7c, 0xe2, 0x55, 0x65, 0xad, 0x9a, 0xe8, 0x55, 0x69, 0x60, 0x55, 0x65, 0xbd,
+			0x67, 0x89, 0x66, 0x89, 0x66, 0x40, 0x55, 0x69, 0x55, 0x65, 0xe6, 0xad, 0x96, 0x60, 0x45, 0x98,
+			0x55, 0x65, 0x79, 0x99, 0x89, 0x66, 0x40, 0xad, 0x9a, 0x55, 0x69, 0xe8, 0x98, 0x60, 0xad, 0x9a,
+			0x99, 0x8d, 0x79, 0x99, 0xcd, 0x8e, 0x40, 0xad, 0x96, 0xa0, 0xe6, 0x60, 0x00, 0x55, 0x65, 0xad,
+			0x9a, 0xe2, 0x45, 0x6e, 0x98, 0xe6, 0xad, 0x96, 0xe8, 0x55, 0x69, 0xe6, 0x55, 0x69, 0xe8, 0x55,
+			0x91, 0x00, 0x45, 0x6e, 0x98, 0xe2, 0x55, 0x69, 0x98, 0xe8, 0x55, 0x69, 0xe8, 0x98, 0xe1,
+		}},
+		NotificationWC: NotificationWC,
+		NotificationWiFi: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x6f, 0x35, 0x88, 0x00, 0x45, 0x6b, 0x88,
+			0x60, 0xf1, 0x6f, 0x55, 0x7f, 0x09, 0x75, 0x7a, 0x40, 0x80, 0xad, 0x7a, 0x60, 0xdd, 0x85, 0xad,
+			0x7a, 0xf9, 0x8a, 0x7a, 0x40, 0xbd, 0x94, 0x88, 0x00, 0x89, 0x90, 0x35, 0x88, 0x60, 0x79, 0x8c,
+			0x25, 0x84, 0x55, 0x88, 0x69, 0x82, 0x40, 0x80, 0xad, 0x80, 0x60, 0xcd, 0x7b, 0xad, 0x80, 0xad,
+			0x77, 0x69, 0x82, 0x40, 0x79, 0x6f, 0x35, 0x88, 0xe2, 0x35, 0x64, 0xf1, 0x7c, 0x00, 0x40, 0xbd,
+			0x78, 0x60, 0x35, 0x66, 0x69, 0x72, 0x71, 0x6e, 0x89, 0x6e, 0x40, 0x80, 0xad, 0x6a, 0x60, 0x55,
+			0x89, 0xad, 0x6a, 0x91, 0x91, 0x89, 0x6e, 0x40, 0xc0, 0xbd, 0x78, 0x00, 0xcd, 0x9b, 0xf1, 0x7c,
+			0x60, 0xf1, 0x95, 0x55, 0x77, 0xf9, 0x8e, 0x68, 0x40, 0x80, 0xad, 0x70, 0x60, 0x70, 0xad, 0x70,
+			0x09, 0x71, 0x68, 0x40, 0x35, 0x64, 0xf1, 0x7c, 0xe2, 0x80, 0xbd, 0x98, 0x00, 0xdd, 0x89, 0xcd,
+			0x8e, 0x60, 0xf1, 0x87, 0xdd, 0x8c, 0x71, 0x85, 0xc5, 0x8b, 0x40, 0x80, 0xad, 0x8a, 0x60, 0x11,
+			0x7d, 0xad, 0x8a, 0x91, 0x7a, 0xc5, 0x8b, 0x40, 0x25, 0x76, 0xcd, 0x8e, 0x00, 0x80, 0xbd, 0x98,
+			0xe1,
+		}},
+		PlacesACUnit:         PlacesACUnit,
+		PlacesAirportShuttle: PlacesAirportShuttle,
+		PlacesAllInclusive:   PlacesAllInclusive,
+		PlacesBeachAccess:    PlacesBeachAccess,
+		PlacesBusinessCenter: PlacesBusinessCenter,
+		PlacesCasino:         PlacesCasino,
+		PlacesChildCare:      PlacesChildCare,
+		PlacesChildFriendly:  PlacesChildFriendly,
+		PlacesFitnessCenter:  PlacesFitnessCenter,
+		PlacesFreeBreakfast:  PlacesFreeBreakfast,
+		PlacesGolfCourse:     PlacesGolfCourse,
+		PlacesHotTub:         PlacesHotTub,
+		PlacesKitchen:        PlacesKitchen,
+		PlacesPool:           PlacesPool,
+		PlacesRVHookup:       PlacesRVHookup,
+		PlacesRoomService:    PlacesRoomService,
+		PlacesSmokeFree:      PlacesSmokeFree,
+		PlacesSmokingRooms:   PlacesSmokingRooms,
+		PlacesSpa:            PlacesSpa,
+		SocialCake: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xad, 0x9a, 0x60, 0x89, 0x69, 0xad,
+			0x9a, 0xc5, 0x68, 0xe9, 0x99, 0x40, 0x50, 0xb0, 0xe8, 0x69, 0x89, 0x60, 0x50, 0xc1, 0x87, 0x2d,
+			0x69, 0x95, 0x86, 0x40, 0x58, 0x69, 0x85, 0xe6, 0xcd, 0x6d, 0xe8, 0x45, 0x79, 0x60, 0xcd, 0x6d,
+			0x9d, 0x77, 0xf9, 0x6e, 0x71, 0x76, 0x40, 0xcd, 0x71, 0x45, 0x75, 0xe6, 0x7c, 0xe8, 0x62, 0x60,
+			0xad, 0x7c, 0x11, 0x70, 0xf9, 0x7b, 0xf9, 0x6e, 0x40, 0x45, 0x7b, 0x51, 0x6c, 0x61, 0x45, 0x7b,
+			0x55, 0x6b, 0xa5, 0x7b, 0x71, 0x6a, 0x78, 0x89, 0x69, 0xad, 0x7c, 0xdd, 0x68, 0x01, 0x80, 0x55,
+			0x65, 0x55, 0x83, 0xdd, 0x68, 0x60, 0x88, 0x89, 0x69, 0x69, 0x84, 0x71, 0x6a, 0x40, 0xcd, 0x84,
+			0x51, 0x6c, 0x61, 0xcd, 0x84, 0xdd, 0x6d, 0x11, 0x84, 0xf9, 0x6e, 0x55, 0x83, 0x11, 0x70, 0x84,
+			0x62, 0xe8, 0x45, 0x75, 0xe6, 0x35, 0x8e, 0x60, 0xd9, 0x8f, 0x45, 0x75, 0x09, 0x91, 0x71, 0x76,
+			0x40, 0x35, 0x92, 0x45, 0x79, 0xe8, 0x69, 0x85, 0xe6, 0xa8, 0x60, 0xa9, 0x95, 0x69, 0x85, 0xd5,
+			0x96, 0x95, 0x86, 0x40, 0xb0, 0x69, 0x89, 0xe8, 0xb0, 0x60, 0xb0, 0x25, 0x99, 0x3d, 0x97, 0xe9,
+			0x99, 0x40, 0x55, 0x95, 0xad, 0x9a, 0xe6, 0xad, 0x6a, 0xe2, 0xcd, 0x71, 0x69, 0x85, 0xe6, 0x35,
+			0x8e, 0xe8, 0x45, 0x79, 0xe6, 0xcd, 0x71, 0xe8, 0x69, 0x85, 0xe2, 0x58, 0xad, 0x96, 0xe6, 0xa8,
+			0xe8, 0x69, 0x89, 0xe6, 0x58, 0xe8, 0xad, 0x96, 0xe2, 0xcd, 0x71, 0x69, 0x85, 0xe6, 0x35, 0x8e,
+			0xe6, 0xcd, 0x71, 0xe2, 0x58, 0xad, 0x96, 0xe6, 0xa8, 0xe6, 0x58, 0xe2, 0xa8, 0x69, 0x85, 0xe6,
+			0x58, 0xe6, 0xa8, 0xe1,
+		}},
+		SocialDomain: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x55, 0x65, 0xb0, 0xe8, 0x50, 0xe6, 0x55, 0x7f,
+			0xe8, 0x66, 0xe6, 0xad, 0x9a, 0xe8, 0xb0, 0xe6, 0x55, 0x65, 0xe2, 0x55, 0x69, 0xa8, 0xe6, 0x55,
+			0x70, 0xe8, 0x9a, 0xe6, 0x55, 0x69, 0xe8, 0xa8, 0xe2, 0x55, 0x69, 0x92, 0xe6, 0x55, 0x70, 0xe8,
+			0x84, 0xe6, 0x55, 0x69, 0xe8, 0x92, 0xe2, 0x55, 0x69, 0x7c, 0xe6, 0x55, 0x70, 0xe8, 0x6e, 0xe6,
+			0x55, 0x69, 0xe8, 0x7c, 0xe2, 0x55, 0x69, 0x66, 0xe6, 0x55, 0x70, 0xe8, 0x58, 0xe6, 0x55, 0x69,
+			0xe8, 0x66, 0xe2, 0x55, 0x74, 0xa8, 0xe6, 0x55, 0x7b, 0xe8, 0x9a, 0xe6, 0x55, 0x74, 0xe8, 0xa8,
+			0xe2, 0x55, 0x74, 0x92, 0xe6, 0x55, 0x7b, 0xe8, 0x84, 0xe6, 0x55, 0x74, 0xe8, 0x92, 0xe2, 0x55,
+			0x74, 0x7c, 0xe6, 0x55, 0x7b, 0xe8, 0x6e, 0xe6, 0x55, 0x74, 0xe8, 0x7c, 0xe2, 0x55, 0x74, 0x66,
+			0xe6, 0x55, 0x7b, 0xe8, 0x58, 0xe6, 0x55, 0x74, 0xe8, 0x66, 0xe2, 0x55, 0x7f, 0xa8, 0xe6, 0xad,
+			0x96, 0xe8, 0x6e, 0xe6, 0x55, 0x7f, 0xe8, 0x7c, 0xe6, 0xad, 0x84, 0xe8, 0x84, 0xe6, 0x55, 0x7f,
+			0xe8, 0x92, 0xe6, 0xad, 0x84, 0xe8, 0x9a, 0xe6, 0x55, 0x7f, 0xe8, 0xa8, 0xe2, 0xad, 0x8b, 0x84,
+			0xe8, 0x7c, 0xe6, 0xad, 0x8f, 0xe8, 0x84, 0xe6, 0xad, 0x8b, 0xe2, 0xad, 0x8b, 0x9a, 0xe8, 0x92,
+			0xe6, 0xad, 0x8f, 0xe8, 0x9a, 0xe6, 0xad, 0x8b, 0xe1,
+		}},
+		SocialGroup: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x62, 0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60,
+			0x89, 0x62, 0xbd, 0x8c, 0xbd, 0x63, 0xd5, 0x8a, 0x40, 0x11, 0x67, 0x90, 0x60, 0xf1, 0x6b, 0xdd,
+			0x85, 0xd5, 0x6f, 0xf1, 0x84, 0x40, 0xdd, 0x77, 0x88, 0x60, 0x78, 0x88, 0xdd, 0x7f, 0xf1, 0x84,
+			0x40, 0x99, 0x88, 0x90, 0x60, 0xbd, 0x8a, 0xf1, 0x88, 0xf9, 0x8b, 0xd5, 0x8a, 0x40, 0x35, 0x8d,
+			0x11, 0x8f, 0xe8, 0x55, 0x95, 0xe6, 0x89, 0x62, 0xe2, 0x35, 0x91, 0x55, 0x95, 0xe8, 0x11, 0x8f,
+			0x60, 0x35, 0x91, 0xdd, 0x8a, 0x11, 0x8f, 0x2d, 0x88, 0x40, 0x79, 0x89, 0xcd, 0x83, 0x60, 0x11,
+			0x8e, 0x55, 0x84, 0x25, 0x92, 0x5d, 0x85, 0x40, 0xbd, 0x98, 0xbd, 0x87, 0x60, 0xf1, 0x9a, 0x92,
+			0x35, 0x9c, 0xdd, 0x8a, 0x40, 0x79, 0x9d, 0x11, 0x8f, 0xe8, 0x55, 0x95, 0xe6, 0x35, 0x91, 0xe2,
+			0xdd, 0x77, 0xf1, 0x7f, 0x60, 0x79, 0x73, 0xf1, 0x7f, 0xad, 0x70, 0x25, 0x7d, 0x40, 0xdd, 0x6d,
+			0xf1, 0x75, 0x60, 0xdd, 0x6d, 0x89, 0x71, 0xad, 0x70, 0xbd, 0x6e, 0x40, 0xdd, 0x77, 0xf1, 0x6b,
+			0x60, 0x45, 0x7c, 0xf1, 0x6b, 0x11, 0x7f, 0xbd, 0x6e, 0x40, 0xdd, 0x81, 0xf1, 0x75, 0x60, 0xdd,
+			0x81, 0x55, 0x7a, 0x11, 0x7f, 0x25, 0x7d, 0x40, 0xdd, 0x77, 0xf1, 0x7f, 0xe2, 0xdd, 0x8f, 0xf1,
+			0x75, 0x60, 0xdd, 0x8f, 0x55, 0x7a, 0x11, 0x8d, 0x25, 0x7d, 0x40, 0xdd, 0x85, 0xf1, 0x7f, 0x60,
+			0x25, 0x85, 0xf1, 0x7f, 0x3d, 0x84, 0xd5, 0x7f, 0x40, 0x99, 0x82, 0x79, 0x7f, 0x60, 0x35, 0x84,
+			0xcd, 0x7d, 0x09, 0x85, 0x5d, 0x7b, 0x40, 0xdd, 0x85, 0xf1, 0x75, 0x60, 0xdd, 0x85, 0xf1, 0x72,
+			0x09, 0x85, 0xa5, 0x70, 0x40, 0x99, 0x82, 0x69, 0x6c, 0x60, 0x55, 0x83, 0x35, 0x6c, 0x3d, 0x84,
+			0x11, 0x6c, 0x40, 0xdd, 0x85, 0xf1, 0x6b, 0x60, 0x45, 0x8a, 0xf1, 0x6b, 0x11, 0x8d, 0xbd, 0x6e,
+			0x40, 0xdd, 0x8f, 0xf1, 0x75, 0xe2, 0x89, 0x66, 0x55, 0x91, 0xe6, 0x35, 0x89, 0xe8, 0x11, 0x8f,
+			0x60, 0x35, 0x89, 0x9c, 0x91, 0x88, 0x9a, 0x40, 0x8e, 0x99, 0x8b, 0x60, 0x35, 0x82, 0x79, 0x89,
+			0xf1, 0x7e, 0xbd, 0x88, 0x40, 0xdd, 0x77, 0x90, 0x60, 0x11, 0x74, 0x90, 0xc5, 0x70, 0xbd, 0x88,
+			0x40, 0xad, 0x68, 0x99, 0x8b, 0x60, 0xbd, 0x67, 0x98, 0x25, 0x67, 0x9a, 0x40, 0x89, 0x66, 0x11,
+			0x8f, 0xe8, 0x55, 0x91, 0xe2, 0xdd, 0x77, 0xf1, 0x7b, 0x60, 0x79, 0x7a, 0xf1, 0x7b, 0x2d, 0x7c,
+			0x3d, 0x7a, 0x40, 0xdd, 0x7d, 0xf1, 0x75, 0x60, 0xdd, 0x7d, 0x55, 0x73, 0x2d, 0x7c, 0xa5, 0x71,
+			0x40, 0xdd, 0x77, 0xf1, 0x6f, 0x60, 0x45, 0x75, 0xf1, 0x6f, 0x91, 0x73, 0xa5, 0x71, 0x40, 0xdd,
+			0x71, 0xf1, 0x75, 0x60, 0xdd, 0x71, 0x89, 0x78, 0x91, 0x73, 0x3d, 0x7a, 0x40, 0xdd, 0x77, 0xf1,
+			0x7b, 0xe2, 0xdd, 0x77, 0x55, 0x91, 0xe2, 0xdd, 0x77, 0xf1, 0x75, 0xe1,
+		}},
+		SocialGroupAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x99, 0x7f, 0x99, 0x7f, 0x60, 0x55, 0x81, 0x79,
+			0x7d, 0x2d, 0x82, 0x35, 0x7b, 0x40, 0x86, 0xf1, 0x75, 0x60, 0x86, 0xf1, 0x72, 0x2d, 0x82, 0xad,
+			0x70, 0x40, 0x99, 0x7f, 0x45, 0x6c, 0x60, 0xad, 0x84, 0x25, 0x6b, 0x81, 0x88, 0xcd, 0x6d, 0x40,
+			0x55, 0x8c, 0xf1, 0x75, 0x60, 0x55, 0x8c, 0x69, 0x7b, 0x81, 0x88, 0x11, 0x7e, 0x40, 0x99, 0x7f,
+			0x99, 0x7f, 0xe2, 0x9c, 0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60, 0x9c, 0xad, 0x8b, 0x45, 0x8c, 0xbd,
+			0x88, 0x40, 0x45, 0x86, 0xcd, 0x83, 0x60, 0xcd, 0x91, 0x45, 0x85, 0x09, 0x96, 0x11, 0x88, 0x40,
+			0x45, 0x9a, 0x11, 0x8f, 0xe8, 0x55, 0x95, 0xe6, 0x9c, 0xe2, 0x55, 0x95, 0x11, 0x82, 0xe8, 0x69,
+			0x7b, 0xe6, 0xad, 0x8e, 0xe8, 0x69, 0x77, 0xe6, 0x55, 0x95, 0xe8, 0xbd, 0x70, 0xe6, 0x55, 0x99,
+			0xe8, 0x69, 0x77, 0xe6, 0xc0, 0xe8, 0x69, 0x7b, 0xe6, 0x55, 0x99, 0xe8, 0x11, 0x82, 0xe6, 0x55,
+			0x95, 0xe2, 0x6a, 0xf1, 0x7f, 0x60, 0x99, 0x70, 0xf1, 0x7f, 0xcd, 0x6d, 0x25, 0x7d, 0x40, 0x56,
+			0xf1, 0x75, 0x60, 0x56, 0x89, 0x71, 0xcd, 0x6d, 0xbd, 0x6e, 0x40, 0x6a, 0xf1, 0x6b, 0x60, 0x69,
+			0x79, 0xf1, 0x6b, 0x35, 0x7c, 0xbd, 0x6e, 0x40, 0x7e, 0xf1, 0x75, 0x60, 0x7e, 0x55, 0x7a, 0x35,
+			0x7c, 0x25, 0x7d, 0x40, 0x6a, 0xf1, 0x7f, 0xe2, 0x40, 0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60, 0x40,
+			0xbd, 0x8c, 0x3d, 0x61, 0xd5, 0x8a, 0x40, 0x89, 0x64, 0x90, 0x60, 0x55, 0x69, 0xdd, 0x85, 0x19,
+			0x6d, 0xf1, 0x84, 0x40, 0x6a, 0x88, 0x60, 0x25, 0x79, 0x88, 0xdd, 0x7c, 0xf1, 0x84, 0x40, 0x69,
+			0x85, 0x90, 0x60, 0x79, 0x87, 0xf1, 0x88, 0xbd, 0x88, 0xd5, 0x8a, 0x40, 0x94, 0x11, 0x8f, 0xe8,
+			0x55, 0x95, 0xe6, 0x40, 0xe2, 0x6a, 0xf1, 0x7b, 0x60, 0x99, 0x77, 0xf1, 0x7b, 0x4d, 0x79, 0x3d,
+			0x7a, 0x40, 0x76, 0xf1, 0x75, 0x60, 0x76, 0x55, 0x73, 0x4d, 0x79, 0xa5, 0x71, 0x40, 0x6a, 0xf1,
+			0x6f, 0x60, 0x69, 0x72, 0xf1, 0x6f, 0xb5, 0x70, 0xa5, 0x71, 0x40, 0x5e, 0xf1, 0x75, 0x60, 0x5e,
+			0x89, 0x78, 0xb5, 0x70, 0x3d, 0x7a, 0x40, 0x6a, 0xf1, 0x7b, 0xe2, 0x48, 0x55, 0x91, 0xe6, 0x8c,
+			0xe8, 0x11, 0x8f, 0x60, 0x8c, 0x9c, 0x79, 0x85, 0x11, 0x8d, 0x40, 0xcd, 0x83, 0x99, 0x8b, 0x60,
+			0x35, 0x7f, 0x79, 0x89, 0x78, 0xbd, 0x88, 0x40, 0x6a, 0x90, 0x60, 0x35, 0x71, 0x90, 0x09, 0x6e,
+			0xbd, 0x88, 0x40, 0x25, 0x66, 0x99, 0x8b, 0x60, 0x25, 0x65, 0x11, 0x8c, 0x91, 0x64, 0x09, 0x8d,
+			0x40, 0x48, 0x11, 0x8f, 0xe8, 0x55, 0x91, 0xe2, 0x6a, 0xf1, 0x75, 0xe2, 0x6a, 0x55, 0x91, 0xe1,
+		}},
+		SocialLocationCity: SocialLocationCity,
+		SocialMood: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x89, 0x79, 0x7c, 0x60, 0x3d, 0x8b, 0x79,
+			0x7c, 0x49, 0x8c, 0x6d, 0x7b, 0x40, 0x55, 0x8d, 0xdd, 0x78, 0x60, 0x55, 0x8d, 0x5d, 0x77, 0x49,
+			0x8c, 0x51, 0x76, 0x40, 0xbd, 0x89, 0x45, 0x75, 0x60, 0x3d, 0x88, 0x45, 0x75, 0x31, 0x87, 0x51,
+			0x76, 0x40, 0x25, 0x86, 0xdd, 0x78, 0x60, 0x25, 0x86, 0x5d, 0x7a, 0x31, 0x87, 0x6d, 0x7b, 0x40,
+			0xbd, 0x89, 0x79, 0x7c, 0xe2, 0x45, 0x76, 0x79, 0x7c, 0x60, 0xc5, 0x77, 0x79, 0x7c, 0xd1, 0x78,
+			0x6d, 0x7b, 0x40, 0xdd, 0x79, 0xdd, 0x78, 0x60, 0xdd, 0x79, 0x5d, 0x77, 0xd1, 0x78, 0x51, 0x76,
+			0x40, 0x45, 0x76, 0x45, 0x75, 0x60, 0xc5, 0x74, 0x45, 0x75, 0xb9, 0x73, 0x51, 0x76, 0x40, 0xad,
+			0x72, 0xdd, 0x78, 0x60, 0xad, 0x72, 0x5d, 0x7a, 0xb9, 0x73, 0x6d, 0x7b, 0x40, 0x45, 0x76, 0x79,
+			0x7c, 0xe2, 0x80, 0x99, 0x8e, 0x60, 0x69, 0x84, 0x99, 0x8e, 0x19, 0x88, 0x3d, 0x8c, 0x40, 0x79,
+			0x8d, 0xcd, 0x85, 0xe6, 0x89, 0x72, 0x60, 0x45, 0x74, 0xdd, 0x89, 0xf1, 0x77, 0x3d, 0x8c, 0x40,
+			0x80, 0x99, 0x8e, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98,
+			0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55,
+			0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d,
+			0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85,
+			0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70,
+			0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69,
+			0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad, 0x96, 0x15,
+			0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed, 0x6f, 0x60,
+			0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55, 0x69, 0x85,
+			0x76, 0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96, 0x80, 0xad,
+			0x96, 0xe1,
+		}},
+		SocialMoodBad: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x89, 0x79, 0x7c, 0x60, 0x3d, 0x8b, 0x79,
+			0x7c, 0x49, 0x8c, 0x6d, 0x7b, 0x40, 0x55, 0x8d, 0xdd, 0x78, 0x60, 0x55, 0x8d, 0x5d, 0x77, 0x49,
+			0x8c, 0x51, 0x76, 0x40, 0xbd, 0x89, 0x45, 0x75, 0x60, 0x3d, 0x88, 0x45, 0x75, 0x31, 0x87, 0x51,
+			0x76, 0x40, 0x25, 0x86, 0xdd, 0x78, 0x60, 0x25, 0x86, 0x5d, 0x7a, 0x31, 0x87, 0x6d, 0x7b, 0x40,
+			0xbd, 0x89, 0x79, 0x7c, 0xe2, 0x45, 0x76, 0x79, 0x7c, 0x60, 0xc5, 0x77, 0x79, 0x7c, 0xd1, 0x78,
+			0x6d, 0x7b, 0x40, 0xdd, 0x79, 0xdd, 0x78, 0x60, 0xdd, 0x79, 0x5d, 0x77, 0xd1, 0x78, 0x51, 0x76,
+			0x40, 0x45, 0x76, 0x45, 0x75, 0x60, 0xc5, 0x74, 0x45, 0x75, 0xb9, 0x73, 0x51, 0x76, 0x40, 0xad,
+			0x72, 0xdd, 0x78, 0x60, 0xad, 0x72, 0x5d, 0x7a, 0xb9, 0x73, 0x6d, 0x7b, 0x40, 0x45, 0x76, 0x79,
+			0x7c, 0xe2, 0x05, 0x80, 0x35, 0x84, 0x60, 0x89, 0x7b, 0x35, 0x84, 0xe9, 0x77, 0xb5, 0x86, 0x40,
+			0x89, 0x72, 0x55, 0x8d, 0xe6, 0x79, 0x8d, 0x61, 0xcd, 0x8b, 0x25, 0x89, 0x25, 0x88, 0xad, 0x86,
+			0x7d, 0x84, 0x35, 0x84, 0x05, 0x80, 0x35, 0x84, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad,
+			0x9a, 0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71,
+			0x67, 0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75,
+			0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80,
+			0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d,
+			0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a,
+			0x89, 0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96,
+			0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61,
+			0x7d, 0x89, 0xad, 0x96, 0x15, 0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40,
+			0x15, 0x90, 0xed, 0x6f, 0x60, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed,
+			0x6f, 0x60, 0x55, 0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85,
+			0x76, 0xad, 0x96, 0x80, 0xad, 0x96, 0xe1,
+		}},
+		SocialNotifications: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0x60, 0xe8, 0x69, 0x7a, 0x60, 0x60, 0xcd, 0x74, 0x4d, 0x73, 0x5d, 0x70, 0x40, 0x78, 0xcd, 0x6a,
+			0xe8, 0x55, 0x69, 0x60, 0x78, 0xad, 0x67, 0x2d, 0x7d, 0x81, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0xad, 0x81, 0x55, 0x65, 0xd5, 0x82, 0x81, 0x66, 0x40, 0x88, 0x55, 0x69, 0xe8, 0xcd, 0x6a, 0x60,
+			0x69, 0x89, 0xf1, 0x6b, 0xb5, 0x8c, 0x5d, 0x70, 0x40, 0xa0, 0x69, 0x7a, 0xe8, 0xad, 0x8e, 0xe6,
+			0x55, 0x95, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x6a, 0xe2, 0x80, 0x89, 0x7e, 0xe2, 0x80, 0xad, 0x9a,
+			0x60, 0xcd, 0x7d, 0xad, 0x9a, 0x3d, 0x7c, 0x19, 0x99, 0x40, 0xad, 0x7a, 0x55, 0x95, 0xe6, 0x55,
+			0x85, 0x60, 0x55, 0x85, 0x89, 0x97, 0xc5, 0x83, 0x19, 0x99, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x68,
+			0xad, 0x8e, 0xe6, 0x98, 0xe8, 0x69, 0x7a, 0x60, 0x98, 0x69, 0x75, 0x81, 0x88, 0xe9, 0x71, 0x40,
+			0x80, 0x69, 0x6e, 0x60, 0x76, 0x69, 0x6e, 0x81, 0x77, 0xe9, 0x71, 0x40, 0x68, 0x69, 0x7a, 0xe8,
+			0xad, 0x8e, 0xe1,
+		}},
+		SocialNotificationsActive: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x50, 0x45, 0x7a, 0x60, 0x50, 0x45, 0x74, 0xad,
+			0x6a, 0x45, 0x6f, 0x40, 0xcd, 0x71, 0xf1, 0x66, 0x00, 0x35, 0x74, 0x25, 0x6a, 0x60, 0x79, 0x70,
+			0xf1, 0x6c, 0x3d, 0x6e, 0x19, 0x71, 0x40, 0x58, 0x45, 0x7a, 0xe6, 0x50, 0xe2, 0xa8, 0x45, 0x7a,
+			0x60, 0xa8, 0x45, 0x75, 0xc5, 0x91, 0x19, 0x71, 0x40, 0xcd, 0x8b, 0x25, 0x6a, 0x00, 0x35, 0x8e,
+			0xf1, 0x66, 0x60, 0xad, 0x92, 0x45, 0x6a, 0x55, 0x95, 0x45, 0x6f, 0x40, 0xb0, 0x45, 0x7a, 0xe6,
+			0xa8, 0xe2, 0xad, 0x6a, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6, 0x60, 0xe8, 0x69, 0x7a, 0x60, 0x60,
+			0xcd, 0x74, 0x4d, 0x73, 0x5d, 0x70, 0x40, 0x78, 0xcd, 0x6a, 0xe8, 0x55, 0x69, 0x60, 0x78, 0xad,
+			0x67, 0x2d, 0x7d, 0x81, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60, 0xad, 0x81, 0x55, 0x65, 0xd5, 0x82,
+			0x81, 0x66, 0x40, 0x88, 0x55, 0x69, 0xe8, 0xcd, 0x6a, 0x60, 0x69, 0x89, 0xf1, 0x6b, 0xb5, 0x8c,
+			0x5d, 0x70, 0x40, 0xa0, 0x69, 0x7a, 0xe8, 0xad, 0x8e, 0xe6, 0x55, 0x95, 0xe8, 0xad, 0x92, 0xe6,
+			0xad, 0x6a, 0xe2, 0x80, 0x89, 0x7e, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0xcd, 0x7d, 0xad, 0x9a, 0x3d,
+			0x7c, 0x19, 0x99, 0x40, 0xad, 0x7a, 0x55, 0x95, 0xe6, 0x55, 0x85, 0x60, 0x55, 0x85, 0x89, 0x97,
+			0xc5, 0x83, 0x19, 0x99, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x68, 0xad, 0x8e, 0xe6, 0x98, 0xe8, 0x69,
+			0x7a, 0x60, 0x98, 0x69, 0x75, 0x81, 0x88, 0xe9, 0x71, 0x40, 0x80, 0x69, 0x6e, 0x60, 0x76, 0x69,
+			0x6e, 0x81, 0x77, 0xe9, 0x71, 0x40, 0x68, 0x69, 0x7a, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		SocialNotificationsNone: SocialNotificationsNone,
+		SocialNotificationsOff: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x6a, 0xad, 0x92, 0xe8, 0xad, 0x8e, 0xe6,
+			0x60, 0xe8, 0x69, 0x7a, 0x60, 0x60, 0x35, 0x78, 0x8d, 0x70, 0x11, 0x76, 0x40, 0x35, 0x72, 0x64,
+			0x00, 0x35, 0x75, 0x6a, 0x60, 0x99, 0x74, 0x45, 0x76, 0x4d, 0x74, 0xa1, 0x77, 0x40, 0x68, 0x69,
+			0x7a, 0xe8, 0xad, 0x8e, 0xe6, 0x45, 0x89, 0x04, 0x4a, 0x55, 0x6a, 0xcd, 0x67, 0x89, 0x67, 0x35,
+			0x98, 0xb0, 0x69, 0x95, 0xcd, 0x9a, 0x45, 0x8d, 0xad, 0x92, 0xe6, 0xad, 0x6a, 0xe2, 0xa0, 0x11,
+			0x8a, 0x00, 0x98, 0x11, 0x86, 0xe8, 0x69, 0x7a, 0x60, 0x98, 0x69, 0x75, 0x81, 0x88, 0xe9, 0x71,
+			0x40, 0x80, 0x69, 0x6e, 0x61, 0xa9, 0x7d, 0x69, 0x6e, 0x81, 0x7b, 0x45, 0x6f, 0x55, 0x79, 0x25,
+			0x70, 0xbd, 0x77, 0xcd, 0x71, 0x00, 0xad, 0x74, 0xcd, 0x6e, 0x60, 0x35, 0x76, 0x45, 0x6d, 0x09,
+			0x78, 0x45, 0x6c, 0x40, 0x78, 0xcd, 0x6a, 0xe8, 0x55, 0x69, 0x60, 0x78, 0xad, 0x67, 0x2d, 0x7d,
+			0x81, 0x66, 0x40, 0x80, 0x55, 0x65, 0x60, 0xad, 0x81, 0x55, 0x65, 0xd5, 0x82, 0x81, 0x66, 0x40,
+			0x88, 0x55, 0x69, 0xe8, 0xcd, 0x6a, 0x60, 0x69, 0x89, 0xf1, 0x6b, 0xb5, 0x8c, 0x5d, 0x70, 0x40,
+			0xa0, 0x69, 0x7a, 0xe8, 0x11, 0x8a, 0xe2, 0xad, 0x7e, 0x88, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0xcd,
+			0x7d, 0xad, 0x9a, 0x3d, 0x7c, 0x19, 0x99, 0x40, 0xad, 0x7a, 0x55, 0x95, 0xe6, 0x55, 0x85, 0x60,
+			0x55, 0x85, 0x89, 0x97, 0xc5, 0x83, 0x19, 0x99, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0xdd, 0x81, 0xf1,
+			0x7b, 0xe1,
+		}},
+		SocialNotificationsPaused: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x35, 0x79, 0x55, 0x89, 0xe6, 0xdd, 0x86, 0xe8,
+			0x99, 0x85, 0xe6, 0x99, 0x7e, 0x00, 0xdd, 0x86, 0x76, 0xe8, 0x45, 0x77, 0xe6, 0x35, 0x79, 0xe8,
+			0x76, 0xe6, 0x69, 0x81, 0x00, 0x35, 0x79, 0x99, 0x85, 0xe8, 0x55, 0x89, 0xe2, 0xad, 0x6a, 0xad,
+			0x92, 0xe8, 0xad, 0x8e, 0xe6, 0x60, 0xe8, 0x69, 0x7a, 0x60, 0x60, 0xcd, 0x74, 0x4d, 0x73, 0x5d,
+			0x70, 0x40, 0x78, 0xcd, 0x6a, 0xe8, 0x55, 0x69, 0x60, 0x78, 0xad, 0x67, 0x2d, 0x7d, 0x81, 0x66,
+			0x40, 0x80, 0x55, 0x65, 0x60, 0xad, 0x81, 0x55, 0x65, 0xd5, 0x82, 0x81, 0x66, 0x40, 0x88, 0x55,
+			0x69, 0xe8, 0xcd, 0x6a, 0x60, 0x69, 0x89, 0xf1, 0x6b, 0xb5, 0x8c, 0x5d, 0x70, 0x40, 0xa0, 0x69,
+			0x7a, 0xe8, 0xad, 0x8e, 0xe6, 0x55, 0x95, 0xe8, 0xad, 0x92, 0xe6, 0xad, 0x6a, 0xe2, 0x80, 0x89,
+			0x7e, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0xcd, 0x7d, 0xad, 0x9a, 0x3d, 0x7c, 0x19, 0x99, 0x40, 0xad,
+			0x7a, 0x55, 0x95, 0xe6, 0x55, 0x85, 0x60, 0x55, 0x85, 0x89, 0x97, 0xc5, 0x83, 0x19, 0x99, 0x40,
+			0x80, 0xad, 0x9a, 0xe2, 0x68, 0xad, 0x8e, 0xe6, 0x98, 0xe8, 0x69, 0x7a, 0x60, 0x98, 0x69, 0x75,
+			0x81, 0x88, 0xe9, 0x71, 0x40, 0x80, 0x69, 0x6e, 0x60, 0x76, 0x69, 0x6e, 0x81, 0x77, 0xe9, 0x71,
+			0x40, 0x68, 0x69, 0x7a, 0xe8, 0xad, 0x8e, 0xe1,
+		}},
+		SocialPages: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58,
+			0xe2, 0x35, 0x78, 0x55, 0x8b, 0x09, 0x80, 0x99, 0x86, 0xcd, 0x87, 0x55, 0x8b, 0xbd, 0x85, 0x79,
+			0x82, 0xad, 0x8c, 0x79, 0x7c, 0x89, 0x83, 0xbd, 0x7b, 0x80, 0x55, 0x73, 0x79, 0x7c, 0xbd, 0x7b,
+			0x55, 0x73, 0x79, 0x7c, 0x45, 0x7a, 0x79, 0x82, 0x35, 0x78, 0x55, 0x8b, 0xe1,
+		}},
+		SocialPartyMode:     SocialPartyMode,
+		SocialPeople:        SocialPeople,
+		SocialPeopleOutline: SocialPeopleOutline,
+		SocialPerson: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xf1, 0x7f, 0x60, 0x99, 0x7b, 0xf1, 0x7f,
+			0xcd, 0x78, 0x25, 0x7d, 0x40, 0x6c, 0xf1, 0x75, 0x60, 0x6c, 0x89, 0x71, 0xcd, 0x78, 0xbd, 0x6e,
+			0x40, 0x80, 0xf1, 0x6b, 0x60, 0x69, 0x84, 0xf1, 0x6b, 0x35, 0x87, 0xbd, 0x6e, 0x40, 0x94, 0xf1,
+			0x75, 0x60, 0x94, 0x55, 0x7a, 0x35, 0x87, 0x25, 0x7d, 0x40, 0x80, 0xf1, 0x7f, 0xe2, 0xad, 0x6a,
+			0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60, 0xad, 0x6a, 0x89, 0x8c, 0xf1, 0x6b, 0xbd, 0x8a, 0x40, 0x35,
+			0x6f, 0x90, 0x60, 0xad, 0x73, 0x8c, 0xc5, 0x77, 0x8a, 0x40, 0x80, 0x88, 0x60, 0x25, 0x84, 0x88,
+			0x35, 0x88, 0x09, 0x85, 0x40, 0xbd, 0x90, 0x05, 0x88, 0x61, 0xd1, 0x92, 0xf5, 0x88, 0x15, 0x94,
+			0xc1, 0x8a, 0x55, 0x95, 0x89, 0x8c, 0x55, 0x95, 0x11, 0x8f, 0xe8, 0x55, 0x95, 0xe6, 0xad, 0x6a,
+			0xe2, 0xad, 0x6e, 0x55, 0x91, 0xe6, 0x55, 0x91, 0xe8, 0x11, 0x8f, 0x60, 0x55, 0x91, 0x9c, 0xb5,
+			0x90, 0x09, 0x8d, 0x40, 0x25, 0x8f, 0x99, 0x8b, 0x60, 0xdd, 0x8a, 0x89, 0x89, 0x55, 0x87, 0xc5,
+			0x88, 0x40, 0x80, 0x90, 0x60, 0x35, 0x7c, 0x90, 0x99, 0x78, 0xc5, 0x88, 0x40, 0xcd, 0x70, 0x99,
+			0x8b, 0x60, 0xdd, 0x6f, 0x11, 0x8c, 0x45, 0x6f, 0x09, 0x8d, 0x40, 0xad, 0x6e, 0x11, 0x8f, 0xe8,
+			0x55, 0x91, 0xe2, 0x80, 0xf1, 0x7b, 0x60, 0x99, 0x82, 0xf1, 0x7b, 0x4d, 0x84, 0x3d, 0x7a, 0x40,
+			0x8c, 0xf1, 0x75, 0x60, 0x8c, 0x55, 0x73, 0x4d, 0x84, 0xa5, 0x71, 0x40, 0x80, 0xf1, 0x6f, 0x60,
+			0x69, 0x7d, 0xf1, 0x6f, 0xb5, 0x7b, 0xa5, 0x71, 0x40, 0x74, 0xf1, 0x75, 0x60, 0x74, 0x89, 0x78,
+			0xb5, 0x7b, 0x3d, 0x7a, 0x40, 0x80, 0xf1, 0x7b, 0xe2, 0x80, 0xf1, 0x75, 0xe2, 0x80, 0x55, 0x91,
+			0xe1,
+		}},
+		SocialPersonAdd: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x90, 0x55, 0x85, 0xe8, 0xad, 0x7c, 0xe6,
+			0x90, 0xe8, 0xad, 0x78, 0xe6, 0xad, 0x90, 0xe8, 0x60, 0xe6, 0xad, 0x94, 0xe8, 0xad, 0x78, 0xe6,
+			0x55, 0x9d, 0xe8, 0xad, 0x7c, 0xe6, 0xad, 0x94, 0xe8, 0x55, 0x85, 0xe6, 0xad, 0x90, 0xe2, 0x70,
+			0xf1, 0x7f, 0x60, 0x99, 0x73, 0xf1, 0x7f, 0xcd, 0x70, 0x25, 0x7d, 0x40, 0x5c, 0xf1, 0x75, 0x60,
+			0x5c, 0x89, 0x71, 0xcd, 0x70, 0xbd, 0x6e, 0x40, 0x70, 0xf1, 0x6b, 0x60, 0x69, 0x7c, 0xf1, 0x6b,
+			0x35, 0x7f, 0xbd, 0x6e, 0x40, 0x84, 0xf1, 0x75, 0x60, 0x84, 0x55, 0x7a, 0x35, 0x7f, 0x25, 0x7d,
+			0x40, 0x70, 0xf1, 0x7f, 0xe2, 0xad, 0x62, 0x55, 0x95, 0xe8, 0x11, 0x8f, 0x60, 0xad, 0x62, 0xbd,
+			0x8c, 0xd5, 0x63, 0xd5, 0x8a, 0x40, 0x35, 0x67, 0x90, 0x64, 0x35, 0x6c, 0xcd, 0x85, 0x19, 0x70,
+			0xe9, 0x84, 0xfd, 0x73, 0x88, 0xfd, 0x77, 0x88, 0x78, 0x88, 0xdd, 0x7f, 0xe9, 0x84, 0xbd, 0x83,
+			0xcd, 0x85, 0xbd, 0x88, 0x90, 0xf1, 0x8a, 0x92, 0x25, 0x8c, 0xdd, 0x8a, 0x40, 0x55, 0x8d, 0x11,
+			0x8f, 0xe8, 0x55, 0x95, 0xe6, 0xad, 0x62, 0xe2, 0xad, 0x66, 0x55, 0x91, 0xe6, 0x55, 0x89, 0xe8,
+			0x11, 0x8f, 0x60, 0x55, 0x89, 0x9c, 0xbd, 0x88, 0x09, 0x8d, 0x40, 0x25, 0x87, 0x99, 0x8b, 0x60,
+			0x69, 0x82, 0x69, 0x89, 0x25, 0x7f, 0xb5, 0x88, 0x40, 0x70, 0x90, 0x60, 0x25, 0x74, 0x90, 0xd5,
+			0x70, 0xb5, 0x88, 0x40, 0xcd, 0x68, 0x99, 0x8b, 0x60, 0xcd, 0x67, 0x11, 0x8c, 0x3d, 0x67, 0x09,
+			0x8d, 0x40, 0xad, 0x66, 0x11, 0x8f, 0xe8, 0x55, 0x91, 0xe2, 0x70, 0xf1, 0x7b, 0x60, 0x99, 0x7a,
+			0xf1, 0x7b, 0x4d, 0x7c, 0x3d, 0x7a, 0x40, 0x7c, 0xf1, 0x75, 0x60, 0x7c, 0x55, 0x73, 0x4d, 0x7c,
+			0xa5, 0x71, 0x40, 0x70, 0xf1, 0x6f, 0x60, 0x69, 0x75, 0xf1, 0x6f, 0xb5, 0x73, 0xa5, 0x71, 0x40,
+			0x64, 0xf1, 0x75, 0x60, 0x64, 0x89, 0x78, 0xb5, 0x73, 0x3d, 0x7a, 0x40, 0x70, 0xf1, 0x7b, 0xe2,
+			0x70, 0xf1, 0x75, 0xe2, 0x70, 0x55, 0x91, 0xe1,
+		}},
+		SocialPersonOutline: SocialPersonOutline,
+		SocialPlusOne:       SocialPlusOne,
+		SocialPoll:          SocialPoll,
+		SocialPublic:        SocialPublic,
+		SocialSchool: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7f, 0xb0, 0x00, 0x99, 0x6c, 0x69, 0x8d,
+			0xe8, 0x69, 0x7d, 0x02, 0xad, 0x62, 0x70, 0xf1, 0x7f, 0x50, 0x55, 0x9d, 0x70, 0xe8, 0x25, 0x8d,
+			0xe6, 0x55, 0x99, 0xe8, 0x55, 0x7a, 0x00, 0x45, 0x93, 0x69, 0x7d, 0xe8, 0x69, 0x8d, 0x00, 0xf1,
+			0x7f, 0xb0, 0xe2, 0xf1, 0x7f, 0x79, 0x83, 0x03, 0xf1, 0x94, 0x70, 0xf1, 0x7f, 0xbd, 0x6c, 0x11,
+			0x6b, 0x70, 0xf1, 0x7f, 0x79, 0x83, 0xe2, 0xf1, 0x7f, 0x79, 0x93, 0x00, 0x45, 0x8f, 0x96, 0xe8,
+			0xcd, 0x7f, 0x01, 0xf1, 0x7f, 0x90, 0x99, 0x70, 0xad, 0x7f, 0xe8, 0x96, 0x00, 0xf1, 0x7f, 0x79,
+			0x93, 0xe2, 0x80, 0x79, 0x83, 0xe2, 0xf1, 0x7f, 0x69, 0x88, 0xe2, 0xf1, 0x7f, 0x69, 0x88, 0xe1,
+		}},
+		SocialSentimentDissatisfied: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x89, 0x79, 0x7c, 0x60, 0x3d, 0x8b, 0x79,
+			0x7c, 0x49, 0x8c, 0x6d, 0x7b, 0x40, 0x55, 0x8d, 0xdd, 0x78, 0x60, 0x55, 0x8d, 0x5d, 0x77, 0x49,
+			0x8c, 0x51, 0x76, 0x40, 0xbd, 0x89, 0x45, 0x75, 0x60, 0x3d, 0x88, 0x45, 0x75, 0x31, 0x87, 0x51,
+			0x76, 0x40, 0x25, 0x86, 0xdd, 0x78, 0x60, 0x25, 0x86, 0x5d, 0x7a, 0x31, 0x87, 0x6d, 0x7b, 0x40,
+			0xbd, 0x89, 0x79, 0x7c, 0xe2, 0x45, 0x76, 0x79, 0x7c, 0x60, 0xc5, 0x77, 0x79, 0x7c, 0xd1, 0x78,
+			0x6d, 0x7b, 0x40, 0xdd, 0x79, 0xdd, 0x78, 0x60, 0xdd, 0x79, 0x5d, 0x77, 0xd1, 0x78, 0x51, 0x76,
+			0x40, 0x45, 0x76, 0x45, 0x75, 0x60, 0xc5, 0x74, 0x45, 0x75, 0xb9, 0x73, 0x51, 0x76, 0x40, 0xad,
+			0x72, 0xdd, 0x78, 0x60, 0xad, 0x72, 0x5d, 0x7a, 0xb9, 0x73, 0x6d, 0x7b, 0x40, 0x45, 0x76, 0x79,
+			0x7c, 0xe2, 0x05, 0x80, 0x35, 0x84, 0x60, 0x89, 0x7b, 0x35, 0x84, 0xe9, 0x77, 0xb5, 0x86, 0x40,
+			0x89, 0x72, 0x55, 0x8d, 0xe6, 0x11, 0x76, 0x60, 0x89, 0x77, 0x89, 0x8a, 0x39, 0x7a, 0x92, 0x40,
+			0x0d, 0x80, 0x79, 0x87, 0x60, 0x35, 0x83, 0x79, 0x87, 0xd5, 0x85, 0x09, 0x89, 0x40, 0x94, 0x55,
+			0x8d, 0xe6, 0x79, 0x8d, 0x61, 0xcd, 0x8b, 0x25, 0x89, 0x25, 0x88, 0xad, 0x86, 0x7d, 0x84, 0x35,
+			0x84, 0x05, 0x80, 0x35, 0x84, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75,
+			0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a,
+			0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d,
+			0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96,
+			0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91,
+			0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad,
+			0x96, 0x15, 0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed,
+			0x6f, 0x60, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55,
+			0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96,
+			0x80, 0xad, 0x96, 0xe1,
+		}},
+		SocialSentimentNeutral: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x89, 0x79, 0x7c, 0x60, 0x3d, 0x8b, 0x79,
+			0x7c, 0x49, 0x8c, 0x6d, 0x7b, 0x40, 0x55, 0x8d, 0xdd, 0x78, 0x60, 0x55, 0x8d, 0x5d, 0x77, 0x49,
+			0x8c, 0x51, 0x76, 0x40, 0xbd, 0x89, 0x45, 0x75, 0x60, 0x3d, 0x88, 0x45, 0x75, 0x31, 0x87, 0x51,
+			0x76, 0x40, 0x25, 0x86, 0xdd, 0x78, 0x60, 0x25, 0x86, 0x5d, 0x7a, 0x31, 0x87, 0x6d, 0x7b, 0x40,
+			0xbd, 0x89, 0x79, 0x7c, 0xe2, 0x45, 0x76, 0x79, 0x7c, 0x60, 0xc5, 0x77, 0x79, 0x7c, 0xd1, 0x78,
+			0x6d, 0x7b, 0x40, 0xdd, 0x79, 0xdd, 0x78, 0x60, 0xdd, 0x79, 0x5d, 0x77, 0xd1, 0x78, 0x51, 0x76,
+			0x40, 0x45, 0x76, 0x45, 0x75, 0x60, 0xc5, 0x74, 0x45, 0x75, 0xb9, 0x73, 0x51, 0x76, 0x40, 0xad,
+			0x72, 0xdd, 0x78, 0x60, 0xad, 0x72, 0x5d, 0x7a, 0xb9, 0x73, 0x6d, 0x7b, 0x40, 0x45, 0x76, 0x79,
+			0x7c, 0xe2, 0x99, 0x77, 0x69, 0x89, 0xe6, 0x79, 0x88, 0xe8, 0x25, 0x86, 0xe6, 0x99, 0x77, 0xe8,
+			0x69, 0x89, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40,
+			0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65,
+			0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60,
+			0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55,
+			0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91,
+			0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a,
+			0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80,
+			0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad, 0x96, 0x15, 0x90,
+			0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed, 0x6f, 0x60, 0x7d,
+			0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55, 0x69, 0x85, 0x76,
+			0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96, 0x80, 0xad, 0x96,
+			0xe1,
+		}},
+		SocialSentimentSatisfied: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xbd, 0x89, 0x79, 0x7c, 0x60, 0x3d, 0x8b, 0x79,
+			0x7c, 0x49, 0x8c, 0x6d, 0x7b, 0x40, 0x55, 0x8d, 0xdd, 0x78, 0x60, 0x55, 0x8d, 0x5d, 0x77, 0x49,
+			0x8c, 0x51, 0x76, 0x40, 0xbd, 0x89, 0x45, 0x75, 0x60, 0x3d, 0x88, 0x45, 0x75, 0x31, 0x87, 0x51,
+			0x76, 0x40, 0x25, 0x86, 0xdd, 0x78, 0x60, 0x25, 0x86, 0x5d, 0x7a, 0x31, 0x87, 0x6d, 0x7b, 0x40,
+			0xbd, 0x89, 0x79, 0x7c, 0xe2, 0x45, 0x76, 0x79, 0x7c, 0x60, 0xc5, 0x77, 0x79, 0x7c, 0xd1, 0x78,
+			0x6d, 0x7b, 0x40, 0xdd, 0x79, 0xdd, 0x78, 0x60, 0xdd, 0x79, 0x5d, 0x77, 0xd1, 0x78, 0x51, 0x76,
+			0x40, 0x45, 0x76, 0x45, 0x75, 0x60, 0xc5, 0x74, 0x45, 0x75, 0xb9, 0x73, 0x51, 0x76, 0x40, 0xad,
+			0x72, 0xdd, 0x78, 0x60, 0xad, 0x72, 0x5d, 0x7a, 0xb9, 0x73, 0x6d, 0x7b, 0x40, 0x45, 0x76, 0x79,
+			0x7c, 0xe2, 0x80, 0x99, 0x8e, 0x60, 0x69, 0x84, 0x99, 0x8e, 0x19, 0x88, 0x3d, 0x8c, 0x40, 0x79,
+			0x8d, 0xcd, 0x85, 0xe6, 0x94, 0x60, 0x79, 0x88, 0x79, 0x88, 0xcd, 0x85, 0xe9, 0x89, 0x40, 0x09,
+			0x80, 0x55, 0x8b, 0x60, 0xf1, 0x7c, 0x55, 0x8b, 0x3d, 0x7a, 0xf1, 0x89, 0x40, 0x11, 0x76, 0xcd,
+			0x85, 0xe6, 0x89, 0x72, 0x60, 0x45, 0x74, 0xdd, 0x89, 0xf1, 0x77, 0x3d, 0x8c, 0x40, 0x80, 0x99,
+			0x8e, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40, 0x25,
+			0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65, 0x80,
+			0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd,
+			0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65,
+			0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98,
+			0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a, 0x40,
+			0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad,
+			0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad, 0x96, 0x15, 0x90, 0x15,
+			0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed, 0x6f, 0x60, 0x7d, 0x89,
+			0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55, 0x69, 0x85, 0x76, 0x55,
+			0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96, 0x80, 0xad, 0x96, 0xe1,
+		}},
+		SocialSentimentVeryDissatisfied: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x35, 0x84, 0x60, 0x89, 0x7b, 0x35, 0x84,
+			0xe9, 0x77, 0xb5, 0x86, 0x40, 0x89, 0x72, 0x55, 0x8d, 0xe6, 0x79, 0x8d, 0x60, 0xcd, 0x8b, 0x25,
+			0x89, 0x25, 0x88, 0xad, 0x86, 0x40, 0x80, 0x35, 0x84, 0xe2, 0xcd, 0x73, 0x69, 0x7f, 0x0b, 0x25,
+			0x77, 0x69, 0x7c, 0x25, 0x7a, 0x69, 0x7f, 0x35, 0x7c, 0x7a, 0x35, 0x79, 0x74, 0x35, 0x7c, 0x6e,
+			0x25, 0x7a, 0x99, 0x74, 0x25, 0x77, 0x99, 0x77, 0xcd, 0x73, 0x99, 0x74, 0xbd, 0x71, 0x6e, 0xbd,
+			0x74, 0x74, 0xbd, 0x71, 0x7a, 0xcd, 0x73, 0x69, 0x7f, 0xe2, 0xf1, 0x85, 0x69, 0x7f, 0x0b, 0xdd,
+			0x88, 0x69, 0x7c, 0x45, 0x8c, 0x69, 0x7f, 0x55, 0x8e, 0x7a, 0x55, 0x8b, 0x74, 0x55, 0x8e, 0x6e,
+			0x45, 0x8c, 0x99, 0x74, 0xdd, 0x88, 0x99, 0x77, 0xf1, 0x85, 0x99, 0x74, 0xdd, 0x83, 0x6e, 0xcd,
+			0x86, 0x74, 0xdd, 0x83, 0x7a, 0xf1, 0x85, 0x69, 0x7f, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a,
+			0xad, 0x9a, 0x99, 0x75, 0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f,
+			0x71, 0x67, 0x69, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99,
+			0x75, 0x40, 0x25, 0x6d, 0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40,
+			0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25,
+			0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad,
+			0x9a, 0x89, 0x85, 0x91, 0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79,
+			0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96,
+			0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x11, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96,
+			0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x89, 0x76, 0x55, 0x69, 0xf1,
+			0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x79, 0x89, 0xf1, 0x6f, 0x11, 0x90,
+			0x40, 0x80, 0xad, 0x96, 0xe1,
+		}},
+		SocialSentimentVerySatisfied: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x99, 0x8e, 0x60, 0x69, 0x84, 0x99, 0x8e,
+			0x19, 0x88, 0x3d, 0x8c, 0x40, 0x79, 0x8d, 0xcd, 0x85, 0xe6, 0x89, 0x72, 0x60, 0x45, 0x74, 0xdd,
+			0x89, 0xf1, 0x77, 0x3d, 0x8c, 0x40, 0x80, 0x99, 0x8e, 0xe2, 0x25, 0x74, 0x79, 0x7c, 0x05, 0x25,
+			0x77, 0x79, 0x79, 0x25, 0x7a, 0x79, 0x7c, 0x89, 0x7c, 0x11, 0x7a, 0x25, 0x77, 0xad, 0x74, 0xbd,
+			0x71, 0x11, 0x7a, 0x25, 0x74, 0x79, 0x7c, 0xe2, 0xf1, 0x85, 0x79, 0x7c, 0x05, 0xf1, 0x88, 0x79,
+			0x79, 0xf1, 0x8b, 0x79, 0x7c, 0x55, 0x8e, 0x11, 0x7a, 0xf1, 0x88, 0xad, 0x74, 0x89, 0x83, 0x11,
+			0x7a, 0xf1, 0x85, 0x79, 0x7c, 0xe2, 0x80, 0xad, 0x9a, 0x60, 0x79, 0x7a, 0xad, 0x9a, 0x99, 0x75,
+			0x91, 0x98, 0x40, 0x25, 0x6d, 0xdd, 0x92, 0x60, 0x89, 0x69, 0x45, 0x8f, 0x71, 0x67, 0x69, 0x8a,
+			0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x25, 0x6d,
+			0x25, 0x6d, 0x60, 0xbd, 0x70, 0x89, 0x69, 0x99, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96,
+			0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x89, 0x85, 0x91,
+			0x98, 0x69, 0x8a, 0x40, 0xdd, 0x92, 0xdd, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0x80, 0xe2, 0x80, 0xad, 0x96, 0x61, 0x7d, 0x89, 0xad,
+			0x96, 0x15, 0x90, 0x15, 0x90, 0xad, 0x96, 0x7d, 0x89, 0xad, 0x96, 0x80, 0x40, 0x15, 0x90, 0xed,
+			0x6f, 0x60, 0x7d, 0x89, 0x55, 0x69, 0x80, 0x55, 0x69, 0x40, 0xed, 0x6f, 0xed, 0x6f, 0x60, 0x55,
+			0x69, 0x85, 0x76, 0x55, 0x69, 0x80, 0x40, 0xed, 0x6f, 0x15, 0x90, 0x60, 0x85, 0x76, 0xad, 0x96,
+			0x80, 0xad, 0x96, 0xe1,
+		}},
+		SocialShare: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x79, 0x90, 0xad, 0x9a, 0x62, 0x4d, 0x8d, 0xad,
+			0x9a, 0x15, 0x8b, 0x71, 0x98, 0xdd, 0x88, 0x39, 0x96, 0xdd, 0x88, 0x0d, 0x93, 0xdd, 0x88, 0x99,
+			0x92, 0xf9, 0x88, 0xf5, 0x91, 0x40, 0x45, 0x89, 0xcd, 0x90, 0x00, 0x11, 0x75, 0x11, 0x85, 0x60,
+			0x11, 0x74, 0x35, 0x86, 0x99, 0x72, 0xe9, 0x86, 0x40, 0x99, 0x6f, 0x99, 0x87, 0x60, 0x71, 0x6c,
+			0x99, 0x87, 0x39, 0x6a, 0x65, 0x85, 0x40, 0x50, 0x80, 0x60, 0x50, 0xd5, 0x7c, 0x39, 0x6a, 0x9d,
+			0x7a, 0x40, 0x99, 0x6f, 0x69, 0x78, 0x60, 0x25, 0x71, 0x69, 0x78, 0x89, 0x72, 0x72, 0x40, 0x11,
+			0x75, 0xbd, 0x7a, 0x00, 0x45, 0x89, 0x25, 0x6f, 0x62, 0x11, 0x89, 0xa9, 0x6e, 0xf9, 0x88, 0x15,
+			0x6e, 0xdd, 0x88, 0x7d, 0x6d, 0xdd, 0x88, 0xf1, 0x6c, 0xdd, 0x88, 0xc5, 0x69, 0x15, 0x8b, 0x8d,
+			0x67, 0x40, 0x79, 0x90, 0x55, 0x65, 0x60, 0xa5, 0x93, 0x55, 0x65, 0xd9, 0x95, 0x8d, 0x67, 0x40,
+			0x11, 0x98, 0xf1, 0x6c, 0x60, 0x11, 0x98, 0x19, 0x70, 0xd9, 0x95, 0x51, 0x72, 0x40, 0x79, 0x90,
+			0x89, 0x74, 0x60, 0xe9, 0x8e, 0x89, 0x74, 0x7d, 0x8d, 0x09, 0x74, 0x40, 0x11, 0x8b, 0x69, 0x72,
+			0x00, 0xdd, 0x76, 0x99, 0x7d, 0x60, 0x6e, 0x25, 0x7e, 0x19, 0x77, 0xd5, 0x7e, 0x40, 0x35, 0x77,
+			0x05, 0x80, 0x61, 0x35, 0x77, 0x81, 0x80, 0x19, 0x77, 0x05, 0x81, 0x6e, 0x89, 0x81, 0xdd, 0x76,
+			0x11, 0x82, 0x00, 0x11, 0x8b, 0x89, 0x8d, 0x60, 0x11, 0x8c, 0x99, 0x8c, 0x69, 0x8d, 0x09, 0x8c,
+			0x40, 0x79, 0x90, 0x79, 0x8b, 0x60, 0xa5, 0x93, 0x79, 0x8b, 0xd9, 0x95, 0xb1, 0x8d, 0x40, 0x11,
+			0x98, 0x11, 0x93, 0x60, 0x11, 0x98, 0x3d, 0x96, 0xd9, 0x95, 0x75, 0x98, 0x40, 0x79, 0x90, 0xad,
+			0x9a, 0xe2, 0x79, 0x90, 0x89, 0x70, 0x61, 0xa4, 0x89, 0x70, 0x09, 0x93, 0x81, 0x6f, 0x11, 0x94,
+			0x79, 0x6e, 0x11, 0x94, 0xf1, 0x6c, 0x40, 0x09, 0x93, 0x5d, 0x6a, 0x60, 0xa4, 0x55, 0x69, 0x79,
+			0x90, 0x55, 0x69, 0x40, 0xe9, 0x8d, 0x61, 0x6a, 0x60, 0xdd, 0x8c, 0x69, 0x6b, 0xdd, 0x8c, 0xf1,
+			0x6c, 0x40, 0xe9, 0x8d, 0x81, 0x6f, 0x60, 0xf1, 0x8e, 0x89, 0x70, 0x79, 0x90, 0x89, 0x70, 0xe2,
+			0x9d, 0x6f, 0x99, 0x83, 0x61, 0x25, 0x71, 0x99, 0x83, 0x2d, 0x72, 0x91, 0x82, 0x35, 0x73, 0x89,
+			0x81, 0x35, 0x73, 0x80, 0x40, 0x2d, 0x72, 0x71, 0x7d, 0x60, 0x21, 0x71, 0x69, 0x7c, 0x99, 0x6f,
+			0x69, 0x7c, 0x40, 0x09, 0x6d, 0x71, 0x7d, 0x60, 0x58, 0x79, 0x7e, 0x58, 0x80, 0x40, 0x09, 0x6d,
+			0x91, 0x82, 0x60, 0x15, 0x6e, 0x99, 0x83, 0x9d, 0x6f, 0x99, 0x83, 0xe2, 0x79, 0x90, 0xad, 0x96,
+			0x61, 0xa4, 0xad, 0x96, 0x09, 0x93, 0xa1, 0x95, 0x11, 0x94, 0x99, 0x94, 0x11, 0x94, 0x11, 0x93,
+			0x40, 0x09, 0x93, 0x81, 0x90, 0x60, 0xa4, 0x79, 0x8f, 0x79, 0x90, 0x79, 0x8f, 0x40, 0xe9, 0x8d,
+			0x81, 0x90, 0x60, 0xdd, 0x8c, 0x89, 0x91, 0xdd, 0x8c, 0x11, 0x93, 0x40, 0xe9, 0x8d, 0xa5, 0x95,
+			0x60, 0xf1, 0x8e, 0xad, 0x96, 0x79, 0x90, 0xad, 0x96, 0xe2, 0x79, 0x90, 0xf1, 0x6c, 0xe2, 0x99,
+			0x6f, 0x80, 0xe2, 0x79, 0x90, 0x11, 0x93, 0xe1,
+		}},
+		SocialWhatsHot: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x99, 0x7b, 0xad, 0x9a,
+			0x89, 0x77, 0x55, 0x99, 0x40, 0x11, 0x70, 0x79, 0x95, 0x00, 0x66, 0x89, 0x92, 0x60, 0xf1, 0x75,
+			0x89, 0x94, 0x45, 0x79, 0x99, 0x95, 0x40, 0x80, 0xad, 0x96, 0x60, 0x89, 0x89, 0xad, 0x96, 0x19,
+			0x90, 0x19, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x79, 0x76, 0x19, 0x90, 0xe9, 0x6f,
+			0x40, 0x80, 0x55, 0x69, 0x60, 0x79, 0x76, 0x55, 0x69, 0xe9, 0x6f, 0xe9, 0x6f, 0x40, 0x55, 0x69,
+			0x80, 0xe6, 0x55, 0x65, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0xa5, 0x75, 0x40, 0x2d, 0x6d,
+			0x2d, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60,
+			0x89, 0x85, 0x55, 0x65, 0x5d, 0x8a, 0x71, 0x67, 0x40, 0xd5, 0x92, 0x2d, 0x6d, 0x60, 0x79, 0x96,
+			0xcd, 0x70, 0x91, 0x98, 0xa5, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91,
+			0x98, 0x55, 0x8a, 0x40, 0xd5, 0x92, 0xd5, 0x92, 0x60, 0x35, 0x8f, 0x79, 0x96, 0x5d, 0x8a, 0x91,
+			0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0xcd, 0x69, 0xad, 0x8e, 0x02, 0xbd, 0x74, 0xbd, 0x83, 0x35,
+			0x7d, 0x25, 0x8b, 0xf1, 0x8b, 0x69, 0x7c, 0xe8, 0x35, 0x85, 0xe6, 0xf1, 0x8f, 0xe8, 0xdd, 0x75,
+			0xe6, 0x99, 0x80, 0xe8, 0xdd, 0x79, 0xe6, 0x69, 0x89, 0x02, 0x11, 0x7d, 0x35, 0x86, 0x99, 0x74,
+			0xcd, 0x7e, 0xf1, 0x67, 0x79, 0x8b, 0x60, 0x69, 0x68, 0x69, 0x8c, 0xd5, 0x68, 0x2d, 0x8d, 0x40,
+			0xcd, 0x69, 0xad, 0x8e, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ToggleCheckBox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xf1, 0x7b, 0x99, 0x8a, 0x05, 0x35, 0x8f, 0x55,
+			0x77, 0x55, 0x8c, 0x79, 0x74, 0xf1, 0x7b, 0xdd, 0x84, 0x68, 0xf1, 0x7c, 0x25, 0x71, 0xcd, 0x7f,
+			0xf1, 0x7b, 0x99, 0x8a, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69, 0xcd, 0x96, 0x40,
+			0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40, 0x58, 0x50, 0xe6,
+			0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8, 0xa8, 0x60, 0xb0,
+			0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58, 0xa8, 0xe6, 0xa8,
+			0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8, 0x58, 0xe1,
+		}},
+		ToggleCheckBoxOutlineBlank: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35, 0x69,
+			0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69, 0x40,
+			0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58, 0xe8,
+			0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2, 0x58,
+			0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe1,
+		}},
+		ToggleIndeterminateCheckBox: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0xad, 0x70, 0xdd, 0x81, 0xe6, 0x69, 0x8f, 0xe8,
+			0xdd, 0x7d, 0xe6, 0xad, 0x70, 0xe8, 0xdd, 0x81, 0xe2, 0x58, 0xb0, 0x60, 0x69, 0x6a, 0xb0, 0x35,
+			0x69, 0xcd, 0x96, 0x40, 0x50, 0xa8, 0xe8, 0x58, 0x60, 0x50, 0x69, 0x6a, 0x35, 0x69, 0x35, 0x69,
+			0x40, 0x58, 0x50, 0xe6, 0xa8, 0x60, 0x99, 0x95, 0x50, 0xcd, 0x96, 0x35, 0x69, 0x40, 0xb0, 0x58,
+			0xe8, 0xa8, 0x60, 0xb0, 0x99, 0x95, 0xcd, 0x96, 0xcd, 0x96, 0x40, 0xa8, 0xb0, 0xe6, 0x58, 0xe2,
+			0x58, 0xa8, 0xe6, 0xa8, 0xe8, 0x58, 0xe6, 0x58, 0xe8, 0xa8, 0xe2, 0x58, 0x58, 0xe8, 0xa8, 0xe8,
+			0x58, 0xe1,
+		}},
+		ToggleRadioButtonChecked: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x69, 0x8c, 0x60, 0x35, 0x85, 0x69, 0x8c,
+			0xcd, 0x88, 0xcd, 0x88, 0x40, 0x69, 0x8c, 0x80, 0x60, 0x69, 0x8c, 0xcd, 0x7a, 0xcd, 0x88, 0x35,
+			0x77, 0x40, 0x80, 0x99, 0x73, 0x60, 0xcd, 0x7a, 0x99, 0x73, 0x35, 0x77, 0x35, 0x77, 0x40, 0x99,
+			0x73, 0x80, 0x60, 0x99, 0x73, 0x35, 0x85, 0x35, 0x77, 0xcd, 0x88, 0x40, 0x80, 0x69, 0x8c, 0xe2,
+			0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a, 0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5,
+			0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67, 0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55,
+			0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40, 0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89,
+			0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55, 0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a,
+			0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60, 0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75,
+			0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79, 0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92,
+			0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69, 0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2,
+			0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96, 0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80,
+			0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1, 0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76,
+			0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55, 0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9,
+			0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2, 0x80, 0x80, 0xe1,
+		}},
+		ToggleRadioButtonUnchecked: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0xad, 0x9a, 0x60, 0x89, 0x7a, 0xad, 0x9a,
+			0xad, 0x75, 0x91, 0x98, 0x40, 0x2d, 0x6d, 0xd5, 0x92, 0x60, 0x89, 0x69, 0x35, 0x8f, 0x71, 0x67,
+			0x55, 0x8a, 0x40, 0x55, 0x65, 0x80, 0x60, 0x55, 0x65, 0x79, 0x7a, 0x71, 0x67, 0x99, 0x75, 0x40,
+			0x2d, 0x6d, 0x25, 0x6d, 0x60, 0xcd, 0x70, 0x89, 0x69, 0xad, 0x75, 0x71, 0x67, 0x40, 0x80, 0x55,
+			0x65, 0x60, 0x89, 0x85, 0x55, 0x65, 0x69, 0x8a, 0x71, 0x67, 0x40, 0xdd, 0x92, 0x25, 0x6d, 0x60,
+			0x79, 0x96, 0xbd, 0x70, 0x91, 0x98, 0x99, 0x75, 0x40, 0xad, 0x9a, 0x80, 0x60, 0xad, 0x9a, 0x79,
+			0x85, 0x91, 0x98, 0x55, 0x8a, 0x40, 0xdd, 0x92, 0xd5, 0x92, 0x60, 0x45, 0x8f, 0x79, 0x96, 0x69,
+			0x8a, 0x91, 0x98, 0x40, 0x80, 0xad, 0x9a, 0xe2, 0x80, 0xad, 0x96, 0x60, 0x79, 0x89, 0xad, 0x96,
+			0x11, 0x90, 0x09, 0x90, 0x40, 0xad, 0x96, 0x80, 0x60, 0xad, 0x96, 0x89, 0x76, 0x11, 0x90, 0xf1,
+			0x6f, 0x40, 0x80, 0x55, 0x69, 0x60, 0x99, 0x76, 0x55, 0x69, 0xf9, 0x6f, 0xf1, 0x6f, 0x40, 0x55,
+			0x69, 0x80, 0x60, 0x55, 0x69, 0x69, 0x89, 0xf9, 0x6f, 0x09, 0x90, 0x40, 0x80, 0xad, 0x96, 0xe2,
+			0x80, 0x80, 0xe1,
+		}},
+		ToggleStar: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x89, 0x75, 0x55, 0x92, 0x09, 0x80, 0x11, 0x8c,
+			0x79, 0x8a, 0x69, 0x92, 0xad, 0x87, 0x89, 0x86, 0xdd, 0x90, 0x89, 0x7e, 0xbd, 0x84, 0x79, 0x7d,
+			0x80, 0x45, 0x72, 0x45, 0x7b, 0x69, 0x7d, 0x25, 0x6f, 0x79, 0x7e, 0x55, 0x78, 0x79, 0x86, 0x89,
+			0x75, 0x55, 0x92, 0xe2, 0x89, 0x6f, 0xad, 0x9a, 0x09, 0xdd, 0x73, 0xf1, 0x87, 0x55, 0x65, 0x55,
+			0x7b, 0x89, 0x78, 0xad, 0x79, 0x80, 0x50, 0x79, 0x87, 0xad, 0x79, 0xad, 0x9a, 0x55, 0x7b, 0x25,
+			0x8c, 0xf1, 0x87, 0x79, 0x90, 0xad, 0x9a, 0x80, 0xbd, 0x90, 0x89, 0x6f, 0xad, 0x9a, 0xe2, 0x80,
+			0x86, 0xe1,
+		}},
+		ToggleStarBorder: ToggleStarBorder,
+		ToggleStarHalf: &Icon{src: &[]byte{
+			0x89, 0x49, 0x56, 0x47, 0x00, 0x80, 0x80, 0xc0, 0x80, 0x11, 0x8c, 0x04, 0x79, 0x8a, 0x69, 0x92,
+			0xad, 0x87, 0x89, 0x86, 0xdd, 0x90, 0x89, 0x7e, 0xbd, 0x84, 0x79, 0x7d, 0x80, 0x45, 0x72, 0xe8,
+			0x11, 0x8c, 0xe2, 0x89, 0x6f, 0xad, 0x9a, 0x09, 0xdd, 0x73, 0xf1, 0x87, 0x55, 0x65, 0x55, 0x7b,
+			0x89, 0x78, 0xad, 0x79, 0x80, 0x50, 0x79, 0x87, 0xad, 0x79, 0xad, 0x9a, 0x55, 0x7b, 0x25, 0x8c,
+			0xf1, 0x87, 0x79, 0x90, 0xad, 0x9a, 0x80, 0xbd, 0x90, 0x89, 0x6f, 0xad, 0x9a, 0xe1,
+		}},
+		fallbacks: []string{
+			"AVArtTrack",
+			"AVBrandingWatermark",
+			"AVCallToAction",
+			"AVFiberDVR",
+			"AVFiberManualRecord",
+			"AVFiberNew",
+			"AVFiberPin",
+			"AVFiberSmartRecord",
+			"AVGames",
+			"AVLoop",
+			"AVMicNone",
+			"AVNotInterested",
+			"AVPauseCircleFilled",
+			"AVPauseCircleOutline",
+			"AVPlayCircleFilled",
+			"AVPlayCircleOutline",
+			"AVQueue",
+			"AVRecentActors",
+			"AVSubscriptions",
+			"Action3DRotation",
+			"ActionAccessibility",
+			"ActionAccessible",
+			"ActionAccountBalance",
+			"ActionAccountBalanceWallet",
+			"ActionAccountBox",
+			"ActionAnnouncement",
+			"ActionAssessment",
+			"ActionAssignment",
+			"ActionAssignmentInd",
+			"ActionAssignmentLate",
+			"ActionAssignmentReturn",
+			"ActionAssignmentReturned",
+			"ActionAssignmentTurnedIn",
+			"ActionBookmarkBorder",
+			"ActionCameraEnhance",
+			"ActionCardGiftcard",
+			"ActionCardTravel",
+			"ActionChromeReaderMode",
+			"ActionClass",
+			"ActionFavoriteBorder",
+			"ActionFlightLand",
+			"ActionFlightTakeoff",
+			"ActionGavel",
+			"ActionGetApp",
+			"ActionHTTPS",
+			"ActionHelpOutline",
+			"ActionHighlightOff",
+			"ActionHourglassFull",
+			"ActionInfoOutline",
+			"ActionLabelOutline",
+			"ActionLaunch",
+			"ActionLightbulbOutline",
+			"ActionLockOutline",
+			"ActionMotorcycle",
+			"ActionPayment",
+			"ActionPermIdentity",
+			"ActionPets",
+			"ActionPregnantWoman",
+			"ActionQueryBuilder",
+			"ActionQuestionAnswer",
+			"ActionReportProblem",
+			"ActionRestore",
+			"ActionRoom",
+			"ActionRowing",
+			"ActionSettingsInputComposite",
+			"ActionSettingsInputHDMI",
+			"ActionSettingsInputSVideo",
+			"ActionSettingsOverscan",
+			"ActionStore",
+			"ActionSystemUpdateAlt",
+			"ActionTheaters",
+			"ActionToll",
+			"ActionTurnedIn",
+			"ActionTurnedInNot",
+			"ActionWatchLater",
+			"AlertErrorOutline",
+			"CommunicationBusiness",
+			"CommunicationCall",
+			"CommunicationCallEnd",
+			"CommunicationCallMade",
+			"CommunicationCallMerge",
+			"CommunicationCallMissed",
+			"CommunicationCallMissedOutgoing",
+			"CommunicationCallReceived",
+			"CommunicationCallSplit",
+			"CommunicationChatBubbleOutline",
+			"CommunicationEmail",
+			"CommunicationImportExport",
+			"CommunicationMailOutline",
+			"CommunicationMessage",
+			"CommunicationPhone",
+			"CommunicationPhoneLinkErase",
+			"CommunicationPhoneLinkLock",
+			"CommunicationPhoneLinkRing",
+			"CommunicationPhoneLinkSetup",
+			"CommunicationSwapCalls",
+			"CommunicationTextSMS",
+			"ContentAddCircleOutline",
+			"ContentClear",
+			"ContentCreate",
+			"ContentMarkUnread",
+			"ContentRemoveCircle",
+			"ContentRemoveCircleOutline",
+			"DeviceAccessAlarm",
+			"DeviceAccessAlarms",
+			"DeviceAccessTime",
+			"DeviceAddAlarm",
+			"DeviceAirplaneModeActive",
+			"DeviceAirplaneModeInactive",
+			"DeviceBattery20",
+			"DeviceBattery30",
+			"DeviceBattery50",
+			"DeviceBattery60",
+			"DeviceBattery80",
+			"DeviceBattery90",
+			"DeviceBatteryFull",
+			"DeviceBatteryStd",
+			"DeviceGPSFixed",
+			"DeviceGPSNotFixed",
+			"DeviceGPSOff",
+			"DeviceLocationDisabled",
+			"DeviceLocationSearching",
+			"DeviceSDStorage",
+			"DeviceSettingsSystemDaydream",
+			"DeviceSignalCellularConnectedNoInternet1Bar",
+			"DeviceSignalCellularConnectedNoInternet2Bar",
+			"DeviceSignalCellularConnectedNoInternet3Bar",
+			"DeviceSignalCellularNoSIM",
+			"DeviceSignalWiFi1Bar",
+			"DeviceSignalWiFi1BarLock",
+			"DeviceSignalWiFi2Bar",
+			"DeviceSignalWiFi2BarLock",
+			"DeviceSignalWiFi3Bar",
+			"DeviceSignalWiFi3BarLock",
+			"DeviceSignalWiFi4BarLock",
+			"DeviceStorage",
+			"DeviceWiFiTethering",
+			"EditorInsertComment",
+			"EditorInsertDriveFile",
+			"EditorInsertEmoticon",
+			"EditorInsertInvitation",
+			"EditorInsertLink",
+			"EditorInsertPhoto",
+			"EditorModeComment",
+			"EditorModeEdit",
+			"EditorPieChartOutlined",
+			"FileCloudQueue",
+			"FileFileDownload",
+			"FileFileUpload",
+			"HardwareHeadset",
+			"HardwareLaptop",
+			"HardwarePhoneLink",
+			"HardwarePhoneLinkOff",
+			"HardwareSIMCard",
+			"ImageAddToPhotos",
+			"ImageAssistant",
+			"ImageAssistantPhoto",
+			"ImageAudiotrack",
+			"ImageCameraAlt",
+			"ImageCollections",
+			"ImageColorLens",
+			"ImageControlPoint",
+			"ImageCropDIN",
+			"ImageCropOriginal",
+			"ImageFlashAuto",
+			"ImageFlashOff",
+			"ImageFlashOn",
+			"ImageGrain",
+			"ImageHDROff",
+			"ImageHDROn",
+			"ImageHDRStrong",
+			"ImageHDRWeak",
+			"ImageHealing",
+			"ImageISO",
+			"ImageLeakAdd",
+			"ImageLeakRemove",
+			"ImageLens",
+			"ImageLinkedCamera",
+			"ImageLooks",
+			"ImageLooks3",
+			"ImageLooks4",
+			"ImageLooks5",
+			"ImageLooks6",
+			"ImageLooksOne",
+			"ImageLooksTwo",
+			"ImageMovieCreation",
+			"ImageMovieFilter",
+			"ImageNaturePeople",
+			"ImagePanoramaFishEye",
+			"ImagePanoramaHorizontal",
+			"ImagePanoramaVertical",
+			"ImagePanoramaWideAngle",
+			"ImagePhotoFilter",
+			"ImagePhotoSizeSelectActual",
+			"ImagePortrait",
+			"ImageRemoveRedEye",
+			"ImageTagFaces",
+			"ImageWBAuto",
+			"ImageWBCloudy",
+			"ImageWBIncandescent",
+			"ImageWBIridescent",
+			"ImageWBSunny",
+			"MapsBeenhere",
+			"MapsDirectionsBike",
+			"MapsDirectionsBoat",
+			"MapsDirectionsBus",
+			"MapsDirectionsCar",
+			"MapsDirectionsRailway",
+			"MapsDirectionsRun",
+			"MapsDirectionsSubway",
+			"MapsDirectionsTransit",
+			"MapsDirectionsWalk",
+			"MapsEVStation",
+			"MapsHotel",
+			"MapsLocalATM",
+			"MapsLocalActivity",
+			"MapsLocalAirport",
+			"MapsLocalBar",
+			"MapsLocalCafe",
+			"MapsLocalCarWash",
+			"MapsLocalConvenienceStore",
+			"MapsLocalDining",
+			"MapsLocalDrink",
+			"MapsLocalFlorist",
+			"MapsLocalGasStation",
+			"MapsLocalGroceryStore",
+			"MapsLocalHospital",
+			"MapsLocalHotel",
+			"MapsLocalLaundryService",
+			"MapsLocalLibrary",
+			"MapsLocalMall",
+			"MapsLocalMovies",
+			"MapsLocalOffer",
+			"MapsLocalParking",
+			"MapsLocalPharmacy",
+			"MapsLocalPhone",
+			"MapsLocalPizza",
+			"MapsLocalPlay",
+			"MapsLocalPostOffice",
+			"MapsLocalPrintshop",
+			"MapsLocalSee",
+			"MapsLocalShipping",
+			"MapsLocalTaxi",
+			"MapsNearMe",
+			"MapsPlace",
+			"MapsRestaurant",
+			"MapsRestaurantMenu",
+			"MapsStoreMallDirectory",
+			"MapsTerrain",
+			"MapsTrain",
+			"MapsTram",
+			"MapsTransferWithinAStation",
+			"NotificationAirlineSeatFlat",
+			"NotificationAirlineSeatFlatAngled",
+			"NotificationAirlineSeatIndividualSuite",
+			"NotificationAirlineSeatLegroomExtra",
+			"NotificationAirlineSeatLegroomNormal",
+			"NotificationAirlineSeatLegroomReduced",
+			"NotificationAirlineSeatReclineExtra",
+			"NotificationAirlineSeatReclineNormal",
+			"NotificationBluetoothAudio",
+			"NotificationDoNotDisturb",
+			"NotificationDoNotDisturbAlt",
+			"NotificationDriveETA",
+			"NotificationOnDemandVideo",
+			"NotificationPersonalVideo",
+			"NotificationRVHookup",
+			"NotificationSIMCardAlert",
+			"NotificationSMSFailed",
+			"NotificationTimeToLeave",
+			"NotificationVibration",
+			"NotificationWC",
+			"PlacesACUnit",
+			"PlacesAirportShuttle",
+			"PlacesAllInclusive",
+			"PlacesBeachAccess",
+			"PlacesBusinessCenter",
+			"PlacesCasino",
+			"PlacesChildCare",
+			"PlacesChildFriendly",
+			"PlacesFitnessCenter",
+			"PlacesFreeBreakfast",
+			"PlacesGolfCourse",
+			"PlacesHotTub",
+			"PlacesKitchen",
+			"PlacesPool",
+			"PlacesRVHookup",
+			"PlacesRoomService",
+			"PlacesSmokeFree",
+			"PlacesSmokingRooms",
+			"PlacesSpa",
+			"SocialLocationCity",
+			"SocialNotificationsNone",
+			"SocialPartyMode",
+			"SocialPeople",
+			"SocialPeopleOutline",
+			"SocialPersonOutline",
+			"SocialPlusOne",
+			"SocialPoll",
+			"SocialPublic",
+			"ToggleStarBorder",
+		},
+	}
+)
+
+// Fallback reports whether the style has no source for the named icon, so that its field
+// is the filled icon. The names of deprecated icons are resolved as by Deprecated.
+func (v *Variant) Fallback(name string) bool {
+	if current, ok := Deprecated(name); ok {
+		name = current
+	}
+	i := sort.SearchStrings(v.fallbacks, name)
+	return i < len(v.fallbacks) && v.fallbacks[i] == name
+}
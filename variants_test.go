@@ -0,0 +1,24 @@
+package icons
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFallback checks that Fallback reports exactly the fields that are the filled icons.
+func TestFallback(t *testing.T) {
+	v := reflect.ValueOf(Outlined)
+	n := 0
+	for _, e := range entries {
+		filled := v.FieldByName(e.Name).Interface().(*Icon) == e.Icon
+		if got := Outlined.Fallback(e.Name); got != filled {
+			t.Errorf("Fallback(%q) = %v, want %v", e.Name, got, filled)
+		}
+		if filled {
+			n++
+		}
+	}
+	if n == len(entries) {
+		t.Error("every Outlined icon is a fallback")
+	}
+}